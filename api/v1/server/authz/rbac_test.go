@@ -21,6 +21,9 @@ var adminAndOwnerOnly = []string{
 	"ApiTokenList",
 	"ApiTokenCreate",
 	"ApiTokenUpdateRevoke",
+	// bundles hold the secrets of webhooks and operators, and importing one can overwrite any configuration
+	"V1TenantBundleExport",
+	"V1TenantBundleImport",
 }
 
 // memberOnlyOps are operations available to MEMBER (and above) that VIEWER should not have -
@@ -55,6 +58,16 @@ var memberOnlyOps = []string{
 	"WorkflowScheduledBulkDelete",
 	"V1FilterDelete",
 	"V1FilterUpdate",
+	"V1BulkJobCreate",
+	"V1BulkJobPause",
+	"V1BulkJobResume",
+	"V1WorkflowRunSignal",
+	"V1ApprovalResolve",
+	"V1EventSchemaCreate",
+	"V1EventSchemaDelete",
+	"V1WorkerCordon",
+	"V1WorkerUncordon",
+	"V1WorkerDrain",
 	"SlackWebhookDelete",
 	"TenantMemberDelete",
 	"WorkflowScheduledDelete",
@@ -1,8 +1,13 @@
 package tenants
 
 import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
 	"github.com/labstack/echo/v4"
 
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/apierrors"
 	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
 	"github.com/hatchet-dev/hatchet/api/v1/server/oas/transformers"
 	v1 "github.com/hatchet-dev/hatchet/pkg/repository"
@@ -79,7 +84,55 @@ func (t *TenantService) TenantUpdate(ctx echo.Context, request gen.TenantUpdateR
 		}
 	}
 
+	if request.Body.PriorityAgingInterval != nil || request.Body.PriorityAgingMaxWait != nil {
+		apiErrors, err := t.updatePriorityAging(ctx.Request().Context(), tenantId, request.Body)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if apiErrors != nil {
+			return gen.TenantUpdate400JSONResponse(*apiErrors), nil
+		}
+	}
+
 	return gen.TenantUpdate200JSONResponse(
 		*transformers.ToTenant(tenant, t.config.Runtime.ServerURL),
 	), nil
 }
+
+// updatePriorityAging merges the requested priority aging settings into the tenant's current
+// policy, so that a setting which isn't part of the request keeps its value.
+func (t *TenantService) updatePriorityAging(ctx context.Context, tenantId uuid.UUID, body *gen.UpdateTenantRequest) (*gen.APIErrors, error) {
+	current, err := t.config.V1.PriorityAging().GetTenantPriorityAging(ctx, tenantId)
+
+	if err != nil {
+		return nil, err
+	}
+
+	opts := v1.PriorityAgingOpts{
+		Interval: body.PriorityAgingInterval,
+		MaxWait:  body.PriorityAgingMaxWait,
+	}
+
+	if current != nil {
+		if opts.Interval == nil && current.Interval > 0 {
+			interval := current.Interval.String()
+			opts.Interval = &interval
+		}
+
+		if opts.MaxWait == nil && current.MaxWait > 0 {
+			maxWait := current.MaxWait.String()
+			opts.MaxWait = &maxWait
+		}
+	}
+
+	_, err = t.config.V1.PriorityAging().UpdateTenantPriorityAging(ctx, tenantId, opts)
+
+	if errors.Is(err, v1.ErrInvalidPriorityAging) {
+		apiErrors := apierrors.NewAPIErrors(err.Error())
+		return &apiErrors, nil
+	}
+
+	return nil, err
+}
@@ -0,0 +1,56 @@
+package approvalsv1
+
+import (
+	"fmt"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/apierrors"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	transformers "github.com/hatchet-dev/hatchet/api/v1/server/oas/transformers/v1"
+	v1 "github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/sqlcv1"
+)
+
+func (t *V1ApprovalsService) V1ApprovalList(ctx echo.Context, request gen.V1ApprovalListRequestObject) (gen.V1ApprovalListResponseObject, error) {
+	tenant := ctx.Get("tenant").(*sqlcv1.Tenant)
+
+	limit := int64(50)
+	offset := int64(0)
+
+	if request.Params.Limit != nil {
+		limit = *request.Params.Limit
+	}
+
+	if request.Params.Offset != nil {
+		offset = *request.Params.Offset
+	}
+
+	if limit < 1 || limit > 1000 {
+		return gen.V1ApprovalList400JSONResponse(apierrors.NewAPIErrors("limit must be between 1 and 1000")), nil
+	}
+
+	if offset < 0 {
+		return gen.V1ApprovalList400JSONResponse(apierrors.NewAPIErrors("offset must not be negative")), nil
+	}
+
+	var statuses []sqlcv1.V1ApprovalStatus
+
+	if request.Params.Statuses != nil {
+		for _, status := range *request.Params.Statuses {
+			statuses = append(statuses, sqlcv1.V1ApprovalStatus(status))
+		}
+	}
+
+	approvals, total, err := t.config.V1.Approvals().ListApprovals(ctx.Request().Context(), tenant.ID, v1.ListApprovalsOpts{
+		Statuses: statuses,
+		Limit:    int32(limit),  // nolint: gosec
+		Offset:   int32(offset), // nolint: gosec
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to list approvals: %w", err)
+	}
+
+	return gen.V1ApprovalList200JSONResponse(transformers.ToV1ApprovalList(approvals, total, limit, offset)), nil
+}
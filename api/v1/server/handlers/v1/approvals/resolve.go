@@ -0,0 +1,81 @@
+package approvalsv1
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/apierrors"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	transformers "github.com/hatchet-dev/hatchet/api/v1/server/oas/transformers/v1"
+	"github.com/hatchet-dev/hatchet/internal/msgqueue"
+	tasktypes "github.com/hatchet-dev/hatchet/internal/services/shared/tasktypes/v1"
+	"github.com/hatchet-dev/hatchet/pkg/analytics"
+	v1 "github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/sqlcv1"
+)
+
+func (t *V1ApprovalsService) V1ApprovalResolve(ctx echo.Context, request gen.V1ApprovalResolveRequestObject) (gen.V1ApprovalResolveResponseObject, error) {
+	tenant := ctx.Get("tenant").(*sqlcv1.Tenant)
+
+	if request.Body == nil {
+		return gen.V1ApprovalResolve400JSONResponse(apierrors.NewAPIErrors("request body is required")), nil
+	}
+
+	opts := v1.ResolveApprovalOpts{
+		Approved: request.Body.Approved,
+		Comment:  request.Body.Comment,
+	}
+
+	// the approver of a session is the signed in user, while api tokens don't identify a person, so
+	// they're recorded by their id and can only resolve approvals without approvers
+	if user, ok := ctx.Get("user").(*sqlcv1.User); ok && user != nil {
+		opts.Approver = user.Email
+	} else {
+		tokenId, ok := ctx.Get(string(analytics.APITokenIDKey)).(uuid.UUID)
+
+		if !ok {
+			return gen.V1ApprovalResolve403JSONResponse(apierrors.NewAPIErrors("approvals can only be resolved by users and api tokens")), nil
+		}
+
+		opts.Approver = v1.APITokenApprover(tokenId)
+		opts.ApiTokenId = &tokenId
+	}
+
+	approval, err := t.config.V1.Approvals().ResolveApproval(ctx.Request().Context(), tenant.ID, request.Task, opts)
+
+	switch {
+	case errors.Is(err, v1.ErrApprovalNotFound):
+		return gen.V1ApprovalResolve404JSONResponse(apierrors.NewAPIErrors("approval not found")), nil
+	case errors.Is(err, v1.ErrApprovalNotPending):
+		return gen.V1ApprovalResolve409JSONResponse(apierrors.NewAPIErrors("approval has already been resolved")), nil
+	case errors.Is(err, v1.ErrApprovalNotApprover) && opts.ApiTokenId != nil:
+		return gen.V1ApprovalResolve403JSONResponse(apierrors.NewAPIErrors("this approval has approvers, so it can't be resolved with an api token")), nil
+	case errors.Is(err, v1.ErrApprovalNotApprover):
+		return gen.V1ApprovalResolve403JSONResponse(apierrors.NewAPIErrors(fmt.Sprintf("%s is not an approver of this approval", opts.Approver))), nil
+	case err != nil:
+		return nil, fmt.Errorf("failed to resolve approval: %w", err)
+	}
+
+	taskMsg, olapMsg, err := tasktypes.ApprovalResolvedMessages(approval)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to create approval task messages: %w", err)
+	}
+
+	if taskMsg != nil {
+		if err := t.config.MessageQueueV1.SendMessage(ctx.Request().Context(), msgqueue.TASK_PROCESSING_QUEUE, taskMsg); err != nil {
+			return nil, fmt.Errorf("failed to send approval task message: %w", err)
+		}
+	}
+
+	if olapMsg != nil {
+		if err := t.config.MessageQueueV1.SendMessage(ctx.Request().Context(), msgqueue.OLAP_QUEUE, olapMsg); err != nil {
+			return nil, fmt.Errorf("failed to send approval olap message: %w", err)
+		}
+	}
+
+	return gen.V1ApprovalResolve200JSONResponse(transformers.ToV1Approval(approval)), nil
+}
@@ -0,0 +1,15 @@
+package approvalsv1
+
+import (
+	"github.com/hatchet-dev/hatchet/pkg/config/server"
+)
+
+type V1ApprovalsService struct {
+	config *server.ServerConfig
+}
+
+func NewV1ApprovalsService(config *server.ServerConfig) *V1ApprovalsService {
+	return &V1ApprovalsService{
+		config: config,
+	}
+}
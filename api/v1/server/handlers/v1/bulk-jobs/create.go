@@ -0,0 +1,79 @@
+package bulkjobsv1
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/apierrors"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	transformers "github.com/hatchet-dev/hatchet/api/v1/server/oas/transformers/v1"
+	v1 "github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/sqlcv1"
+)
+
+func (t *V1BulkJobsService) V1BulkJobCreate(ctx echo.Context, request gen.V1BulkJobCreateRequestObject) (gen.V1BulkJobCreateResponseObject, error) {
+	tenant := ctx.Get("tenant").(*sqlcv1.Tenant)
+
+	opts := v1.CreateBulkJobOpts{
+		Kind: sqlcv1.V1BulkJobKind(request.Body.Kind),
+	}
+
+	switch opts.Kind {
+	case sqlcv1.V1BulkJobKindCANCEL, sqlcv1.V1BulkJobKindREPLAY, sqlcv1.V1BulkJobKindDELETE:
+	default:
+		return gen.V1BulkJobCreate400JSONResponse(apierrors.NewAPIErrors("kind must be one of CANCEL, REPLAY or DELETE")), nil
+	}
+
+	hasExternalIds := request.Body.ExternalIds != nil && len(*request.Body.ExternalIds) > 0
+
+	if hasExternalIds == (request.Body.Filter != nil) {
+		return gen.V1BulkJobCreate400JSONResponse(apierrors.NewAPIErrors("exactly one of externalIds or filter must be provided")), nil
+	}
+
+	if hasExternalIds {
+		opts.ExternalIds = *request.Body.ExternalIds
+	}
+
+	if request.Body.Filter != nil {
+		filter := &v1.BulkJobFilter{
+			Since: request.Body.Filter.Since,
+			Until: request.Body.Filter.Until,
+		}
+
+		if request.Body.Filter.Statuses != nil {
+			for _, status := range *request.Body.Filter.Statuses {
+				filter.Statuses = append(filter.Statuses, sqlcv1.V1ReadableStatusOlap(status))
+			}
+		}
+
+		if request.Body.Filter.WorkflowIds != nil {
+			filter.WorkflowIds = *request.Body.Filter.WorkflowIds
+		}
+
+		if request.Body.Filter.AdditionalMetadata != nil {
+			filter.AdditionalMetadata = make(map[string]string)
+
+			for _, v := range *request.Body.Filter.AdditionalMetadata {
+				kv := strings.SplitN(v, ":", 2)
+
+				if len(kv) != 2 {
+					return gen.V1BulkJobCreate400JSONResponse(apierrors.NewAPIErrors(fmt.Sprintf("invalid additional metadata filter: %s", v))), nil
+				}
+
+				filter.AdditionalMetadata[kv[0]] = kv[1]
+			}
+		}
+
+		opts.Filter = filter
+	}
+
+	job, err := t.config.V1.BulkJobs().CreateBulkJob(ctx.Request().Context(), tenant.ID, opts)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to create bulk job: %w", err)
+	}
+
+	return gen.V1BulkJobCreate200JSONResponse(transformers.ToV1BulkJob(job, nil)), nil
+}
@@ -0,0 +1,26 @@
+package bulkjobsv1
+
+import (
+	"fmt"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	transformers "github.com/hatchet-dev/hatchet/api/v1/server/oas/transformers/v1"
+	"github.com/hatchet-dev/hatchet/pkg/repository/sqlcv1"
+)
+
+// the number of failed items returned alongside a bulk job
+const maxFailuresReturned = 100
+
+func (t *V1BulkJobsService) V1BulkJobGet(ctx echo.Context, request gen.V1BulkJobGetRequestObject) (gen.V1BulkJobGetResponseObject, error) {
+	job := ctx.Get("v1-bulk-job").(*sqlcv1.V1BulkJob)
+
+	failures, err := t.config.V1.BulkJobs().ListFailedBulkJobItems(ctx.Request().Context(), job.TenantID, job.ID, maxFailuresReturned)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to list bulk job failures: %w", err)
+	}
+
+	return gen.V1BulkJobGet200JSONResponse(transformers.ToV1BulkJob(job, failures)), nil
+}
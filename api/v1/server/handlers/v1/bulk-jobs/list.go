@@ -0,0 +1,23 @@
+package bulkjobsv1
+
+import (
+	"fmt"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	transformers "github.com/hatchet-dev/hatchet/api/v1/server/oas/transformers/v1"
+	"github.com/hatchet-dev/hatchet/pkg/repository/sqlcv1"
+)
+
+func (t *V1BulkJobsService) V1BulkJobList(ctx echo.Context, request gen.V1BulkJobListRequestObject) (gen.V1BulkJobListResponseObject, error) {
+	tenant := ctx.Get("tenant").(*sqlcv1.Tenant)
+
+	jobs, err := t.config.V1.BulkJobs().ListBulkJobs(ctx.Request().Context(), tenant.ID, 100)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to list bulk jobs: %w", err)
+	}
+
+	return gen.V1BulkJobList200JSONResponse(transformers.ToV1BulkJobList(jobs)), nil
+}
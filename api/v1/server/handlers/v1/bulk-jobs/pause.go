@@ -0,0 +1,30 @@
+package bulkjobsv1
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/apierrors"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	transformers "github.com/hatchet-dev/hatchet/api/v1/server/oas/transformers/v1"
+	v1 "github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/sqlcv1"
+)
+
+func (t *V1BulkJobsService) V1BulkJobPause(ctx echo.Context, request gen.V1BulkJobPauseRequestObject) (gen.V1BulkJobPauseResponseObject, error) {
+	job := ctx.Get("v1-bulk-job").(*sqlcv1.V1BulkJob)
+
+	paused, err := t.config.V1.BulkJobs().PauseBulkJob(ctx.Request().Context(), job.TenantID, job.ID)
+
+	if errors.Is(err, v1.ErrBulkJobInvalidTransition) {
+		return gen.V1BulkJobPause400JSONResponse(apierrors.NewAPIErrors("only running bulk jobs can be paused")), nil
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to pause bulk job: %w", err)
+	}
+
+	return gen.V1BulkJobPause200JSONResponse(transformers.ToV1BulkJob(paused, nil)), nil
+}
@@ -0,0 +1,30 @@
+package bulkjobsv1
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/apierrors"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	transformers "github.com/hatchet-dev/hatchet/api/v1/server/oas/transformers/v1"
+	v1 "github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/sqlcv1"
+)
+
+func (t *V1BulkJobsService) V1BulkJobResume(ctx echo.Context, request gen.V1BulkJobResumeRequestObject) (gen.V1BulkJobResumeResponseObject, error) {
+	job := ctx.Get("v1-bulk-job").(*sqlcv1.V1BulkJob)
+
+	resumed, err := t.config.V1.BulkJobs().ResumeBulkJob(ctx.Request().Context(), job.TenantID, job.ID)
+
+	if errors.Is(err, v1.ErrBulkJobInvalidTransition) {
+		return gen.V1BulkJobResume400JSONResponse(apierrors.NewAPIErrors("only paused bulk jobs can be resumed")), nil
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to resume bulk job: %w", err)
+	}
+
+	return gen.V1BulkJobResume200JSONResponse(transformers.ToV1BulkJob(resumed, nil)), nil
+}
@@ -0,0 +1,15 @@
+package bulkjobsv1
+
+import (
+	"github.com/hatchet-dev/hatchet/pkg/config/server"
+)
+
+type V1BulkJobsService struct {
+	config *server.ServerConfig
+}
+
+func NewV1BulkJobsService(config *server.ServerConfig) *V1BulkJobsService {
+	return &V1BulkJobsService{
+		config: config,
+	}
+}
@@ -0,0 +1,23 @@
+package circuitbreakersv1
+
+import (
+	"fmt"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	transformers "github.com/hatchet-dev/hatchet/api/v1/server/oas/transformers/v1"
+	"github.com/hatchet-dev/hatchet/pkg/repository/sqlcv1"
+)
+
+func (t *V1CircuitBreakersService) V1CircuitBreakerList(ctx echo.Context, request gen.V1CircuitBreakerListRequestObject) (gen.V1CircuitBreakerListResponseObject, error) {
+	tenant := ctx.Get("tenant").(*sqlcv1.Tenant)
+
+	breakers, err := t.config.V1.CircuitBreakers().ListCircuitBreakers(ctx.Request().Context(), tenant.ID)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to list circuit breakers: %w", err)
+	}
+
+	return gen.V1CircuitBreakerList200JSONResponse(transformers.ToV1CircuitBreakerList(breakers)), nil
+}
@@ -0,0 +1,15 @@
+package circuitbreakersv1
+
+import (
+	"github.com/hatchet-dev/hatchet/pkg/config/server"
+)
+
+type V1CircuitBreakersService struct {
+	config *server.ServerConfig
+}
+
+func NewV1CircuitBreakersService(config *server.ServerConfig) *V1CircuitBreakersService {
+	return &V1CircuitBreakersService{
+		config: config,
+	}
+}
@@ -0,0 +1,51 @@
+package eventschemasv1
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/apierrors"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	transformers "github.com/hatchet-dev/hatchet/api/v1/server/oas/transformers/v1"
+	v1 "github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/sqlcv1"
+)
+
+func (t *V1EventSchemasService) V1EventSchemaCreate(ctx echo.Context, request gen.V1EventSchemaCreateRequestObject) (gen.V1EventSchemaCreateResponseObject, error) {
+	tenant := ctx.Get("tenant").(*sqlcv1.Tenant)
+
+	schema, err := json.Marshal(request.Body.Schema)
+
+	if err != nil {
+		return gen.V1EventSchemaCreate400JSONResponse(apierrors.NewAPIErrors("failed to marshal schema to json")), nil
+	}
+
+	mode := sqlcv1.V1EventSchemaModeREJECT
+
+	if request.Body.Mode != nil {
+		mode = sqlcv1.V1EventSchemaMode(*request.Body.Mode)
+	}
+
+	eventSchema, err := t.config.V1.EventSchemas().RegisterEventSchema(
+		ctx.Request().Context(),
+		tenant.ID,
+		v1.RegisterEventSchemaOpts{
+			EventKey: request.Body.EventKey,
+			Schema:   schema,
+			Mode:     mode,
+		},
+	)
+
+	if errors.Is(err, v1.ErrInvalidEventSchema) {
+		return gen.V1EventSchemaCreate400JSONResponse(apierrors.NewAPIErrors(err.Error())), nil
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to register event schema: %w", err)
+	}
+
+	return gen.V1EventSchemaCreate200JSONResponse(transformers.ToV1EventSchema(eventSchema)), nil
+}
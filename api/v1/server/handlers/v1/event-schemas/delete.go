@@ -0,0 +1,29 @@
+package eventschemasv1
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/apierrors"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/pkg/repository/sqlcv1"
+)
+
+func (t *V1EventSchemasService) V1EventSchemaDelete(ctx echo.Context, request gen.V1EventSchemaDeleteRequestObject) (gen.V1EventSchemaDeleteResponseObject, error) {
+	tenant := ctx.Get("tenant").(*sqlcv1.Tenant)
+
+	err := t.config.V1.EventSchemas().DeleteEventSchema(ctx.Request().Context(), tenant.ID, request.Params.EventKey)
+
+	if errors.Is(err, pgx.ErrNoRows) {
+		return gen.V1EventSchemaDelete404JSONResponse(apierrors.NewAPIErrors("no schema is registered for the event key")), nil
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to delete event schema: %w", err)
+	}
+
+	return gen.V1EventSchemaDelete204Response{}, nil
+}
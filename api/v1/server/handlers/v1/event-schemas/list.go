@@ -0,0 +1,30 @@
+package eventschemasv1
+
+import (
+	"fmt"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	transformers "github.com/hatchet-dev/hatchet/api/v1/server/oas/transformers/v1"
+	"github.com/hatchet-dev/hatchet/pkg/repository/sqlcv1"
+)
+
+func (t *V1EventSchemasService) V1EventSchemaList(ctx echo.Context, request gen.V1EventSchemaListRequestObject) (gen.V1EventSchemaListResponseObject, error) {
+	tenant := ctx.Get("tenant").(*sqlcv1.Tenant)
+
+	var schemas []*sqlcv1.V1EventSchema
+	var err error
+
+	if request.Params.EventKey != nil {
+		schemas, err = t.config.V1.EventSchemas().ListEventSchemaVersions(ctx.Request().Context(), tenant.ID, *request.Params.EventKey)
+	} else {
+		schemas, err = t.config.V1.EventSchemas().ListEventSchemas(ctx.Request().Context(), tenant.ID)
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to list event schemas: %w", err)
+	}
+
+	return gen.V1EventSchemaList200JSONResponse(transformers.ToV1EventSchemaList(schemas)), nil
+}
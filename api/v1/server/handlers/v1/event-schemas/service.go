@@ -0,0 +1,15 @@
+package eventschemasv1
+
+import (
+	"github.com/hatchet-dev/hatchet/pkg/config/server"
+)
+
+type V1EventSchemasService struct {
+	config *server.ServerConfig
+}
+
+func NewV1EventSchemasService(config *server.ServerConfig) *V1EventSchemasService {
+	return &V1EventSchemasService{
+		config: config,
+	}
+}
@@ -1,11 +1,14 @@
 package logs
 
 import (
+	"fmt"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
 	"github.com/labstack/echo/v4"
 
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/apierrors"
 	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
 	"github.com/hatchet-dev/hatchet/pkg/analytics"
 	v1 "github.com/hatchet-dev/hatchet/pkg/repository"
@@ -37,6 +40,7 @@ func (t *LogsService) V1TenantLogLineList(ctx echo.Context, request gen.V1Tenant
 		taskExternalIds  []uuid.UUID
 		workflowIds      []uuid.UUID
 		stepIds          []uuid.UUID
+		workerIds        []uuid.UUID
 	)
 
 	if request.Params.Limit != nil {
@@ -83,6 +87,16 @@ func (t *LogsService) V1TenantLogLineList(ctx echo.Context, request gen.V1Tenant
 		stepIds = append(stepIds, *request.Params.StepIds...)
 	}
 
+	if request.Params.WorkerIds != nil {
+		workerIds = append(workerIds, *request.Params.WorkerIds...)
+	}
+
+	metadata, err := parseLogMetadataFilters(request.Params.Metadata)
+
+	if err != nil {
+		return gen.V1TenantLogLineList400JSONResponse(apierrors.NewAPIErrors(err.Error())), nil
+	}
+
 	limitInt := int(limit)
 
 	opts := &v1.ListLogsOpts{
@@ -96,6 +110,9 @@ func (t *LogsService) V1TenantLogLineList(ctx echo.Context, request gen.V1Tenant
 		TaskExternalIds:  taskExternalIds,
 		WorkflowIds:      workflowIds,
 		StepIds:          stepIds,
+		WorkerIds:        workerIds,
+		Query:            request.Params.Query,
+		Metadata:         metadata,
 	}
 
 	logLines, err := t.config.V1.Logs().ListLogLines(reqCtx, tenantId, opts)
@@ -115,6 +132,9 @@ func (t *LogsService) V1TenantLogLineList(ctx echo.Context, request gen.V1Tenant
 		"has_task_external_ids": len(taskExternalIds) > 0,
 		"has_workflow_ids":      len(workflowIds) > 0,
 		"has_step_ids":          len(stepIds) > 0,
+		"has_worker_ids":        len(workerIds) > 0,
+		"has_query":             request.Params.Query != nil,
+		"has_metadata":          len(metadata) > 0,
 	})
 
 	rows := make([]gen.V1LogLine, len(logLines))
@@ -138,3 +158,24 @@ func (t *LogsService) V1TenantLogLineList(ctx echo.Context, request gen.V1Tenant
 		},
 	), nil
 }
+
+// parseLogMetadataFilters parses key:value metadata filters into a map.
+func parseLogMetadataFilters(pairs *[]string) (map[string]string, error) {
+	if pairs == nil {
+		return nil, nil
+	}
+
+	res := make(map[string]string, len(*pairs))
+
+	for _, pair := range *pairs {
+		key, value, ok := strings.Cut(pair, ":")
+
+		if !ok || key == "" {
+			return nil, fmt.Errorf("invalid metadata filter %q, expected key:value", pair)
+		}
+
+		res[key] = value
+	}
+
+	return res, nil
+}
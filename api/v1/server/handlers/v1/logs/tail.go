@@ -0,0 +1,268 @@
+package logs
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/rs/zerolog"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/apierrors"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/pkg/analytics"
+	v1 "github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/sqlcv1"
+
+	transformers "github.com/hatchet-dev/hatchet/api/v1/server/oas/transformers/v1"
+)
+
+const (
+	// how often the tail polls for new log lines
+	tailPollInterval = time.Second
+
+	// the tail follows line ids, and created_at only bounds the scan. Workers send lines in batches
+	// and created_at comes from the worker, which can be up to v1.MaxLogLineClockSkew before the
+	// line was written, so the scan goes back that far plus a margin for lines still committing.
+	tailLookback = v1.MaxLogLineClockSkew + time.Minute
+
+	// log line ids are assigned when lines are inserted, so a line can commit after lines with higher
+	// ids. Each poll reads again from the highest id seen this long ago, and skips the lines which
+	// were already sent.
+	tailCommitWindow = 10 * time.Second
+
+	// how often a comment is sent on an idle tail, so proxies don't close the connection
+	tailKeepAliveInterval = 15 * time.Second
+
+	// the maximum number of lines read per query, the tail reads again immediately when it's reached
+	tailPollLimit = 1000
+)
+
+func (t *LogsService) V1TenantLogLineTail(ctx echo.Context, request gen.V1TenantLogLineTailRequestObject) (gen.V1TenantLogLineTailResponseObject, error) {
+	tenant := ctx.Get("tenant").(*sqlcv1.Tenant)
+	tenantId := tenant.ID
+	reqCtx := ctx.Request().Context()
+
+	metadata, err := parseLogMetadataFilters(request.Params.Metadata)
+
+	if err != nil {
+		return gen.V1TenantLogLineTail400JSONResponse(apierrors.NewAPIErrors(err.Error())), nil
+	}
+
+	filters := &v1.ListLogsOpts{
+		Search:   request.Params.Search,
+		Query:    request.Params.Query,
+		Metadata: metadata,
+	}
+
+	if request.Params.Levels != nil {
+		for _, level := range *request.Params.Levels {
+			filters.Levels = append(filters.Levels, string(level))
+		}
+	}
+
+	if request.Params.TaskExternalIds != nil {
+		filters.TaskExternalIds = *request.Params.TaskExternalIds
+	}
+
+	if request.Params.WorkflowIds != nil {
+		filters.WorkflowIds = *request.Params.WorkflowIds
+	}
+
+	if request.Params.StepIds != nil {
+		filters.StepIds = *request.Params.StepIds
+	}
+
+	if request.Params.WorkerIds != nil {
+		filters.WorkerIds = *request.Params.WorkerIds
+	}
+
+	var afterId int64
+
+	// reconnecting EventSource clients send the id of the last event they received
+	lastEventId := ctx.Request().Header.Get("Last-Event-ID")
+
+	switch {
+	case request.Params.After != nil:
+		afterId = *request.Params.After
+	case lastEventId != "":
+		afterId, err = strconv.ParseInt(lastEventId, 10, 64)
+
+		if err != nil {
+			return gen.V1TenantLogLineTail400JSONResponse(apierrors.NewAPIErrors("invalid Last-Event-ID header")), nil
+		}
+	default:
+		afterId, err = t.config.V1.Logs().GetLatestLogLineId(reqCtx, tenantId, time.Now().Add(-tailLookback))
+
+		if err != nil {
+			return nil, fmt.Errorf("could not get latest log line: %w", err)
+		}
+	}
+
+	t.config.Analytics.Count(reqCtx, analytics.Log, analytics.Subscribe, analytics.Properties{
+		"has_search":       filters.Search != nil,
+		"has_query":        filters.Query != nil,
+		"has_levels":       len(filters.Levels) > 0,
+		"has_workflow_ids": len(filters.WorkflowIds) > 0,
+		"has_worker_ids":   len(filters.WorkerIds) > 0,
+		"has_metadata":     len(filters.Metadata) > 0,
+	})
+
+	return &logTailResponse{
+		ctx:      reqCtx,
+		repo:     t.config.V1.Logs(),
+		l:        t.config.Logger,
+		tenantId: tenantId,
+		opts: &v1.TailLogsOpts{
+			ListLogsOpts: filters,
+			AfterId:      afterId,
+			Limit:        tailPollLimit,
+		},
+	}, nil
+}
+
+// logTailResponse streams log lines as server-sent events until the request is done. Each event
+// has the id of its log line, so clients can resume from it.
+type logTailResponse struct {
+	ctx      context.Context
+	repo     v1.LogLineRepository
+	l        *zerolog.Logger
+	tenantId uuid.UUID
+	opts     *v1.TailLogsOpts
+
+	// the ids of the lines above opts.AfterId which were already sent
+	sent map[int64]struct{}
+
+	// the highest id seen at each poll in the commit window, oldest first
+	seen []seenLogLineId
+}
+
+type seenLogLineId struct {
+	at time.Time
+	id int64
+}
+
+func (r *logTailResponse) VisitV1TenantLogLineTailResponse(w http.ResponseWriter) error {
+	flusher, ok := w.(http.Flusher)
+
+	if !ok {
+		return fmt.Errorf("response writer does not support streaming")
+	}
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+	flusher.Flush()
+
+	poll := time.NewTimer(0)
+	defer poll.Stop()
+
+	keepAlive := time.NewTicker(tailKeepAliveInterval)
+	defer keepAlive.Stop()
+
+	for {
+		select {
+		case <-r.ctx.Done():
+			return nil
+		case <-keepAlive.C:
+			if _, err := fmt.Fprint(w, ": keep-alive\n\n"); err != nil {
+				return nil
+			}
+
+			flusher.Flush()
+		case <-poll.C:
+			err := r.writeNewLines(w)
+
+			if err != nil {
+				if r.ctx.Err() != nil {
+					return nil
+				}
+
+				// the response has started, so the error can't be returned to the client, which
+				// resumes from the last event it received when it reconnects
+				r.l.Error().Err(err).Msgf("could not tail logs for tenant %s", r.tenantId)
+
+				return nil
+			}
+
+			flusher.Flush()
+			poll.Reset(tailPollInterval)
+		}
+	}
+}
+
+// writeNewLines writes the lines which weren't sent yet. It reads from the highest id seen at the
+// start of the commit window, so lines which committed after lines with higher ids aren't missed.
+func (r *logTailResponse) writeNewLines(w http.ResponseWriter) error {
+	now := time.Now()
+
+	if r.sent == nil {
+		r.sent = make(map[int64]struct{})
+	}
+
+	opts := *r.opts
+	opts.Since = now.Add(-tailLookback)
+
+	maxId := r.opts.AfterId
+
+	for {
+		lines, err := r.repo.TailLogLines(r.ctx, r.tenantId, &opts)
+
+		if err != nil {
+			return err
+		}
+
+		for _, line := range lines {
+			opts.AfterId = line.ID
+			maxId = max(maxId, line.ID)
+
+			if _, ok := r.sent[line.ID]; ok {
+				continue
+			}
+
+			r.sent[line.ID] = struct{}{}
+
+			// the task of the line was deleted
+			if line.TaskExternalId == uuid.Nil {
+				continue
+			}
+
+			data, err := json.Marshal(transformers.ToV1LogLine(line))
+
+			if err != nil {
+				return err
+			}
+
+			if _, err := fmt.Fprintf(w, "id: %d\nevent: log\ndata: %s\n\n", line.ID, data); err != nil {
+				return err
+			}
+		}
+
+		if len(lines) < tailPollLimit {
+			break
+		}
+	}
+
+	r.seen = append(r.seen, seenLogLineId{at: now, id: maxId})
+
+	// lines at or below the highest id seen before the commit window are assumed to have committed,
+	// so they're not read again
+	for len(r.seen) > 0 && now.Sub(r.seen[0].at) >= tailCommitWindow {
+		r.opts.AfterId = r.seen[0].id
+		r.seen = r.seen[1:]
+	}
+
+	for id := range r.sent {
+		if id <= r.opts.AfterId {
+			delete(r.sent, id)
+		}
+	}
+
+	return nil
+}
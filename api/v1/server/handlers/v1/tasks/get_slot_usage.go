@@ -0,0 +1,66 @@
+package tasks
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/apierrors"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	transformers "github.com/hatchet-dev/hatchet/api/v1/server/oas/transformers/v1"
+	v1 "github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/sqlcv1"
+	"github.com/hatchet-dev/hatchet/pkg/telemetry"
+)
+
+func (t *TasksService) V1TaskGetSlotUsage(ctx echo.Context, request gen.V1TaskGetSlotUsageRequestObject) (gen.V1TaskGetSlotUsageResponseObject, error) {
+	tenant := ctx.Get("tenant").(*sqlcv1.Tenant)
+	tenantId := tenant.ID
+
+	spanContext, span := telemetry.NewSpan(ctx.Request().Context(), "v1-task-get-slot-usage")
+	defer span.End()
+
+	opts := v1.ListTaskSlotUsageOpts{
+		Since:    request.Params.Since.UTC(),
+		Until:    time.Now().UTC(),
+		GroupBy:  v1.SlotUsageGroupBy(request.Params.GroupBy),
+		SlotType: request.Params.SlotType,
+		Limit:    v1.DefaultSlotUsageLimit,
+	}
+
+	if request.Params.Until != nil {
+		opts.Until = request.Params.Until.UTC()
+	}
+
+	if request.Params.Key != nil {
+		opts.Key = *request.Params.Key
+	}
+
+	if request.Params.WorkflowIds != nil {
+		opts.WorkflowIds = *request.Params.WorkflowIds
+	}
+
+	if request.Params.Limit != nil {
+		if *request.Params.Limit <= 0 || *request.Params.Limit > v1.MaxSlotUsageLimit {
+			return gen.V1TaskGetSlotUsage400JSONResponse(apierrors.NewAPIErrors(fmt.Sprintf("limit must be between 1 and %d", v1.MaxSlotUsageLimit))), nil
+		}
+
+		opts.Limit = int32(*request.Params.Limit) // nolint: gosec
+	}
+
+	rows, err := t.config.V1.OLAP().ListTaskSlotUsage(spanContext, tenantId, opts)
+
+	if err != nil {
+		if errors.Is(err, v1.ErrInvalidSlotUsageQuery) {
+			return gen.V1TaskGetSlotUsage400JSONResponse(apierrors.NewAPIErrors(err.Error())), nil
+		}
+
+		return nil, err
+	}
+
+	return gen.V1TaskGetSlotUsage200JSONResponse(
+		transformers.ToSlotUsageList(rows, request.Params.GroupBy, opts.Since, opts.Until),
+	), nil
+}
@@ -0,0 +1,48 @@
+package tenantbundlesv1
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/pkg/repository/sqlcv1"
+	"github.com/hatchet-dev/hatchet/pkg/tenantbundle"
+)
+
+func (t *V1TenantBundlesService) V1TenantBundleExport(ctx echo.Context, request gen.V1TenantBundleExportRequestObject) (gen.V1TenantBundleExportResponseObject, error) {
+	tenant := ctx.Get("tenant").(*sqlcv1.Tenant)
+
+	opts := tenantbundle.ExportOpts{}
+
+	if request.Body.Passphrase != nil {
+		opts.Passphrase = *request.Body.Passphrase
+	}
+
+	bundle, warnings, err := tenantbundle.NewExporter(t.config.V1, t.config.Encryption).Export(ctx.Request().Context(), tenant.ID, opts)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to export tenant: %w", err)
+	}
+
+	data, err := json.Marshal(bundle)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal bundle: %w", err)
+	}
+
+	res := gen.V1TenantBundleExport{
+		Warnings: warnings,
+	}
+
+	if err := json.Unmarshal(data, &res.Bundle); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal bundle: %w", err)
+	}
+
+	if res.Warnings == nil {
+		res.Warnings = []string{}
+	}
+
+	return gen.V1TenantBundleExport200JSONResponse(res), nil
+}
@@ -0,0 +1,84 @@
+package tenantbundlesv1
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/apierrors"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/pkg/repository/sqlcv1"
+	"github.com/hatchet-dev/hatchet/pkg/tenantbundle"
+)
+
+func (t *V1TenantBundlesService) V1TenantBundleImport(ctx echo.Context, request gen.V1TenantBundleImportRequestObject) (gen.V1TenantBundleImportResponseObject, error) {
+	tenant := ctx.Get("tenant").(*sqlcv1.Tenant)
+
+	data, err := json.Marshal(request.Body.Bundle)
+
+	if err != nil {
+		return gen.V1TenantBundleImport400JSONResponse(apierrors.NewAPIErrors("failed to marshal bundle to json")), nil
+	}
+
+	var bundle tenantbundle.Bundle
+
+	if err := json.Unmarshal(data, &bundle); err != nil {
+		return gen.V1TenantBundleImport400JSONResponse(apierrors.NewAPIErrors(fmt.Sprintf("invalid bundle: %s", err))), nil
+	}
+
+	opts := tenantbundle.ImportOpts{}
+
+	if request.Body.Passphrase != nil {
+		opts.Passphrase = *request.Body.Passphrase
+	}
+
+	if request.Body.ConflictStrategy != nil {
+		opts.ConflictStrategy = tenantbundle.ConflictStrategy(*request.Body.ConflictStrategy)
+	}
+
+	if request.Body.DryRun != nil {
+		opts.DryRun = *request.Body.DryRun
+	}
+
+	res, err := tenantbundle.NewImporter(t.config.V1, t.config.Encryption).Import(ctx.Request().Context(), tenant.ID, &bundle, opts)
+
+	switch {
+	case errors.Is(err, tenantbundle.ErrConflicts):
+		return gen.V1TenantBundleImport409JSONResponse(toV1TenantBundleImportResult(res)), nil
+	case errors.Is(err, tenantbundle.ErrInvalidBundle),
+		errors.Is(err, tenantbundle.ErrPassphraseRequired),
+		errors.Is(err, tenantbundle.ErrInvalidPassphrase):
+		return gen.V1TenantBundleImport400JSONResponse(apierrors.NewAPIErrors(err.Error())), nil
+	case err != nil:
+		return nil, fmt.Errorf("failed to import bundle: %w", err)
+	}
+
+	return gen.V1TenantBundleImport200JSONResponse(toV1TenantBundleImportResult(res)), nil
+}
+
+func toV1TenantBundleImportResult(res *tenantbundle.ImportResult) gen.V1TenantBundleImportResult {
+	items := make([]gen.V1TenantBundleImportItem, 0, len(res.Items))
+
+	for _, item := range res.Items {
+		genItem := gen.V1TenantBundleImportItem{
+			Resource: item.Resource,
+			Name:     item.Name,
+			Action:   gen.V1TenantBundleImportAction(item.Action),
+		}
+
+		if item.Reason != "" {
+			reason := item.Reason
+			genItem.Reason = &reason
+		}
+
+		items = append(items, genItem)
+	}
+
+	return gen.V1TenantBundleImportResult{
+		DryRun:  res.DryRun,
+		Applied: res.Applied,
+		Items:   items,
+	}
+}
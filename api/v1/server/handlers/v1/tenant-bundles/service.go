@@ -0,0 +1,15 @@
+package tenantbundlesv1
+
+import (
+	"github.com/hatchet-dev/hatchet/pkg/config/server"
+)
+
+type V1TenantBundlesService struct {
+	config *server.ServerConfig
+}
+
+func NewV1TenantBundlesService(config *server.ServerConfig) *V1TenantBundlesService {
+	return &V1TenantBundlesService{
+		config: config,
+	}
+}
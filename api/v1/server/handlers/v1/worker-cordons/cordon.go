@@ -0,0 +1,60 @@
+package workercordonsv1
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/apierrors"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	transformers "github.com/hatchet-dev/hatchet/api/v1/server/oas/transformers/v1"
+	v1 "github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/sqlcv1"
+)
+
+func (t *V1WorkerCordonsService) V1WorkerCordon(ctx echo.Context, request gen.V1WorkerCordonRequestObject) (gen.V1WorkerCordonResponseObject, error) {
+	tenant := ctx.Get("tenant").(*sqlcv1.Tenant)
+	reqCtx := ctx.Request().Context()
+
+	cordons, err := t.config.V1.WorkerCordons().CordonWorkers(reqCtx, tenant.ID, toWorkerSelector(request.Body.Selector), request.Body.Reason)
+
+	if errors.Is(err, v1.ErrEmptyWorkerSelector) {
+		return gen.V1WorkerCordon400JSONResponse(apierrors.NewAPIErrors(err.Error())), nil
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to cordon workers: %w", err)
+	}
+
+	rows, err := t.listCordons(ctx, cordons)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return gen.V1WorkerCordon200JSONResponse(transformers.ToV1WorkerCordonList(rows)), nil
+}
+
+// listCordons lists the given cordons with the names and running tasks of their workers.
+func (t *V1WorkerCordonsService) listCordons(ctx echo.Context, cordons []*sqlcv1.V1WorkerCordon) ([]*sqlcv1.ListWorkerCordonsRow, error) {
+	if len(cordons) == 0 {
+		return []*sqlcv1.ListWorkerCordonsRow{}, nil
+	}
+
+	tenant := ctx.Get("tenant").(*sqlcv1.Tenant)
+	workerIds := make([]uuid.UUID, len(cordons))
+
+	for i, cordon := range cordons {
+		workerIds[i] = cordon.WorkerID
+	}
+
+	rows, err := t.config.V1.WorkerCordons().ListWorkerCordons(ctx.Request().Context(), tenant.ID, workerIds)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to list worker cordons: %w", err)
+	}
+
+	return rows, nil
+}
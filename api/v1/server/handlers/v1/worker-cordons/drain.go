@@ -0,0 +1,68 @@
+package workercordonsv1
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/apierrors"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	transformers "github.com/hatchet-dev/hatchet/api/v1/server/oas/transformers/v1"
+	v1 "github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/sqlcv1"
+)
+
+const (
+	defaultDrainTimeout = 10 * time.Minute
+	maxDrainTimeout     = 24 * time.Hour
+)
+
+func (t *V1WorkerCordonsService) V1WorkerDrain(ctx echo.Context, request gen.V1WorkerDrainRequestObject) (gen.V1WorkerDrainResponseObject, error) {
+	tenant := ctx.Get("tenant").(*sqlcv1.Tenant)
+
+	timeout := defaultDrainTimeout
+
+	if request.Body.Timeout != nil {
+		var err error
+
+		timeout, err = time.ParseDuration(*request.Body.Timeout)
+
+		if err != nil {
+			return gen.V1WorkerDrain400JSONResponse(apierrors.NewAPIErrors(fmt.Sprintf("invalid timeout: %s", err))), nil
+		}
+
+		if timeout < 0 || timeout > maxDrainTimeout {
+			return gen.V1WorkerDrain400JSONResponse(apierrors.NewAPIErrors(fmt.Sprintf("timeout must be between 0 and %s", maxDrainTimeout))), nil
+		}
+	}
+
+	action := sqlcv1.V1WorkerDrainActionREQUEUE
+
+	if request.Body.OnDeadline != nil {
+		action = sqlcv1.V1WorkerDrainAction(*request.Body.OnDeadline)
+	}
+
+	cordons, err := t.config.V1.WorkerCordons().DrainWorkers(ctx.Request().Context(), tenant.ID, toWorkerSelector(request.Body.Selector), v1.DrainWorkersOpts{
+		Reason:   request.Body.Reason,
+		Deadline: time.Now().Add(timeout),
+		Action:   action,
+	})
+
+	if errors.Is(err, v1.ErrEmptyWorkerSelector) {
+		return gen.V1WorkerDrain400JSONResponse(apierrors.NewAPIErrors(err.Error())), nil
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to drain workers: %w", err)
+	}
+
+	rows, err := t.listCordons(ctx, cordons)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return gen.V1WorkerDrain200JSONResponse(transformers.ToV1WorkerCordonList(rows)), nil
+}
@@ -0,0 +1,30 @@
+package workercordonsv1
+
+import (
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	transformers "github.com/hatchet-dev/hatchet/api/v1/server/oas/transformers/v1"
+	"github.com/hatchet-dev/hatchet/pkg/repository/sqlcv1"
+)
+
+func (t *V1WorkerCordonsService) V1WorkerCordonList(ctx echo.Context, request gen.V1WorkerCordonListRequestObject) (gen.V1WorkerCordonListResponseObject, error) {
+	tenant := ctx.Get("tenant").(*sqlcv1.Tenant)
+
+	var workerIds []uuid.UUID
+
+	if request.Params.WorkerIds != nil {
+		workerIds = *request.Params.WorkerIds
+	}
+
+	cordons, err := t.config.V1.WorkerCordons().ListWorkerCordons(ctx.Request().Context(), tenant.ID, workerIds)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to list worker cordons: %w", err)
+	}
+
+	return gen.V1WorkerCordonList200JSONResponse(transformers.ToV1WorkerCordonList(cordons)), nil
+}
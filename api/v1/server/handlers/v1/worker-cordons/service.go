@@ -0,0 +1,31 @@
+package workercordonsv1
+
+import (
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/pkg/config/server"
+	v1 "github.com/hatchet-dev/hatchet/pkg/repository"
+)
+
+type V1WorkerCordonsService struct {
+	config *server.ServerConfig
+}
+
+func NewV1WorkerCordonsService(config *server.ServerConfig) *V1WorkerCordonsService {
+	return &V1WorkerCordonsService{
+		config: config,
+	}
+}
+
+func toWorkerSelector(selector gen.V1WorkerSelector) v1.WorkerSelector {
+	res := v1.WorkerSelector{}
+
+	if selector.WorkerIds != nil {
+		res.WorkerIds = *selector.WorkerIds
+	}
+
+	if selector.Labels != nil {
+		res.Labels = *selector.Labels
+	}
+
+	return res
+}
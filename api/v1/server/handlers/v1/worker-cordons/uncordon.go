@@ -0,0 +1,31 @@
+package workercordonsv1
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/apierrors"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	v1 "github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/sqlcv1"
+)
+
+func (t *V1WorkerCordonsService) V1WorkerUncordon(ctx echo.Context, request gen.V1WorkerUncordonRequestObject) (gen.V1WorkerUncordonResponseObject, error) {
+	tenant := ctx.Get("tenant").(*sqlcv1.Tenant)
+
+	workerIds, err := t.config.V1.WorkerCordons().UncordonWorkers(ctx.Request().Context(), tenant.ID, toWorkerSelector(request.Body.Selector))
+
+	if errors.Is(err, v1.ErrEmptyWorkerSelector) {
+		return gen.V1WorkerUncordon400JSONResponse(apierrors.NewAPIErrors(err.Error())), nil
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to uncordon workers: %w", err)
+	}
+
+	return gen.V1WorkerUncordon200JSONResponse(gen.V1UncordonWorkersResponse{
+		WorkerIds: workerIds,
+	}), nil
+}
@@ -180,68 +180,12 @@ func (t *V1WorkflowRunsService) WithDags(ctx context.Context, request gen.V1Work
 		return nil, err
 	}
 
-	dagExternalIds := make([]uuid.UUID, 0)
-
-	for _, dag := range dags {
-		if dag.Kind == sqlcv1.V1RunKindDAG {
-			dagExternalIds = append(dagExternalIds, dag.ExternalID)
-		}
-	}
-
-	tasks, taskIdToDagExternalId, err := t.config.V1.OLAP().ListTasksByDAGId(
-		ctx,
-		tenantId,
-		dagExternalIds,
-		includePayloads,
-	)
-
-	if err != nil {
-		return nil, err
-	}
-
-	pgWorkflowIds := make([]uuid.UUID, 0)
-
-	for _, wf := range dags {
-		pgWorkflowIds = append(pgWorkflowIds, wf.WorkflowID)
-	}
-
-	workflowNames, err := t.config.V1.Workflows().ListWorkflowNamesByIds(
-		ctx,
-		tenantId,
-		pgWorkflowIds,
-	)
+	result, err := t.toWorkflowRunList(ctx, tenantId, dags, total, limit, offset, includePayloads, canViewPayloads)
 
 	if err != nil {
 		return nil, err
 	}
 
-	taskIdToWorkflowName := make(map[int64]string)
-	taskIdToActionId := make(map[int64]string)
-
-	for _, task := range tasks {
-		taskIdToActionId[task.ID] = task.ActionID
-		if name, ok := workflowNames[task.WorkflowID]; ok {
-			taskIdToWorkflowName[task.ID] = name
-		}
-	}
-
-	parsedTasks := transformers.TaskRunDataRowToWorkflowRunsMany(tasks, taskIdToWorkflowName, total, limit, offset, transformers.WithPayloads(canViewPayloads))
-
-	dagChildren := make(map[uuid.UUID][]gen.V1TaskSummary)
-
-	for _, task := range parsedTasks.Rows {
-		dagExternalId := taskIdToDagExternalId[int64(task.TaskId)]
-		existing, ok := dagChildren[dagExternalId]
-
-		if ok {
-			dagChildren[dagExternalId] = append(existing, task)
-		} else {
-			dagChildren[dagExternalId] = []gen.V1TaskSummary{task}
-		}
-	}
-
-	result := transformers.ToWorkflowRunMany(dags, dagChildren, taskIdToActionId, workflowNames, total, limit, offset, transformers.WithPayloads(canViewPayloads))
-
 	// Search for api errors to see how we handle errors in other cases
 	return gen.V1WorkflowRunList200JSONResponse(
 		result,
@@ -358,6 +302,74 @@ func (t *V1WorkflowRunsService) OnlyTasks(ctx context.Context, request gen.V1Wor
 	), nil
 }
 
+// toWorkflowRunList populates the tasks of the DAGs in a page of workflow runs and converts the page
+// to a task summary list.
+func (t *V1WorkflowRunsService) toWorkflowRunList(ctx context.Context, tenantId uuid.UUID, dags []*v1.WorkflowRunData, total int, limit, offset int64, includePayloads bool, canViewPayloads bool) (gen.V1TaskSummaryList, error) {
+	dagExternalIds := make([]uuid.UUID, 0)
+
+	for _, dag := range dags {
+		if dag.Kind == sqlcv1.V1RunKindDAG {
+			dagExternalIds = append(dagExternalIds, dag.ExternalID)
+		}
+	}
+
+	tasks, taskIdToDagExternalId, err := t.config.V1.OLAP().ListTasksByDAGId(
+		ctx,
+		tenantId,
+		dagExternalIds,
+		includePayloads,
+	)
+
+	if err != nil {
+		return gen.V1TaskSummaryList{}, err
+	}
+
+	pgWorkflowIds := make([]uuid.UUID, 0)
+
+	for _, wf := range dags {
+		pgWorkflowIds = append(pgWorkflowIds, wf.WorkflowID)
+	}
+
+	workflowNames, err := t.config.V1.Workflows().ListWorkflowNamesByIds(
+		ctx,
+		tenantId,
+		pgWorkflowIds,
+	)
+
+	if err != nil {
+		return gen.V1TaskSummaryList{}, err
+	}
+
+	taskIdToWorkflowName := make(map[int64]string)
+	taskIdToActionId := make(map[int64]string)
+
+	for _, task := range tasks {
+		taskIdToActionId[task.ID] = task.ActionID
+		if name, ok := workflowNames[task.WorkflowID]; ok {
+			taskIdToWorkflowName[task.ID] = name
+		}
+	}
+
+	parsedTasks := transformers.TaskRunDataRowToWorkflowRunsMany(tasks, taskIdToWorkflowName, total, limit, offset, transformers.WithPayloads(canViewPayloads))
+
+	dagChildren := make(map[uuid.UUID][]gen.V1TaskSummary)
+
+	for _, task := range parsedTasks.Rows {
+		dagExternalId := taskIdToDagExternalId[int64(task.TaskId)]
+		existing, ok := dagChildren[dagExternalId]
+
+		if ok {
+			dagChildren[dagExternalId] = append(existing, task)
+		} else {
+			dagChildren[dagExternalId] = []gen.V1TaskSummary{task}
+		}
+	}
+
+	result := transformers.ToWorkflowRunMany(dags, dagChildren, taskIdToActionId, workflowNames, total, limit, offset, transformers.WithPayloads(canViewPayloads))
+
+	return result, nil
+}
+
 func (t *V1WorkflowRunsService) V1WorkflowRunList(ctx echo.Context, request gen.V1WorkflowRunListRequestObject) (gen.V1WorkflowRunListResponseObject, error) {
 	tenant := ctx.Get("tenant").(*sqlcv1.Tenant)
 	tenantId := tenant.ID
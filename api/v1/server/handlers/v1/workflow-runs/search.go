@@ -0,0 +1,67 @@
+package workflowruns
+
+import (
+	"errors"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/authz"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/apierrors"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	v1 "github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/sqlcv1"
+	"github.com/hatchet-dev/hatchet/pkg/telemetry"
+)
+
+func (t *V1WorkflowRunsService) V1WorkflowRunSearch(ctx echo.Context, request gen.V1WorkflowRunSearchRequestObject) (gen.V1WorkflowRunSearchResponseObject, error) {
+	tenant := ctx.Get("tenant").(*sqlcv1.Tenant)
+	tenantId := tenant.ID
+
+	spanContext, span := telemetry.NewSpan(ctx.Request().Context(), "v1-workflow-runs-search")
+	defer span.End()
+
+	opts := v1.SearchWorkflowRunsOpts{
+		Where:    request.Body.Where,
+		Since:    request.Body.Since,
+		Until:    request.Body.Until,
+		Statuses: allOlapStatuses(nil),
+	}
+
+	if request.Body.Statuses != nil && len(*request.Body.Statuses) > 0 {
+		opts.Statuses = normalizeWorkflowRunStatuses(*request.Body.Statuses, nil)
+	}
+
+	if request.Body.WorkflowIds != nil {
+		opts.WorkflowIds = *request.Body.WorkflowIds
+	}
+
+	opts.Limit = v1.DefaultRunSearchLimit
+
+	if request.Body.Limit != nil {
+		opts.Limit = *request.Body.Limit
+	}
+
+	if request.Body.IncludePayloads != nil {
+		opts.IncludePayloads = *request.Body.IncludePayloads
+	}
+
+	dags, err := t.config.V1.OLAP().SearchWorkflowRuns(spanContext, tenantId, opts)
+
+	if err != nil {
+		if errors.Is(err, v1.ErrInvalidRunSearch) {
+			return gen.V1WorkflowRunSearch400JSONResponse(apierrors.NewAPIErrors(err.Error())), nil
+		}
+
+		return nil, err
+	}
+
+	result, err := t.toWorkflowRunList(spanContext, tenantId, dags, len(dags), max(opts.Limit, 1), 0, opts.IncludePayloads, authz.CanViewPayloads(ctx))
+
+	if err != nil {
+		return nil, err
+	}
+
+	return gen.V1WorkflowRunSearch200JSONResponse(
+		result,
+	), nil
+}
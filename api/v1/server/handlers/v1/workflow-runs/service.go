@@ -14,6 +14,7 @@ type V1WorkflowRunsService struct {
 	config                 *server.ServerConfig
 	proxyTrigger           *proxy.Proxy[admincontracts.TriggerWorkflowRunRequest, admincontracts.TriggerWorkflowRunResponse]
 	proxyBranchDurableTask *proxy.Proxy[admincontracts.BranchDurableTaskRequest, admincontracts.BranchDurableTaskResponse]
+	proxySignalRun         *proxy.Proxy[admincontracts.SignalRunRequest, admincontracts.SignalRunResponse]
 }
 
 func NewV1WorkflowRunsService(config *server.ServerConfig) *V1WorkflowRunsService {
@@ -25,9 +26,14 @@ func NewV1WorkflowRunsService(config *server.ServerConfig) *V1WorkflowRunsServic
 		return cli.Admin().BranchDurableTask(ctx, in)
 	})
 
+	proxySignalRun := proxy.NewProxy(config, func(ctx context.Context, cli *client.GRPCClient, in *admincontracts.SignalRunRequest) (*admincontracts.SignalRunResponse, error) {
+		return cli.Admin().SignalRun(ctx, in)
+	})
+
 	return &V1WorkflowRunsService{
 		config:                 config,
 		proxyTrigger:           proxyTrigger,
 		proxyBranchDurableTask: proxyBranchDurableTask,
+		proxySignalRun:         proxySignalRun,
 	}
 }
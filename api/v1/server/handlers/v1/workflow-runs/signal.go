@@ -0,0 +1,88 @@
+package workflowruns
+
+import (
+	"encoding/json"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/apierrors"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	contracts "github.com/hatchet-dev/hatchet/internal/services/shared/proto/v1"
+	"github.com/hatchet-dev/hatchet/pkg/repository/sqlcv1"
+)
+
+func (t *V1WorkflowRunsService) V1WorkflowRunSignal(ctx echo.Context, request gen.V1WorkflowRunSignalRequestObject) (gen.V1WorkflowRunSignalResponseObject, error) {
+	tenant := ctx.Get("tenant").(*sqlcv1.Tenant)
+
+	grpcReq := &contracts.SignalRunRequest{
+		RunExternalId: request.Body.RunExternalId.String(),
+		Name:          request.Body.Name,
+	}
+
+	if request.Body.Payload != nil {
+		payload, err := json.Marshal(request.Body.Payload)
+
+		if err != nil {
+			return gen.V1WorkflowRunSignal400JSONResponse(
+				apierrors.NewAPIErrors("payload must be a JSON object"),
+			), nil
+		}
+
+		grpcReq.Payload = payload
+	}
+
+	if request.Body.SignalId != nil {
+		signalId := request.Body.SignalId.String()
+		grpcReq.SignalId = &signalId
+	}
+
+	resp, err := t.proxySignalRun.Do(
+		ctx.Request().Context(),
+		tenant,
+		grpcReq,
+	)
+
+	if err != nil {
+		if e, ok := status.FromError(err); ok {
+			switch e.Code() {
+			case codes.InvalidArgument:
+				return gen.V1WorkflowRunSignal400JSONResponse(apierrors.NewAPIErrors(e.Message())), nil
+			case codes.NotFound:
+				return gen.V1WorkflowRunSignal404JSONResponse(apierrors.NewAPIErrors(e.Message())), nil
+			case codes.FailedPrecondition:
+				return gen.V1WorkflowRunSignal409JSONResponse(apierrors.NewAPIErrors(e.Message())), nil
+			}
+		}
+
+		return nil, err
+	}
+
+	signalId, err := uuid.Parse(resp.SignalId)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return gen.V1WorkflowRunSignal200JSONResponse{
+		SignalId:          signalId,
+		WaitingConditions: resp.WaitingConditions,
+		MatchedConditions: resp.MatchedConditions,
+		CreatedTasks:      toUUIDs(resp.CreatedTasks),
+		ResumedTasks:      toUUIDs(resp.ResumedTasks),
+	}, nil
+}
+
+func toUUIDs(ids []string) []uuid.UUID {
+	res := make([]uuid.UUID, 0, len(ids))
+
+	for _, id := range ids {
+		if parsed, err := uuid.Parse(id); err == nil {
+			res = append(res, parsed)
+		}
+	}
+
+	return res
+}
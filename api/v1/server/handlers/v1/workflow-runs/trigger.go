@@ -59,11 +59,29 @@ func (t *V1WorkflowRunsService) V1WorkflowRunCreate(ctx echo.Context, request ge
 		priority = &newPrio
 	}
 
+	var parentOutputs map[string][]byte
+
+	if request.Body.ParentOutputs != nil {
+		parentOutputs = make(map[string][]byte, len(*request.Body.ParentOutputs))
+
+		for name, output := range *request.Body.ParentOutputs {
+			parentOutputs[name], err = json.Marshal(output)
+
+			if err != nil {
+				return gen.V1WorkflowRunCreate400JSONResponse(
+					apierrors.NewAPIErrors(fmt.Sprintf("Invalid output for parent %s", name)),
+				), nil
+			}
+		}
+	}
+
 	grpcReq := &contracts.TriggerWorkflowRunRequest{
 		WorkflowName:       request.Body.WorkflowName,
 		Input:              inputBytes,
 		AdditionalMetadata: additionalMetadataBytes,
 		Priority:           priority,
+		TargetActionId:     request.Body.TargetActionId,
+		ParentOutputs:      parentOutputs,
 	}
 
 	resp, err := t.proxyTrigger.Do(
@@ -0,0 +1,94 @@
+package workflowspecsv1
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/apierrors"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/sqlcv1"
+	"github.com/hatchet-dev/hatchet/pkg/workflowspec"
+)
+
+func (t *V1WorkflowSpecsService) V1WorkflowSpecGet(ctx echo.Context, request gen.V1WorkflowSpecGetRequestObject) (gen.V1WorkflowSpecGetResponseObject, error) {
+	tenant := ctx.Get("tenant").(*sqlcv1.Tenant)
+	workflow := ctx.Get("workflow").(*sqlcv1.GetWorkflowByIdRow)
+
+	var workflowVersionId uuid.UUID
+
+	if request.Params.Version != nil {
+		workflowVersionId = *request.Params.Version
+	} else {
+		row, err := t.config.V1.Workflows().GetWorkflowById(ctx.Request().Context(), workflow.Workflow.ID)
+
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return gen.V1WorkflowSpecGet404JSONResponse(apierrors.NewAPIErrors("workflow not found")), nil
+			}
+
+			return nil, err
+		}
+
+		if row.WorkflowVersionId == nil {
+			return gen.V1WorkflowSpecGet404JSONResponse(apierrors.NewAPIErrors("workflow has no versions")), nil
+		}
+
+		workflowVersionId = *row.WorkflowVersionId
+	}
+
+	row, _, _, _, _, _, err := t.config.V1.Workflows().GetWorkflowVersionWithTriggers(ctx.Request().Context(), tenant.ID, workflowVersionId)
+
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return gen.V1WorkflowSpecGet404JSONResponse(apierrors.NewAPIErrors("version not found")), nil
+		}
+
+		return nil, fmt.Errorf("error fetching version: %w", err)
+	}
+
+	if row.WorkflowVersion.WorkflowId != workflow.Workflow.ID {
+		return gen.V1WorkflowSpecGet404JSONResponse(apierrors.NewAPIErrors("version not found")), nil
+	}
+
+	// versions registered before the options were stored can't be exported
+	if len(row.WorkflowVersion.CreateWorkflowVersionOpts) == 0 {
+		return gen.V1WorkflowSpecGet404JSONResponse(
+			apierrors.NewAPIErrors("the spec of this version isn't stored, register the workflow again to export it"),
+		), nil
+	}
+
+	var opts repository.CreateWorkflowVersionOpts
+
+	if err := json.Unmarshal(row.WorkflowVersion.CreateWorkflowVersionOpts, &opts); err != nil {
+		return nil, fmt.Errorf("failed to decode workflow version options: %w", err)
+	}
+
+	req, err := workflowspec.FromCreateWorkflowVersionOpts(&opts)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to build workflow spec: %w", err)
+	}
+
+	b, err := workflowspec.Marshal(&workflowspec.Document{Workflow: req}, workflowspec.FormatJSON)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to write workflow spec: %w", err)
+	}
+
+	var spec map[string]interface{}
+
+	if err := json.Unmarshal(b, &spec); err != nil {
+		return nil, fmt.Errorf("failed to write workflow spec: %w", err)
+	}
+
+	return gen.V1WorkflowSpecGet200JSONResponse{
+		WorkflowVersionId: workflowVersionId,
+		Spec:              spec,
+	}, nil
+}
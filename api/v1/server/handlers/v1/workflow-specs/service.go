@@ -0,0 +1,15 @@
+package workflowspecsv1
+
+import (
+	"github.com/hatchet-dev/hatchet/pkg/config/server"
+)
+
+type V1WorkflowSpecsService struct {
+	config *server.ServerConfig
+}
+
+func NewV1WorkflowSpecsService(config *server.ServerConfig) *V1WorkflowSpecsService {
+	return &V1WorkflowSpecsService{
+		config: config,
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"encoding/base64"
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
 	"net/url"
 	"path"
@@ -223,12 +224,50 @@ const (
 	OR  V1AdditionalMetadataOperator = "OR"
 )
 
+// Defines values for V1ApprovalExpiryAction.
+const (
+	V1ApprovalExpiryActionAPPROVE V1ApprovalExpiryAction = "APPROVE"
+	V1ApprovalExpiryActionFAIL    V1ApprovalExpiryAction = "FAIL"
+	V1ApprovalExpiryActionREJECT  V1ApprovalExpiryAction = "REJECT"
+)
+
+// Defines values for V1ApprovalStatus.
+const (
+	V1ApprovalStatusAPPROVED  V1ApprovalStatus = "APPROVED"
+	V1ApprovalStatusCANCELLED V1ApprovalStatus = "CANCELLED"
+	V1ApprovalStatusEXPIRED   V1ApprovalStatus = "EXPIRED"
+	V1ApprovalStatusPENDING   V1ApprovalStatus = "PENDING"
+	V1ApprovalStatusREJECTED  V1ApprovalStatus = "REJECTED"
+)
+
+// Defines values for V1BulkJobKind.
+const (
+	V1BulkJobKindCANCEL V1BulkJobKind = "CANCEL"
+	V1BulkJobKindDELETE V1BulkJobKind = "DELETE"
+	V1BulkJobKindREPLAY V1BulkJobKind = "REPLAY"
+)
+
+// Defines values for V1BulkJobStatus.
+const (
+	V1BulkJobStatusCOMPLETED V1BulkJobStatus = "COMPLETED"
+	V1BulkJobStatusFAILED    V1BulkJobStatus = "FAILED"
+	V1BulkJobStatusPAUSED    V1BulkJobStatus = "PAUSED"
+	V1BulkJobStatusRUNNING   V1BulkJobStatus = "RUNNING"
+)
+
 // Defines values for V1CELDebugResponseStatus.
 const (
 	V1CELDebugResponseStatusERROR   V1CELDebugResponseStatus = "ERROR"
 	V1CELDebugResponseStatusSUCCESS V1CELDebugResponseStatus = "SUCCESS"
 )
 
+// Defines values for V1CircuitBreakerState.
+const (
+	CLOSED   V1CircuitBreakerState = "CLOSED"
+	HALFOPEN V1CircuitBreakerState = "HALF_OPEN"
+	OPEN     V1CircuitBreakerState = "OPEN"
+)
+
 // Defines values for V1CreateWebhookRequestAPIKeyAuthType.
 const (
 	V1CreateWebhookRequestAPIKeyAuthTypeAPIKEY V1CreateWebhookRequestAPIKeyAuthType = "API_KEY"
@@ -258,6 +297,12 @@ const (
 	USEREVENT     V1DurableWaitConditionKind = "USER_EVENT"
 )
 
+// Defines values for V1EventSchemaMode.
+const (
+	V1EventSchemaModeREJECT V1EventSchemaMode = "REJECT"
+	V1EventSchemaModeWARN   V1EventSchemaMode = "WARN"
+)
+
 // Defines values for V1LogLineLevel.
 const (
 	V1LogLineLevelDEBUG V1LogLineLevel = "DEBUG"
@@ -279,6 +324,14 @@ const (
 	ONWORKER V1RunningFilter = "ON_WORKER"
 )
 
+// Defines values for V1SlotUsageGroupBy.
+const (
+	V1SlotUsageGroupByAction      V1SlotUsageGroupBy = "action"
+	V1SlotUsageGroupByMetadata    V1SlotUsageGroupBy = "metadata"
+	V1SlotUsageGroupByWorkerLabel V1SlotUsageGroupBy = "worker_label"
+	V1SlotUsageGroupByWorkflow    V1SlotUsageGroupBy = "workflow"
+)
+
 // Defines values for V1TaskEventType.
 const (
 	V1TaskEventTypeACKNOWLEDGED         V1TaskEventType = "ACKNOWLEDGED"
@@ -291,6 +344,7 @@ const (
 	V1TaskEventTypeDURABLERESTORING     V1TaskEventType = "DURABLE_RESTORING"
 	V1TaskEventTypeFAILED               V1TaskEventType = "FAILED"
 	V1TaskEventTypeFINISHED             V1TaskEventType = "FINISHED"
+	V1TaskEventTypePREEMPTED            V1TaskEventType = "PREEMPTED"
 	V1TaskEventTypeQUEUED               V1TaskEventType = "QUEUED"
 	V1TaskEventTypeRATELIMITERROR       V1TaskEventType = "RATE_LIMIT_ERROR"
 	V1TaskEventTypeREASSIGNED           V1TaskEventType = "REASSIGNED"
@@ -317,6 +371,22 @@ const (
 	V1TaskStatusRUNNING   V1TaskStatus = "RUNNING"
 )
 
+// Defines values for V1TenantBundleConflictStrategy.
+const (
+	V1TenantBundleConflictStrategyFAIL      V1TenantBundleConflictStrategy = "FAIL"
+	V1TenantBundleConflictStrategyOVERWRITE V1TenantBundleConflictStrategy = "OVERWRITE"
+	V1TenantBundleConflictStrategySKIP      V1TenantBundleConflictStrategy = "SKIP"
+)
+
+// Defines values for V1TenantBundleImportAction.
+const (
+	V1TenantBundleImportActionCONFLICT  V1TenantBundleImportAction = "CONFLICT"
+	V1TenantBundleImportActionCREATE    V1TenantBundleImportAction = "CREATE"
+	V1TenantBundleImportActionSKIP      V1TenantBundleImportAction = "SKIP"
+	V1TenantBundleImportActionUNCHANGED V1TenantBundleImportAction = "UNCHANGED"
+	V1TenantBundleImportActionUPDATE    V1TenantBundleImportAction = "UPDATE"
+)
+
 // Defines values for V1WebhookAuthType.
 const (
 	V1WebhookAuthTypeAPIKEY V1WebhookAuthType = "API_KEY"
@@ -349,6 +419,19 @@ const (
 	SVIX    V1WebhookSourceName = "SVIX"
 )
 
+// Defines values for V1WorkerCordonStatus.
+const (
+	CORDONED V1WorkerCordonStatus = "CORDONED"
+	DRAINED  V1WorkerCordonStatus = "DRAINED"
+	DRAINING V1WorkerCordonStatus = "DRAINING"
+)
+
+// Defines values for V1WorkerDrainAction.
+const (
+	V1WorkerDrainActionCANCEL  V1WorkerDrainAction = "CANCEL"
+	V1WorkerDrainActionREQUEUE V1WorkerDrainAction = "REQUEUE"
+)
+
 // Defines values for V1WorkflowType.
 const (
 	V1WorkflowTypeDAG  V1WorkflowType = "DAG"
@@ -1444,8 +1527,14 @@ type UpdateTenantRequest struct {
 	MaxAlertingFrequency *string `json:"maxAlertingFrequency,omitempty" validate:"omitnil,duration"`
 
 	// Name The name of the tenant.
-	Name    *string        `json:"name,omitempty"`
-	Version *TenantVersion `json:"version,omitempty"`
+	Name *string `json:"name,omitempty"`
+
+	// PriorityAgingInterval The time in queue after which a task's priority is raised by one level, for workflows without their own priority aging policy. "0s" disables stepwise aging.
+	PriorityAgingInterval *string `json:"priorityAgingInterval,omitempty" validate:"omitnil,duration"`
+
+	// PriorityAgingMaxWait The time in queue after which a task is ordered ahead of all other tasks, for workflows without their own priority aging policy. "0s" disables it.
+	PriorityAgingMaxWait *string        `json:"priorityAgingMaxWait,omitempty" validate:"omitnil,duration"`
+	Version              *TenantVersion `json:"version,omitempty"`
 }
 
 // UpdateWorkerRequest defines model for UpdateWorkerRequest.
@@ -1521,6 +1610,55 @@ type UserTenantPublic struct {
 // V1AdditionalMetadataOperator defines model for V1AdditionalMetadataOperator.
 type V1AdditionalMetadataOperator string
 
+// V1Approval defines model for V1Approval.
+type V1Approval struct {
+	// Approver The identity of whoever approved or rejected the approval, which is the email of a user, or `api-token:<id>` for an API token.
+	Approver *string `json:"approver,omitempty"`
+
+	// Approvers The emails of the approvers. Any tenant member can resolve the approval when empty.
+	Approvers []string `json:"approvers"`
+
+	// Comment The comment left by the approver.
+	Comment *string `json:"comment,omitempty"`
+
+	// Context The fields of the task payload shown to approvers.
+	Context map[string]interface{} `json:"context"`
+
+	// CreatedAt When the approval was requested.
+	CreatedAt time.Time `json:"createdAt"`
+
+	// ExpiresAt When the approval expires.
+	ExpiresAt time.Time `json:"expiresAt"`
+
+	// OnExpiry What happens to the task when the approval expires.
+	OnExpiry V1ApprovalExpiryAction `json:"onExpiry"`
+
+	// ResolvedAt When the approval was resolved.
+	ResolvedAt *time.Time       `json:"resolvedAt,omitempty"`
+	Status     V1ApprovalStatus `json:"status"`
+
+	// TaskExternalId The external id of the approval task.
+	TaskExternalId openapi_types.UUID `json:"taskExternalId"`
+
+	// TaskName The name of the approval task.
+	TaskName string `json:"taskName"`
+
+	// WorkflowRunExternalId The external id of the workflow run.
+	WorkflowRunExternalId openapi_types.UUID `json:"workflowRunExternalId"`
+}
+
+// V1ApprovalExpiryAction What happens to the task when the approval expires.
+type V1ApprovalExpiryAction string
+
+// V1ApprovalList defines model for V1ApprovalList.
+type V1ApprovalList struct {
+	Pagination *PaginationResponse `json:"pagination,omitempty"`
+	Rows       []V1Approval        `json:"rows"`
+}
+
+// V1ApprovalStatus defines model for V1ApprovalStatus.
+type V1ApprovalStatus string
+
 // V1BranchDurableTaskRequest defines model for V1BranchDurableTaskRequest.
 type V1BranchDurableTaskRequest struct {
 	// BranchId The branch id to replay from.
@@ -1545,6 +1683,57 @@ type V1BranchDurableTaskResponse struct {
 	TaskExternalId openapi_types.UUID `json:"taskExternalId"`
 }
 
+// V1BulkJob defines model for V1BulkJob.
+type V1BulkJob struct {
+	// Error Why the job failed, if it failed.
+	Error *string `json:"error,omitempty"`
+
+	// FailedCount The number of runs which the operation failed for.
+	FailedCount int64 `json:"failedCount"`
+
+	// Failures A sample of the runs which the operation failed for. Only set when getting a single job.
+	Failures *[]V1BulkJobItemFailure `json:"failures,omitempty"`
+	Filter   *V1TaskFilter           `json:"filter,omitempty"`
+
+	// FinishedAt When the job completed or failed.
+	FinishedAt *time.Time `json:"finishedAt,omitempty"`
+
+	// IsEnumerated Whether the runs which the job applies to have been resolved. Until then, totalCount is not final.
+	IsEnumerated bool            `json:"isEnumerated"`
+	Kind         V1BulkJobKind   `json:"kind"`
+	Metadata     APIResourceMeta `json:"metadata"`
+
+	// ProcessedCount The number of runs which have been processed.
+	ProcessedCount int64           `json:"processedCount"`
+	Status         V1BulkJobStatus `json:"status"`
+
+	// SucceededCount The number of runs which the operation succeeded for.
+	SucceededCount int64 `json:"succeededCount"`
+
+	// TotalCount The number of runs which the job applies to.
+	TotalCount int64 `json:"totalCount"`
+}
+
+// V1BulkJobItemFailure defines model for V1BulkJobItemFailure.
+type V1BulkJobItemFailure struct {
+	// Error Why the operation failed for the run.
+	Error string `json:"error"`
+
+	// ExternalId The external id of the run which the operation failed for.
+	ExternalId openapi_types.UUID `json:"externalId"`
+}
+
+// V1BulkJobKind defines model for V1BulkJobKind.
+type V1BulkJobKind string
+
+// V1BulkJobList defines model for V1BulkJobList.
+type V1BulkJobList struct {
+	Rows []V1BulkJob `json:"rows"`
+}
+
+// V1BulkJobStatus defines model for V1BulkJobStatus.
+type V1BulkJobStatus string
+
 // V1CELDebugRequest defines model for V1CELDebugRequest.
 type V1CELDebugRequest struct {
 	// AdditionalMetadata Additional metadata, which simulates metadata that could be sent with an event or a workflow run
@@ -1588,6 +1777,74 @@ type V1CancelledTasks struct {
 	Ids *[]openapi_types.UUID `json:"ids,omitempty"`
 }
 
+// V1CircuitBreaker defines model for V1CircuitBreaker.
+type V1CircuitBreaker struct {
+	// CooldownSeconds How long the breaker stays open before a probe task is assigned, in seconds.
+	CooldownSeconds int32 `json:"cooldownSeconds"`
+
+	// FailureCount The number of failures counted in the current window.
+	FailureCount int32 `json:"failureCount"`
+
+	// FailureThreshold The number of failures within the window which opens the breaker.
+	FailureThreshold int32 `json:"failureThreshold"`
+
+	// Key The key of the circuit breaker.
+	Key string `json:"key"`
+
+	// OpenedAt When the breaker last opened.
+	OpenedAt *time.Time `json:"openedAt,omitempty"`
+
+	// ProbeStartedAt When the current probe task was assigned, if the breaker is half-open.
+	ProbeStartedAt *time.Time            `json:"probeStartedAt,omitempty"`
+	State          V1CircuitBreakerState `json:"state"`
+
+	// UpdatedAt When the breaker was last updated.
+	UpdatedAt time.Time `json:"updatedAt"`
+
+	// WindowSeconds The period in which failures are counted, in seconds.
+	WindowSeconds int32 `json:"windowSeconds"`
+
+	// WindowStartedAt When the current failure window started.
+	WindowStartedAt time.Time `json:"windowStartedAt"`
+}
+
+// V1CircuitBreakerList defines model for V1CircuitBreakerList.
+type V1CircuitBreakerList struct {
+	Rows []V1CircuitBreaker `json:"rows"`
+}
+
+// V1CircuitBreakerState defines model for V1CircuitBreakerState.
+type V1CircuitBreakerState string
+
+// V1CordonWorkersRequest defines model for V1CordonWorkersRequest.
+type V1CordonWorkersRequest struct {
+	// Reason The reason the workers are cordoned.
+	Reason *string `json:"reason,omitempty"`
+
+	// Selector Selects workers by id and labels. A worker is selected if it has one of the worker ids, when any are set, and every label. At least one worker id or label must be set.
+	Selector V1WorkerSelector `json:"selector"`
+}
+
+// V1CreateBulkJobRequest defines model for V1CreateBulkJobRequest.
+type V1CreateBulkJobRequest struct {
+	// ExternalIds A list of workflow run external IDs to apply the job to. Exactly one of externalIds or filter must be set.
+	ExternalIds *[]openapi_types.UUID `json:"externalIds,omitempty"`
+	Filter      *V1TaskFilter         `json:"filter,omitempty"`
+	Kind        V1BulkJobKind         `json:"kind"`
+}
+
+// V1CreateEventSchemaRequest defines model for V1CreateEventSchemaRequest.
+type V1CreateEventSchemaRequest struct {
+	// EventKey The event key the schema applies to. May contain `*` wildcards, which match any sequence of characters.
+	EventKey string `json:"eventKey"`
+
+	// Mode Whether events which fail validation are rejected, or only recorded.
+	Mode *V1EventSchemaMode `json:"mode,omitempty"`
+
+	// Schema The JSON Schema event payloads are validated against.
+	Schema map[string]interface{} `json:"schema"`
+}
+
 // V1CreateFilterRequest defines model for V1CreateFilterRequest.
 type V1CreateFilterRequest struct {
 	// Expression The expression for the filter
@@ -1732,6 +1989,21 @@ type V1DagChildren struct {
 	DagId    *openapi_types.UUID `json:"dagId,omitempty"`
 }
 
+// V1DrainWorkersRequest defines model for V1DrainWorkersRequest.
+type V1DrainWorkersRequest struct {
+	// OnDeadline What happens to the non-durable tasks still running on a draining worker at its deadline. Durable tasks are always evicted and resume on another worker.
+	OnDeadline *V1WorkerDrainAction `json:"onDeadline,omitempty"`
+
+	// Reason The reason the workers are drained.
+	Reason *string `json:"reason,omitempty"`
+
+	// Selector Selects workers by id and labels. A worker is selected if it has one of the worker ids, when any are set, and every label. At least one worker id or label must be set.
+	Selector V1WorkerSelector `json:"selector"`
+
+	// Timeout How long running tasks are given to finish before they are moved off the workers, as a Go duration such as `10m`. Defaults to 10 minutes, and may be at most 24 hours.
+	Timeout *string `json:"timeout,omitempty"`
+}
+
 // V1DurableEventLogEntry defines model for V1DurableEventLogEntry.
 type V1DurableEventLogEntry struct {
 	// BranchId The branch id when this entry was first seen.
@@ -1817,6 +2089,32 @@ type V1EventList struct {
 	Rows       *[]V1Event          `json:"rows,omitempty"`
 }
 
+// V1EventSchema defines model for V1EventSchema.
+type V1EventSchema struct {
+	// CreatedAt The time the version was registered.
+	CreatedAt time.Time `json:"createdAt"`
+
+	// EventKey The event key the schema applies to. May contain `*` wildcards.
+	EventKey string `json:"eventKey"`
+
+	// Mode Whether events which fail validation are rejected, or only recorded.
+	Mode V1EventSchemaMode `json:"mode"`
+
+	// Schema The JSON Schema event payloads are validated against.
+	Schema map[string]interface{} `json:"schema"`
+
+	// Version The version of the schema. The latest version is the active one.
+	Version int32 `json:"version"`
+}
+
+// V1EventSchemaList defines model for V1EventSchemaList.
+type V1EventSchemaList struct {
+	Rows []V1EventSchema `json:"rows"`
+}
+
+// V1EventSchemaMode Whether events which fail validation are rejected, or only recorded.
+type V1EventSchemaMode string
+
 // V1EventTriggeredRun defines model for V1EventTriggeredRun.
 type V1EventTriggeredRun struct {
 	// FilterId The ID of the filter that triggered the run, if applicable.
@@ -1920,6 +2218,9 @@ type V1LogLine struct {
 
 	// TaskExternalId The external ID of the task associated with the log line.
 	TaskExternalId *openapi_types.UUID `json:"taskExternalId,omitempty"`
+
+	// WorkerId The ID of the worker which wrote the log line.
+	WorkerId *openapi_types.UUID `json:"workerId,omitempty"`
 }
 
 // V1LogLineLevel defines model for V1LogLineLevel.
@@ -1961,6 +2262,15 @@ type V1ReplayedTasks struct {
 	Ids *[]openapi_types.UUID `json:"ids,omitempty"`
 }
 
+// V1ResolveApprovalRequest defines model for V1ResolveApprovalRequest.
+type V1ResolveApprovalRequest struct {
+	// Approved Whether to approve or reject.
+	Approved bool `json:"approved"`
+
+	// Comment A comment which is recorded on the approval and returned in the task output.
+	Comment *string `json:"comment,omitempty"`
+}
+
 // V1RestoreTaskResponse defines model for V1RestoreTaskResponse.
 type V1RestoreTaskResponse struct {
 	Requeued bool `json:"requeued"`
@@ -1978,6 +2288,65 @@ type V1RunningDetailCount struct {
 // V1RunningFilter defines model for V1RunningFilter.
 type V1RunningFilter string
 
+// V1SignalRunRequest defines model for V1SignalRunRequest.
+type V1SignalRunRequest struct {
+	// Name The name of the signal, matched against the event key of the run's waiting conditions.
+	Name string `json:"name"`
+
+	// Payload The payload of the signal.
+	Payload *map[string]interface{} `json:"payload,omitempty"`
+
+	// RunExternalId The external id of the workflow run or task to signal.
+	RunExternalId openapi_types.UUID `json:"runExternalId"`
+
+	// SignalId A unique id for the signal. Generated if not set.
+	SignalId *openapi_types.UUID `json:"signalId,omitempty"`
+}
+
+// V1SignalRunResponse defines model for V1SignalRunResponse.
+type V1SignalRunResponse struct {
+	// CreatedTasks The external ids of tasks which were created by the signal.
+	CreatedTasks []openapi_types.UUID `json:"createdTasks"`
+
+	// MatchedConditions The number of waiting conditions whose expressions matched the payload.
+	MatchedConditions int32 `json:"matchedConditions"`
+
+	// ResumedTasks The external ids of durable tasks which were resumed by the signal.
+	ResumedTasks []openapi_types.UUID `json:"resumedTasks"`
+
+	// SignalId The id of the signal.
+	SignalId openapi_types.UUID `json:"signalId"`
+
+	// WaitingConditions The number of conditions for the signal which the run was waiting on.
+	WaitingConditions int32 `json:"waitingConditions"`
+}
+
+// V1SlotUsage defines model for V1SlotUsage.
+type V1SlotUsage struct {
+	// Key The workflow name, action id, worker label value or additional metadata value of the group. Usage without a value for the grouping key is returned under an empty key.
+	Key string `json:"key"`
+
+	// SlotSeconds The slot units held multiplied by the seconds they were held for.
+	SlotSeconds float64 `json:"slotSeconds"`
+
+	// SlotType The slot type the usage was consumed on.
+	SlotType string `json:"slotType"`
+
+	// TaskAttempts The number of task attempts which consumed the slot-seconds.
+	TaskAttempts int64 `json:"taskAttempts"`
+}
+
+// V1SlotUsageGroupBy defines model for V1SlotUsageGroupBy.
+type V1SlotUsageGroupBy string
+
+// V1SlotUsageList defines model for V1SlotUsageList.
+type V1SlotUsageList struct {
+	GroupBy V1SlotUsageGroupBy `json:"groupBy"`
+	Rows    []V1SlotUsage      `json:"rows"`
+	Since   time.Time          `json:"since"`
+	Until   time.Time          `json:"until"`
+}
+
 // V1TaskEvent defines model for V1TaskEvent.
 type V1TaskEvent struct {
 	// Attempt The attempt number of the task.
@@ -2189,21 +2558,100 @@ type V1TaskTimingList struct {
 	Rows []V1TaskTiming `json:"rows"`
 }
 
+// V1TenantBundleConflictStrategy What happens to resources in the bundle which already exist in the tenant with a different configuration. SKIP keeps them, OVERWRITE replaces them, and FAIL aborts the import before anything is imported.
+type V1TenantBundleConflictStrategy string
+
+// V1TenantBundleExport defines model for V1TenantBundleExport.
+type V1TenantBundleExport struct {
+	// Bundle The bundle, which can be imported into another tenant.
+	Bundle map[string]interface{} `json:"bundle"`
+
+	// Warnings The parts of the configuration which couldn't be exported.
+	Warnings []string `json:"warnings"`
+}
+
+// V1TenantBundleExportRequest defines model for V1TenantBundleExportRequest.
+type V1TenantBundleExportRequest struct {
+	// Passphrase The passphrase to encrypt the secrets of webhooks and operators with. If not set, the bundle holds no secrets.
+	Passphrase *string `json:"passphrase,omitempty"`
+}
+
+// V1TenantBundleImportAction What the import does with a resource in the bundle.
+type V1TenantBundleImportAction string
+
+// V1TenantBundleImportItem defines model for V1TenantBundleImportItem.
+type V1TenantBundleImportItem struct {
+	// Action What the import does with a resource in the bundle.
+	Action V1TenantBundleImportAction `json:"action"`
+
+	// Name The name of the resource.
+	Name string `json:"name"`
+
+	// Reason Why the resource is skipped.
+	Reason *string `json:"reason,omitempty"`
+
+	// Resource The kind of resource, for example workflow, cron or webhook.
+	Resource string `json:"resource"`
+}
+
+// V1TenantBundleImportRequest defines model for V1TenantBundleImportRequest.
+type V1TenantBundleImportRequest struct {
+	// Bundle The bundle to import, as returned by the export.
+	Bundle map[string]interface{} `json:"bundle"`
+
+	// ConflictStrategy What happens to resources in the bundle which already exist in the tenant with a different configuration. SKIP keeps them, OVERWRITE replaces them, and FAIL aborts the import before anything is imported.
+	ConflictStrategy *V1TenantBundleConflictStrategy `json:"conflictStrategy,omitempty"`
+
+	// DryRun Whether to only return what would be imported, without importing it.
+	DryRun *bool `json:"dryRun,omitempty"`
+
+	// Passphrase The passphrase the secrets in the bundle are encrypted with. Required if the bundle holds secrets.
+	Passphrase *string `json:"passphrase,omitempty"`
+}
+
+// V1TenantBundleImportResult defines model for V1TenantBundleImportResult.
+type V1TenantBundleImportResult struct {
+	// Applied Whether the bundle was imported.
+	Applied bool `json:"applied"`
+
+	// DryRun Whether the import was a dry run.
+	DryRun bool                       `json:"dryRun"`
+	Items  []V1TenantBundleImportItem `json:"items"`
+}
+
 // V1TriggerWorkflowRunRequest defines model for V1TriggerWorkflowRunRequest.
 type V1TriggerWorkflowRunRequest struct {
 	AdditionalMetadata *map[string]interface{} `json:"additionalMetadata,omitempty"`
 	Input              map[string]interface{}  `json:"input"`
 
+	// ParentOutputs The outputs of the target task's parents, keyed by task name, which the task sees instead of running them. Requires targetActionId.
+	ParentOutputs *map[string]map[string]interface{} `json:"parentOutputs,omitempty"`
+
 	// Priority The priority of the workflow run.
 	Priority *int `json:"priority,omitempty"`
 
 	// ReturnOnlyId A boolean flag indicating whether to only return the id of the created run.
 	ReturnOnlyId *bool `json:"return_only_id,omitempty"`
 
+	// TargetActionId Only run the task of the workflow with this action id, e.g. "my-workflow:step2".
+	TargetActionId *string `json:"targetActionId,omitempty"`
+
 	// WorkflowName The name of the workflow.
 	WorkflowName string `json:"workflowName"`
 }
 
+// V1UncordonWorkersRequest defines model for V1UncordonWorkersRequest.
+type V1UncordonWorkersRequest struct {
+	// Selector Selects workers by id and labels. A worker is selected if it has one of the worker ids, when any are set, and every label. At least one worker id or label must be set.
+	Selector V1WorkerSelector `json:"selector"`
+}
+
+// V1UncordonWorkersResponse defines model for V1UncordonWorkersResponse.
+type V1UncordonWorkersResponse struct {
+	// WorkerIds The ids of the workers which were uncordoned.
+	WorkerIds []openapi_types.UUID `json:"workerIds"`
+}
+
 // V1UpdateFilterRequest defines model for V1UpdateFilterRequest.
 type V1UpdateFilterRequest struct {
 	// Expression The expression for the filter
@@ -2340,6 +2788,51 @@ type V1WebhookResponse struct {
 // V1WebhookSourceName defines model for V1WebhookSourceName.
 type V1WebhookSourceName string
 
+// V1WorkerCordon defines model for V1WorkerCordon.
+type V1WorkerCordon struct {
+	CordonedAt time.Time `json:"cordonedAt"`
+
+	// DrainAction What happens to the non-durable tasks still running on a draining worker at its deadline. Durable tasks are always evicted and resume on another worker.
+	DrainAction *V1WorkerDrainAction `json:"drainAction,omitempty"`
+
+	// DrainDeadline The time after which the tasks still running on the worker are moved off it.
+	DrainDeadline *time.Time `json:"drainDeadline,omitempty"`
+
+	// DrainedAt The time the worker was found to have no running tasks left.
+	DrainedAt *time.Time `json:"drainedAt,omitempty"`
+
+	// Reason The reason the worker was cordoned.
+	Reason *string `json:"reason,omitempty"`
+
+	// RunningTasks The number of tasks still running on the worker.
+	RunningTasks int32 `json:"runningTasks"`
+
+	// Status Whether the worker is only cordoned, is draining, or has been drained.
+	Status     V1WorkerCordonStatus `json:"status"`
+	WorkerId   openapi_types.UUID   `json:"workerId"`
+	WorkerName string               `json:"workerName"`
+}
+
+// V1WorkerCordonList defines model for V1WorkerCordonList.
+type V1WorkerCordonList struct {
+	Rows []V1WorkerCordon `json:"rows"`
+}
+
+// V1WorkerCordonStatus Whether the worker is only cordoned, is draining, or has been drained.
+type V1WorkerCordonStatus string
+
+// V1WorkerDrainAction What happens to the non-durable tasks still running on a draining worker at its deadline. Durable tasks are always evicted and resume on another worker.
+type V1WorkerDrainAction string
+
+// V1WorkerSelector Selects workers by id and labels. A worker is selected if it has one of the worker ids, when any are set, and every label. At least one worker id or label must be set.
+type V1WorkerSelector struct {
+	// Labels The labels a worker must have to be selected. Integer labels match their decimal representation.
+	Labels *map[string]string `json:"labels,omitempty"`
+
+	// WorkerIds The ids of the workers to select.
+	WorkerIds *[]openapi_types.UUID `json:"workerIds,omitempty"`
+}
+
 // V1WorkflowRun defines model for V1WorkflowRun.
 type V1WorkflowRun struct {
 	// AdditionalMetadata Additional metadata for the task run.
@@ -2411,6 +2904,39 @@ type V1WorkflowRunDisplayNameList struct {
 // V1WorkflowRunExternalIdList The list of external IDs
 type V1WorkflowRunExternalIdList = []openapi_types.UUID
 
+// V1WorkflowRunSearchRequest defines model for V1WorkflowRunSearchRequest.
+type V1WorkflowRunSearchRequest struct {
+	// IncludePayloads Whether to include the input and output payloads of the runs in the response.
+	IncludePayloads *bool `json:"includePayloads,omitempty"`
+
+	// Limit The maximum number of runs to return. Defaults to 50, at most 500.
+	Limit *int64 `json:"limit,omitempty"`
+
+	// Since The start of the time window to search, on the run's creation time.
+	Since time.Time `json:"since"`
+
+	// Statuses The statuses to filter by.
+	Statuses *[]V1TaskStatus `json:"statuses,omitempty"`
+
+	// Until The end of the time window to search. Defaults to now. The window can be at most 7 days.
+	Until *time.Time `json:"until,omitempty"`
+
+	// Where A CEL expression over the run's input, output, error and additional_metadata, for example `input.customer.email == "a@b.com" && error.contains("timeout")`.
+	Where string `json:"where"`
+
+	// WorkflowIds The workflow ids to filter by.
+	WorkflowIds *[]openapi_types.UUID `json:"workflowIds,omitempty"`
+}
+
+// V1WorkflowSpec defines model for V1WorkflowSpec.
+type V1WorkflowSpec struct {
+	// Spec The workflow spec, in the format read by `hatchet workflows apply`.
+	Spec map[string]interface{} `json:"spec"`
+
+	// WorkflowVersionId The id of the workflow version the spec was built from.
+	WorkflowVersionId openapi_types.UUID `json:"workflowVersionId"`
+}
+
 // V1WorkflowType defines model for V1WorkflowType.
 type V1WorkflowType string
 
@@ -2521,6 +3047,9 @@ type Worker struct {
 
 // WorkerLabel defines model for WorkerLabel.
 type WorkerLabel struct {
+	// Dynamic Whether the label is reported on the worker's heartbeats, in which case its value changes while the worker runs.
+	Dynamic *bool `json:"dynamic,omitempty"`
+
 	// Key The key of the label.
 	Key      string          `json:"key"`
 	Metadata APIResourceMeta `json:"metadata"`
@@ -2851,6 +3380,18 @@ type V1TaskEventListParams struct {
 	Limit *int64 `form:"limit,omitempty" json:"limit,omitempty"`
 }
 
+// V1ApprovalListParams defines parameters for V1ApprovalList.
+type V1ApprovalListParams struct {
+	// Statuses The statuses to filter by
+	Statuses *[]V1ApprovalStatus `form:"statuses,omitempty" json:"statuses,omitempty"`
+
+	// Offset The number to skip
+	Offset *int64 `form:"offset,omitempty" json:"offset,omitempty"`
+
+	// Limit The number to limit by
+	Limit *int64 `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
 // V1DurableTaskEventLogListParams defines parameters for V1DurableTaskEventLogList.
 type V1DurableTaskEventLogListParams struct {
 	// Offset The number of event log entries to skip
@@ -2860,6 +3401,18 @@ type V1DurableTaskEventLogListParams struct {
 	Limit *int64 `form:"limit,omitempty" json:"limit,omitempty"`
 }
 
+// V1EventSchemaDeleteParams defines parameters for V1EventSchemaDelete.
+type V1EventSchemaDeleteParams struct {
+	// EventKey The event key or pattern the schema is registered for
+	EventKey string `form:"eventKey" json:"eventKey"`
+}
+
+// V1EventSchemaListParams defines parameters for V1EventSchemaList.
+type V1EventSchemaListParams struct {
+	// EventKey The event key or pattern to list every version of the schema for
+	EventKey *string `form:"eventKey,omitempty" json:"eventKey,omitempty"`
+}
+
 // V1EventListParams defines parameters for V1EventList.
 type V1EventListParams struct {
 	// Offset The number to skip
@@ -2963,6 +3516,45 @@ type V1TenantLogLineListParams struct {
 
 	// StepIds The step id(s) to filter for
 	StepIds *[]openapi_types.UUID `form:"step_ids,omitempty" json:"step_ids,omitempty"`
+
+	// WorkerIds The worker id(s) to filter for
+	WorkerIds *[]openapi_types.UUID `form:"worker_ids,omitempty" json:"worker_ids,omitempty"`
+
+	// Query A full-text query to match log messages against. Supports quoted phrases, OR, and excluding words with a leading -
+	Query *string `form:"query,omitempty" json:"query,omitempty"`
+
+	// Metadata Metadata key:value pairs which log lines must all have. Nested fields are addressed with dot-separated keys, and values are compared as text
+	Metadata *[]string `form:"metadata,omitempty" json:"metadata,omitempty"`
+}
+
+// V1TenantLogLineTailParams defines parameters for V1TenantLogLineTail.
+type V1TenantLogLineTailParams struct {
+	// After The id of the last log line received. Only lines written after it are streamed, and if it isn't set, only lines written after the request
+	After *int64 `form:"after,omitempty" json:"after,omitempty"`
+
+	// Search A search query to filter for
+	Search *string `form:"search,omitempty" json:"search,omitempty"`
+
+	// Levels The log level(s) to include
+	Levels *[]V1LogLineLevel `form:"levels,omitempty" json:"levels,omitempty"`
+
+	// TaskExternalIds The task external ID(s) to filter by
+	TaskExternalIds *[]openapi_types.UUID `form:"taskExternalIds,omitempty" json:"taskExternalIds,omitempty"`
+
+	// WorkflowIds The workflow id(s) to filter for
+	WorkflowIds *[]openapi_types.UUID `form:"workflow_ids,omitempty" json:"workflow_ids,omitempty"`
+
+	// StepIds The step id(s) to filter for
+	StepIds *[]openapi_types.UUID `form:"step_ids,omitempty" json:"step_ids,omitempty"`
+
+	// WorkerIds The worker id(s) to filter for
+	WorkerIds *[]openapi_types.UUID `form:"worker_ids,omitempty" json:"worker_ids,omitempty"`
+
+	// Query A full-text query to match log messages against. Supports quoted phrases, OR, and excluding words with a leading -
+	Query *string `form:"query,omitempty" json:"query,omitempty"`
+
+	// Metadata Metadata key:value pairs which log lines must all have. Nested fields are addressed with dot-separated keys, and values are compared as text
+	Metadata *[]string `form:"metadata,omitempty" json:"metadata,omitempty"`
 }
 
 // V1HttpOperatorListParams defines parameters for V1HttpOperatorList.
@@ -2974,6 +3566,30 @@ type V1HttpOperatorListParams struct {
 	Limit *int64 `form:"limit,omitempty" json:"limit,omitempty"`
 }
 
+// V1TaskGetSlotUsageParams defines parameters for V1TaskGetSlotUsage.
+type V1TaskGetSlotUsageParams struct {
+	// Since The earliest time slots were released to count usage for
+	Since time.Time `form:"since" json:"since"`
+
+	// Until The latest time slots were released to count usage for, defaults to now
+	Until *time.Time `form:"until,omitempty" json:"until,omitempty"`
+
+	// GroupBy The dimension to group slot usage by
+	GroupBy V1SlotUsageGroupBy `form:"group_by" json:"group_by"`
+
+	// Key The worker label or additional metadata key to group by, required when grouping by worker_label or metadata
+	Key *string `form:"key,omitempty" json:"key,omitempty"`
+
+	// WorkflowIds The workflow ids to count slot usage for
+	WorkflowIds *[]openapi_types.UUID `form:"workflow_ids,omitempty" json:"workflow_ids,omitempty"`
+
+	// SlotType The slot type to count slot usage for
+	SlotType *string `form:"slot_type,omitempty" json:"slot_type,omitempty"`
+
+	// Limit The maximum number of groups to return
+	Limit *int64 `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
 // V1TaskListStatusMetricsParams defines parameters for V1TaskListStatusMetrics.
 type V1TaskListStatusMetricsParams struct {
 	// Since The start time to get metrics for
@@ -3031,6 +3647,12 @@ type V1WebhookListParams struct {
 	WebhookNames *[]string `form:"webhookNames,omitempty" json:"webhookNames,omitempty"`
 }
 
+// V1WorkerCordonListParams defines parameters for V1WorkerCordonList.
+type V1WorkerCordonListParams struct {
+	// WorkerIds The worker ids to list the cordons of
+	WorkerIds *[]openapi_types.UUID `form:"workerIds,omitempty" json:"workerIds,omitempty"`
+}
+
 // V1WorkflowRunListParams defines parameters for V1WorkflowRunList.
 type V1WorkflowRunListParams struct {
 	// Offset The number to skip
@@ -3121,6 +3743,12 @@ type V1WorkflowRunGetTimingsParams struct {
 	Depth *int64 `form:"depth,omitempty" json:"depth,omitempty"`
 }
 
+// V1WorkflowSpecGetParams defines parameters for V1WorkflowSpecGet.
+type V1WorkflowSpecGetParams struct {
+	// Version The workflow version. If not supplied, the latest version is fetched.
+	Version *openapi_types.UUID `form:"version,omitempty" json:"version,omitempty"`
+}
+
 // StepRunListArchivesParams defines parameters for StepRunListArchives.
 type StepRunListArchivesParams struct {
 	// Offset The number to skip
@@ -3409,12 +4037,27 @@ type AlertEmailGroupUpdateJSONRequestBody = UpdateTenantAlertEmailGroupRequest
 // V1HttpOperatorUpdateJSONRequestBody defines body for V1HttpOperatorUpdate for application/json ContentType.
 type V1HttpOperatorUpdateJSONRequestBody = V1UpdateHTTPOperatorRequest
 
+// V1ApprovalResolveJSONRequestBody defines body for V1ApprovalResolve for application/json ContentType.
+type V1ApprovalResolveJSONRequestBody = V1ResolveApprovalRequest
+
+// V1BulkJobCreateJSONRequestBody defines body for V1BulkJobCreate for application/json ContentType.
+type V1BulkJobCreateJSONRequestBody = V1CreateBulkJobRequest
+
+// V1TenantBundleExportJSONRequestBody defines body for V1TenantBundleExport for application/json ContentType.
+type V1TenantBundleExportJSONRequestBody = V1TenantBundleExportRequest
+
+// V1TenantBundleImportJSONRequestBody defines body for V1TenantBundleImport for application/json ContentType.
+type V1TenantBundleImportJSONRequestBody = V1TenantBundleImportRequest
+
 // V1CelDebugJSONRequestBody defines body for V1CelDebug for application/json ContentType.
 type V1CelDebugJSONRequestBody = V1CELDebugRequest
 
 // V1DurableTaskBranchJSONRequestBody defines body for V1DurableTaskBranch for application/json ContentType.
 type V1DurableTaskBranchJSONRequestBody = V1BranchDurableTaskRequest
 
+// V1EventSchemaCreateJSONRequestBody defines body for V1EventSchemaCreate for application/json ContentType.
+type V1EventSchemaCreateJSONRequestBody = V1CreateEventSchemaRequest
+
 // V1FilterCreateJSONRequestBody defines body for V1FilterCreate for application/json ContentType.
 type V1FilterCreateJSONRequestBody = V1CreateFilterRequest
 
@@ -3436,8 +4079,23 @@ type V1WebhookCreateJSONRequestBody = V1CreateWebhookRequest
 // V1WebhookUpdateJSONRequestBody defines body for V1WebhookUpdate for application/json ContentType.
 type V1WebhookUpdateJSONRequestBody = V1UpdateWebhookRequest
 
-// V1WorkflowRunCreateJSONRequestBody defines body for V1WorkflowRunCreate for application/json ContentType.
-type V1WorkflowRunCreateJSONRequestBody = V1TriggerWorkflowRunRequest
+// V1WorkerCordonJSONRequestBody defines body for V1WorkerCordon for application/json ContentType.
+type V1WorkerCordonJSONRequestBody = V1CordonWorkersRequest
+
+// V1WorkerDrainJSONRequestBody defines body for V1WorkerDrain for application/json ContentType.
+type V1WorkerDrainJSONRequestBody = V1DrainWorkersRequest
+
+// V1WorkerUncordonJSONRequestBody defines body for V1WorkerUncordon for application/json ContentType.
+type V1WorkerUncordonJSONRequestBody = V1UncordonWorkersRequest
+
+// V1WorkflowRunSearchJSONRequestBody defines body for V1WorkflowRunSearch for application/json ContentType.
+type V1WorkflowRunSearchJSONRequestBody = V1WorkflowRunSearchRequest
+
+// V1WorkflowRunSignalJSONRequestBody defines body for V1WorkflowRunSignal for application/json ContentType.
+type V1WorkflowRunSignalJSONRequestBody = V1SignalRunRequest
+
+// V1WorkflowRunCreateJSONRequestBody defines body for V1WorkflowRunCreate for application/json ContentType.
+type V1WorkflowRunCreateJSONRequestBody = V1TriggerWorkflowRunRequest
 
 // TenantCreateJSONRequestBody defines body for TenantCreate for application/json ContentType.
 type TenantCreateJSONRequestBody = CreateTenantRequest
@@ -3747,15 +4405,54 @@ type ServerInterface interface {
 	// List events for a task
 	// (GET /api/v1/stable/tasks/{task}/task-events)
 	V1TaskEventList(ctx echo.Context, task openapi_types.UUID, params V1TaskEventListParams) error
+	// List approvals
+	// (GET /api/v1/stable/tenants/{tenant}/approvals)
+	V1ApprovalList(ctx echo.Context, tenant openapi_types.UUID, params V1ApprovalListParams) error
+	// Resolve an approval
+	// (POST /api/v1/stable/tenants/{tenant}/approvals/{task}/resolve)
+	V1ApprovalResolve(ctx echo.Context, tenant openapi_types.UUID, task openapi_types.UUID) error
+	// List bulk jobs
+	// (GET /api/v1/stable/tenants/{tenant}/bulk-jobs)
+	V1BulkJobList(ctx echo.Context, tenant openapi_types.UUID) error
+	// Create a bulk job
+	// (POST /api/v1/stable/tenants/{tenant}/bulk-jobs)
+	V1BulkJobCreate(ctx echo.Context, tenant openapi_types.UUID) error
+	// Get a bulk job
+	// (GET /api/v1/stable/tenants/{tenant}/bulk-jobs/{v1-bulk-job})
+	V1BulkJobGet(ctx echo.Context, tenant openapi_types.UUID, v1BulkJob openapi_types.UUID) error
+	// Pause a bulk job
+	// (POST /api/v1/stable/tenants/{tenant}/bulk-jobs/{v1-bulk-job}/pause)
+	V1BulkJobPause(ctx echo.Context, tenant openapi_types.UUID, v1BulkJob openapi_types.UUID) error
+	// Resume a bulk job
+	// (POST /api/v1/stable/tenants/{tenant}/bulk-jobs/{v1-bulk-job}/resume)
+	V1BulkJobResume(ctx echo.Context, tenant openapi_types.UUID, v1BulkJob openapi_types.UUID) error
+	// Export tenant
+	// (POST /api/v1/stable/tenants/{tenant}/bundle/export)
+	V1TenantBundleExport(ctx echo.Context, tenant openapi_types.UUID) error
+	// Import tenant
+	// (POST /api/v1/stable/tenants/{tenant}/bundle/import)
+	V1TenantBundleImport(ctx echo.Context, tenant openapi_types.UUID) error
 	// Debug a CEL expression
 	// (POST /api/v1/stable/tenants/{tenant}/cel/debug)
 	V1CelDebug(ctx echo.Context, tenant openapi_types.UUID) error
+	// List circuit breakers
+	// (GET /api/v1/stable/tenants/{tenant}/circuit-breakers)
+	V1CircuitBreakerList(ctx echo.Context, tenant openapi_types.UUID) error
 	// Branch durable task
 	// (POST /api/v1/stable/tenants/{tenant}/durable-tasks/branch)
 	V1DurableTaskBranch(ctx echo.Context, tenant openapi_types.UUID) error
 	// List durable event log
 	// (GET /api/v1/stable/tenants/{tenant}/durable-tasks/{durable-task})
 	V1DurableTaskEventLogList(ctx echo.Context, tenant openapi_types.UUID, durableTask openapi_types.UUID, params V1DurableTaskEventLogListParams) error
+	// Delete an event schema
+	// (DELETE /api/v1/stable/tenants/{tenant}/event-schemas)
+	V1EventSchemaDelete(ctx echo.Context, tenant openapi_types.UUID, params V1EventSchemaDeleteParams) error
+	// List event schemas
+	// (GET /api/v1/stable/tenants/{tenant}/event-schemas)
+	V1EventSchemaList(ctx echo.Context, tenant openapi_types.UUID, params V1EventSchemaListParams) error
+	// Register an event schema
+	// (POST /api/v1/stable/tenants/{tenant}/event-schemas)
+	V1EventSchemaCreate(ctx echo.Context, tenant openapi_types.UUID) error
 	// List events
 	// (GET /api/v1/stable/tenants/{tenant}/events)
 	V1EventList(ctx echo.Context, tenant openapi_types.UUID, params V1EventListParams) error
@@ -3786,12 +4483,18 @@ type ServerInterface interface {
 	// List log lines
 	// (GET /api/v1/stable/tenants/{tenant}/logs)
 	V1TenantLogLineList(ctx echo.Context, tenant openapi_types.UUID, params V1TenantLogLineListParams) error
+	// Tail log lines
+	// (GET /api/v1/stable/tenants/{tenant}/logs/tail)
+	V1TenantLogLineTail(ctx echo.Context, tenant openapi_types.UUID, params V1TenantLogLineTailParams) error
 	// List HTTP operators
 	// (GET /api/v1/stable/tenants/{tenant}/operators/http)
 	V1HttpOperatorList(ctx echo.Context, tenant openapi_types.UUID, params V1HttpOperatorListParams) error
 	// Create an HTTP operator
 	// (POST /api/v1/stable/tenants/{tenant}/operators/http)
 	V1HttpOperatorCreate(ctx echo.Context, tenant openapi_types.UUID) error
+	// Get slot usage
+	// (GET /api/v1/stable/tenants/{tenant}/slot-usage)
+	V1TaskGetSlotUsage(ctx echo.Context, tenant openapi_types.UUID, params V1TaskGetSlotUsageParams) error
 	// Get task metrics
 	// (GET /api/v1/stable/tenants/{tenant}/task-metrics)
 	V1TaskListStatusMetrics(ctx echo.Context, tenant openapi_types.UUID, params V1TaskListStatusMetricsParams) error
@@ -3825,6 +4528,18 @@ type ServerInterface interface {
 	// Post a webhook message
 	// (POST /api/v1/stable/tenants/{tenant}/webhooks/{v1-webhook})
 	V1WebhookReceive(ctx echo.Context, tenant openapi_types.UUID, v1Webhook string) error
+	// Cordon workers
+	// (POST /api/v1/stable/tenants/{tenant}/workers/cordon)
+	V1WorkerCordon(ctx echo.Context, tenant openapi_types.UUID) error
+	// List worker cordons
+	// (GET /api/v1/stable/tenants/{tenant}/workers/cordons)
+	V1WorkerCordonList(ctx echo.Context, tenant openapi_types.UUID, params V1WorkerCordonListParams) error
+	// Drain workers
+	// (POST /api/v1/stable/tenants/{tenant}/workers/drain)
+	V1WorkerDrain(ctx echo.Context, tenant openapi_types.UUID) error
+	// Uncordon workers
+	// (POST /api/v1/stable/tenants/{tenant}/workers/uncordon)
+	V1WorkerUncordon(ctx echo.Context, tenant openapi_types.UUID) error
 	// List workflow runs
 	// (GET /api/v1/stable/tenants/{tenant}/workflow-runs)
 	V1WorkflowRunList(ctx echo.Context, tenant openapi_types.UUID, params V1WorkflowRunListParams) error
@@ -3834,6 +4549,12 @@ type ServerInterface interface {
 	// List workflow run external ids
 	// (GET /api/v1/stable/tenants/{tenant}/workflow-runs/external-ids)
 	V1WorkflowRunExternalIdsList(ctx echo.Context, tenant openapi_types.UUID, params V1WorkflowRunExternalIdsListParams) error
+	// Search workflow runs
+	// (POST /api/v1/stable/tenants/{tenant}/workflow-runs/search)
+	V1WorkflowRunSearch(ctx echo.Context, tenant openapi_types.UUID) error
+	// Signal workflow run
+	// (POST /api/v1/stable/tenants/{tenant}/workflow-runs/signal)
+	V1WorkflowRunSignal(ctx echo.Context, tenant openapi_types.UUID) error
 	// Create workflow run
 	// (POST /api/v1/stable/tenants/{tenant}/workflow-runs/trigger)
 	V1WorkflowRunCreate(ctx echo.Context, tenant openapi_types.UUID) error
@@ -3849,6 +4570,9 @@ type ServerInterface interface {
 	// List timings for a workflow run
 	// (GET /api/v1/stable/workflow-runs/{v1-workflow-run}/task-timings)
 	V1WorkflowRunGetTimings(ctx echo.Context, v1WorkflowRun openapi_types.UUID, params V1WorkflowRunGetTimingsParams) error
+	// Get workflow spec
+	// (GET /api/v1/stable/workflows/{workflow}/spec)
+	V1WorkflowSpecGet(ctx echo.Context, workflow openapi_types.UUID, params V1WorkflowSpecGetParams) error
 	// List archives for step run
 	// (GET /api/v1/step-runs/{step-run}/archives)
 	StepRunListArchives(ctx echo.Context, stepRun openapi_types.UUID, params StepRunListArchivesParams) error
@@ -4610,13 +5334,13 @@ func (w *ServerInterfaceWrapper) V1TaskEventList(ctx echo.Context) error {
 	return err
 }
 
-// V1CelDebug converts echo context to params.
-func (w *ServerInterfaceWrapper) V1CelDebug(ctx echo.Context) error {
+// V1ApprovalList converts echo context to params.
+func (w *ServerInterfaceWrapper) V1ApprovalList(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "tenant" -------------
 	var tenant openapi_types.UUID
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
+	err = runtime.BindStyledParameterWithOptions("simple", "tenant", ctx.Param("tenant"), &tenant, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
 	}
@@ -4625,169 +5349,228 @@ func (w *ServerInterfaceWrapper) V1CelDebug(ctx echo.Context) error {
 
 	ctx.Set(CookieAuthScopes, []string{})
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params V1ApprovalListParams
+	// ------------- Optional query parameter "statuses" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "statuses", ctx.QueryParams(), &params.Statuses)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter statuses: %s", err))
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.V1CelDebug(ctx, tenant)
+	err = w.Handler.V1ApprovalList(ctx, tenant, params)
 	return err
 }
 
-// V1DurableTaskBranch converts echo context to params.
-func (w *ServerInterfaceWrapper) V1DurableTaskBranch(ctx echo.Context) error {
+// V1ApprovalResolve converts echo context to params.
+func (w *ServerInterfaceWrapper) V1ApprovalResolve(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "tenant" -------------
 	var tenant openapi_types.UUID
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
+	err = runtime.BindStyledParameterWithOptions("simple", "tenant", ctx.Param("tenant"), &tenant, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
 	}
 
+	// ------------- Path parameter "task" -------------
+	var task openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "task", ctx.Param("task"), &task, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter task: %s", err))
+	}
+
 	ctx.Set(BearerAuthScopes, []string{})
 
 	ctx.Set(CookieAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.V1DurableTaskBranch(ctx, tenant)
+	err = w.Handler.V1ApprovalResolve(ctx, tenant, task)
 	return err
 }
 
-// V1DurableTaskEventLogList converts echo context to params.
-func (w *ServerInterfaceWrapper) V1DurableTaskEventLogList(ctx echo.Context) error {
+// V1BulkJobList converts echo context to params.
+func (w *ServerInterfaceWrapper) V1BulkJobList(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "tenant" -------------
 	var tenant openapi_types.UUID
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
+	err = runtime.BindStyledParameterWithOptions("simple", "tenant", ctx.Param("tenant"), &tenant, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
 	}
 
-	// ------------- Path parameter "durable-task" -------------
-	var durableTask openapi_types.UUID
-
-	err = runtime.BindStyledParameterWithLocation("simple", false, "durable-task", runtime.ParamLocationPath, ctx.Param("durable-task"), &durableTask)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter durable-task: %s", err))
-	}
-
 	ctx.Set(BearerAuthScopes, []string{})
 
 	ctx.Set(CookieAuthScopes, []string{})
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params V1DurableTaskEventLogListParams
-	// ------------- Optional query parameter "offset" -------------
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.V1BulkJobList(ctx, tenant)
+	return err
+}
 
-	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
+// V1BulkJobCreate converts echo context to params.
+func (w *ServerInterfaceWrapper) V1BulkJobCreate(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "tenant" -------------
+	var tenant openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "tenant", ctx.Param("tenant"), &tenant, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
 	}
 
-	// ------------- Optional query parameter "limit" -------------
+	ctx.Set(BearerAuthScopes, []string{})
 
-	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
-	}
+	ctx.Set(CookieAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.V1DurableTaskEventLogList(ctx, tenant, durableTask, params)
+	err = w.Handler.V1BulkJobCreate(ctx, tenant)
 	return err
 }
 
-// V1EventList converts echo context to params.
-func (w *ServerInterfaceWrapper) V1EventList(ctx echo.Context) error {
+// V1BulkJobGet converts echo context to params.
+func (w *ServerInterfaceWrapper) V1BulkJobGet(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "tenant" -------------
 	var tenant openapi_types.UUID
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
+	err = runtime.BindStyledParameterWithOptions("simple", "tenant", ctx.Param("tenant"), &tenant, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
 	}
 
+	// ------------- Path parameter "v1-bulk-job" -------------
+	var v1BulkJob openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "v1-bulk-job", ctx.Param("v1-bulk-job"), &v1BulkJob, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter v1-bulk-job: %s", err))
+	}
+
 	ctx.Set(BearerAuthScopes, []string{})
 
 	ctx.Set(CookieAuthScopes, []string{})
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params V1EventListParams
-	// ------------- Optional query parameter "offset" -------------
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.V1BulkJobGet(ctx, tenant, v1BulkJob)
+	return err
+}
 
-	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
+// V1BulkJobPause converts echo context to params.
+func (w *ServerInterfaceWrapper) V1BulkJobPause(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "tenant" -------------
+	var tenant openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "tenant", ctx.Param("tenant"), &tenant, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
 	}
 
-	// ------------- Optional query parameter "limit" -------------
+	// ------------- Path parameter "v1-bulk-job" -------------
+	var v1BulkJob openapi_types.UUID
 
-	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	err = runtime.BindStyledParameterWithOptions("simple", "v1-bulk-job", ctx.Param("v1-bulk-job"), &v1BulkJob, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter v1-bulk-job: %s", err))
 	}
 
-	// ------------- Optional query parameter "keys" -------------
+	ctx.Set(BearerAuthScopes, []string{})
 
-	err = runtime.BindQueryParameter("form", true, false, "keys", ctx.QueryParams(), &params.Keys)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter keys: %s", err))
-	}
+	ctx.Set(CookieAuthScopes, []string{})
 
-	// ------------- Optional query parameter "since" -------------
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.V1BulkJobPause(ctx, tenant, v1BulkJob)
+	return err
+}
 
-	err = runtime.BindQueryParameter("form", true, false, "since", ctx.QueryParams(), &params.Since)
+// V1BulkJobResume converts echo context to params.
+func (w *ServerInterfaceWrapper) V1BulkJobResume(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "tenant" -------------
+	var tenant openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "tenant", ctx.Param("tenant"), &tenant, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter since: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
 	}
 
-	// ------------- Optional query parameter "until" -------------
+	// ------------- Path parameter "v1-bulk-job" -------------
+	var v1BulkJob openapi_types.UUID
 
-	err = runtime.BindQueryParameter("form", true, false, "until", ctx.QueryParams(), &params.Until)
+	err = runtime.BindStyledParameterWithOptions("simple", "v1-bulk-job", ctx.Param("v1-bulk-job"), &v1BulkJob, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter until: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter v1-bulk-job: %s", err))
 	}
 
-	// ------------- Optional query parameter "workflowIds" -------------
+	ctx.Set(BearerAuthScopes, []string{})
 
-	err = runtime.BindQueryParameter("form", true, false, "workflowIds", ctx.QueryParams(), &params.WorkflowIds)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter workflowIds: %s", err))
-	}
+	ctx.Set(CookieAuthScopes, []string{})
 
-	// ------------- Optional query parameter "workflowRunStatuses" -------------
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.V1BulkJobResume(ctx, tenant, v1BulkJob)
+	return err
+}
 
-	err = runtime.BindQueryParameter("form", true, false, "workflowRunStatuses", ctx.QueryParams(), &params.WorkflowRunStatuses)
+// V1TenantBundleExport converts echo context to params.
+func (w *ServerInterfaceWrapper) V1TenantBundleExport(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "tenant" -------------
+	var tenant openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "tenant", ctx.Param("tenant"), &tenant, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter workflowRunStatuses: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
 	}
 
-	// ------------- Optional query parameter "eventIds" -------------
+	ctx.Set(BearerAuthScopes, []string{})
 
-	err = runtime.BindQueryParameter("form", true, false, "eventIds", ctx.QueryParams(), &params.EventIds)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter eventIds: %s", err))
-	}
+	ctx.Set(CookieAuthScopes, []string{})
 
-	// ------------- Optional query parameter "additionalMetadata" -------------
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.V1TenantBundleExport(ctx, tenant)
+	return err
+}
 
-	err = runtime.BindQueryParameter("form", true, false, "additionalMetadata", ctx.QueryParams(), &params.AdditionalMetadata)
+// V1TenantBundleImport converts echo context to params.
+func (w *ServerInterfaceWrapper) V1TenantBundleImport(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "tenant" -------------
+	var tenant openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "tenant", ctx.Param("tenant"), &tenant, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter additionalMetadata: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
 	}
 
-	// ------------- Optional query parameter "scopes" -------------
+	ctx.Set(BearerAuthScopes, []string{})
 
-	err = runtime.BindQueryParameter("form", true, false, "scopes", ctx.QueryParams(), &params.Scopes)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter scopes: %s", err))
-	}
+	ctx.Set(CookieAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.V1EventList(ctx, tenant, params)
+	err = w.Handler.V1TenantBundleImport(ctx, tenant)
 	return err
 }
 
-// V1EventKeyList converts echo context to params.
-func (w *ServerInterfaceWrapper) V1EventKeyList(ctx echo.Context) error {
+// V1CelDebug converts echo context to params.
+func (w *ServerInterfaceWrapper) V1CelDebug(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "tenant" -------------
 	var tenant openapi_types.UUID
@@ -4802,27 +5585,39 @@ func (w *ServerInterfaceWrapper) V1EventKeyList(ctx echo.Context) error {
 	ctx.Set(CookieAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.V1EventKeyList(ctx, tenant)
+	err = w.Handler.V1CelDebug(ctx, tenant)
 	return err
 }
 
-// V1EventGet converts echo context to params.
-func (w *ServerInterfaceWrapper) V1EventGet(ctx echo.Context) error {
+// V1CircuitBreakerList converts echo context to params.
+func (w *ServerInterfaceWrapper) V1CircuitBreakerList(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "tenant" -------------
 	var tenant openapi_types.UUID
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
+	err = runtime.BindStyledParameterWithOptions("simple", "tenant", ctx.Param("tenant"), &tenant, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
 	}
 
-	// ------------- Path parameter "v1-event" -------------
-	var v1Event openapi_types.UUID
+	ctx.Set(BearerAuthScopes, []string{})
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "v1-event", runtime.ParamLocationPath, ctx.Param("v1-event"), &v1Event)
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.V1CircuitBreakerList(ctx, tenant)
+	return err
+}
+
+// V1DurableTaskBranch converts echo context to params.
+func (w *ServerInterfaceWrapper) V1DurableTaskBranch(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "tenant" -------------
+	var tenant openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter v1-event: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
 	}
 
 	ctx.Set(BearerAuthScopes, []string{})
@@ -4830,12 +5625,12 @@ func (w *ServerInterfaceWrapper) V1EventGet(ctx echo.Context) error {
 	ctx.Set(CookieAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.V1EventGet(ctx, tenant, v1Event)
+	err = w.Handler.V1DurableTaskBranch(ctx, tenant)
 	return err
 }
 
-// V1FilterList converts echo context to params.
-func (w *ServerInterfaceWrapper) V1FilterList(ctx echo.Context) error {
+// V1DurableTaskEventLogList converts echo context to params.
+func (w *ServerInterfaceWrapper) V1DurableTaskEventLogList(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "tenant" -------------
 	var tenant openapi_types.UUID
@@ -4845,12 +5640,20 @@ func (w *ServerInterfaceWrapper) V1FilterList(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
 	}
 
+	// ------------- Path parameter "durable-task" -------------
+	var durableTask openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "durable-task", runtime.ParamLocationPath, ctx.Param("durable-task"), &durableTask)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter durable-task: %s", err))
+	}
+
 	ctx.Set(BearerAuthScopes, []string{})
 
 	ctx.Set(CookieAuthScopes, []string{})
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params V1FilterListParams
+	var params V1DurableTaskEventLogListParams
 	// ------------- Optional query parameter "offset" -------------
 
 	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
@@ -4865,32 +5668,47 @@ func (w *ServerInterfaceWrapper) V1FilterList(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
 	}
 
-	// ------------- Optional query parameter "workflowIds" -------------
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.V1DurableTaskEventLogList(ctx, tenant, durableTask, params)
+	return err
+}
 
-	err = runtime.BindQueryParameter("form", true, false, "workflowIds", ctx.QueryParams(), &params.WorkflowIds)
+// V1EventSchemaDelete converts echo context to params.
+func (w *ServerInterfaceWrapper) V1EventSchemaDelete(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "tenant" -------------
+	var tenant openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "tenant", ctx.Param("tenant"), &tenant, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter workflowIds: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
 	}
 
-	// ------------- Optional query parameter "scopes" -------------
+	ctx.Set(BearerAuthScopes, []string{})
 
-	err = runtime.BindQueryParameter("form", true, false, "scopes", ctx.QueryParams(), &params.Scopes)
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params V1EventSchemaDeleteParams
+	// ------------- Required query parameter "eventKey" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "eventKey", ctx.QueryParams(), &params.EventKey)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter scopes: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter eventKey: %s", err))
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.V1FilterList(ctx, tenant, params)
+	err = w.Handler.V1EventSchemaDelete(ctx, tenant, params)
 	return err
 }
 
-// V1FilterCreate converts echo context to params.
-func (w *ServerInterfaceWrapper) V1FilterCreate(ctx echo.Context) error {
+// V1EventSchemaList converts echo context to params.
+func (w *ServerInterfaceWrapper) V1EventSchemaList(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "tenant" -------------
 	var tenant openapi_types.UUID
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
+	err = runtime.BindStyledParameterWithOptions("simple", "tenant", ctx.Param("tenant"), &tenant, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
 	}
@@ -4899,41 +5717,42 @@ func (w *ServerInterfaceWrapper) V1FilterCreate(ctx echo.Context) error {
 
 	ctx.Set(CookieAuthScopes, []string{})
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params V1EventSchemaListParams
+	// ------------- Optional query parameter "eventKey" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "eventKey", ctx.QueryParams(), &params.EventKey)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter eventKey: %s", err))
+	}
+
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.V1FilterCreate(ctx, tenant)
+	err = w.Handler.V1EventSchemaList(ctx, tenant, params)
 	return err
 }
 
-// V1FilterDelete converts echo context to params.
-func (w *ServerInterfaceWrapper) V1FilterDelete(ctx echo.Context) error {
+// V1EventSchemaCreate converts echo context to params.
+func (w *ServerInterfaceWrapper) V1EventSchemaCreate(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "tenant" -------------
 	var tenant openapi_types.UUID
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
+	err = runtime.BindStyledParameterWithOptions("simple", "tenant", ctx.Param("tenant"), &tenant, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
 	}
 
-	// ------------- Path parameter "v1-filter" -------------
-	var v1Filter openapi_types.UUID
-
-	err = runtime.BindStyledParameterWithLocation("simple", false, "v1-filter", runtime.ParamLocationPath, ctx.Param("v1-filter"), &v1Filter)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter v1-filter: %s", err))
-	}
-
 	ctx.Set(BearerAuthScopes, []string{})
 
 	ctx.Set(CookieAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.V1FilterDelete(ctx, tenant, v1Filter)
+	err = w.Handler.V1EventSchemaCreate(ctx, tenant)
 	return err
 }
 
-// V1FilterGet converts echo context to params.
-func (w *ServerInterfaceWrapper) V1FilterGet(ctx echo.Context) error {
+// V1EventList converts echo context to params.
+func (w *ServerInterfaceWrapper) V1EventList(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "tenant" -------------
 	var tenant openapi_types.UUID
@@ -4943,8 +5762,246 @@ func (w *ServerInterfaceWrapper) V1FilterGet(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
 	}
 
-	// ------------- Path parameter "v1-filter" -------------
-	var v1Filter openapi_types.UUID
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params V1EventListParams
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// ------------- Optional query parameter "keys" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "keys", ctx.QueryParams(), &params.Keys)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter keys: %s", err))
+	}
+
+	// ------------- Optional query parameter "since" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "since", ctx.QueryParams(), &params.Since)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter since: %s", err))
+	}
+
+	// ------------- Optional query parameter "until" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "until", ctx.QueryParams(), &params.Until)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter until: %s", err))
+	}
+
+	// ------------- Optional query parameter "workflowIds" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "workflowIds", ctx.QueryParams(), &params.WorkflowIds)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter workflowIds: %s", err))
+	}
+
+	// ------------- Optional query parameter "workflowRunStatuses" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "workflowRunStatuses", ctx.QueryParams(), &params.WorkflowRunStatuses)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter workflowRunStatuses: %s", err))
+	}
+
+	// ------------- Optional query parameter "eventIds" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "eventIds", ctx.QueryParams(), &params.EventIds)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter eventIds: %s", err))
+	}
+
+	// ------------- Optional query parameter "additionalMetadata" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "additionalMetadata", ctx.QueryParams(), &params.AdditionalMetadata)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter additionalMetadata: %s", err))
+	}
+
+	// ------------- Optional query parameter "scopes" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "scopes", ctx.QueryParams(), &params.Scopes)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter scopes: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.V1EventList(ctx, tenant, params)
+	return err
+}
+
+// V1EventKeyList converts echo context to params.
+func (w *ServerInterfaceWrapper) V1EventKeyList(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "tenant" -------------
+	var tenant openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.V1EventKeyList(ctx, tenant)
+	return err
+}
+
+// V1EventGet converts echo context to params.
+func (w *ServerInterfaceWrapper) V1EventGet(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "tenant" -------------
+	var tenant openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
+	}
+
+	// ------------- Path parameter "v1-event" -------------
+	var v1Event openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "v1-event", runtime.ParamLocationPath, ctx.Param("v1-event"), &v1Event)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter v1-event: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.V1EventGet(ctx, tenant, v1Event)
+	return err
+}
+
+// V1FilterList converts echo context to params.
+func (w *ServerInterfaceWrapper) V1FilterList(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "tenant" -------------
+	var tenant openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params V1FilterListParams
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// ------------- Optional query parameter "workflowIds" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "workflowIds", ctx.QueryParams(), &params.WorkflowIds)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter workflowIds: %s", err))
+	}
+
+	// ------------- Optional query parameter "scopes" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "scopes", ctx.QueryParams(), &params.Scopes)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter scopes: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.V1FilterList(ctx, tenant, params)
+	return err
+}
+
+// V1FilterCreate converts echo context to params.
+func (w *ServerInterfaceWrapper) V1FilterCreate(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "tenant" -------------
+	var tenant openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.V1FilterCreate(ctx, tenant)
+	return err
+}
+
+// V1FilterDelete converts echo context to params.
+func (w *ServerInterfaceWrapper) V1FilterDelete(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "tenant" -------------
+	var tenant openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
+	}
+
+	// ------------- Path parameter "v1-filter" -------------
+	var v1Filter openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "v1-filter", runtime.ParamLocationPath, ctx.Param("v1-filter"), &v1Filter)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter v1-filter: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.V1FilterDelete(ctx, tenant, v1Filter)
+	return err
+}
+
+// V1FilterGet converts echo context to params.
+func (w *ServerInterfaceWrapper) V1FilterGet(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "tenant" -------------
+	var tenant openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
+	}
+
+	// ------------- Path parameter "v1-filter" -------------
+	var v1Filter openapi_types.UUID
 
 	err = runtime.BindStyledParameterWithLocation("simple", false, "v1-filter", runtime.ParamLocationPath, ctx.Param("v1-filter"), &v1Filter)
 	if err != nil {
@@ -5146,18 +6203,39 @@ func (w *ServerInterfaceWrapper) V1TenantLogLineList(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter step_ids: %s", err))
 	}
 
+	// ------------- Optional query parameter "worker_ids" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "worker_ids", ctx.QueryParams(), &params.WorkerIds)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter worker_ids: %s", err))
+	}
+
+	// ------------- Optional query parameter "query" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "query", ctx.QueryParams(), &params.Query)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter query: %s", err))
+	}
+
+	// ------------- Optional query parameter "metadata" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "metadata", ctx.QueryParams(), &params.Metadata)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter metadata: %s", err))
+	}
+
 	// Invoke the callback with all the unmarshaled arguments
 	err = w.Handler.V1TenantLogLineList(ctx, tenant, params)
 	return err
 }
 
-// V1HttpOperatorList converts echo context to params.
-func (w *ServerInterfaceWrapper) V1HttpOperatorList(ctx echo.Context) error {
+// V1TenantLogLineTail converts echo context to params.
+func (w *ServerInterfaceWrapper) V1TenantLogLineTail(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "tenant" -------------
 	var tenant openapi_types.UUID
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
+	err = runtime.BindStyledParameterWithOptions("simple", "tenant", ctx.Param("tenant"), &tenant, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
 	}
@@ -5167,7 +6245,92 @@ func (w *ServerInterfaceWrapper) V1HttpOperatorList(ctx echo.Context) error {
 	ctx.Set(CookieAuthScopes, []string{})
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params V1HttpOperatorListParams
+	var params V1TenantLogLineTailParams
+	// ------------- Optional query parameter "after" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "after", ctx.QueryParams(), &params.After)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter after: %s", err))
+	}
+
+	// ------------- Optional query parameter "search" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "search", ctx.QueryParams(), &params.Search)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter search: %s", err))
+	}
+
+	// ------------- Optional query parameter "levels" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "levels", ctx.QueryParams(), &params.Levels)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter levels: %s", err))
+	}
+
+	// ------------- Optional query parameter "taskExternalIds" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "taskExternalIds", ctx.QueryParams(), &params.TaskExternalIds)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter taskExternalIds: %s", err))
+	}
+
+	// ------------- Optional query parameter "workflow_ids" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "workflow_ids", ctx.QueryParams(), &params.WorkflowIds)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter workflow_ids: %s", err))
+	}
+
+	// ------------- Optional query parameter "step_ids" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "step_ids", ctx.QueryParams(), &params.StepIds)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter step_ids: %s", err))
+	}
+
+	// ------------- Optional query parameter "worker_ids" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "worker_ids", ctx.QueryParams(), &params.WorkerIds)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter worker_ids: %s", err))
+	}
+
+	// ------------- Optional query parameter "query" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "query", ctx.QueryParams(), &params.Query)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter query: %s", err))
+	}
+
+	// ------------- Optional query parameter "metadata" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "metadata", ctx.QueryParams(), &params.Metadata)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter metadata: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.V1TenantLogLineTail(ctx, tenant, params)
+	return err
+}
+
+// V1HttpOperatorList converts echo context to params.
+func (w *ServerInterfaceWrapper) V1HttpOperatorList(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "tenant" -------------
+	var tenant openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params V1HttpOperatorListParams
 	// ------------- Optional query parameter "offset" -------------
 
 	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
@@ -5207,6 +6370,77 @@ func (w *ServerInterfaceWrapper) V1HttpOperatorCreate(ctx echo.Context) error {
 	return err
 }
 
+// V1TaskGetSlotUsage converts echo context to params.
+func (w *ServerInterfaceWrapper) V1TaskGetSlotUsage(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "tenant" -------------
+	var tenant openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "tenant", ctx.Param("tenant"), &tenant, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params V1TaskGetSlotUsageParams
+	// ------------- Required query parameter "since" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "since", ctx.QueryParams(), &params.Since)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter since: %s", err))
+	}
+
+	// ------------- Optional query parameter "until" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "until", ctx.QueryParams(), &params.Until)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter until: %s", err))
+	}
+
+	// ------------- Required query parameter "group_by" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "group_by", ctx.QueryParams(), &params.GroupBy)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter group_by: %s", err))
+	}
+
+	// ------------- Optional query parameter "key" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "key", ctx.QueryParams(), &params.Key)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter key: %s", err))
+	}
+
+	// ------------- Optional query parameter "workflow_ids" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "workflow_ids", ctx.QueryParams(), &params.WorkflowIds)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter workflow_ids: %s", err))
+	}
+
+	// ------------- Optional query parameter "slot_type" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "slot_type", ctx.QueryParams(), &params.SlotType)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter slot_type: %s", err))
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.V1TaskGetSlotUsage(ctx, tenant, params)
+	return err
+}
+
 // V1TaskListStatusMetrics converts echo context to params.
 func (w *ServerInterfaceWrapper) V1TaskListStatusMetrics(ctx echo.Context) error {
 	var err error
@@ -5568,6 +6802,95 @@ func (w *ServerInterfaceWrapper) V1WebhookReceive(ctx echo.Context) error {
 	return err
 }
 
+// V1WorkerCordon converts echo context to params.
+func (w *ServerInterfaceWrapper) V1WorkerCordon(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "tenant" -------------
+	var tenant openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "tenant", ctx.Param("tenant"), &tenant, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.V1WorkerCordon(ctx, tenant)
+	return err
+}
+
+// V1WorkerCordonList converts echo context to params.
+func (w *ServerInterfaceWrapper) V1WorkerCordonList(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "tenant" -------------
+	var tenant openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "tenant", ctx.Param("tenant"), &tenant, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params V1WorkerCordonListParams
+	// ------------- Optional query parameter "workerIds" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "workerIds", ctx.QueryParams(), &params.WorkerIds)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter workerIds: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.V1WorkerCordonList(ctx, tenant, params)
+	return err
+}
+
+// V1WorkerDrain converts echo context to params.
+func (w *ServerInterfaceWrapper) V1WorkerDrain(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "tenant" -------------
+	var tenant openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "tenant", ctx.Param("tenant"), &tenant, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.V1WorkerDrain(ctx, tenant)
+	return err
+}
+
+// V1WorkerUncordon converts echo context to params.
+func (w *ServerInterfaceWrapper) V1WorkerUncordon(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "tenant" -------------
+	var tenant openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "tenant", ctx.Param("tenant"), &tenant, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.V1WorkerUncordon(ctx, tenant)
+	return err
+}
+
 // V1WorkflowRunList converts echo context to params.
 func (w *ServerInterfaceWrapper) V1WorkflowRunList(ctx echo.Context) error {
 	var err error
@@ -5788,6 +7111,46 @@ func (w *ServerInterfaceWrapper) V1WorkflowRunExternalIdsList(ctx echo.Context)
 	return err
 }
 
+// V1WorkflowRunSearch converts echo context to params.
+func (w *ServerInterfaceWrapper) V1WorkflowRunSearch(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "tenant" -------------
+	var tenant openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "tenant", ctx.Param("tenant"), &tenant, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.V1WorkflowRunSearch(ctx, tenant)
+	return err
+}
+
+// V1WorkflowRunSignal converts echo context to params.
+func (w *ServerInterfaceWrapper) V1WorkflowRunSignal(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "tenant" -------------
+	var tenant openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "tenant", ctx.Param("tenant"), &tenant, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.V1WorkflowRunSignal(ctx, tenant)
+	return err
+}
+
 // V1WorkflowRunCreate converts echo context to params.
 func (w *ServerInterfaceWrapper) V1WorkflowRunCreate(ctx echo.Context) error {
 	var err error
@@ -5913,6 +7276,35 @@ func (w *ServerInterfaceWrapper) V1WorkflowRunGetTimings(ctx echo.Context) error
 	return err
 }
 
+// V1WorkflowSpecGet converts echo context to params.
+func (w *ServerInterfaceWrapper) V1WorkflowSpecGet(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "workflow" -------------
+	var workflow openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "workflow", ctx.Param("workflow"), &workflow, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter workflow: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params V1WorkflowSpecGetParams
+	// ------------- Optional query parameter "version" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "version", ctx.QueryParams(), &params.Version)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter version: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.V1WorkflowSpecGet(ctx, workflow, params)
+	return err
+}
+
 // StepRunListArchives converts echo context to params.
 func (w *ServerInterfaceWrapper) StepRunListArchives(ctx echo.Context) error {
 	var err error
@@ -8409,9 +9801,22 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 	router.GET(baseURL+"/api/v1/stable/tasks/:task/logs", wrapper.V1LogLineList)
 	router.POST(baseURL+"/api/v1/stable/tasks/:task/restore", wrapper.V1TaskRestore)
 	router.GET(baseURL+"/api/v1/stable/tasks/:task/task-events", wrapper.V1TaskEventList)
+	router.GET(baseURL+"/api/v1/stable/tenants/:tenant/approvals", wrapper.V1ApprovalList)
+	router.POST(baseURL+"/api/v1/stable/tenants/:tenant/approvals/:task/resolve", wrapper.V1ApprovalResolve)
+	router.GET(baseURL+"/api/v1/stable/tenants/:tenant/bulk-jobs", wrapper.V1BulkJobList)
+	router.POST(baseURL+"/api/v1/stable/tenants/:tenant/bulk-jobs", wrapper.V1BulkJobCreate)
+	router.GET(baseURL+"/api/v1/stable/tenants/:tenant/bulk-jobs/:v1-bulk-job", wrapper.V1BulkJobGet)
+	router.POST(baseURL+"/api/v1/stable/tenants/:tenant/bulk-jobs/:v1-bulk-job/pause", wrapper.V1BulkJobPause)
+	router.POST(baseURL+"/api/v1/stable/tenants/:tenant/bulk-jobs/:v1-bulk-job/resume", wrapper.V1BulkJobResume)
+	router.POST(baseURL+"/api/v1/stable/tenants/:tenant/bundle/export", wrapper.V1TenantBundleExport)
+	router.POST(baseURL+"/api/v1/stable/tenants/:tenant/bundle/import", wrapper.V1TenantBundleImport)
 	router.POST(baseURL+"/api/v1/stable/tenants/:tenant/cel/debug", wrapper.V1CelDebug)
+	router.GET(baseURL+"/api/v1/stable/tenants/:tenant/circuit-breakers", wrapper.V1CircuitBreakerList)
 	router.POST(baseURL+"/api/v1/stable/tenants/:tenant/durable-tasks/branch", wrapper.V1DurableTaskBranch)
 	router.GET(baseURL+"/api/v1/stable/tenants/:tenant/durable-tasks/:durable-task", wrapper.V1DurableTaskEventLogList)
+	router.DELETE(baseURL+"/api/v1/stable/tenants/:tenant/event-schemas", wrapper.V1EventSchemaDelete)
+	router.GET(baseURL+"/api/v1/stable/tenants/:tenant/event-schemas", wrapper.V1EventSchemaList)
+	router.POST(baseURL+"/api/v1/stable/tenants/:tenant/event-schemas", wrapper.V1EventSchemaCreate)
 	router.GET(baseURL+"/api/v1/stable/tenants/:tenant/events", wrapper.V1EventList)
 	router.GET(baseURL+"/api/v1/stable/tenants/:tenant/events/keys", wrapper.V1EventKeyList)
 	router.GET(baseURL+"/api/v1/stable/tenants/:tenant/events/:v1-event", wrapper.V1EventGet)
@@ -8422,8 +9827,10 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 	router.PATCH(baseURL+"/api/v1/stable/tenants/:tenant/filters/:v1-filter", wrapper.V1FilterUpdate)
 	router.GET(baseURL+"/api/v1/stable/tenants/:tenant/log-point-metrics", wrapper.V1TenantLogLineGetPointMetrics)
 	router.GET(baseURL+"/api/v1/stable/tenants/:tenant/logs", wrapper.V1TenantLogLineList)
+	router.GET(baseURL+"/api/v1/stable/tenants/:tenant/logs/tail", wrapper.V1TenantLogLineTail)
 	router.GET(baseURL+"/api/v1/stable/tenants/:tenant/operators/http", wrapper.V1HttpOperatorList)
 	router.POST(baseURL+"/api/v1/stable/tenants/:tenant/operators/http", wrapper.V1HttpOperatorCreate)
+	router.GET(baseURL+"/api/v1/stable/tenants/:tenant/slot-usage", wrapper.V1TaskGetSlotUsage)
 	router.GET(baseURL+"/api/v1/stable/tenants/:tenant/task-metrics", wrapper.V1TaskListStatusMetrics)
 	router.GET(baseURL+"/api/v1/stable/tenants/:tenant/task-point-metrics", wrapper.V1TaskGetPointMetrics)
 	router.POST(baseURL+"/api/v1/stable/tenants/:tenant/tasks/cancel", wrapper.V1TaskCancel)
@@ -8435,14 +9842,21 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 	router.GET(baseURL+"/api/v1/stable/tenants/:tenant/webhooks/:v1-webhook", wrapper.V1WebhookGet)
 	router.PATCH(baseURL+"/api/v1/stable/tenants/:tenant/webhooks/:v1-webhook", wrapper.V1WebhookUpdate)
 	router.POST(baseURL+"/api/v1/stable/tenants/:tenant/webhooks/:v1-webhook", wrapper.V1WebhookReceive)
+	router.POST(baseURL+"/api/v1/stable/tenants/:tenant/workers/cordon", wrapper.V1WorkerCordon)
+	router.GET(baseURL+"/api/v1/stable/tenants/:tenant/workers/cordons", wrapper.V1WorkerCordonList)
+	router.POST(baseURL+"/api/v1/stable/tenants/:tenant/workers/drain", wrapper.V1WorkerDrain)
+	router.POST(baseURL+"/api/v1/stable/tenants/:tenant/workers/uncordon", wrapper.V1WorkerUncordon)
 	router.GET(baseURL+"/api/v1/stable/tenants/:tenant/workflow-runs", wrapper.V1WorkflowRunList)
 	router.GET(baseURL+"/api/v1/stable/tenants/:tenant/workflow-runs/display-names", wrapper.V1WorkflowRunDisplayNamesList)
 	router.GET(baseURL+"/api/v1/stable/tenants/:tenant/workflow-runs/external-ids", wrapper.V1WorkflowRunExternalIdsList)
+	router.POST(baseURL+"/api/v1/stable/tenants/:tenant/workflow-runs/search", wrapper.V1WorkflowRunSearch)
+	router.POST(baseURL+"/api/v1/stable/tenants/:tenant/workflow-runs/signal", wrapper.V1WorkflowRunSignal)
 	router.POST(baseURL+"/api/v1/stable/tenants/:tenant/workflow-runs/trigger", wrapper.V1WorkflowRunCreate)
 	router.GET(baseURL+"/api/v1/stable/workflow-runs/:v1-workflow-run", wrapper.V1WorkflowRunGet)
 	router.GET(baseURL+"/api/v1/stable/workflow-runs/:v1-workflow-run/status", wrapper.V1WorkflowRunGetStatus)
 	router.GET(baseURL+"/api/v1/stable/workflow-runs/:v1-workflow-run/task-events", wrapper.V1WorkflowRunTaskEventsList)
 	router.GET(baseURL+"/api/v1/stable/workflow-runs/:v1-workflow-run/task-timings", wrapper.V1WorkflowRunGetTimings)
+	router.GET(baseURL+"/api/v1/stable/workflows/:workflow/spec", wrapper.V1WorkflowSpecGet)
 	router.GET(baseURL+"/api/v1/step-runs/:step-run/archives", wrapper.StepRunListArchives)
 	router.GET(baseURL+"/api/v1/step-runs/:step-run/events", wrapper.StepRunListEvents)
 	router.POST(baseURL+"/api/v1/tenants", wrapper.TenantCreate)
@@ -9355,5963 +10769,7220 @@ func (response V1TaskEventList501JSONResponse) VisitV1TaskEventListResponse(w ht
 	return json.NewEncoder(w).Encode(response)
 }
 
-type V1CelDebugRequestObject struct {
+type V1ApprovalListRequestObject struct {
 	Tenant openapi_types.UUID `json:"tenant"`
-	Body   *V1CelDebugJSONRequestBody
+	Params V1ApprovalListParams
 }
 
-type V1CelDebugResponseObject interface {
-	VisitV1CelDebugResponse(w http.ResponseWriter) error
+type V1ApprovalListResponseObject interface {
+	VisitV1ApprovalListResponse(w http.ResponseWriter) error
 }
 
-type V1CelDebug200JSONResponse V1CELDebugResponse
+type V1ApprovalList200JSONResponse V1ApprovalList
 
-func (response V1CelDebug200JSONResponse) VisitV1CelDebugResponse(w http.ResponseWriter) error {
+func (response V1ApprovalList200JSONResponse) VisitV1ApprovalListResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type V1CelDebug400JSONResponse APIErrors
+type V1ApprovalList400JSONResponse APIErrors
 
-func (response V1CelDebug400JSONResponse) VisitV1CelDebugResponse(w http.ResponseWriter) error {
+func (response V1ApprovalList400JSONResponse) VisitV1ApprovalListResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type V1CelDebug403JSONResponse APIErrors
+type V1ApprovalList403JSONResponse APIErrors
 
-func (response V1CelDebug403JSONResponse) VisitV1CelDebugResponse(w http.ResponseWriter) error {
+func (response V1ApprovalList403JSONResponse) VisitV1ApprovalListResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type V1DurableTaskBranchRequestObject struct {
+type V1ApprovalResolveRequestObject struct {
 	Tenant openapi_types.UUID `json:"tenant"`
-	Body   *V1DurableTaskBranchJSONRequestBody
+	Task   openapi_types.UUID `json:"task"`
+	Body   *V1ApprovalResolveJSONRequestBody
 }
 
-type V1DurableTaskBranchResponseObject interface {
-	VisitV1DurableTaskBranchResponse(w http.ResponseWriter) error
+type V1ApprovalResolveResponseObject interface {
+	VisitV1ApprovalResolveResponse(w http.ResponseWriter) error
 }
 
-type V1DurableTaskBranch200JSONResponse V1BranchDurableTaskResponse
+type V1ApprovalResolve200JSONResponse V1Approval
 
-func (response V1DurableTaskBranch200JSONResponse) VisitV1DurableTaskBranchResponse(w http.ResponseWriter) error {
+func (response V1ApprovalResolve200JSONResponse) VisitV1ApprovalResolveResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type V1DurableTaskBranch400JSONResponse APIErrors
+type V1ApprovalResolve400JSONResponse APIErrors
 
-func (response V1DurableTaskBranch400JSONResponse) VisitV1DurableTaskBranchResponse(w http.ResponseWriter) error {
+func (response V1ApprovalResolve400JSONResponse) VisitV1ApprovalResolveResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type V1DurableTaskBranch403JSONResponse APIErrors
+type V1ApprovalResolve403JSONResponse APIErrors
 
-func (response V1DurableTaskBranch403JSONResponse) VisitV1DurableTaskBranchResponse(w http.ResponseWriter) error {
+func (response V1ApprovalResolve403JSONResponse) VisitV1ApprovalResolveResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type V1DurableTaskEventLogListRequestObject struct {
-	Tenant      openapi_types.UUID `json:"tenant"`
-	DurableTask openapi_types.UUID `json:"durable-task"`
-	Params      V1DurableTaskEventLogListParams
-}
-
-type V1DurableTaskEventLogListResponseObject interface {
-	VisitV1DurableTaskEventLogListResponse(w http.ResponseWriter) error
-}
-
-type V1DurableTaskEventLogList200JSONResponse V1DurableEventLogList
-
-func (response V1DurableTaskEventLogList200JSONResponse) VisitV1DurableTaskEventLogListResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
-
-	return json.NewEncoder(w).Encode(response)
-}
-
-type V1DurableTaskEventLogList400JSONResponse APIErrors
-
-func (response V1DurableTaskEventLogList400JSONResponse) VisitV1DurableTaskEventLogListResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
-
-	return json.NewEncoder(w).Encode(response)
-}
-
-type V1DurableTaskEventLogList403JSONResponse APIErrors
+type V1ApprovalResolve404JSONResponse APIErrors
 
-func (response V1DurableTaskEventLogList403JSONResponse) VisitV1DurableTaskEventLogListResponse(w http.ResponseWriter) error {
+func (response V1ApprovalResolve404JSONResponse) VisitV1ApprovalResolveResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type V1DurableTaskEventLogList404JSONResponse APIErrors
+type V1ApprovalResolve409JSONResponse APIErrors
 
-func (response V1DurableTaskEventLogList404JSONResponse) VisitV1DurableTaskEventLogListResponse(w http.ResponseWriter) error {
+func (response V1ApprovalResolve409JSONResponse) VisitV1ApprovalResolveResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	w.WriteHeader(409)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type V1EventListRequestObject struct {
+type V1BulkJobListRequestObject struct {
 	Tenant openapi_types.UUID `json:"tenant"`
-	Params V1EventListParams
 }
 
-type V1EventListResponseObject interface {
-	VisitV1EventListResponse(w http.ResponseWriter) error
+type V1BulkJobListResponseObject interface {
+	VisitV1BulkJobListResponse(w http.ResponseWriter) error
 }
 
-type V1EventList200JSONResponse V1EventList
+type V1BulkJobList200JSONResponse V1BulkJobList
 
-func (response V1EventList200JSONResponse) VisitV1EventListResponse(w http.ResponseWriter) error {
+func (response V1BulkJobList200JSONResponse) VisitV1BulkJobListResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type V1EventList400JSONResponse APIErrors
+type V1BulkJobList400JSONResponse APIErrors
 
-func (response V1EventList400JSONResponse) VisitV1EventListResponse(w http.ResponseWriter) error {
+func (response V1BulkJobList400JSONResponse) VisitV1BulkJobListResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type V1EventList403JSONResponse APIErrors
+type V1BulkJobList403JSONResponse APIErrors
 
-func (response V1EventList403JSONResponse) VisitV1EventListResponse(w http.ResponseWriter) error {
+func (response V1BulkJobList403JSONResponse) VisitV1BulkJobListResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type V1EventKeyListRequestObject struct {
+type V1BulkJobCreateRequestObject struct {
 	Tenant openapi_types.UUID `json:"tenant"`
+	Body   *V1BulkJobCreateJSONRequestBody
 }
 
-type V1EventKeyListResponseObject interface {
-	VisitV1EventKeyListResponse(w http.ResponseWriter) error
+type V1BulkJobCreateResponseObject interface {
+	VisitV1BulkJobCreateResponse(w http.ResponseWriter) error
 }
 
-type V1EventKeyList200JSONResponse EventKeyList
+type V1BulkJobCreate200JSONResponse V1BulkJob
 
-func (response V1EventKeyList200JSONResponse) VisitV1EventKeyListResponse(w http.ResponseWriter) error {
+func (response V1BulkJobCreate200JSONResponse) VisitV1BulkJobCreateResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type V1EventKeyList400JSONResponse APIErrors
+type V1BulkJobCreate400JSONResponse APIErrors
 
-func (response V1EventKeyList400JSONResponse) VisitV1EventKeyListResponse(w http.ResponseWriter) error {
+func (response V1BulkJobCreate400JSONResponse) VisitV1BulkJobCreateResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type V1EventKeyList403JSONResponse APIErrors
+type V1BulkJobCreate403JSONResponse APIErrors
 
-func (response V1EventKeyList403JSONResponse) VisitV1EventKeyListResponse(w http.ResponseWriter) error {
+func (response V1BulkJobCreate403JSONResponse) VisitV1BulkJobCreateResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type V1EventGetRequestObject struct {
-	Tenant  openapi_types.UUID `json:"tenant"`
-	V1Event openapi_types.UUID `json:"v1-event"`
+type V1BulkJobGetRequestObject struct {
+	Tenant    openapi_types.UUID `json:"tenant"`
+	V1BulkJob openapi_types.UUID `json:"v1-bulk-job"`
 }
 
-type V1EventGetResponseObject interface {
-	VisitV1EventGetResponse(w http.ResponseWriter) error
+type V1BulkJobGetResponseObject interface {
+	VisitV1BulkJobGetResponse(w http.ResponseWriter) error
 }
 
-type V1EventGet200JSONResponse V1Event
+type V1BulkJobGet200JSONResponse V1BulkJob
 
-func (response V1EventGet200JSONResponse) VisitV1EventGetResponse(w http.ResponseWriter) error {
+func (response V1BulkJobGet200JSONResponse) VisitV1BulkJobGetResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type V1EventGet400JSONResponse APIErrors
+type V1BulkJobGet400JSONResponse APIErrors
 
-func (response V1EventGet400JSONResponse) VisitV1EventGetResponse(w http.ResponseWriter) error {
+func (response V1BulkJobGet400JSONResponse) VisitV1BulkJobGetResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type V1EventGet403JSONResponse APIErrors
+type V1BulkJobGet403JSONResponse APIErrors
 
-func (response V1EventGet403JSONResponse) VisitV1EventGetResponse(w http.ResponseWriter) error {
+func (response V1BulkJobGet403JSONResponse) VisitV1BulkJobGetResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type V1FilterListRequestObject struct {
-	Tenant openapi_types.UUID `json:"tenant"`
-	Params V1FilterListParams
-}
-
-type V1FilterListResponseObject interface {
-	VisitV1FilterListResponse(w http.ResponseWriter) error
-}
-
-type V1FilterList200JSONResponse V1FilterList
-
-func (response V1FilterList200JSONResponse) VisitV1FilterListResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
-
-	return json.NewEncoder(w).Encode(response)
-}
-
-type V1FilterList400JSONResponse APIErrors
-
-func (response V1FilterList400JSONResponse) VisitV1FilterListResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
-
-	return json.NewEncoder(w).Encode(response)
-}
-
-type V1FilterList403JSONResponse APIErrors
+type V1BulkJobGet404JSONResponse APIErrors
 
-func (response V1FilterList403JSONResponse) VisitV1FilterListResponse(w http.ResponseWriter) error {
+func (response V1BulkJobGet404JSONResponse) VisitV1BulkJobGetResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type V1FilterCreateRequestObject struct {
-	Tenant openapi_types.UUID `json:"tenant"`
-	Body   *V1FilterCreateJSONRequestBody
+type V1BulkJobPauseRequestObject struct {
+	Tenant    openapi_types.UUID `json:"tenant"`
+	V1BulkJob openapi_types.UUID `json:"v1-bulk-job"`
 }
 
-type V1FilterCreateResponseObject interface {
-	VisitV1FilterCreateResponse(w http.ResponseWriter) error
+type V1BulkJobPauseResponseObject interface {
+	VisitV1BulkJobPauseResponse(w http.ResponseWriter) error
 }
 
-type V1FilterCreate200JSONResponse V1Filter
+type V1BulkJobPause200JSONResponse V1BulkJob
 
-func (response V1FilterCreate200JSONResponse) VisitV1FilterCreateResponse(w http.ResponseWriter) error {
+func (response V1BulkJobPause200JSONResponse) VisitV1BulkJobPauseResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type V1FilterCreate400JSONResponse APIErrors
+type V1BulkJobPause400JSONResponse APIErrors
 
-func (response V1FilterCreate400JSONResponse) VisitV1FilterCreateResponse(w http.ResponseWriter) error {
+func (response V1BulkJobPause400JSONResponse) VisitV1BulkJobPauseResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type V1FilterCreate403JSONResponse APIErrors
+type V1BulkJobPause403JSONResponse APIErrors
 
-func (response V1FilterCreate403JSONResponse) VisitV1FilterCreateResponse(w http.ResponseWriter) error {
+func (response V1BulkJobPause403JSONResponse) VisitV1BulkJobPauseResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type V1FilterCreate404JSONResponse APIErrors
+type V1BulkJobPause404JSONResponse APIErrors
 
-func (response V1FilterCreate404JSONResponse) VisitV1FilterCreateResponse(w http.ResponseWriter) error {
+func (response V1BulkJobPause404JSONResponse) VisitV1BulkJobPauseResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type V1FilterDeleteRequestObject struct {
-	Tenant   openapi_types.UUID `json:"tenant"`
-	V1Filter openapi_types.UUID `json:"v1-filter"`
+type V1BulkJobResumeRequestObject struct {
+	Tenant    openapi_types.UUID `json:"tenant"`
+	V1BulkJob openapi_types.UUID `json:"v1-bulk-job"`
 }
 
-type V1FilterDeleteResponseObject interface {
-	VisitV1FilterDeleteResponse(w http.ResponseWriter) error
+type V1BulkJobResumeResponseObject interface {
+	VisitV1BulkJobResumeResponse(w http.ResponseWriter) error
 }
 
-type V1FilterDelete200JSONResponse V1Filter
+type V1BulkJobResume200JSONResponse V1BulkJob
 
-func (response V1FilterDelete200JSONResponse) VisitV1FilterDeleteResponse(w http.ResponseWriter) error {
+func (response V1BulkJobResume200JSONResponse) VisitV1BulkJobResumeResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type V1FilterDelete400JSONResponse APIErrors
+type V1BulkJobResume400JSONResponse APIErrors
 
-func (response V1FilterDelete400JSONResponse) VisitV1FilterDeleteResponse(w http.ResponseWriter) error {
+func (response V1BulkJobResume400JSONResponse) VisitV1BulkJobResumeResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type V1FilterDelete403JSONResponse APIErrors
+type V1BulkJobResume403JSONResponse APIErrors
 
-func (response V1FilterDelete403JSONResponse) VisitV1FilterDeleteResponse(w http.ResponseWriter) error {
+func (response V1BulkJobResume403JSONResponse) VisitV1BulkJobResumeResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type V1FilterDelete404JSONResponse APIErrors
+type V1BulkJobResume404JSONResponse APIErrors
 
-func (response V1FilterDelete404JSONResponse) VisitV1FilterDeleteResponse(w http.ResponseWriter) error {
+func (response V1BulkJobResume404JSONResponse) VisitV1BulkJobResumeResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type V1FilterGetRequestObject struct {
-	Tenant   openapi_types.UUID `json:"tenant"`
-	V1Filter openapi_types.UUID `json:"v1-filter"`
+type V1TenantBundleExportRequestObject struct {
+	Tenant openapi_types.UUID `json:"tenant"`
+	Body   *V1TenantBundleExportJSONRequestBody
 }
 
-type V1FilterGetResponseObject interface {
-	VisitV1FilterGetResponse(w http.ResponseWriter) error
+type V1TenantBundleExportResponseObject interface {
+	VisitV1TenantBundleExportResponse(w http.ResponseWriter) error
 }
 
-type V1FilterGet200JSONResponse V1Filter
+type V1TenantBundleExport200JSONResponse V1TenantBundleExport
 
-func (response V1FilterGet200JSONResponse) VisitV1FilterGetResponse(w http.ResponseWriter) error {
+func (response V1TenantBundleExport200JSONResponse) VisitV1TenantBundleExportResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type V1FilterGet400JSONResponse APIErrors
+type V1TenantBundleExport400JSONResponse APIErrors
 
-func (response V1FilterGet400JSONResponse) VisitV1FilterGetResponse(w http.ResponseWriter) error {
+func (response V1TenantBundleExport400JSONResponse) VisitV1TenantBundleExportResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type V1FilterGet403JSONResponse APIErrors
+type V1TenantBundleExport403JSONResponse APIErrors
 
-func (response V1FilterGet403JSONResponse) VisitV1FilterGetResponse(w http.ResponseWriter) error {
+func (response V1TenantBundleExport403JSONResponse) VisitV1TenantBundleExportResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type V1FilterUpdateRequestObject struct {
-	Tenant   openapi_types.UUID `json:"tenant"`
-	V1Filter openapi_types.UUID `json:"v1-filter"`
-	Body     *V1FilterUpdateJSONRequestBody
+type V1TenantBundleImportRequestObject struct {
+	Tenant openapi_types.UUID `json:"tenant"`
+	Body   *V1TenantBundleImportJSONRequestBody
 }
 
-type V1FilterUpdateResponseObject interface {
-	VisitV1FilterUpdateResponse(w http.ResponseWriter) error
+type V1TenantBundleImportResponseObject interface {
+	VisitV1TenantBundleImportResponse(w http.ResponseWriter) error
 }
 
-type V1FilterUpdate200JSONResponse V1Filter
+type V1TenantBundleImport200JSONResponse V1TenantBundleImportResult
 
-func (response V1FilterUpdate200JSONResponse) VisitV1FilterUpdateResponse(w http.ResponseWriter) error {
+func (response V1TenantBundleImport200JSONResponse) VisitV1TenantBundleImportResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type V1FilterUpdate400JSONResponse APIErrors
+type V1TenantBundleImport400JSONResponse APIErrors
 
-func (response V1FilterUpdate400JSONResponse) VisitV1FilterUpdateResponse(w http.ResponseWriter) error {
+func (response V1TenantBundleImport400JSONResponse) VisitV1TenantBundleImportResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type V1FilterUpdate403JSONResponse APIErrors
+type V1TenantBundleImport403JSONResponse APIErrors
 
-func (response V1FilterUpdate403JSONResponse) VisitV1FilterUpdateResponse(w http.ResponseWriter) error {
+func (response V1TenantBundleImport403JSONResponse) VisitV1TenantBundleImportResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type V1FilterUpdate404JSONResponse APIErrors
+type V1TenantBundleImport409JSONResponse V1TenantBundleImportResult
 
-func (response V1FilterUpdate404JSONResponse) VisitV1FilterUpdateResponse(w http.ResponseWriter) error {
+func (response V1TenantBundleImport409JSONResponse) VisitV1TenantBundleImportResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	w.WriteHeader(409)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type V1TenantLogLineGetPointMetricsRequestObject struct {
+type V1CelDebugRequestObject struct {
 	Tenant openapi_types.UUID `json:"tenant"`
-	Params V1TenantLogLineGetPointMetricsParams
+	Body   *V1CelDebugJSONRequestBody
 }
 
-type V1TenantLogLineGetPointMetricsResponseObject interface {
-	VisitV1TenantLogLineGetPointMetricsResponse(w http.ResponseWriter) error
+type V1CelDebugResponseObject interface {
+	VisitV1CelDebugResponse(w http.ResponseWriter) error
 }
 
-type V1TenantLogLineGetPointMetrics200JSONResponse V1LogsPointMetrics
+type V1CelDebug200JSONResponse V1CELDebugResponse
 
-func (response V1TenantLogLineGetPointMetrics200JSONResponse) VisitV1TenantLogLineGetPointMetricsResponse(w http.ResponseWriter) error {
+func (response V1CelDebug200JSONResponse) VisitV1CelDebugResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type V1TenantLogLineGetPointMetrics400JSONResponse APIErrors
+type V1CelDebug400JSONResponse APIErrors
 
-func (response V1TenantLogLineGetPointMetrics400JSONResponse) VisitV1TenantLogLineGetPointMetricsResponse(w http.ResponseWriter) error {
+func (response V1CelDebug400JSONResponse) VisitV1CelDebugResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type V1TenantLogLineGetPointMetrics403JSONResponse APIErrors
+type V1CelDebug403JSONResponse APIErrors
 
-func (response V1TenantLogLineGetPointMetrics403JSONResponse) VisitV1TenantLogLineGetPointMetricsResponse(w http.ResponseWriter) error {
+func (response V1CelDebug403JSONResponse) VisitV1CelDebugResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type V1TenantLogLineGetPointMetrics501JSONResponse APIErrors
-
-func (response V1TenantLogLineGetPointMetrics501JSONResponse) VisitV1TenantLogLineGetPointMetricsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(501)
-
-	return json.NewEncoder(w).Encode(response)
-}
-
-type V1TenantLogLineListRequestObject struct {
+type V1CircuitBreakerListRequestObject struct {
 	Tenant openapi_types.UUID `json:"tenant"`
-	Params V1TenantLogLineListParams
 }
 
-type V1TenantLogLineListResponseObject interface {
-	VisitV1TenantLogLineListResponse(w http.ResponseWriter) error
+type V1CircuitBreakerListResponseObject interface {
+	VisitV1CircuitBreakerListResponse(w http.ResponseWriter) error
 }
 
-type V1TenantLogLineList200JSONResponse V1LogLineList
+type V1CircuitBreakerList200JSONResponse V1CircuitBreakerList
 
-func (response V1TenantLogLineList200JSONResponse) VisitV1TenantLogLineListResponse(w http.ResponseWriter) error {
+func (response V1CircuitBreakerList200JSONResponse) VisitV1CircuitBreakerListResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type V1TenantLogLineList400JSONResponse APIErrors
+type V1CircuitBreakerList400JSONResponse APIErrors
 
-func (response V1TenantLogLineList400JSONResponse) VisitV1TenantLogLineListResponse(w http.ResponseWriter) error {
+func (response V1CircuitBreakerList400JSONResponse) VisitV1CircuitBreakerListResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type V1TenantLogLineList403JSONResponse APIErrors
+type V1CircuitBreakerList403JSONResponse APIErrors
 
-func (response V1TenantLogLineList403JSONResponse) VisitV1TenantLogLineListResponse(w http.ResponseWriter) error {
+func (response V1CircuitBreakerList403JSONResponse) VisitV1CircuitBreakerListResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type V1HttpOperatorListRequestObject struct {
+type V1DurableTaskBranchRequestObject struct {
 	Tenant openapi_types.UUID `json:"tenant"`
-	Params V1HttpOperatorListParams
+	Body   *V1DurableTaskBranchJSONRequestBody
 }
 
-type V1HttpOperatorListResponseObject interface {
-	VisitV1HttpOperatorListResponse(w http.ResponseWriter) error
+type V1DurableTaskBranchResponseObject interface {
+	VisitV1DurableTaskBranchResponse(w http.ResponseWriter) error
 }
 
-type V1HttpOperatorList200JSONResponse V1HTTPOperatorList
+type V1DurableTaskBranch200JSONResponse V1BranchDurableTaskResponse
 
-func (response V1HttpOperatorList200JSONResponse) VisitV1HttpOperatorListResponse(w http.ResponseWriter) error {
+func (response V1DurableTaskBranch200JSONResponse) VisitV1DurableTaskBranchResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type V1HttpOperatorList400JSONResponse APIErrors
+type V1DurableTaskBranch400JSONResponse APIErrors
 
-func (response V1HttpOperatorList400JSONResponse) VisitV1HttpOperatorListResponse(w http.ResponseWriter) error {
+func (response V1DurableTaskBranch400JSONResponse) VisitV1DurableTaskBranchResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type V1HttpOperatorList403JSONResponse APIErrors
+type V1DurableTaskBranch403JSONResponse APIErrors
 
-func (response V1HttpOperatorList403JSONResponse) VisitV1HttpOperatorListResponse(w http.ResponseWriter) error {
+func (response V1DurableTaskBranch403JSONResponse) VisitV1DurableTaskBranchResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type V1HttpOperatorCreateRequestObject struct {
-	Tenant openapi_types.UUID `json:"tenant"`
-	Body   *V1HttpOperatorCreateJSONRequestBody
+type V1DurableTaskEventLogListRequestObject struct {
+	Tenant      openapi_types.UUID `json:"tenant"`
+	DurableTask openapi_types.UUID `json:"durable-task"`
+	Params      V1DurableTaskEventLogListParams
 }
 
-type V1HttpOperatorCreateResponseObject interface {
-	VisitV1HttpOperatorCreateResponse(w http.ResponseWriter) error
+type V1DurableTaskEventLogListResponseObject interface {
+	VisitV1DurableTaskEventLogListResponse(w http.ResponseWriter) error
 }
 
-type V1HttpOperatorCreate200JSONResponse V1HTTPOperator
+type V1DurableTaskEventLogList200JSONResponse V1DurableEventLogList
 
-func (response V1HttpOperatorCreate200JSONResponse) VisitV1HttpOperatorCreateResponse(w http.ResponseWriter) error {
+func (response V1DurableTaskEventLogList200JSONResponse) VisitV1DurableTaskEventLogListResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type V1HttpOperatorCreate400JSONResponse APIErrors
+type V1DurableTaskEventLogList400JSONResponse APIErrors
 
-func (response V1HttpOperatorCreate400JSONResponse) VisitV1HttpOperatorCreateResponse(w http.ResponseWriter) error {
+func (response V1DurableTaskEventLogList400JSONResponse) VisitV1DurableTaskEventLogListResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type V1HttpOperatorCreate403JSONResponse APIErrors
+type V1DurableTaskEventLogList403JSONResponse APIErrors
 
-func (response V1HttpOperatorCreate403JSONResponse) VisitV1HttpOperatorCreateResponse(w http.ResponseWriter) error {
+func (response V1DurableTaskEventLogList403JSONResponse) VisitV1DurableTaskEventLogListResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type V1HttpOperatorCreate404JSONResponse APIErrors
+type V1DurableTaskEventLogList404JSONResponse APIErrors
 
-func (response V1HttpOperatorCreate404JSONResponse) VisitV1HttpOperatorCreateResponse(w http.ResponseWriter) error {
+func (response V1DurableTaskEventLogList404JSONResponse) VisitV1DurableTaskEventLogListResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type V1TaskListStatusMetricsRequestObject struct {
+type V1EventSchemaDeleteRequestObject struct {
 	Tenant openapi_types.UUID `json:"tenant"`
-	Params V1TaskListStatusMetricsParams
+	Params V1EventSchemaDeleteParams
 }
 
-type V1TaskListStatusMetricsResponseObject interface {
-	VisitV1TaskListStatusMetricsResponse(w http.ResponseWriter) error
+type V1EventSchemaDeleteResponseObject interface {
+	VisitV1EventSchemaDeleteResponse(w http.ResponseWriter) error
 }
 
-type V1TaskListStatusMetrics200JSONResponse V1TaskRunMetrics
-
-func (response V1TaskListStatusMetrics200JSONResponse) VisitV1TaskListStatusMetricsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+type V1EventSchemaDelete204Response struct {
+}
 
-	return json.NewEncoder(w).Encode(response)
+func (response V1EventSchemaDelete204Response) VisitV1EventSchemaDeleteResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
 }
 
-type V1TaskListStatusMetrics400JSONResponse APIErrors
+type V1EventSchemaDelete400JSONResponse APIErrors
 
-func (response V1TaskListStatusMetrics400JSONResponse) VisitV1TaskListStatusMetricsResponse(w http.ResponseWriter) error {
+func (response V1EventSchemaDelete400JSONResponse) VisitV1EventSchemaDeleteResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type V1TaskListStatusMetrics403JSONResponse APIErrors
+type V1EventSchemaDelete403JSONResponse APIErrors
 
-func (response V1TaskListStatusMetrics403JSONResponse) VisitV1TaskListStatusMetricsResponse(w http.ResponseWriter) error {
+func (response V1EventSchemaDelete403JSONResponse) VisitV1EventSchemaDeleteResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type V1TaskListStatusMetrics501JSONResponse APIErrors
+type V1EventSchemaDelete404JSONResponse APIErrors
 
-func (response V1TaskListStatusMetrics501JSONResponse) VisitV1TaskListStatusMetricsResponse(w http.ResponseWriter) error {
+func (response V1EventSchemaDelete404JSONResponse) VisitV1EventSchemaDeleteResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(501)
+	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type V1TaskGetPointMetricsRequestObject struct {
+type V1EventSchemaListRequestObject struct {
 	Tenant openapi_types.UUID `json:"tenant"`
-	Params V1TaskGetPointMetricsParams
+	Params V1EventSchemaListParams
 }
 
-type V1TaskGetPointMetricsResponseObject interface {
-	VisitV1TaskGetPointMetricsResponse(w http.ResponseWriter) error
+type V1EventSchemaListResponseObject interface {
+	VisitV1EventSchemaListResponse(w http.ResponseWriter) error
 }
 
-type V1TaskGetPointMetrics200JSONResponse V1TaskPointMetrics
+type V1EventSchemaList200JSONResponse V1EventSchemaList
 
-func (response V1TaskGetPointMetrics200JSONResponse) VisitV1TaskGetPointMetricsResponse(w http.ResponseWriter) error {
+func (response V1EventSchemaList200JSONResponse) VisitV1EventSchemaListResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type V1TaskGetPointMetrics400JSONResponse APIErrors
+type V1EventSchemaList400JSONResponse APIErrors
 
-func (response V1TaskGetPointMetrics400JSONResponse) VisitV1TaskGetPointMetricsResponse(w http.ResponseWriter) error {
+func (response V1EventSchemaList400JSONResponse) VisitV1EventSchemaListResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type V1TaskGetPointMetrics403JSONResponse APIErrors
+type V1EventSchemaList403JSONResponse APIErrors
 
-func (response V1TaskGetPointMetrics403JSONResponse) VisitV1TaskGetPointMetricsResponse(w http.ResponseWriter) error {
+func (response V1EventSchemaList403JSONResponse) VisitV1EventSchemaListResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type V1TaskGetPointMetrics501JSONResponse APIErrors
-
-func (response V1TaskGetPointMetrics501JSONResponse) VisitV1TaskGetPointMetricsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(501)
-
-	return json.NewEncoder(w).Encode(response)
-}
-
-type V1TaskCancelRequestObject struct {
+type V1EventSchemaCreateRequestObject struct {
 	Tenant openapi_types.UUID `json:"tenant"`
-	Body   *V1TaskCancelJSONRequestBody
+	Body   *V1EventSchemaCreateJSONRequestBody
 }
 
-type V1TaskCancelResponseObject interface {
-	VisitV1TaskCancelResponse(w http.ResponseWriter) error
+type V1EventSchemaCreateResponseObject interface {
+	VisitV1EventSchemaCreateResponse(w http.ResponseWriter) error
 }
 
-type V1TaskCancel200JSONResponse V1CancelledTasks
+type V1EventSchemaCreate200JSONResponse V1EventSchema
 
-func (response V1TaskCancel200JSONResponse) VisitV1TaskCancelResponse(w http.ResponseWriter) error {
+func (response V1EventSchemaCreate200JSONResponse) VisitV1EventSchemaCreateResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type V1TaskCancel400JSONResponse APIErrors
+type V1EventSchemaCreate400JSONResponse APIErrors
 
-func (response V1TaskCancel400JSONResponse) VisitV1TaskCancelResponse(w http.ResponseWriter) error {
+func (response V1EventSchemaCreate400JSONResponse) VisitV1EventSchemaCreateResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type V1TaskCancel403JSONResponse APIErrors
+type V1EventSchemaCreate403JSONResponse APIErrors
 
-func (response V1TaskCancel403JSONResponse) VisitV1TaskCancelResponse(w http.ResponseWriter) error {
+func (response V1EventSchemaCreate403JSONResponse) VisitV1EventSchemaCreateResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type V1TaskCancel404JSONResponse APIErrors
-
-func (response V1TaskCancel404JSONResponse) VisitV1TaskCancelResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+type V1EventListRequestObject struct {
+	Tenant openapi_types.UUID `json:"tenant"`
+	Params V1EventListParams
+}
 
-	return json.NewEncoder(w).Encode(response)
+type V1EventListResponseObject interface {
+	VisitV1EventListResponse(w http.ResponseWriter) error
 }
 
-type V1TaskCancel501JSONResponse APIErrors
+type V1EventList200JSONResponse V1EventList
 
-func (response V1TaskCancel501JSONResponse) VisitV1TaskCancelResponse(w http.ResponseWriter) error {
+func (response V1EventList200JSONResponse) VisitV1EventListResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(501)
+	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type V1TaskReplayRequestObject struct {
-	Tenant openapi_types.UUID `json:"tenant"`
-	Body   *V1TaskReplayJSONRequestBody
-}
-
-type V1TaskReplayResponseObject interface {
-	VisitV1TaskReplayResponse(w http.ResponseWriter) error
-}
-
-type V1TaskReplay200JSONResponse V1ReplayedTasks
+type V1EventList400JSONResponse APIErrors
 
-func (response V1TaskReplay200JSONResponse) VisitV1TaskReplayResponse(w http.ResponseWriter) error {
+func (response V1EventList400JSONResponse) VisitV1EventListResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type V1TaskReplay400JSONResponse APIErrors
+type V1EventList403JSONResponse APIErrors
 
-func (response V1TaskReplay400JSONResponse) VisitV1TaskReplayResponse(w http.ResponseWriter) error {
+func (response V1EventList403JSONResponse) VisitV1EventListResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type V1TaskReplay403JSONResponse APIErrors
+type V1EventKeyListRequestObject struct {
+	Tenant openapi_types.UUID `json:"tenant"`
+}
 
-func (response V1TaskReplay403JSONResponse) VisitV1TaskReplayResponse(w http.ResponseWriter) error {
+type V1EventKeyListResponseObject interface {
+	VisitV1EventKeyListResponse(w http.ResponseWriter) error
+}
+
+type V1EventKeyList200JSONResponse EventKeyList
+
+func (response V1EventKeyList200JSONResponse) VisitV1EventKeyListResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type V1TaskReplay404JSONResponse APIErrors
+type V1EventKeyList400JSONResponse APIErrors
 
-func (response V1TaskReplay404JSONResponse) VisitV1TaskReplayResponse(w http.ResponseWriter) error {
+func (response V1EventKeyList400JSONResponse) VisitV1EventKeyListResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type V1TaskReplay501JSONResponse APIErrors
+type V1EventKeyList403JSONResponse APIErrors
 
-func (response V1TaskReplay501JSONResponse) VisitV1TaskReplayResponse(w http.ResponseWriter) error {
+func (response V1EventKeyList403JSONResponse) VisitV1EventKeyListResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(501)
+	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type V1ObservabilityGetTraceRequestObject struct {
-	Tenant openapi_types.UUID `json:"tenant"`
-	Params V1ObservabilityGetTraceParams
+type V1EventGetRequestObject struct {
+	Tenant  openapi_types.UUID `json:"tenant"`
+	V1Event openapi_types.UUID `json:"v1-event"`
 }
 
-type V1ObservabilityGetTraceResponseObject interface {
-	VisitV1ObservabilityGetTraceResponse(w http.ResponseWriter) error
+type V1EventGetResponseObject interface {
+	VisitV1EventGetResponse(w http.ResponseWriter) error
 }
 
-type V1ObservabilityGetTrace200JSONResponse OtelSpanList
+type V1EventGet200JSONResponse V1Event
 
-func (response V1ObservabilityGetTrace200JSONResponse) VisitV1ObservabilityGetTraceResponse(w http.ResponseWriter) error {
+func (response V1EventGet200JSONResponse) VisitV1EventGetResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type V1ObservabilityGetTrace400JSONResponse APIErrors
+type V1EventGet400JSONResponse APIErrors
 
-func (response V1ObservabilityGetTrace400JSONResponse) VisitV1ObservabilityGetTraceResponse(w http.ResponseWriter) error {
+func (response V1EventGet400JSONResponse) VisitV1EventGetResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type V1ObservabilityGetTrace403JSONResponse APIErrors
+type V1EventGet403JSONResponse APIErrors
 
-func (response V1ObservabilityGetTrace403JSONResponse) VisitV1ObservabilityGetTraceResponse(w http.ResponseWriter) error {
+func (response V1EventGet403JSONResponse) VisitV1EventGetResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type V1ObservabilityGetTrace404JSONResponse APIErrors
-
-func (response V1ObservabilityGetTrace404JSONResponse) VisitV1ObservabilityGetTraceResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
-
-	return json.NewEncoder(w).Encode(response)
-}
-
-type V1WebhookListRequestObject struct {
+type V1FilterListRequestObject struct {
 	Tenant openapi_types.UUID `json:"tenant"`
-	Params V1WebhookListParams
+	Params V1FilterListParams
 }
 
-type V1WebhookListResponseObject interface {
-	VisitV1WebhookListResponse(w http.ResponseWriter) error
+type V1FilterListResponseObject interface {
+	VisitV1FilterListResponse(w http.ResponseWriter) error
 }
 
-type V1WebhookList200JSONResponse V1WebhookList
+type V1FilterList200JSONResponse V1FilterList
 
-func (response V1WebhookList200JSONResponse) VisitV1WebhookListResponse(w http.ResponseWriter) error {
+func (response V1FilterList200JSONResponse) VisitV1FilterListResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type V1WebhookList400JSONResponse APIErrors
+type V1FilterList400JSONResponse APIErrors
 
-func (response V1WebhookList400JSONResponse) VisitV1WebhookListResponse(w http.ResponseWriter) error {
+func (response V1FilterList400JSONResponse) VisitV1FilterListResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type V1WebhookList403JSONResponse APIErrors
+type V1FilterList403JSONResponse APIErrors
 
-func (response V1WebhookList403JSONResponse) VisitV1WebhookListResponse(w http.ResponseWriter) error {
+func (response V1FilterList403JSONResponse) VisitV1FilterListResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type V1WebhookCreateRequestObject struct {
+type V1FilterCreateRequestObject struct {
 	Tenant openapi_types.UUID `json:"tenant"`
-	Body   *V1WebhookCreateJSONRequestBody
+	Body   *V1FilterCreateJSONRequestBody
 }
 
-type V1WebhookCreateResponseObject interface {
-	VisitV1WebhookCreateResponse(w http.ResponseWriter) error
+type V1FilterCreateResponseObject interface {
+	VisitV1FilterCreateResponse(w http.ResponseWriter) error
 }
 
-type V1WebhookCreate200JSONResponse V1Webhook
+type V1FilterCreate200JSONResponse V1Filter
 
-func (response V1WebhookCreate200JSONResponse) VisitV1WebhookCreateResponse(w http.ResponseWriter) error {
+func (response V1FilterCreate200JSONResponse) VisitV1FilterCreateResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type V1WebhookCreate400JSONResponse APIErrors
+type V1FilterCreate400JSONResponse APIErrors
 
-func (response V1WebhookCreate400JSONResponse) VisitV1WebhookCreateResponse(w http.ResponseWriter) error {
+func (response V1FilterCreate400JSONResponse) VisitV1FilterCreateResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type V1WebhookCreate403JSONResponse APIErrors
+type V1FilterCreate403JSONResponse APIErrors
 
-func (response V1WebhookCreate403JSONResponse) VisitV1WebhookCreateResponse(w http.ResponseWriter) error {
+func (response V1FilterCreate403JSONResponse) VisitV1FilterCreateResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type V1WebhookCreate404JSONResponse APIErrors
+type V1FilterCreate404JSONResponse APIErrors
 
-func (response V1WebhookCreate404JSONResponse) VisitV1WebhookCreateResponse(w http.ResponseWriter) error {
+func (response V1FilterCreate404JSONResponse) VisitV1FilterCreateResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type V1WebhookDeleteRequestObject struct {
-	Tenant    openapi_types.UUID `json:"tenant"`
-	V1Webhook string             `json:"v1-webhook"`
+type V1FilterDeleteRequestObject struct {
+	Tenant   openapi_types.UUID `json:"tenant"`
+	V1Filter openapi_types.UUID `json:"v1-filter"`
 }
 
-type V1WebhookDeleteResponseObject interface {
-	VisitV1WebhookDeleteResponse(w http.ResponseWriter) error
+type V1FilterDeleteResponseObject interface {
+	VisitV1FilterDeleteResponse(w http.ResponseWriter) error
 }
 
-type V1WebhookDelete200JSONResponse V1Webhook
+type V1FilterDelete200JSONResponse V1Filter
 
-func (response V1WebhookDelete200JSONResponse) VisitV1WebhookDeleteResponse(w http.ResponseWriter) error {
+func (response V1FilterDelete200JSONResponse) VisitV1FilterDeleteResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type V1WebhookDelete400JSONResponse APIErrors
+type V1FilterDelete400JSONResponse APIErrors
 
-func (response V1WebhookDelete400JSONResponse) VisitV1WebhookDeleteResponse(w http.ResponseWriter) error {
+func (response V1FilterDelete400JSONResponse) VisitV1FilterDeleteResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type V1WebhookDelete403JSONResponse APIErrors
+type V1FilterDelete403JSONResponse APIErrors
 
-func (response V1WebhookDelete403JSONResponse) VisitV1WebhookDeleteResponse(w http.ResponseWriter) error {
+func (response V1FilterDelete403JSONResponse) VisitV1FilterDeleteResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type V1WebhookDelete404JSONResponse APIErrors
+type V1FilterDelete404JSONResponse APIErrors
 
-func (response V1WebhookDelete404JSONResponse) VisitV1WebhookDeleteResponse(w http.ResponseWriter) error {
+func (response V1FilterDelete404JSONResponse) VisitV1FilterDeleteResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type V1WebhookGetRequestObject struct {
-	Tenant    openapi_types.UUID `json:"tenant"`
-	V1Webhook string             `json:"v1-webhook"`
+type V1FilterGetRequestObject struct {
+	Tenant   openapi_types.UUID `json:"tenant"`
+	V1Filter openapi_types.UUID `json:"v1-filter"`
 }
 
-type V1WebhookGetResponseObject interface {
-	VisitV1WebhookGetResponse(w http.ResponseWriter) error
+type V1FilterGetResponseObject interface {
+	VisitV1FilterGetResponse(w http.ResponseWriter) error
 }
 
-type V1WebhookGet200JSONResponse V1Webhook
+type V1FilterGet200JSONResponse V1Filter
 
-func (response V1WebhookGet200JSONResponse) VisitV1WebhookGetResponse(w http.ResponseWriter) error {
+func (response V1FilterGet200JSONResponse) VisitV1FilterGetResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type V1WebhookGet400JSONResponse APIErrors
+type V1FilterGet400JSONResponse APIErrors
 
-func (response V1WebhookGet400JSONResponse) VisitV1WebhookGetResponse(w http.ResponseWriter) error {
+func (response V1FilterGet400JSONResponse) VisitV1FilterGetResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type V1WebhookGet403JSONResponse APIErrors
+type V1FilterGet403JSONResponse APIErrors
 
-func (response V1WebhookGet403JSONResponse) VisitV1WebhookGetResponse(w http.ResponseWriter) error {
+func (response V1FilterGet403JSONResponse) VisitV1FilterGetResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type V1WebhookUpdateRequestObject struct {
-	Tenant    openapi_types.UUID `json:"tenant"`
-	V1Webhook string             `json:"v1-webhook"`
-	Body      *V1WebhookUpdateJSONRequestBody
+type V1FilterUpdateRequestObject struct {
+	Tenant   openapi_types.UUID `json:"tenant"`
+	V1Filter openapi_types.UUID `json:"v1-filter"`
+	Body     *V1FilterUpdateJSONRequestBody
 }
 
-type V1WebhookUpdateResponseObject interface {
-	VisitV1WebhookUpdateResponse(w http.ResponseWriter) error
+type V1FilterUpdateResponseObject interface {
+	VisitV1FilterUpdateResponse(w http.ResponseWriter) error
 }
 
-type V1WebhookUpdate200JSONResponse V1Webhook
+type V1FilterUpdate200JSONResponse V1Filter
 
-func (response V1WebhookUpdate200JSONResponse) VisitV1WebhookUpdateResponse(w http.ResponseWriter) error {
+func (response V1FilterUpdate200JSONResponse) VisitV1FilterUpdateResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type V1WebhookUpdate400JSONResponse APIErrors
+type V1FilterUpdate400JSONResponse APIErrors
 
-func (response V1WebhookUpdate400JSONResponse) VisitV1WebhookUpdateResponse(w http.ResponseWriter) error {
+func (response V1FilterUpdate400JSONResponse) VisitV1FilterUpdateResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type V1WebhookUpdate403JSONResponse APIErrors
+type V1FilterUpdate403JSONResponse APIErrors
 
-func (response V1WebhookUpdate403JSONResponse) VisitV1WebhookUpdateResponse(w http.ResponseWriter) error {
+func (response V1FilterUpdate403JSONResponse) VisitV1FilterUpdateResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type V1WebhookUpdate404JSONResponse APIErrors
+type V1FilterUpdate404JSONResponse APIErrors
 
-func (response V1WebhookUpdate404JSONResponse) VisitV1WebhookUpdateResponse(w http.ResponseWriter) error {
+func (response V1FilterUpdate404JSONResponse) VisitV1FilterUpdateResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type V1WebhookReceiveRequestObject struct {
-	Tenant    openapi_types.UUID `json:"tenant"`
-	V1Webhook string             `json:"v1-webhook"`
+type V1TenantLogLineGetPointMetricsRequestObject struct {
+	Tenant openapi_types.UUID `json:"tenant"`
+	Params V1TenantLogLineGetPointMetricsParams
 }
 
-type V1WebhookReceiveResponseObject interface {
-	VisitV1WebhookReceiveResponse(w http.ResponseWriter) error
+type V1TenantLogLineGetPointMetricsResponseObject interface {
+	VisitV1TenantLogLineGetPointMetricsResponse(w http.ResponseWriter) error
 }
 
-type V1WebhookReceive200JSONResponse V1WebhookResponse
+type V1TenantLogLineGetPointMetrics200JSONResponse V1LogsPointMetrics
 
-func (response V1WebhookReceive200JSONResponse) VisitV1WebhookReceiveResponse(w http.ResponseWriter) error {
+func (response V1TenantLogLineGetPointMetrics200JSONResponse) VisitV1TenantLogLineGetPointMetricsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type V1WebhookReceive204Response struct {
-}
-
-func (response V1WebhookReceive204Response) VisitV1WebhookReceiveResponse(w http.ResponseWriter) error {
-	w.WriteHeader(204)
-	return nil
-}
-
-type V1WebhookReceive400JSONResponse APIErrors
+type V1TenantLogLineGetPointMetrics400JSONResponse APIErrors
 
-func (response V1WebhookReceive400JSONResponse) VisitV1WebhookReceiveResponse(w http.ResponseWriter) error {
+func (response V1TenantLogLineGetPointMetrics400JSONResponse) VisitV1TenantLogLineGetPointMetricsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type V1WebhookReceive403JSONResponse APIErrors
+type V1TenantLogLineGetPointMetrics403JSONResponse APIErrors
 
-func (response V1WebhookReceive403JSONResponse) VisitV1WebhookReceiveResponse(w http.ResponseWriter) error {
+func (response V1TenantLogLineGetPointMetrics403JSONResponse) VisitV1TenantLogLineGetPointMetricsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type V1WebhookReceive429JSONResponse APIErrors
+type V1TenantLogLineGetPointMetrics501JSONResponse APIErrors
 
-func (response V1WebhookReceive429JSONResponse) VisitV1WebhookReceiveResponse(w http.ResponseWriter) error {
+func (response V1TenantLogLineGetPointMetrics501JSONResponse) VisitV1TenantLogLineGetPointMetricsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(429)
+	w.WriteHeader(501)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type V1WorkflowRunListRequestObject struct {
+type V1TenantLogLineListRequestObject struct {
 	Tenant openapi_types.UUID `json:"tenant"`
-	Params V1WorkflowRunListParams
+	Params V1TenantLogLineListParams
 }
 
-type V1WorkflowRunListResponseObject interface {
-	VisitV1WorkflowRunListResponse(w http.ResponseWriter) error
+type V1TenantLogLineListResponseObject interface {
+	VisitV1TenantLogLineListResponse(w http.ResponseWriter) error
 }
 
-type V1WorkflowRunList200JSONResponse V1TaskSummaryList
+type V1TenantLogLineList200JSONResponse V1LogLineList
 
-func (response V1WorkflowRunList200JSONResponse) VisitV1WorkflowRunListResponse(w http.ResponseWriter) error {
+func (response V1TenantLogLineList200JSONResponse) VisitV1TenantLogLineListResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type V1WorkflowRunList400JSONResponse APIErrors
+type V1TenantLogLineList400JSONResponse APIErrors
 
-func (response V1WorkflowRunList400JSONResponse) VisitV1WorkflowRunListResponse(w http.ResponseWriter) error {
+func (response V1TenantLogLineList400JSONResponse) VisitV1TenantLogLineListResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type V1WorkflowRunList403JSONResponse APIErrors
+type V1TenantLogLineList403JSONResponse APIErrors
 
-func (response V1WorkflowRunList403JSONResponse) VisitV1WorkflowRunListResponse(w http.ResponseWriter) error {
+func (response V1TenantLogLineList403JSONResponse) VisitV1TenantLogLineListResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type V1WorkflowRunList501JSONResponse APIErrors
+type V1TenantLogLineTailRequestObject struct {
+	Tenant openapi_types.UUID `json:"tenant"`
+	Params V1TenantLogLineTailParams
+}
 
-func (response V1WorkflowRunList501JSONResponse) VisitV1WorkflowRunListResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(501)
+type V1TenantLogLineTailResponseObject interface {
+	VisitV1TenantLogLineTailResponse(w http.ResponseWriter) error
+}
 
-	return json.NewEncoder(w).Encode(response)
+type V1TenantLogLineTail200TexteventStreamResponse struct {
+	Body          io.Reader
+	ContentLength int64
 }
 
-type V1WorkflowRunDisplayNamesListRequestObject struct {
-	Tenant openapi_types.UUID `json:"tenant"`
-	Params V1WorkflowRunDisplayNamesListParams
+func (response V1TenantLogLineTail200TexteventStreamResponse) VisitV1TenantLogLineTailResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "text/event-stream")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
 }
 
-type V1WorkflowRunDisplayNamesListResponseObject interface {
-	VisitV1WorkflowRunDisplayNamesListResponse(w http.ResponseWriter) error
+type V1TenantLogLineTail400JSONResponse APIErrors
+
+func (response V1TenantLogLineTail400JSONResponse) VisitV1TenantLogLineTailResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type V1WorkflowRunDisplayNamesList200JSONResponse V1WorkflowRunDisplayNameList
+type V1TenantLogLineTail403JSONResponse APIErrors
 
-func (response V1WorkflowRunDisplayNamesList200JSONResponse) VisitV1WorkflowRunDisplayNamesListResponse(w http.ResponseWriter) error {
+func (response V1TenantLogLineTail403JSONResponse) VisitV1TenantLogLineTailResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type V1WorkflowRunDisplayNamesList400JSONResponse APIErrors
+type V1HttpOperatorListRequestObject struct {
+	Tenant openapi_types.UUID `json:"tenant"`
+	Params V1HttpOperatorListParams
+}
 
-func (response V1WorkflowRunDisplayNamesList400JSONResponse) VisitV1WorkflowRunDisplayNamesListResponse(w http.ResponseWriter) error {
+type V1HttpOperatorListResponseObject interface {
+	VisitV1HttpOperatorListResponse(w http.ResponseWriter) error
+}
+
+type V1HttpOperatorList200JSONResponse V1HTTPOperatorList
+
+func (response V1HttpOperatorList200JSONResponse) VisitV1HttpOperatorListResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type V1WorkflowRunDisplayNamesList403JSONResponse APIErrors
+type V1HttpOperatorList400JSONResponse APIErrors
 
-func (response V1WorkflowRunDisplayNamesList403JSONResponse) VisitV1WorkflowRunDisplayNamesListResponse(w http.ResponseWriter) error {
+func (response V1HttpOperatorList400JSONResponse) VisitV1HttpOperatorListResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type V1WorkflowRunDisplayNamesList501JSONResponse APIErrors
+type V1HttpOperatorList403JSONResponse APIErrors
 
-func (response V1WorkflowRunDisplayNamesList501JSONResponse) VisitV1WorkflowRunDisplayNamesListResponse(w http.ResponseWriter) error {
+func (response V1HttpOperatorList403JSONResponse) VisitV1HttpOperatorListResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(501)
+	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type V1WorkflowRunExternalIdsListRequestObject struct {
+type V1HttpOperatorCreateRequestObject struct {
 	Tenant openapi_types.UUID `json:"tenant"`
-	Params V1WorkflowRunExternalIdsListParams
+	Body   *V1HttpOperatorCreateJSONRequestBody
 }
 
-type V1WorkflowRunExternalIdsListResponseObject interface {
-	VisitV1WorkflowRunExternalIdsListResponse(w http.ResponseWriter) error
+type V1HttpOperatorCreateResponseObject interface {
+	VisitV1HttpOperatorCreateResponse(w http.ResponseWriter) error
 }
 
-type V1WorkflowRunExternalIdsList200JSONResponse V1WorkflowRunExternalIdList
+type V1HttpOperatorCreate200JSONResponse V1HTTPOperator
 
-func (response V1WorkflowRunExternalIdsList200JSONResponse) VisitV1WorkflowRunExternalIdsListResponse(w http.ResponseWriter) error {
+func (response V1HttpOperatorCreate200JSONResponse) VisitV1HttpOperatorCreateResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type V1WorkflowRunExternalIdsList400JSONResponse APIErrors
+type V1HttpOperatorCreate400JSONResponse APIErrors
 
-func (response V1WorkflowRunExternalIdsList400JSONResponse) VisitV1WorkflowRunExternalIdsListResponse(w http.ResponseWriter) error {
+func (response V1HttpOperatorCreate400JSONResponse) VisitV1HttpOperatorCreateResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type V1WorkflowRunExternalIdsList403JSONResponse APIErrors
+type V1HttpOperatorCreate403JSONResponse APIErrors
 
-func (response V1WorkflowRunExternalIdsList403JSONResponse) VisitV1WorkflowRunExternalIdsListResponse(w http.ResponseWriter) error {
+func (response V1HttpOperatorCreate403JSONResponse) VisitV1HttpOperatorCreateResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type V1WorkflowRunExternalIdsList501JSONResponse APIErrors
+type V1HttpOperatorCreate404JSONResponse APIErrors
 
-func (response V1WorkflowRunExternalIdsList501JSONResponse) VisitV1WorkflowRunExternalIdsListResponse(w http.ResponseWriter) error {
+func (response V1HttpOperatorCreate404JSONResponse) VisitV1HttpOperatorCreateResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(501)
+	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type V1WorkflowRunCreateRequestObject struct {
+type V1TaskGetSlotUsageRequestObject struct {
 	Tenant openapi_types.UUID `json:"tenant"`
-	Body   *V1WorkflowRunCreateJSONRequestBody
+	Params V1TaskGetSlotUsageParams
 }
 
-type V1WorkflowRunCreateResponseObject interface {
-	VisitV1WorkflowRunCreateResponse(w http.ResponseWriter) error
+type V1TaskGetSlotUsageResponseObject interface {
+	VisitV1TaskGetSlotUsageResponse(w http.ResponseWriter) error
 }
 
-type V1WorkflowRunCreate200JSONResponse V1WorkflowRunDetails
+type V1TaskGetSlotUsage200JSONResponse V1SlotUsageList
 
-func (response V1WorkflowRunCreate200JSONResponse) VisitV1WorkflowRunCreateResponse(w http.ResponseWriter) error {
+func (response V1TaskGetSlotUsage200JSONResponse) VisitV1TaskGetSlotUsageResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type V1WorkflowRunCreate400JSONResponse APIErrors
+type V1TaskGetSlotUsage400JSONResponse APIErrors
 
-func (response V1WorkflowRunCreate400JSONResponse) VisitV1WorkflowRunCreateResponse(w http.ResponseWriter) error {
+func (response V1TaskGetSlotUsage400JSONResponse) VisitV1TaskGetSlotUsageResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type V1WorkflowRunCreate403JSONResponse APIErrors
+type V1TaskGetSlotUsage403JSONResponse APIErrors
 
-func (response V1WorkflowRunCreate403JSONResponse) VisitV1WorkflowRunCreateResponse(w http.ResponseWriter) error {
+func (response V1TaskGetSlotUsage403JSONResponse) VisitV1TaskGetSlotUsageResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type V1WorkflowRunGetRequestObject struct {
-	V1WorkflowRun openapi_types.UUID `json:"v1-workflow-run"`
+type V1TaskListStatusMetricsRequestObject struct {
+	Tenant openapi_types.UUID `json:"tenant"`
+	Params V1TaskListStatusMetricsParams
 }
 
-type V1WorkflowRunGetResponseObject interface {
-	VisitV1WorkflowRunGetResponse(w http.ResponseWriter) error
+type V1TaskListStatusMetricsResponseObject interface {
+	VisitV1TaskListStatusMetricsResponse(w http.ResponseWriter) error
 }
 
-type V1WorkflowRunGet200JSONResponse V1WorkflowRunDetails
+type V1TaskListStatusMetrics200JSONResponse V1TaskRunMetrics
 
-func (response V1WorkflowRunGet200JSONResponse) VisitV1WorkflowRunGetResponse(w http.ResponseWriter) error {
+func (response V1TaskListStatusMetrics200JSONResponse) VisitV1TaskListStatusMetricsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type V1WorkflowRunGet400JSONResponse APIErrors
+type V1TaskListStatusMetrics400JSONResponse APIErrors
 
-func (response V1WorkflowRunGet400JSONResponse) VisitV1WorkflowRunGetResponse(w http.ResponseWriter) error {
+func (response V1TaskListStatusMetrics400JSONResponse) VisitV1TaskListStatusMetricsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type V1WorkflowRunGet403JSONResponse APIErrors
+type V1TaskListStatusMetrics403JSONResponse APIErrors
 
-func (response V1WorkflowRunGet403JSONResponse) VisitV1WorkflowRunGetResponse(w http.ResponseWriter) error {
+func (response V1TaskListStatusMetrics403JSONResponse) VisitV1TaskListStatusMetricsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type V1WorkflowRunGet501JSONResponse APIErrors
+type V1TaskListStatusMetrics501JSONResponse APIErrors
 
-func (response V1WorkflowRunGet501JSONResponse) VisitV1WorkflowRunGetResponse(w http.ResponseWriter) error {
+func (response V1TaskListStatusMetrics501JSONResponse) VisitV1TaskListStatusMetricsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(501)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type V1WorkflowRunGetStatusRequestObject struct {
-	V1WorkflowRun openapi_types.UUID `json:"v1-workflow-run"`
+type V1TaskGetPointMetricsRequestObject struct {
+	Tenant openapi_types.UUID `json:"tenant"`
+	Params V1TaskGetPointMetricsParams
 }
 
-type V1WorkflowRunGetStatusResponseObject interface {
-	VisitV1WorkflowRunGetStatusResponse(w http.ResponseWriter) error
+type V1TaskGetPointMetricsResponseObject interface {
+	VisitV1TaskGetPointMetricsResponse(w http.ResponseWriter) error
 }
 
-type V1WorkflowRunGetStatus200JSONResponse V1TaskStatus
+type V1TaskGetPointMetrics200JSONResponse V1TaskPointMetrics
 
-func (response V1WorkflowRunGetStatus200JSONResponse) VisitV1WorkflowRunGetStatusResponse(w http.ResponseWriter) error {
+func (response V1TaskGetPointMetrics200JSONResponse) VisitV1TaskGetPointMetricsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type V1WorkflowRunGetStatus400JSONResponse APIErrors
+type V1TaskGetPointMetrics400JSONResponse APIErrors
 
-func (response V1WorkflowRunGetStatus400JSONResponse) VisitV1WorkflowRunGetStatusResponse(w http.ResponseWriter) error {
+func (response V1TaskGetPointMetrics400JSONResponse) VisitV1TaskGetPointMetricsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type V1WorkflowRunGetStatus403JSONResponse APIErrors
+type V1TaskGetPointMetrics403JSONResponse APIErrors
 
-func (response V1WorkflowRunGetStatus403JSONResponse) VisitV1WorkflowRunGetStatusResponse(w http.ResponseWriter) error {
+func (response V1TaskGetPointMetrics403JSONResponse) VisitV1TaskGetPointMetricsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type V1WorkflowRunGetStatus404JSONResponse APIErrors
-
-func (response V1WorkflowRunGetStatus404JSONResponse) VisitV1WorkflowRunGetStatusResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
-
-	return json.NewEncoder(w).Encode(response)
-}
-
-type V1WorkflowRunGetStatus501JSONResponse APIErrors
+type V1TaskGetPointMetrics501JSONResponse APIErrors
 
-func (response V1WorkflowRunGetStatus501JSONResponse) VisitV1WorkflowRunGetStatusResponse(w http.ResponseWriter) error {
+func (response V1TaskGetPointMetrics501JSONResponse) VisitV1TaskGetPointMetricsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(501)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type V1WorkflowRunTaskEventsListRequestObject struct {
-	V1WorkflowRun openapi_types.UUID `json:"v1-workflow-run"`
-	Params        V1WorkflowRunTaskEventsListParams
+type V1TaskCancelRequestObject struct {
+	Tenant openapi_types.UUID `json:"tenant"`
+	Body   *V1TaskCancelJSONRequestBody
 }
 
-type V1WorkflowRunTaskEventsListResponseObject interface {
-	VisitV1WorkflowRunTaskEventsListResponse(w http.ResponseWriter) error
+type V1TaskCancelResponseObject interface {
+	VisitV1TaskCancelResponse(w http.ResponseWriter) error
 }
 
-type V1WorkflowRunTaskEventsList200JSONResponse V1TaskEventList
+type V1TaskCancel200JSONResponse V1CancelledTasks
 
-func (response V1WorkflowRunTaskEventsList200JSONResponse) VisitV1WorkflowRunTaskEventsListResponse(w http.ResponseWriter) error {
+func (response V1TaskCancel200JSONResponse) VisitV1TaskCancelResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type V1WorkflowRunTaskEventsList400JSONResponse APIErrors
+type V1TaskCancel400JSONResponse APIErrors
 
-func (response V1WorkflowRunTaskEventsList400JSONResponse) VisitV1WorkflowRunTaskEventsListResponse(w http.ResponseWriter) error {
+func (response V1TaskCancel400JSONResponse) VisitV1TaskCancelResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type V1WorkflowRunTaskEventsList403JSONResponse APIErrors
+type V1TaskCancel403JSONResponse APIErrors
 
-func (response V1WorkflowRunTaskEventsList403JSONResponse) VisitV1WorkflowRunTaskEventsListResponse(w http.ResponseWriter) error {
+func (response V1TaskCancel403JSONResponse) VisitV1TaskCancelResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type V1WorkflowRunTaskEventsList501JSONResponse APIErrors
+type V1TaskCancel404JSONResponse APIErrors
 
-func (response V1WorkflowRunTaskEventsList501JSONResponse) VisitV1WorkflowRunTaskEventsListResponse(w http.ResponseWriter) error {
+func (response V1TaskCancel404JSONResponse) VisitV1TaskCancelResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(501)
+	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type V1WorkflowRunGetTimingsRequestObject struct {
-	V1WorkflowRun openapi_types.UUID `json:"v1-workflow-run"`
-	Params        V1WorkflowRunGetTimingsParams
+type V1TaskCancel501JSONResponse APIErrors
+
+func (response V1TaskCancel501JSONResponse) VisitV1TaskCancelResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(501)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type V1WorkflowRunGetTimingsResponseObject interface {
-	VisitV1WorkflowRunGetTimingsResponse(w http.ResponseWriter) error
+type V1TaskReplayRequestObject struct {
+	Tenant openapi_types.UUID `json:"tenant"`
+	Body   *V1TaskReplayJSONRequestBody
 }
 
-type V1WorkflowRunGetTimings200JSONResponse V1TaskTimingList
+type V1TaskReplayResponseObject interface {
+	VisitV1TaskReplayResponse(w http.ResponseWriter) error
+}
 
-func (response V1WorkflowRunGetTimings200JSONResponse) VisitV1WorkflowRunGetTimingsResponse(w http.ResponseWriter) error {
+type V1TaskReplay200JSONResponse V1ReplayedTasks
+
+func (response V1TaskReplay200JSONResponse) VisitV1TaskReplayResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type V1WorkflowRunGetTimings400JSONResponse APIErrors
+type V1TaskReplay400JSONResponse APIErrors
 
-func (response V1WorkflowRunGetTimings400JSONResponse) VisitV1WorkflowRunGetTimingsResponse(w http.ResponseWriter) error {
+func (response V1TaskReplay400JSONResponse) VisitV1TaskReplayResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type V1WorkflowRunGetTimings403JSONResponse APIErrors
+type V1TaskReplay403JSONResponse APIErrors
 
-func (response V1WorkflowRunGetTimings403JSONResponse) VisitV1WorkflowRunGetTimingsResponse(w http.ResponseWriter) error {
+func (response V1TaskReplay403JSONResponse) VisitV1TaskReplayResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type V1WorkflowRunGetTimings501JSONResponse APIErrors
+type V1TaskReplay404JSONResponse APIErrors
 
-func (response V1WorkflowRunGetTimings501JSONResponse) VisitV1WorkflowRunGetTimingsResponse(w http.ResponseWriter) error {
+func (response V1TaskReplay404JSONResponse) VisitV1TaskReplayResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type V1TaskReplay501JSONResponse APIErrors
+
+func (response V1TaskReplay501JSONResponse) VisitV1TaskReplayResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(501)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type StepRunListArchivesRequestObject struct {
-	StepRun openapi_types.UUID `json:"step-run"`
-	Params  StepRunListArchivesParams
+type V1ObservabilityGetTraceRequestObject struct {
+	Tenant openapi_types.UUID `json:"tenant"`
+	Params V1ObservabilityGetTraceParams
 }
 
-type StepRunListArchivesResponseObject interface {
-	VisitStepRunListArchivesResponse(w http.ResponseWriter) error
+type V1ObservabilityGetTraceResponseObject interface {
+	VisitV1ObservabilityGetTraceResponse(w http.ResponseWriter) error
 }
 
-type StepRunListArchives200JSONResponse StepRunArchiveList
+type V1ObservabilityGetTrace200JSONResponse OtelSpanList
 
-func (response StepRunListArchives200JSONResponse) VisitStepRunListArchivesResponse(w http.ResponseWriter) error {
+func (response V1ObservabilityGetTrace200JSONResponse) VisitV1ObservabilityGetTraceResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type StepRunListArchives400JSONResponse APIErrors
+type V1ObservabilityGetTrace400JSONResponse APIErrors
 
-func (response StepRunListArchives400JSONResponse) VisitStepRunListArchivesResponse(w http.ResponseWriter) error {
+func (response V1ObservabilityGetTrace400JSONResponse) VisitV1ObservabilityGetTraceResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type StepRunListArchives403JSONResponse APIErrors
+type V1ObservabilityGetTrace403JSONResponse APIErrors
 
-func (response StepRunListArchives403JSONResponse) VisitStepRunListArchivesResponse(w http.ResponseWriter) error {
+func (response V1ObservabilityGetTrace403JSONResponse) VisitV1ObservabilityGetTraceResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type StepRunListArchives404JSONResponse APIErrors
+type V1ObservabilityGetTrace404JSONResponse APIErrors
 
-func (response StepRunListArchives404JSONResponse) VisitStepRunListArchivesResponse(w http.ResponseWriter) error {
+func (response V1ObservabilityGetTrace404JSONResponse) VisitV1ObservabilityGetTraceResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type StepRunListEventsRequestObject struct {
-	StepRun openapi_types.UUID `json:"step-run"`
-	Params  StepRunListEventsParams
+type V1WebhookListRequestObject struct {
+	Tenant openapi_types.UUID `json:"tenant"`
+	Params V1WebhookListParams
 }
 
-type StepRunListEventsResponseObject interface {
-	VisitStepRunListEventsResponse(w http.ResponseWriter) error
+type V1WebhookListResponseObject interface {
+	VisitV1WebhookListResponse(w http.ResponseWriter) error
 }
 
-type StepRunListEvents200JSONResponse StepRunEventList
+type V1WebhookList200JSONResponse V1WebhookList
 
-func (response StepRunListEvents200JSONResponse) VisitStepRunListEventsResponse(w http.ResponseWriter) error {
+func (response V1WebhookList200JSONResponse) VisitV1WebhookListResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type StepRunListEvents400JSONResponse APIErrors
+type V1WebhookList400JSONResponse APIErrors
 
-func (response StepRunListEvents400JSONResponse) VisitStepRunListEventsResponse(w http.ResponseWriter) error {
+func (response V1WebhookList400JSONResponse) VisitV1WebhookListResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type StepRunListEvents403JSONResponse APIErrors
+type V1WebhookList403JSONResponse APIErrors
 
-func (response StepRunListEvents403JSONResponse) VisitStepRunListEventsResponse(w http.ResponseWriter) error {
+func (response V1WebhookList403JSONResponse) VisitV1WebhookListResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type StepRunListEvents404JSONResponse APIErrors
-
-func (response StepRunListEvents404JSONResponse) VisitStepRunListEventsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
-
-	return json.NewEncoder(w).Encode(response)
-}
-
-type TenantCreateRequestObject struct {
-	Body *TenantCreateJSONRequestBody
+type V1WebhookCreateRequestObject struct {
+	Tenant openapi_types.UUID `json:"tenant"`
+	Body   *V1WebhookCreateJSONRequestBody
 }
 
-type TenantCreateResponseObject interface {
-	VisitTenantCreateResponse(w http.ResponseWriter) error
+type V1WebhookCreateResponseObject interface {
+	VisitV1WebhookCreateResponse(w http.ResponseWriter) error
 }
 
-type TenantCreate200JSONResponse Tenant
+type V1WebhookCreate200JSONResponse V1Webhook
 
-func (response TenantCreate200JSONResponse) VisitTenantCreateResponse(w http.ResponseWriter) error {
+func (response V1WebhookCreate200JSONResponse) VisitV1WebhookCreateResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type TenantCreate400JSONResponse APIErrors
+type V1WebhookCreate400JSONResponse APIErrors
 
-func (response TenantCreate400JSONResponse) VisitTenantCreateResponse(w http.ResponseWriter) error {
+func (response V1WebhookCreate400JSONResponse) VisitV1WebhookCreateResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type TenantCreate403JSONResponse APIError
+type V1WebhookCreate403JSONResponse APIErrors
 
-func (response TenantCreate403JSONResponse) VisitTenantCreateResponse(w http.ResponseWriter) error {
+func (response V1WebhookCreate403JSONResponse) VisitV1WebhookCreateResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type TenantGetRequestObject struct {
-	Tenant openapi_types.UUID `json:"tenant"`
+type V1WebhookCreate404JSONResponse APIErrors
+
+func (response V1WebhookCreate404JSONResponse) VisitV1WebhookCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type TenantGetResponseObject interface {
-	VisitTenantGetResponse(w http.ResponseWriter) error
+type V1WebhookDeleteRequestObject struct {
+	Tenant    openapi_types.UUID `json:"tenant"`
+	V1Webhook string             `json:"v1-webhook"`
 }
 
-type TenantGet200JSONResponse Tenant
+type V1WebhookDeleteResponseObject interface {
+	VisitV1WebhookDeleteResponse(w http.ResponseWriter) error
+}
 
-func (response TenantGet200JSONResponse) VisitTenantGetResponse(w http.ResponseWriter) error {
+type V1WebhookDelete200JSONResponse V1Webhook
+
+func (response V1WebhookDelete200JSONResponse) VisitV1WebhookDeleteResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type TenantGet400JSONResponse APIErrors
+type V1WebhookDelete400JSONResponse APIErrors
 
-func (response TenantGet400JSONResponse) VisitTenantGetResponse(w http.ResponseWriter) error {
+func (response V1WebhookDelete400JSONResponse) VisitV1WebhookDeleteResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type TenantGet403JSONResponse APIError
+type V1WebhookDelete403JSONResponse APIErrors
 
-func (response TenantGet403JSONResponse) VisitTenantGetResponse(w http.ResponseWriter) error {
+func (response V1WebhookDelete403JSONResponse) VisitV1WebhookDeleteResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type TenantGet404JSONResponse APIErrors
+type V1WebhookDelete404JSONResponse APIErrors
 
-func (response TenantGet404JSONResponse) VisitTenantGetResponse(w http.ResponseWriter) error {
+func (response V1WebhookDelete404JSONResponse) VisitV1WebhookDeleteResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type TenantUpdateRequestObject struct {
-	Tenant openapi_types.UUID `json:"tenant"`
-	Body   *TenantUpdateJSONRequestBody
+type V1WebhookGetRequestObject struct {
+	Tenant    openapi_types.UUID `json:"tenant"`
+	V1Webhook string             `json:"v1-webhook"`
 }
 
-type TenantUpdateResponseObject interface {
-	VisitTenantUpdateResponse(w http.ResponseWriter) error
+type V1WebhookGetResponseObject interface {
+	VisitV1WebhookGetResponse(w http.ResponseWriter) error
 }
 
-type TenantUpdate200JSONResponse Tenant
+type V1WebhookGet200JSONResponse V1Webhook
 
-func (response TenantUpdate200JSONResponse) VisitTenantUpdateResponse(w http.ResponseWriter) error {
+func (response V1WebhookGet200JSONResponse) VisitV1WebhookGetResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type TenantUpdate400JSONResponse APIErrors
+type V1WebhookGet400JSONResponse APIErrors
 
-func (response TenantUpdate400JSONResponse) VisitTenantUpdateResponse(w http.ResponseWriter) error {
+func (response V1WebhookGet400JSONResponse) VisitV1WebhookGetResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type TenantUpdate403JSONResponse APIError
+type V1WebhookGet403JSONResponse APIErrors
 
-func (response TenantUpdate403JSONResponse) VisitTenantUpdateResponse(w http.ResponseWriter) error {
+func (response V1WebhookGet403JSONResponse) VisitV1WebhookGetResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type AlertEmailGroupListRequestObject struct {
-	Tenant openapi_types.UUID `json:"tenant"`
+type V1WebhookUpdateRequestObject struct {
+	Tenant    openapi_types.UUID `json:"tenant"`
+	V1Webhook string             `json:"v1-webhook"`
+	Body      *V1WebhookUpdateJSONRequestBody
 }
 
-type AlertEmailGroupListResponseObject interface {
-	VisitAlertEmailGroupListResponse(w http.ResponseWriter) error
+type V1WebhookUpdateResponseObject interface {
+	VisitV1WebhookUpdateResponse(w http.ResponseWriter) error
 }
 
-type AlertEmailGroupList200JSONResponse TenantAlertEmailGroupList
+type V1WebhookUpdate200JSONResponse V1Webhook
 
-func (response AlertEmailGroupList200JSONResponse) VisitAlertEmailGroupListResponse(w http.ResponseWriter) error {
+func (response V1WebhookUpdate200JSONResponse) VisitV1WebhookUpdateResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type AlertEmailGroupList400JSONResponse APIErrors
+type V1WebhookUpdate400JSONResponse APIErrors
 
-func (response AlertEmailGroupList400JSONResponse) VisitAlertEmailGroupListResponse(w http.ResponseWriter) error {
+func (response V1WebhookUpdate400JSONResponse) VisitV1WebhookUpdateResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type AlertEmailGroupList403JSONResponse APIError
+type V1WebhookUpdate403JSONResponse APIErrors
 
-func (response AlertEmailGroupList403JSONResponse) VisitAlertEmailGroupListResponse(w http.ResponseWriter) error {
+func (response V1WebhookUpdate403JSONResponse) VisitV1WebhookUpdateResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type AlertEmailGroupCreateRequestObject struct {
-	Tenant openapi_types.UUID `json:"tenant"`
-	Body   *AlertEmailGroupCreateJSONRequestBody
+type V1WebhookUpdate404JSONResponse APIErrors
+
+func (response V1WebhookUpdate404JSONResponse) VisitV1WebhookUpdateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type AlertEmailGroupCreateResponseObject interface {
-	VisitAlertEmailGroupCreateResponse(w http.ResponseWriter) error
+type V1WebhookReceiveRequestObject struct {
+	Tenant    openapi_types.UUID `json:"tenant"`
+	V1Webhook string             `json:"v1-webhook"`
 }
 
-type AlertEmailGroupCreate201JSONResponse TenantAlertEmailGroup
+type V1WebhookReceiveResponseObject interface {
+	VisitV1WebhookReceiveResponse(w http.ResponseWriter) error
+}
 
-func (response AlertEmailGroupCreate201JSONResponse) VisitAlertEmailGroupCreateResponse(w http.ResponseWriter) error {
+type V1WebhookReceive200JSONResponse V1WebhookResponse
+
+func (response V1WebhookReceive200JSONResponse) VisitV1WebhookReceiveResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type AlertEmailGroupCreate400JSONResponse APIErrors
+type V1WebhookReceive204Response struct {
+}
 
-func (response AlertEmailGroupCreate400JSONResponse) VisitAlertEmailGroupCreateResponse(w http.ResponseWriter) error {
+func (response V1WebhookReceive204Response) VisitV1WebhookReceiveResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type V1WebhookReceive400JSONResponse APIErrors
+
+func (response V1WebhookReceive400JSONResponse) VisitV1WebhookReceiveResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type AlertEmailGroupCreate403JSONResponse APIError
+type V1WebhookReceive403JSONResponse APIErrors
 
-func (response AlertEmailGroupCreate403JSONResponse) VisitAlertEmailGroupCreateResponse(w http.ResponseWriter) error {
+func (response V1WebhookReceive403JSONResponse) VisitV1WebhookReceiveResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type TenantAlertingSettingsGetRequestObject struct {
+type V1WebhookReceive429JSONResponse APIErrors
+
+func (response V1WebhookReceive429JSONResponse) VisitV1WebhookReceiveResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type V1WorkerCordonRequestObject struct {
 	Tenant openapi_types.UUID `json:"tenant"`
+	Body   *V1WorkerCordonJSONRequestBody
 }
 
-type TenantAlertingSettingsGetResponseObject interface {
-	VisitTenantAlertingSettingsGetResponse(w http.ResponseWriter) error
+type V1WorkerCordonResponseObject interface {
+	VisitV1WorkerCordonResponse(w http.ResponseWriter) error
 }
 
-type TenantAlertingSettingsGet200JSONResponse TenantAlertingSettings
+type V1WorkerCordon200JSONResponse V1WorkerCordonList
 
-func (response TenantAlertingSettingsGet200JSONResponse) VisitTenantAlertingSettingsGetResponse(w http.ResponseWriter) error {
+func (response V1WorkerCordon200JSONResponse) VisitV1WorkerCordonResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type TenantAlertingSettingsGet400JSONResponse APIErrors
+type V1WorkerCordon400JSONResponse APIErrors
 
-func (response TenantAlertingSettingsGet400JSONResponse) VisitTenantAlertingSettingsGetResponse(w http.ResponseWriter) error {
+func (response V1WorkerCordon400JSONResponse) VisitV1WorkerCordonResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type TenantAlertingSettingsGet403JSONResponse APIError
+type V1WorkerCordon403JSONResponse APIErrors
 
-func (response TenantAlertingSettingsGet403JSONResponse) VisitTenantAlertingSettingsGetResponse(w http.ResponseWriter) error {
+func (response V1WorkerCordon403JSONResponse) VisitV1WorkerCordonResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ApiTokenListRequestObject struct {
+type V1WorkerCordonListRequestObject struct {
 	Tenant openapi_types.UUID `json:"tenant"`
+	Params V1WorkerCordonListParams
 }
 
-type ApiTokenListResponseObject interface {
-	VisitApiTokenListResponse(w http.ResponseWriter) error
+type V1WorkerCordonListResponseObject interface {
+	VisitV1WorkerCordonListResponse(w http.ResponseWriter) error
 }
 
-type ApiTokenList200JSONResponse ListAPITokensResponse
+type V1WorkerCordonList200JSONResponse V1WorkerCordonList
 
-func (response ApiTokenList200JSONResponse) VisitApiTokenListResponse(w http.ResponseWriter) error {
+func (response V1WorkerCordonList200JSONResponse) VisitV1WorkerCordonListResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ApiTokenList400JSONResponse APIErrors
+type V1WorkerCordonList400JSONResponse APIErrors
 
-func (response ApiTokenList400JSONResponse) VisitApiTokenListResponse(w http.ResponseWriter) error {
+func (response V1WorkerCordonList400JSONResponse) VisitV1WorkerCordonListResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ApiTokenList403JSONResponse APIErrors
+type V1WorkerCordonList403JSONResponse APIErrors
 
-func (response ApiTokenList403JSONResponse) VisitApiTokenListResponse(w http.ResponseWriter) error {
+func (response V1WorkerCordonList403JSONResponse) VisitV1WorkerCordonListResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ApiTokenCreateRequestObject struct {
+type V1WorkerDrainRequestObject struct {
 	Tenant openapi_types.UUID `json:"tenant"`
-	Body   *ApiTokenCreateJSONRequestBody
+	Body   *V1WorkerDrainJSONRequestBody
 }
 
-type ApiTokenCreateResponseObject interface {
-	VisitApiTokenCreateResponse(w http.ResponseWriter) error
+type V1WorkerDrainResponseObject interface {
+	VisitV1WorkerDrainResponse(w http.ResponseWriter) error
 }
 
-type ApiTokenCreate200JSONResponse CreateAPITokenResponse
+type V1WorkerDrain200JSONResponse V1WorkerCordonList
 
-func (response ApiTokenCreate200JSONResponse) VisitApiTokenCreateResponse(w http.ResponseWriter) error {
+func (response V1WorkerDrain200JSONResponse) VisitV1WorkerDrainResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ApiTokenCreate400JSONResponse APIErrors
+type V1WorkerDrain400JSONResponse APIErrors
 
-func (response ApiTokenCreate400JSONResponse) VisitApiTokenCreateResponse(w http.ResponseWriter) error {
+func (response V1WorkerDrain400JSONResponse) VisitV1WorkerDrainResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ApiTokenCreate403JSONResponse APIErrors
+type V1WorkerDrain403JSONResponse APIErrors
 
-func (response ApiTokenCreate403JSONResponse) VisitApiTokenCreateResponse(w http.ResponseWriter) error {
+func (response V1WorkerDrain403JSONResponse) VisitV1WorkerDrainResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type EventListRequestObject struct {
+type V1WorkerUncordonRequestObject struct {
 	Tenant openapi_types.UUID `json:"tenant"`
-	Params EventListParams
+	Body   *V1WorkerUncordonJSONRequestBody
 }
 
-type EventListResponseObject interface {
-	VisitEventListResponse(w http.ResponseWriter) error
+type V1WorkerUncordonResponseObject interface {
+	VisitV1WorkerUncordonResponse(w http.ResponseWriter) error
 }
 
-type EventList200JSONResponse EventList
+type V1WorkerUncordon200JSONResponse V1UncordonWorkersResponse
 
-func (response EventList200JSONResponse) VisitEventListResponse(w http.ResponseWriter) error {
+func (response V1WorkerUncordon200JSONResponse) VisitV1WorkerUncordonResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type EventList400JSONResponse APIErrors
+type V1WorkerUncordon400JSONResponse APIErrors
 
-func (response EventList400JSONResponse) VisitEventListResponse(w http.ResponseWriter) error {
+func (response V1WorkerUncordon400JSONResponse) VisitV1WorkerUncordonResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type EventList403JSONResponse APIErrors
+type V1WorkerUncordon403JSONResponse APIErrors
 
-func (response EventList403JSONResponse) VisitEventListResponse(w http.ResponseWriter) error {
+func (response V1WorkerUncordon403JSONResponse) VisitV1WorkerUncordonResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type EventCreateRequestObject struct {
+type V1WorkflowRunListRequestObject struct {
 	Tenant openapi_types.UUID `json:"tenant"`
-	Body   *EventCreateJSONRequestBody
+	Params V1WorkflowRunListParams
 }
 
-type EventCreateResponseObject interface {
-	VisitEventCreateResponse(w http.ResponseWriter) error
+type V1WorkflowRunListResponseObject interface {
+	VisitV1WorkflowRunListResponse(w http.ResponseWriter) error
 }
 
-type EventCreate200JSONResponse Event
+type V1WorkflowRunList200JSONResponse V1TaskSummaryList
 
-func (response EventCreate200JSONResponse) VisitEventCreateResponse(w http.ResponseWriter) error {
+func (response V1WorkflowRunList200JSONResponse) VisitV1WorkflowRunListResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type EventCreate400JSONResponse APIErrors
+type V1WorkflowRunList400JSONResponse APIErrors
 
-func (response EventCreate400JSONResponse) VisitEventCreateResponse(w http.ResponseWriter) error {
+func (response V1WorkflowRunList400JSONResponse) VisitV1WorkflowRunListResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type EventCreate403JSONResponse APIErrors
+type V1WorkflowRunList403JSONResponse APIErrors
 
-func (response EventCreate403JSONResponse) VisitEventCreateResponse(w http.ResponseWriter) error {
+func (response V1WorkflowRunList403JSONResponse) VisitV1WorkflowRunListResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type EventCreate429JSONResponse APIErrors
+type V1WorkflowRunList501JSONResponse APIErrors
 
-func (response EventCreate429JSONResponse) VisitEventCreateResponse(w http.ResponseWriter) error {
+func (response V1WorkflowRunList501JSONResponse) VisitV1WorkflowRunListResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(429)
+	w.WriteHeader(501)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type EventCreateBulkRequestObject struct {
+type V1WorkflowRunDisplayNamesListRequestObject struct {
 	Tenant openapi_types.UUID `json:"tenant"`
-	Body   *EventCreateBulkJSONRequestBody
+	Params V1WorkflowRunDisplayNamesListParams
 }
 
-type EventCreateBulkResponseObject interface {
-	VisitEventCreateBulkResponse(w http.ResponseWriter) error
+type V1WorkflowRunDisplayNamesListResponseObject interface {
+	VisitV1WorkflowRunDisplayNamesListResponse(w http.ResponseWriter) error
 }
 
-type EventCreateBulk200JSONResponse Events
+type V1WorkflowRunDisplayNamesList200JSONResponse V1WorkflowRunDisplayNameList
 
-func (response EventCreateBulk200JSONResponse) VisitEventCreateBulkResponse(w http.ResponseWriter) error {
+func (response V1WorkflowRunDisplayNamesList200JSONResponse) VisitV1WorkflowRunDisplayNamesListResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type EventCreateBulk400JSONResponse APIErrors
+type V1WorkflowRunDisplayNamesList400JSONResponse APIErrors
 
-func (response EventCreateBulk400JSONResponse) VisitEventCreateBulkResponse(w http.ResponseWriter) error {
+func (response V1WorkflowRunDisplayNamesList400JSONResponse) VisitV1WorkflowRunDisplayNamesListResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type EventCreateBulk403JSONResponse APIErrors
+type V1WorkflowRunDisplayNamesList403JSONResponse APIErrors
 
-func (response EventCreateBulk403JSONResponse) VisitEventCreateBulkResponse(w http.ResponseWriter) error {
+func (response V1WorkflowRunDisplayNamesList403JSONResponse) VisitV1WorkflowRunDisplayNamesListResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type EventCreateBulk429JSONResponse APIErrors
+type V1WorkflowRunDisplayNamesList501JSONResponse APIErrors
 
-func (response EventCreateBulk429JSONResponse) VisitEventCreateBulkResponse(w http.ResponseWriter) error {
+func (response V1WorkflowRunDisplayNamesList501JSONResponse) VisitV1WorkflowRunDisplayNamesListResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(429)
+	w.WriteHeader(501)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type EventUpdateCancelRequestObject struct {
+type V1WorkflowRunExternalIdsListRequestObject struct {
 	Tenant openapi_types.UUID `json:"tenant"`
-	Body   *EventUpdateCancelJSONRequestBody
+	Params V1WorkflowRunExternalIdsListParams
 }
 
-type EventUpdateCancelResponseObject interface {
-	VisitEventUpdateCancelResponse(w http.ResponseWriter) error
+type V1WorkflowRunExternalIdsListResponseObject interface {
+	VisitV1WorkflowRunExternalIdsListResponse(w http.ResponseWriter) error
 }
 
-type EventUpdateCancel200JSONResponse struct {
-	WorkflowRunIds *[]openapi_types.UUID `json:"workflowRunIds,omitempty"`
-}
+type V1WorkflowRunExternalIdsList200JSONResponse V1WorkflowRunExternalIdList
 
-func (response EventUpdateCancel200JSONResponse) VisitEventUpdateCancelResponse(w http.ResponseWriter) error {
+func (response V1WorkflowRunExternalIdsList200JSONResponse) VisitV1WorkflowRunExternalIdsListResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type EventUpdateCancel400JSONResponse APIErrors
+type V1WorkflowRunExternalIdsList400JSONResponse APIErrors
 
-func (response EventUpdateCancel400JSONResponse) VisitEventUpdateCancelResponse(w http.ResponseWriter) error {
+func (response V1WorkflowRunExternalIdsList400JSONResponse) VisitV1WorkflowRunExternalIdsListResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type EventUpdateCancel403JSONResponse APIErrors
+type V1WorkflowRunExternalIdsList403JSONResponse APIErrors
 
-func (response EventUpdateCancel403JSONResponse) VisitEventUpdateCancelResponse(w http.ResponseWriter) error {
+func (response V1WorkflowRunExternalIdsList403JSONResponse) VisitV1WorkflowRunExternalIdsListResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type EventUpdateCancel429JSONResponse APIErrors
+type V1WorkflowRunExternalIdsList501JSONResponse APIErrors
 
-func (response EventUpdateCancel429JSONResponse) VisitEventUpdateCancelResponse(w http.ResponseWriter) error {
+func (response V1WorkflowRunExternalIdsList501JSONResponse) VisitV1WorkflowRunExternalIdsListResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(429)
+	w.WriteHeader(501)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type EventKeyListRequestObject struct {
+type V1WorkflowRunSearchRequestObject struct {
 	Tenant openapi_types.UUID `json:"tenant"`
+	Body   *V1WorkflowRunSearchJSONRequestBody
 }
 
-type EventKeyListResponseObject interface {
-	VisitEventKeyListResponse(w http.ResponseWriter) error
+type V1WorkflowRunSearchResponseObject interface {
+	VisitV1WorkflowRunSearchResponse(w http.ResponseWriter) error
 }
 
-type EventKeyList200JSONResponse EventKeyList
+type V1WorkflowRunSearch200JSONResponse V1TaskSummaryList
 
-func (response EventKeyList200JSONResponse) VisitEventKeyListResponse(w http.ResponseWriter) error {
+func (response V1WorkflowRunSearch200JSONResponse) VisitV1WorkflowRunSearchResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type EventKeyList400JSONResponse APIErrors
+type V1WorkflowRunSearch400JSONResponse APIErrors
 
-func (response EventKeyList400JSONResponse) VisitEventKeyListResponse(w http.ResponseWriter) error {
+func (response V1WorkflowRunSearch400JSONResponse) VisitV1WorkflowRunSearchResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type EventKeyList403JSONResponse APIErrors
+type V1WorkflowRunSearch403JSONResponse APIErrors
 
-func (response EventKeyList403JSONResponse) VisitEventKeyListResponse(w http.ResponseWriter) error {
+func (response V1WorkflowRunSearch403JSONResponse) VisitV1WorkflowRunSearchResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type EventUpdateReplayRequestObject struct {
+type V1WorkflowRunSignalRequestObject struct {
 	Tenant openapi_types.UUID `json:"tenant"`
-	Body   *EventUpdateReplayJSONRequestBody
+	Body   *V1WorkflowRunSignalJSONRequestBody
 }
 
-type EventUpdateReplayResponseObject interface {
-	VisitEventUpdateReplayResponse(w http.ResponseWriter) error
+type V1WorkflowRunSignalResponseObject interface {
+	VisitV1WorkflowRunSignalResponse(w http.ResponseWriter) error
 }
 
-type EventUpdateReplay200JSONResponse EventList
+type V1WorkflowRunSignal200JSONResponse V1SignalRunResponse
 
-func (response EventUpdateReplay200JSONResponse) VisitEventUpdateReplayResponse(w http.ResponseWriter) error {
+func (response V1WorkflowRunSignal200JSONResponse) VisitV1WorkflowRunSignalResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type EventUpdateReplay400JSONResponse APIErrors
+type V1WorkflowRunSignal400JSONResponse APIErrors
 
-func (response EventUpdateReplay400JSONResponse) VisitEventUpdateReplayResponse(w http.ResponseWriter) error {
+func (response V1WorkflowRunSignal400JSONResponse) VisitV1WorkflowRunSignalResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type EventUpdateReplay403JSONResponse APIErrors
+type V1WorkflowRunSignal403JSONResponse APIErrors
 
-func (response EventUpdateReplay403JSONResponse) VisitEventUpdateReplayResponse(w http.ResponseWriter) error {
+func (response V1WorkflowRunSignal403JSONResponse) VisitV1WorkflowRunSignalResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type EventUpdateReplay429JSONResponse APIErrors
+type V1WorkflowRunSignal404JSONResponse APIErrors
 
-func (response EventUpdateReplay429JSONResponse) VisitEventUpdateReplayResponse(w http.ResponseWriter) error {
+func (response V1WorkflowRunSignal404JSONResponse) VisitV1WorkflowRunSignalResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(429)
+	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type EventDataGetWithTenantRequestObject struct {
-	Tenant          openapi_types.UUID `json:"tenant"`
-	EventWithTenant openapi_types.UUID `json:"event-with-tenant"`
+type V1WorkflowRunSignal409JSONResponse APIErrors
+
+func (response V1WorkflowRunSignal409JSONResponse) VisitV1WorkflowRunSignalResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type EventDataGetWithTenantResponseObject interface {
-	VisitEventDataGetWithTenantResponse(w http.ResponseWriter) error
+type V1WorkflowRunCreateRequestObject struct {
+	Tenant openapi_types.UUID `json:"tenant"`
+	Body   *V1WorkflowRunCreateJSONRequestBody
 }
 
-type EventDataGetWithTenant200JSONResponse EventData
+type V1WorkflowRunCreateResponseObject interface {
+	VisitV1WorkflowRunCreateResponse(w http.ResponseWriter) error
+}
 
-func (response EventDataGetWithTenant200JSONResponse) VisitEventDataGetWithTenantResponse(w http.ResponseWriter) error {
+type V1WorkflowRunCreate200JSONResponse V1WorkflowRunDetails
+
+func (response V1WorkflowRunCreate200JSONResponse) VisitV1WorkflowRunCreateResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type EventDataGetWithTenant400JSONResponse APIErrors
+type V1WorkflowRunCreate400JSONResponse APIErrors
 
-func (response EventDataGetWithTenant400JSONResponse) VisitEventDataGetWithTenantResponse(w http.ResponseWriter) error {
+func (response V1WorkflowRunCreate400JSONResponse) VisitV1WorkflowRunCreateResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type EventDataGetWithTenant403JSONResponse APIErrors
+type V1WorkflowRunCreate403JSONResponse APIErrors
 
-func (response EventDataGetWithTenant403JSONResponse) VisitEventDataGetWithTenantResponse(w http.ResponseWriter) error {
+func (response V1WorkflowRunCreate403JSONResponse) VisitV1WorkflowRunCreateResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type TenantFeatureFlagEvaluateRequestObject struct {
-	Tenant openapi_types.UUID `json:"tenant"`
-	Params TenantFeatureFlagEvaluateParams
+type V1WorkflowRunGetRequestObject struct {
+	V1WorkflowRun openapi_types.UUID `json:"v1-workflow-run"`
 }
 
-type TenantFeatureFlagEvaluateResponseObject interface {
-	VisitTenantFeatureFlagEvaluateResponse(w http.ResponseWriter) error
+type V1WorkflowRunGetResponseObject interface {
+	VisitV1WorkflowRunGetResponse(w http.ResponseWriter) error
 }
 
-type TenantFeatureFlagEvaluate200JSONResponse FeatureFlagEvaluationResult
+type V1WorkflowRunGet200JSONResponse V1WorkflowRunDetails
 
-func (response TenantFeatureFlagEvaluate200JSONResponse) VisitTenantFeatureFlagEvaluateResponse(w http.ResponseWriter) error {
+func (response V1WorkflowRunGet200JSONResponse) VisitV1WorkflowRunGetResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type TenantFeatureFlagEvaluate400JSONResponse APIErrors
+type V1WorkflowRunGet400JSONResponse APIErrors
 
-func (response TenantFeatureFlagEvaluate400JSONResponse) VisitTenantFeatureFlagEvaluateResponse(w http.ResponseWriter) error {
+func (response V1WorkflowRunGet400JSONResponse) VisitV1WorkflowRunGetResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type TenantFeatureFlagEvaluate403JSONResponse APIErrors
+type V1WorkflowRunGet403JSONResponse APIErrors
 
-func (response TenantFeatureFlagEvaluate403JSONResponse) VisitTenantFeatureFlagEvaluateResponse(w http.ResponseWriter) error {
+func (response V1WorkflowRunGet403JSONResponse) VisitV1WorkflowRunGetResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type TenantFeatureFlagEvaluate404JSONResponse APIErrors
+type V1WorkflowRunGet501JSONResponse APIErrors
 
-func (response TenantFeatureFlagEvaluate404JSONResponse) VisitTenantFeatureFlagEvaluateResponse(w http.ResponseWriter) error {
+func (response V1WorkflowRunGet501JSONResponse) VisitV1WorkflowRunGetResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	w.WriteHeader(501)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type TenantInviteListRequestObject struct {
-	Tenant openapi_types.UUID `json:"tenant"`
+type V1WorkflowRunGetStatusRequestObject struct {
+	V1WorkflowRun openapi_types.UUID `json:"v1-workflow-run"`
 }
 
-type TenantInviteListResponseObject interface {
-	VisitTenantInviteListResponse(w http.ResponseWriter) error
+type V1WorkflowRunGetStatusResponseObject interface {
+	VisitV1WorkflowRunGetStatusResponse(w http.ResponseWriter) error
 }
 
-type TenantInviteList200JSONResponse TenantInviteList
+type V1WorkflowRunGetStatus200JSONResponse V1TaskStatus
 
-func (response TenantInviteList200JSONResponse) VisitTenantInviteListResponse(w http.ResponseWriter) error {
+func (response V1WorkflowRunGetStatus200JSONResponse) VisitV1WorkflowRunGetStatusResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type TenantInviteList400JSONResponse APIErrors
+type V1WorkflowRunGetStatus400JSONResponse APIErrors
 
-func (response TenantInviteList400JSONResponse) VisitTenantInviteListResponse(w http.ResponseWriter) error {
+func (response V1WorkflowRunGetStatus400JSONResponse) VisitV1WorkflowRunGetStatusResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type TenantInviteList403JSONResponse APIError
+type V1WorkflowRunGetStatus403JSONResponse APIErrors
 
-func (response TenantInviteList403JSONResponse) VisitTenantInviteListResponse(w http.ResponseWriter) error {
+func (response V1WorkflowRunGetStatus403JSONResponse) VisitV1WorkflowRunGetStatusResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type TenantInviteCreateRequestObject struct {
-	Tenant openapi_types.UUID `json:"tenant"`
-	Body   *TenantInviteCreateJSONRequestBody
+type V1WorkflowRunGetStatus404JSONResponse APIErrors
+
+func (response V1WorkflowRunGetStatus404JSONResponse) VisitV1WorkflowRunGetStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type TenantInviteCreateResponseObject interface {
-	VisitTenantInviteCreateResponse(w http.ResponseWriter) error
+type V1WorkflowRunGetStatus501JSONResponse APIErrors
+
+func (response V1WorkflowRunGetStatus501JSONResponse) VisitV1WorkflowRunGetStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(501)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type TenantInviteCreate201JSONResponse TenantInvite
+type V1WorkflowRunTaskEventsListRequestObject struct {
+	V1WorkflowRun openapi_types.UUID `json:"v1-workflow-run"`
+	Params        V1WorkflowRunTaskEventsListParams
+}
 
-func (response TenantInviteCreate201JSONResponse) VisitTenantInviteCreateResponse(w http.ResponseWriter) error {
+type V1WorkflowRunTaskEventsListResponseObject interface {
+	VisitV1WorkflowRunTaskEventsListResponse(w http.ResponseWriter) error
+}
+
+type V1WorkflowRunTaskEventsList200JSONResponse V1TaskEventList
+
+func (response V1WorkflowRunTaskEventsList200JSONResponse) VisitV1WorkflowRunTaskEventsListResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type TenantInviteCreate400JSONResponse APIErrors
+type V1WorkflowRunTaskEventsList400JSONResponse APIErrors
 
-func (response TenantInviteCreate400JSONResponse) VisitTenantInviteCreateResponse(w http.ResponseWriter) error {
+func (response V1WorkflowRunTaskEventsList400JSONResponse) VisitV1WorkflowRunTaskEventsListResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type TenantInviteCreate403JSONResponse APIError
+type V1WorkflowRunTaskEventsList403JSONResponse APIErrors
 
-func (response TenantInviteCreate403JSONResponse) VisitTenantInviteCreateResponse(w http.ResponseWriter) error {
+func (response V1WorkflowRunTaskEventsList403JSONResponse) VisitV1WorkflowRunTaskEventsListResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type TenantInviteCreate422JSONResponse APIErrors
+type V1WorkflowRunTaskEventsList501JSONResponse APIErrors
 
-func (response TenantInviteCreate422JSONResponse) VisitTenantInviteCreateResponse(w http.ResponseWriter) error {
+func (response V1WorkflowRunTaskEventsList501JSONResponse) VisitV1WorkflowRunTaskEventsListResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(422)
+	w.WriteHeader(501)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type TenantInviteDeleteRequestObject struct {
-	Tenant       openapi_types.UUID `json:"tenant"`
-	TenantInvite openapi_types.UUID `json:"tenant-invite"`
+type V1WorkflowRunGetTimingsRequestObject struct {
+	V1WorkflowRun openapi_types.UUID `json:"v1-workflow-run"`
+	Params        V1WorkflowRunGetTimingsParams
 }
 
-type TenantInviteDeleteResponseObject interface {
-	VisitTenantInviteDeleteResponse(w http.ResponseWriter) error
+type V1WorkflowRunGetTimingsResponseObject interface {
+	VisitV1WorkflowRunGetTimingsResponse(w http.ResponseWriter) error
 }
 
-type TenantInviteDelete200JSONResponse TenantInvite
+type V1WorkflowRunGetTimings200JSONResponse V1TaskTimingList
 
-func (response TenantInviteDelete200JSONResponse) VisitTenantInviteDeleteResponse(w http.ResponseWriter) error {
+func (response V1WorkflowRunGetTimings200JSONResponse) VisitV1WorkflowRunGetTimingsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type TenantInviteDelete400JSONResponse APIErrors
+type V1WorkflowRunGetTimings400JSONResponse APIErrors
 
-func (response TenantInviteDelete400JSONResponse) VisitTenantInviteDeleteResponse(w http.ResponseWriter) error {
+func (response V1WorkflowRunGetTimings400JSONResponse) VisitV1WorkflowRunGetTimingsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type TenantInviteUpdateRequestObject struct {
-	Tenant       openapi_types.UUID `json:"tenant"`
-	TenantInvite openapi_types.UUID `json:"tenant-invite"`
-	Body         *TenantInviteUpdateJSONRequestBody
-}
-
-type TenantInviteUpdateResponseObject interface {
-	VisitTenantInviteUpdateResponse(w http.ResponseWriter) error
-}
-
-type TenantInviteUpdate200JSONResponse TenantInvite
+type V1WorkflowRunGetTimings403JSONResponse APIErrors
 
-func (response TenantInviteUpdate200JSONResponse) VisitTenantInviteUpdateResponse(w http.ResponseWriter) error {
+func (response V1WorkflowRunGetTimings403JSONResponse) VisitV1WorkflowRunGetTimingsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type TenantInviteUpdate400JSONResponse APIErrors
+type V1WorkflowRunGetTimings501JSONResponse APIErrors
 
-func (response TenantInviteUpdate400JSONResponse) VisitTenantInviteUpdateResponse(w http.ResponseWriter) error {
+func (response V1WorkflowRunGetTimings501JSONResponse) VisitV1WorkflowRunGetTimingsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	w.WriteHeader(501)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type TenantMemberListRequestObject struct {
-	Tenant openapi_types.UUID `json:"tenant"`
+type V1WorkflowSpecGetRequestObject struct {
+	Workflow openapi_types.UUID `json:"workflow"`
+	Params   V1WorkflowSpecGetParams
 }
 
-type TenantMemberListResponseObject interface {
-	VisitTenantMemberListResponse(w http.ResponseWriter) error
+type V1WorkflowSpecGetResponseObject interface {
+	VisitV1WorkflowSpecGetResponse(w http.ResponseWriter) error
 }
 
-type TenantMemberList200JSONResponse TenantMemberList
+type V1WorkflowSpecGet200JSONResponse V1WorkflowSpec
 
-func (response TenantMemberList200JSONResponse) VisitTenantMemberListResponse(w http.ResponseWriter) error {
+func (response V1WorkflowSpecGet200JSONResponse) VisitV1WorkflowSpecGetResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type TenantMemberList400JSONResponse APIErrors
+type V1WorkflowSpecGet400JSONResponse APIErrors
 
-func (response TenantMemberList400JSONResponse) VisitTenantMemberListResponse(w http.ResponseWriter) error {
+func (response V1WorkflowSpecGet400JSONResponse) VisitV1WorkflowSpecGetResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type TenantMemberList403JSONResponse APIError
+type V1WorkflowSpecGet403JSONResponse APIErrors
 
-func (response TenantMemberList403JSONResponse) VisitTenantMemberListResponse(w http.ResponseWriter) error {
+func (response V1WorkflowSpecGet403JSONResponse) VisitV1WorkflowSpecGetResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type TenantMemberDeleteRequestObject struct {
-	Tenant openapi_types.UUID `json:"tenant"`
-	Member openapi_types.UUID `json:"member"`
+type V1WorkflowSpecGet404JSONResponse APIErrors
+
+func (response V1WorkflowSpecGet404JSONResponse) VisitV1WorkflowSpecGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type TenantMemberDeleteResponseObject interface {
-	VisitTenantMemberDeleteResponse(w http.ResponseWriter) error
+type StepRunListArchivesRequestObject struct {
+	StepRun openapi_types.UUID `json:"step-run"`
+	Params  StepRunListArchivesParams
 }
 
-type TenantMemberDelete204JSONResponse TenantMember
+type StepRunListArchivesResponseObject interface {
+	VisitStepRunListArchivesResponse(w http.ResponseWriter) error
+}
 
-func (response TenantMemberDelete204JSONResponse) VisitTenantMemberDeleteResponse(w http.ResponseWriter) error {
+type StepRunListArchives200JSONResponse StepRunArchiveList
+
+func (response StepRunListArchives200JSONResponse) VisitStepRunListArchivesResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(204)
+	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type TenantMemberDelete400JSONResponse APIErrors
+type StepRunListArchives400JSONResponse APIErrors
 
-func (response TenantMemberDelete400JSONResponse) VisitTenantMemberDeleteResponse(w http.ResponseWriter) error {
+func (response StepRunListArchives400JSONResponse) VisitStepRunListArchivesResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type TenantMemberDelete403JSONResponse APIErrors
+type StepRunListArchives403JSONResponse APIErrors
 
-func (response TenantMemberDelete403JSONResponse) VisitTenantMemberDeleteResponse(w http.ResponseWriter) error {
+func (response StepRunListArchives403JSONResponse) VisitStepRunListArchivesResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type TenantMemberDelete404JSONResponse APIErrors
+type StepRunListArchives404JSONResponse APIErrors
 
-func (response TenantMemberDelete404JSONResponse) VisitTenantMemberDeleteResponse(w http.ResponseWriter) error {
+func (response StepRunListArchives404JSONResponse) VisitStepRunListArchivesResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type TenantMemberUpdateRequestObject struct {
-	Tenant openapi_types.UUID `json:"tenant"`
-	Member openapi_types.UUID `json:"member"`
-	Body   *TenantMemberUpdateJSONRequestBody
+type StepRunListEventsRequestObject struct {
+	StepRun openapi_types.UUID `json:"step-run"`
+	Params  StepRunListEventsParams
 }
 
-type TenantMemberUpdateResponseObject interface {
-	VisitTenantMemberUpdateResponse(w http.ResponseWriter) error
+type StepRunListEventsResponseObject interface {
+	VisitStepRunListEventsResponse(w http.ResponseWriter) error
 }
 
-type TenantMemberUpdate200JSONResponse TenantMember
+type StepRunListEvents200JSONResponse StepRunEventList
 
-func (response TenantMemberUpdate200JSONResponse) VisitTenantMemberUpdateResponse(w http.ResponseWriter) error {
+func (response StepRunListEvents200JSONResponse) VisitStepRunListEventsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type TenantMemberUpdate400JSONResponse APIErrors
+type StepRunListEvents400JSONResponse APIErrors
 
-func (response TenantMemberUpdate400JSONResponse) VisitTenantMemberUpdateResponse(w http.ResponseWriter) error {
+func (response StepRunListEvents400JSONResponse) VisitStepRunListEventsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type TenantMemberUpdate403JSONResponse APIErrors
+type StepRunListEvents403JSONResponse APIErrors
 
-func (response TenantMemberUpdate403JSONResponse) VisitTenantMemberUpdateResponse(w http.ResponseWriter) error {
+func (response StepRunListEvents403JSONResponse) VisitStepRunListEventsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type TenantMemberUpdate404JSONResponse APIErrors
+type StepRunListEvents404JSONResponse APIErrors
 
-func (response TenantMemberUpdate404JSONResponse) VisitTenantMemberUpdateResponse(w http.ResponseWriter) error {
+func (response StepRunListEvents404JSONResponse) VisitStepRunListEventsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type TenantGetPrometheusMetricsRequestObject struct {
-	Tenant openapi_types.UUID `json:"tenant"`
+type TenantCreateRequestObject struct {
+	Body *TenantCreateJSONRequestBody
 }
 
-type TenantGetPrometheusMetricsResponseObject interface {
-	VisitTenantGetPrometheusMetricsResponse(w http.ResponseWriter) error
+type TenantCreateResponseObject interface {
+	VisitTenantCreateResponse(w http.ResponseWriter) error
 }
 
-type TenantGetPrometheusMetrics200TextResponse string
+type TenantCreate200JSONResponse Tenant
 
-func (response TenantGetPrometheusMetrics200TextResponse) VisitTenantGetPrometheusMetricsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "text/plain")
+func (response TenantCreate200JSONResponse) VisitTenantCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
-	_, err := w.Write([]byte(response))
-	return err
+	return json.NewEncoder(w).Encode(response)
 }
 
-type TenantGetPrometheusMetrics400JSONResponse APIErrors
+type TenantCreate400JSONResponse APIErrors
 
-func (response TenantGetPrometheusMetrics400JSONResponse) VisitTenantGetPrometheusMetricsResponse(w http.ResponseWriter) error {
+func (response TenantCreate400JSONResponse) VisitTenantCreateResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type TenantGetPrometheusMetrics403JSONResponse APIErrors
+type TenantCreate403JSONResponse APIError
 
-func (response TenantGetPrometheusMetrics403JSONResponse) VisitTenantGetPrometheusMetricsResponse(w http.ResponseWriter) error {
+func (response TenantCreate403JSONResponse) VisitTenantCreateResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type TenantGetPrometheusMetrics404JSONResponse APIErrors
-
-func (response TenantGetPrometheusMetrics404JSONResponse) VisitTenantGetPrometheusMetricsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
-
-	return json.NewEncoder(w).Encode(response)
-}
-
-type TenantGetQueueMetricsRequestObject struct {
+type TenantGetRequestObject struct {
 	Tenant openapi_types.UUID `json:"tenant"`
-	Params TenantGetQueueMetricsParams
 }
 
-type TenantGetQueueMetricsResponseObject interface {
-	VisitTenantGetQueueMetricsResponse(w http.ResponseWriter) error
+type TenantGetResponseObject interface {
+	VisitTenantGetResponse(w http.ResponseWriter) error
 }
 
-type TenantGetQueueMetrics200JSONResponse TenantQueueMetrics
+type TenantGet200JSONResponse Tenant
 
-func (response TenantGetQueueMetrics200JSONResponse) VisitTenantGetQueueMetricsResponse(w http.ResponseWriter) error {
+func (response TenantGet200JSONResponse) VisitTenantGetResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type TenantGetQueueMetrics400JSONResponse APIErrors
+type TenantGet400JSONResponse APIErrors
 
-func (response TenantGetQueueMetrics400JSONResponse) VisitTenantGetQueueMetricsResponse(w http.ResponseWriter) error {
+func (response TenantGet400JSONResponse) VisitTenantGetResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type TenantGetQueueMetrics403JSONResponse APIErrors
+type TenantGet403JSONResponse APIError
 
-func (response TenantGetQueueMetrics403JSONResponse) VisitTenantGetQueueMetricsResponse(w http.ResponseWriter) error {
+func (response TenantGet403JSONResponse) VisitTenantGetResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type TenantGetQueueMetrics404JSONResponse APIErrors
+type TenantGet404JSONResponse APIErrors
 
-func (response TenantGetQueueMetrics404JSONResponse) VisitTenantGetQueueMetricsResponse(w http.ResponseWriter) error {
+func (response TenantGet404JSONResponse) VisitTenantGetResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type RateLimitDeleteRequestObject struct {
+type TenantUpdateRequestObject struct {
 	Tenant openapi_types.UUID `json:"tenant"`
-	Params RateLimitDeleteParams
+	Body   *TenantUpdateJSONRequestBody
 }
 
-type RateLimitDeleteResponseObject interface {
-	VisitRateLimitDeleteResponse(w http.ResponseWriter) error
+type TenantUpdateResponseObject interface {
+	VisitTenantUpdateResponse(w http.ResponseWriter) error
 }
 
-type RateLimitDelete204Response struct {
-}
+type TenantUpdate200JSONResponse Tenant
 
-func (response RateLimitDelete204Response) VisitRateLimitDeleteResponse(w http.ResponseWriter) error {
-	w.WriteHeader(204)
-	return nil
+func (response TenantUpdate200JSONResponse) VisitTenantUpdateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type RateLimitDelete400JSONResponse APIErrors
+type TenantUpdate400JSONResponse APIErrors
 
-func (response RateLimitDelete400JSONResponse) VisitRateLimitDeleteResponse(w http.ResponseWriter) error {
+func (response TenantUpdate400JSONResponse) VisitTenantUpdateResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type RateLimitDelete403JSONResponse APIErrors
+type TenantUpdate403JSONResponse APIError
 
-func (response RateLimitDelete403JSONResponse) VisitRateLimitDeleteResponse(w http.ResponseWriter) error {
+func (response TenantUpdate403JSONResponse) VisitTenantUpdateResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type RateLimitListRequestObject struct {
+type AlertEmailGroupListRequestObject struct {
 	Tenant openapi_types.UUID `json:"tenant"`
-	Params RateLimitListParams
 }
 
-type RateLimitListResponseObject interface {
-	VisitRateLimitListResponse(w http.ResponseWriter) error
+type AlertEmailGroupListResponseObject interface {
+	VisitAlertEmailGroupListResponse(w http.ResponseWriter) error
 }
 
-type RateLimitList200JSONResponse RateLimitList
+type AlertEmailGroupList200JSONResponse TenantAlertEmailGroupList
 
-func (response RateLimitList200JSONResponse) VisitRateLimitListResponse(w http.ResponseWriter) error {
+func (response AlertEmailGroupList200JSONResponse) VisitAlertEmailGroupListResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type RateLimitList400JSONResponse APIErrors
+type AlertEmailGroupList400JSONResponse APIErrors
 
-func (response RateLimitList400JSONResponse) VisitRateLimitListResponse(w http.ResponseWriter) error {
+func (response AlertEmailGroupList400JSONResponse) VisitAlertEmailGroupListResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type RateLimitList403JSONResponse APIErrors
+type AlertEmailGroupList403JSONResponse APIError
 
-func (response RateLimitList403JSONResponse) VisitRateLimitListResponse(w http.ResponseWriter) error {
+func (response AlertEmailGroupList403JSONResponse) VisitAlertEmailGroupListResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type TenantResourcePolicyGetRequestObject struct {
+type AlertEmailGroupCreateRequestObject struct {
 	Tenant openapi_types.UUID `json:"tenant"`
+	Body   *AlertEmailGroupCreateJSONRequestBody
 }
 
-type TenantResourcePolicyGetResponseObject interface {
-	VisitTenantResourcePolicyGetResponse(w http.ResponseWriter) error
+type AlertEmailGroupCreateResponseObject interface {
+	VisitAlertEmailGroupCreateResponse(w http.ResponseWriter) error
 }
 
-type TenantResourcePolicyGet200JSONResponse TenantResourcePolicy
+type AlertEmailGroupCreate201JSONResponse TenantAlertEmailGroup
 
-func (response TenantResourcePolicyGet200JSONResponse) VisitTenantResourcePolicyGetResponse(w http.ResponseWriter) error {
+func (response AlertEmailGroupCreate201JSONResponse) VisitAlertEmailGroupCreateResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	w.WriteHeader(201)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type TenantResourcePolicyGet400JSONResponse APIErrors
+type AlertEmailGroupCreate400JSONResponse APIErrors
 
-func (response TenantResourcePolicyGet400JSONResponse) VisitTenantResourcePolicyGetResponse(w http.ResponseWriter) error {
+func (response AlertEmailGroupCreate400JSONResponse) VisitAlertEmailGroupCreateResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type TenantResourcePolicyGet403JSONResponse APIError
+type AlertEmailGroupCreate403JSONResponse APIError
 
-func (response TenantResourcePolicyGet403JSONResponse) VisitTenantResourcePolicyGetResponse(w http.ResponseWriter) error {
+func (response AlertEmailGroupCreate403JSONResponse) VisitAlertEmailGroupCreateResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type SlackWebhookListRequestObject struct {
+type TenantAlertingSettingsGetRequestObject struct {
 	Tenant openapi_types.UUID `json:"tenant"`
 }
 
-type SlackWebhookListResponseObject interface {
-	VisitSlackWebhookListResponse(w http.ResponseWriter) error
+type TenantAlertingSettingsGetResponseObject interface {
+	VisitTenantAlertingSettingsGetResponse(w http.ResponseWriter) error
 }
 
-type SlackWebhookList200JSONResponse ListSlackWebhooks
+type TenantAlertingSettingsGet200JSONResponse TenantAlertingSettings
 
-func (response SlackWebhookList200JSONResponse) VisitSlackWebhookListResponse(w http.ResponseWriter) error {
+func (response TenantAlertingSettingsGet200JSONResponse) VisitTenantAlertingSettingsGetResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type SlackWebhookList400JSONResponse APIErrors
+type TenantAlertingSettingsGet400JSONResponse APIErrors
 
-func (response SlackWebhookList400JSONResponse) VisitSlackWebhookListResponse(w http.ResponseWriter) error {
+func (response TenantAlertingSettingsGet400JSONResponse) VisitTenantAlertingSettingsGetResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type SlackWebhookList401JSONResponse APIErrors
+type TenantAlertingSettingsGet403JSONResponse APIError
 
-func (response SlackWebhookList401JSONResponse) VisitSlackWebhookListResponse(w http.ResponseWriter) error {
+func (response TenantAlertingSettingsGet403JSONResponse) VisitTenantAlertingSettingsGetResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type SlackWebhookList405JSONResponse APIErrors
+type ApiTokenListRequestObject struct {
+	Tenant openapi_types.UUID `json:"tenant"`
+}
 
-func (response SlackWebhookList405JSONResponse) VisitSlackWebhookListResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(405)
+type ApiTokenListResponseObject interface {
+	VisitApiTokenListResponse(w http.ResponseWriter) error
+}
+
+type ApiTokenList200JSONResponse ListAPITokensResponse
+
+func (response ApiTokenList200JSONResponse) VisitApiTokenListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UserUpdateSlackOauthStartRequestObject struct {
-	Tenant openapi_types.UUID `json:"tenant"`
-}
+type ApiTokenList400JSONResponse APIErrors
 
-type UserUpdateSlackOauthStartResponseObject interface {
-	VisitUserUpdateSlackOauthStartResponse(w http.ResponseWriter) error
-}
+func (response ApiTokenList400JSONResponse) VisitApiTokenListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
 
-type UserUpdateSlackOauthStart302ResponseHeaders struct {
-	Location string
+	return json.NewEncoder(w).Encode(response)
 }
 
-type UserUpdateSlackOauthStart302Response struct {
-	Headers UserUpdateSlackOauthStart302ResponseHeaders
-}
+type ApiTokenList403JSONResponse APIErrors
 
-func (response UserUpdateSlackOauthStart302Response) VisitUserUpdateSlackOauthStartResponse(w http.ResponseWriter) error {
-	w.Header().Set("location", fmt.Sprint(response.Headers.Location))
-	w.WriteHeader(302)
-	return nil
+func (response ApiTokenList403JSONResponse) VisitApiTokenListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type SnsListRequestObject struct {
+type ApiTokenCreateRequestObject struct {
 	Tenant openapi_types.UUID `json:"tenant"`
+	Body   *ApiTokenCreateJSONRequestBody
 }
 
-type SnsListResponseObject interface {
-	VisitSnsListResponse(w http.ResponseWriter) error
+type ApiTokenCreateResponseObject interface {
+	VisitApiTokenCreateResponse(w http.ResponseWriter) error
 }
 
-type SnsList200JSONResponse ListSNSIntegrations
+type ApiTokenCreate200JSONResponse CreateAPITokenResponse
 
-func (response SnsList200JSONResponse) VisitSnsListResponse(w http.ResponseWriter) error {
+func (response ApiTokenCreate200JSONResponse) VisitApiTokenCreateResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type SnsList400JSONResponse APIErrors
+type ApiTokenCreate400JSONResponse APIErrors
 
-func (response SnsList400JSONResponse) VisitSnsListResponse(w http.ResponseWriter) error {
+func (response ApiTokenCreate400JSONResponse) VisitApiTokenCreateResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type SnsList401JSONResponse APIErrors
-
-func (response SnsList401JSONResponse) VisitSnsListResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
-
-	return json.NewEncoder(w).Encode(response)
-}
-
-type SnsList405JSONResponse APIErrors
+type ApiTokenCreate403JSONResponse APIErrors
 
-func (response SnsList405JSONResponse) VisitSnsListResponse(w http.ResponseWriter) error {
+func (response ApiTokenCreate403JSONResponse) VisitApiTokenCreateResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(405)
+	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type SnsCreateRequestObject struct {
+type EventListRequestObject struct {
 	Tenant openapi_types.UUID `json:"tenant"`
-	Body   *SnsCreateJSONRequestBody
+	Params EventListParams
 }
 
-type SnsCreateResponseObject interface {
-	VisitSnsCreateResponse(w http.ResponseWriter) error
+type EventListResponseObject interface {
+	VisitEventListResponse(w http.ResponseWriter) error
 }
 
-type SnsCreate201JSONResponse SNSIntegration
+type EventList200JSONResponse EventList
 
-func (response SnsCreate201JSONResponse) VisitSnsCreateResponse(w http.ResponseWriter) error {
+func (response EventList200JSONResponse) VisitEventListResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type SnsCreate400JSONResponse APIErrors
+type EventList400JSONResponse APIErrors
 
-func (response SnsCreate400JSONResponse) VisitSnsCreateResponse(w http.ResponseWriter) error {
+func (response EventList400JSONResponse) VisitEventListResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type SnsCreate401JSONResponse APIErrors
-
-func (response SnsCreate401JSONResponse) VisitSnsCreateResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
-
-	return json.NewEncoder(w).Encode(response)
-}
-
-type SnsCreate405JSONResponse APIErrors
+type EventList403JSONResponse APIErrors
 
-func (response SnsCreate405JSONResponse) VisitSnsCreateResponse(w http.ResponseWriter) error {
+func (response EventList403JSONResponse) VisitEventListResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(405)
+	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type TenantGetStepRunQueueMetricsRequestObject struct {
+type EventCreateRequestObject struct {
 	Tenant openapi_types.UUID `json:"tenant"`
+	Body   *EventCreateJSONRequestBody
 }
 
-type TenantGetStepRunQueueMetricsResponseObject interface {
-	VisitTenantGetStepRunQueueMetricsResponse(w http.ResponseWriter) error
+type EventCreateResponseObject interface {
+	VisitEventCreateResponse(w http.ResponseWriter) error
 }
 
-type TenantGetStepRunQueueMetrics200JSONResponse TenantStepRunQueueMetrics
+type EventCreate200JSONResponse Event
 
-func (response TenantGetStepRunQueueMetrics200JSONResponse) VisitTenantGetStepRunQueueMetricsResponse(w http.ResponseWriter) error {
+func (response EventCreate200JSONResponse) VisitEventCreateResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type TenantGetStepRunQueueMetrics400JSONResponse APIErrors
+type EventCreate400JSONResponse APIErrors
 
-func (response TenantGetStepRunQueueMetrics400JSONResponse) VisitTenantGetStepRunQueueMetricsResponse(w http.ResponseWriter) error {
+func (response EventCreate400JSONResponse) VisitEventCreateResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type TenantGetStepRunQueueMetrics403JSONResponse APIErrors
+type EventCreate403JSONResponse APIErrors
 
-func (response TenantGetStepRunQueueMetrics403JSONResponse) VisitTenantGetStepRunQueueMetricsResponse(w http.ResponseWriter) error {
+func (response EventCreate403JSONResponse) VisitEventCreateResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type TenantGetStepRunQueueMetrics404JSONResponse APIErrors
+type EventCreate429JSONResponse APIErrors
 
-func (response TenantGetStepRunQueueMetrics404JSONResponse) VisitTenantGetStepRunQueueMetricsResponse(w http.ResponseWriter) error {
+func (response EventCreate429JSONResponse) VisitEventCreateResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	w.WriteHeader(429)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type StepRunGetRequestObject struct {
-	Tenant  openapi_types.UUID `json:"tenant"`
-	StepRun openapi_types.UUID `json:"step-run"`
+type EventCreateBulkRequestObject struct {
+	Tenant openapi_types.UUID `json:"tenant"`
+	Body   *EventCreateBulkJSONRequestBody
 }
 
-type StepRunGetResponseObject interface {
-	VisitStepRunGetResponse(w http.ResponseWriter) error
+type EventCreateBulkResponseObject interface {
+	VisitEventCreateBulkResponse(w http.ResponseWriter) error
 }
 
-type StepRunGet200JSONResponse StepRun
+type EventCreateBulk200JSONResponse Events
 
-func (response StepRunGet200JSONResponse) VisitStepRunGetResponse(w http.ResponseWriter) error {
+func (response EventCreateBulk200JSONResponse) VisitEventCreateBulkResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type StepRunGet400JSONResponse APIErrors
+type EventCreateBulk400JSONResponse APIErrors
 
-func (response StepRunGet400JSONResponse) VisitStepRunGetResponse(w http.ResponseWriter) error {
+func (response EventCreateBulk400JSONResponse) VisitEventCreateBulkResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type StepRunGet403JSONResponse APIErrors
+type EventCreateBulk403JSONResponse APIErrors
 
-func (response StepRunGet403JSONResponse) VisitStepRunGetResponse(w http.ResponseWriter) error {
+func (response EventCreateBulk403JSONResponse) VisitEventCreateBulkResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type StepRunGet404JSONResponse APIErrors
+type EventCreateBulk429JSONResponse APIErrors
 
-func (response StepRunGet404JSONResponse) VisitStepRunGetResponse(w http.ResponseWriter) error {
+func (response EventCreateBulk429JSONResponse) VisitEventCreateBulkResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	w.WriteHeader(429)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type StepRunUpdateCancelRequestObject struct {
-	Tenant  openapi_types.UUID `json:"tenant"`
-	StepRun openapi_types.UUID `json:"step-run"`
+type EventUpdateCancelRequestObject struct {
+	Tenant openapi_types.UUID `json:"tenant"`
+	Body   *EventUpdateCancelJSONRequestBody
 }
 
-type StepRunUpdateCancelResponseObject interface {
-	VisitStepRunUpdateCancelResponse(w http.ResponseWriter) error
+type EventUpdateCancelResponseObject interface {
+	VisitEventUpdateCancelResponse(w http.ResponseWriter) error
 }
 
-type StepRunUpdateCancel200JSONResponse StepRun
+type EventUpdateCancel200JSONResponse struct {
+	WorkflowRunIds *[]openapi_types.UUID `json:"workflowRunIds,omitempty"`
+}
 
-func (response StepRunUpdateCancel200JSONResponse) VisitStepRunUpdateCancelResponse(w http.ResponseWriter) error {
+func (response EventUpdateCancel200JSONResponse) VisitEventUpdateCancelResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type StepRunUpdateCancel400JSONResponse APIErrors
+type EventUpdateCancel400JSONResponse APIErrors
 
-func (response StepRunUpdateCancel400JSONResponse) VisitStepRunUpdateCancelResponse(w http.ResponseWriter) error {
+func (response EventUpdateCancel400JSONResponse) VisitEventUpdateCancelResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type StepRunUpdateCancel403JSONResponse APIErrors
+type EventUpdateCancel403JSONResponse APIErrors
 
-func (response StepRunUpdateCancel403JSONResponse) VisitStepRunUpdateCancelResponse(w http.ResponseWriter) error {
+func (response EventUpdateCancel403JSONResponse) VisitEventUpdateCancelResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type StepRunUpdateRerunRequestObject struct {
-	Tenant  openapi_types.UUID `json:"tenant"`
-	StepRun openapi_types.UUID `json:"step-run"`
-	Body    *StepRunUpdateRerunJSONRequestBody
+type EventUpdateCancel429JSONResponse APIErrors
+
+func (response EventUpdateCancel429JSONResponse) VisitEventUpdateCancelResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type StepRunUpdateRerunResponseObject interface {
-	VisitStepRunUpdateRerunResponse(w http.ResponseWriter) error
+type EventKeyListRequestObject struct {
+	Tenant openapi_types.UUID `json:"tenant"`
 }
 
-type StepRunUpdateRerun200JSONResponse StepRun
+type EventKeyListResponseObject interface {
+	VisitEventKeyListResponse(w http.ResponseWriter) error
+}
 
-func (response StepRunUpdateRerun200JSONResponse) VisitStepRunUpdateRerunResponse(w http.ResponseWriter) error {
+type EventKeyList200JSONResponse EventKeyList
+
+func (response EventKeyList200JSONResponse) VisitEventKeyListResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type StepRunUpdateRerun400JSONResponse APIErrors
+type EventKeyList400JSONResponse APIErrors
 
-func (response StepRunUpdateRerun400JSONResponse) VisitStepRunUpdateRerunResponse(w http.ResponseWriter) error {
+func (response EventKeyList400JSONResponse) VisitEventKeyListResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type StepRunUpdateRerun403JSONResponse APIErrors
+type EventKeyList403JSONResponse APIErrors
 
-func (response StepRunUpdateRerun403JSONResponse) VisitStepRunUpdateRerunResponse(w http.ResponseWriter) error {
+func (response EventKeyList403JSONResponse) VisitEventKeyListResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type StepRunGetSchemaRequestObject struct {
-	Tenant  openapi_types.UUID `json:"tenant"`
-	StepRun openapi_types.UUID `json:"step-run"`
+type EventUpdateReplayRequestObject struct {
+	Tenant openapi_types.UUID `json:"tenant"`
+	Body   *EventUpdateReplayJSONRequestBody
 }
 
-type StepRunGetSchemaResponseObject interface {
-	VisitStepRunGetSchemaResponse(w http.ResponseWriter) error
+type EventUpdateReplayResponseObject interface {
+	VisitEventUpdateReplayResponse(w http.ResponseWriter) error
 }
 
-type StepRunGetSchema200JSONResponse map[string]interface{}
+type EventUpdateReplay200JSONResponse EventList
 
-func (response StepRunGetSchema200JSONResponse) VisitStepRunGetSchemaResponse(w http.ResponseWriter) error {
+func (response EventUpdateReplay200JSONResponse) VisitEventUpdateReplayResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type StepRunGetSchema400JSONResponse APIErrors
+type EventUpdateReplay400JSONResponse APIErrors
 
-func (response StepRunGetSchema400JSONResponse) VisitStepRunGetSchemaResponse(w http.ResponseWriter) error {
+func (response EventUpdateReplay400JSONResponse) VisitEventUpdateReplayResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type StepRunGetSchema403JSONResponse APIErrors
+type EventUpdateReplay403JSONResponse APIErrors
 
-func (response StepRunGetSchema403JSONResponse) VisitStepRunGetSchemaResponse(w http.ResponseWriter) error {
+func (response EventUpdateReplay403JSONResponse) VisitEventUpdateReplayResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type StepRunGetSchema404JSONResponse APIErrors
+type EventUpdateReplay429JSONResponse APIErrors
 
-func (response StepRunGetSchema404JSONResponse) VisitStepRunGetSchemaResponse(w http.ResponseWriter) error {
+func (response EventUpdateReplay429JSONResponse) VisitEventUpdateReplayResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	w.WriteHeader(429)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type TenantGetTaskStatsRequestObject struct {
-	Tenant openapi_types.UUID `json:"tenant"`
-	Params TenantGetTaskStatsParams
+type EventDataGetWithTenantRequestObject struct {
+	Tenant          openapi_types.UUID `json:"tenant"`
+	EventWithTenant openapi_types.UUID `json:"event-with-tenant"`
 }
 
-type TenantGetTaskStatsResponseObject interface {
-	VisitTenantGetTaskStatsResponse(w http.ResponseWriter) error
+type EventDataGetWithTenantResponseObject interface {
+	VisitEventDataGetWithTenantResponse(w http.ResponseWriter) error
 }
 
-type TenantGetTaskStats200JSONResponse TaskStats
+type EventDataGetWithTenant200JSONResponse EventData
 
-func (response TenantGetTaskStats200JSONResponse) VisitTenantGetTaskStatsResponse(w http.ResponseWriter) error {
+func (response EventDataGetWithTenant200JSONResponse) VisitEventDataGetWithTenantResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type TenantGetTaskStats400JSONResponse APIErrors
+type EventDataGetWithTenant400JSONResponse APIErrors
 
-func (response TenantGetTaskStats400JSONResponse) VisitTenantGetTaskStatsResponse(w http.ResponseWriter) error {
+func (response EventDataGetWithTenant400JSONResponse) VisitEventDataGetWithTenantResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type TenantGetTaskStats403JSONResponse APIErrors
+type EventDataGetWithTenant403JSONResponse APIErrors
 
-func (response TenantGetTaskStats403JSONResponse) VisitTenantGetTaskStatsResponse(w http.ResponseWriter) error {
+func (response EventDataGetWithTenant403JSONResponse) VisitEventDataGetWithTenantResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type TenantGetTaskStats404JSONResponse APIErrors
-
-func (response TenantGetTaskStats404JSONResponse) VisitTenantGetTaskStatsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
-
-	return json.NewEncoder(w).Encode(response)
-}
-
-type WebhookListRequestObject struct {
+type TenantFeatureFlagEvaluateRequestObject struct {
 	Tenant openapi_types.UUID `json:"tenant"`
+	Params TenantFeatureFlagEvaluateParams
 }
 
-type WebhookListResponseObject interface {
-	VisitWebhookListResponse(w http.ResponseWriter) error
+type TenantFeatureFlagEvaluateResponseObject interface {
+	VisitTenantFeatureFlagEvaluateResponse(w http.ResponseWriter) error
 }
 
-type WebhookList200JSONResponse WebhookWorkerListResponse
+type TenantFeatureFlagEvaluate200JSONResponse FeatureFlagEvaluationResult
 
-func (response WebhookList200JSONResponse) VisitWebhookListResponse(w http.ResponseWriter) error {
+func (response TenantFeatureFlagEvaluate200JSONResponse) VisitTenantFeatureFlagEvaluateResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type WebhookList400JSONResponse APIErrors
+type TenantFeatureFlagEvaluate400JSONResponse APIErrors
 
-func (response WebhookList400JSONResponse) VisitWebhookListResponse(w http.ResponseWriter) error {
+func (response TenantFeatureFlagEvaluate400JSONResponse) VisitTenantFeatureFlagEvaluateResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type WebhookList401JSONResponse APIErrors
+type TenantFeatureFlagEvaluate403JSONResponse APIErrors
 
-func (response WebhookList401JSONResponse) VisitWebhookListResponse(w http.ResponseWriter) error {
+func (response TenantFeatureFlagEvaluate403JSONResponse) VisitTenantFeatureFlagEvaluateResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type WebhookList405JSONResponse APIErrors
+type TenantFeatureFlagEvaluate404JSONResponse APIErrors
 
-func (response WebhookList405JSONResponse) VisitWebhookListResponse(w http.ResponseWriter) error {
+func (response TenantFeatureFlagEvaluate404JSONResponse) VisitTenantFeatureFlagEvaluateResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(405)
+	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type WebhookCreateRequestObject struct {
+type TenantInviteListRequestObject struct {
 	Tenant openapi_types.UUID `json:"tenant"`
-	Body   *WebhookCreateJSONRequestBody
 }
 
-type WebhookCreateResponseObject interface {
-	VisitWebhookCreateResponse(w http.ResponseWriter) error
+type TenantInviteListResponseObject interface {
+	VisitTenantInviteListResponse(w http.ResponseWriter) error
 }
 
-type WebhookCreate200JSONResponse WebhookWorkerCreated
+type TenantInviteList200JSONResponse TenantInviteList
 
-func (response WebhookCreate200JSONResponse) VisitWebhookCreateResponse(w http.ResponseWriter) error {
+func (response TenantInviteList200JSONResponse) VisitTenantInviteListResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type WebhookCreate400JSONResponse APIErrors
+type TenantInviteList400JSONResponse APIErrors
 
-func (response WebhookCreate400JSONResponse) VisitWebhookCreateResponse(w http.ResponseWriter) error {
+func (response TenantInviteList400JSONResponse) VisitTenantInviteListResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type WebhookCreate401JSONResponse APIErrors
-
-func (response WebhookCreate401JSONResponse) VisitWebhookCreateResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
-
-	return json.NewEncoder(w).Encode(response)
-}
-
-type WebhookCreate405JSONResponse APIErrors
+type TenantInviteList403JSONResponse APIError
 
-func (response WebhookCreate405JSONResponse) VisitWebhookCreateResponse(w http.ResponseWriter) error {
+func (response TenantInviteList403JSONResponse) VisitTenantInviteListResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(405)
+	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type WorkerListRequestObject struct {
+type TenantInviteCreateRequestObject struct {
 	Tenant openapi_types.UUID `json:"tenant"`
-	Params WorkerListParams
+	Body   *TenantInviteCreateJSONRequestBody
 }
 
-type WorkerListResponseObject interface {
-	VisitWorkerListResponse(w http.ResponseWriter) error
+type TenantInviteCreateResponseObject interface {
+	VisitTenantInviteCreateResponse(w http.ResponseWriter) error
 }
 
-type WorkerList200JSONResponse WorkerList
+type TenantInviteCreate201JSONResponse TenantInvite
 
-func (response WorkerList200JSONResponse) VisitWorkerListResponse(w http.ResponseWriter) error {
+func (response TenantInviteCreate201JSONResponse) VisitTenantInviteCreateResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	w.WriteHeader(201)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type WorkerList400JSONResponse APIErrors
+type TenantInviteCreate400JSONResponse APIErrors
 
-func (response WorkerList400JSONResponse) VisitWorkerListResponse(w http.ResponseWriter) error {
+func (response TenantInviteCreate400JSONResponse) VisitTenantInviteCreateResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type WorkerList403JSONResponse APIErrors
+type TenantInviteCreate403JSONResponse APIError
 
-func (response WorkerList403JSONResponse) VisitWorkerListResponse(w http.ResponseWriter) error {
+func (response TenantInviteCreate403JSONResponse) VisitTenantInviteCreateResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type WorkflowRunUpdateReplayRequestObject struct {
-	Tenant openapi_types.UUID `json:"tenant"`
-	Body   *WorkflowRunUpdateReplayJSONRequestBody
+type TenantInviteCreate422JSONResponse APIErrors
+
+func (response TenantInviteCreate422JSONResponse) VisitTenantInviteCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type WorkflowRunUpdateReplayResponseObject interface {
-	VisitWorkflowRunUpdateReplayResponse(w http.ResponseWriter) error
+type TenantInviteDeleteRequestObject struct {
+	Tenant       openapi_types.UUID `json:"tenant"`
+	TenantInvite openapi_types.UUID `json:"tenant-invite"`
 }
 
-type WorkflowRunUpdateReplay200JSONResponse ReplayWorkflowRunsResponse
+type TenantInviteDeleteResponseObject interface {
+	VisitTenantInviteDeleteResponse(w http.ResponseWriter) error
+}
 
-func (response WorkflowRunUpdateReplay200JSONResponse) VisitWorkflowRunUpdateReplayResponse(w http.ResponseWriter) error {
+type TenantInviteDelete200JSONResponse TenantInvite
+
+func (response TenantInviteDelete200JSONResponse) VisitTenantInviteDeleteResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type WorkflowRunUpdateReplay400JSONResponse APIErrors
+type TenantInviteDelete400JSONResponse APIErrors
 
-func (response WorkflowRunUpdateReplay400JSONResponse) VisitWorkflowRunUpdateReplayResponse(w http.ResponseWriter) error {
+func (response TenantInviteDelete400JSONResponse) VisitTenantInviteDeleteResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type WorkflowRunUpdateReplay403JSONResponse APIErrors
+type TenantInviteUpdateRequestObject struct {
+	Tenant       openapi_types.UUID `json:"tenant"`
+	TenantInvite openapi_types.UUID `json:"tenant-invite"`
+	Body         *TenantInviteUpdateJSONRequestBody
+}
 
-func (response WorkflowRunUpdateReplay403JSONResponse) VisitWorkflowRunUpdateReplayResponse(w http.ResponseWriter) error {
+type TenantInviteUpdateResponseObject interface {
+	VisitTenantInviteUpdateResponse(w http.ResponseWriter) error
+}
+
+type TenantInviteUpdate200JSONResponse TenantInvite
+
+func (response TenantInviteUpdate200JSONResponse) VisitTenantInviteUpdateResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type WorkflowRunUpdateReplay429JSONResponse APIErrors
+type TenantInviteUpdate400JSONResponse APIErrors
 
-func (response WorkflowRunUpdateReplay429JSONResponse) VisitWorkflowRunUpdateReplayResponse(w http.ResponseWriter) error {
+func (response TenantInviteUpdate400JSONResponse) VisitTenantInviteUpdateResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(429)
+	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type WorkflowRunGetRequestObject struct {
-	Tenant      openapi_types.UUID `json:"tenant"`
-	WorkflowRun openapi_types.UUID `json:"workflow-run"`
+type TenantMemberListRequestObject struct {
+	Tenant openapi_types.UUID `json:"tenant"`
 }
 
-type WorkflowRunGetResponseObject interface {
-	VisitWorkflowRunGetResponse(w http.ResponseWriter) error
+type TenantMemberListResponseObject interface {
+	VisitTenantMemberListResponse(w http.ResponseWriter) error
 }
 
-type WorkflowRunGet200JSONResponse WorkflowRun
+type TenantMemberList200JSONResponse TenantMemberList
 
-func (response WorkflowRunGet200JSONResponse) VisitWorkflowRunGetResponse(w http.ResponseWriter) error {
+func (response TenantMemberList200JSONResponse) VisitTenantMemberListResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type WorkflowRunGet400JSONResponse APIErrors
+type TenantMemberList400JSONResponse APIErrors
 
-func (response WorkflowRunGet400JSONResponse) VisitWorkflowRunGetResponse(w http.ResponseWriter) error {
+func (response TenantMemberList400JSONResponse) VisitTenantMemberListResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type WorkflowRunGet403JSONResponse APIErrors
+type TenantMemberList403JSONResponse APIError
 
-func (response WorkflowRunGet403JSONResponse) VisitWorkflowRunGetResponse(w http.ResponseWriter) error {
+func (response TenantMemberList403JSONResponse) VisitTenantMemberListResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type WorkflowRunGetInputRequestObject struct {
-	Tenant      openapi_types.UUID `json:"tenant"`
-	WorkflowRun openapi_types.UUID `json:"workflow-run"`
+type TenantMemberDeleteRequestObject struct {
+	Tenant openapi_types.UUID `json:"tenant"`
+	Member openapi_types.UUID `json:"member"`
 }
 
-type WorkflowRunGetInputResponseObject interface {
-	VisitWorkflowRunGetInputResponse(w http.ResponseWriter) error
+type TenantMemberDeleteResponseObject interface {
+	VisitTenantMemberDeleteResponse(w http.ResponseWriter) error
 }
 
-type WorkflowRunGetInput200JSONResponse map[string]interface{}
+type TenantMemberDelete204JSONResponse TenantMember
 
-func (response WorkflowRunGetInput200JSONResponse) VisitWorkflowRunGetInputResponse(w http.ResponseWriter) error {
+func (response TenantMemberDelete204JSONResponse) VisitTenantMemberDeleteResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	w.WriteHeader(204)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type WorkflowRunGetInput400JSONResponse APIErrors
+type TenantMemberDelete400JSONResponse APIErrors
 
-func (response WorkflowRunGetInput400JSONResponse) VisitWorkflowRunGetInputResponse(w http.ResponseWriter) error {
+func (response TenantMemberDelete400JSONResponse) VisitTenantMemberDeleteResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type WorkflowRunGetInput403JSONResponse APIErrors
+type TenantMemberDelete403JSONResponse APIErrors
 
-func (response WorkflowRunGetInput403JSONResponse) VisitWorkflowRunGetInputResponse(w http.ResponseWriter) error {
+func (response TenantMemberDelete403JSONResponse) VisitTenantMemberDeleteResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type WorkflowRunGetInput404JSONResponse APIErrors
+type TenantMemberDelete404JSONResponse APIErrors
 
-func (response WorkflowRunGetInput404JSONResponse) VisitWorkflowRunGetInputResponse(w http.ResponseWriter) error {
+func (response TenantMemberDelete404JSONResponse) VisitTenantMemberDeleteResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type WorkflowRunGetShapeRequestObject struct {
-	Tenant      openapi_types.UUID `json:"tenant"`
-	WorkflowRun openapi_types.UUID `json:"workflow-run"`
+type TenantMemberUpdateRequestObject struct {
+	Tenant openapi_types.UUID `json:"tenant"`
+	Member openapi_types.UUID `json:"member"`
+	Body   *TenantMemberUpdateJSONRequestBody
 }
 
-type WorkflowRunGetShapeResponseObject interface {
-	VisitWorkflowRunGetShapeResponse(w http.ResponseWriter) error
+type TenantMemberUpdateResponseObject interface {
+	VisitTenantMemberUpdateResponse(w http.ResponseWriter) error
 }
 
-type WorkflowRunGetShape200JSONResponse WorkflowRunShape
+type TenantMemberUpdate200JSONResponse TenantMember
 
-func (response WorkflowRunGetShape200JSONResponse) VisitWorkflowRunGetShapeResponse(w http.ResponseWriter) error {
+func (response TenantMemberUpdate200JSONResponse) VisitTenantMemberUpdateResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type WorkflowRunGetShape400JSONResponse APIErrors
+type TenantMemberUpdate400JSONResponse APIErrors
 
-func (response WorkflowRunGetShape400JSONResponse) VisitWorkflowRunGetShapeResponse(w http.ResponseWriter) error {
+func (response TenantMemberUpdate400JSONResponse) VisitTenantMemberUpdateResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type WorkflowRunGetShape403JSONResponse APIErrors
+type TenantMemberUpdate403JSONResponse APIErrors
 
-func (response WorkflowRunGetShape403JSONResponse) VisitWorkflowRunGetShapeResponse(w http.ResponseWriter) error {
+func (response TenantMemberUpdate403JSONResponse) VisitTenantMemberUpdateResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type WorkflowRunListStepRunEventsRequestObject struct {
-	Tenant      openapi_types.UUID `json:"tenant"`
-	WorkflowRun openapi_types.UUID `json:"workflow-run"`
-	Params      WorkflowRunListStepRunEventsParams
+type TenantMemberUpdate404JSONResponse APIErrors
+
+func (response TenantMemberUpdate404JSONResponse) VisitTenantMemberUpdateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type WorkflowRunListStepRunEventsResponseObject interface {
-	VisitWorkflowRunListStepRunEventsResponse(w http.ResponseWriter) error
+type TenantGetPrometheusMetricsRequestObject struct {
+	Tenant openapi_types.UUID `json:"tenant"`
 }
 
-type WorkflowRunListStepRunEvents200JSONResponse StepRunEventList
+type TenantGetPrometheusMetricsResponseObject interface {
+	VisitTenantGetPrometheusMetricsResponse(w http.ResponseWriter) error
+}
 
-func (response WorkflowRunListStepRunEvents200JSONResponse) VisitWorkflowRunListStepRunEventsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
+type TenantGetPrometheusMetrics200TextResponse string
+
+func (response TenantGetPrometheusMetrics200TextResponse) VisitTenantGetPrometheusMetricsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "text/plain")
 	w.WriteHeader(200)
 
-	return json.NewEncoder(w).Encode(response)
+	_, err := w.Write([]byte(response))
+	return err
 }
 
-type WorkflowRunListStepRunEvents400JSONResponse APIErrors
+type TenantGetPrometheusMetrics400JSONResponse APIErrors
 
-func (response WorkflowRunListStepRunEvents400JSONResponse) VisitWorkflowRunListStepRunEventsResponse(w http.ResponseWriter) error {
+func (response TenantGetPrometheusMetrics400JSONResponse) VisitTenantGetPrometheusMetricsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type WorkflowRunListStepRunEvents403JSONResponse APIErrors
+type TenantGetPrometheusMetrics403JSONResponse APIErrors
 
-func (response WorkflowRunListStepRunEvents403JSONResponse) VisitWorkflowRunListStepRunEventsResponse(w http.ResponseWriter) error {
+func (response TenantGetPrometheusMetrics403JSONResponse) VisitTenantGetPrometheusMetricsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type WorkflowRunListStepRunEvents404JSONResponse APIErrors
+type TenantGetPrometheusMetrics404JSONResponse APIErrors
 
-func (response WorkflowRunListStepRunEvents404JSONResponse) VisitWorkflowRunListStepRunEventsResponse(w http.ResponseWriter) error {
+func (response TenantGetPrometheusMetrics404JSONResponse) VisitTenantGetPrometheusMetricsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type WorkflowListRequestObject struct {
+type TenantGetQueueMetricsRequestObject struct {
 	Tenant openapi_types.UUID `json:"tenant"`
-	Params WorkflowListParams
+	Params TenantGetQueueMetricsParams
 }
 
-type WorkflowListResponseObject interface {
-	VisitWorkflowListResponse(w http.ResponseWriter) error
-}
+type TenantGetQueueMetricsResponseObject interface {
+	VisitTenantGetQueueMetricsResponse(w http.ResponseWriter) error
+}
 
-type WorkflowList200JSONResponse WorkflowList
+type TenantGetQueueMetrics200JSONResponse TenantQueueMetrics
 
-func (response WorkflowList200JSONResponse) VisitWorkflowListResponse(w http.ResponseWriter) error {
+func (response TenantGetQueueMetrics200JSONResponse) VisitTenantGetQueueMetricsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type WorkflowList400JSONResponse APIErrors
+type TenantGetQueueMetrics400JSONResponse APIErrors
 
-func (response WorkflowList400JSONResponse) VisitWorkflowListResponse(w http.ResponseWriter) error {
+func (response TenantGetQueueMetrics400JSONResponse) VisitTenantGetQueueMetricsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type WorkflowList403JSONResponse APIErrors
+type TenantGetQueueMetrics403JSONResponse APIErrors
 
-func (response WorkflowList403JSONResponse) VisitWorkflowListResponse(w http.ResponseWriter) error {
+func (response TenantGetQueueMetrics403JSONResponse) VisitTenantGetQueueMetricsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type WorkflowRunCancelRequestObject struct {
-	Tenant openapi_types.UUID `json:"tenant"`
-	Body   *WorkflowRunCancelJSONRequestBody
+type TenantGetQueueMetrics404JSONResponse APIErrors
+
+func (response TenantGetQueueMetrics404JSONResponse) VisitTenantGetQueueMetricsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type WorkflowRunCancelResponseObject interface {
-	VisitWorkflowRunCancelResponse(w http.ResponseWriter) error
+type RateLimitDeleteRequestObject struct {
+	Tenant openapi_types.UUID `json:"tenant"`
+	Params RateLimitDeleteParams
 }
 
-type WorkflowRunCancel200JSONResponse struct {
-	WorkflowRunIds *[]openapi_types.UUID `json:"workflowRunIds,omitempty"`
+type RateLimitDeleteResponseObject interface {
+	VisitRateLimitDeleteResponse(w http.ResponseWriter) error
 }
 
-func (response WorkflowRunCancel200JSONResponse) VisitWorkflowRunCancelResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+type RateLimitDelete204Response struct {
+}
 
-	return json.NewEncoder(w).Encode(response)
+func (response RateLimitDelete204Response) VisitRateLimitDeleteResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
 }
 
-type WorkflowRunCancel400JSONResponse APIErrors
+type RateLimitDelete400JSONResponse APIErrors
 
-func (response WorkflowRunCancel400JSONResponse) VisitWorkflowRunCancelResponse(w http.ResponseWriter) error {
+func (response RateLimitDelete400JSONResponse) VisitRateLimitDeleteResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type WorkflowRunCancel403JSONResponse APIErrors
+type RateLimitDelete403JSONResponse APIErrors
 
-func (response WorkflowRunCancel403JSONResponse) VisitWorkflowRunCancelResponse(w http.ResponseWriter) error {
+func (response RateLimitDelete403JSONResponse) VisitRateLimitDeleteResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CronWorkflowListRequestObject struct {
+type RateLimitListRequestObject struct {
 	Tenant openapi_types.UUID `json:"tenant"`
-	Params CronWorkflowListParams
+	Params RateLimitListParams
 }
 
-type CronWorkflowListResponseObject interface {
-	VisitCronWorkflowListResponse(w http.ResponseWriter) error
+type RateLimitListResponseObject interface {
+	VisitRateLimitListResponse(w http.ResponseWriter) error
 }
 
-type CronWorkflowList200JSONResponse CronWorkflowsList
+type RateLimitList200JSONResponse RateLimitList
 
-func (response CronWorkflowList200JSONResponse) VisitCronWorkflowListResponse(w http.ResponseWriter) error {
+func (response RateLimitList200JSONResponse) VisitRateLimitListResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CronWorkflowList400JSONResponse APIErrors
+type RateLimitList400JSONResponse APIErrors
 
-func (response CronWorkflowList400JSONResponse) VisitCronWorkflowListResponse(w http.ResponseWriter) error {
+func (response RateLimitList400JSONResponse) VisitRateLimitListResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CronWorkflowList403JSONResponse APIErrors
+type RateLimitList403JSONResponse APIErrors
 
-func (response CronWorkflowList403JSONResponse) VisitCronWorkflowListResponse(w http.ResponseWriter) error {
+func (response RateLimitList403JSONResponse) VisitRateLimitListResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type WorkflowCronDeleteRequestObject struct {
-	Tenant       openapi_types.UUID `json:"tenant"`
-	CronWorkflow openapi_types.UUID `json:"cron-workflow"`
+type TenantResourcePolicyGetRequestObject struct {
+	Tenant openapi_types.UUID `json:"tenant"`
 }
 
-type WorkflowCronDeleteResponseObject interface {
-	VisitWorkflowCronDeleteResponse(w http.ResponseWriter) error
+type TenantResourcePolicyGetResponseObject interface {
+	VisitTenantResourcePolicyGetResponse(w http.ResponseWriter) error
 }
 
-type WorkflowCronDelete204Response struct {
-}
+type TenantResourcePolicyGet200JSONResponse TenantResourcePolicy
 
-func (response WorkflowCronDelete204Response) VisitWorkflowCronDeleteResponse(w http.ResponseWriter) error {
-	w.WriteHeader(204)
-	return nil
+func (response TenantResourcePolicyGet200JSONResponse) VisitTenantResourcePolicyGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type WorkflowCronDelete400JSONResponse APIErrors
+type TenantResourcePolicyGet400JSONResponse APIErrors
 
-func (response WorkflowCronDelete400JSONResponse) VisitWorkflowCronDeleteResponse(w http.ResponseWriter) error {
+func (response TenantResourcePolicyGet400JSONResponse) VisitTenantResourcePolicyGetResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type WorkflowCronDelete403JSONResponse APIError
+type TenantResourcePolicyGet403JSONResponse APIError
 
-func (response WorkflowCronDelete403JSONResponse) VisitWorkflowCronDeleteResponse(w http.ResponseWriter) error {
+func (response TenantResourcePolicyGet403JSONResponse) VisitTenantResourcePolicyGetResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type WorkflowCronGetRequestObject struct {
-	Tenant       openapi_types.UUID `json:"tenant"`
-	CronWorkflow openapi_types.UUID `json:"cron-workflow"`
+type SlackWebhookListRequestObject struct {
+	Tenant openapi_types.UUID `json:"tenant"`
 }
 
-type WorkflowCronGetResponseObject interface {
-	VisitWorkflowCronGetResponse(w http.ResponseWriter) error
+type SlackWebhookListResponseObject interface {
+	VisitSlackWebhookListResponse(w http.ResponseWriter) error
 }
 
-type WorkflowCronGet200JSONResponse CronWorkflows
+type SlackWebhookList200JSONResponse ListSlackWebhooks
 
-func (response WorkflowCronGet200JSONResponse) VisitWorkflowCronGetResponse(w http.ResponseWriter) error {
+func (response SlackWebhookList200JSONResponse) VisitSlackWebhookListResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type WorkflowCronGet400JSONResponse APIErrors
+type SlackWebhookList400JSONResponse APIErrors
 
-func (response WorkflowCronGet400JSONResponse) VisitWorkflowCronGetResponse(w http.ResponseWriter) error {
+func (response SlackWebhookList400JSONResponse) VisitSlackWebhookListResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type WorkflowCronGet403JSONResponse APIErrors
+type SlackWebhookList401JSONResponse APIErrors
 
-func (response WorkflowCronGet403JSONResponse) VisitWorkflowCronGetResponse(w http.ResponseWriter) error {
+func (response SlackWebhookList401JSONResponse) VisitSlackWebhookListResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type WorkflowCronGet404JSONResponse APIErrors
+type SlackWebhookList405JSONResponse APIErrors
 
-func (response WorkflowCronGet404JSONResponse) VisitWorkflowCronGetResponse(w http.ResponseWriter) error {
+func (response SlackWebhookList405JSONResponse) VisitSlackWebhookListResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	w.WriteHeader(405)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type WorkflowCronUpdateRequestObject struct {
-	Tenant       openapi_types.UUID `json:"tenant"`
-	CronWorkflow openapi_types.UUID `json:"cron-workflow"`
-	Body         *WorkflowCronUpdateJSONRequestBody
-}
-
-type WorkflowCronUpdateResponseObject interface {
-	VisitWorkflowCronUpdateResponse(w http.ResponseWriter) error
+type UserUpdateSlackOauthStartRequestObject struct {
+	Tenant openapi_types.UUID `json:"tenant"`
 }
 
-type WorkflowCronUpdate204Response struct {
+type UserUpdateSlackOauthStartResponseObject interface {
+	VisitUserUpdateSlackOauthStartResponse(w http.ResponseWriter) error
 }
 
-func (response WorkflowCronUpdate204Response) VisitWorkflowCronUpdateResponse(w http.ResponseWriter) error {
-	w.WriteHeader(204)
-	return nil
+type UserUpdateSlackOauthStart302ResponseHeaders struct {
+	Location string
 }
 
-type WorkflowCronUpdate400JSONResponse APIErrors
-
-func (response WorkflowCronUpdate400JSONResponse) VisitWorkflowCronUpdateResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
-
-	return json.NewEncoder(w).Encode(response)
+type UserUpdateSlackOauthStart302Response struct {
+	Headers UserUpdateSlackOauthStart302ResponseHeaders
 }
 
-type WorkflowCronUpdate403JSONResponse APIError
-
-func (response WorkflowCronUpdate403JSONResponse) VisitWorkflowCronUpdateResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
-
-	return json.NewEncoder(w).Encode(response)
+func (response UserUpdateSlackOauthStart302Response) VisitUserUpdateSlackOauthStartResponse(w http.ResponseWriter) error {
+	w.Header().Set("location", fmt.Sprint(response.Headers.Location))
+	w.WriteHeader(302)
+	return nil
 }
 
-type WorkflowCronTriggerRequestObject struct {
-	Tenant       openapi_types.UUID `json:"tenant"`
-	CronWorkflow openapi_types.UUID `json:"cron-workflow"`
+type SnsListRequestObject struct {
+	Tenant openapi_types.UUID `json:"tenant"`
 }
 
-type WorkflowCronTriggerResponseObject interface {
-	VisitWorkflowCronTriggerResponse(w http.ResponseWriter) error
+type SnsListResponseObject interface {
+	VisitSnsListResponse(w http.ResponseWriter) error
 }
 
-type WorkflowCronTrigger200JSONResponse TriggerRunResult
+type SnsList200JSONResponse ListSNSIntegrations
 
-func (response WorkflowCronTrigger200JSONResponse) VisitWorkflowCronTriggerResponse(w http.ResponseWriter) error {
+func (response SnsList200JSONResponse) VisitSnsListResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type WorkflowCronTrigger400JSONResponse APIErrors
+type SnsList400JSONResponse APIErrors
 
-func (response WorkflowCronTrigger400JSONResponse) VisitWorkflowCronTriggerResponse(w http.ResponseWriter) error {
+func (response SnsList400JSONResponse) VisitSnsListResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type WorkflowCronTrigger403JSONResponse APIError
-
-func (response WorkflowCronTrigger403JSONResponse) VisitWorkflowCronTriggerResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
-
-	return json.NewEncoder(w).Encode(response)
-}
-
-type WorkflowCronTrigger404JSONResponse APIErrors
+type SnsList401JSONResponse APIErrors
 
-func (response WorkflowCronTrigger404JSONResponse) VisitWorkflowCronTriggerResponse(w http.ResponseWriter) error {
+func (response SnsList401JSONResponse) VisitSnsListResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type WorkflowCronTrigger500JSONResponse APIErrors
+type SnsList405JSONResponse APIErrors
 
-func (response WorkflowCronTrigger500JSONResponse) VisitWorkflowCronTriggerResponse(w http.ResponseWriter) error {
+func (response SnsList405JSONResponse) VisitSnsListResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	w.WriteHeader(405)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type WorkflowRunListRequestObject struct {
+type SnsCreateRequestObject struct {
 	Tenant openapi_types.UUID `json:"tenant"`
-	Params WorkflowRunListParams
+	Body   *SnsCreateJSONRequestBody
 }
 
-type WorkflowRunListResponseObject interface {
-	VisitWorkflowRunListResponse(w http.ResponseWriter) error
+type SnsCreateResponseObject interface {
+	VisitSnsCreateResponse(w http.ResponseWriter) error
 }
 
-type WorkflowRunList200JSONResponse WorkflowRunList
+type SnsCreate201JSONResponse SNSIntegration
 
-func (response WorkflowRunList200JSONResponse) VisitWorkflowRunListResponse(w http.ResponseWriter) error {
+func (response SnsCreate201JSONResponse) VisitSnsCreateResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	w.WriteHeader(201)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type WorkflowRunList400JSONResponse APIErrors
+type SnsCreate400JSONResponse APIErrors
 
-func (response WorkflowRunList400JSONResponse) VisitWorkflowRunListResponse(w http.ResponseWriter) error {
+func (response SnsCreate400JSONResponse) VisitSnsCreateResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type WorkflowRunList403JSONResponse APIErrors
+type SnsCreate401JSONResponse APIErrors
 
-func (response WorkflowRunList403JSONResponse) VisitWorkflowRunListResponse(w http.ResponseWriter) error {
+func (response SnsCreate401JSONResponse) VisitSnsCreateResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type WorkflowRunGetMetricsRequestObject struct {
+type SnsCreate405JSONResponse APIErrors
+
+func (response SnsCreate405JSONResponse) VisitSnsCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(405)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TenantGetStepRunQueueMetricsRequestObject struct {
 	Tenant openapi_types.UUID `json:"tenant"`
-	Params WorkflowRunGetMetricsParams
 }
 
-type WorkflowRunGetMetricsResponseObject interface {
-	VisitWorkflowRunGetMetricsResponse(w http.ResponseWriter) error
+type TenantGetStepRunQueueMetricsResponseObject interface {
+	VisitTenantGetStepRunQueueMetricsResponse(w http.ResponseWriter) error
 }
 
-type WorkflowRunGetMetrics200JSONResponse WorkflowRunsMetrics
+type TenantGetStepRunQueueMetrics200JSONResponse TenantStepRunQueueMetrics
 
-func (response WorkflowRunGetMetrics200JSONResponse) VisitWorkflowRunGetMetricsResponse(w http.ResponseWriter) error {
+func (response TenantGetStepRunQueueMetrics200JSONResponse) VisitTenantGetStepRunQueueMetricsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type WorkflowRunGetMetrics400JSONResponse APIErrors
+type TenantGetStepRunQueueMetrics400JSONResponse APIErrors
 
-func (response WorkflowRunGetMetrics400JSONResponse) VisitWorkflowRunGetMetricsResponse(w http.ResponseWriter) error {
+func (response TenantGetStepRunQueueMetrics400JSONResponse) VisitTenantGetStepRunQueueMetricsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type WorkflowRunGetMetrics403JSONResponse APIErrors
+type TenantGetStepRunQueueMetrics403JSONResponse APIErrors
 
-func (response WorkflowRunGetMetrics403JSONResponse) VisitWorkflowRunGetMetricsResponse(w http.ResponseWriter) error {
+func (response TenantGetStepRunQueueMetrics403JSONResponse) VisitTenantGetStepRunQueueMetricsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type WorkflowScheduledListRequestObject struct {
-	Tenant openapi_types.UUID `json:"tenant"`
-	Params WorkflowScheduledListParams
+type TenantGetStepRunQueueMetrics404JSONResponse APIErrors
+
+func (response TenantGetStepRunQueueMetrics404JSONResponse) VisitTenantGetStepRunQueueMetricsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type WorkflowScheduledListResponseObject interface {
-	VisitWorkflowScheduledListResponse(w http.ResponseWriter) error
+type StepRunGetRequestObject struct {
+	Tenant  openapi_types.UUID `json:"tenant"`
+	StepRun openapi_types.UUID `json:"step-run"`
 }
 
-type WorkflowScheduledList200JSONResponse ScheduledWorkflowsList
+type StepRunGetResponseObject interface {
+	VisitStepRunGetResponse(w http.ResponseWriter) error
+}
 
-func (response WorkflowScheduledList200JSONResponse) VisitWorkflowScheduledListResponse(w http.ResponseWriter) error {
+type StepRunGet200JSONResponse StepRun
+
+func (response StepRunGet200JSONResponse) VisitStepRunGetResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type WorkflowScheduledList400JSONResponse APIErrors
+type StepRunGet400JSONResponse APIErrors
 
-func (response WorkflowScheduledList400JSONResponse) VisitWorkflowScheduledListResponse(w http.ResponseWriter) error {
+func (response StepRunGet400JSONResponse) VisitStepRunGetResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type WorkflowScheduledList403JSONResponse APIErrors
+type StepRunGet403JSONResponse APIErrors
 
-func (response WorkflowScheduledList403JSONResponse) VisitWorkflowScheduledListResponse(w http.ResponseWriter) error {
+func (response StepRunGet403JSONResponse) VisitStepRunGetResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type WorkflowScheduledBulkDeleteRequestObject struct {
-	Tenant openapi_types.UUID `json:"tenant"`
-	Body   *WorkflowScheduledBulkDeleteJSONRequestBody
+type StepRunGet404JSONResponse APIErrors
+
+func (response StepRunGet404JSONResponse) VisitStepRunGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type WorkflowScheduledBulkDeleteResponseObject interface {
-	VisitWorkflowScheduledBulkDeleteResponse(w http.ResponseWriter) error
+type StepRunUpdateCancelRequestObject struct {
+	Tenant  openapi_types.UUID `json:"tenant"`
+	StepRun openapi_types.UUID `json:"step-run"`
 }
 
-type WorkflowScheduledBulkDelete200JSONResponse ScheduledWorkflowsBulkDeleteResponse
+type StepRunUpdateCancelResponseObject interface {
+	VisitStepRunUpdateCancelResponse(w http.ResponseWriter) error
+}
 
-func (response WorkflowScheduledBulkDelete200JSONResponse) VisitWorkflowScheduledBulkDeleteResponse(w http.ResponseWriter) error {
+type StepRunUpdateCancel200JSONResponse StepRun
+
+func (response StepRunUpdateCancel200JSONResponse) VisitStepRunUpdateCancelResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type WorkflowScheduledBulkDelete400JSONResponse APIErrors
+type StepRunUpdateCancel400JSONResponse APIErrors
 
-func (response WorkflowScheduledBulkDelete400JSONResponse) VisitWorkflowScheduledBulkDeleteResponse(w http.ResponseWriter) error {
+func (response StepRunUpdateCancel400JSONResponse) VisitStepRunUpdateCancelResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type WorkflowScheduledBulkDelete403JSONResponse APIErrors
+type StepRunUpdateCancel403JSONResponse APIErrors
 
-func (response WorkflowScheduledBulkDelete403JSONResponse) VisitWorkflowScheduledBulkDeleteResponse(w http.ResponseWriter) error {
+func (response StepRunUpdateCancel403JSONResponse) VisitStepRunUpdateCancelResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type WorkflowScheduledBulkUpdateRequestObject struct {
-	Tenant openapi_types.UUID `json:"tenant"`
-	Body   *WorkflowScheduledBulkUpdateJSONRequestBody
+type StepRunUpdateRerunRequestObject struct {
+	Tenant  openapi_types.UUID `json:"tenant"`
+	StepRun openapi_types.UUID `json:"step-run"`
+	Body    *StepRunUpdateRerunJSONRequestBody
 }
 
-type WorkflowScheduledBulkUpdateResponseObject interface {
-	VisitWorkflowScheduledBulkUpdateResponse(w http.ResponseWriter) error
+type StepRunUpdateRerunResponseObject interface {
+	VisitStepRunUpdateRerunResponse(w http.ResponseWriter) error
 }
 
-type WorkflowScheduledBulkUpdate200JSONResponse ScheduledWorkflowsBulkUpdateResponse
+type StepRunUpdateRerun200JSONResponse StepRun
 
-func (response WorkflowScheduledBulkUpdate200JSONResponse) VisitWorkflowScheduledBulkUpdateResponse(w http.ResponseWriter) error {
+func (response StepRunUpdateRerun200JSONResponse) VisitStepRunUpdateRerunResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type WorkflowScheduledBulkUpdate400JSONResponse APIErrors
+type StepRunUpdateRerun400JSONResponse APIErrors
 
-func (response WorkflowScheduledBulkUpdate400JSONResponse) VisitWorkflowScheduledBulkUpdateResponse(w http.ResponseWriter) error {
+func (response StepRunUpdateRerun400JSONResponse) VisitStepRunUpdateRerunResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type WorkflowScheduledBulkUpdate403JSONResponse APIErrors
+type StepRunUpdateRerun403JSONResponse APIErrors
 
-func (response WorkflowScheduledBulkUpdate403JSONResponse) VisitWorkflowScheduledBulkUpdateResponse(w http.ResponseWriter) error {
+func (response StepRunUpdateRerun403JSONResponse) VisitStepRunUpdateRerunResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type WorkflowScheduledDeleteRequestObject struct {
-	Tenant               openapi_types.UUID `json:"tenant"`
-	ScheduledWorkflowRun openapi_types.UUID `json:"scheduled-workflow-run"`
+type StepRunGetSchemaRequestObject struct {
+	Tenant  openapi_types.UUID `json:"tenant"`
+	StepRun openapi_types.UUID `json:"step-run"`
 }
 
-type WorkflowScheduledDeleteResponseObject interface {
-	VisitWorkflowScheduledDeleteResponse(w http.ResponseWriter) error
+type StepRunGetSchemaResponseObject interface {
+	VisitStepRunGetSchemaResponse(w http.ResponseWriter) error
 }
 
-type WorkflowScheduledDelete204Response struct {
-}
+type StepRunGetSchema200JSONResponse map[string]interface{}
 
-func (response WorkflowScheduledDelete204Response) VisitWorkflowScheduledDeleteResponse(w http.ResponseWriter) error {
-	w.WriteHeader(204)
-	return nil
+func (response StepRunGetSchema200JSONResponse) VisitStepRunGetSchemaResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type WorkflowScheduledDelete400JSONResponse APIErrors
+type StepRunGetSchema400JSONResponse APIErrors
 
-func (response WorkflowScheduledDelete400JSONResponse) VisitWorkflowScheduledDeleteResponse(w http.ResponseWriter) error {
+func (response StepRunGetSchema400JSONResponse) VisitStepRunGetSchemaResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type WorkflowScheduledDelete403JSONResponse APIError
+type StepRunGetSchema403JSONResponse APIErrors
 
-func (response WorkflowScheduledDelete403JSONResponse) VisitWorkflowScheduledDeleteResponse(w http.ResponseWriter) error {
+func (response StepRunGetSchema403JSONResponse) VisitStepRunGetSchemaResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type WorkflowScheduledGetRequestObject struct {
-	Tenant               openapi_types.UUID `json:"tenant"`
-	ScheduledWorkflowRun openapi_types.UUID `json:"scheduled-workflow-run"`
+type StepRunGetSchema404JSONResponse APIErrors
+
+func (response StepRunGetSchema404JSONResponse) VisitStepRunGetSchemaResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type WorkflowScheduledGetResponseObject interface {
-	VisitWorkflowScheduledGetResponse(w http.ResponseWriter) error
+type TenantGetTaskStatsRequestObject struct {
+	Tenant openapi_types.UUID `json:"tenant"`
+	Params TenantGetTaskStatsParams
 }
 
-type WorkflowScheduledGet200JSONResponse ScheduledWorkflows
+type TenantGetTaskStatsResponseObject interface {
+	VisitTenantGetTaskStatsResponse(w http.ResponseWriter) error
+}
 
-func (response WorkflowScheduledGet200JSONResponse) VisitWorkflowScheduledGetResponse(w http.ResponseWriter) error {
+type TenantGetTaskStats200JSONResponse TaskStats
+
+func (response TenantGetTaskStats200JSONResponse) VisitTenantGetTaskStatsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type WorkflowScheduledGet400JSONResponse APIErrors
+type TenantGetTaskStats400JSONResponse APIErrors
 
-func (response WorkflowScheduledGet400JSONResponse) VisitWorkflowScheduledGetResponse(w http.ResponseWriter) error {
+func (response TenantGetTaskStats400JSONResponse) VisitTenantGetTaskStatsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type WorkflowScheduledGet403JSONResponse APIErrors
+type TenantGetTaskStats403JSONResponse APIErrors
 
-func (response WorkflowScheduledGet403JSONResponse) VisitWorkflowScheduledGetResponse(w http.ResponseWriter) error {
+func (response TenantGetTaskStats403JSONResponse) VisitTenantGetTaskStatsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type WorkflowScheduledGet404JSONResponse APIErrors
+type TenantGetTaskStats404JSONResponse APIErrors
 
-func (response WorkflowScheduledGet404JSONResponse) VisitWorkflowScheduledGetResponse(w http.ResponseWriter) error {
+func (response TenantGetTaskStats404JSONResponse) VisitTenantGetTaskStatsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type WorkflowScheduledUpdateRequestObject struct {
-	Tenant               openapi_types.UUID `json:"tenant"`
-	ScheduledWorkflowRun openapi_types.UUID `json:"scheduled-workflow-run"`
-	Body                 *WorkflowScheduledUpdateJSONRequestBody
+type WebhookListRequestObject struct {
+	Tenant openapi_types.UUID `json:"tenant"`
 }
 
-type WorkflowScheduledUpdateResponseObject interface {
-	VisitWorkflowScheduledUpdateResponse(w http.ResponseWriter) error
+type WebhookListResponseObject interface {
+	VisitWebhookListResponse(w http.ResponseWriter) error
 }
 
-type WorkflowScheduledUpdate200JSONResponse ScheduledWorkflows
+type WebhookList200JSONResponse WebhookWorkerListResponse
 
-func (response WorkflowScheduledUpdate200JSONResponse) VisitWorkflowScheduledUpdateResponse(w http.ResponseWriter) error {
+func (response WebhookList200JSONResponse) VisitWebhookListResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type WorkflowScheduledUpdate400JSONResponse APIErrors
+type WebhookList400JSONResponse APIErrors
 
-func (response WorkflowScheduledUpdate400JSONResponse) VisitWorkflowScheduledUpdateResponse(w http.ResponseWriter) error {
+func (response WebhookList400JSONResponse) VisitWebhookListResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type WorkflowScheduledUpdate403JSONResponse APIErrors
+type WebhookList401JSONResponse APIErrors
 
-func (response WorkflowScheduledUpdate403JSONResponse) VisitWorkflowScheduledUpdateResponse(w http.ResponseWriter) error {
+func (response WebhookList401JSONResponse) VisitWebhookListResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type WorkflowScheduledUpdate404JSONResponse APIErrors
+type WebhookList405JSONResponse APIErrors
 
-func (response WorkflowScheduledUpdate404JSONResponse) VisitWorkflowScheduledUpdateResponse(w http.ResponseWriter) error {
+func (response WebhookList405JSONResponse) VisitWebhookListResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	w.WriteHeader(405)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type WorkflowScheduledTriggerRequestObject struct {
-	Tenant               openapi_types.UUID `json:"tenant"`
-	ScheduledWorkflowRun openapi_types.UUID `json:"scheduled-workflow-run"`
+type WebhookCreateRequestObject struct {
+	Tenant openapi_types.UUID `json:"tenant"`
+	Body   *WebhookCreateJSONRequestBody
 }
 
-type WorkflowScheduledTriggerResponseObject interface {
-	VisitWorkflowScheduledTriggerResponse(w http.ResponseWriter) error
+type WebhookCreateResponseObject interface {
+	VisitWebhookCreateResponse(w http.ResponseWriter) error
 }
 
-type WorkflowScheduledTrigger200JSONResponse TriggerRunResult
+type WebhookCreate200JSONResponse WebhookWorkerCreated
 
-func (response WorkflowScheduledTrigger200JSONResponse) VisitWorkflowScheduledTriggerResponse(w http.ResponseWriter) error {
+func (response WebhookCreate200JSONResponse) VisitWebhookCreateResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type WorkflowScheduledTrigger400JSONResponse APIErrors
+type WebhookCreate400JSONResponse APIErrors
 
-func (response WorkflowScheduledTrigger400JSONResponse) VisitWorkflowScheduledTriggerResponse(w http.ResponseWriter) error {
+func (response WebhookCreate400JSONResponse) VisitWebhookCreateResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type WorkflowScheduledTrigger403JSONResponse APIErrors
-
-func (response WorkflowScheduledTrigger403JSONResponse) VisitWorkflowScheduledTriggerResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
-
-	return json.NewEncoder(w).Encode(response)
-}
-
-type WorkflowScheduledTrigger404JSONResponse APIErrors
+type WebhookCreate401JSONResponse APIErrors
 
-func (response WorkflowScheduledTrigger404JSONResponse) VisitWorkflowScheduledTriggerResponse(w http.ResponseWriter) error {
+func (response WebhookCreate401JSONResponse) VisitWebhookCreateResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type WorkflowScheduledTrigger500JSONResponse APIErrors
+type WebhookCreate405JSONResponse APIErrors
 
-func (response WorkflowScheduledTrigger500JSONResponse) VisitWorkflowScheduledTriggerResponse(w http.ResponseWriter) error {
+func (response WebhookCreate405JSONResponse) VisitWebhookCreateResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	w.WriteHeader(405)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CronWorkflowTriggerCreateRequestObject struct {
-	Tenant   openapi_types.UUID `json:"tenant"`
-	Workflow string             `json:"workflow"`
-	Body     *CronWorkflowTriggerCreateJSONRequestBody
+type WorkerListRequestObject struct {
+	Tenant openapi_types.UUID `json:"tenant"`
+	Params WorkerListParams
 }
 
-type CronWorkflowTriggerCreateResponseObject interface {
-	VisitCronWorkflowTriggerCreateResponse(w http.ResponseWriter) error
+type WorkerListResponseObject interface {
+	VisitWorkerListResponse(w http.ResponseWriter) error
 }
 
-type CronWorkflowTriggerCreate200JSONResponse CronWorkflows
+type WorkerList200JSONResponse WorkerList
 
-func (response CronWorkflowTriggerCreate200JSONResponse) VisitCronWorkflowTriggerCreateResponse(w http.ResponseWriter) error {
+func (response WorkerList200JSONResponse) VisitWorkerListResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CronWorkflowTriggerCreate400JSONResponse APIErrors
+type WorkerList400JSONResponse APIErrors
 
-func (response CronWorkflowTriggerCreate400JSONResponse) VisitCronWorkflowTriggerCreateResponse(w http.ResponseWriter) error {
+func (response WorkerList400JSONResponse) VisitWorkerListResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CronWorkflowTriggerCreate403JSONResponse APIErrors
+type WorkerList403JSONResponse APIErrors
 
-func (response CronWorkflowTriggerCreate403JSONResponse) VisitCronWorkflowTriggerCreateResponse(w http.ResponseWriter) error {
+func (response WorkerList403JSONResponse) VisitWorkerListResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CronWorkflowTriggerCreate404JSONResponse APIErrors
+type WorkflowRunUpdateReplayRequestObject struct {
+	Tenant openapi_types.UUID `json:"tenant"`
+	Body   *WorkflowRunUpdateReplayJSONRequestBody
+}
 
-func (response CronWorkflowTriggerCreate404JSONResponse) VisitCronWorkflowTriggerCreateResponse(w http.ResponseWriter) error {
+type WorkflowRunUpdateReplayResponseObject interface {
+	VisitWorkflowRunUpdateReplayResponse(w http.ResponseWriter) error
+}
+
+type WorkflowRunUpdateReplay200JSONResponse ReplayWorkflowRunsResponse
+
+func (response WorkflowRunUpdateReplay200JSONResponse) VisitWorkflowRunUpdateReplayResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CronWorkflowTriggerCreate429JSONResponse APIErrors
-
-func (response CronWorkflowTriggerCreate429JSONResponse) VisitCronWorkflowTriggerCreateResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(429)
-
-	return json.NewEncoder(w).Encode(response)
-}
-
-type ScheduledWorkflowRunCreateRequestObject struct {
-	Tenant   openapi_types.UUID `json:"tenant"`
-	Workflow string             `json:"workflow"`
-	Body     *ScheduledWorkflowRunCreateJSONRequestBody
-}
-
-type ScheduledWorkflowRunCreateResponseObject interface {
-	VisitScheduledWorkflowRunCreateResponse(w http.ResponseWriter) error
-}
-
-type ScheduledWorkflowRunCreate200JSONResponse ScheduledWorkflows
-
-func (response ScheduledWorkflowRunCreate200JSONResponse) VisitScheduledWorkflowRunCreateResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
-
-	return json.NewEncoder(w).Encode(response)
-}
-
-type ScheduledWorkflowRunCreate400JSONResponse APIErrors
+type WorkflowRunUpdateReplay400JSONResponse APIErrors
 
-func (response ScheduledWorkflowRunCreate400JSONResponse) VisitScheduledWorkflowRunCreateResponse(w http.ResponseWriter) error {
+func (response WorkflowRunUpdateReplay400JSONResponse) VisitWorkflowRunUpdateReplayResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ScheduledWorkflowRunCreate403JSONResponse APIErrors
+type WorkflowRunUpdateReplay403JSONResponse APIErrors
 
-func (response ScheduledWorkflowRunCreate403JSONResponse) VisitScheduledWorkflowRunCreateResponse(w http.ResponseWriter) error {
+func (response WorkflowRunUpdateReplay403JSONResponse) VisitWorkflowRunUpdateReplayResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ScheduledWorkflowRunCreate404JSONResponse APIErrors
-
-func (response ScheduledWorkflowRunCreate404JSONResponse) VisitScheduledWorkflowRunCreateResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
-
-	return json.NewEncoder(w).Encode(response)
-}
-
-type ScheduledWorkflowRunCreate429JSONResponse APIErrors
+type WorkflowRunUpdateReplay429JSONResponse APIErrors
 
-func (response ScheduledWorkflowRunCreate429JSONResponse) VisitScheduledWorkflowRunCreateResponse(w http.ResponseWriter) error {
+func (response WorkflowRunUpdateReplay429JSONResponse) VisitWorkflowRunUpdateReplayResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(429)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type WorkflowGetWorkersCountRequestObject struct {
-	Tenant   openapi_types.UUID `json:"tenant"`
-	Workflow openapi_types.UUID `json:"workflow"`
+type WorkflowRunGetRequestObject struct {
+	Tenant      openapi_types.UUID `json:"tenant"`
+	WorkflowRun openapi_types.UUID `json:"workflow-run"`
 }
 
-type WorkflowGetWorkersCountResponseObject interface {
-	VisitWorkflowGetWorkersCountResponse(w http.ResponseWriter) error
+type WorkflowRunGetResponseObject interface {
+	VisitWorkflowRunGetResponse(w http.ResponseWriter) error
 }
 
-type WorkflowGetWorkersCount200JSONResponse WorkflowWorkersCount
+type WorkflowRunGet200JSONResponse WorkflowRun
 
-func (response WorkflowGetWorkersCount200JSONResponse) VisitWorkflowGetWorkersCountResponse(w http.ResponseWriter) error {
+func (response WorkflowRunGet200JSONResponse) VisitWorkflowRunGetResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type WorkflowGetWorkersCount400JSONResponse APIErrors
+type WorkflowRunGet400JSONResponse APIErrors
 
-func (response WorkflowGetWorkersCount400JSONResponse) VisitWorkflowGetWorkersCountResponse(w http.ResponseWriter) error {
+func (response WorkflowRunGet400JSONResponse) VisitWorkflowRunGetResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type WorkflowGetWorkersCount403JSONResponse APIErrors
+type WorkflowRunGet403JSONResponse APIErrors
 
-func (response WorkflowGetWorkersCount403JSONResponse) VisitWorkflowGetWorkersCountResponse(w http.ResponseWriter) error {
+func (response WorkflowRunGet403JSONResponse) VisitWorkflowRunGetResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UserGetCurrentRequestObject struct {
+type WorkflowRunGetInputRequestObject struct {
+	Tenant      openapi_types.UUID `json:"tenant"`
+	WorkflowRun openapi_types.UUID `json:"workflow-run"`
 }
 
-type UserGetCurrentResponseObject interface {
-	VisitUserGetCurrentResponse(w http.ResponseWriter) error
+type WorkflowRunGetInputResponseObject interface {
+	VisitWorkflowRunGetInputResponse(w http.ResponseWriter) error
 }
 
-type UserGetCurrent200JSONResponse User
+type WorkflowRunGetInput200JSONResponse map[string]interface{}
 
-func (response UserGetCurrent200JSONResponse) VisitUserGetCurrentResponse(w http.ResponseWriter) error {
+func (response WorkflowRunGetInput200JSONResponse) VisitWorkflowRunGetInputResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UserGetCurrent400JSONResponse APIErrors
+type WorkflowRunGetInput400JSONResponse APIErrors
 
-func (response UserGetCurrent400JSONResponse) VisitUserGetCurrentResponse(w http.ResponseWriter) error {
+func (response WorkflowRunGetInput400JSONResponse) VisitWorkflowRunGetInputResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UserGetCurrent401JSONResponse APIErrors
+type WorkflowRunGetInput403JSONResponse APIErrors
 
-func (response UserGetCurrent401JSONResponse) VisitUserGetCurrentResponse(w http.ResponseWriter) error {
+func (response WorkflowRunGetInput403JSONResponse) VisitWorkflowRunGetInputResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UserGetCurrent405JSONResponse APIErrors
+type WorkflowRunGetInput404JSONResponse APIErrors
 
-func (response UserGetCurrent405JSONResponse) VisitUserGetCurrentResponse(w http.ResponseWriter) error {
+func (response WorkflowRunGetInput404JSONResponse) VisitWorkflowRunGetInputResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(405)
+	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UserUpdateGithubOauthCallbackRequestObject struct {
+type WorkflowRunGetShapeRequestObject struct {
+	Tenant      openapi_types.UUID `json:"tenant"`
+	WorkflowRun openapi_types.UUID `json:"workflow-run"`
 }
 
-type UserUpdateGithubOauthCallbackResponseObject interface {
-	VisitUserUpdateGithubOauthCallbackResponse(w http.ResponseWriter) error
+type WorkflowRunGetShapeResponseObject interface {
+	VisitWorkflowRunGetShapeResponse(w http.ResponseWriter) error
 }
 
-type UserUpdateGithubOauthCallback302ResponseHeaders struct {
-	Location string
-}
+type WorkflowRunGetShape200JSONResponse WorkflowRunShape
 
-type UserUpdateGithubOauthCallback302Response struct {
-	Headers UserUpdateGithubOauthCallback302ResponseHeaders
-}
+func (response WorkflowRunGetShape200JSONResponse) VisitWorkflowRunGetShapeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
 
-func (response UserUpdateGithubOauthCallback302Response) VisitUserUpdateGithubOauthCallbackResponse(w http.ResponseWriter) error {
-	w.Header().Set("location", fmt.Sprint(response.Headers.Location))
-	w.WriteHeader(302)
-	return nil
+	return json.NewEncoder(w).Encode(response)
 }
 
-type UserUpdateGithubOauthStartRequestObject struct {
-}
+type WorkflowRunGetShape400JSONResponse APIErrors
 
-type UserUpdateGithubOauthStartResponseObject interface {
-	VisitUserUpdateGithubOauthStartResponse(w http.ResponseWriter) error
-}
+func (response WorkflowRunGetShape400JSONResponse) VisitWorkflowRunGetShapeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
 
-type UserUpdateGithubOauthStart302ResponseHeaders struct {
-	Location string
+	return json.NewEncoder(w).Encode(response)
 }
 
-type UserUpdateGithubOauthStart302Response struct {
-	Headers UserUpdateGithubOauthStart302ResponseHeaders
-}
+type WorkflowRunGetShape403JSONResponse APIErrors
 
-func (response UserUpdateGithubOauthStart302Response) VisitUserUpdateGithubOauthStartResponse(w http.ResponseWriter) error {
-	w.Header().Set("location", fmt.Sprint(response.Headers.Location))
-	w.WriteHeader(302)
-	return nil
-}
+func (response WorkflowRunGetShape403JSONResponse) VisitWorkflowRunGetShapeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
 
-type UserUpdateGoogleOauthCallbackRequestObject struct {
+	return json.NewEncoder(w).Encode(response)
 }
 
-type UserUpdateGoogleOauthCallbackResponseObject interface {
-	VisitUserUpdateGoogleOauthCallbackResponse(w http.ResponseWriter) error
+type WorkflowRunListStepRunEventsRequestObject struct {
+	Tenant      openapi_types.UUID `json:"tenant"`
+	WorkflowRun openapi_types.UUID `json:"workflow-run"`
+	Params      WorkflowRunListStepRunEventsParams
 }
 
-type UserUpdateGoogleOauthCallback302ResponseHeaders struct {
-	Location string
+type WorkflowRunListStepRunEventsResponseObject interface {
+	VisitWorkflowRunListStepRunEventsResponse(w http.ResponseWriter) error
 }
 
-type UserUpdateGoogleOauthCallback302Response struct {
-	Headers UserUpdateGoogleOauthCallback302ResponseHeaders
-}
+type WorkflowRunListStepRunEvents200JSONResponse StepRunEventList
 
-func (response UserUpdateGoogleOauthCallback302Response) VisitUserUpdateGoogleOauthCallbackResponse(w http.ResponseWriter) error {
-	w.Header().Set("location", fmt.Sprint(response.Headers.Location))
-	w.WriteHeader(302)
-	return nil
-}
+func (response WorkflowRunListStepRunEvents200JSONResponse) VisitWorkflowRunListStepRunEventsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
 
-type UserUpdateGoogleOauthStartRequestObject struct {
+	return json.NewEncoder(w).Encode(response)
 }
 
-type UserUpdateGoogleOauthStartResponseObject interface {
-	VisitUserUpdateGoogleOauthStartResponse(w http.ResponseWriter) error
-}
+type WorkflowRunListStepRunEvents400JSONResponse APIErrors
 
-type UserUpdateGoogleOauthStart302ResponseHeaders struct {
-	Location string
+func (response WorkflowRunListStepRunEvents400JSONResponse) VisitWorkflowRunListStepRunEventsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type UserUpdateGoogleOauthStart302Response struct {
-	Headers UserUpdateGoogleOauthStart302ResponseHeaders
+type WorkflowRunListStepRunEvents403JSONResponse APIErrors
+
+func (response WorkflowRunListStepRunEvents403JSONResponse) VisitWorkflowRunListStepRunEventsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-func (response UserUpdateGoogleOauthStart302Response) VisitUserUpdateGoogleOauthStartResponse(w http.ResponseWriter) error {
-	w.Header().Set("location", fmt.Sprint(response.Headers.Location))
-	w.WriteHeader(302)
-	return nil
+type WorkflowRunListStepRunEvents404JSONResponse APIErrors
+
+func (response WorkflowRunListStepRunEvents404JSONResponse) VisitWorkflowRunListStepRunEventsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type UserListTenantInvitesRequestObject struct {
+type WorkflowListRequestObject struct {
+	Tenant openapi_types.UUID `json:"tenant"`
+	Params WorkflowListParams
 }
 
-type UserListTenantInvitesResponseObject interface {
-	VisitUserListTenantInvitesResponse(w http.ResponseWriter) error
+type WorkflowListResponseObject interface {
+	VisitWorkflowListResponse(w http.ResponseWriter) error
 }
 
-type UserListTenantInvites200JSONResponse TenantInviteList
+type WorkflowList200JSONResponse WorkflowList
 
-func (response UserListTenantInvites200JSONResponse) VisitUserListTenantInvitesResponse(w http.ResponseWriter) error {
+func (response WorkflowList200JSONResponse) VisitWorkflowListResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UserListTenantInvites400JSONResponse APIErrors
+type WorkflowList400JSONResponse APIErrors
 
-func (response UserListTenantInvites400JSONResponse) VisitUserListTenantInvitesResponse(w http.ResponseWriter) error {
+func (response WorkflowList400JSONResponse) VisitWorkflowListResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UserListTenantInvites403JSONResponse APIErrors
+type WorkflowList403JSONResponse APIErrors
 
-func (response UserListTenantInvites403JSONResponse) VisitUserListTenantInvitesResponse(w http.ResponseWriter) error {
+func (response WorkflowList403JSONResponse) VisitWorkflowListResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type TenantInviteAcceptRequestObject struct {
-	Body *TenantInviteAcceptJSONRequestBody
+type WorkflowRunCancelRequestObject struct {
+	Tenant openapi_types.UUID `json:"tenant"`
+	Body   *WorkflowRunCancelJSONRequestBody
 }
 
-type TenantInviteAcceptResponseObject interface {
-	VisitTenantInviteAcceptResponse(w http.ResponseWriter) error
+type WorkflowRunCancelResponseObject interface {
+	VisitWorkflowRunCancelResponse(w http.ResponseWriter) error
 }
 
-type TenantInviteAccept200Response struct {
+type WorkflowRunCancel200JSONResponse struct {
+	WorkflowRunIds *[]openapi_types.UUID `json:"workflowRunIds,omitempty"`
 }
 
-func (response TenantInviteAccept200Response) VisitTenantInviteAcceptResponse(w http.ResponseWriter) error {
+func (response WorkflowRunCancel200JSONResponse) VisitWorkflowRunCancelResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
-	return nil
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type TenantInviteAccept400JSONResponse APIErrors
+type WorkflowRunCancel400JSONResponse APIErrors
 
-func (response TenantInviteAccept400JSONResponse) VisitTenantInviteAcceptResponse(w http.ResponseWriter) error {
+func (response WorkflowRunCancel400JSONResponse) VisitWorkflowRunCancelResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type TenantInviteAccept403JSONResponse APIError
+type WorkflowRunCancel403JSONResponse APIErrors
 
-func (response TenantInviteAccept403JSONResponse) VisitTenantInviteAcceptResponse(w http.ResponseWriter) error {
+func (response WorkflowRunCancel403JSONResponse) VisitWorkflowRunCancelResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type TenantInviteRejectRequestObject struct {
-	Body *TenantInviteRejectJSONRequestBody
+type CronWorkflowListRequestObject struct {
+	Tenant openapi_types.UUID `json:"tenant"`
+	Params CronWorkflowListParams
 }
 
-type TenantInviteRejectResponseObject interface {
-	VisitTenantInviteRejectResponse(w http.ResponseWriter) error
+type CronWorkflowListResponseObject interface {
+	VisitCronWorkflowListResponse(w http.ResponseWriter) error
 }
 
-type TenantInviteReject200Response struct {
-}
+type CronWorkflowList200JSONResponse CronWorkflowsList
 
-func (response TenantInviteReject200Response) VisitTenantInviteRejectResponse(w http.ResponseWriter) error {
+func (response CronWorkflowList200JSONResponse) VisitCronWorkflowListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
-	return nil
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type TenantInviteReject400JSONResponse APIErrors
+type CronWorkflowList400JSONResponse APIErrors
 
-func (response TenantInviteReject400JSONResponse) VisitTenantInviteRejectResponse(w http.ResponseWriter) error {
+func (response CronWorkflowList400JSONResponse) VisitCronWorkflowListResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type TenantInviteReject403JSONResponse APIError
+type CronWorkflowList403JSONResponse APIErrors
 
-func (response TenantInviteReject403JSONResponse) VisitTenantInviteRejectResponse(w http.ResponseWriter) error {
+func (response CronWorkflowList403JSONResponse) VisitCronWorkflowListResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UserUpdateLoginRequestObject struct {
-	Body *UserUpdateLoginJSONRequestBody
-}
-
-type UserUpdateLoginResponseObject interface {
-	VisitUserUpdateLoginResponse(w http.ResponseWriter) error
+type WorkflowCronDeleteRequestObject struct {
+	Tenant       openapi_types.UUID `json:"tenant"`
+	CronWorkflow openapi_types.UUID `json:"cron-workflow"`
 }
 
-type UserUpdateLogin200JSONResponse User
-
-func (response UserUpdateLogin200JSONResponse) VisitUserUpdateLoginResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
-
-	return json.NewEncoder(w).Encode(response)
+type WorkflowCronDeleteResponseObject interface {
+	VisitWorkflowCronDeleteResponse(w http.ResponseWriter) error
 }
 
-type UserUpdateLogin400JSONResponse APIErrors
-
-func (response UserUpdateLogin400JSONResponse) VisitUserUpdateLoginResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
-
-	return json.NewEncoder(w).Encode(response)
+type WorkflowCronDelete204Response struct {
 }
 
-type UserUpdateLogin401JSONResponse APIErrors
-
-func (response UserUpdateLogin401JSONResponse) VisitUserUpdateLoginResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
-
-	return json.NewEncoder(w).Encode(response)
+func (response WorkflowCronDelete204Response) VisitWorkflowCronDeleteResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
 }
 
-type UserUpdateLogin405JSONResponse APIErrors
+type WorkflowCronDelete400JSONResponse APIErrors
 
-func (response UserUpdateLogin405JSONResponse) VisitUserUpdateLoginResponse(w http.ResponseWriter) error {
+func (response WorkflowCronDelete400JSONResponse) VisitWorkflowCronDeleteResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(405)
+	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UserUpdateLogin422JSONResponse APIErrors
+type WorkflowCronDelete403JSONResponse APIError
 
-func (response UserUpdateLogin422JSONResponse) VisitUserUpdateLoginResponse(w http.ResponseWriter) error {
+func (response WorkflowCronDelete403JSONResponse) VisitWorkflowCronDeleteResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(422)
+	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UserUpdateLogoutRequestObject struct {
+type WorkflowCronGetRequestObject struct {
+	Tenant       openapi_types.UUID `json:"tenant"`
+	CronWorkflow openapi_types.UUID `json:"cron-workflow"`
 }
 
-type UserUpdateLogoutResponseObject interface {
-	VisitUserUpdateLogoutResponse(w http.ResponseWriter) error
+type WorkflowCronGetResponseObject interface {
+	VisitWorkflowCronGetResponse(w http.ResponseWriter) error
 }
 
-type UserUpdateLogout200JSONResponse User
+type WorkflowCronGet200JSONResponse CronWorkflows
 
-func (response UserUpdateLogout200JSONResponse) VisitUserUpdateLogoutResponse(w http.ResponseWriter) error {
+func (response WorkflowCronGet200JSONResponse) VisitWorkflowCronGetResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UserUpdateLogout400JSONResponse APIErrors
+type WorkflowCronGet400JSONResponse APIErrors
 
-func (response UserUpdateLogout400JSONResponse) VisitUserUpdateLogoutResponse(w http.ResponseWriter) error {
+func (response WorkflowCronGet400JSONResponse) VisitWorkflowCronGetResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UserUpdateLogout401JSONResponse APIErrors
+type WorkflowCronGet403JSONResponse APIErrors
 
-func (response UserUpdateLogout401JSONResponse) VisitUserUpdateLogoutResponse(w http.ResponseWriter) error {
+func (response WorkflowCronGet403JSONResponse) VisitWorkflowCronGetResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UserUpdateLogout405JSONResponse APIErrors
+type WorkflowCronGet404JSONResponse APIErrors
 
-func (response UserUpdateLogout405JSONResponse) VisitUserUpdateLogoutResponse(w http.ResponseWriter) error {
+func (response WorkflowCronGet404JSONResponse) VisitWorkflowCronGetResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(405)
+	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type TenantMembershipsListRequestObject struct {
+type WorkflowCronUpdateRequestObject struct {
+	Tenant       openapi_types.UUID `json:"tenant"`
+	CronWorkflow openapi_types.UUID `json:"cron-workflow"`
+	Body         *WorkflowCronUpdateJSONRequestBody
 }
 
-type TenantMembershipsListResponseObject interface {
-	VisitTenantMembershipsListResponse(w http.ResponseWriter) error
+type WorkflowCronUpdateResponseObject interface {
+	VisitWorkflowCronUpdateResponse(w http.ResponseWriter) error
 }
 
-type TenantMembershipsList200JSONResponse UserTenantMembershipsList
-
-func (response TenantMembershipsList200JSONResponse) VisitTenantMembershipsListResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+type WorkflowCronUpdate204Response struct {
+}
 
-	return json.NewEncoder(w).Encode(response)
+func (response WorkflowCronUpdate204Response) VisitWorkflowCronUpdateResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
 }
 
-type TenantMembershipsList400JSONResponse APIErrors
+type WorkflowCronUpdate400JSONResponse APIErrors
 
-func (response TenantMembershipsList400JSONResponse) VisitTenantMembershipsListResponse(w http.ResponseWriter) error {
+func (response WorkflowCronUpdate400JSONResponse) VisitWorkflowCronUpdateResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type TenantMembershipsList403JSONResponse APIErrors
+type WorkflowCronUpdate403JSONResponse APIError
 
-func (response TenantMembershipsList403JSONResponse) VisitTenantMembershipsListResponse(w http.ResponseWriter) error {
+func (response WorkflowCronUpdate403JSONResponse) VisitWorkflowCronUpdateResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UserUpdatePasswordRequestObject struct {
-	Body *UserUpdatePasswordJSONRequestBody
+type WorkflowCronTriggerRequestObject struct {
+	Tenant       openapi_types.UUID `json:"tenant"`
+	CronWorkflow openapi_types.UUID `json:"cron-workflow"`
 }
 
-type UserUpdatePasswordResponseObject interface {
-	VisitUserUpdatePasswordResponse(w http.ResponseWriter) error
+type WorkflowCronTriggerResponseObject interface {
+	VisitWorkflowCronTriggerResponse(w http.ResponseWriter) error
 }
 
-type UserUpdatePassword200JSONResponse User
+type WorkflowCronTrigger200JSONResponse TriggerRunResult
 
-func (response UserUpdatePassword200JSONResponse) VisitUserUpdatePasswordResponse(w http.ResponseWriter) error {
+func (response WorkflowCronTrigger200JSONResponse) VisitWorkflowCronTriggerResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UserUpdatePassword400JSONResponse APIErrors
+type WorkflowCronTrigger400JSONResponse APIErrors
 
-func (response UserUpdatePassword400JSONResponse) VisitUserUpdatePasswordResponse(w http.ResponseWriter) error {
+func (response WorkflowCronTrigger400JSONResponse) VisitWorkflowCronTriggerResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UserUpdatePassword401JSONResponse APIErrors
+type WorkflowCronTrigger403JSONResponse APIError
 
-func (response UserUpdatePassword401JSONResponse) VisitUserUpdatePasswordResponse(w http.ResponseWriter) error {
+func (response WorkflowCronTrigger403JSONResponse) VisitWorkflowCronTriggerResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UserUpdatePassword405JSONResponse APIErrors
+type WorkflowCronTrigger404JSONResponse APIErrors
 
-func (response UserUpdatePassword405JSONResponse) VisitUserUpdatePasswordResponse(w http.ResponseWriter) error {
+func (response WorkflowCronTrigger404JSONResponse) VisitWorkflowCronTriggerResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(405)
+	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UserUpdatePassword422JSONResponse APIErrors
+type WorkflowCronTrigger500JSONResponse APIErrors
 
-func (response UserUpdatePassword422JSONResponse) VisitUserUpdatePasswordResponse(w http.ResponseWriter) error {
+func (response WorkflowCronTrigger500JSONResponse) VisitWorkflowCronTriggerResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(422)
+	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UserCreateRequestObject struct {
-	Body *UserCreateJSONRequestBody
+type WorkflowRunListRequestObject struct {
+	Tenant openapi_types.UUID `json:"tenant"`
+	Params WorkflowRunListParams
 }
 
-type UserCreateResponseObject interface {
-	VisitUserCreateResponse(w http.ResponseWriter) error
+type WorkflowRunListResponseObject interface {
+	VisitWorkflowRunListResponse(w http.ResponseWriter) error
 }
 
-type UserCreate200JSONResponse User
+type WorkflowRunList200JSONResponse WorkflowRunList
 
-func (response UserCreate200JSONResponse) VisitUserCreateResponse(w http.ResponseWriter) error {
+func (response WorkflowRunList200JSONResponse) VisitWorkflowRunListResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UserCreate400JSONResponse APIErrors
+type WorkflowRunList400JSONResponse APIErrors
 
-func (response UserCreate400JSONResponse) VisitUserCreateResponse(w http.ResponseWriter) error {
+func (response WorkflowRunList400JSONResponse) VisitWorkflowRunListResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UserCreate401JSONResponse APIErrors
+type WorkflowRunList403JSONResponse APIErrors
 
-func (response UserCreate401JSONResponse) VisitUserCreateResponse(w http.ResponseWriter) error {
+func (response WorkflowRunList403JSONResponse) VisitWorkflowRunListResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UserCreate405JSONResponse APIErrors
+type WorkflowRunGetMetricsRequestObject struct {
+	Tenant openapi_types.UUID `json:"tenant"`
+	Params WorkflowRunGetMetricsParams
+}
 
-func (response UserCreate405JSONResponse) VisitUserCreateResponse(w http.ResponseWriter) error {
+type WorkflowRunGetMetricsResponseObject interface {
+	VisitWorkflowRunGetMetricsResponse(w http.ResponseWriter) error
+}
+
+type WorkflowRunGetMetrics200JSONResponse WorkflowRunsMetrics
+
+func (response WorkflowRunGetMetrics200JSONResponse) VisitWorkflowRunGetMetricsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(405)
+	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UserCreate422JSONResponse APIErrors
+type WorkflowRunGetMetrics400JSONResponse APIErrors
 
-func (response UserCreate422JSONResponse) VisitUserCreateResponse(w http.ResponseWriter) error {
+func (response WorkflowRunGetMetrics400JSONResponse) VisitWorkflowRunGetMetricsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(422)
+	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UserUpdateSlackOauthCallbackRequestObject struct {
-}
+type WorkflowRunGetMetrics403JSONResponse APIErrors
 
-type UserUpdateSlackOauthCallbackResponseObject interface {
-	VisitUserUpdateSlackOauthCallbackResponse(w http.ResponseWriter) error
-}
+func (response WorkflowRunGetMetrics403JSONResponse) VisitWorkflowRunGetMetricsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
 
-type UserUpdateSlackOauthCallback302ResponseHeaders struct {
-	Location string
+	return json.NewEncoder(w).Encode(response)
 }
 
-type UserUpdateSlackOauthCallback302Response struct {
-	Headers UserUpdateSlackOauthCallback302ResponseHeaders
+type WorkflowScheduledListRequestObject struct {
+	Tenant openapi_types.UUID `json:"tenant"`
+	Params WorkflowScheduledListParams
 }
 
-func (response UserUpdateSlackOauthCallback302Response) VisitUserUpdateSlackOauthCallbackResponse(w http.ResponseWriter) error {
-	w.Header().Set("location", fmt.Sprint(response.Headers.Location))
-	w.WriteHeader(302)
-	return nil
+type WorkflowScheduledListResponseObject interface {
+	VisitWorkflowScheduledListResponse(w http.ResponseWriter) error
 }
 
-type InfoGetVersionRequestObject struct {
-}
+type WorkflowScheduledList200JSONResponse ScheduledWorkflowsList
 
-type InfoGetVersionResponseObject interface {
-	VisitInfoGetVersionResponse(w http.ResponseWriter) error
-}
+func (response WorkflowScheduledList200JSONResponse) VisitWorkflowScheduledListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
 
-type InfoGetVersion200JSONResponse struct {
-	Version string `json:"version"`
+	return json.NewEncoder(w).Encode(response)
 }
 
-func (response InfoGetVersion200JSONResponse) VisitInfoGetVersionResponse(w http.ResponseWriter) error {
+type WorkflowScheduledList400JSONResponse APIErrors
+
+func (response WorkflowScheduledList400JSONResponse) VisitWorkflowScheduledListResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type WebhookDeleteRequestObject struct {
-	Webhook openapi_types.UUID `json:"webhook"`
-}
+type WorkflowScheduledList403JSONResponse APIErrors
 
-type WebhookDeleteResponseObject interface {
-	VisitWebhookDeleteResponse(w http.ResponseWriter) error
-}
+func (response WorkflowScheduledList403JSONResponse) VisitWorkflowScheduledListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
 
-type WebhookDelete200Response struct {
+	return json.NewEncoder(w).Encode(response)
 }
 
-func (response WebhookDelete200Response) VisitWebhookDeleteResponse(w http.ResponseWriter) error {
-	w.WriteHeader(200)
-	return nil
+type WorkflowScheduledBulkDeleteRequestObject struct {
+	Tenant openapi_types.UUID `json:"tenant"`
+	Body   *WorkflowScheduledBulkDeleteJSONRequestBody
 }
 
-type WebhookDelete400JSONResponse APIErrors
+type WorkflowScheduledBulkDeleteResponseObject interface {
+	VisitWorkflowScheduledBulkDeleteResponse(w http.ResponseWriter) error
+}
 
-func (response WebhookDelete400JSONResponse) VisitWebhookDeleteResponse(w http.ResponseWriter) error {
+type WorkflowScheduledBulkDelete200JSONResponse ScheduledWorkflowsBulkDeleteResponse
+
+func (response WorkflowScheduledBulkDelete200JSONResponse) VisitWorkflowScheduledBulkDeleteResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type WebhookDelete401JSONResponse APIErrors
+type WorkflowScheduledBulkDelete400JSONResponse APIErrors
 
-func (response WebhookDelete401JSONResponse) VisitWebhookDeleteResponse(w http.ResponseWriter) error {
+func (response WorkflowScheduledBulkDelete400JSONResponse) VisitWorkflowScheduledBulkDeleteResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type WebhookDelete405JSONResponse APIErrors
+type WorkflowScheduledBulkDelete403JSONResponse APIErrors
 
-func (response WebhookDelete405JSONResponse) VisitWebhookDeleteResponse(w http.ResponseWriter) error {
+func (response WorkflowScheduledBulkDelete403JSONResponse) VisitWorkflowScheduledBulkDeleteResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(405)
+	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type WebhookRequestsListRequestObject struct {
-	Webhook openapi_types.UUID `json:"webhook"`
+type WorkflowScheduledBulkUpdateRequestObject struct {
+	Tenant openapi_types.UUID `json:"tenant"`
+	Body   *WorkflowScheduledBulkUpdateJSONRequestBody
 }
 
-type WebhookRequestsListResponseObject interface {
-	VisitWebhookRequestsListResponse(w http.ResponseWriter) error
+type WorkflowScheduledBulkUpdateResponseObject interface {
+	VisitWorkflowScheduledBulkUpdateResponse(w http.ResponseWriter) error
 }
 
-type WebhookRequestsList200JSONResponse WebhookWorkerRequestListResponse
+type WorkflowScheduledBulkUpdate200JSONResponse ScheduledWorkflowsBulkUpdateResponse
 
-func (response WebhookRequestsList200JSONResponse) VisitWebhookRequestsListResponse(w http.ResponseWriter) error {
+func (response WorkflowScheduledBulkUpdate200JSONResponse) VisitWorkflowScheduledBulkUpdateResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type WebhookRequestsList400JSONResponse APIErrors
+type WorkflowScheduledBulkUpdate400JSONResponse APIErrors
 
-func (response WebhookRequestsList400JSONResponse) VisitWebhookRequestsListResponse(w http.ResponseWriter) error {
+func (response WorkflowScheduledBulkUpdate400JSONResponse) VisitWorkflowScheduledBulkUpdateResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type WebhookRequestsList401JSONResponse APIErrors
+type WorkflowScheduledBulkUpdate403JSONResponse APIErrors
 
-func (response WebhookRequestsList401JSONResponse) VisitWebhookRequestsListResponse(w http.ResponseWriter) error {
+func (response WorkflowScheduledBulkUpdate403JSONResponse) VisitWorkflowScheduledBulkUpdateResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type WebhookRequestsList405JSONResponse APIErrors
-
-func (response WebhookRequestsList405JSONResponse) VisitWebhookRequestsListResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(405)
-
-	return json.NewEncoder(w).Encode(response)
+type WorkflowScheduledDeleteRequestObject struct {
+	Tenant               openapi_types.UUID `json:"tenant"`
+	ScheduledWorkflowRun openapi_types.UUID `json:"scheduled-workflow-run"`
 }
 
-type WorkerGetRequestObject struct {
-	Worker openapi_types.UUID `json:"worker"`
+type WorkflowScheduledDeleteResponseObject interface {
+	VisitWorkflowScheduledDeleteResponse(w http.ResponseWriter) error
 }
 
-type WorkerGetResponseObject interface {
-	VisitWorkerGetResponse(w http.ResponseWriter) error
+type WorkflowScheduledDelete204Response struct {
 }
 
-type WorkerGet200JSONResponse Worker
-
-func (response WorkerGet200JSONResponse) VisitWorkerGetResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
-
-	return json.NewEncoder(w).Encode(response)
+func (response WorkflowScheduledDelete204Response) VisitWorkflowScheduledDeleteResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
 }
 
-type WorkerGet400JSONResponse APIErrors
+type WorkflowScheduledDelete400JSONResponse APIErrors
 
-func (response WorkerGet400JSONResponse) VisitWorkerGetResponse(w http.ResponseWriter) error {
+func (response WorkflowScheduledDelete400JSONResponse) VisitWorkflowScheduledDeleteResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type WorkerGet403JSONResponse APIErrors
+type WorkflowScheduledDelete403JSONResponse APIError
 
-func (response WorkerGet403JSONResponse) VisitWorkerGetResponse(w http.ResponseWriter) error {
+func (response WorkflowScheduledDelete403JSONResponse) VisitWorkflowScheduledDeleteResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type WorkerUpdateRequestObject struct {
-	Worker openapi_types.UUID `json:"worker"`
-	Body   *WorkerUpdateJSONRequestBody
+type WorkflowScheduledGetRequestObject struct {
+	Tenant               openapi_types.UUID `json:"tenant"`
+	ScheduledWorkflowRun openapi_types.UUID `json:"scheduled-workflow-run"`
 }
 
-type WorkerUpdateResponseObject interface {
-	VisitWorkerUpdateResponse(w http.ResponseWriter) error
+type WorkflowScheduledGetResponseObject interface {
+	VisitWorkflowScheduledGetResponse(w http.ResponseWriter) error
 }
 
-type WorkerUpdate200JSONResponse Worker
+type WorkflowScheduledGet200JSONResponse ScheduledWorkflows
 
-func (response WorkerUpdate200JSONResponse) VisitWorkerUpdateResponse(w http.ResponseWriter) error {
+func (response WorkflowScheduledGet200JSONResponse) VisitWorkflowScheduledGetResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type WorkerUpdate400JSONResponse APIErrors
+type WorkflowScheduledGet400JSONResponse APIErrors
 
-func (response WorkerUpdate400JSONResponse) VisitWorkerUpdateResponse(w http.ResponseWriter) error {
+func (response WorkflowScheduledGet400JSONResponse) VisitWorkflowScheduledGetResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type WorkerUpdate403JSONResponse APIErrors
+type WorkflowScheduledGet403JSONResponse APIErrors
 
-func (response WorkerUpdate403JSONResponse) VisitWorkerUpdateResponse(w http.ResponseWriter) error {
+func (response WorkflowScheduledGet403JSONResponse) VisitWorkflowScheduledGetResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type WorkflowDeleteRequestObject struct {
-	Workflow openapi_types.UUID `json:"workflow"`
+type WorkflowScheduledGet404JSONResponse APIErrors
+
+func (response WorkflowScheduledGet404JSONResponse) VisitWorkflowScheduledGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type WorkflowDeleteResponseObject interface {
-	VisitWorkflowDeleteResponse(w http.ResponseWriter) error
+type WorkflowScheduledUpdateRequestObject struct {
+	Tenant               openapi_types.UUID `json:"tenant"`
+	ScheduledWorkflowRun openapi_types.UUID `json:"scheduled-workflow-run"`
+	Body                 *WorkflowScheduledUpdateJSONRequestBody
 }
 
-type WorkflowDelete204Response struct {
+type WorkflowScheduledUpdateResponseObject interface {
+	VisitWorkflowScheduledUpdateResponse(w http.ResponseWriter) error
 }
 
-func (response WorkflowDelete204Response) VisitWorkflowDeleteResponse(w http.ResponseWriter) error {
-	w.WriteHeader(204)
-	return nil
+type WorkflowScheduledUpdate200JSONResponse ScheduledWorkflows
+
+func (response WorkflowScheduledUpdate200JSONResponse) VisitWorkflowScheduledUpdateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type WorkflowDelete400JSONResponse APIErrors
+type WorkflowScheduledUpdate400JSONResponse APIErrors
 
-func (response WorkflowDelete400JSONResponse) VisitWorkflowDeleteResponse(w http.ResponseWriter) error {
+func (response WorkflowScheduledUpdate400JSONResponse) VisitWorkflowScheduledUpdateResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type WorkflowDelete403JSONResponse APIErrors
+type WorkflowScheduledUpdate403JSONResponse APIErrors
 
-func (response WorkflowDelete403JSONResponse) VisitWorkflowDeleteResponse(w http.ResponseWriter) error {
+func (response WorkflowScheduledUpdate403JSONResponse) VisitWorkflowScheduledUpdateResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type WorkflowDelete404JSONResponse APIErrors
+type WorkflowScheduledUpdate404JSONResponse APIErrors
 
-func (response WorkflowDelete404JSONResponse) VisitWorkflowDeleteResponse(w http.ResponseWriter) error {
+func (response WorkflowScheduledUpdate404JSONResponse) VisitWorkflowScheduledUpdateResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type WorkflowGetRequestObject struct {
-	Workflow openapi_types.UUID `json:"workflow"`
+type WorkflowScheduledTriggerRequestObject struct {
+	Tenant               openapi_types.UUID `json:"tenant"`
+	ScheduledWorkflowRun openapi_types.UUID `json:"scheduled-workflow-run"`
 }
 
-type WorkflowGetResponseObject interface {
-	VisitWorkflowGetResponse(w http.ResponseWriter) error
+type WorkflowScheduledTriggerResponseObject interface {
+	VisitWorkflowScheduledTriggerResponse(w http.ResponseWriter) error
 }
 
-type WorkflowGet200JSONResponse Workflow
+type WorkflowScheduledTrigger200JSONResponse TriggerRunResult
 
-func (response WorkflowGet200JSONResponse) VisitWorkflowGetResponse(w http.ResponseWriter) error {
+func (response WorkflowScheduledTrigger200JSONResponse) VisitWorkflowScheduledTriggerResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type WorkflowGet400JSONResponse APIErrors
+type WorkflowScheduledTrigger400JSONResponse APIErrors
 
-func (response WorkflowGet400JSONResponse) VisitWorkflowGetResponse(w http.ResponseWriter) error {
+func (response WorkflowScheduledTrigger400JSONResponse) VisitWorkflowScheduledTriggerResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type WorkflowGet403JSONResponse APIErrors
+type WorkflowScheduledTrigger403JSONResponse APIErrors
 
-func (response WorkflowGet403JSONResponse) VisitWorkflowGetResponse(w http.ResponseWriter) error {
+func (response WorkflowScheduledTrigger403JSONResponse) VisitWorkflowScheduledTriggerResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type WorkflowGet404JSONResponse APIErrors
+type WorkflowScheduledTrigger404JSONResponse APIErrors
 
-func (response WorkflowGet404JSONResponse) VisitWorkflowGetResponse(w http.ResponseWriter) error {
+func (response WorkflowScheduledTrigger404JSONResponse) VisitWorkflowScheduledTriggerResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type WorkflowUpdateRequestObject struct {
-	Workflow openapi_types.UUID `json:"workflow"`
-	Body     *WorkflowUpdateJSONRequestBody
+type WorkflowScheduledTrigger500JSONResponse APIErrors
+
+func (response WorkflowScheduledTrigger500JSONResponse) VisitWorkflowScheduledTriggerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type WorkflowUpdateResponseObject interface {
-	VisitWorkflowUpdateResponse(w http.ResponseWriter) error
+type CronWorkflowTriggerCreateRequestObject struct {
+	Tenant   openapi_types.UUID `json:"tenant"`
+	Workflow string             `json:"workflow"`
+	Body     *CronWorkflowTriggerCreateJSONRequestBody
 }
 
-type WorkflowUpdate200JSONResponse Workflow
+type CronWorkflowTriggerCreateResponseObject interface {
+	VisitCronWorkflowTriggerCreateResponse(w http.ResponseWriter) error
+}
 
-func (response WorkflowUpdate200JSONResponse) VisitWorkflowUpdateResponse(w http.ResponseWriter) error {
+type CronWorkflowTriggerCreate200JSONResponse CronWorkflows
+
+func (response CronWorkflowTriggerCreate200JSONResponse) VisitCronWorkflowTriggerCreateResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type WorkflowUpdate400JSONResponse APIErrors
+type CronWorkflowTriggerCreate400JSONResponse APIErrors
 
-func (response WorkflowUpdate400JSONResponse) VisitWorkflowUpdateResponse(w http.ResponseWriter) error {
+func (response CronWorkflowTriggerCreate400JSONResponse) VisitCronWorkflowTriggerCreateResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type WorkflowUpdate403JSONResponse APIErrors
+type CronWorkflowTriggerCreate403JSONResponse APIErrors
 
-func (response WorkflowUpdate403JSONResponse) VisitWorkflowUpdateResponse(w http.ResponseWriter) error {
+func (response CronWorkflowTriggerCreate403JSONResponse) VisitCronWorkflowTriggerCreateResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type WorkflowGetMetricsRequestObject struct {
-	Workflow openapi_types.UUID `json:"workflow"`
-	Params   WorkflowGetMetricsParams
+type CronWorkflowTriggerCreate404JSONResponse APIErrors
+
+func (response CronWorkflowTriggerCreate404JSONResponse) VisitCronWorkflowTriggerCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type WorkflowGetMetricsResponseObject interface {
-	VisitWorkflowGetMetricsResponse(w http.ResponseWriter) error
+type CronWorkflowTriggerCreate429JSONResponse APIErrors
+
+func (response CronWorkflowTriggerCreate429JSONResponse) VisitCronWorkflowTriggerCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type WorkflowGetMetrics200JSONResponse WorkflowMetrics
+type ScheduledWorkflowRunCreateRequestObject struct {
+	Tenant   openapi_types.UUID `json:"tenant"`
+	Workflow string             `json:"workflow"`
+	Body     *ScheduledWorkflowRunCreateJSONRequestBody
+}
 
-func (response WorkflowGetMetrics200JSONResponse) VisitWorkflowGetMetricsResponse(w http.ResponseWriter) error {
+type ScheduledWorkflowRunCreateResponseObject interface {
+	VisitScheduledWorkflowRunCreateResponse(w http.ResponseWriter) error
+}
+
+type ScheduledWorkflowRunCreate200JSONResponse ScheduledWorkflows
+
+func (response ScheduledWorkflowRunCreate200JSONResponse) VisitScheduledWorkflowRunCreateResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type WorkflowGetMetrics400JSONResponse APIErrors
+type ScheduledWorkflowRunCreate400JSONResponse APIErrors
 
-func (response WorkflowGetMetrics400JSONResponse) VisitWorkflowGetMetricsResponse(w http.ResponseWriter) error {
+func (response ScheduledWorkflowRunCreate400JSONResponse) VisitScheduledWorkflowRunCreateResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type WorkflowGetMetrics403JSONResponse APIErrors
+type ScheduledWorkflowRunCreate403JSONResponse APIErrors
 
-func (response WorkflowGetMetrics403JSONResponse) VisitWorkflowGetMetricsResponse(w http.ResponseWriter) error {
+func (response ScheduledWorkflowRunCreate403JSONResponse) VisitScheduledWorkflowRunCreateResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type WorkflowGetMetrics404JSONResponse APIErrors
+type ScheduledWorkflowRunCreate404JSONResponse APIErrors
 
-func (response WorkflowGetMetrics404JSONResponse) VisitWorkflowGetMetricsResponse(w http.ResponseWriter) error {
+func (response ScheduledWorkflowRunCreate404JSONResponse) VisitScheduledWorkflowRunCreateResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type WorkflowRunCreateRequestObject struct {
-	Workflow openapi_types.UUID `json:"workflow"`
-	Params   WorkflowRunCreateParams
-	Body     *WorkflowRunCreateJSONRequestBody
-}
+type ScheduledWorkflowRunCreate429JSONResponse APIErrors
 
-type WorkflowRunCreateResponseObject interface {
-	VisitWorkflowRunCreateResponse(w http.ResponseWriter) error
-}
-
-type WorkflowRunCreate200JSONResponse WorkflowRun
-
-func (response WorkflowRunCreate200JSONResponse) VisitWorkflowRunCreateResponse(w http.ResponseWriter) error {
+func (response ScheduledWorkflowRunCreate429JSONResponse) VisitScheduledWorkflowRunCreateResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	w.WriteHeader(429)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type WorkflowRunCreate400JSONResponse APIErrors
-
-func (response WorkflowRunCreate400JSONResponse) VisitWorkflowRunCreateResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+type WorkflowGetWorkersCountRequestObject struct {
+	Tenant   openapi_types.UUID `json:"tenant"`
+	Workflow openapi_types.UUID `json:"workflow"`
+}
 
-	return json.NewEncoder(w).Encode(response)
+type WorkflowGetWorkersCountResponseObject interface {
+	VisitWorkflowGetWorkersCountResponse(w http.ResponseWriter) error
 }
 
-type WorkflowRunCreate403JSONResponse APIErrors
+type WorkflowGetWorkersCount200JSONResponse WorkflowWorkersCount
 
-func (response WorkflowRunCreate403JSONResponse) VisitWorkflowRunCreateResponse(w http.ResponseWriter) error {
+func (response WorkflowGetWorkersCount200JSONResponse) VisitWorkflowGetWorkersCountResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type WorkflowRunCreate404JSONResponse APIErrors
+type WorkflowGetWorkersCount400JSONResponse APIErrors
 
-func (response WorkflowRunCreate404JSONResponse) VisitWorkflowRunCreateResponse(w http.ResponseWriter) error {
+func (response WorkflowGetWorkersCount400JSONResponse) VisitWorkflowGetWorkersCountResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type WorkflowRunCreate429JSONResponse APIErrors
+type WorkflowGetWorkersCount403JSONResponse APIErrors
 
-func (response WorkflowRunCreate429JSONResponse) VisitWorkflowRunCreateResponse(w http.ResponseWriter) error {
+func (response WorkflowGetWorkersCount403JSONResponse) VisitWorkflowGetWorkersCountResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(429)
+	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type WorkflowVersionGetRequestObject struct {
-	Workflow openapi_types.UUID `json:"workflow"`
-	Params   WorkflowVersionGetParams
+type UserGetCurrentRequestObject struct {
 }
 
-type WorkflowVersionGetResponseObject interface {
-	VisitWorkflowVersionGetResponse(w http.ResponseWriter) error
+type UserGetCurrentResponseObject interface {
+	VisitUserGetCurrentResponse(w http.ResponseWriter) error
 }
 
-type WorkflowVersionGet200JSONResponse WorkflowVersion
+type UserGetCurrent200JSONResponse User
 
-func (response WorkflowVersionGet200JSONResponse) VisitWorkflowVersionGetResponse(w http.ResponseWriter) error {
+func (response UserGetCurrent200JSONResponse) VisitUserGetCurrentResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type WorkflowVersionGet400JSONResponse APIErrors
+type UserGetCurrent400JSONResponse APIErrors
 
-func (response WorkflowVersionGet400JSONResponse) VisitWorkflowVersionGetResponse(w http.ResponseWriter) error {
+func (response UserGetCurrent400JSONResponse) VisitUserGetCurrentResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type WorkflowVersionGet403JSONResponse APIErrors
+type UserGetCurrent401JSONResponse APIErrors
 
-func (response WorkflowVersionGet403JSONResponse) VisitWorkflowVersionGetResponse(w http.ResponseWriter) error {
+func (response UserGetCurrent401JSONResponse) VisitUserGetCurrentResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type WorkflowVersionGet404JSONResponse APIErrors
+type UserGetCurrent405JSONResponse APIErrors
 
-func (response WorkflowVersionGet404JSONResponse) VisitWorkflowVersionGetResponse(w http.ResponseWriter) error {
+func (response UserGetCurrent405JSONResponse) VisitUserGetCurrentResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	w.WriteHeader(405)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type StrictServerInterface interface {
-	LivenessGet(ctx echo.Context, request LivenessGetRequestObject) (LivenessGetResponseObject, error)
-
-	ReadinessGet(ctx echo.Context, request ReadinessGetRequestObject) (ReadinessGetResponseObject, error)
+type UserUpdateGithubOauthCallbackRequestObject struct {
+}
 
-	AlertEmailGroupDelete(ctx echo.Context, request AlertEmailGroupDeleteRequestObject) (AlertEmailGroupDeleteResponseObject, error)
+type UserUpdateGithubOauthCallbackResponseObject interface {
+	VisitUserUpdateGithubOauthCallbackResponse(w http.ResponseWriter) error
+}
 
-	AlertEmailGroupUpdate(ctx echo.Context, request AlertEmailGroupUpdateRequestObject) (AlertEmailGroupUpdateResponseObject, error)
+type UserUpdateGithubOauthCallback302ResponseHeaders struct {
+	Location string
+}
 
-	ApiTokenUpdateRevoke(ctx echo.Context, request ApiTokenUpdateRevokeRequestObject) (ApiTokenUpdateRevokeResponseObject, error)
+type UserUpdateGithubOauthCallback302Response struct {
+	Headers UserUpdateGithubOauthCallback302ResponseHeaders
+}
 
-	CloudMetadataGet(ctx echo.Context, request CloudMetadataGetRequestObject) (CloudMetadataGetResponseObject, error)
+func (response UserUpdateGithubOauthCallback302Response) VisitUserUpdateGithubOauthCallbackResponse(w http.ResponseWriter) error {
+	w.Header().Set("location", fmt.Sprint(response.Headers.Location))
+	w.WriteHeader(302)
+	return nil
+}
 
-	EventGet(ctx echo.Context, request EventGetRequestObject) (EventGetResponseObject, error)
+type UserUpdateGithubOauthStartRequestObject struct {
+}
 
-	EventDataGet(ctx echo.Context, request EventDataGetRequestObject) (EventDataGetResponseObject, error)
+type UserUpdateGithubOauthStartResponseObject interface {
+	VisitUserUpdateGithubOauthStartResponse(w http.ResponseWriter) error
+}
 
-	MetadataGet(ctx echo.Context, request MetadataGetRequestObject) (MetadataGetResponseObject, error)
+type UserUpdateGithubOauthStart302ResponseHeaders struct {
+	Location string
+}
 
-	MetadataListIntegrations(ctx echo.Context, request MetadataListIntegrationsRequestObject) (MetadataListIntegrationsResponseObject, error)
+type UserUpdateGithubOauthStart302Response struct {
+	Headers UserUpdateGithubOauthStart302ResponseHeaders
+}
 
-	MonitoringPostRunProbe(ctx echo.Context, request MonitoringPostRunProbeRequestObject) (MonitoringPostRunProbeResponseObject, error)
+func (response UserUpdateGithubOauthStart302Response) VisitUserUpdateGithubOauthStartResponse(w http.ResponseWriter) error {
+	w.Header().Set("location", fmt.Sprint(response.Headers.Location))
+	w.WriteHeader(302)
+	return nil
+}
 
-	SlackWebhookDelete(ctx echo.Context, request SlackWebhookDeleteRequestObject) (SlackWebhookDeleteResponseObject, error)
+type UserUpdateGoogleOauthCallbackRequestObject struct {
+}
 
-	SnsDelete(ctx echo.Context, request SnsDeleteRequestObject) (SnsDeleteResponseObject, error)
+type UserUpdateGoogleOauthCallbackResponseObject interface {
+	VisitUserUpdateGoogleOauthCallbackResponse(w http.ResponseWriter) error
+}
 
-	SnsUpdate(ctx echo.Context, request SnsUpdateRequestObject) (SnsUpdateResponseObject, error)
+type UserUpdateGoogleOauthCallback302ResponseHeaders struct {
+	Location string
+}
 
-	V1DagListTasks(ctx echo.Context, request V1DagListTasksRequestObject) (V1DagListTasksResponseObject, error)
+type UserUpdateGoogleOauthCallback302Response struct {
+	Headers UserUpdateGoogleOauthCallback302ResponseHeaders
+}
 
-	V1HttpOperatorDelete(ctx echo.Context, request V1HttpOperatorDeleteRequestObject) (V1HttpOperatorDeleteResponseObject, error)
+func (response UserUpdateGoogleOauthCallback302Response) VisitUserUpdateGoogleOauthCallbackResponse(w http.ResponseWriter) error {
+	w.Header().Set("location", fmt.Sprint(response.Headers.Location))
+	w.WriteHeader(302)
+	return nil
+}
 
-	V1HttpOperatorGet(ctx echo.Context, request V1HttpOperatorGetRequestObject) (V1HttpOperatorGetResponseObject, error)
+type UserUpdateGoogleOauthStartRequestObject struct {
+}
 
-	V1HttpOperatorUpdate(ctx echo.Context, request V1HttpOperatorUpdateRequestObject) (V1HttpOperatorUpdateResponseObject, error)
+type UserUpdateGoogleOauthStartResponseObject interface {
+	VisitUserUpdateGoogleOauthStartResponse(w http.ResponseWriter) error
+}
 
-	V1TaskGet(ctx echo.Context, request V1TaskGetRequestObject) (V1TaskGetResponseObject, error)
+type UserUpdateGoogleOauthStart302ResponseHeaders struct {
+	Location string
+}
 
-	V1LogLineList(ctx echo.Context, request V1LogLineListRequestObject) (V1LogLineListResponseObject, error)
+type UserUpdateGoogleOauthStart302Response struct {
+	Headers UserUpdateGoogleOauthStart302ResponseHeaders
+}
 
-	V1TaskRestore(ctx echo.Context, request V1TaskRestoreRequestObject) (V1TaskRestoreResponseObject, error)
+func (response UserUpdateGoogleOauthStart302Response) VisitUserUpdateGoogleOauthStartResponse(w http.ResponseWriter) error {
+	w.Header().Set("location", fmt.Sprint(response.Headers.Location))
+	w.WriteHeader(302)
+	return nil
+}
 
-	V1TaskEventList(ctx echo.Context, request V1TaskEventListRequestObject) (V1TaskEventListResponseObject, error)
+type UserListTenantInvitesRequestObject struct {
+}
 
-	V1CelDebug(ctx echo.Context, request V1CelDebugRequestObject) (V1CelDebugResponseObject, error)
+type UserListTenantInvitesResponseObject interface {
+	VisitUserListTenantInvitesResponse(w http.ResponseWriter) error
+}
 
-	V1DurableTaskBranch(ctx echo.Context, request V1DurableTaskBranchRequestObject) (V1DurableTaskBranchResponseObject, error)
+type UserListTenantInvites200JSONResponse TenantInviteList
 
-	V1DurableTaskEventLogList(ctx echo.Context, request V1DurableTaskEventLogListRequestObject) (V1DurableTaskEventLogListResponseObject, error)
+func (response UserListTenantInvites200JSONResponse) VisitUserListTenantInvitesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
 
-	V1EventList(ctx echo.Context, request V1EventListRequestObject) (V1EventListResponseObject, error)
+	return json.NewEncoder(w).Encode(response)
+}
 
-	V1EventKeyList(ctx echo.Context, request V1EventKeyListRequestObject) (V1EventKeyListResponseObject, error)
+type UserListTenantInvites400JSONResponse APIErrors
 
-	V1EventGet(ctx echo.Context, request V1EventGetRequestObject) (V1EventGetResponseObject, error)
+func (response UserListTenantInvites400JSONResponse) VisitUserListTenantInvitesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
 
-	V1FilterList(ctx echo.Context, request V1FilterListRequestObject) (V1FilterListResponseObject, error)
+	return json.NewEncoder(w).Encode(response)
+}
 
-	V1FilterCreate(ctx echo.Context, request V1FilterCreateRequestObject) (V1FilterCreateResponseObject, error)
+type UserListTenantInvites403JSONResponse APIErrors
 
-	V1FilterDelete(ctx echo.Context, request V1FilterDeleteRequestObject) (V1FilterDeleteResponseObject, error)
+func (response UserListTenantInvites403JSONResponse) VisitUserListTenantInvitesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
 
-	V1FilterGet(ctx echo.Context, request V1FilterGetRequestObject) (V1FilterGetResponseObject, error)
+	return json.NewEncoder(w).Encode(response)
+}
 
-	V1FilterUpdate(ctx echo.Context, request V1FilterUpdateRequestObject) (V1FilterUpdateResponseObject, error)
+type TenantInviteAcceptRequestObject struct {
+	Body *TenantInviteAcceptJSONRequestBody
+}
 
-	V1TenantLogLineGetPointMetrics(ctx echo.Context, request V1TenantLogLineGetPointMetricsRequestObject) (V1TenantLogLineGetPointMetricsResponseObject, error)
+type TenantInviteAcceptResponseObject interface {
+	VisitTenantInviteAcceptResponse(w http.ResponseWriter) error
+}
 
-	V1TenantLogLineList(ctx echo.Context, request V1TenantLogLineListRequestObject) (V1TenantLogLineListResponseObject, error)
+type TenantInviteAccept200Response struct {
+}
 
-	V1HttpOperatorList(ctx echo.Context, request V1HttpOperatorListRequestObject) (V1HttpOperatorListResponseObject, error)
+func (response TenantInviteAccept200Response) VisitTenantInviteAcceptResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
 
-	V1HttpOperatorCreate(ctx echo.Context, request V1HttpOperatorCreateRequestObject) (V1HttpOperatorCreateResponseObject, error)
+type TenantInviteAccept400JSONResponse APIErrors
 
-	V1TaskListStatusMetrics(ctx echo.Context, request V1TaskListStatusMetricsRequestObject) (V1TaskListStatusMetricsResponseObject, error)
+func (response TenantInviteAccept400JSONResponse) VisitTenantInviteAcceptResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
 
-	V1TaskGetPointMetrics(ctx echo.Context, request V1TaskGetPointMetricsRequestObject) (V1TaskGetPointMetricsResponseObject, error)
+	return json.NewEncoder(w).Encode(response)
+}
 
-	V1TaskCancel(ctx echo.Context, request V1TaskCancelRequestObject) (V1TaskCancelResponseObject, error)
+type TenantInviteAccept403JSONResponse APIError
 
-	V1TaskReplay(ctx echo.Context, request V1TaskReplayRequestObject) (V1TaskReplayResponseObject, error)
+func (response TenantInviteAccept403JSONResponse) VisitTenantInviteAcceptResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
 
-	V1ObservabilityGetTrace(ctx echo.Context, request V1ObservabilityGetTraceRequestObject) (V1ObservabilityGetTraceResponseObject, error)
+	return json.NewEncoder(w).Encode(response)
+}
 
-	V1WebhookList(ctx echo.Context, request V1WebhookListRequestObject) (V1WebhookListResponseObject, error)
+type TenantInviteRejectRequestObject struct {
+	Body *TenantInviteRejectJSONRequestBody
+}
 
-	V1WebhookCreate(ctx echo.Context, request V1WebhookCreateRequestObject) (V1WebhookCreateResponseObject, error)
+type TenantInviteRejectResponseObject interface {
+	VisitTenantInviteRejectResponse(w http.ResponseWriter) error
+}
 
-	V1WebhookDelete(ctx echo.Context, request V1WebhookDeleteRequestObject) (V1WebhookDeleteResponseObject, error)
+type TenantInviteReject200Response struct {
+}
 
-	V1WebhookGet(ctx echo.Context, request V1WebhookGetRequestObject) (V1WebhookGetResponseObject, error)
+func (response TenantInviteReject200Response) VisitTenantInviteRejectResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
 
-	V1WebhookUpdate(ctx echo.Context, request V1WebhookUpdateRequestObject) (V1WebhookUpdateResponseObject, error)
+type TenantInviteReject400JSONResponse APIErrors
 
-	V1WebhookReceive(ctx echo.Context, request V1WebhookReceiveRequestObject) (V1WebhookReceiveResponseObject, error)
+func (response TenantInviteReject400JSONResponse) VisitTenantInviteRejectResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
 
-	V1WorkflowRunList(ctx echo.Context, request V1WorkflowRunListRequestObject) (V1WorkflowRunListResponseObject, error)
+	return json.NewEncoder(w).Encode(response)
+}
 
-	V1WorkflowRunDisplayNamesList(ctx echo.Context, request V1WorkflowRunDisplayNamesListRequestObject) (V1WorkflowRunDisplayNamesListResponseObject, error)
+type TenantInviteReject403JSONResponse APIError
 
-	V1WorkflowRunExternalIdsList(ctx echo.Context, request V1WorkflowRunExternalIdsListRequestObject) (V1WorkflowRunExternalIdsListResponseObject, error)
+func (response TenantInviteReject403JSONResponse) VisitTenantInviteRejectResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
 
-	V1WorkflowRunCreate(ctx echo.Context, request V1WorkflowRunCreateRequestObject) (V1WorkflowRunCreateResponseObject, error)
+	return json.NewEncoder(w).Encode(response)
+}
 
-	V1WorkflowRunGet(ctx echo.Context, request V1WorkflowRunGetRequestObject) (V1WorkflowRunGetResponseObject, error)
-
-	V1WorkflowRunGetStatus(ctx echo.Context, request V1WorkflowRunGetStatusRequestObject) (V1WorkflowRunGetStatusResponseObject, error)
-
-	V1WorkflowRunTaskEventsList(ctx echo.Context, request V1WorkflowRunTaskEventsListRequestObject) (V1WorkflowRunTaskEventsListResponseObject, error)
+type UserUpdateLoginRequestObject struct {
+	Body *UserUpdateLoginJSONRequestBody
+}
 
-	V1WorkflowRunGetTimings(ctx echo.Context, request V1WorkflowRunGetTimingsRequestObject) (V1WorkflowRunGetTimingsResponseObject, error)
+type UserUpdateLoginResponseObject interface {
+	VisitUserUpdateLoginResponse(w http.ResponseWriter) error
+}
 
-	StepRunListArchives(ctx echo.Context, request StepRunListArchivesRequestObject) (StepRunListArchivesResponseObject, error)
+type UserUpdateLogin200JSONResponse User
 
-	StepRunListEvents(ctx echo.Context, request StepRunListEventsRequestObject) (StepRunListEventsResponseObject, error)
+func (response UserUpdateLogin200JSONResponse) VisitUserUpdateLoginResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
 
-	TenantCreate(ctx echo.Context, request TenantCreateRequestObject) (TenantCreateResponseObject, error)
+	return json.NewEncoder(w).Encode(response)
+}
 
-	TenantGet(ctx echo.Context, request TenantGetRequestObject) (TenantGetResponseObject, error)
+type UserUpdateLogin400JSONResponse APIErrors
 
-	TenantUpdate(ctx echo.Context, request TenantUpdateRequestObject) (TenantUpdateResponseObject, error)
+func (response UserUpdateLogin400JSONResponse) VisitUserUpdateLoginResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
 
-	AlertEmailGroupList(ctx echo.Context, request AlertEmailGroupListRequestObject) (AlertEmailGroupListResponseObject, error)
+	return json.NewEncoder(w).Encode(response)
+}
 
-	AlertEmailGroupCreate(ctx echo.Context, request AlertEmailGroupCreateRequestObject) (AlertEmailGroupCreateResponseObject, error)
+type UserUpdateLogin401JSONResponse APIErrors
 
-	TenantAlertingSettingsGet(ctx echo.Context, request TenantAlertingSettingsGetRequestObject) (TenantAlertingSettingsGetResponseObject, error)
+func (response UserUpdateLogin401JSONResponse) VisitUserUpdateLoginResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
 
-	ApiTokenList(ctx echo.Context, request ApiTokenListRequestObject) (ApiTokenListResponseObject, error)
+	return json.NewEncoder(w).Encode(response)
+}
 
-	ApiTokenCreate(ctx echo.Context, request ApiTokenCreateRequestObject) (ApiTokenCreateResponseObject, error)
+type UserUpdateLogin405JSONResponse APIErrors
 
-	EventList(ctx echo.Context, request EventListRequestObject) (EventListResponseObject, error)
+func (response UserUpdateLogin405JSONResponse) VisitUserUpdateLoginResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(405)
 
-	EventCreate(ctx echo.Context, request EventCreateRequestObject) (EventCreateResponseObject, error)
+	return json.NewEncoder(w).Encode(response)
+}
 
-	EventCreateBulk(ctx echo.Context, request EventCreateBulkRequestObject) (EventCreateBulkResponseObject, error)
+type UserUpdateLogin422JSONResponse APIErrors
 
-	EventUpdateCancel(ctx echo.Context, request EventUpdateCancelRequestObject) (EventUpdateCancelResponseObject, error)
+func (response UserUpdateLogin422JSONResponse) VisitUserUpdateLoginResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
 
-	EventKeyList(ctx echo.Context, request EventKeyListRequestObject) (EventKeyListResponseObject, error)
+	return json.NewEncoder(w).Encode(response)
+}
 
-	EventUpdateReplay(ctx echo.Context, request EventUpdateReplayRequestObject) (EventUpdateReplayResponseObject, error)
+type UserUpdateLogoutRequestObject struct {
+}
 
-	EventDataGetWithTenant(ctx echo.Context, request EventDataGetWithTenantRequestObject) (EventDataGetWithTenantResponseObject, error)
+type UserUpdateLogoutResponseObject interface {
+	VisitUserUpdateLogoutResponse(w http.ResponseWriter) error
+}
 
-	TenantFeatureFlagEvaluate(ctx echo.Context, request TenantFeatureFlagEvaluateRequestObject) (TenantFeatureFlagEvaluateResponseObject, error)
+type UserUpdateLogout200JSONResponse User
 
-	TenantInviteList(ctx echo.Context, request TenantInviteListRequestObject) (TenantInviteListResponseObject, error)
+func (response UserUpdateLogout200JSONResponse) VisitUserUpdateLogoutResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
 
-	TenantInviteCreate(ctx echo.Context, request TenantInviteCreateRequestObject) (TenantInviteCreateResponseObject, error)
+	return json.NewEncoder(w).Encode(response)
+}
 
-	TenantInviteDelete(ctx echo.Context, request TenantInviteDeleteRequestObject) (TenantInviteDeleteResponseObject, error)
+type UserUpdateLogout400JSONResponse APIErrors
 
-	TenantInviteUpdate(ctx echo.Context, request TenantInviteUpdateRequestObject) (TenantInviteUpdateResponseObject, error)
+func (response UserUpdateLogout400JSONResponse) VisitUserUpdateLogoutResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
 
-	TenantMemberList(ctx echo.Context, request TenantMemberListRequestObject) (TenantMemberListResponseObject, error)
+	return json.NewEncoder(w).Encode(response)
+}
 
-	TenantMemberDelete(ctx echo.Context, request TenantMemberDeleteRequestObject) (TenantMemberDeleteResponseObject, error)
+type UserUpdateLogout401JSONResponse APIErrors
 
-	TenantMemberUpdate(ctx echo.Context, request TenantMemberUpdateRequestObject) (TenantMemberUpdateResponseObject, error)
+func (response UserUpdateLogout401JSONResponse) VisitUserUpdateLogoutResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
 
-	TenantGetPrometheusMetrics(ctx echo.Context, request TenantGetPrometheusMetricsRequestObject) (TenantGetPrometheusMetricsResponseObject, error)
+	return json.NewEncoder(w).Encode(response)
+}
 
-	TenantGetQueueMetrics(ctx echo.Context, request TenantGetQueueMetricsRequestObject) (TenantGetQueueMetricsResponseObject, error)
+type UserUpdateLogout405JSONResponse APIErrors
 
-	RateLimitDelete(ctx echo.Context, request RateLimitDeleteRequestObject) (RateLimitDeleteResponseObject, error)
+func (response UserUpdateLogout405JSONResponse) VisitUserUpdateLogoutResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(405)
 
-	RateLimitList(ctx echo.Context, request RateLimitListRequestObject) (RateLimitListResponseObject, error)
+	return json.NewEncoder(w).Encode(response)
+}
 
-	TenantResourcePolicyGet(ctx echo.Context, request TenantResourcePolicyGetRequestObject) (TenantResourcePolicyGetResponseObject, error)
+type TenantMembershipsListRequestObject struct {
+}
 
-	SlackWebhookList(ctx echo.Context, request SlackWebhookListRequestObject) (SlackWebhookListResponseObject, error)
+type TenantMembershipsListResponseObject interface {
+	VisitTenantMembershipsListResponse(w http.ResponseWriter) error
+}
 
-	UserUpdateSlackOauthStart(ctx echo.Context, request UserUpdateSlackOauthStartRequestObject) (UserUpdateSlackOauthStartResponseObject, error)
+type TenantMembershipsList200JSONResponse UserTenantMembershipsList
 
-	SnsList(ctx echo.Context, request SnsListRequestObject) (SnsListResponseObject, error)
+func (response TenantMembershipsList200JSONResponse) VisitTenantMembershipsListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
 
-	SnsCreate(ctx echo.Context, request SnsCreateRequestObject) (SnsCreateResponseObject, error)
+	return json.NewEncoder(w).Encode(response)
+}
 
-	TenantGetStepRunQueueMetrics(ctx echo.Context, request TenantGetStepRunQueueMetricsRequestObject) (TenantGetStepRunQueueMetricsResponseObject, error)
+type TenantMembershipsList400JSONResponse APIErrors
 
-	StepRunGet(ctx echo.Context, request StepRunGetRequestObject) (StepRunGetResponseObject, error)
+func (response TenantMembershipsList400JSONResponse) VisitTenantMembershipsListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
 
-	StepRunUpdateCancel(ctx echo.Context, request StepRunUpdateCancelRequestObject) (StepRunUpdateCancelResponseObject, error)
+	return json.NewEncoder(w).Encode(response)
+}
 
-	StepRunUpdateRerun(ctx echo.Context, request StepRunUpdateRerunRequestObject) (StepRunUpdateRerunResponseObject, error)
+type TenantMembershipsList403JSONResponse APIErrors
 
-	StepRunGetSchema(ctx echo.Context, request StepRunGetSchemaRequestObject) (StepRunGetSchemaResponseObject, error)
+func (response TenantMembershipsList403JSONResponse) VisitTenantMembershipsListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
 
-	TenantGetTaskStats(ctx echo.Context, request TenantGetTaskStatsRequestObject) (TenantGetTaskStatsResponseObject, error)
+	return json.NewEncoder(w).Encode(response)
+}
 
-	WebhookList(ctx echo.Context, request WebhookListRequestObject) (WebhookListResponseObject, error)
+type UserUpdatePasswordRequestObject struct {
+	Body *UserUpdatePasswordJSONRequestBody
+}
 
-	WebhookCreate(ctx echo.Context, request WebhookCreateRequestObject) (WebhookCreateResponseObject, error)
+type UserUpdatePasswordResponseObject interface {
+	VisitUserUpdatePasswordResponse(w http.ResponseWriter) error
+}
 
-	WorkerList(ctx echo.Context, request WorkerListRequestObject) (WorkerListResponseObject, error)
+type UserUpdatePassword200JSONResponse User
 
-	WorkflowRunUpdateReplay(ctx echo.Context, request WorkflowRunUpdateReplayRequestObject) (WorkflowRunUpdateReplayResponseObject, error)
+func (response UserUpdatePassword200JSONResponse) VisitUserUpdatePasswordResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
 
-	WorkflowRunGet(ctx echo.Context, request WorkflowRunGetRequestObject) (WorkflowRunGetResponseObject, error)
+	return json.NewEncoder(w).Encode(response)
+}
 
-	WorkflowRunGetInput(ctx echo.Context, request WorkflowRunGetInputRequestObject) (WorkflowRunGetInputResponseObject, error)
+type UserUpdatePassword400JSONResponse APIErrors
 
-	WorkflowRunGetShape(ctx echo.Context, request WorkflowRunGetShapeRequestObject) (WorkflowRunGetShapeResponseObject, error)
+func (response UserUpdatePassword400JSONResponse) VisitUserUpdatePasswordResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
 
-	WorkflowRunListStepRunEvents(ctx echo.Context, request WorkflowRunListStepRunEventsRequestObject) (WorkflowRunListStepRunEventsResponseObject, error)
+	return json.NewEncoder(w).Encode(response)
+}
 
-	WorkflowList(ctx echo.Context, request WorkflowListRequestObject) (WorkflowListResponseObject, error)
+type UserUpdatePassword401JSONResponse APIErrors
 
-	WorkflowRunCancel(ctx echo.Context, request WorkflowRunCancelRequestObject) (WorkflowRunCancelResponseObject, error)
+func (response UserUpdatePassword401JSONResponse) VisitUserUpdatePasswordResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
 
-	CronWorkflowList(ctx echo.Context, request CronWorkflowListRequestObject) (CronWorkflowListResponseObject, error)
+	return json.NewEncoder(w).Encode(response)
+}
 
-	WorkflowCronDelete(ctx echo.Context, request WorkflowCronDeleteRequestObject) (WorkflowCronDeleteResponseObject, error)
+type UserUpdatePassword405JSONResponse APIErrors
 
-	WorkflowCronGet(ctx echo.Context, request WorkflowCronGetRequestObject) (WorkflowCronGetResponseObject, error)
+func (response UserUpdatePassword405JSONResponse) VisitUserUpdatePasswordResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(405)
 
-	WorkflowCronUpdate(ctx echo.Context, request WorkflowCronUpdateRequestObject) (WorkflowCronUpdateResponseObject, error)
+	return json.NewEncoder(w).Encode(response)
+}
 
-	WorkflowCronTrigger(ctx echo.Context, request WorkflowCronTriggerRequestObject) (WorkflowCronTriggerResponseObject, error)
+type UserUpdatePassword422JSONResponse APIErrors
 
-	WorkflowRunList(ctx echo.Context, request WorkflowRunListRequestObject) (WorkflowRunListResponseObject, error)
+func (response UserUpdatePassword422JSONResponse) VisitUserUpdatePasswordResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
 
-	WorkflowRunGetMetrics(ctx echo.Context, request WorkflowRunGetMetricsRequestObject) (WorkflowRunGetMetricsResponseObject, error)
+	return json.NewEncoder(w).Encode(response)
+}
 
-	WorkflowScheduledList(ctx echo.Context, request WorkflowScheduledListRequestObject) (WorkflowScheduledListResponseObject, error)
+type UserCreateRequestObject struct {
+	Body *UserCreateJSONRequestBody
+}
 
-	WorkflowScheduledBulkDelete(ctx echo.Context, request WorkflowScheduledBulkDeleteRequestObject) (WorkflowScheduledBulkDeleteResponseObject, error)
+type UserCreateResponseObject interface {
+	VisitUserCreateResponse(w http.ResponseWriter) error
+}
 
-	WorkflowScheduledBulkUpdate(ctx echo.Context, request WorkflowScheduledBulkUpdateRequestObject) (WorkflowScheduledBulkUpdateResponseObject, error)
+type UserCreate200JSONResponse User
 
-	WorkflowScheduledDelete(ctx echo.Context, request WorkflowScheduledDeleteRequestObject) (WorkflowScheduledDeleteResponseObject, error)
+func (response UserCreate200JSONResponse) VisitUserCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
 
-	WorkflowScheduledGet(ctx echo.Context, request WorkflowScheduledGetRequestObject) (WorkflowScheduledGetResponseObject, error)
+	return json.NewEncoder(w).Encode(response)
+}
 
-	WorkflowScheduledUpdate(ctx echo.Context, request WorkflowScheduledUpdateRequestObject) (WorkflowScheduledUpdateResponseObject, error)
+type UserCreate400JSONResponse APIErrors
 
-	WorkflowScheduledTrigger(ctx echo.Context, request WorkflowScheduledTriggerRequestObject) (WorkflowScheduledTriggerResponseObject, error)
+func (response UserCreate400JSONResponse) VisitUserCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
 
-	CronWorkflowTriggerCreate(ctx echo.Context, request CronWorkflowTriggerCreateRequestObject) (CronWorkflowTriggerCreateResponseObject, error)
+	return json.NewEncoder(w).Encode(response)
+}
 
-	ScheduledWorkflowRunCreate(ctx echo.Context, request ScheduledWorkflowRunCreateRequestObject) (ScheduledWorkflowRunCreateResponseObject, error)
+type UserCreate401JSONResponse APIErrors
 
-	WorkflowGetWorkersCount(ctx echo.Context, request WorkflowGetWorkersCountRequestObject) (WorkflowGetWorkersCountResponseObject, error)
+func (response UserCreate401JSONResponse) VisitUserCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
 
-	UserGetCurrent(ctx echo.Context, request UserGetCurrentRequestObject) (UserGetCurrentResponseObject, error)
+	return json.NewEncoder(w).Encode(response)
+}
 
-	UserUpdateGithubOauthCallback(ctx echo.Context, request UserUpdateGithubOauthCallbackRequestObject) (UserUpdateGithubOauthCallbackResponseObject, error)
+type UserCreate405JSONResponse APIErrors
 
-	UserUpdateGithubOauthStart(ctx echo.Context, request UserUpdateGithubOauthStartRequestObject) (UserUpdateGithubOauthStartResponseObject, error)
+func (response UserCreate405JSONResponse) VisitUserCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(405)
 
-	UserUpdateGoogleOauthCallback(ctx echo.Context, request UserUpdateGoogleOauthCallbackRequestObject) (UserUpdateGoogleOauthCallbackResponseObject, error)
+	return json.NewEncoder(w).Encode(response)
+}
 
-	UserUpdateGoogleOauthStart(ctx echo.Context, request UserUpdateGoogleOauthStartRequestObject) (UserUpdateGoogleOauthStartResponseObject, error)
+type UserCreate422JSONResponse APIErrors
 
-	UserListTenantInvites(ctx echo.Context, request UserListTenantInvitesRequestObject) (UserListTenantInvitesResponseObject, error)
+func (response UserCreate422JSONResponse) VisitUserCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
 
-	TenantInviteAccept(ctx echo.Context, request TenantInviteAcceptRequestObject) (TenantInviteAcceptResponseObject, error)
+	return json.NewEncoder(w).Encode(response)
+}
 
-	TenantInviteReject(ctx echo.Context, request TenantInviteRejectRequestObject) (TenantInviteRejectResponseObject, error)
+type UserUpdateSlackOauthCallbackRequestObject struct {
+}
 
-	UserUpdateLogin(ctx echo.Context, request UserUpdateLoginRequestObject) (UserUpdateLoginResponseObject, error)
+type UserUpdateSlackOauthCallbackResponseObject interface {
+	VisitUserUpdateSlackOauthCallbackResponse(w http.ResponseWriter) error
+}
 
-	UserUpdateLogout(ctx echo.Context, request UserUpdateLogoutRequestObject) (UserUpdateLogoutResponseObject, error)
+type UserUpdateSlackOauthCallback302ResponseHeaders struct {
+	Location string
+}
 
-	TenantMembershipsList(ctx echo.Context, request TenantMembershipsListRequestObject) (TenantMembershipsListResponseObject, error)
+type UserUpdateSlackOauthCallback302Response struct {
+	Headers UserUpdateSlackOauthCallback302ResponseHeaders
+}
 
-	UserUpdatePassword(ctx echo.Context, request UserUpdatePasswordRequestObject) (UserUpdatePasswordResponseObject, error)
+func (response UserUpdateSlackOauthCallback302Response) VisitUserUpdateSlackOauthCallbackResponse(w http.ResponseWriter) error {
+	w.Header().Set("location", fmt.Sprint(response.Headers.Location))
+	w.WriteHeader(302)
+	return nil
+}
+
+type InfoGetVersionRequestObject struct {
+}
+
+type InfoGetVersionResponseObject interface {
+	VisitInfoGetVersionResponse(w http.ResponseWriter) error
+}
+
+type InfoGetVersion200JSONResponse struct {
+	Version string `json:"version"`
+}
+
+func (response InfoGetVersion200JSONResponse) VisitInfoGetVersionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WebhookDeleteRequestObject struct {
+	Webhook openapi_types.UUID `json:"webhook"`
+}
+
+type WebhookDeleteResponseObject interface {
+	VisitWebhookDeleteResponse(w http.ResponseWriter) error
+}
+
+type WebhookDelete200Response struct {
+}
+
+func (response WebhookDelete200Response) VisitWebhookDeleteResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type WebhookDelete400JSONResponse APIErrors
+
+func (response WebhookDelete400JSONResponse) VisitWebhookDeleteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WebhookDelete401JSONResponse APIErrors
+
+func (response WebhookDelete401JSONResponse) VisitWebhookDeleteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WebhookDelete405JSONResponse APIErrors
+
+func (response WebhookDelete405JSONResponse) VisitWebhookDeleteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(405)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WebhookRequestsListRequestObject struct {
+	Webhook openapi_types.UUID `json:"webhook"`
+}
+
+type WebhookRequestsListResponseObject interface {
+	VisitWebhookRequestsListResponse(w http.ResponseWriter) error
+}
+
+type WebhookRequestsList200JSONResponse WebhookWorkerRequestListResponse
+
+func (response WebhookRequestsList200JSONResponse) VisitWebhookRequestsListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WebhookRequestsList400JSONResponse APIErrors
+
+func (response WebhookRequestsList400JSONResponse) VisitWebhookRequestsListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WebhookRequestsList401JSONResponse APIErrors
+
+func (response WebhookRequestsList401JSONResponse) VisitWebhookRequestsListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WebhookRequestsList405JSONResponse APIErrors
+
+func (response WebhookRequestsList405JSONResponse) VisitWebhookRequestsListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(405)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WorkerGetRequestObject struct {
+	Worker openapi_types.UUID `json:"worker"`
+}
+
+type WorkerGetResponseObject interface {
+	VisitWorkerGetResponse(w http.ResponseWriter) error
+}
+
+type WorkerGet200JSONResponse Worker
+
+func (response WorkerGet200JSONResponse) VisitWorkerGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WorkerGet400JSONResponse APIErrors
+
+func (response WorkerGet400JSONResponse) VisitWorkerGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WorkerGet403JSONResponse APIErrors
+
+func (response WorkerGet403JSONResponse) VisitWorkerGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WorkerUpdateRequestObject struct {
+	Worker openapi_types.UUID `json:"worker"`
+	Body   *WorkerUpdateJSONRequestBody
+}
+
+type WorkerUpdateResponseObject interface {
+	VisitWorkerUpdateResponse(w http.ResponseWriter) error
+}
+
+type WorkerUpdate200JSONResponse Worker
+
+func (response WorkerUpdate200JSONResponse) VisitWorkerUpdateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WorkerUpdate400JSONResponse APIErrors
+
+func (response WorkerUpdate400JSONResponse) VisitWorkerUpdateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WorkerUpdate403JSONResponse APIErrors
+
+func (response WorkerUpdate403JSONResponse) VisitWorkerUpdateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WorkflowDeleteRequestObject struct {
+	Workflow openapi_types.UUID `json:"workflow"`
+}
+
+type WorkflowDeleteResponseObject interface {
+	VisitWorkflowDeleteResponse(w http.ResponseWriter) error
+}
+
+type WorkflowDelete204Response struct {
+}
+
+func (response WorkflowDelete204Response) VisitWorkflowDeleteResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type WorkflowDelete400JSONResponse APIErrors
+
+func (response WorkflowDelete400JSONResponse) VisitWorkflowDeleteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WorkflowDelete403JSONResponse APIErrors
+
+func (response WorkflowDelete403JSONResponse) VisitWorkflowDeleteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WorkflowDelete404JSONResponse APIErrors
+
+func (response WorkflowDelete404JSONResponse) VisitWorkflowDeleteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WorkflowGetRequestObject struct {
+	Workflow openapi_types.UUID `json:"workflow"`
+}
+
+type WorkflowGetResponseObject interface {
+	VisitWorkflowGetResponse(w http.ResponseWriter) error
+}
+
+type WorkflowGet200JSONResponse Workflow
+
+func (response WorkflowGet200JSONResponse) VisitWorkflowGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WorkflowGet400JSONResponse APIErrors
+
+func (response WorkflowGet400JSONResponse) VisitWorkflowGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WorkflowGet403JSONResponse APIErrors
+
+func (response WorkflowGet403JSONResponse) VisitWorkflowGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WorkflowGet404JSONResponse APIErrors
+
+func (response WorkflowGet404JSONResponse) VisitWorkflowGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WorkflowUpdateRequestObject struct {
+	Workflow openapi_types.UUID `json:"workflow"`
+	Body     *WorkflowUpdateJSONRequestBody
+}
+
+type WorkflowUpdateResponseObject interface {
+	VisitWorkflowUpdateResponse(w http.ResponseWriter) error
+}
+
+type WorkflowUpdate200JSONResponse Workflow
+
+func (response WorkflowUpdate200JSONResponse) VisitWorkflowUpdateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WorkflowUpdate400JSONResponse APIErrors
+
+func (response WorkflowUpdate400JSONResponse) VisitWorkflowUpdateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WorkflowUpdate403JSONResponse APIErrors
+
+func (response WorkflowUpdate403JSONResponse) VisitWorkflowUpdateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WorkflowGetMetricsRequestObject struct {
+	Workflow openapi_types.UUID `json:"workflow"`
+	Params   WorkflowGetMetricsParams
+}
+
+type WorkflowGetMetricsResponseObject interface {
+	VisitWorkflowGetMetricsResponse(w http.ResponseWriter) error
+}
+
+type WorkflowGetMetrics200JSONResponse WorkflowMetrics
+
+func (response WorkflowGetMetrics200JSONResponse) VisitWorkflowGetMetricsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WorkflowGetMetrics400JSONResponse APIErrors
+
+func (response WorkflowGetMetrics400JSONResponse) VisitWorkflowGetMetricsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WorkflowGetMetrics403JSONResponse APIErrors
+
+func (response WorkflowGetMetrics403JSONResponse) VisitWorkflowGetMetricsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WorkflowGetMetrics404JSONResponse APIErrors
+
+func (response WorkflowGetMetrics404JSONResponse) VisitWorkflowGetMetricsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WorkflowRunCreateRequestObject struct {
+	Workflow openapi_types.UUID `json:"workflow"`
+	Params   WorkflowRunCreateParams
+	Body     *WorkflowRunCreateJSONRequestBody
+}
+
+type WorkflowRunCreateResponseObject interface {
+	VisitWorkflowRunCreateResponse(w http.ResponseWriter) error
+}
+
+type WorkflowRunCreate200JSONResponse WorkflowRun
+
+func (response WorkflowRunCreate200JSONResponse) VisitWorkflowRunCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WorkflowRunCreate400JSONResponse APIErrors
+
+func (response WorkflowRunCreate400JSONResponse) VisitWorkflowRunCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WorkflowRunCreate403JSONResponse APIErrors
+
+func (response WorkflowRunCreate403JSONResponse) VisitWorkflowRunCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WorkflowRunCreate404JSONResponse APIErrors
+
+func (response WorkflowRunCreate404JSONResponse) VisitWorkflowRunCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WorkflowRunCreate429JSONResponse APIErrors
+
+func (response WorkflowRunCreate429JSONResponse) VisitWorkflowRunCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WorkflowVersionGetRequestObject struct {
+	Workflow openapi_types.UUID `json:"workflow"`
+	Params   WorkflowVersionGetParams
+}
+
+type WorkflowVersionGetResponseObject interface {
+	VisitWorkflowVersionGetResponse(w http.ResponseWriter) error
+}
+
+type WorkflowVersionGet200JSONResponse WorkflowVersion
+
+func (response WorkflowVersionGet200JSONResponse) VisitWorkflowVersionGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WorkflowVersionGet400JSONResponse APIErrors
+
+func (response WorkflowVersionGet400JSONResponse) VisitWorkflowVersionGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WorkflowVersionGet403JSONResponse APIErrors
+
+func (response WorkflowVersionGet403JSONResponse) VisitWorkflowVersionGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WorkflowVersionGet404JSONResponse APIErrors
+
+func (response WorkflowVersionGet404JSONResponse) VisitWorkflowVersionGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type StrictServerInterface interface {
+	LivenessGet(ctx echo.Context, request LivenessGetRequestObject) (LivenessGetResponseObject, error)
+
+	ReadinessGet(ctx echo.Context, request ReadinessGetRequestObject) (ReadinessGetResponseObject, error)
+
+	AlertEmailGroupDelete(ctx echo.Context, request AlertEmailGroupDeleteRequestObject) (AlertEmailGroupDeleteResponseObject, error)
+
+	AlertEmailGroupUpdate(ctx echo.Context, request AlertEmailGroupUpdateRequestObject) (AlertEmailGroupUpdateResponseObject, error)
+
+	ApiTokenUpdateRevoke(ctx echo.Context, request ApiTokenUpdateRevokeRequestObject) (ApiTokenUpdateRevokeResponseObject, error)
+
+	CloudMetadataGet(ctx echo.Context, request CloudMetadataGetRequestObject) (CloudMetadataGetResponseObject, error)
+
+	EventGet(ctx echo.Context, request EventGetRequestObject) (EventGetResponseObject, error)
+
+	EventDataGet(ctx echo.Context, request EventDataGetRequestObject) (EventDataGetResponseObject, error)
+
+	MetadataGet(ctx echo.Context, request MetadataGetRequestObject) (MetadataGetResponseObject, error)
+
+	MetadataListIntegrations(ctx echo.Context, request MetadataListIntegrationsRequestObject) (MetadataListIntegrationsResponseObject, error)
+
+	MonitoringPostRunProbe(ctx echo.Context, request MonitoringPostRunProbeRequestObject) (MonitoringPostRunProbeResponseObject, error)
+
+	SlackWebhookDelete(ctx echo.Context, request SlackWebhookDeleteRequestObject) (SlackWebhookDeleteResponseObject, error)
+
+	SnsDelete(ctx echo.Context, request SnsDeleteRequestObject) (SnsDeleteResponseObject, error)
+
+	SnsUpdate(ctx echo.Context, request SnsUpdateRequestObject) (SnsUpdateResponseObject, error)
+
+	V1DagListTasks(ctx echo.Context, request V1DagListTasksRequestObject) (V1DagListTasksResponseObject, error)
+
+	V1HttpOperatorDelete(ctx echo.Context, request V1HttpOperatorDeleteRequestObject) (V1HttpOperatorDeleteResponseObject, error)
+
+	V1HttpOperatorGet(ctx echo.Context, request V1HttpOperatorGetRequestObject) (V1HttpOperatorGetResponseObject, error)
+
+	V1HttpOperatorUpdate(ctx echo.Context, request V1HttpOperatorUpdateRequestObject) (V1HttpOperatorUpdateResponseObject, error)
+
+	V1TaskGet(ctx echo.Context, request V1TaskGetRequestObject) (V1TaskGetResponseObject, error)
+
+	V1LogLineList(ctx echo.Context, request V1LogLineListRequestObject) (V1LogLineListResponseObject, error)
+
+	V1TaskRestore(ctx echo.Context, request V1TaskRestoreRequestObject) (V1TaskRestoreResponseObject, error)
+
+	V1TaskEventList(ctx echo.Context, request V1TaskEventListRequestObject) (V1TaskEventListResponseObject, error)
+
+	V1ApprovalList(ctx echo.Context, request V1ApprovalListRequestObject) (V1ApprovalListResponseObject, error)
+
+	V1ApprovalResolve(ctx echo.Context, request V1ApprovalResolveRequestObject) (V1ApprovalResolveResponseObject, error)
+
+	V1BulkJobList(ctx echo.Context, request V1BulkJobListRequestObject) (V1BulkJobListResponseObject, error)
+
+	V1BulkJobCreate(ctx echo.Context, request V1BulkJobCreateRequestObject) (V1BulkJobCreateResponseObject, error)
+
+	V1BulkJobGet(ctx echo.Context, request V1BulkJobGetRequestObject) (V1BulkJobGetResponseObject, error)
+
+	V1BulkJobPause(ctx echo.Context, request V1BulkJobPauseRequestObject) (V1BulkJobPauseResponseObject, error)
+
+	V1BulkJobResume(ctx echo.Context, request V1BulkJobResumeRequestObject) (V1BulkJobResumeResponseObject, error)
+
+	V1TenantBundleExport(ctx echo.Context, request V1TenantBundleExportRequestObject) (V1TenantBundleExportResponseObject, error)
+
+	V1TenantBundleImport(ctx echo.Context, request V1TenantBundleImportRequestObject) (V1TenantBundleImportResponseObject, error)
+
+	V1CelDebug(ctx echo.Context, request V1CelDebugRequestObject) (V1CelDebugResponseObject, error)
+
+	V1CircuitBreakerList(ctx echo.Context, request V1CircuitBreakerListRequestObject) (V1CircuitBreakerListResponseObject, error)
+
+	V1DurableTaskBranch(ctx echo.Context, request V1DurableTaskBranchRequestObject) (V1DurableTaskBranchResponseObject, error)
+
+	V1DurableTaskEventLogList(ctx echo.Context, request V1DurableTaskEventLogListRequestObject) (V1DurableTaskEventLogListResponseObject, error)
+
+	V1EventSchemaDelete(ctx echo.Context, request V1EventSchemaDeleteRequestObject) (V1EventSchemaDeleteResponseObject, error)
+
+	V1EventSchemaList(ctx echo.Context, request V1EventSchemaListRequestObject) (V1EventSchemaListResponseObject, error)
+
+	V1EventSchemaCreate(ctx echo.Context, request V1EventSchemaCreateRequestObject) (V1EventSchemaCreateResponseObject, error)
+
+	V1EventList(ctx echo.Context, request V1EventListRequestObject) (V1EventListResponseObject, error)
+
+	V1EventKeyList(ctx echo.Context, request V1EventKeyListRequestObject) (V1EventKeyListResponseObject, error)
+
+	V1EventGet(ctx echo.Context, request V1EventGetRequestObject) (V1EventGetResponseObject, error)
+
+	V1FilterList(ctx echo.Context, request V1FilterListRequestObject) (V1FilterListResponseObject, error)
+
+	V1FilterCreate(ctx echo.Context, request V1FilterCreateRequestObject) (V1FilterCreateResponseObject, error)
+
+	V1FilterDelete(ctx echo.Context, request V1FilterDeleteRequestObject) (V1FilterDeleteResponseObject, error)
+
+	V1FilterGet(ctx echo.Context, request V1FilterGetRequestObject) (V1FilterGetResponseObject, error)
+
+	V1FilterUpdate(ctx echo.Context, request V1FilterUpdateRequestObject) (V1FilterUpdateResponseObject, error)
+
+	V1TenantLogLineGetPointMetrics(ctx echo.Context, request V1TenantLogLineGetPointMetricsRequestObject) (V1TenantLogLineGetPointMetricsResponseObject, error)
+
+	V1TenantLogLineList(ctx echo.Context, request V1TenantLogLineListRequestObject) (V1TenantLogLineListResponseObject, error)
+
+	V1TenantLogLineTail(ctx echo.Context, request V1TenantLogLineTailRequestObject) (V1TenantLogLineTailResponseObject, error)
+
+	V1HttpOperatorList(ctx echo.Context, request V1HttpOperatorListRequestObject) (V1HttpOperatorListResponseObject, error)
+
+	V1HttpOperatorCreate(ctx echo.Context, request V1HttpOperatorCreateRequestObject) (V1HttpOperatorCreateResponseObject, error)
+
+	V1TaskGetSlotUsage(ctx echo.Context, request V1TaskGetSlotUsageRequestObject) (V1TaskGetSlotUsageResponseObject, error)
+
+	V1TaskListStatusMetrics(ctx echo.Context, request V1TaskListStatusMetricsRequestObject) (V1TaskListStatusMetricsResponseObject, error)
+
+	V1TaskGetPointMetrics(ctx echo.Context, request V1TaskGetPointMetricsRequestObject) (V1TaskGetPointMetricsResponseObject, error)
+
+	V1TaskCancel(ctx echo.Context, request V1TaskCancelRequestObject) (V1TaskCancelResponseObject, error)
+
+	V1TaskReplay(ctx echo.Context, request V1TaskReplayRequestObject) (V1TaskReplayResponseObject, error)
+
+	V1ObservabilityGetTrace(ctx echo.Context, request V1ObservabilityGetTraceRequestObject) (V1ObservabilityGetTraceResponseObject, error)
+
+	V1WebhookList(ctx echo.Context, request V1WebhookListRequestObject) (V1WebhookListResponseObject, error)
+
+	V1WebhookCreate(ctx echo.Context, request V1WebhookCreateRequestObject) (V1WebhookCreateResponseObject, error)
+
+	V1WebhookDelete(ctx echo.Context, request V1WebhookDeleteRequestObject) (V1WebhookDeleteResponseObject, error)
+
+	V1WebhookGet(ctx echo.Context, request V1WebhookGetRequestObject) (V1WebhookGetResponseObject, error)
+
+	V1WebhookUpdate(ctx echo.Context, request V1WebhookUpdateRequestObject) (V1WebhookUpdateResponseObject, error)
+
+	V1WebhookReceive(ctx echo.Context, request V1WebhookReceiveRequestObject) (V1WebhookReceiveResponseObject, error)
+
+	V1WorkerCordon(ctx echo.Context, request V1WorkerCordonRequestObject) (V1WorkerCordonResponseObject, error)
+
+	V1WorkerCordonList(ctx echo.Context, request V1WorkerCordonListRequestObject) (V1WorkerCordonListResponseObject, error)
+
+	V1WorkerDrain(ctx echo.Context, request V1WorkerDrainRequestObject) (V1WorkerDrainResponseObject, error)
+
+	V1WorkerUncordon(ctx echo.Context, request V1WorkerUncordonRequestObject) (V1WorkerUncordonResponseObject, error)
+
+	V1WorkflowRunList(ctx echo.Context, request V1WorkflowRunListRequestObject) (V1WorkflowRunListResponseObject, error)
+
+	V1WorkflowRunDisplayNamesList(ctx echo.Context, request V1WorkflowRunDisplayNamesListRequestObject) (V1WorkflowRunDisplayNamesListResponseObject, error)
+
+	V1WorkflowRunExternalIdsList(ctx echo.Context, request V1WorkflowRunExternalIdsListRequestObject) (V1WorkflowRunExternalIdsListResponseObject, error)
+
+	V1WorkflowRunSearch(ctx echo.Context, request V1WorkflowRunSearchRequestObject) (V1WorkflowRunSearchResponseObject, error)
+
+	V1WorkflowRunSignal(ctx echo.Context, request V1WorkflowRunSignalRequestObject) (V1WorkflowRunSignalResponseObject, error)
+
+	V1WorkflowRunCreate(ctx echo.Context, request V1WorkflowRunCreateRequestObject) (V1WorkflowRunCreateResponseObject, error)
+
+	V1WorkflowRunGet(ctx echo.Context, request V1WorkflowRunGetRequestObject) (V1WorkflowRunGetResponseObject, error)
+
+	V1WorkflowRunGetStatus(ctx echo.Context, request V1WorkflowRunGetStatusRequestObject) (V1WorkflowRunGetStatusResponseObject, error)
+
+	V1WorkflowRunTaskEventsList(ctx echo.Context, request V1WorkflowRunTaskEventsListRequestObject) (V1WorkflowRunTaskEventsListResponseObject, error)
+
+	V1WorkflowRunGetTimings(ctx echo.Context, request V1WorkflowRunGetTimingsRequestObject) (V1WorkflowRunGetTimingsResponseObject, error)
+
+	V1WorkflowSpecGet(ctx echo.Context, request V1WorkflowSpecGetRequestObject) (V1WorkflowSpecGetResponseObject, error)
+
+	StepRunListArchives(ctx echo.Context, request StepRunListArchivesRequestObject) (StepRunListArchivesResponseObject, error)
+
+	StepRunListEvents(ctx echo.Context, request StepRunListEventsRequestObject) (StepRunListEventsResponseObject, error)
+
+	TenantCreate(ctx echo.Context, request TenantCreateRequestObject) (TenantCreateResponseObject, error)
+
+	TenantGet(ctx echo.Context, request TenantGetRequestObject) (TenantGetResponseObject, error)
+
+	TenantUpdate(ctx echo.Context, request TenantUpdateRequestObject) (TenantUpdateResponseObject, error)
+
+	AlertEmailGroupList(ctx echo.Context, request AlertEmailGroupListRequestObject) (AlertEmailGroupListResponseObject, error)
+
+	AlertEmailGroupCreate(ctx echo.Context, request AlertEmailGroupCreateRequestObject) (AlertEmailGroupCreateResponseObject, error)
+
+	TenantAlertingSettingsGet(ctx echo.Context, request TenantAlertingSettingsGetRequestObject) (TenantAlertingSettingsGetResponseObject, error)
+
+	ApiTokenList(ctx echo.Context, request ApiTokenListRequestObject) (ApiTokenListResponseObject, error)
+
+	ApiTokenCreate(ctx echo.Context, request ApiTokenCreateRequestObject) (ApiTokenCreateResponseObject, error)
+
+	EventList(ctx echo.Context, request EventListRequestObject) (EventListResponseObject, error)
+
+	EventCreate(ctx echo.Context, request EventCreateRequestObject) (EventCreateResponseObject, error)
+
+	EventCreateBulk(ctx echo.Context, request EventCreateBulkRequestObject) (EventCreateBulkResponseObject, error)
+
+	EventUpdateCancel(ctx echo.Context, request EventUpdateCancelRequestObject) (EventUpdateCancelResponseObject, error)
+
+	EventKeyList(ctx echo.Context, request EventKeyListRequestObject) (EventKeyListResponseObject, error)
+
+	EventUpdateReplay(ctx echo.Context, request EventUpdateReplayRequestObject) (EventUpdateReplayResponseObject, error)
+
+	EventDataGetWithTenant(ctx echo.Context, request EventDataGetWithTenantRequestObject) (EventDataGetWithTenantResponseObject, error)
+
+	TenantFeatureFlagEvaluate(ctx echo.Context, request TenantFeatureFlagEvaluateRequestObject) (TenantFeatureFlagEvaluateResponseObject, error)
+
+	TenantInviteList(ctx echo.Context, request TenantInviteListRequestObject) (TenantInviteListResponseObject, error)
+
+	TenantInviteCreate(ctx echo.Context, request TenantInviteCreateRequestObject) (TenantInviteCreateResponseObject, error)
+
+	TenantInviteDelete(ctx echo.Context, request TenantInviteDeleteRequestObject) (TenantInviteDeleteResponseObject, error)
+
+	TenantInviteUpdate(ctx echo.Context, request TenantInviteUpdateRequestObject) (TenantInviteUpdateResponseObject, error)
+
+	TenantMemberList(ctx echo.Context, request TenantMemberListRequestObject) (TenantMemberListResponseObject, error)
+
+	TenantMemberDelete(ctx echo.Context, request TenantMemberDeleteRequestObject) (TenantMemberDeleteResponseObject, error)
+
+	TenantMemberUpdate(ctx echo.Context, request TenantMemberUpdateRequestObject) (TenantMemberUpdateResponseObject, error)
+
+	TenantGetPrometheusMetrics(ctx echo.Context, request TenantGetPrometheusMetricsRequestObject) (TenantGetPrometheusMetricsResponseObject, error)
+
+	TenantGetQueueMetrics(ctx echo.Context, request TenantGetQueueMetricsRequestObject) (TenantGetQueueMetricsResponseObject, error)
+
+	RateLimitDelete(ctx echo.Context, request RateLimitDeleteRequestObject) (RateLimitDeleteResponseObject, error)
+
+	RateLimitList(ctx echo.Context, request RateLimitListRequestObject) (RateLimitListResponseObject, error)
+
+	TenantResourcePolicyGet(ctx echo.Context, request TenantResourcePolicyGetRequestObject) (TenantResourcePolicyGetResponseObject, error)
+
+	SlackWebhookList(ctx echo.Context, request SlackWebhookListRequestObject) (SlackWebhookListResponseObject, error)
+
+	UserUpdateSlackOauthStart(ctx echo.Context, request UserUpdateSlackOauthStartRequestObject) (UserUpdateSlackOauthStartResponseObject, error)
+
+	SnsList(ctx echo.Context, request SnsListRequestObject) (SnsListResponseObject, error)
+
+	SnsCreate(ctx echo.Context, request SnsCreateRequestObject) (SnsCreateResponseObject, error)
+
+	TenantGetStepRunQueueMetrics(ctx echo.Context, request TenantGetStepRunQueueMetricsRequestObject) (TenantGetStepRunQueueMetricsResponseObject, error)
+
+	StepRunGet(ctx echo.Context, request StepRunGetRequestObject) (StepRunGetResponseObject, error)
+
+	StepRunUpdateCancel(ctx echo.Context, request StepRunUpdateCancelRequestObject) (StepRunUpdateCancelResponseObject, error)
+
+	StepRunUpdateRerun(ctx echo.Context, request StepRunUpdateRerunRequestObject) (StepRunUpdateRerunResponseObject, error)
+
+	StepRunGetSchema(ctx echo.Context, request StepRunGetSchemaRequestObject) (StepRunGetSchemaResponseObject, error)
+
+	TenantGetTaskStats(ctx echo.Context, request TenantGetTaskStatsRequestObject) (TenantGetTaskStatsResponseObject, error)
+
+	WebhookList(ctx echo.Context, request WebhookListRequestObject) (WebhookListResponseObject, error)
+
+	WebhookCreate(ctx echo.Context, request WebhookCreateRequestObject) (WebhookCreateResponseObject, error)
+
+	WorkerList(ctx echo.Context, request WorkerListRequestObject) (WorkerListResponseObject, error)
+
+	WorkflowRunUpdateReplay(ctx echo.Context, request WorkflowRunUpdateReplayRequestObject) (WorkflowRunUpdateReplayResponseObject, error)
+
+	WorkflowRunGet(ctx echo.Context, request WorkflowRunGetRequestObject) (WorkflowRunGetResponseObject, error)
+
+	WorkflowRunGetInput(ctx echo.Context, request WorkflowRunGetInputRequestObject) (WorkflowRunGetInputResponseObject, error)
+
+	WorkflowRunGetShape(ctx echo.Context, request WorkflowRunGetShapeRequestObject) (WorkflowRunGetShapeResponseObject, error)
+
+	WorkflowRunListStepRunEvents(ctx echo.Context, request WorkflowRunListStepRunEventsRequestObject) (WorkflowRunListStepRunEventsResponseObject, error)
+
+	WorkflowList(ctx echo.Context, request WorkflowListRequestObject) (WorkflowListResponseObject, error)
+
+	WorkflowRunCancel(ctx echo.Context, request WorkflowRunCancelRequestObject) (WorkflowRunCancelResponseObject, error)
+
+	CronWorkflowList(ctx echo.Context, request CronWorkflowListRequestObject) (CronWorkflowListResponseObject, error)
+
+	WorkflowCronDelete(ctx echo.Context, request WorkflowCronDeleteRequestObject) (WorkflowCronDeleteResponseObject, error)
+
+	WorkflowCronGet(ctx echo.Context, request WorkflowCronGetRequestObject) (WorkflowCronGetResponseObject, error)
+
+	WorkflowCronUpdate(ctx echo.Context, request WorkflowCronUpdateRequestObject) (WorkflowCronUpdateResponseObject, error)
+
+	WorkflowCronTrigger(ctx echo.Context, request WorkflowCronTriggerRequestObject) (WorkflowCronTriggerResponseObject, error)
+
+	WorkflowRunList(ctx echo.Context, request WorkflowRunListRequestObject) (WorkflowRunListResponseObject, error)
+
+	WorkflowRunGetMetrics(ctx echo.Context, request WorkflowRunGetMetricsRequestObject) (WorkflowRunGetMetricsResponseObject, error)
+
+	WorkflowScheduledList(ctx echo.Context, request WorkflowScheduledListRequestObject) (WorkflowScheduledListResponseObject, error)
+
+	WorkflowScheduledBulkDelete(ctx echo.Context, request WorkflowScheduledBulkDeleteRequestObject) (WorkflowScheduledBulkDeleteResponseObject, error)
+
+	WorkflowScheduledBulkUpdate(ctx echo.Context, request WorkflowScheduledBulkUpdateRequestObject) (WorkflowScheduledBulkUpdateResponseObject, error)
+
+	WorkflowScheduledDelete(ctx echo.Context, request WorkflowScheduledDeleteRequestObject) (WorkflowScheduledDeleteResponseObject, error)
+
+	WorkflowScheduledGet(ctx echo.Context, request WorkflowScheduledGetRequestObject) (WorkflowScheduledGetResponseObject, error)
+
+	WorkflowScheduledUpdate(ctx echo.Context, request WorkflowScheduledUpdateRequestObject) (WorkflowScheduledUpdateResponseObject, error)
+
+	WorkflowScheduledTrigger(ctx echo.Context, request WorkflowScheduledTriggerRequestObject) (WorkflowScheduledTriggerResponseObject, error)
+
+	CronWorkflowTriggerCreate(ctx echo.Context, request CronWorkflowTriggerCreateRequestObject) (CronWorkflowTriggerCreateResponseObject, error)
+
+	ScheduledWorkflowRunCreate(ctx echo.Context, request ScheduledWorkflowRunCreateRequestObject) (ScheduledWorkflowRunCreateResponseObject, error)
+
+	WorkflowGetWorkersCount(ctx echo.Context, request WorkflowGetWorkersCountRequestObject) (WorkflowGetWorkersCountResponseObject, error)
+
+	UserGetCurrent(ctx echo.Context, request UserGetCurrentRequestObject) (UserGetCurrentResponseObject, error)
+
+	UserUpdateGithubOauthCallback(ctx echo.Context, request UserUpdateGithubOauthCallbackRequestObject) (UserUpdateGithubOauthCallbackResponseObject, error)
+
+	UserUpdateGithubOauthStart(ctx echo.Context, request UserUpdateGithubOauthStartRequestObject) (UserUpdateGithubOauthStartResponseObject, error)
+
+	UserUpdateGoogleOauthCallback(ctx echo.Context, request UserUpdateGoogleOauthCallbackRequestObject) (UserUpdateGoogleOauthCallbackResponseObject, error)
+
+	UserUpdateGoogleOauthStart(ctx echo.Context, request UserUpdateGoogleOauthStartRequestObject) (UserUpdateGoogleOauthStartResponseObject, error)
+
+	UserListTenantInvites(ctx echo.Context, request UserListTenantInvitesRequestObject) (UserListTenantInvitesResponseObject, error)
+
+	TenantInviteAccept(ctx echo.Context, request TenantInviteAcceptRequestObject) (TenantInviteAcceptResponseObject, error)
+
+	TenantInviteReject(ctx echo.Context, request TenantInviteRejectRequestObject) (TenantInviteRejectResponseObject, error)
+
+	UserUpdateLogin(ctx echo.Context, request UserUpdateLoginRequestObject) (UserUpdateLoginResponseObject, error)
+
+	UserUpdateLogout(ctx echo.Context, request UserUpdateLogoutRequestObject) (UserUpdateLogoutResponseObject, error)
+
+	TenantMembershipsList(ctx echo.Context, request TenantMembershipsListRequestObject) (TenantMembershipsListResponseObject, error)
+
+	UserUpdatePassword(ctx echo.Context, request UserUpdatePasswordRequestObject) (UserUpdatePasswordResponseObject, error)
+
+	UserCreate(ctx echo.Context, request UserCreateRequestObject) (UserCreateResponseObject, error)
+
+	UserUpdateSlackOauthCallback(ctx echo.Context, request UserUpdateSlackOauthCallbackRequestObject) (UserUpdateSlackOauthCallbackResponseObject, error)
+
+	InfoGetVersion(ctx echo.Context, request InfoGetVersionRequestObject) (InfoGetVersionResponseObject, error)
+
+	WebhookDelete(ctx echo.Context, request WebhookDeleteRequestObject) (WebhookDeleteResponseObject, error)
+
+	WebhookRequestsList(ctx echo.Context, request WebhookRequestsListRequestObject) (WebhookRequestsListResponseObject, error)
+
+	WorkerGet(ctx echo.Context, request WorkerGetRequestObject) (WorkerGetResponseObject, error)
+
+	WorkerUpdate(ctx echo.Context, request WorkerUpdateRequestObject) (WorkerUpdateResponseObject, error)
+
+	WorkflowDelete(ctx echo.Context, request WorkflowDeleteRequestObject) (WorkflowDeleteResponseObject, error)
+
+	WorkflowGet(ctx echo.Context, request WorkflowGetRequestObject) (WorkflowGetResponseObject, error)
+
+	WorkflowUpdate(ctx echo.Context, request WorkflowUpdateRequestObject) (WorkflowUpdateResponseObject, error)
+
+	WorkflowGetMetrics(ctx echo.Context, request WorkflowGetMetricsRequestObject) (WorkflowGetMetricsResponseObject, error)
+
+	WorkflowRunCreate(ctx echo.Context, request WorkflowRunCreateRequestObject) (WorkflowRunCreateResponseObject, error)
+
+	WorkflowVersionGet(ctx echo.Context, request WorkflowVersionGetRequestObject) (WorkflowVersionGetResponseObject, error)
+}
+type StrictHandlerFunc func(ctx echo.Context, args interface{}) (interface{}, error)
+
+func NewStrictHandler(ssi StrictServerInterface) ServerInterface {
+	return &strictHandler{ssi: ssi}
+}
+
+type strictHandler struct {
+	ssi StrictServerInterface
+}
+
+// LivenessGet operation
+func (sh *strictHandler) LivenessGet(ctx echo.Context) error {
+	var request LivenessGetRequestObject
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.LivenessGet(ctx, request.(LivenessGetRequestObject))
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(LivenessGetResponseObject); ok {
+		return validResponse.VisitLivenessGetResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// ReadinessGet operation
+func (sh *strictHandler) ReadinessGet(ctx echo.Context) error {
+	var request ReadinessGetRequestObject
 
-	UserCreate(ctx echo.Context, request UserCreateRequestObject) (UserCreateResponseObject, error)
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.ReadinessGet(ctx, request.(ReadinessGetRequestObject))
+	}
 
-	UserUpdateSlackOauthCallback(ctx echo.Context, request UserUpdateSlackOauthCallbackRequestObject) (UserUpdateSlackOauthCallbackResponseObject, error)
+	response, err := handler(ctx, request)
 
-	InfoGetVersion(ctx echo.Context, request InfoGetVersionRequestObject) (InfoGetVersionResponseObject, error)
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(ReadinessGetResponseObject); ok {
+		return validResponse.VisitReadinessGetResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
+}
 
-	WebhookDelete(ctx echo.Context, request WebhookDeleteRequestObject) (WebhookDeleteResponseObject, error)
+// AlertEmailGroupDelete operation
+func (sh *strictHandler) AlertEmailGroupDelete(ctx echo.Context, alertEmailGroup openapi_types.UUID) error {
+	var request AlertEmailGroupDeleteRequestObject
 
-	WebhookRequestsList(ctx echo.Context, request WebhookRequestsListRequestObject) (WebhookRequestsListResponseObject, error)
+	request.AlertEmailGroup = alertEmailGroup
 
-	WorkerGet(ctx echo.Context, request WorkerGetRequestObject) (WorkerGetResponseObject, error)
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.AlertEmailGroupDelete(ctx, request.(AlertEmailGroupDeleteRequestObject))
+	}
 
-	WorkerUpdate(ctx echo.Context, request WorkerUpdateRequestObject) (WorkerUpdateResponseObject, error)
+	response, err := handler(ctx, request)
 
-	WorkflowDelete(ctx echo.Context, request WorkflowDeleteRequestObject) (WorkflowDeleteResponseObject, error)
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(AlertEmailGroupDeleteResponseObject); ok {
+		return validResponse.VisitAlertEmailGroupDeleteResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
+}
 
-	WorkflowGet(ctx echo.Context, request WorkflowGetRequestObject) (WorkflowGetResponseObject, error)
+// AlertEmailGroupUpdate operation
+func (sh *strictHandler) AlertEmailGroupUpdate(ctx echo.Context, alertEmailGroup openapi_types.UUID) error {
+	var request AlertEmailGroupUpdateRequestObject
 
-	WorkflowUpdate(ctx echo.Context, request WorkflowUpdateRequestObject) (WorkflowUpdateResponseObject, error)
+	request.AlertEmailGroup = alertEmailGroup
 
-	WorkflowGetMetrics(ctx echo.Context, request WorkflowGetMetricsRequestObject) (WorkflowGetMetricsResponseObject, error)
+	var body AlertEmailGroupUpdateJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
 
-	WorkflowRunCreate(ctx echo.Context, request WorkflowRunCreateRequestObject) (WorkflowRunCreateResponseObject, error)
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.AlertEmailGroupUpdate(ctx, request.(AlertEmailGroupUpdateRequestObject))
+	}
 
-	WorkflowVersionGet(ctx echo.Context, request WorkflowVersionGetRequestObject) (WorkflowVersionGetResponseObject, error)
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(AlertEmailGroupUpdateResponseObject); ok {
+		return validResponse.VisitAlertEmailGroupUpdateResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
 }
-type StrictHandlerFunc func(ctx echo.Context, args interface{}) (interface{}, error)
 
-func NewStrictHandler(ssi StrictServerInterface) ServerInterface {
-	return &strictHandler{ssi: ssi}
+// ApiTokenUpdateRevoke operation
+func (sh *strictHandler) ApiTokenUpdateRevoke(ctx echo.Context, apiToken openapi_types.UUID) error {
+	var request ApiTokenUpdateRevokeRequestObject
+
+	request.ApiToken = apiToken
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.ApiTokenUpdateRevoke(ctx, request.(ApiTokenUpdateRevokeRequestObject))
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(ApiTokenUpdateRevokeResponseObject); ok {
+		return validResponse.VisitApiTokenUpdateRevokeResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// CloudMetadataGet operation
+func (sh *strictHandler) CloudMetadataGet(ctx echo.Context) error {
+	var request CloudMetadataGetRequestObject
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.CloudMetadataGet(ctx, request.(CloudMetadataGetRequestObject))
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(CloudMetadataGetResponseObject); ok {
+		return validResponse.VisitCloudMetadataGetResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// EventGet operation
+func (sh *strictHandler) EventGet(ctx echo.Context, event openapi_types.UUID) error {
+	var request EventGetRequestObject
+
+	request.Event = event
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.EventGet(ctx, request.(EventGetRequestObject))
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(EventGetResponseObject); ok {
+		return validResponse.VisitEventGetResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// EventDataGet operation
+func (sh *strictHandler) EventDataGet(ctx echo.Context, event openapi_types.UUID) error {
+	var request EventDataGetRequestObject
+
+	request.Event = event
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.EventDataGet(ctx, request.(EventDataGetRequestObject))
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(EventDataGetResponseObject); ok {
+		return validResponse.VisitEventDataGetResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// MetadataGet operation
+func (sh *strictHandler) MetadataGet(ctx echo.Context) error {
+	var request MetadataGetRequestObject
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.MetadataGet(ctx, request.(MetadataGetRequestObject))
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(MetadataGetResponseObject); ok {
+		return validResponse.VisitMetadataGetResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// MetadataListIntegrations operation
+func (sh *strictHandler) MetadataListIntegrations(ctx echo.Context) error {
+	var request MetadataListIntegrationsRequestObject
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.MetadataListIntegrations(ctx, request.(MetadataListIntegrationsRequestObject))
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(MetadataListIntegrationsResponseObject); ok {
+		return validResponse.VisitMetadataListIntegrationsResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// MonitoringPostRunProbe operation
+func (sh *strictHandler) MonitoringPostRunProbe(ctx echo.Context, tenant openapi_types.UUID) error {
+	var request MonitoringPostRunProbeRequestObject
+
+	request.Tenant = tenant
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.MonitoringPostRunProbe(ctx, request.(MonitoringPostRunProbeRequestObject))
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(MonitoringPostRunProbeResponseObject); ok {
+		return validResponse.VisitMonitoringPostRunProbeResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// SlackWebhookDelete operation
+func (sh *strictHandler) SlackWebhookDelete(ctx echo.Context, slack openapi_types.UUID) error {
+	var request SlackWebhookDeleteRequestObject
+
+	request.Slack = slack
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.SlackWebhookDelete(ctx, request.(SlackWebhookDeleteRequestObject))
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(SlackWebhookDeleteResponseObject); ok {
+		return validResponse.VisitSlackWebhookDeleteResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// SnsDelete operation
+func (sh *strictHandler) SnsDelete(ctx echo.Context, sns openapi_types.UUID) error {
+	var request SnsDeleteRequestObject
+
+	request.Sns = sns
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.SnsDelete(ctx, request.(SnsDeleteRequestObject))
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(SnsDeleteResponseObject); ok {
+		return validResponse.VisitSnsDeleteResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
 }
 
-type strictHandler struct {
-	ssi StrictServerInterface
-}
+// SnsUpdate operation
+func (sh *strictHandler) SnsUpdate(ctx echo.Context, tenant openapi_types.UUID, event string) error {
+	var request SnsUpdateRequestObject
 
-// LivenessGet operation
-func (sh *strictHandler) LivenessGet(ctx echo.Context) error {
-	var request LivenessGetRequestObject
+	request.Tenant = tenant
+	request.Event = event
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.LivenessGet(ctx, request.(LivenessGetRequestObject))
+		return sh.ssi.SnsUpdate(ctx, request.(SnsUpdateRequestObject))
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(LivenessGetResponseObject); ok {
-		return validResponse.VisitLivenessGetResponse(ctx.Response())
+	} else if validResponse, ok := response.(SnsUpdateResponseObject); ok {
+		return validResponse.VisitSnsUpdateResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("Unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// ReadinessGet operation
-func (sh *strictHandler) ReadinessGet(ctx echo.Context) error {
-	var request ReadinessGetRequestObject
+// V1DagListTasks operation
+func (sh *strictHandler) V1DagListTasks(ctx echo.Context, params V1DagListTasksParams) error {
+	var request V1DagListTasksRequestObject
+
+	request.Params = params
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.ReadinessGet(ctx, request.(ReadinessGetRequestObject))
+		return sh.ssi.V1DagListTasks(ctx, request.(V1DagListTasksRequestObject))
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(ReadinessGetResponseObject); ok {
-		return validResponse.VisitReadinessGetResponse(ctx.Response())
+	} else if validResponse, ok := response.(V1DagListTasksResponseObject); ok {
+		return validResponse.VisitV1DagListTasksResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("Unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// AlertEmailGroupDelete operation
-func (sh *strictHandler) AlertEmailGroupDelete(ctx echo.Context, alertEmailGroup openapi_types.UUID) error {
-	var request AlertEmailGroupDeleteRequestObject
+// V1HttpOperatorDelete operation
+func (sh *strictHandler) V1HttpOperatorDelete(ctx echo.Context, v1HttpOperator openapi_types.UUID) error {
+	var request V1HttpOperatorDeleteRequestObject
 
-	request.AlertEmailGroup = alertEmailGroup
+	request.V1HttpOperator = v1HttpOperator
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.AlertEmailGroupDelete(ctx, request.(AlertEmailGroupDeleteRequestObject))
+		return sh.ssi.V1HttpOperatorDelete(ctx, request.(V1HttpOperatorDeleteRequestObject))
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(AlertEmailGroupDeleteResponseObject); ok {
-		return validResponse.VisitAlertEmailGroupDeleteResponse(ctx.Response())
+	} else if validResponse, ok := response.(V1HttpOperatorDeleteResponseObject); ok {
+		return validResponse.VisitV1HttpOperatorDeleteResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("Unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// AlertEmailGroupUpdate operation
-func (sh *strictHandler) AlertEmailGroupUpdate(ctx echo.Context, alertEmailGroup openapi_types.UUID) error {
-	var request AlertEmailGroupUpdateRequestObject
-
-	request.AlertEmailGroup = alertEmailGroup
+// V1HttpOperatorGet operation
+func (sh *strictHandler) V1HttpOperatorGet(ctx echo.Context, v1HttpOperator openapi_types.UUID) error {
+	var request V1HttpOperatorGetRequestObject
 
-	var body AlertEmailGroupUpdateJSONRequestBody
-	if err := ctx.Bind(&body); err != nil {
-		return err
-	}
-	request.Body = &body
+	request.V1HttpOperator = v1HttpOperator
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.AlertEmailGroupUpdate(ctx, request.(AlertEmailGroupUpdateRequestObject))
+		return sh.ssi.V1HttpOperatorGet(ctx, request.(V1HttpOperatorGetRequestObject))
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(AlertEmailGroupUpdateResponseObject); ok {
-		return validResponse.VisitAlertEmailGroupUpdateResponse(ctx.Response())
+	} else if validResponse, ok := response.(V1HttpOperatorGetResponseObject); ok {
+		return validResponse.VisitV1HttpOperatorGetResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("Unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// ApiTokenUpdateRevoke operation
-func (sh *strictHandler) ApiTokenUpdateRevoke(ctx echo.Context, apiToken openapi_types.UUID) error {
-	var request ApiTokenUpdateRevokeRequestObject
+// V1HttpOperatorUpdate operation
+func (sh *strictHandler) V1HttpOperatorUpdate(ctx echo.Context, v1HttpOperator openapi_types.UUID) error {
+	var request V1HttpOperatorUpdateRequestObject
 
-	request.ApiToken = apiToken
+	request.V1HttpOperator = v1HttpOperator
+
+	var body V1HttpOperatorUpdateJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.ApiTokenUpdateRevoke(ctx, request.(ApiTokenUpdateRevokeRequestObject))
+		return sh.ssi.V1HttpOperatorUpdate(ctx, request.(V1HttpOperatorUpdateRequestObject))
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(ApiTokenUpdateRevokeResponseObject); ok {
-		return validResponse.VisitApiTokenUpdateRevokeResponse(ctx.Response())
+	} else if validResponse, ok := response.(V1HttpOperatorUpdateResponseObject); ok {
+		return validResponse.VisitV1HttpOperatorUpdateResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("Unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// CloudMetadataGet operation
-func (sh *strictHandler) CloudMetadataGet(ctx echo.Context) error {
-	var request CloudMetadataGetRequestObject
+// V1TaskGet operation
+func (sh *strictHandler) V1TaskGet(ctx echo.Context, task openapi_types.UUID, params V1TaskGetParams) error {
+	var request V1TaskGetRequestObject
+
+	request.Task = task
+	request.Params = params
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.CloudMetadataGet(ctx, request.(CloudMetadataGetRequestObject))
+		return sh.ssi.V1TaskGet(ctx, request.(V1TaskGetRequestObject))
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(CloudMetadataGetResponseObject); ok {
-		return validResponse.VisitCloudMetadataGetResponse(ctx.Response())
+	} else if validResponse, ok := response.(V1TaskGetResponseObject); ok {
+		return validResponse.VisitV1TaskGetResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("Unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// EventGet operation
-func (sh *strictHandler) EventGet(ctx echo.Context, event openapi_types.UUID) error {
-	var request EventGetRequestObject
+// V1LogLineList operation
+func (sh *strictHandler) V1LogLineList(ctx echo.Context, task openapi_types.UUID, params V1LogLineListParams) error {
+	var request V1LogLineListRequestObject
 
-	request.Event = event
+	request.Task = task
+	request.Params = params
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.EventGet(ctx, request.(EventGetRequestObject))
+		return sh.ssi.V1LogLineList(ctx, request.(V1LogLineListRequestObject))
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(EventGetResponseObject); ok {
-		return validResponse.VisitEventGetResponse(ctx.Response())
+	} else if validResponse, ok := response.(V1LogLineListResponseObject); ok {
+		return validResponse.VisitV1LogLineListResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("Unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// EventDataGet operation
-func (sh *strictHandler) EventDataGet(ctx echo.Context, event openapi_types.UUID) error {
-	var request EventDataGetRequestObject
+// V1TaskRestore operation
+func (sh *strictHandler) V1TaskRestore(ctx echo.Context, task openapi_types.UUID) error {
+	var request V1TaskRestoreRequestObject
 
-	request.Event = event
+	request.Task = task
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.EventDataGet(ctx, request.(EventDataGetRequestObject))
+		return sh.ssi.V1TaskRestore(ctx, request.(V1TaskRestoreRequestObject))
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(EventDataGetResponseObject); ok {
-		return validResponse.VisitEventDataGetResponse(ctx.Response())
+	} else if validResponse, ok := response.(V1TaskRestoreResponseObject); ok {
+		return validResponse.VisitV1TaskRestoreResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("Unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// MetadataGet operation
-func (sh *strictHandler) MetadataGet(ctx echo.Context) error {
-	var request MetadataGetRequestObject
+// V1TaskEventList operation
+func (sh *strictHandler) V1TaskEventList(ctx echo.Context, task openapi_types.UUID, params V1TaskEventListParams) error {
+	var request V1TaskEventListRequestObject
+
+	request.Task = task
+	request.Params = params
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.MetadataGet(ctx, request.(MetadataGetRequestObject))
+		return sh.ssi.V1TaskEventList(ctx, request.(V1TaskEventListRequestObject))
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(MetadataGetResponseObject); ok {
-		return validResponse.VisitMetadataGetResponse(ctx.Response())
+	} else if validResponse, ok := response.(V1TaskEventListResponseObject); ok {
+		return validResponse.VisitV1TaskEventListResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("Unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// MetadataListIntegrations operation
-func (sh *strictHandler) MetadataListIntegrations(ctx echo.Context) error {
-	var request MetadataListIntegrationsRequestObject
+// V1ApprovalList operation
+func (sh *strictHandler) V1ApprovalList(ctx echo.Context, tenant openapi_types.UUID, params V1ApprovalListParams) error {
+	var request V1ApprovalListRequestObject
+
+	request.Tenant = tenant
+	request.Params = params
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.MetadataListIntegrations(ctx, request.(MetadataListIntegrationsRequestObject))
+		return sh.ssi.V1ApprovalList(ctx, request.(V1ApprovalListRequestObject))
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(MetadataListIntegrationsResponseObject); ok {
-		return validResponse.VisitMetadataListIntegrationsResponse(ctx.Response())
+	} else if validResponse, ok := response.(V1ApprovalListResponseObject); ok {
+		return validResponse.VisitV1ApprovalListResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("Unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// MonitoringPostRunProbe operation
-func (sh *strictHandler) MonitoringPostRunProbe(ctx echo.Context, tenant openapi_types.UUID) error {
-	var request MonitoringPostRunProbeRequestObject
+// V1ApprovalResolve operation
+func (sh *strictHandler) V1ApprovalResolve(ctx echo.Context, tenant openapi_types.UUID, task openapi_types.UUID) error {
+	var request V1ApprovalResolveRequestObject
 
 	request.Tenant = tenant
+	request.Task = task
+
+	var body V1ApprovalResolveJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.MonitoringPostRunProbe(ctx, request.(MonitoringPostRunProbeRequestObject))
+		return sh.ssi.V1ApprovalResolve(ctx, request.(V1ApprovalResolveRequestObject))
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(MonitoringPostRunProbeResponseObject); ok {
-		return validResponse.VisitMonitoringPostRunProbeResponse(ctx.Response())
+	} else if validResponse, ok := response.(V1ApprovalResolveResponseObject); ok {
+		return validResponse.VisitV1ApprovalResolveResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("Unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// SlackWebhookDelete operation
-func (sh *strictHandler) SlackWebhookDelete(ctx echo.Context, slack openapi_types.UUID) error {
-	var request SlackWebhookDeleteRequestObject
+// V1BulkJobList operation
+func (sh *strictHandler) V1BulkJobList(ctx echo.Context, tenant openapi_types.UUID) error {
+	var request V1BulkJobListRequestObject
 
-	request.Slack = slack
+	request.Tenant = tenant
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.SlackWebhookDelete(ctx, request.(SlackWebhookDeleteRequestObject))
+		return sh.ssi.V1BulkJobList(ctx, request.(V1BulkJobListRequestObject))
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(SlackWebhookDeleteResponseObject); ok {
-		return validResponse.VisitSlackWebhookDeleteResponse(ctx.Response())
+	} else if validResponse, ok := response.(V1BulkJobListResponseObject); ok {
+		return validResponse.VisitV1BulkJobListResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("Unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// SnsDelete operation
-func (sh *strictHandler) SnsDelete(ctx echo.Context, sns openapi_types.UUID) error {
-	var request SnsDeleteRequestObject
+// V1BulkJobCreate operation
+func (sh *strictHandler) V1BulkJobCreate(ctx echo.Context, tenant openapi_types.UUID) error {
+	var request V1BulkJobCreateRequestObject
 
-	request.Sns = sns
+	request.Tenant = tenant
+
+	var body V1BulkJobCreateJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.SnsDelete(ctx, request.(SnsDeleteRequestObject))
+		return sh.ssi.V1BulkJobCreate(ctx, request.(V1BulkJobCreateRequestObject))
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(SnsDeleteResponseObject); ok {
-		return validResponse.VisitSnsDeleteResponse(ctx.Response())
+	} else if validResponse, ok := response.(V1BulkJobCreateResponseObject); ok {
+		return validResponse.VisitV1BulkJobCreateResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("Unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// SnsUpdate operation
-func (sh *strictHandler) SnsUpdate(ctx echo.Context, tenant openapi_types.UUID, event string) error {
-	var request SnsUpdateRequestObject
+// V1BulkJobGet operation
+func (sh *strictHandler) V1BulkJobGet(ctx echo.Context, tenant openapi_types.UUID, v1BulkJob openapi_types.UUID) error {
+	var request V1BulkJobGetRequestObject
 
 	request.Tenant = tenant
-	request.Event = event
+	request.V1BulkJob = v1BulkJob
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.SnsUpdate(ctx, request.(SnsUpdateRequestObject))
+		return sh.ssi.V1BulkJobGet(ctx, request.(V1BulkJobGetRequestObject))
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(SnsUpdateResponseObject); ok {
-		return validResponse.VisitSnsUpdateResponse(ctx.Response())
+	} else if validResponse, ok := response.(V1BulkJobGetResponseObject); ok {
+		return validResponse.VisitV1BulkJobGetResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("Unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// V1DagListTasks operation
-func (sh *strictHandler) V1DagListTasks(ctx echo.Context, params V1DagListTasksParams) error {
-	var request V1DagListTasksRequestObject
+// V1BulkJobPause operation
+func (sh *strictHandler) V1BulkJobPause(ctx echo.Context, tenant openapi_types.UUID, v1BulkJob openapi_types.UUID) error {
+	var request V1BulkJobPauseRequestObject
 
-	request.Params = params
+	request.Tenant = tenant
+	request.V1BulkJob = v1BulkJob
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.V1DagListTasks(ctx, request.(V1DagListTasksRequestObject))
+		return sh.ssi.V1BulkJobPause(ctx, request.(V1BulkJobPauseRequestObject))
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(V1DagListTasksResponseObject); ok {
-		return validResponse.VisitV1DagListTasksResponse(ctx.Response())
+	} else if validResponse, ok := response.(V1BulkJobPauseResponseObject); ok {
+		return validResponse.VisitV1BulkJobPauseResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("Unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// V1HttpOperatorDelete operation
-func (sh *strictHandler) V1HttpOperatorDelete(ctx echo.Context, v1HttpOperator openapi_types.UUID) error {
-	var request V1HttpOperatorDeleteRequestObject
+// V1BulkJobResume operation
+func (sh *strictHandler) V1BulkJobResume(ctx echo.Context, tenant openapi_types.UUID, v1BulkJob openapi_types.UUID) error {
+	var request V1BulkJobResumeRequestObject
 
-	request.V1HttpOperator = v1HttpOperator
+	request.Tenant = tenant
+	request.V1BulkJob = v1BulkJob
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.V1HttpOperatorDelete(ctx, request.(V1HttpOperatorDeleteRequestObject))
+		return sh.ssi.V1BulkJobResume(ctx, request.(V1BulkJobResumeRequestObject))
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(V1HttpOperatorDeleteResponseObject); ok {
-		return validResponse.VisitV1HttpOperatorDeleteResponse(ctx.Response())
+	} else if validResponse, ok := response.(V1BulkJobResumeResponseObject); ok {
+		return validResponse.VisitV1BulkJobResumeResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("Unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// V1HttpOperatorGet operation
-func (sh *strictHandler) V1HttpOperatorGet(ctx echo.Context, v1HttpOperator openapi_types.UUID) error {
-	var request V1HttpOperatorGetRequestObject
+// V1TenantBundleExport operation
+func (sh *strictHandler) V1TenantBundleExport(ctx echo.Context, tenant openapi_types.UUID) error {
+	var request V1TenantBundleExportRequestObject
 
-	request.V1HttpOperator = v1HttpOperator
+	request.Tenant = tenant
+
+	var body V1TenantBundleExportJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.V1HttpOperatorGet(ctx, request.(V1HttpOperatorGetRequestObject))
+		return sh.ssi.V1TenantBundleExport(ctx, request.(V1TenantBundleExportRequestObject))
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(V1HttpOperatorGetResponseObject); ok {
-		return validResponse.VisitV1HttpOperatorGetResponse(ctx.Response())
+	} else if validResponse, ok := response.(V1TenantBundleExportResponseObject); ok {
+		return validResponse.VisitV1TenantBundleExportResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("Unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// V1HttpOperatorUpdate operation
-func (sh *strictHandler) V1HttpOperatorUpdate(ctx echo.Context, v1HttpOperator openapi_types.UUID) error {
-	var request V1HttpOperatorUpdateRequestObject
+// V1TenantBundleImport operation
+func (sh *strictHandler) V1TenantBundleImport(ctx echo.Context, tenant openapi_types.UUID) error {
+	var request V1TenantBundleImportRequestObject
 
-	request.V1HttpOperator = v1HttpOperator
+	request.Tenant = tenant
 
-	var body V1HttpOperatorUpdateJSONRequestBody
+	var body V1TenantBundleImportJSONRequestBody
 	if err := ctx.Bind(&body); err != nil {
 		return err
 	}
 	request.Body = &body
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.V1HttpOperatorUpdate(ctx, request.(V1HttpOperatorUpdateRequestObject))
+		return sh.ssi.V1TenantBundleImport(ctx, request.(V1TenantBundleImportRequestObject))
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(V1HttpOperatorUpdateResponseObject); ok {
-		return validResponse.VisitV1HttpOperatorUpdateResponse(ctx.Response())
+	} else if validResponse, ok := response.(V1TenantBundleImportResponseObject); ok {
+		return validResponse.VisitV1TenantBundleImportResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("Unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// V1TaskGet operation
-func (sh *strictHandler) V1TaskGet(ctx echo.Context, task openapi_types.UUID, params V1TaskGetParams) error {
-	var request V1TaskGetRequestObject
+// V1CelDebug operation
+func (sh *strictHandler) V1CelDebug(ctx echo.Context, tenant openapi_types.UUID) error {
+	var request V1CelDebugRequestObject
 
-	request.Task = task
-	request.Params = params
+	request.Tenant = tenant
+
+	var body V1CelDebugJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.V1TaskGet(ctx, request.(V1TaskGetRequestObject))
+		return sh.ssi.V1CelDebug(ctx, request.(V1CelDebugRequestObject))
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(V1TaskGetResponseObject); ok {
-		return validResponse.VisitV1TaskGetResponse(ctx.Response())
+	} else if validResponse, ok := response.(V1CelDebugResponseObject); ok {
+		return validResponse.VisitV1CelDebugResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("Unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// V1LogLineList operation
-func (sh *strictHandler) V1LogLineList(ctx echo.Context, task openapi_types.UUID, params V1LogLineListParams) error {
-	var request V1LogLineListRequestObject
+// V1CircuitBreakerList operation
+func (sh *strictHandler) V1CircuitBreakerList(ctx echo.Context, tenant openapi_types.UUID) error {
+	var request V1CircuitBreakerListRequestObject
 
-	request.Task = task
-	request.Params = params
+	request.Tenant = tenant
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.V1LogLineList(ctx, request.(V1LogLineListRequestObject))
+		return sh.ssi.V1CircuitBreakerList(ctx, request.(V1CircuitBreakerListRequestObject))
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(V1LogLineListResponseObject); ok {
-		return validResponse.VisitV1LogLineListResponse(ctx.Response())
+	} else if validResponse, ok := response.(V1CircuitBreakerListResponseObject); ok {
+		return validResponse.VisitV1CircuitBreakerListResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("Unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// V1TaskRestore operation
-func (sh *strictHandler) V1TaskRestore(ctx echo.Context, task openapi_types.UUID) error {
-	var request V1TaskRestoreRequestObject
+// V1DurableTaskBranch operation
+func (sh *strictHandler) V1DurableTaskBranch(ctx echo.Context, tenant openapi_types.UUID) error {
+	var request V1DurableTaskBranchRequestObject
 
-	request.Task = task
+	request.Tenant = tenant
+
+	var body V1DurableTaskBranchJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.V1TaskRestore(ctx, request.(V1TaskRestoreRequestObject))
+		return sh.ssi.V1DurableTaskBranch(ctx, request.(V1DurableTaskBranchRequestObject))
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(V1TaskRestoreResponseObject); ok {
-		return validResponse.VisitV1TaskRestoreResponse(ctx.Response())
+	} else if validResponse, ok := response.(V1DurableTaskBranchResponseObject); ok {
+		return validResponse.VisitV1DurableTaskBranchResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("Unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// V1TaskEventList operation
-func (sh *strictHandler) V1TaskEventList(ctx echo.Context, task openapi_types.UUID, params V1TaskEventListParams) error {
-	var request V1TaskEventListRequestObject
+// V1DurableTaskEventLogList operation
+func (sh *strictHandler) V1DurableTaskEventLogList(ctx echo.Context, tenant openapi_types.UUID, durableTask openapi_types.UUID, params V1DurableTaskEventLogListParams) error {
+	var request V1DurableTaskEventLogListRequestObject
 
-	request.Task = task
+	request.Tenant = tenant
+	request.DurableTask = durableTask
 	request.Params = params
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.V1TaskEventList(ctx, request.(V1TaskEventListRequestObject))
+		return sh.ssi.V1DurableTaskEventLogList(ctx, request.(V1DurableTaskEventLogListRequestObject))
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(V1TaskEventListResponseObject); ok {
-		return validResponse.VisitV1TaskEventListResponse(ctx.Response())
+	} else if validResponse, ok := response.(V1DurableTaskEventLogListResponseObject); ok {
+		return validResponse.VisitV1DurableTaskEventLogListResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("Unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// V1CelDebug operation
-func (sh *strictHandler) V1CelDebug(ctx echo.Context, tenant openapi_types.UUID) error {
-	var request V1CelDebugRequestObject
+// V1EventSchemaDelete operation
+func (sh *strictHandler) V1EventSchemaDelete(ctx echo.Context, tenant openapi_types.UUID, params V1EventSchemaDeleteParams) error {
+	var request V1EventSchemaDeleteRequestObject
 
 	request.Tenant = tenant
-
-	var body V1CelDebugJSONRequestBody
-	if err := ctx.Bind(&body); err != nil {
-		return err
-	}
-	request.Body = &body
+	request.Params = params
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.V1CelDebug(ctx, request.(V1CelDebugRequestObject))
+		return sh.ssi.V1EventSchemaDelete(ctx, request.(V1EventSchemaDeleteRequestObject))
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(V1CelDebugResponseObject); ok {
-		return validResponse.VisitV1CelDebugResponse(ctx.Response())
+	} else if validResponse, ok := response.(V1EventSchemaDeleteResponseObject); ok {
+		return validResponse.VisitV1EventSchemaDeleteResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("Unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// V1DurableTaskBranch operation
-func (sh *strictHandler) V1DurableTaskBranch(ctx echo.Context, tenant openapi_types.UUID) error {
-	var request V1DurableTaskBranchRequestObject
+// V1EventSchemaList operation
+func (sh *strictHandler) V1EventSchemaList(ctx echo.Context, tenant openapi_types.UUID, params V1EventSchemaListParams) error {
+	var request V1EventSchemaListRequestObject
 
 	request.Tenant = tenant
-
-	var body V1DurableTaskBranchJSONRequestBody
-	if err := ctx.Bind(&body); err != nil {
-		return err
-	}
-	request.Body = &body
+	request.Params = params
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.V1DurableTaskBranch(ctx, request.(V1DurableTaskBranchRequestObject))
+		return sh.ssi.V1EventSchemaList(ctx, request.(V1EventSchemaListRequestObject))
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(V1DurableTaskBranchResponseObject); ok {
-		return validResponse.VisitV1DurableTaskBranchResponse(ctx.Response())
+	} else if validResponse, ok := response.(V1EventSchemaListResponseObject); ok {
+		return validResponse.VisitV1EventSchemaListResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("Unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// V1DurableTaskEventLogList operation
-func (sh *strictHandler) V1DurableTaskEventLogList(ctx echo.Context, tenant openapi_types.UUID, durableTask openapi_types.UUID, params V1DurableTaskEventLogListParams) error {
-	var request V1DurableTaskEventLogListRequestObject
+// V1EventSchemaCreate operation
+func (sh *strictHandler) V1EventSchemaCreate(ctx echo.Context, tenant openapi_types.UUID) error {
+	var request V1EventSchemaCreateRequestObject
 
 	request.Tenant = tenant
-	request.DurableTask = durableTask
-	request.Params = params
+
+	var body V1EventSchemaCreateJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.V1DurableTaskEventLogList(ctx, request.(V1DurableTaskEventLogListRequestObject))
+		return sh.ssi.V1EventSchemaCreate(ctx, request.(V1EventSchemaCreateRequestObject))
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(V1DurableTaskEventLogListResponseObject); ok {
-		return validResponse.VisitV1DurableTaskEventLogListResponse(ctx.Response())
+	} else if validResponse, ok := response.(V1EventSchemaCreateResponseObject); ok {
+		return validResponse.VisitV1EventSchemaCreateResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("Unexpected response type: %T", response)
 	}
@@ -15558,6 +18229,29 @@ func (sh *strictHandler) V1TenantLogLineList(ctx echo.Context, tenant openapi_ty
 	return nil
 }
 
+// V1TenantLogLineTail operation
+func (sh *strictHandler) V1TenantLogLineTail(ctx echo.Context, tenant openapi_types.UUID, params V1TenantLogLineTailParams) error {
+	var request V1TenantLogLineTailRequestObject
+
+	request.Tenant = tenant
+	request.Params = params
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.V1TenantLogLineTail(ctx, request.(V1TenantLogLineTailRequestObject))
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(V1TenantLogLineTailResponseObject); ok {
+		return validResponse.VisitV1TenantLogLineTailResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
+}
+
 // V1HttpOperatorList operation
 func (sh *strictHandler) V1HttpOperatorList(ctx echo.Context, tenant openapi_types.UUID, params V1HttpOperatorListParams) error {
 	var request V1HttpOperatorListRequestObject
@@ -15609,6 +18303,29 @@ func (sh *strictHandler) V1HttpOperatorCreate(ctx echo.Context, tenant openapi_t
 	return nil
 }
 
+// V1TaskGetSlotUsage operation
+func (sh *strictHandler) V1TaskGetSlotUsage(ctx echo.Context, tenant openapi_types.UUID, params V1TaskGetSlotUsageParams) error {
+	var request V1TaskGetSlotUsageRequestObject
+
+	request.Tenant = tenant
+	request.Params = params
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.V1TaskGetSlotUsage(ctx, request.(V1TaskGetSlotUsageRequestObject))
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(V1TaskGetSlotUsageResponseObject); ok {
+		return validResponse.VisitV1TaskGetSlotUsageResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
+}
+
 // V1TaskListStatusMetrics operation
 func (sh *strictHandler) V1TaskListStatusMetrics(ctx echo.Context, tenant openapi_types.UUID, params V1TaskListStatusMetricsParams) error {
 	var request V1TaskListStatusMetricsRequestObject
@@ -15883,6 +18600,113 @@ func (sh *strictHandler) V1WebhookReceive(ctx echo.Context, tenant openapi_types
 	return nil
 }
 
+// V1WorkerCordon operation
+func (sh *strictHandler) V1WorkerCordon(ctx echo.Context, tenant openapi_types.UUID) error {
+	var request V1WorkerCordonRequestObject
+
+	request.Tenant = tenant
+
+	var body V1WorkerCordonJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.V1WorkerCordon(ctx, request.(V1WorkerCordonRequestObject))
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(V1WorkerCordonResponseObject); ok {
+		return validResponse.VisitV1WorkerCordonResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// V1WorkerCordonList operation
+func (sh *strictHandler) V1WorkerCordonList(ctx echo.Context, tenant openapi_types.UUID, params V1WorkerCordonListParams) error {
+	var request V1WorkerCordonListRequestObject
+
+	request.Tenant = tenant
+	request.Params = params
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.V1WorkerCordonList(ctx, request.(V1WorkerCordonListRequestObject))
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(V1WorkerCordonListResponseObject); ok {
+		return validResponse.VisitV1WorkerCordonListResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// V1WorkerDrain operation
+func (sh *strictHandler) V1WorkerDrain(ctx echo.Context, tenant openapi_types.UUID) error {
+	var request V1WorkerDrainRequestObject
+
+	request.Tenant = tenant
+
+	var body V1WorkerDrainJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.V1WorkerDrain(ctx, request.(V1WorkerDrainRequestObject))
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(V1WorkerDrainResponseObject); ok {
+		return validResponse.VisitV1WorkerDrainResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// V1WorkerUncordon operation
+func (sh *strictHandler) V1WorkerUncordon(ctx echo.Context, tenant openapi_types.UUID) error {
+	var request V1WorkerUncordonRequestObject
+
+	request.Tenant = tenant
+
+	var body V1WorkerUncordonJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.V1WorkerUncordon(ctx, request.(V1WorkerUncordonRequestObject))
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(V1WorkerUncordonResponseObject); ok {
+		return validResponse.VisitV1WorkerUncordonResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
+}
+
 // V1WorkflowRunList operation
 func (sh *strictHandler) V1WorkflowRunList(ctx echo.Context, tenant openapi_types.UUID, params V1WorkflowRunListParams) error {
 	var request V1WorkflowRunListRequestObject
@@ -15952,6 +18776,62 @@ func (sh *strictHandler) V1WorkflowRunExternalIdsList(ctx echo.Context, tenant o
 	return nil
 }
 
+// V1WorkflowRunSearch operation
+func (sh *strictHandler) V1WorkflowRunSearch(ctx echo.Context, tenant openapi_types.UUID) error {
+	var request V1WorkflowRunSearchRequestObject
+
+	request.Tenant = tenant
+
+	var body V1WorkflowRunSearchJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.V1WorkflowRunSearch(ctx, request.(V1WorkflowRunSearchRequestObject))
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(V1WorkflowRunSearchResponseObject); ok {
+		return validResponse.VisitV1WorkflowRunSearchResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// V1WorkflowRunSignal operation
+func (sh *strictHandler) V1WorkflowRunSignal(ctx echo.Context, tenant openapi_types.UUID) error {
+	var request V1WorkflowRunSignalRequestObject
+
+	request.Tenant = tenant
+
+	var body V1WorkflowRunSignalJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.V1WorkflowRunSignal(ctx, request.(V1WorkflowRunSignalRequestObject))
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(V1WorkflowRunSignalResponseObject); ok {
+		return validResponse.VisitV1WorkflowRunSignalResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
+}
+
 // V1WorkflowRunCreate operation
 func (sh *strictHandler) V1WorkflowRunCreate(ctx echo.Context, tenant openapi_types.UUID) error {
 	var request V1WorkflowRunCreateRequestObject
@@ -16070,6 +18950,29 @@ func (sh *strictHandler) V1WorkflowRunGetTimings(ctx echo.Context, v1WorkflowRun
 	return nil
 }
 
+// V1WorkflowSpecGet operation
+func (sh *strictHandler) V1WorkflowSpecGet(ctx echo.Context, workflow openapi_types.UUID, params V1WorkflowSpecGetParams) error {
+	var request V1WorkflowSpecGetRequestObject
+
+	request.Workflow = workflow
+	request.Params = params
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.V1WorkflowSpecGet(ctx, request.(V1WorkflowSpecGetRequestObject))
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(V1WorkflowSpecGetResponseObject); ok {
+		return validResponse.VisitV1WorkflowSpecGetResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
+}
+
 // StepRunListArchives operation
 func (sh *strictHandler) StepRunListArchives(ctx echo.Context, stepRun openapi_types.UUID, params StepRunListArchivesParams) error {
 	var request StepRunListArchivesRequestObject
@@ -18287,394 +21190,504 @@ func (sh *strictHandler) WorkflowVersionGet(ctx echo.Context, workflow openapi_t
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+y9e2/bONYw/lUI/37AMwPYuc10dp4C7x9p4rbepkkeO2mffXeLLG3RNjeypBWppN6i",
-	"3/0FbxIlkRLlW+xWwGIntXg9POfw8Fy/dSbhIgoDFFDSef2tQyZztID8z/PbQT+Ow5j9HcVhhGKKEf8y",
-	"CT3E/ushMolxRHEYdF53IJgkhIYL8B7SyRxRgFhvwBt3O+grXEQ+6rw+/f3kpNuZhvEC0s7rToID+sfv",
-	"nW6HLiPUed3BAUUzFHe+d/PDl2fT/g2mYQzoHBMxpz5d5zxr+ITkmhaIEDhD2ayExjiY8UnDCXnwcfBo",
-	"mpL9DmgI6BwBL5wkCxRQaFhAF+ApwBSgr5hQklvODNN5Mj6ahIvjuYBTz0NP6m/TiqYY+V55NWwN/BOg",
-	"c0i1yQEmABISTjCkyAPPmM75emAU+XgCx37uODoBXBgA8b3bidG/Exwjr/P677mpv6SNw/G/0ISyNSpc",
-	"IWVkQenvmKIF/+P/j9G087rz/x1nuHcsEe84xbrv6TQwjuGytCQ5rmU1HxGF5bVA3w+fL+YwmKFbSMhz",
-	"GBsA+zxHdI5iEMYgCClICIoJmMAATHhHdvg4BpHqr8GSxglKlzMOQx/BgK1HTBsjSNEdCmBAm0zKu4EA",
-	"PQPK+xLnGQfBE6Zi446TYd4DhPyr+JljOyYAB4TCYIKcZx/hWZBEDSYneBaAJMpIqdGUCZ07oBZDi3PW",
-	"VHa5xIQRRD0WsMYooHgiyB0T4Mmu4Bf2Lf3XOMG+9ysIA/septAn1k2oFd2FjygwUz1ajJHnMdIO40cU",
-	"g/PbAaCseReEgb8EBFE2f3lZeU6Eln+dj99N8A3+69v7/wxOr/GAHB0dmVhQOCYofoJj7GO67AduIMt1",
-	"Ar/QGE4QmIS+jyasw68MiEiMtRq4opDQeThzPPZb2Zp1jMMFW2pCRih+QrHrjiC4TXuCKfJQLLCB8FHY",
-	"fiZhMMWzJGZoMeoPP/WHD7fDm4/9u/f9+9GD/OV+eLUigkRLPwzOo2hguQ9u2XfG6MHgktNRQhDvw+4b",
-	"xr8oIEkUhTHNYcLp2W+/v/rjL3/22B+F/2O///fJ6ZnxirBx3nNJjXnuyw/ExI/Y0uW6kAfYoASE0wLN",
-	"6Sv+e2cMCZ50up1ZGM58xG6B9HYpYW/pGrEte8BkD3GmhnusDknkcaZDlPAb6YddPlx+ERthw74wgIgh",
-	"sjWW5Yrai1ze9mozFbfnbUZdhUs0wu9DQi0YGBL6PpxxnjRnrfQ1zimNyOvjY0m4R/ILQ04T14ER/oCW",
-	"9fM8omVummj++JChLhxPPDR1Rt8hImEST5BZgBC3sXdu2T3FC6SJY7EcCzxDIi/ynLzQOTs5O+udnvVO",
-	"f7s7ffX65I/Xv/959Oeff/726s/eyavXJycdTVD2IEU9NoEJVNjCELAn8EZbTBfgANzfCwbBhtYXNB6f",
-	"nf7+58lfeme//4F6v/8GX/Xg2Suv9/vpX/449U4n0+l/s/kX8OsVCmaMyH/7w7CcJPJWBZMPCQWy/zZg",
-	"VaAHzCbJTlVfuoU20ou5wB6+RjhGxLTlz3MkyD+9qIFsfeR8wAtEoQcFStZcdjkMtvKVuwJfSdd2lD/f",
-	"s1ev6mCYrq2bspcUGEYgTiYookI6HaJ/J0gwkzw8hSgqILsedi5wYEfWbudrL4QR7rFn6gwFPfSVxrBH",
-	"4Yyv4gn6mJ1L53W6426SYK/zvYRIYr2m/b5J/Ech/fefUECtW0ZP6hXu9FIyDFn7ZhIzfPne7Vywe8h3",
-	"WNDAyy+p8XFkT/2EU1uT43HaEFsh31IYTJI4RsFkeYUXmI5oDCmaLcXtnSxYh4vz64v+1cPgmsll74b9",
-	"0ajT7VwOb24frvuf+6O7TrfzP/f9+372z3fDm/vbh+HN/fXlw/DmzeBaO+Nsldrco0kYIX3OzzfDD2+v",
-	"bj53up2789GH2v6IUvaricXEiBCjOoSR8yQbA2Rtu0wI9Ng7boYCJrEiANmVCaZxuAAUkkeAgyihpAsU",
-	"IXcBohPjQ8AvwrUSQW3n8Z0jwTAJiHkjC/gVL5IFCJLFmEnf02xrlD96pn74DOIkyDNQHNDfzoyaJKKO",
-	"xHG54ghZR4qiIYIek5ZMQjdbbSy/p5ctAqwbg/jzHE/m4pLTD4eIExYaGXEL1HBYCa3iAXR1nFDbNLEg",
-	"fW8U0jrcKp37I1oK2c/zMNs69G9z3fUzsGjzSmsSP3xzkcsEq1OXr51fiWtnYKEPL4kzZZ06GiRvZEwA",
-	"Z/blw3C/IsIFpgH2u2oivhnz9XsuLl+h61jr9uXjf3EAGonCgKAy1KhZ03BXXFb1MsQo9nVcxGHwWZLu",
-	"XYxnMxRbzzHDso+a2FMaeBKHQb8ab1mTa3kAZaGZsT3jyFGMwxjTZRG1OXuR3Knz+jd+eYm/T8soXxIQ",
-	"2Gxd0+a0dZZ29SWFYPVdbYZZAenSNimrTzGQ36TaMWfAMI/FCcptgEfTI47157eQpXt2TPphlMdQXxXr",
-	"Tcdpci2Uh+Wf+OL4gGCKfYrYiuopQTxHOdSywxtdjzTtgvUUaRjhyXlsI8cF/E8YACXgA4Yx4Jfz4fWv",
-	"avej6xHgY6zDxlJJd4GD/3PaXcCv/+fs1R9lkTddrJ3qhbr73Ecx7S8g9t/FYRLZ+TdrQkzM0seEsj2K",
-	"Fkq1FbMb0VHvs8L2PfyEunzG8t7lUut2XvPImcDgE0bPt3Dph9Ajxqej1C0hqZz3+Ma5wvwJo2cQyb5H",
-	"4BJNYeJTobKPE3Rk1DKJ/RjRi39SmMRnoaGcdSPopEDZ7cShXyuOCQB+REwEHLL2xiPoyMHqDsKOc8EM",
-	"B+gTitUdUr8m1ZhBM3jCcRgskLDp1Pftax2c3+bC8LOJM+BADINxCGMPB7NLydrNYp2wtVivkGwYcRHQ",
-	"EBAaxohbHM3rzs6G+MnMwnn9ZLb5jXelgZVfst8tWlG+KDMmZcILcb17q4BqlGWMTExTO5eJOZVgGs21",
-	"hi5pgeg89Oo1Exq4PoouGrJX3vAri1vdjsCWgWecQz0baz5bhUXVQBK/cRi7Xixdmmmgwuy5tUrMyPAg",
-	"PYNaPL3CJn4XwRkOUhNH1Snepi3TtwNn3c9NVFQ63TiZYky4o+lSLvtvz++v7jpcFWvWpOgD3MQeit8s",
-	"3yoXCjVMoGRtVFL2ZiNxgXuXkvaagvIadE1Tt4T6K6xIauXlDi7zDLzojiKdVawbUfg/TIJRsljAuFbV",
-	"xI/qc7lbBUkKMT3dyBd14OpOzB96k0cQ+OWvo5trMF5SRH6tfy+kLwU+/Yf1cECNsQfEn26nTPdqofuy",
-	"yoolSg5yiWPhtqBzEUgmHSEg2fmHjQM5sJ4RgvFkbryNbPhuemFMkG+0W3MpM9OwqoZGvapFpzeF2GFo",
-	"0arJuBEKPKkCrxpYNmsy8r8TlNSvWLRqMm6cBIHDimWzJiOTZDJByKtfdNrQffQUy0mVNcrwUuTfjvTX",
-	"9wo0tsaNZWfrmonrLYI0idFbH876T9BPFKNIfJO9kVi9gPRH+FSMCaY+nOkOHoozy4u0/PIuagPT6Uxy",
-	"nLbyQY5riOF7fjjrqUuyJ1RTvUxA5J5WPS4rw0j7PYxnMMD/4WDoERL2yl4gGYf5azg23IJVHrn8MtR8",
-	"cqUI8K9wfLQli3ZpTEJR5M76RxRFJqysfEdQvEBhQs3blx/rtv607hviSXs7qLcr37oJmf4ajodJUHE1",
-	"CJ8FNz+EtFPqGm5vMkSQWF61UxxgMm829b8ERladKENa0dJyemsgXZwyjrIyg8KYNtsMoZAmxGE/7HIX",
-	"bZU5UppNnVGcHX5zLJ88oriaBJpsV5Po65asSTWFnuu/ucUgCkHSU7BTzSg9JsWBb/vXl4Prd51uZ3h/",
-	"fS3+Gt1fXPT7l/3LTrfz9nxwxf8Qngbi7zfnFx9u3r41MlomA5v9D1395YtdDYctJ+GWQGI3Be5U8k59",
-	"qYzCN1tx3mhCXni9+dXUuqZoa5MTmdCMb9OHk8fPaDwPw8cX36S2lg1t8YYifxTBoMab0o2RKNP6tavb",
-	"QQRj9pSKYGDhZsr78JzSGI8TiiodHWxGpmy7MaLx8iJMAmpUZ1qskFa9I/+qmSfKDVD8hCcVA0Qw2NTe",
-	"iB2M7NMHHNTqhhU28Layn33tnP1eyHCz2mGz1mnfjzLSy7g9Jie7XCqqYQoAbdnazvNnkVt9DnHzDqca",
-	"vlRRj4Ktuofur0e3/YvB2wG/YAbXd/3h9fkVu4x4kAG7gK4G/eu7TrdzO7y5vL8Qv91cj+4/9ofGm0hN",
-	"tSWtTLrPPDdyoJDibdaIo6Xsx0n9XMCjPMD7DJo3HzrdTn84vDED0bB53WnyW0f6sT1EHC3Pup0AfVX/",
-	"+q3bCZIF/wfpvD49EbEqOsfMdTb5Vis3uUjEN6YTnzkpG7S1GAMR0NfyyL+5jZzty+gSHlLo66od1pS/",
-	"qn1MqLD5ZSGkJy66DcPp3sKEoFTAzEzCYYBupp3Xf6/D63Jv/lvne7d5z/sgEn2/WBYmhi6bHybm5/cl",
-	"Zv9aMOQLY4ADj8fNBDPlY8/HBHxOwl+nSig+4tYlgeRiSSa85l88tcSLOAyGSfA/CUrQGzSHT1g8Bl0E",
-	"e76v0WSOvMRHnnGk0nz8893dlfnZfXd3xVFFU9uB5zn2UW6fABOxfQ+M0TSM+dclmCEKvDiMIuR1ldss",
-	"8gAkAIJ3YY/Qpa+5DgqAgF/Q0ewI/KNz6v1l/tvJ4h+dX82uS7lNpHveJuQKV5fEFqfzc1+v9Xi+OBKd",
-	"Qv4NY3cS1OC3bGDAcDPcTPvhm/2IaIwnBmk9SBa3btprfnspHfaRjWn+j5PCWoyFRZgJJwPrgEM3TbUY",
-	"Ueqrjzq17o3ZUnOzdHWAmKA5hBRx7/AyKJ3ModyhnXtFmx3WIaFDNMW+xeeJhxvJeCR9MB6LFPOOiPsE",
-	"byFoi0/0CfoJcnWDl3hOAI+wltZUeerPOPAEsld7Rqxqrq0B9JN9H0okMexjAT3kugnxzTyF+Ma3wc4S",
-	"B5p/dwZmobCfhvEEea5+nJoWSTsvtd90VTlM+6Lj9R7YODMaM6pa0s9r2DqLY5TsnQKaCmoaKI2joQl7",
-	"tGvaTtMtYcNn8RWYfPl19XQTtcMq+uo1dM1bUyhLkGYa5ZJ6tRit5XJNDqRbktK8yrUURzeyfzTDhKI4",
-	"kyUMpjnLOWeCnQfidJw0sl5kRzgyRKCVgOfmhplFHlVN5hBvag3ZGCL2188TGTlEkQ+XP1QQotiSZsQg",
-	"1p3lqONl96c1f3VyUrPfwrptu7YZGbTu7ldYwSrkuj61upjxPM76KsjKHA1kDONhoxbsAYYBZ+ylE1sk",
-	"z/vhFXeZRoHHwzZkTioCaLgd7z7bdZkE+N9MNvJQQPEUo7jgzKAi9UV0iZ7gYoz8MJipFddy2S0Gt7iZ",
-	"ASsDVtRrV8O0dQPUthxg1u1QEUjnLic0iUnLBv+igcfbnFWUB1qzP0YX7/uX9+xHkzCYzrxdD/w99aUv",
-	"7z5zqN+F33xjFNucq/0wCS6aWwhLEu2u71JtAS5bHDkJ7p9LHV4yJiFDispwhDLuvkn8x0vkI4recq+1",
-	"Fb3r03jA1Ln+ES0Bf1yCCGKRtk74xYHxMp856hEtT1/zpqfCC/xM/OusSRKp1K4shArz06kh3ogRP9c9",
-	"yFbExg0M9r3hEVuvz2l69s04Xwl7uH280MogTa8vHA/EUFw2XuBA/vPUxaJYDSGbkOzx796Gd1JE4oa5",
-	"Oc1bcUvXqW2oW5W7s2oOc25Rc2z/RtC9lG+0wZLveSIphik2jca6h7mu8Jfn5E13ZqVukUFrXazSwNeU",
-	"BPVNqsU0352NMrdJM2n6sa3SvRFEq1DmHqi2DY+D76tx5VViDcuj2NTfusRUHfozQgsYzcMYjfyQblj3",
-	"ndMrm93XhTqT+KEwgcke7onqVtRDE12QMgSFUxSBOFEbq1c16C7K9RvFvq989913WnpoVGionZdeoM0M",
-	"LF1d117QqzOs0f02y46WcxgEyLctU34G2DOb/ggbHDyL0c1GFTHCtVWPrqbg+vQVJ1lLAwYXtt2zb2ts",
-	"nXW375sPvs6m90J356ZdU4BIwZ3Hi66Ghsb7haKoyiHEgHTY92KU95WvlXkxuUxingW/MtCLcxxMuOfP",
-	"2LckU9lKoIl4B5Jmu4pXS1JnviX8UJljXPyFbRUbOuwCy0z9CjE5YH9hczywAUDvH8nJyW8clWkuXFrL",
-	"FLepgCzLlu3orYE1h+sqgCR1rwrQV1qB11sIwDqn/SjMhQdrB7GhMC1OYZ9t9ppapMx1J6lPfHm59nfc",
-	"Kob3rE8FhIq6+VycmUOYkoyqS9tvng+ECbUtcUUWwR3DzqdS9+IGzI2HvYkuFSezhgTpGvHJ2trYiQOv",
-	"abLjtEvFjoXzwOrq2xQD051VhrZJ0J3Hkzl+QgfJl5qbBfaKxYTslWjuVEH1+cgiI+Fshx61p9luSKLi",
-	"FaQBQcHR/KK24fs+KC3yBGh0ypNtLDmIJnYssFujPXOHRUWIVJzSoMN+pB8P78ED056Qsk669h6pPk54",
-	"9xbHhI6QeAG4494VbNqrYRCyeELlFliYOYWsBqbsJLryfCuQeV/S5+TQtBaRM5au9GLDvvACeLi+efh8",
-	"M/zAo9TSH4fnd/2Hq8HHwV3mJTC4fvdwN/jYv3y4uee6udFo8O5a+BHcnQ/v+F/nFx+ubz5f9S/fCfeD",
-	"wfVg9D7viTDs3w3/JjwVdKcENvTN/d3DsP922Jd9hn1tEn3u0dUNa3nVPx+lYw76lw9v/vZwP+JbUbnf",
-	"H4b31w8ilfyH/t8edN8ISxO5UKOK0EQxGlAH129v2MDnQ+mKcTEc3A0uzq+qRqty6pB/PQgwfBRhhRpM",
-	"Gjh9yL9F66q4+DtIHs25yrMcPpXJymT/hPBR8jl6mnQ0qY9Vm8r3scsknarR5QoM3D/N5u6eiq+QAd7w",
-	"QAh9Txp03LiiaN//OvETDwezIaJxMR18ZX9+jptPKx9S6Dt1NoI+TYRXrByIYpmMtm/JUpxqj0LAWysV",
-	"3IL3ImYNEgygv6R4Qm4iepPQap2UHHAOCQgjijwgVRvpIJaUv2smqd167Rlbmld+rc6M8WAXYUDj0O9F",
-	"PgwQIHMYCx/wtBRnCi0RqgefyeuE9J4Rob0zc7CeqOJm9deURd7uh1elGXDASAARICqc/WpWqq2T8DZL",
-	"GtQwRXFtqR6+rmz0L1aaKCTx3m327i3lCrMn8TbueQ/kLfNZmJKdz8KeoL7OkFtFv+d3hYOZLDRDdsft",
-	"RMKx/tcIs1Pm2V/4YqrHF73ENAQ882paPJENgDECMIriEE7mOJiJslocwFXzq4zgAkl41NKKqxBbVhlE",
-	"yuvhYU6VsNCUq28h9pMYOSyF+4zrC8nVxOH5Fs1z+pCIrdptwFlAJAzkyXI7cLGqQnXoE/yqkOwtVztK",
-	"ScUY4wimqgmAVMXtSazarB3QzgmMC7bzhX7+RlUCsx9OoM+j5J6QH0b8M8/g4CXFcGJNztUKBfxQFQK+",
-	"p3XgKo3wqgqgrD28y8p4q5UhqLPJSq5gsyirz3aoiRZVNmU+Qq6AkFVwqLn9VP2E7Kz0nMhWAhDoujf3",
-	"oaSeZtegONO1SA4Ted9l1EYheeyKBKldAAMP+OEsJUEVoe9BMufFE3iLYX90B85vB0fg5vN1f8h/O7/8",
-	"OLgG0H+GS14du8ukWxh7PiIkLek59eHMlaoXMEig7y8foOdVJzlNN0XmOOLMH32NfDzB1F+CWQwDyote",
-	"szdIFMrScmQZTJAHnjDkbKE3Y2IJoHBGfj0CN4G/TMdQY3OIzeGTqqfOsBAgj/OvMAZjBGK0CJ9ygdr6",
-	"dl6K6t1zpDNA1LW+JygWPW6TsY8nVfTKx6sod6KveW8oUxLZKpQ5lOekbldOHJ1uh5NGp9v52P/4hv/w",
-	"adD/bElpJcarzthRr4ZoonWoAkluHZpaeVUlUnG8YuRiCgBFAsWqlKmKsj98GF3d3HW6nf4nod27Ox99",
-	"eBjeX3Pt4c21FqXF041d3HwcXL97+Nx/8/7m5kMF7HNitumlAeNFRQ4M/l1GdhivU5Gtg4bgGcY853BJ",
-	"/ha9zTklmqUHMWcG2UyyDzG2fYvm9a+XzzbFiXo6TjHILdVH3YE1z/CxQBTFKs+HknrEWOAXfISOwCnw",
-	"4LILTsEzQo/sv4swoPNfV/RmS8FjzPth578KULehjyeGhPziSVilJUnLYYumBhGvAf/Nk1+dK7hcnH13",
-	"0lbgylCtDElLaKn40acTxspPzaxEFoxMrOnb0VeK4gBaXVzVdz3ZjRiUIaf2fF43/q8YNZKtywhVsYYd",
-	"xBxbw9hFvEOT8pwVJam+pwOODHFZ9rKHa4ayVEexiAX9jKUI9Z3vsBShUWLfSM0/q/Cr71T238BODe+7",
-	"TJsymAVhLEs/FB5uXfA8D7XX20tDxM5UDtqs1SqTX1KZvEUl71ZKbjewOq5sa7NQ4Wfu4GlPPUN4jsya",
-	"0jPCS1TLYsrYzQQGQUgBnExQREGAsrL4hgI05dURkwKsVgEMPS9O9VBCEZx7CCnNYlkfzD68h2Ruuljn",
-	"kMz1If+LFKaTV614S9wu/TAAoySKwpiCizmk1gk/oRhPcR14+V3GeNCTbC4VU7k1mClhDsktJOQ5jF3n",
-	"gCCSHQBBdOMqLjsBeJhEPlzmCEGdX2PNcR66XywIdjGHwQwpAFmJIGBXsg2InHb57Suhph5F5rWvIGGp",
-	"kfm+o8qFpIuohN96ayhVWpBfujk42UB+Fc5wUC3bbp6+16oIvXcQV3uM6mCtMhgeFLjdbkgLY9jD05LO",
-	"LM6HpovVZI4jcqgK85IBYYe3+TZuGTGZ6dg+nZ6XNCA3EYohFXEdqZmA2wiuzZ6zn07fxDCYzGUI6B0k",
-	"j1a6HfOWNoWS+AowN3/FPP0hmMbhwrGqYxB61lhN9m3lgSkkj31XZVgWDCrDXLn5kk0stne0YW1YYXEp",
-	"GLoZsL/YTsmWj8P1mORGmRSBAhovN3hQKw69gaN6sQO66F9donEya6iurK2S3ZVvQ4IXiQ8pIlmKL+4q",
-	"MgkT3wNjxB2SxEsABrLschgDWNTlltiIrPBgrWB50b8CWRv+Vhe1Qy1xYD5FsVQlmQeUacik3qi8P6g+",
-	"sZcACAP2Q4yecJiQnoxrkmN0qrIWlifmn8rz0VJeCpkEslphrMFNzWrm0hlmVGbQsaA7+6RSoQI8ldWu",
-	"VfFWWUrY6Iyexs2Zgt9J4qdB4IUTzkbvsgl5FV1CpolvfJS5BaeWoaDiVEuRbdYoTesYlgQp7Ftui+m+",
-	"tPoXPDxjNKqsYPTp9IKHYFZejpldo1pJnpldiELFCQxAjKZSEYbF45hdO2GcR0y9s65d33ASNLdsdJ9O",
-	"GTxU3rnv5gNToausKTGl/SI2468AFweDxvKJYHrPKEZZge6tgeK72ATnOWKjFcdfzUU1+lIv9SIP018T",
-	"FexTMUfbMFo4+iSMLPIo/2Q2R4vxjsA9kap8koyJcIxmIPf4I0S2IgBSnRu5JUCtTFy/4fs7l6VTACR3",
-	"5VkYDT/y93d3t0qgth78HEGfzidzNHnsB14U4sDCc9loI4BkGxCFvLZ8hGIcengCfX/JyN/DZBI+Se2Y",
-	"SKZBxLmEciVgDgPPR+Ro9bz9aihLgAnf6Z2ISR6hSRjYqDRCcU9VGlKJRcZw8khoGHUBDgARvYvin63u",
-	"I54F3At/EiNqizlh30Aivezefzy/6LFuwEM+fuK26zS/yi9cIpIOhv/bew/pZI5ob4RngShZPkfQQ/Gv",
-	"R+BzjCnqhYG/fA0wBZiAALEziBFN4gB5bJBY3jlmuEt7ayMM+GVOaUS6gOtrf//9t18Fb4OEbYjtj/FM",
-	"bvTINmdMhW5++heXVIRv14i6tvOvohOZZatx+Thz/zeQ4Ml5Que1ReTM/c9vBx/QcsXODKFEAbrKwbmh",
-	"0F9ni6JEXuGFwDZde+vKocRKJJx417uljdvztELhFLBWKKC8UpgQ5ROSeUzLpFuacHR+O3j40P+bQ3Ew",
-	"Nb1YiQFb7CDlwDAXufiAlv3GrxN9S+IZ9IiWR+COu9STlHWIl0S+FVcr6LBQl+0avDaDqil9RRKLoOXz",
-	"tDyE9emkWShFx4LPjtgFFO8Zxa1SaUFYVHlhFrk1TLLOZsme3ZbrwZ8PUYa9fAHy6pxhHKMJzSQA7rPN",
-	"l2WOSeRmHRUK4EQpo6yLfK/gSeUDVTRJQWcmk/KuhN242FsKVsiQ087ysFRxiNmyuyZycOfIGUfdA76l",
-	"s/ctsa0356PBxXaZFr8n9gCabB3bBSbf6cZgeQlnF1qSwmJSTkP6wvon6ChZLGC8NL1kPThzLeJloCWp",
-	"bRWZRcJZP6DxcnV1a8aBuUqUe01PcUwoIAgFjhpSHBBrsOJnwwSqvbtbNiYjSDGpcw9IZ5lDAsYIBYCo",
-	"bmbj/aNDKfQSyFVN9Cqd8yIMQhoG8gmFA3axE/ZYVcpo+QIQ96MfzhxBne7HFdZpB642w9QCGse0PJA8",
-	"Xgqj0XUjq1LebsFXqDYu14pJ8eF/ZFvB2nYT9/nXVNwkBMVaafui+o3HYEVx+IQ95KXq1DAGPhwjvyuV",
-	"5+xQEaFw7GPCPcxgup1naCnzyT5cOjilfDr9rFqWLvySSUGSS54ac9TfLVsmiijzxYWpFavoiwCXz+eD",
-	"u4e33HD4sf/xxqIVLQylLMaOrNvIXQ08PG3J4HcRBsJCYn8xGN2NG3Gf3ESKBREfoehSOtV9dE3GUlPa",
-	"p1hglc1VeWzlpWlnN7rq92873c79qD98UGFLF+8HV5cPKqWS5SQtSdVWNFnln1/GBLZOlYyL3TeUTNhB",
-	"txpOswXoWvoxAjBYgr+Obq57BMUY+vg/nD2InRm3qvyjh4itf0KNtavjBCkBQd1U6QMCEhAuMKW8TPsE",
-	"MnGNv5yg7ws/7CCkRVds06lgAgjFvg9kdhbLRV2rNa4ATuGBF7LlUTQLY/wf2YFYMs2goCo1PaFwERUB",
-	"JLIwFESn6ku1YcDqJqpE25E4fX2rPMKVQTqpKYs7IWdWkEwBMF4WZnRkxJz477TFmLiwnAYHM/nyuG6i",
-	"/ZBZDdKlZuvkshKMIh9PClnFzbXBpKzvtqnP5Y4VTpyl8taGeb9k7HIPHKQU47YYrsoHayk8VY/hqcam",
-	"cIwcHQ1nWFuPwqFWRGWEWpwER1syFdlrM9vRyp4y2EIjaeX3tGHqp+4gVkirf83QolWTcbNEglXjilZN",
-	"xtUSDVYNLJs1GZl7JyCvftFpQ/fRi4FFchMpmPTZ0zPR8jVLbmEr7NfAZFxlqDU/4i/RxIdMWH2qKesg",
-	"KRsT4GVdwC80TtCv7AKP4nAWw8WCKzV/mUKf6OncNuOdb5XJNDFGiUNclCnD4zDs4JsQKCqOvYGZvW7s",
-	"TTLW6hKbNst8hhY6Ge3FrZsVW6yvq/XpVHcj2Hf/ga1nttxT14NNEKa+tfp6XHvkMGCmT6sbwfpuAzpF",
-	"7AVB50jUkay5xiswmK4hpWgRWY5UftQEE562I5wBHweWnCy54g6GFCPsM7t1+IVkGNExHwt6Qn49qOS2",
-	"r3jrfLJ8gxtfOFPK1lp2Y+stWhhVK/n6DyY3VxovAU8d7wbplbXuXNttkkoM862oYteYkMtkm7zAMwTU",
-	"U/Knh/dFp4crhUZZ0cg39+94iqQ0B3uNt60aaR84g6JyyxNbfpZFLi9xjNLqbKkTzeii0+1c9kcX9u2S",
-	"W8ZFRf6Y8pYFBI1ptwQYjZ84vI1f+BGYs3hhgfSrZBwRjdxOu8BH9e0bvISFt3rDU8uB1JGlD3lwUevm",
-	"3dTNW8BtO17esRx7y07eQ0RoGNdEVsmCYeZEPwVlgWpqRvqh0CVcIgqxn95gRYOWzVqQU2zIZlL6Y3eB",
-	"NHjLGhQqEmKcTB6RJc+YSHWE4rq5xBwyp5m/FME5Mg3DKjMXQ2rkjrUFVYIv06ykzPbqiqfsG1yIsic3",
-	"16qIipn3svO2Gb+ai3EqAM1Sz+9jRV0hrgxX3jv19NhPm69Yx8i5qlalvk4UuKjfvkGuMgpDTs464pri",
-	"JqFmBYuUortZbVesbOsDVcRITK2fWQZrM76mR7YXMk2G9BZmmMew7RcnaliNSI2Vq0JUrDxkLltUrEY0",
-	"6l/fPdzpm0n38CCEllLppIth/1wsW2ybjfJhcHsrShedD+7Ylt/eDB/enN9dvOc1fe4u3j+8vbqXq7i4",
-	"ub9iELx7GPWvL3OzX94Pz99c9R8yBqZ+GfZHdzdDBg87I7Mpms1mfPdCEwQHE9SohB5NCGqKkVnhyuL8",
-	"SUCx37w2mRTRrFJDnVxQCEzkQLCTd6UEL7HayKWzAlFbEskNFaictrERSbwIGkdJnMthSWCDZ0W1v9go",
-	"WVUv0iCNNYhs1ZHXHM1aVb6usNemsM2AZHSd0tamsfGUcWVlyi5uPt5e9e9K1ckqiq7lvW8tdcptag3x",
-	"taDUMCpJ1nVC4o8LaS4uQX+jQp7uv2x/7KhWQph2d9OocXWuURJmXjQpTJ4hkVEwDfxSvZW1Y/kj0EZU",
-	"ierMw8mvxaG4jn+BfR9riv56ubsuwL4wC/glTawMKSKU/fZrdflbZ/Cz4VW3Bu7ZHlpEIUXBZPnB5kOn",
-	"teH+dOIxzU7bh3ihPIWyZRjnqUmjUHG0krpkgh71Y4QCGOGj6zC4Tnwfjn301xHPop626uFFFMZ8UpnV",
-	"p9w4guxV35lhOk/GR5NwcTyXYZ0eelJ/H8MIHz+dHosCZcch5FLF114gx+q85mZsYScXfpU1ru5FegE8",
-	"Y10x70jZJo5J3/aIz+X7ZMOn3uLqQc+D0dLn9S/Cd49fJITNL3n3r5vPlZcsRhF8DpB3UcnQNN8K0bzM",
-	"2qqqOZcHFN8a0uABYZsoUn+3mtJfdLbmuLHlEHB0O5WpUcQJiDIteS/UKUa+R4RKbpfeqFtQRuSqgjuz",
-	"atnLnVOvIj7qhfhNcYkoMkhM62pDVzdCbWh2B7N7pWZpUBEUVSH7NI+NauYlcMT9WeAi8jlOj89Of//z",
-	"5C+9s9//QL3ff4OvevDsldf7/fQvf5x6p5Pp9L/RBsDppEFM89pLBaJ6MV+EwRTPjFn0865Fzu6dVm2f",
-	"5my5AvLV1CewziYzE9tmklmODRPVT2L3qdCtp7r43BWqRZVtynDtptdlN3tJGsN+sj9yEUKZMwcVWsqc",
-	"z5X5CL4UX3bbVVlWG4U29TwqJY9NFy9XYn+Z3+GF9FzdonnAQxGdWx5A7FNOJpJGlmdIUTyFvm8ecncv",
-	"kkOUcbcpijVk2cJO2PCY2P0lOrof1M8mSq3nzGNTV7Ti0g8kLq0WhaJLH0frSAaC7Rcu98uciLDKdf+l",
-	"cHm95A3OsImX1G50kctLd2P3+M6KSnU7UYzDGFOLak59taGSwZ7Bc/08hIG/fMCmEAkgb0Re/BTgwOOp",
-	"PYIZe9irhEGst541KAvXVwqt/PzaJVuU5Gvi/WTreqfj3LhdrfzWp1NRIqRNuLhyoInZpibAaklqmF/x",
-	"W6HtoSFIIuFVHAAYcH/0zM0d3Eg9kNQNwRgBH00pSIIJr3DBr4oVoh3OAxBGUl9kjnqgoShKsszFFGTN",
-	"MAExgpO5NaTUMdLgRi0jfEJxjL0sUc5epz5smtEQ3IrMGADylN2iViUNQRxSdviY7mXOQyuKl/MRHlxu",
-	"uTY13Aqp4fYys5sBSz9rKWMchSLWZUDRwmzlT7/uOimKCGhrlvAln8bF5G60pUwrppOQ6c5em5KwuXlj",
-	"qnScqsP37iHwl63H+bVpL9u0lzXMcTNRl+XxV4lyrEu2qWVX/KJzDi0Lb5mHRNjqonF+OxCuGRmQ84kZ",
-	"TUgg8lW7PcRE2+IhymlrYaXN1FX7+FLFQc81fplPw9lNswh3bbkktXFyqUqL+gqnEltsq2M2Cgdox5Iq",
-	"zs7E1NfagQogS0etKbaVT93pz8IY0/kil8br/flpp8v+c/bqD/HHq1P2bPh4+aoaemk2UENZW20i98yi",
-	"aS9eUXUSetIU4jxCX3WSbxyeeP392njMhgbpeEaG6fygYmSY8jL2nnKZoJj+NAWUBifzjotLq8WRvgb3",
-	"NClr/3+5Y/moz6Uh8cf98KoaPfYi+ECJXI6OwOlbzhaTNZlD30dBVVhNgzRKldHMykmwcCWmUofrK7V8",
-	"QWtH+65/3R9yvvlucPf+/g0PlBgObvs8xuH84kOn27kaXPfPefjCp8H/2s48U3ZuPrFfpU9tc09UZdFq",
-	"vVEPzRv1p/ASXeOx1Lo7lm3sa9rsfhDHxv02nR+M5bahP1qNA5jBxit9wtay8/LWmZE3e33m3cFy3lmp",
-	"55duGdMudRGsZIrRSgJ3D0CZ7ZPMYb2uS097yNq/DWPDepSLBE/26hL2zxtmMlXes2/9iFaxHLK5mgK1",
-	"zpLltIGdHEwUuNXKykebl2ryx+vVhFGvfFlVuCnoScUrFvtSfga6kNfA0cAC8U05HXw2uVcqENk3s6Pk",
-	"HwUnYD1fz/m7Trdzdz76YHxZyMdLli8if9q7UixLl0GjDJ/ElvLeqm8S+430hVKvw8Y1nXUOJKLSi9X2",
-	"uLFNEjfNBuOrsnI8u8XAYAqYHKNKIXQBBDEMvHChOj0z0WWMwAwFKFavMR27zrYG8eZg9vYTAVc7m12j",
-	"crrOWmAzrmXXwOxUgZRnP05KpFwXK2FK3cMDtJwbd4NhzwcmaUtjk3D/WElzsUB0HnqNdiuX/lH0TGX7",
-	"i9BDdt8LlYNnEnopBit9lUNGHg0q6ZpzE39xBHg1CinHleqrMdO3STcX17veiAEr487H9Ogynd1dp9u5",
-	"vRnx/9zfcSnJdkPKpKxVYfEyY6v0ip/AAEQoZniV23HtHc/kIv5oNyZ4z/sBph44WSeu1Lq/H1wCidK7",
-	"f+Xx6jwWUMksVKKCD0fznBeiYM1u6CGYHBvHBEYfEvoewZiOEaRV7/XcqbFeoqwQBHPVO/9SPjs5O+ud",
-	"nvVOf7s7ffX65I/Xv/959Oeff/726s/eyavXJyfuqTuhIDB2ZfdV/SJb5rAXXen2b2f7rRyjCQroiKLI",
-	"XnBDtBGRlrzQhv4ubYBSw/xcBqyK0YydWIw8JYiT2qTdBGS9QBjop9hgZcV5jatLAnaEg2AaulHPUOvA",
-	"fXhCmj2QM3PDbY4V1g87ysbhYVI6cNg3AJ8g9uEY+5gu+fXs4wVO9QkZkv/CVvTAiyD2/pGcnPyGwDfV",
-	"2Udd0Q18/9Vo0WB9iU2cW8BoHsYIsEaSDa2INCM11ojPZ3KNctLmSdAV8im59EkDQsW1Z80jLy9icTUU",
-	"tlqvixO97+tE2/vhlWH4ppIub2+UUjSuX7qkK+tTqVTCrOumvau4760lOpW75dZMXl3epgIOL28btsr0",
-	"6SKHea6UX6sPg1kiDWvO/Gp0+YGIG1R0lvphc8pEs9QlWWX/K42hsQHxHu3DljbHV6TLljdX5zxD0+3f",
-	"7t5zM83d3277o4vh4JbnwLt/8zeznqbIP0s4Vcs/oeBpbOiyJ3/KO+uCCdOGIAlynDk3eNlYyhdiMcTD",
-	"r3iRLLRJmgxdIBExj50yyumzzi/uBp/6PMlx+uft+f3IkidLY626l0//6u37m5HIuPXx/PpcZBf83H/z",
-	"/ubmg3UgfmGXlcI6iMxRxOkvDqE63Q4mtzAhdTG9mWhCQMTbm21d/wrHluuTfTEtyIlh/DUcmy7JnciY",
-	"VsgJOKijuojDYJgE/5OgBL1Bc/iEw9jVzsJPYDSZIy/xkWccqTRf2ny7k1I4sxwo+7LygaYqaWh8z1Zb",
-	"JaWTaPakrTwmadZrdjtpFkSFMZWmAoM0ksZoWviNtCyJx+3EkFZvhqj2/V0cJpExgkom2BOpx2eIEul3",
-	"nHYFM9Y3lbA0c4URYJxNjmgMKZrV1t/TVniV6/edqwDs768yZ09XTHO2QbfAquJ5yKmLu+kaoVp1RINL",
-	"UyRmusDBpRGGqnexcOvb++uLuwG/3GW+V/bX+bvKW4AN0qjkbm52A3mp72ZRcK1kCzuWIs2v2u8V52nN",
-	"eMqJ5AOqyptAQwp9E8amNPaIlhaPMDU8Q0u31AxKKQEBidAET/EkmwT8EkFCkAeeMJTRBr+aqcIKCAf+",
-	"r9sKhze3KhFyJbI28EI0KwtonCDDsuus4bo7X6rOOj05ObG65xmHyTvUNfSNa7Shf4VjxR1dZSBLxda1",
-	"s6OIi3bXKl8xt9ScvcwScq5Zm3Sz0j1ojL5W9hrBb5YNBr/TepWdnxpKOlb3qXWK/mUD6Y5R2rKrLt9h",
-	"EuyJukJzoXK/a4ZJsEa9oPIoPFhfH0FPOJbhco6LaZyxZpKRcg1reXfLu1ve/VK82zLHD8jaK3xLV2DN",
-	"fLQBRQu7t6rlGVTf2RCLJBI3jngS1+rqE2v672V5Yjee/nUDA9qD8HP1KYq5reSmuiVAaqPWYU9JW3vb",
-	"v74UNQ6yageGkhj5sgdphYQ35xcfbt6+rb0l+bQrPcfzDMWOjHd5dlL0XgqDW43zl9bKGqhnnT1SztJ5",
-	"7evoczHPmiODqTlscsFLrVt9unLp3bZIjpbUVnLauk1YdQ8iD2YDPFJDXYiOdVJooXlp/owgjJVWqora",
-	"KKIzfpTEZfymaLR5qZyqzd7BmQm8fhhvxlwSbDg5mtQWixVW4Y9kClxPw1DExBeMJC3o8gF7jrlSChPy",
-	"UAbjjJyPPDxaUs6sOS0x77C5ZFCAm4HzojSAZZWBU/hsVrgX4pYZfJkE9iCNG83BLPJnWfkptzXVP60T",
-	"glIuo5wtq2bVhNciheYMIS7w120n3GNoChOf3lYmZZSNrMkZHXO48UfjX4m4ZxeWmtZ/Hd1cA7HocswT",
-	"H8HogaQsqC9kFw1jT7iyuqSyk1KGzK1ndomgePK4tKWhYd8AkcYZJ/5LNfbQgEq5yPV0WrC3OcFY6zMS",
-	"uRpNIH/KMNuebd5lg8+a6d/V+tE4q7/zq09tSyFGbqAv9ZTO0WqTFqYm+LkXZ7IrgAvfE2KpMDyNERIe",
-	"QtZyegv4tabFczPZ3lYJT4TNJIz/cv4pVjhGMEaxymnDIcp1Sfzn7FDmlEb8lROGjxip5pidqvhJWeBf",
-	"d2QYe9ZXpjdivRNCw4XjZN85xxeOaIbQCzELOL8d8KKxlKvA8r+miNg5PTo5OuF4LAL5O687vx2dHp3I",
-	"mHwOCR537+MnJJ0AyvO+U0Z+1ipAhIBU/SIypEr1TudKfn/HwZCmAWUjnp2clAd+z5O2chC9Et8nYUBl",
-	"YhUYRb7MWXX8LyLoiqQXYA0d9+M4ZFz4e8m59zqk6T5yyNF5/fcv3Q5RVQfZrrOGyjPl73LNPNFs5wvr",
-	"z+EXI+gt6wHImuEqCA5Vg30HId8woCGAkwmKKKAxnE7xpBaiKQRqQfp0egx9xlKCWQ8tIPZ73BxNjr/x",
-	"n/Xfvgu4+IgaHkuX/HcCYJrtjXUHvLuwcJdO4Zy16LMG3GFDjMBpJoYLRLk88PcKV6HSDEAWZOm8Frkw",
-	"UqZR2kpHZ2rCHJCd2Fq6he9fSvj0u8F3M5lMECHTxPeXQIDUy6XKKwHve7fz+64w7xwsoM+ggDzAs6il",
-	"qYnFMn7b+DJMq3gbxmPseUgU58/wW+BJFZopjL/jTdhl9bUXS5GDfxB9O10DYnzhr1w6MZRzEa+rdVBc",
-	"jPBjoDjHhzeh4McbQQYBHXFoBcBl79ASmlRCK82AXoLGdzPb38hGjFswrT3HBsRCWzbgyAYEtmyPDegX",
-	"ZIR7NHxEAbsV1d/8NoxCUz6IIXoKHxGAAU/YyVtLn690xgKbiPAda6X0N6y7C5dIh7fwBLXWvbruYr49",
-	"ied8dT82UpMmWC1Rhx3snTw5hcbZb1WYnB55DoMnfph4x/oL3S5Bl7IFqmcPHwTggFAYTFAJiS/YZ+VN",
-	"Yhestw9bvhCQBFmIy74gWI3ULgCsm+fl0X/UDGpfe2qInqqyIW807byF9vv4G//v96rzZlyKtzoqHShX",
-	"gouDrOVEIimbTTgRaTx3yYQ2d9gyL1XN5S1Krj1JtiagwU+s5W05FNcgk6G3AHEFVxP488WO4cd1bI0f",
-	"S8rVanD+MmVgPzve8zIXLe7vGe4v0Mp3uPX23t3FLdPVNcGp9Eo8kIt8E1c4G+OY6+nFKRHriV9hwh5A",
-	"Psi1th0waz3IN9zaabO55IlrUzY8fJU+KLe7fUKE9Oj5QRQOoXz+uUMOA0xDxs2PvwmK/34cxeEY2R+X",
-	"yvYpy+6q6iNcryuql4iMUdIEZif4dOrbkNBhEtzyed11U7ZLL+VcO771KhAKfUWTROlWOHyPdnorXIeU",
-	"V6EIY/wfUalAJoQS4e4i1rOk5qQQ+8gDQm8P+PGAt5KfD7JjNV8cOTQjPpw8Hn/j/3HQ4oMRa6jVAMpj",
-	"Dv8qM2u5K+1zY1qRhy9xL7XzeZjsk2hzuptl3AcZCouJX+1mYpGwjee9hL4fPrPpTRaBItYq1st/rxKx",
-	"BNLlKSYgx99IQJyo5Xqkc/0yvQSkAZnkB7MTiry5945MCsBoCWUPCaWEsCmpXI8qCSUgBjJRgoumbTKL",
-	"Lmxe9SQukUhj29iLyR9duyJAFOdaSROgreHs1avcIk43IQNFccj+gbz2Dtsj0rQ9InkNDwCjSGF7+VoT",
-	"bQr0SOHYR8cenJHjNG++9dFI+KtR1OzldXzHyA+DmZ6bIM3RDmflJ+Wn00vIq9Pf8alc1GUqO3qW5kXk",
-	"K+ck8+8ExcuMZjw4e8Be9TW3rYAQJ75TWO9LPXycsbe6lEOD1PuXcHYhQ7zM+d4q+BCbUln/+Kw/t5aw",
-	"23m1K+bHXqF4EflogQJakg248kLhQWo6h+TRyGFUzXZyPKc0Ov72dNpjf/TU7y5yc7EivYG/vKc0UtXu",
-	"3WXojLnkxmfvbk8NYriji3s4UMX9p1O2awW1WnrUvc/yx/GzE+bv4tGzG8KchklgEdcNdKLoMz3lCqG9",
-	"hNZfvncrzcF5ohkvAaZE3HpV5OloMMsPbpPhf05SnIW0JcP9I0MTWWyCBivdTJveju7P54rbMfWV3AOS",
-	"3Lx/6adTASSdJmscS2V1wRQ0Ims7Kp3M7nxLG7IU3am0ZSt7xVbsdL4mZylL61ysP/7G/lPjDCaK+rE7",
-	"33Tfs+eA4z3Px7Gq6NizYscKOkgpWkRU5mK0POFlo46+llKigu1KDLnyhY0M5RyqLVnviKxTJH+GhOv1",
-	"JI3vzYM+o+fSg97OTqjtwa+zkGM/nNVpFv1wBnwcIJXtVK6jyFGuwtkVDkTpyUPkKjKzKw1lBZLx0sJZ",
-	"RJp642pwQP/43Zjn1RxfD2MqqwCFYIYoAzWHsmVmgoWfgGHmirxqFitHWkDNaeokoNjfwNTngPG7HkVf",
-	"KSAIxpM54DOxZYg8uVX75x1MLL16rxyD0RPyfyG/solkPWjb+bKWpGPUTVczfEUCbABXVbSnMk+yhfGY",
-	"cjvm8c8P4+VD2im3SqfFlRJeOl2yTsezB1euzoQaqK9lipnWyzWvQ045v3btXIWz9W+dGBEaxqgq7oo3",
-	"EO7dvLQ88JKY1y6xXD/sOpS99v362S4JSCBIeMiMurXSJ+/TCp/7I3wWYskkOWxHCGT/38vSbNldk7US",
-	"8bCKELkr/A8gCZJHHNnu4umUoI2IgVsVPLf/ws3OeoXoktZm3L5ycyKHicOsz+x4C82/bYL8Yw+Nk5ld",
-	"Auk/QT/hWj1w0b8C6GsUI8IzUsEZxAHJKreLZHE8GujIwA8vkH/JpzoUf/xtqOsv+lccCDVKeg5JrqSn",
-	"iAgLmhn4O1bUZ8t3FKiQxB7PsIf2maHbxcfJrERiGs1f9K/sJO9E6/Ld0BMSzziGgTDVmcn+Df8OYO65",
-	"AaZxuNB96YLQQ11Rah5zL7sAPYOx7BowEPekkyb7jCkBCx61R0wM4lLMxLibmP2n5hQCBBpMaliGhLpC",
-	"6t3yBcNiHRmEXDaPZdYfti1vSHmDJMXCu18xBpVXEfBCH5tkEd/0f353cLsVDuN+OAMoYBKuEl/0ldcQ",
-	"vhCiw5nzm2kfHedzLFPzDzavUYfyC73wwqnh7Hb87LOt4eDeghKbc5jsqIItAaB9Fb60SwN/jCmCTs9H",
-	"47/yuIEIGKh4leXo3IkdO2iiNMZLcgm5THy2mTZqHznrj6SRMpgEVezCI1oSzdJknZa1a26f42ggEwzX",
-	"WeYuwoBgD8UKxXhMTTjhicY9AKcUyfrm0u65TWtt9VrGaBrGqHYxm7LfvhVHQ8PcamCMACQknGD+5n3G",
-	"dK4/l/RywZb1ZVm0LSe75agg933lah+Ld514A05QTCEOskzFVftMaw2hlSzN3LXIUquoanPpkchdjpfs",
-	"CYJjIKKzTCuW5Yhe9FjGS5DV/8tS4kgXTnlfWIzS5fKIxo0YKmCraR7RsvcE/QSBCOKYgF88xBkfo74l",
-	"gOCfr//5a5FtVcZ8unkGkEkYISd+KFq67ou3Xm+925Uk3S0KrQm/zoSf0oZjlqoGAtoxv4Zdn8f8bneS",
-	"1D6g5aEIa1vP2qZg0ZQQOLhbYjARA5DS4xYI4tvTaa9Bns7KgKwmKTv3OI1CRVjYIadUlOfTXlAbyaRI",
-	"mmRRTDHHiTKFjONyTcmWtXeUEElbdcK+qhPucnXcPCcBuvb1WTlF6YnIH+NizqP1C2k3eyuQZEwQBRMY",
-	"eJhHJSm83ujroWrH4J4gj5ORWAs3kZbXA6myknPnZ0tJ8J0+PDTSbsDYFYtpOXte2lJwyXi7gG+VrNW1",
-	"mOUvYiR8cQL0LAe2smbR9ud2t+EgEOBwcbnhHjcpKkvHhjDYsTVdokcd6fHV5WivNRe9tLkopc+UNt1p",
-	"3l2K4w8s8bdTVpo6TtG4Ttl+iXGSWrHnkA4nhcRhvrYcWYPKgNOyhf1Ke9OcLXQ1pK3OcpMJ93Zlipjt",
-	"kLUpKa3/5BSuEuu0FB4Yg8TXJrSaVDZ1V+qB537NXak1OXR2Q3DbS56z8vPgBeowOvMHlSWn5Q/7lRpn",
-	"Xcbk8Ejww1kvCnFAewtEYzwhNflxFjhIKGJyg/orRvDRC5950Xk/nAE5TnXFx0+noiqlDD1/h+gtW8RH",
-	"uYZD5YRtcoo2OUXBk3twKZdYp05n3fqy10t5DhV09PmV209RdZHpql9m3YSiqMGaWfNdrXfr6TtIjns2",
-	"rC8VzgC/ARTnbjNS708Cq/LhOCYVcb38G+excrrPfxBDb5vTqhUb2pxWW8pp1cpOrey0D7LTKqnP+MXZ",
-	"qlHXTHzmJKPkK204uKTlsgnXe6bpScRb/7SfIgGTnkG8KeXnsavlAQUeUABPk1zejn5MTYoCtE5N0qmp",
-	"Qcr/nO0iXyHhhTycGiX81/2c2oT/e+nutE7CfyeRgWdmdDNnyNWpGnAgTgJnAwYkj4zniYjSH89yoYGh",
-	"RgvhsNC1tRL1q9mUYqLop84fRIEnIpf3/B0XwRgFoozdfxFTNpnCokX7B9b+QbV+4K23imxZCR4R78TD",
-	"xqko7K/n07U89kVDHMweePddrfzcEEz92HuS8c0OWoosqvphURlW/bJvYp6SKwlWsyYUuWhrTNgfYwI/",
-	"m7IdoTpDqPuNuzk3An2hLtfwj+I+wC88lSdFSzwrhdpOt4O+QnbEndeds5Oz094J+9/dyclr/r//a+E7",
-	"svv5VPiSbOKC5CtNs6joSw3Z+tZY7BQHmMyR94YP3ny52+eNa9haOZhaY+s+80ebtXVDXJIcT2AwQb49",
-	"meoF/56WIjbxO9Hk59ZrcBA4pDqV5d1DMFFA22kOZD6pjzyR8a1We6Gat/XB21zvJR5V4Awb50wxiny4",
-	"rKovw75XcibR5KfmTAIETThTrIC2S84klunKmGLZuuVLLV9C5UI7Ob6wSb4Uwwmqfkve3DGWyNrJl2Ih",
-	"b2KRS92MCYqf4Bj7mC7fIXrHuh7si1HfrIO+L06CgrbshTJIkwgGL5E1Op33wIzWNxT5owgGK5QMygik",
-	"Zdk7Y9mcHwUVBfW1U9HsXzpvWpN1PqPxPAwfXZI4yaa1rjKfRbvWS2afszgJdAFsWLc0qLz9NWu+iqer",
-	"xIlROoqzq6REOueFyg4VK62e5MUTJenk08DjKCXk1tco72uUAkarbyJ+WtvJSA5t54GtZ5H0LJLwaOJU",
-	"pIjyhdyJFI408SRS+NAKUPuSMimj0Aa030Bs4lmT5D/c0ibV8owDT5zEJlduG4qE61MoZVCxL3a3JjxX",
-	"+ldpkVra37e8SCvQflfHxZrUSAq5ZW4kKTtaiPqQ0yMVpOMfjYBV1qOWgC1pj2roCAW8BFUMKerx5yk7",
-	"XHn2jlRWlxep9s488MxI26Ww7WU5+nGlepXqqGUM+5jvaBM3u/l5fxsSXkMCB5NwgYNZiq8LRAicVdzw",
-	"QzRB+KnlQU14UJD4fgnzgyWI4NIPoQdwAGCwBHK33Q5FX+lx5ENcwLTilDvhIQ7liEX+drUUtS1GS2eC",
-	"lip6BaGx48/Ngc7+ezezDiUHkep49HWCkCcT79OUggV7QpMkxnTZef33LzqzEpzEwD9WZVkuWglp5u3F",
-	"SVBn0cmX2qu16WSl9Vq7zv4X+ySy/KGTZWdnpRJ5wBaMfYwIBfwqd1neFqPHfEibLGVjOW32JkKotLT3",
-	"4TN3gg0XYxwgsEh8iiMfAcOMYrlH4GYoCnUiIlgJ484QB7xuJ7/LcdwF59eX9la+r8a6RFOY+JQD4WZ4",
-	"5L79By1M1PUePy9Vr9TChx1L3xxIwB9bRJp616VgD4q3HCX3eY7oXLBGmV0JXJ6/I0zUCAN/qf+uHMaM",
-	"rDrwlw+qQa1MOg5DH8HAISxSd5JygdkLRUjqq6wLlXQoFPxiIZNg6sMZF0KeJV6EMfeL0dEgVSXAwANh",
-	"QtmfUjIm7KnAGiiROc9K/snw4Z8AT0ESEERtfEXO9KAG7TRDIVlPl0nvcjXD++vrwfU7eR2DcTJ5RPQI",
-	"nF9dgRjRJA4IGId0DsKgJymUbQ094QnXPTC07oKb64fPN8MP/WHaRxAIdwuOk4Dz0DCQLo0o7oL+p8HF",
-	"Xf8y3z43ah4851dXR3ZPQDb+Q5ph2NlvWHRMM0tbAnzRIgopCiZL8IiWrnmltG4P9nLlLx8dOxKPgaae",
-	"HK3f9B45LQsXEv2tpD/h1O9D9vuaFmX97XbsYRL5cNnjbk81LznZlg0r3aTCacXzrvp1dykG4+5TB/3S",
-	"065Gktqjc0CRKSMk+CTo7FxHuwurhY2DzHBnRoGWdbWsqynrUnTSY3RSzblyNMqlvxyB8gcjk26yaocV",
-	"nEtL7HmwjKvV4LQanDU1OAerp2ifTxXPp51d/hkXbe/+H+nuz921O5EDpDLJHid+JxooX/rqeEwNRVun",
-	"+lMJOg0oNT44OVSgofRa37XzjfbGQBRinzTzrtcxpPXSKzq7FwhoAwSep2fu6a798r0md1cO5djFjCnJ",
-	"pCUapg/vUNz2/+h4HCn+0QGRxdMmwx9Hf9rcGoQmf8Z7WtxbtO0dbLHPFaisvcX3+BYv5ktwJOhuCaFX",
-	"IPFjIXpXUjoVaWGZhB5OC3R/VEvF8u25Mi3r02uPmx+TtPXHekvSe+q9ehEmvieC+tmz2yS57FEyuxxV",
-	"EUWML8JreHZQbneu1htyhxHxfndK5aIxHEZAfT6Dq4rwh08EYGCrRnXRj8tROUK02o5WTlqXd1G8wMGs",
-	"XlqS7Rpzr3eI3skpDvbtY65phyI6FynuREoiMJlj34uRzVGJd9irYkGMkYjDaTnJwXOSKvrcNHtBkeQp",
-	"6s/vxzCezPETqpOCZCu5TF6bz8RCRhRF0m3/XA3swD7UeFbtqVpv68K/n7ng5LnLM18hI5wUxduH4w4T",
-	"eKZUV0jiWWZSOfLXiF/xJ3b8jDdVsaaUhOt5ksu7TLRpwI/EU6zlRj8PN3J/a7W86HB4kUb4G+VE0sJc",
-	"UQSBm76INCFb/FtFyfkL3eK5aYusGFxMVJfOW9ikX8YGe6fiVt2trirU9YemvBXMrSmypXmspSG1iOQm",
-	"jE59Jmp1BcImKk0rlQjeNMVQ6v8sZ7Dq+nbjJvGyGK+SALXYvttrRiCjFyJxw6CvQjQoldxxJbZcAs/q",
-	"rEKBmA0Hs2q6OpzcQltyNxIAaHK5RTEDJMXCfTlRAGzvuUO65ySdrEB6FffdMfQZYgSzHlpA7PdmcZhE",
-	"lRpzJtwpp3iJXnwMwAcAcoAi6Z6zJn3W4h1rcCgBAdu/CU2AaVicznoILe3k1cgV2NroHnN++pTnqiOM",
-	"n96XVn+5FWDjdteVQN7oaXe6XfJe4QY04FBL18a3n5HaNntLHhNEaZ1NmfDTU12A6lId9KuhCw5mI9nn",
-	"QNLU7uia1ACzxh2pn0lLSoZnnQFMG6OjCPdo+IhqsqGB89sBEO2qqeY8wnesWStPkmNuUL4dcHgQh2SI",
-	"JjpRhvG2aklReGQYKUCrEUP64zqVS4IM292QvZUROQAUrmti4TZVGMVJW/racLxURkwNCazqwnEwk4ti",
-	"ajlbuS3vZmYtbfNt7nW+zUe0dAr7t2erqsJyjgYf0NIlij5bU+q3Nrgkrmn/BK9ovEDlCze4XHGJWfDB",
-	"GhkvXFY4TAIRQCMVX+baeAjGkzngczolJBMdnBfDz3Mk+hhTI0JuHw5jrwoG/POb5VuMfK/Z1Dd6TwsM",
-	"xOQejtFEpumvWMOl1qz5OrLelciSJdpAS/AE/QSZ022gr3AR+Yix7Ee0PH3Nm552uuxfZ+JfZ4y9V6fl",
-	"+LjZrBzZNkR+xjQxRzWe88aD3STk2OZbYaUQi9bnJ7A722hCCwfu+ipkPq5FBmmfABwAHBY1amGZf/VF",
-	"3HsEJjTR+SLRo60r8KJ1BcwPFHE2Dei8/mFyPE78R7s73ZvEl5V5EMl4AqlkCqzPT8wY2PYbMgfyktyB",
-	"NGcPrdvtnvEHTqY6kyAb5hITGEyQX+F2y78LRYaWXzYn4tq4hnArESP8zAIFB4C7QCEfDDGKfLjcONvI",
-	"HLbYv56zx/JA5PDcVg5A9UM4/heaOEguHGgoC05vmdTeMqkhx9Tt8CeuRnPUsQrdnIOe9QNatma9TNm4",
-	"0mudA7t9sZte7EDqfjdJB/I2sN7TggZJs6t5qK6Yn/VqFgDYl6t5M2o1sbhWqv9JL8xv/L+9Z0znPfWJ",
-	"a7drw48gheLyDCoVhJeQwneIfsZ0fqfIvpZ/KPIxs4/Skndtu/zhb3l2aKvE4XKsaG/5vC+bBhln2u0a",
-	"kLyanqcI0iRGvakPK5xC+0/QT0SpZNkhK7BV4xH6VrR/68OZGqWBKDC43Cfng9zeRcAjyvZksrdNs90P",
-	"vMrlVuHQ29wo9npnOPA4kgYz8MxtvnMExmgOn3AYq5I1uT2QOc8tOEYAT8FtSOj7cAYwrwAEx76gjSSA",
-	"TxD77N+28lmkz0v2e4PpdchGmYezZtXztsmZygiIw2CISOLXSznqdL0y6IqVe9tEAi9by92RRSlGKrEC",
-	"vOV8b0VhCAdPmKKm0Waql5lfDvjXVnGgHOc1eKzkMq+g3TrKm2LJMlzcUgCZmKAS11tfAC1kTIDELVJM",
-	"wPZFw8PEcleJCpOI8bMnRjg725HKgF2NLk4CRbo18QXExb1eDCnq8TEZeUhaW+MeVT/0xL+/CxbjI4rK",
-	"zOaS/07Sq92F0Yg+B+v6nKf66rX1UnAc+s1fy1sEhuwzb8mRmUDCDF1tD/n8OdamH2lGCYeTguRQKGG7",
-	"WVJWkwpeLE+KI+WK9R0M5cr8JY0pt+rmW6DFmBNfoxek6mUm8Y/8a/uCVNiowWOlF6SCdvuCNL0gM1zc",
-	"TIS1HO/4m/jDQQgEUC4CTONwUaePFtjwY4iCctu2tYnPO6Xd37dCu6vIgD8H1R6AYjYl0tzBNOAXXYXI",
-	"Djn4SpPYWcCPIQPvBQvYrvArjstN+JXg2JN8gY7cyyAHy3NrmdcLMy8rX1mBeVVJPVEcLhCdo4T0FkwG",
-	"ndQX/cm6ANmlaJO0pvW9Tbt+lJP9EA8Fir7S48iHuIAVxZGavAHKUG6J8qWJklGA4Vw29QL5d4IS5EyG",
-	"vHVjCvwf1uuAiO+w00IcUqT/9vUhOdxbLf0PeEIxwWHQ8sR94onp6ZQ5oqKcVXliZuojTgqZODM3VkfK",
-	"DCFFV6zdgWtkxF4fUUWiHheXuDrViqMOJAN/61Vb0kRowMkIhNvHrwSCV/q+1ISIZYMTV8xv83Htcznj",
-	"TeRuqoXkNjM0pXi2B1maimvRMzVtU/DJ01qDIESNnFtOWjAA6bBpzEgrhQ3ZoxeFPp4s61NVqw5AdHAJ",
-	"S1AhVLe8R5um+tgEltXspYXTaO2mO8/2Tnw4eaxOUD1iTcAzGs/D8LHsScA/fxZfW08CkZtah0mTh3MB",
-	"1PtEDjsqkX0fwITOwxj/B3li4le7mfgjovPQ45XAoO+Hz+by3OKAuBwoSEC/z/jHtQjxmFAYUys5jthX",
-	"cY/dnCd0Dvg7vUiQ90RZLPmCbhhAec9DpMzfTs5qHrMcZPJayUFljqAnHab8UCBMjbKfHziaJDGmSw6f",
-	"SRg+YsQG5cUUv+j4wEGan1EhAjuB7bg/k7pqAqPrURE9C+w6IC2Xllz6ejTQQdWATxeh3HLqvePUZUJI",
-	"+fT1aI0iBoWBTQTWhilxAOTpq7J2weZwNj+pc7hR8VRbgt4jgrZSniNFV96osvp3bxe2XFmJ/tBMuttX",
-	"JpgA00yjkFaMz51Ma23cB2tjejab9r9QxEuOv6k/q8uaw2wt46UgqMLtLRDxQLR8ZjOE2qFtWQpUB8ox",
-	"5BGtyB9ajrCzAus6Lj5DUWW9jkXolzr7iR10hcdkisrN+URtpuFzStEikimzeVuNfdgYx6GlGG45SJWf",
-	"BCY8iECyEIEE/v49EF7YxFdHKLsi6BixjhUZSXnqZlca5s1bEt7HHKlxEsijqgn1wEGUcG8JYfo1bff7",
-	"XkgqbYbUCv7CD/wlGEq2p0pdgGgmXQnqmMs7REdi2Ja1vJx00Cz3v0XTIIdrHxT7/KBQp7QVrkEheewR",
-	"CmmNwhCSR15jUmoKa7SEd5A8jvigB5n9lG2WzU4AnUMKFgmhAEYRgjHAgXLC4qR7BD5iQnAw4xAiAMYI",
-	"/AfFYW+KfR95gITgQ//y/L/SwJ0ejDD46+jm+hbSOYD+M1wS7kTkPyGeWd7kgsjGvmbr2cMoi/SkG7Ag",
-	"IzK1TGgP9Jw2Ot9FYjTpNNR7DuPHqjQxmf+51aOrdebKwsgEKD5zoDKAVBVDF8EdMtRNdATqOFp74r45",
-	"CGjov3r6UjmIjYR+ekeAHP0IaFT6AZxsc2avUfJRdbQt5e6fJ4BOeCtdlhwrqi2F7IYUzLs6SCC7G9rI",
-	"rH2MzHqrorblccpi/02q+ztHnKNYVPh3iTkvrcuHY+Tb1pV+NKzKIIWw1uARLXt6CPsvHuIQRR6bFYJ/",
-	"vv7nr8W4dg19Tl+2brtGV6tFnrc6VEPQdz7/noDxqt4XCtBCb9q8IFwahM76HxkZqywF2laH06rDaXAh",
-	"NfYPHcIvWCvOtG77M8puGskhTKvy2MsacvkzKqeVqNa8NmE43/R/1rl95SihVp6TaHrIXmAF0jcvTYfg",
-	"oWposuNaNUNN6xVmzw+TN7jW54bp5nFqdXo+5rb7WtursPALgtYXfVRD1wM+ekvcL0/cWTasW60SvFjj",
-	"OmbaPIz4cbdGkh0ZST7rsA9c8lBlh9RUZNgcxyFzGKEtyREjPnbLbw5GmBAH1koUP5BEkYZ6SRe7ykBq",
-	"0UaQuO+n7iTEIGtUkT6PMxaeX31VXbvlARtf4BUkFAwuVeVaH6oTtKpTCR14VtXyb2cm1fIOXNKbVNUv",
-	"1cZuVSL754q2Ai9x91Nz44XEyc7FW7pJND+lrctDU5j4tPP6pJtjFbuweqVzv1pl8pFISzhecq88y6Ty",
-	"U5Mso5sXu1pjz+blrU2m9k3HrI2du1BhQGNIJ/OSsadKYjqc2Llt+cxodhIBDNcoFxl8VTaVbNrYE2ma",
-	"mm+p0DdMgoFHcqbptQBctqE3VAjJgL3WelSTa1CgzS4sN+R4EodBvUTCWoF/heNsUTTGs1mtM85FHAY/",
-	"tZhyMMmS04PFHpt2hmgqEh/VlIOwPdy28NZlMzdd3nWdKGWckmN8k+lYh+ZTHWali4oE1OMlmMok1xvL",
-	"g61zEeKeC3u83F46bE0o2HFC7Bww1pDQ22vXIKWX7rktievs0j3+xv7TU7+6lUstX8TOhg+GOAdeqiPd",
-	"vW1ZOYjuvnyqY40P4yG2ybaL1T7MYGpmq8gjhLUKiDAmrklch+yetMeUtaWrs702D0Gx3+iy3gh/qCtT",
-	"zGdNZ3RmDgdes3i/+MO2qhbrDOJOKDicdH0MC0QpYBfdXp2ooBcVbkWFaj4gyXJLrMCoS5eIUWIFeLFA",
-	"HoYU+Ut3tiAHa/nCXufElawgCYaIJH79i1sqR38+N6S9zAvR7bzaFcQHAUVxAH1AUPyEYoAkUHSWpfiH",
-	"+bWhcZE1+ZebKoKLs64OCbqXRL2bZavw32eFP3eCaaDt5+13qOrfRztEBGMGNIvrXWFZovFn3Ri7o/UZ",
-	"MsIZ1yad3La7rnNjfClQgd0udceNQeCufsO8r9STuyzuEQee06p4w8ZL+oADr341B28MoniBAJyyhZaC",
-	"P54hUZk99C10zk7OTnsn7H93Jyev+f/+r9XYxrufswnMyMueBT22io4j7fAVj9E0jNE2l/yGz7DJNVdA",
-	"eYoDTOarr1n13ymcN7XojUJ6e8bNsiXxpzVtFmXHVkO7lXCP7dg0eYSHS7keCOTS2EWXJ3+9fo9jINcB",
-	"le1pxfBWDN8DMbyVLVvZ8kVCOMlqlcTyyqe2kFj9/W6o67W5e54t1Ut8dj3WaA3TlqvoD0eqc6tF3Gct",
-	"4vbeRSkCHJTnZytMtcLUwQhT2TYyVr0R3axTgs6UwFMt7Y4zWpY5TKt12KxUYpEAtiuXHI8T/7GXeVKb",
-	"vTjeJP6jdMrdkKDCRjwc/+ot+VGVaSoDi2vY5Lj+aHZbNqxyT/bEmTqKxWm7lkMoDvHG6Zy3zimEu10N",
-	"pxCNwC8xUr1/3SDbOBzn0J2yDZVmuAHbkOe0v2xD7amGbch9tGzDwjZqz3mbbONb+mevlPO2NoLLvOSG",
-	"TOPA47gMMLAWLzSCem9Du8yn2zpsF2O7LHBq5vFowY2aKK+NEOAhx3odFvVt80Ju3/qHHgO2bT5SHQ2W",
-	"ew5siLMceKDY3jOXbcWOlbhLg3LoGRqVA0Ze9slSyyH1YLWfUvg5gFqo91WPpQ3yyppwOQt7bBw3l2Lp",
-	"oQfP/ayC2JrxdC2baUPrqkPrtsvp3NRFabLz71mOvarytQCCAD3bM+25J9qTUDicYrf1Od+qs5tXLm1H",
-	"QqCA9qoJBGhoifan6RW3OymwWZoUvUavff0tc34J5rxnJekko6vC8u0kOdV4cc590cyPlXwpObL7W970",
-	"BGy58C65sDqBFd7gFZLlnj/BdQ7cysYt+7WxXyUd18jEG2e5os5xbxImAa2JDONtVNUYVe4dPkHsw7GP",
-	"OPfV2I1ZPfAOUVFHmVzwGQ+e9dYV9znw4l65w1rRICNLtgsUa30lzKEhOSCtVvIrT/4JQTE5niRxjKop",
-	"m4jXgWgIWLcS9d4TFL9D9EIOtkW8YzM1xDO+4n1Cq9PdLOM+gAmdhzH+DxIX2smr3Uz8EdF56PEqTtD3",
-	"w2d1l6FJEmO65Gx8EoaPGJ0njHf9/QtjVYX0kHl0U+jOj9+AxjNM58n4eAJ9fwwnj1Z0vggXkY8oEjh9",
-	"w+YHxvuITSQ07+/40DcMlhdq+AKC/3ZyVuNlMpHzeuV55wh6/HL71vFDcRj5cyiy9e8FYOZgpzaYnyMP",
-	"PsYpUMDu5F4MKepxuYONLeRjG3AJhbGdUYzY19XAyrs2hylfz/Yhyle3UXCG4cxH28FVPvRPjasCuBvG",
-	"1QysPxmu4uAJU1Rd3ZPweFElhYsOXNh3EhvYCHe870DOtU3blTaRU7iQj4k6tvwGWznV+TrnVRsL0Mvw",
-	"8s7wMs3h3jGcTFBE7Rq/c/6dpJo9OUkJ2/TDF30629FjicHFRJoCy6J4qsA+sXMT/rU+qSl6CWiXzt4d",
-	"v2LE659Z8WvIvzfDL9FnS/glBt8Afomdt/hViV8C2ivglx/OcGBHq6twRgAOAOR341GF+HHFB9qS+xu7",
-	"gtn49Yi0u/e7H85myAM4aJ/tL/xs73Z+Pzvb1b6jOGQ4wJXF/YBiugQ98AR97PHJ2KHIJjiYCRcS0qkS",
-	"hzlim1UITQVhP5yFCa2h5jChbuTMhtoTImNLaanscJRjAns2g9QLtBijmMxx1OCFp3Vye+WJG/Jj1k0m",
-	"Bdoq+psnbf7c00HUPvlWefLpEKxX5EaQkOcwrnDwSGv5sA5Ata9iuLdqzO2JUBdzGMzSifZJlprwlXkp",
-	"oFpm34pUzUSqalIXmJ8nxrUvphjNGCeOqx7logWpFLhS/61t0b1axj5RvAJea/5siX4z7yiF5ZuROokP",
-	"J49bMX+N2Mh7bP2q4aQbNYc9oZjIBVpdttgOZTvltiXiM0owHgTT8B2in+Sga7K4KGajUyx6ayvN8uee",
-	"Hp0cnZgy9GreUn9Pu35JG4Zjrni1+IuaN1uF+p8RiBFN4iAHrMK7hzHdJAgYNaXw+9pTQ/bCSCQALB/S",
-	"MxrPw/CxJ53ljr/JHxySkbCLT7YuO9OJ393zjMiB7M5q6UQ79lVzTNyh1tdecy+vyCgmC9HR1OqhJlt8",
-	"cSKOYwlnF6WFaip9/2soRopxxDVt8d7SzWZ8PMXqhYunBA2DTFX+KwaVtCqThE56XC157hF5ch1N6Yia",
-	"0mhKm/yP7zUe4qKV0fmbO5A60ZxwhK3yqzaE3B2OV3Vj/1a541Y7WXKcLgWlKRHa7ifNtZL1dcQrEdk9",
-	"Ccxe4PK2cqrk7g3bXSEhkCiQ7S5Wy5HW9BQpLaVZKnivQ2yF26QYgOSUlrFZRf8G76K9jOJpktIwXWAb",
-	"RPjCeXwksmoYs2IMT7dOwnKnhAYi188QzLZiAFtLWy9NW3qk3DqE5SL2uVNXMzlwLwhs87JgHhiu8fwy",
-	"Q3SOynYtHDpxhKJ42PIDq4C4HnHWiIlOxUvZIeWrlKaE95RaNqw3ZYNipftAz4aCQaLczwaqua9ey928",
-	"sFkcJhGvwpQtQR2UdSm80we07NSmKtkyk1izMqIyKrXFEfdQmlipGmMjxqXSJ1ldXbIcnM0SGq2Ux2gv",
-	"OdedgVyOwGDKtdskYdiBvC6nKh9SRGhKU5iAKaKTOfJstfoyxr/ngpREgxWTI71YSiRtvY1yIbUZkNoM",
-	"SFvIgNSINUveQBysWrmb3IktS1+aA1LB/Ah8ectcTjlIrScKtvxur0TADBVXFQGLboBjBGMUp26AXaNj",
-	"IPckE/wgif3O607n+5fv/y8AAP//A+Z+D/C1AwA=",
+	"H4sIAAAAAAACA+29DXPbyLEo+ldQeq9udl+RkuVdJ5utd249WaJtxbKkiNL65CYuLUiOKMQQwAOAkpUt",
+	"//c33T0zGAAzwIBfomxUpbIyMZ893T09/fnHzji+m8URi7J059c/dtLxLbvz8c+D8+NBksQJ/D1L4hlL",
+	"soDhl3E8YfDfCUvHSTDLgjja+XXH98bzNIvvvHd+xkfJPAa9PWzc22Ff/LtZyLvt//ziRW/nJk7u/Iz3",
+	"mgdR9uefeYPscca/7vB/silLdr72isNXZ9P+7fHhvOw2SGlOfbqdg7zhPRNrumNp6k9ZPmuaJUE0xUnj",
+	"cXodBtFn05Twu5fFfCrm8YbzOw4237CAnhfceAGHwJcg5XDVlzMNstv5aJdDfe+W4NSfsHv5t2lFNwEL",
+	"J9XVwBrwE5/Xz7TJPf6Hn6bxOPAzNvEe+IS4Hn82C4OxPwoLx7ET+XcGQPB5E/Y/8yBhfOp/Fqb+pBrH",
+	"o3+zcQZrlLiSVpGFqd+DjN3hH/93wm549/9rL8e9PYF4ewrrvqpp/CTxHytLEuNaVvOBZX51LX4Yxg+H",
+	"t340ZeccRA9xYgDsAz+HW5Z4HJJRnHnzlCWpN/Yjb4wd4fCDxJvJ/hoss2TO1HJGcRwyP4L10LQJ4+dx",
+	"ySI/ytpMit28iD14GfZNnWc8ju45yNMWkwXYw4vxK/2M2M4xKojSzI/GzHn2YTCN5rMWk6e8gzef5aTU",
+	"asp5duuAWoAWB9BUdDkKUiCIZiyAxnwwTj9I7nx1E9HV+wG+qX+N5kE4+dHjbax7uPHD1LoJuaLL+DOL",
+	"zFTP7kZsMgHSjpPPfIl8X/yYePMenzZ89FLOe/n81WUVORF7/Nvt6O04OAv+9ubqP8f7p8Fxuru7a2JB",
+	"8Yif0r0/CsIgexxEbiArdPJ+yBJ/zPhtEIacSnmHHwGIjMZaDFyzOM1u46njsZ+L1tAxie9gqfN0yFfI",
+	"Etcd+d656undsAlLCBtSHAX2M46jm2A6TwAthoOL3wYX1+cXZx8Gl+8GV8Nr8cvVxcmCCDJ7DOPoYDY7",
+	"ttwH5/AdGL13fIR0xKkL+8B9A/wr89L5bBYnWQET9l/+9POrP//llz78Ufo/+P2vL/ZfGq8IG+c9ENRY",
+	"5L54ICZ+BEsX6+KAg0FTL74p0Zy+4n/ujPw0GPOfpnE85b/wW0DdLhXsrVwjtmUfg+xBZ2q4x5qQRByn",
+	"GqKC30w/7Orh4kVshA18AYDQEPkaq3JF40Uubnu5mZrb8zynrtIlOgve8W8WDORf3sVT5Em30Epf422W",
+	"zdJf9/YE4e6KL4CcJq7DJ3rPHpvn+cwb6dPMbj9f56jrj8YTzhxc0feCpfE8GTOzAEG38eTAsvssuGOa",
+	"OJaIsbwHPxUXeUFe2Hn54uVLTmX9/Z8u91/9+uLPv/78y+4vv/zy06tf+i/4v1/saILyhPfuwwQmUAUW",
+	"hhBMCG+0xXBZMPKurohBwND6gkajl/s///LiL/2XP/+Z9X/+yX/V91++mvR/3v/Ln/cn++Obm7/C/Hf+",
+	"lxMWTYHIf/qzYTnz2WRRMIV+yoUC6r8OWJXoIYBJ8lPVl26hDXUxl9jDlxkfMzVt+SPnYki76qL2ROtd",
+	"5wPmt47PG/gOl10Bg6185bLEV9Tadovn+/LVqyYYqrX1FHtRwDACcTxms4yk0ws+DiNmUoQniaIE2eWw",
+	"8y6I7Mja2/nSjzmj6cMzdcqiPvvCBZV+5k9xFfd+GMC58A5yx735nCPN1woi0XpN+309Dz+T9D+454dl",
+	"3TK7l69wp5eSYcjGNxPN8In/fAj3UOiwoONJcUmtjyN/6s+R2tocj9OGYIW4pTgaz5OERePHk+AuyIb8",
+	"JPll+Ui39/wOOhwenB4OTq6PT0Eue3sxGA75io4uzs6vTwcfB8NL/q+/Xw2uBvk/316cXZ1f8/87PeL/",
+	"//r4VDvjfJXa3MNxPGP6nB/PLt6/OTn7yAe7PBi+b+zPsgx+NbEYTlSpUR0C5DzOx/Dytj0QAifwjuPo",
+	"DRIr43IsvzK9Gy7LepmffuYXwmyepT1PEnLPY9nY+BAIy3CtRVDbeXxFJLiYR6l5I/xjcDe/8zj8RiB9",
+	"3+Rby/DRc8Pfl17C+xcYKBeNfnpp1CSl8kgcl0tHCB0zNrtgHChcWjIJ3bDaRHxXly0XZnk3gPjDbTC+",
+	"pUtOP5yUTpg0MnQLNHBYAa3yAfR0nJDbNLEgfW+ZnzXhVuXcOb6Q7DeZBLB1PzwvdNfPwKLNq6yJfvjD",
+	"RS4jVicvXzu/omvn2EIfk3mSK+vk0TBxI/MjQmZfPQz3KyLmZxMFYU9OhJsxX78HdPmSrmOp2xfH/+QA",
+	"tJQjfMqqUMvMmobL8rLql0Gj2NdxmMTRR0G6l0kw5VhhPcccyz5oYk9l4DEfclCPt9DkVBxAVWgGtmcc",
+	"eZYEcRJkj2XURvYiuBO/rvDyor/3qyhfERBgtp5pc9o6K7v6pCBYf1ebYVZCOtVGsXqFgXiTasecA8M8",
+	"FhKU2wCfTY846I+3kKV7fkz6YVTHkF8l61XjtLkWqsPiJ1wcDujdBGHGYEXNlEDPUYRafnjD06GmXbCe",
+	"YhbPgvFBYiPHO/8/nH1JAd8DjPF+OLg4/VHunk/j4RjLsDEl6XLs/q/9Hsf3/3r56s9VkVct1k71pO4+",
+	"CPkOB3d+EL5N4vnMzr+hSWpilmHAX4V8j9RCqrYSuBEd9T4LbH8S3LMezljdu1hq084bHjljP/otYA/n",
+	"/mMY+5PU+HQUuiUmlPMT3DgqzO95V28m+u56R+zGn4cZqeyTOds1aploP0b0wk8Sk3AWPhLNuhJ0kqDk",
+	"kIzDRnGMAPgBlN3JBbQ3HsGOGKzpIOw4F02DiP3GcUncIc1rko0Bmhw6nF+DHdCt70Dr4Pw2J8PPKs4A",
+	"gRhHo9hPJnyEI8HazWId2VqsV0g+DF0EHFnSLE4YWhzN687PJg3nUwvn5V9Wv/GeMLDiJfvVohXFRZkx",
+	"KRdeUte7tw6oRlnGyMQ0tXOVmJUE02quJXRJYPyIJ82aCQ1cH6iLhuy1N/zC4hb/hVjuxDiHfDY2fLYK",
+	"i7KBIH7jMHa9mFqaaaDS7IW1CszI8UCdQSOengQmfjfzOb9TJo66UzxXLdXbAVn3QxsVlU43TqYYE+5o",
+	"upSjwZuDqxPQy3DsNGtS9AHOkglLXj++kS4UcphIytqsouzNR0KBe5OS9pKC8hJ0nSm3hOYrrExq1eUe",
+	"HxUZeNkdRTirWDci8f9iHg3nd3d+0qhqwqP6WO1WQ5IkpquNfJIHLu/E4qG3eQR5P/xteHbqjR4zlv7Y",
+	"/F5QLwWc/v1yOCDH2ALiV9up0r1c6LassmaJgoMc8dMayyVJLuKnYIKGo7LzDxsHcmA9Q+Yn41vjbWTD",
+	"d9MLY8xCo90apcxcwyobGvWqFp3eDZfAm4emVm3GnbFoIlTgdQOLZm1G5q+AefOKqVWbcXnTyGHFolmb",
+	"kdP5eMzYpHnRqqH76ArL0zprlOGliN929df3AjS2xI1lZ+uaiesNp7B5wt6E/nTAnwRzySj4I9lgb0yt",
+	"XkD6I/yGxvRu+KC6g4fkzOIirb68y9pANZ1JjtNWflzgGjR8P4ynfXlJ9kk11c8FRPS06qOs7M+03+Nk",
+	"6kfBfxAMfX4j96teIDmH+Vs8MtyCdR65eBlqPrlCBPh3PNpdk0W7MibYXdxZ/5C3NmFl7TsCDPTxPDNv",
+	"X3xs2vr9sm+Ie+3tIN+uuHUTMvGT5DdEzdVAPgtufgiqk3INtze5YH5qedXecORMb9tN/W/CyLoTBaSl",
+	"lpbTWwLpEsU4qsqMzE+ydpvhXbJ56rAfuNyprTRHCrOpM4rD4bfH8vFnltSTQJvtahJ905I1qabUc/k3",
+	"Nw0iEUSdgp1qhuqYJAc+H5weHZ++5Z0vrk5P6a/h1eHhYHA0OOJ/vzk4PsE/yNOA/n59cPj+7M0bI6MF",
+	"Gdjsf+jqL1/uajhsMQlaAlO7KXCjkrfypTIK37DiotEkfeL1FlfT6JqirU1MZEIz3Gbojz9/ZKPbOP78",
+	"5JvU1rKiLZ5lLBzO/KjBm9KNkUjT+qmr28HMB68RmN/CzaT34UHGfxrNM1br6GAzMuXbTViWPB7G8ygz",
+	"qjMtVkir3hG/auaJagOW3AfjmgH41le1t9QORvj0PogadcMSG7Ct6GdfO7LfQxFu1jhs3lr1/SAivYzb",
+	"AznZ5VKRDRUAtGVrOy+eRWH1BcQtOpxq+FJHPRK28h66Oh2eDw6P3xzjBXN8ejm4OD04gcsIgwzgAjo5",
+	"HpyCpvT84uzo6pB+OzsdXn3gf5puIjnVmrQyap9FbuRAIeXbrBVHU+zHSf1cwqMiwAcAzbP3/P8GFxdn",
+	"ZiAaNq87TXKmR35s1zNEy5dcgGdf5L9+4v+a3+E/+L72X1Csis4xC51NvtXSTW5G8Y1q4pdOygZtLcZA",
+	"BP65MvJPbiPn+zK6hMeZH+qqHWiKr2ow/ZPNLw8hfeGi2zCc7rk/T5kSMHOTMEeZM45A/2zC62pv/I0P",
+	"3b7nVTSjvp8sC6Ohq+aHsfn5fRTAv+4A+SAGNJpg3Ew0lT72OKaHc6b4OpVC8S5alwjJaUkmvMYvE7lE",
+	"MLJw8fjvoCd7zW79+4Aegy6CPe5ryH+czPkj0ThSZT78fHl5Yn528w+IKpraDvz7QlbYJ6hraFRvxG7A",
+	"UM2/PnpTlnkTDuEZm/Sk2yxv4qee772N+2n2GGqugwQQ7we2O931/rWzP/nL7U8v7v6186PZdamwCbXn",
+	"dUKudHUJbHE6P/f1Wo/nkyPRSeRfMXbPowb8Fg0MGG6Gm2k/uFn+5kqCsUFa5xOdu2mv8faSOuxdG9P8",
+	"u5PCmsYKKMwEycA64IWbpppGFPrq3Z1G98Z8qYVZejpATNC84IIQeodXQelkDkWHdvSKNjus+2l2wW6C",
+	"0OLzhOFGIh5JHwxjkRLsyNAneA1BWzjRb344Z65u8ALPOWuCCGthTRWn/sCJgpC93jNiUXNtA6Dv7fuQ",
+	"IolhH3f+hLlugr6Zp6BvuA04Sz5a7t+dg5kU9vxwxmzi6sepaZG085L7VasqYNonHa+3wMaZ05hR1aI+",
+	"L2HrLI9RsXcSNCXUNFAaR2NjeLRr2k7TLWHDZ/rqmXz5dfV0G7XDIvrqJXTNa1MoC5DmGuWKerUcreVy",
+	"TR4LtySpeRVrKY9uZP9syumDJbksYTDNWc45F+y4zKfGUZH1lB1h1xCBVgGemxtmHnlUN5lDvKk1ZOOC",
+	"wV/fT2TkBZuF/uM3FYRIW9KMGKl1ZwXqeNr9ac1fQXqm2v2W1m3btc3IoHV3v8JKViHX9cnVJcDzkPXV",
+	"kJU5GsgYxgOjluwBhgGn8NJJLJLn1cUJukxz2RjDNkROKnDfX493n+26nEfB/4BsNIFcGzcBl9CKzgwy",
+	"Up+iS/QEFyMWxtFUrriRy64xuMXNDFgbsCJfuxqmLRugtuYAM9BgYyCdu5zQJiYtH/yTBp7J6qyiGGgN",
+	"fwwP3w2OruBHkzCoZl6vB/6W+tJXd5871G/Cb741iq3O1Z5j2mF7C2FFot30XaotwGWLQyfB/WOlw1PG",
+	"JORIURuOUMVdSIVxxEKWsTfotbagd72KB1TO9aASwselN/MDSltHfnHe6LGYOYq33P8Vm+6TF/hL+tfL",
+	"NkmklF2ZhArz06kl3tCIH5seZAti4woG+9ryiK3X5406+3acr4I9aB8vtTJI08sLx8c0FMrGvLn4576L",
+	"RbEeQjYheYLfJyveSRmJW+bmNG/FLV2ntqFeXe7OujnMuUXNsf0rQfdKvtEWS77CRFKAKTaNxrKHuazw",
+	"V+TkbXdmpW7KoLUsVmnga0uC+iblYtrvzkaZ66QZlX5srXRvBNEilLkFqm3D4+DrYlx5kVjD6ig29bcu",
+	"MdWH/gz5vma3ccKGYZytWPdd0Cub3ddJnZnyudEEJnq4J6pbUA+d6oKUISgcEiolc7mxZlWD7qLcvNEg",
+	"DKXvvvtOKw+NGg2189JLtJmDpafr2kt6dcAa3W+z6mh560cRC23LFJ9Bj240/aUwuPdAo5uNKjTCqVWP",
+	"LqdAffqCkyylAfPvbLuHb0tsHbrb942DL7PprdDduWnXJCAUuIt40dPQ0Hi/QDhOjUOIAemCcJKwoq98",
+	"o8wbpEfzBLPg1wZ6IceBNN/U2JxMZS2BJvQOTNvtKlksSZ35luA8UUh3Lv7CtooNO3CB5aZ+iZgI2B9g",
+	"jmsYwOv/b8DirBAprSWJW1UslmW3dszWIFpAcxk7ojyrwCmyBqXXEHt1kA1mcSEyWDuDFUVoIXF9tJlq",
+	"GvGx0D1V7vDV5dqfcIvY3PM+NRAqq+ULIWYOEUoioE61Xz0L4HhrW+KC3AF9wg5uhNrFDZgrj3ijLjUn",
+	"s4Tw6BrsCW1t7MSB17TZsepSs2PyG1hcc6swUO2sNqpNgO4g4fR5z54lX2pvEdgqFhPDA9HcqYbqi0FF",
+	"RsJZDz1qr7LNkETNA0gDgoSj+TFtw/dt0FcUCdDojyfaWNIPje1YYDdET8wd7mqioxJFgw77ES482ANj",
+	"0u6ZNEy69h7KPk549yZIUt6FhH933Dvx2/ZqGX9Mr6fCAkszK8hqYMpPoifOtwaZtyVzTgFNGxE5Z+lS",
+	"JXYxIAeA69Oza0iRjgFq6seLg8vB9cnxh+PL3EHg+PTt9eXxB/717ArVcsPh8dtTciG4PLi4xL8ODt+f",
+	"nn08GRy9Jc+D49Pj4buiE8LF4PLiH+SkoPsjwNB84OuLwZuLgehzMdAm0ecenpxByxP+XY15zL++/sf1",
+	"1RC3ItO+X19cnV5TFvn3g39c624RliZioUbtoIliNKAen745g4EPLoQXxuHF8eXx4cFJ3Wh1/hzir2sC",
+	"wweKKNRg0sLfQ/xNretC4i/99LM5TXmevqc2T5noP09xlGJ6njYdTZpj2ab2aewyyU7d6GIFBu6vErm7",
+	"Z+ErJX83PBDicCJsOW5ckdoPvozDOUR2XEA0TCkTfG1/PMfVZ5SHIEKnzkbQqxx45aKB/G/KQzuwJChW",
+	"iqPYw9ZS+3aHvVKz8sjne37MgnF6NsvO5lm9OkoMeOunXjwDHaJQbahBLNl+l8xPu/ayM7YMr3itTo2h",
+	"YBydsyQO+7PQj5iX3voJuX+rKpwKWhSl5z+kv87T/gNH2P5Lc5weFXCzumqK+m7gsVmeIYiABFjqUXGz",
+	"H836tGVy3eb5glpmJ26s0oPrykf/ZKWJUv7uzSbuXlOaMHv+buOet0DeMp+FKc/5NO4T9e1coEH0a3FX",
+	"HMqixky6OW5HucYGUKGDT4yJX3Ax9eNTL5oGYnqhkBbmsPH8hEH9lCT2+UsqmlJFLQRw3fwyGTghCQYs",
+	"LbgK2rJMHlJdD0Y41cJCU66+4YCeJ8xhKeguri+kUA4HUy2a54TwNBzfbv7NYyH9SJwsmoDLBRXqo578",
+	"LxLJ3qDaUUgqxvBG70Y28fxMhuwJrFqtCdDOCYwLtvOFQfFGlQJzGI/9EAPk7lkYz/AzJm+YzMuRxJqc",
+	"q9UI+KaKA3xVJeBq7e+yAKAoO7zJoniLVSBoMscKrmAzJsvPdqhRizpzMo5QqB1kFRwabj9ZOiE/Kz0d",
+	"spUACF235j4U1NPuGqQzXYrk+HHSfZdTG9RS61Fu1B5noRMvjKeKBGVw/sRPb7FuAra4GAwvocrSrnf2",
+	"8XRwgb8dHH04PuU88MF/xMLYPZBueYeQpamq5gn5R12p+s6P5n4YPl77k0l9flO1qfQ2mCHz56gRBuMg",
+	"Cx+9acIhh/Wu4Q0yi0VVufQxGvO/7gMf2UJ/CmKJB2GCP/JdQT1qOYYcGyF269/LUuqAhR6bIP/iOD0C",
+	"a/VdfF+I0da381RU754eHQDR1PqKt6Ee5/MRh08dveJ4NZVO9DVvDWUKIluEMi/EOcnbFYkD9E5AGvy/",
+	"HwYfXuMPvx0PPlqyWdF49ck6mtUQbbQOdSAprENTKy+qRCqPVw5aVACQJFAuSKlUlIOLa9BlQlKr30i7",
+	"B0UqQSOJ2sOzUy1ACzONHZ59AIXgx8Hrd2dn72tgXxCzTS8NP7mrSX+B30VQh/E6pUQdUGbRTzDdcEX+",
+	"pt7mdBLtMoOYk4KsJs8HjW3fonn9y6WyVTjRTMcKg9yyfDQdWPvkHnyn/HYSKT6k1ENjeT8Eu2zX2+fX",
+	"6mOP/+eBsc/w37s4ym5/XNCRTYHHmPLDzn8loM5jzs4NufjpSVinJVGVsKmpQcRrwX+L5NfkBS4WZ9+d",
+	"sBW4MlQrQ9JyWUp+9BvkzPlt38xKRK3IuTVzO/vC0YMzT5tQLr/reW5oUEBO7fm8bOhfOWAkX5cRqrSG",
+	"DYQbWyPYKdShTWXOmmpUX9WAQ0NIlr3i4ZJRLPUBLLSg77EKob7zDVYhNErsKyn3ZxV+9Z2K/ivYqeF9",
+	"l2tTjqdRnIiqD6WHW897uI2119tTQ8TOVJ61WatTJj+lMnmNSt61VNtuYXWUORUO+Ct4Cvldkns/rNFi",
+	"BhHlW/T8G5RScXs+6oL+lObViTGBXoC5Rx89TuheCIriHpUil27SKCoDBZBeJH6I8gHgVT71ZihaQubR",
+	"F+m/drxJkMLhp+jd/sCHp2Yrh2oBKB/8Lx/9IFsMJgAIdN2DFKu3zMdoBD8MvZiQkjdJVwaVYPX4tbAp",
+	"1sKkP6L/rz0pUYrZUxuKEpETsZbfFm4jfvdEMX9WjsdslnkRe1BloQyliaqrS0360Ub7AJdUE6WmJDtB",
+	"4Z0sFc9VcwF8eOentya5i18Pt/qQnLCK0wlJjJ6a5/yWjrzhfDaL+ZV1eOtn1gn5AUHUVAN4UdSBK+pe",
+	"NBeYWFiDmVHyXuf8KcwPyHUOn58hdeDsOVu5BtTOHznVQA6vAp+U59fasFCE7icLgvGziaZMAshKBBFI",
+	"bDYgImtH4UxATb6ZzWtfQACXIxMnrF2IWkQt/JZbQ6UGh/jSK8DJBvKTmHPM+qfP6ul7qVrhWwdxucdZ",
+	"E6xlbstnBW43AcrCGLbwtISvk/Oh6a8usIKlz9WeUrEvbfA2X8ctQ5OZju23/YOKguyM7xNSxResSGhC",
+	"OjU7VvNB4MElhP3SUxm/kEBU3RIFX3NhlG+Hv/3BKdsTPdCemWBSV5IaxAc/7AmpOKBc9cq7goyoPej3",
+	"O5AAPlB//X+Dyf/+HW82PwJzMb1bjQQo15rWHKw6UdV41zuIHovPe9R7wJM0JEOtWjo9FdndLHvcbeXG",
+	"xzFbus0YzAf0kb+LbjJ4I+nLM6cciPnj7ItltBvIv6G2iQ8Pob3x0lt4QcADVG3eFHhciDOryG1RCSK+",
+	"qkHQxjAk/C7c5mjtHRNHqCBpDMLJMZ/aH4xlfTNx+q2AQD1WnS8kX2Me9QmnOnDV/edh72q90H93BamB",
+	"+TCnTpdmZea6YKMFdqZrb3ZXbMwoAdu2VA0cWhrwnCflZKvhp04ITQF9FmQ1oKcPasXZDNR74B0j2cBD",
+	"HWHJmwLCVzCm52+DQ8r/c35x9tug4eLYAklBu8WaDH7Wen0VWjPGBQmQHCkw4Z+D/+ZPzlLgjxlorxM/",
+	"Gt+KVBwQ9WKVkkfY0kYH9BWogJ9ygmmovZskvnOsrh1xEdM2NHxbeOAFuZNIN0K4yiem7a2dmgUYejmw",
+	"P9lOyZYXzfWYxEbhzc5RN3lc4UEtOPQKjurpDmgefjaW6lZB72W++ChLU6N+H4peBVDtR/zLeC3RJxV+",
+	"3lDgPtVqr8QohmNoDo4BMqzjqdyQGcNoiE0xm6s8CJc5yRMxZRldAFOKf+Didsr/E6pS3Y5MVkAdchAK",
+	"a4tJ3nVLc/rbPtBVntC0mJPAInTB8cFYmFMTHgv56bmJXVB/fn4HgGrSfpagCzPzqzMMMFM9uXGOGIty",
+	"0c+74s+hEBpHParrh5gDTx1QSPP9+Raz0meH+qEK+LKA6DKZlpJ4jNXe2qJ2vms1hCNWu4q7YpNajpP5",
+	"eMzYZFkyVMO0oMT8DFtOW8QVp9nsquXPosSqFCoLGFxYZOVgK9ArsjSzEGSg8bZM1sSJJE1ZgiZa30KQ",
+	"WbAFv12tX1OvJitukVA1GZIkQ5Qbz08O/sH/OBqcDC5t0rUYxSxctxSO5W25hGxcJEw9nYEKhD8/uKKc",
+	"AIdnH85hZ1pEvHmPHB5HbDSftvQAK12Lqo1Kiy61TGlwNw8hD26eMB2jb8bxPIRqmBjjRdYzP6IIBrhU",
+	"/LJ7XAUeol6m8QkGCMs35uVt0P0BvChBe2tMrQOXoPDOsSl2MKm7UOZU9+fneh5+1/Mp+Q8Juw/iedoX",
+	"L0sxxk5dDQiDlg8+VefLKlk+RUmNeh88DW5yVjO65ZhRm4/Ywi7gkywsA2IeqhrpAHIeYdQdqVREplSC",
+	"4Icp+U/phPPRUa5EvpumN/PQeOG73oZlKMhrsZIsyJr4yjqGJd0sfCtsUe1LUxNgxovhsLYeNJ8Ys1rV",
+	"PnFzllrvd5h7sqYSFUlLeyN8iwLlReFpLhSImHpnXdJdcUr5RYTer+YDk9nAoGlqSqKe2vzpCVwIBu3K",
+	"TInpPbCEeSrV2NpA8ZU2ESTjeZC95qznszHijBPDJH6IhmwcR6YNvePHB5laERNHNAzg5yN4zLFIljIG",
+	"NhePmPKx8Tk5TiN83XGhjwYvC2CWauvi4eUk8MlHmodZizhvFVFuMhggr+fpPvHlLR/wNg4nzpNXCogK",
+	"2ohJ+5cDznEhtYVnBVMY08HqI1e5KJ+/4SEnTxSjTai9+yMOj3xoz+mtZlFF4XMcAW29hiQ3hdVwBLr1",
+	"w5s+LKidKp85MPMCSQyxU5753glasHiEmOjUImk34oeV2tBIzZIgnuQ1ZBWagVemwPMFyErM3OK0xMQS",
+	"p1umYjdWs6UjKtG4gfLKgOpVGFV1Q/oRWq7gwsmvRKIv8dclBHsTWuqvlpMzEurPzgcQEvfu4OTNNf5t",
+	"ufXjZEJxFSyxl4DMk+wZUzancaQ5+EkMhIEJD7QL6uWrV8bENiHfYOxwH9NKh7J9RbSSHyzAQ9FavI2W",
+	"FXOsYouw2oa5CjOLd73BF38M8cngVasJScdg/03kg+FuzsfGh04xjGoLpJ+F9F5l6oYf646GEsbhWPWV",
+	"Vt/b7j56FcINiOm0cShdu+N98B89sO75nDX+/v/8DlUVJmM/mShRlQMa/IAj0MOiQzie1/jWT/gBCjt8",
+	"GaO1A9g3ZYCIJw43jrb5D9BBlI268807/dvw7NSj5mLXKogfCFD6H008f8r3mma7zc89CVk1cd1ZEWLU",
+	"UFH9m1t7jUl9U/nFq/tr1Ty25VPaNoyWD3oczywWcPxkjgel8Xa9q1ToxtL5KBWaeS6gTxDMolUKwQPa",
+	"29Wt+GBt0egV68UKFfIIIAUFSd2Rv7u8PJcuS9aDv2V+mN1y/Bl/HkSTWRzY5HMYbegx0Qbc40EVSHJN",
+	"MIZUEMBJJwFf5L3Q8lM2+5TOJRYr4UJgBGkndhevmS2HsmR4w51eUlLgJqGsL5qrzP4jf/w5zeLZAsIY",
+	"yL2YBmucsMyW9A2+eXOR5uLdh4PDPnTzJiwM7jGAQdU2+AH1Z+IJ8t/9d8DoWNYf8uZ+BiIcRDqw5Mdd",
+	"72PCr55+HIWPv4LRDWwi6DDGp5onET2gEqGhMMNdBDy2woAfbrNsxvkwesT//PNPP9JLWMr/FHaBzC3f",
+	"nLEMsdm5srykMnx7RtS1nX8dnYgKNxqJcGZ/xln/PxsFRUP/134ajA/mnLy/9hbpf3B+DEx9sc6AUDtf",
+	"P1k3JwbHSL1wmS0yXGBJnwybbpYJaShaiYATdr18tHF7LOkBfoxzsP9lnNtkQvEL8RnyIhEFbzRVGp8D",
+	"Ut8ahOkSyqnpaSUGbLGDFIFhFXsGrXXZ+paUeLTrXWJOq1SxDtI7F1uhK4kOC3nZLsFrc6ia8sdz9oJy",
+	"0IEqzW5VtGshgtSxFDRPu/BJtSK5lZIWhIcVB4DcGji5ys5mPTDclsvBH4eowl6I//isjvmzepzlEgAm",
+	"TcJlmZOCoq1YuvY5Ucow7yL0IcG41pxBTRTozGRS3RUFbpZ7C8GKGepJWeRSmQg0X3bPRA7uHDnnqFvA",
+	"t3T2via29fpgeHy4XqaF98QWQBPWsV5g4k5XBssjf3qoFQgrF8QzlA5rfrIP53d3fvJoevlP/Km5xLJD",
+	"EWW+2IS/JJuURXF0xMXIMIiYqzoHh9X9uVtrmyYwwtqVTTWlvJT9Q6Rw12TVKZdUI2Ly4DIlTSF8C4/4",
+	"/Y4iMG5u9F31PAyyfBt7Mq4XjJS38Ovv+y/ufi8m1dt/4fE34DxjKWX0u/MfQYvEZee7mL9EXv7s3XLe",
+	"mTZLyg36M+FhSaUd4ukAvBcXd7HMb2B0g0R1+Q3Uo+AXR0mhb/f6Cfid3qCqLkwg27fxQRvyA0ibAnDV",
+	"LBAei05Xqey2jBtZCeTSnazOz/QujuIsjsQTOohAsAP/QeWAKl6AJB+F8dTVKUzuxxXWqoN03jSDxrEu",
+	"CieoIwrLOm0Vt1X0VcYVyo2LtZIhUlf87NpWsLSvtPv8S+p8IUDrQ144p6zFxiSY4D8fgJeddL6IwcQ3",
+	"gowP5GqDIVRcfhuFnHORJ6rczoMfmFNTwIcjB09Hzl9ly4rAV3EjVo51OjUWqL9X9UYuo4wTUyv7gVGG",
+	"wY8Hx5fXbzA078Pgw5nFmlIaSpqOHK9uI3c13OGqJcDvMI7In6peUV5ZbCvuU5hIsqA0ZGx2JG6nD67V",
+	"MOSzRtJwgznQbjCwLk07u+HJYHDOlwGlbq5l3sjDd8cnR9eypo3lJC1VrRZ0cCs+v42BfLXGfFv3FRVy",
+	"ddCtxzf5AnSfHpAyoke0RfQ5KQZ+GPwH2QPtzLhVaabgC4H8fEbX7stkzvIgqIJ5A68WyHoCzHLExpBL",
+	"hF7O/L6jgFBw4C7lwjKdClQ2zqCotiiPYbmoG60GNcApPfBjWB6nhTgJ/iM6pJZSHyyqKwvOefLdrAwg",
+	"SoPfxheibcbg+rziWuZCCtE1mlPsSKy0L7KQa22WxLQQWJH7TOUKIIzP1Wd0ZMRI/JfaYkxcWEzDly5e",
+	"nqdttF8irbxaar5OlJXQYDkuVXQ2BmDKt57bpj5WO9b5sqMpUEs5apj3U84utyKwsKbOW8HCanhs20Op",
+	"tWIAzBO5lUQgMeXOaBVOvSb7tfle2FLLcyFFVXUGCWNZFRvnAgUjJDzOwLomW4jMBGAYvGfgXOFkWrMb",
+	"vuWyFAQEDJsjfjUgrsRnSEfWJRyGymdrfcLSbaR5k8mDBDDDucoMEZj2AWyD/Bdw8yHkz+slimhkKO5X",
+	"J10VGGwFWHRhNt80SnNeYqd4LRh4aYPey1BJs2Wq3jVEtReXVHPMH433gqV2cpPfqmqoMrI5hkMyJ5fY",
+	"duPmFRXrxqVWbcbVKi42RIyhVq/FyCqWq2nsPNbNefQy9YtNKDDps6sz0QpXi1v7jXIDW9h1p85hxqxM",
+	"O2Lj0IdH4z2rj+gUlM3Hm+RdvB+ggMiPwIH4mqeJz7EcjEs/3Phhqte1W00eOuvbSHtOyGcJPimq8Hge",
+	"/kirEOxrjr2Fu1PT2KtkrObE+k0eUjla6GS0FdJv7qxZERcMd4buzrXtflxrL/G5pS5gqyBMfWuN8s82",
+	"OW6Z6dPqzrW8+5ZOEVtB0AUSdSRr1DxHBhciP8sgH5qljg191AQTrF8SQ1rvyFKcpuHJjJ/h1sELyTCi",
+	"Y2EayEHdDCqx7RNs/TWvVW8OvuOrEA0a2Y2tN7UwPm4TliWPNaFp+J2idNwgvbD1C61OJqnEMN+Cpi6N",
+	"CblMtqRdiwzkzQxRZJum5+xDEgvXtpWtpMSqclLIMU9Do086ZZ5IhJZv5qPB66u3WLXqzZl8OddH68qR",
+	"toFHSX5jUbqJz2eQTv3141EA3m2lyjoHw0PMrzA8tG83PQd+TiV9qlsmCBoroREYjZ8Q3sYveATmwmoB",
+	"kd8iRWCokdtplzi6vv3UFKEF0e4tT60AUsfL5QJTjHVh4m3DxAlu64kST8TYaw4Sv6DUSTLtnj0LiMgq",
+	"W1+phRrlmWfNtjdrMtYDlYpVZaiV2kgvLqVOBJcoPXJBXVWUP6LkNfbzi782cnu1xU+Ww+bSftKQiQ7l",
+	"0rmlSlVJwSObWqYj/c8Rv2yCPANS2RnAZmktKKNEMyGxaxHrIm+MzDkxmo8/M0uRPBlP2jQXzSHCiDEy",
+	"UqRywTJ67WeuKPVpx9qCasGXa8PUtXRygvUmj0XayLPTa1GT0nxLYSBNWFdGzO0pmuI4PYpEzM0oJdf8",
+	"3Pr5pxS9cVD/I10h0t2F4+gKqzCLt0snfgW6l8kj84mWZFo0kGlFB948CviZwGqUKz5N671lEaXnAmMB",
+	"eA6I4NtVSodJKf0s4sGnMtLYOIWQLdXVUQvtNCctIftiIhGRVEikrM5Bbr0wGu9GgZ3K+SZtovcqjkIi",
+	"8lTXJ6cK57McIR01JSAC3bWCku6ZV4CWGGqV0LLjJiVnr1Ldsu8kgrb78WjHUiQRLXEbpnHzc3YTR4uY",
+	"WxUsTKs0IVavSAKlsxZ0FMbZlXzwF+nH6lulOBLQY09oJflh9OQ1hM6QolYsZBozOBGJj3R6WFUcNPPg",
+	"TCkLQsk2EqrYCKAHXBzlFiGazKMJw9z5mLUeo7SMvkF8p7UqSGgADC9LvVsWTrw7/jQIwIMgx2jqTk7o",
+	"iPLYsJyGbxLPOX3kayBkkWuwh1bgAjC+ggonIDR8SL8TEWHFkVXtcEB6qNRFepBKK0m8avxMLKJvUba6",
+	"WNVEKhK50SLcS2s1CxYKJbGS5+tHXbZQlbV7O4R2O1K1cY1IV1AemKUNObpZCTDN56x/u1RW2V4DkNOe",
+	"kfNFY+cXc29nDmlZF3xg01RyjJ6CQa/OQQKYiM3ds73CtJQ0X7ufMM2d5pFtdgySRNX83hyo5mBXnZi1",
+	"FXc18+XZ80yBmLX6S80yDtoDljZv36DBNNK/U3gSqWHQCdIds3TNYXP4U6EWsfQmJ7dyNbV+ZjmsG9Bs",
+	"K3R2OdJbHvtFDNP94Qd/vxpcDY6uT8/ka6iX/3hxcDm4Pjn+cAweQMPDd4OjqxP+eLu+PP7Av55dYZmC",
+	"4fD47Sm+qIaXBxci8ejx6fHwnZ6DFEa9vPgHJSvN8/T3dvSxLgb6aCdnl9cXgxP+m2rIm/Gf3lwMxOAw",
+	"5jHv/vof1+AVDr0Gp5fXl/pm1B6uSSnHl3z4/vTsI5/+LWVM5dPSsmnbMMr74/Nz/AtCBWDLb84url8f",
+	"XB6+47/hf6/fnFyJVRyeXZ0ABC+v+exHhdmPri4OXp8MrvNnp/yF7+Hy7EIkb70YDD6cX1pTtWoKKUcn",
+	"dvcCOS0ZOr3bWVvs1CqolOZvdUPo5vziElqK8Marxk7qtdpqgeFGjo3ZOgdHts/Lq5/zCQzpfmu2sRKt",
+	"cxk0jlpn1KTNIxs8x/Kmsjp4lXRj9Ys06NNaZIHVkdec+bUnFvypea9tYZsDyRg4pK1NY+mKieXJofWc",
+	"0Drvrc0PrcceV7kOSrm2B7B4ehVNiebKYUuG4OC7oZjZPIf+SgU+PXrbrtiXrUgL4R6k0BDo7eDNTjEk",
+	"eckheKBRL3fb/GRhm7QtubyqY2weTkZDl4ZCz5q7IORQzV98zTJ4UzLq0izeDxKLhBc6/+1Hc4pwe3UM",
+	"C/hheNmtRXDyhKNknEHZcmtIgdZGBBb4FCs0Dv1AU3PJZRjnaUg5XnO0grpEAUj544xF/izYPY2j03kY",
+	"ghoOogr0Vv3gDvyJcrX5TrXxzAf91840yG7no11OJ3u3IqnVhN3Lv/f4RHv3+3spS+5Zshf7KFV86Udi",
+	"rJ1f0XmUvFNJJdgQ6F2mFwrWL1faqVqzgnRgM8Ponq84vIqVliYZTMWjDCQ/UOQaXiQQPizNJD+uvhbz",
+	"/G448x8iNjmsZWiaRzM1r7I2g62oJoM7fWtJg88I22Y+GL4uF3O1oc7Wqk42y49j0KUoI0AnQDklijGY",
+	"onIlqg43GYu5BsVEas91XMOqW6Y5Xkx8hF5sdmzNysRmBolpBYUiF3T9WtHsDs6utVqm45qUIDWyT/vM",
+	"IO18czFI6guWBAOcHr3c//mXF3/pv/z5z6z/80/+q77/8tWk//P+X/68P9kf39z8la0AnE7aRBk2JJWJ",
+	"8sV8GEc3wVR7V+WCctGh3zmoyqr5W6SkaNHXT6v/4ryc3yjYzzaTjDGsTtQ8id2TWfcU1MXnHqkZZWUW",
+	"w7WrrkutvpQx6UX+RyE/Ru5CnZHGshDpYD6CT+WX3XrVl/UOUKt6HpUOR1t8o6XgMrgT8WJrNBVM2IxS",
+	"nhkeQPCpIBPJkhIcqRIuWoTmITf3InmOMu46RbGWLJs8vVoeE9xf1NH9oL43UWo5F3qbuqITl74hcWmx",
+	"2O+mgubOkgGx/dLlflQQERa57j+VLq+nvMEBm/gSWl7k4tJd2T2OkHk9h2hDkHFDfvkMM/ABnD4212hP",
+	"xN2Rypt3hAMJFxQ/5OLd5JHjCexaOhtTcB5VLuQ85uaG4ZUxRgFbqDR3PbAhep8Zm6Fjzl3PO/ttcPHx",
+	"4vhyQC7eYyY/wIMcVPGeP4qTjFJgkAJDVdqKHjPM2AYpefFLMU0DzAUurXIGodu3afY1iA2+SEVJKe0j",
+	"frUkfcRvpdxIclkcSuAQHsUkHCjqrz46/CRC5LG4rwIkZN0rHbLKO2geTqI/YYEV9iUHiavls4RtYr/a",
+	"spqxjWBndQ+e+Wk6u01E/m/TFuV3jI+IxsnjLJMOXfyWxu2LDEMpIokM/SRf7l3vWLm49nTchWpKUPdY",
+	"jrPrmhFW39wxnufB2Ky9RzLS8HQSi5JonCIkSRUpSkdXsrhDKrfzI/HH6eG7g9O3yviORqvTNyfHh5cO",
+	"SExrhaq5NkuVA2ey7d055lju2xJzbE6DK8v25kBLvfRzwPnTxDIOtbOklwuiCcl41KqHhjJx8aqrreeN",
+	"kxidtqtJu20uz3JaFbAr4PrJEZGsVNLMaIA6CM0wj65ysBSGDiJ+I4sZG64DdyyoXCbwlkseRYIbayyM",
+	"yKWDKewf0DgjC95KFtlTzqT0CzJ2S8hMGyaicY7iZQax2oK/yJSk3oU4XVWGT+cdtYzDwDbdsQBcDIxB",
+	"RmHQ9MiUN7NfvAOrMGs8pJxvPZCtJ3ksPQl0M4+8UFxFHDNjarqAxKJ7ChZyZgtsKV5eyxHUso6zvQ6x",
+	"IcUjyDdnqK0qjXhemKnS02YG0l6fyZTRa/tPqXh7pz0wbAoCBymT/Llzv3X8MWUos6UZoyAXlaqbn4PC",
+	"7lRMcCDcFcwZLJMgToLMYm+VX23vA1PoAtD+NfCB68AYviKwy7sJfU750QSz1fO1P5i5SFaIKZBWSivC",
+	"Frdcnf8Mh57rkXOlreXJJjQnerY73fX+tXP32JftfgWbxct/7ey6KIkbEimK1s0cpzCuqmONNHEVjZ0K",
+	"IG6qQGFlPbaQIOnLmtpM/mkxDr4Q3jKP9OqMawoZrZ4BrdeycazJ2ZWUWziF09casFrKthVX/IYsulD+",
+	"Ykb5OiIIRYG+eQIZ70zYeoX9F8SEkN1AtMn41o+mhFEL5BE64MLlTNiEzfmE+MLuWRLcPBay9eTNMJDG",
+	"56uwJU11zOFzJpcByYqSYJLH7Wx1cbe2Ndu8c8r9zmWZiD2ICCXQbsRQUtYLsq2s6mZF8WrFtWdXPasr",
+	"frVA8autrF1lwNKPWlEEx1cBdDG/A+SAZvXFmtP+kxDUrqRBsVCBKaRgTbUETCchCjr9aioz5RZ9JQsO",
+	"yg5fe8+Bv6w9g15X2K8r7NfAHFeTz9BZB1mbP7CpnKBWP+6Tzjm0OqMGrVRgdcPm/cj9OgdysfScCQmo",
+	"Iq/bi5jalg9RTNsIK22mntzHpzoOeqDxy2KhwZ6qk9qzVcvTxikUY6zaQzi5TOyKTPiKWx3BKAjQHUsx",
+	"JDsTk18bByqBTI3ay1daCzIsThhC9Y/s9q5QqObdwT7YMd4dvHz1Z/rj1T48Gz4cvaqHnqp3WMVFfSL3",
+	"2omqF9xq0TieCHcn5xEGspPMegGlpd8tjccwtKfG27Gkf3F7UAEZKl4G7ymXCcopoBSgNDiZd1xeWiOO",
+	"DDS4q7KTg//GQNLhAKUh+uPq4qQePbYi2FiKXI7BfuotZ82Hcwve5VFdGH2LQiG1eUJlIFDpSlRSh+sr",
+	"tXpBa0f7dnA6uEC++fb48t3VawyMvjg+H2BM88Hhe/7fk+PTwQGGK/92/N+2M0cl2yHq9kxBkqTzIz8f",
+	"x/AurTLnYsU8cQS9IKilsop/k6lsnVLLLEMCpKK+UPizVDszyFoErVG10MZKLzIXGdSljOcRsotb/56B",
+	"obxY5xN0YG0871oUOBWJU3J9bXU4WkpN4qNyzrUasLoqrxxd8HSUzF3x6jIxLJQO1q2anJq20K2nh+Uq",
+	"EimB1cKytd2tpOZLgYKXKPpiAHutbVOgGuQKB1OPhEMPqy8AtXBAYNkX5bGrVdxVPhpnF0dnlInh6OLg",
+	"WEQS45/WkOEqz2j0v4L1RnHUL2bvqqC0rxauGEbmQUqkiWBGu95RYQTgJ3744D+myh2ZEkdCSiHShpOH",
+	"Uk4oaucYIZ3nwajd7FCzJRV3Sl9SZbIZPWI9BL4ITAkEkVPaSZEpiczxorYqP7NS+uNggrlVWYRl+mCL",
+	"6PojArqSRxqZD5xxHuaDn16k9VX1QL27eYp+UyI3XhHJaXUOZl6NBVe9BHGQ3PkbZ0Ruy88cZ6bt7nrH",
+	"xIZkD0wXBnsOEqgUEtz5IbjL8XPjxEWedUZnstYWNHz4hiJV6dqsZhZqFib71ZekrI2Hbx9FLr3Ru0jy",
+	"5xZJ/l1EeC+hBO1ClavxMUsyv28kKHm7w16eTdRFy1jShuBNQ3yGiOdcKkYDW+cBGrlWuRjKWYisVFGb",
+	"uuuRdqlToiFTfqV55B69K+rUplxUbrQY6IUCof2bODGsR4Y3YWFIl/T0VEFS6UqKUbnLZ6aj5bROJGZP",
+	"ktMY6FxN47xTgIkEt1xZ9WiLUk3xeCcN6RAXvqxqQoy0KesW+1QxQrqQ1yJIyALxVQUMfTSFRksQ2Tez",
+	"oSIVhfUNmZ+Mb61+MOIiE0bBtNb2KtqSMymKgBhRQqKOqvirF+EWPuRSOWq+LcOAX80Wbav/Jbib3xUL",
+	"b6a5HZg/2dmNDynn4LdXL3rwpr+LObRfvXjhWpdTZi80WkOTXIgDLeBDEE3IUpsiXHtSWUZZ91WBK2jc",
+	"9iJnqd0mC1818/Hocbdl5Htz8kTDgyWa1G6+CP0ofqCCzKKZCOqSB/IXb+I/pu4w4RJewkyezyWzuyoh",
+	"SCdQkgnpzQVomj+LryX7K4a2/I5dd8fzNIvvWLLLoReE3n/9l/evHf//Q5H5Xzve//pfNOSuqLid/vCv",
+	"HeH696+dH38vVQ55+eLnX5oTUNZWv6w59bW65yL0JXEUL4bhjBlSLqbiV7PGB4q09up2Ct17kl/QzkD1",
+	"jR78v4vniWqdYg3nx9+tehwHcdBQhkKm90D3Db4e1FuM5hz46FizrirO+UJ7BMX6O6dsYj86AK3q5cHw",
+	"vVHLKAxNeQWW4rFtyglIU5JWjfGJhQHJvrxBK98OYYOHcU2wLIDkEPVSSxZocdhk6maFBo6Ul1lX8Zkz",
+	"cs6d9CA4krOz+E52eoDnKOezU1m1BHmQhocv1wbx9mCebCcCLnY2m0Zltc5GYIMkareWb9TYX2Q/Tvrt",
+	"QhcrYQp98rVvOTcMWYCLX1lvpav+QtpofiS38aTVbsXSP1BPJeYdxhNm95OXVa3GvJUWFVwWn201KjSo",
+	"qDUXJv7kCPB6FJJBBvXPndw3QoQkuMqsRgxYGHc+qKPL/SsgVfz52RD/c3WJAo7thhSlqevSFIu61cJQ",
+	"BYIv7w941SabAL3FUdIxVjMthvCpaIm8E4pPV1fHR0J+egLNXW74MxT/pbpuwn6YZloJ4Jw1u6EHMTms",
+	"imIAY+in2Tv+QMlGnBYafDryU4Ne4McK5XluZe+i9vPli5cv+/v8fz9d7r/69cWff/35l91ffvnlp1e/",
+	"9F/wf79wL2DsE4HBlT3gkBiFaJvZwpWu/3a238oJG/M5hhmbXcxt9EdtKPMlqgZ0XWMLlLoozmXAqoRN",
+	"4cQ4n5WCuMvjLe9FigJ1ii1WVp7XuDp4xt+x4+gmdqOeC62DKN+UKz1tVvvmYYf5OBWjPnzz/Hv+qvZH",
+	"QQjx0OjJANofeW45kv8AK7rGklH9/+39IftBzhjs4X390fj6g242PQpf5+wWcuFgOSriQAviy1CONcT5",
+	"TBEsTsYZAbWSdsalj8rNSTee7Zkr72C6FWx+XVbTCvW+apJqry5ODMO3FXKxvVFA0Rh+VWn+yLtTEQi7",
+	"PxNdOhgSKnIMFZzc/pTmnDTFe1QmJkoZ+ghROCSFtGLodFjwCATWY1ZuWkvNaRU7yeVmxewXV2xJY6pX",
+	"qLNNbj+tz5ZAgPzt8dRvDttjQy3yosguy95L0XQuvDicGenw6H1KVzt1Fkodc50tszgoePjgS5b4xgbp",
+	"5LN92MrmcEW60Ht2ckClgv5x+Q59Ai7/cT4YHl4cn2PhpKvX/zArkMqMveqm1sTY/bwAYNVZTHH2JldV",
+	"1VCUMlRXRmHwqoK/lX2hzdAlEqF57JRRrbNycHh5/NsAK7+rP88ProYW70iN8euhIoOTN+/4Uwb9LD8c",
+	"nB5QCqyPg9fvzs7eWwdCSaLKTHUQmdPNql8cEm9A9tdzcLeYNLubkswEGVygvZmb/jseWS53+GJakBPD",
+	"+Fs8Mha03YTwa4UcwUEe1WHCGeI8+jvkdH3Nbv37oDn5iOyLJzCEEqrzkE2MI1XmU83XO2nm27L3wZeF",
+	"D1Tpyn3jQ7veBUZEGlYNBMZjEkaDdreTpvaXGFNrjjHISiqZp4XfCDcGenWPDfWXpizTvmOtT2MaDpG/",
+	"hwSiKRPJJcd5V6pfq+Q/zfZsBBiySdd0atoKTwr9sN70F/vDsMrZ1YqzgiNKukjBYjl1eTc9I1Trjuj4",
+	"yGTjVAs8PjLCUPZ+L6L35V3w5uqU3yN4uYsigfDXwdvaWwAGkVJbKwyWGQDK5CW/m0XBpbJyb1iKND+3",
+	"v9acp7U0HhLJe1aXYDuLMz80YayiMS56W9yP5fCAlm45vKW2xEdbZ3ATjPNJvB8gfpW/ku4DX1iffzRT",
+	"hRUQDvxfN2JenJ3L6pm1yNrC5b3OEl1NM9fgeqX7jis92/6LFy+svuDGYYre2y0dsVttiAtEkju6ykDC",
+	"YXCFYhC5CdNFu2ldNM0tVHpPs4SCH/AqfXp1/y6jY68tURObNFcA1wa/1HpVXStaSjpW54xFcjYYnSeU",
+	"F6627LrLl+9wS9QVmr+u+13DO5wlE5a8fjwKIJOIZE/ydTmECOIj/sRv4qhiFMz4po+gV6bJcbnAxTTO",
+	"2DDJUPohd7y7490d734q3m2Z4xtk7TWBDAuwZhwNEqzZQyMsz6DmzoaEFlTha4jV/urLlC/pLJ4XFFx5",
+	"ncAVDOgWx18pgiI21asAUhu1CXsq2trzwekRhbDnZbENtdOL9bFVKe3XB4fvz968abwlcdqFnuNFhmJH",
+	"xssiOym7VcXRucb5K2uFBvJZZ0+3Yum89HX0sVyQx5HBNBx2euhHYxZanc0KdYDWSI4WD2ExbdMmrLoH",
+	"KpjWAo/kUIfUsUkKLTWvzJ8TRH5imsglCMf4TRKd8aMgLuM3SaPGjznZGj7XbRYUygbwhqbsDYuYS6IV",
+	"pzoX2mJaYR3+CKaAehpAERNfMJI00aXIUu+Q+qg0IcbNGWdEPnL92ZK3dMlpU/MO20sGJbgZOC9T0ZKL",
+	"DKzgs1rhnsQtM/hyCexaGDfag5mSMNfUNZqnrPlpzRspLiO9QOtm1YTXMoUWDCEu8NdtJ+jKhJFO57WF",
+	"HkQja8EHx1xK+Gj8W0r37J1vngvi+j1adDXAlkKZdsxPy1bvyhXjXQyahnI2X1tKKSFliATtZpeILBh/",
+	"frTFzcE3/h8yzjjx30xjDy2oFEWu+/2Svc0JxlqfISX8N4H8Psdse1lilw0+aKZ/V+tH6/LPzq8+uS2J",
+	"GIWBPjVTOqLVKi1MbfBzK85kUwAXBUiUaakI8ZuEMfIQEp+r0OJycUOLh3ayPQq1hjVTPM8c+C/yT1Ej",
+	"jHGBIZGJURGiqEvCn/NDgdIEVPYr/hww2TyAU6WfpAWeN6WgxLyvyJELvTGG1HGyr8jxyRHNEBMiQh85",
+	"rkLHIEMVWPFXhYg7+7svdl8gHlPWGP7TT7v8R5EABiGBSV6groJwAqjO+1Ya+aFVxNLUU+oXKrMh1Ds7",
+	"J+L7WwSDqiUBI7588aI68Dus/IEgekXfIYxWZOfEolWU+Hjv3yIdYqouwAY6HoDWNiVgFuc8jTO1jwJy",
+	"cBziyJOKZBC467yh9Ez5p1gzVivZ+QT9EX5Y07MZgNAsqIPghWyw7SCkIqZQlnM8ZlBjMvFvboJxI0QV",
+	"BBpBer+/54cMS9n1Mfa6j+bodO8P/Fn/7SvBJWSZ4bF0hL9D4jiZMhy6exTOjd0rp3AALQbQAB02aASk",
+	"mYTTeobywD9rXIUqM3ioCkC2gYmXFNOobGVHZ2pkDshPbLlo408VfPrZ4Ls55+eZpjfzMHz0CKSTQr71",
+	"CvD4ef28Kcw78O78EKDAMPXgyFf1bWgZP618GaZVvImTUTCZsIiwXeE34UkdmkmMp4J+cFl96atywai5",
+	"pA89A2J8wlcu5/PVQ6PX1TIoTiN8GyiO+PA6Jn68EmQg6NChlQCXv0ONSSut0FJltCrQ+Gpm+yvZiHEL",
+	"prUX2AAttGMDjmyAsGV9bEC/IGdBP4s/swhuRfk33oaz2JR86ILd8xZQtg2qPmBr4fOlZiyxiVlwCa2k",
+	"/ga6u3AJNbyFJ8i1btV1l+D2BJ7j6r5tpE7bYLVAHTjYS3FyEo3z3+owWR15AYPHYTyf7OkvdLsEXUlN",
+	"K589OAhWbgWzTQWJD+Gz9CaxC9brhy0uxJtHeYjLtiBYg9ROANbN8+LoP2gGtS99OURflmoUN5p23qT9",
+	"3vsD//u17ryBS2Gr3cqBohKcDrKRE1EGUJtwQrUgNsmEVnfYIgliw+WdgCmO3Qu2RtDAE+t4WwHFNcjk",
+	"6E0gruFqhD+f7Bi+18TW8FgUV2vA+SPFwL53vMdaiR3ubxnu37GF73Dr7b25i1vkRm2DU+pKfCYX+Squ",
+	"cBhjD/X0dEqp9cTBbYk/gCA9ttbadsDQ+rjYcG2nDXOJE9embHn4Mq9RYXfbhAjq6PEgSodQPf/CIcdR",
+	"kMXAzff+IIr/ujdL4hGzPy6l7VOU6JAlLFGvSzkUKZWVMIHZCV5Nfc7nuZhH5zivu27KdukpzrXhW68G",
+	"odgXTm9St4Lw3d3orQCqfChlyMH9Hyp3JzJVUbi7L8p0ldSc4KXKW5Pe3sPj8d4Ifn6cH6v54iigWRr6",
+	"4897f+B/HLT43hAaaoVki5iDX0XKL3elfWFMK/LgErdSO1+EyTaJNvubWcZVlKMwTfxqMxNTJjlMyMlv",
+	"ufgBpjdZBMpYK1kv/l4nYhHSFSkGdH38/5yo5XSoc/0qvURpCzIpDmYnFHFzbx2ZlIDREcoWEkoFYRWp",
+	"nA5rCSVKDWQiBRdN22QWXWBe+SSukEhr29iTyR89uyKAKjwvpAnQE5i/elVYxP4qZCAu9sA/oIJ3d4dt",
+	"DWnaHpFYMAryrUtsr15r1KZEj5DVku1NeJM9VaTF+mhM8dVIZRczKO84YmEcTfXcBKogCExaptrf9o/8",
+	"KQxExTld1GWyFEee5oUSqSPJcHxIHnOa4XNeB5P6a25dASFOfKe03qd6+Dhjb33doBZ1XvixH4oQL3O+",
+	"txo+BFNK6x/O+n1rCcGhbH9zr9AAonnveJ+KbIDKC4kHynTO/23kMMQI+ER74E6598f9fh/+6MvfXeRm",
+	"P6Lc27KPgb+842Oeic/uMnTOXArjw7t7Igcx3NHlPTxTxT2HGt+1hFojPereZ8Xj+N4J82d69GyGMLGy",
+	"uVlcN9CJpE91yjVCewWtwcWtzhxcJBqofswlBUTyOvJ0NJgVB7fJ8N8nKU7jrCPD7SNDE1msggZr3Uzb",
+	"3o7uz+ea21H5Sm4BSa7ev/S3fQKSTpMNjqWilK0CjcxfXT6ZzfmWtmQpulNpx1a2iq3Y6XxJzlKV1lGs",
+	"3/sD/tPgDEYVZOHON9338BxwvOdxHKuKDp4VG1bQ+Rl/284ykYvR8oQXjXb0tVQSFaxXYijUym1lKEeo",
+	"dmS9IbJWSA6FraKcxrfmQZ/Tc+VBb2cnme3Br7OQvTCeNmkWeRMvhBA06flO6yhzlJN4esJbYead58hV",
+	"RGZXLiBQfZTRo4WzUJp642psRXLNM1J1XCpPFENmaAA1QtkyMxUTNc5ck1fNYuVQld2cpqYqt8tPfeAB",
+	"v+tn7EsmiuB6OJNWpbVm/9jBxNLr94oYzJlr+EP6o1aH2Xa+0DLdMeqm6xm+JAEYwFUVPZGZJ2FhGFNu",
+	"xzz8fD16vFadCqt0Wlwl4aXTJet0PFtw5epMqIX6WqSY6bxcizpkxfm1a4dDePlbh/flAi6ri7vCBuTe",
+	"HYzhnCAJKdQusVw/cB2KXtt+/ayXBAQQBDxERt1G6RP7dMLn9gifpVgyQQ7rEQLh//t5mi27azK1qZcD",
+	"YUnoCv8NSILp52Bmu4tvblK2EjFwrYLn+l+4+VkvEF3S2Yy7V25B5DBxmOWZHbbQ/Nv4/pL43g+bnr5Y",
+	"KFi2lbjCkWf0qH4WTjdBpGLCe94dl2lkZdWbIEkN0Wm/7R+IAZzZ5Db6ylGEAku194GVfcm2i7ysJLBs",
+	"+Xk7Rr4cIy8gY4uHU05I3dupyMhyyGhx/+I3OxNrx72051Qc3tc8p2hiTqYcWgmDNG8QdDRj0SQAJ0Ex",
+	"Xk8WbY2BR0N+HP2IxeURZLcUgcvGASRN62mNWIKFK6m82d2dMSw3x7ULsepn6ydc9YAswOrp3pbrMLiK",
+	"08oPr9baKrFjwwZVRWAOD17YTZGJdbLoExhSYda/bk4CVhQagBDsgZM051qCE1Zf3YAkoIRSKLJyZj6a",
+	"h5/7Mqlwgyiqy5XQj4q26vmITPz2NW/5t3j0XOTM9Qo6OjBayDkK2p2cU5JzcsjkpAFA9iD3dQ1p9Cyi",
+	"yiGWzALxRI6sSslDhYm0BxXoQ/8RZZmJSFWJtRgDcugZ+ePPkPkrmtQQA83ybMhhHRc6gUDAo+E6V0cB",
+	"4ekSdJu82MUyG0lWFFwr0GxHsjnJ0qFrxNWSattcaBhKIP/V5KykMAweEOClzC/XaQKJf5XXcg05u3oy",
+	"beMzQu28xoNaQvG5X7vOftMd7W6Vy/Ri7KJXQN1lmMeeqndiFhqw0gnIDFwQiEClIde76yGJgXs2yAeK",
+	"qXDpX1aj9EbsBmxrgHZAhmkWz9IaXoNzddzmW+A2iFWdsLBdDAfpawtYDh9+flfvosK/ox6V0EjxHDvv",
+	"oD4d8/gWmAfhR8c9tot7EIVtlH1EE/47+zKLk8zOLQb4PS3UMkp7HtbM63myUtcENRk9YUrlf4hcEaD1",
+	"gHcbGg75P1TMdk9kKxEw63myAoiXUi2sFB9Ucq9iAGmuEEwGipJ4tI9ermzhchEY62Mo4gw5ZuK8WgLv",
+	"7kcxHyHxWHQf8D2ArYcUMrMwfrRZfih9+2uciQDyXWtgquBoUMNQikk0thO+CeYDA6BlbsNaGcN5NnFN",
+	"WnehNkKnn8nZ10Aca+7S4lb4oA2rIqK2s6rjO2JVkicQ8efntevJom+p4BZ+SNWN2BfQCCsTcQoVV8dY",
+	"824ucqD5/KEVspvMm0fjWz+askmvwKDUiAmL/pQplS6tAzp/ZrNG1kIb6FhLARyNGl4EMQRHSOg9FSeR",
+	"603nYTM/UTdUjic9IOxZ6EeR+JnaCDvZJHmES7aT1FZodW13fhq6AXPgc3Jmo5hGkV8UBBViFSB4gIAD",
+	"bozy9EtclFawJi46ZuHehI3m0xph794P56RnPxycwJ0HSifkf1Mf0viCIuo+mKBgNZtTJnETVztk4RFO",
+	"9V0bqgYnCIQGDoaQRNkoYylJRmbgb5i15ct3DMZgAnsmhj10spKeU4dDtUJiGrnzD8vSepCM50HWH3H5",
+	"5rOoatzgowFerlhnnj/M+FUjRvDECFKikakAUdi59cG3eAzRfRO+nxs/COcJM/IDGu01DdZ5dCB9VWHS",
+	"wrGjdD6df0fZv6MCII2+xCcB+iVpTcT39SkyaZT4EaXUMV+xr/E7yHNaWKB3k8R3es7LKJ6wHjkFoKOr",
+	"F7EHbyS64rOjL5KpwmfQjNxhdQ2j/eeIZoIoBJr9u76VCQQaTJoeGAR1id8bdh+pLtbxMhbLRo9nPQC1",
+	"YxOKTQhSLMXnSiYh6597F3NIOb1CFvGH/s+vDulxSVUKQc38v0mgElroK28gfAp2i6fPOminwDI1L3bz",
+	"GnUoP1EkJklTpbPbcFSPbQ3PLtRHYHMBkx0FpgoAOi3KU9u7UEaTBK3OR+O/4rg9SuxdY/kq0LkTO8bp",
+	"+mL5LhXl6UnE/y/VdDvUv1DMD5LvoxIPMookkYknI/oOsWvravNbWm5A27EOmAAeh1MgQEjQYM++guO8",
+	"x6oF9vWvtsS9bnvsWMH25BgunItzgUJrMmEtFnqcBfeshoKZzyXBHKML2g7Uy7dhAQ10/6xlMDPVx5TG",
+	"vwFIzQygDcGvUrYon07bFEzSj6F7XpnSIijotCJpm/caXSlg5v3b8OzUGzZfw94xYSL8LE2+oLv0xcIo",
+	"FBhULMarC+w1nI9EkPpN4jYoYUaMg4oVGAyHWwPxdwE8IoBHg0mDBkYcCmcy8lA2rIXRlurgXafwppM0",
+	"7MxBkvEyt76rpJ+6qliaQ1Lb5Yfaxvv7W0otYkjSKasJcVbvlNsF2rXP6zKQEotDPpdDzpQCyI4pUAyr",
+	"XMXj8TzBu+UGloclWEUm0nXmT61fi4onqV/MqjKqvqGjAZ9AbTXgK+WnaTwO0JKM7hWaYUTVGwZXGPP6",
+	"ZJPjieVk11yny31f+maEBYesPWOWZD5/g1DmoYZ9Xsyj4RIZijDZt3N2onxz6kjELkePcOEFUFAlrRP0",
+	"n/hYIAPWZBJQyfO8SL0oqiDuC0uaWNXvQ15c3bCRKhdU03B20wcvCcZl04DLsD9MGDI+kZrL+/3X338s",
+	"s63aKoxu+aTSMb/I3HJdYUvXfWHr5da7gXddl1R3dU+5dC0C2h5ew66GMLzbnSQ1fkV3via5uLKYagPP",
+	"piMGk15DSI9rIAgI6ctrGdeVSqOV1JVIw2U950wDtMWa2L3m0sbb7AhGaNNdUEvQJNBC2/upl2OOE2WK",
+	"2DaHa0q0bLyjSCTt1Anbqk641GIe4VnjIkA3vj5rp6g8EfExTnMCBm2gxHT+VkjnI35sEFI5CbBOmMTr",
+	"lb4e6nbsXaVkOhZrQWfI6nr8TPqeYzkSo/5hww8PjbRbMHbJYjrOXpS2JFxy3k7wXTxPm3CppYGtrLkz",
+	"1ghjDYHDJZDFEyGXgk+SC/PG86kK9GiTdU2gQucN8sTeIIo+FW2607y7FIcPLPrbqU58E6d45r5cglq5",
+	"ANJcoF5B4nm+thxZg3QX69jCdjmJtWcLPQ1p6+vO58K9XZlCsz1nbYqi9e+cwmXKxo7CI2PqxKUJraG4",
+	"fNOV6l5Ofvuv1Iaq9pshuPWVs1/4eaDgsoWPA1m3vuMP21WsflnG5PBICONpfxYHUda/g9Jv47QhCTSn",
+	"vjlfGpcb5F8QWTyJH9AJGuKOxDgFlbA1G5AoBsvHPodFfBBreMa1trpy0d97uehizObxkVhikzodug1E",
+	"r6fyHCrp6Isrt5+i7HIdPOG604zNWqwZmm9qvWsvqJ0WuGe7Ip9ASngDSM793d//W1Js8y3x8NLhOJb5",
+	"dr38m4y8qr54q/v8GzH0bsry2okNndjgTTiCj8msG3uQaKpGVsDP16PHa9WpsEqnxZ3BGK8fj9QIlnVB",
+	"ZNndLNPIovF4RBfT+TRQQic7dbKTI5xR49QGypxinmrNOrNTXA6jIMSTOU39KRQqoPSHu95wPqMMv/8z",
+	"j0EzM7tNfC489ryzC0qxyb4A58Ikm5wZpDJ6JGQ+/ti3wEH+swXf/KB59P+qe/RTZrxcQLibQ03dMPRu",
+	"/Xu2651SHe6bgIV8fRgUMplABkDp/jKJs37KQEaAduBgSlvDOagH8C9fBKYC8Cy7uqsNUnhy6VxJRC38",
+	"Y1A06xT1RecYhWsrl4L3Mp9LNTZReJglzL9L1XOJ8B1S7EsJ1sePj3/iOPuQBPz6i3rwW8qSe5ZwLI+k",
+	"0+auN8jzLwQQmK1QpKcKqwUTmd1/5iO98KF+x/i5370f4gTXceKnWR/9PvvHR94tp3uW/EiRw1heAZM6",
+	"8E3tNsnqlz7Kc89TVs/rOoe+hiBYgTXgL9xd7yxCwoIDEycjIhEDilRL8WxlkvPgBn4PUshsnjLIh2Hr",
+	"DnPmmfKMUtAN6W6XjPPshONOLuzkwk4u7OTCrZMLYWqZYg2vkaIQVAahQeaibtJ0RrJNJ/UpqQ9kkzVJ",
+	"fao+095tls0cQl3eXV6e51WdGiNe3vFRz0TrLu5lS+Je1vvUAwwpHHmL914RuzoeUHr5lcCTMwIJ76Xj",
+	"IwozNNBzFywhgiV0jG/jE1UA9lNFTuiLbxU/UUSVzltqS8IoogoNt2ESDiJDGnLJdA4Sea2TFGaCDFGI",
+	"5UDhMi7/f6pDColjIMdvz5sm8XxGv8iHXg8z68VRT75iQn/EQjhmUxYXyL3pDfVZfFEgG6b25lGA5eHw",
+	"3XzLJW1I++LLke/mYRbwMxJLgk5ikBHLHhiLUAvlp2kwpbKRIH6TwoM/JFKsnQItYCpjHQbItMNBAeu7",
+	"Qng923h8P+FgSoVxFvfrPbBEQQJdXsccKTnIYaMONluH9be24YZ+1nKRPW/CbnyOBxj/GsUPazb3koWT",
+	"I1MqLJxIAQJZcVFWQQ9bXo/qsybXs3qFiG9hrNdN+oMmysvXP3rseXJR/OHLKQd/x/r2j2K4azWc9h61",
+	"ZGhrr7UrR23TOWtw3XYVE6wUGrZcPDS6xglag4zvIrib32llA/DQUtKeZ/MkejZPDoXYTu+NouNXDubu",
+	"uVH0utIgo1UmxKT7SwkQcBs7+lmLBaF1A+7wZB45e1bz9oAOlOru23Op1sDwBFdt2V2qeTWrvEL1BBqo",
+	"346oHvm2c3nQ1kIZc46af0pNBW1Ki6b219D+Wra+xtYbMieSgRbzWfIe06lKcyxq7BitTdSQj3+N3Te1",
+	"8gOTlNK/F+p4BzNZLuZcb7MrBVYFm0eLuTmXuWjn5bw9Xs54NlUH51XduKuLb9IX6nINfytxTXjh5X4P",
+	"CAYo7iy0YnwS9sWHI+btX754ud9/Af+7fPHiV/zf/7HwHdH9wO4o0fqCxJWq9M76UmNY3xKL5XdtkN6y",
+	"yWscvP1y188blwgCQTB1USDbzB9tYSAr4pLp3tjnUnNor+d6iN9Jg2nhd9Tk+zaMIAgcqq0iHFHpIYG2",
+	"0ZLnOGnIJlR0rtH8IZsrbtExiI0ZPi71eyzKrSBbw6NKnGHlnClhs9B/tHOmC/xey5moyXfNmQgEbThT",
+	"IoG2Sc5Ey3RlTIlo3fGlji9V+FKJL6ySLyX+mNW/Jc8ugSVCO/FSLBV0KXOpsxFEC/ijIAyyRz7AJXR9",
+	"ti9GfbMO+j7eqqQte6Ii1unMj56icLWa95l5vZ1lLBzytS9gf8oJpGPZG2PZyI8iiydNkW3pDjQ6b1qS",
+	"dT6w0W0cf3bJLi+aNvrafqR2nZvtNqeXJ3TxYFi3+kzY/hSaLxJlJHBiqEZxjiERSOe8UNGhZqX1kzx5",
+	"BnedfFq4LCtC7rwHis7KCjA5AxUgXtpLWQxt54Gda7JwTRbwaOOVLInyifyRJY60cUWW+NAJUNuSyz2n",
+	"0Ba030JswnTu4h9u+dwbecYzz+gOk0u3DUnCzbndc6jYF7tZE54r/ct87R3tb1vC9gVov6fjYkPOdonc",
+	"Imm7kB0tRP2c87aXpONvjYBlOvaOgC352BvoiOMkvyL7EB/ex+cpHK44e0cqa0rY3nhnPvOU7eulsPWl",
+	"X/92pXqZg71jDNuYiH0VN7v5eX8ep1jcNoj4ojFvh8BXkfzDzoIuKMdRx4Na8KCI01oF86NHb+Y/hjFH",
+	"+SDiZ/Hoid32KMPHLPSDqDG1x/p5yIWYoYaXUAYVuRS5LaCll0RLNb2i2Njx++ZAL/+6mVkvBAcR6nj2",
+	"ZczYRFQEzRQFE3ti43kCdphf//lJZ1bESQz8Y1GW5aKVwAjHlG83mRAsLEpM/E4Z9EQfynMEHM/3Uv6C",
+	"GmcQlZpCMCqqOskLBfMDYSAyhbHyAe52vUv6FvIrffIIJuYIxoHAUm186DqP/JsbPjSbGO1G2JLW9n2r",
+	"TBEEBI60QbiS4MVwTQG5jQpU2qE5mSxolVK0otV3by5NdYnwUZDR+AX+sqzessAhmqy+cETqvCSmCd0e",
+	"jdiTtZP5JZXEU0gcJhoEiTdJIE9aE6k/azuxSnEnvEXSTAMagKI2093xt1DwojUH0I2WBD6JjR0bKJku",
+	"i+BZEy9AMl1aWMCsJETxQiy4EIJALjpM+QMp8jACl3hIcMdi0g1QPE9PhDVR0sLJPIGVawOw+wCkB5xL",
+	"ZNaFTCpRzEdLxOooPSH9kvfEs52LpIYwJ6WBoT2AJ9QjYIFyrLczrSOE1vcsniAEWksnEwG3rRZOMLyd",
+	"CTxG3O2EFBN3QhRYt4wyj5reMVfR2PUlQxwlzeJZijoFPGF84NhfNpTo1c4K5PTfNTeQQGjNEObRkzxY",
+	"Kutt1OIIjXDUvVzqmYKE7Dr5Ajix9yHFRsPLRXd4b84O+1G05hJL57W6rV6rB/S8Au98TKXj6Lcq2i7i",
+	"tAp6LUrb4+qtqhLUoaHCZXnrT0PnupSVlRLbmvwnlaW94wwBFWV3IyhLIRIvMs8wIy131zu7IHmCYxuy",
+	"EmCvQjb00VIRcOHi4PTI3ioM5VhHWo6/s4td9+1fa1k0Xe+5/Bhkangtu6pT7rpnk85IKWGc/MNljYF1",
+	"5gD6eMvo/anqdnhHB29TuLWxhIn2uwyHM7Jq3vZaNmi0uI3iOGR+5JD0SQ8Bc4HZE+V/0lfZlAiqFM63",
+	"VQmhvJvQn6IQ8iDwgv8JUT86GihHCdRezDP4U9j9UjCEUsJXElaLrOR3wIffoVgOp1WW2fiKmOlaDrrT",
+	"DoWo1jmqT8RqLq5OT49P34rr2BvNx5/57N7ByYnI28h/i7NbjvF9QaFYEkOocETq3bPT649nF+8HF6oP",
+	"EQg+yjRTkq+0O4Pfjg8vB0fF9oVRi+Dh69m1xznC+NeqsLtzVDR1FPXfrenLGO/KJcHxI6QpdS2ConW7",
+	"hnIb25r7a0iCf1uVbxcVvkUh2UrLrN5KpUcb/n4Bv6/w7bY3CVIIBe9jUFfDS060RUUwBYHxq8D+vKt/",
+	"3R3RYBgc9qxfetrVqCxyRaCIhJgCfAJ0dq6j3YX1wsaztVFVUaBjXR3rasu6JJ30gU7qOVeBRlH6KxCo",
+	"UlOTRJDWcy6tbt6zZVydBqfT4CypwXm2eoru+VTzfNrY5Z9z0e7u/5bu/sJduxE5QJT1tZqmh1QnGLU2",
+	"qBEWYeLg0k5pcR842+IrEyVBDwcnfBMzcKCDkizxPSX3DRLSCnFSJ50Q/4MB1LRqpJwrGCqj7Hq0BAba",
+	"aBjNz7w78En+C79PHkW3hHEeM48mVHrIR16EIJeuOQ3WsqEsbvz92r8r0GiwgIsC0pDPb75p23dbtQ2t",
+	"1aBh7ezeGjcSpL75twh4q/g1KYKPWBgg5XvUUsbgQXExZBcpfoqmYSlDXEyec16Qeg8+BnR6UAktnfNt",
+	"+tBJeubBV1K/REI1T6oYUViClPWkdtdm3fWQEGhNmC8gEM8hcO2j4sqath9KPEkVh1hNPhYUfJr6ySQs",
+	"eB6nY860mngXQe975l0EAg6LJp6l0GdCKLVhvqWt09FbR6xT1laio+4CODeYihT4SCUT6c8v/rrZFQRp",
+	"9KdMY2IaOqDzXwAfsY3CmDJ3J9wv2RfXz9wF27Rz90vBV0Uqqvp0phrf63JS7QvQaUBxcFtUtyN4kEgY",
+	"btqbWSqxuZAfhGm75FQ6hnTiWzlX1OoJvEjPmChK++VrQ+mbAsrBYw2SzCh1HFzFwrIjmNq/+CiAFP/a",
+	"8WaWQPUcfxzT0RTWQK4iU+xpiQ7XtrdutrBNVNapibZYTVRON+5I0L0KQi9A4nuk222uT00qYP58KdL9",
+	"biMVC+PGwrSsT69pz79N0tatQR1Jb+nb4TCehxN6NQSRWXLZolpQBapKJTE+Ca/B4nqoMak3TKNHMhmI",
+	"nCohaAwHCGiAM7jaoL/5PNoGtmq0R367HBURojOndXLSsrwrCyAcu1laEu1acy+o4iKmeLZvHyMPmrBZ",
+	"RhYlUdHDG98G4SRhNk947LBFZUuIkdDhdJzk2XOSOvpcK3vhrEX+yd9eMzau4yUUND5h49AHjsGpBnqU",
+	"HmH3nEEEYPui+PGxH3kj5iFsRS4J7/dbtFzlsmCK3x9/r3u9DflUbZUwNj2sbPBkRaUEkHa94xsU3NM5",
+	"waeHEBZObKIRmPpuGJr6bG5FouXOc9MYwZm2LLqkQIjI2r0xN2ifKmNv2Vhlf+rhWVU5WR0TUyRa4l5s",
+	"JiQi+efXPfAl4Myo6Q0nWgkmC92NAtCQfxBR7QdyYAeeI8ez8hy53i7CfTsLwYlzF2e+QDk4oUjoWNIG",
+	"WZKiujpWVCV/jfglT4LjB8mqjicpEm7mSS5aJWrTgh+RIqnjRt8PN3LXFHW86PnwIo3wV8qJhH9MTWJC",
+	"NNynwgHGEv55iT8f6v4aq/YnocFpoqZa3uRR8zQeJJcyabW7z4jMc/1NU94CziIK2VQRa+EGUkZyE0Yr",
+	"j69GTSd5dAjDcC2Ct60vpMKDxQxWS8VmnLyeFuNlBaAO2zd7zRAyTmJGNwz7QqJB+eHtTGyF6p31JYUi",
+	"mg1TfNbR1fMpLLQmZ0kCQJvLbZYAILOAonvnEoDdPfec7jlBJwuQXs19t+eHgBjRtM/XFYT9aRLPZ2m9",
+	"jt5XMeMCvXAMDwfwxABl0j2AJgNo8RYaPJd4+fXfhCbAtHuK2Q+ho52iEawGW1vdY85Pn+pcTYTx3UcC",
+	"6C+3Emzc7roKyFs97fbXS94L3IAGHOro2vj2M1Lbam/JvZRlWZNHDFmxZRdPdqnPiaWhC288FH2eSY3a",
+	"DV2TGmCWuCP1M+lIyfCsM4BpZXQ0C/pZ/Jk1JAv3+AY8aldPNQez4BKadfJkuocG5fNjhId7Dn2z80OX",
+	"TKAsPAJGEmg1YlA/Li4wgt5DYbsbsncyIgJA4romFq5ThVGetKOvFUd75sTUksDqLhwHM3mK0RcFW7mt",
+	"LEVuLe3KUWx1OQpI0uySFc+ezLkOyxEN3rNHlyRz+ZqUs9rxUeqaFZ94ResFSv+346MFl5iHTi2RENJl",
+	"hZD0BfsKxZcRi0R+JpzTKV93KnNguS0Gz1PkzTJWDvDRPhwnkzoY4OfXj28CFk7aTX2m97TAgCafcEYx",
+	"zsgTt2YNR1qz9uvIe9ciS56Hkj169344Z+ZslOyLD87pwLJ5y/1fsek+/8D/9ZL+9RLYe33Wyg+rTVqZ",
+	"b4MSGqm8lfV4jo2/gRKhCwWIdT4/kd3ZRhNaELjLq5BxXIsM0j0BEAAIiwa1sMh49iTuPYQJbXS+jHp8",
+	"7251LzeUk+pC0KcQT9mXMWOTSgiVeKDQ2bSg8+aHyd5oHn62u9O95l8FeqQ5T0hrmQL0+Y4ZA2y/JXNI",
+	"n5I7pO3ZQ+d2u2X8AclUZxLpirkE1cCucbvF76TI0MqvFERcG9cgtxIa4XsWKBAA7gKFeDAkDBJ+rZxt",
+	"5A5b8K+H/LF8TCUu1pUiX/4Qj/7Nn4DNrAmBxvLUGh2T2lomdYGYuh7+hGo0Rx0r6eYc9Kzv2WNn1suV",
+	"jQu91hHY3Yvd9GL3hO53lXQgbgPrPU00mLa7mi/kFfO9Xs0EgG25mlejVqPFdVL9d3ph/oH/7UOykr78",
+	"hNrtxvAjULjr2f0trOOIt+N9PvIJLiXZN/IPST5m9lFZ8qZtl9/8LQ+HtkgcLmJFd8sXfdk0yDjTbs+A",
+	"5PX0fMMf/fOE9aGctF0EHoCVC519PNEhrz/d4BH6htq/4c3lKC1EgeOjbXI+KOydAh5ZvieTve0m3/3x",
+	"pHa5dTj0pjCKvRx4EE0QSaOp94A2X77mEbv17wMoEk/lTgp7SG8xM+qIQaXvcy7yvYunkE1pEqSQBAtp",
+	"Yx75934Qwr9t1aXTQYTNj29OYxjlNp62Ky6/Ts5URUA+Br9B52GzlCNPd1IFnVQWfBdhXluUSODUkjfA",
+	"kUVJRiqwwnuDfG9BYSiI7oOMtY02k73M/PIYv3aKA+k4r8FjIZd5Ce3OUd4US5bj4poCyGiCWlzvfAG0",
+	"kDECiVukGMH2ScPDaLmLRIUJxPjeEyO8fLkhlQFcjS5OAmW6NfEFhuJeP4EC0DgmkIegtSXuUflDn/79",
+	"lVhMyDmCsQ4hI2bjzmioz7N1fS5Sff3a+gocz/3mdynLx7abtxTIjJAwR1fbQ754jo3pR9pRwvNJQfJc",
+	"KGG9WVIWkwqeLE+KI+XS+p4N5Yr8Ja0pt+7mu2MQX9L2BSl7mUn8A37tXpASGzV4LPSClNDuXpCmF2SO",
+	"i6uJsBbj7f1BfzgIgZw+qK13k8R3TfpowoZvQxQU27atjT5vlHZ/XgvtLiIDfh9U+wwUs4pICwfTgl/0",
+	"JCI75OCrTGJnAd+GDLwVLGC9wi8dl5vwK8CxJfkCHbmXQQ4W59YxrydmXla+sgDzqpN6OMJyFnTL5mn/",
+	"DmTQcXPJsryLJ7qUbZLWtL7nqusHMdk38VDI2Jdsbxb6QQkryiO1eQNUodwR5VMTJVCA4VxW9QLh4J0z",
+	"ZzLE1q0p8O/Q6xkR3/NOC/GcIv3Xrw8p4N6CtcVkgauOJ24RT1SnU+WIzQXF6nlibupLnRQySW5urI+U",
+	"AbvkCbR75hoZ2ivnE/ZEPS4ucU2qFUcdSA7+zqu2oonQgJMTCNrHTwjBa31fGkLE8sFTV8zv8nFtczH2",
+	"VeRuaoTkOjM0KTzbgixN5bXomZrWKfgUaa1FEKJGzh0nLRmAdNi0ZqS1wobo0Z/FfFOPzamqZQePOriE",
+	"JcgQqnPs0aWp3jOBZTF7aek0OrvpxrO9p6E//lyfoHoITbwHNrqN489VTwL8/JG+dp4ElJtah0mbh3MJ",
+	"1NtEDvubWcZV5M+z2zgJ/gNepzDxq81M/IHxaSdYCYwL5/EDM1abpANCOZBIQL/P8ONShLiXZn6SWclx",
+	"CF/pHjs74GDy8J1eJsirVFoscUFnAFDs+Rwp86cXLxseswgyca0UoHLL/IlwmApjQpgGZT8eOBvPkyB7",
+	"RPiMORkGDAbFYoqfdHxAkBZnlIgAJ7Ae9+e0qZrA8HRYRs8Su47SjksLLn06PNZB1YJPl6Hcceqt49RV",
+	"QlB8+nS4RBGD0sAmAuvClBAARfqqrV2wOpwtTuocblQ+1Y6gt4igrZTnSNG1N6qo/t3fhC1XVKJ/bibd",
+	"9SsTTIBpp1FQFeMLJ9NZG7fB2qjOZtX+F5J4+U/yz/qy5n6+ltEjEVTp9iZEfCZaPrMZQu7QtiwJqmfK",
+	"McQRLcgfOo6wsQLrOi4++FRlvYlF6Jc6/AQHXeMxqVC5PZ9ozDR8kGXsbiZSZmNbjX3YGMdzSzHccZA6",
+	"P4kgxSACwUIICcLteyA8sYmviVA2RdAJg441GUkxdbMrDWPzjoS3MUdqApW08KgaQj2CaDZHbwky/Zq2",
+	"+3UrJJUuQ2oNf8EDfwqGku+pVhdAzYQrQRNzAS0ADduxlqeTDtrl/rdoGsRw3YNimx8U8pTWwjUyP/3c",
+	"h4KQDQpD3gxrTApNYYOW8JI3H+KgzzL7KWwWZgdDtZ95d/M08zhGMD/h97F0wkLS3fU+BGkKOUgBQlw0",
+	"S5j3H5bE/ZsghJSiaey9Hxwd/EkF7vT5QXh/G56dnvNNen74ABnm4QTDe4aZ5U0uiDD2KaxnC6Ms1Em3",
+	"YEFGZOqY0BboOW10vonEaMJpqA+RHXVpYnL/c6tHV+fMlYeRESg+IlABIHXF0Cm4Q4S6UUdPHkdnT9w2",
+	"BwEN/RdPXyoGsZHQd+8IUKAfgkatH8CLdc48aZV8VB5tR7nb5wmgE95ClyViRb2lEG5IYt71QQL53dBF",
+	"Zm1jZNYbGbUtjhMFtHlqC8nCj2zBiHOWDGlwh5jzyrpCf8RC27rUR8OqDFIItIYQ074ewv7DhCFEOXPh",
+	"s/re77/+/mM5rl1Dn/2nrduu0dVikeedDtUQ9F3Mv0cwXtT7QgKa9KbtC8KpIHTov2tkrKIUaFcdTqsO",
+	"p8ElbbB/6BB+wlpxpnXbn1F200gBYTqVx1bWkCueUTWtRL3mtQ3D+UP/Z5PbV4ESGuU5gabP2QusRPrm",
+	"pekQfK4amvy4Fs1Q03mF2fPDFA2uzblhekWcWpye99B232h7JQs/EbS+6N0Guj7G0TvifnrizrNhnWuV",
+	"4GmNy5hpizDC4+6MJBsyknzUYR+55KHKD6mtyLA6jpPe+jO2JjliiGN3/ObZCBN0YJ1E8Q1JFCrUS7jY",
+	"1QZSUxsi8TBU7iSpQdaoI32MMybPr4Gsrt3xgJUv8MRPwQdGVq4NfXmCVnVqmh1PrKrln16aVMsbcElH",
+	"HFlA59k5jW6pK9oCvMTdT82NF6ZOdi5s6SbRfJe2rgm78aEO9K8vegVWsQmrl5r71SKTDykt4egRvfIs",
+	"k4pPbbKMrl7s6ow9q5e3VpnaV43ZGDt3KMOARhA/VTH21ElMzyd2bl0+M5qdhIDhGuUigq+qppJVG3tm",
+	"mqbmDyX08QUfT9KCaXopAFdt6C0VQiJgr7MeNeQaJLTZhOWGc44kjpolEmjl/Tse5YviODGdNjrjHPJ+",
+	"37WY8mySJauDDSYwLccGJRLvNpSDsD3c1vDWhZnbLu+0SZQyTokY32Y66NB+qudZ6aImATUXa29EkuuV",
+	"5cHWuUjqngt79Li+dNiaULDhhNgFYCwhoXfXrkFKr9xzaxLX4dLd+wP+05e/upVLrV7EzoYPQJxnXqpD",
+	"7d62rAJEN18+1bHGh/EQu2Tb5WofZjC1s1UUEcJaBYSMiUsS13N2T9piylrT1dldm89Bsd/qsl4Jf2gq",
+	"U4yzqhmdmcMzr1m8XfxhXVWLdQZxSQoOJ10fYAGVAnbR7TWJCnpR4U5UqOcDgizXxAqMunSBGBVWENxx",
+	"EAV8NeGjO1sQg3V8Yatz4gpWAPmtUjD8NYkOQjn6/bkhbWVeiN7Oq01BHFKdJ5EfeilL7jmPYAIoOsuS",
+	"/MP82tC4yJL8y00VgeKsq0OC7iXR7GbZKfy3WeGPTjAttP3YfoOq/m20Q3BUBqBZXO9Ky6LGH3Vj7IbW",
+	"Z8gIZ1ybcHJb77oOjPGlngzsdqk7bgwCd/Ubxr5CT+6yuM9BNHFaFTZsvaT3vFfzap69MSgL7vhj+QYW",
+	"Wgn+AP88kdlD3wIX2V7u91/A/y5fvPgV//d/rMY27H4AE5iRF54FfVjFjiPt4IpHjA/A1rnk1zjDKtdc",
+	"A+WbIArS28XXLPtvFM6rWvRKIb0+42bVkvjdmjbLsmOnoV1LuMd6bJoY4eFSrsf3xNLgoiuSv16/xzGQ",
+	"6xmV7enE8E4M3wIxvJMtO9nySUI408UqiRWVT10hseb73VDXa3X3PCx1Mg/hemzQGqqWi+gPh7Jzp0Xc",
+	"Zi3i+t5FCgGelednJ0x1wtSzEabybeSseiW6WacEnYrAlZZ2wxktqxym0zqsViqxSADrlUv2RvPwcz/3",
+	"pDZ7cbzmjYRT7ooEFRjx+fhXr8mPqkpTOVhcwyZHzUez2bJhtXuyJ87UUSxR7ToOITnEa6dzXjunIHe7",
+	"Bk5Bjbwf+Lyi948rZBvPxzl0o2xDphluwTbEOW0v25B7amAbYh8d27CwjcZzXifb+EP92a/kvG2M4DIv",
+	"uSXTeOZxXAYYWIsXGkG9taFd5tPtHLbLsV0WOLXzeLTgRkOU10oI8DnHej0v6lvnhdy99Z97DNi6+Uh9",
+	"NFjhObAizvLMA8W2nrmsK3aswl1alEPP0agaMPK0T5ZGDqkHq32Xws8zqIV6VfdYWiGvbAiXs7DH1nFz",
+	"Ckufe/Dc9yqILRlP17GZLrSuPrRuvZzOTV2kkp1/zXPs1ZWv5XwvYg/2THvuifYEFJ5PsdvmnG/12c1r",
+	"l7YhIZCgvWgCAS4DmqP9M3XFbU4KbJcmRa/Ra19/x5yfgjlvWUk6wejqsHw9SU41XlxwXzTzYylfCo7s",
+	"/pY3PQE7LrxJLixPYIE3eI1kueVPcJ0Dd7Jxx35t7FdKxw0y8cpZLtU57o85WLKGyDBsI6vGyHLv/r0f",
+	"hP6IM2Tgvhq7MasH+EhURzk9xBmfPettKu7zzIt7FQ5rQYOMKNlOKNb5SphDQwpAWqzkV5H85/wpnu6N",
+	"50nC6ik7pdcBNfSgW4V6r/iPvOWhGGyNeAcztcQzXPE2odX+ZpZxFfnz7DZOgv8wutBevNrMxB8Yn3aC",
+	"VZz8kOOdvMsYx6Ege0Q2Po7jzwE7mAPv+ucnYFWl9JBFdJPojsdvQONpkN3OR3tjPt/IH3+2ovNhDI78",
+	"GSOcPoP5PeN9BBOR5v0tDn0GsDyUw5cQ/KcXLxu8TMZi3kl13lvmT/By+2MnjOkwiudQZutfS8AswE5u",
+	"sDhHEXzAKThv4HdyP4HAQpQ7YGySj23ATTM/sTOKIXxdDKzYtT1McT3rhyiubqXgjONpyNaDqzj0d42r",
+	"BNwV42oO1u8MV4PoPshYfXXPFONFpRROHVDYdxIbYIRL7Hss5lqn7UqbyClcCEKsxLEVN9jJqc7XOVZt",
+	"LEEvx8tLw8u0gHt7Pj+PWWbX+B3g91Rp9sQkFWzTD5/67KxHj0WD00SaAsuieKrBPtq5Cf86n1SFXgTt",
+	"ytm741fCsP6ZFb8u8Hs7/KI+a8IvGnwF+EU77/CrFr8I2gvgVxhPg8iOVifxNOXDcbSC5rs14scJDrQm",
+	"9ze4gmH8ZkTa3PudQ27KcSGIumf7Ez/bQQ3+clP7niUx4AAqiwdRxmULrw9h+cEEJ4NDEU24xEouJOlO",
+	"nTiMiG1WIbQVhDlKxvOsgZp5CzdyhqG2hMhgKR2VPR/lGGHPapD6jkHGmfQ2mLV44Wmd3F55dEN+yLuJ",
+	"pEBrRX/zpO2fezqIuiffIk8+HYLNityZn6YPcVLj4KFq+UAHT7avY7jncsz1iVCHt340VRNtkyw1xpVN",
+	"FKA6Zt+JVO1EqnpSJ8wvEuPSF1PCpsCJk7pHObVIawUu5b+1LrqXy9gmipfA68yfHdGv5h0lsXw1Umca",
+	"+uPPazF/DWHkLbZ+NXDSlZrD7vlKxQKtLluwQ9FOum1RfEYFxsfRTcx7/CYGXZLFcezjo2cB9dZWmufP",
+	"3d99sfvClKFX85b6p+r6STWMR6h4tfiLmjdbh/ofIY1LNk+iArBK7x5guvMoAmpS8PvSl0P24xklAKwe",
+	"0gMb3XKM6Atnub0/xA8OyUjg4hOtq8509Lt7nhExkN1ZTU20YV81x8Qdcn3dNff0ioxyshAdTa0eaqLF",
+	"Jyfi2BNwdlFayKbC97+BYoQYl7qmLd5aulmNjyetnlw8BWgAMnX5rwAqqiqTgI46ro48t4g8UUdTOaK2",
+	"NKpoE//42uAhTq2Mzt/oQOpEc+QIW+dXbQi5ez5e1a39W8WOO+1kxXG6EpQmRWi7nzRqJZvriNcisnsS",
+	"mK3A5XXlVCncG7a7QkBgLkG2uVgtR1rTU6R0lGap4L0MsZVuk3IAklNaRhUl4ZSCpMW7aCujeNqkNFQL",
+	"7IIInziPj0BWDWMWjOHpNUlY7pTQQuT6HoLZFgxg62jrqWlLj5RbhrBcxD536monB24Fga1eFiwCwzWe",
+	"X2SILlDZpoVDJ45QFg87fmAVEJcjzgYx0al4KRxSsUqpIrx7Zdmw3pQtipVuAz0bCgZRuZ8VVHNfvJa7",
+	"eWHTJJ7PsApTvgR5UNalYKf37HGnMVXJmpnEkpURpVGpK464hdLEQtUYWzEumT7J6uqS5+Bsl9BooTxG",
+	"W8m5Lg3ksusd36B2O50DdrBJD6kq5PtMM0VTAWf0LIO0OrZafTnj33JBSqDBgsmRniwlkrbeVrmQugxI",
+	"XQakNWRAasWaBW9IHaxahZvciS0LX5pnpIL5FvjymrmcdJBaThTs+N1WiYA5Ki4qApbdAEfMT1ii3AB7",
+	"RsdA9CQjfjBPQr6ona+fvv7/T+NA0LtoBAA=",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -0,0 +1,71 @@
+package transformers
+
+import (
+	"encoding/json"
+	"math"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/pkg/repository/sqlcv1"
+)
+
+func ToV1Approval(approval *sqlcv1.V1Approval) gen.V1Approval {
+	approvalCtx := make(map[string]interface{})
+
+	if len(approval.Context) > 0 {
+		// nolint: errcheck
+		json.Unmarshal(approval.Context, &approvalCtx)
+	}
+
+	approvers := approval.Approvers
+
+	if approvers == nil {
+		approvers = []string{}
+	}
+
+	res := gen.V1Approval{
+		TaskExternalId:        approval.TaskExternalID,
+		WorkflowRunExternalId: approval.WorkflowRunID,
+		TaskName:              approval.TaskName,
+		Status:                gen.V1ApprovalStatus(approval.Status),
+		Approvers:             approvers,
+		Context:               approvalCtx,
+		OnExpiry:              gen.V1ApprovalExpiryAction(approval.OnExpiry),
+		ExpiresAt:             approval.ExpiresAt.Time,
+		CreatedAt:             approval.CreatedAt.Time,
+	}
+
+	if approval.Approver.Valid {
+		res.Approver = &approval.Approver.String
+	}
+
+	if approval.Comment.Valid {
+		res.Comment = &approval.Comment.String
+	}
+
+	if approval.ResolvedAt.Valid {
+		res.ResolvedAt = &approval.ResolvedAt.Time
+	}
+
+	return res
+}
+
+func ToV1ApprovalList(approvals []*sqlcv1.V1Approval, total, limit, offset int64) gen.V1ApprovalList {
+	rows := make([]gen.V1Approval, len(approvals))
+
+	for i, approval := range approvals {
+		rows[i] = ToV1Approval(approval)
+	}
+
+	currentPage := offset / limit
+	nextPage := currentPage + 1
+	totalPages := int64(math.Ceil(float64(total) / float64(limit)))
+
+	return gen.V1ApprovalList{
+		Rows: rows,
+		Pagination: &gen.PaginationResponse{
+			CurrentPage: &currentPage,
+			NextPage:    &nextPage,
+			NumPages:    &totalPages,
+		},
+	}
+}
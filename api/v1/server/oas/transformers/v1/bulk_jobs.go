@@ -0,0 +1,102 @@
+package transformers
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	v1 "github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/sqlcv1"
+)
+
+func ToV1BulkJob(job *sqlcv1.V1BulkJob, failures []*sqlcv1.V1BulkJobItem) gen.V1BulkJob {
+	res := gen.V1BulkJob{
+		Metadata: gen.APIResourceMeta{
+			CreatedAt: job.CreatedAt.Time,
+			UpdatedAt: job.UpdatedAt.Time,
+			Id:        job.ID.String(),
+		},
+		Kind:           gen.V1BulkJobKind(job.Kind),
+		Status:         gen.V1BulkJobStatus(job.Status),
+		IsEnumerated:   job.IsEnumerated,
+		TotalCount:     job.TotalCount,
+		ProcessedCount: job.ProcessedCount,
+		SucceededCount: job.SucceededCount,
+		FailedCount:    job.FailedCount,
+	}
+
+	if filter, err := v1.ParseBulkJobFilter(job); err == nil && filter != nil {
+		res.Filter = toV1TaskFilter(filter)
+	}
+
+	if job.Error.Valid {
+		res.Error = &job.Error.String
+	}
+
+	if job.FinishedAt.Valid {
+		res.FinishedAt = &job.FinishedAt.Time
+	}
+
+	if failures != nil {
+		items := make([]gen.V1BulkJobItemFailure, len(failures))
+
+		for i, item := range failures {
+			items[i] = gen.V1BulkJobItemFailure{
+				ExternalId: item.ExternalID,
+				Error:      item.Error.String,
+			}
+		}
+
+		res.Failures = &items
+	}
+
+	return res
+}
+
+func ToV1BulkJobList(jobs []*sqlcv1.V1BulkJob) gen.V1BulkJobList {
+	rows := make([]gen.V1BulkJob, len(jobs))
+
+	for i, job := range jobs {
+		rows[i] = ToV1BulkJob(job, nil)
+	}
+
+	return gen.V1BulkJobList{
+		Rows: rows,
+	}
+}
+
+func toV1TaskFilter(filter *v1.BulkJobFilter) *gen.V1TaskFilter {
+	res := &gen.V1TaskFilter{
+		Since: filter.Since,
+		Until: filter.Until,
+	}
+
+	if len(filter.Statuses) > 0 {
+		statuses := make([]gen.V1TaskStatus, len(filter.Statuses))
+
+		for i, status := range filter.Statuses {
+			statuses[i] = gen.V1TaskStatus(status)
+		}
+
+		res.Statuses = &statuses
+	}
+
+	if len(filter.WorkflowIds) > 0 {
+		workflowIds := filter.WorkflowIds
+		res.WorkflowIds = &workflowIds
+	}
+
+	if len(filter.AdditionalMetadata) > 0 {
+		additionalMetadata := make([]string, 0, len(filter.AdditionalMetadata))
+
+		for k, v := range filter.AdditionalMetadata {
+			additionalMetadata = append(additionalMetadata, fmt.Sprintf("%s:%s", k, v))
+		}
+
+		sort.Strings(additionalMetadata)
+
+		res.AdditionalMetadata = &additionalMetadata
+	}
+
+	return res
+}
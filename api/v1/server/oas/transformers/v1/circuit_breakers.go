@@ -0,0 +1,41 @@
+package transformers
+
+import (
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/pkg/repository/sqlcv1"
+)
+
+func ToV1CircuitBreaker(cb *sqlcv1.V1CircuitBreaker) gen.V1CircuitBreaker {
+	res := gen.V1CircuitBreaker{
+		Key:              cb.Key,
+		State:            gen.V1CircuitBreakerState(cb.State),
+		FailureCount:     cb.FailureCount,
+		FailureThreshold: cb.FailureThreshold,
+		WindowSeconds:    cb.WindowSeconds,
+		CooldownSeconds:  cb.CooldownSeconds,
+		WindowStartedAt:  cb.WindowStartedAt.Time,
+		UpdatedAt:        cb.UpdatedAt.Time,
+	}
+
+	if cb.OpenedAt.Valid {
+		res.OpenedAt = &cb.OpenedAt.Time
+	}
+
+	if cb.ProbeStartedAt.Valid {
+		res.ProbeStartedAt = &cb.ProbeStartedAt.Time
+	}
+
+	return res
+}
+
+func ToV1CircuitBreakerList(breakers []*sqlcv1.V1CircuitBreaker) gen.V1CircuitBreakerList {
+	rows := make([]gen.V1CircuitBreaker, len(breakers))
+
+	for i, cb := range breakers {
+		rows[i] = ToV1CircuitBreaker(cb)
+	}
+
+	return gen.V1CircuitBreakerList{
+		Rows: rows,
+	}
+}
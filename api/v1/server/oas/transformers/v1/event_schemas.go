@@ -0,0 +1,28 @@
+package transformers
+
+import (
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/pkg/repository/sqlcv1"
+)
+
+func ToV1EventSchema(schema *sqlcv1.V1EventSchema) gen.V1EventSchema {
+	return gen.V1EventSchema{
+		EventKey:  schema.EventKey,
+		Version:   schema.Version,
+		Schema:    jsonToMap(schema.Schema),
+		Mode:      gen.V1EventSchemaMode(schema.Mode),
+		CreatedAt: schema.CreatedAt.Time,
+	}
+}
+
+func ToV1EventSchemaList(schemas []*sqlcv1.V1EventSchema) gen.V1EventSchemaList {
+	rows := make([]gen.V1EventSchema, len(schemas))
+
+	for i, schema := range schemas {
+		rows[i] = ToV1EventSchema(schema)
+	}
+
+	return gen.V1EventSchemaList{
+		Rows: rows,
+	}
+}
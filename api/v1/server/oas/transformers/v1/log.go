@@ -31,6 +31,7 @@ func ToV1LogLine(log *v1.ListLogLineRow) *gen.V1LogLine {
 		Level:           &level,
 		TaskExternalId:  &log.TaskExternalId,
 		TaskDisplayName: &log.TaskDisplayName,
+		WorkerId:        log.WorkerID,
 	}
 
 	if log.Metadata != nil {
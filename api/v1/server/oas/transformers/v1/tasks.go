@@ -563,3 +563,23 @@ func ToCancelledOrReplayedTaskResponse(ids []string) gen.V1ReplayedTasks {
 		Ids: &idUuids,
 	}
 }
+
+func ToSlotUsageList(rows []*sqlcv1.ListTaskSlotUsageRow, groupBy gen.V1SlotUsageGroupBy, since, until time.Time) gen.V1SlotUsageList {
+	toReturn := make([]gen.V1SlotUsage, len(rows))
+
+	for i, row := range rows {
+		toReturn[i] = gen.V1SlotUsage{
+			Key:          row.Key,
+			SlotType:     row.SlotType,
+			SlotSeconds:  row.SlotSeconds,
+			TaskAttempts: row.TaskAttempts,
+		}
+	}
+
+	return gen.V1SlotUsageList{
+		GroupBy: groupBy,
+		Rows:    toReturn,
+		Since:   since,
+		Until:   until,
+	}
+}
@@ -0,0 +1,51 @@
+package transformers
+
+import (
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/pkg/repository/sqlcv1"
+)
+
+func ToV1WorkerCordon(row *sqlcv1.ListWorkerCordonsRow) gen.V1WorkerCordon {
+	cordon := row.V1WorkerCordon
+
+	res := gen.V1WorkerCordon{
+		WorkerId:     cordon.WorkerID,
+		WorkerName:   row.WorkerName,
+		Status:       gen.CORDONED,
+		CordonedAt:   cordon.CordonedAt.Time,
+		RunningTasks: row.RunningTasks,
+	}
+
+	if cordon.Reason.Valid {
+		res.Reason = &cordon.Reason.String
+	}
+
+	if cordon.DrainDeadline.Valid {
+		res.Status = gen.DRAINING
+		res.DrainDeadline = &cordon.DrainDeadline.Time
+	}
+
+	if cordon.DrainAction.Valid {
+		action := gen.V1WorkerDrainAction(cordon.DrainAction.V1WorkerDrainAction)
+		res.DrainAction = &action
+	}
+
+	if cordon.DrainedAt.Valid {
+		res.Status = gen.DRAINED
+		res.DrainedAt = &cordon.DrainedAt.Time
+	}
+
+	return res
+}
+
+func ToV1WorkerCordonList(rows []*sqlcv1.ListWorkerCordonsRow) gen.V1WorkerCordonList {
+	res := make([]gen.V1WorkerCordon, len(rows))
+
+	for i, row := range rows {
+		res[i] = ToV1WorkerCordon(row)
+	}
+
+	return gen.V1WorkerCordonList{
+		Rows: res,
+	}
+}
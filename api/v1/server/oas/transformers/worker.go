@@ -34,6 +34,7 @@ func ToWorkerLabels(labels []*sqlcv1.ListWorkerLabelsRow) *[]gen.WorkerLabel {
 			Metadata: *toAPIMetadata(uuid.Nil, labels[i].CreatedAt.Time, labels[i].UpdatedAt.Time),
 			Key:      labels[i].Key,
 			Value:    value,
+			Dynamic:  &labels[i].IsDynamic,
 		}
 	}
 
@@ -30,8 +30,12 @@ import (
 	stepruns "github.com/hatchet-dev/hatchet/api/v1/server/handlers/step-runs"
 	"github.com/hatchet-dev/hatchet/api/v1/server/handlers/tenants"
 	"github.com/hatchet-dev/hatchet/api/v1/server/handlers/users"
+	approvalsv1 "github.com/hatchet-dev/hatchet/api/v1/server/handlers/v1/approvals"
+	bulkjobsv1 "github.com/hatchet-dev/hatchet/api/v1/server/handlers/v1/bulk-jobs"
 	celv1 "github.com/hatchet-dev/hatchet/api/v1/server/handlers/v1/cel"
+	circuitbreakersv1 "github.com/hatchet-dev/hatchet/api/v1/server/handlers/v1/circuit-breakers"
 	durabletasksv1 "github.com/hatchet-dev/hatchet/api/v1/server/handlers/v1/durable-tasks"
+	eventschemasv1 "github.com/hatchet-dev/hatchet/api/v1/server/handlers/v1/event-schemas"
 	eventsv1 "github.com/hatchet-dev/hatchet/api/v1/server/handlers/v1/events"
 	featureflagsv1 "github.com/hatchet-dev/hatchet/api/v1/server/handlers/v1/feature-flags"
 	filtersv1 "github.com/hatchet-dev/hatchet/api/v1/server/handlers/v1/filters"
@@ -39,8 +43,11 @@ import (
 	"github.com/hatchet-dev/hatchet/api/v1/server/handlers/v1/observability"
 	operatorsv1 "github.com/hatchet-dev/hatchet/api/v1/server/handlers/v1/operators"
 	"github.com/hatchet-dev/hatchet/api/v1/server/handlers/v1/tasks"
+	tenantbundlesv1 "github.com/hatchet-dev/hatchet/api/v1/server/handlers/v1/tenant-bundles"
 	webhooksv1 "github.com/hatchet-dev/hatchet/api/v1/server/handlers/v1/webhooks"
+	workercordonsv1 "github.com/hatchet-dev/hatchet/api/v1/server/handlers/v1/worker-cordons"
 	workflowrunsv1 "github.com/hatchet-dev/hatchet/api/v1/server/handlers/v1/workflow-runs"
+	workflowspecsv1 "github.com/hatchet-dev/hatchet/api/v1/server/handlers/v1/workflow-specs"
 	webhookworker "github.com/hatchet-dev/hatchet/api/v1/server/handlers/webhook-worker"
 	"github.com/hatchet-dev/hatchet/api/v1/server/handlers/workers"
 	workflowruns "github.com/hatchet-dev/hatchet/api/v1/server/handlers/workflow-runs"
@@ -81,6 +88,13 @@ type apiService struct {
 	*operatorsv1.V1OperatorsService
 	*webhooksv1.V1WebhooksService
 	*celv1.V1CELService
+	*bulkjobsv1.V1BulkJobsService
+	*circuitbreakersv1.V1CircuitBreakersService
+	*eventschemasv1.V1EventSchemasService
+	*tenantbundlesv1.V1TenantBundlesService
+	*workercordonsv1.V1WorkerCordonsService
+	*approvalsv1.V1ApprovalsService
+	*workflowspecsv1.V1WorkflowSpecsService
 	*observability.V1ObservabilityService
 	*featureflagsv1.V1FeatureFlagsService
 	*durabletasksv1.DurableTasksService
@@ -88,32 +102,39 @@ type apiService struct {
 
 func newAPIService(config *server.ServerConfig) *apiService {
 	return &apiService{
-		UserService:            users.NewUserService(config),
-		TenantService:          tenants.NewTenantService(config),
-		EventService:           events.NewEventService(config),
-		RateLimitService:       rate_limits.NewRateLimitService(config),
-		LogsService:            logs.NewLogsService(config),
-		WorkflowService:        workflows.NewWorkflowService(config),
-		WorkflowRunsService:    workflowruns.NewWorkflowRunsService(config),
-		WorkerService:          workers.NewWorkerService(config),
-		MetadataService:        metadata.NewMetadataService(config),
-		APITokenService:        apitokens.NewAPITokenService(config),
-		StepRunService:         stepruns.NewStepRunService(config),
-		IngestorsService:       ingestors.NewIngestorsService(config),
-		SlackAppService:        slackapp.NewSlackAppService(config),
-		WebhookWorkersService:  webhookworker.NewWebhookWorkersService(config),
-		MonitoringService:      monitoring.NewMonitoringService(config),
-		InfoService:            info.NewInfoService(config),
-		TasksService:           tasks.NewTasksService(config),
-		V1WorkflowRunsService:  workflowrunsv1.NewV1WorkflowRunsService(config),
-		V1EventsService:        eventsv1.NewV1EventsService(config),
-		V1FiltersService:       filtersv1.NewV1FiltersService(config),
-		V1OperatorsService:     operatorsv1.NewV1OperatorsService(config),
-		V1WebhooksService:      webhooksv1.NewV1WebhooksService(config),
-		V1CELService:           celv1.NewV1CELService(config),
-		V1ObservabilityService: observability.NewV1ObservabilityService(config),
-		V1FeatureFlagsService:  featureflagsv1.NewV1FeatureFlagsService(config),
-		DurableTasksService:    durabletasksv1.NewDurableTasksService(config),
+		UserService:              users.NewUserService(config),
+		TenantService:            tenants.NewTenantService(config),
+		EventService:             events.NewEventService(config),
+		RateLimitService:         rate_limits.NewRateLimitService(config),
+		LogsService:              logs.NewLogsService(config),
+		WorkflowService:          workflows.NewWorkflowService(config),
+		WorkflowRunsService:      workflowruns.NewWorkflowRunsService(config),
+		WorkerService:            workers.NewWorkerService(config),
+		MetadataService:          metadata.NewMetadataService(config),
+		APITokenService:          apitokens.NewAPITokenService(config),
+		StepRunService:           stepruns.NewStepRunService(config),
+		IngestorsService:         ingestors.NewIngestorsService(config),
+		SlackAppService:          slackapp.NewSlackAppService(config),
+		WebhookWorkersService:    webhookworker.NewWebhookWorkersService(config),
+		MonitoringService:        monitoring.NewMonitoringService(config),
+		InfoService:              info.NewInfoService(config),
+		TasksService:             tasks.NewTasksService(config),
+		V1WorkflowRunsService:    workflowrunsv1.NewV1WorkflowRunsService(config),
+		V1EventsService:          eventsv1.NewV1EventsService(config),
+		V1FiltersService:         filtersv1.NewV1FiltersService(config),
+		V1OperatorsService:       operatorsv1.NewV1OperatorsService(config),
+		V1WebhooksService:        webhooksv1.NewV1WebhooksService(config),
+		V1CELService:             celv1.NewV1CELService(config),
+		V1BulkJobsService:        bulkjobsv1.NewV1BulkJobsService(config),
+		V1CircuitBreakersService: circuitbreakersv1.NewV1CircuitBreakersService(config),
+		V1EventSchemasService:    eventschemasv1.NewV1EventSchemasService(config),
+		V1TenantBundlesService:   tenantbundlesv1.NewV1TenantBundlesService(config),
+		V1WorkerCordonsService:   workercordonsv1.NewV1WorkerCordonsService(config),
+		V1ApprovalsService:       approvalsv1.NewV1ApprovalsService(config),
+		V1WorkflowSpecsService:   workflowspecsv1.NewV1WorkflowSpecsService(config),
+		V1ObservabilityService:   observability.NewV1ObservabilityService(config),
+		V1FeatureFlagsService:    featureflagsv1.NewV1FeatureFlagsService(config),
+		DurableTasksService:      durabletasksv1.NewDurableTasksService(config),
 	}
 }
 
@@ -635,6 +656,32 @@ func (t *APIServer) registerSpec(g *echo.Group, spec *openapi3.T) (*populator.Po
 		return workflowRun, workflowRun.WorkflowRun.TenantID.String(), nil
 	})
 
+	populatorMW.RegisterGetter("v1-bulk-job", func(config *server.ServerConfig, parentId, id string) (result interface{}, uniqueParentId string, err error) {
+		idUuid, err := uuid.Parse(id)
+
+		if err != nil {
+			return nil, "", echo.NewHTTPError(http.StatusBadRequest, "invalid bulk job id")
+		}
+
+		parentIdUuid, err := uuid.Parse(parentId)
+
+		if err != nil {
+			return nil, "", echo.NewHTTPError(http.StatusBadRequest, "invalid tenant id")
+		}
+
+		job, err := t.config.V1.BulkJobs().GetBulkJob(
+			context.Background(),
+			parentIdUuid,
+			idUuid,
+		)
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return job, job.TenantID.String(), nil
+	})
+
 	populatorMW.RegisterGetter("v1-filter", func(config *server.ServerConfig, parentId, id string) (result interface{}, uniqueParentId string, err error) {
 		idUuid, err := uuid.Parse(id)
 
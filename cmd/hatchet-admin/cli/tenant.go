@@ -0,0 +1,305 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+
+	"github.com/google/uuid"
+	"github.com/spf13/cobra"
+
+	"github.com/hatchet-dev/hatchet/pkg/config/loader"
+	"github.com/hatchet-dev/hatchet/pkg/config/server"
+	"github.com/hatchet-dev/hatchet/pkg/tenantbundle"
+)
+
+// bundlePassphraseEnv is the environment variable the bundle passphrase is read from when
+// --passphrase isn't set, so it doesn't end up in the shell history.
+const bundlePassphraseEnv = "HATCHET_BUNDLE_PASSPHRASE"
+
+var (
+	tenantBundleTenantIdStr   string
+	tenantBundleFile          string
+	tenantBundlePassphrase    string
+	tenantBundleDryRun        bool
+	tenantBundleOnConflictStr string
+)
+
+var tenantCmd = &cobra.Command{
+	Use:   "tenant",
+	Short: "command for managing tenants.",
+}
+
+var tenantExportCmd = &cobra.Command{
+	Use:   "export",
+	Short: "export the configuration of a tenant to a bundle.",
+	Long: `Exports the workflows, crons, scheduled runs, filters, webhooks, rate limits, operators, event schemas,
+alerting settings and resource limits of a tenant to a JSON bundle, which can be imported into a tenant of
+another environment or deployment with [tenant import].
+
+The secrets of webhooks and operators are only exported if a passphrase is set with --passphrase or the
+` + bundlePassphraseEnv + ` environment variable, and are encrypted with it.`,
+	Example: `  hatchet-admin tenant export --tenant-id <tenant-id> --file staging.json
+  HATCHET_BUNDLE_PASSPHRASE=... hatchet-admin tenant export --tenant-id <tenant-id> > staging.json`,
+	Run: func(cmd *cobra.Command, args []string) {
+		err := runTenantExport()
+
+		if err != nil {
+			log.Printf("Fatal: could not run [tenant export] command: %v", err)
+			os.Exit(1)
+		}
+	},
+}
+
+var tenantImportCmd = &cobra.Command{
+	Use:   "import",
+	Short: "import a bundle into a tenant.",
+	Long: `Imports a bundle exported with [tenant export] into a tenant. Resources which already exist with the same
+configuration are left unchanged, and resources which aren't in the bundle are kept.
+
+--on-conflict determines what happens to resources which exist with a different configuration: skip keeps
+them, overwrite replaces them, and fail aborts the import before anything is imported. Use --dry-run to see
+what would be imported.`,
+	Example: `  hatchet-admin tenant import --tenant-id <tenant-id> --file staging.json --dry-run
+  hatchet-admin tenant import --tenant-id <tenant-id> --file staging.json --on-conflict overwrite`,
+	Run: func(cmd *cobra.Command, args []string) {
+		err := runTenantImport()
+
+		if err != nil {
+			log.Printf("Fatal: could not run [tenant import] command: %v", err)
+			os.Exit(1)
+		}
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(tenantCmd)
+	tenantCmd.AddCommand(tenantExportCmd)
+	tenantCmd.AddCommand(tenantImportCmd)
+
+	tenantCmd.PersistentFlags().StringVar(
+		&tenantBundleTenantIdStr,
+		"tenant-id",
+		"",
+		"the tenant ID to export or import",
+	)
+
+	tenantCmd.PersistentFlags().StringVarP(
+		&tenantBundleFile,
+		"file",
+		"f",
+		"-",
+		"the bundle file to write or read, - for stdout or stdin",
+	)
+
+	tenantCmd.PersistentFlags().StringVar(
+		&tenantBundlePassphrase,
+		"passphrase",
+		"",
+		"the passphrase the secrets in the bundle are encrypted with, defaults to $"+bundlePassphraseEnv,
+	)
+
+	tenantImportCmd.PersistentFlags().BoolVar(
+		&tenantBundleDryRun,
+		"dry-run",
+		false,
+		"show what would be imported without importing it",
+	)
+
+	tenantImportCmd.PersistentFlags().StringVar(
+		&tenantBundleOnConflictStr,
+		"on-conflict",
+		"skip",
+		"what happens to resources which exist with a different configuration: skip, overwrite or fail",
+	)
+
+	_ = tenantCmd.MarkPersistentFlagRequired("tenant-id")
+}
+
+func bundlePassphrase() string {
+	if tenantBundlePassphrase != "" {
+		return tenantBundlePassphrase
+	}
+
+	return os.Getenv(bundlePassphraseEnv)
+}
+
+// loadServerForTenantBundle loads the server config without the message queue, since bundles are
+// read from and written to the database directly.
+func loadServerForTenantBundle() (*server.ServerConfig, func() error, error) {
+	configLoader := loader.NewConfigLoader(configDirectory)
+
+	cleanup, srv, err := configLoader.CreateServerFromConfig("", func(scf *server.ServerConfigFile) {
+		// disable rabbitmq since it's not needed to export or import
+		scf.MessageQueue.Enabled = false
+
+		// disable security checks since we're not running the server
+		scf.SecurityCheck.Enabled = false
+	})
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return srv, cleanup, nil
+}
+
+func runTenantExport() error {
+	tenantId, err := uuid.Parse(strings.TrimSpace(tenantBundleTenantIdStr))
+
+	if err != nil {
+		return fmt.Errorf("parse --tenant-id: %w", err)
+	}
+
+	srv, cleanup, err := loadServerForTenantBundle()
+
+	if err != nil {
+		return err
+	}
+
+	defer cleanup() // nolint:errcheck
+
+	defer srv.Disconnect() // nolint:errcheck
+
+	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer cancel()
+
+	passphrase := bundlePassphrase()
+
+	bundle, warnings, err := tenantbundle.NewExporter(srv.V1, srv.Encryption).Export(ctx, tenantId, tenantbundle.ExportOpts{
+		Passphrase: passphrase,
+	})
+
+	if err != nil {
+		return err
+	}
+
+	for _, warning := range warnings {
+		log.Printf("Warning: %s", warning)
+	}
+
+	if passphrase == "" && (len(bundle.Webhooks) > 0 || len(bundle.Operators) > 0) {
+		log.Printf("Warning: no passphrase was set, so the secrets of webhooks and operators were not exported")
+	}
+
+	data, err := json.MarshalIndent(bundle, "", "  ")
+
+	if err != nil {
+		return err
+	}
+
+	data = append(data, '\n')
+
+	if tenantBundleFile == "-" {
+		_, err = os.Stdout.Write(data)
+		return err
+	}
+
+	if err := os.WriteFile(tenantBundleFile, data, 0600); err != nil {
+		return fmt.Errorf("could not write bundle: %w", err)
+	}
+
+	log.Printf("exported %d workflows, %d crons, %d scheduled runs, %d filters, %d webhooks, %d rate limits, %d operators and %d event schemas to %s",
+		len(bundle.Workflows), len(bundle.Crons), len(bundle.ScheduledRuns), len(bundle.Filters), len(bundle.Webhooks),
+		len(bundle.RateLimits), len(bundle.Operators), len(bundle.EventSchemas), tenantBundleFile,
+	)
+
+	return nil
+}
+
+func runTenantImport() error {
+	tenantId, err := uuid.Parse(strings.TrimSpace(tenantBundleTenantIdStr))
+
+	if err != nil {
+		return fmt.Errorf("parse --tenant-id: %w", err)
+	}
+
+	strategy := tenantbundle.ConflictStrategy(strings.ToUpper(strings.TrimSpace(tenantBundleOnConflictStr)))
+
+	var data []byte
+
+	if tenantBundleFile == "-" {
+		data, err = io.ReadAll(os.Stdin)
+	} else {
+		data, err = os.ReadFile(tenantBundleFile) // nolint: gosec
+	}
+
+	if err != nil {
+		return fmt.Errorf("could not read bundle: %w", err)
+	}
+
+	var bundle tenantbundle.Bundle
+
+	if err := json.Unmarshal(data, &bundle); err != nil {
+		return fmt.Errorf("could not decode bundle: %w", err)
+	}
+
+	srv, cleanup, err := loadServerForTenantBundle()
+
+	if err != nil {
+		return err
+	}
+
+	defer cleanup() // nolint:errcheck
+
+	defer srv.Disconnect() // nolint:errcheck
+
+	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer cancel()
+
+	res, err := tenantbundle.NewImporter(srv.V1, srv.Encryption).Import(ctx, tenantId, &bundle, tenantbundle.ImportOpts{
+		Passphrase:       bundlePassphrase(),
+		ConflictStrategy: strategy,
+		DryRun:           tenantBundleDryRun,
+
+		ImportResourceLimits: true,
+	})
+
+	if res != nil {
+		printImportResult(res)
+	}
+
+	if errors.Is(err, tenantbundle.ErrConflicts) {
+		return fmt.Errorf("%w, nothing was imported", err)
+	}
+
+	return err
+}
+
+func printImportResult(res *tenantbundle.ImportResult) {
+	for _, item := range res.Items {
+		if item.Action == tenantbundle.ActionUnchanged {
+			continue
+		}
+
+		line := fmt.Sprintf("%-9s %s %s", strings.ToLower(string(item.Action)), strings.ReplaceAll(item.Resource, "_", " "), item.Name)
+
+		if item.Reason != "" {
+			line += " (" + item.Reason + ")"
+		}
+
+		fmt.Println(line)
+	}
+
+	prefix := "imported"
+
+	if res.DryRun {
+		prefix = "dry run, would import"
+	}
+
+	fmt.Printf("%s: %d created, %d updated, %d unchanged, %d skipped, %d conflicts\n",
+		prefix,
+		res.Count(tenantbundle.ActionCreate),
+		res.Count(tenantbundle.ActionUpdate),
+		res.Count(tenantbundle.ActionUnchanged),
+		res.Count(tenantbundle.ActionSkip),
+		res.Count(tenantbundle.ActionConflict),
+	)
+}
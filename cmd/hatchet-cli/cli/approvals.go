@@ -0,0 +1,206 @@
+package cli
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/spf13/cobra"
+
+	"github.com/hatchet-dev/hatchet/cmd/hatchet-cli/cli/internal/config/cli"
+	"github.com/hatchet-dev/hatchet/cmd/hatchet-cli/cli/internal/styles"
+	"github.com/hatchet-dev/hatchet/pkg/client/rest"
+)
+
+var approvalsCmd = &cobra.Command{
+	Use:     "approvals",
+	Aliases: []string{"approval"},
+	Short:   "Manage approvals",
+	Long:    `Commands for listing the approvals requested by approval tasks, and approving or rejecting them.`,
+	Run:     func(cmd *cobra.Command, args []string) { _ = cmd.Help() },
+}
+
+var approvalsListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List approvals",
+	Long:  `List the approvals requested by approval tasks, most recent first. Only pending approvals are listed unless --status is set.`,
+	Example: `  # List pending approvals
+  hatchet approvals list
+
+  # List resolved approvals as JSON
+  hatchet approvals list --status APPROVED --status REJECTED -o json`,
+	Run: func(cmd *cobra.Command, args []string) {
+		statusStrs, _ := cmd.Flags().GetStringSlice("status")
+		limit, _ := cmd.Flags().GetInt64("limit")
+		offset, _ := cmd.Flags().GetInt64("offset")
+
+		statuses := make([]rest.V1ApprovalStatus, 0, len(statusStrs))
+		for _, s := range statusStrs {
+			statuses = append(statuses, rest.V1ApprovalStatus(strings.ToUpper(strings.TrimSpace(s))))
+		}
+
+		if len(statuses) == 0 {
+			statuses = append(statuses, rest.V1ApprovalStatusPENDING)
+		}
+
+		_, hatchetClient := clientFromCmd(cmd)
+
+		resp, err := hatchetClient.API().V1ApprovalListWithResponse(cmd.Context(), clientTenantUUID(hatchetClient), &rest.V1ApprovalListParams{
+			Statuses: &statuses,
+			Limit:    &limit,
+			Offset:   &offset,
+		})
+		if err != nil {
+			cli.Logger.Fatalf("failed to list approvals: %v", err)
+		}
+		if resp.JSON400 != nil {
+			cli.Logger.Fatalf("failed to list approvals: %s", apiErrorsMessage(resp.JSON400))
+		}
+		if resp.JSON200 == nil {
+			cli.Logger.Fatalf("unexpected response from API (status %d)", resp.StatusCode())
+		}
+
+		if isJSONOutput(cmd) {
+			printJSON(resp.JSON200)
+			return
+		}
+
+		if len(resp.JSON200.Rows) == 0 {
+			fmt.Println(styles.InfoMessage("No approvals found"))
+			return
+		}
+
+		for i, approval := range resp.JSON200.Rows {
+			if i > 0 {
+				fmt.Println()
+			}
+
+			printApproval(&approval)
+		}
+	},
+}
+
+var approvalsApproveCmd = &cobra.Command{
+	Use:   "approve <task-id>",
+	Short: "Approve a pending approval",
+	Long: `Approve the pending approval of an approval task, which completes the task with approved set to true.
+With an API token, only approvals without approvers can be resolved, and the token is recorded as the approver.`,
+	Args: cobra.ExactArgs(1),
+	Example: `  # Approve with a comment
+  hatchet approvals approve 8ff4f149-099e-4c16-a8d1-0535f8c79b83 --comment "looks good"`,
+	Run: func(cmd *cobra.Command, args []string) {
+		resolveApproval(cmd, args[0], true)
+	},
+}
+
+var approvalsRejectCmd = &cobra.Command{
+	Use:   "reject <task-id>",
+	Short: "Reject a pending approval",
+	Long: `Reject the pending approval of an approval task, which completes the task with approved set to false.
+With an API token, only approvals without approvers can be resolved, and the token is recorded as the approver.`,
+	Args: cobra.ExactArgs(1),
+	Example: `  # Reject with a comment
+  hatchet approvals reject 8ff4f149-099e-4c16-a8d1-0535f8c79b83 --comment "wrong amount"`,
+	Run: func(cmd *cobra.Command, args []string) {
+		resolveApproval(cmd, args[0], false)
+	},
+}
+
+func resolveApproval(cmd *cobra.Command, taskIdStr string, approved bool) {
+	taskUUID, err := uuid.Parse(taskIdStr)
+	if err != nil {
+		cli.Logger.Fatalf("invalid task ID %q: %v", taskIdStr, err)
+	}
+
+	comment, _ := cmd.Flags().GetString("comment")
+
+	req := rest.V1ResolveApprovalRequest{
+		Approved: approved,
+	}
+
+	if comment != "" {
+		req.Comment = &comment
+	}
+
+	_, hatchetClient := clientFromCmd(cmd)
+
+	resp, err := hatchetClient.API().V1ApprovalResolveWithResponse(cmd.Context(), clientTenantUUID(hatchetClient), taskUUID, req)
+	if err != nil {
+		cli.Logger.Fatalf("failed to resolve approval: %v", err)
+	}
+
+	for _, apiErrs := range []*rest.APIErrors{resp.JSON400, resp.JSON403, resp.JSON404, resp.JSON409} {
+		if apiErrs != nil {
+			cli.Logger.Fatalf("approval was not resolved: %s", apiErrorsMessage(apiErrs))
+		}
+	}
+
+	if resp.JSON200 == nil {
+		cli.Logger.Fatalf("unexpected response from API (status %d)", resp.StatusCode())
+	}
+
+	if isJSONOutput(cmd) {
+		printJSON(resp.JSON200)
+		return
+	}
+
+	verb := "Rejected"
+	if approved {
+		verb = "Approved"
+	}
+
+	fmt.Println(styles.SuccessMessage(fmt.Sprintf("%s task %s", verb, taskUUID)))
+	printApproval(resp.JSON200)
+}
+
+func printApproval(approval *rest.V1Approval) {
+	fmt.Println(styles.KeyValue("Task", fmt.Sprintf("%s (%s)", approval.TaskName, approval.TaskExternalId)))
+	fmt.Println(styles.KeyValue("Workflow run", approval.WorkflowRunExternalId.String()))
+	fmt.Println(styles.KeyValue("Status", string(approval.Status)))
+
+	if len(approval.Approvers) > 0 {
+		fmt.Println(styles.KeyValue("Approvers", strings.Join(approval.Approvers, ", ")))
+	} else {
+		fmt.Println(styles.KeyValue("Approvers", "any tenant member"))
+	}
+
+	if approval.Status == rest.V1ApprovalStatusPENDING {
+		fmt.Println(styles.KeyValue("Expires", fmt.Sprintf("%s (then %s)", approval.ExpiresAt.Local().Format(time.RFC1123), strings.ToLower(string(approval.OnExpiry)))))
+	}
+
+	if approval.Approver != nil {
+		fmt.Println(styles.KeyValue("Resolved by", *approval.Approver))
+	}
+
+	if approval.Comment != nil {
+		fmt.Println(styles.KeyValue("Comment", *approval.Comment))
+	}
+
+	keys := make([]string, 0, len(approval.Context))
+	for k := range approval.Context {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		fmt.Println(styles.Muted.Render(fmt.Sprintf("  %s: %v", k, approval.Context[k])))
+	}
+}
+
+func init() {
+	rootCmd.AddCommand(approvalsCmd)
+	approvalsCmd.AddCommand(approvalsListCmd, approvalsApproveCmd, approvalsRejectCmd)
+
+	approvalsCmd.PersistentFlags().StringP("profile", "p", "", "Profile to use for connecting to Hatchet (default: prompts for selection)")
+	approvalsCmd.PersistentFlags().StringP("output", "o", "", "Output format: json")
+
+	approvalsListCmd.Flags().StringSlice("status", nil, "Filter by status: PENDING, APPROVED, REJECTED, EXPIRED or CANCELLED (default: PENDING)")
+	approvalsListCmd.Flags().Int64("limit", 50, "Number of results to return")
+	approvalsListCmd.Flags().Int64("offset", 0, "Offset for pagination")
+
+	for _, c := range []*cobra.Command{approvalsApproveCmd, approvalsRejectCmd} {
+		c.Flags().StringP("comment", "m", "", "A comment recorded with the decision")
+	}
+}
@@ -0,0 +1,244 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbles/progress"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/google/uuid"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+
+	"github.com/hatchet-dev/hatchet/cmd/hatchet-cli/cli/internal/config/cli"
+	"github.com/hatchet-dev/hatchet/cmd/hatchet-cli/cli/internal/styles"
+	"github.com/hatchet-dev/hatchet/pkg/client" //nolint:staticcheck
+	"github.com/hatchet-dev/hatchet/pkg/client/rest"
+)
+
+const bulkJobPollInterval = time.Second
+
+// the maximum number of failed runs printed once a bulk job finishes
+const maxBulkJobFailuresPrinted = 10
+
+// runBulkJob submits a bulk job for the runs matching the filter. In JSON mode the created job is
+// printed straight away, otherwise the job is followed with a progress bar until it finishes.
+func runBulkJob(ctx context.Context, hatchetClient client.Client, tenantUUID openapi_types.UUID, kind rest.V1BulkJobKind, filter *rest.V1TaskFilter, isJSON bool) { //nolint:staticcheck
+	resp, err := hatchetClient.API().V1BulkJobCreateWithResponse(ctx, tenantUUID, rest.V1CreateBulkJobRequest{
+		Kind:   kind,
+		Filter: filter,
+	})
+	if err != nil {
+		cli.Logger.Fatalf("failed to create bulk job: %v", err)
+	}
+	if resp.JSON200 == nil {
+		cli.Logger.Fatalf("unexpected response from API (status %d)", resp.StatusCode())
+	}
+
+	if isJSON {
+		printJSON(resp.JSON200)
+		return
+	}
+
+	job, interrupted := watchBulkJob(ctx, hatchetClient, tenantUUID, resp.JSON200)
+
+	if interrupted {
+		fmt.Println(styles.InfoMessage(fmt.Sprintf("The bulk job is still running in the background (id %s)", job.Metadata.Id)))
+		return
+	}
+
+	printBulkJobSummary(job)
+}
+
+func watchBulkJob(ctx context.Context, hatchetClient client.Client, tenantUUID openapi_types.UUID, job *rest.V1BulkJob) (*rest.V1BulkJob, bool) { //nolint:staticcheck
+	jobUUID, err := uuid.Parse(job.Metadata.Id)
+	if err != nil {
+		cli.Logger.Fatalf("invalid bulk job id %q: %v", job.Metadata.Id, err)
+	}
+
+	fetch := func() (*rest.V1BulkJob, error) {
+		resp, err := hatchetClient.API().V1BulkJobGetWithResponse(ctx, tenantUUID, jobUUID)
+		if err != nil {
+			return nil, err
+		}
+		if resp.JSON200 == nil {
+			return nil, fmt.Errorf("unexpected response from API (status %d)", resp.StatusCode())
+		}
+		return resp.JSON200, nil
+	}
+
+	m := bulkJobModel{
+		fetch: fetch,
+		job:   job,
+		progress: progress.New(
+			progress.WithScaledGradient(string(styles.Blue), string(styles.Cyan)),
+			progress.WithWidth(80),
+		),
+	}
+
+	final, err := tea.NewProgram(m).Run()
+
+	if err != nil {
+		// no TTY to render to, so poll quietly until the job finishes
+		for !bulkJobFinished(job) {
+			time.Sleep(bulkJobPollInterval)
+
+			if job, err = fetch(); err != nil {
+				cli.Logger.Fatalf("failed to get bulk job: %v", err)
+			}
+		}
+
+		return job, false
+	}
+
+	fm := final.(bulkJobModel)
+
+	if fm.err != nil {
+		cli.Logger.Fatalf("failed to get bulk job: %v", fm.err)
+	}
+
+	return fm.job, fm.interrupted
+}
+
+func bulkJobFinished(job *rest.V1BulkJob) bool {
+	return job.Status == rest.V1BulkJobStatusCOMPLETED || job.Status == rest.V1BulkJobStatusFAILED
+}
+
+func printBulkJobSummary(job *rest.V1BulkJob) {
+	if job.Status == rest.V1BulkJobStatusFAILED {
+		reason := "unknown error"
+		if job.Error != nil {
+			reason = *job.Error
+		}
+		cli.Logger.Fatalf("bulk job failed: %s", reason)
+	}
+
+	fmt.Println(styles.SuccessMessage(fmt.Sprintf("%s %d run(s)", bulkJobPastTense(job.Kind), job.SucceededCount)))
+
+	if job.FailedCount == 0 {
+		return
+	}
+
+	fmt.Println(styles.Muted.Render(fmt.Sprintf("%d run(s) could not be processed:", job.FailedCount)))
+
+	if job.Failures == nil {
+		return
+	}
+
+	for i, failure := range *job.Failures {
+		if i == maxBulkJobFailuresPrinted {
+			break
+		}
+		fmt.Printf("  %s  %s\n", failure.ExternalId, styles.Muted.Render(failure.Error))
+	}
+}
+
+func bulkJobPastTense(kind rest.V1BulkJobKind) string {
+	switch kind {
+	case rest.V1BulkJobKindCANCEL:
+		return "Cancelled"
+	case rest.V1BulkJobKindREPLAY:
+		return "Replayed"
+	case rest.V1BulkJobKindDELETE:
+		return "Deleted"
+	default:
+		return "Processed"
+	}
+}
+
+type bulkJobPolledMsg struct {
+	job *rest.V1BulkJob
+	err error
+}
+
+// bulkJobModel renders a progress bar for a bulk job, polling the API until the job finishes
+type bulkJobModel struct {
+	fetch       func() (*rest.V1BulkJob, error)
+	job         *rest.V1BulkJob
+	err         error
+	progress    progress.Model
+	interrupted bool
+	done        bool
+}
+
+func (m bulkJobModel) poll() tea.Cmd {
+	return tea.Tick(bulkJobPollInterval, func(time.Time) tea.Msg {
+		job, err := m.fetch()
+		return bulkJobPolledMsg{job: job, err: err}
+	})
+}
+
+func (m bulkJobModel) Init() tea.Cmd {
+	return m.poll()
+}
+
+func (m bulkJobModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "ctrl+c", "q":
+			m.interrupted = true
+			m.done = true
+			return m, tea.Quit
+		}
+		return m, nil
+
+	case tea.WindowSizeMsg:
+		m.progress.Width = min(msg.Width-4, 80)
+		return m, nil
+
+	case bulkJobPolledMsg:
+		if msg.err != nil {
+			m.err = msg.err
+			m.done = true
+			return m, tea.Quit
+		}
+
+		m.job = msg.job
+
+		if bulkJobFinished(m.job) {
+			m.done = true
+			return m, tea.Quit
+		}
+
+		return m, tea.Batch(m.progress.SetPercent(bulkJobPercent(m.job)), m.poll())
+
+	case progress.FrameMsg:
+		progressModel, cmd := m.progress.Update(msg)
+		m.progress = progressModel.(progress.Model)
+		return m, cmd
+
+	default:
+		return m, nil
+	}
+}
+
+func (m bulkJobModel) View() string {
+	if m.done {
+		return ""
+	}
+
+	pad := strings.Repeat(" ", 2)
+
+	var status string
+
+	switch {
+	case !m.job.IsEnumerated:
+		status = "Finding matching runs..."
+	case m.job.Status == rest.V1BulkJobStatusPAUSED:
+		status = fmt.Sprintf("Paused at %d/%d runs", m.job.ProcessedCount, m.job.TotalCount)
+	default:
+		status = fmt.Sprintf("Processed %d/%d runs (%d failed)", m.job.ProcessedCount, m.job.TotalCount, m.job.FailedCount)
+	}
+
+	return "\n" + pad + m.progress.View() + "\n" + pad + styles.Muted.Render(status) + "\n" + pad + styles.Muted.Render("press q to stop watching; the job keeps running") + "\n"
+}
+
+func bulkJobPercent(job *rest.V1BulkJob) float64 {
+	if !job.IsEnumerated || job.TotalCount == 0 {
+		return 0
+	}
+
+	return float64(job.ProcessedCount) / float64(job.TotalCount)
+}
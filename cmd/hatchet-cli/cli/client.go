@@ -91,9 +91,14 @@ func clientFromCmd(cmd *cobra.Command) (string, client.Client) { //nolint:static
 		}
 	}
 
-	profile, err := cli.GetProfile(selectedProfile)
+	return selectedProfile, clientFromProfileName(selectedProfile)
+}
+
+// clientFromProfileName creates a Hatchet client for the named profile, exiting on failure.
+func clientFromProfileName(name string) client.Client { //nolint:staticcheck
+	profile, err := cli.GetProfile(name)
 	if err != nil {
-		cli.Logger.Fatalf("could not get profile '%s': %v", selectedProfile, err)
+		cli.Logger.Fatalf("could not get profile '%s': %v", name, err)
 	}
 
 	nopLogger := zerolog.Nop()
@@ -102,7 +107,7 @@ func clientFromCmd(cmd *cobra.Command) (string, client.Client) { //nolint:static
 		cli.Logger.Fatalf("could not create Hatchet client: %v", err)
 	}
 
-	return selectedProfile, hatchetClient
+	return hatchetClient
 }
 
 // clientTenantUUID parses and returns the tenant UUID from the client configuration.
@@ -0,0 +1,264 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/hatchet-dev/hatchet/cmd/hatchet-cli/cli/internal/config/cli"
+	"github.com/hatchet-dev/hatchet/cmd/hatchet-cli/cli/internal/styles"
+	"github.com/hatchet-dev/hatchet/pkg/client/rest"
+)
+
+var eventsCmd = &cobra.Command{
+	Use:     "events",
+	Aliases: []string{"event"},
+	Short:   "Manage events",
+	Long:    `Commands for managing events.`,
+	Run:     func(cmd *cobra.Command, args []string) { _ = cmd.Help() },
+}
+
+var eventSchemasCmd = &cobra.Command{
+	Use:     "schemas",
+	Aliases: []string{"schema"},
+	Short:   "Manage event schemas",
+	Long: `Commands for managing the JSON Schemas which event payloads are validated against when they're pushed.
+A schema is registered for an event key, or for a pattern where * matches any sequence of characters. An event is
+validated against the schema for its exact key if there is one, and otherwise against the most specific matching
+pattern. Registering a schema for a key which already has one adds a new version, which becomes the active one.`,
+	Run: func(cmd *cobra.Command, args []string) { _ = cmd.Help() },
+}
+
+var eventSchemasListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List event schemas",
+	Long:  `List the active version of the schema for each event key, or every version of the schema for a single event key with --event-key.`,
+	Example: `  # List the active schemas
+  hatchet events schemas list
+
+  # List every version of the schema for an event key as JSON
+  hatchet events schemas list --event-key "user:*" -o json`,
+	Run: func(cmd *cobra.Command, args []string) {
+		eventKey, _ := cmd.Flags().GetString("event-key")
+
+		schemas := listEventSchemas(cmd, eventKey)
+
+		if isJSONOutput(cmd) {
+			printJSON(schemas)
+			return
+		}
+
+		if len(schemas.Rows) == 0 {
+			fmt.Println(styles.InfoMessage("No event schemas found"))
+			return
+		}
+
+		for i, schema := range schemas.Rows {
+			if i > 0 {
+				fmt.Println()
+			}
+
+			printEventSchema(&schema)
+		}
+	},
+}
+
+var eventSchemasGetCmd = &cobra.Command{
+	Use:   "get <event-key>",
+	Short: "Get an event schema",
+	Long:  `Get the active version of the schema for an event key, or a specific version with --version. Outputs raw JSON.`,
+	Args:  cobra.ExactArgs(1),
+	Example: `  # Get the active schema
+  hatchet events schemas get user:created
+
+  # Get the first version of the schema
+  hatchet events schemas get user:created --version 1`,
+	Run: func(cmd *cobra.Command, args []string) {
+		eventKey := args[0]
+		version, _ := cmd.Flags().GetInt32("version")
+
+		schemas := listEventSchemas(cmd, eventKey)
+
+		// versions are listed latest first
+		for i := range schemas.Rows {
+			if version == 0 || schemas.Rows[i].Version == version {
+				printJSON(schemas.Rows[i])
+				return
+			}
+		}
+
+		if version != 0 {
+			cli.Logger.Fatalf("version %d of the schema for %q not found", version, eventKey)
+		}
+
+		cli.Logger.Fatalf("no schema is registered for %q", eventKey)
+	},
+}
+
+var eventSchemasRegisterCmd = &cobra.Command{
+	Use:   "register <event-key>",
+	Short: "Register an event schema",
+	Long: `Register a JSON Schema for an event key or pattern, read from --file (or stdin with --file -).
+If the key already has a schema, the new schema is registered as its next version and becomes the active one.
+In reject mode (the default) events which fail validation are rejected; in warn mode they're ingested and the failure is only recorded.
+Schemas are cached by the engine, so a new version can take a few seconds to apply.`,
+	Args: cobra.ExactArgs(1),
+	Example: `  # Reject user events with invalid payloads
+  hatchet events schemas register "user:*" --file user.schema.json
+
+  # Record invalid payloads of an event without rejecting them
+  cat order.schema.json | hatchet events schemas register order:created --file - --mode warn`,
+	Run: func(cmd *cobra.Command, args []string) {
+		eventKey := args[0]
+		file, _ := cmd.Flags().GetString("file")
+		modeStr, _ := cmd.Flags().GetString("mode")
+
+		if file == "" {
+			cli.Logger.Fatal("--file is required")
+		}
+
+		var raw []byte
+		var err error
+
+		if file == "-" {
+			raw, err = io.ReadAll(os.Stdin)
+		} else {
+			raw, err = os.ReadFile(file) // nolint: gosec
+		}
+
+		if err != nil {
+			cli.Logger.Fatalf("failed to read schema: %v", err)
+		}
+
+		var schema map[string]interface{}
+		if err := json.Unmarshal(raw, &schema); err != nil {
+			cli.Logger.Fatalf("schema must be a JSON object: %v", err)
+		}
+
+		mode := rest.V1EventSchemaMode(strings.ToUpper(strings.TrimSpace(modeStr)))
+		if mode != rest.V1EventSchemaModeREJECT && mode != rest.V1EventSchemaModeWARN {
+			cli.Logger.Fatalf("invalid mode %q (must be one of: reject, warn)", modeStr)
+		}
+
+		_, hatchetClient := clientFromCmd(cmd)
+
+		resp, err := hatchetClient.API().V1EventSchemaCreateWithResponse(cmd.Context(), clientTenantUUID(hatchetClient), rest.V1CreateEventSchemaRequest{
+			EventKey: eventKey,
+			Schema:   schema,
+			Mode:     &mode,
+		})
+		if err != nil {
+			cli.Logger.Fatalf("failed to register event schema: %v", err)
+		}
+		if resp.JSON400 != nil {
+			cli.Logger.Fatalf("failed to register event schema: %s", apiErrorsMessage(resp.JSON400))
+		}
+		if resp.JSON200 == nil {
+			cli.Logger.Fatalf("unexpected response from API (status %d)", resp.StatusCode())
+		}
+
+		if isJSONOutput(cmd) {
+			printJSON(resp.JSON200)
+			return
+		}
+
+		fmt.Println(styles.SuccessMessage(fmt.Sprintf("Registered version %d of the schema for %s", resp.JSON200.Version, resp.JSON200.EventKey)))
+	},
+}
+
+var eventSchemasDeleteCmd = &cobra.Command{
+	Use:   "delete <event-key>",
+	Short: "Delete an event schema",
+	Long:  `Delete every version of the schema for an event key or pattern, after which matching events are no longer validated. Use --yes to skip confirmation.`,
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		eventKey := args[0]
+		isJSON := isJSONOutput(cmd)
+		yes, _ := cmd.Flags().GetBool("yes")
+
+		if !isJSON && !yes {
+			if !confirmAction(fmt.Sprintf("Delete every version of the schema for '%s'?", eventKey)) {
+				fmt.Println("Aborted.")
+				return
+			}
+		}
+
+		_, hatchetClient := clientFromCmd(cmd)
+
+		resp, err := hatchetClient.API().V1EventSchemaDeleteWithResponse(cmd.Context(), clientTenantUUID(hatchetClient), &rest.V1EventSchemaDeleteParams{
+			EventKey: eventKey,
+		})
+		if err != nil {
+			cli.Logger.Fatalf("failed to delete event schema: %v", err)
+		}
+
+		for _, apiErrs := range []*rest.APIErrors{resp.JSON400, resp.JSON403, resp.JSON404} {
+			if apiErrs != nil {
+				cli.Logger.Fatalf("failed to delete event schema: %s", apiErrorsMessage(apiErrs))
+			}
+		}
+
+		if resp.StatusCode() >= 400 {
+			cli.Logger.Fatalf("failed to delete event schema (status %d)", resp.StatusCode())
+		}
+
+		if isJSON {
+			printJSON(map[string]interface{}{"deleted": true, "eventKey": eventKey})
+		} else {
+			fmt.Println(styles.SuccessMessage(fmt.Sprintf("Deleted the schema for %s", eventKey)))
+		}
+	},
+}
+
+// listEventSchemas lists the active schemas, or every version of the schema for eventKey if it's set.
+func listEventSchemas(cmd *cobra.Command, eventKey string) *rest.V1EventSchemaList {
+	_, hatchetClient := clientFromCmd(cmd)
+
+	params := &rest.V1EventSchemaListParams{}
+	if eventKey != "" {
+		params.EventKey = &eventKey
+	}
+
+	resp, err := hatchetClient.API().V1EventSchemaListWithResponse(cmd.Context(), clientTenantUUID(hatchetClient), params)
+	if err != nil {
+		cli.Logger.Fatalf("failed to list event schemas: %v", err)
+	}
+	if resp.JSON400 != nil {
+		cli.Logger.Fatalf("failed to list event schemas: %s", apiErrorsMessage(resp.JSON400))
+	}
+	if resp.JSON200 == nil {
+		cli.Logger.Fatalf("unexpected response from API (status %d)", resp.StatusCode())
+	}
+
+	return resp.JSON200
+}
+
+func printEventSchema(schema *rest.V1EventSchema) {
+	fmt.Println(styles.KeyValue("Event key", schema.EventKey))
+	fmt.Println(styles.KeyValue("Version", fmt.Sprintf("%d", schema.Version)))
+	fmt.Println(styles.KeyValue("Mode", strings.ToLower(string(schema.Mode))))
+	fmt.Println(styles.KeyValue("Registered", schema.CreatedAt.Local().Format(time.RFC1123)))
+}
+
+func init() {
+	rootCmd.AddCommand(eventsCmd)
+	eventsCmd.AddCommand(eventSchemasCmd)
+	eventSchemasCmd.AddCommand(eventSchemasListCmd, eventSchemasGetCmd, eventSchemasRegisterCmd, eventSchemasDeleteCmd)
+
+	eventsCmd.PersistentFlags().StringP("profile", "p", "", "Profile to use for connecting to Hatchet (default: prompts for selection)")
+	eventsCmd.PersistentFlags().StringP("output", "o", "", "Output format: json")
+
+	eventSchemasListCmd.Flags().StringP("event-key", "k", "", "List every version of the schema for this event key or pattern")
+
+	eventSchemasGetCmd.Flags().Int32("version", 0, "The version of the schema to get (default: the active version)")
+
+	eventSchemasRegisterCmd.Flags().StringP("file", "f", "", "Path to the JSON Schema file, or - to read it from stdin")
+	eventSchemasRegisterCmd.Flags().String("mode", "reject", "What happens to events which fail validation: reject, or warn to only record the failure")
+
+	eventSchemasDeleteCmd.Flags().BoolP("yes", "y", false, "Skip confirmation prompt")
+}
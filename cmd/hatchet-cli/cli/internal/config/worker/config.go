@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"strings"
 
 	"github.com/spf13/viper"
 )
@@ -36,6 +37,174 @@ type WorkerDevConfig struct {
 
 	// whether to reload on file changes
 	Reload bool `mapstructure:"reload" json:"reload,omitempty"`
+
+	// workers to run side by side, instead of a single runCmd
+	Workers []DevWorker `mapstructure:"workers" json:"workers,omitempty"`
+
+	// names of triggers to run once every worker has registered
+	Fixtures []string `mapstructure:"fixtures" json:"fixtures,omitempty"`
+}
+
+type DevWorker struct {
+	// name which prefixes the worker's output, and which other workers refer to it by
+	Name string `mapstructure:"name" json:"name"`
+
+	// optional name the worker registers with in Hatchet (defaults to name)
+	WorkerName string `mapstructure:"workerName" json:"workerName,omitempty"`
+
+	// optional working directory of the worker's commands, relative to hatchet.yaml
+	Dir string `mapstructure:"dir" json:"dir,omitempty"`
+
+	// KEY=value environment variables set for the worker's commands
+	Env []string `mapstructure:"env" json:"env,omitempty"`
+
+	// commands to run before starting the worker
+	PreCmds []string `mapstructure:"preCmds" json:"preCmds,omitempty"`
+
+	// command to run the worker
+	RunCmd string `mapstructure:"runCmd" json:"runCmd"`
+
+	// list of glob files to watch for reloads, relative to dir
+	Files []string `mapstructure:"files" json:"files,omitempty"`
+
+	// whether to reload on file changes (defaults to dev.reload)
+	Reload *bool `mapstructure:"reload" json:"reload,omitempty"`
+
+	// names of workers which must register before this worker starts
+	DependsOn []string `mapstructure:"dependsOn" json:"dependsOn,omitempty"`
+}
+
+// GetWorkerName returns the name the worker registers with in Hatchet.
+func (w *DevWorker) GetWorkerName() string {
+	if w.WorkerName != "" {
+		return w.WorkerName
+	}
+
+	return w.Name
+}
+
+// Validate checks that the dev workers have unique names and run commands, and that their
+// dependencies and the fixtures refer to workers and triggers which exist, without cycles.
+func (c *WorkerConfig) Validate() error {
+	if len(c.Dev.Workers) == 0 {
+		if len(c.Dev.Fixtures) > 0 {
+			return fmt.Errorf("dev.fixtures can only be used with dev.workers")
+		}
+
+		return nil
+	}
+
+	if c.Dev.RunCmd != "" {
+		return fmt.Errorf("dev.runCmd can't be combined with dev.workers, set runCmd on each worker instead")
+	}
+
+	workers := make(map[string]*DevWorker, len(c.Dev.Workers))
+
+	for i := range c.Dev.Workers {
+		w := &c.Dev.Workers[i]
+
+		if w.Name == "" {
+			return fmt.Errorf("dev.workers[%d] must have a name", i)
+		}
+
+		if _, ok := workers[w.Name]; ok {
+			return fmt.Errorf("worker name '%s' is used more than once", w.Name)
+		}
+
+		if w.RunCmd == "" {
+			return fmt.Errorf("worker '%s' must have a runCmd", w.Name)
+		}
+
+		for _, kv := range w.Env {
+			if key, _, ok := strings.Cut(kv, "="); !ok || key == "" {
+				return fmt.Errorf("worker '%s' has an invalid env entry '%s', expected KEY=value", w.Name, kv)
+			}
+		}
+
+		workers[w.Name] = w
+	}
+
+	for _, w := range c.Dev.Workers {
+		for _, dep := range w.DependsOn {
+			if _, ok := workers[dep]; !ok {
+				return fmt.Errorf("worker '%s' depends on unknown worker '%s'", w.Name, dep)
+			}
+		}
+	}
+
+	// a dependency cycle would leave the workers in it waiting on each other forever
+	const (
+		unvisited = iota
+		visiting
+		visited
+	)
+
+	state := make(map[string]int, len(workers))
+
+	var visit func(name string, path []string) error
+
+	visit = func(name string, path []string) error {
+		switch state[name] {
+		case visiting:
+			return fmt.Errorf("workers have a dependency cycle: %s", strings.Join(append(path, name), " -> "))
+		case visited:
+			return nil
+		}
+
+		state[name] = visiting
+
+		for _, dep := range workers[name].DependsOn {
+			if err := visit(dep, append(path, name)); err != nil {
+				return err
+			}
+		}
+
+		state[name] = visited
+
+		return nil
+	}
+
+	for _, w := range c.Dev.Workers {
+		if err := visit(w.Name, nil); err != nil {
+			return err
+		}
+	}
+
+	_, err := c.FixtureTriggers()
+
+	return err
+}
+
+// FixtureTriggers returns the triggers named by dev.fixtures, in order.
+func (c *WorkerConfig) FixtureTriggers() ([]Trigger, error) {
+	res := make([]Trigger, 0, len(c.Dev.Fixtures))
+
+	for _, name := range c.Dev.Fixtures {
+		found := false
+
+		for _, trigger := range c.Triggers {
+			if trigger.GetName() == name {
+				res = append(res, trigger)
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			return nil, fmt.Errorf("fixture '%s' is not a trigger in hatchet.yaml", name)
+		}
+	}
+
+	return res, nil
+}
+
+// GetName returns the name of the trigger, which defaults to its command.
+func (t *Trigger) GetName() string {
+	if t.Name != "" {
+		return t.Name
+	}
+
+	return t.Command
 }
 
 var workerViperConfig *viper.Viper
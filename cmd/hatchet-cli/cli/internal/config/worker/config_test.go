@@ -0,0 +1,130 @@
+package worker
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateDevWorkers(t *testing.T) {
+	tests := []struct {
+		name    string
+		config  WorkerConfig
+		wantErr string
+	}{
+		{
+			name: "single run command",
+			config: WorkerConfig{
+				Dev: WorkerDevConfig{RunCmd: "go run ./worker"},
+			},
+		},
+		{
+			name: "workers with dependencies and fixtures",
+			config: WorkerConfig{
+				Triggers: []Trigger{{Name: "seed", Command: "go run ./cmd/seed"}},
+				Dev: WorkerDevConfig{
+					Workers: []DevWorker{
+						{Name: "go", RunCmd: "go run ./worker", Env: []string{"PORT=8001"}},
+						{Name: "python", RunCmd: "poetry run python worker.py", DependsOn: []string{"go"}},
+					},
+					Fixtures: []string{"seed"},
+				},
+			},
+		},
+		{
+			name: "run command with workers",
+			config: WorkerConfig{
+				Dev: WorkerDevConfig{
+					RunCmd:  "go run ./worker",
+					Workers: []DevWorker{{Name: "go", RunCmd: "go run ./worker"}},
+				},
+			},
+			wantErr: "dev.runCmd can't be combined with dev.workers",
+		},
+		{
+			name: "duplicate names",
+			config: WorkerConfig{
+				Dev: WorkerDevConfig{
+					Workers: []DevWorker{
+						{Name: "go", RunCmd: "go run ./worker"},
+						{Name: "go", RunCmd: "go run ./other"},
+					},
+				},
+			},
+			wantErr: "worker name 'go' is used more than once",
+		},
+		{
+			name: "invalid env",
+			config: WorkerConfig{
+				Dev: WorkerDevConfig{
+					Workers: []DevWorker{{Name: "go", RunCmd: "go run ./worker", Env: []string{"PORT"}}},
+				},
+			},
+			wantErr: "invalid env entry 'PORT'",
+		},
+		{
+			name: "unknown dependency",
+			config: WorkerConfig{
+				Dev: WorkerDevConfig{
+					Workers: []DevWorker{{Name: "go", RunCmd: "go run ./worker", DependsOn: []string{"python"}}},
+				},
+			},
+			wantErr: "worker 'go' depends on unknown worker 'python'",
+		},
+		{
+			name: "dependency cycle",
+			config: WorkerConfig{
+				Dev: WorkerDevConfig{
+					Workers: []DevWorker{
+						{Name: "a", RunCmd: "a", DependsOn: []string{"b"}},
+						{Name: "b", RunCmd: "b", DependsOn: []string{"c"}},
+						{Name: "c", RunCmd: "c", DependsOn: []string{"a"}},
+					},
+				},
+			},
+			wantErr: "workers have a dependency cycle: a -> b -> c -> a",
+		},
+		{
+			name: "unknown fixture",
+			config: WorkerConfig{
+				Dev: WorkerDevConfig{
+					Workers:  []DevWorker{{Name: "go", RunCmd: "go run ./worker"}},
+					Fixtures: []string{"seed"},
+				},
+			},
+			wantErr: "fixture 'seed' is not a trigger in hatchet.yaml",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.config.Validate()
+
+			if tt.wantErr == "" {
+				require.NoError(t, err)
+				return
+			}
+
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
+
+func TestFixtureTriggers(t *testing.T) {
+	config := WorkerConfig{
+		Triggers: []Trigger{
+			{Name: "seed", Command: "go run ./cmd/seed"},
+			{Command: "go run ./cmd/backfill"},
+		},
+		Dev: WorkerDevConfig{
+			Fixtures: []string{"go run ./cmd/backfill", "seed"},
+		},
+	}
+
+	triggers, err := config.FixtureTriggers()
+
+	require.NoError(t, err)
+	assert.Equal(t, []Trigger{config.Triggers[1], config.Triggers[0]}, triggers)
+}
@@ -22,15 +22,20 @@ func WatchFiles(ctx context.Context, patterns []string, pm *ProcessManager) <-ch
 		cli.Logger.Fatalf("error creating file watcher: %v", err)
 	}
 
-	cwd, err := os.Getwd()
+	// patterns are relative to the working directory of the process
+	cwd := pm.opts.Dir
 
-	if err != nil {
-		cli.Logger.Fatalf("error getting cwd: %v", err)
+	if cwd == "" {
+		cwd, err = os.Getwd()
+
+		if err != nil {
+			cli.Logger.Fatalf("error getting cwd: %v", err)
+		}
 	}
 
 	// Create pattern matcher for file watching
 	for _, pattern := range patterns {
-		fmt.Println(styles.Muted.Render(fmt.Sprintf("  Watching pattern: %s", pattern)))
+		pm.println(styles.Muted.Render(fmt.Sprintf("  Watching pattern: %s", pattern)))
 	}
 
 	patternM, err := patternmatcher.New(patterns)
@@ -100,11 +105,11 @@ func WatchFiles(ctx context.Context, patterns []string, pm *ProcessManager) <-ch
 
 	// TODO: remove debug prints
 	for _, file := range watcher.WatchList() {
-		fmt.Println(styles.Muted.Render(fmt.Sprintf("  Watching file: %s", file)))
+		pm.println(styles.Muted.Render(fmt.Sprintf("  Watching file: %s", file)))
 	}
 
 	watchCount := len(watcher.WatchList())
-	fmt.Println(styles.Muted.Render(fmt.Sprintf("  Watching %d file(s) and directories", watchCount)))
+	pm.println(styles.Muted.Render(fmt.Sprintf("  Watching %d file(s) and directories", watchCount)))
 
 	go func() {
 		for {
@@ -166,7 +171,7 @@ func WatchFiles(ctx context.Context, patterns []string, pm *ProcessManager) <-ch
 				}
 
 				if shouldReload {
-					fmt.Println(styles.InfoMessage(fmt.Sprintf("File change detected: %s", event.Name)))
+					pm.println(styles.InfoMessage(fmt.Sprintf("File change detected: %s", event.Name)))
 
 					// non-blocking write to reloadNotifier
 					select {
@@ -175,7 +180,7 @@ func WatchFiles(ctx context.Context, patterns []string, pm *ProcessManager) <-ch
 					}
 				}
 			case <-reloadNotifier:
-				fmt.Println(styles.InfoMessage("Reloading worker..."))
+				pm.println(styles.InfoMessage("Reloading worker..."))
 
 				err = pm.StartProcess(ctx)
 
@@ -186,7 +191,7 @@ func WatchFiles(ctx context.Context, patterns []string, pm *ProcessManager) <-ch
 				if !ok {
 					return
 				}
-				fmt.Printf("Watcher error: %v\n", err)
+				pm.println(fmt.Sprintf("Watcher error: %v", err))
 			}
 		}
 	}()
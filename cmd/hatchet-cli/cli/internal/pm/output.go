@@ -0,0 +1,86 @@
+package pm
+
+import (
+	"bytes"
+	"io"
+	"sync"
+)
+
+// OutputMux multiplexes the output of several processes onto one writer, prefixing each line with
+// the process it came from. Lines are written whole, so the output of different processes isn't
+// interleaved within a line.
+type OutputMux struct {
+	w  io.Writer
+	mu sync.Mutex
+}
+
+func NewOutputMux(w io.Writer) *OutputMux {
+	return &OutputMux{
+		w: w,
+	}
+}
+
+// Writer returns a writer which prefixes every line written to it. Partial lines are held back
+// until they're completed by a newline or the writer is flushed.
+func (m *OutputMux) Writer(prefix string) *PrefixWriter {
+	return &PrefixWriter{
+		mux:    m,
+		prefix: []byte(prefix),
+	}
+}
+
+// PrefixWriter is a writer returned by OutputMux.Writer.
+type PrefixWriter struct {
+	mux    *OutputMux
+	prefix []byte
+	buf    []byte
+}
+
+func (w *PrefixWriter) Write(p []byte) (int, error) {
+	w.mux.mu.Lock()
+	defer w.mux.mu.Unlock()
+
+	w.buf = append(w.buf, p...)
+
+	for {
+		i := bytes.IndexByte(w.buf, '\n')
+
+		if i < 0 {
+			break
+		}
+
+		if err := w.writeLine(w.buf[:i+1]); err != nil {
+			return 0, err
+		}
+
+		w.buf = w.buf[i+1:]
+	}
+
+	return len(p), nil
+}
+
+// Flush writes the partial line held back by the writer, if any.
+func (w *PrefixWriter) Flush() error {
+	w.mux.mu.Lock()
+	defer w.mux.mu.Unlock()
+
+	if len(w.buf) == 0 {
+		return nil
+	}
+
+	line := append(w.buf, '\n')
+	w.buf = nil
+
+	return w.writeLine(line)
+}
+
+// writeLine writes a line with the prefix. It must be called with the mux locked.
+func (w *PrefixWriter) writeLine(line []byte) error {
+	if _, err := w.mux.w.Write(w.prefix); err != nil {
+		return err
+	}
+
+	_, err := w.mux.w.Write(line)
+
+	return err
+}
@@ -0,0 +1,33 @@
+package pm
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOutputMux(t *testing.T) {
+	var out bytes.Buffer
+
+	mux := NewOutputMux(&out)
+
+	api := mux.Writer("api | ")
+	jobs := mux.Writer("jobs | ")
+
+	_, err := api.Write([]byte("starting\nlist"))
+	require.NoError(t, err)
+
+	// the partial line from api is held back until it's completed
+	_, err = jobs.Write([]byte("ready\n"))
+	require.NoError(t, err)
+
+	_, err = api.Write([]byte("ening on :8080\nshutting"))
+	require.NoError(t, err)
+
+	require.NoError(t, api.Flush())
+	require.NoError(t, jobs.Flush())
+
+	assert.Equal(t, "api | starting\njobs | ready\napi | listening on :8080\napi | shutting\n", out.String())
+}
@@ -3,6 +3,7 @@ package pm
 import (
 	"context"
 	"fmt"
+	"io"
 	"os"
 	"os/exec"
 	"strings"
@@ -10,25 +11,65 @@ import (
 
 	"github.com/kballard/go-shellquote"
 
-	"github.com/hatchet-dev/hatchet/cmd/hatchet-cli/cli/internal/config/cli"
 	cliconfig "github.com/hatchet-dev/hatchet/pkg/config/cli"
 )
 
+// ProcessOpts configures how a child process is run. The zero value runs it in the current
+// directory, with the environment and output of the CLI.
+type ProcessOpts struct {
+	// Dir is the working directory of the process
+	Dir string
+
+	// Env are KEY=value pairs added to the environment of the process
+	Env []string
+
+	// Stdout and Stderr receive the output of the process
+	Stdout io.Writer
+	Stderr io.Writer
+}
+
+func (o ProcessOpts) stdout() io.Writer {
+	if o.Stdout == nil {
+		return os.Stdout
+	}
+
+	return o.Stdout
+}
+
+func (o ProcessOpts) stderr() io.Writer {
+	if o.Stderr == nil {
+		return os.Stderr
+	}
+
+	return o.Stderr
+}
+
 // ProcessManager manages the lifecycle of a child process which can be started, stopped, and restarted.
 type ProcessManager struct {
 	proc    *exec.Cmd
 	profile *cliconfig.Profile
 	cmd     string
+	opts    ProcessOpts
 	procLk  sync.Mutex
 }
 
 func NewProcessManager(cmd string, profile *cliconfig.Profile) *ProcessManager {
+	return NewProcessManagerWithOpts(cmd, profile, ProcessOpts{})
+}
+
+func NewProcessManagerWithOpts(cmd string, profile *cliconfig.Profile, opts ProcessOpts) *ProcessManager {
 	return &ProcessManager{
 		cmd:     cmd,
 		profile: profile,
+		opts:    opts,
 	}
 }
 
+// println writes a status message to the output of the process.
+func (pm *ProcessManager) println(msg string) {
+	fmt.Fprintln(pm.opts.stdout(), msg)
+}
+
 func (pm *ProcessManager) StartProcess(ctx context.Context) error {
 	pm.KillProcess()
 
@@ -46,10 +87,11 @@ func (pm *ProcessManager) StartProcess(ctx context.Context) error {
 	// Set platform-specific process attributes
 	pm.setPlatformAttributes()
 
-	pm.proc.Env = prepareEnviron(pm.profile)
+	pm.proc.Dir = pm.opts.Dir
+	pm.proc.Env = prepareEnviron(pm.profile, pm.opts.Env)
 
-	pm.proc.Stdout = os.Stdout
-	pm.proc.Stderr = os.Stderr
+	pm.proc.Stdout = pm.opts.stdout()
+	pm.proc.Stderr = pm.opts.stderr()
 	err = pm.proc.Start()
 
 	if err != nil {
@@ -71,7 +113,7 @@ func (pm *ProcessManager) StartProcess(ctx context.Context) error {
 		}
 
 		if err != nil && !strings.Contains(err.Error(), "signal: killed") {
-			fmt.Printf("Worker exited with error: %v\n", err)
+			pm.println(fmt.Sprintf("Worker exited with error: %v", err))
 		}
 	}()
 
@@ -87,32 +129,43 @@ func (pm *ProcessManager) KillProcess() {
 
 // Exec implements a platform-agnostic child process execution
 func Exec(ctx context.Context, cmd string, profile *cliconfig.Profile) error {
-	env := prepareEnviron(profile)
+	return ExecWithOpts(ctx, cmd, profile, ProcessOpts{})
+}
+
+// ExecWithOpts runs a command to completion with the given options.
+func ExecWithOpts(ctx context.Context, cmd string, profile *cliconfig.Profile, opts ProcessOpts) error {
+	env := prepareEnviron(profile, opts.Env)
 
 	preCmdArgs, err := shellquote.Split(cmd)
 	if err != nil {
 		return fmt.Errorf("could not parse command '%s': %w", cmd, err)
 	}
 
+	if len(preCmdArgs) == 0 {
+		return fmt.Errorf("command is empty")
+	}
+
 	preCmd := exec.CommandContext(ctx, preCmdArgs[0], preCmdArgs[1:]...) // nolint: gosec
-	preCmd.Stdout = os.Stdout
-	preCmd.Stderr = os.Stderr
+	preCmd.Dir = opts.Dir
+	preCmd.Stdout = opts.stdout()
+	preCmd.Stderr = opts.stderr()
 	preCmd.Env = env
 
 	err = preCmd.Run()
 	if err != nil {
-		cli.Logger.Fatalf("error running command '%s': %v", cmd, err)
+		return fmt.Errorf("error running command '%s': %w", cmd, err)
 	}
 
 	return nil
 }
 
-func prepareEnviron(profile *cliconfig.Profile) []string {
+func prepareEnviron(profile *cliconfig.Profile, extra []string) []string {
 	env := append(os.Environ(), fmt.Sprintf("HATCHET_CLIENT_TOKEN=%s", profile.Token))
 
 	if profile.TLSStrategy != "tls" { // tls is the default on all SDKs
 		env = append(env, "HATCHET_CLIENT_TLS_STRATEGY="+profile.TLSStrategy)
 	}
 
-	return env
+	// later values take precedence, so the extra variables override the ones above
+	return append(env, extra...)
 }
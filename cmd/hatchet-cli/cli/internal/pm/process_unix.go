@@ -3,7 +3,6 @@
 package pm
 
 import (
-	"fmt"
 	"syscall"
 	"time"
 
@@ -15,7 +14,7 @@ func (pm *ProcessManager) killProcessPlatform() error {
 		return nil
 	}
 
-	fmt.Println(styles.InfoMessage("Stopping worker"))
+	pm.println(styles.InfoMessage("Stopping worker"))
 
 	// Create a process group for easier cleanup
 	pgid, err := syscall.Getpgid(pm.proc.Process.Pid)
@@ -34,7 +33,7 @@ func (pm *ProcessManager) killProcessPlatform() error {
 			// Process exited, all good
 		case <-time.After(3 * time.Second):
 			// Process didn't exit in time, force kill
-			fmt.Println(styles.Muted.Render("Worker didn't exit gracefully, force killing"))
+			pm.println(styles.Muted.Render("Worker didn't exit gracefully, force killing"))
 			_ = syscall.Kill(-pgid, syscall.SIGKILL)
 			<-done // Still wait for the process to be fully gone
 		}
@@ -53,7 +52,7 @@ func (pm *ProcessManager) killProcessPlatform() error {
 			// Process exited, all good
 		case <-time.After(3 * time.Second):
 			// Process didn't exit in time, force kill
-			fmt.Println(styles.Muted.Render("Worker didn't exit gracefully, force killing"))
+			pm.println(styles.Muted.Render("Worker didn't exit gracefully, force killing"))
 			_ = pm.proc.Process.Kill()
 			<-done // Still wait for the process to be fully gone
 		}
@@ -3,7 +3,6 @@
 package pm
 
 import (
-	"fmt"
 	"os/exec"
 	"strconv"
 	"time"
@@ -16,7 +15,7 @@ func (pm *ProcessManager) killProcessPlatform() error {
 		return nil
 	}
 
-	fmt.Println(styles.InfoMessage("Stopping worker"))
+	pm.println(styles.InfoMessage("Stopping worker"))
 
 	pid := pm.proc.Process.Pid
 
@@ -35,7 +34,7 @@ func (pm *ProcessManager) killProcessPlatform() error {
 		// Process exited, all good
 	case <-time.After(3 * time.Second):
 		// Process didn't exit in time, force kill
-		fmt.Println(styles.Muted.Render("Worker didn't exit gracefully, force killing"))
+		pm.println(styles.Muted.Render("Worker didn't exit gracefully, force killing"))
 		forceKillCmd := exec.Command("taskkill", "/F", "/T", "/PID", strconv.Itoa(pid))
 		_ = forceKillCmd.Run()
 		<-done // Still wait for the process to be fully gone
@@ -81,6 +81,10 @@ var (
 		Foreground(SuccessColor).
 		Bold(true)
 
+	Error = lipgloss.NewStyle().
+		Foreground(ErrorColor).
+		Bold(true)
+
 	Highlight = lipgloss.NewStyle().
 			Foreground(HighlightColor).
 			Bold(true)
@@ -150,6 +154,13 @@ func SuccessMessage(message string) string {
 	return fmt.Sprintf("%s %s", icon, text)
 }
 
+// ErrorMessage renders an error message with a cross
+func ErrorMessage(message string) string {
+	icon := Error.Render("✗")
+	text := Primary.Render(message)
+	return fmt.Sprintf("%s %s", icon, text)
+}
+
 // Info renders an info message with icon
 func InfoMessage(message string) string {
 	icon := Accent.Render("ℹ")
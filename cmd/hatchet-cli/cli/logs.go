@@ -0,0 +1,425 @@
+package cli
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"os/signal"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/google/uuid"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+	"github.com/spf13/cobra"
+
+	"github.com/hatchet-dev/hatchet/cmd/hatchet-cli/cli/internal/config/cli"
+	"github.com/hatchet-dev/hatchet/pkg/client" //nolint:staticcheck
+	"github.com/hatchet-dev/hatchet/pkg/client/rest"
+)
+
+// how long logs tail waits before reconnecting after the stream ends
+const logsTailReconnectDelay = 2 * time.Second
+
+var logsCmd = &cobra.Command{
+	Use:     "logs",
+	Aliases: []string{"log"},
+	Short:   "Search and tail logs",
+	Long:    `Commands for searching the logs of every run in a tenant, and following new log lines as they're written.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		_ = cmd.Help()
+	},
+}
+
+var logsSearchCmd = &cobra.Command{
+	Use:   "search",
+	Short: "Search logs across runs",
+	Long: `Search the log lines of every run in the tenant, filtered by workflow, level, worker, metadata,
+time range, and a full-text query. Prints the matching lines sorted by timestamp.`,
+	Example: `  # Error logs of a workflow in the last hour
+  hatchet logs search --workflow my-workflow --level error
+
+  # Lines matching a full-text query in the last day
+  hatchet logs search --query '"connection refused" -retry' --since 24h
+
+  # Lines with a metadata field, written by a worker
+  hatchet logs search --metadata customer.id:42 --worker 8ff4f149-099e-4c16-a8d1-0535f8c79b83
+
+  # JSON output
+  hatchet logs search --level warn -o json | jq .`,
+	Run: func(cmd *cobra.Command, args []string) {
+		isJSON := isJSONOutput(cmd)
+		_, hatchetClient := clientFromCmd(cmd)
+
+		ctx := cmd.Context()
+		tenantUUID := clientTenantUUID(hatchetClient)
+
+		filters, err := logFiltersFromFlags(ctx, cmd, hatchetClient)
+		if err != nil {
+			cli.Logger.Fatalf("%v", err)
+		}
+
+		sinceStr, _ := cmd.Flags().GetString("since")
+		untilStr, _ := cmd.Flags().GetString("until")
+		limit, _ := cmd.Flags().GetInt64("limit")
+
+		since, err := parseSinceDuration(sinceStr)
+		if err != nil {
+			cli.Logger.Fatalf("invalid --since value: %v", err)
+		}
+
+		// the newest lines are fetched first so --limit keeps the most recent ones
+		desc := rest.V1LogLineOrderByDirectionDESC
+
+		params := &rest.V1TenantLogLineListParams{
+			Since:            &since,
+			Limit:            &limit,
+			OrderByDirection: &desc,
+			Search:           filters.search,
+			Levels:           filters.levels,
+			WorkflowIds:      filters.workflowIds,
+			WorkerIds:        filters.workerIds,
+			Query:            filters.query,
+			Metadata:         filters.metadata,
+		}
+
+		if untilStr != "" {
+			until, err := parseSinceDuration(untilStr)
+			if err != nil {
+				cli.Logger.Fatalf("invalid --until value: %v", err)
+			}
+			params.Until = &until
+		}
+
+		resp, err := hatchetClient.API().V1TenantLogLineListWithResponse(ctx, tenantUUID, params)
+		if err != nil {
+			cli.Logger.Fatalf("failed to search logs: %v", err)
+		}
+		if resp.JSON400 != nil {
+			cli.Logger.Fatalf("invalid search: %s", apiErrorsMessage(resp.JSON400))
+		}
+		if resp.JSON200 == nil {
+			cli.Logger.Fatalf("unexpected response from API (status %d): %s", resp.StatusCode(), string(resp.Body))
+		}
+
+		var rows []rest.V1LogLine
+		if resp.JSON200.Rows != nil {
+			rows = *resp.JSON200.Rows
+		}
+
+		// reverse the DESC results back to chronological order
+		for i, j := 0, len(rows)-1; i < j; i, j = i+1, j-1 {
+			rows[i], rows[j] = rows[j], rows[i]
+		}
+
+		if isJSON {
+			printJSON(rows)
+			return
+		}
+
+		if len(rows) == 0 {
+			fmt.Println("No logs found.")
+			return
+		}
+
+		for _, line := range rows {
+			printTenantLogLine(line)
+		}
+	},
+}
+
+var logsTailCmd = &cobra.Command{
+	Use:   "tail",
+	Short: "Follow new log lines across runs",
+	Long: `Stream the log lines of every run in the tenant as they're written, filtered by workflow, level,
+worker, metadata, and a full-text query. Reconnects without losing lines if the stream is interrupted.
+Press Ctrl+C to stop.`,
+	Example: `  # Follow the error logs of a workflow
+  hatchet logs tail --workflow my-workflow --level error
+
+  # Follow warnings and errors from a worker
+  hatchet logs tail --level warn --level error --worker 8ff4f149-099e-4c16-a8d1-0535f8c79b83
+
+  # One JSON object per line
+  hatchet logs tail --query timeout -o json | jq .message`,
+	Run: func(cmd *cobra.Command, args []string) {
+		isJSON := isJSONOutput(cmd)
+		_, hatchetClient := clientFromCmd(cmd)
+
+		ctx, cancel := context.WithCancel(cmd.Context())
+		defer cancel()
+
+		tenantUUID := clientTenantUUID(hatchetClient)
+
+		filters, err := logFiltersFromFlags(ctx, cmd, hatchetClient)
+		if err != nil {
+			cli.Logger.Fatalf("%v", err)
+		}
+
+		sigCh := make(chan os.Signal, 1)
+		signal.Notify(sigCh, os.Interrupt, syscall.SIGTERM)
+		defer signal.Stop(sigCh)
+
+		go func() {
+			<-sigCh
+			cancel()
+		}()
+
+		params := &rest.V1TenantLogLineTailParams{
+			Search:      filters.search,
+			Levels:      filters.levels,
+			WorkflowIds: filters.workflowIds,
+			WorkerIds:   filters.workerIds,
+			Query:       filters.query,
+			Metadata:    filters.metadata,
+		}
+
+		for {
+			err := tailLogs(ctx, hatchetClient, tenantUUID, params, func(id int64, line rest.V1LogLine) {
+				// resume after the last line printed when reconnecting
+				params.After = &id
+
+				if isJSON {
+					out, _ := json.Marshal(line)
+					fmt.Println(string(out))
+					return
+				}
+
+				printTenantLogLine(line)
+			})
+
+			if ctx.Err() != nil {
+				return
+			}
+
+			if err != nil {
+				cli.Logger.Warnf("log stream disconnected, reconnecting: %v", err)
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(logsTailReconnectDelay):
+			}
+		}
+	},
+}
+
+// logFilters are the filters shared by logs search and logs tail.
+type logFilters struct {
+	search      *string
+	query       *string
+	levels      *[]rest.V1LogLineLevel
+	workflowIds *[]openapi_types.UUID
+	workerIds   *[]openapi_types.UUID
+	metadata    *[]string
+}
+
+func logFiltersFromFlags(ctx context.Context, cmd *cobra.Command, hatchetClient client.Client) (*logFilters, error) { //nolint:staticcheck
+	filters := &logFilters{}
+
+	if search, _ := cmd.Flags().GetString("search"); search != "" {
+		filters.search = &search
+	}
+
+	if query, _ := cmd.Flags().GetString("query"); query != "" {
+		filters.query = &query
+	}
+
+	levelStrs, _ := cmd.Flags().GetStringSlice("level")
+
+	if len(levelStrs) > 0 {
+		levels, err := parseLogLevels(levelStrs)
+		if err != nil {
+			return nil, err
+		}
+		filters.levels = &levels
+	}
+
+	workflowStrs, _ := cmd.Flags().GetStringSlice("workflow")
+
+	if len(workflowStrs) > 0 {
+		workflowIds := make([]openapi_types.UUID, 0, len(workflowStrs))
+		for _, w := range workflowStrs {
+			id, err := resolveWorkflowID(ctx, hatchetClient, w)
+			if err != nil {
+				return nil, err
+			}
+			workflowIds = append(workflowIds, id)
+		}
+		filters.workflowIds = &workflowIds
+	}
+
+	workerStrs, _ := cmd.Flags().GetStringSlice("worker")
+
+	if len(workerStrs) > 0 {
+		workerIds := make([]openapi_types.UUID, 0, len(workerStrs))
+		for _, w := range workerStrs {
+			id, err := uuid.Parse(w)
+			if err != nil {
+				return nil, fmt.Errorf("invalid worker ID %q: %w", w, err)
+			}
+			workerIds = append(workerIds, id)
+		}
+		filters.workerIds = &workerIds
+	}
+
+	metadata, _ := cmd.Flags().GetStringArray("metadata")
+
+	if len(metadata) > 0 {
+		for _, pair := range metadata {
+			if key, _, ok := strings.Cut(pair, ":"); !ok || key == "" {
+				return nil, fmt.Errorf("invalid metadata filter %q (use key:value)", pair)
+			}
+		}
+		filters.metadata = &metadata
+	}
+
+	return filters, nil
+}
+
+// parseLogLevels parses a list of level strings into V1LogLineLevel values
+func parseLogLevels(strs []string) ([]rest.V1LogLineLevel, error) {
+	var levels []rest.V1LogLineLevel
+	for _, s := range strs {
+		switch strings.ToUpper(s) {
+		case "DEBUG":
+			levels = append(levels, rest.V1LogLineLevelDEBUG)
+		case "INFO":
+			levels = append(levels, rest.V1LogLineLevelINFO)
+		case "WARN", "WARNING":
+			levels = append(levels, rest.V1LogLineLevelWARN)
+		case "ERROR":
+			levels = append(levels, rest.V1LogLineLevelERROR)
+		default:
+			return nil, fmt.Errorf("invalid level %q (valid: DEBUG,INFO,WARN,ERROR)", s)
+		}
+	}
+	return levels, nil
+}
+
+// tailLogs opens the log tail stream and calls onLine for each log line until the stream ends or
+// ctx is done.
+func tailLogs(ctx context.Context, hatchetClient client.Client, tenantUUID openapi_types.UUID, params *rest.V1TenantLogLineTailParams, onLine func(id int64, line rest.V1LogLine)) error { //nolint:staticcheck
+	resp, err := hatchetClient.API().V1TenantLogLineTail(ctx, tenantUUID, params)
+	if err != nil {
+		return fmt.Errorf("failed to open log stream: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		cli.Logger.Fatalf("unexpected response from API (status %d): %s", resp.StatusCode, string(body))
+	}
+
+	return readLogEvents(resp.Body, onLine)
+}
+
+// readLogEvents reads the server-sent events of the log tail stream and calls onLine for each log
+// event. Comments, such as keep-alives, and other events are ignored.
+func readLogEvents(r io.Reader, onLine func(id int64, line rest.V1LogLine)) error {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 4*1024*1024)
+
+	var (
+		id    int64
+		event string
+		data  strings.Builder
+	)
+
+	for scanner.Scan() {
+		text := scanner.Text()
+
+		if text == "" {
+			// a blank line dispatches the event
+			if event == "log" && data.Len() > 0 {
+				var line rest.V1LogLine
+				if err := json.Unmarshal([]byte(data.String()), &line); err != nil {
+					return fmt.Errorf("invalid log line: %w", err)
+				}
+				onLine(id, line)
+			}
+
+			event = ""
+			data.Reset()
+			continue
+		}
+
+		if strings.HasPrefix(text, ":") {
+			continue
+		}
+
+		field, value, _ := strings.Cut(text, ":")
+		value = strings.TrimPrefix(value, " ")
+
+		switch field {
+		case "id":
+			if parsed, err := strconv.ParseInt(value, 10, 64); err == nil {
+				id = parsed
+			}
+		case "event":
+			event = value
+		case "data":
+			if data.Len() > 0 {
+				data.WriteByte('\n')
+			}
+			data.WriteString(value)
+		}
+	}
+
+	return scanner.Err()
+}
+
+func printTenantLogLine(line rest.V1LogLine) {
+	ts := line.CreatedAt.Local().Format("15:04:05.000")
+
+	task := ""
+	if line.TaskDisplayName != nil {
+		task = *line.TaskDisplayName
+	}
+
+	level := ""
+	if line.Level != nil {
+		level = string(*line.Level)
+	}
+
+	switch {
+	case task != "" && level != "":
+		fmt.Printf("[%s] %s %-8s %s\n", task, ts, level, line.Message)
+	case task != "":
+		fmt.Printf("[%s] %s %s\n", task, ts, line.Message)
+	case level != "":
+		fmt.Printf("%s %-8s %s\n", ts, level, line.Message)
+	default:
+		fmt.Printf("%s %s\n", ts, line.Message)
+	}
+}
+
+func addLogFilterFlags(cmd *cobra.Command) {
+	cmd.Flags().StringSliceP("workflow", "w", nil, "Filter by workflow name or ID (repeatable)")
+	cmd.Flags().StringSliceP("level", "l", nil, "Filter by level: DEBUG, INFO, WARN, ERROR (repeatable)")
+	cmd.Flags().StringSlice("worker", nil, "Filter by the ID of the worker which wrote the line (repeatable)")
+	cmd.Flags().String("search", "", "Only show lines containing this text")
+	cmd.Flags().StringP("query", "q", "", `Full-text query: words, "quoted phrases", OR, and -excluded words`)
+	cmd.Flags().StringArray("metadata", nil, "Only show lines whose metadata has key:value, with dot-separated keys for nested fields (repeatable)")
+}
+
+func init() {
+	rootCmd.AddCommand(logsCmd)
+	logsCmd.AddCommand(logsSearchCmd, logsTailCmd)
+
+	logsCmd.PersistentFlags().StringP("profile", "p", "", "Profile to use for connecting to Hatchet (default: prompts for selection)")
+	logsCmd.PersistentFlags().StringP("output", "o", "", "Output format: json")
+
+	addLogFilterFlags(logsSearchCmd)
+	logsSearchCmd.Flags().String("since", "1h", "Only show logs newer than this duration ago (e.g. 5m, 1h, 7d)")
+	logsSearchCmd.Flags().String("until", "", "Only show logs older than this duration ago (e.g. 5m, 1h)")
+	logsSearchCmd.Flags().Int64("limit", 100, "Maximum number of lines to show")
+
+	addLogFilterFlags(logsTailCmd)
+}
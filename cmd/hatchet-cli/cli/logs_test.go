@@ -0,0 +1,58 @@
+package cli
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hatchet-dev/hatchet/pkg/client/rest"
+)
+
+func TestReadLogEvents(t *testing.T) {
+	stream := strings.Join([]string{
+		": keep-alive",
+		"",
+		"id: 41",
+		"event: log",
+		`data: {"createdAt":"2026-01-02T15:04:05Z","message":"first","metadata":{}}`,
+		"",
+		"id: 42",
+		"event: other",
+		`data: {"message":"ignored"}`,
+		"",
+		"id: 43",
+		"event: log",
+		`data: {"createdAt":"2026-01-02T15:04:06Z","message":"second","level":"ERROR","metadata":{}}`,
+		"",
+	}, "\n") + "\n"
+
+	var ids []int64
+	var messages []string
+
+	err := readLogEvents(strings.NewReader(stream), func(id int64, line rest.V1LogLine) {
+		ids = append(ids, id)
+		messages = append(messages, line.Message)
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, []int64{41, 43}, ids)
+	assert.Equal(t, []string{"first", "second"}, messages)
+}
+
+func TestReadLogEventsInvalidData(t *testing.T) {
+	err := readLogEvents(strings.NewReader("id: 1\nevent: log\ndata: {\n\n"), func(int64, rest.V1LogLine) {})
+
+	assert.Error(t, err)
+}
+
+func TestParseLogLevels(t *testing.T) {
+	levels, err := parseLogLevels([]string{"error", "Warning", "INFO"})
+
+	require.NoError(t, err)
+	assert.Equal(t, []rest.V1LogLineLevel{rest.V1LogLineLevelERROR, rest.V1LogLineLevelWARN, rest.V1LogLineLevelINFO}, levels)
+
+	_, err = parseLogLevels([]string{"fatal"})
+	assert.Error(t, err)
+}
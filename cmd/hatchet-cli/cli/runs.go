@@ -29,7 +29,7 @@ var runsCmd = &cobra.Command{
 	Use:     "runs",
 	Aliases: []string{"run"},
 	Short:   "Manage runs",
-	Long:    `Commands for listing, inspecting, cancelling, replaying, and viewing logs/events for runs.`,
+	Long:    `Commands for listing, inspecting, cancelling, replaying, signalling, and viewing logs/events for runs.`,
 	Run: func(cmd *cobra.Command, args []string) {
 		_ = cmd.Help()
 	},
@@ -44,9 +44,19 @@ var runsListCmd = &cobra.Command{
 
   # JSON output with filters
   hatchet runs list -o json --since 24h --status FAILED
-  hatchet runs list -o json --since 1h --workflow my-workflow --limit 100`,
+  hatchet runs list -o json --since 1h --workflow my-workflow --limit 100
+
+  # Search runs by their input, output, error or additional metadata (at most 7 days)
+  hatchet runs list -o json --since 24h --where 'input.customer.email == "a@b.com"'
+  hatchet runs list -o json --since 6h --status FAILED --where 'error.contains("timeout") && additional_metadata.env == "prod"'`,
 	Run: func(cmd *cobra.Command, args []string) {
 		isJSON := isJSONOutput(cmd)
+		where, _ := cmd.Flags().GetString("where")
+
+		if where != "" && !isJSON {
+			cli.Logger.Fatalf("--where requires --output json")
+		}
+
 		selectedProfile, hatchetClient := clientFromCmd(cmd)
 
 		if !isJSON {
@@ -104,6 +114,18 @@ var runsListCmd = &cobra.Command{
 		offset, _ := cmd.Flags().GetInt64("offset")
 		onlyTasks, _ := cmd.Flags().GetBool("only-tasks")
 
+		if where != "" {
+			searchRunsJSON(ctx, hatchetClient, tenantUUID, rest.V1WorkflowRunSearchRequest{
+				Where:       where,
+				Since:       sinceTime,
+				Until:       untilPtr,
+				Statuses:    statusesPtr,
+				WorkflowIds: workflowUUIDs,
+				Limit:       &limit,
+			})
+			return
+		}
+
 		params := &rest.V1WorkflowRunListParams{
 			Since:     sinceTime,
 			Until:     untilPtr,
@@ -180,7 +202,9 @@ var runsCancelCmd = &cobra.Command{
 	Long: `Cancel a specific run by ID, or cancel multiple runs matching filter criteria.
 
 If a run ID is provided, cancels that specific run (task or DAG).
-If no run ID is provided, requires --since flag and cancels runs matching the filter.`,
+If no run ID is provided, requires --since flag and cancels runs matching the filter. Bulk cancels
+run as a background job on the server and show a progress bar until the job finishes; with
+--output json the created job is printed instead.`,
 	Args: cobra.MaximumNArgs(1),
 	Example: `  # Cancel a specific run
   hatchet runs cancel 8ff4f149-099e-4c16-a8d1-0535f8c79b83 --profile local
@@ -265,25 +289,7 @@ If no run ID is provided, requires --since flag and cancels runs matching the fi
 			}
 		}
 
-		resp, err := hatchetClient.API().V1TaskCancelWithResponse(ctx, tenantUUID, rest.V1CancelTaskRequest{
-			Filter: filter,
-		})
-		if err != nil {
-			cli.Logger.Fatalf("failed to cancel runs: %v", err)
-		}
-		if resp.JSON200 == nil {
-			cli.Logger.Fatalf("unexpected response from API (status %d)", resp.StatusCode())
-		}
-
-		if isJSON {
-			printJSON(resp.JSON200)
-		} else {
-			count := 0
-			if resp.JSON200.Ids != nil {
-				count = len(*resp.JSON200.Ids)
-			}
-			fmt.Println(styles.SuccessMessage(fmt.Sprintf("Cancelled %d run(s)", count)))
-		}
+		runBulkJob(ctx, hatchetClient, tenantUUID, rest.V1BulkJobKindCANCEL, filter, isJSON)
 	},
 }
 
@@ -293,7 +299,9 @@ var runsReplayCmd = &cobra.Command{
 	Long: `Replay a specific run by ID, or replay multiple runs matching filter criteria.
 
 If a run ID is provided, replays that specific run (task or DAG).
-If no run ID is provided, requires --since flag and replays runs matching the filter.`,
+If no run ID is provided, requires --since flag and replays runs matching the filter. Bulk replays
+run as a background job on the server and show a progress bar until the job finishes; with
+--output json the created job is printed instead.`,
 	Args: cobra.MaximumNArgs(1),
 	Example: `  # Replay a specific run
   hatchet runs replay 8ff4f149-099e-4c16-a8d1-0535f8c79b83 --profile local
@@ -377,25 +385,7 @@ If no run ID is provided, requires --since flag and replays runs matching the fi
 			}
 		}
 
-		resp, err := hatchetClient.API().V1TaskReplayWithResponse(ctx, tenantUUID, rest.V1ReplayTaskRequest{
-			Filter: filter,
-		})
-		if err != nil {
-			cli.Logger.Fatalf("failed to replay runs: %v", err)
-		}
-		if resp.JSON200 == nil {
-			cli.Logger.Fatalf("unexpected response from API (status %d)", resp.StatusCode())
-		}
-
-		if isJSON {
-			printJSON(resp.JSON200)
-		} else {
-			count := 0
-			if resp.JSON200.Ids != nil {
-				count = len(*resp.JSON200.Ids)
-			}
-			fmt.Println(styles.SuccessMessage(fmt.Sprintf("Replayed %d run(s)", count)))
-		}
+		runBulkJob(ctx, hatchetClient, tenantUUID, rest.V1BulkJobKindREPLAY, filter, isJSON)
 	},
 }
 
@@ -833,6 +823,7 @@ func init() {
 	runsListCmd.Flags().Int64("limit", 50, "Number of results to return")
 	runsListCmd.Flags().Int64("offset", 0, "Offset for pagination")
 	runsListCmd.Flags().Bool("only-tasks", false, "Show only task runs, not DAG runs")
+	runsListCmd.Flags().String("where", "", "Search runs with a CEL expression over input, output, error and additional_metadata (requires --output json)")
 
 	// runs cancel flags
 	runsCancelCmd.Flags().StringP("since", "s", "", "Cancel runs since this duration ago (e.g. 1h, 24h) [required for bulk cancel]")
@@ -865,6 +856,22 @@ func isJSONOutput(cmd *cobra.Command) bool {
 }
 
 // parseSinceDuration parses a duration string (e.g. "1h", "24h", "7d") into a time.Time
+// searchRunsJSON runs a run search and prints the matching runs as JSON.
+func searchRunsJSON(ctx context.Context, hatchetClient client.Client, tenantUUID openapi_types.UUID, body rest.V1WorkflowRunSearchRequest) { //nolint:staticcheck
+	resp, err := hatchetClient.API().V1WorkflowRunSearchWithResponse(ctx, tenantUUID, body)
+	if err != nil {
+		cli.Logger.Fatalf("failed to search runs: %v", err)
+	}
+	if resp.JSON400 != nil {
+		cli.Logger.Fatalf("invalid search: %s", apiErrorsMessage(resp.JSON400))
+	}
+	if resp.JSON200 == nil {
+		cli.Logger.Fatalf("unexpected response from API (status %d)", resp.StatusCode())
+	}
+
+	printJSON(resp.JSON200)
+}
+
 func parseSinceDuration(s string) (time.Time, error) {
 	if s == "" {
 		return time.Time{}, fmt.Errorf("duration cannot be empty")
@@ -0,0 +1,249 @@
+package cli
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/spf13/cobra"
+
+	"github.com/hatchet-dev/hatchet/cmd/hatchet-cli/cli/internal/config/cli"
+	"github.com/hatchet-dev/hatchet/cmd/hatchet-cli/cli/internal/styles"
+	"github.com/hatchet-dev/hatchet/pkg/client/rest"
+)
+
+var runsReplayLocalCmd = &cobra.Command{
+	Use:   "replay-local <run-id>",
+	Short: "Replay a run from one profile on another",
+	Long: `Fetch a run's input and additional metadata from one profile, such as production, and trigger it again on
+another, such as a local server with a dev worker.
+
+By default the whole run is replayed. With --task, only that task of the run is replayed. With --stub-parents, the
+task sees the outputs its parents recorded in the original run, so the parents don't run again.
+
+The workflow must be registered on the target profile, e.g. by running hatchet worker dev.`,
+	Args: cobra.ExactArgs(1),
+	Example: `  # Replay a production run against a local dev worker
+  hatchet runs replay-local 8ff4f149-099e-4c16-a8d1-0535f8c79b83 --from-profile prod --to-profile local
+
+  # Replay only the task which failed, with the outputs its parents recorded in production
+  hatchet runs replay-local 8ff4f149-099e-4c16-a8d1-0535f8c79b83 --from-profile prod --to-profile local \
+    --task process-order --stub-parents
+
+  # Print the replay request without triggering it
+  hatchet runs replay-local 8ff4f149-099e-4c16-a8d1-0535f8c79b83 --from-profile prod --to-profile local --dry-run`,
+	Run: func(cmd *cobra.Command, args []string) {
+		runUUID, err := uuid.Parse(args[0])
+		if err != nil {
+			cli.Logger.Fatalf("invalid run ID %q: %v", args[0], err)
+		}
+
+		fromProfile, _ := cmd.Flags().GetString("from-profile")
+		toProfile, _ := cmd.Flags().GetString("to-profile")
+		taskName, _ := cmd.Flags().GetString("task")
+		stubParents, _ := cmd.Flags().GetBool("stub-parents")
+		dryRun, _ := cmd.Flags().GetBool("dry-run")
+		isJSON := isJSONOutput(cmd)
+
+		if fromProfile == "" || toProfile == "" {
+			cli.Logger.Fatal("--from-profile and --to-profile are required")
+		}
+
+		ctx := cmd.Context()
+		fromClient := clientFromProfileName(fromProfile)
+
+		runResp, err := fromClient.API().V1WorkflowRunGetWithResponse(ctx, runUUID)
+		if err != nil {
+			cli.Logger.Fatalf("failed to get run: %v", err)
+		}
+		if runResp.JSON200 == nil {
+			cli.Logger.Fatalf("run not found on profile '%s' (status %d)", fromProfile, runResp.StatusCode())
+		}
+
+		details := runResp.JSON200
+
+		workflowName := ""
+		for _, task := range details.Tasks {
+			if task.WorkflowName != nil {
+				workflowName = *task.WorkflowName
+				break
+			}
+		}
+		if workflowName == "" {
+			workflowName, err = resolveWorkflowName(ctx, fromClient, details.Run.WorkflowId.String())
+			if err != nil {
+				cli.Logger.Fatalf("%v", err)
+			}
+		}
+
+		req, err := newReplayRequest(details, workflowName, taskName, stubParents)
+		if err != nil {
+			cli.Logger.Fatalf("%v", err)
+		}
+
+		if dryRun {
+			printJSON(req)
+			return
+		}
+
+		toClient := clientFromProfileName(toProfile)
+
+		resp, err := toClient.API().V1WorkflowRunCreateWithResponse(ctx, clientTenantUUID(toClient), *req)
+		if err != nil {
+			cli.Logger.Fatalf("failed to trigger run: %v", err)
+		}
+		if resp.JSON400 != nil {
+			cli.Logger.Fatalf("could not replay run on profile '%s': %s", toProfile, apiErrorsMessage(resp.JSON400))
+		}
+		if resp.JSON200 == nil {
+			cli.Logger.Fatalf("unexpected response from API (status %d)", resp.StatusCode())
+		}
+
+		stubbed := make([]string, 0)
+		if req.ParentOutputs != nil {
+			for name := range *req.ParentOutputs {
+				stubbed = append(stubbed, name)
+			}
+			sort.Strings(stubbed)
+		}
+
+		runID := resp.JSON200.Run.Metadata.Id
+
+		if isJSON {
+			printJSON(struct {
+				RunID          string   `json:"runId"`
+				Workflow       string   `json:"workflow"`
+				Task           string   `json:"task,omitempty"`
+				StubbedParents []string `json:"stubbedParents"`
+			}{RunID: runID, Workflow: workflowName, Task: taskName, StubbedParents: stubbed})
+			return
+		}
+
+		fmt.Println(styles.SuccessMessage(fmt.Sprintf("Replayed run %s on profile '%s'", shortID(args[0]), toProfile)))
+		fmt.Println()
+		fmt.Println(styles.KeyValue("Run ID", runID))
+		fmt.Println(styles.KeyValue("Workflow", workflowName))
+		if taskName != "" {
+			fmt.Println(styles.KeyValue("Task", taskName))
+		}
+		if len(stubbed) > 0 {
+			fmt.Println(styles.KeyValue("Stubbed parents", strings.Join(stubbed, ", ")))
+		}
+	},
+}
+
+func init() {
+	runsCmd.AddCommand(runsReplayLocalCmd)
+
+	runsReplayLocalCmd.Flags().String("from-profile", "", "Profile to fetch the run from")
+	runsReplayLocalCmd.Flags().String("to-profile", "", "Profile to replay the run on")
+	runsReplayLocalCmd.Flags().StringP("task", "t", "", "Only replay this task of the run (default: the whole run)")
+	runsReplayLocalCmd.Flags().Bool("stub-parents", false, "Give the task the outputs its parents recorded in the run instead of running them (requires --task)")
+	runsReplayLocalCmd.Flags().Bool("dry-run", false, "Print the replay request as JSON without triggering it")
+}
+
+// newReplayRequest builds the request which triggers the run again. With a task name, only that
+// task is triggered, and with stubParents it gets the outputs its parents recorded in the run.
+func newReplayRequest(details *rest.V1WorkflowRunDetails, workflowName, taskName string, stubParents bool) (*rest.V1TriggerWorkflowRunRequest, error) {
+	if details.Run.PayloadsRestricted != nil && *details.Run.PayloadsRestricted {
+		return nil, fmt.Errorf("the run's payloads are hidden from this profile's user, so it can't be replayed")
+	}
+
+	returnOnlyId := true
+
+	req := &rest.V1TriggerWorkflowRunRequest{
+		WorkflowName:       workflowName,
+		Input:              replayInput(details),
+		AdditionalMetadata: details.Run.AdditionalMetadata,
+		ReturnOnlyId:       &returnOnlyId,
+	}
+
+	if taskName == "" {
+		if stubParents {
+			return nil, fmt.Errorf("--stub-parents requires --task")
+		}
+
+		return req, nil
+	}
+
+	task, err := findRunTask(details, taskName)
+	if err != nil {
+		return nil, err
+	}
+
+	if task.ActionId == nil {
+		return nil, fmt.Errorf("task %q has no action id", taskName)
+	}
+
+	req.TargetActionId = task.ActionId
+
+	parents, _ := task.Input["parents"].(map[string]interface{})
+
+	if len(parents) == 0 {
+		return req, nil
+	}
+
+	if !stubParents {
+		return nil, fmt.Errorf("task %q has parents, pass --stub-parents to replay it with their recorded outputs, or leave out --task to replay the whole run", taskName)
+	}
+
+	parentOutputs := make(map[string]map[string]interface{}, len(parents))
+
+	for name, output := range parents {
+		outputMap, ok := output.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("output of parent %q is not a JSON object", name)
+		}
+
+		parentOutputs[name] = outputMap
+	}
+
+	req.ParentOutputs = &parentOutputs
+
+	return req, nil
+}
+
+// replayInput returns the input the run was triggered with. A task's input holds the trigger input
+// under "input", next to the outputs of its parents.
+func replayInput(details *rest.V1WorkflowRunDetails) map[string]interface{} {
+	for _, task := range details.Tasks {
+		if raw, ok := task.Input["input"]; ok {
+			input, _ := raw.(map[string]interface{})
+			if input == nil {
+				input = map[string]interface{}{}
+			}
+
+			return input
+		}
+	}
+
+	return details.Run.Input
+}
+
+// findRunTask returns the task of the run with the given name.
+func findRunTask(details *rest.V1WorkflowRunDetails, taskName string) (*rest.V1TaskSummary, error) {
+	names := make([]string, 0, len(details.Shape))
+
+	for _, item := range details.Shape {
+		names = append(names, item.TaskName)
+
+		if item.TaskName != taskName {
+			continue
+		}
+
+		for i := range details.Tasks {
+			task := &details.Tasks[i]
+
+			if task.StepId != nil && *task.StepId == item.StepId {
+				return task, nil
+			}
+		}
+
+		return nil, fmt.Errorf("task %q didn't run in this run", taskName)
+	}
+
+	sort.Strings(names)
+
+	return nil, fmt.Errorf("run has no task %q (tasks: %s)", taskName, strings.Join(names, ", "))
+}
@@ -0,0 +1,91 @@
+package cli
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hatchet-dev/hatchet/pkg/client/rest"
+)
+
+func replayTestRun() *rest.V1WorkflowRunDetails {
+	fetchStep := uuid.New()
+	processStep := uuid.New()
+
+	fetchAction := "orders:fetch"
+	processAction := "orders:process"
+
+	return &rest.V1WorkflowRunDetails{
+		Run: rest.V1WorkflowRun{
+			AdditionalMetadata: &map[string]interface{}{"customer": "acme"},
+		},
+		Shape: rest.WorkflowRunShapeForWorkflowRunDetails{
+			{StepId: fetchStep, TaskName: "fetch", ChildrenStepIds: []uuid.UUID{processStep}},
+			{StepId: processStep, TaskName: "process"},
+		},
+		Tasks: []rest.V1TaskSummary{
+			{
+				StepId:   &fetchStep,
+				ActionId: &fetchAction,
+				Input:    map[string]interface{}{"input": map[string]interface{}{"order_id": "42"}, "parents": map[string]interface{}{}},
+			},
+			{
+				StepId:   &processStep,
+				ActionId: &processAction,
+				Input: map[string]interface{}{
+					"input":   map[string]interface{}{"order_id": "42"},
+					"parents": map[string]interface{}{"fetch": map[string]interface{}{"items": float64(3)}},
+				},
+			},
+		},
+	}
+}
+
+func TestNewReplayRequest(t *testing.T) {
+	details := replayTestRun()
+
+	req, err := newReplayRequest(details, "orders", "", false)
+
+	require.NoError(t, err)
+	assert.Equal(t, "orders", req.WorkflowName)
+	assert.Equal(t, map[string]interface{}{"order_id": "42"}, req.Input)
+	assert.Equal(t, details.Run.AdditionalMetadata, req.AdditionalMetadata)
+	assert.Nil(t, req.TargetActionId)
+	assert.Nil(t, req.ParentOutputs)
+
+	req, err = newReplayRequest(details, "orders", "process", true)
+
+	require.NoError(t, err)
+	require.NotNil(t, req.TargetActionId)
+	assert.Equal(t, "orders:process", *req.TargetActionId)
+	require.NotNil(t, req.ParentOutputs)
+	assert.Equal(t, map[string]map[string]interface{}{"fetch": {"items": float64(3)}}, *req.ParentOutputs)
+
+	// a task without parents doesn't need stubbing
+	req, err = newReplayRequest(details, "orders", "fetch", false)
+
+	require.NoError(t, err)
+	assert.Equal(t, "orders:fetch", *req.TargetActionId)
+	assert.Nil(t, req.ParentOutputs)
+}
+
+func TestNewReplayRequestErrors(t *testing.T) {
+	details := replayTestRun()
+
+	_, err := newReplayRequest(details, "orders", "process", false)
+	assert.ErrorContains(t, err, "pass --stub-parents")
+
+	_, err = newReplayRequest(details, "orders", "", true)
+	assert.ErrorContains(t, err, "--stub-parents requires --task")
+
+	_, err = newReplayRequest(details, "orders", "ship", false)
+	assert.ErrorContains(t, err, `run has no task "ship" (tasks: fetch, process)`)
+
+	restricted := true
+	details.Run.PayloadsRestricted = &restricted
+
+	_, err = newReplayRequest(details, "orders", "", false)
+	assert.ErrorContains(t, err, "payloads are hidden")
+}
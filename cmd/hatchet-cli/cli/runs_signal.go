@@ -0,0 +1,116 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/google/uuid"
+	"github.com/spf13/cobra"
+
+	"github.com/hatchet-dev/hatchet/cmd/hatchet-cli/cli/internal/config/cli"
+	"github.com/hatchet-dev/hatchet/cmd/hatchet-cli/cli/internal/styles"
+	"github.com/hatchet-dev/hatchet/pkg/client/rest"
+)
+
+var runsSignalCmd = &cobra.Command{
+	Use:   "signal <run-id> <name>",
+	Short: "Send a signal to a waiting run",
+	Long: `Deliver a signal to the conditions a single run is waiting on, such as a durable task waiting for an event
+or a task's event trigger conditions. The signal name is matched against the event key of the waiting conditions,
+regardless of their scope. Fails if the run isn't waiting on the signal.`,
+	Args: cobra.ExactArgs(2),
+	Example: `  # Approve a paused run
+  hatchet runs signal 8ff4f149-099e-4c16-a8d1-0535f8c79b83 approval --data '{"approved": true}'
+
+  # Read the payload from a file, and print the result as JSON
+  hatchet runs signal 8ff4f149-099e-4c16-a8d1-0535f8c79b83 approval --data-file approval.json -o json`,
+	Run: func(cmd *cobra.Command, args []string) {
+		runUUID, err := uuid.Parse(args[0])
+		if err != nil {
+			cli.Logger.Fatalf("invalid run ID %q: %v", args[0], err)
+		}
+
+		name := args[1]
+		dataStr, _ := cmd.Flags().GetString("data")
+		dataFile, _ := cmd.Flags().GetString("data-file")
+		signalIdStr, _ := cmd.Flags().GetString("signal-id")
+
+		req := rest.V1SignalRunRequest{
+			RunExternalId: runUUID,
+			Name:          name,
+		}
+
+		if dataFile != "" {
+			data, err := os.ReadFile(dataFile)
+			if err != nil {
+				cli.Logger.Fatalf("failed to read --data-file: %v", err)
+			}
+			dataStr = string(data)
+		}
+
+		if dataStr != "" {
+			payload := map[string]interface{}{}
+			if err := json.Unmarshal([]byte(dataStr), &payload); err != nil {
+				cli.Logger.Fatalf("failed to parse signal data as a JSON object: %v", err)
+			}
+			req.Payload = &payload
+		}
+
+		if signalIdStr != "" {
+			signalId, err := uuid.Parse(signalIdStr)
+			if err != nil {
+				cli.Logger.Fatalf("invalid signal ID %q: %v", signalIdStr, err)
+			}
+			req.SignalId = &signalId
+		}
+
+		_, hatchetClient := clientFromCmd(cmd)
+
+		resp, err := hatchetClient.API().V1WorkflowRunSignalWithResponse(cmd.Context(), clientTenantUUID(hatchetClient), req)
+		if err != nil {
+			cli.Logger.Fatalf("failed to signal run: %v", err)
+		}
+
+		for _, apiErrs := range []*rest.APIErrors{resp.JSON400, resp.JSON404, resp.JSON409} {
+			if apiErrs != nil {
+				cli.Logger.Fatalf("signal %q was not delivered: %s", name, apiErrorsMessage(apiErrs))
+			}
+		}
+
+		if resp.JSON200 == nil {
+			cli.Logger.Fatalf("unexpected response from API (status %d)", resp.StatusCode())
+		}
+
+		if isJSONOutput(cmd) {
+			printJSON(resp.JSON200)
+			return
+		}
+
+		result := resp.JSON200
+
+		fmt.Println(styles.SuccessMessage(fmt.Sprintf("Delivered signal %s to run %s", name, runUUID)))
+		fmt.Println(styles.KeyValue("Signal ID", result.SignalId.String()))
+		fmt.Println(styles.KeyValue("Matched conditions", fmt.Sprintf("%d of %d", result.MatchedConditions, result.WaitingConditions)))
+
+		for _, id := range result.CreatedTasks {
+			fmt.Println(styles.KeyValue("Created task", id.String()))
+		}
+
+		for _, id := range result.ResumedTasks {
+			fmt.Println(styles.KeyValue("Resumed task", id.String()))
+		}
+
+		if result.MatchedConditions == 0 {
+			fmt.Println(styles.InfoMessage("The run is waiting on this signal, but no condition's expression matched the payload"))
+		}
+	},
+}
+
+func init() {
+	runsCmd.AddCommand(runsSignalCmd)
+
+	runsSignalCmd.Flags().StringP("data", "d", "", "The signal payload, as a JSON object")
+	runsSignalCmd.Flags().String("data-file", "", "Read the signal payload from a JSON file")
+	runsSignalCmd.Flags().String("signal-id", "", "A unique ID for the signal (default: generated)")
+}
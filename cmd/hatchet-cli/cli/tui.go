@@ -100,6 +100,7 @@ const (
 	ViewTypeScheduledRuns
 	ViewTypeCronJobs
 	ViewTypeWebhooks
+	ViewTypeCircuitBreakers
 )
 
 // viewOption represents a selectable view in the view selector
@@ -118,6 +119,7 @@ var availableViews = []viewOption{
 	{Type: ViewTypeScheduledRuns, Name: "Scheduled Runs", Description: "View scheduled runs"},
 	{Type: ViewTypeCronJobs, Name: "Cron Jobs", Description: "View cron jobs"},
 	{Type: ViewTypeWebhooks, Name: "Webhooks", Description: "View webhooks"},
+	{Type: ViewTypeCircuitBreakers, Name: "Circuit Breakers", Description: "View circuit breaker state"},
 }
 
 // tuiModel is the root model that manages different views
@@ -578,6 +580,8 @@ func (m tuiModel) createViewForType(viewType ViewType) tui.View {
 		return tui.NewCronJobsView(m.ctx)
 	case ViewTypeWebhooks:
 		return tui.NewWebhooksView(m.ctx)
+	case ViewTypeCircuitBreakers:
+		return tui.NewCircuitBreakersView(m.ctx)
 	default:
 		return tui.NewRunsListView(m.ctx)
 	}
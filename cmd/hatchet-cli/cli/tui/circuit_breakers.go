@@ -0,0 +1,311 @@
+package tui
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbles/table"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/google/uuid"
+
+	"github.com/hatchet-dev/hatchet/cmd/hatchet-cli/cli/internal/styles"
+	"github.com/hatchet-dev/hatchet/pkg/client/rest"
+)
+
+// CircuitBreakersView displays the state of the tenant's circuit breakers in a table
+type CircuitBreakersView struct {
+	lastFetch       time.Time
+	table           *TableWithStyleFunc
+	debugLogger     *DebugLogger
+	circuitBreakers []rest.V1CircuitBreaker
+	BaseModel
+	loading   bool
+	showDebug bool
+}
+
+// circuitBreakersMsg contains the fetched circuit breakers
+type circuitBreakersMsg struct {
+	err             error
+	debugInfo       string
+	circuitBreakers []rest.V1CircuitBreaker
+}
+
+// circuitBreakerTickMsg is sent periodically to refresh the data
+type circuitBreakerTickMsg time.Time
+
+// NewCircuitBreakersView creates a new circuit breakers list view
+func NewCircuitBreakersView(ctx ViewContext) *CircuitBreakersView {
+	v := &CircuitBreakersView{
+		BaseModel:   BaseModel{Ctx: ctx},
+		loading:     false,
+		debugLogger: NewDebugLogger(5000),
+		showDebug:   false,
+	}
+
+	columns := []table.Column{
+		{Title: "Key", Width: 35},
+		{Title: "State", Width: 10},
+		{Title: "Failures", Width: 10},
+		{Title: "Window", Width: 10},
+		{Title: "Cooldown", Width: 10},
+		{Title: "Opened At", Width: 18},
+	}
+
+	t := NewTableWithStyleFunc(
+		table.WithColumns(columns),
+		table.WithFocused(true),
+		table.WithHeight(20),
+	)
+
+	s := table.DefaultStyles()
+	s.Header = s.Header.
+		BorderStyle(lipgloss.NormalBorder()).
+		BorderForeground(styles.AccentColor).
+		BorderBottom(true).
+		Bold(true).
+		Foreground(styles.AccentColor)
+	s.Selected = s.Selected.
+		Foreground(lipgloss.AdaptiveColor{Light: "#ffffff", Dark: "#0A1029"}).
+		Background(styles.Blue).
+		Bold(true)
+	s.Cell = lipgloss.NewStyle()
+	t.SetStyles(s)
+
+	// Style: red while open, yellow while a half-open probe is running.
+	t.SetStyleFunc(func(row, col int) lipgloss.Style {
+		if row < len(v.circuitBreakers) {
+			switch v.circuitBreakers[row].State {
+			case rest.OPEN:
+				return lipgloss.NewStyle().Foreground(styles.StatusFailedColor)
+			case rest.HALFOPEN:
+				return lipgloss.NewStyle().Foreground(styles.StatusInProgressColor)
+			}
+		}
+		return lipgloss.NewStyle()
+	})
+
+	v.table = t
+	return v
+}
+
+// Init initializes the view
+func (v *CircuitBreakersView) Init() tea.Cmd {
+	return tea.Batch(v.fetchCircuitBreakers(), circuitBreakerTick())
+}
+
+// Update handles messages and updates the view state
+func (v *CircuitBreakersView) Update(msg tea.Msg) (View, tea.Cmd) {
+	var cmd tea.Cmd
+
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		v.SetSize(msg.Width, msg.Height)
+		v.table.SetHeight(msg.Height - 12)
+		return v, nil
+
+	case tea.KeyMsg:
+		if v.showDebug {
+			if handled, debugCmd := HandleDebugKeyboard(v.debugLogger, msg.String()); handled {
+				return v, debugCmd
+			}
+		}
+
+		switch msg.String() {
+		case "r":
+			v.loading = true
+			return v, v.fetchCircuitBreakers()
+		case "d":
+			v.showDebug = !v.showDebug
+			return v, nil
+		case "c":
+			if v.showDebug && !v.debugLogger.IsPromptingFile() {
+				v.debugLogger.Clear()
+			}
+			return v, nil
+		case "w":
+			if v.showDebug && !v.debugLogger.IsPromptingFile() {
+				v.debugLogger.StartFilePrompt()
+			}
+			return v, nil
+		}
+
+	case circuitBreakerTickMsg:
+		return v, tea.Batch(v.fetchCircuitBreakers(), circuitBreakerTick())
+
+	case circuitBreakersMsg:
+		v.loading = false
+		if msg.err != nil {
+			v.HandleError(msg.err)
+			v.debugLogger.Log("Error fetching circuit breakers: %v", msg.err)
+		} else {
+			v.circuitBreakers = msg.circuitBreakers
+			v.updateTableRows()
+			v.lastFetch = time.Now()
+			v.ClearError()
+			v.debugLogger.Log("Fetched %d circuit breakers", len(msg.circuitBreakers))
+		}
+		if msg.debugInfo != "" {
+			v.debugLogger.Log("API: %s", msg.debugInfo)
+		}
+		return v, nil
+	}
+
+	if mouseMsg, ok := msg.(tea.MouseMsg); ok {
+		if mouseMsg.Action == tea.MouseActionPress {
+			switch mouseMsg.Button {
+			case tea.MouseButtonWheelUp:
+				if v.table.Cursor() > 0 {
+					upMsg := tea.KeyMsg{Type: tea.KeyUp}
+					_, cmd = v.table.Update(upMsg)
+					return v, cmd
+				}
+			case tea.MouseButtonWheelDown:
+				if v.table.Cursor() < len(v.circuitBreakers)-1 {
+					downMsg := tea.KeyMsg{Type: tea.KeyDown}
+					_, cmd = v.table.Update(downMsg)
+					return v, cmd
+				}
+			}
+		}
+	}
+
+	_, cmd = v.table.Update(msg)
+	return v, cmd
+}
+
+// View renders the view to a string
+func (v *CircuitBreakersView) View() string {
+	if v.Width == 0 {
+		return "Initializing..."
+	}
+
+	if v.showDebug {
+		return RenderDebugView(v.debugLogger, v.Width, v.Height, "")
+	}
+
+	header := RenderHeaderWithViewIndicator("Circuit Breakers", v.Ctx.ProfileName, v.Width)
+
+	open := 0
+	for _, cb := range v.circuitBreakers {
+		if cb.State != rest.CLOSED {
+			open++
+		}
+	}
+
+	statsStyle := lipgloss.NewStyle().Foreground(styles.MutedColor).Padding(0, 1)
+	stats := statsStyle.Render(fmt.Sprintf("Total: %d  Open: %d", len(v.circuitBreakers), open))
+
+	loadingText := ""
+	if v.loading {
+		loadingStyle := lipgloss.NewStyle().Foreground(styles.AccentColor).Padding(0, 1)
+		loadingText = loadingStyle.Render("Loading...")
+	}
+
+	controlItems := []string{
+		"↑/↓: Navigate",
+		"r: Refresh",
+		"d: Debug",
+		"h: Help",
+		"shift+tab: Switch View",
+		"q: Quit",
+	}
+	controls := RenderFooter(controlItems, v.Width)
+
+	var b strings.Builder
+	b.WriteString(header)
+	b.WriteString("\n\n")
+	b.WriteString(stats)
+	if loadingText != "" {
+		b.WriteString("  ")
+		b.WriteString(loadingText)
+	}
+	b.WriteString("\n\n")
+	b.WriteString(v.table.View())
+	b.WriteString("\n\n")
+
+	if v.Err != nil {
+		b.WriteString(RenderError(fmt.Sprintf("Error: %v", v.Err), v.Width))
+		b.WriteString("\n")
+	}
+
+	if !v.lastFetch.IsZero() {
+		lastFetchStyle := lipgloss.NewStyle().Foreground(styles.MutedColor).Padding(0, 1)
+		b.WriteString(lastFetchStyle.Render(fmt.Sprintf("Last updated: %s", v.lastFetch.Format("15:04:05"))))
+		b.WriteString("\n")
+	}
+
+	b.WriteString(controls)
+	return b.String()
+}
+
+// SetSize updates the view dimensions
+func (v *CircuitBreakersView) SetSize(width, height int) {
+	v.BaseModel.SetSize(width, height)
+	if height > 12 {
+		v.table.SetHeight(height - 12)
+	}
+}
+
+// fetchCircuitBreakers fetches circuit breakers from the API
+func (v *CircuitBreakersView) fetchCircuitBreakers() tea.Cmd {
+	return func() tea.Msg {
+		ctx := context.Background()
+
+		tenantUUID, err := uuid.Parse(v.Ctx.Client.TenantId())
+		if err != nil {
+			return circuitBreakersMsg{err: fmt.Errorf("invalid tenant ID: %w", err)}
+		}
+
+		response, err := v.Ctx.Client.API().V1CircuitBreakerListWithResponse(ctx, tenantUUID)
+		if err != nil {
+			return circuitBreakersMsg{
+				err:       fmt.Errorf("failed to fetch circuit breakers: %w", err),
+				debugInfo: "Error: " + err.Error(),
+			}
+		}
+		if response.JSON200 == nil {
+			return circuitBreakersMsg{
+				err:       fmt.Errorf("unexpected response from API: status %d", response.StatusCode()),
+				debugInfo: fmt.Sprintf("Status: %d", response.StatusCode()),
+			}
+		}
+
+		return circuitBreakersMsg{
+			circuitBreakers: response.JSON200.Rows,
+			debugInfo:       fmt.Sprintf("Fetched %d circuit breakers", len(response.JSON200.Rows)),
+		}
+	}
+}
+
+// updateTableRows updates the table rows based on current circuit breakers
+func (v *CircuitBreakersView) updateTableRows() {
+	rows := make([]table.Row, len(v.circuitBreakers))
+
+	for i, cb := range v.circuitBreakers {
+		openedAt := "-"
+		if cb.OpenedAt != nil {
+			openedAt = cb.OpenedAt.Format("01/02 15:04:05")
+		}
+
+		rows[i] = table.Row{
+			cb.Key,
+			string(cb.State),
+			fmt.Sprintf("%d/%d", cb.FailureCount, cb.FailureThreshold),
+			(time.Duration(cb.WindowSeconds) * time.Second).String(),
+			(time.Duration(cb.CooldownSeconds) * time.Second).String(),
+			openedAt,
+		}
+	}
+
+	v.table.SetRows(rows)
+}
+
+// circuitBreakerTick returns a command that sends a tick message after a delay
+func circuitBreakerTick() tea.Cmd {
+	return tea.Tick(5*time.Second, func(t time.Time) tea.Msg {
+		return circuitBreakerTickMsg(t)
+	})
+}
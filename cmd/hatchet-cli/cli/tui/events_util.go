@@ -55,6 +55,8 @@ func FormatEventType(eventType rest.V1TaskEventType) string {
 		return "Skipped"
 	case rest.V1TaskEventTypeCOULDNOTSENDTOWORKER:
 		return "Could not send to worker"
+	case rest.V1TaskEventTypePREEMPTED:
+		return "Preempted by higher-priority task"
 	default:
 		return "Unknown"
 	}
@@ -98,7 +100,8 @@ func GetEventSeverity(eventType rest.V1TaskEventType) EventSeverity {
 		rest.V1TaskEventTypeREQUEUEDNOWORKER,
 		rest.V1TaskEventTypeREQUEUEDRATELIMIT,
 		rest.V1TaskEventTypeRETRIEDBYUSER,
-		rest.V1TaskEventTypeRETRYING:
+		rest.V1TaskEventTypeRETRYING,
+		rest.V1TaskEventTypePREEMPTED:
 		return EventSeverityWarning
 
 	// INFO: Green events (default)
@@ -332,6 +332,10 @@ func (v *WorkerDetailsView) renderWorkerInfo() string {
 			if label.Value != nil {
 				labelVal = *label.Value
 			}
+			// dynamic labels are reported on heartbeats, so show when they last changed
+			if label.Dynamic != nil && *label.Dynamic {
+				labelVal = fmt.Sprintf("%s (dynamic, updated %s)", labelVal, formatRelativeTime(label.Metadata.UpdatedAt))
+			}
 			b.WriteString(labelItemStyle.Render(fmt.Sprintf("• %s: %s", label.Key, labelVal)))
 			b.WriteString("\n")
 		}
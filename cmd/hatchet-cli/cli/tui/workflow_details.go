@@ -21,6 +21,7 @@ type WorkflowDetailsView struct {
 	lastFetch   time.Time
 	table       *TableWithStyleFunc
 	workflow    *rest.Workflow
+	slotUsage   *float64
 	debugLogger *DebugLogger
 	workflowID  string
 	tasks       []rest.V1TaskSummary
@@ -49,6 +50,16 @@ type workflowRunsMsg struct {
 	hasMore    bool
 }
 
+// workflowSlotUsageMsg contains the slot-seconds the workflow consumed over the slot usage window
+type workflowSlotUsageMsg struct {
+	slotSeconds *float64
+	err         error
+	debugInfo   string
+}
+
+// workflowSlotUsageWindow is the window the workflow's slot usage is summed over
+const workflowSlotUsageWindow = 24 * time.Hour
+
 // workflowDetailsTickMsg is sent periodically to refresh the data
 type workflowDetailsTickMsg time.Time
 
@@ -119,7 +130,7 @@ func NewWorkflowDetailsView(ctx ViewContext, workflowID string) *WorkflowDetails
 // Init initializes the view
 func (v *WorkflowDetailsView) Init() tea.Cmd {
 	// Start fetching workflow details and runs immediately
-	return tea.Batch(v.fetchWorkflowDetails(), v.fetchWorkflowRuns(), workflowDetailsTick())
+	return tea.Batch(v.fetchWorkflowDetails(), v.fetchWorkflowRuns(), v.fetchSlotUsage(), workflowDetailsTick())
 }
 
 // Update handles messages and updates the view state
@@ -150,7 +161,7 @@ func (v *WorkflowDetailsView) Update(msg tea.Msg) (View, tea.Cmd) {
 			v.debugLogger.Log("Manual refresh triggered")
 			v.currentOffset = 0
 			v.loading = true
-			return v, tea.Batch(v.fetchWorkflowDetails(), v.fetchWorkflowRuns())
+			return v, tea.Batch(v.fetchWorkflowDetails(), v.fetchWorkflowRuns(), v.fetchSlotUsage())
 		case "d":
 			// Toggle debug view
 			v.showDebug = !v.showDebug
@@ -223,6 +234,18 @@ func (v *WorkflowDetailsView) Update(msg tea.Msg) (View, tea.Cmd) {
 		}
 		return v, nil
 
+	case workflowSlotUsageMsg:
+		// slot usage is informational, so errors (e.g. from servers without slot usage) are only logged
+		if msg.err != nil {
+			v.debugLogger.Log("Error fetching slot usage: %v", msg.err)
+		} else {
+			v.slotUsage = msg.slotSeconds
+		}
+		if msg.debugInfo != "" {
+			v.debugLogger.Log("Slot usage API: %s", msg.debugInfo)
+		}
+		return v, nil
+
 	case workflowRunsMsg:
 		v.loading = false
 		if msg.err != nil {
@@ -414,6 +437,12 @@ func (v *WorkflowDetailsView) renderWorkflowInfo() string {
 		statusParts = append(statusParts, fmt.Sprintf("Updated: %s", updatedStyle.Render(formatRelativeTime(updatedAt))))
 	}
 
+	// Slot usage
+	if v.slotUsage != nil {
+		usageStyle := lipgloss.NewStyle().Foreground(styles.MutedColor)
+		statusParts = append(statusParts, fmt.Sprintf("Slot-seconds (24h): %s", usageStyle.Render(fmt.Sprintf("%.1f", *v.slotUsage))))
+	}
+
 	b.WriteString(infoStyle.Render(strings.Join(statusParts, "  |  ")))
 	b.WriteString("\n")
 
@@ -496,6 +525,58 @@ func (v *WorkflowDetailsView) fetchWorkflowDetails() tea.Cmd {
 	}
 }
 
+// fetchSlotUsage fetches the slot-seconds the workflow consumed over the slot usage window. It isn't
+// refreshed on every tick, since it aggregates over the whole window.
+func (v *WorkflowDetailsView) fetchSlotUsage() tea.Cmd {
+	return func() tea.Msg {
+		ctx := context.Background()
+
+		tenantUUID, err := uuid.Parse(v.Ctx.Client.TenantId())
+		if err != nil {
+			return workflowSlotUsageMsg{err: fmt.Errorf("invalid tenant ID: %w", err)}
+		}
+
+		workflowUUID, err := uuid.Parse(v.workflowID)
+		if err != nil {
+			return workflowSlotUsageMsg{err: fmt.Errorf("invalid workflow ID: %w", err)}
+		}
+
+		workflowIDs := []openapi_types.UUID{workflowUUID}
+		params := &rest.V1TaskGetSlotUsageParams{
+			Since:       time.Now().Add(-workflowSlotUsageWindow),
+			GroupBy:     rest.V1SlotUsageGroupByWorkflow,
+			WorkflowIds: &workflowIDs,
+		}
+
+		debugReq := fmt.Sprintf("Request: tenant=%s, workflow=%s", tenantUUID.String(), workflowUUID.String())
+
+		response, err := v.Ctx.Client.API().V1TaskGetSlotUsageWithResponse(ctx, tenantUUID, params)
+		if err != nil {
+			return workflowSlotUsageMsg{
+				err:       fmt.Errorf("failed to fetch slot usage: %w", err),
+				debugInfo: debugReq + " | Error: " + err.Error(),
+			}
+		}
+
+		if response.JSON200 == nil {
+			return workflowSlotUsageMsg{
+				err:       fmt.Errorf("unexpected response from API: status %d", response.StatusCode()),
+				debugInfo: fmt.Sprintf("Status: %d", response.StatusCode()),
+			}
+		}
+
+		var slotSeconds float64
+		for _, row := range response.JSON200.Rows {
+			slotSeconds += row.SlotSeconds
+		}
+
+		return workflowSlotUsageMsg{
+			slotSeconds: &slotSeconds,
+			debugInfo:   debugReq + fmt.Sprintf(" | Response: rows=%d", len(response.JSON200.Rows)),
+		}
+	}
+}
+
 // fetchWorkflowRuns fetches recent runs for this workflow from the API
 func (v *WorkflowDetailsView) fetchWorkflowRuns() tea.Cmd {
 	return func() tea.Msg {
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"log"
 	"os"
+	"slices"
 	"strings"
 
 	tea "github.com/charmbracelet/bubbletea"
@@ -47,7 +48,7 @@ var workerCmd = &cobra.Command{
 var devCmd = &cobra.Command{
 	Use:   "dev",
 	Short: "Start a development environment for the Hatchet worker",
-	Long:  `Start a Hatchet worker in development mode with automatic reloading on file changes. This command connects to your Hatchet instance using a profile and runs your worker with the configuration specified in hatchet.yaml.`,
+	Long:  `Start a Hatchet worker in development mode with automatic reloading on file changes. This command connects to your Hatchet instance using a profile and runs your worker with the configuration specified in hatchet.yaml. When hatchet.yaml declares dev.workers, every worker runs side by side in startup order, and the dev.fixtures triggers run once all of them have registered.`,
 	Example: `  # Start worker in dev mode (uses the default or only profile, otherwise prompts)
   hatchet worker dev
 
@@ -58,23 +59,38 @@ var devCmd = &cobra.Command{
   hatchet worker dev --profile production --no-reload
 
   # Override the run command
-  hatchet worker dev --run-cmd "npm run dev"`,
+  hatchet worker dev --run-cmd "npm run dev"
+
+  # Run the workers declared in dev.workers, with their output prefixed by name
+  hatchet worker dev --profile local`,
 	Run: func(cmd *cobra.Command, args []string) {
 		// Get flag values
 		profileFlag, _ := cmd.Flags().GetString("profile")
 		noReload, _ := cmd.Flags().GetBool("no-reload")
 		runCmd, _ := cmd.Flags().GetString("run-cmd")
 
+		if err := c.Validate(); err != nil {
+			cli.Logger.Fatalf("invalid hatchet.yaml: %v", err)
+		}
+
 		// Override config with flags if provided
-		devConfig := c.Dev
+		config := *c
 		if noReload {
-			devConfig.Reload = false
+			config.Dev.Reload = false
+
+			config.Dev.Workers = slices.Clone(config.Dev.Workers)
+			for i := range config.Dev.Workers {
+				config.Dev.Workers[i].Reload = nil
+			}
 		}
 		if runCmd != "" {
-			devConfig.RunCmd = runCmd
+			if len(config.Dev.Workers) > 0 {
+				cli.Logger.Fatal("--run-cmd can't be used when hatchet.yaml declares dev.workers")
+			}
+			config.Dev.RunCmd = runCmd
 		}
 
-		startWorker(cmd, &devConfig, profileFlag)
+		startWorker(cmd, &config, profileFlag)
 	},
 }
 
@@ -121,7 +137,7 @@ var workerListCmd = &cobra.Command{
 var workerGetCmd = &cobra.Command{
 	Use:   "get <worker-id>",
 	Short: "Get worker details",
-	Long:  `Get details about a worker. Without --output json, launches the TUI navigated to the worker. With --output json, outputs raw JSON.`,
+	Long:  `Get details about a worker, including the current values of its dynamic labels. Without --output json, launches the TUI navigated to the worker. With --output json, outputs raw JSON.`,
 	Args:  cobra.ExactArgs(1),
 	Example: `  # Launch TUI for a specific worker
   hatchet worker get <worker-id> --profile local
@@ -199,7 +215,7 @@ func init() {
 	workerGetCmd.Flags().StringP("output", "o", "", "Output format: json (skips interactive TUI)")
 }
 
-func startWorker(cmd *cobra.Command, devConfig *worker.WorkerDevConfig, profileFlag string) {
+func startWorker(cmd *cobra.Command, config *worker.WorkerConfig, profileFlag string) {
 	var selectedProfile string
 
 	// Use profile from flag if provided, otherwise use default or show selection form
@@ -226,9 +242,15 @@ func startWorker(cmd *cobra.Command, devConfig *worker.WorkerDevConfig, profileF
 	ctx, cancel := cmdutils.NewInterruptContext()
 	defer cancel()
 
-	fmt.Println(workerStartingView(selectedProfile, devConfig.Reload))
+	fmt.Println(workerStartingView(selectedProfile, &config.Dev))
 
-	if err := RunWorkerDev(ctx, profile, devConfig, nil); err != nil {
+	if len(config.Dev.Workers) > 0 {
+		err = RunWorkersDev(ctx, profile, config, nil)
+	} else {
+		err = RunWorkerDev(ctx, profile, &config.Dev, nil)
+	}
+
+	if err != nil {
 		cli.Logger.Fatalf("error running worker: %v", err)
 	}
 }
@@ -377,20 +399,40 @@ func workerConfigMissingView() string {
 }
 
 // workerStartingView renders the worker starting message
-func workerStartingView(profile string, reloadEnabled bool) string {
+func workerStartingView(profile string, devConfig *worker.WorkerDevConfig) string {
 	var lines []string
 
-	lines = append(lines, styles.SuccessMessage("Starting Hatchet worker"))
+	if len(devConfig.Workers) > 0 {
+		lines = append(lines, styles.SuccessMessage("Starting Hatchet workers"))
+	} else {
+		lines = append(lines, styles.SuccessMessage("Starting Hatchet worker"))
+	}
 	lines = append(lines, "")
 	lines = append(lines, styles.KeyValue("Profile", profile))
 
+	if len(devConfig.Workers) > 0 {
+		names := make([]string, len(devConfig.Workers))
+		for i, w := range devConfig.Workers {
+			names[i] = w.Name
+		}
+		lines = append(lines, styles.KeyValue("Workers", strings.Join(names, ", ")))
+
+		if len(devConfig.Fixtures) > 0 {
+			lines = append(lines, styles.KeyValue("Fixtures", strings.Join(devConfig.Fixtures, ", ")))
+		}
+	}
+
 	reloadStatus := "disabled"
-	if reloadEnabled {
+	if devConfig.Reload {
 		reloadStatus = "enabled"
 	}
 	lines = append(lines, styles.KeyValue("Auto-reload", reloadStatus))
 	lines = append(lines, "")
-	lines = append(lines, styles.Muted.Render("Press Ctrl+C to stop the worker"))
+	if len(devConfig.Workers) > 0 {
+		lines = append(lines, styles.Muted.Render("Press Ctrl+C to stop the workers"))
+	} else {
+		lines = append(lines, styles.Muted.Render("Press Ctrl+C to stop the worker"))
+	}
 
 	return styles.SuccessBox.Render(strings.Join(lines, "\n"))
 }
@@ -0,0 +1,347 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/spf13/cobra"
+
+	"github.com/hatchet-dev/hatchet/cmd/hatchet-cli/cli/internal/config/cli"
+	"github.com/hatchet-dev/hatchet/cmd/hatchet-cli/cli/internal/styles"
+	"github.com/hatchet-dev/hatchet/pkg/client" //nolint:staticcheck
+	"github.com/hatchet-dev/hatchet/pkg/client/rest"
+)
+
+const workerDrainPollInterval = 2 * time.Second
+
+var workerCordonCmd = &cobra.Command{
+	Use:   "cordon [worker-id...]",
+	Short: "Stop assigning new tasks to workers",
+	Long: `Cordon workers by id or label selector, so no new tasks are assigned to them. Tasks already running on the
+workers are unaffected. Use [worker uncordon] to assign tasks to the workers again.`,
+	Example: `  # Cordon a worker
+  hatchet worker cordon 8ff4f149-099e-4c16-a8d1-0535f8c79b83
+
+  # Cordon every worker with the labels pool=gpu and zone=us-east-1a
+  hatchet worker cordon -l pool=gpu,zone=us-east-1a --reason "node maintenance"`,
+	// Override parent PersistentPreRun — no hatchet.yaml required for cordoning
+	PersistentPreRun: func(cmd *cobra.Command, args []string) {},
+	Run: func(cmd *cobra.Command, args []string) {
+		selector := workerSelectorFromCmd(cmd, args)
+		reason, _ := cmd.Flags().GetString("reason")
+
+		req := rest.V1CordonWorkersRequest{
+			Selector: selector,
+		}
+
+		if reason != "" {
+			req.Reason = &reason
+		}
+
+		_, hatchetClient := clientFromCmd(cmd)
+
+		resp, err := hatchetClient.API().V1WorkerCordonWithResponse(cmd.Context(), clientTenantUUID(hatchetClient), req)
+		if err != nil {
+			cli.Logger.Fatalf("failed to cordon workers: %v", err)
+		}
+		if resp.JSON400 != nil {
+			cli.Logger.Fatalf("failed to cordon workers: %s", apiErrorsMessage(resp.JSON400))
+		}
+		if resp.JSON200 == nil {
+			cli.Logger.Fatalf("unexpected response from API (status %d)", resp.StatusCode())
+		}
+
+		if isJSONOutput(cmd) {
+			printJSON(resp.JSON200)
+			return
+		}
+
+		if len(resp.JSON200.Rows) == 0 {
+			fmt.Println(styles.InfoMessage("No workers matched the selector"))
+			return
+		}
+
+		fmt.Println(styles.SuccessMessage(fmt.Sprintf("Cordoned %d worker(s)", len(resp.JSON200.Rows))))
+		printWorkerCordons(resp.JSON200.Rows)
+	},
+}
+
+var workerUncordonCmd = &cobra.Command{
+	Use:   "uncordon [worker-id...]",
+	Short: "Assign tasks to cordoned workers again",
+	Long:  `Uncordon workers by id or label selector, which stops any drain, so tasks are assigned to the workers again.`,
+	Example: `  # Uncordon a worker
+  hatchet worker uncordon 8ff4f149-099e-4c16-a8d1-0535f8c79b83
+
+  # Uncordon every worker with the label pool=gpu
+  hatchet worker uncordon -l pool=gpu`,
+	// Override parent PersistentPreRun — no hatchet.yaml required for uncordoning
+	PersistentPreRun: func(cmd *cobra.Command, args []string) {},
+	Run: func(cmd *cobra.Command, args []string) {
+		selector := workerSelectorFromCmd(cmd, args)
+
+		_, hatchetClient := clientFromCmd(cmd)
+
+		resp, err := hatchetClient.API().V1WorkerUncordonWithResponse(cmd.Context(), clientTenantUUID(hatchetClient), rest.V1UncordonWorkersRequest{
+			Selector: selector,
+		})
+		if err != nil {
+			cli.Logger.Fatalf("failed to uncordon workers: %v", err)
+		}
+		if resp.JSON400 != nil {
+			cli.Logger.Fatalf("failed to uncordon workers: %s", apiErrorsMessage(resp.JSON400))
+		}
+		if resp.JSON200 == nil {
+			cli.Logger.Fatalf("unexpected response from API (status %d)", resp.StatusCode())
+		}
+
+		if isJSONOutput(cmd) {
+			printJSON(resp.JSON200)
+			return
+		}
+
+		if len(resp.JSON200.WorkerIds) == 0 {
+			fmt.Println(styles.InfoMessage("No cordoned workers matched the selector"))
+			return
+		}
+
+		fmt.Println(styles.SuccessMessage(fmt.Sprintf("Uncordoned %d worker(s)", len(resp.JSON200.WorkerIds))))
+
+		for _, id := range resp.JSON200.WorkerIds {
+			fmt.Printf("  %s\n", id)
+		}
+	},
+}
+
+var workerDrainCmd = &cobra.Command{
+	Use:   "drain [worker-id...]",
+	Short: "Move tasks off workers before they are shut down",
+	Long: `Drain workers by id or label selector. The workers are cordoned, and running tasks are given until --timeout
+to finish. After the timeout, durable tasks are evicted and resume on another worker, and other tasks are
+requeued without consuming a retry, or cancelled with --on-deadline cancel.
+
+Waits until the workers have no tasks left running, unless --no-wait or --output json is set.`,
+	Example: `  # Drain a worker, giving its tasks 10 minutes to finish
+  hatchet worker drain 8ff4f149-099e-4c16-a8d1-0535f8c79b83
+
+  # Drain every worker in a zone before a rolling deploy, cancelling tasks still running after 2 minutes
+  hatchet worker drain -l zone=us-east-1a --timeout 2m --on-deadline cancel
+
+  # Start draining without waiting
+  hatchet worker drain -l pool=gpu --no-wait`,
+	// Override parent PersistentPreRun — no hatchet.yaml required for draining
+	PersistentPreRun: func(cmd *cobra.Command, args []string) {},
+	Run: func(cmd *cobra.Command, args []string) {
+		selector := workerSelectorFromCmd(cmd, args)
+		reason, _ := cmd.Flags().GetString("reason")
+		timeout, _ := cmd.Flags().GetDuration("timeout")
+		onDeadlineStr, _ := cmd.Flags().GetString("on-deadline")
+		noWait, _ := cmd.Flags().GetBool("no-wait")
+
+		onDeadline := rest.V1WorkerDrainAction(strings.ToUpper(strings.TrimSpace(onDeadlineStr)))
+
+		if onDeadline != rest.V1WorkerDrainActionREQUEUE && onDeadline != rest.V1WorkerDrainActionCANCEL {
+			cli.Logger.Fatalf("invalid --on-deadline %q: must be requeue or cancel", onDeadlineStr)
+		}
+
+		timeoutStr := timeout.String()
+
+		req := rest.V1DrainWorkersRequest{
+			Selector:   selector,
+			Timeout:    &timeoutStr,
+			OnDeadline: &onDeadline,
+		}
+
+		if reason != "" {
+			req.Reason = &reason
+		}
+
+		_, hatchetClient := clientFromCmd(cmd)
+		tenantUUID := clientTenantUUID(hatchetClient)
+
+		resp, err := hatchetClient.API().V1WorkerDrainWithResponse(cmd.Context(), tenantUUID, req)
+		if err != nil {
+			cli.Logger.Fatalf("failed to drain workers: %v", err)
+		}
+		if resp.JSON400 != nil {
+			cli.Logger.Fatalf("failed to drain workers: %s", apiErrorsMessage(resp.JSON400))
+		}
+		if resp.JSON200 == nil {
+			cli.Logger.Fatalf("unexpected response from API (status %d)", resp.StatusCode())
+		}
+
+		if isJSONOutput(cmd) {
+			printJSON(resp.JSON200)
+			return
+		}
+
+		if len(resp.JSON200.Rows) == 0 {
+			fmt.Println(styles.InfoMessage("No workers matched the selector"))
+			return
+		}
+
+		fmt.Println(styles.SuccessMessage(fmt.Sprintf("Draining %d worker(s)", len(resp.JSON200.Rows))))
+		printWorkerCordons(resp.JSON200.Rows)
+
+		if noWait {
+			return
+		}
+
+		waitForWorkerDrains(cmd.Context(), hatchetClient, resp.JSON200.Rows)
+	},
+}
+
+// workerSelectorFromCmd builds a worker selector from the worker ids given as arguments and the
+// --selector flag.
+func workerSelectorFromCmd(cmd *cobra.Command, args []string) rest.V1WorkerSelector {
+	selectorStr, _ := cmd.Flags().GetString("selector")
+
+	if len(args) == 0 && selectorStr == "" {
+		cli.Logger.Fatalf("specify worker ids or a label selector with --selector")
+	}
+
+	selector := rest.V1WorkerSelector{}
+
+	if len(args) > 0 {
+		workerIds := make([]uuid.UUID, len(args))
+
+		for i, arg := range args {
+			id, err := uuid.Parse(arg)
+			if err != nil {
+				cli.Logger.Fatalf("invalid worker ID %q: %v", arg, err)
+			}
+
+			workerIds[i] = id
+		}
+
+		selector.WorkerIds = &workerIds
+	}
+
+	if selectorStr != "" {
+		labels, err := parseLabelSelector(selectorStr)
+		if err != nil {
+			cli.Logger.Fatalf("invalid --selector: %v", err)
+		}
+
+		selector.Labels = &labels
+	}
+
+	return selector
+}
+
+// parseLabelSelector parses a label selector of the form key=value,key=value.
+func parseLabelSelector(s string) (map[string]string, error) {
+	labels := make(map[string]string)
+
+	for _, part := range strings.Split(s, ",") {
+		part = strings.TrimSpace(part)
+
+		if part == "" {
+			continue
+		}
+
+		key, value, ok := strings.Cut(part, "=")
+		key = strings.TrimSpace(key)
+
+		if !ok || key == "" {
+			return nil, fmt.Errorf("%q is not of the form key=value", part)
+		}
+
+		labels[key] = strings.TrimSpace(value)
+	}
+
+	if len(labels) == 0 {
+		return nil, fmt.Errorf("no labels given")
+	}
+
+	return labels, nil
+}
+
+// waitForWorkerDrains polls the cordons of the workers until each is drained, or has been
+// uncordoned.
+func waitForWorkerDrains(ctx context.Context, hatchetClient client.Client, cordons []rest.V1WorkerCordon) { //nolint:staticcheck
+	workerIds := make([]uuid.UUID, len(cordons))
+
+	for i, cordon := range cordons {
+		workerIds[i] = cordon.WorkerId
+	}
+
+	tenantUUID := clientTenantUUID(hatchetClient)
+	lastRunning := -1
+
+	for {
+		resp, err := hatchetClient.API().V1WorkerCordonListWithResponse(ctx, tenantUUID, &rest.V1WorkerCordonListParams{
+			WorkerIds: &workerIds,
+		})
+		if err != nil {
+			cli.Logger.Fatalf("failed to list worker cordons: %v", err)
+		}
+		if resp.JSON200 == nil {
+			cli.Logger.Fatalf("unexpected response from API (status %d)", resp.StatusCode())
+		}
+
+		draining := 0
+		running := 0
+
+		for _, cordon := range resp.JSON200.Rows {
+			if cordon.Status == rest.DRAINING {
+				draining++
+				running += int(cordon.RunningTasks)
+			}
+		}
+
+		if draining == 0 {
+			fmt.Println(styles.SuccessMessage("All workers are drained and can be shut down"))
+			return
+		}
+
+		if running != lastRunning {
+			fmt.Println(styles.Muted.Render(fmt.Sprintf("waiting for %d task(s) on %d worker(s)...", running, draining)))
+			lastRunning = running
+		}
+
+		select {
+		case <-ctx.Done():
+			fmt.Println(styles.InfoMessage("Stopped waiting; the workers keep draining in the background"))
+			return
+		case <-time.After(workerDrainPollInterval):
+		}
+	}
+}
+
+func printWorkerCordons(cordons []rest.V1WorkerCordon) {
+	for _, cordon := range cordons {
+		line := fmt.Sprintf("  %s  %-24s %-9s %d running", cordon.WorkerId, cordon.WorkerName, cordon.Status, cordon.RunningTasks)
+
+		if cordon.DrainDeadline != nil && cordon.Status == rest.DRAINING {
+			action := "requeue"
+			if cordon.DrainAction != nil {
+				action = strings.ToLower(string(*cordon.DrainAction))
+			}
+
+			line += styles.Muted.Render(fmt.Sprintf("  (%s at %s)", action, cordon.DrainDeadline.Local().Format(time.Kitchen)))
+		}
+
+		fmt.Println(line)
+	}
+}
+
+func init() {
+	workerCmd.AddCommand(workerCordonCmd, workerUncordonCmd, workerDrainCmd)
+
+	for _, cmd := range []*cobra.Command{workerCordonCmd, workerUncordonCmd, workerDrainCmd} {
+		cmd.Flags().StringP("profile", "p", "", "Profile to use for connecting to Hatchet (default: the configured default or only profile, otherwise prompts)")
+		cmd.Flags().StringP("output", "o", "", "Output format: json")
+		cmd.Flags().StringP("selector", "l", "", "Label selector of the form key=value,key=value; workers must have every label")
+	}
+
+	workerCordonCmd.Flags().String("reason", "", "Why the workers are cordoned")
+
+	workerDrainCmd.Flags().String("reason", "", "Why the workers are drained")
+	workerDrainCmd.Flags().Duration("timeout", 10*time.Minute, "How long running tasks are given to finish (at most 24h)")
+	workerDrainCmd.Flags().String("on-deadline", "requeue", "What happens to non-durable tasks still running after the timeout: requeue or cancel")
+	workerDrainCmd.Flags().Bool("no-wait", false, "Return once draining has started instead of waiting for the workers to drain")
+}
@@ -0,0 +1,61 @@
+package cli
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseLabelSelector(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		want    map[string]string
+		wantErr bool
+	}{
+		{
+			name:  "single label",
+			input: "pool=gpu",
+			want:  map[string]string{"pool": "gpu"},
+		},
+		{
+			name:  "several labels with whitespace",
+			input: " pool = gpu, zone=us-east-1a ,",
+			want:  map[string]string{"pool": "gpu", "zone": "us-east-1a"},
+		},
+		{
+			name:  "empty value",
+			input: "canary=",
+			want:  map[string]string{"canary": ""},
+		},
+		{
+			name:    "missing value",
+			input:   "pool",
+			wantErr: true,
+		},
+		{
+			name:    "missing key",
+			input:   "=gpu",
+			wantErr: true,
+		},
+		{
+			name:    "no labels",
+			input:   " , ",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseLabelSelector(tt.input)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,328 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+	"golang.org/x/sync/errgroup"
+
+	"github.com/hatchet-dev/hatchet/cmd/hatchet-cli/cli/internal/config/worker"
+	"github.com/hatchet-dev/hatchet/cmd/hatchet-cli/cli/internal/pm"
+	"github.com/hatchet-dev/hatchet/cmd/hatchet-cli/cli/internal/styles"
+	"github.com/hatchet-dev/hatchet/pkg/client" //nolint:staticcheck
+	"github.com/hatchet-dev/hatchet/pkg/client/rest"
+	profileconfig "github.com/hatchet-dev/hatchet/pkg/config/cli"
+)
+
+const (
+	// how long a worker has to register before the workers and fixtures waiting on it give up
+	workerRegisterTimeout = 2 * time.Minute
+
+	workerRegisterPollInterval = time.Second
+)
+
+// the colors of the worker output prefixes, in order
+var workerPrefixColors = []lipgloss.TerminalColor{
+	styles.Blue,
+	styles.Magenta,
+	styles.Yellow,
+	styles.Cyan,
+	styles.StatusSuccessColor,
+	styles.StatusCancelledColor,
+}
+
+// RunWorkersDev runs the workers declared in dev.workers side by side, with their output prefixed
+// by the worker name. A worker starts once the workers it depends on have registered with Hatchet,
+// and the fixtures run once every worker has registered.
+// If preCmdsCompleteChan is provided, it will be signaled when the shared pre-commands complete.
+func RunWorkersDev(ctx context.Context, profile *profileconfig.Profile, config *worker.WorkerConfig, preCmdsCompleteChan chan<- struct{}) error {
+	devConfig := &config.Dev
+
+	fixtures, err := config.FixtureTriggers()
+
+	if err != nil {
+		return err
+	}
+
+	for _, preCmdStr := range devConfig.PreCmds {
+		fmt.Println(styles.InfoMessage(fmt.Sprintf("Running pre-command: %s", preCmdStr)))
+
+		if err := pm.Exec(ctx, preCmdStr, profile); err != nil {
+			return fmt.Errorf("error running pre-command '%s': %w", preCmdStr, err)
+		}
+	}
+
+	if preCmdsCompleteChan != nil {
+		preCmdsCompleteChan <- struct{}{}
+	}
+
+	// registration is only tracked for workers which something waits on
+	awaited := make(map[string]bool, len(devConfig.Workers))
+
+	for _, w := range devConfig.Workers {
+		for _, dep := range w.DependsOn {
+			awaited[dep] = true
+		}
+
+		if len(fixtures) > 0 {
+			awaited[w.Name] = true
+		}
+	}
+
+	var hatchetClient client.Client //nolint:staticcheck
+
+	if len(awaited) > 0 {
+		nopLogger := zerolog.Nop()
+
+		hatchetClient, err = NewClientFromProfile(profile, &nopLogger)
+
+		if err != nil {
+			return fmt.Errorf("could not create Hatchet client: %w", err)
+		}
+	}
+
+	registered := make(map[string]chan struct{}, len(devConfig.Workers))
+
+	for _, w := range devConfig.Workers {
+		registered[w.Name] = make(chan struct{})
+	}
+
+	width := 0
+
+	for _, w := range devConfig.Workers {
+		width = max(width, len(w.Name))
+	}
+
+	for _, f := range fixtures {
+		width = max(width, len(f.GetName()))
+	}
+
+	mux := pm.NewOutputMux(os.Stdout)
+
+	newOpts := func(name string, color lipgloss.TerminalColor) (pm.ProcessOpts, func()) {
+		prefix := lipgloss.NewStyle().Foreground(color).Bold(true).Render(fmt.Sprintf("%-*s |", width, name)) + " "
+
+		stdout := mux.Writer(prefix)
+		stderr := mux.Writer(prefix)
+
+		return pm.ProcessOpts{Stdout: stdout, Stderr: stderr}, func() {
+			_ = stdout.Flush()
+			_ = stderr.Flush()
+		}
+	}
+
+	g, gctx := errgroup.WithContext(ctx)
+
+	for i := range devConfig.Workers {
+		w := devConfig.Workers[i]
+
+		opts, flush := newOpts(w.Name, workerPrefixColors[i%len(workerPrefixColors)])
+
+		g.Go(func() error {
+			defer flush()
+
+			return runDevWorker(gctx, profile, hatchetClient, devConfig, &w, opts, registered, awaited[w.Name])
+		})
+	}
+
+	if len(fixtures) > 0 {
+		g.Go(func() error {
+			for _, ch := range registered {
+				select {
+				case <-gctx.Done():
+					return nil
+				case <-ch:
+				}
+			}
+
+			for _, fixture := range fixtures {
+				opts, flush := newOpts(fixture.GetName(), styles.MutedColor)
+
+				fmt.Fprintln(opts.Stdout, styles.InfoMessage(fmt.Sprintf("Running fixture: %s", fixture.Command)))
+
+				// a failing fixture is reported without stopping the workers, so it can be fixed and
+				// rerun with hatchet trigger
+				if err := pm.ExecWithOpts(gctx, fixture.Command, profile, opts); err != nil && gctx.Err() == nil {
+					fmt.Fprintln(opts.Stdout, styles.Error.Render(fmt.Sprintf("Fixture failed: %v", err)))
+				}
+
+				flush()
+			}
+
+			return nil
+		})
+	}
+
+	return g.Wait()
+}
+
+// runDevWorker runs one worker of dev.workers until ctx is done. It closes the worker's registered
+// channel once the worker has registered, if anything waits on it.
+func runDevWorker(
+	ctx context.Context,
+	profile *profileconfig.Profile,
+	hatchetClient client.Client, //nolint:staticcheck
+	devConfig *worker.WorkerDevConfig,
+	w *worker.DevWorker,
+	opts pm.ProcessOpts,
+	registered map[string]chan struct{},
+	awaited bool,
+) error {
+	dir, err := filepath.Abs(w.Dir)
+
+	if err != nil {
+		return fmt.Errorf("invalid dir for worker '%s': %w", w.Name, err)
+	}
+
+	opts.Dir = dir
+	opts.Env = w.Env
+
+	for _, preCmdStr := range w.PreCmds {
+		fmt.Fprintln(opts.Stdout, styles.InfoMessage(fmt.Sprintf("Running pre-command: %s", preCmdStr)))
+
+		if err := pm.ExecWithOpts(ctx, preCmdStr, profile, opts); err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+
+			return fmt.Errorf("error running pre-command for worker '%s': %w", w.Name, err)
+		}
+	}
+
+	if len(w.DependsOn) > 0 {
+		fmt.Fprintln(opts.Stdout, styles.Muted.Render(fmt.Sprintf("Waiting for %s to register", strings.Join(w.DependsOn, ", "))))
+	}
+
+	for _, dep := range w.DependsOn {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-registered[dep]:
+		}
+	}
+
+	var existing map[string]bool
+
+	// the workers which were registered before the process started don't count as it registering
+	if awaited {
+		existing, err = listWorkerIdsByName(ctx, hatchetClient, profile, w.GetWorkerName())
+
+		if err != nil {
+			return fmt.Errorf("could not list workers: %w", err)
+		}
+	}
+
+	reload := devConfig.Reload
+
+	if w.Reload != nil {
+		reload = *w.Reload
+	}
+
+	// the worker is stopped when ctx is done, or when it doesn't register
+	procCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	proc := pm.NewProcessManagerWithOpts(w.RunCmd, profile, opts)
+
+	var cleanup <-chan error
+
+	if reload {
+		cleanup = pm.WatchFiles(procCtx, w.Files, proc)
+	} else if err := proc.StartProcess(procCtx); err != nil {
+		return fmt.Errorf("error starting worker '%s': %w", w.Name, err)
+	}
+
+	var runErr error
+
+	if awaited {
+		workerId, err := waitForWorkerRegistration(procCtx, hatchetClient, profile, w.GetWorkerName(), existing)
+
+		switch {
+		case err == nil:
+			fmt.Fprintln(opts.Stdout, styles.SuccessMessage(fmt.Sprintf("Registered as worker %s", workerId)))
+			close(registered[w.Name])
+		case ctx.Err() == nil:
+			runErr = fmt.Errorf("worker '%s' didn't register: %w", w.Name, err)
+			cancel()
+		}
+	}
+
+	<-procCtx.Done()
+
+	if cleanup != nil {
+		<-cleanup
+	} else {
+		proc.KillProcess()
+	}
+
+	return runErr
+}
+
+// listWorkerIdsByName returns the ids of the tenant's workers with the given name.
+func listWorkerIdsByName(ctx context.Context, hatchetClient client.Client, profile *profileconfig.Profile, name string) (map[string]bool, error) { //nolint:staticcheck
+	tenantUUID, err := uuid.Parse(profile.TenantId)
+
+	if err != nil {
+		return nil, fmt.Errorf("invalid tenant ID: %w", err)
+	}
+
+	resp, err := hatchetClient.API().WorkerListWithResponse(ctx, tenantUUID, nil)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.JSON200 == nil {
+		return nil, fmt.Errorf("unexpected response from API (status %d)", resp.StatusCode())
+	}
+
+	ids := make(map[string]bool)
+
+	if resp.JSON200.Rows != nil {
+		for _, w := range *resp.JSON200.Rows {
+			if w.Name == name && (w.Status == nil || *w.Status == rest.ACTIVE) {
+				ids[w.Metadata.Id] = true
+			}
+		}
+	}
+
+	return ids, nil
+}
+
+// waitForWorkerRegistration polls until an active worker with the given name which isn't in
+// existing shows up, and returns its id.
+func waitForWorkerRegistration(ctx context.Context, hatchetClient client.Client, profile *profileconfig.Profile, name string, existing map[string]bool) (string, error) { //nolint:staticcheck
+	ctx, cancel := context.WithTimeout(ctx, workerRegisterTimeout)
+	defer cancel()
+
+	ticker := time.NewTicker(workerRegisterPollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return "", fmt.Errorf("no worker named '%s' registered within %s, set workerName if the worker registers with a different name", name, workerRegisterTimeout)
+		case <-ticker.C:
+		}
+
+		ids, err := listWorkerIdsByName(ctx, hatchetClient, profile, name)
+
+		// the worker may still be starting up, so errors are retried until the timeout
+		if err != nil {
+			continue
+		}
+
+		for id := range ids {
+			if !existing[id] {
+				return id, nil
+			}
+		}
+	}
+}
@@ -1,6 +1,10 @@
 package cli
 
 import (
+	"context"
+	"fmt"
+	"time"
+
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/google/uuid"
 	"github.com/spf13/cobra"
@@ -14,7 +18,7 @@ var workflowsCmd = &cobra.Command{
 	Use:     "workflows",
 	Aliases: []string{"workflow"},
 	Short:   "Manage workflows",
-	Long:    `Commands for listing and inspecting workflows.`,
+	Long:    `Commands for listing and inspecting workflows, and for applying and exporting declarative workflow specs.`,
 	Run:     func(cmd *cobra.Command, args []string) { _ = cmd.Help() },
 }
 
@@ -72,13 +76,19 @@ var workflowsListCmd = &cobra.Command{
 var workflowsGetCmd = &cobra.Command{
 	Use:   "get <workflow-id>",
 	Short: "Get workflow details",
-	Long:  `Get details about a workflow. Without --output json, launches the TUI navigated to the workflow. With --output json, outputs raw JSON.`,
-	Args:  cobra.ExactArgs(1),
+	Long: `Get details about a workflow. Without --output json, launches the TUI navigated to the workflow. With --output json, outputs raw JSON.
+
+The JSON output includes the workflow's slot usage under slotUsage: the slot-seconds its tasks
+consumed, grouped by action, worker label or additional metadata key.`,
+	Args: cobra.ExactArgs(1),
 	Example: `  # Launch TUI for a specific workflow
   hatchet workflows get <workflow-id> --profile local
 
   # JSON output
-  hatchet workflows get <workflow-id> -o json`,
+  hatchet workflows get <workflow-id> -o json
+
+  # Slot usage over the last 7 days, grouped by the customer_id additional metadata key
+  hatchet workflows get <workflow-id> -o json --usage-since 168h --usage-by metadata --usage-key customer_id`,
 	Run: func(cmd *cobra.Command, args []string) {
 		workflowID := args[0]
 		isJSON := isJSONOutput(cmd)
@@ -104,6 +114,10 @@ var workflowsGetCmd = &cobra.Command{
 			cli.Logger.Fatalf("invalid workflow ID %q: %v", workflowID, err)
 		}
 
+		usageSince, _ := cmd.Flags().GetDuration("usage-since")
+		usageBy, _ := cmd.Flags().GetString("usage-by")
+		usageKey, _ := cmd.Flags().GetString("usage-key")
+
 		ctx := cmd.Context()
 		resp, err := hatchetClient.API().WorkflowGetWithResponse(ctx, workflowUUID)
 		if err != nil {
@@ -113,10 +127,51 @@ var workflowsGetCmd = &cobra.Command{
 			cli.Logger.Fatalf("workflow not found (status %d)", resp.StatusCode())
 		}
 
-		printJSON(resp.JSON200)
+		out := workflowWithSlotUsage{Workflow: resp.JSON200}
+
+		// slot usage is best effort, so that workflows can still be inspected on servers without it
+		slotUsage, err := getWorkflowSlotUsage(ctx, hatchetClient.API(), clientTenantUUID(hatchetClient), workflowUUID, usageSince, rest.V1SlotUsageGroupBy(usageBy), usageKey)
+		if err != nil {
+			cli.Logger.Warnf("could not get slot usage: %v", err)
+		} else {
+			out.SlotUsage = slotUsage
+		}
+
+		printJSON(out)
 	},
 }
 
+// workflowWithSlotUsage is the JSON output of workflows get: the workflow with its slot usage
+type workflowWithSlotUsage struct {
+	*rest.Workflow
+	SlotUsage *rest.V1SlotUsageList `json:"slotUsage,omitempty"`
+}
+
+func getWorkflowSlotUsage(ctx context.Context, client *rest.ClientWithResponses, tenantUUID, workflowUUID uuid.UUID, since time.Duration, groupBy rest.V1SlotUsageGroupBy, key string) (*rest.V1SlotUsageList, error) {
+	workflowIDs := []uuid.UUID{workflowUUID}
+	params := &rest.V1TaskGetSlotUsageParams{
+		Since:       time.Now().Add(-since),
+		GroupBy:     groupBy,
+		WorkflowIds: &workflowIDs,
+	}
+	if key != "" {
+		params.Key = &key
+	}
+
+	resp, err := client.V1TaskGetSlotUsageWithResponse(ctx, tenantUUID, params)
+	if err != nil {
+		return nil, err
+	}
+	if resp.JSON400 != nil && len(resp.JSON400.Errors) > 0 {
+		return nil, fmt.Errorf("%s", resp.JSON400.Errors[0].Description)
+	}
+	if resp.JSON200 == nil {
+		return nil, fmt.Errorf("unexpected response from API (status %d)", resp.StatusCode())
+	}
+
+	return resp.JSON200, nil
+}
+
 // tuiModelWithInitialWorkflow wraps tuiModel to navigate to a specific workflow on init
 type tuiModelWithInitialWorkflow struct {
 	initialWorkflowID string
@@ -140,4 +195,8 @@ func init() {
 	workflowsListCmd.Flags().StringP("search", "s", "", "Search workflows by name")
 	workflowsListCmd.Flags().Int("limit", 50, "Number of results to return")
 	workflowsListCmd.Flags().Int("offset", 0, "Offset for pagination")
+
+	workflowsGetCmd.Flags().Duration("usage-since", 24*time.Hour, "How far back to sum slot usage for (with --output json)")
+	workflowsGetCmd.Flags().String("usage-by", string(rest.V1SlotUsageGroupByAction), "Group slot usage by action, worker_label or metadata (with --output json)")
+	workflowsGetCmd.Flags().String("usage-key", "", "The worker label or additional metadata key to group slot usage by")
 }
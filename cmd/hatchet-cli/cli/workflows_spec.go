@@ -0,0 +1,404 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/google/uuid"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+	"github.com/spf13/cobra"
+
+	"github.com/hatchet-dev/hatchet/cmd/hatchet-cli/cli/internal/config/cli"
+	"github.com/hatchet-dev/hatchet/cmd/hatchet-cli/cli/internal/styles"
+	"github.com/hatchet-dev/hatchet/pkg/client" //nolint:staticcheck
+	"github.com/hatchet-dev/hatchet/pkg/client/rest"
+	"github.com/hatchet-dev/hatchet/pkg/workflowspec"
+)
+
+// the request timeout of operators created from a spec which doesn't set one, matching the
+// operator's own default
+const defaultSpecOperatorRequestTimeoutSeconds = 60
+
+// specFile is a workflow spec along with the file it was read from.
+type specFile struct {
+	path string
+	doc  *workflowspec.Document
+}
+
+var workflowsApplyCmd = &cobra.Command{
+	Use:   "apply -f <file>",
+	Short: "Register workflows from spec files",
+	Long: `Register the workflows declared in YAML or JSON spec files, creating or updating the HTTP operators
+they're bound to. Every spec is validated before anything is applied.
+
+A directory applies every .yaml, .yml and .json file in it, and - reads from stdin.`,
+	Example: `  # Apply a single spec
+  hatchet workflows apply -f process-order.yaml
+
+  # Apply every spec in a directory
+  hatchet workflows apply -f workflows/`,
+	Run: func(cmd *cobra.Command, args []string) {
+		specs := readSpecFiles(cmd)
+
+		if !validateSpecFiles(specs) {
+			os.Exit(1)
+		}
+
+		_, hatchetClient := clientFromCmd(cmd)
+		ctx := cmd.Context()
+		tenantUUID := clientTenantUUID(hatchetClient)
+
+		for _, spec := range specs {
+			if spec.doc.Operator != nil {
+				applySpecOperator(ctx, hatchetClient, tenantUUID, spec.doc.Operator.HTTP)
+			}
+
+			if err := hatchetClient.Admin().PutWorkflowV1(spec.doc.Workflow); err != nil {
+				cli.Logger.Fatalf("failed to apply workflow %q from %s: %v", spec.doc.Workflow.Name, spec.path, err)
+			}
+
+			fmt.Println(styles.SuccessMessage(fmt.Sprintf("Applied workflow %s", spec.doc.Workflow.Name)))
+		}
+	},
+}
+
+var workflowsValidateCmd = &cobra.Command{
+	Use:   "validate -f <file>",
+	Short: "Validate workflow spec files",
+	Long:  `Check YAML or JSON workflow spec files without applying them. Exits with a non-zero status if any spec is invalid.`,
+	Example: `  # Validate a single spec
+  hatchet workflows validate -f process-order.yaml
+
+  # Validate every spec in a directory
+  hatchet workflows validate -f workflows/`,
+	Run: func(cmd *cobra.Command, args []string) {
+		specs := readSpecFiles(cmd)
+
+		if !validateSpecFiles(specs) {
+			os.Exit(1)
+		}
+
+		fmt.Println(styles.SuccessMessage(fmt.Sprintf("%d workflow spec(s) are valid", len(specs))))
+	},
+}
+
+var workflowsExportCmd = &cobra.Command{
+	Use:   "export <workflow-id>",
+	Short: "Export a registered workflow as a spec",
+	Long: `Write the spec of a registered workflow, which can be checked in and applied with "hatchet workflows apply".
+Operator bindings aren't exported.`,
+	Args: cobra.ExactArgs(1),
+	Example: `  # Print the spec of the latest version
+  hatchet workflows export <workflow-id>
+
+  # Write a specific version to a file as JSON
+  hatchet workflows export <workflow-id> --version <version-id> --format json -f process-order.json`,
+	Run: func(cmd *cobra.Command, args []string) {
+		workflowUUID, err := uuid.Parse(args[0])
+		if err != nil {
+			cli.Logger.Fatalf("invalid workflow ID %q: %v", args[0], err)
+		}
+
+		format, _ := cmd.Flags().GetString("format")
+		outFile, _ := cmd.Flags().GetString("file")
+		versionStr, _ := cmd.Flags().GetString("version")
+
+		if format != string(workflowspec.FormatYAML) && format != string(workflowspec.FormatJSON) {
+			cli.Logger.Fatalf("invalid format %q: must be yaml or json", format)
+		}
+
+		params := &rest.V1WorkflowSpecGetParams{}
+
+		if versionStr != "" {
+			versionUUID, err := uuid.Parse(versionStr)
+			if err != nil {
+				cli.Logger.Fatalf("invalid version ID %q: %v", versionStr, err)
+			}
+
+			params.Version = &versionUUID
+		}
+
+		_, hatchetClient := clientFromCmd(cmd)
+
+		resp, err := hatchetClient.API().V1WorkflowSpecGetWithResponse(cmd.Context(), workflowUUID, params)
+		if err != nil {
+			cli.Logger.Fatalf("failed to export workflow: %v", err)
+		}
+		if resp.JSON404 != nil {
+			cli.Logger.Fatalf("failed to export workflow: %s", apiErrorsMessage(resp.JSON404))
+		}
+		if resp.JSON200 == nil {
+			cli.Logger.Fatalf("unexpected response from API (status %d)", resp.StatusCode())
+		}
+
+		// the spec is read back so the output is written in spec order, rather than the
+		// alphabetical order of the decoded map
+		raw, err := json.Marshal(resp.JSON200.Spec)
+		if err != nil {
+			cli.Logger.Fatalf("failed to read workflow spec: %v", err)
+		}
+
+		docs, err := workflowspec.Parse(raw)
+		if err != nil {
+			cli.Logger.Fatalf("failed to read workflow spec: %v", err)
+		}
+
+		out, err := workflowspec.Marshal(docs[0], workflowspec.Format(format))
+		if err != nil {
+			cli.Logger.Fatalf("failed to write workflow spec: %v", err)
+		}
+
+		if outFile == "" {
+			fmt.Print(string(out))
+
+			if format == string(workflowspec.FormatJSON) {
+				fmt.Println()
+			}
+
+			return
+		}
+
+		if err := os.WriteFile(outFile, out, 0o600); err != nil {
+			cli.Logger.Fatalf("failed to write %s: %v", outFile, err)
+		}
+
+		fmt.Println(styles.SuccessMessage(fmt.Sprintf("Exported workflow %s to %s", docs[0].Workflow.Name, outFile)))
+	},
+}
+
+// readSpecFiles parses every spec named by the --file flags, exiting on the first file which can't be
+// read or parsed.
+func readSpecFiles(cmd *cobra.Command) []specFile {
+	paths, _ := cmd.Flags().GetStringArray("file")
+
+	if len(paths) == 0 {
+		cli.Logger.Fatalf("at least one spec file is required (-f)")
+	}
+
+	var files []string
+
+	for _, path := range paths {
+		if path == "-" {
+			files = append(files, path)
+			continue
+		}
+
+		info, err := os.Stat(path)
+		if err != nil {
+			cli.Logger.Fatalf("failed to read %s: %v", path, err)
+		}
+
+		if !info.IsDir() {
+			files = append(files, path)
+			continue
+		}
+
+		entries, err := os.ReadDir(path)
+		if err != nil {
+			cli.Logger.Fatalf("failed to read %s: %v", path, err)
+		}
+
+		for _, entry := range entries {
+			switch strings.ToLower(filepath.Ext(entry.Name())) {
+			case ".yaml", ".yml", ".json":
+				if !entry.IsDir() {
+					files = append(files, filepath.Join(path, entry.Name()))
+				}
+			}
+		}
+	}
+
+	if len(files) == 0 {
+		cli.Logger.Fatalf("no spec files found")
+	}
+
+	var specs []specFile
+
+	for _, file := range files {
+		var data []byte
+		var err error
+
+		if file == "-" {
+			data, err = io.ReadAll(os.Stdin)
+		} else {
+			data, err = os.ReadFile(file)
+		}
+
+		if err != nil {
+			cli.Logger.Fatalf("failed to read %s: %v", file, err)
+		}
+
+		docs, err := workflowspec.Parse(data)
+		if err != nil {
+			cli.Logger.Fatalf("failed to parse %s: %v", file, err)
+		}
+
+		for _, doc := range docs {
+			specs = append(specs, specFile{path: file, doc: doc})
+		}
+	}
+
+	return specs
+}
+
+// validateSpecFiles prints every problem with the specs, and reports whether they're all valid. A
+// workflow may only be declared once across the files.
+func validateSpecFiles(specs []specFile) bool {
+	valid := true
+	declaredIn := make(map[string]string, len(specs))
+
+	for _, spec := range specs {
+		name := spec.doc.Workflow.Name
+
+		if err := workflowspec.Validate(spec.doc); err != nil {
+			valid = false
+
+			fmt.Println(styles.ErrorMessage(fmt.Sprintf("%s: workflow %q is invalid:", spec.path, name)))
+
+			for _, line := range strings.Split(err.Error(), "\n") {
+				fmt.Println(styles.Muted.Render("  - " + line))
+			}
+		}
+
+		if name == "" {
+			continue
+		}
+
+		if path, exists := declaredIn[name]; exists {
+			valid = false
+
+			fmt.Println(styles.ErrorMessage(fmt.Sprintf("%s: workflow %q is already declared in %s", spec.path, name, path)))
+
+			continue
+		}
+
+		declaredIn[name] = spec.path
+	}
+
+	return valid
+}
+
+// applySpecOperator creates the HTTP operator a spec is bound to, or updates the existing operator
+// with the same name.
+func applySpecOperator(ctx context.Context, hatchetClient client.Client, tenantUUID openapi_types.UUID, op *workflowspec.HTTPOperator) { //nolint:staticcheck
+	signingSecret := os.Getenv(op.SigningSecretEnv)
+
+	if signingSecret == "" {
+		cli.Logger.Fatalf("the signing secret of operator %q must be set in %s", op.Name, op.SigningSecretEnv)
+	}
+
+	timeout := op.RequestTimeoutSeconds
+
+	if timeout == 0 {
+		timeout = defaultSpecOperatorRequestTimeoutSeconds
+	}
+
+	existing := findHTTPOperator(ctx, hatchetClient, tenantUUID, op.Name)
+
+	if existing == nil {
+		resp, err := hatchetClient.API().V1HttpOperatorCreateWithResponse(ctx, tenantUUID, rest.V1CreateHTTPOperatorRequest{
+			Name:                  op.Name,
+			TriggerEndpoint:       op.TriggerEndpoint,
+			HealthcheckEndpoint:   op.HealthcheckEndpoint,
+			SigningSecret:         signingSecret,
+			RequestTimeoutSeconds: timeout,
+		})
+		if err != nil {
+			cli.Logger.Fatalf("failed to create operator %q: %v", op.Name, err)
+		}
+		if resp.JSON400 != nil {
+			cli.Logger.Fatalf("failed to create operator %q: %s", op.Name, apiErrorsMessage(resp.JSON400))
+		}
+		if resp.JSON200 == nil {
+			cli.Logger.Fatalf("unexpected response from API (status %d)", resp.StatusCode())
+		}
+
+		fmt.Println(styles.SuccessMessage(fmt.Sprintf("Created operator %s", op.Name)))
+
+		return
+	}
+
+	operatorUUID, err := uuid.Parse(existing.Metadata.Id)
+	if err != nil {
+		cli.Logger.Fatalf("invalid operator id %q: %v", existing.Metadata.Id, err)
+	}
+
+	resp, err := hatchetClient.API().V1HttpOperatorUpdateWithResponse(ctx, operatorUUID, rest.V1UpdateHTTPOperatorRequest{
+		TriggerEndpoint:       &op.TriggerEndpoint,
+		HealthcheckEndpoint:   &op.HealthcheckEndpoint,
+		SigningSecret:         &signingSecret,
+		RequestTimeoutSeconds: &timeout,
+	})
+	if err != nil {
+		cli.Logger.Fatalf("failed to update operator %q: %v", op.Name, err)
+	}
+	if resp.JSON400 != nil {
+		cli.Logger.Fatalf("failed to update operator %q: %s", op.Name, apiErrorsMessage(resp.JSON400))
+	}
+	if resp.JSON200 == nil {
+		cli.Logger.Fatalf("unexpected response from API (status %d)", resp.StatusCode())
+	}
+
+	fmt.Println(styles.SuccessMessage(fmt.Sprintf("Updated operator %s", op.Name)))
+}
+
+func findHTTPOperator(ctx context.Context, hatchetClient client.Client, tenantUUID openapi_types.UUID, name string) *rest.V1HTTPOperator { //nolint:staticcheck
+	const pageSize = 100
+
+	for offset := int64(0); ; offset += pageSize {
+		limit := int64(pageSize)
+
+		resp, err := hatchetClient.API().V1HttpOperatorListWithResponse(ctx, tenantUUID, &rest.V1HttpOperatorListParams{
+			Offset: &offset,
+			Limit:  &limit,
+		})
+		if err != nil {
+			cli.Logger.Fatalf("failed to list operators: %v", err)
+		}
+		if resp.JSON200 == nil {
+			cli.Logger.Fatalf("unexpected response from API (status %d)", resp.StatusCode())
+		}
+		if resp.JSON200.Rows == nil {
+			return nil
+		}
+
+		rows := *resp.JSON200.Rows
+
+		for i := range rows {
+			if rows[i].Name == name {
+				return &rows[i]
+			}
+		}
+
+		if len(rows) < pageSize {
+			return nil
+		}
+	}
+}
+
+func apiErrorsMessage(errs *rest.APIErrors) string {
+	messages := make([]string, 0, len(errs.Errors))
+
+	for _, e := range errs.Errors {
+		messages = append(messages, e.Description)
+	}
+
+	return strings.Join(messages, "; ")
+}
+
+func init() {
+	workflowsCmd.AddCommand(workflowsApplyCmd, workflowsValidateCmd, workflowsExportCmd)
+
+	for _, c := range []*cobra.Command{workflowsApplyCmd, workflowsValidateCmd} {
+		c.Flags().StringArrayP("file", "f", nil, "A spec file or directory of spec files, or - for stdin (repeatable)")
+	}
+
+	workflowsExportCmd.Flags().StringP("file", "f", "", "Write the spec to a file rather than stdout")
+	workflowsExportCmd.Flags().String("format", string(workflowspec.FormatYAML), "Output format: yaml or json")
+	workflowsExportCmd.Flags().String("version", "", "The workflow version to export (default: latest)")
+}
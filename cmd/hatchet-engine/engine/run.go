@@ -26,6 +26,7 @@ import (
 	"github.com/hatchet-dev/hatchet/internal/services/ingestor"
 	"github.com/hatchet-dev/hatchet/internal/services/otelcol"
 	"github.com/hatchet-dev/hatchet/internal/services/partition"
+	"github.com/hatchet-dev/hatchet/internal/services/scaler"
 	schedulerv1 "github.com/hatchet-dev/hatchet/internal/services/scheduler/v1"
 	"github.com/hatchet-dev/hatchet/internal/services/ticker"
 	"github.com/hatchet-dev/hatchet/pkg/config/loader"
@@ -204,6 +205,7 @@ func runV0Config(ctx context.Context, sc *server.ServerConfig, cleanup *cleanup.
 			schedulerv1.WithQueueLoggerConfig(&sc.AdditionalLoggers.Queue),
 			schedulerv1.WithSchedulerPool(sc.SchedulingPoolV1),
 			schedulerv1.WithPrometheusGate(sc.PrometheusGate),
+			schedulerv1.WithPreemptionWaitThreshold(sc.Runtime.SchedulerPreemptionWaitThreshold),
 		)
 
 		if err != nil {
@@ -295,6 +297,7 @@ func runV0Config(ctx context.Context, sc *server.ServerConfig, cleanup *cleanup.
 			olap.WithMQQos(sc.Operations.OLAPMQQos),
 			olap.WithMaxRequeueCount(sc.MQMaxDeathCount),
 			olap.WithPrometheusGate(sc.PrometheusGate),
+			olap.WithOLAPExportConfig(sc.OLAPExport),
 		)
 
 		if err != nil {
@@ -359,6 +362,7 @@ func runV0Config(ctx context.Context, sc *server.ServerConfig, cleanup *cleanup.
 			dispatcher.WithEncryption(sc.Encryption),
 			dispatcher.WithInfraBlockedCIDRs(sc.Runtime.OperatorInfraBlockedCIDRs),
 			dispatcher.WithDAGOperatorDefaultSlots(sc.Runtime.DagOperatorDefaultSlots),
+			dispatcher.WithApprovalNotifier(sc.TenantAlerter),
 			dispatcher.WithPrometheusGate(sc.PrometheusGate),
 		)
 
@@ -377,6 +381,8 @@ func runV0Config(ctx context.Context, sc *server.ServerConfig, cleanup *cleanup.
 			ingestor.WithPubSub(sc.PubSubV1),
 			ingestor.WithRepositoryV1(sc.V1),
 			ingestor.WithLogIngestionEnabled(sc.Runtime.LogIngestionEnabled),
+			ingestor.WithLogIngestionRateLimit(sc.Runtime.LogIngestionRateLimit, sc.Runtime.LogIngestionBurst),
+			ingestor.WithEventDedupeKeyTTL(sc.Runtime.EventDedupeKeyTTL),
 			ingestor.WithLocalScheduler(localScheduler),
 			ingestor.WithLocalDispatcher(d),
 			ingestor.WithOptimisticSchedulingEnabled(sc.Runtime.OptimisticSchedulingEnabled),
@@ -416,6 +422,7 @@ func runV0Config(ctx context.Context, sc *server.ServerConfig, cleanup *cleanup.
 			adminv1.WithOptimisticSchedulingEnabled(sc.Runtime.OptimisticSchedulingEnabled),
 			adminv1.WithGrpcTriggersEnabled(sc.Runtime.GRPCTriggerWritesEnabled),
 			adminv1.WithGrpcTriggerSlots(sc.Runtime.GRPCTriggerWriteSlots),
+			adminv1.WithTimeTravelEnabled(sc.Runtime.AllowTimeTravel),
 			adminv1.WithPrometheusGate(sc.PrometheusGate),
 		)
 
@@ -453,6 +460,23 @@ func runV0Config(ctx context.Context, sc *server.ServerConfig, cleanup *cleanup.
 			grpcOpts = append(grpcOpts, grpc.WithOTelCollector(oc))
 		}
 
+		if sc.WorkerScaler.Enabled {
+			ws, err := scaler.New(
+				scaler.WithRepository(sc.V1),
+				scaler.WithJWTManager(sc.Auth.JWTManager),
+				scaler.WithLogger(sc.Logger),
+				scaler.WithPollInterval(sc.WorkerScaler.PollInterval),
+				scaler.WithScheduleTimeoutWindow(sc.WorkerScaler.ScheduleTimeoutWindow),
+				scaler.WithDefaultSlotsPerReplica(sc.WorkerScaler.DefaultSlotsPerReplica),
+			)
+
+			if err != nil {
+				return fmt.Errorf("could not create worker scaler: %w", err)
+			}
+
+			grpcOpts = append(grpcOpts, grpc.WithScaler(ws))
+		}
+
 		if sc.Runtime.GRPCInsecure {
 			grpcOpts = append(grpcOpts, grpc.WithInsecure())
 		}
@@ -690,6 +714,7 @@ func runV1Config(ctx context.Context, sc *server.ServerConfig, cleanup *cleanup.
 				olap.WithMQQos(sc.Operations.OLAPMQQos),
 				olap.WithMaxRequeueCount(sc.MQMaxDeathCount),
 				olap.WithPrometheusGate(sc.PrometheusGate),
+				olap.WithOLAPExportConfig(sc.OLAPExport),
 			)
 
 			if err != nil {
@@ -767,6 +792,7 @@ func runV1Config(ctx context.Context, sc *server.ServerConfig, cleanup *cleanup.
 			schedulerv1.WithQueueLoggerConfig(&sc.AdditionalLoggers.Queue),
 			schedulerv1.WithSchedulerPool(sc.SchedulingPoolV1),
 			schedulerv1.WithPrometheusGate(sc.PrometheusGate),
+			schedulerv1.WithPreemptionWaitThreshold(sc.Runtime.SchedulerPreemptionWaitThreshold),
 		)
 
 		if err != nil {
@@ -807,6 +833,7 @@ func runV1Config(ctx context.Context, sc *server.ServerConfig, cleanup *cleanup.
 			dispatcher.WithEncryption(sc.Encryption),
 			dispatcher.WithInfraBlockedCIDRs(sc.Runtime.OperatorInfraBlockedCIDRs),
 			dispatcher.WithDAGOperatorDefaultSlots(sc.Runtime.DagOperatorDefaultSlots),
+			dispatcher.WithApprovalNotifier(sc.TenantAlerter),
 			dispatcher.WithPrometheusGate(sc.PrometheusGate),
 		)
 
@@ -826,6 +853,8 @@ func runV1Config(ctx context.Context, sc *server.ServerConfig, cleanup *cleanup.
 			ingestor.WithPubSub(sc.PubSubV1),
 			ingestor.WithRepositoryV1(sc.V1),
 			ingestor.WithLogIngestionEnabled(sc.Runtime.LogIngestionEnabled),
+			ingestor.WithLogIngestionRateLimit(sc.Runtime.LogIngestionRateLimit, sc.Runtime.LogIngestionBurst),
+			ingestor.WithEventDedupeKeyTTL(sc.Runtime.EventDedupeKeyTTL),
 			ingestor.WithLocalScheduler(localScheduler),
 			ingestor.WithLocalDispatcher(d),
 			ingestor.WithOptimisticSchedulingEnabled(sc.Runtime.OptimisticSchedulingEnabled),
@@ -866,6 +895,7 @@ func runV1Config(ctx context.Context, sc *server.ServerConfig, cleanup *cleanup.
 			adminv1.WithOptimisticSchedulingEnabled(sc.Runtime.OptimisticSchedulingEnabled),
 			adminv1.WithGrpcTriggersEnabled(sc.Runtime.GRPCTriggerWritesEnabled),
 			adminv1.WithGrpcTriggerSlots(sc.Runtime.GRPCTriggerWriteSlots),
+			adminv1.WithTimeTravelEnabled(sc.Runtime.AllowTimeTravel),
 			adminv1.WithPrometheusGate(sc.PrometheusGate),
 		)
 
@@ -903,6 +933,23 @@ func runV1Config(ctx context.Context, sc *server.ServerConfig, cleanup *cleanup.
 			grpcOpts = append(grpcOpts, grpc.WithOTelCollector(oc))
 		}
 
+		if sc.WorkerScaler.Enabled {
+			ws, err := scaler.New(
+				scaler.WithRepository(sc.V1),
+				scaler.WithJWTManager(sc.Auth.JWTManager),
+				scaler.WithLogger(sc.Logger),
+				scaler.WithPollInterval(sc.WorkerScaler.PollInterval),
+				scaler.WithScheduleTimeoutWindow(sc.WorkerScaler.ScheduleTimeoutWindow),
+				scaler.WithDefaultSlotsPerReplica(sc.WorkerScaler.DefaultSlotsPerReplica),
+			)
+
+			if err != nil {
+				return fmt.Errorf("could not create worker scaler: %w", err)
+			}
+
+			grpcOpts = append(grpcOpts, grpc.WithScaler(ws))
+		}
+
 		if sc.Runtime.GRPCInsecure {
 			grpcOpts = append(grpcOpts, grpc.WithInsecure())
 		}
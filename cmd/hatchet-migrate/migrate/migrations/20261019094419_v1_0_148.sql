@@ -0,0 +1,19 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE v1_step_retry_policy (
+    step_id UUID NOT NULL,
+    policy_index INTEGER NOT NULL,
+    error_class TEXT,
+    expression TEXT,
+    retries INTEGER,
+    backoff_factor DOUBLE PRECISION,
+    backoff_max_seconds INTEGER,
+    never_retry BOOLEAN NOT NULL DEFAULT FALSE,
+    CONSTRAINT v1_step_retry_policy_pkey PRIMARY KEY (step_id, policy_index)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE v1_step_retry_policy;
+-- +goose StatementEnd
@@ -0,0 +1,38 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TYPE "StepExpressionKind" ADD VALUE IF NOT EXISTS 'CIRCUIT_BREAKER_KEY';
+
+CREATE TABLE v1_step_circuit_breaker (
+    step_id UUID NOT NULL,
+    tenant_id UUID NOT NULL,
+    failure_threshold INTEGER NOT NULL,
+    window_seconds INTEGER NOT NULL,
+    cooldown_seconds INTEGER NOT NULL,
+    CONSTRAINT v1_step_circuit_breaker_pkey PRIMARY KEY (step_id)
+);
+
+CREATE TYPE v1_circuit_breaker_state AS ENUM ('CLOSED', 'OPEN', 'HALF_OPEN');
+
+CREATE TABLE v1_circuit_breaker (
+    tenant_id UUID NOT NULL,
+    key TEXT NOT NULL,
+    state v1_circuit_breaker_state NOT NULL DEFAULT 'CLOSED',
+    failure_count INTEGER NOT NULL DEFAULT 0,
+    failure_threshold INTEGER NOT NULL,
+    window_seconds INTEGER NOT NULL,
+    cooldown_seconds INTEGER NOT NULL,
+    window_started_at TIMESTAMPTZ NOT NULL,
+    opened_at TIMESTAMPTZ,
+    probe_task_id BIGINT,
+    probe_started_at TIMESTAMPTZ,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT v1_circuit_breaker_pkey PRIMARY KEY (tenant_id, key)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE v1_circuit_breaker;
+DROP TYPE v1_circuit_breaker_state;
+DROP TABLE v1_step_circuit_breaker;
+-- +goose StatementEnd
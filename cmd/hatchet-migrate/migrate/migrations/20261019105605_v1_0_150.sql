@@ -0,0 +1,48 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TYPE v1_bulk_job_kind AS ENUM ('CANCEL', 'REPLAY', 'DELETE');
+
+CREATE TYPE v1_bulk_job_status AS ENUM ('RUNNING', 'PAUSED', 'COMPLETED', 'FAILED');
+
+CREATE TABLE v1_bulk_job (
+    id UUID NOT NULL DEFAULT gen_random_uuid(),
+    tenant_id UUID NOT NULL,
+    kind v1_bulk_job_kind NOT NULL,
+    status v1_bulk_job_status NOT NULL DEFAULT 'RUNNING',
+    filter JSONB,
+    is_enumerated BOOLEAN NOT NULL DEFAULT FALSE,
+    total_count BIGINT NOT NULL DEFAULT 0,
+    processed_count BIGINT NOT NULL DEFAULT 0,
+    succeeded_count BIGINT NOT NULL DEFAULT 0,
+    failed_count BIGINT NOT NULL DEFAULT 0,
+    error TEXT,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    finished_at TIMESTAMPTZ,
+    CONSTRAINT v1_bulk_job_pkey PRIMARY KEY (tenant_id, id)
+);
+
+CREATE INDEX v1_bulk_job_tenant_status_idx ON v1_bulk_job (tenant_id, status, created_at);
+
+CREATE TYPE v1_bulk_job_item_status AS ENUM ('PENDING', 'SUCCEEDED', 'FAILED');
+
+CREATE TABLE v1_bulk_job_item (
+    tenant_id UUID NOT NULL,
+    bulk_job_id UUID NOT NULL,
+    external_id UUID NOT NULL,
+    status v1_bulk_job_item_status NOT NULL DEFAULT 'PENDING',
+    error TEXT,
+    CONSTRAINT v1_bulk_job_item_pkey PRIMARY KEY (tenant_id, bulk_job_id, external_id)
+);
+
+CREATE INDEX v1_bulk_job_item_pending_idx ON v1_bulk_job_item (tenant_id, bulk_job_id) WHERE status = 'PENDING';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE v1_bulk_job_item;
+DROP TYPE v1_bulk_job_item_status;
+DROP TABLE v1_bulk_job;
+DROP TYPE v1_bulk_job_status;
+DROP TYPE v1_bulk_job_kind;
+-- +goose StatementEnd
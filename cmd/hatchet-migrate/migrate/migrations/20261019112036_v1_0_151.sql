@@ -0,0 +1,15 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE v1_step_map (
+    step_id UUID NOT NULL,
+    tenant_id UUID NOT NULL,
+    expression TEXT NOT NULL,
+    max_parallelism INTEGER NOT NULL,
+    CONSTRAINT v1_step_map_pkey PRIMARY KEY (step_id)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE v1_step_map;
+-- +goose StatementEnd
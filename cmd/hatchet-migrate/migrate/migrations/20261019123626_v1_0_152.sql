@@ -0,0 +1,54 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TYPE v1_operator_kind ADD VALUE IF NOT EXISTS 'APPROVAL';
+
+CREATE TYPE v1_approval_expiry_action AS ENUM ('FAIL', 'REJECT', 'APPROVE');
+
+CREATE TABLE v1_step_approval (
+    step_id UUID NOT NULL,
+    tenant_id UUID NOT NULL,
+    approvers TEXT[] NOT NULL DEFAULT '{}',
+    context_fields TEXT[] NOT NULL DEFAULT '{}',
+    expires_in_seconds INTEGER NOT NULL,
+    on_expiry v1_approval_expiry_action NOT NULL DEFAULT 'FAIL',
+    CONSTRAINT v1_step_approval_pkey PRIMARY KEY (step_id)
+);
+
+CREATE TYPE v1_approval_status AS ENUM ('PENDING', 'APPROVED', 'REJECTED', 'EXPIRED', 'CANCELLED');
+
+CREATE TABLE v1_approval (
+    tenant_id UUID NOT NULL,
+    task_external_id UUID NOT NULL,
+    task_id BIGINT NOT NULL,
+    task_inserted_at TIMESTAMPTZ NOT NULL,
+    task_retry_count INTEGER NOT NULL,
+    workflow_run_id UUID NOT NULL,
+    step_id UUID NOT NULL,
+    task_name TEXT NOT NULL,
+    approvers TEXT[] NOT NULL DEFAULT '{}',
+    context JSONB NOT NULL DEFAULT '{}',
+    on_expiry v1_approval_expiry_action NOT NULL,
+    status v1_approval_status NOT NULL DEFAULT 'PENDING',
+    expires_at TIMESTAMPTZ NOT NULL,
+    approver TEXT,
+    approver_api_token_id UUID,
+    comment TEXT,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    resolved_at TIMESTAMPTZ,
+    CONSTRAINT v1_approval_pkey PRIMARY KEY (tenant_id, task_external_id)
+);
+
+CREATE INDEX v1_approval_pending_expires_at_idx ON v1_approval (tenant_id, expires_at) WHERE status = 'PENDING';
+
+CREATE INDEX v1_approval_tenant_created_at_idx ON v1_approval (tenant_id, created_at DESC);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DELETE FROM v1_operator WHERE kind = 'APPROVAL';
+
+DROP TABLE v1_approval;
+DROP TYPE v1_approval_status;
+DROP TABLE v1_step_approval;
+DROP TYPE v1_approval_expiry_action;
+-- +goose StatementEnd
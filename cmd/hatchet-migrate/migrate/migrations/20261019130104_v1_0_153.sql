@@ -0,0 +1,22 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE v1_olap_export_checkpoint (
+    tenant_id UUID NOT NULL,
+    partition_date DATE NOT NULL,
+    is_completed BOOLEAN NOT NULL DEFAULT FALSE,
+    lease_process_id UUID NOT NULL,
+    lease_expires_at TIMESTAMPTZ NOT NULL,
+    destination TEXT,
+    run_count BIGINT NOT NULL DEFAULT 0,
+    task_count BIGINT NOT NULL DEFAULT 0,
+    task_event_count BIGINT NOT NULL DEFAULT 0,
+    exported_at TIMESTAMPTZ,
+
+    PRIMARY KEY (tenant_id, partition_date)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE v1_olap_export_checkpoint;
+-- +goose StatementEnd
@@ -0,0 +1,23 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- GIN index for run search predicates (@@ jsonpath) over inline OLAP payloads.
+--
+-- Like the additional_metadata indexes, ON ONLY creates the index on the partitioned
+-- parent without building it on existing partitions, so this migration is instant. New
+-- partitions get the index automatically, and existing partitions can be backfilled
+-- with CREATE INDEX CONCURRENTLY and ALTER INDEX ... ATTACH PARTITION.
+--
+-- jsonb_path_ops supports the @@ and @? jsonpath operators used by run search.
+
+CREATE INDEX IF NOT EXISTS ix_v1_payloads_olap_inline_content_gin
+    ON ONLY v1_payloads_olap USING gin (inline_content jsonb_path_ops);
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+DROP INDEX IF EXISTS ix_v1_payloads_olap_inline_content_gin;
+
+-- +goose StatementEnd
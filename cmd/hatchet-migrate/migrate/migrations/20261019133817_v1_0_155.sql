@@ -0,0 +1,21 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- v1_worker_scaling_target stores the autoscaling targets a worker declared when it registered.
+CREATE TABLE IF NOT EXISTS v1_worker_scaling_target (
+    tenant_id UUID NOT NULL,
+    worker_id UUID NOT NULL,
+    slots_per_replica INTEGER,
+    max_queue_age_seconds INTEGER,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (tenant_id, worker_id)
+);
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+DROP TABLE IF EXISTS v1_worker_scaling_target;
+
+-- +goose StatementEnd
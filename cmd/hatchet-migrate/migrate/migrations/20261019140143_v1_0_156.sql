@@ -0,0 +1,41 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- v1_task_slot_usage_olap records the slot time each task attempt consumed on a worker, from its
+-- assignment until its slots were released. The action and additional metadata are captured when
+-- the slots are released, and the workflow name and worker labels are looked up when the usage is
+-- recorded, so that usage can be aggregated without joins. Worker labels are the worker's labels at
+-- the time the usage is recorded, not at the time the task ran.
+CREATE TABLE v1_task_slot_usage_olap (
+    tenant_id UUID NOT NULL,
+    task_id BIGINT NOT NULL,
+    task_inserted_at TIMESTAMPTZ NOT NULL,
+    retry_count INTEGER NOT NULL,
+    slot_type TEXT NOT NULL,
+    units INTEGER NOT NULL,
+    worker_id UUID NOT NULL,
+    workflow_id UUID NOT NULL,
+    workflow_name TEXT NOT NULL,
+    action_id TEXT NOT NULL,
+    worker_labels JSONB NOT NULL DEFAULT '{}'::JSONB,
+    additional_metadata JSONB,
+    assigned_at TIMESTAMPTZ NOT NULL,
+    released_at TIMESTAMPTZ NOT NULL,
+    slot_seconds DOUBLE PRECISION NOT NULL,
+
+    PRIMARY KEY (released_at, task_id, task_inserted_at, retry_count, slot_type)
+) PARTITION BY RANGE(released_at);
+
+CREATE INDEX v1_task_slot_usage_olap_tenant_released_at_idx ON v1_task_slot_usage_olap (tenant_id, released_at);
+
+SELECT create_v1_range_partition('v1_task_slot_usage_olap'::TEXT, NOW()::DATE);
+SELECT create_v1_range_partition('v1_task_slot_usage_olap'::TEXT, (NOW() + INTERVAL '1 day')::DATE);
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+DROP TABLE IF EXISTS v1_task_slot_usage_olap;
+
+-- +goose StatementEnd
@@ -0,0 +1,29 @@
+-- +goose Up
+-- +goose StatementBegin
+-- v1_workflow_priority_aging stores the priority aging policy of a workflow, set by its latest
+-- version. The tenant's policy in v1_tenant_priority_aging applies to workflows without one.
+CREATE TABLE v1_workflow_priority_aging (
+    workflow_id UUID NOT NULL,
+    tenant_id UUID NOT NULL,
+    interval_seconds INTEGER,
+    max_wait_seconds INTEGER,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT v1_workflow_priority_aging_pkey PRIMARY KEY (workflow_id)
+);
+
+CREATE INDEX v1_workflow_priority_aging_tenant_idx ON v1_workflow_priority_aging (tenant_id);
+
+CREATE TABLE v1_tenant_priority_aging (
+    tenant_id UUID NOT NULL,
+    interval_seconds INTEGER,
+    max_wait_seconds INTEGER,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT v1_tenant_priority_aging_pkey PRIMARY KEY (tenant_id)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE v1_tenant_priority_aging;
+DROP TABLE v1_workflow_priority_aging;
+-- +goose StatementEnd
@@ -0,0 +1,31 @@
+-- +goose Up
+-- +goose StatementBegin
+-- v1_step_preemption opts a step's tasks into preemption: a running task of the step can be
+-- cancelled and requeued, without consuming a retry, to free its slot for higher-priority work.
+CREATE TABLE v1_step_preemption (
+    step_id UUID NOT NULL,
+    tenant_id UUID NOT NULL,
+    max_preemptions INTEGER NOT NULL,
+    CONSTRAINT v1_step_preemption_pkey PRIMARY KEY (step_id)
+);
+
+-- v1_task_preemption counts how many times each task has been preempted, so that a task can't be
+-- preempted indefinitely.
+CREATE TABLE v1_task_preemption (
+    task_id BIGINT NOT NULL,
+    task_inserted_at TIMESTAMPTZ NOT NULL,
+    tenant_id UUID NOT NULL,
+    preempted_count INTEGER NOT NULL DEFAULT 0,
+    last_preempted_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT v1_task_preemption_pkey PRIMARY KEY (task_id, task_inserted_at)
+);
+
+ALTER TYPE v1_event_type_olap ADD VALUE IF NOT EXISTS 'PREEMPTED';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE v1_task_preemption;
+DROP TABLE v1_step_preemption;
+-- NOTE: Postgres does not support removing enum values, so 'PREEMPTED' stays in v1_event_type_olap.
+-- +goose StatementEnd
@@ -0,0 +1,27 @@
+-- +goose Up
+-- +goose StatementBegin
+-- v1_delayed_event holds events which were pushed with a delivery time in the future. The ticker
+-- delivers each event once its deliver_at has passed, and deletes it afterwards.
+CREATE TABLE v1_delayed_event (
+    id bigint GENERATED ALWAYS AS IDENTITY,
+    tenant_id UUID NOT NULL,
+    external_id UUID NOT NULL,
+    key TEXT NOT NULL,
+    data JSONB,
+    additional_metadata JSONB,
+    priority INTEGER,
+    scope TEXT,
+    deliver_at TIMESTAMPTZ NOT NULL,
+    -- set while a ticker is delivering the event, so that the event isn't delivered concurrently
+    locked_until TIMESTAMPTZ,
+    inserted_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT v1_delayed_event_pkey PRIMARY KEY (id)
+);
+
+CREATE INDEX v1_delayed_event_deliver_at_idx ON v1_delayed_event (deliver_at);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE v1_delayed_event;
+-- +goose StatementEnd
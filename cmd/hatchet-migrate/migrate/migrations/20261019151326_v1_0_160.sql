@@ -0,0 +1,51 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TYPE v1_event_schema_mode AS ENUM ('REJECT', 'WARN');
+
+-- v1_event_schema binds an event key, or an event key pattern containing `*` wildcards, to a JSON
+-- Schema which payloads of matching events are validated against at ingest. Registering a schema
+-- for a key which already has one adds a new version, and the latest version is the active one.
+CREATE TABLE v1_event_schema (
+    tenant_id UUID NOT NULL,
+    event_key TEXT NOT NULL,
+    version INTEGER NOT NULL,
+    schema JSONB NOT NULL,
+    mode v1_event_schema_mode NOT NULL DEFAULT 'REJECT',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT v1_event_schema_pkey PRIMARY KEY (tenant_id, event_key, version)
+);
+
+CREATE TABLE v1_event_schema_validation_failures_olap (
+    id BIGINT NOT NULL GENERATED ALWAYS AS IDENTITY,
+
+    tenant_id UUID NOT NULL,
+
+    event_key TEXT NOT NULL,
+
+    -- the key or pattern and the version of the schema the event failed to validate against
+    schema_event_key TEXT NOT NULL,
+    schema_version INTEGER NOT NULL,
+
+    -- whether the event was rejected, or only recorded because the schema is in warn mode
+    rejected BOOLEAN NOT NULL,
+
+    error TEXT NOT NULL,
+
+    inserted_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    PRIMARY KEY (inserted_at, id)
+) PARTITION BY RANGE(inserted_at);
+
+CREATE INDEX v1_event_schema_validation_failures_olap_tenant_id_event_key_idx ON v1_event_schema_validation_failures_olap (tenant_id, event_key);
+
+SELECT create_v1_range_partition('v1_event_schema_validation_failures_olap', NOW()::DATE);
+SELECT create_v1_range_partition('v1_event_schema_validation_failures_olap', (NOW() + INTERVAL '1 day')::DATE);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE v1_event_schema_validation_failures_olap;
+DROP TABLE v1_event_schema;
+DROP TYPE v1_event_schema_mode;
+-- +goose StatementEnd
@@ -0,0 +1,28 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TYPE v1_worker_drain_action AS ENUM ('CANCEL', 'REQUEUE');
+
+-- v1_worker_cordon holds the cordoned workers of a tenant. A cordoned worker keeps running the tasks
+-- assigned to it, but isn't assigned new ones. A draining worker is also given a deadline, after
+-- which the tasks it's still running are moved off it: durable tasks are evicted and restored on
+-- another worker, and other tasks are cancelled or requeued according to the drain action.
+CREATE TABLE v1_worker_cordon (
+    tenant_id UUID NOT NULL,
+    worker_id UUID NOT NULL,
+    reason TEXT,
+    cordoned_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    drain_deadline TIMESTAMPTZ,
+    drain_action v1_worker_drain_action,
+    -- set once a draining worker is no longer running any tasks
+    drained_at TIMESTAMPTZ,
+    PRIMARY KEY (tenant_id, worker_id)
+);
+
+CREATE INDEX v1_worker_cordon_worker_id_idx ON v1_worker_cordon (worker_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE v1_worker_cordon;
+DROP TYPE v1_worker_drain_action;
+-- +goose StatementEnd
@@ -0,0 +1,19 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TYPE "WorkerLabelComparator" ADD VALUE IF NOT EXISTS 'IN';
+ALTER TYPE "WorkerLabelComparator" ADD VALUE IF NOT EXISTS 'NOT_IN';
+ALTER TYPE "WorkerLabelComparator" ADD VALUE IF NOT EXISTS 'EXISTS';
+ALTER TYPE "WorkerLabelComparator" ADD VALUE IF NOT EXISTS 'NOT_EXISTS';
+ALTER TYPE "WorkerLabelComparator" ADD VALUE IF NOT EXISTS 'SEMVER';
+ALTER TYPE "WorkerLabelComparator" ADD VALUE IF NOT EXISTS 'NOT_COLOCATED';
+
+-- the set of values for the IN and NOT_IN comparators
+ALTER TABLE "StepDesiredWorkerLabel" ADD COLUMN "strValues" TEXT[];
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE "StepDesiredWorkerLabel" DROP COLUMN "strValues";
+-- NOTE: Postgres does not support removing enum values, so the new comparators stay in
+-- "WorkerLabelComparator".
+-- +goose StatementEnd
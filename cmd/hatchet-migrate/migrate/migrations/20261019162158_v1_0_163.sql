@@ -0,0 +1,14 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TYPE "WorkerLabelComparator" ADD VALUE IF NOT EXISTS 'BEST_FIT';
+
+-- labels reported on worker heartbeats, which are replaced on every heartbeat
+ALTER TABLE "WorkerLabel" ADD COLUMN "isDynamic" BOOLEAN NOT NULL DEFAULT false;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE "WorkerLabel" DROP COLUMN "isDynamic";
+-- NOTE: Postgres does not support removing enum values, so BEST_FIT stays in
+-- "WorkerLabelComparator".
+-- +goose StatementEnd
@@ -0,0 +1,10 @@
+-- +goose Up
+-- +goose StatementBegin
+-- the worker which wrote the log line, which is null for lines written by older SDKs
+ALTER TABLE v1_log_line ADD COLUMN worker_id UUID;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE v1_log_line DROP COLUMN worker_id;
+-- +goose StatementEnd
@@ -0,0 +1,67 @@
+package migrations
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/pressly/goose/v3"
+)
+
+func init() {
+	goose.AddMigrationNoTxContext(up20261019173514, down20261019173514)
+}
+
+const v1LogLineMessageTsvIdx = "v1_log_line_message_tsv_idx"
+
+func v1LogLineMessageTsvIdxName(partition string) string {
+	return fmt.Sprintf("v1_log_line_message_tsv_idx_%s", partition)
+}
+
+// up20261019173514 creates a GIN index on the full-text search vector of log line messages, which
+// log searches with a query match against. Like up20260324135804, it's built concurrently on each
+// leaf partition, then created on the parent, which attaches the built indexes.
+func up20261019173514(ctx context.Context, db *sql.DB) error {
+	partitions, err := listLeafPartitions(ctx, db, v1LogLineTable, 1)
+	if err != nil {
+		return err
+	}
+
+	for _, partition := range partitions {
+		stmt := fmt.Sprintf(
+			`CREATE INDEX CONCURRENTLY IF NOT EXISTS %s ON %s USING GIN (to_tsvector('simple', message))`,
+			quoteIdent(v1LogLineMessageTsvIdxName(partition)),
+			quoteIdent(partition),
+		)
+
+		if _, err := db.ExecContext(ctx, stmt); err != nil {
+			return fmt.Errorf("create index concurrently on %s: %w", partition, err)
+		}
+	}
+
+	stmt := fmt.Sprintf(
+		`CREATE INDEX IF NOT EXISTS %s ON %s USING GIN (to_tsvector('simple', message))`,
+		quoteIdent(v1LogLineMessageTsvIdx),
+		quoteIdent(v1LogLineTable),
+	)
+
+	if _, err := db.ExecContext(ctx, stmt); err != nil {
+		return fmt.Errorf("create index on %s: %w", v1LogLineTable, err)
+	}
+
+	return nil
+}
+
+// down20261019173514 drops the parent index, which cascades to all child partition indexes.
+func down20261019173514(ctx context.Context, db *sql.DB) error {
+	stmt := fmt.Sprintf(
+		`DROP INDEX IF EXISTS %s`,
+		quoteIdent(v1LogLineMessageTsvIdx),
+	)
+
+	if _, err := db.ExecContext(ctx, stmt); err != nil {
+		return fmt.Errorf("drop index on %s: %w", v1LogLineTable, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,18 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE v1_step_retry_policy ADD COLUMN tenant_id UUID;
+
+UPDATE v1_step_retry_policy rp
+SET tenant_id = s."tenantId"
+FROM "Step" s
+WHERE s."id" = rp.step_id;
+
+DELETE FROM v1_step_retry_policy WHERE tenant_id IS NULL;
+
+ALTER TABLE v1_step_retry_policy ALTER COLUMN tenant_id SET NOT NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE v1_step_retry_policy DROP COLUMN tenant_id;
+-- +goose StatementEnd
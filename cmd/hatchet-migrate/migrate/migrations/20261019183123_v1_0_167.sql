@@ -0,0 +1,18 @@
+-- +goose Up
+-- +goose StatementBegin
+-- v1_task_retry_policy_count counts the retries of a task per matched retry policy, so each policy's
+-- retries only limit the failures which matched it.
+CREATE TABLE v1_task_retry_policy_count (
+    task_id BIGINT NOT NULL,
+    task_inserted_at TIMESTAMPTZ NOT NULL,
+    tenant_id UUID NOT NULL,
+    policy_index INTEGER NOT NULL,
+    retry_count INTEGER NOT NULL DEFAULT 0,
+    CONSTRAINT v1_task_retry_policy_count_pkey PRIMARY KEY (task_id, task_inserted_at, policy_index)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE v1_task_retry_policy_count;
+-- +goose StatementEnd
@@ -0,0 +1,11 @@
+-- +goose Up
+-- +goose StatementBegin
+-- circuit breaker keys are now prefixed with the step id. Breakers under the old keys would never
+-- be updated again, so they're removed and rebuilt from new failures.
+DELETE FROM v1_circuit_breaker;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DELETE FROM v1_circuit_breaker;
+-- +goose StatementEnd
@@ -0,0 +1,13 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE v1_bulk_job
+    ADD COLUMN enumeration_cursor_inserted_at TIMESTAMPTZ,
+    ADD COLUMN enumeration_cursor_id BIGINT;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE v1_bulk_job
+    DROP COLUMN enumeration_cursor_inserted_at,
+    DROP COLUMN enumeration_cursor_id;
+-- +goose StatementEnd
@@ -350,6 +350,7 @@ export enum V1TaskEventType {
   COULD_NOT_SEND_TO_WORKER = "COULD_NOT_SEND_TO_WORKER",
   DURABLE_EVICTED = "DURABLE_EVICTED",
   DURABLE_RESTORING = "DURABLE_RESTORING",
+  PREEMPTED = "PREEMPTED",
 }
 
 export enum V1WorkflowType {
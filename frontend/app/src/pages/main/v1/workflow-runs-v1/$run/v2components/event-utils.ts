@@ -18,6 +18,7 @@ export function eventTypeToSeverity(
     case V1TaskEventType.RETRIED_BY_USER:
     case V1TaskEventType.RETRYING:
     case V1TaskEventType.DURABLE_RESTORING:
+    case V1TaskEventType.PREEMPTED:
       return StepRunEventSeverity.WARNING;
     case V1TaskEventType.DURABLE_EVICTED:
       return 'EVICTION';
@@ -86,6 +87,8 @@ export function mapEventTypeToTitle(
       return 'Batch flushed';
     case V1TaskEventType.WAITING_FOR_BATCH:
       return 'Waiting for batch';
+    case V1TaskEventType.PREEMPTED:
+      return 'Preempted by higher-priority task';
     case undefined:
       return 'Unknown';
     default:
@@ -6,6 +6,14 @@ set -a
 . .env
 set +a
 
+# the timetravel build lets the admin API move the engine-wide clock, so it's opt-in
+TAGS=""
+
+if [ "${HATCHET_DEV_TIMETRAVEL:-false}" = "true" ]; then
+    TAGS="timetravel"
+    export SERVER_ALLOW_TIME_TRAVEL=true
+fi
+
 npx --yes nodemon --signal SIGINT --config nodemon.engine.json --exec go run \
-    -ldflags="-X main.Version=$(git rev-parse --short HEAD)" \
+    -tags "$TAGS" -ldflags="-X main.Version=$(git rev-parse --short HEAD)" \
     ./cmd/hatchet-engine --no-graceful-shutdown
@@ -41,3 +41,7 @@ protoc --proto_path=api-contracts/workflows --proto_path=api-contracts \
     --go_out=./internal/services/admin/contracts --go_opt=paths=source_relative \
     --go-grpc_out=./internal/services/admin/contracts --go-grpc_opt=paths=source_relative \
     workflows.proto
+
+protoc --proto_path=api-contracts/keda --go_out=./internal/services/scaler/contracts --go_opt=paths=source_relative \
+    --go-grpc_out=./internal/services/scaler/contracts --go-grpc_opt=paths=source_relative \
+    externalscaler.proto
@@ -3,6 +3,7 @@ package cel
 import (
 	"crypto/sha256"
 	"fmt"
+	"reflect"
 
 	"github.com/google/cel-go/cel"
 	"github.com/google/cel-go/checker/decls"
@@ -14,6 +15,7 @@ import (
 	"github.com/hatchet-dev/hatchet/pkg/repository/sqlcv1"
 
 	expr "google.golang.org/genproto/googleapis/api/expr/v1alpha1"
+	"google.golang.org/protobuf/types/known/structpb"
 )
 
 type CELParser struct {
@@ -21,6 +23,7 @@ type CELParser struct {
 	stepRunEnv         *cel.Env
 	eventEnv           *cel.Env
 	incomingWebhookEnv *cel.Env
+	errorEnv           *cel.Env
 }
 
 var checksumDecl = decls.NewFunction("checksum",
@@ -92,11 +95,20 @@ func NewCELParser() *CELParser {
 		),
 	)
 
+	errorEnv, _ := cel.NewEnv(
+		cel.Declarations(
+			decls.NewVar("error", decls.NewMapType(decls.String, decls.String)),
+			decls.NewVar("retry_count", decls.Int),
+		),
+		ext.Strings(),
+	)
+
 	return &CELParser{
 		workflowStrEnv:     workflowStrEnv,
 		stepRunEnv:         stepRunEnv,
 		eventEnv:           eventEnv,
 		incomingWebhookEnv: incomingWebhookEnv,
+		errorEnv:           errorEnv,
 	}
 }
 
@@ -152,6 +164,22 @@ func WithEventKey(key string) InputOpts {
 	}
 }
 
+// WithError sets the error of a failed task, exposed as `error.class` and `error.message`.
+func WithError(class, message string) InputOpts {
+	return func(w Input) {
+		w["error"] = map[string]string{
+			"class":   class,
+			"message": message,
+		}
+	}
+}
+
+func WithRetryCount(retryCount int32) InputOpts {
+	return func(w Input) {
+		w["retry_count"] = int64(retryCount)
+	}
+}
+
 func NewInput(opts ...InputOpts) Input {
 	res := make(map[string]interface{})
 
@@ -329,6 +357,16 @@ func (p *CELParser) CheckStepRunOutAgainstKnownV1(out *StepRunOut, knownType sql
 				return fmt.Errorf("%s, got int", prefix)
 			}
 
+			return fmt.Errorf("%s, got unknown type", prefix)
+		}
+	case sqlcv1.StepExpressionKindCIRCUITBREAKERKEY:
+		if out.String == nil {
+			prefix := "expected string output for circuit breaker key"
+
+			if out.Int != nil {
+				return fmt.Errorf("%s, got int", prefix)
+			}
+
 			return fmt.Errorf("%s, got unknown type", prefix)
 		}
 	case sqlcv1.StepExpressionKindDYNAMICRATELIMITUNITS:
@@ -397,3 +435,92 @@ func (p *CELParser) EvaluateIncomingWebhookExpression(expr string, input Input)
 
 	return out.Value().(string), nil
 }
+
+// ParseErrorExpression compiles a retry policy expression, which must evaluate to a boolean.
+func (p *CELParser) ParseErrorExpression(expr string) (cel.Program, error) {
+	ast, issues := p.errorEnv.Compile(expr)
+
+	if issues != nil && issues.Err() != nil {
+		return nil, issues.Err()
+	}
+
+	if ast.OutputType() != cel.BoolType {
+		return nil, fmt.Errorf("expression must evaluate to a boolean: got %s", ast.OutputType())
+	}
+
+	return p.errorEnv.Program(ast)
+}
+
+func (p *CELParser) EvaluateErrorExpression(expr string, input Input) (bool, error) {
+	program, err := p.ParseErrorExpression(expr)
+
+	if err != nil {
+		return false, fmt.Errorf("failed to compile expression: %w", err)
+	}
+
+	return EvaluateErrorProgram(program, input)
+}
+
+// EvaluateErrorProgram evaluates a retry policy expression compiled with ParseErrorExpression, so
+// callers can compile an expression once and evaluate it for every failure.
+func EvaluateErrorProgram(program cel.Program, input Input) (bool, error) {
+	var inMap map[string]interface{} = input
+
+	out, _, err := program.Eval(inMap)
+	if err != nil {
+		return false, fmt.Errorf("failed to evaluate expression: %w", err)
+	}
+
+	if out.Type() != types.BoolType {
+		return false, fmt.Errorf("expression did not evaluate to a boolean: got %s", out.Type().TypeName())
+	}
+
+	return out.Value().(bool), nil
+}
+
+// ParseStepRunList compiles a map step expression. Expressions whose type is known when compiling,
+// like a string or a map, must be a list; expressions on dynamic values such as `input.items` are
+// checked when they're evaluated.
+func (p *CELParser) ParseStepRunList(expr string) (cel.Program, error) {
+	ast, issues := p.stepRunEnv.Compile(expr)
+
+	if issues != nil && issues.Err() != nil {
+		return nil, issues.Err()
+	}
+
+	switch ast.OutputType().Kind() {
+	case types.ListKind, types.DynKind, types.AnyKind:
+	default:
+		return nil, fmt.Errorf("expression must evaluate to a list: got %s", ast.OutputType())
+	}
+
+	return p.stepRunEnv.Program(ast)
+}
+
+// EvaluateStepRunList evaluates a map step expression, which must return a list. The elements are
+// converted to JSON-compatible values.
+func (p *CELParser) EvaluateStepRunList(expr string, input Input) ([]interface{}, error) {
+	program, err := p.ParseStepRunList(expr)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to compile expression: %w", err)
+	}
+
+	var inMap map[string]interface{} = input
+
+	out, _, err := program.Eval(inMap)
+	if err != nil {
+		return nil, fmt.Errorf("failed to evaluate expression: %w", err)
+	}
+
+	if out.Type() != types.ListType {
+		return nil, fmt.Errorf("expression did not evaluate to a list: got %s", out.Type().TypeName())
+	}
+
+	native, err := out.ConvertToNative(reflect.TypeOf(&structpb.ListValue{}))
+	if err != nil {
+		return nil, fmt.Errorf("list elements must be JSON values: %w", err)
+	}
+
+	return native.(*structpb.ListValue).AsSlice(), nil
+}
@@ -177,3 +177,124 @@ func TestCELParserEventExpression(t *testing.T) {
 		})
 	}
 }
+
+func TestCELParserErrorExpression(t *testing.T) {
+	parser := cel.NewCELParser()
+
+	input := cel.NewInput(
+		cel.WithError("RateLimitError", "429 too many requests"),
+		cel.WithRetryCount(2),
+	)
+
+	tests := []struct {
+		expression  string
+		expected    bool
+		expectError bool
+	}{
+		{
+			expression: `error.class == "RateLimitError"`,
+			expected:   true,
+		},
+		{
+			expression: `error.message.contains("429") && retry_count < 5`,
+			expected:   true,
+		},
+		{
+			expression: `retry_count > 2`,
+			expected:   false,
+		},
+		{
+			expression:  `error.class`, // Not a boolean, expecting error
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expression, func(t *testing.T) {
+			result, err := parser.EvaluateErrorExpression(tt.expression, input)
+
+			if tt.expectError {
+				assert.Error(t, err, "Expected error but got none")
+			} else {
+				assert.NoError(t, err, "Did not expect error but got one")
+				assert.Equal(t, tt.expected, result, "Unexpected result")
+			}
+		})
+	}
+}
+
+func TestCELParserStepRunList(t *testing.T) {
+	parser := cel.NewCELParser()
+
+	input := cel.NewInput(
+		cel.WithInput(map[string]interface{}{
+			"regions": []interface{}{"us", "eu"},
+		}),
+		cel.WithParents(map[string]interface{}{
+			"fetch": map[string]interface{}{
+				"urls": []interface{}{"a", "b", "c"},
+			},
+		}),
+	)
+
+	tests := []struct {
+		expression  string
+		expected    []interface{}
+		expectError bool
+	}{
+		{
+			expression: `parents.fetch.urls`,
+			expected:   []interface{}{"a", "b", "c"},
+		},
+		{
+			expression: `input.regions.map(r, {"region": r})`,
+			expected: []interface{}{
+				map[string]interface{}{"region": "us"},
+				map[string]interface{}{"region": "eu"},
+			},
+		},
+		{
+			expression: `[1, 2]`,
+			expected:   []interface{}{float64(1), float64(2)},
+		},
+		{
+			expression: `[]`,
+			expected:   []interface{}{},
+		},
+		{
+			expression:  `input.missing`,
+			expectError: true,
+		},
+		{
+			expression:  `"not a list"`,
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expression, func(t *testing.T) {
+			result, err := parser.EvaluateStepRunList(tt.expression, input)
+
+			if tt.expectError {
+				assert.Error(t, err, "Expected error but got none")
+			} else {
+				assert.NoError(t, err, "Did not expect error but got one")
+				assert.Equal(t, tt.expected, result, "Unexpected result")
+			}
+		})
+	}
+}
+
+func TestCELParserParseStepRunList(t *testing.T) {
+	parser := cel.NewCELParser()
+
+	for _, expr := range []string{`parents.fetch.urls`, `input.regions.map(r, {"region": r})`, `[1, 2]`, `input.missing`} {
+		_, err := parser.ParseStepRunList(expr)
+		assert.NoError(t, err, expr)
+	}
+
+	for _, expr := range []string{`"not a list"`, `workflow_run_id`, `1 + 2`, `{"a": 1}`, `input.`} {
+		_, err := parser.ParseStepRunList(expr)
+		assert.Error(t, err, expr)
+	}
+}
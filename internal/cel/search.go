@@ -0,0 +1,586 @@
+package cel
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	celgo "github.com/google/cel-go/cel"
+	celast "github.com/google/cel-go/common/ast"
+	"github.com/google/cel-go/common/operators"
+	"github.com/google/cel-go/common/types"
+)
+
+// SearchField is the part of a run which a search condition applies to.
+type SearchField string
+
+const (
+	SearchFieldInput              SearchField = "input"
+	SearchFieldOutput             SearchField = "output"
+	SearchFieldError              SearchField = "error"
+	SearchFieldAdditionalMetadata SearchField = "additional_metadata"
+)
+
+// TextOp is how a text condition matches the text of a field.
+type TextOp string
+
+const (
+	TextOpEquals     TextOp = "equals"
+	TextOpContains   TextOp = "contains"
+	TextOpStartsWith TextOp = "startsWith"
+	TextOpEndsWith   TextOp = "endsWith"
+	TextOpMatches    TextOp = "matches"
+)
+
+const (
+	// maxSearchExprLength and maxSearchConditions bound the size of the SQL a search expression
+	// compiles to.
+	maxSearchExprLength = 2048
+	maxSearchConditions = 16
+)
+
+// SearchExpr is a search expression compiled to a tree of conditions. Exactly one of And, Or, Not
+// and Condition is set.
+type SearchExpr struct {
+	And       []*SearchExpr
+	Or        []*SearchExpr
+	Not       *SearchExpr
+	Condition *SearchCondition
+}
+
+// Conditions returns every condition in the expression.
+func (e *SearchExpr) Conditions() []*SearchCondition {
+	switch {
+	case e.Condition != nil:
+		return []*SearchCondition{e.Condition}
+	case e.Not != nil:
+		return e.Not.Conditions()
+	}
+
+	res := make([]*SearchCondition, 0)
+
+	for _, child := range append(e.And, e.Or...) {
+		res = append(res, child.Conditions()...)
+	}
+
+	return res
+}
+
+// SearchCondition is a single predicate on a field of a run. JSON conditions are compiled to a
+// Postgres JSONPath predicate over the field's JSON document, and text conditions match the text of
+// the field (the error message, or the serialized JSON document).
+type SearchCondition struct {
+	Field SearchField
+
+	// IsText is set for text conditions, which match Text using TextOp.
+	IsText bool
+	TextOp TextOp
+	Text   string
+
+	// path and predicate make up a JSON condition, as `<path><predicate>`. The path is kept
+	// separately so it can be nested under a prefix.
+	path      []string
+	predicate string
+	exists    bool
+
+	// raw is a JSONPath passed through jsonpath(field, "<path>").
+	raw string
+}
+
+// JSONPath returns the JSONPath predicate of a JSON condition, suitable for the `@@` operator. The
+// prefix keys are prepended to the path, for documents which nest the field under a key.
+func (c *SearchCondition) JSONPath(prefix ...string) string {
+	root := "$"
+
+	for _, key := range prefix {
+		root += "." + jsonPathString(key)
+	}
+
+	if c.raw != "" {
+		return "exists(" + root + strings.TrimPrefix(c.raw, "$") + ")"
+	}
+
+	path := root + strings.Join(c.path, "")
+
+	if c.exists {
+		return "exists(" + path + c.predicate + ")"
+	}
+
+	return path + c.predicate
+}
+
+// CompileSearchExpr compiles a search expression, written in a subset of CEL, to a tree of
+// conditions which can be evaluated by the database. The expression can reference:
+//
+//   - input, output and additional_metadata: JSON documents which support comparisons between a
+//     path and a literal (input.customer.email == "a@b.com", output.count > 5), has(),
+//     `"x" in input.tags`, `input.region in ["us", "eu"]`, and contains, startsWith, endsWith
+//     and matches on string values. contains on the document itself (output.contains("timeout"))
+//     searches its full text, and jsonpath(input, "$.items[*] ? (@.qty > 5)") matches a raw
+//     JSONPath expression.
+//   - error: the error message of a failed attempt, which supports ==, contains, startsWith,
+//     endsWith and matches.
+//
+// Conditions can be combined with &&, || and !.
+func CompileSearchExpr(expr string) (*SearchExpr, error) {
+	if strings.TrimSpace(expr) == "" {
+		return nil, fmt.Errorf("search expression is empty")
+	}
+
+	if len(expr) > maxSearchExprLength {
+		return nil, fmt.Errorf("search expression is longer than %d characters", maxSearchExprLength)
+	}
+
+	env, err := celgo.NewEnv()
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to create CEL environment: %w", err)
+	}
+
+	ast, issues := env.Parse(expr)
+
+	if issues != nil && issues.Err() != nil {
+		return nil, fmt.Errorf("could not parse search expression: %w", issues.Err())
+	}
+
+	res, err := compileSearchNode(ast.NativeRep().Expr())
+
+	if err != nil {
+		return nil, err
+	}
+
+	if n := len(res.Conditions()); n > maxSearchConditions {
+		return nil, fmt.Errorf("search expression has %d conditions, the maximum is %d", n, maxSearchConditions)
+	}
+
+	return res, nil
+}
+
+var comparisonOps = map[string]string{
+	operators.Equals:        "==",
+	operators.NotEquals:     "!=",
+	operators.Less:          "<",
+	operators.LessEquals:    "<=",
+	operators.Greater:       ">",
+	operators.GreaterEquals: ">=",
+}
+
+// flippedComparisonOps are the operators used when the literal is on the left of the comparison.
+var flippedComparisonOps = map[string]string{
+	"==": "==",
+	"!=": "!=",
+	"<":  ">",
+	"<=": ">=",
+	">":  "<",
+	">=": "<=",
+}
+
+func compileSearchNode(e celast.Expr) (*SearchExpr, error) {
+	switch e.Kind() {
+	case celast.CallKind:
+		return compileSearchCall(e)
+	case celast.SelectKind:
+		if e.AsSelect().IsTestOnly() {
+			return compileHas(e)
+		}
+	case celast.ComprehensionKind:
+		return nil, fmt.Errorf("macros such as all() and exists() are not supported in search expressions")
+	}
+
+	return nil, fmt.Errorf("%s is not a condition; search expressions must be comparisons combined with &&, || and !", describeExpr(e))
+}
+
+func compileSearchCall(e celast.Expr) (*SearchExpr, error) {
+	call := e.AsCall()
+	args := call.Args()
+
+	switch fn := call.FunctionName(); fn {
+	case operators.LogicalAnd, operators.LogicalOr:
+		children := make([]*SearchExpr, 0, len(args))
+
+		for _, arg := range args {
+			child, err := compileSearchNode(arg)
+
+			if err != nil {
+				return nil, err
+			}
+
+			children = append(children, child)
+		}
+
+		if fn == operators.LogicalAnd {
+			return &SearchExpr{And: children}, nil
+		}
+
+		return &SearchExpr{Or: children}, nil
+	case operators.LogicalNot:
+		child, err := compileSearchNode(args[0])
+
+		if err != nil {
+			return nil, err
+		}
+
+		return &SearchExpr{Not: child}, nil
+	case operators.In:
+		return compileIn(args[0], args[1])
+	case "contains", "startsWith", "endsWith", "matches":
+		if !call.IsMemberFunction() || len(args) != 1 {
+			return nil, fmt.Errorf("%s must be called on a field, for example input.name.%s(\"value\")", fn, fn)
+		}
+
+		return compileStringFunction(TextOp(fn), call.Target(), args[0])
+	case "jsonpath":
+		return compileRawJSONPath(args)
+	}
+
+	op, ok := comparisonOps[call.FunctionName()]
+
+	if !ok {
+		return nil, fmt.Errorf("function or operator %s is not supported in search expressions", strings.Trim(call.FunctionName(), "_@"))
+	}
+
+	lhs, rhs := args[0], args[1]
+
+	if lhs.Kind() == celast.LiteralKind || lhs.Kind() == celast.ListKind {
+		lhs, rhs = rhs, lhs
+		op = flippedComparisonOps[op]
+	}
+
+	field, path, err := compileFieldPath(lhs)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if field == SearchFieldError {
+		if len(path) > 0 {
+			return nil, fmt.Errorf("error is a string and has no fields")
+		}
+
+		if op != "==" {
+			return nil, fmt.Errorf("error only supports ==, contains, startsWith, endsWith and matches")
+		}
+
+		text, err := stringLiteral(rhs)
+
+		if err != nil {
+			return nil, err
+		}
+
+		return textCondition(field, TextOpEquals, text), nil
+	}
+
+	if len(path) == 0 {
+		return nil, fmt.Errorf("%s can't be compared directly, compare one of its fields instead (for example %s.id == \"value\")", field, field)
+	}
+
+	value, err := jsonPathLiteral(rhs)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return jsonCondition(field, path, " "+op+" "+value), nil
+}
+
+func compileHas(e celast.Expr) (*SearchExpr, error) {
+	sel := e.AsSelect()
+
+	field, path, err := compileFieldPath(sel.Operand())
+
+	if err != nil {
+		return nil, err
+	}
+
+	if field == SearchFieldError {
+		return nil, fmt.Errorf("has() is not supported on error")
+	}
+
+	return &SearchExpr{Condition: &SearchCondition{
+		Field:  field,
+		path:   append(path, "."+jsonPathString(sel.FieldName())),
+		exists: true,
+	}}, nil
+}
+
+func compileIn(elem, container celast.Expr) (*SearchExpr, error) {
+	// input.region in ["us", "eu"]
+	if container.Kind() == celast.ListKind {
+		field, path, err := compileFieldPath(elem)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if field == SearchFieldError || len(path) == 0 {
+			return nil, fmt.Errorf("the left side of in [...] must be a field of input, output or additional_metadata")
+		}
+
+		elems := container.AsList().Elements()
+
+		if len(elems) == 0 {
+			return nil, fmt.Errorf("in [...] requires at least one value")
+		}
+
+		values := make([]string, 0, len(elems))
+
+		for _, listElem := range elems {
+			value, err := jsonPathLiteral(listElem)
+
+			if err != nil {
+				return nil, err
+			}
+
+			values = append(values, value)
+		}
+
+		// a single JSONPath predicate keeps a long list to one condition
+		predicate := make([]string, len(values))
+
+		for i, value := range values {
+			predicate[i] = "@ == " + value
+		}
+
+		return jsonCondition(field, path, " ? ("+strings.Join(predicate, " || ")+")", true), nil
+	}
+
+	// "x" in input.tags
+	field, path, err := compileFieldPath(container)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if field == SearchFieldError || len(path) == 0 {
+		return nil, fmt.Errorf("the right side of in must be a field of input, output or additional_metadata, or a list")
+	}
+
+	value, err := jsonPathLiteral(elem)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return jsonCondition(field, append(path, "[*]"), " == "+value), nil
+}
+
+func compileStringFunction(op TextOp, target, arg celast.Expr) (*SearchExpr, error) {
+	field, path, err := compileFieldPath(target)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if field == SearchFieldError && len(path) > 0 {
+		return nil, fmt.Errorf("error is a string and has no fields")
+	}
+
+	text, err := stringLiteral(arg)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if op == TextOpMatches {
+		if _, err := regexp.Compile(text); err != nil {
+			return nil, fmt.Errorf("invalid regular expression %q: %w", text, err)
+		}
+	}
+
+	// contains on a whole document, or any function on the error, matches its text
+	if len(path) == 0 {
+		if field != SearchFieldError && op != TextOpContains {
+			return nil, fmt.Errorf("%s only supports contains; use %s on one of its fields instead", field, op)
+		}
+
+		return textCondition(field, op, text), nil
+	}
+
+	var pattern string
+
+	switch op {
+	case TextOpContains:
+		pattern = regexp.QuoteMeta(text)
+	case TextOpStartsWith:
+		return jsonCondition(field, path, " starts with "+jsonPathString(text)), nil
+	case TextOpEndsWith:
+		pattern = regexp.QuoteMeta(text) + "$"
+	case TextOpMatches:
+		pattern = text
+	}
+
+	return jsonCondition(field, path, " like_regex "+jsonPathString(pattern)), nil
+}
+
+func compileRawJSONPath(args []celast.Expr) (*SearchExpr, error) {
+	if len(args) != 2 {
+		return nil, fmt.Errorf(`jsonpath takes a field and a path, for example jsonpath(input, "$.items[*] ? (@.qty > 5)")`)
+	}
+
+	field, path, err := compileFieldPath(args[0])
+
+	if err != nil {
+		return nil, err
+	}
+
+	if field == SearchFieldError || len(path) > 0 {
+		return nil, fmt.Errorf("the first argument of jsonpath must be input, output or additional_metadata")
+	}
+
+	raw, err := stringLiteral(args[1])
+
+	if err != nil {
+		return nil, err
+	}
+
+	raw = strings.TrimSpace(raw)
+
+	if !strings.HasPrefix(raw, "$") {
+		return nil, fmt.Errorf("jsonpath %q must start with $", raw)
+	}
+
+	return &SearchExpr{Condition: &SearchCondition{
+		Field: field,
+		raw:   raw,
+	}}, nil
+}
+
+// compileFieldPath resolves an identifier, field selection or index expression to the field it
+// refers to and its JSONPath accessors.
+func compileFieldPath(e celast.Expr) (SearchField, []string, error) {
+	switch e.Kind() {
+	case celast.IdentKind:
+		switch field := SearchField(e.AsIdent()); field {
+		case SearchFieldInput, SearchFieldOutput, SearchFieldError, SearchFieldAdditionalMetadata:
+			return field, nil, nil
+		}
+
+		return "", nil, fmt.Errorf("unknown field %s, expected input, output, error or additional_metadata", e.AsIdent())
+	case celast.SelectKind:
+		sel := e.AsSelect()
+
+		if sel.IsTestOnly() {
+			break
+		}
+
+		field, path, err := compileFieldPath(sel.Operand())
+
+		if err != nil {
+			return "", nil, err
+		}
+
+		return field, append(path, "."+jsonPathString(sel.FieldName())), nil
+	case celast.CallKind:
+		call := e.AsCall()
+
+		if call.FunctionName() != operators.Index {
+			break
+		}
+
+		field, path, err := compileFieldPath(call.Args()[0])
+
+		if err != nil {
+			return "", nil, err
+		}
+
+		index := call.Args()[1]
+
+		if index.Kind() != celast.LiteralKind {
+			return "", nil, fmt.Errorf("indexes must be literals")
+		}
+
+		switch v := index.AsLiteral().(type) {
+		case types.String:
+			return field, append(path, "."+jsonPathString(string(v))), nil
+		case types.Int:
+			if v < 0 {
+				return "", nil, fmt.Errorf("array indexes must not be negative")
+			}
+
+			return field, append(path, "["+strconv.FormatInt(int64(v), 10)+"]"), nil
+		case types.Uint:
+			return field, append(path, "["+strconv.FormatUint(uint64(v), 10)+"]"), nil
+		}
+
+		return "", nil, fmt.Errorf("indexes must be strings or integers")
+	}
+
+	return "", nil, fmt.Errorf("%s is not a field, expected a path such as input.customer.email", describeExpr(e))
+}
+
+func jsonPathLiteral(e celast.Expr) (string, error) {
+	if e.Kind() != celast.LiteralKind {
+		return "", fmt.Errorf("%s is not a literal; fields can only be compared to strings, numbers, booleans and null", describeExpr(e))
+	}
+
+	switch v := e.AsLiteral().(type) {
+	case types.String:
+		return jsonPathString(string(v)), nil
+	case types.Int:
+		return strconv.FormatInt(int64(v), 10), nil
+	case types.Uint:
+		return strconv.FormatUint(uint64(v), 10), nil
+	case types.Double:
+		return strconv.FormatFloat(float64(v), 'f', -1, 64), nil
+	case types.Bool:
+		return strconv.FormatBool(bool(v)), nil
+	case types.Null:
+		return "null", nil
+	}
+
+	return "", fmt.Errorf("unsupported literal of type %s", e.AsLiteral().Type())
+}
+
+func stringLiteral(e celast.Expr) (string, error) {
+	if e.Kind() == celast.LiteralKind {
+		if s, ok := e.AsLiteral().(types.String); ok {
+			return string(s), nil
+		}
+	}
+
+	return "", fmt.Errorf("%s is not a string literal", describeExpr(e))
+}
+
+// jsonPathString quotes s as a JSONPath string literal, which uses the same escapes as JSON.
+func jsonPathString(s string) string {
+	b, _ := json.Marshal(s)
+
+	return string(b)
+}
+
+func jsonCondition(field SearchField, path []string, predicate string, exists ...bool) *SearchExpr {
+	return &SearchExpr{Condition: &SearchCondition{
+		Field:     field,
+		path:      path,
+		predicate: predicate,
+		exists:    len(exists) > 0 && exists[0],
+	}}
+}
+
+func textCondition(field SearchField, op TextOp, text string) *SearchExpr {
+	return &SearchExpr{Condition: &SearchCondition{
+		Field:  field,
+		IsText: true,
+		TextOp: op,
+		Text:   text,
+	}}
+}
+
+func describeExpr(e celast.Expr) string {
+	switch e.Kind() {
+	case celast.LiteralKind:
+		return fmt.Sprintf("literal %v", e.AsLiteral().Value())
+	case celast.IdentKind:
+		return e.AsIdent()
+	case celast.CallKind:
+		return fmt.Sprintf("call to %s", strings.Trim(e.AsCall().FunctionName(), "_@"))
+	case celast.ListKind:
+		return "list"
+	case celast.MapKind:
+		return "map"
+	}
+
+	return "expression"
+}
@@ -0,0 +1,134 @@
+//go:build !e2e && !load && !rampup && !integration
+
+package cel_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hatchet-dev/hatchet/internal/cel"
+)
+
+func TestCompileSearchExprConditions(t *testing.T) {
+	tests := []struct {
+		expr     string
+		field    cel.SearchField
+		jsonPath string
+	}{
+		{`input.customer.email == "a@b.com"`, cel.SearchFieldInput, `$."customer"."email" == "a@b.com"`},
+		{`input["first name"] != "x"`, cel.SearchFieldInput, `$."first name" != "x"`},
+		{`5 < output.count`, cel.SearchFieldOutput, `$."count" > 5`},
+		{`output.items[0].price >= 1.5`, cel.SearchFieldOutput, `$."items"[0]."price" >= 1.5`},
+		{`input.enabled == true`, cel.SearchFieldInput, `$."enabled" == true`},
+		{`input.deleted_at == null`, cel.SearchFieldInput, `$."deleted_at" == null`},
+		{`has(input.customer.id)`, cel.SearchFieldInput, `exists($."customer"."id")`},
+		{`"vip" in input.tags`, cel.SearchFieldInput, `$."tags"[*] == "vip"`},
+		{`additional_metadata.region in ["us", "eu"]`, cel.SearchFieldAdditionalMetadata, `exists($."region" ? (@ == "us" || @ == "eu"))`},
+		{`input.email.endsWith("@example.com")`, cel.SearchFieldInput, `$."email" like_regex "@example\\.com$"`},
+		{`input.email.startsWith("admin")`, cel.SearchFieldInput, `$."email" starts with "admin"`},
+		{`output.message.contains("a.b")`, cel.SearchFieldOutput, `$."message" like_regex "a\\.b"`},
+		{`input.id.matches("^[0-9]+$")`, cel.SearchFieldInput, `$."id" like_regex "^[0-9]+$"`},
+		{`input.name == "say \"hi\""`, cel.SearchFieldInput, `$."name" == "say \"hi\""`},
+		{`jsonpath(input, "$.items[*] ? (@.qty > 5)")`, cel.SearchFieldInput, `exists($.items[*] ? (@.qty > 5))`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expr, func(t *testing.T) {
+			expr, err := cel.CompileSearchExpr(tt.expr)
+			require.NoError(t, err)
+			require.NotNil(t, expr.Condition)
+
+			assert.Equal(t, tt.field, expr.Condition.Field)
+			assert.False(t, expr.Condition.IsText)
+			assert.Equal(t, tt.jsonPath, expr.Condition.JSONPath())
+		})
+	}
+}
+
+func TestCompileSearchExprPrefix(t *testing.T) {
+	expr, err := cel.CompileSearchExpr(`input.customer.email == "a@b.com"`)
+	require.NoError(t, err)
+
+	assert.Equal(t, `$."input"."customer"."email" == "a@b.com"`, expr.Condition.JSONPath("input"))
+
+	expr, err = cel.CompileSearchExpr(`jsonpath(input, "$.items[*] ? (@.qty > 5)")`)
+	require.NoError(t, err)
+
+	assert.Equal(t, `exists($."input".items[*] ? (@.qty > 5))`, expr.Condition.JSONPath("input"))
+}
+
+func TestCompileSearchExprText(t *testing.T) {
+	tests := []struct {
+		expr  string
+		field cel.SearchField
+		op    cel.TextOp
+		text  string
+	}{
+		{`output.contains("timeout")`, cel.SearchFieldOutput, cel.TextOpContains, "timeout"},
+		{`error.contains("timeout")`, cel.SearchFieldError, cel.TextOpContains, "timeout"},
+		{`error.startsWith("context deadline")`, cel.SearchFieldError, cel.TextOpStartsWith, "context deadline"},
+		{`error == "boom"`, cel.SearchFieldError, cel.TextOpEquals, "boom"},
+		{`error.matches("status [45][0-9]{2}")`, cel.SearchFieldError, cel.TextOpMatches, "status [45][0-9]{2}"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expr, func(t *testing.T) {
+			expr, err := cel.CompileSearchExpr(tt.expr)
+			require.NoError(t, err)
+			require.NotNil(t, expr.Condition)
+
+			assert.True(t, expr.Condition.IsText)
+			assert.Equal(t, tt.field, expr.Condition.Field)
+			assert.Equal(t, tt.op, expr.Condition.TextOp)
+			assert.Equal(t, tt.text, expr.Condition.Text)
+		})
+	}
+}
+
+func TestCompileSearchExprBoolean(t *testing.T) {
+	expr, err := cel.CompileSearchExpr(`input.customer.email == "a@b.com" && (error.contains("timeout") || !has(output.result))`)
+	require.NoError(t, err)
+
+	require.Len(t, expr.And, 2)
+	assert.Equal(t, cel.SearchFieldInput, expr.And[0].Condition.Field)
+
+	require.Len(t, expr.And[1].Or, 2)
+	assert.Equal(t, cel.SearchFieldError, expr.And[1].Or[0].Condition.Field)
+
+	require.NotNil(t, expr.And[1].Or[1].Not)
+	assert.Equal(t, `exists($."result")`, expr.And[1].Or[1].Not.Condition.JSONPath())
+
+	assert.Len(t, expr.Conditions(), 3)
+}
+
+func TestCompileSearchExprErrors(t *testing.T) {
+	tests := []struct {
+		expr string
+		err  string
+	}{
+		{``, "empty"},
+		{`input.a ==`, "could not parse"},
+		{`foo.bar == 1`, "unknown field foo"},
+		{`input == 1`, "can't be compared directly"},
+		{`input.a == input.b`, "not a literal"},
+		{`input.a + 1 == 2`, "not a field"},
+		{`input.a`, "not a condition"},
+		{`input.items.all(i, i > 1)`, "not supported"},
+		{`error > "a"`, "error only supports"},
+		{`error.message == "a"`, "has no fields"},
+		{`input.startsWith("a")`, "only supports contains"},
+		{`input.name.matches("(")`, "invalid regular expression"},
+		{`jsonpath(input, "items")`, "must start with $"},
+		{`size(input.items) > 1`, "not a field"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expr, func(t *testing.T) {
+			_, err := cel.CompileSearchExpr(tt.expr)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.err)
+		})
+	}
+}
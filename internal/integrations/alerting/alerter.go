@@ -2,7 +2,10 @@ package alerting
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"sort"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
@@ -219,3 +222,106 @@ func (t *TenantAlertManager) sendTenantResourceLimitAlert(ctx context.Context, t
 
 	return nil
 }
+
+// SendApprovalRequestedAlert notifies the tenant's alerting channels and the approvers that an
+// approval task is waiting for a decision.
+func (t *TenantAlertManager) SendApprovalRequestedAlert(tenantId uuid.UUID, approval *sqlcv1.V1Approval) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	tenantAlerting, err := t.repo.TenantAlertingSettings().GetTenantAlertingSettings(ctx, tenantId)
+
+	if err != nil {
+		return fmt.Errorf("could not get tenant alerting settings: %w", err)
+	}
+
+	payload := &alerttypes.ApprovalRequestedItem{
+		Link:                  fmt.Sprintf("%s/tenants/%s/runs/%s", t.frontendURL, tenantId, approval.WorkflowRunID),
+		TaskName:              approval.TaskName,
+		TaskExternalId:        approval.TaskExternalID.String(),
+		ExpiresAtRelativeDate: timediff.TimeDiff(approval.ExpiresAt.Time),
+		ExpiresAtAbsoluteDate: approval.ExpiresAt.Time.UTC().Format("2006-01-02 15:04:05"),
+		Context:               getApprovalContextFields(approval.Context),
+	}
+
+	// iterate through possible alerters
+	for _, slackWebhook := range tenantAlerting.SlackWebhooks {
+		if innerErr := t.sendSlackApprovalRequestedAlert(slackWebhook, payload); innerErr != nil {
+			err = multierror.Append(err, innerErr)
+		}
+	}
+
+	// approvers are emailed along with the tenant's alert email groups, once per address
+	emails := make([]string, 0, len(approval.Approvers))
+	seen := make(map[string]bool)
+
+	addEmail := func(e string) {
+		key := strings.ToLower(strings.TrimSpace(e))
+
+		if key == "" || seen[key] {
+			return
+		}
+
+		seen[key] = true
+		emails = append(emails, e)
+	}
+
+	for _, approver := range approval.Approvers {
+		addEmail(approver)
+	}
+
+	for _, emailGroup := range tenantAlerting.EmailGroups {
+		for _, e := range emailGroup.Emails {
+			addEmail(e)
+		}
+	}
+
+	if len(emails) > 0 {
+		if innerErr := t.sendEmailApprovalRequestedAlert(tenantAlerting.Tenant, emails, payload); innerErr != nil {
+			err = multierror.Append(err, innerErr)
+		}
+	}
+
+	if err != nil {
+		return fmt.Errorf("could not send approval alert: %w", err)
+	}
+
+	return nil
+}
+
+// getApprovalContextFields renders the approval context as sorted key-value pairs. String values
+// are shown as-is and other values as JSON.
+func getApprovalContextFields(approvalCtx []byte) []alerttypes.ApprovalContextField {
+	res := make([]alerttypes.ApprovalContextField, 0)
+
+	var fields map[string]json.RawMessage
+
+	if err := json.Unmarshal(approvalCtx, &fields); err != nil {
+		return res
+	}
+
+	keys := make([]string, 0, len(fields))
+
+	for k := range fields {
+		keys = append(keys, k)
+	}
+
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		value := string(fields[k])
+
+		var str string
+
+		if err := json.Unmarshal(fields[k], &str); err == nil {
+			value = str
+		}
+
+		res = append(res, alerttypes.ApprovalContextField{
+			Key:   k,
+			Value: value,
+		})
+	}
+
+	return res
+}
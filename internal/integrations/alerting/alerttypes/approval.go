@@ -0,0 +1,15 @@
+package alerttypes
+
+type ApprovalRequestedItem struct {
+	Link                  string                 `json:"link"`
+	TaskName              string                 `json:"task_name"`
+	TaskExternalId        string                 `json:"task_external_id"`
+	ExpiresAtRelativeDate string                 `json:"expires_at_relative_date"`
+	ExpiresAtAbsoluteDate string                 `json:"expires_at_absolute_date"`
+	Context               []ApprovalContextField `json:"context"`
+}
+
+type ApprovalContextField struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
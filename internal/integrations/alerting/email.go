@@ -104,3 +104,24 @@ func (t *TenantAlertManager) sendEmailTenantResourceLimitAlert(tenant *sqlcv1.Te
 		},
 	)
 }
+
+func (t *TenantAlertManager) sendEmailApprovalRequestedAlert(tenant *sqlcv1.Tenant, emails []string, payload *alerttypes.ApprovalRequestedItem) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	return t.email.SendApprovalRequestedEmail(
+		ctx,
+		emails,
+		email.ApprovalRequestedEmailData{
+			TenantName:            tenant.Name,
+			Subject:               fmt.Sprintf("Approval requested for '%s'", payload.TaskName),
+			TaskName:              payload.TaskName,
+			TaskExternalId:        payload.TaskExternalId,
+			ExpiresAtAbsoluteDate: payload.ExpiresAtAbsoluteDate,
+			ExpiresAtRelativeDate: payload.ExpiresAtRelativeDate,
+			Context:               payload.Context,
+			Link:                  payload.Link,
+			SettingsLink:          fmt.Sprintf("%s/tenants/%s/settings/alerting", t.frontendURL, tenant.ID),
+		},
+	)
+}
@@ -221,3 +221,72 @@ func (t *TenantAlertManager) getSlackTenantResourceLimitTextAndBlocks(payload *a
 		BlockSet: res,
 	}
 }
+
+func (t *TenantAlertManager) sendSlackApprovalRequestedAlert(slackWebhook *sqlcv1.SlackAppWebhook, payload *alerttypes.ApprovalRequestedItem) error {
+	headerText, blocks := t.getSlackApprovalRequestedTextAndBlocks(payload)
+
+	// decrypt the webhook url
+	whDecrypted, err := t.enc.Decrypt(slackWebhook.WebhookURL, "incoming_webhook_url")
+
+	if err != nil {
+		return err
+	}
+
+	return slack.PostWebhook(string(whDecrypted), &slack.WebhookMessage{
+		Text:   headerText,
+		Blocks: blocks,
+	})
+}
+
+func (t *TenantAlertManager) getSlackApprovalRequestedTextAndBlocks(payload *alerttypes.ApprovalRequestedItem) (string, *slack.Blocks) {
+	res := make([]slack.Block, 0)
+
+	headerText := fmt.Sprintf(":raised_hand: `%s` is waiting for approval, expires %s", payload.TaskName, payload.ExpiresAtRelativeDate)
+
+	res = append(res, slack.NewSectionBlock(
+		slack.NewTextBlockObject(slack.MarkdownType, headerText, false, false),
+		nil,
+		nil,
+	))
+
+	if len(payload.Context) > 0 {
+		fields := make([]*slack.TextBlockObject, 0, len(payload.Context))
+
+		for i, field := range payload.Context {
+			// slack allows at most 10 fields per section
+			if i >= 10 {
+				break
+			}
+
+			fields = append(fields, slack.NewTextBlockObject(slack.MarkdownType, fmt.Sprintf("*%s*\n%s", field.Key, field.Value), false, false))
+		}
+
+		res = append(res, slack.NewSectionBlock(nil, fields, nil))
+	}
+
+	buttonAccessory := slack.NewAccessory(
+		slack.NewButtonBlockElement(
+			"View Run",
+			payload.TaskExternalId,
+			slack.NewTextBlockObject(slack.PlainTextType, "View Run", true, false),
+		),
+	)
+
+	buttonAccessory.ButtonElement.URL = payload.Link
+	buttonAccessory.ButtonElement.ActionID = "button-action"
+
+	res = append(res, slack.NewSectionBlock(
+		slack.NewTextBlockObject(
+			slack.MarkdownType,
+			fmt.Sprintf("Approve or reject with `hatchet approvals approve %s` or `hatchet approvals reject %s`.", payload.TaskExternalId, payload.TaskExternalId),
+			false,
+			false,
+		),
+		nil,
+		buttonAccessory,
+	))
+
+	return headerText, &slack.Blocks{
+		BlockSet: res,
+	}
+}
@@ -14,6 +14,7 @@ const (
 	MsgIDCreatedDAG                   = "created-dag"
 	MsgIDCreatedEventTrigger          = "created-event-trigger"
 	MsgIDCreatedTask                  = "created-task"
+	MsgIDEventSchemaValidationFailure = "event-schema-validation-failure"
 	MsgIDFailedWebhookValidation      = "failed-webhook-validation"
 	MsgIDInternalEvent                = "internal-event"
 	MsgIDOffloadPayload               = "offload-payload"
@@ -22,6 +23,7 @@ const (
 	MsgIDTaskCancelled                = "task-cancelled"
 	MsgIDTaskCompleted                = "task-completed"
 	MsgIDTaskFailed                   = "task-failed"
+	MsgIDTaskSlotUsage                = "task-slot-usage"
 	MsgIDTaskStreamEvent              = "task-stream-event"
 	MsgIDTaskTrigger                  = "task-trigger"
 	MsgIDUserEvent                    = "user-event"
@@ -73,6 +73,10 @@ func (a *AdminServiceImpl) PutWorkflow(ctx context.Context, req *contracts.PutWo
 		createOpts,
 	)
 
+	if errors.Is(err, v1.ErrInvalidDesiredWorkerLabel) {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
 	if err != nil {
 		return nil, err
 	}
@@ -400,6 +404,7 @@ func getCreateTaskOpts(steps []*contracts.CreateWorkflowStepOpts, scheduleTimeou
 					Key:        k,
 					StrValue:   v.StrValue,
 					IntValue:   v.IntValue,
+					StrValues:  v.StrValues,
 					Required:   v.Required,
 					Weight:     v.Weight,
 					Comparator: c,
@@ -430,7 +435,7 @@ func getCreateTaskOpts(steps []*contracts.CreateWorkflowStepOpts, scheduleTimeou
 				maxInt := int(*step.BackoffMaxSeconds)
 				tasks[j].RetryBackoffMaxSeconds = &maxInt
 			} else {
-				maxInt := 24 * 60 * 60
+				maxInt := v1.DefaultRetryBackoffMaxSeconds
 				tasks[j].RetryBackoffMaxSeconds = &maxInt
 			}
 		}
@@ -37,6 +37,8 @@ type AdminServiceImpl struct {
 
 	tw        *trigger.TriggerWriter
 	pubBuffer *msgqueue.MQPubBuffer
+
+	timeTravelEnabled bool
 }
 
 type AdminServiceOpt func(*AdminServiceOpts)
@@ -55,6 +57,7 @@ type AdminServiceOpts struct {
 
 	grpcTriggersEnabled bool
 	grpcTriggerSlots    int
+	timeTravelEnabled   bool
 
 	promGate *prometheus.Gate
 }
@@ -118,6 +121,14 @@ func WithOptimisticSchedulingEnabled(enabled bool) AdminServiceOpt {
 	}
 }
 
+// WithTimeTravelEnabled allows AdvanceClock to move the engine clock. The engine must also be
+// built with the `timetravel` build tag.
+func WithTimeTravelEnabled(enabled bool) AdminServiceOpt {
+	return func(opts *AdminServiceOpts) {
+		opts.timeTravelEnabled = enabled
+	}
+}
+
 func WithLogger(l *zerolog.Logger) AdminServiceOpt {
 	return func(opts *AdminServiceOpts) {
 		opts.l = l
@@ -171,16 +182,17 @@ func NewAdminService(fs ...AdminServiceOpt) (AdminService, error) {
 	}
 
 	return &AdminServiceImpl{
-		repo:            opts.repo,
-		mq:              opts.mq,
-		pubsub:          opts.pubsub,
-		v:               opts.v,
-		analytics:       opts.analytics,
-		localScheduler:  localScheduler,
-		localDispatcher: opts.localDispatcher,
-		l:               opts.l,
-		tw:              tw,
-		pubBuffer:       pubBuffer,
+		repo:              opts.repo,
+		mq:                opts.mq,
+		pubsub:            opts.pubsub,
+		v:                 opts.v,
+		analytics:         opts.analytics,
+		localScheduler:    localScheduler,
+		localDispatcher:   opts.localDispatcher,
+		l:                 opts.l,
+		tw:                tw,
+		pubBuffer:         pubBuffer,
+		timeTravelEnabled: opts.timeTravelEnabled,
 	}, nil
 }
 
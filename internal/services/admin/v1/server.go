@@ -9,17 +9,22 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
 	"golang.org/x/sync/errgroup"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/hatchet-dev/hatchet/internal/listutils"
 	"github.com/hatchet-dev/hatchet/internal/msgqueue"
+	"github.com/hatchet-dev/hatchet/internal/services/shared/durable"
 	contracts "github.com/hatchet-dev/hatchet/internal/services/shared/proto/v1"
+	"github.com/hatchet-dev/hatchet/internal/services/shared/replay"
 	tasktypes "github.com/hatchet-dev/hatchet/internal/services/shared/tasktypes/v1"
 	"github.com/hatchet-dev/hatchet/internal/statusutils"
 	"github.com/hatchet-dev/hatchet/pkg/analytics"
 	"github.com/hatchet-dev/hatchet/pkg/client/types"
+	"github.com/hatchet-dev/hatchet/pkg/clock"
 	v1 "github.com/hatchet-dev/hatchet/pkg/repository"
 	"github.com/hatchet-dev/hatchet/pkg/repository/sqlcv1"
 
@@ -272,97 +277,13 @@ func (a *AdminServiceImpl) ReplayTasks(ctx context.Context, req *contracts.Repla
 		externalIds = append(externalIds, runExternalIds...)
 	}
 
-	tasksToReplay := []tasktypes.TaskIdInsertedAtRetryCountWithExternalId{}
-
-	tasks, err := a.repo.Tasks().FlattenExternalIds(ctx, tenant.ID, externalIds)
-
-	if err != nil {
-		return nil, err
-	}
-
-	wholeRunOrchestrators := make(map[uuid.UUID]bool)
-
-	for _, task := range tasks {
-		if !task.IsDagOrchestrator {
-			continue
-		}
-
-		wholeRunOrchestrators[task.ExternalID] = true
-
-		// a whole-run replay forces every step of the DAG to re-run
-		childExternalIds, err := a.repo.Tasks().ListDurableOrchestratorChildExternalIds(ctx, tenant.ID, task.ExternalID)
-
-		if err != nil {
-			return nil, status.Errorf(codes.Internal, "failed to list orchestrator children for replay: %v", err)
-		}
-
-		if _, err := a.repo.DurableEvents().HandleBranchForDAGReplay(ctx, tenant.ID, task, childExternalIds); err != nil {
-			return nil, status.Errorf(codes.Internal, "failed to branch durable task for replay: %v", err)
-		}
-	}
-
-	tasks, err = a.handleOperatorDAGStepReplays(ctx, tenant.ID, tasks, wholeRunOrchestrators)
+	tasksToReplay, err := replay.ResolveTasks(ctx, a.repo, tenant.ID, externalIds)
 
 	if err != nil {
-		return nil, err
-	}
-
-	// Deduplicate based on TaskIdInsertedAtRetryCountWithExternalId
-	existingReplays := make(map[tasktypes.TaskIdInsertedAtRetryCountWithExternalId]bool)
-
-	for _, task := range tasks {
-		record := tasktypes.TaskIdInsertedAtRetryCountWithExternalId{
-			TaskIdInsertedAtRetryCount: v1.TaskIdInsertedAtRetryCount{
-				Id:         task.ID,
-				InsertedAt: task.InsertedAt,
-				RetryCount: task.RetryCount,
-			},
-			WorkflowRunExternalId: task.WorkflowRunID,
-			TaskExternalId:        task.ExternalID,
-		}
-
-		if _, exists := existingReplays[record]; exists {
-			continue
-		}
-
-		existingReplays[record] = true
-		tasksToReplay = append(tasksToReplay, record)
-	}
-
-	workflowRunIdToTasksToReplay := make(map[uuid.UUID][]tasktypes.TaskIdInsertedAtRetryCountWithExternalId)
-	for _, item := range tasksToReplay {
-		workflowRunIdToTasksToReplay[item.WorkflowRunExternalId] = append(
-			workflowRunIdToTasksToReplay[item.WorkflowRunExternalId],
-			item,
-		)
+		return nil, status.Errorf(codes.Internal, "failed to resolve tasks for replay: %v", err)
 	}
 
-	var batches [][]tasktypes.TaskIdInsertedAtRetryCountWithExternalId
-	var currentBatch []tasktypes.TaskIdInsertedAtRetryCountWithExternalId
-	batchSize := 100
-
-	for _, tasksForWorkflowRun := range workflowRunIdToTasksToReplay {
-		if len(currentBatch) > 0 && len(currentBatch)+len(tasksForWorkflowRun) > batchSize {
-			// If the current batch would exceed the batch size if we added the current workflow run's tasks,
-			// we "finalize" the batch and start a new one
-			batches = append(batches, currentBatch)
-			currentBatch = nil
-		}
-
-		if len(tasksForWorkflowRun) > batchSize {
-			// If the current workflow run's task count exceeds the batch size on its own,
-			// we let it be its own batch
-			batches = append(batches, tasksForWorkflowRun)
-		} else {
-			// Otherwise, add it to the current batch
-			currentBatch = append(currentBatch, tasksForWorkflowRun...)
-		}
-	}
-
-	if len(currentBatch) > 0 {
-		// Last case to handle - add the last batch if it has any tasks
-		batches = append(batches, currentBatch)
-	}
+	batches := replay.BatchByWorkflowRun(tasksToReplay, 100)
 
 	replayedIds := make([]string, 0)
 
@@ -402,110 +323,6 @@ func (a *AdminServiceImpl) ReplayTasks(ctx context.Context, req *contracts.Repla
 	}, nil
 }
 
-// handleOperatorDAGStepReplays rewrites the replay set for selected steps of operator-managed
-// DAGs: resetting a child task alone would never re-trigger its descendants, so the orchestrator's
-// log is branched with the selected children as the forced-replay set and the orchestrator
-// replaces them in the replay batch.
-func (a *AdminServiceImpl) handleOperatorDAGStepReplays(
-	ctx context.Context,
-	tenantId uuid.UUID,
-	tasks []*sqlcv1.FlattenExternalIdsRow,
-	wholeRunOrchestrators map[uuid.UUID]bool,
-) ([]*sqlcv1.FlattenExternalIdsRow, error) {
-	parentExternalIds := make([]uuid.UUID, 0)
-	seenParents := make(map[uuid.UUID]bool)
-
-	for _, task := range tasks {
-		if task.IsDagOrchestrator || task.ParentTaskExternalID == nil || seenParents[*task.ParentTaskExternalID] {
-			continue
-		}
-
-		seenParents[*task.ParentTaskExternalID] = true
-		parentExternalIds = append(parentExternalIds, *task.ParentTaskExternalID)
-	}
-
-	if len(parentExternalIds) == 0 {
-		return tasks, nil
-	}
-
-	parents, err := a.repo.Tasks().FlattenExternalIds(ctx, tenantId, parentExternalIds)
-
-	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to look up parent tasks for replay: %v", err)
-	}
-
-	orchestrators := make(map[uuid.UUID]*sqlcv1.FlattenExternalIdsRow)
-
-	for _, parent := range parents {
-		if parent.IsDagOrchestrator {
-			orchestrators[parent.ExternalID] = parent
-		}
-	}
-
-	if len(orchestrators) == 0 {
-		return tasks, nil
-	}
-
-	orchestratorToChildren := make(map[uuid.UUID][]uuid.UUID)
-	childRows := make(map[uuid.UUID]*sqlcv1.FlattenExternalIdsRow)
-
-	for _, task := range tasks {
-		if task.IsDagOrchestrator || task.ParentTaskExternalID == nil {
-			continue
-		}
-
-		orchestrator, ok := orchestrators[*task.ParentTaskExternalID]
-
-		if !ok {
-			continue
-		}
-
-		childRows[task.ExternalID] = task
-
-		// when the whole run is also selected, its branch already replays every step
-		if !wholeRunOrchestrators[orchestrator.ExternalID] {
-			orchestratorToChildren[orchestrator.ExternalID] = append(orchestratorToChildren[orchestrator.ExternalID], task.ExternalID)
-		}
-	}
-
-	if len(childRows) == 0 {
-		return tasks, nil
-	}
-
-	result := make([]*sqlcv1.FlattenExternalIdsRow, 0, len(tasks))
-
-	for _, task := range tasks {
-		if _, ok := childRows[task.ExternalID]; ok {
-			continue
-		}
-
-		result = append(result, task)
-	}
-
-	for orchestratorExternalId, childExternalIds := range orchestratorToChildren {
-		orchestrator := orchestrators[orchestratorExternalId]
-
-		branch, err := a.repo.DurableEvents().HandleBranchForDAGReplay(ctx, tenantId, orchestrator, childExternalIds)
-
-		if err != nil {
-			return nil, status.Errorf(codes.Internal, "failed to branch durable task for step replay: %v", err)
-		}
-
-		if branch == nil {
-			// no durable event log to re-invoke; replay the selected children directly
-			for _, childExternalId := range childExternalIds {
-				result = append(result, childRows[childExternalId])
-			}
-
-			continue
-		}
-
-		result = append(result, orchestrator)
-	}
-
-	return result, nil
-}
-
 func (a *AdminServiceImpl) TriggerWorkflowRun(ctx context.Context, req *contracts.TriggerWorkflowRunRequest) (*contracts.TriggerWorkflowRunResponse, error) {
 	tenant := ctx.Value("tenant").(*sqlcv1.Tenant)
 	tenantId := tenant.ID
@@ -513,6 +330,7 @@ func (a *AdminServiceImpl) TriggerWorkflowRun(ctx context.Context, req *contract
 		"has_priority", req.Priority != nil,
 		"has_additional_meta", len(req.AdditionalMetadata) > 0,
 		"has_desired_worker_labels", len(req.DesiredWorkerLabels) > 0,
+		"has_target_action", req.TargetActionId != nil,
 	))
 
 	canCreateTR, trLimit, err := a.repo.TenantLimit().CanCreate(
@@ -632,6 +450,142 @@ func (a *AdminServiceImpl) BranchDurableTask(ctx context.Context, req *contracts
 	}, nil
 }
 
+// AdvanceClock moves the engine clock forward, so tests can fast-forward through sleeps,
+// timeouts and scheduled runs. The clock is shared by every tenant, so it is rejected unless
+// the engine was built with -tags timetravel and SERVER_ALLOW_TIME_TRAVEL is set, which must
+// only be done for single-user dev and test engines.
+func (a *AdminServiceImpl) AdvanceClock(ctx context.Context, req *contracts.AdvanceClockRequest) (*contracts.AdvanceClockResponse, error) {
+	if !a.timeTravelEnabled {
+		return nil, status.Error(codes.PermissionDenied, "time travel is not allowed on this engine, set SERVER_ALLOW_TIME_TRAVEL on a dev engine to enable it")
+	}
+
+	d, err := time.ParseDuration(req.AdvanceBy)
+
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid advance_by duration %q: %v", req.AdvanceBy, err)
+	}
+
+	offset, err := clock.Advance(d)
+
+	if errors.Is(err, clock.ErrTimeTravelDisabled) {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	} else if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	tenant := ctx.Value("tenant").(*sqlcv1.Tenant)
+
+	a.l.Warn().Str("tenant_id", tenant.ID.String()).Msgf("engine clock advanced by %s, total offset is now %s", d, offset)
+
+	return &contracts.AdvanceClockResponse{
+		Now:      timestamppb.New(clock.Now()),
+		OffsetMs: offset.Milliseconds(),
+	}, nil
+}
+
+// SignalRun delivers a signal to the conditions a single run is waiting on. It fails with
+// FailedPrecondition if the run isn't waiting on the signal, so callers know it wasn't delivered.
+func (a *AdminServiceImpl) SignalRun(ctx context.Context, req *contracts.SignalRunRequest) (*contracts.SignalRunResponse, error) {
+	tenant := ctx.Value("tenant").(*sqlcv1.Tenant)
+	tenantId := tenant.ID
+
+	runExternalId, err := uuid.Parse(req.RunExternalId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid run_external_id")
+	}
+
+	if req.Name == "" {
+		return nil, status.Error(codes.InvalidArgument, "name is required")
+	}
+
+	signalId := uuid.New()
+
+	if req.SignalId != nil {
+		signalId, err = uuid.Parse(*req.SignalId)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid signal_id")
+		}
+	}
+
+	payload := req.Payload
+
+	if len(payload) == 0 {
+		payload = []byte("{}")
+	} else if !json.Valid(payload) {
+		return nil, status.Error(codes.InvalidArgument, "payload must be valid JSON")
+	}
+
+	a.analytics.Count(ctx, analytics.WorkflowRun, analytics.Signal)
+
+	// every outcome is logged with the signal, so deliveries can be audited
+	logger := a.l.With().
+		Str("tenant_id", tenantId.String()).
+		Str("run_external_id", runExternalId.String()).
+		Str("signal", req.Name).
+		Str("signal_id", signalId.String()).
+		Logger()
+
+	result, err := a.repo.Matches().SignalRun(ctx, tenantId, v1.SignalRunOpts{
+		RunExternalId: runExternalId,
+		Name:          req.Name,
+		SignalId:      signalId,
+		Data:          payload,
+	})
+
+	if errors.Is(err, v1.ErrSignalRunNotFound) {
+		logger.Info().Msg("signal was not delivered, the run doesn't exist")
+
+		return nil, status.Errorf(codes.NotFound, "run %s not found", runExternalId)
+	} else if err != nil {
+		logger.Error().Err(err).Msg("failed to signal run")
+
+		return nil, status.Errorf(codes.Internal, "failed to signal run: %v", err)
+	}
+
+	if result.WaitingConditions == 0 {
+		logger.Info().Msg("signal was not delivered, the run isn't waiting on it")
+
+		return nil, status.Errorf(codes.FailedPrecondition, "run %s isn't waiting on signal %q", runExternalId, req.Name)
+	}
+
+	if len(result.CreatedTasks) > 0 {
+		if err := a.tw.SignalCreated(ctx, tenantId, result.CreatedTasks, nil); err != nil {
+			a.l.Error().Ctx(ctx).Err(err).Msg("failed to signal tasks created by run signal")
+		}
+	}
+
+	if len(result.SatisfiedDurableEventLogEntries) > 0 {
+		if err := durable.DispatchCallbacks(ctx, a.l, a.mq, a.repo, tenantId, result.SatisfiedDurableEventLogEntries); err != nil {
+			a.l.Error().Ctx(ctx).Err(err).Msg("failed to dispatch durable callbacks for run signal")
+		}
+	}
+
+	resp := &contracts.SignalRunResponse{
+		SignalId:          signalId.String(),
+		WaitingConditions: int32(result.WaitingConditions), // nolint: gosec
+		MatchedConditions: int32(result.MatchedConditions), // nolint: gosec
+		CreatedTasks:      make([]string, 0, len(result.CreatedTasks)),
+		ResumedTasks:      make([]string, 0, len(result.SatisfiedDurableEventLogEntries)),
+	}
+
+	for _, task := range result.CreatedTasks {
+		resp.CreatedTasks = append(resp.CreatedTasks, task.ExternalID.String())
+	}
+
+	for _, entry := range result.SatisfiedDurableEventLogEntries {
+		resp.ResumedTasks = append(resp.ResumedTasks, entry.DurableTaskExternalId.String())
+	}
+
+	logger.Info().
+		Int("waiting_conditions", result.WaitingConditions).
+		Int("matched_conditions", result.MatchedConditions).
+		Strs("created_tasks", resp.CreatedTasks).
+		Strs("resumed_tasks", resp.ResumedTasks).
+		Msg("signal delivered")
+
+	return resp, nil
+}
+
 func (a *AdminServiceImpl) GetRunDetails(ctx context.Context, req *contracts.GetRunDetailsRequest) (*contracts.GetRunDetailsResponse, error) {
 	tenant := ctx.Value("tenant").(*sqlcv1.Tenant)
 	tenantId := tenant.ID
@@ -731,11 +685,103 @@ func (a *AdminServiceImpl) newTriggerOpt(
 		t.Priority = req.Priority
 	}
 
+	if len(req.ParentOutputs) > 0 && req.TargetActionId == nil {
+		return nil, &v1.TriggerOptInvalidArgumentError{
+			Err: fmt.Errorf("parent_outputs can only be set with target_action_id"),
+		}
+	}
+
+	if req.TargetActionId != nil {
+		err := a.validateTargetStep(ctx, tenantId, req.WorkflowName, *req.TargetActionId, req.ParentOutputs)
+
+		if err != nil {
+			return nil, err
+		}
+
+		t.TargetActionId = req.TargetActionId
+		t.ParentOutputs = req.ParentOutputs
+	}
+
 	return &v1.WorkflowNameTriggerOpts{
 		TriggerTaskData: t,
 	}, nil
 }
 
+// validateTargetStep checks that the latest version of the workflow has a step with the target
+// action id, and that the parent outputs are valid JSON objects for parents of that step.
+func (a *AdminServiceImpl) validateTargetStep(
+	ctx context.Context,
+	tenantId uuid.UUID,
+	workflowName string,
+	actionId string,
+	parentOutputs map[string][]byte,
+) error {
+	workflow, err := a.repo.Workflows().GetWorkflowByName(ctx, tenantId, workflowName)
+
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return &v1.TriggerOptInvalidArgumentError{
+				Err: fmt.Errorf("workflow %s not found", workflowName),
+			}
+		}
+
+		return fmt.Errorf("could not get workflow: %w", err)
+	}
+
+	version, err := a.repo.Workflows().GetLatestWorkflowVersion(ctx, tenantId, workflow.ID)
+
+	if err != nil {
+		return fmt.Errorf("could not get latest workflow version: %w", err)
+	}
+
+	steps, err := a.repo.Workflows().ListStepsByWorkflowVersionId(ctx, tenantId, version.WorkflowVersion.ID)
+
+	if err != nil {
+		return fmt.Errorf("could not list steps: %w", err)
+	}
+
+	var target *sqlcv1.ListStepsByWorkflowVersionIdsRow
+	readableIds := make(map[uuid.UUID]string, len(steps))
+
+	for _, step := range steps {
+		readableIds[step.ID] = step.ReadableId.String
+
+		if step.ActionId == actionId && !step.IsDagOrchestrator {
+			target = step
+		}
+	}
+
+	if target == nil {
+		return &v1.TriggerOptInvalidArgumentError{
+			Err: fmt.Errorf("workflow %s has no task with action id %s", workflowName, actionId),
+		}
+	}
+
+	parents := make(map[string]bool, len(target.Parents))
+
+	for _, parentId := range target.Parents {
+		parents[readableIds[parentId]] = true
+	}
+
+	for name, output := range parentOutputs {
+		if !parents[name] {
+			return &v1.TriggerOptInvalidArgumentError{
+				Err: fmt.Errorf("%s is not a parent of task %s", name, target.ReadableId.String),
+			}
+		}
+
+		var outputMap map[string]interface{}
+
+		if err := json.Unmarshal(output, &outputMap); err != nil {
+			return &v1.TriggerOptInvalidArgumentError{
+				Err: fmt.Errorf("output of parent %s must be a JSON object: %w", name, err),
+			}
+		}
+	}
+
+	return nil
+}
+
 func (a *AdminServiceImpl) generateExternalIds(ctx context.Context, tenantId uuid.UUID, opts []*v1.WorkflowNameTriggerOpts) error {
 	return a.repo.Triggers().PopulateExternalIdsForWorkflow(ctx, tenantId, opts)
 }
@@ -870,6 +916,10 @@ func (a *AdminServiceImpl) PutWorkflow(ctx context.Context, req *contracts.Creat
 		createOpts,
 	)
 
+	if errors.Is(err, v1.ErrMapStepRequiresDagOperator) || errors.Is(err, v1.ErrInvalidPriorityAging) || errors.Is(err, v1.ErrInvalidDesiredWorkerLabel) {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
 	if err != nil {
 		return nil, err
 	}
@@ -888,6 +938,12 @@ func (a *AdminServiceImpl) PutWorkflow(ctx context.Context, req *contracts.Creat
 		}
 	}
 
+	if hasApprovalTask(req) {
+		if err := a.ensureApprovalOperator(ctx, tenantId); err != nil {
+			a.l.Err(err).Ctx(ctx).Msg("could not ensure approval operator for tenant")
+		}
+	}
+
 	// notify that a new set of queues have been created
 	// important: this assumes that actions correspond 1:1 with queues, which they do at the moment
 	// but might not in the future
@@ -958,6 +1014,46 @@ func (a *AdminServiceImpl) ensureDAGOperator(ctx context.Context, tenantId uuid.
 	return err
 }
 
+func (a *AdminServiceImpl) ensureApprovalOperator(ctx context.Context, tenantId uuid.UUID) error {
+	exists, err := a.repo.Operators().HasApprovalOperator(ctx, tenantId)
+
+	if err != nil {
+		return fmt.Errorf("could not check for approval operator: %w", err)
+	}
+
+	if exists {
+		return nil
+	}
+
+	config, err := json.Marshal(struct{}{})
+
+	if err != nil {
+		return fmt.Errorf("could not marshal approval operator config: %w", err)
+	}
+
+	_, err = a.repo.Operators().CreateOperator(ctx, tenantId, v1.CreateOperatorOpts{
+		Name:   "default",
+		Kind:   sqlcv1.V1OperatorKindAPPROVAL,
+		Config: config,
+	})
+
+	return err
+}
+
+func hasApprovalTask(req *contracts.CreateWorkflowVersionRequest) bool {
+	if req.OnFailureTask != nil && req.OnFailureTask.Approval != nil {
+		return true
+	}
+
+	for _, task := range req.Tasks {
+		if task != nil && task.Approval != nil {
+			return true
+		}
+	}
+
+	return false
+}
+
 func getActionsForTasks(tasks []*contracts.CreateTaskOpts) ([]string, error) {
 	actions := make([]string, len(tasks))
 
@@ -1104,6 +1200,19 @@ func getCreateWorkflowOpts(req *contracts.CreateWorkflowVersionRequest) (*v1.Cre
 		}
 	}
 
+	var priorityAging *v1.PriorityAgingOpts
+
+	if req.PriorityAging != nil {
+		if req.PriorityAging.Interval == nil && req.PriorityAging.MaxWait == nil {
+			return nil, status.Error(codes.InvalidArgument, "priority aging requires an interval or a max wait")
+		}
+
+		priorityAging = &v1.PriorityAgingOpts{
+			Interval: req.PriorityAging.Interval,
+			MaxWait:  req.PriorityAging.MaxWait,
+		}
+	}
+
 	return &v1.CreateWorkflowVersionOpts{
 		Name:            req.Name,
 		Concurrency:     concurrency,
@@ -1115,6 +1224,7 @@ func getCreateWorkflowOpts(req *contracts.CreateWorkflowVersionRequest) (*v1.Cre
 		OnFailure:       onFailureTask,
 		Sticky:          sticky,
 		DefaultPriority: req.DefaultPriority,
+		PriorityAging:   priorityAging,
 		DefaultFilters:  defaultFilters,
 		InputJsonSchema: req.InputJsonSchema,
 		Idempotency:     idempotency,
@@ -1175,6 +1285,7 @@ func getCreateTaskOpts(tasks []*contracts.CreateTaskOpts, kind string) ([]v1.Cre
 					Key:        k,
 					StrValue:   v.StrValue,
 					IntValue:   v.IntValue,
+					StrValues:  v.StrValues,
 					Required:   v.Required,
 					Weight:     v.Weight,
 					Comparator: c,
@@ -1216,7 +1327,7 @@ func getCreateTaskOpts(tasks []*contracts.CreateTaskOpts, kind string) ([]v1.Cre
 				maxInt := int(*stepCp.BackoffMaxSeconds)
 				steps[j].RetryBackoffMaxSeconds = &maxInt
 			} else {
-				maxInt := 24 * 60 * 60
+				maxInt := v1.DefaultRetryBackoffMaxSeconds
 				steps[j].RetryBackoffMaxSeconds = &maxInt
 			}
 		}
@@ -1413,6 +1524,62 @@ func getCreateTaskOpts(tasks []*contracts.CreateTaskOpts, kind string) ([]v1.Cre
 			}
 
 		}
+
+		for _, policy := range stepCp.RetryPolicies {
+			if policy == nil {
+				continue
+			}
+
+			opt := v1.CreateRetryPolicyOpts{
+				ErrorClass:        policy.ErrorClass,
+				Expression:        policy.Expression,
+				Retries:           policy.Retries,
+				BackoffMaxSeconds: policy.BackoffMaxSeconds,
+				NeverRetry:        policy.NeverRetry,
+			}
+
+			if policy.BackoffFactor != nil {
+				f64 := float64(*policy.BackoffFactor)
+				opt.BackoffFactor = &f64
+			}
+
+			steps[j].RetryPolicies = append(steps[j].RetryPolicies, opt)
+		}
+
+		if stepCp.CircuitBreaker != nil {
+			steps[j].CircuitBreaker = &v1.CreateCircuitBreakerOpts{
+				KeyExpr:          stepCp.CircuitBreaker.KeyExpr,
+				FailureThreshold: stepCp.CircuitBreaker.FailureThreshold,
+				Window:           stepCp.CircuitBreaker.Window,
+				Cooldown:         stepCp.CircuitBreaker.Cooldown,
+			}
+		}
+
+		if stepCp.Preemption != nil {
+			steps[j].Preemption = &v1.CreatePreemptionOpts{
+				MaxPreemptions: stepCp.Preemption.MaxPreemptions,
+			}
+		}
+
+		if stepCp.Map != nil {
+			steps[j].Map = &v1.CreateStepMapOpts{
+				Expression:     stepCp.Map.Expression,
+				MaxParallelism: stepCp.Map.MaxParallelism,
+			}
+		}
+
+		if stepCp.Approval != nil {
+			steps[j].Approval = &v1.CreateStepApprovalOpts{
+				Approvers:     stepCp.Approval.Approvers,
+				ContextFields: stepCp.Approval.ContextFields,
+				ExpiresIn:     stepCp.Approval.ExpiresIn,
+			}
+
+			if stepCp.Approval.OnExpiry != nil {
+				onExpiry := strings.TrimPrefix(stepCp.Approval.OnExpiry.String(), "APPROVAL_EXPIRY_")
+				steps[j].Approval.OnExpiry = &onExpiry
+			}
+		}
 	}
 
 	// Check if parents are in the map
@@ -1432,8 +1599,8 @@ func putWorkflowFeatureFlags(req *contracts.CreateWorkflowVersionRequest) map[st
 		return nil
 	}
 
-	var hasTaskRateLimits, hasTaskWorkerLabels, hasTaskRetries, hasTaskBackoff,
-		hasTaskTimeout, hasTaskDag, hasTaskConcurrency, hasTaskConditions,
+	var hasTaskRateLimits, hasTaskWorkerLabels, hasTaskRetries, hasTaskBackoff, hasTaskRetryPolicies,
+		hasTaskCircuitBreaker, hasTaskPreemption, hasTaskMap, hasTaskApproval, hasTaskTimeout, hasTaskDag, hasTaskConcurrency, hasTaskConditions,
 		hasTaskDurable, hasTaskSlotRequests, hasTaskScheduleTimeout bool
 
 	for _, t := range req.Tasks {
@@ -1444,6 +1611,11 @@ func putWorkflowFeatureFlags(req *contracts.CreateWorkflowVersionRequest) map[st
 		hasTaskWorkerLabels = hasTaskWorkerLabels || len(t.WorkerLabels) > 0
 		hasTaskRetries = hasTaskRetries || t.Retries > 0
 		hasTaskBackoff = hasTaskBackoff || t.BackoffFactor != nil
+		hasTaskRetryPolicies = hasTaskRetryPolicies || len(t.RetryPolicies) > 0
+		hasTaskCircuitBreaker = hasTaskCircuitBreaker || t.CircuitBreaker != nil
+		hasTaskPreemption = hasTaskPreemption || t.Preemption != nil
+		hasTaskMap = hasTaskMap || t.Map != nil
+		hasTaskApproval = hasTaskApproval || t.Approval != nil
 		hasTaskTimeout = hasTaskTimeout || t.Timeout != ""
 		hasTaskDag = hasTaskDag || len(t.Parents) > 0
 		hasTaskConcurrency = hasTaskConcurrency || len(t.Concurrency) > 0
@@ -1457,6 +1629,7 @@ func putWorkflowFeatureFlags(req *contracts.CreateWorkflowVersionRequest) map[st
 		"has_wf_concurrency", len(req.ConcurrencyArr) > 0,
 		"has_wf_sticky", req.Sticky != nil,
 		"has_wf_default_priority", req.DefaultPriority != nil,
+		"has_wf_priority_aging", req.PriorityAging != nil,
 		"has_wf_on_failure", req.OnFailureTask != nil,
 		"has_wf_cron_triggers", len(req.CronTriggers) > 0,
 		"has_wf_event_triggers", len(req.EventTriggers) > 0,
@@ -1467,6 +1640,11 @@ func putWorkflowFeatureFlags(req *contracts.CreateWorkflowVersionRequest) map[st
 		"has_task_worker_labels", hasTaskWorkerLabels,
 		"has_task_retries", hasTaskRetries,
 		"has_task_backoff", hasTaskBackoff,
+		"has_task_retry_policies", hasTaskRetryPolicies,
+		"has_task_circuit_breaker", hasTaskCircuitBreaker,
+		"has_task_preemption", hasTaskPreemption,
+		"has_task_map", hasTaskMap,
+		"has_task_approval", hasTaskApproval,
 		"has_task_timeout", hasTaskTimeout,
 		"has_task_dag", hasTaskDag,
 		"has_task_concurrency", hasTaskConcurrency,
@@ -1490,6 +1668,7 @@ func protoMapToDesiredWorkerLabels(m map[string]*contracts.DesiredWorkerLabels)
 			key,
 			label.StrValue,
 			label.IntValue,
+			label.StrValues,
 			label.Required,
 			label.Weight,
 			comparator,
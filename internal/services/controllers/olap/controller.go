@@ -30,6 +30,7 @@ import (
 	hatcheterrors "github.com/hatchet-dev/hatchet/pkg/errors"
 	"github.com/hatchet-dev/hatchet/pkg/integrations/metrics/prometheus"
 	"github.com/hatchet-dev/hatchet/pkg/logger"
+	"github.com/hatchet-dev/hatchet/pkg/olapexport"
 	v1 "github.com/hatchet-dev/hatchet/pkg/repository"
 	"github.com/hatchet-dev/hatchet/pkg/repository/sqlchelpers"
 	"github.com/hatchet-dev/hatchet/pkg/repository/sqlcv1"
@@ -77,6 +78,9 @@ type OLAPControllerImpl struct {
 	s                            gocron.Scheduler
 	ta                           *alerting.TenantAlertManager
 	processTenantAlertOperations *queueutils.OperationPool
+	exportOperations             *queueutils.OperationPool
+	exporter                     *olapexport.Exporter
+	exportConfig                 server.OLAPExportConfigFile
 	samplingHashThreshold        *int64
 	olapConfig                   *server.ConfigFileOperations
 	maxRequeueCount              int
@@ -112,6 +116,7 @@ type OLAPControllerOpts struct {
 	statusUpdateBatchSizeLimits v1.StatusUpdateBatchSizeLimits
 	mqQos                       int
 	promGate                    *prometheus.Gate
+	exportConfig                server.OLAPExportConfigFile
 }
 
 func defaultOLAPControllerOpts() *OLAPControllerOpts {
@@ -233,6 +238,13 @@ func WithPrometheusGate(gate *prometheus.Gate) OLAPControllerOpt {
 	}
 }
 
+// WithOLAPExportConfig enables the background export of closed day partitions to Parquet files.
+func WithOLAPExportConfig(c server.OLAPExportConfigFile) OLAPControllerOpt {
+	return func(opts *OLAPControllerOpts) {
+		opts.exportConfig = c
+	}
+}
+
 func New(fs ...OLAPControllerOpt) (*OLAPControllerImpl, error) {
 	opts := defaultOLAPControllerOpts()
 
@@ -300,6 +312,7 @@ func New(fs ...OLAPControllerOpt) (*OLAPControllerImpl, error) {
 		statusUpdateBatchSizeLimits: opts.statusUpdateBatchSizeLimits,
 		mqQos:                       opts.mqQos,
 		promGate:                    opts.promGate,
+		exportConfig:                opts.exportConfig,
 	}
 
 	// Default jitter value
@@ -320,6 +333,27 @@ func New(fs ...OLAPControllerOpt) (*OLAPControllerImpl, error) {
 		o.processTenantAlerts,
 	).WithJitter(jitter)
 
+	if opts.exportConfig.Enabled {
+		store, err := olapexport.NewStore(context.Background(), opts.exportConfig.Destination)
+
+		if err != nil {
+			return nil, fmt.Errorf("could not create olap export store: %w", err)
+		}
+
+		o.exporter = olapexport.NewExporter(opts.repo.OLAP(), store, opts.l, olapexport.ExporterOpts{
+			IncludePayloads: opts.exportConfig.IncludePayloads,
+			BatchSize:       opts.exportConfig.BatchSize,
+			LeaseDuration:   exportOperationTimeout,
+		})
+
+		o.exportOperations = queueutils.NewOperationPool(
+			opts.l,
+			exportOperationTimeout,
+			"export olap partitions",
+			o.exportTenantPartitions,
+		).WithJitter(jitter)
+	}
+
 	return o, nil
 }
 
@@ -453,6 +487,21 @@ func (o *OLAPControllerImpl) Start() (func() error, error) {
 		return nil, wrappedErr
 	}
 
+	if o.exporter != nil {
+		_, err = o.s.NewJob(
+			gocron.DurationJob(o.exportConfig.Interval),
+			gocron.NewTask(
+				o.runTenantExportPartitions(ctx),
+			),
+			gocron.WithSingletonMode(gocron.LimitModeReschedule),
+		)
+
+		if err != nil {
+			cancel()
+			return nil, fmt.Errorf("could not schedule olap export: %w", err)
+		}
+	}
+
 	cleanupBuffer, err := mqBuffer.Start()
 
 	if err != nil {
@@ -525,8 +574,12 @@ func (tc *OLAPControllerImpl) handleBufferedMsgs(tenantId uuid.UUID, msgId strin
 		return tc.handleFailedWebhookValidation(ctx, tenantId, payloads)
 	case "cel-evaluation-failure":
 		return tc.handleCelEvaluationFailure(ctx, tenantId, payloads)
+	case "event-schema-validation-failure":
+		return tc.handleEventSchemaValidationFailure(ctx, tenantId, payloads)
 	case "offload-payload":
 		return tc.handlePayloadOffload(ctx, tenantId, payloads)
+	case "task-slot-usage":
+		return tc.handleTaskSlotUsage(ctx, tenantId, payloads)
 	}
 
 	return fmt.Errorf("unknown message id: %s", msgId)
@@ -570,6 +623,25 @@ func (tc *OLAPControllerImpl) handleCelEvaluationFailure(ctx context.Context, te
 	return tc.repo.OLAP().StoreCELEvaluationFailures(ctx, tenantId, failures)
 }
 
+func (tc *OLAPControllerImpl) handleEventSchemaValidationFailure(ctx context.Context, tenantId uuid.UUID, payloads [][]byte) error {
+	failures := make([]v1.EventSchemaValidationFailure, 0)
+
+	msgs := msgqueue.JSONConvert[tasktypes.EventSchemaValidationFailures](payloads)
+
+	for _, msg := range msgs {
+		for _, failure := range msg.Failures {
+			if !tc.sample(failure.ErrorMessage) {
+				tc.l.Debug().Ctx(ctx).Msgf("skipping event schema validation failure for event key %s", failure.EventKey)
+				continue
+			}
+
+			failures = append(failures, failure)
+		}
+	}
+
+	return tc.repo.OLAP().StoreEventSchemaValidationFailures(ctx, tenantId, failures)
+}
+
 // handleCreatedTask is responsible for flushing a created task to the OLAP repository
 func (tc *OLAPControllerImpl) handleCreatedTask(ctx context.Context, tenantId uuid.UUID, payloads [][]byte) error {
 	ctx, span := telemetry.NewSpan(ctx, "OLAPControllerImpl.handleCreatedTask")
@@ -1052,6 +1124,8 @@ func (tc *OLAPControllerImpl) handleCreateMonitoringEvent(ctx context.Context, t
 			readableStatuses = append(readableStatuses, sqlcv1.V1ReadableStatusOlapQUEUED)
 		case sqlcv1.V1EventTypeOlapREASSIGNED:
 			readableStatuses = append(readableStatuses, sqlcv1.V1ReadableStatusOlapQUEUED)
+		case sqlcv1.V1EventTypeOlapPREEMPTED:
+			readableStatuses = append(readableStatuses, sqlcv1.V1ReadableStatusOlapQUEUED)
 		case sqlcv1.V1EventTypeOlapRETRIEDBYUSER:
 			readableStatuses = append(readableStatuses, sqlcv1.V1ReadableStatusOlapQUEUED)
 		case sqlcv1.V1EventTypeOlapCREATED:
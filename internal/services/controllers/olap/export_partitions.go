@@ -0,0 +1,79 @@
+package olap
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/hatchet-dev/hatchet/pkg/telemetry"
+)
+
+// exportOperationTimeout bounds a single tenant's export run, and is also the lease duration of the
+// partitions it exports, so a crashed engine's partitions are picked up again on a later run.
+const exportOperationTimeout = time.Hour
+
+func (o *OLAPControllerImpl) runTenantExportPartitions(ctx context.Context) func() {
+	return func() {
+		o.l.Debug().Ctx(ctx).Msgf("partition: exporting olap partitions")
+
+		// list all tenants
+		tenants, err := o.p.ListTenantsForController(ctx)
+
+		if err != nil {
+			o.l.Error().Ctx(ctx).Err(err).Msg("could not list tenants")
+			return
+		}
+
+		o.exportOperations.SetTenants(tenants)
+
+		for _, tenantId := range tenants {
+			o.exportOperations.RunOrContinue(tenantId.String())
+		}
+	}
+}
+
+func (o *OLAPControllerImpl) exportTenantPartitions(ctx context.Context, tenantId string) (bool, error) {
+	ctx, span := telemetry.NewSpan(ctx, "export-tenant-partitions")
+	defer span.End()
+
+	telemetry.WithAttributes(span, telemetry.AttributeKV{Key: "tenant.id", Value: tenantId})
+
+	tenantUUID := uuid.MustParse(tenantId)
+
+	// a day is closed once it has ended and runs created late in the day have had time to finish
+	pending, err := o.repo.OLAP().ListPendingOLAPExportDates(ctx, tenantUUID, time.Now().Add(-o.exportConfig.Delay))
+
+	if err != nil {
+		return false, fmt.Errorf("could not list pending olap export partitions: %w", err)
+	}
+
+	limit := o.exportConfig.MaxPartitionsPerInterval
+
+	if limit > 0 && len(pending) > limit {
+		pending = pending[:limit]
+	}
+
+	for _, day := range pending {
+		res, err := o.exporter.ExportPartition(ctx, tenantUUID, day, false)
+
+		if err != nil {
+			return false, fmt.Errorf("could not export olap partition %s: %w", day.Format(time.DateOnly), err)
+		}
+
+		if res.Skipped {
+			continue
+		}
+
+		o.l.Info().Ctx(ctx).
+			Str("tenant_id", tenantId).
+			Str("date", day.Format(time.DateOnly)).
+			Int64("runs", res.RunCount).
+			Int64("tasks", res.TaskCount).
+			Int64("task_events", res.TaskEventCount).
+			Msg("exported olap partition")
+	}
+
+	return false, nil
+}
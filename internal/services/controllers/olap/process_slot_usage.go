@@ -0,0 +1,120 @@
+package olap
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/google/uuid"
+
+	"github.com/hatchet-dev/hatchet/internal/msgqueue"
+	tasktypes "github.com/hatchet-dev/hatchet/internal/services/shared/tasktypes/v1"
+	"github.com/hatchet-dev/hatchet/pkg/integrations/metrics/prometheus"
+	v1 "github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/sqlcv1"
+	"github.com/hatchet-dev/hatchet/pkg/telemetry"
+)
+
+// handleTaskSlotUsage records the slot time consumed by released tasks, along with the workflow
+// name and the labels of the worker they ran on. The labels are looked up here, so they're the
+// worker's current labels rather than those it had when the task ran. Slot usage is used for
+// billing, so it is never sampled.
+func (tc *OLAPControllerImpl) handleTaskSlotUsage(ctx context.Context, tenantId uuid.UUID, payloads [][]byte) error {
+	ctx, span := telemetry.NewSpan(ctx, "OLAPControllerImpl.handleTaskSlotUsage")
+	defer span.End()
+
+	msgs := msgqueue.JSONConvert[tasktypes.TaskSlotUsagePayload](payloads)
+
+	usage := make([]v1.TaskSlotUsage, 0)
+
+	for _, msg := range msgs {
+		usage = append(usage, msg.Usage...)
+	}
+
+	if len(usage) == 0 {
+		return nil
+	}
+
+	workflowIdSet := make(map[uuid.UUID]struct{})
+	workerIdSet := make(map[uuid.UUID]struct{})
+
+	for _, u := range usage {
+		workflowIdSet[u.WorkflowId] = struct{}{}
+
+		if u.WorkerId != uuid.Nil {
+			workerIdSet[u.WorkerId] = struct{}{}
+		}
+	}
+
+	workflowIds := make([]uuid.UUID, 0, len(workflowIdSet))
+
+	for id := range workflowIdSet {
+		workflowIds = append(workflowIds, id)
+	}
+
+	workerIds := make([]uuid.UUID, 0, len(workerIdSet))
+
+	for id := range workerIdSet {
+		workerIds = append(workerIds, id)
+	}
+
+	workflowNames, err := tc.repo.Workflows().ListWorkflowNamesByIds(ctx, tenantId, workflowIds)
+
+	if err != nil {
+		return fmt.Errorf("could not list workflow names: %w", err)
+	}
+
+	workerLabels := make(map[uuid.UUID][]*sqlcv1.ListWorkerLabelsRow)
+
+	if len(workerIds) > 0 {
+		workerLabels, err = tc.repo.Workers().ListWorkerLabels(ctx, tenantId, workerIds)
+
+		if err != nil {
+			return fmt.Errorf("could not list worker labels: %w", err)
+		}
+	}
+
+	opts := make([]v1.StoreTaskSlotUsageOpts, 0, len(usage))
+
+	for _, u := range usage {
+		opts = append(opts, v1.StoreTaskSlotUsageOpts{
+			TaskSlotUsage: u,
+			WorkflowName:  workflowNames[u.WorkflowId],
+			WorkerLabels:  workerLabelValues(workerLabels[u.WorkerId]),
+		})
+	}
+
+	if err := tc.repo.OLAP().StoreTaskSlotUsage(ctx, tenantId, opts); err != nil {
+		return err
+	}
+
+	tenantMetricsEnabled := tc.promGate.Enabled(ctx, tenantId)
+
+	for _, opt := range opts {
+		slotSeconds := opt.SlotSeconds()
+
+		prometheus.SlotSeconds.WithLabelValues(opt.SlotType).Add(slotSeconds)
+
+		if tenantMetricsEnabled {
+			prometheus.TenantSlotSeconds.WithLabelValues(tenantId.String(), opt.WorkflowName, opt.SlotType).Add(slotSeconds)
+		}
+	}
+
+	return nil
+}
+
+// workerLabelValues flattens a worker's labels to their string values.
+func workerLabelValues(labels []*sqlcv1.ListWorkerLabelsRow) map[string]string {
+	res := make(map[string]string, len(labels))
+
+	for _, label := range labels {
+		switch {
+		case label.StrValue.Valid:
+			res[label.Key] = label.StrValue.String
+		case label.IntValue.Valid:
+			res[label.Key] = strconv.FormatInt(int64(label.IntValue.Int32), 10)
+		}
+	}
+
+	return res
+}
@@ -63,6 +63,9 @@ type TasksControllerImpl struct {
 	evictExpiredIdempotencyKeysOperations    *operation.TenantOperationPool
 	deactivateStaleStepConcurrencyOperations *operation.TenantOperationPool
 	expirePausedWorkflowQueueItemsOperations *operation.TenantOperationPool
+	processBulkJobsOperations                *operation.TenantOperationPool
+	expireApprovalsOperations                *operation.TenantOperationPool
+	processWorkerDrainsOperations            *operation.TenantOperationPool
 
 	replayEnabled       bool
 	analyzeCronInterval time.Duration
@@ -319,6 +322,34 @@ func New(fs ...TasksControllerOpt) (*TasksControllerImpl, error) {
 		opts.repov1.Tasks().DefaultTaskActivityGauge,
 	))
 
+	// bulk job batches can touch thousands of runs, so they get a longer timeout
+	t.processBulkJobsOperations = operation.NewTenantOperationPool(opts.p, opts.l, "process-bulk-jobs", 5*time.Minute, "process bulk jobs", t.processBulkJobs, operation.WithPoolInterval(
+		opts.repov1.IntervalSettings(),
+		jitter,
+		1*time.Second,
+		30*time.Second,
+		3,
+		opts.repov1.Tasks().DefaultTaskActivityGauge,
+	))
+
+	t.expireApprovalsOperations = operation.NewTenantOperationPool(opts.p, opts.l, "expire-approvals", timeout, "expire approvals", t.processApprovalExpiries, operation.WithPoolInterval(
+		opts.repov1.IntervalSettings(),
+		jitter,
+		1*time.Second,
+		30*time.Second,
+		3,
+		opts.repov1.Tasks().DefaultTaskActivityGauge,
+	))
+
+	t.processWorkerDrainsOperations = operation.NewTenantOperationPool(opts.p, opts.l, "process-worker-drains", timeout, "process worker drains", t.processWorkerDrains, operation.WithPoolInterval(
+		opts.repov1.IntervalSettings(),
+		jitter,
+		1*time.Second,
+		5*time.Second,
+		3,
+		opts.repov1.Tasks().DefaultTaskActivityGauge,
+	))
+
 	return t, nil
 }
 
@@ -436,6 +467,9 @@ func (tc *TasksControllerImpl) Start() (func() error, error) {
 		tc.evictExpiredIdempotencyKeysOperations.Cleanup()
 		tc.deactivateStaleStepConcurrencyOperations.Cleanup()
 		tc.expirePausedWorkflowQueueItemsOperations.Cleanup()
+		tc.processBulkJobsOperations.Cleanup()
+		tc.expireApprovalsOperations.Cleanup()
+		tc.processWorkerDrainsOperations.Cleanup()
 
 		tc.pubBuffer.Stop()
 
@@ -564,6 +598,7 @@ func (tc *TasksControllerImpl) handleTaskFailed(ctx context.Context, tenantId uu
 			},
 			IsAppError:     msg.IsAppError,
 			ErrorMessage:   msg.ErrorMsg,
+			ErrorClass:     msg.ErrorClass,
 			IsNonRetryable: msg.IsNonRetryable,
 		})
 
@@ -1009,6 +1044,8 @@ func (tc *TasksControllerImpl) notifyQueuesOnCompletion(ctx context.Context, ten
 		return
 	}
 
+	tc.publishSlotUsage(ctx, tenantId, v1.TaskSlotUsageFromReleasedTasks(releasedTasks))
+
 	tenant, err := tc.repov1.Tenant().GetTenantByID(ctx, tenantId)
 
 	if err != nil {
@@ -1361,3 +1398,21 @@ func (tc *TasksControllerImpl) pubRetryEvent(ctx context.Context, tenantId uuid.
 
 	return nil
 }
+
+// publishSlotUsage sends the slot time consumed by released tasks to the OLAP repository.
+func (tc *TasksControllerImpl) publishSlotUsage(ctx context.Context, tenantId uuid.UUID, usage []v1.TaskSlotUsage) {
+	msg, err := tasktypes.TaskSlotUsageMessage(tenantId, usage)
+
+	if err != nil {
+		tc.l.Error().Ctx(ctx).Err(err).Msg("could not create task slot usage message")
+		return
+	}
+
+	if msg == nil {
+		return
+	}
+
+	if err := tc.pubBuffer.Pub(ctx, msgqueue.OLAP_QUEUE, msg, false); err != nil {
+		tc.l.Error().Ctx(ctx).Err(err).Msg("could not publish task slot usage message")
+	}
+}
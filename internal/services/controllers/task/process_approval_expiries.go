@@ -0,0 +1,53 @@
+package task
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/hatchet-dev/hatchet/internal/msgqueue"
+	tasktypes "github.com/hatchet-dev/hatchet/internal/services/shared/tasktypes/v1"
+	"github.com/hatchet-dev/hatchet/pkg/telemetry"
+)
+
+// the number of approvals expired per run, the pool continues immediately when it's reached
+const approvalExpiryLimit = 100
+
+func (tc *TasksControllerImpl) processApprovalExpiries(ctx context.Context, tenantId string) (bool, error) {
+	ctx, span := telemetry.NewSpan(ctx, "process-approval-expiries")
+	defer span.End()
+
+	telemetry.WithAttributes(span, telemetry.AttributeKV{Key: "tenant.id", Value: tenantId})
+	tenantIdUUID := uuid.MustParse(tenantId)
+
+	expired, err := tc.repov1.Approvals().ExpireApprovals(ctx, tenantIdUUID, approvalExpiryLimit)
+
+	if err != nil {
+		return false, fmt.Errorf("could not expire approvals for tenant %s: %w", tenantId, err)
+	}
+
+	for _, approval := range expired {
+		taskMsg, olapMsg, err := tasktypes.ApprovalResolvedMessages(approval)
+
+		if err != nil {
+			tc.l.Error().Ctx(ctx).Err(err).Msg("could not create messages for expired approval")
+			continue
+		}
+
+		if taskMsg != nil {
+			if err := tc.mq.SendMessage(ctx, msgqueue.TASK_PROCESSING_QUEUE, taskMsg); err != nil {
+				tc.l.Error().Ctx(ctx).Err(err).Msg("could not send message for expired approval")
+				continue
+			}
+		}
+
+		if olapMsg != nil {
+			if err := tc.pubBuffer.Pub(ctx, msgqueue.OLAP_QUEUE, olapMsg, false); err != nil {
+				tc.l.Error().Ctx(ctx).Err(err).Msg("could not publish monitoring event message for expired approval")
+			}
+		}
+	}
+
+	return len(expired) == approvalExpiryLimit, nil
+}
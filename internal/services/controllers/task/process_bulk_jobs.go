@@ -0,0 +1,260 @@
+package task
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/hatchet-dev/hatchet/internal/msgqueue"
+	"github.com/hatchet-dev/hatchet/internal/services/shared/replay"
+	tasktypes "github.com/hatchet-dev/hatchet/internal/services/shared/tasktypes/v1"
+	v1 "github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/sqlcv1"
+	"github.com/hatchet-dev/hatchet/pkg/telemetry"
+)
+
+// the number of runs processed per bulk job batch
+const bulkJobBatchSize = 500
+
+// the number of runs enumerated per page of a bulk job's filter
+const bulkJobEnumeratePageSize = 5000
+
+// processBulkJobs advances the oldest running bulk job for a tenant by one step: either resolving
+// the next page of its filter into items, or applying its operation to the next batch of pending
+// items.
+func (tc *TasksControllerImpl) processBulkJobs(ctx context.Context, tenantId string) (bool, error) {
+	ctx, span := telemetry.NewSpan(ctx, "process-bulk-jobs")
+	defer span.End()
+
+	telemetry.WithAttributes(span, telemetry.AttributeKV{Key: "tenant.id", Value: tenantId})
+	tenantIdUUID := uuid.MustParse(tenantId)
+
+	job, err := tc.repov1.BulkJobs().GetNextRunningBulkJob(ctx, tenantIdUUID)
+
+	if err != nil {
+		return false, fmt.Errorf("could not get next running bulk job for tenant %s: %w", tenantId, err)
+	}
+
+	if job == nil {
+		return false, nil
+	}
+
+	telemetry.WithAttributes(span, telemetry.AttributeKV{Key: "bulk_job.id", Value: job.ID.String()})
+
+	if !job.IsEnumerated {
+		return true, tc.enumerateBulkJob(ctx, tenantIdUUID, job)
+	}
+
+	externalIds, err := tc.repov1.BulkJobs().ListPendingBulkJobItems(ctx, tenantIdUUID, job.ID, bulkJobBatchSize)
+
+	if err != nil {
+		return false, fmt.Errorf("could not list pending items for bulk job %s: %w", job.ID, err)
+	}
+
+	if len(externalIds) == 0 {
+		if _, err := tc.repov1.BulkJobs().FinishBulkJob(ctx, tenantIdUUID, job.ID, nil); err != nil {
+			return false, fmt.Errorf("could not finish bulk job %s: %w", job.ID, err)
+		}
+
+		// there may be another running job queued behind this one
+		return true, nil
+	}
+
+	var results []v1.BulkJobItemResult
+
+	switch job.Kind {
+	case sqlcv1.V1BulkJobKindCANCEL:
+		results, err = tc.bulkCancelRuns(ctx, tenantIdUUID, externalIds)
+	case sqlcv1.V1BulkJobKindREPLAY:
+		results, err = tc.bulkReplayRuns(ctx, tenantIdUUID, externalIds)
+	case sqlcv1.V1BulkJobKindDELETE:
+		results, err = tc.bulkDeleteRuns(ctx, tenantIdUUID, externalIds)
+	default:
+		errMsg := fmt.Sprintf("unsupported bulk job kind %s", job.Kind)
+
+		if _, err := tc.repov1.BulkJobs().FinishBulkJob(ctx, tenantIdUUID, job.ID, &errMsg); err != nil {
+			return false, fmt.Errorf("could not fail bulk job %s: %w", job.ID, err)
+		}
+
+		return true, nil
+	}
+
+	if err != nil {
+		// the items are left pending, so the batch is retried on the next run
+		return false, fmt.Errorf("could not process batch for bulk job %s: %w", job.ID, err)
+	}
+
+	if _, err := tc.repov1.BulkJobs().RecordBulkJobItemResults(ctx, tenantIdUUID, job.ID, results); err != nil {
+		return false, fmt.Errorf("could not record results for bulk job %s: %w", job.ID, err)
+	}
+
+	return true, nil
+}
+
+func (tc *TasksControllerImpl) enumerateBulkJob(ctx context.Context, tenantId uuid.UUID, job *sqlcv1.V1BulkJob) error {
+	filter, err := v1.ParseBulkJobFilter(job)
+
+	if err != nil || filter == nil {
+		errMsg := "bulk job has an invalid filter"
+
+		if _, err := tc.repov1.BulkJobs().FinishBulkJob(ctx, tenantId, job.ID, &errMsg); err != nil {
+			return fmt.Errorf("could not fail bulk job %s: %w", job.ID, err)
+		}
+
+		return nil
+	}
+
+	// each page is stored with its cursor, so enumeration resumes from the last stored page
+	externalIds, next, err := tc.repov1.OLAP().ListWorkflowRunExternalIdsPage(
+		ctx,
+		tenantId,
+		filter.ListWorkflowRunOpts(),
+		v1.BulkJobEnumerationCursor(job),
+		bulkJobEnumeratePageSize,
+	)
+
+	if err != nil {
+		return fmt.Errorf("could not list runs for bulk job %s: %w", job.ID, err)
+	}
+
+	if _, err := tc.repov1.BulkJobs().EnumerateBulkJob(ctx, tenantId, job.ID, externalIds, next); err != nil {
+		return fmt.Errorf("could not enumerate bulk job %s: %w", job.ID, err)
+	}
+
+	return nil
+}
+
+func (tc *TasksControllerImpl) bulkCancelRuns(ctx context.Context, tenantId uuid.UUID, externalIds []uuid.UUID) ([]v1.BulkJobItemResult, error) {
+	tasks, err := tc.repov1.Tasks().FlattenExternalIds(ctx, tenantId, externalIds)
+
+	if err != nil {
+		return nil, err
+	}
+
+	found := make(map[uuid.UUID]bool, len(externalIds))
+	tasksToCancel := make([]v1.TaskIdInsertedAtRetryCount, 0, len(tasks))
+
+	for _, task := range tasks {
+		found[task.WorkflowRunID] = true
+		found[task.ExternalID] = true
+
+		tasksToCancel = append(tasksToCancel, v1.TaskIdInsertedAtRetryCount{
+			Id:         task.ID,
+			InsertedAt: task.InsertedAt,
+			RetryCount: task.RetryCount,
+		})
+	}
+
+	if len(tasksToCancel) > 0 {
+		msg, err := msgqueue.NewTenantMessage(
+			tenantId,
+			msgqueue.MsgIDCancelTasks,
+			false,
+			true,
+			tasktypes.CancelTasksPayload{
+				Tasks: tasksToCancel,
+			},
+		)
+
+		if err != nil {
+			return nil, fmt.Errorf("could not create message for task cancellation: %w", err)
+		}
+
+		if err := tc.mq.SendMessage(ctx, msgqueue.TASK_PROCESSING_QUEUE, msg); err != nil {
+			return nil, fmt.Errorf("could not send message for task cancellation: %w", err)
+		}
+	}
+
+	return bulkJobResults(externalIds, func(id uuid.UUID) string {
+		if !found[id] {
+			return "run not found"
+		}
+
+		return ""
+	}), nil
+}
+
+func (tc *TasksControllerImpl) bulkReplayRuns(ctx context.Context, tenantId uuid.UUID, externalIds []uuid.UUID) ([]v1.BulkJobItemResult, error) {
+	if !tc.replayEnabled {
+		return bulkJobResults(externalIds, func(uuid.UUID) string {
+			return "replay is disabled"
+		}), nil
+	}
+
+	tasksToReplay, err := replay.ResolveTasks(ctx, tc.repov1, tenantId, externalIds)
+
+	if err != nil {
+		return nil, err
+	}
+
+	found := make(map[uuid.UUID]bool, len(externalIds))
+
+	for _, task := range tasksToReplay {
+		found[task.WorkflowRunExternalId] = true
+		found[task.TaskExternalId] = true
+	}
+
+	for _, batch := range replay.BatchByWorkflowRun(tasksToReplay, 100) {
+		msg, err := msgqueue.NewTenantMessage(
+			tenantId,
+			msgqueue.MsgIDReplayTasks,
+			false,
+			true,
+			tasktypes.ReplayTasksPayload{
+				Tasks: batch,
+			},
+		)
+
+		if err != nil {
+			return nil, fmt.Errorf("could not create message for task replay: %w", err)
+		}
+
+		if err := tc.mq.SendMessage(ctx, msgqueue.TASK_PROCESSING_QUEUE, msg); err != nil {
+			return nil, fmt.Errorf("could not send message for task replay: %w", err)
+		}
+	}
+
+	return bulkJobResults(externalIds, func(id uuid.UUID) string {
+		if !found[id] {
+			return "run not found"
+		}
+
+		return ""
+	}), nil
+}
+
+func (tc *TasksControllerImpl) bulkDeleteRuns(ctx context.Context, tenantId uuid.UUID, externalIds []uuid.UUID) ([]v1.BulkJobItemResult, error) {
+	deletedIds, err := tc.repov1.OLAP().DeleteFinishedRuns(ctx, tenantId, externalIds)
+
+	if err != nil {
+		return nil, err
+	}
+
+	deleted := make(map[uuid.UUID]bool, len(deletedIds))
+
+	for _, id := range deletedIds {
+		deleted[id] = true
+	}
+
+	return bulkJobResults(externalIds, func(id uuid.UUID) string {
+		if !deleted[id] {
+			return "run not found or not finished"
+		}
+
+		return ""
+	}), nil
+}
+
+func bulkJobResults(externalIds []uuid.UUID, errFor func(uuid.UUID) string) []v1.BulkJobItemResult {
+	results := make([]v1.BulkJobItemResult, len(externalIds))
+
+	for i, id := range externalIds {
+		results[i] = v1.BulkJobItemResult{
+			ExternalId: id,
+			Error:      errFor(id),
+		}
+	}
+
+	return results
+}
@@ -0,0 +1,296 @@
+package task
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/go-multierror"
+
+	"github.com/hatchet-dev/hatchet/internal/msgqueue"
+	tasktypes "github.com/hatchet-dev/hatchet/internal/services/shared/tasktypes/v1"
+	v1 "github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/sqlcv1"
+	"github.com/hatchet-dev/hatchet/pkg/telemetry"
+)
+
+// the number of tasks moved off a draining worker per run, the pool continues immediately when it's
+// reached
+const workerDrainTaskLimit = 100
+
+// processWorkerDrains marks draining workers without running tasks as drained, and moves the tasks
+// still running on workers whose drain deadline has passed off them. Durable tasks are evicted and
+// restored on another worker, and other tasks are requeued or cancelled depending on the drain
+// action.
+func (tc *TasksControllerImpl) processWorkerDrains(ctx context.Context, tenantId string) (bool, error) {
+	ctx, span := telemetry.NewSpan(ctx, "process-worker-drains")
+	defer span.End()
+
+	telemetry.WithAttributes(span, telemetry.AttributeKV{Key: "tenant.id", Value: tenantId})
+	tenantIdUUID := uuid.MustParse(tenantId)
+
+	workers, err := tc.repov1.WorkerCordons().ListWorkersToDrain(ctx, tenantIdUUID)
+
+	if err != nil {
+		return false, fmt.Errorf("could not list workers to drain for tenant %s: %w", tenantId, err)
+	}
+
+	now := time.Now()
+	drained := make([]uuid.UUID, 0)
+	shouldContinue := false
+
+	var outerErr error
+
+	for _, worker := range workers {
+		if worker.RunningTasks == 0 {
+			drained = append(drained, worker.WorkerID)
+			continue
+		}
+
+		if worker.DrainDeadline.Time.After(now) {
+			continue
+		}
+
+		more, err := tc.drainWorker(ctx, tenantIdUUID, worker)
+
+		if err != nil {
+			outerErr = multierror.Append(outerErr, fmt.Errorf("could not drain worker %s: %w", worker.WorkerID, err))
+			continue
+		}
+
+		shouldContinue = shouldContinue || more
+	}
+
+	if len(drained) > 0 {
+		if err := tc.repov1.WorkerCordons().MarkWorkersDrained(ctx, tenantIdUUID, drained); err != nil {
+			outerErr = multierror.Append(outerErr, fmt.Errorf("could not mark workers as drained: %w", err))
+		}
+	}
+
+	return shouldContinue, outerErr
+}
+
+// drainWorker moves up to workerDrainTaskLimit tasks off a worker whose drain deadline has passed.
+// It returns true if the limit was reached.
+func (tc *TasksControllerImpl) drainWorker(ctx context.Context, tenantId uuid.UUID, worker *sqlcv1.ListWorkersToDrainRow) (bool, error) {
+	tasks, err := tc.repov1.WorkerCordons().ListRunningTasksOnWorker(ctx, tenantId, worker.WorkerID, workerDrainTaskLimit)
+
+	if err != nil {
+		return false, fmt.Errorf("could not list running tasks: %w", err)
+	}
+
+	durableTasks := make([]*sqlcv1.ListRunningTasksOnWorkerRow, 0)
+	otherTasks := make([]v1.TaskIdInsertedAtRetryCount, 0, len(tasks))
+
+	for _, task := range tasks {
+		if task.IsDurable {
+			durableTasks = append(durableTasks, task)
+			continue
+		}
+
+		otherTasks = append(otherTasks, v1.TaskIdInsertedAtRetryCount{
+			Id:         task.ID,
+			InsertedAt: task.InsertedAt,
+			RetryCount: task.RetryCount,
+		})
+	}
+
+	var outerErr error
+
+	if err := tc.evictDrainedDurableTasks(ctx, tenantId, worker.WorkerID, durableTasks); err != nil {
+		outerErr = multierror.Append(outerErr, err)
+	}
+
+	if worker.DrainAction.Valid && worker.DrainAction.V1WorkerDrainAction == sqlcv1.V1WorkerDrainActionCANCEL {
+		err = tc.cancelDrainedTasks(ctx, tenantId, worker.WorkerID, otherTasks)
+	} else {
+		err = tc.requeueDrainedTasks(ctx, tenantId, worker.WorkerID, otherTasks)
+	}
+
+	if err != nil {
+		outerErr = multierror.Append(outerErr, err)
+	}
+
+	return len(tasks) == workerDrainTaskLimit, outerErr
+}
+
+// evictDrainedDurableTasks evicts durable tasks from a draining worker and restores them, so they
+// resume from their event log on another worker.
+func (tc *TasksControllerImpl) evictDrainedDurableTasks(ctx context.Context, tenantId, workerId uuid.UUID, tasks []*sqlcv1.ListRunningTasksOnWorkerRow) error {
+	evicted := make([]tasktypes.SignalTaskCancelledPayload, 0, len(tasks))
+
+	var outerErr error
+
+	for _, task := range tasks {
+		wasEvicted, slotUsage, err := tc.repov1.Tasks().EvictTask(ctx, tenantId, v1.TaskIdInsertedAtRetryCount{
+			Id:         task.ID,
+			InsertedAt: task.InsertedAt,
+			RetryCount: task.RetryCount,
+		})
+
+		if err != nil {
+			outerErr = multierror.Append(outerErr, fmt.Errorf("could not evict durable task %s: %w", task.ExternalID, err))
+			continue
+		}
+
+		tc.publishSlotUsage(ctx, tenantId, slotUsage)
+
+		// the task may have finished since it was listed
+		if !wasEvicted {
+			continue
+		}
+
+		evicted = append(evicted, tasktypes.SignalTaskCancelledPayload{
+			TaskId:     task.ID,
+			WorkerId:   workerId,
+			RetryCount: task.RetryCount,
+		})
+
+		olapMsg, err := tasktypes.MonitoringEventMessageFromInternal(
+			tenantId,
+			tasktypes.CreateMonitoringEventPayload{
+				TaskId:         task.ID,
+				RetryCount:     task.RetryCount,
+				EventTimestamp: time.Now(),
+				EventType:      sqlcv1.V1EventTypeOlapDURABLEEVICTED,
+				EventMessage:   fmt.Sprintf("Evicted because worker %s was drained", workerId),
+				WorkerId:       &workerId,
+			},
+		)
+
+		if err != nil {
+			tc.l.Error().Ctx(ctx).Err(err).Msg("could not create monitoring event message for drained durable task")
+		} else if err := tc.pubBuffer.Pub(ctx, msgqueue.OLAP_QUEUE, olapMsg, false); err != nil {
+			tc.l.Error().Ctx(ctx).Err(err).Msg("could not publish monitoring event message for drained durable task")
+		}
+
+		restoreMsg, err := tasktypes.DurableRestoreTaskMessage(tenantId, task.ExternalID, "worker was drained")
+
+		if err != nil {
+			outerErr = multierror.Append(outerErr, fmt.Errorf("could not create restore message for durable task %s: %w", task.ExternalID, err))
+			continue
+		}
+
+		if err := tc.mq.SendMessage(ctx, msgqueue.TASK_PROCESSING_QUEUE, restoreMsg); err != nil {
+			outerErr = multierror.Append(outerErr, fmt.Errorf("could not send restore message for durable task %s: %w", task.ExternalID, err))
+		}
+	}
+
+	// the worker still holds the evicted invocations, so it's told to stop them
+	if len(evicted) > 0 {
+		if err := tc.sendTaskCancellationsToDispatcher(ctx, tenantId, evicted); err != nil {
+			outerErr = multierror.Append(outerErr, fmt.Errorf("could not send task cancellations to dispatcher: %w", err))
+		}
+	}
+
+	return outerErr
+}
+
+// requeueDrainedTasks requeues tasks from a draining worker as new attempts, which don't count
+// against their retries. The cordoned worker is excluded from scheduling, so they're assigned to
+// another worker.
+func (tc *TasksControllerImpl) requeueDrainedTasks(ctx context.Context, tenantId, workerId uuid.UUID, tasks []v1.TaskIdInsertedAtRetryCount) error {
+	if len(tasks) == 0 {
+		return nil
+	}
+
+	requeued, err := tc.repov1.WorkerCordons().RequeueTasks(ctx, tenantId, tasks)
+
+	if err != nil {
+		return fmt.Errorf("could not requeue tasks: %w", err)
+	}
+
+	if len(requeued) == 0 {
+		return nil
+	}
+
+	released := make([]*sqlcv1.ReleaseTasksRow, len(requeued))
+	cancellations := make([]tasktypes.SignalTaskCancelledPayload, len(requeued))
+
+	for i, task := range requeued {
+		released[i] = task.ReleaseTasksRow
+
+		cancellations[i] = tasktypes.SignalTaskCancelledPayload{
+			TaskId:     task.ID,
+			WorkerId:   task.WorkerID,
+			RetryCount: task.RetryCount,
+		}
+	}
+
+	var outerErr error
+
+	// the task runtimes were released in the repository, so the worker is signalled directly
+	if err := tc.sendTaskCancellationsToDispatcher(ctx, tenantId, cancellations); err != nil {
+		outerErr = multierror.Append(outerErr, fmt.Errorf("could not send task cancellations to dispatcher: %w", err))
+	}
+
+	tc.notifyQueuesOnCompletion(ctx, tenantId, released)
+
+	for _, task := range requeued {
+		olapMsg, err := tasktypes.MonitoringEventMessageFromInternal(
+			tenantId,
+			tasktypes.CreateMonitoringEventPayload{
+				TaskId:         task.ID,
+				RetryCount:     task.RetryCount,
+				EventType:      sqlcv1.V1EventTypeOlapREASSIGNED,
+				EventTimestamp: time.Now(),
+				EventMessage:   fmt.Sprintf("Requeued because worker %s was drained", workerId),
+				WorkerId:       &workerId,
+			},
+		)
+
+		if err != nil {
+			outerErr = multierror.Append(outerErr, fmt.Errorf("could not create monitoring event message: %w", err))
+			continue
+		}
+
+		if err := tc.pubBuffer.Pub(ctx, msgqueue.OLAP_QUEUE, olapMsg, false); err != nil {
+			outerErr = multierror.Append(outerErr, fmt.Errorf("could not publish monitoring event message: %w", err))
+		}
+	}
+
+	return outerErr
+}
+
+// cancelDrainedTasks cancels tasks on a draining worker through the regular cancellation flow,
+// which also tells the worker to stop them.
+func (tc *TasksControllerImpl) cancelDrainedTasks(ctx context.Context, tenantId, workerId uuid.UUID, tasks []v1.TaskIdInsertedAtRetryCount) error {
+	if len(tasks) == 0 {
+		return nil
+	}
+
+	payloads := make([]tasktypes.CancelledTaskPayload, len(tasks))
+
+	for i, task := range tasks {
+		payloads[i] = tasktypes.CancelledTaskPayload{
+			TaskId:       task.Id,
+			InsertedAt:   task.InsertedAt,
+			RetryCount:   task.RetryCount,
+			EventType:    sqlcv1.V1EventTypeOlapCANCELLED,
+			EventMessage: fmt.Sprintf("Cancelled because worker %s was drained", workerId),
+			ShouldNotify: true,
+		}
+	}
+
+	msg, err := msgqueue.NewTenantMessage(
+		tenantId,
+		msgqueue.MsgIDTaskCancelled,
+		false,
+		true,
+		payloads...,
+	)
+
+	if err != nil {
+		return fmt.Errorf("could not create message for task cancellation: %w", err)
+	}
+
+	return msgqueue.PubTenantMessage(
+		ctx,
+		tc.l,
+		tc.mq,
+		tc.pubsub,
+		msgqueue.TASK_PROCESSING_QUEUE,
+		msg,
+	)
+}
@@ -148,3 +148,36 @@ func zerologNop() *zerolog.Logger {
 	l := zerolog.Nop()
 	return &l
 }
+
+// TestResolveDagParentOutputs_GathersMapParentRuns checks that the runs of a map step are gathered
+// into a results array in DagParentTaskRunIds order, and that a map step with no runs still shows
+// up with an empty array.
+func TestResolveDagParentOutputs_GathersMapParentRuns(t *testing.T) {
+	fetch := uuid.New()
+	itemOne := uuid.New()
+	itemTwo := uuid.New()
+
+	child := newDagChildInput(t, 1, fetch, itemTwo, itemOne)
+	child.currInput.DagMapParentReadableIds = []string{"process", "empty"}
+
+	dagParentOutputs := map[uuid.UUID]*v1.TaskOutputEvent{
+		fetch:   parentOutputEvent(t, fetch, "fetch", map[string]int{"count": 2}),
+		itemOne: parentOutputEvent(t, itemOne, "process", map[string]int{"item": 1}),
+		itemTwo: parentOutputEvent(t, itemTwo, "process", map[string]int{"item": 2}),
+	}
+
+	inputs := make(map[v1.RetrievePayloadOpts][]byte)
+
+	resolveDagParentOutputs(context.Background(), zerologNop(), []*dagChildTaskInput{child}, dagParentOutputs, inputs)
+
+	parents := unmarshalParents(t, inputs, child.payloadKey)
+
+	assert.Equal(t, map[string]interface{}{"count": float64(2)}, parents["fetch"])
+	assert.Equal(t, map[string]interface{}{
+		"results": []interface{}{
+			map[string]interface{}{"item": float64(2)},
+			map[string]interface{}{"item": float64(1)},
+		},
+	}, parents["process"])
+	assert.Equal(t, map[string]interface{}{"results": []interface{}{}}, parents["empty"])
+}
@@ -30,6 +30,7 @@ import (
 	"github.com/hatchet-dev/hatchet/pkg/integrations/metrics/prometheus"
 	"github.com/hatchet-dev/hatchet/pkg/logger"
 	"github.com/hatchet-dev/hatchet/pkg/operator"
+	"github.com/hatchet-dev/hatchet/pkg/operator/approvaloperator"
 	"github.com/hatchet-dev/hatchet/pkg/operator/manager"
 	v1 "github.com/hatchet-dev/hatchet/pkg/repository"
 	"github.com/hatchet-dev/hatchet/pkg/repository/cache"
@@ -177,6 +178,7 @@ type DispatcherOpts struct {
 	enc                                 encryption.EncryptionService
 	infraBlockedCIDRs                   []string
 	dagOperatorDefaultSlots             int
+	approvalNotifier                    approvaloperator.Notifier
 	dispatcherId                        uuid.UUID
 	promGate                            *prometheus.Gate
 }
@@ -264,6 +266,12 @@ func WithDAGOperatorDefaultSlots(slots int) DispatcherOpt {
 	}
 }
 
+func WithApprovalNotifier(notifier approvaloperator.Notifier) DispatcherOpt {
+	return func(opts *DispatcherOpts) {
+		opts.approvalNotifier = notifier
+	}
+}
+
 func WithPayloadSizeThreshold(threshold int) DispatcherOpt {
 	return func(opts *DispatcherOpts) {
 		opts.payloadSizeThreshold = threshold
@@ -344,7 +352,7 @@ func New(fs ...DispatcherOpt) (*DispatcherImpl, error) {
 
 	pubBuffer := msgqueue.NewMQPubBuffer(opts.mqv1)
 
-	om := manager.NewOperatorManager(opts.dispatcherId, opts.l, opts.repov1, opts.enc, opts.infraBlockedCIDRs, opts.dagOperatorDefaultSlots)
+	om := manager.NewOperatorManager(opts.dispatcherId, opts.l, opts.repov1, opts.enc, opts.infraBlockedCIDRs, opts.dagOperatorDefaultSlots, opts.approvalNotifier)
 	v := validator.NewDefaultValidator()
 
 	return &DispatcherImpl{
@@ -880,7 +888,7 @@ func (d *DispatcherImpl) populateTaskData(
 			}
 		}
 
-		if len(currInput.DagParentTaskRunIds) > 0 {
+		if len(currInput.DagParentTaskRunIds) > 0 || len(currInput.DagMapParentReadableIds) > 0 {
 			dagChildInputs = append(dagChildInputs, &dagChildTaskInput{payloadKey: payloadKey, currInput: currInput})
 
 			for _, parentExternalId := range currInput.DagParentTaskRunIds {
@@ -1000,6 +1008,14 @@ func resolveDagParentOutputs(
 	for _, entry := range dagChildInputs {
 		parents := make(map[string]map[string]interface{})
 
+		// the runs of a map step are gathered in order into a results array, which is present even
+		// when the map step had no elements
+		mapResults := make(map[string][]interface{}, len(entry.currInput.DagMapParentReadableIds))
+
+		for _, readableId := range entry.currInput.DagMapParentReadableIds {
+			mapResults[readableId] = []interface{}{}
+		}
+
 		for _, parentExternalId := range entry.currInput.DagParentTaskRunIds {
 			parentOutput, ok := dagParentOutputs[parentExternalId]
 
@@ -1014,9 +1030,18 @@ func resolveDagParentOutputs(
 				continue
 			}
 
+			if results, ok := mapResults[parentOutput.StepReadableID]; ok {
+				mapResults[parentOutput.StepReadableID] = append(results, outputMap)
+				continue
+			}
+
 			parents[parentOutput.StepReadableID] = outputMap
 		}
 
+		for readableId, results := range mapResults {
+			parents[readableId] = map[string]interface{}{"results": results}
+		}
+
 		entry.currInput.Parents = parents
 		inputs[entry.payloadKey] = entry.currInput.Bytes()
 	}
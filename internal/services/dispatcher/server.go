@@ -74,6 +74,17 @@ func (s *DispatcherImpl) Register(ctx context.Context, request *contracts.Worker
 		opts.SlotConfig = map[string]int32{v1.SlotTypeDefault: *request.Slots}
 	}
 
+	if request.ScalingTargets != nil {
+		opts.ScalingTargets = &v1.WorkerScalingTargets{
+			SlotsPerReplica: request.ScalingTargets.SlotsPerReplica,
+		}
+
+		if request.ScalingTargets.MaxQueueAgeSeconds != nil {
+			maxQueueAge := time.Duration(*request.ScalingTargets.MaxQueueAgeSeconds) * time.Second
+			opts.ScalingTargets.MaxQueueAge = &maxQueueAge
+		}
+	}
+
 	if apiErrors, err := s.v.ValidateAPI(opts); err != nil {
 		return nil, err
 	} else if apiErrors != nil {
@@ -115,6 +126,7 @@ func (s *DispatcherImpl) Register(ctx context.Context, request *contracts.Worker
 		"has_slot_config", len(request.SlotConfig) > 0,
 		"has_custom_slots", request.Slots != nil,
 		"has_services", len(request.Services) > 0,
+		"has_scaling_targets", request.ScalingTargets != nil,
 	))
 
 	return &contracts.WorkerRegisterResponse{
@@ -482,9 +494,49 @@ func (s *DispatcherImpl) Heartbeat(ctx context.Context, req *contracts.Heartbeat
 		}
 	}
 
+	if req.DynamicLabels != nil {
+		if err := s.replaceDynamicLabels(ctx, workerId, req.DynamicLabels.Labels); err != nil {
+			span.RecordError(err)
+			span.SetStatus(telemetry_codes.Error, "could not replace dynamic worker labels")
+			return nil, err
+		}
+	}
+
 	return &contracts.HeartbeatResponse{}, nil
 }
 
+// the maximum number of dynamic labels a worker can report on a heartbeat
+const maxDynamicWorkerLabels = 100
+
+// replaceDynamicLabels replaces the dynamic labels of a worker with the labels reported on its
+// heartbeat. The scheduler picks up changed labels when it next refreshes its workers.
+func (s *DispatcherImpl) replaceDynamicLabels(ctx context.Context, workerId uuid.UUID, request map[string]*contracts.WorkerLabels) error {
+	if len(request) > maxDynamicWorkerLabels {
+		return status.Errorf(codes.InvalidArgument, "too many dynamic labels: %d, the maximum is %d", len(request), maxDynamicWorkerLabels)
+	}
+
+	labels := make([]v1.UpsertWorkerLabelOpts, 0, len(request))
+
+	for key, config := range request {
+		if err := s.v.Validate(config); err != nil {
+			return status.Errorf(codes.InvalidArgument, "invalid dynamic label %s: %s", key, err.Error())
+		}
+
+		labels = append(labels, v1.UpsertWorkerLabelOpts{
+			Key:      key,
+			IntValue: config.IntValue,
+			StrValue: config.StrValue,
+		})
+	}
+
+	if _, err := s.repov1.Workers().ReplaceDynamicWorkerLabels(ctx, workerId, labels); err != nil {
+		s.l.Error().Ctx(ctx).Err(err).Msgf("could not replace dynamic labels for worker %s", workerId.String())
+		return err
+	}
+
+	return nil
+}
+
 func (s *DispatcherImpl) ReleaseSlot(ctx context.Context, req *contracts.ReleaseSlotRequest) (*contracts.ReleaseSlotResponse, error) {
 	tenant := ctx.Value("tenant").(*sqlcv1.Tenant)
 	s.analytics.Count(ctx, analytics.Worker, analytics.Release)
@@ -1490,16 +1542,22 @@ func (s *DispatcherImpl) handleTaskFailed(inputCtx context.Context, task *sqlcv1
 		shouldNotRetry = *request.ShouldNotRetry
 	}
 
-	msg, err := tasktypes.FailedTaskMessage(
+	msg, err := msgqueue.NewTenantMessage(
 		tenantId,
-		task.ID,
-		task.InsertedAt,
-		task.ExternalID,
-		task.WorkflowRunID,
-		retryCount,
+		msgqueue.MsgIDTaskFailed,
+		false,
 		true,
-		request.EventPayload,
-		shouldNotRetry,
+		tasktypes.FailedTaskPayload{
+			TaskId:         task.ID,
+			InsertedAt:     task.InsertedAt,
+			ExternalId:     task.ExternalID,
+			WorkflowRunId:  task.WorkflowRunID,
+			RetryCount:     retryCount,
+			IsAppError:     true,
+			ErrorMsg:       request.EventPayload,
+			IsNonRetryable: shouldNotRetry,
+			ErrorClass:     request.GetErrorClass(),
+		},
 	)
 
 	if err != nil {
@@ -1893,6 +1951,7 @@ func (s *DispatcherImpl) handleBatchTaskFailed(
 			IsAppError:     true,
 			ErrorMsg:       item.EventPayload,
 			IsNonRetryable: shouldNotRetry,
+			ErrorClass:     item.GetErrorClass(),
 		})
 	}
 
@@ -2088,7 +2147,7 @@ func (d *DispatcherImpl) releaseSlot(ctx context.Context, tenant *sqlcv1.Tenant,
 		return nil, status.Errorf(codes.InvalidArgument, "invalid task external run id %s: %v", request.TaskRunExternalId, err)
 	}
 
-	releasedSlot, err := d.repov1.Tasks().ReleaseSlot(ctx, tenantId, stepRunId)
+	releasedSlot, slotUsage, err := d.repov1.Tasks().ReleaseSlot(ctx, tenantId, stepRunId)
 
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
@@ -2098,6 +2157,8 @@ func (d *DispatcherImpl) releaseSlot(ctx context.Context, tenant *sqlcv1.Tenant,
 		return nil, err
 	}
 
+	publishSlotUsage(ctx, d.l, d.pubBuffer, tenantId, slotUsage)
+
 	workerId := releasedSlot.WorkerID
 
 	// send to the OLAP repository
@@ -2125,6 +2186,25 @@ func (d *DispatcherImpl) releaseSlot(ctx context.Context, tenant *sqlcv1.Tenant,
 	return &contracts.ReleaseSlotResponse{}, nil
 }
 
+// publishSlotUsage sends the slot time consumed by tasks whose slots were released before they
+// completed to the OLAP repository.
+func publishSlotUsage(ctx context.Context, l *zerolog.Logger, pubBuffer *msgqueue.MQPubBuffer, tenantId uuid.UUID, usage []v1.TaskSlotUsage) {
+	msg, err := tasktypes.TaskSlotUsageMessage(tenantId, usage)
+
+	if err != nil {
+		l.Error().Ctx(ctx).Err(err).Msg("could not create task slot usage message")
+		return
+	}
+
+	if msg == nil {
+		return
+	}
+
+	if err := pubBuffer.Pub(ctx, msgqueue.OLAP_QUEUE, msg, false); err != nil {
+		l.Error().Ctx(ctx).Err(err).Msg("could not publish task slot usage message")
+	}
+}
+
 func (d *DispatcherImpl) restoreEvictedTask(ctx context.Context, tenant *sqlcv1.Tenant, request *contracts.RestoreEvictedTaskRequest) (*contracts.RestoreEvictedTaskResponse, error) {
 	tenantId := tenant.ID
 	taskExternalId, err := uuid.Parse(request.TaskRunExternalId)
@@ -1302,7 +1302,7 @@ func (d *DispatcherServiceImpl) handleEvictInvocation(
 		return d.sendEvictionError(invocation, req, fmt.Sprintf("task not found: %v", err))
 	}
 
-	wasEvicted, err := d.repo.Tasks().EvictTask(ctx, invocation.tenantId, v1.TaskIdInsertedAtRetryCount{
+	wasEvicted, slotUsage, err := d.repo.Tasks().EvictTask(ctx, invocation.tenantId, v1.TaskIdInsertedAtRetryCount{
 		Id:         task.ID,
 		InsertedAt: task.InsertedAt,
 		RetryCount: task.RetryCount,
@@ -1311,6 +1311,8 @@ func (d *DispatcherServiceImpl) handleEvictInvocation(
 		return d.sendEvictionError(invocation, req, fmt.Sprintf("failed to evict task: %v", err))
 	}
 
+	publishSlotUsage(ctx, d.l, d.pubBuffer, invocation.tenantId, slotUsage)
+
 	if wasEvicted {
 		msg, err := tasktypes.MonitoringEventMessageFromInternal(
 			invocation.tenantId,
@@ -1605,22 +1607,24 @@ func (d *DispatcherServiceImpl) TriggerDAGStep(ctx context.Context, tenantId uui
 		TriggerTaskData: &v1.TriggerTaskData{
 			WorkflowName: req.WorkflowName,
 			// Pin to the DAG's original version so a mid-run deploy can't retarget the step.
-			WorkflowVersionId:    &workflowVersionId,
-			TargetActionId:       &req.ActionId,
-			UserMessage:          &stepLabel,
-			Data:                 []byte(req.Input),
-			AdditionalMetadata:   req.AdditionalMetadata,
-			ParentExternalId:     &task.ExternalID,
-			ParentTaskId:         &task.ID,
-			ParentTaskInsertedAt: &task.InsertedAt.Time,
-			ChildIndex:           &childIndex,
-			DagParentTaskRunIds:  req.DagParentTaskRunIds,
-			IsSkipped:            req.IsSkipped,
-			IsCancelled:          req.IsCancelled,
-			DesiredWorkerLabels:  req.DesiredWorkerLabels,
-			WorkflowRunId:        &orchestratorWorkflowRunId,
-			OlapDagId:            &task.ID,
-			OlapDagInsertedAt:    &task.InsertedAt.Time,
+			WorkflowVersionId:       &workflowVersionId,
+			TargetActionId:          &req.ActionId,
+			UserMessage:             &stepLabel,
+			Data:                    []byte(req.Input),
+			AdditionalMetadata:      req.AdditionalMetadata,
+			ParentExternalId:        &task.ExternalID,
+			ParentTaskId:            &task.ID,
+			ParentTaskInsertedAt:    &task.InsertedAt.Time,
+			ChildIndex:              &childIndex,
+			ChildKey:                req.ChildKey,
+			DagParentTaskRunIds:     req.DagParentTaskRunIds,
+			DagMapParentReadableIds: req.DagMapParentReadableIds,
+			IsSkipped:               req.IsSkipped,
+			IsCancelled:             req.IsCancelled,
+			DesiredWorkerLabels:     req.DesiredWorkerLabels,
+			WorkflowRunId:           &orchestratorWorkflowRunId,
+			OlapDagId:               &task.ID,
+			OlapDagInsertedAt:       &task.InsertedAt.Time,
 		},
 	}}
 
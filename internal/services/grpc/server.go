@@ -32,6 +32,8 @@ import (
 	"github.com/hatchet-dev/hatchet/internal/services/ingestor"
 	eventcontracts "github.com/hatchet-dev/hatchet/internal/services/ingestor/contracts"
 	"github.com/hatchet-dev/hatchet/internal/services/otelcol"
+	"github.com/hatchet-dev/hatchet/internal/services/scaler"
+	scalercontracts "github.com/hatchet-dev/hatchet/internal/services/scaler/contracts"
 	v1contracts "github.com/hatchet-dev/hatchet/internal/services/shared/proto/v1"
 	"github.com/hatchet-dev/hatchet/pkg/analytics"
 	"github.com/hatchet-dev/hatchet/pkg/config/server"
@@ -63,6 +65,7 @@ type Server struct {
 	admin         admin.AdminService
 	adminv1       adminv1.AdminService
 	otelCollector otelcol.OTelCollector
+	scaler        scaler.Scaler
 	tls           *tls.Config
 	insecure      bool
 
@@ -84,6 +87,7 @@ type ServerOpts struct {
 	admin         admin.AdminService
 	adminv1       adminv1.AdminService
 	otelCollector otelcol.OTelCollector
+	scaler        scaler.Scaler
 	tls           *tls.Config
 	insecure      bool
 
@@ -201,6 +205,12 @@ func WithOTelCollector(oc otelcol.OTelCollector) ServerOpt {
 	}
 }
 
+func WithScaler(sc scaler.Scaler) ServerOpt {
+	return func(opts *ServerOpts) {
+		opts.scaler = sc
+	}
+}
+
 func NewServer(fs ...ServerOpt) (*Server, error) {
 	opts := defaultServerOpts()
 
@@ -232,6 +242,7 @@ func NewServer(fs ...ServerOpt) (*Server, error) {
 		admin:         opts.admin,
 		adminv1:       opts.adminv1,
 		otelCollector: opts.otelCollector,
+		scaler:        opts.scaler,
 		tls:           opts.tls,
 		insecure:      opts.insecure,
 
@@ -377,6 +388,11 @@ func (s *Server) startGRPC() (func() error, error) {
 		collectortracev1.RegisterTraceServiceServer(grpcServer, s.otelCollector)
 	}
 
+	if s.scaler != nil {
+		// Register as a KEDA external scaler
+		scalercontracts.RegisterExternalScalerServer(grpcServer, s.scaler)
+	}
+
 	go func() {
 		if err := grpcServer.Serve(lis); err != nil {
 			panic(fmt.Errorf("failed to serve: %w", err))
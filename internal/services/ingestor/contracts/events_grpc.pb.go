@@ -26,6 +26,9 @@ type EventsServiceClient interface {
 	BulkPush(ctx context.Context, in *BulkPushEventRequest, opts ...grpc.CallOption) (*Events, error)
 	ReplaySingleEvent(ctx context.Context, in *ReplayEventRequest, opts ...grpc.CallOption) (*Event, error)
 	PutLog(ctx context.Context, in *PutLogRequest, opts ...grpc.CallOption) (*PutLogResponse, error)
+	// PutLogs streams batches of log lines. Each batch is acknowledged with its sequence number once
+	// it's written, and batches are processed in order.
+	PutLogs(ctx context.Context, opts ...grpc.CallOption) (EventsService_PutLogsClient, error)
 	PutStreamEvent(ctx context.Context, in *PutStreamEventRequest, opts ...grpc.CallOption) (*PutStreamEventResponse, error)
 }
 
@@ -73,6 +76,37 @@ func (c *eventsServiceClient) PutLog(ctx context.Context, in *PutLogRequest, opt
 	return out, nil
 }
 
+func (c *eventsServiceClient) PutLogs(ctx context.Context, opts ...grpc.CallOption) (EventsService_PutLogsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &EventsService_ServiceDesc.Streams[0], "/EventsService/PutLogs", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &eventsServicePutLogsClient{stream}
+	return x, nil
+}
+
+type EventsService_PutLogsClient interface {
+	Send(*PutLogsRequest) error
+	Recv() (*PutLogsResponse, error)
+	grpc.ClientStream
+}
+
+type eventsServicePutLogsClient struct {
+	grpc.ClientStream
+}
+
+func (x *eventsServicePutLogsClient) Send(m *PutLogsRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *eventsServicePutLogsClient) Recv() (*PutLogsResponse, error) {
+	m := new(PutLogsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *eventsServiceClient) PutStreamEvent(ctx context.Context, in *PutStreamEventRequest, opts ...grpc.CallOption) (*PutStreamEventResponse, error) {
 	out := new(PutStreamEventResponse)
 	err := c.cc.Invoke(ctx, "/EventsService/PutStreamEvent", in, out, opts...)
@@ -90,6 +124,9 @@ type EventsServiceServer interface {
 	BulkPush(context.Context, *BulkPushEventRequest) (*Events, error)
 	ReplaySingleEvent(context.Context, *ReplayEventRequest) (*Event, error)
 	PutLog(context.Context, *PutLogRequest) (*PutLogResponse, error)
+	// PutLogs streams batches of log lines. Each batch is acknowledged with its sequence number once
+	// it's written, and batches are processed in order.
+	PutLogs(EventsService_PutLogsServer) error
 	PutStreamEvent(context.Context, *PutStreamEventRequest) (*PutStreamEventResponse, error)
 	mustEmbedUnimplementedEventsServiceServer()
 }
@@ -110,6 +147,9 @@ func (UnimplementedEventsServiceServer) ReplaySingleEvent(context.Context, *Repl
 func (UnimplementedEventsServiceServer) PutLog(context.Context, *PutLogRequest) (*PutLogResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PutLog not implemented")
 }
+func (UnimplementedEventsServiceServer) PutLogs(EventsService_PutLogsServer) error {
+	return status.Errorf(codes.Unimplemented, "method PutLogs not implemented")
+}
 func (UnimplementedEventsServiceServer) PutStreamEvent(context.Context, *PutStreamEventRequest) (*PutStreamEventResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PutStreamEvent not implemented")
 }
@@ -198,6 +238,32 @@ func _EventsService_PutLog_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _EventsService_PutLogs_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(EventsServiceServer).PutLogs(&eventsServicePutLogsServer{stream})
+}
+
+type EventsService_PutLogsServer interface {
+	Send(*PutLogsResponse) error
+	Recv() (*PutLogsRequest, error)
+	grpc.ServerStream
+}
+
+type eventsServicePutLogsServer struct {
+	grpc.ServerStream
+}
+
+func (x *eventsServicePutLogsServer) Send(m *PutLogsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *eventsServicePutLogsServer) Recv() (*PutLogsRequest, error) {
+	m := new(PutLogsRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func _EventsService_PutStreamEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(PutStreamEventRequest)
 	if err := dec(in); err != nil {
@@ -244,6 +310,13 @@ var EventsService_ServiceDesc = grpc.ServiceDesc{
 			Handler:    _EventsService_PutStreamEvent_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "PutLogs",
+			Handler:       _EventsService_PutLogs_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+	},
 	Metadata: "events.proto",
 }
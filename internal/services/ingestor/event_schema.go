@@ -0,0 +1,206 @@
+package ingestor
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/santhosh-tekuri/jsonschema/v6"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	msgqueue "github.com/hatchet-dev/hatchet/internal/msgqueue"
+	tasktypes "github.com/hatchet-dev/hatchet/internal/services/shared/tasktypes/v1"
+	v1 "github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/sqlcv1"
+)
+
+// eventSchemaCacheTTL is how long the schemas of a tenant are cached by the ingestor, and so how
+// long a registered or deleted schema can take to apply to pushed events.
+const eventSchemaCacheTTL = 10 * time.Second
+
+type compiledEventSchema struct {
+	eventKey string
+	version  int32
+	mode     sqlcv1.V1EventSchemaMode
+	schema   *jsonschema.Schema
+}
+
+// tenantEventSchemas returns the active schemas of the tenant, compiled for validation.
+func (i *IngestorImpl) tenantEventSchemas(ctx context.Context, tenantId uuid.UUID) ([]*compiledEventSchema, error) {
+	if schemas, ok := i.eventSchemaCache.Get(tenantId); ok {
+		return schemas, nil
+	}
+
+	rows, err := i.repov1.EventSchemas().ListEventSchemas(ctx, tenantId)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not list event schemas: %w", err)
+	}
+
+	schemas := make([]*compiledEventSchema, 0, len(rows))
+
+	for _, row := range rows {
+		compiled, err := v1.CompileEventSchema(row.Schema)
+
+		// schemas are compiled when they're registered, so this is unexpected
+		if err != nil {
+			i.l.Error().Ctx(ctx).Err(err).Msgf("could not compile version %d of the event schema for %s", row.Version, row.EventKey)
+			continue
+		}
+
+		schemas = append(schemas, &compiledEventSchema{
+			eventKey: row.EventKey,
+			version:  row.Version,
+			mode:     row.Mode,
+			schema:   compiled,
+		})
+	}
+
+	i.eventSchemaCache.Add(tenantId, schemas)
+
+	return schemas, nil
+}
+
+// matchEventSchema returns the schema which applies to an event key: the schema registered for the
+// exact key, or else the most specific wildcard pattern which matches it, which is the one with the
+// most literal characters.
+func matchEventSchema(schemas []*compiledEventSchema, key string) *compiledEventSchema {
+	var res *compiledEventSchema
+	resLiterals := -1
+
+	for _, schema := range schemas {
+		if schema.eventKey == key {
+			return schema
+		}
+
+		if !strings.Contains(schema.eventKey, "*") || !eventKeyMatchesPattern(schema.eventKey, key) {
+			continue
+		}
+
+		if literals := len(schema.eventKey) - strings.Count(schema.eventKey, "*"); literals > resLiterals {
+			res = schema
+			resLiterals = literals
+		}
+	}
+
+	return res
+}
+
+// eventKeyMatchesPattern returns whether an event key matches a pattern, where `*` matches any
+// sequence of characters.
+func eventKeyMatchesPattern(pattern, key string) bool {
+	parts := strings.Split(pattern, "*")
+
+	if !strings.HasPrefix(key, parts[0]) {
+		return false
+	}
+
+	key = key[len(parts[0]):]
+
+	last := parts[len(parts)-1]
+
+	for _, part := range parts[1 : len(parts)-1] {
+		idx := strings.Index(key, part)
+
+		if idx < 0 {
+			return false
+		}
+
+		key = key[idx+len(part):]
+	}
+
+	return strings.HasSuffix(key, last)
+}
+
+// validateEventPayload validates an event payload against a schema. An event without a payload is
+// validated as an empty object.
+func validateEventPayload(schema *jsonschema.Schema, data []byte) error {
+	if len(data) == 0 {
+		data = []byte("{}")
+	}
+
+	doc, err := jsonschema.UnmarshalJSON(bytes.NewReader(data))
+
+	if err != nil {
+		return fmt.Errorf("payload is not valid JSON: %w", err)
+	}
+
+	return schema.Validate(doc)
+}
+
+// validateEventSchemas validates the payloads of events against the schemas registered for their
+// keys. Failures are recorded in the OLAP repository, and an InvalidArgument error is returned if
+// any event failed to validate against a schema in reject mode.
+func (i *IngestorImpl) validateEventSchemas(ctx context.Context, tenantId uuid.UUID, events []tasktypes.UserEventTaskPayload) error {
+	schemas, err := i.tenantEventSchemas(ctx, tenantId)
+
+	if err != nil {
+		return err
+	}
+
+	if len(schemas) == 0 {
+		return nil
+	}
+
+	failures := make([]v1.EventSchemaValidationFailure, 0)
+	var rejectErr error
+
+	for _, event := range events {
+		schema := matchEventSchema(schemas, event.EventKey)
+
+		if schema == nil {
+			continue
+		}
+
+		validationErr := validateEventPayload(schema.schema, event.EventData)
+
+		if validationErr == nil {
+			continue
+		}
+
+		rejected := schema.mode == sqlcv1.V1EventSchemaModeREJECT
+
+		failures = append(failures, v1.EventSchemaValidationFailure{
+			EventKey:       event.EventKey,
+			SchemaEventKey: schema.eventKey,
+			SchemaVersion:  schema.version,
+			Rejected:       rejected,
+			ErrorMessage:   validationErr.Error(),
+		})
+
+		if rejected && rejectErr == nil {
+			rejectErr = status.Error(
+				codes.InvalidArgument,
+				fmt.Sprintf("payload of event %s does not match version %d of the schema for %s: %s", event.EventKey, schema.version, schema.eventKey, validationErr.Error()),
+			)
+		}
+	}
+
+	if len(failures) > 0 {
+		if err := i.ingestEventSchemaValidationFailures(ctx, tenantId, failures); err != nil {
+			i.l.Error().Ctx(ctx).Err(err).Msg("could not record event schema validation failures")
+		}
+	}
+
+	return rejectErr
+}
+
+func (i *IngestorImpl) ingestEventSchemaValidationFailures(ctx context.Context, tenantId uuid.UUID, failures []v1.EventSchemaValidationFailure) error {
+	msg, err := tasktypes.EventSchemaValidationFailureMessage(tenantId, failures)
+
+	if err != nil {
+		return fmt.Errorf("failed to create event schema validation failure message: %w", err)
+	}
+
+	err = i.mqv1.SendMessage(ctx, msgqueue.OLAP_QUEUE, msg)
+
+	if err != nil {
+		return fmt.Errorf("failed to send event schema validation failure message: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,93 @@
+package ingestor
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	v1 "github.com/hatchet-dev/hatchet/pkg/repository"
+)
+
+func TestEventKeyMatchesPattern(t *testing.T) {
+	cases := []struct {
+		pattern string
+		key     string
+		matches bool
+	}{
+		{"user:*", "user:created", true},
+		{"user:*", "user:", true},
+		{"user:*", "order:created", false},
+		{"*:created", "user:created", true},
+		{"*:created", "user:deleted", false},
+		{"user:*:v*", "user:created:v2", true},
+		{"user:*:v*", "user:created", false},
+		{"a*a", "a", false},
+		{"a*a", "aa", true},
+		{"*", "anything", true},
+	}
+
+	for _, c := range cases {
+		assert.Equal(t, c.matches, eventKeyMatchesPattern(c.pattern, c.key), "pattern %q, key %q", c.pattern, c.key)
+	}
+}
+
+func TestMatchEventSchema(t *testing.T) {
+	schemas := []*compiledEventSchema{
+		{eventKey: "*"},
+		{eventKey: "user:*"},
+		{eventKey: "user:created"},
+		{eventKey: "user:*:v2"},
+	}
+
+	keyOf := func(schema *compiledEventSchema) string {
+		if schema == nil {
+			return ""
+		}
+
+		return schema.eventKey
+	}
+
+	assert.Equal(t, "user:created", keyOf(matchEventSchema(schemas, "user:created")))
+	assert.Equal(t, "user:*:v2", keyOf(matchEventSchema(schemas, "user:deleted:v2")))
+	assert.Equal(t, "user:*", keyOf(matchEventSchema(schemas, "user:deleted")))
+	assert.Equal(t, "*", keyOf(matchEventSchema(schemas, "order:created")))
+	assert.Nil(t, matchEventSchema(schemas[1:], "order:created"))
+}
+
+func TestValidateEventPayload(t *testing.T) {
+	schema, err := v1.CompileEventSchema([]byte(`{
+		"type": "object",
+		"properties": {"userId": {"type": "string"}},
+		"required": ["userId"]
+	}`))
+
+	require.NoError(t, err)
+
+	assert.NoError(t, validateEventPayload(schema, []byte(`{"userId": "abc"}`)))
+	assert.Error(t, validateEventPayload(schema, []byte(`{"user_id": "abc"}`)))
+	assert.Error(t, validateEventPayload(schema, []byte(`{"userId": 1}`)))
+	assert.Error(t, validateEventPayload(schema, nil))
+	assert.Error(t, validateEventPayload(schema, []byte(`not json`)))
+
+	_, err = v1.CompileEventSchema([]byte(`{"type": "not-a-type"}`))
+	assert.ErrorIs(t, err, v1.ErrInvalidEventSchema)
+}
+
+func TestCompileEventSchemaRejectsExternalRefs(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "schema.json")
+
+	require.NoError(t, os.WriteFile(path, []byte(`{"type": "string"}`), 0o600))
+
+	for _, ref := range []string{"file://" + path, "http://127.0.0.1:1/schema.json", "other.json"} {
+		_, err := v1.CompileEventSchema([]byte(`{"$ref": "` + ref + `"}`))
+		assert.ErrorIs(t, err, v1.ErrInvalidEventSchema, ref)
+	}
+
+	// the standard metaschemas are still available
+	_, err := v1.CompileEventSchema([]byte(`{"$schema": "http://json-schema.org/draft-07/schema#", "type": "object"}`))
+	assert.NoError(t, err)
+}
@@ -3,9 +3,11 @@ package ingestor
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/google/uuid"
 	lru "github.com/hashicorp/golang-lru/v2"
+	"github.com/hashicorp/golang-lru/v2/expirable"
 
 	"github.com/hatchet-dev/hatchet/internal/msgqueue"
 	"github.com/hatchet-dev/hatchet/internal/services/controllers/task/trigger"
@@ -20,6 +22,7 @@ import (
 	"github.com/hatchet-dev/hatchet/pkg/validator"
 
 	"github.com/rs/zerolog"
+	"golang.org/x/time/rate"
 )
 
 type Ingestor interface {
@@ -40,6 +43,8 @@ type IngestorOpts struct {
 	repov1                v1.Repository
 	analytics             analytics.Analytics
 	isLogIngestionEnabled bool
+	logRateLimit          rate.Limit
+	logBurst              int
 
 	localScheduler              *scheduler.Scheduler
 	localDispatcher             *dispatcher.DispatcherImpl
@@ -50,6 +55,8 @@ type IngestorOpts struct {
 	grpcTriggerSlots    int
 
 	promGate *prometheus.Gate
+
+	eventDedupeKeyTTL time.Duration
 }
 
 func WithMessageQueueV1(mq msgqueue.MessageQueue) IngestorOptFunc {
@@ -76,6 +83,20 @@ func WithLogIngestionEnabled(isEnabled bool) IngestorOptFunc {
 	}
 }
 
+// WithLogIngestionRateLimit sets the number of log lines per second each tenant can stream, with
+// bursts of up to burst lines. A rate of 0 or less disables the limit.
+func WithLogIngestionRateLimit(linesPerSecond float64, burst int) IngestorOptFunc {
+	return func(opts *IngestorOpts) {
+		if linesPerSecond <= 0 {
+			opts.logRateLimit = rate.Inf
+		} else {
+			opts.logRateLimit = rate.Limit(linesPerSecond)
+		}
+
+		opts.logBurst = max(burst, 1)
+	}
+}
+
 func WithGrpcTriggersEnabled(enabled bool) IngestorOptFunc {
 	return func(opts *IngestorOpts) {
 		opts.grpcTriggersEnabled = enabled
@@ -88,13 +109,22 @@ func WithGrpcTriggerSlots(slots int) IngestorOptFunc {
 	}
 }
 
+func WithEventDedupeKeyTTL(ttl time.Duration) IngestorOptFunc {
+	return func(opts *IngestorOpts) {
+		opts.eventDedupeKeyTTL = ttl
+	}
+}
+
 func defaultIngestorOpts() *IngestorOpts {
 	l := logger.NewDefaultLogger("ingestor")
 
 	return &IngestorOpts{
 		isLogIngestionEnabled: true,
+		logRateLimit:          rate.Inf,
+		logBurst:              1,
 		analytics:             analytics.NoOpAnalytics{},
 		l:                     &l,
+		eventDedupeKeyTTL:     24 * time.Hour,
 	}
 }
 
@@ -138,6 +168,9 @@ type IngestorImpl struct {
 	contracts.UnimplementedEventsServiceServer
 
 	steprunTenantLookupCache *lru.Cache[string, string]
+	eventSchemaCache         *expirable.LRU[uuid.UUID, []*compiledEventSchema]
+	logWorkerCache           *expirable.LRU[logWorkerKey, struct{}]
+	logRateLimiters          *lru.Cache[uuid.UUID, *rate.Limiter]
 
 	mqv1   msgqueue.MessageQueue
 	pubsub msgqueue.PubSub
@@ -145,6 +178,8 @@ type IngestorImpl struct {
 	repov1 v1.Repository
 
 	isLogIngestionEnabled bool
+	logRateLimit          rate.Limit
+	logBurst              int
 
 	localScheduler  *scheduler.Scheduler
 	localDispatcher *dispatcher.DispatcherImpl
@@ -153,6 +188,8 @@ type IngestorImpl struct {
 	analytics analytics.Analytics
 	tw        *trigger.TriggerWriter
 	pubBuffer *msgqueue.MQPubBuffer
+
+	eventDedupeKeyTTL time.Duration
 }
 
 func NewIngestor(fs ...IngestorOptFunc) (Ingestor, error) {
@@ -181,6 +218,12 @@ func NewIngestor(fs ...IngestorOptFunc) (Ingestor, error) {
 		return nil, fmt.Errorf("could not create step run cache: %w", err)
 	}
 
+	logRateLimiters, err := lru.New[uuid.UUID, *rate.Limiter](10000)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not create log rate limiter cache: %w", err)
+	}
+
 	var tw *trigger.TriggerWriter
 	var pubBuffer *msgqueue.MQPubBuffer
 
@@ -198,17 +241,23 @@ func NewIngestor(fs ...IngestorOptFunc) (Ingestor, error) {
 
 	return &IngestorImpl{
 		steprunTenantLookupCache: stepRunCache,
+		eventSchemaCache:         expirable.NewLRU(10000, func(uuid.UUID, []*compiledEventSchema) {}, eventSchemaCacheTTL),
+		logWorkerCache:           expirable.NewLRU(10000, func(logWorkerKey, struct{}) {}, logWorkerCacheTTL),
 		mqv1:                     opts.mqv1,
 		pubsub:                   opts.pubsub,
 		v:                        validator.NewDefaultValidator(),
 		repov1:                   opts.repov1,
 		analytics:                opts.analytics,
 		isLogIngestionEnabled:    opts.isLogIngestionEnabled,
+		logRateLimiters:          logRateLimiters,
+		logRateLimit:             opts.logRateLimit,
+		logBurst:                 opts.logBurst,
 		l:                        opts.l,
 		localScheduler:           localScheduler,
 		localDispatcher:          opts.localDispatcher,
 		tw:                       tw,
 		pubBuffer:                pubBuffer,
+		eventDedupeKeyTTL:        opts.eventDedupeKeyTTL,
 	}, nil
 }
 
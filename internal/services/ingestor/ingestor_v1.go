@@ -56,6 +56,11 @@ func (i *IngestorImpl) ingestEventV1(ctx context.Context, tenant *sqlcv1.Tenant,
 
 	opt := eventToPayload(tenantId, key, data, metadata, priority, scope, triggeringWebhookName)
 
+	// a rejection by the event schema is returned as is, so callers can surface its message
+	if err := i.validateEventSchemas(ctx, tenantId, []tasktypes.UserEventTaskPayload{opt}); err != nil {
+		return nil, err
+	}
+
 	events, err := i.ingest(ctx, tenant, opt)
 
 	if err != nil {
@@ -69,23 +74,15 @@ func (i *IngestorImpl) ingestEventV1(ctx context.Context, tenant *sqlcv1.Tenant,
 	return events[0], nil
 }
 
+// ingest writes events and triggers their runs. Events must be validated against their schemas
+// with validateEventSchemas before they're ingested.
 func (i *IngestorImpl) ingest(ctx context.Context, tenant *sqlcv1.Tenant, eventOpts ...tasktypes.UserEventTaskPayload) ([]*sqlcv1.Event, error) {
 	res := make([]*sqlcv1.Event, 0, len(eventOpts))
 	now := time.Now().UTC()
 	tenantId := tenant.ID
 
 	for _, event := range eventOpts {
-		e := &sqlcv1.Event{
-			ID:                 event.EventExternalId,
-			CreatedAt:          sqlchelpers.TimestampFromTime(now),
-			UpdatedAt:          sqlchelpers.TimestampFromTime(now),
-			Key:                event.EventKey,
-			TenantId:           tenantId,
-			Data:               event.EventData,
-			AdditionalMetadata: event.EventAdditionalMetadata,
-		}
-
-		res = append(res, e)
+		res = append(res, payloadToEvent(tenantId, event, now))
 	}
 
 	wasProcessedLocally := false
@@ -244,7 +241,160 @@ func (i *IngestorImpl) bulkIngestEventV1(ctx context.Context, tenant *sqlcv1.Ten
 		payloads = append(payloads, eventToPayload(tenantId, event.Key, event.Data, event.AdditionalMetadata, event.Priority, event.Scope, event.TriggeringWebhookName))
 	}
 
-	return i.ingest(ctx, tenant, payloads...)
+	claims, duplicates, err := i.claimEventDedupeKeys(ctx, tenantId, eventOpts, payloads)
+
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	res := make([]*sqlcv1.Event, len(payloads))
+
+	immediate := make([]tasktypes.UserEventTaskPayload, 0, len(payloads))
+	immediateIdxs := make([]int, 0, len(payloads))
+	immediateClaims := make([]v1.ClaimIdempotencyKeysOpt, 0)
+
+	delayed := make([]v1.CreateDelayedEventOpts, 0)
+	delayedClaims := make([]v1.ClaimIdempotencyKeysOpt, 0)
+
+	validated := make([]tasktypes.UserEventTaskPayload, 0, len(payloads))
+
+	for idx, payload := range payloads {
+		claim, isClaimed := claims[idx]
+
+		// a duplicate returns the event which holds its dedupe key, and isn't ingested again
+		if existingId, ok := duplicates[idx]; ok {
+			payload.EventExternalId = existingId
+			res[idx] = payloadToEvent(tenantId, payload, now)
+			continue
+		}
+
+		if deliverAt := eventOpts[idx].DeliverAt; deliverAt != nil {
+			delayed = append(delayed, v1.CreateDelayedEventOpts{
+				ExternalId:         payload.EventExternalId,
+				Key:                payload.EventKey,
+				Data:               payload.EventData,
+				AdditionalMetadata: payload.EventAdditionalMetadata,
+				Priority:           payload.EventPriority,
+				Scope:              payload.EventScope,
+				DeliverAt:          *deliverAt,
+			})
+
+			validated = append(validated, payload)
+
+			if isClaimed {
+				delayedClaims = append(delayedClaims, claim)
+			}
+
+			res[idx] = payloadToEvent(tenantId, payload, now)
+			continue
+		}
+
+		validated = append(validated, payload)
+		immediate = append(immediate, payload)
+		immediateIdxs = append(immediateIdxs, idx)
+
+		if isClaimed {
+			immediateClaims = append(immediateClaims, claim)
+		}
+	}
+
+	// delayed events are validated against their schemas when they're pushed rather than when
+	// they're delivered. The whole batch is validated before any event is written, so that a
+	// rejected event rejects the whole batch.
+	if err := i.validateEventSchemas(ctx, tenantId, validated); err != nil {
+		i.releaseEventDedupeKeys(ctx, tenantId, append(delayedClaims, immediateClaims...))
+		return nil, err
+	}
+
+	if len(delayed) > 0 {
+		if err := i.repov1.DelayedEvents().CreateDelayedEvents(ctx, tenantId, delayed); err != nil {
+			i.releaseEventDedupeKeys(ctx, tenantId, append(delayedClaims, immediateClaims...))
+			return nil, fmt.Errorf("could not store delayed events: %w", err)
+		}
+	}
+
+	if len(immediate) > 0 {
+		events, err := i.ingest(ctx, tenant, immediate...)
+
+		if err != nil {
+			i.releaseEventDedupeKeys(ctx, tenantId, immediateClaims)
+			return nil, err
+		}
+
+		for j, event := range events {
+			res[immediateIdxs[j]] = event
+		}
+	}
+
+	return res, nil
+}
+
+// claimEventDedupeKeys claims the dedupe keys of the events which have one. It returns the claims
+// which were made, and the external id of the original event for each event which is a duplicate,
+// both by the index of the event.
+func (i *IngestorImpl) claimEventDedupeKeys(ctx context.Context, tenantId uuid.UUID, eventOpts []*CreateEventOpts, payloads []tasktypes.UserEventTaskPayload) (map[int]v1.ClaimIdempotencyKeysOpt, map[int]uuid.UUID, error) {
+	claims := make(map[int]v1.ClaimIdempotencyKeysOpt)
+	duplicates := make(map[int]uuid.UUID)
+
+	opts := make([]v1.ClaimIdempotencyKeysOpt, 0)
+	expiresAt := sqlchelpers.TimestamptzFromTime(time.Now().Add(i.eventDedupeKeyTTL))
+
+	for idx, event := range eventOpts {
+		if event.DedupeKey == nil {
+			continue
+		}
+
+		opt := v1.ClaimIdempotencyKeysOpt{
+			Key:                 eventDedupeKey(*event.DedupeKey),
+			ExpiresAt:           expiresAt,
+			ClaimedByExternalId: payloads[idx].EventExternalId,
+		}
+
+		claims[idx] = opt
+		opts = append(opts, opt)
+	}
+
+	if len(opts) == 0 {
+		return claims, duplicates, nil
+	}
+
+	holders, err := i.repov1.Idempotency().ClaimKeys(ctx, tenantId, opts)
+
+	if err != nil {
+		return nil, nil, fmt.Errorf("could not claim event dedupe keys: %w", err)
+	}
+
+	for idx, claim := range claims {
+		holder, ok := holders[v1.IdempotencyKey(claim.Key)]
+
+		if !ok {
+			return nil, nil, fmt.Errorf("event dedupe key %s was not claimed", claim.Key)
+		}
+
+		if holder != claim.ClaimedByExternalId {
+			duplicates[idx] = holder
+			delete(claims, idx)
+		}
+	}
+
+	return claims, duplicates, nil
+}
+
+// releaseEventDedupeKeys releases the dedupe keys of events which could not be ingested, so that
+// retries of the events aren't treated as duplicates of events which don't exist.
+func (i *IngestorImpl) releaseEventDedupeKeys(ctx context.Context, tenantId uuid.UUID, claims []v1.ClaimIdempotencyKeysOpt) {
+	if len(claims) == 0 {
+		return
+	}
+
+	if err := i.repov1.Idempotency().ReleaseKeys(ctx, tenantId, claims); err != nil {
+		i.l.Error().Ctx(ctx).Err(err).Msg("could not release event dedupe keys")
+	}
+}
+
+func eventDedupeKey(key string) string {
+	return fmt.Sprintf("hatchet_event_dedupe_%s", key)
 }
 
 func (i *IngestorImpl) ingestReplayedEventV1(ctx context.Context, tenant *sqlcv1.Tenant, replayedEvent *sqlcv1.Event) (*sqlcv1.Event, error) {
@@ -255,6 +405,10 @@ func (i *IngestorImpl) ingestReplayedEventV1(ctx context.Context, tenant *sqlcv1
 
 	opt := eventToPayload(tenantId, replayedEvent.Key, replayedEvent.Data, replayedEvent.AdditionalMetadata, nil, nil, nil)
 
+	if err := i.validateEventSchemas(ctx, tenantId, []tasktypes.UserEventTaskPayload{opt}); err != nil {
+		return nil, err
+	}
+
 	events, err := i.ingest(ctx, tenant, opt)
 
 	if err != nil {
@@ -286,6 +440,18 @@ func eventToPayload(tenantId uuid.UUID, key string, data, additionalMeta []byte,
 	}
 }
 
+func payloadToEvent(tenantId uuid.UUID, event tasktypes.UserEventTaskPayload, now time.Time) *sqlcv1.Event {
+	return &sqlcv1.Event{
+		ID:                 event.EventExternalId,
+		CreatedAt:          sqlchelpers.TimestampFromTime(now),
+		UpdatedAt:          sqlchelpers.TimestampFromTime(now),
+		Key:                event.EventKey,
+		TenantId:           tenantId,
+		Data:               event.EventData,
+		AdditionalMetadata: event.EventAdditionalMetadata,
+	}
+}
+
 func createWebhookValidationFailureMsg(tenantId uuid.UUID, webhookName, errorText string) (*msgqueue.Message, error) {
 	payloadTyped := tasktypes.FailedWebhookValidationPayload{
 		WebhookName: webhookName,
@@ -0,0 +1,47 @@
+package ingestor
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"golang.org/x/time/rate"
+)
+
+// logRateLimiter returns the log line limiter of a tenant. Limiters are kept per ingestor, so the
+// rate applies to each engine instance separately.
+func (i *IngestorImpl) logRateLimiter(tenantId uuid.UUID) *rate.Limiter {
+	if limiter, ok := i.logRateLimiters.Get(tenantId); ok {
+		return limiter
+	}
+
+	limiter := rate.NewLimiter(i.logRateLimit, i.logBurst)
+
+	if existing, ok, _ := i.logRateLimiters.PeekOrAdd(tenantId, limiter); ok {
+		return existing
+	}
+
+	return limiter
+}
+
+// waitForLogCapacity blocks until the tenant is allowed to write n more log lines, or the context
+// is done.
+func (i *IngestorImpl) waitForLogCapacity(ctx context.Context, tenantId uuid.UUID, n int) error {
+	if i.logRateLimit == rate.Inf {
+		return nil
+	}
+
+	limiter := i.logRateLimiter(tenantId)
+
+	// WaitN fails for more than the burst, so large batches wait in burst-sized chunks
+	for n > 0 {
+		chunk := min(n, limiter.Burst())
+
+		if err := limiter.WaitN(ctx, chunk); err != nil {
+			return err
+		}
+
+		n -= chunk
+	}
+
+	return nil
+}
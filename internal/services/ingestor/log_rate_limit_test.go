@@ -0,0 +1,59 @@
+package ingestor
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	lru "github.com/hashicorp/golang-lru/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/time/rate"
+)
+
+func newTestLogRateLimitIngestor(t *testing.T, linesPerSecond float64, burst int) *IngestorImpl {
+	t.Helper()
+
+	opts := defaultIngestorOpts()
+	WithLogIngestionRateLimit(linesPerSecond, burst)(opts)
+
+	limiters, err := lru.New[uuid.UUID, *rate.Limiter](10)
+	require.NoError(t, err)
+
+	return &IngestorImpl{
+		logRateLimiters: limiters,
+		logRateLimit:    opts.logRateLimit,
+		logBurst:        opts.logBurst,
+	}
+}
+
+func TestWaitForLogCapacity(t *testing.T) {
+	t.Run("batches larger than the burst wait in chunks", func(t *testing.T) {
+		i := newTestLogRateLimitIngestor(t, 1_000_000, 10)
+
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+
+		assert.NoError(t, i.waitForLogCapacity(ctx, uuid.New(), 95))
+	})
+
+	t.Run("tenants are limited separately", func(t *testing.T) {
+		i := newTestLogRateLimitIngestor(t, 1, 10)
+		tenantA, tenantB := uuid.New(), uuid.New()
+
+		require.NoError(t, i.waitForLogCapacity(context.Background(), tenantA, 10))
+
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+
+		assert.Error(t, i.waitForLogCapacity(ctx, tenantA, 10))
+		assert.NoError(t, i.waitForLogCapacity(ctx, tenantB, 10))
+	})
+
+	t.Run("a rate of 0 disables the limit", func(t *testing.T) {
+		i := newTestLogRateLimitIngestor(t, 0, 0)
+
+		assert.NoError(t, i.waitForLogCapacity(context.Background(), uuid.New(), 1_000_000))
+	})
+}
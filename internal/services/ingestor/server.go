@@ -2,6 +2,8 @@ package ingestor
 
 import (
 	"context"
+	"fmt"
+	"time"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -24,6 +26,8 @@ func (i *IngestorImpl) Push(ctx context.Context, req *contracts.PushEventRequest
 		"has_priority", req.Priority != nil,
 		"has_scope", req.Scope != nil,
 		"has_additional_meta", req.AdditionalMetadata != nil,
+		"has_dedupe_key", req.DedupeKey != nil,
+		"has_delivery_time", req.DeliverAt != nil || req.Delay != nil,
 	))
 
 	var additionalMeta []byte
@@ -46,7 +50,40 @@ func (i *IngestorImpl) Push(ctx context.Context, req *contracts.PushEventRequest
 		return nil, status.Errorf(codes.InvalidArgument, "priority must be between 1 and 3, got %d", *req.Priority)
 	}
 
-	event, err := i.IngestEvent(ctx, tenant, req.Key, []byte(req.Payload), additionalMeta, req.Priority, req.Scope, nil)
+	deliverAt, err := deliverAtFromRequest(req, time.Now().UTC())
+
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid request: %s", err)
+	}
+
+	var event *sqlcv1.Event
+
+	if req.DedupeKey == nil && deliverAt == nil {
+		event, err = i.IngestEvent(ctx, tenant, req.Key, []byte(req.Payload), additionalMeta, req.Priority, req.Scope, nil)
+	} else {
+		opt := &CreateEventOpts{
+			TenantId:           tenant.ID,
+			Key:                req.Key,
+			Data:               payloadBytes,
+			AdditionalMetadata: additionalMeta,
+			Priority:           req.Priority,
+			Scope:              req.Scope,
+			DedupeKey:          req.DedupeKey,
+			DeliverAt:          deliverAt,
+		}
+
+		if err := i.v.Validate(opt); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "Invalid request: %s", err)
+		}
+
+		var events []*sqlcv1.Event
+
+		events, err = i.BulkIngestEvent(ctx, tenant, []*CreateEventOpts{opt})
+
+		if err == nil {
+			event = events[0]
+		}
+	}
 
 	if err == v1.ErrResourceExhausted {
 		return nil, status.Errorf(codes.ResourceExhausted, "resource exhausted: event limit exceeded for tenant")
@@ -89,6 +126,8 @@ func (i *IngestorImpl) BulkPush(ctx context.Context, req *contracts.BulkPushEven
 			"has_priority", e.Priority != nil,
 			"has_scope", e.Scope != nil,
 			"has_additional_meta", e.AdditionalMetadata != nil,
+			"has_dedupe_key", e.DedupeKey != nil,
+			"has_delivery_time", e.DeliverAt != nil || e.Delay != nil,
 		))
 	}
 
@@ -104,6 +143,7 @@ func (i *IngestorImpl) BulkPush(ctx context.Context, req *contracts.BulkPushEven
 	}
 
 	events := make([]*CreateEventOpts, 0)
+	now := time.Now().UTC()
 
 	for _, e := range req.Events {
 		var additionalMeta []byte
@@ -125,6 +165,12 @@ func (i *IngestorImpl) BulkPush(ctx context.Context, req *contracts.BulkPushEven
 			return nil, status.Errorf(codes.InvalidArgument, "priority must be between 1 and 3, got %d", *e.Priority)
 		}
 
+		deliverAt, err := deliverAtFromRequest(e, now)
+
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "Invalid request: %s", err)
+		}
+
 		events = append(events, &CreateEventOpts{
 			TenantId:           tenantId,
 			Key:                e.Key,
@@ -132,6 +178,8 @@ func (i *IngestorImpl) BulkPush(ctx context.Context, req *contracts.BulkPushEven
 			AdditionalMetadata: additionalMeta,
 			Priority:           e.Priority,
 			Scope:              e.Scope,
+			DedupeKey:          e.DedupeKey,
+			DeliverAt:          deliverAt,
 		})
 	}
 
@@ -209,6 +257,12 @@ func (i *IngestorImpl) PutLog(ctx context.Context, req *contracts.PutLogRequest)
 	return i.putLogV1(ctx, tenant, req)
 }
 
+func (i *IngestorImpl) PutLogs(stream contracts.EventsService_PutLogsServer) error {
+	tenant := stream.Context().Value("tenant").(*sqlcv1.Tenant)
+	i.analytics.Count(stream.Context(), analytics.Log, analytics.Create)
+	return i.putLogsV1(tenant, stream)
+}
+
 func toEvent(e *sqlcv1.Event) (*contracts.Event, error) {
 	tenantId := e.TenantId.String()
 	eventId := e.ID.String()
@@ -230,6 +284,54 @@ func toEvent(e *sqlcv1.Event) (*contracts.Event, error) {
 	}, nil
 }
 
+// maxEventDelay is the furthest in the future an event can be delivered. Workflows which should run
+// further out can be scheduled instead.
+const maxEventDelay = 30 * 24 * time.Hour
+
+// deliverAtFromRequest returns when a pushed event should be delivered, or nil if it should be
+// delivered immediately.
+func deliverAtFromRequest(req *contracts.PushEventRequest, now time.Time) (*time.Time, error) {
+	if req.DeliverAt != nil && req.Delay != nil {
+		return nil, fmt.Errorf("deliver_at and delay cannot both be set")
+	}
+
+	var deliverAt time.Time
+
+	switch {
+	case req.DeliverAt != nil:
+		if err := req.DeliverAt.CheckValid(); err != nil {
+			return nil, fmt.Errorf("invalid deliver_at: %w", err)
+		}
+
+		deliverAt = req.DeliverAt.AsTime()
+	case req.Delay != nil:
+		delay, err := time.ParseDuration(*req.Delay)
+
+		if err != nil {
+			return nil, fmt.Errorf("invalid delay: %w", err)
+		}
+
+		if delay < 0 {
+			return nil, fmt.Errorf("delay cannot be negative, got %s", *req.Delay)
+		}
+
+		deliverAt = now.Add(delay)
+	default:
+		return nil, nil
+	}
+
+	if deliverAt.After(now.Add(maxEventDelay)) {
+		return nil, fmt.Errorf("events can be delivered at most %d days in the future", int(maxEventDelay.Hours()/24))
+	}
+
+	// events which are already due are delivered immediately
+	if !deliverAt.After(now) {
+		return nil, nil
+	}
+
+	return &deliverAt, nil
+}
+
 type BulkCreateEventOpts struct {
 	TenantId uuid.UUID `validate:"required"`
 	Events   []*CreateEventOpts
@@ -244,4 +346,10 @@ type CreateEventOpts struct {
 	Key                   string     `validate:"required"`
 	Data                  []byte
 	AdditionalMetadata    []byte
+
+	// DedupeKey deduplicates the event against other events with the same key, while the key is held.
+	DedupeKey *string `validate:"omitnil,min=1,max=512"`
+
+	// DeliverAt holds the event until the given time. A nil DeliverAt delivers the event immediately.
+	DeliverAt *time.Time
 }
@@ -0,0 +1,66 @@
+package ingestor
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/hatchet-dev/hatchet/internal/services/ingestor/contracts"
+)
+
+func TestDeliverAtFromRequest(t *testing.T) {
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	strPtr := func(s string) *string { return &s }
+
+	t.Run("no delivery time delivers immediately", func(t *testing.T) {
+		deliverAt, err := deliverAtFromRequest(&contracts.PushEventRequest{}, now)
+
+		require.NoError(t, err)
+		assert.Nil(t, deliverAt)
+	})
+
+	t.Run("deliver_at in the future", func(t *testing.T) {
+		deliverAt, err := deliverAtFromRequest(&contracts.PushEventRequest{
+			DeliverAt: timestamppb.New(now.Add(time.Hour)),
+		}, now)
+
+		require.NoError(t, err)
+		require.NotNil(t, deliverAt)
+		assert.Equal(t, now.Add(time.Hour), *deliverAt)
+	})
+
+	t.Run("delay is relative to now", func(t *testing.T) {
+		deliverAt, err := deliverAtFromRequest(&contracts.PushEventRequest{
+			Delay: strPtr("90s"),
+		}, now)
+
+		require.NoError(t, err)
+		require.NotNil(t, deliverAt)
+		assert.Equal(t, now.Add(90*time.Second), *deliverAt)
+	})
+
+	t.Run("deliver_at in the past delivers immediately", func(t *testing.T) {
+		deliverAt, err := deliverAtFromRequest(&contracts.PushEventRequest{
+			DeliverAt: timestamppb.New(now.Add(-time.Minute)),
+		}, now)
+
+		require.NoError(t, err)
+		assert.Nil(t, deliverAt)
+	})
+
+	t.Run("invalid requests", func(t *testing.T) {
+		for name, req := range map[string]*contracts.PushEventRequest{
+			"both deliver_at and delay": {DeliverAt: timestamppb.New(now.Add(time.Hour)), Delay: strPtr("1h")},
+			"unparseable delay":         {Delay: strPtr("soon")},
+			"negative delay":            {Delay: strPtr("-1m")},
+			"beyond the maximum delay":  {DeliverAt: timestamppb.New(now.Add(maxEventDelay + time.Hour))},
+		} {
+			_, err := deliverAtFromRequest(req, now)
+			assert.Error(t, err, name)
+		}
+	})
+}
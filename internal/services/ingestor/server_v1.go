@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"io"
 	"time"
 
 	"github.com/google/uuid"
@@ -69,9 +70,8 @@ func (i *IngestorImpl) putStreamEventV1(ctx context.Context, tenant *sqlcv1.Tena
 
 func (i *IngestorImpl) putLogV1(ctx context.Context, tenant *sqlcv1.Tenant, req *contracts.PutLogRequest) (*contracts.PutLogResponse, error) {
 	tenantId := tenant.ID
-	taskExternalId, err := uuid.Parse(req.TaskRunExternalId)
 
-	if err != nil {
+	if _, err := uuid.Parse(req.TaskRunExternalId); err != nil {
 		return nil, status.Error(codes.InvalidArgument, "task external run id is not a valid uuid")
 	}
 
@@ -79,6 +79,170 @@ func (i *IngestorImpl) putLogV1(ctx context.Context, tenant *sqlcv1.Tenant, req
 		return &contracts.PutLogResponse{}, nil
 	}
 
+	task, err := i.getLogTask(ctx, tenantId, req.TaskRunExternalId)
+
+	if err != nil {
+		return nil, err
+	}
+
+	workerId, err := i.getLogWorkerId(ctx, tenantId, req.WorkerId)
+
+	if err != nil {
+		return nil, err
+	}
+
+	opts, err := i.newLogLineOpts(task, req, workerId)
+
+	if err != nil {
+		return nil, err
+	}
+
+	err = i.repov1.Logs().PutLog(ctx, tenantId, opts)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &contracts.PutLogResponse{}, nil
+}
+
+// the maximum number of log lines in a single PutLogs batch
+const maxLogBatchSize = 1000
+
+func (i *IngestorImpl) putLogsV1(tenant *sqlcv1.Tenant, stream contracts.EventsService_PutLogsServer) error {
+	ctx := stream.Context()
+
+	for {
+		req, err := stream.Recv()
+
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if len(req.Logs) > maxLogBatchSize {
+			return status.Errorf(codes.InvalidArgument, "batch has %d log lines, the maximum is %d", len(req.Logs), maxLogBatchSize)
+		}
+
+		rejected := 0
+
+		if i.isLogIngestionEnabled {
+			// the stream isn't read while the tenant is over its log rate, which pushes back on the
+			// worker through flow control
+			if err := i.waitForLogCapacity(ctx, tenant.ID, len(req.Logs)); err != nil {
+				return err
+			}
+
+			rejected, err = i.putLogBatch(ctx, tenant.ID, req.Logs)
+
+			if err != nil {
+				return err
+			}
+		}
+
+		err = stream.Send(&contracts.PutLogsResponse{
+			Sequence:      req.Sequence,
+			RejectedCount: int32(rejected), // nolint: gosec
+		})
+
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// putLogBatch writes the valid lines of a batch in bulk. Lines which are invalid or whose task run
+// doesn't exist are dropped, so they don't fail the lines around them, and their count is returned.
+func (i *IngestorImpl) putLogBatch(ctx context.Context, tenantId uuid.UUID, logs []*contracts.PutLogRequest) (int, error) {
+	tasks := make(map[string]*sqlcv1.FlattenExternalIdsRow)
+	workers := make(map[string]*uuid.UUID)
+	opts := make([]*v1.CreateLogLineOpts, 0, len(logs))
+	rejected := 0
+
+	for _, req := range logs {
+		task, ok := tasks[req.TaskRunExternalId]
+
+		if !ok {
+			var err error
+
+			task, err = i.getLogTask(ctx, tenantId, req.TaskRunExternalId)
+
+			if err != nil {
+				if c := status.Code(err); c != codes.NotFound && c != codes.InvalidArgument {
+					return 0, err
+				}
+
+				task = nil
+			}
+
+			tasks[req.TaskRunExternalId] = task
+		}
+
+		if task == nil {
+			rejected++
+			continue
+		}
+
+		var workerId *uuid.UUID
+
+		if req.WorkerId != nil {
+			var ok bool
+
+			workerId, ok = workers[*req.WorkerId]
+
+			if !ok {
+				var err error
+
+				workerId, err = i.getLogWorkerId(ctx, tenantId, req.WorkerId)
+
+				if err != nil {
+					if c := status.Code(err); c != codes.NotFound && c != codes.InvalidArgument {
+						return 0, err
+					}
+
+					workerId = nil
+				}
+
+				workers[*req.WorkerId] = workerId
+			}
+
+			if workerId == nil {
+				rejected++
+				continue
+			}
+		}
+
+		opt, err := i.newLogLineOpts(task, req, workerId)
+
+		if err != nil {
+			rejected++
+			continue
+		}
+
+		opts = append(opts, opt)
+	}
+
+	if rejected > 0 {
+		i.l.Warn().Ctx(ctx).Msgf("dropped %d invalid log lines for tenant %s", rejected, tenantId)
+	}
+
+	if err := i.repov1.Logs().PutLogs(ctx, tenantId, opts); err != nil {
+		return 0, err
+	}
+
+	return rejected, nil
+}
+
+func (i *IngestorImpl) getLogTask(ctx context.Context, tenantId uuid.UUID, taskRunExternalId string) (*sqlcv1.FlattenExternalIdsRow, error) {
+	taskExternalId, err := uuid.Parse(taskRunExternalId)
+
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "task external run id is not a valid uuid")
+	}
+
 	task, err := i.repov1.Tasks().GetTaskByExternalId(ctx, tenantId, taskExternalId, false)
 
 	if err != nil {
@@ -89,6 +253,52 @@ func (i *IngestorImpl) putLogV1(ctx context.Context, tenant *sqlcv1.Tenant, req
 		return nil, err
 	}
 
+	return task, nil
+}
+
+// logWorkerCacheTTL is how long a worker is remembered as belonging to a tenant once a log line
+// from it was checked. Workers never move between tenants, so this only bounds the cache.
+const logWorkerCacheTTL = 5 * time.Minute
+
+type logWorkerKey struct {
+	tenantId uuid.UUID
+	workerId uuid.UUID
+}
+
+// getLogWorkerId returns the id of the worker a log line says it was written by, after checking that
+// the worker belongs to the tenant, so a line can't be attributed to another tenant's worker. Workers
+// which were found are cached, so the check doesn't cost a query per line.
+func (i *IngestorImpl) getLogWorkerId(ctx context.Context, tenantId uuid.UUID, workerId *string) (*uuid.UUID, error) {
+	if workerId == nil {
+		return nil, nil
+	}
+
+	id, err := uuid.Parse(*workerId)
+
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "worker id is not a valid uuid")
+	}
+
+	key := logWorkerKey{tenantId: tenantId, workerId: id}
+
+	if _, ok := i.logWorkerCache.Get(key); ok {
+		return &id, nil
+	}
+
+	if _, err := i.repov1.Workers().GetWorkerForEngine(ctx, tenantId, id); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, status.Errorf(codes.NotFound, "worker not found: %s", id)
+		}
+
+		return nil, err
+	}
+
+	i.logWorkerCache.Add(key, struct{}{})
+
+	return &id, nil
+}
+
+func (i *IngestorImpl) newLogLineOpts(task *sqlcv1.FlattenExternalIdsRow, req *contracts.PutLogRequest, workerId *uuid.UUID) (*v1.CreateLogLineOpts, error) {
 	var createdAt *time.Time
 
 	if t := req.CreatedAt.AsTime(); !t.IsZero() {
@@ -105,6 +315,7 @@ func (i *IngestorImpl) putLogV1(ctx context.Context, tenant *sqlcv1.Tenant, req
 		}
 
 		// Re-marshal to ensure consistent formatting
+		var err error
 		metadata, err = json.Marshal(metadataMap)
 		if err != nil {
 			return nil, status.Errorf(codes.Internal, "Failed to marshal metadata: %v", err)
@@ -130,6 +341,7 @@ func (i *IngestorImpl) putLogV1(ctx context.Context, tenant *sqlcv1.Tenant, req
 		RetryCount:     retryCount,
 		WorkflowId:     task.WorkflowID,
 		StepId:         task.StepID,
+		WorkerId:       workerId,
 	}
 
 	if apiErrors, err := i.v.ValidateAPI(opts); err != nil {
@@ -142,11 +354,5 @@ func (i *IngestorImpl) putLogV1(ctx context.Context, tenant *sqlcv1.Tenant, req
 		return nil, status.Errorf(codes.InvalidArgument, "Invalid request: %s", err)
 	}
 
-	err = i.repov1.Logs().PutLog(ctx, tenantId, opts)
-
-	if err != nil {
-		return nil, err
-	}
-
-	return &contracts.PutLogResponse{}, nil
+	return opts, nil
 }
@@ -0,0 +1,256 @@
+package scaler
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"math"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/sqlcv1"
+	schedulingv1 "github.com/hatchet-dev/hatchet/pkg/scheduling/v1"
+)
+
+// The scaler metadata keys of a KEDA trigger which describe a worker pool.
+const (
+	metadataToken           = "token"
+	metadataActions         = "actions"
+	metadataLabelSelector   = "labelSelector"
+	metadataSlotsPerReplica = "slotsPerReplica"
+	metadataMaxQueueAge     = "maxQueueAge"
+)
+
+// pool is the worker pool a KEDA trigger scales, selected by the actions its workers register and
+// the labels they carry.
+type pool struct {
+	actions []string
+
+	// selector holds the labels of the pool's workers, in the form the scheduler matches desired
+	// labels against
+	selector []*sqlcv1.ListManyWorkerLabelsRow
+
+	// targets set on the trigger, which take precedence over the ones workers registered with
+	slotsPerReplica *int32
+	maxQueueAge     *time.Duration
+}
+
+func parsePool(metadata map[string]string) (*pool, error) {
+	p := &pool{}
+
+	for _, action := range strings.Split(metadata[metadataActions], ",") {
+		if action = strings.TrimSpace(action); action != "" {
+			p.actions = append(p.actions, action)
+		}
+	}
+
+	slices.Sort(p.actions)
+	p.actions = slices.Compact(p.actions)
+
+	for _, pair := range strings.Split(metadata[metadataLabelSelector], ",") {
+		if pair = strings.TrimSpace(pair); pair == "" {
+			continue
+		}
+
+		key, value, ok := strings.Cut(pair, "=")
+		key = strings.TrimSpace(key)
+
+		if !ok || key == "" {
+			return nil, fmt.Errorf("invalid %s %q, expected key=value pairs", metadataLabelSelector, pair)
+		}
+
+		label := &sqlcv1.ListManyWorkerLabelsRow{Key: key}
+		label.StrValue.String, label.StrValue.Valid = strings.TrimSpace(value), true
+
+		if i, err := strconv.ParseInt(label.StrValue.String, 10, 32); err == nil {
+			label.IntValue.Int32, label.IntValue.Valid = int32(i), true
+		}
+
+		p.selector = append(p.selector, label)
+	}
+
+	slices.SortFunc(p.selector, func(a, b *sqlcv1.ListManyWorkerLabelsRow) int {
+		return strings.Compare(a.Key, b.Key)
+	})
+
+	if len(p.actions) == 0 && len(p.selector) == 0 {
+		return nil, fmt.Errorf("one of %s or %s is required", metadataActions, metadataLabelSelector)
+	}
+
+	if v, ok := metadata[metadataSlotsPerReplica]; ok && v != "" {
+		slotsPerReplica, err := strconv.ParseInt(v, 10, 32)
+
+		if err != nil || slotsPerReplica <= 0 {
+			return nil, fmt.Errorf("invalid %s %q, expected a positive integer", metadataSlotsPerReplica, v)
+		}
+
+		s := int32(slotsPerReplica)
+		p.slotsPerReplica = &s
+	}
+
+	if v, ok := metadata[metadataMaxQueueAge]; ok && v != "" {
+		maxQueueAge, err := time.ParseDuration(v)
+
+		if err != nil || maxQueueAge <= 0 {
+			return nil, fmt.Errorf("invalid %s %q, expected a positive duration", metadataMaxQueueAge, v)
+		}
+
+		p.maxQueueAge = &maxQueueAge
+	}
+
+	return p, nil
+}
+
+// metricName returns a stable metric name for the pool, so that different pools scaled by the
+// same ScaledObject report distinct metrics.
+func (p *pool) metricName() string {
+	h := sha256.New()
+
+	for _, action := range p.actions {
+		h.Write([]byte(action))
+		h.Write([]byte(";"))
+	}
+
+	h.Write([]byte("|"))
+
+	for _, label := range p.selector {
+		h.Write([]byte(label.Key + "=" + label.StrValue.String))
+		h.Write([]byte(";"))
+	}
+
+	return "hatchet-worker-pool-" + hex.EncodeToString(h.Sum(nil))[:12]
+}
+
+// matchesWorker returns true if the worker belongs to the pool: it registers one of the pool's
+// actions and carries all of the selector's labels.
+func (p *pool) matchesWorker(w *repository.ScalerWorker) bool {
+	if len(p.actions) > 0 && !slices.ContainsFunc(w.Actions, p.hasAction) {
+		return false
+	}
+
+	for _, selected := range p.selector {
+		found := slices.ContainsFunc(w.Labels, func(label *sqlcv1.ListManyWorkerLabelsRow) bool {
+			if label.Key != selected.Key {
+				return false
+			}
+
+			if label.IntValue.Valid {
+				return selected.IntValue.Valid && label.IntValue.Int32 == selected.IntValue.Int32
+			}
+
+			return label.StrValue.Valid && label.StrValue.String == selected.StrValue.String
+		})
+
+		if !found {
+			return false
+		}
+	}
+
+	return true
+}
+
+// matchesTask returns true if the queued tasks should be served by the pool. With actions, tasks
+// for those actions match as long as the selector's labels satisfy their required labels. With a
+// selector alone, only tasks which require worker labels, all satisfied by the selector, match.
+func (p *pool) matchesTask(s *repository.ScalerQueueStat) bool {
+	if len(p.actions) > 0 {
+		if !p.hasAction(s.ActionID) {
+			return false
+		}
+
+		return len(p.selector) == 0 || schedulingv1.WorkerLabelsSatisfy(p.selector, s.DesiredLabels)
+	}
+
+	requiresLabels := slices.ContainsFunc(s.DesiredLabels, func(label *sqlcv1.GetDesiredLabelsRow) bool {
+		return label.Required
+	})
+
+	return requiresLabels && schedulingv1.WorkerLabelsSatisfy(p.selector, s.DesiredLabels)
+}
+
+func (p *pool) hasAction(action string) bool {
+	_, found := slices.BinarySearch(p.actions, action)
+	return found
+}
+
+// desiredReplicas computes the number of replicas the pool needs to serve its queued and running
+// tasks. The slots the pool needs are its queued slot units plus the slots in use on its workers,
+// divided by the slots per replica. If a queued task has waited longer than the pool's max queue
+// age or is close to its schedule timeout, the pool is asked to grow by at least one replica.
+func (p *pool) desiredReplicas(workers []*repository.ScalerWorker, stats []*repository.ScalerQueueStat, now time.Time, defaultSlotsPerReplica int32) int64 {
+	var (
+		poolWorkers               int64
+		totalSlots, usedSlots     int64
+		registeredSlotsPerReplica *int32
+		registeredMaxQueueAge     *time.Duration
+		nearScheduleTimeout       int64
+		oldestTaskInsertedAt      time.Time
+	)
+
+	for _, w := range workers {
+		if !p.matchesWorker(w) {
+			continue
+		}
+
+		poolWorkers++
+		totalSlots += int64(w.Slots)
+		usedSlots += int64(w.UsedSlots)
+
+		if w.SlotsPerReplica != nil && (registeredSlotsPerReplica == nil || *w.SlotsPerReplica > *registeredSlotsPerReplica) {
+			registeredSlotsPerReplica = w.SlotsPerReplica
+		}
+
+		if w.MaxQueueAge != nil && (registeredMaxQueueAge == nil || *w.MaxQueueAge < *registeredMaxQueueAge) {
+			registeredMaxQueueAge = w.MaxQueueAge
+		}
+	}
+
+	demand := usedSlots
+
+	for _, s := range stats {
+		if !p.matchesTask(s) {
+			continue
+		}
+
+		demand += int64(s.QueuedUnits)
+		nearScheduleTimeout += int64(s.NearScheduleTimeout)
+
+		if oldestTaskInsertedAt.IsZero() || s.OldestTaskInsertedAt.Before(oldestTaskInsertedAt) {
+			oldestTaskInsertedAt = s.OldestTaskInsertedAt
+		}
+	}
+
+	var slotsPerReplica int64
+
+	switch {
+	case p.slotsPerReplica != nil:
+		slotsPerReplica = int64(*p.slotsPerReplica)
+	case registeredSlotsPerReplica != nil:
+		slotsPerReplica = int64(*registeredSlotsPerReplica)
+	case poolWorkers > 0 && totalSlots > 0:
+		slotsPerReplica = totalSlots / poolWorkers
+	default:
+		slotsPerReplica = int64(defaultSlotsPerReplica)
+	}
+
+	slotsPerReplica = max(slotsPerReplica, 1)
+
+	desired := int64(math.Ceil(float64(demand) / float64(slotsPerReplica)))
+
+	maxQueueAge := registeredMaxQueueAge
+
+	if p.maxQueueAge != nil {
+		maxQueueAge = p.maxQueueAge
+	}
+
+	queueAgeExceeded := maxQueueAge != nil && !oldestTaskInsertedAt.IsZero() && now.Sub(oldestTaskInsertedAt) > *maxQueueAge
+
+	if queueAgeExceeded || nearScheduleTimeout > 0 {
+		desired = max(desired, poolWorkers+1)
+	}
+
+	return desired
+}
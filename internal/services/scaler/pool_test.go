@@ -0,0 +1,151 @@
+package scaler
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/sqlcv1"
+)
+
+func strLabel(key, value string) *sqlcv1.ListManyWorkerLabelsRow {
+	return &sqlcv1.ListManyWorkerLabelsRow{Key: key, StrValue: pgtype.Text{String: value, Valid: true}}
+}
+
+func requiredLabel(key, value string) *sqlcv1.GetDesiredLabelsRow {
+	return &sqlcv1.GetDesiredLabelsRow{
+		Key:        key,
+		StrValue:   pgtype.Text{String: value, Valid: true},
+		Required:   true,
+		Comparator: sqlcv1.WorkerLabelComparatorEQUAL,
+	}
+}
+
+func TestParsePool(t *testing.T) {
+	p, err := parsePool(map[string]string{
+		metadataActions:         "b:step, a:step,,b:step",
+		metadataLabelSelector:   "gpu=a100, tier = 2",
+		metadataSlotsPerReplica: "10",
+		metadataMaxQueueAge:     "30s",
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"a:step", "b:step"}, p.actions)
+	require.Len(t, p.selector, 2)
+	assert.Equal(t, "gpu", p.selector[0].Key)
+	assert.False(t, p.selector[0].IntValue.Valid)
+	assert.Equal(t, "tier", p.selector[1].Key)
+	assert.Equal(t, int32(2), p.selector[1].IntValue.Int32)
+	assert.Equal(t, int32(10), *p.slotsPerReplica)
+	assert.Equal(t, 30*time.Second, *p.maxQueueAge)
+
+	reordered, err := parsePool(map[string]string{
+		metadataActions:       "a:step,b:step",
+		metadataLabelSelector: "tier=2,gpu=a100",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, p.metricName(), reordered.metricName())
+
+	for _, metadata := range []map[string]string{
+		{},
+		{metadataLabelSelector: "gpu"},
+		{metadataActions: "a:step", metadataSlotsPerReplica: "0"},
+		{metadataActions: "a:step", metadataMaxQueueAge: "soon"},
+	} {
+		_, err := parsePool(metadata)
+		assert.Error(t, err, metadata)
+	}
+}
+
+func TestDesiredReplicas(t *testing.T) {
+	now := time.Now()
+	slotsPerReplica := int32(4)
+	maxQueueAge := time.Minute
+
+	workers := []*repository.ScalerWorker{
+		{ID: uuid.New(), Actions: []string{"a:step"}, Slots: 4, UsedSlots: 3},
+		{ID: uuid.New(), Actions: []string{"a:step"}, Slots: 4, UsedSlots: 4, SlotsPerReplica: &slotsPerReplica, MaxQueueAge: &maxQueueAge},
+		{ID: uuid.New(), Actions: []string{"b:step"}, Slots: 100, UsedSlots: 100},
+		{ID: uuid.New(), Actions: []string{"c:step"}, Labels: []*sqlcv1.ListManyWorkerLabelsRow{strLabel("gpu", "a100")}, Slots: 2, UsedSlots: 1},
+	}
+
+	stats := []*repository.ScalerQueueStat{
+		{ActionID: "a:step", Queued: 5, QueuedUnits: 5, OldestTaskInsertedAt: now.Add(-10 * time.Second)},
+		{ActionID: "b:step", Queued: 50, QueuedUnits: 50, OldestTaskInsertedAt: now.Add(-time.Hour)},
+		{ActionID: "c:step", Queued: 3, QueuedUnits: 6, DesiredLabels: []*sqlcv1.GetDesiredLabelsRow{requiredLabel("gpu", "a100")}, OldestTaskInsertedAt: now},
+		{ActionID: "c:step", Queued: 7, QueuedUnits: 7, DesiredLabels: []*sqlcv1.GetDesiredLabelsRow{requiredLabel("gpu", "h100")}, OldestTaskInsertedAt: now},
+	}
+
+	tests := []struct {
+		name     string
+		metadata map[string]string
+		stats    []*repository.ScalerQueueStat
+		expected int64
+	}{
+		{
+			// (7 used + 5 queued) / 4 registered slots per replica
+			name:     "registered slots per replica",
+			metadata: map[string]string{metadataActions: "a:step"},
+			expected: 3,
+		},
+		{
+			name:     "trigger slots per replica",
+			metadata: map[string]string{metadataActions: "a:step", metadataSlotsPerReplica: "12"},
+			expected: 1,
+		},
+		{
+			name:     "registered max queue age",
+			metadata: map[string]string{metadataActions: "a:step", metadataSlotsPerReplica: "12", metadataMaxQueueAge: "5s"},
+			expected: 3,
+		},
+		{
+			// (100 used + 50 queued) / 100 average slots, but the oldest task is past the max age
+			name:     "average slots per replica",
+			metadata: map[string]string{metadataActions: "b:step", metadataMaxQueueAge: "1m"},
+			expected: 2,
+		},
+		{
+			// only the tasks which require the selected labels count
+			name:     "label selector",
+			metadata: map[string]string{metadataLabelSelector: "gpu=a100"},
+			expected: 4,
+		},
+		{
+			name:     "no workers uses the default",
+			metadata: map[string]string{metadataActions: "d:step"},
+			stats:    []*repository.ScalerQueueStat{{ActionID: "d:step", Queued: 150, QueuedUnits: 150, OldestTaskInsertedAt: now}},
+			expected: 2,
+		},
+		{
+			name:     "schedule timeout pressure",
+			metadata: map[string]string{metadataActions: "d:step"},
+			stats:    []*repository.ScalerQueueStat{{ActionID: "d:step", Queued: 1, QueuedUnits: 1, NearScheduleTimeout: 1, OldestTaskInsertedAt: now}},
+			expected: 1,
+		},
+		{
+			name:     "idle",
+			metadata: map[string]string{metadataActions: "d:step"},
+			expected: 0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, err := parsePool(tt.metadata)
+			require.NoError(t, err)
+
+			s := stats
+
+			if tt.stats != nil {
+				s = tt.stats
+			}
+
+			assert.Equal(t, tt.expected, p.desiredReplicas(workers, s, now, 100))
+		})
+	}
+}
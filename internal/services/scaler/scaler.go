@@ -0,0 +1,281 @@
+package scaler
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/grpc-ecosystem/go-grpc-middleware/v2/interceptors/auth"
+	"github.com/rs/zerolog"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/hatchet-dev/hatchet/internal/services/scaler/contracts"
+	"github.com/hatchet-dev/hatchet/pkg/auth/token"
+	"github.com/hatchet-dev/hatchet/pkg/clock"
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+)
+
+// Scaler is a KEDA external scaler which reports the number of replicas a worker pool needs.
+type Scaler interface {
+	contracts.ExternalScalerServer
+}
+
+type ScalerOpt func(*ScalerOpts)
+
+type ScalerOpts struct {
+	repo                   repository.Repository
+	jwtManager             token.JWTManager
+	l                      *zerolog.Logger
+	pollInterval           time.Duration
+	scheduleTimeoutWindow  time.Duration
+	defaultSlotsPerReplica int32
+}
+
+func defaultScalerOpts() *ScalerOpts {
+	return &ScalerOpts{
+		pollInterval:           15 * time.Second,
+		scheduleTimeoutWindow:  time.Minute,
+		defaultSlotsPerReplica: 100,
+	}
+}
+
+func WithRepository(r repository.Repository) ScalerOpt {
+	return func(opts *ScalerOpts) {
+		opts.repo = r
+	}
+}
+
+func WithJWTManager(m token.JWTManager) ScalerOpt {
+	return func(opts *ScalerOpts) {
+		opts.jwtManager = m
+	}
+}
+
+func WithLogger(l *zerolog.Logger) ScalerOpt {
+	return func(opts *ScalerOpts) {
+		opts.l = l
+	}
+}
+
+// WithPollInterval sets how often StreamIsActive re-evaluates a pool.
+func WithPollInterval(d time.Duration) ScalerOpt {
+	return func(opts *ScalerOpts) {
+		opts.pollInterval = d
+	}
+}
+
+// WithScheduleTimeoutWindow sets how close to its schedule timeout a queued task must be to put
+// pressure on its pool.
+func WithScheduleTimeoutWindow(d time.Duration) ScalerOpt {
+	return func(opts *ScalerOpts) {
+		opts.scheduleTimeoutWindow = d
+	}
+}
+
+// WithDefaultSlotsPerReplica sets the slots per replica used for pools which don't set one and
+// have no active workers.
+func WithDefaultSlotsPerReplica(n int32) ScalerOpt {
+	return func(opts *ScalerOpts) {
+		opts.defaultSlotsPerReplica = n
+	}
+}
+
+type scalerImpl struct {
+	contracts.UnimplementedExternalScalerServer
+
+	repo                   repository.Repository
+	jwtManager             token.JWTManager
+	l                      *zerolog.Logger
+	pollInterval           time.Duration
+	scheduleTimeoutWindow  time.Duration
+	defaultSlotsPerReplica int32
+}
+
+func New(fs ...ScalerOpt) (Scaler, error) {
+	opts := defaultScalerOpts()
+
+	for _, f := range fs {
+		f(opts)
+	}
+
+	if opts.repo == nil {
+		return nil, fmt.Errorf("repository is required. use WithRepository")
+	}
+
+	if opts.jwtManager == nil {
+		return nil, fmt.Errorf("jwt manager is required. use WithJWTManager")
+	}
+
+	if opts.l == nil {
+		return nil, fmt.Errorf("logger is required. use WithLogger")
+	}
+
+	if opts.pollInterval <= 0 {
+		return nil, fmt.Errorf("poll interval must be positive")
+	}
+
+	if opts.defaultSlotsPerReplica <= 0 {
+		return nil, fmt.Errorf("default slots per replica must be positive")
+	}
+
+	newLogger := opts.l.With().Str("service", "worker-scaler").Logger()
+
+	return &scalerImpl{
+		repo:                   opts.repo,
+		jwtManager:             opts.jwtManager,
+		l:                      &newLogger,
+		pollInterval:           opts.pollInterval,
+		scheduleTimeoutWindow:  opts.scheduleTimeoutWindow,
+		defaultSlotsPerReplica: opts.defaultSlotsPerReplica,
+	}, nil
+}
+
+// AuthFuncOverride skips the engine's bearer token authentication for the scaler. KEDA can't set
+// headers on external scaler calls, so each call is authenticated with the token in its scaler
+// metadata instead.
+func (s *scalerImpl) AuthFuncOverride(ctx context.Context, fullMethodName string) (context.Context, error) {
+	return ctx, nil
+}
+
+func (s *scalerImpl) IsActive(ctx context.Context, ref *contracts.ScaledObjectRef) (*contracts.IsActiveResponse, error) {
+	desired, _, err := s.desiredReplicas(ctx, ref)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &contracts.IsActiveResponse{
+		Result: desired > 0,
+	}, nil
+}
+
+func (s *scalerImpl) StreamIsActive(ref *contracts.ScaledObjectRef, stream contracts.ExternalScaler_StreamIsActiveServer) error {
+	ctx := stream.Context()
+
+	ticker := time.NewTicker(s.pollInterval)
+	defer ticker.Stop()
+
+	for {
+		res, err := s.IsActive(ctx, ref)
+
+		if err != nil {
+			return err
+		}
+
+		if err := stream.Send(res); err != nil {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s *scalerImpl) GetMetricSpec(ctx context.Context, ref *contracts.ScaledObjectRef) (*contracts.GetMetricSpecResponse, error) {
+	p, err := parsePool(ref.GetScalerMetadata())
+
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	// the metric is the desired number of replicas, so a target of one replica per unit
+	return &contracts.GetMetricSpecResponse{
+		MetricSpecs: []*contracts.MetricSpec{
+			{
+				MetricName:      p.metricName(),
+				TargetSize:      1,
+				TargetSizeFloat: 1,
+			},
+		},
+	}, nil
+}
+
+func (s *scalerImpl) GetMetrics(ctx context.Context, req *contracts.GetMetricsRequest) (*contracts.GetMetricsResponse, error) {
+	desired, p, err := s.desiredReplicas(ctx, req.GetScaledObjectRef())
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &contracts.GetMetricsResponse{
+		MetricValues: []*contracts.MetricValue{
+			{
+				MetricName:       p.metricName(),
+				MetricValue:      desired,
+				MetricValueFloat: float64(desired),
+			},
+		},
+	}, nil
+}
+
+func (s *scalerImpl) desiredReplicas(ctx context.Context, ref *contracts.ScaledObjectRef) (int64, *pool, error) {
+	metadata := ref.GetScalerMetadata()
+
+	p, err := parsePool(metadata)
+
+	if err != nil {
+		return 0, nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	tenantId, err := s.authenticate(ctx, metadata[metadataToken])
+
+	if err != nil {
+		return 0, nil, err
+	}
+
+	workers, err := s.repo.Workers().ListScalerWorkers(ctx, tenantId)
+
+	if err != nil {
+		s.l.Error().Ctx(ctx).Err(err).Str("scaled_object", ref.GetNamespace()+"/"+ref.GetName()).Msg("could not list workers")
+		return 0, nil, status.Error(codes.Internal, "could not list workers")
+	}
+
+	now := clock.Now()
+
+	stats, err := s.repo.Workers().ListScalerQueueStats(ctx, tenantId, repository.ListScalerQueueStatsOpts{
+		Actions:               p.actions,
+		ScheduleTimeoutBefore: now.Add(s.scheduleTimeoutWindow),
+	})
+
+	if err != nil {
+		s.l.Error().Ctx(ctx).Err(err).Str("scaled_object", ref.GetNamespace()+"/"+ref.GetName()).Msg("could not list queue stats")
+		return 0, nil, status.Error(codes.Internal, "could not list queue stats")
+	}
+
+	return p.desiredReplicas(workers, stats, now, s.defaultSlotsPerReplica), p, nil
+}
+
+// authenticate returns the tenant of the API token in the scaler metadata, falling back to a
+// bearer token on the call.
+func (s *scalerImpl) authenticate(ctx context.Context, tok string) (uuid.UUID, error) {
+	unauthenticated := status.Errorf(codes.Unauthenticated, "invalid auth token")
+
+	if tok == "" {
+		var err error
+
+		tok, err = auth.AuthFromMD(ctx, "bearer")
+
+		if err != nil {
+			return uuid.Nil, status.Errorf(codes.Unauthenticated, "a %s is required in the scaler metadata", metadataToken)
+		}
+	}
+
+	tenantId, _, err := s.jwtManager.ValidateTenantToken(ctx, tok)
+
+	if err != nil {
+		s.l.Debug().Ctx(ctx).Err(err).Msg("error validating tenant token")
+		return uuid.Nil, unauthenticated
+	}
+
+	if _, err := s.repo.Tenant().GetTenantByID(ctx, tenantId); err != nil {
+		s.l.Debug().Ctx(ctx).Err(err).Msg("error getting tenant by id")
+		return uuid.Nil, unauthenticated
+	}
+
+	return tenantId, nil
+}
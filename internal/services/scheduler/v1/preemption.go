@@ -0,0 +1,168 @@
+package scheduler
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/go-multierror"
+
+	"github.com/hatchet-dev/hatchet/internal/listutils"
+	"github.com/hatchet-dev/hatchet/internal/msgqueue"
+	tasktypes "github.com/hatchet-dev/hatchet/internal/services/shared/tasktypes/v1"
+	"github.com/hatchet-dev/hatchet/pkg/integrations/metrics/prometheus"
+	repov1 "github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/sqlcv1"
+	"github.com/hatchet-dev/hatchet/pkg/telemetry"
+)
+
+// maxPreemptionsPerRun caps the number of waiting tasks considered for preemption after a single
+// queue run, so that a large backlog doesn't cancel a whole fleet's work at once.
+const maxPreemptionsPerRun = 100
+
+// waitingForPreemption returns the unassigned queue items which have waited at least threshold
+// for a slot and can benefit from preempting a lower-priority task. Items which need a specific
+// worker are skipped, as the slot freed by a preemption may be on another worker.
+func waitingForPreemption(items []*sqlcv1.V1QueueItem, now time.Time, threshold time.Duration, recentlyAttempted func(taskId int64) bool) []*sqlcv1.V1QueueItem {
+	res := make([]*sqlcv1.V1QueueItem, 0)
+
+	for _, qi := range items {
+		if len(res) >= maxPreemptionsPerRun {
+			break
+		}
+
+		// the lowest priority can't preempt anything
+		if qi.Priority <= 1 {
+			continue
+		}
+
+		if qi.Sticky == sqlcv1.V1StickyStrategyHARD && qi.DesiredWorkerID != nil {
+			continue
+		}
+
+		if !qi.TaskInsertedAt.Valid || now.Sub(qi.TaskInsertedAt.Time) < threshold {
+			continue
+		}
+
+		if recentlyAttempted(qi.TaskID) {
+			continue
+		}
+
+		res = append(res, qi)
+	}
+
+	return res
+}
+
+// preemptForUnassigned preempts lower-priority running tasks of preemptible steps for the
+// higher-priority tasks which have been waiting for a slot longer than the preemption threshold.
+// The preempted tasks are requeued by the repository, and their workers are told to cancel them.
+func (s *Scheduler) preemptForUnassigned(ctx context.Context, tenantId uuid.UUID, unassigned []*sqlcv1.V1QueueItem) error {
+	ctx, span := telemetry.NewSpan(ctx, "preempt-for-unassigned")
+	defer span.End()
+
+	waiting := waitingForPreemption(unassigned, time.Now(), s.preemptionWaitThreshold, func(taskId int64) bool {
+		return s.preemptionAttempted.Contains(taskId)
+	})
+
+	if len(waiting) == 0 {
+		return nil
+	}
+
+	tasks := make([]repov1.TaskIdInsertedAtRetryCount, 0, len(waiting))
+
+	for _, qi := range waiting {
+		// a waiting task is only considered once per threshold, whether or not a task was preempted
+		// for it, as the freed slot may be taken by another task
+		s.preemptionAttempted.Add(qi.TaskID, struct{}{})
+
+		tasks = append(tasks, repov1.TaskIdInsertedAtRetryCount{
+			Id:         qi.TaskID,
+			InsertedAt: qi.TaskInsertedAt,
+			RetryCount: qi.RetryCount,
+		})
+	}
+
+	res, err := s.repov1.Tasks().PreemptTasks(ctx, tenantId, tasks)
+
+	if err != nil {
+		return fmt.Errorf("could not preempt tasks: %w", err)
+	}
+
+	if len(res.Preempted) == 0 {
+		return nil
+	}
+
+	cancelled := make([]repov1.TaskWithCancelledReason, 0, len(res.Preempted))
+	queues := make([]string, 0)
+	strategyIds := make([]int64, 0)
+
+	for _, task := range res.Preempted {
+		cancelled = append(cancelled, repov1.TaskWithCancelledReason{
+			TaskIdInsertedAtRetryCount: &repov1.TaskIdInsertedAtRetryCount{
+				Id:         task.ID,
+				InsertedAt: task.InsertedAt,
+				RetryCount: task.RetryCount,
+			},
+			CancelledReason: repov1.CancelledReasonPreempted,
+			TaskExternalId:  task.ExternalID,
+			WorkflowRunId:   task.WorkflowRunID,
+			WorkerId:        task.WorkerID,
+			SlotUsage:       repov1.TaskSlotUsageFromReleasedTasks([]*sqlcv1.ReleaseTasksRow{task.ReleaseTasksRow}),
+		})
+
+		queues = append(queues, task.Queue)
+		strategyIds = append(strategyIds, task.ConcurrencyStrategyIds...)
+	}
+
+	// the task runtimes were released in the repository, so the workers are signalled directly
+	s.signalWorkersToCancelInProgress(ctx, tenantId, cancelled)
+
+	s.publishSlotUsage(ctx, tenantId, cancelled)
+
+	prometheus.PreemptedTasks.Add(float64(len(res.Preempted)))
+
+	if s.promGate.Enabled(ctx, tenantId) {
+		prometheus.TenantPreemptedTasks.WithLabelValues(tenantId.String()).Add(float64(len(res.Preempted)))
+	}
+
+	var outerErr error
+
+	for _, task := range res.Preempted {
+		var workerId *uuid.UUID
+
+		if task.WorkerID != uuid.Nil {
+			workerId = &task.WorkerID
+		}
+
+		msg, err := tasktypes.MonitoringEventMessageFromInternal(
+			tenantId,
+			tasktypes.CreateMonitoringEventPayload{
+				TaskId:         task.ID,
+				RetryCount:     task.RetryCount,
+				EventType:      sqlcv1.V1EventTypeOlapPREEMPTED,
+				EventTimestamp: time.Now(),
+				EventMessage:   fmt.Sprintf("Preempted for a task with priority %d, requeued with priority %d", task.PreemptedForPriority, task.Priority),
+				WorkerId:       workerId,
+			},
+		)
+
+		if err != nil {
+			outerErr = multierror.Append(outerErr, fmt.Errorf("could not create monitoring event message: %w", err))
+			continue
+		}
+
+		if err := s.pubBuffer.Pub(ctx, msgqueue.OLAP_QUEUE, msg, false); err != nil {
+			outerErr = multierror.Append(outerErr, fmt.Errorf("could not send monitoring event message: %w", err))
+		}
+	}
+
+	// the preempted tasks were requeued and their slots released, so the waiting tasks can be
+	// assigned on the next queue run
+	s.pool.NotifyConcurrency(ctx, tenantId, listutils.Uniq(strategyIds))
+	s.pool.NotifyQueues(ctx, tenantId, listutils.Uniq(queues))
+	s.pool.Replenish(ctx, tenantId)
+
+	return outerErr
+}
@@ -0,0 +1,84 @@
+package scheduler
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/hatchet-dev/hatchet/pkg/repository/sqlcv1"
+)
+
+func TestWaitingForPreemption(t *testing.T) {
+	now := time.Now()
+	threshold := 30 * time.Second
+
+	item := func(taskId int64, priority int32, waited time.Duration) *sqlcv1.V1QueueItem {
+		return &sqlcv1.V1QueueItem{
+			TaskID:         taskId,
+			TaskInsertedAt: pgtype.Timestamptz{Time: now.Add(-waited), Valid: true},
+			Priority:       priority,
+			Sticky:         sqlcv1.V1StickyStrategyNONE,
+		}
+	}
+
+	taskIds := func(items []*sqlcv1.V1QueueItem) []int64 {
+		ids := make([]int64, 0, len(items))
+		for _, qi := range items {
+			ids = append(ids, qi.TaskID)
+		}
+		return ids
+	}
+
+	never := func(int64) bool { return false }
+
+	t.Run("only higher-priority items past the threshold are waiting", func(t *testing.T) {
+		items := []*sqlcv1.V1QueueItem{
+			item(1, 3, time.Minute),
+			item(2, 1, time.Minute),
+			item(3, 2, 10*time.Second),
+			item(4, 2, threshold),
+		}
+
+		assert.Equal(t, []int64{1, 4}, taskIds(waitingForPreemption(items, now, threshold, never)))
+	})
+
+	t.Run("recently attempted items are skipped", func(t *testing.T) {
+		items := []*sqlcv1.V1QueueItem{
+			item(1, 3, time.Minute),
+			item(2, 3, time.Minute),
+		}
+
+		attempted := func(taskId int64) bool { return taskId == 1 }
+
+		assert.Equal(t, []int64{2}, taskIds(waitingForPreemption(items, now, threshold, attempted)))
+	})
+
+	t.Run("items pinned to a worker are skipped", func(t *testing.T) {
+		workerId := uuid.New()
+
+		pinned := item(1, 3, time.Minute)
+		pinned.Sticky = sqlcv1.V1StickyStrategyHARD
+		pinned.DesiredWorkerID = &workerId
+
+		soft := item(2, 3, time.Minute)
+		soft.Sticky = sqlcv1.V1StickyStrategySOFT
+		soft.DesiredWorkerID = &workerId
+
+		items := []*sqlcv1.V1QueueItem{pinned, soft}
+
+		assert.Equal(t, []int64{2}, taskIds(waitingForPreemption(items, now, threshold, never)))
+	})
+
+	t.Run("at most maxPreemptionsPerRun items are returned", func(t *testing.T) {
+		items := make([]*sqlcv1.V1QueueItem, 0, maxPreemptionsPerRun+10)
+
+		for i := range maxPreemptionsPerRun + 10 {
+			items = append(items, item(int64(i), 2, time.Minute))
+		}
+
+		assert.Len(t, waitingForPreemption(items, now, threshold, never), maxPreemptionsPerRun)
+	})
+}
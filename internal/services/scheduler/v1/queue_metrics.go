@@ -37,19 +37,23 @@ type metadataQueueSizeKey struct {
 // scheduler partition. It is only accessed from a singleton gocron job, so its state
 // needs no locking.
 type queueMetricsPoller struct {
-	tasks repov1.TaskRepository
-	l     *zerolog.Logger
+	tasks           repov1.TaskRepository
+	circuitBreakers repov1.CircuitBreakerRepository
+	l               *zerolog.Logger
 
-	knownQueueSizes    map[uuid.UUID]map[queueSizeKey]bool
-	knownMetadataSizes map[uuid.UUID]map[metadataQueueSizeKey]bool
+	knownQueueSizes      map[uuid.UUID]map[queueSizeKey]bool
+	knownMetadataSizes   map[uuid.UUID]map[metadataQueueSizeKey]bool
+	knownCircuitBreakers map[uuid.UUID]map[string]bool
 }
 
-func newQueueMetricsPoller(tasks repov1.TaskRepository, l *zerolog.Logger) *queueMetricsPoller {
+func newQueueMetricsPoller(tasks repov1.TaskRepository, circuitBreakers repov1.CircuitBreakerRepository, l *zerolog.Logger) *queueMetricsPoller {
 	return &queueMetricsPoller{
-		tasks:              tasks,
-		l:                  l,
-		knownQueueSizes:    make(map[uuid.UUID]map[queueSizeKey]bool),
-		knownMetadataSizes: make(map[uuid.UUID]map[metadataQueueSizeKey]bool),
+		tasks:                tasks,
+		circuitBreakers:      circuitBreakers,
+		l:                    l,
+		knownQueueSizes:      make(map[uuid.UUID]map[queueSizeKey]bool),
+		knownMetadataSizes:   make(map[uuid.UUID]map[metadataQueueSizeKey]bool),
+		knownCircuitBreakers: make(map[uuid.UUID]map[string]bool),
 	}
 }
 
@@ -102,11 +106,26 @@ func (p *queueMetricsPoller) poll(ctx context.Context, tenantIds []uuid.UUID) {
 			p.applyMetadataSizes(tenantId, nil)
 		}
 	}
+
+	for tenantId := range p.knownCircuitBreakers {
+		if _, ok := activeTenants[tenantId]; !ok {
+			p.applyCircuitBreakers(tenantId, nil)
+		}
+	}
 }
 
-// pollTenant queries and reports both queue size gauges for one tenant. On a query error
-// the tenant's previously-reported values are kept rather than reporting false zeroes.
+// pollTenant queries and reports the queue size and circuit breaker gauges for one tenant.
+// On a query error the tenant's previously-reported values are kept rather than reporting
+// false zeroes.
 func (p *queueMetricsPoller) pollTenant(ctx context.Context, tenantId uuid.UUID) {
+	breakers, err := p.circuitBreakers.ListCircuitBreakers(ctx, tenantId)
+
+	if err != nil {
+		p.l.Warn().Err(err).Str("tenant_id", tenantId.String()).Msg("could not poll circuit breakers")
+	} else {
+		p.applyCircuitBreakers(tenantId, breakers)
+	}
+
 	sizes, err := p.tasks.GetQueueSizes(ctx, tenantId)
 
 	if err != nil {
@@ -186,6 +205,47 @@ func (p *queueMetricsPoller) applyMetadataSizes(tenantId uuid.UUID, rows []*sqlc
 	}
 }
 
+// circuitBreakerStateValue maps a breaker state to its gauge value, ordered by severity.
+func circuitBreakerStateValue(state sqlcv1.V1CircuitBreakerState) float64 {
+	switch state {
+	case sqlcv1.V1CircuitBreakerStateHALFOPEN:
+		return 1
+	case sqlcv1.V1CircuitBreakerStateOPEN:
+		return 2
+	default:
+		return 0
+	}
+}
+
+func (p *queueMetricsPoller) applyCircuitBreakers(tenantId uuid.UUID, rows []*sqlcv1.V1CircuitBreaker) {
+	tenantIdStr := tenantId.String()
+	current := make(map[string]struct{}, len(rows))
+
+	for _, row := range rows {
+		current[row.Key] = struct{}{}
+		prometheus.TenantCircuitBreakerState.WithLabelValues(tenantIdStr, row.Key).Set(circuitBreakerStateValue(row.State))
+		prometheus.TenantCircuitBreakerFailures.WithLabelValues(tenantIdStr, row.Key).Set(float64(row.FailureCount))
+	}
+
+	zeroed, deleted, next := diffStaleSeries(p.knownCircuitBreakers[tenantId], current)
+
+	for _, k := range zeroed {
+		prometheus.TenantCircuitBreakerState.WithLabelValues(tenantIdStr, k).Set(0)
+		prometheus.TenantCircuitBreakerFailures.WithLabelValues(tenantIdStr, k).Set(0)
+	}
+
+	for _, k := range deleted {
+		prometheus.TenantCircuitBreakerState.DeleteLabelValues(tenantIdStr, k)
+		prometheus.TenantCircuitBreakerFailures.DeleteLabelValues(tenantIdStr, k)
+	}
+
+	if len(next) == 0 {
+		delete(p.knownCircuitBreakers, tenantId)
+	} else {
+		p.knownCircuitBreakers[tenantId] = next
+	}
+}
+
 func (s *Scheduler) runPollQueueMetrics(ctx context.Context) func() {
 	return func() {
 		ctx, cancel := context.WithTimeout(ctx, queueMetricsPollTimeout)
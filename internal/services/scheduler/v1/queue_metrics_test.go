@@ -118,7 +118,7 @@ func collectGaugeSeries(t *testing.T, vec *promclient.GaugeVec, tenantId string)
 
 func TestQueueMetricsPollerLifecycle(t *testing.T) {
 	l := zerolog.Nop()
-	p := newQueueMetricsPoller(nil, &l)
+	p := newQueueMetricsPoller(nil, nil, &l)
 
 	tenantId := uuid.New()
 	tenantIdStr := tenantId.String()
@@ -153,7 +153,7 @@ func TestQueueMetricsPollerLifecycle(t *testing.T) {
 
 func TestQueueMetricsPollerDropsUnprefixedMetadataKeys(t *testing.T) {
 	l := zerolog.Nop()
-	p := newQueueMetricsPoller(nil, &l)
+	p := newQueueMetricsPoller(nil, nil, &l)
 
 	tenantId := uuid.New()
 	tenantIdStr := tenantId.String()
@@ -175,7 +175,7 @@ func TestQueueMetricsPollerDropsUnprefixedMetadataKeys(t *testing.T) {
 
 func TestQueueMetricsPollerDrainsRemovedTenants(t *testing.T) {
 	l := zerolog.Nop()
-	p := newQueueMetricsPoller(nil, &l)
+	p := newQueueMetricsPoller(nil, nil, &l)
 
 	tenantId := uuid.New()
 	tenantIdStr := tenantId.String()
@@ -199,3 +199,28 @@ func TestQueueMetricsPollerDrainsRemovedTenants(t *testing.T) {
 	assert.Empty(t, collectGaugeSeries(t, prometheus.TenantQueueSize, tenantIdStr))
 	assert.Empty(t, collectGaugeSeries(t, prometheus.TenantQueueSizeByMetadata, tenantIdStr))
 }
+
+func TestQueueMetricsPollerCircuitBreakers(t *testing.T) {
+	l := zerolog.Nop()
+	p := newQueueMetricsPoller(nil, nil, &l)
+
+	tenantId := uuid.New()
+	tenantIdStr := tenantId.String()
+
+	p.applyCircuitBreakers(tenantId, []*sqlcv1.V1CircuitBreaker{
+		{Key: "payments", State: sqlcv1.V1CircuitBreakerStateOPEN, FailureCount: 5},
+		{Key: "search", State: sqlcv1.V1CircuitBreakerStateHALFOPEN, FailureCount: 3},
+		{Key: "email", State: sqlcv1.V1CircuitBreakerStateCLOSED, FailureCount: 1},
+	})
+
+	assert.Equal(t, map[string]float64{"key=payments": 2, "key=search": 1, "key=email": 0}, collectGaugeSeries(t, prometheus.TenantCircuitBreakerState, tenantIdStr))
+	assert.Equal(t, map[string]float64{"key=payments": 5, "key=search": 3, "key=email": 1}, collectGaugeSeries(t, prometheus.TenantCircuitBreakerFailures, tenantIdStr))
+
+	// removed breakers drain like the queue size series
+	p.poll(context.Background(), nil)
+	p.poll(context.Background(), nil)
+
+	assert.Empty(t, collectGaugeSeries(t, prometheus.TenantCircuitBreakerState, tenantIdStr))
+	assert.Empty(t, collectGaugeSeries(t, prometheus.TenantCircuitBreakerFailures, tenantIdStr))
+	assert.Empty(t, p.knownCircuitBreakers)
+}
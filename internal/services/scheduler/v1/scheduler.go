@@ -50,6 +50,8 @@ type SchedulerOpts struct {
 	queueLogger *zerolog.Logger
 	pool        scheduling.Pool
 	promGate    *prometheus.Gate
+
+	preemptionWaitThreshold time.Duration
 }
 
 func defaultSchedulerOpts() *SchedulerOpts {
@@ -127,6 +129,14 @@ func WithPrometheusGate(gate *prometheus.Gate) SchedulerOpt {
 	}
 }
 
+// WithPreemptionWaitThreshold sets how long a higher-priority task must wait for a slot before the
+// scheduler preempts a lower-priority running task for it. Zero disables preemption.
+func WithPreemptionWaitThreshold(threshold time.Duration) SchedulerOpt {
+	return func(opts *SchedulerOpts) {
+		opts.preemptionWaitThreshold = threshold
+	}
+}
+
 type Scheduler struct {
 	mq        msgqueue.MessageQueue
 	pubsub    msgqueue.PubSub
@@ -150,6 +160,12 @@ type Scheduler struct {
 	promGate *prometheus.Gate
 
 	queueMetrics *queueMetricsPoller
+
+	preemptionWaitThreshold time.Duration
+
+	// waiting tasks which have recently triggered a preemption attempt, so that a task which is
+	// still waiting isn't considered again on every queue run
+	preemptionAttempted *expirable.LRU[int64, struct{}]
 }
 
 func New(
@@ -195,24 +211,32 @@ func New(
 	// TODO: replace with config or pull into a constant
 	tasksWithNoWorkerCache := expirable.NewLRU(10000, func(string, struct{}) {}, 5*time.Minute)
 
+	var preemptionAttempted *expirable.LRU[int64, struct{}]
+
+	if opts.preemptionWaitThreshold > 0 {
+		preemptionAttempted = expirable.NewLRU(10000, func(int64, struct{}) {}, opts.preemptionWaitThreshold)
+	}
+
 	signaler := signal.NewOLAPSignaler(opts.mq, opts.pubsub, opts.repov1, opts.l, pubBuffer, opts.promGate)
 
 	q := &Scheduler{
-		mq:                     opts.mq,
-		pubsub:                 opts.pubsub,
-		pubBuffer:              pubBuffer,
-		l:                      opts.l,
-		repov1:                 opts.repov1,
-		dv:                     opts.dv,
-		s:                      s,
-		a:                      a,
-		p:                      opts.p,
-		ql:                     opts.queueLogger,
-		pool:                   opts.pool,
-		tasksWithNoWorkerCache: tasksWithNoWorkerCache,
-		signaler:               signaler,
-		promGate:               opts.promGate,
-		queueMetrics:           newQueueMetricsPoller(opts.repov1.Tasks(), opts.l),
+		mq:                      opts.mq,
+		pubsub:                  opts.pubsub,
+		pubBuffer:               pubBuffer,
+		l:                       opts.l,
+		repov1:                  opts.repov1,
+		dv:                      opts.dv,
+		s:                       s,
+		a:                       a,
+		p:                       opts.p,
+		ql:                      opts.queueLogger,
+		pool:                    opts.pool,
+		tasksWithNoWorkerCache:  tasksWithNoWorkerCache,
+		signaler:                signaler,
+		promGate:                opts.promGate,
+		queueMetrics:            newQueueMetricsPoller(opts.repov1.Tasks(), opts.repov1.CircuitBreakers(), opts.l),
+		preemptionWaitThreshold: opts.preemptionWaitThreshold,
+		preemptionAttempted:     preemptionAttempted,
 	}
 
 	return q, nil
@@ -710,6 +734,12 @@ func (s *Scheduler) scheduleStepRuns(ctx context.Context, tenantId uuid.UUID, re
 
 			s.tasksWithNoWorkerCache.Add(taskExternalId, struct{}{})
 		}
+
+		if s.preemptionWaitThreshold > 0 {
+			if err := s.preemptForUnassigned(ctx, tenantId, res.Unassigned); err != nil {
+				outerErr = multierror.Append(outerErr, err)
+			}
+		}
 	}
 
 	return outerErr
@@ -886,6 +916,8 @@ func (s *Scheduler) notifyAfterConcurrency(ctx context.Context, tenantId uuid.UU
 	// directly using the worker id captured during the flush, mirroring sendTaskCancellationsToDispatcher.
 	s.signalWorkersToCancelInProgress(ctx, tenantId, res.Cancelled)
 
+	s.publishSlotUsage(ctx, tenantId, res.Cancelled)
+
 	// handle cancellations
 	for _, cancelled := range res.Cancelled {
 		eventType := sqlcv1.V1EventTypeOlapCANCELLED
@@ -925,6 +957,31 @@ func (s *Scheduler) notifyAfterConcurrency(ctx context.Context, tenantId uuid.UU
 	}
 }
 
+// publishSlotUsage sends the slot time consumed by tasks which were running when a concurrency
+// strategy cancelled them to the OLAP repository.
+func (s *Scheduler) publishSlotUsage(ctx context.Context, tenantId uuid.UUID, cancelled []repov1.TaskWithCancelledReason) {
+	usage := make([]repov1.TaskSlotUsage, 0)
+
+	for _, task := range cancelled {
+		usage = append(usage, task.SlotUsage...)
+	}
+
+	msg, err := tasktypes.TaskSlotUsageMessage(tenantId, usage)
+
+	if err != nil {
+		s.l.Error().Ctx(ctx).Err(err).Msg("could not create task slot usage message")
+		return
+	}
+
+	if msg == nil {
+		return
+	}
+
+	if err := s.pubBuffer.Pub(ctx, msgqueue.OLAP_QUEUE, msg, false); err != nil {
+		s.l.Error().Ctx(ctx).Err(err).Msg("could not publish task slot usage message")
+	}
+}
+
 // signalWorkersToCancelInProgress tells the relevant dispatchers to cancel tasks that were running on
 // a worker when a concurrency strategy cancelled them. The in-memory concurrency flush releases the
 // task runtime in its transaction, so the MsgIDTaskCancelled handler (handleTaskCancelled) can't map
@@ -0,0 +1,228 @@
+package replay
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	tasktypes "github.com/hatchet-dev/hatchet/internal/services/shared/tasktypes/v1"
+	v1 "github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/sqlcv1"
+)
+
+// ResolveTasks flattens task and workflow run external ids into the deduplicated set of tasks
+// which should be sent to the tasks controller for replay. Durable DAG orchestrators have their
+// event logs branched so that the replayed steps are re-run.
+func ResolveTasks(ctx context.Context, repo v1.Repository, tenantId uuid.UUID, externalIds []uuid.UUID) ([]tasktypes.TaskIdInsertedAtRetryCountWithExternalId, error) {
+	tasks, err := repo.Tasks().FlattenExternalIds(ctx, tenantId, externalIds)
+
+	if err != nil {
+		return nil, err
+	}
+
+	wholeRunOrchestrators := make(map[uuid.UUID]bool)
+
+	for _, task := range tasks {
+		if !task.IsDagOrchestrator {
+			continue
+		}
+
+		wholeRunOrchestrators[task.ExternalID] = true
+
+		// a whole-run replay forces every step of the DAG to re-run
+		childExternalIds, err := repo.Tasks().ListDurableOrchestratorChildExternalIds(ctx, tenantId, task.ExternalID)
+
+		if err != nil {
+			return nil, fmt.Errorf("failed to list orchestrator children for replay: %w", err)
+		}
+
+		if _, err := repo.DurableEvents().HandleBranchForDAGReplay(ctx, tenantId, task, childExternalIds); err != nil {
+			return nil, fmt.Errorf("failed to branch durable task for replay: %w", err)
+		}
+	}
+
+	tasks, err = handleOperatorDAGStepReplays(ctx, repo, tenantId, tasks, wholeRunOrchestrators)
+
+	if err != nil {
+		return nil, err
+	}
+
+	// Deduplicate based on TaskIdInsertedAtRetryCountWithExternalId
+	tasksToReplay := []tasktypes.TaskIdInsertedAtRetryCountWithExternalId{}
+	existingReplays := make(map[tasktypes.TaskIdInsertedAtRetryCountWithExternalId]bool)
+
+	for _, task := range tasks {
+		record := tasktypes.TaskIdInsertedAtRetryCountWithExternalId{
+			TaskIdInsertedAtRetryCount: v1.TaskIdInsertedAtRetryCount{
+				Id:         task.ID,
+				InsertedAt: task.InsertedAt,
+				RetryCount: task.RetryCount,
+			},
+			WorkflowRunExternalId: task.WorkflowRunID,
+			TaskExternalId:        task.ExternalID,
+		}
+
+		if _, exists := existingReplays[record]; exists {
+			continue
+		}
+
+		existingReplays[record] = true
+		tasksToReplay = append(tasksToReplay, record)
+	}
+
+	return tasksToReplay, nil
+}
+
+// BatchByWorkflowRun splits tasks into batches of roughly batchSize, keeping the tasks of a
+// workflow run in the same batch so that a run is never partially replayed by a batch.
+func BatchByWorkflowRun(tasks []tasktypes.TaskIdInsertedAtRetryCountWithExternalId, batchSize int) [][]tasktypes.TaskIdInsertedAtRetryCountWithExternalId {
+	workflowRunIdToTasksToReplay := make(map[uuid.UUID][]tasktypes.TaskIdInsertedAtRetryCountWithExternalId)
+	workflowRunIds := make([]uuid.UUID, 0)
+
+	for _, item := range tasks {
+		if _, ok := workflowRunIdToTasksToReplay[item.WorkflowRunExternalId]; !ok {
+			workflowRunIds = append(workflowRunIds, item.WorkflowRunExternalId)
+		}
+
+		workflowRunIdToTasksToReplay[item.WorkflowRunExternalId] = append(
+			workflowRunIdToTasksToReplay[item.WorkflowRunExternalId],
+			item,
+		)
+	}
+
+	var batches [][]tasktypes.TaskIdInsertedAtRetryCountWithExternalId
+	var currentBatch []tasktypes.TaskIdInsertedAtRetryCountWithExternalId
+
+	for _, workflowRunId := range workflowRunIds {
+		tasksForWorkflowRun := workflowRunIdToTasksToReplay[workflowRunId]
+
+		if len(currentBatch) > 0 && len(currentBatch)+len(tasksForWorkflowRun) > batchSize {
+			// If the current batch would exceed the batch size if we added the current workflow run's tasks,
+			// we "finalize" the batch and start a new one
+			batches = append(batches, currentBatch)
+			currentBatch = nil
+		}
+
+		if len(tasksForWorkflowRun) > batchSize {
+			// If the current workflow run's task count exceeds the batch size on its own,
+			// we let it be its own batch
+			batches = append(batches, tasksForWorkflowRun)
+		} else {
+			// Otherwise, add it to the current batch
+			currentBatch = append(currentBatch, tasksForWorkflowRun...)
+		}
+	}
+
+	if len(currentBatch) > 0 {
+		// Last case to handle - add the last batch if it has any tasks
+		batches = append(batches, currentBatch)
+	}
+
+	return batches
+}
+
+// handleOperatorDAGStepReplays rewrites the replay set for selected steps of operator-managed
+// DAGs: resetting a child task alone would never re-trigger its descendants, so the orchestrator's
+// log is branched with the selected children as the forced-replay set and the orchestrator
+// replaces them in the replay batch.
+func handleOperatorDAGStepReplays(
+	ctx context.Context,
+	repo v1.Repository,
+	tenantId uuid.UUID,
+	tasks []*sqlcv1.FlattenExternalIdsRow,
+	wholeRunOrchestrators map[uuid.UUID]bool,
+) ([]*sqlcv1.FlattenExternalIdsRow, error) {
+	parentExternalIds := make([]uuid.UUID, 0)
+	seenParents := make(map[uuid.UUID]bool)
+
+	for _, task := range tasks {
+		if task.IsDagOrchestrator || task.ParentTaskExternalID == nil || seenParents[*task.ParentTaskExternalID] {
+			continue
+		}
+
+		seenParents[*task.ParentTaskExternalID] = true
+		parentExternalIds = append(parentExternalIds, *task.ParentTaskExternalID)
+	}
+
+	if len(parentExternalIds) == 0 {
+		return tasks, nil
+	}
+
+	parents, err := repo.Tasks().FlattenExternalIds(ctx, tenantId, parentExternalIds)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to look up parent tasks for replay: %w", err)
+	}
+
+	orchestrators := make(map[uuid.UUID]*sqlcv1.FlattenExternalIdsRow)
+
+	for _, parent := range parents {
+		if parent.IsDagOrchestrator {
+			orchestrators[parent.ExternalID] = parent
+		}
+	}
+
+	if len(orchestrators) == 0 {
+		return tasks, nil
+	}
+
+	orchestratorToChildren := make(map[uuid.UUID][]uuid.UUID)
+	childRows := make(map[uuid.UUID]*sqlcv1.FlattenExternalIdsRow)
+
+	for _, task := range tasks {
+		if task.IsDagOrchestrator || task.ParentTaskExternalID == nil {
+			continue
+		}
+
+		orchestrator, ok := orchestrators[*task.ParentTaskExternalID]
+
+		if !ok {
+			continue
+		}
+
+		childRows[task.ExternalID] = task
+
+		// when the whole run is also selected, its branch already replays every step
+		if !wholeRunOrchestrators[orchestrator.ExternalID] {
+			orchestratorToChildren[orchestrator.ExternalID] = append(orchestratorToChildren[orchestrator.ExternalID], task.ExternalID)
+		}
+	}
+
+	if len(childRows) == 0 {
+		return tasks, nil
+	}
+
+	result := make([]*sqlcv1.FlattenExternalIdsRow, 0, len(tasks))
+
+	for _, task := range tasks {
+		if _, ok := childRows[task.ExternalID]; ok {
+			continue
+		}
+
+		result = append(result, task)
+	}
+
+	for orchestratorExternalId, childExternalIds := range orchestratorToChildren {
+		orchestrator := orchestrators[orchestratorExternalId]
+
+		branch, err := repo.DurableEvents().HandleBranchForDAGReplay(ctx, tenantId, orchestrator, childExternalIds)
+
+		if err != nil {
+			return nil, fmt.Errorf("failed to branch durable task for step replay: %w", err)
+		}
+
+		if branch == nil {
+			// no durable event log to re-invoke; replay the selected children directly
+			for _, childExternalId := range childExternalIds {
+				result = append(result, childRows[childExternalId])
+			}
+
+			continue
+		}
+
+		result = append(result, orchestrator)
+	}
+
+	return result, nil
+}
@@ -0,0 +1,69 @@
+//go:build !e2e && !load && !rampup && !integration
+
+package replay
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	tasktypes "github.com/hatchet-dev/hatchet/internal/services/shared/tasktypes/v1"
+	v1 "github.com/hatchet-dev/hatchet/pkg/repository"
+)
+
+func tasksForRun(runId uuid.UUID, n int, startId int64) []tasktypes.TaskIdInsertedAtRetryCountWithExternalId {
+	res := make([]tasktypes.TaskIdInsertedAtRetryCountWithExternalId, n)
+
+	for i := range res {
+		res[i] = tasktypes.TaskIdInsertedAtRetryCountWithExternalId{
+			TaskIdInsertedAtRetryCount: v1.TaskIdInsertedAtRetryCount{Id: startId + int64(i)},
+			WorkflowRunExternalId:      runId,
+			TaskExternalId:             uuid.New(),
+		}
+	}
+
+	return res
+}
+
+func TestBatchByWorkflowRun(t *testing.T) {
+	runA, runB, runC := uuid.New(), uuid.New(), uuid.New()
+
+	t.Run("runs are never split across batches", func(t *testing.T) {
+		tasks := append(tasksForRun(runA, 2, 0), tasksForRun(runB, 2, 10)...)
+		tasks = append(tasks, tasksForRun(runC, 2, 20)...)
+
+		batches := BatchByWorkflowRun(tasks, 3)
+
+		assert.Len(t, batches, 3)
+
+		for _, batch := range batches {
+			assert.Len(t, batch, 2)
+			assert.Equal(t, batch[0].WorkflowRunExternalId, batch[1].WorkflowRunExternalId)
+		}
+	})
+
+	t.Run("small runs share a batch", func(t *testing.T) {
+		tasks := append(tasksForRun(runA, 1, 0), tasksForRun(runB, 1, 10)...)
+
+		batches := BatchByWorkflowRun(tasks, 100)
+
+		assert.Len(t, batches, 1)
+		assert.Len(t, batches[0], 2)
+	})
+
+	t.Run("oversized run gets its own batch", func(t *testing.T) {
+		tasks := append(tasksForRun(runA, 1, 0), tasksForRun(runB, 5, 10)...)
+
+		batches := BatchByWorkflowRun(tasks, 3)
+
+		assert.Len(t, batches, 2)
+		assert.Len(t, batches[0], 1)
+		assert.Len(t, batches[1], 5)
+		assert.Equal(t, runB, batches[1][0].WorkflowRunExternalId)
+	})
+
+	t.Run("no tasks", func(t *testing.T) {
+		assert.Empty(t, BatchByWorkflowRun(nil, 100))
+	})
+}
@@ -0,0 +1,144 @@
+package v1
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/hatchet-dev/hatchet/internal/msgqueue"
+	"github.com/hatchet-dev/hatchet/pkg/repository/sqlcv1"
+)
+
+// ApprovalOutput is the output of an approval task once its approval is resolved.
+type ApprovalOutput struct {
+	Approved bool    `json:"approved"`
+	Approver *string `json:"approver"`
+	Comment  *string `json:"comment"`
+
+	// Expired is set when nobody resolved the approval before it expired
+	Expired bool `json:"expired,omitempty"`
+}
+
+// ApprovalExpiredErrorMsg is the error of approval tasks which fail because their approval expired.
+const ApprovalExpiredErrorMsg = "approval expired before it was approved or rejected"
+
+// ApprovalResult returns how an approval task finishes for a resolved approval: with the output,
+// or failed when output is nil. ok is false for approvals which don't finish the task, such as
+// pending or cancelled approvals.
+func ApprovalResult(approval *sqlcv1.V1Approval) (output *ApprovalOutput, ok bool) {
+	output = &ApprovalOutput{}
+
+	switch approval.Status {
+	case sqlcv1.V1ApprovalStatusAPPROVED:
+		output.Approved = true
+	case sqlcv1.V1ApprovalStatusREJECTED:
+		output.Approved = false
+	case sqlcv1.V1ApprovalStatusEXPIRED:
+		output.Expired = true
+
+		switch approval.OnExpiry {
+		case sqlcv1.V1ApprovalExpiryActionAPPROVE:
+			output.Approved = true
+		case sqlcv1.V1ApprovalExpiryActionREJECT:
+			output.Approved = false
+		default:
+			return nil, true
+		}
+	default:
+		return nil, false
+	}
+
+	if approval.Approver.Valid {
+		output.Approver = &approval.Approver.String
+	}
+
+	if approval.Comment.Valid {
+		output.Comment = &approval.Comment.String
+	}
+
+	return output, true
+}
+
+// ApprovalResolvedMessages returns the messages which finish an approval task after its approval
+// was resolved. The task message goes to the task processing queue, and the olap message (which
+// may be nil) to the olap queue. Both are nil for approvals which don't finish the task.
+func ApprovalResolvedMessages(approval *sqlcv1.V1Approval) (taskMsg *msgqueue.Message, olapMsg *msgqueue.Message, err error) {
+	output, ok := ApprovalResult(approval)
+
+	if !ok {
+		return nil, nil, nil
+	}
+
+	if output == nil {
+		// the controller records the olap event for failed tasks
+		taskMsg, err = FailedTaskMessage(
+			approval.TenantID,
+			approval.TaskID,
+			approval.TaskInsertedAt,
+			approval.TaskExternalID,
+			approval.WorkflowRunID,
+			approval.TaskRetryCount,
+			true,
+			ApprovalExpiredErrorMsg,
+			true,
+		)
+
+		return taskMsg, nil, err
+	}
+
+	outputBytes, err := json.Marshal(output)
+
+	if err != nil {
+		return nil, nil, fmt.Errorf("could not marshal approval output: %w", err)
+	}
+
+	taskMsg, err = CompletedTaskMessage(
+		approval.TenantID,
+		approval.TaskID,
+		approval.TaskInsertedAt,
+		approval.TaskExternalID,
+		approval.WorkflowRunID,
+		approval.TaskRetryCount,
+		outputBytes,
+	)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	olapMsg, err = MonitoringEventMessageFromInternal(
+		approval.TenantID,
+		CreateMonitoringEventPayload{
+			TaskId:         approval.TaskID,
+			RetryCount:     approval.TaskRetryCount,
+			EventType:      sqlcv1.V1EventTypeOlapFINISHED,
+			EventTimestamp: time.Now().UTC(),
+			EventPayload:   string(outputBytes),
+			EventMessage:   approvalEventMessage(*output),
+		},
+	)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return taskMsg, olapMsg, nil
+}
+
+func approvalEventMessage(output ApprovalOutput) string {
+	verb := "rejected"
+
+	if output.Approved {
+		verb = "approved"
+	}
+
+	if output.Expired {
+		return fmt.Sprintf("Approval expired and was %s automatically", verb)
+	}
+
+	if output.Approver != nil {
+		return fmt.Sprintf("Approval %s by %s", verb, *output.Approver)
+	}
+
+	return fmt.Sprintf("Approval %s", verb)
+}
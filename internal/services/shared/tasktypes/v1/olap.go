@@ -28,6 +28,44 @@ func CELEvaluationFailureMessage(tenantId uuid.UUID, failures []v1.CELEvaluation
 	)
 }
 
+type EventSchemaValidationFailures struct {
+	Failures []v1.EventSchemaValidationFailure
+}
+
+func EventSchemaValidationFailureMessage(tenantId uuid.UUID, failures []v1.EventSchemaValidationFailure) (*msgqueue.Message, error) {
+	return msgqueue.NewTenantMessage(
+		tenantId,
+		msgqueue.MsgIDEventSchemaValidationFailure,
+		false,
+		true,
+		EventSchemaValidationFailures{
+			Failures: failures,
+		},
+	)
+}
+
+type TaskSlotUsagePayload struct {
+	Usage []v1.TaskSlotUsage `json:"usage"`
+}
+
+// TaskSlotUsageMessage returns a message recording the slot usage of released tasks, or nil if there
+// is no usage to record.
+func TaskSlotUsageMessage(tenantId uuid.UUID, usage []v1.TaskSlotUsage) (*msgqueue.Message, error) {
+	if len(usage) == 0 {
+		return nil, nil
+	}
+
+	return msgqueue.NewTenantMessage(
+		tenantId,
+		msgqueue.MsgIDTaskSlotUsage,
+		false,
+		true,
+		TaskSlotUsagePayload{
+			Usage: usage,
+		},
+	)
+}
+
 type CreatedTaskPayload struct {
 	*v1.V1TaskWithPayload
 	RequeueCount int `json:"requeue_count"`
@@ -111,6 +111,9 @@ type FailedTaskPayload struct {
 
 	// (optional) A boolean flag to indicate whether the error is non-retryable, meaning it should _not_ be retried. Defaults to false.
 	IsNonRetryable bool `json:"is_non_retryable"`
+
+	// (optional) the error class reported by the worker, matched against the task's retry policies
+	ErrorClass string `json:"error_class,omitempty"`
 }
 
 func FailedTaskMessage(
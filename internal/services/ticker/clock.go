@@ -0,0 +1,57 @@
+package ticker
+
+import (
+	"time"
+
+	"github.com/jonboulle/clockwork"
+
+	"github.com/hatchet-dev/hatchet/pkg/clock"
+)
+
+// gocronClock adapts the engine clock to the clockwork.Clock interface so user crons follow
+// the engine clock. gocron only reads the time and schedules runs with AfterFunc, the rest
+// of the interface is delegated to the wall clock.
+type gocronClock struct {
+	clockwork.Clock
+
+	c clock.Clock
+}
+
+func newGocronClock(c clock.Clock) clockwork.Clock {
+	return &gocronClock{
+		Clock: clockwork.NewRealClock(),
+		c:     c,
+	}
+}
+
+func (g *gocronClock) Now() time.Time {
+	return g.c.Now()
+}
+
+func (g *gocronClock) Since(t time.Time) time.Duration {
+	return g.c.Now().Sub(t)
+}
+
+func (g *gocronClock) Until(t time.Time) time.Duration {
+	return t.Sub(g.c.Now())
+}
+
+func (g *gocronClock) AfterFunc(d time.Duration, f func()) clockwork.Timer {
+	return &gocronTimer{
+		Timer: g.c.AfterFunc(d, f),
+	}
+}
+
+type gocronTimer struct {
+	clock.Timer
+}
+
+// Chan returns nil, as AfterFunc timers don't deliver on a channel.
+func (t *gocronTimer) Chan() <-chan time.Time {
+	return nil
+}
+
+// Reset is not supported: gocron stops AfterFunc timers and creates new ones instead.
+func (t *gocronTimer) Reset(time.Duration) bool {
+	return false
+}
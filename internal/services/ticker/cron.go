@@ -103,7 +103,7 @@ func (t *TickerImpl) handleScheduleCron(ctx context.Context, cron *sqlcv1.PollCr
 			if scheduledAt == nil {
 				// this should be pretty rare in practice, since we're assigning the `scheduledAtPtr` immediately before
 				// it triggers, but just here for nil safety
-				now := time.Now().UTC()
+				now := t.clock.Now().UTC()
 				scheduledAt = &now
 			}
 
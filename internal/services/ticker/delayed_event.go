@@ -0,0 +1,93 @@
+package ticker
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hatchet-dev/hatchet/internal/msgqueue"
+	tasktypes "github.com/hatchet-dev/hatchet/internal/services/shared/tasktypes/v1"
+)
+
+// delayedEventPollLimit is the number of delayed events delivered per poll. When a poll is full,
+// the ticker polls again straight away.
+const delayedEventPollLimit = 1000
+
+func (t *TickerImpl) runPollDelayedEvents(ctx context.Context) func() {
+	return func() {
+		ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+		defer cancel()
+
+		t.l.Debug().Ctx(ctx).Msgf("ticker: polling delayed events")
+
+		for {
+			polled, err := t.deliverDelayedEvents(ctx)
+
+			if err != nil {
+				t.l.Err(err).Ctx(ctx).Msg("could not deliver delayed events")
+				return
+			}
+
+			if polled < delayedEventPollLimit {
+				return
+			}
+		}
+	}
+}
+
+// deliverDelayedEvents sends the delayed events which are due to the task processing queue, as if
+// they were pushed now, and returns the number of events which were polled. Events which could not
+// be sent are delivered by a later poll once their lease expires.
+func (t *TickerImpl) deliverDelayedEvents(ctx context.Context) (int, error) {
+	events, err := t.repov1.DelayedEvents().PollDelayedEvents(ctx, delayedEventPollLimit)
+
+	if err != nil {
+		return 0, fmt.Errorf("could not poll delayed events: %w", err)
+	}
+
+	delivered := make([]int64, 0, len(events))
+
+	for _, event := range events {
+		payload := tasktypes.UserEventTaskPayload{
+			EventExternalId:         event.ExternalID,
+			EventSeenAt:             time.Now().UTC(),
+			EventKey:                event.Key,
+			EventData:               event.Data,
+			EventAdditionalMetadata: event.AdditionalMetadata,
+		}
+
+		if event.Priority.Valid {
+			payload.EventPriority = &event.Priority.Int32
+		}
+
+		if event.Scope.Valid {
+			payload.EventScope = &event.Scope.String
+		}
+
+		msg, err := msgqueue.NewTenantMessage(
+			event.TenantID,
+			msgqueue.MsgIDUserEvent,
+			false,
+			true,
+			payload,
+		)
+
+		if err != nil {
+			t.l.Err(err).Ctx(ctx).Msgf("could not create message for delayed event %s", event.ExternalID)
+			continue
+		}
+
+		if err := t.mqv1.SendMessage(ctx, msgqueue.TASK_PROCESSING_QUEUE, msg); err != nil {
+			t.l.Err(err).Ctx(ctx).Msgf("could not deliver delayed event %s", event.ExternalID)
+			continue
+		}
+
+		delivered = append(delivered, event.ID)
+	}
+
+	if err := t.repov1.DelayedEvents().DeleteDelayedEvents(ctx, delivered); err != nil {
+		return 0, fmt.Errorf("could not delete delivered delayed events: %w", err)
+	}
+
+	return len(events), nil
+}
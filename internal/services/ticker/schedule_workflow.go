@@ -78,15 +78,17 @@ func (t *TickerImpl) handleScheduleWorkflow(ctx context.Context, scheduledWorkfl
 		return fmt.Errorf("workflow %s already scheduled", key)
 	}
 
+	now := t.clock.Now()
+
 	// if start is in the past, run now
-	if triggerAt.Before(time.Now()) {
+	if triggerAt.Before(now) {
 		t.l.Debug().Ctx(ctx).Msg("ticker: trigger time is in the past, running now")
 
 		t.runScheduledWorkflow(tenantId, workflowVersionId, scheduledWorkflowId, scheduledWorkflow)()
 		return nil
 	}
 
-	duration := time.Until(triggerAt)
+	duration := triggerAt.Sub(now)
 
 	// create a cancellation context for this specific scheduled workflow
 	cancelCtx, cancel := context.WithTimeout(context.Background(), duration*2)
@@ -99,13 +101,18 @@ func (t *TickerImpl) handleScheduleWorkflow(ctx context.Context, scheduledWorkfl
 		defer cancel()
 		defer t.scheduledWorkflows.Delete(key)
 
-		timer := time.After(duration)
+		// the engine clock fires early if it's advanced in a time travel build
+		fired := make(chan struct{})
+		timer := t.clock.AfterFunc(duration, func() {
+			close(fired)
+		})
+		defer timer.Stop()
 
 		select {
 		case <-cancelCtx.Done():
 			t.l.Debug().Ctx(ctx).Msgf("ticker: scheduled workflow %s was cancelled", key)
 			return
-		case <-timer:
+		case <-fired:
 			t.runScheduledWorkflow(tenantId, workflowVersionId, scheduledWorkflowId, scheduledWorkflow)()
 		}
 	}()
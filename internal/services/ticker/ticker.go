@@ -14,6 +14,7 @@ import (
 	"github.com/hatchet-dev/hatchet/internal/integrations/alerting"
 	"github.com/hatchet-dev/hatchet/internal/msgqueue"
 	"github.com/hatchet-dev/hatchet/internal/syncx"
+	"github.com/hatchet-dev/hatchet/pkg/clock"
 	"github.com/hatchet-dev/hatchet/pkg/logger"
 	v1 "github.com/hatchet-dev/hatchet/pkg/repository"
 )
@@ -34,6 +35,8 @@ type TickerImpl struct {
 
 	dv datautils.DataDecoderValidator
 
+	clock clock.Clock
+
 	tickerId uuid.UUID
 
 	userCronScheduler     gocron.Scheduler
@@ -56,7 +59,8 @@ type TickerOpts struct {
 	tickerId uuid.UUID
 	ta       *alerting.TenantAlertManager
 
-	dv datautils.DataDecoderValidator
+	dv    datautils.DataDecoderValidator
+	clock clock.Clock
 }
 
 func defaultTickerOpts() *TickerOpts {
@@ -65,6 +69,7 @@ func defaultTickerOpts() *TickerOpts {
 		l:        &logger,
 		tickerId: uuid.New(),
 		dv:       datautils.NewDataDecoderValidator(),
+		clock:    clock.Engine(),
 	}
 }
 
@@ -92,6 +97,14 @@ func WithTenantAlerter(ta *alerting.TenantAlertManager) TickerOpt {
 	}
 }
 
+// WithClock sets the clock scheduled and cron workflows are triggered by. Defaults to the
+// engine clock.
+func WithClock(c clock.Clock) TickerOpt {
+	return func(opts *TickerOpts) {
+		opts.clock = c
+	}
+}
+
 func New(fs ...TickerOpt) (*TickerImpl, error) {
 	opts := defaultTickerOpts()
 
@@ -120,7 +133,7 @@ func New(fs ...TickerOpt) (*TickerImpl, error) {
 		return nil, fmt.Errorf("could not create scheduler: %w", err)
 	}
 
-	userCronScheduler, err := gocron.NewScheduler(gocron.WithLocation(time.UTC))
+	userCronScheduler, err := gocron.NewScheduler(gocron.WithLocation(time.UTC), gocron.WithClock(newGocronClock(opts.clock)))
 
 	if err != nil {
 		return nil, fmt.Errorf("could not create user cron scheduler: %w", err)
@@ -132,6 +145,7 @@ func New(fs ...TickerOpt) (*TickerImpl, error) {
 		repov1:                 opts.repov1,
 		s:                      s,
 		dv:                     opts.dv,
+		clock:                  opts.clock,
 		tickerId:               opts.tickerId,
 		ta:                     opts.ta,
 		userCronScheduler:      userCronScheduler,
@@ -209,6 +223,20 @@ func (t *TickerImpl) Start() (func() error, error) {
 		return nil, fmt.Errorf("could not create poll cron schedules job: %w", err)
 	}
 
+	_, err = t.s.NewJob(
+		// delayed events are polled every second, so that they're delivered close to their delivery time
+		gocron.DurationJob(time.Second),
+		gocron.NewTask(
+			t.runPollDelayedEvents(ctx),
+		),
+		gocron.WithSingletonMode(gocron.LimitModeReschedule),
+	)
+
+	if err != nil {
+		cancel()
+		return nil, fmt.Errorf("could not create poll delayed events job: %w", err)
+	}
+
 	// poll for expiring tokens every 15 minutes
 	_, err = t.s.NewJob(
 		gocron.DurationJob(time.Minute*15),
@@ -53,6 +53,7 @@ const (
 	Memo      Action = "memo"
 	WaitFor   Action = "wait-for"
 	Connect   Action = "connect"
+	Signal    Action = "signal"
 )
 
 type Properties map[string]interface{}
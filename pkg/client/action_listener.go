@@ -43,6 +43,9 @@ type actionListenerImpl struct {
 	// goroutine — classifyActionError and connectOnce both execute there.
 	listenerStrategy ListenerStrategy
 
+	// dynamicLabels returns the labels reported on every heartbeat, if set
+	dynamicLabels func() map[string]interface{}
+
 	actionStreamOnce sync.Once
 }
 
@@ -78,10 +81,18 @@ func (a *actionListenerImpl) heartbeatLoop(ctx context.Context) {
 
 			a.l.Debug().Ctx(ctx).Str("worker_id", a.workerId).Msg("updating worker heartbeat")
 
-			_, err := a.client.Heartbeat(a.ctx.newContext(ctx), &dispatchercontracts.HeartbeatRequest{
+			req := &dispatchercontracts.HeartbeatRequest{
 				WorkerId:    a.workerId,
 				HeartbeatAt: timestamppb.New(now),
-			})
+			}
+
+			if a.dynamicLabels != nil {
+				req.DynamicLabels = &dispatchercontracts.DynamicWorkerLabels{
+					Labels: mapLabels(a.dynamicLabels()),
+				}
+			}
+
+			_, err := a.client.Heartbeat(a.ctx.newContext(ctx), req)
 
 			if err != nil {
 				a.l.Error().Ctx(ctx).Err(err).Str("worker_id", a.workerId).Msg("could not update worker heartbeat")
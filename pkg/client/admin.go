@@ -175,6 +175,11 @@ type AdminClient interface {
 	PutRateLimit(key string, opts *types.RateLimitOpts) error
 
 	GetRunDetails(ctx context.Context, externalId uuid.UUID) (*RunDetails, error)
+
+	// AdvanceClock moves the engine clock forward by d and returns the engine's total clock
+	// offset. Only engines built with the timetravel build tag and started with
+	// SERVER_ALLOW_TIME_TRAVEL support this.
+	AdvanceClock(ctx context.Context, d time.Duration) (time.Duration, error)
 }
 
 type DedupeViolationErr struct {
@@ -382,8 +387,9 @@ func desiredWorkerLabelsToProto(labels map[string]*types.DesiredWorkerLabel) map
 
 	for key, label := range labels {
 		proto := &admincontracts.DesiredWorkerLabels{
-			Required: &label.Required,
-			Weight:   &label.Weight,
+			Required:  &label.Required,
+			Weight:    &label.Weight,
+			StrValues: label.Values,
 		}
 
 		if label.Comparator != nil {
@@ -653,6 +659,18 @@ func (a *adminClientImpl) GetRunDetails(ctx context.Context, externalId uuid.UUI
 	}, nil
 }
 
+func (a *adminClientImpl) AdvanceClock(ctx context.Context, d time.Duration) (time.Duration, error) {
+	resp, err := a.v1Client.AdvanceClock(a.ctx.newContext(ctx), &v1contracts.AdvanceClockRequest{
+		AdvanceBy: d.String(),
+	})
+
+	if err != nil {
+		return 0, fmt.Errorf("could not advance engine clock: %w", err)
+	}
+
+	return time.Duration(resp.GetOffsetMs()) * time.Millisecond, nil
+}
+
 func (a *adminClientImpl) getPutRequest(workflow *types.Workflow) (*admincontracts.PutWorkflowRequest, error) {
 	opts := &admincontracts.CreateWorkflowVersionOpts{
 		Name:          workflow.Name,
@@ -811,8 +829,9 @@ func (a *adminClientImpl) getJobOpts(jobName string, job *types.WorkflowJob) (*a
 			stepOpt.WorkerLabels = make(map[string]*admincontracts.DesiredWorkerLabels, len(step.DesiredLabels))
 			for key, desiredLabel := range step.DesiredLabels {
 				stepOpt.WorkerLabels[key] = &admincontracts.DesiredWorkerLabels{
-					Required: &desiredLabel.Required,
-					Weight:   &desiredLabel.Weight,
+					Required:  &desiredLabel.Required,
+					Weight:    &desiredLabel.Weight,
+					StrValues: desiredLabel.Values,
 				}
 
 				switch value := desiredLabel.Value.(type) {
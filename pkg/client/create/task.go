@@ -69,6 +69,21 @@ type WorkflowTask[I, O any] struct {
 	// (optional) SlotCost is the number of default worker slots this task consumes. Defaults to one.
 	// Durable tasks ignore it.
 	SlotCost *int32
+
+	// (optional) RetryPolicies override Retries and the backoff for failures which match them
+	RetryPolicies []*types.RetryPolicy
+
+	// (optional) CircuitBreaker holds the task in the queue after repeated failures
+	CircuitBreaker *types.CircuitBreaker
+
+	// (optional) Preemption lets the engine preempt the running task for higher-priority tasks
+	Preemption *types.Preemption
+
+	// (optional) Map fans the task out into one run per element of a list
+	Map *types.TaskMap
+
+	// (optional) Approval makes the task wait for a person to approve or reject it
+	Approval *types.TaskApproval
 }
 
 type WorkflowOnFailureTask[I, O any] struct {
@@ -90,4 +90,7 @@ type WorkflowCreateOpts[I any] struct {
 
 	// (optional) Idempotency configuration for preventing duplicate runs
 	Idempotency *IdempotencyConfig
+
+	// (optional) Raises the priority of the workflow's queued tasks the longer they wait
+	PriorityAging *types.PriorityAging
 }
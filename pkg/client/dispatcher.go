@@ -60,10 +60,27 @@ type GetActionListenerRequest struct {
 	Labels     map[string]interface{}
 	WebhookId  *string
 
+	// DynamicLabels, when non-nil, is called on every heartbeat and its labels are reported as the
+	// worker's dynamic labels, which replace the ones reported on the previous heartbeat.
+	DynamicLabels func() map[string]interface{}
+
 	// LegacySlots, when non-nil, causes the registration to use the deprecated
 	// `slots` proto field instead of `slot_config`. This is for backward
 	// compatibility with engines that do not support multiple slot types.
 	LegacySlots *int32
+
+	// ScalingTargets, when non-nil, declares the autoscaling targets of the
+	// worker's pool to the engine.
+	ScalingTargets *WorkerScalingTargets
+}
+
+// WorkerScalingTargets are the autoscaling targets a worker declares for its pool.
+type WorkerScalingTargets struct {
+	// SlotsPerReplica is the number of slots a single replica of the pool should serve.
+	SlotsPerReplica *int32
+
+	// MaxQueueAge is the longest a task should wait in the queue before the pool scales up.
+	MaxQueueAge *time.Duration
 }
 
 // ActionPayload unmarshals the action payload into the target. It also validates the resulting target.
@@ -233,6 +250,9 @@ type ActionEvent struct {
 
 	// If this is an error, whether to retry on failure
 	ShouldNotRetry *bool
+
+	// If this is an error, the error class matched against the task's retry policies
+	ErrorClass *string
 }
 
 type ActionEventResponse struct {
@@ -308,6 +328,17 @@ func (d *dispatcherClientImpl) newActionListener(ctx context.Context, req *GetAc
 		},
 	}
 
+	if req.ScalingTargets != nil {
+		registerReq.ScalingTargets = &dispatchercontracts.WorkerScalingTargets{
+			SlotsPerReplica: req.ScalingTargets.SlotsPerReplica,
+		}
+
+		if req.ScalingTargets.MaxQueueAge != nil {
+			maxQueueAgeSeconds := int32(req.ScalingTargets.MaxQueueAge.Seconds()) // nolint: gosec
+			registerReq.ScalingTargets.MaxQueueAgeSeconds = &maxQueueAgeSeconds
+		}
+	}
+
 	registerReq.Labels = map[string]*dispatchercontracts.WorkerLabels{}
 
 	if req.Labels != nil {
@@ -360,6 +391,7 @@ func (d *dispatcherClientImpl) newActionListener(ctx context.Context, req *GetAc
 		tenantId:         d.tenantId,
 		ctx:              d.ctx,
 		listenerStrategy: ListenerStrategyV2,
+		dynamicLabels:    req.DynamicLabels,
 	}, &resp.WorkerId, nil
 }
 
@@ -412,6 +444,7 @@ func (d *dispatcherClientImpl) SendStepActionEvent(ctx context.Context, in *Acti
 		EventPayload:      string(payloadBytes),
 		RetryCount:        &in.RetryCount,
 		ShouldNotRetry:    in.ShouldNotRetry,
+		ErrorClass:        in.ErrorClass,
 	})
 
 	if err != nil {
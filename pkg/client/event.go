@@ -6,6 +6,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"time"
 
 	"github.com/rs/zerolog"
 	"go.opentelemetry.io/otel"
@@ -65,6 +66,9 @@ type pushOpt struct {
 	additionalMetadata map[string]string
 	priority           *int32
 	scope              *string
+	dedupeKey          *string
+	deliverAt          *time.Time
+	delay              *time.Duration
 }
 
 type PushOpFunc func(*pushOpt) error
@@ -92,6 +96,13 @@ type EventClient interface {
 
 	PutLogWithTimestamp(ctx context.Context, taskRunId, msg string, level *string, taskRetryCount *int32, createdAt *timestamppb.Timestamp) error
 
+	// StreamLog buffers a log line which is written to the engine in a batch with other lines. It
+	// blocks while the buffer is full, until ctx is done.
+	StreamLog(ctx context.Context, taskRunId, msg string, level *string, taskRetryCount *int32, workerId *string, createdAt *timestamppb.Timestamp) error
+
+	// FlushLogs waits until the log lines buffered by StreamLog so far are written, or ctx is done.
+	FlushLogs(ctx context.Context) error
+
 	PutStreamEvent(ctx context.Context, stepRunId string, message []byte, options ...StreamEventOption) error
 }
 
@@ -101,6 +112,9 @@ type EventWithAdditionalMetadata struct {
 	Key                string            `json:"key"`
 	Priority           *int32            `json:"priority"`
 	Scope              *string           `json:"scope"`
+	DedupeKey          *string           `json:"dedupeKey,omitempty"`
+	DeliverAt          *time.Time        `json:"deliverAt,omitempty"`
+	Delay              *time.Duration    `json:"delay,omitempty"`
 }
 
 type eventClientImpl struct {
@@ -117,11 +131,16 @@ type eventClientImpl struct {
 	ctx *contextLoader
 
 	sharedMeta map[string]string
+
+	logs *logStreamer
 }
 
 func newEvent(conn *grpc.ClientConn, opts *sharedClientOpts) EventClient {
+	client := eventcontracts.NewEventsServiceClient(conn)
+
 	return &eventClientImpl{
-		client:     eventcontracts.NewEventsServiceClient(conn),
+		client:     client,
+		logs:       newLogStreamer(client, opts.ctxLoader, opts.l),
 		tenantId:   opts.tenantId,
 		namespace:  opts.namespace,
 		l:          opts.l,
@@ -153,6 +172,39 @@ func WithFilterScope(scope *string) PushOpFunc {
 	}
 }
 
+// WithEventDedupeKey deduplicates the event against other events pushed with the same key. While the
+// key is held by the engine, pushing an event with the same key doesn't trigger workflows again.
+func WithEventDedupeKey(key string) PushOpFunc {
+	return func(r *pushOpt) error {
+		r.dedupeKey = &key
+		return nil
+	}
+}
+
+// WithEventDeliverAt holds the event until the given time before triggering workflows.
+func WithEventDeliverAt(deliverAt time.Time) PushOpFunc {
+	return func(r *pushOpt) error {
+		if r.delay != nil {
+			return fmt.Errorf("WithEventDeliverAt cannot be used with WithEventDelay")
+		}
+
+		r.deliverAt = &deliverAt
+		return nil
+	}
+}
+
+// WithEventDelay holds the event for the given duration before triggering workflows.
+func WithEventDelay(delay time.Duration) PushOpFunc {
+	return func(r *pushOpt) error {
+		if r.deliverAt != nil {
+			return fmt.Errorf("WithEventDelay cannot be used with WithEventDeliverAt")
+		}
+
+		r.delay = &delay
+		return nil
+	}
+}
+
 func (a *eventClientImpl) Push(ctx context.Context, eventKey string, payload interface{}, options ...PushOpFunc) error {
 	sourceInfo, _ := getSourceInfo(ctx)
 
@@ -209,6 +261,7 @@ func (a *eventClientImpl) Push(ctx context.Context, eventKey string, payload int
 	request.AdditionalMetadata = &additionalMetaString
 	request.Priority = opts.priority
 	request.Scope = opts.scope
+	request.DedupeKey, request.DeliverAt, request.Delay = a.deliveryOpts(opts.dedupeKey, opts.deliverAt, opts.delay)
 
 	_, err = a.client.Push(a.ctx.newContext(ctx), &request)
 
@@ -257,14 +310,24 @@ func (a *eventClientImpl) BulkPush(ctx context.Context, payload []EventWithAddit
 		}
 		eMetadataString := string(eMetadata)
 
-		events = append(events, &eventcontracts.PushEventRequest{
+		if p.DeliverAt != nil && p.Delay != nil {
+			err := fmt.Errorf("event %s cannot set both DeliverAt and Delay", p.Key)
+			span.SetStatus(codes.Error, err.Error())
+			return err
+		}
+
+		e := &eventcontracts.PushEventRequest{
 			Key:                a.namespace + p.Key,
 			EventTimestamp:     timestamppb.Now(),
 			Payload:            string(ePayload),
 			AdditionalMetadata: &eMetadataString,
 			Priority:           p.Priority,
 			Scope:              p.Scope,
-		})
+		}
+
+		e.DedupeKey, e.DeliverAt, e.Delay = a.deliveryOpts(p.DedupeKey, p.DeliverAt, p.Delay)
+
+		events = append(events, e)
 	}
 
 	request.Events = events
@@ -280,6 +343,30 @@ func (a *eventClientImpl) BulkPush(ctx context.Context, payload []EventWithAddit
 	return nil
 }
 
+// deliveryOpts converts an event's dedupe key and delivery time to their request fields. Dedupe keys
+// are namespaced, so that namespaces sharing a tenant don't deduplicate each other's events.
+func (a *eventClientImpl) deliveryOpts(dedupeKey *string, deliverAt *time.Time, delay *time.Duration) (*string, *timestamppb.Timestamp, *string) {
+	var resDedupeKey *string
+	var resDeliverAt *timestamppb.Timestamp
+	var resDelay *string
+
+	if dedupeKey != nil {
+		namespaced := client.ApplyNamespace(*dedupeKey, &a.namespace)
+		resDedupeKey = &namespaced
+	}
+
+	if deliverAt != nil {
+		resDeliverAt = timestamppb.New(*deliverAt)
+	}
+
+	if delay != nil {
+		delayStr := delay.String()
+		resDelay = &delayStr
+	}
+
+	return resDedupeKey, resDeliverAt, resDelay
+}
+
 func (a *eventClientImpl) PutLog(ctx context.Context, taskRunId, msg string, level *string, taskRetryCount *int32) error {
 	return a.PutLogWithTimestamp(ctx, taskRunId, msg, level, taskRetryCount, timestamppb.Now())
 }
@@ -296,6 +383,21 @@ func (a *eventClientImpl) PutLogWithTimestamp(ctx context.Context, taskRunId, ms
 	return err
 }
 
+func (a *eventClientImpl) StreamLog(ctx context.Context, taskRunId, msg string, level *string, taskRetryCount *int32, workerId *string, createdAt *timestamppb.Timestamp) error {
+	return a.logs.put(ctx, &eventcontracts.PutLogRequest{
+		CreatedAt:         createdAt,
+		TaskRunExternalId: taskRunId,
+		Message:           msg,
+		Level:             level,
+		TaskRetryCount:    taskRetryCount,
+		WorkerId:          workerId,
+	})
+}
+
+func (a *eventClientImpl) FlushLogs(ctx context.Context) error {
+	return a.logs.flush(ctx)
+}
+
 func (a *eventClientImpl) PutStreamEvent(ctx context.Context, taskRunId string, message []byte, options ...StreamEventOption) error {
 	opts := &streamEventOpts{}
 
@@ -0,0 +1,359 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"io"
+	"slices"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/rs/zerolog"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	eventcontracts "github.com/hatchet-dev/hatchet/internal/services/ingestor/contracts"
+	"github.com/hatchet-dev/hatchet/pkg/client/retry"
+)
+
+const (
+	// the maximum number of log lines in a batch
+	logBatchSize = 100
+
+	// how long a log line waits for its batch to fill before it's sent
+	logFlushInterval = 200 * time.Millisecond
+
+	// the maximum number of log lines which haven't been sent. Writing a log line blocks while the
+	// buffer is full, which slows the task down when the engine is pushing back.
+	maxBufferedLogLines = 10_000
+
+	// the maximum number of batches which have been sent but not acknowledged
+	maxInFlightLogBatches = 10
+)
+
+type logBatch struct {
+	sequence int64
+	logs     []*eventcontracts.PutLogRequest
+}
+
+// logStreamer buffers log lines and writes them to the engine in batches over the PutLogs stream.
+// Each batch has a sequence number which the engine acknowledges once the batch is written, and
+// batches which weren't acknowledged are sent again when the stream reconnects. Engines without
+// PutLogs get the lines through PutLog instead.
+type logStreamer struct {
+	client eventcontracts.EventsServiceClient
+	ctx    *contextLoader
+	l      *zerolog.Logger
+
+	start sync.Once
+
+	// sleep waits out the reconnect backoff. Tests inject a no-op.
+	sleep func(ctx context.Context, attempt int) error
+
+	// wake tells the sender to send the buffered lines without waiting for the flush interval
+	wake chan struct{}
+
+	mu sync.Mutex
+
+	buffer  []*eventcontracts.PutLogRequest
+	pending []*logBatch
+
+	lastSequence int64
+
+	// enqueued and completed count the lines which were buffered and the lines which were
+	// acknowledged or dropped, which is what Flush waits on
+	enqueued  int64
+	completed int64
+
+	// changed is closed and replaced whenever lines leave the buffer or complete
+	changed chan struct{}
+}
+
+func newLogStreamer(client eventcontracts.EventsServiceClient, ctx *contextLoader, l *zerolog.Logger) *logStreamer {
+	return &logStreamer{
+		client:  client,
+		ctx:     ctx,
+		l:       l,
+		sleep:   retry.SleepStreamBackoff,
+		wake:    make(chan struct{}, 1),
+		changed: make(chan struct{}),
+	}
+}
+
+// put buffers a log line. It blocks while the buffer is full, until ctx is done.
+func (s *logStreamer) put(ctx context.Context, req *eventcontracts.PutLogRequest) error {
+	s.start.Do(func() {
+		go s.run()
+	})
+
+	s.mu.Lock()
+
+	for len(s.buffer) >= maxBufferedLogLines {
+		changed := s.changed
+		s.mu.Unlock()
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-changed:
+		}
+
+		s.mu.Lock()
+	}
+
+	s.buffer = append(s.buffer, req)
+	s.enqueued++
+	full := len(s.buffer) >= logBatchSize
+
+	s.mu.Unlock()
+
+	if full {
+		s.notify()
+	}
+
+	return nil
+}
+
+// flush sends the buffered lines and waits until every line buffered before the call has been
+// acknowledged or dropped, or ctx is done.
+func (s *logStreamer) flush(ctx context.Context) error {
+	s.mu.Lock()
+	target := s.enqueued
+	s.mu.Unlock()
+
+	s.notify()
+
+	for {
+		s.mu.Lock()
+		done := s.completed >= target
+		changed := s.changed
+		s.mu.Unlock()
+
+		if done {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-changed:
+		}
+	}
+}
+
+func (s *logStreamer) notify() {
+	select {
+	case s.wake <- struct{}{}:
+	default:
+	}
+}
+
+// signalLocked wakes up the callers waiting on the buffer or on completed lines. It must be called
+// with mu held.
+func (s *logStreamer) signalLocked() {
+	close(s.changed)
+	s.changed = make(chan struct{})
+}
+
+// nextBatch moves up to logBatchSize buffered lines into a new pending batch. It returns nil if
+// there are no buffered lines or too many batches are in flight.
+func (s *logStreamer) nextBatch() *logBatch {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if len(s.buffer) == 0 || len(s.pending) >= maxInFlightLogBatches {
+		return nil
+	}
+
+	n := min(len(s.buffer), logBatchSize)
+
+	s.lastSequence++
+
+	batch := &logBatch{
+		sequence: s.lastSequence,
+		logs:     slices.Clone(s.buffer[:n]),
+	}
+
+	s.buffer = slices.Delete(s.buffer, 0, n)
+	s.pending = append(s.pending, batch)
+	s.signalLocked()
+
+	return batch
+}
+
+// complete marks the pending batches up to and including the sequence as done.
+func (s *logStreamer) complete(sequence int64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	i := 0
+
+	for ; i < len(s.pending) && s.pending[i].sequence <= sequence; i++ {
+		s.completed += int64(len(s.pending[i].logs))
+	}
+
+	if i == 0 {
+		return
+	}
+
+	s.pending = slices.Delete(s.pending, 0, i)
+	s.signalLocked()
+}
+
+func (s *logStreamer) run() {
+	ctx := context.Background()
+
+	attempt := 0
+
+	for {
+		progressed, err := s.stream(ctx)
+
+		if status.Code(err) == codes.Unimplemented {
+			s.l.Debug().Msg("engine does not support streaming logs, falling back to PutLog")
+			s.runUnary(ctx)
+			return
+		}
+
+		if progressed {
+			attempt = 0
+		}
+
+		if retry.ClassifyStreamError(ctx, err) == retry.StreamDecisionStop {
+			// the engine won't accept the pending batches on a new stream either
+			s.l.Error().Err(err).Msg("could not stream logs, dropping unacknowledged log lines")
+
+			s.mu.Lock()
+			lastSequence := s.lastSequence
+			s.mu.Unlock()
+
+			s.complete(lastSequence)
+		} else {
+			s.l.Warn().Err(err).Msg("log stream disconnected, reconnecting")
+		}
+
+		attempt++
+
+		_ = s.sleep(ctx, attempt)
+	}
+}
+
+// stream sends the pending and buffered batches on a new PutLogs stream until the stream fails. It
+// returns whether any batch was acknowledged.
+func (s *logStreamer) stream(ctx context.Context) (bool, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	stream, err := s.client.PutLogs(s.ctx.newContext(ctx))
+
+	if err != nil {
+		return false, err
+	}
+
+	var progressed atomic.Bool
+
+	recvErr := make(chan error, 1)
+
+	go func() {
+		for {
+			resp, err := stream.Recv()
+
+			if err != nil {
+				recvErr <- err
+				return
+			}
+
+			progressed.Store(true)
+
+			if resp.RejectedCount > 0 {
+				s.l.Warn().Msgf("engine rejected %d log lines", resp.RejectedCount)
+			}
+
+			s.complete(resp.Sequence)
+
+			// acknowledged batches make room for the next ones
+			s.notify()
+		}
+	}()
+
+	send := func(batch *logBatch) error {
+		err := stream.Send(&eventcontracts.PutLogsRequest{
+			Sequence: batch.sequence,
+			Logs:     batch.logs,
+		})
+
+		// the stream's error is returned by Recv
+		if errors.Is(err, io.EOF) {
+			return <-recvErr
+		}
+
+		return err
+	}
+
+	// batches which weren't acknowledged on the previous stream are sent again first. The engine
+	// may have written some of them already, so lines can be duplicated across reconnects.
+	s.mu.Lock()
+	resend := slices.Clone(s.pending)
+	s.mu.Unlock()
+
+	for _, batch := range resend {
+		if err := send(batch); err != nil {
+			return progressed.Load(), err
+		}
+	}
+
+	ticker := time.NewTicker(logFlushInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case err := <-recvErr:
+			return progressed.Load(), err
+		case <-s.wake:
+		case <-ticker.C:
+		}
+
+		for batch := s.nextBatch(); batch != nil; batch = s.nextBatch() {
+			if err := send(batch); err != nil {
+				return progressed.Load(), err
+			}
+		}
+	}
+}
+
+// runUnary writes the batches line by line through PutLog, for engines which don't implement
+// PutLogs.
+func (s *logStreamer) runUnary(ctx context.Context) {
+	// the pending batches were sent on the stream but never acknowledged
+	s.mu.Lock()
+	resend := slices.Clone(s.pending)
+	s.mu.Unlock()
+
+	for _, batch := range resend {
+		s.putUnary(ctx, batch)
+	}
+
+	ticker := time.NewTicker(logFlushInterval)
+	defer ticker.Stop()
+
+	for {
+		for batch := s.nextBatch(); batch != nil; batch = s.nextBatch() {
+			s.putUnary(ctx, batch)
+		}
+
+		select {
+		case <-s.wake:
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s *logStreamer) putUnary(ctx context.Context, batch *logBatch) {
+	for _, req := range batch.logs {
+		if _, err := s.client.PutLog(s.ctx.newContext(ctx), req); err != nil {
+			s.l.Warn().Err(err).Msg("could not put log")
+		}
+	}
+
+	s.complete(batch.sequence)
+}
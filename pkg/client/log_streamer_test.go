@@ -0,0 +1,211 @@
+package client
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	eventcontracts "github.com/hatchet-dev/hatchet/internal/services/ingestor/contracts"
+)
+
+type mockEventsClient struct {
+	eventcontracts.EventsServiceClient
+
+	putLogsFn func(ctx context.Context) (eventcontracts.EventsService_PutLogsClient, error)
+	putLogFn  func(req *eventcontracts.PutLogRequest) error
+}
+
+func (m *mockEventsClient) PutLogs(ctx context.Context, opts ...grpc.CallOption) (eventcontracts.EventsService_PutLogsClient, error) {
+	return m.putLogsFn(ctx)
+}
+
+func (m *mockEventsClient) PutLog(ctx context.Context, req *eventcontracts.PutLogRequest, opts ...grpc.CallOption) (*eventcontracts.PutLogResponse, error) {
+	return &eventcontracts.PutLogResponse{}, m.putLogFn(req)
+}
+
+// mockPutLogsStream records the batches it's sent, and acknowledges them if ack is set.
+type mockPutLogsStream struct {
+	grpc.ClientStream
+
+	ctx  context.Context
+	ack  bool
+	acks chan *eventcontracts.PutLogsResponse
+
+	// recvErr is returned by Recv once a batch was sent, if it's set
+	recvErr error
+
+	mu      sync.Mutex
+	batches []*eventcontracts.PutLogsRequest
+}
+
+func newMockPutLogsStream(ctx context.Context, ack bool, recvErr error) *mockPutLogsStream {
+	return &mockPutLogsStream{
+		ctx:     ctx,
+		ack:     ack,
+		acks:    make(chan *eventcontracts.PutLogsResponse, 100),
+		recvErr: recvErr,
+	}
+}
+
+func (m *mockPutLogsStream) Send(req *eventcontracts.PutLogsRequest) error {
+	m.mu.Lock()
+	m.batches = append(m.batches, req)
+	m.mu.Unlock()
+
+	if m.ack || m.recvErr != nil {
+		m.acks <- &eventcontracts.PutLogsResponse{Sequence: req.Sequence}
+	}
+
+	return nil
+}
+
+func (m *mockPutLogsStream) Recv() (*eventcontracts.PutLogsResponse, error) {
+	select {
+	case <-m.ctx.Done():
+		return nil, status.Error(codes.Canceled, "stream closed")
+	case resp := <-m.acks:
+		if m.recvErr != nil {
+			return nil, m.recvErr
+		}
+
+		return resp, nil
+	}
+}
+
+func (m *mockPutLogsStream) CloseSend() error {
+	return nil
+}
+
+func (m *mockPutLogsStream) sent() []*eventcontracts.PutLogsRequest {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return append([]*eventcontracts.PutLogsRequest{}, m.batches...)
+}
+
+func newTestLogStreamer(client eventcontracts.EventsServiceClient) *logStreamer {
+	l := zerolog.Nop()
+
+	s := newLogStreamer(client, newContextLoader("", nil), &l)
+	s.sleep = func(context.Context, int) error { return nil }
+
+	return s
+}
+
+func putTestLogs(t *testing.T, s *logStreamer, n int) {
+	t.Helper()
+
+	for i := range n {
+		require.NoError(t, s.put(context.Background(), &eventcontracts.PutLogRequest{
+			TaskRunExternalId: "task",
+			Message:           string(rune('a' + i%26)),
+		}))
+	}
+}
+
+func flushTestLogs(t *testing.T, s *logStreamer) {
+	t.Helper()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	require.NoError(t, s.flush(ctx))
+}
+
+func TestLogStreamer_SendsBatchesAndFlushes(t *testing.T) {
+	var stream *mockPutLogsStream
+
+	s := newTestLogStreamer(&mockEventsClient{
+		putLogsFn: func(ctx context.Context) (eventcontracts.EventsService_PutLogsClient, error) {
+			stream = newMockPutLogsStream(ctx, true, nil)
+			return stream, nil
+		},
+	})
+
+	putTestLogs(t, s, 250)
+	flushTestLogs(t, s)
+
+	batches := stream.sent()
+	lines := 0
+
+	for i, batch := range batches {
+		assert.Equal(t, int64(i+1), batch.Sequence)
+		assert.LessOrEqual(t, len(batch.Logs), logBatchSize)
+
+		lines += len(batch.Logs)
+	}
+
+	assert.Equal(t, 250, lines)
+}
+
+func TestLogStreamer_ResendsUnacknowledgedBatchesOnReconnect(t *testing.T) {
+	streams := make(chan *mockPutLogsStream, 2)
+	attempts := 0
+
+	s := newTestLogStreamer(&mockEventsClient{
+		putLogsFn: func(ctx context.Context) (eventcontracts.EventsService_PutLogsClient, error) {
+			attempts++
+
+			var stream *mockPutLogsStream
+
+			if attempts == 1 {
+				// the first stream fails before acknowledging anything
+				stream = newMockPutLogsStream(ctx, false, status.Error(codes.Unavailable, "engine restarting"))
+			} else {
+				stream = newMockPutLogsStream(ctx, true, nil)
+			}
+
+			streams <- stream
+
+			return stream, nil
+		},
+	})
+
+	putTestLogs(t, s, 10)
+	flushTestLogs(t, s)
+
+	first := <-streams
+	second := <-streams
+
+	require.Len(t, first.sent(), 1)
+	require.Len(t, second.sent(), 1)
+
+	assert.Equal(t, first.sent()[0].Sequence, second.sent()[0].Sequence)
+	assert.Len(t, second.sent()[0].Logs, 10)
+}
+
+func TestLogStreamer_FallsBackToPutLog(t *testing.T) {
+	var mu sync.Mutex
+
+	received := 0
+
+	s := newTestLogStreamer(&mockEventsClient{
+		putLogsFn: func(ctx context.Context) (eventcontracts.EventsService_PutLogsClient, error) {
+			return newMockPutLogsStream(ctx, false, status.Error(codes.Unimplemented, "unknown method PutLogs")), nil
+		},
+		putLogFn: func(req *eventcontracts.PutLogRequest) error {
+			mu.Lock()
+			defer mu.Unlock()
+
+			received++
+
+			return nil
+		},
+	})
+
+	putTestLogs(t, s, 150)
+	flushTestLogs(t, s)
+
+	mu.Lock()
+	defer mu.Unlock()
+
+	assert.Equal(t, 150, received)
+}
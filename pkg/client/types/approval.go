@@ -0,0 +1,39 @@
+package types
+
+import "time"
+
+// ApprovalExpiryAction is what happens to an approval task when nobody approves or rejects it
+// before it expires.
+type ApprovalExpiryAction string
+
+const (
+	// ApprovalExpiryFail fails the task.
+	ApprovalExpiryFail ApprovalExpiryAction = "FAIL"
+
+	// ApprovalExpiryReject completes the task as rejected.
+	ApprovalExpiryReject ApprovalExpiryAction = "REJECT"
+
+	// ApprovalExpiryApprove completes the task as approved.
+	ApprovalExpiryApprove ApprovalExpiryAction = "APPROVE"
+)
+
+// TaskApproval makes a task wait for a person to approve or reject it. Approval tasks are run by
+// the engine rather than a worker: when the task runs, the engine records a pending approval and
+// notifies the approvers through the tenant's alerting channels. The task completes with
+// `{approved, approver, comment}` once someone approves or rejects it through the API or the CLI.
+type TaskApproval struct {
+	// Approvers are the emails of the people who can resolve the approval. Optional; any tenant
+	// member can resolve it when empty.
+	Approvers []string
+
+	// ContextFields are dot-separated paths into the task payload which are shown to approvers,
+	// e.g. `input.amount` or `parents.prepare.total`. Optional.
+	ContextFields []string
+
+	// ExpiresIn is how long the approval stays pending. Optional; defaults to 24h.
+	ExpiresIn time.Duration
+
+	// OnExpiry is what happens to the task when the approval expires. Optional; defaults to
+	// ApprovalExpiryFail.
+	OnExpiry ApprovalExpiryAction
+}
@@ -0,0 +1,25 @@
+package types
+
+import "time"
+
+// CircuitBreaker stops the engine from assigning a task after repeated failures, so queued tasks
+// don't burn their retries while a downstream dependency is down. After FailureThreshold failures
+// within Window, the breaker opens and tasks stay queued. After Cooldown, the engine assigns a
+// single probe task: the breaker closes if it succeeds and re-opens if it fails.
+//
+// Each task has its own breakers: runs of the task with the same key share a breaker.
+type CircuitBreaker struct {
+	// FailureThreshold is the number of failures within the window which opens the breaker.
+	FailureThreshold int32
+
+	// Window is the period in which failures are counted. Optional; defaults to 60s.
+	Window time.Duration
+
+	// Cooldown is how long the breaker stays open before a probe is assigned. Optional; defaults
+	// to 30s.
+	Cooldown time.Duration
+
+	// KeyExpr is a CEL expression for the breaker key with access to `input` and
+	// `additional_metadata`, e.g. `input.region`. Optional; defaults to the task's action id.
+	KeyExpr *string
+}
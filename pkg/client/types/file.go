@@ -109,6 +109,33 @@ const (
 	WorkerLabelComparator_GREATER_THAN_OR_EQUAL WorkerLabelComparator = 3
 	WorkerLabelComparator_LESS_THAN             WorkerLabelComparator = 4
 	WorkerLabelComparator_LESS_THAN_OR_EQUAL    WorkerLabelComparator = 5
+
+	// WorkerLabelComparator_IN matches workers whose label value is one of the desired label's Values.
+	WorkerLabelComparator_IN WorkerLabelComparator = 6
+
+	// WorkerLabelComparator_NOT_IN matches workers without the label, or whose label value is not one
+	// of the desired label's Values.
+	WorkerLabelComparator_NOT_IN WorkerLabelComparator = 7
+
+	// WorkerLabelComparator_EXISTS matches workers with the label, whatever its value.
+	WorkerLabelComparator_EXISTS WorkerLabelComparator = 8
+
+	// WorkerLabelComparator_NOT_EXISTS matches workers without the label.
+	WorkerLabelComparator_NOT_EXISTS WorkerLabelComparator = 9
+
+	// WorkerLabelComparator_SEMVER matches workers whose label value is a version satisfying the
+	// constraint in the desired label's Value, e.g. ">= 2.3, < 3".
+	WorkerLabelComparator_SEMVER WorkerLabelComparator = 10
+
+	// WorkerLabelComparator_NOT_COLOCATED matches workers which aren't running other tasks of the same
+	// workflow run, and don't share the label's value with a worker which is. The label is a topology
+	// key like a host or zone; a key which workers don't have only keeps the tasks off the same worker.
+	WorkerLabelComparator_NOT_COLOCATED WorkerLabelComparator = 11
+
+	// WorkerLabelComparator_BEST_FIT matches workers whose label value is at least the desired label's
+	// Value, and scales the weight so workers with the least headroom above it are preferred. It's
+	// meant for gauges which workers report on their heartbeats, like free GPU memory.
+	WorkerLabelComparator_BEST_FIT WorkerLabelComparator = 12
 )
 
 func ComparatorPtr(v WorkerLabelComparator) *WorkerLabelComparator {
@@ -116,7 +143,11 @@ func ComparatorPtr(v WorkerLabelComparator) *WorkerLabelComparator {
 }
 
 type DesiredWorkerLabel struct {
-	Value      any                    `yaml:"value,omitempty"`
+	Value any `yaml:"value,omitempty"`
+
+	// Values is the set of values for the IN and NOT_IN comparators.
+	Values []string `yaml:"values,omitempty"`
+
 	Required   bool                   `yaml:"required,omitempty"`
 	Weight     int32                  `yaml:"weight,omitempty"`
 	Comparator *WorkerLabelComparator `yaml:"comparator,omitempty"`
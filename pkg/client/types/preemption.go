@@ -0,0 +1,12 @@
+package types
+
+// Preemption lets the engine cancel the running task when a higher-priority task for the same
+// action has been waiting for a slot longer than the engine's preemption threshold. The preempted
+// task is requeued at its own priority and the new attempt doesn't count against its retries. A
+// durable task resumes from its last checkpoint; any other task starts over, so only enable it for
+// tasks which are safe to restart.
+type Preemption struct {
+	// MaxPreemptions is the number of times a single run of the task may be preempted. Optional;
+	// defaults to 3.
+	MaxPreemptions int32
+}
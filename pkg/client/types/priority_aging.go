@@ -0,0 +1,19 @@
+package types
+
+import "time"
+
+// PriorityAging raises the priority of a workflow's queued tasks the longer they wait, so that
+// sustained load at a higher priority can't starve them. A task's priority is raised by one level
+// for every Interval it waits, up to the highest priority. Once a task has waited for MaxWait, it
+// is ordered ahead of every task which has not. At least one of the two must be set.
+//
+// Time in queue is measured from when the task was created. Aging only changes the order in which
+// tasks are assigned: a task still needs a free slot on a worker to run.
+type PriorityAging struct {
+	// Interval is the time in queue after which the priority is raised by one level. Optional.
+	Interval time.Duration
+
+	// MaxWait is the time in queue after which the task is ordered ahead of all other tasks.
+	// Optional.
+	MaxWait time.Duration
+}
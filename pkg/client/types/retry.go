@@ -0,0 +1,30 @@
+package types
+
+// RetryPolicy overrides a task's retries and backoff for failures which match it. The engine
+// evaluates a task's policies in order and applies the first match; failures which match no policy
+// use the task's own retries and backoff.
+//
+// A policy matches when ErrorClass (if set) equals the class of the failure and Expression (if
+// set) evaluates to true. A policy with neither set matches every failure.
+type RetryPolicy struct {
+	// ErrorClass is the class of errors this policy applies to, as reported by the worker. Tasks
+	// which exceed their timeout fail with the class "TIMEOUT".
+	ErrorClass *string
+
+	// Expression is a CEL expression which decides whether this policy applies, with access to
+	// `error.class`, `error.message` and `retry_count`, e.g. `error.message.contains("429")`.
+	Expression *string
+
+	// Retries is the number of retries for matching failures. Optional; defaults to the task's
+	// retries.
+	Retries *int32
+
+	// BackoffFactor is the backoff multiplier for matching failures. Optional.
+	BackoffFactor *float32
+
+	// BackoffMaxSeconds is the maximum backoff for matching failures. Optional.
+	BackoffMaxSeconds *int32
+
+	// NeverRetry, when true, fails matching errors without retrying.
+	NeverRetry bool
+}
@@ -0,0 +1,15 @@
+package types
+
+// TaskMap fans a task in a DAG out into one run per element of a list, which is computed when the
+// task's parents complete. Each run receives its element as input, and the task's output gathers
+// the outputs of the runs, in element order, under `results`.
+//
+// If a run fails, no further runs are started and the task fails once the runs in progress finish.
+type TaskMap struct {
+	// Expression is a CEL expression on `input` and `parents` which returns a list of objects, e.g.
+	// `parents.list_files.files`.
+	Expression string
+
+	// MaxParallelism is the maximum number of runs in progress at once. Optional; defaults to 10.
+	MaxParallelism int32
+}
@@ -0,0 +1,202 @@
+// Package clock is the engine's source of time for work which is scheduled in the future:
+// durable sleeps, task timeouts and scheduled and cron workflows.
+//
+// In regular builds the engine clock is the wall clock. Test and development builds compiled
+// with the `timetravel` build tag allow the clock to be moved forward at runtime with Advance,
+// which lets integration tests fast-forward through long sleeps instead of waiting for them.
+// The offset is engine-wide: advancing the clock moves time forward for every tenant served by
+// the engine instance it is called on. Worker heartbeats and other liveness checks always use
+// the wall clock.
+//
+// Manual is a clock which only moves when it is told to, for tests which need full control
+// over time. It shares its implementation with the time travel engine clock.
+package clock
+
+import (
+	"errors"
+	"sync"
+	"time"
+)
+
+// ErrTimeTravelDisabled is returned by Advance when the engine was not built with the
+// `timetravel` build tag.
+var ErrTimeTravelDisabled = errors.New("time travel is disabled, rebuild the engine with -tags timetravel")
+
+// Clock tells the time and schedules callbacks relative to it.
+type Clock interface {
+	Now() time.Time
+
+	// AfterFunc calls f in its own goroutine once the clock has moved forward by d.
+	AfterFunc(d time.Duration, f func()) Timer
+}
+
+// Timer is a pending AfterFunc callback.
+type Timer interface {
+	// Stop prevents the callback from running. It returns false if the callback has already
+	// run or the timer was already stopped.
+	Stop() bool
+}
+
+// Now returns the engine's current time.
+func Now() time.Time {
+	return Engine().Now()
+}
+
+// Wall returns a clock which always follows the wall clock.
+func Wall() Clock {
+	return wallClock{}
+}
+
+type wallClock struct{}
+
+func (wallClock) Now() time.Time {
+	return time.Now()
+}
+
+func (wallClock) AfterFunc(d time.Duration, f func()) Timer {
+	return time.AfterFunc(d, f)
+}
+
+// adjustable is a clock which is offset from a base time by an amount that can be changed at
+// runtime. The base is the wall clock, or a fixed start time for clocks which only move when
+// they are adjusted.
+type adjustable struct {
+	// start is the fixed base time. A zero start means the clock follows the wall clock.
+	start time.Time
+
+	mu       sync.RWMutex
+	offset   time.Duration
+	changed  chan struct{}
+	onChange []func()
+}
+
+func newAdjustable(start time.Time) *adjustable {
+	return &adjustable{
+		start:   start,
+		changed: make(chan struct{}),
+	}
+}
+
+func (c *adjustable) Now() time.Time {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return c.nowLocked()
+}
+
+func (c *adjustable) nowLocked() time.Time {
+	if c.start.IsZero() {
+		return time.Now().Add(c.offset)
+	}
+
+	return c.start.Add(c.offset)
+}
+
+// adjust replaces the offset with the result of fn, wakes up pending timers so they can
+// re-check their deadlines and calls the change listeners. It returns the new offset.
+func (c *adjustable) adjust(fn func(offset time.Duration) time.Duration) time.Duration {
+	c.mu.Lock()
+
+	c.offset = fn(c.offset)
+	offset := c.offset
+
+	close(c.changed)
+	c.changed = make(chan struct{})
+
+	listeners := append([]func(){}, c.onChange...)
+
+	c.mu.Unlock()
+
+	for _, listener := range listeners {
+		listener()
+	}
+
+	return offset
+}
+
+func (c *adjustable) AfterFunc(d time.Duration, f func()) Timer {
+	t := &timer{
+		clock:    c,
+		deadline: c.Now().Add(d),
+		stop:     make(chan struct{}),
+	}
+
+	go t.run(f)
+
+	return t
+}
+
+// timer waits on a wall clock timer for the remaining duration, and re-checks its deadline
+// whenever the clock is adjusted. Timers on clocks with a fixed start only fire on adjustment.
+type timer struct {
+	clock    *adjustable
+	deadline time.Time
+
+	mu   sync.Mutex
+	done bool
+	stop chan struct{}
+}
+
+func (t *timer) run(f func()) {
+	for {
+		t.clock.mu.RLock()
+		changed := t.clock.changed
+		remaining := t.deadline.Sub(t.clock.nowLocked())
+		t.clock.mu.RUnlock()
+
+		if remaining > 0 {
+			var wall *time.Timer
+			var wallC <-chan time.Time
+
+			if t.clock.start.IsZero() {
+				wall = time.NewTimer(remaining)
+				wallC = wall.C
+			}
+
+			select {
+			case <-t.stop:
+				stopWallTimer(wall)
+				return
+			case <-changed:
+				stopWallTimer(wall)
+				continue
+			case <-wallC:
+			}
+		}
+
+		if t.markDone() {
+			f()
+		}
+
+		return
+	}
+}
+
+func stopWallTimer(wall *time.Timer) {
+	if wall != nil {
+		wall.Stop()
+	}
+}
+
+func (t *timer) markDone() bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.done {
+		return false
+	}
+
+	t.done = true
+
+	return true
+}
+
+func (t *timer) Stop() bool {
+	if !t.markDone() {
+		return false
+	}
+
+	close(t.stop)
+
+	return true
+}
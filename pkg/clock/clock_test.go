@@ -0,0 +1,83 @@
+//go:build !e2e && !load && !rampup && !integration
+
+package clock
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAfterFunc(t *testing.T) {
+	fired := make(chan struct{})
+
+	Engine().AfterFunc(10*time.Millisecond, func() {
+		close(fired)
+	})
+
+	select {
+	case <-fired:
+	case <-time.After(5 * time.Second):
+		t.Fatal("timer did not fire")
+	}
+}
+
+func TestAfterFuncStop(t *testing.T) {
+	fired := make(chan struct{})
+
+	timer := Engine().AfterFunc(50*time.Millisecond, func() {
+		close(fired)
+	})
+
+	require.True(t, timer.Stop())
+	assert.False(t, timer.Stop())
+
+	select {
+	case <-fired:
+		t.Fatal("stopped timer fired")
+	case <-time.After(100 * time.Millisecond):
+	}
+}
+
+func TestManualAfterFunc(t *testing.T) {
+	c := NewManual(time.Time{})
+	fired := make(chan struct{})
+
+	c.AfterFunc(time.Hour, func() {
+		close(fired)
+	})
+
+	c.Advance(30 * time.Minute)
+
+	select {
+	case <-fired:
+		t.Fatal("timer fired before its deadline")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	c.Advance(30 * time.Minute)
+
+	select {
+	case <-fired:
+	case <-time.After(5 * time.Second):
+		t.Fatal("timer did not fire after the clock was advanced")
+	}
+}
+
+func TestManualSet(t *testing.T) {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	c := NewManual(start)
+
+	changes := 0
+	c.OnChange(func() {
+		changes++
+	})
+
+	c.Set(start.Add(24 * time.Hour))
+	c.Advance(time.Hour)
+
+	assert.Equal(t, start.Add(25*time.Hour), c.Now())
+	assert.Equal(t, 2, changes)
+}
@@ -0,0 +1,18 @@
+//go:build !timetravel
+
+package clock
+
+import "time"
+
+const TimeTravelEnabled = false
+
+// Engine returns the engine-wide clock, which is the wall clock when time travel is disabled.
+func Engine() Clock {
+	return Wall()
+}
+
+// Offset returns how far the engine clock has been moved ahead of the wall clock.
+func Offset() time.Duration { return 0 }
+
+// Advance moves the engine clock forward by d and returns the new total offset.
+func Advance(time.Duration) (time.Duration, error) { return 0, ErrTimeTravelDisabled }
@@ -0,0 +1,45 @@
+package clock
+
+import "time"
+
+// Manual is a Clock which only moves when Advance or Set is called, which makes sleeps and
+// timeouts measured against it fully deterministic.
+type Manual struct {
+	*adjustable
+}
+
+// NewManual returns a Manual clock starting at the given time. A zero start time defaults to
+// the current wall-clock time.
+func NewManual(start time.Time) *Manual {
+	if start.IsZero() {
+		start = time.Now()
+	}
+
+	return &Manual{
+		adjustable: newAdjustable(start),
+	}
+}
+
+// Advance moves the clock forward by d.
+func (c *Manual) Advance(d time.Duration) {
+	c.adjust(func(offset time.Duration) time.Duration {
+		return offset + d
+	})
+}
+
+// Set moves the clock to t. Moving the clock backwards is allowed, but callbacks which have
+// already fired are not undone.
+func (c *Manual) Set(t time.Time) {
+	c.adjust(func(time.Duration) time.Duration {
+		return t.Sub(c.start)
+	})
+}
+
+// OnChange registers fn to be called synchronously every time the clock is moved, before
+// Advance or Set returns.
+func (c *Manual) OnChange(fn func()) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.onChange = append(c.onChange, fn)
+}
@@ -0,0 +1,37 @@
+//go:build timetravel
+
+package clock
+
+import (
+	"fmt"
+	"time"
+)
+
+const TimeTravelEnabled = true
+
+var engine = newAdjustable(time.Time{})
+
+// Engine returns the engine-wide clock, which includes any offset applied with Advance.
+func Engine() Clock {
+	return engine
+}
+
+// Offset returns how far the engine clock has been moved ahead of the wall clock.
+func Offset() time.Duration {
+	engine.mu.RLock()
+	defer engine.mu.RUnlock()
+
+	return engine.offset
+}
+
+// Advance moves the engine clock forward by d and returns the new total offset. The clock
+// can't be moved backwards.
+func Advance(d time.Duration) (time.Duration, error) {
+	if d < 0 {
+		return 0, fmt.Errorf("cannot advance the clock by a negative duration %s", d)
+	}
+
+	return engine.adjust(func(offset time.Duration) time.Duration {
+		return offset + d
+	}), nil
+}
@@ -0,0 +1,44 @@
+//go:build timetravel
+
+package clock
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAdvance(t *testing.T) {
+	before := Offset()
+	fired := make(chan struct{})
+
+	Engine().AfterFunc(72*time.Hour, func() {
+		close(fired)
+	})
+
+	newOffset, err := Advance(24 * time.Hour)
+	require.NoError(t, err)
+	assert.Equal(t, before+24*time.Hour, newOffset)
+
+	select {
+	case <-fired:
+		t.Fatal("timer fired before its deadline")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	_, err = Advance(48 * time.Hour)
+	require.NoError(t, err)
+
+	select {
+	case <-fired:
+	case <-time.After(5 * time.Second):
+		t.Fatal("timer did not fire after the clock was advanced")
+	}
+
+	assert.WithinDuration(t, time.Now().Add(before+72*time.Hour), Now(), time.Second)
+
+	_, err = Advance(-time.Hour)
+	assert.Error(t, err)
+}
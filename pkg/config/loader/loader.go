@@ -548,6 +548,7 @@ func createControllerLayer(dc *database.Layer, cf *server.ServerConfigFile, vers
 			ingestor.WithMessageQueueV1(mqv1),
 			ingestor.WithPubSub(pubsubv1),
 			ingestor.WithRepositoryV1(dc.V1),
+			ingestor.WithEventDedupeKeyTTL(cf.Runtime.EventDedupeKeyTTL),
 		)
 
 		if err != nil {
@@ -653,6 +654,10 @@ func createControllerLayer(dc *database.Layer, cf *server.ServerConfigFile, vers
 		return nil
 	})
 
+	if cf.OLAPExport.Enabled && cf.OLAPExport.Destination == "" {
+		return nil, nil, fmt.Errorf("olap export destination is required when olap export is enabled")
+	}
+
 	var pylon server.PylonConfig
 
 	if cf.Pylon.Enabled {
@@ -954,6 +959,8 @@ func createControllerLayer(dc *database.Layer, cf *server.ServerConfigFile, vers
 		Operations:             cf.OLAP,
 		CronOperations:         cf.CronOperations,
 		OLAPStatusUpdates:      cf.OLAPStatusUpdates,
+		OLAPExport:             cf.OLAPExport,
+		WorkerScaler:           cf.WorkerScaler,
 		MQMaxDeathCount:        cf.MessageQueue.RabbitMQ.MaxDeathCount,
 	}, nil
 }
@@ -88,6 +88,10 @@ type ServerConfigFile struct {
 
 	OLAPStatusUpdates OLAPStatusUpdateConfigFile `mapstructure:"statusUpdates" json:"statusUpdates,omitempty"`
 
+	OLAPExport OLAPExportConfigFile `mapstructure:"olapExport" json:"olapExport,omitempty"`
+
+	WorkerScaler WorkerScalerConfigFile `mapstructure:"workerScaler" json:"workerScaler,omitempty"`
+
 	VersionOverride string `mapstructure:"versionOverride" json:"versionOverride,omitempty"`
 }
 
@@ -165,6 +169,51 @@ type OLAPStatusUpdateConfigFile struct {
 	TaskBatchSizeLimit int `mapstructure:"taskBatchSizeLimit" json:"taskBatchSizeLimit,omitempty" default:"1000"`
 }
 
+// OLAPExportConfigFile is the configuration for exporting run history to Parquet files
+type OLAPExportConfigFile struct {
+	// Enabled controls whether the OLAP controller exports closed day partitions
+	Enabled bool `mapstructure:"enabled" json:"enabled,omitempty" default:"false"`
+
+	// Destination is where Parquet files are written: a local directory (file:///path/to/dir) or an
+	// S3 compatible bucket (s3://bucket/prefix), using the standard AWS credentials and endpoint settings
+	Destination string `mapstructure:"destination" json:"destination,omitempty"`
+
+	// IncludePayloads adds task inputs and task event outputs to the export
+	IncludePayloads bool `mapstructure:"includePayloads" json:"includePayloads,omitempty" default:"false"`
+
+	// Interval is how often the exporter checks for partitions to export
+	Interval time.Duration `mapstructure:"interval" json:"interval,omitempty" default:"1h"`
+
+	// Delay is how long after the end of a day its partition is exported, which gives runs created
+	// late in the day time to finish
+	Delay time.Duration `mapstructure:"delay" json:"delay,omitempty" default:"1h"`
+
+	// MaxPartitionsPerInterval is the number of partitions exported per tenant each interval, so a
+	// backlog of partitions is exported gradually
+	MaxPartitionsPerInterval int `mapstructure:"maxPartitionsPerInterval" json:"maxPartitionsPerInterval,omitempty" default:"3"`
+
+	// BatchSize is the number of rows read from the database at a time
+	BatchSize int32 `mapstructure:"batchSize" json:"batchSize,omitempty" default:"1000"`
+}
+
+// WorkerScalerConfigFile is the configuration for the KEDA external scaler which reports the replicas
+// worker pools need
+type WorkerScalerConfigFile struct {
+	// Enabled registers the KEDA external scaler service on the engine's gRPC server
+	Enabled bool `mapstructure:"enabled" json:"enabled,omitempty" default:"false"`
+
+	// PollInterval is how often the scaler pushes activity to KEDA on StreamIsActive
+	PollInterval time.Duration `mapstructure:"pollInterval" json:"pollInterval,omitempty" default:"15s"`
+
+	// ScheduleTimeoutWindow is how close to its schedule timeout a queued task must be to scale up
+	// its worker pool
+	ScheduleTimeoutWindow time.Duration `mapstructure:"scheduleTimeoutWindow" json:"scheduleTimeoutWindow,omitempty" default:"1m"`
+
+	// DefaultSlotsPerReplica is the slots per replica used for pools which don't declare one and
+	// have no active workers
+	DefaultSlotsPerReplica int32 `mapstructure:"defaultSlotsPerReplica" json:"defaultSlotsPerReplica,omitempty" default:"100"`
+}
+
 // General server runtime options
 type ConfigFileRuntime struct {
 	// Port is the port that the core server listens on
@@ -236,6 +285,10 @@ type ConfigFileRuntime struct {
 	// Allow new tenants to be created
 	AllowSignup bool `mapstructure:"allowSignup" json:"allowSignup,omitempty" default:"true"`
 
+	// Allow the engine clock to be advanced through the admin API. The clock is shared by every
+	// tenant, so this must only be enabled on dev and test engines built with -tags timetravel.
+	AllowTimeTravel bool `mapstructure:"allowTimeTravel" json:"allowTimeTravel,omitempty" default:"false"`
+
 	// Allow new invites to be created
 	AllowInvites bool `mapstructure:"allowInvites" json:"allowInvites,omitempty" default:"true"`
 
@@ -321,12 +374,27 @@ type ConfigFileRuntime struct {
 
 	SchedulerAdvisoryLockTimeout time.Duration `mapstructure:"schedulerAdvisoryLockTimeout" json:"schedulerAdvisoryLockTimeout,omitempty" default:"5s"`
 
+	// SchedulerPreemptionWaitThreshold is how long a higher-priority task must wait for a slot before the
+	// scheduler preempts a lower-priority running task of a preemptible step for it. 0 disables preemption.
+	SchedulerPreemptionWaitThreshold time.Duration `mapstructure:"schedulerPreemptionWaitThreshold" json:"schedulerPreemptionWaitThreshold,omitempty" default:"30s"`
+
 	// ConcurrencyInMemoryIndexEnabled controls whether the in-memory index + outbox approach is used for concurrency strategies
 	ConcurrencyInMemoryIndexEnabled bool `mapstructure:"concurrencyInMemoryIndexEnabled" json:"concurrencyInMemoryIndexEnabled,omitempty" default:"true"`
 
 	// LogIngestionEnabled controls whether the server enables log ingestion for tasks
 	LogIngestionEnabled bool `mapstructure:"logIngestionEnabled" json:"logIngestionEnabled,omitempty" default:"true"`
 
+	// LogIngestionRateLimit is the number of log lines per second each tenant can stream to an engine
+	// instance. Workers are slowed down rather than having lines dropped. 0 disables the limit.
+	LogIngestionRateLimit float64 `mapstructure:"logIngestionRateLimit" json:"logIngestionRateLimit,omitempty" default:"5000"`
+
+	// LogIngestionBurst is the number of log lines a tenant can stream above the rate limit in a burst
+	LogIngestionBurst int `mapstructure:"logIngestionBurst" json:"logIngestionBurst,omitempty" default:"10000"`
+
+	// EventDedupeKeyTTL is how long an event's dedupe key is held. Pushes with the same dedupe key within
+	// this window return the original event.
+	EventDedupeKeyTTL time.Duration `mapstructure:"eventDedupeKeyTTL" json:"eventDedupeKeyTTL,omitempty" default:"24h"`
+
 	// TaskOperationLimits controls the limits for various task operations
 	TaskOperationLimits TaskOperationLimitsConfigFile `mapstructure:"taskOperationLimits" json:"taskOperationLimits,omitempty"`
 
@@ -769,6 +837,10 @@ type ServerConfig struct {
 
 	OLAPStatusUpdates OLAPStatusUpdateConfigFile
 
+	OLAPExport OLAPExportConfigFile
+
+	WorkerScaler WorkerScalerConfigFile
+
 	MQMaxDeathCount int
 }
 
@@ -818,6 +890,7 @@ func BindAllEnv(v *viper.Viper) {
 	_ = v.BindEnv("runtime.schedulerCheckActiveMinInterval", "SCHEDULER_CHECK_ACTIVE_MIN_INTERVAL")
 	_ = v.BindEnv("runtime.schedulerCheckActiveMaxInterval", "SCHEDULER_CHECK_ACTIVE_MAX_INTERVAL")
 	_ = v.BindEnv("runtime.schedulerAdvisoryLockTimeout", "SCHEDULER_ADVISORY_LOCK_TIMEOUT")
+	_ = v.BindEnv("runtime.schedulerPreemptionWaitThreshold", "SCHEDULER_PREEMPTION_WAIT_THRESHOLD")
 	_ = v.BindEnv("runtime.concurrencyInMemoryIndexEnabled", "SERVER_CONCURRENCY_IN_MEMORY_INDEX_ENABLED")
 	_ = v.BindEnv("servicesString", "SERVER_SERVICES")
 	_ = v.BindEnv("pausedControllers", "SERVER_PAUSED_CONTROLLERS")
@@ -825,6 +898,7 @@ func BindAllEnv(v *viper.Viper) {
 	_ = v.BindEnv("enableWorkerRetention", "SERVER_ENABLE_WORKER_RETENTION")
 	_ = v.BindEnv("runtime.enforceLimits", "SERVER_ENFORCE_LIMITS")
 	_ = v.BindEnv("runtime.allowSignup", "SERVER_ALLOW_SIGNUP")
+	_ = v.BindEnv("runtime.allowTimeTravel", "SERVER_ALLOW_TIME_TRAVEL")
 	_ = v.BindEnv("runtime.allowInvites", "SERVER_ALLOW_INVITES")
 	_ = v.BindEnv("runtime.allowCreateTenant", "SERVER_ALLOW_CREATE_TENANT")
 	_ = v.BindEnv("runtime.maxPendingInvites", "SERVER_MAX_PENDING_INVITES")
@@ -868,6 +942,9 @@ func BindAllEnv(v *viper.Viper) {
 
 	// log ingestion
 	_ = v.BindEnv("runtime.logIngestionEnabled", "SERVER_LOG_INGESTION_ENABLED")
+	_ = v.BindEnv("runtime.logIngestionRateLimit", "SERVER_LOG_INGESTION_RATE_LIMIT")
+	_ = v.BindEnv("runtime.logIngestionBurst", "SERVER_LOG_INGESTION_BURST")
+	_ = v.BindEnv("runtime.eventDedupeKeyTTL", "SERVER_EVENT_DEDUPE_KEY_TTL")
 
 	// alerting options
 	_ = v.BindEnv("alerting.sentry.enabled", "SERVER_ALERTING_SENTRY_ENABLED")
@@ -1064,6 +1141,21 @@ func BindAllEnv(v *viper.Viper) {
 	// OLAP status update options
 	_ = v.BindEnv("statusUpdates.dagBatchSizeLimit", "SERVER_OLAP_STATUS_UPDATE_DAG_BATCH_SIZE_LIMIT")
 	_ = v.BindEnv("statusUpdates.taskBatchSizeLimit", "SERVER_OLAP_STATUS_UPDATE_TASK_BATCH_SIZE_LIMIT")
+
+	// olap export options
+	_ = v.BindEnv("olapExport.enabled", "SERVER_OLAP_EXPORT_ENABLED")
+	_ = v.BindEnv("olapExport.destination", "SERVER_OLAP_EXPORT_DESTINATION")
+	_ = v.BindEnv("olapExport.includePayloads", "SERVER_OLAP_EXPORT_INCLUDE_PAYLOADS")
+	_ = v.BindEnv("olapExport.interval", "SERVER_OLAP_EXPORT_INTERVAL")
+	_ = v.BindEnv("olapExport.delay", "SERVER_OLAP_EXPORT_DELAY")
+	_ = v.BindEnv("olapExport.maxPartitionsPerInterval", "SERVER_OLAP_EXPORT_MAX_PARTITIONS_PER_INTERVAL")
+	_ = v.BindEnv("olapExport.batchSize", "SERVER_OLAP_EXPORT_BATCH_SIZE")
+
+	// worker scaler options
+	_ = v.BindEnv("workerScaler.enabled", "SERVER_WORKER_SCALER_ENABLED")
+	_ = v.BindEnv("workerScaler.pollInterval", "SERVER_WORKER_SCALER_POLL_INTERVAL")
+	_ = v.BindEnv("workerScaler.scheduleTimeoutWindow", "SERVER_WORKER_SCALER_SCHEDULE_TIMEOUT_WINDOW")
+	_ = v.BindEnv("workerScaler.defaultSlotsPerReplica", "SERVER_WORKER_SCALER_DEFAULT_SLOTS_PER_REPLICA")
 	_ = v.BindEnv("olap.olapMqQos", "SERVER_OLAP_MQ_QOS")
 
 	// exchange token options
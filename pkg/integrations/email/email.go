@@ -12,6 +12,7 @@ const (
 	TokenAlertExpiringTemplate = "token-expiring" // nolint: gosec
 	ResourceLimitAlertTemplate = "resource-limit-alert"
 	OrganizationInviteTemplate = "organization-invite"
+	ApprovalRequestedTemplate  = "approval-requested"
 )
 
 type TenantInviteEmailData struct {
@@ -52,6 +53,18 @@ type ResourceLimitAlertData struct {
 	SettingsLink string `json:"settings_link"`
 }
 
+type ApprovalRequestedEmailData struct {
+	Subject               string                            `json:"subject"`
+	TenantName            string                            `json:"tenant_name"`
+	TaskName              string                            `json:"task_name"`
+	TaskExternalId        string                            `json:"task_external_id"`
+	ExpiresAtAbsoluteDate string                            `json:"expires_at_absolute_date"`
+	ExpiresAtRelativeDate string                            `json:"expires_at_relative_date"`
+	Context               []alerttypes.ApprovalContextField `json:"context"`
+	Link                  string                            `json:"link"`
+	SettingsLink          string                            `json:"settings_link"`
+}
+
 type SendEmailFromTemplateRequest struct {
 	TemplateModel interface{} `json:"TemplateModel"`
 	From          string      `json:"From"`
@@ -68,6 +81,7 @@ type EmailService interface {
 	SendWorkflowRunFailedAlerts(ctx context.Context, emails []string, data WorkflowRunsFailedEmailData) error
 	SendExpiringTokenEmail(ctx context.Context, emails []string, data ExpiringTokenEmailData) error
 	SendTenantResourceLimitAlert(ctx context.Context, emails []string, data ResourceLimitAlertData) error
+	SendApprovalRequestedEmail(ctx context.Context, emails []string, data ApprovalRequestedEmailData) error
 
 	// Used for extending the email provider for sending additional templated emails
 	SendTemplateEmail(ctx context.Context, to, templateAlias string, templateModelData interface{}, bccSupport bool) error
@@ -96,6 +110,10 @@ func (s *NoOpService) SendTenantResourceLimitAlert(ctx context.Context, emails [
 	return nil
 }
 
+func (s *NoOpService) SendApprovalRequestedEmail(ctx context.Context, emails []string, data ApprovalRequestedEmailData) error {
+	return nil
+}
+
 func (s *NoOpService) SendTemplateEmail(ctx context.Context, to, templateAlias string, templateModelData interface{}, bccSupport bool) error {
 	return nil
 }
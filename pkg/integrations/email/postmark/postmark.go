@@ -57,6 +57,10 @@ func (c *PostmarkClient) SendTenantResourceLimitAlert(ctx context.Context, email
 	return c.SendTemplateEmailBCC(ctx, strings.Join(emails, ","), email.ResourceLimitAlertTemplate, data, true)
 }
 
+func (c *PostmarkClient) SendApprovalRequestedEmail(ctx context.Context, emails []string, data email.ApprovalRequestedEmailData) error {
+	return c.SendTemplateEmailBCC(ctx, strings.Join(emails, ","), email.ApprovalRequestedTemplate, data, false)
+}
+
 func (c *PostmarkClient) SendTemplateEmail(ctx context.Context, to, templateAlias string, templateModelData interface{}, bccSupport bool) error {
 	var bcc string
 
@@ -104,6 +104,15 @@ func (s *SMTPService) SendTenantResourceLimitAlert(ctx context.Context, emails [
 	})
 }
 
+func (s *SMTPService) SendApprovalRequestedEmail(ctx context.Context, emails []string, data email.ApprovalRequestedEmailData) error {
+	return s.sendRequest(ctx, &email.SendEmailFromTemplateRequest{
+		From:          fmt.Sprintf("%s <%s>", s.fromName, s.fromEmail),
+		Bcc:           strings.Join(emails, ","),
+		TemplateAlias: email.ApprovalRequestedTemplate,
+		TemplateModel: data,
+	})
+}
+
 func (s *SMTPService) SendTemplateEmail(ctx context.Context, to, templateAlias string, templateModelData interface{}, bccSupport bool) error {
 	var bcc string
 
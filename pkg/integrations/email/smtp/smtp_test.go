@@ -12,6 +12,7 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/hatchet-dev/hatchet/internal/integrations/alerting/alerttypes"
 	"github.com/hatchet-dev/hatchet/pkg/integrations/email"
 	"github.com/stretchr/testify/require"
 )
@@ -110,6 +111,30 @@ func TestSMTPServiceSendMail(t *testing.T) {
 				)
 			},
 		},
+		{
+			name:               "approval requested",
+			expectedTemplate:   templateRegistry[email.ApprovalRequestedTemplate].bodyTmpl,
+			expectedSubject:    "[Acme Corp] Approval requested for 'approve-refund'",
+			expectedRecipients: []string{"finance@example.com", "admin@example.com"},
+			templateData: email.ApprovalRequestedEmailData{
+				TenantName:            "Acme Corp",
+				Subject:               "Approval requested for 'approve-refund'",
+				TaskName:              "approve-refund",
+				TaskExternalId:        "3f1d2e6a-3c0a-4f3e-9a55-0c7e0c7b9b1a",
+				ExpiresAtAbsoluteDate: "2026-02-01 12:00:00",
+				ExpiresAtRelativeDate: "in 1 day",
+				Context: []alerttypes.ApprovalContextField{
+					{Key: "input.amount", Value: "1200"},
+				},
+				Link:         "https://app.example.com/runs/1",
+				SettingsLink: "https://app.example.com/settings",
+			},
+			sendFunc: func(s *SMTPService, ctx context.Context, d interface{}) error {
+				return s.SendApprovalRequestedEmail(
+					ctx, []string{"finance@example.com", "admin@example.com"}, d.(email.ApprovalRequestedEmailData),
+				)
+			},
+		},
 	}
 
 	for _, tt := range tests {
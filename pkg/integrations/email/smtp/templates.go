@@ -33,6 +33,7 @@ func init() {
 		email.TokenAlertExpiringTemplate: {"templates/expiring_token.html", defaultSubjectTemplate},
 		email.ResourceLimitAlertTemplate: {"templates/resource_limit_alert.html", defaultSubjectTemplate},
 		email.WorkflowRunsFailedTemplate: {"templates/workflow_runs_failed.html", defaultSubjectTemplate},
+		email.ApprovalRequestedTemplate:  {"templates/approval_requested.html", defaultSubjectTemplate},
 	}
 
 	for alias, tmpl := range templates {
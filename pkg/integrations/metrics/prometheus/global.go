@@ -21,6 +21,8 @@ const (
 	QueuedToAssignedTotal       GlobalHatchetMetric = "hatchet_queued_to_assigned"
 	QueuedToAssignedTimeSeconds GlobalHatchetMetric = "hatchet_queued_to_assigned_time_seconds"
 	ReassignedTasksTotal        GlobalHatchetMetric = "hatchet_reassigned_tasks"
+	PreemptedTasksTotal         GlobalHatchetMetric = "hatchet_preempted_tasks"
+	SlotSecondsTotal            GlobalHatchetMetric = "hatchet_slot_seconds_total"
 )
 
 var (
@@ -89,4 +91,14 @@ var (
 		Name: string(ReassignedTasksTotal),
 		Help: "The total number of tasks that were reassigned to a worker",
 	})
+
+	PreemptedTasks = promauto.NewCounter(prometheus.CounterOpts{
+		Name: string(PreemptedTasksTotal),
+		Help: "The total number of running tasks that were preempted for higher-priority tasks",
+	})
+
+	SlotSeconds = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: string(SlotSecondsTotal),
+		Help: "The total number of slot-seconds consumed by tasks, the slot units they held multiplied by the seconds they held them for",
+	}, []string{"slot_type"})
 )
@@ -24,6 +24,7 @@ const (
 	TenantSkippedTasksTotal                     TenantHatchetMetric = "hatchet_tenant_skipped_tasks"
 	TenantCancelledTasksTotal                   TenantHatchetMetric = "hatchet_tenant_cancelled_tasks"
 	TenantReassignedTasksTotal                  TenantHatchetMetric = "hatchet_tenant_reassigned_tasks"
+	TenantPreemptedTasksTotal                   TenantHatchetMetric = "hatchet_tenant_preempted_tasks"
 	TenantUsedWorkerSlotsTotal                  TenantHatchetMetric = "hatchet_tenant_used_worker_slots"
 	TenantAvailableWorkerSlotsTotal             TenantHatchetMetric = "hatchet_tenant_available_worker_slots"
 	TenantWorkerSlotsTotal                      TenantHatchetMetric = "hatchet_tenant_worker_slots"
@@ -32,6 +33,12 @@ const (
 	TenantWorkerLabelSlotsTotal                 TenantHatchetMetric = "hatchet_tenant_worker_label_slots"
 	TenantQueueSizeTotal                        TenantHatchetMetric = "hatchet_tenant_queue_size"
 	TenantAdditionalMetadataQueueSize           TenantHatchetMetric = "hatchet_tenant_additional_metadata_queue_size"
+	TenantCircuitBreakerStateValue              TenantHatchetMetric = "hatchet_tenant_circuit_breaker_state"
+	TenantCircuitBreakerFailuresTotal           TenantHatchetMetric = "hatchet_tenant_circuit_breaker_failures"
+	TenantSlotSecondsTotal                      TenantHatchetMetric = "hatchet_tenant_slot_seconds"
+	TenantAgedQueueItemsTotal                   TenantHatchetMetric = "hatchet_tenant_aged_queue_items"
+	TenantOverdueQueueItemsTotal                TenantHatchetMetric = "hatchet_tenant_overdue_queue_items"
+	TenantQueueOldestWaitSecondsValue           TenantHatchetMetric = "hatchet_tenant_queue_oldest_wait_seconds"
 )
 
 var (
@@ -148,6 +155,16 @@ var (
 		Help: "The total number of tasks that were reassigned to a worker",
 	}, []string{"tenant_id"})
 
+	TenantPreemptedTasks = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: string(TenantPreemptedTasksTotal),
+		Help: "The total number of running tasks that were preempted for higher-priority tasks",
+	}, []string{"tenant_id"})
+
+	TenantSlotSeconds = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: string(TenantSlotSecondsTotal),
+		Help: "The total number of slot-seconds consumed by tasks, by workflow name and slot type",
+	}, []string{"tenant_id", "workflow_name", "slot_type"})
+
 	TenantWorkerSlots = promauto.NewGaugeVec(
 		prometheus.GaugeOpts{
 			Name: string(TenantWorkerSlotsTotal),
@@ -211,4 +228,44 @@ var (
 		},
 		[]string{"tenant_id", "queue", "key", "value"},
 	)
+
+	TenantCircuitBreakerState = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: string(TenantCircuitBreakerStateValue),
+			Help: "State of each circuit breaker: 0 when closed, 1 when half-open and 2 when open",
+		},
+		[]string{"tenant_id", "key"},
+	)
+
+	TenantCircuitBreakerFailures = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: string(TenantCircuitBreakerFailuresTotal),
+			Help: "Number of failures counted by each circuit breaker in its current window",
+		},
+		[]string{"tenant_id", "key"},
+	)
+
+	TenantAgedQueueItems = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: string(TenantAgedQueueItemsTotal),
+			Help: "Number of loaded queue items whose effective priority has been raised by priority aging, per queue",
+		},
+		[]string{"tenant_id", "queue"},
+	)
+
+	TenantOverdueQueueItems = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: string(TenantOverdueQueueItemsTotal),
+			Help: "Number of loaded queue items which have waited longer than their priority aging policy's max wait, per queue",
+		},
+		[]string{"tenant_id", "queue"},
+	)
+
+	TenantQueueOldestWaitSeconds = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: string(TenantQueueOldestWaitSecondsValue),
+			Help: "Longest time in seconds any loaded queue item has been waiting, per queue whose tasks age",
+		},
+		[]string{"tenant_id", "queue"},
+	)
 )
@@ -0,0 +1,358 @@
+// Package olapexport exports the run history in the OLAP tables to Parquet files, one set of files
+// per tenant and day partition, so it can be loaded into a data warehouse.
+//
+// Files are written with a Hive-style layout:
+//
+//	runs/tenant_id=<tenant>/date=<YYYY-MM-DD>/part-00000.parquet
+//	tasks/tenant_id=<tenant>/date=<YYYY-MM-DD>/part-00000.parquet
+//	task_events/tenant_id=<tenant>/date=<YYYY-MM-DD>/part-00000.parquet
+//
+// Exports are checkpointed per partition. Object keys are deterministic, so an export which is
+// interrupted and retried overwrites the same files instead of duplicating rows.
+package olapexport
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/parquet-go/parquet-go"
+	"github.com/rs/zerolog"
+
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/telemetry"
+)
+
+const (
+	TableRuns       = "runs"
+	TableTasks      = "tasks"
+	TableTaskEvents = "task_events"
+)
+
+// ObjectKey returns the key of the file holding a table's rows for a tenant's day partition.
+func ObjectKey(table string, tenantId uuid.UUID, day time.Time) string {
+	return fmt.Sprintf("%s/tenant_id=%s/date=%s/part-00000.parquet", table, tenantId, repository.PartitionDay(day).Format(time.DateOnly))
+}
+
+type ExporterOpts struct {
+	// IncludePayloads adds task inputs and task event outputs to the export.
+	IncludePayloads bool
+
+	// BatchSize is the number of rows read from the database at a time. Defaults to 1000.
+	BatchSize int32
+
+	// LeaseDuration is how long a partition is leased while it's being exported. Another process
+	// can take over the partition once the lease expires. Defaults to 1 hour.
+	LeaseDuration time.Duration
+}
+
+type Exporter struct {
+	repo      repository.OLAPRepository
+	store     Store
+	l         *zerolog.Logger
+	processId uuid.UUID
+	opts      ExporterOpts
+}
+
+func NewExporter(repo repository.OLAPRepository, store Store, l *zerolog.Logger, opts ExporterOpts) *Exporter {
+	if opts.BatchSize <= 0 {
+		opts.BatchSize = 1000
+	}
+
+	if opts.LeaseDuration <= 0 {
+		opts.LeaseDuration = time.Hour
+	}
+
+	return &Exporter{
+		repo:      repo,
+		store:     store,
+		l:         l,
+		processId: uuid.New(),
+		opts:      opts,
+	}
+}
+
+// Destination returns the location the exporter writes to.
+func (e *Exporter) Destination() string {
+	return e.store.String()
+}
+
+type PartitionResult struct {
+	TenantId uuid.UUID
+	Date     time.Time
+
+	// Skipped is true when the partition was already exported, or is being exported by another
+	// process.
+	Skipped bool
+
+	RunCount       int64
+	TaskCount      int64
+	TaskEventCount int64
+}
+
+// ExportPartition exports a tenant's day partition. Partitions which have already been exported
+// are skipped unless force is set, in which case their files are overwritten.
+func (e *Exporter) ExportPartition(ctx context.Context, tenantId uuid.UUID, day time.Time, force bool) (*PartitionResult, error) {
+	ctx, span := telemetry.NewSpan(ctx, "olap-export-partition")
+	defer span.End()
+
+	day = repository.PartitionDay(day)
+
+	telemetry.WithAttributes(span,
+		telemetry.AttributeKV{Key: "tenant.id", Value: tenantId.String()},
+		telemetry.AttributeKV{Key: "partition.date", Value: day.Format(time.DateOnly)},
+	)
+
+	res := &PartitionResult{
+		TenantId: tenantId,
+		Date:     day,
+	}
+
+	acquired, err := e.repo.AcquireOLAPExportLease(ctx, tenantId, day, e.processId, e.opts.LeaseDuration, force)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if !acquired {
+		res.Skipped = true
+		return res, nil
+	}
+
+	completed := false
+
+	defer func() {
+		if completed {
+			return
+		}
+
+		// release the lease so the next attempt doesn't have to wait for it to expire
+		releaseCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		if err := e.repo.ReleaseOLAPExportLease(releaseCtx, tenantId, day, e.processId); err != nil {
+			e.l.Warn().Ctx(ctx).Err(err).Msg("could not release olap export lease")
+		}
+	}()
+
+	dir, err := os.MkdirTemp("", "hatchet-olap-export-*")
+
+	if err != nil {
+		return nil, fmt.Errorf("could not create temporary export directory: %w", err)
+	}
+
+	defer os.RemoveAll(dir) // nolint: errcheck
+
+	runs, err := newPartFile[RunRow](dir, TableRuns)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer runs.close()
+
+	tasks, err := newPartFile[TaskRow](dir, TableTasks)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer tasks.close()
+
+	events, err := newPartFile[TaskEventRow](dir, TableTaskEvents)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer events.close()
+
+	if err := e.writeRuns(ctx, tenantId, day, runs); err != nil {
+		return nil, err
+	}
+
+	if err := e.writeTasksAndEvents(ctx, tenantId, day, tasks, events); err != nil {
+		return nil, err
+	}
+
+	if err := runs.upload(ctx, e.store, ObjectKey(TableRuns, tenantId, day)); err != nil {
+		return nil, err
+	}
+
+	if err := tasks.upload(ctx, e.store, ObjectKey(TableTasks, tenantId, day)); err != nil {
+		return nil, err
+	}
+
+	if err := events.upload(ctx, e.store, ObjectKey(TableTaskEvents, tenantId, day)); err != nil {
+		return nil, err
+	}
+
+	res.RunCount = runs.rows
+	res.TaskCount = tasks.rows
+	res.TaskEventCount = events.rows
+
+	err = e.repo.CompleteOLAPExport(ctx, tenantId, day, e.processId, repository.CompleteOLAPExportOpts{
+		Destination:    e.store.String(),
+		RunCount:       res.RunCount,
+		TaskCount:      res.TaskCount,
+		TaskEventCount: res.TaskEventCount,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	completed = true
+
+	return res, nil
+}
+
+func (e *Exporter) writeRuns(ctx context.Context, tenantId uuid.UUID, day time.Time, out *partFile[RunRow]) error {
+	opts := repository.ListOLAPExportRowsOpts{
+		PartitionDate: day,
+		Limit:         e.opts.BatchSize,
+	}
+
+	for {
+		batch, err := e.repo.ListRunsForExport(ctx, tenantId, opts)
+
+		if err != nil {
+			return fmt.Errorf("could not list runs for export: %w", err)
+		}
+
+		rows := make([]RunRow, len(batch))
+
+		for i, run := range batch {
+			rows[i] = newRunRow(run)
+		}
+
+		if err := out.write(rows); err != nil {
+			return err
+		}
+
+		if len(batch) < int(opts.Limit) {
+			return nil
+		}
+
+		last := batch[len(batch)-1]
+		opts.AfterInsertedAt = last.InsertedAt.Time
+		opts.AfterId = last.ID
+	}
+}
+
+func (e *Exporter) writeTasksAndEvents(ctx context.Context, tenantId uuid.UUID, day time.Time, tasksOut *partFile[TaskRow], eventsOut *partFile[TaskEventRow]) error {
+	opts := repository.ListOLAPExportRowsOpts{
+		PartitionDate:   day,
+		Limit:           e.opts.BatchSize,
+		IncludePayloads: e.opts.IncludePayloads,
+	}
+
+	for {
+		batch, err := e.repo.ListTasksForExport(ctx, tenantId, opts)
+
+		if err != nil {
+			return err
+		}
+
+		rows := make([]TaskRow, len(batch))
+		taskMetadata := make([]repository.TaskMetadata, len(batch))
+		taskIdToExternalId := make(map[int64]uuid.UUID, len(batch))
+
+		for i, task := range batch {
+			rows[i] = newTaskRow(task)
+			taskMetadata[i] = repository.TaskMetadata{
+				TaskID:         task.ID,
+				TaskInsertedAt: task.InsertedAt.Time,
+			}
+			taskIdToExternalId[task.ID] = task.ExternalID
+		}
+
+		if err := tasksOut.write(rows); err != nil {
+			return err
+		}
+
+		taskEvents, err := e.repo.ListTaskEventsForExport(ctx, tenantId, taskMetadata, e.opts.IncludePayloads)
+
+		if err != nil {
+			return err
+		}
+
+		eventRows := make([]TaskEventRow, len(taskEvents))
+
+		for i, event := range taskEvents {
+			eventRows[i] = newTaskEventRow(event, taskIdToExternalId[event.TaskID])
+		}
+
+		if err := eventsOut.write(eventRows); err != nil {
+			return err
+		}
+
+		if len(batch) < int(opts.Limit) {
+			return nil
+		}
+
+		last := batch[len(batch)-1]
+		opts.AfterInsertedAt = last.InsertedAt.Time
+		opts.AfterId = last.ID
+	}
+}
+
+// partFile buffers the rows of a table in a local Parquet file until it's uploaded to the store.
+type partFile[T any] struct {
+	f    *os.File
+	w    *parquet.GenericWriter[T]
+	rows int64
+}
+
+func newPartFile[T any](dir, table string) (*partFile[T], error) {
+	f, err := os.CreateTemp(dir, table+"-*.parquet")
+
+	if err != nil {
+		return nil, fmt.Errorf("could not create temporary %s file: %w", table, err)
+	}
+
+	return &partFile[T]{
+		f: f,
+		w: parquet.NewGenericWriter[T](f, schemaOf[T](table), parquet.Compression(&parquet.Zstd)),
+	}, nil
+}
+
+func (p *partFile[T]) write(rows []T) error {
+	if len(rows) == 0 {
+		return nil
+	}
+
+	n, err := p.w.Write(rows)
+	p.rows += int64(n)
+
+	if err != nil {
+		return fmt.Errorf("could not write parquet rows: %w", err)
+	}
+
+	return nil
+}
+
+func (p *partFile[T]) upload(ctx context.Context, store Store, key string) error {
+	if err := p.w.Close(); err != nil {
+		return fmt.Errorf("could not finish parquet file %s: %w", key, err)
+	}
+
+	size, err := p.f.Seek(0, io.SeekEnd)
+
+	if err != nil {
+		return fmt.Errorf("could not read parquet file %s: %w", key, err)
+	}
+
+	if _, err := p.f.Seek(0, io.SeekStart); err != nil {
+		return fmt.Errorf("could not read parquet file %s: %w", key, err)
+	}
+
+	return store.Put(ctx, key, p.f, size)
+}
+
+func (p *partFile[T]) close() {
+	_ = p.f.Close()
+}
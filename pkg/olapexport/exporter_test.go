@@ -0,0 +1,289 @@
+//go:build !e2e && !load && !rampup && !integration
+
+package olapexport
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/parquet-go/parquet-go"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/sqlcv1"
+)
+
+// fakeOLAPRepository serves export rows from memory. Methods which aren't used by the exporter
+// panic through the embedded nil interface.
+type fakeOLAPRepository struct {
+	repository.OLAPRepository
+
+	runs   []*sqlcv1.ListRunsForExportRow
+	tasks  []*repository.TaskForExport
+	events []*repository.TaskEventForExport
+
+	leased    bool
+	completed *repository.CompleteOLAPExportOpts
+	released  bool
+}
+
+func (r *fakeOLAPRepository) AcquireOLAPExportLease(ctx context.Context, tenantId uuid.UUID, partitionDate time.Time, processId uuid.UUID, leaseDuration time.Duration, force bool) (bool, error) {
+	if r.completed != nil && !force {
+		return false, nil
+	}
+
+	r.leased = true
+
+	return true, nil
+}
+
+func (r *fakeOLAPRepository) CompleteOLAPExport(ctx context.Context, tenantId uuid.UUID, partitionDate time.Time, processId uuid.UUID, opts repository.CompleteOLAPExportOpts) error {
+	r.completed = &opts
+	return nil
+}
+
+func (r *fakeOLAPRepository) ReleaseOLAPExportLease(ctx context.Context, tenantId uuid.UUID, partitionDate time.Time, processId uuid.UUID) error {
+	r.released = true
+	return nil
+}
+
+func (r *fakeOLAPRepository) ListRunsForExport(ctx context.Context, tenantId uuid.UUID, opts repository.ListOLAPExportRowsOpts) ([]*sqlcv1.ListRunsForExportRow, error) {
+	res := make([]*sqlcv1.ListRunsForExportRow, 0)
+
+	for _, run := range r.runs {
+		if afterCursor(run.InsertedAt.Time, run.ID, opts) && len(res) < int(opts.Limit) {
+			res = append(res, run)
+		}
+	}
+
+	return res, nil
+}
+
+func (r *fakeOLAPRepository) ListTasksForExport(ctx context.Context, tenantId uuid.UUID, opts repository.ListOLAPExportRowsOpts) ([]*repository.TaskForExport, error) {
+	res := make([]*repository.TaskForExport, 0)
+
+	for _, task := range r.tasks {
+		if afterCursor(task.InsertedAt.Time, task.ID, opts) && len(res) < int(opts.Limit) {
+			res = append(res, task)
+		}
+	}
+
+	return res, nil
+}
+
+func (r *fakeOLAPRepository) ListTaskEventsForExport(ctx context.Context, tenantId uuid.UUID, tasks []repository.TaskMetadata, includePayloads bool) ([]*repository.TaskEventForExport, error) {
+	ids := make(map[int64]bool, len(tasks))
+
+	for _, task := range tasks {
+		ids[task.TaskID] = true
+	}
+
+	res := make([]*repository.TaskEventForExport, 0)
+
+	for _, event := range r.events {
+		if ids[event.TaskID] {
+			res = append(res, event)
+		}
+	}
+
+	return res, nil
+}
+
+func afterCursor(insertedAt time.Time, id int64, opts repository.ListOLAPExportRowsOpts) bool {
+	return insertedAt.After(opts.AfterInsertedAt) || (insertedAt.Equal(opts.AfterInsertedAt) && id > opts.AfterId)
+}
+
+func ts(t time.Time) pgtype.Timestamptz {
+	return pgtype.Timestamptz{Time: t, Valid: true}
+}
+
+func newFakeRepository(tenantId uuid.UUID, day time.Time, numTasks int) *fakeOLAPRepository {
+	repo := &fakeOLAPRepository{}
+	workerId := uuid.New()
+
+	for i := 0; i < numTasks; i++ {
+		insertedAt := day.Add(time.Duration(i) * time.Minute)
+		runId := uuid.New()
+
+		run := &sqlcv1.ListRunsForExportRow{
+			TenantID:           tenantId,
+			ID:                 int64(i + 1),
+			InsertedAt:         ts(insertedAt),
+			ExternalID:         runId,
+			Kind:               sqlcv1.V1RunKindTASK,
+			ReadableStatus:     sqlcv1.V1ReadableStatusOlapCOMPLETED,
+			AdditionalMetadata: []byte(`{"customer":"acme"}`),
+			DisplayName:        "my-task",
+			TaskCount:          1,
+			StartedAt:          ts(insertedAt.Add(time.Second)),
+			FinishedAt:         ts(insertedAt.Add(3 * time.Second)),
+		}
+
+		task := &repository.TaskForExport{
+			ListTasksForExportRow: &sqlcv1.ListTasksForExportRow{
+				TenantID:         tenantId,
+				ID:               int64(i + 1),
+				InsertedAt:       ts(insertedAt),
+				ExternalID:       runId,
+				DisplayName:      "my-task",
+				Queue:            "default",
+				ActionID:         "my-task:run",
+				WorkflowRunID:    runId,
+				ReadableStatus:   sqlcv1.V1ReadableStatusOlapCOMPLETED,
+				LatestRetryCount: 1,
+				LatestWorkerID:   &workerId,
+				QueuedAt:         ts(insertedAt),
+				StartedAt:        ts(insertedAt.Add(time.Second)),
+				FinishedAt:       ts(insertedAt.Add(3 * time.Second)),
+			},
+			InputPayload: []byte(`{"n":1}`),
+		}
+
+		// the first run is still running, so it has no finish time or duration
+		if i == 0 {
+			run.ReadableStatus = sqlcv1.V1ReadableStatusOlapRUNNING
+			run.FinishedAt = pgtype.Timestamptz{}
+			task.FinishedAt = pgtype.Timestamptz{}
+		}
+
+		repo.runs = append(repo.runs, run)
+		repo.tasks = append(repo.tasks, task)
+
+		for j, eventType := range []sqlcv1.V1EventTypeOlap{sqlcv1.V1EventTypeOlapQUEUED, sqlcv1.V1EventTypeOlapSTARTED} {
+			repo.events = append(repo.events, &repository.TaskEventForExport{
+				V1TaskEventsOlap: &sqlcv1.V1TaskEventsOlap{
+					TenantID:       tenantId,
+					ID:             int64(i*2 + j + 1),
+					TaskID:         task.ID,
+					TaskInsertedAt: task.InsertedAt,
+					EventType:      eventType,
+					ExternalID:     uuid.New(),
+					ReadableStatus: sqlcv1.V1ReadableStatusOlapRUNNING,
+					EventTimestamp: ts(insertedAt.Add(time.Duration(j) * time.Second)),
+				},
+			})
+		}
+	}
+
+	return repo
+}
+
+func readRows[T any](t *testing.T, path string) []T {
+	t.Helper()
+
+	f, err := os.Open(path)
+	require.NoError(t, err)
+
+	defer f.Close()
+
+	stat, err := f.Stat()
+	require.NoError(t, err)
+
+	rows, err := parquet.Read[T](f, stat.Size())
+	require.NoError(t, err)
+
+	return rows
+}
+
+func TestExportPartition(t *testing.T) {
+	l := zerolog.Nop()
+	tenantId := uuid.New()
+	day := time.Date(2026, 9, 14, 0, 0, 0, 0, time.UTC)
+	dir := t.TempDir()
+
+	store, err := NewFileStore(dir)
+	require.NoError(t, err)
+
+	repo := newFakeRepository(tenantId, day, 5)
+
+	// a batch size smaller than the number of rows exercises pagination
+	exporter := NewExporter(repo, store, &l, ExporterOpts{
+		BatchSize:       2,
+		IncludePayloads: true,
+	})
+
+	res, err := exporter.ExportPartition(context.Background(), tenantId, day.Add(13*time.Hour), false)
+	require.NoError(t, err)
+
+	assert.False(t, res.Skipped)
+	assert.Equal(t, day, res.Date)
+	assert.EqualValues(t, 5, res.RunCount)
+	assert.EqualValues(t, 5, res.TaskCount)
+	assert.EqualValues(t, 10, res.TaskEventCount)
+
+	require.NotNil(t, repo.completed)
+	assert.Equal(t, store.String(), repo.completed.Destination)
+	assert.EqualValues(t, 5, repo.completed.RunCount)
+	assert.False(t, repo.released)
+
+	runs := readRows[RunRow](t, filepath.Join(dir, filepath.FromSlash(ObjectKey(TableRuns, tenantId, day))))
+	require.Len(t, runs, 5)
+
+	assert.Equal(t, "RUNNING", runs[0].Status)
+	assert.NotNil(t, runs[0].StartedAt)
+	assert.Nil(t, runs[0].FinishedAt)
+	assert.Nil(t, runs[0].DurationMs)
+
+	assert.Equal(t, "COMPLETED", runs[1].Status)
+	require.NotNil(t, runs[1].DurationMs)
+	assert.EqualValues(t, 2000, *runs[1].DurationMs)
+	require.NotNil(t, runs[1].AdditionalMetadata)
+	assert.JSONEq(t, `{"customer":"acme"}`, *runs[1].AdditionalMetadata)
+	assert.True(t, runs[1].CreatedAt.Equal(day.Add(time.Minute)))
+
+	tasks := readRows[TaskRow](t, filepath.Join(dir, filepath.FromSlash(ObjectKey(TableTasks, tenantId, day))))
+	require.Len(t, tasks, 5)
+
+	assert.EqualValues(t, 1, tasks[1].RetryCount)
+	require.NotNil(t, tasks[1].WorkerID)
+	require.NotNil(t, tasks[1].Input)
+	assert.JSONEq(t, `{"n":1}`, *tasks[1].Input)
+
+	events := readRows[TaskEventRow](t, filepath.Join(dir, filepath.FromSlash(ObjectKey(TableTaskEvents, tenantId, day))))
+	require.Len(t, events, 10)
+
+	assert.Equal(t, tasks[0].TaskID, events[0].TaskID)
+
+	// exporting the partition again is a no-op
+	res, err = exporter.ExportPartition(context.Background(), tenantId, day, false)
+	require.NoError(t, err)
+	assert.True(t, res.Skipped)
+
+	// unless it's forced
+	res, err = exporter.ExportPartition(context.Background(), tenantId, day, true)
+	require.NoError(t, err)
+	assert.False(t, res.Skipped)
+	assert.EqualValues(t, 5, res.RunCount)
+}
+
+func TestExportPartitionEmpty(t *testing.T) {
+	l := zerolog.Nop()
+	tenantId := uuid.New()
+	day := time.Date(2026, 9, 14, 0, 0, 0, 0, time.UTC)
+	dir := t.TempDir()
+
+	store, err := NewFileStore(dir)
+	require.NoError(t, err)
+
+	repo := &fakeOLAPRepository{}
+
+	res, err := NewExporter(repo, store, &l, ExporterOpts{}).ExportPartition(context.Background(), tenantId, day, false)
+	require.NoError(t, err)
+	assert.EqualValues(t, 0, res.RunCount)
+
+	// empty days still get files, so the warehouse can tell them apart from days which weren't exported
+	for _, table := range []string{TableRuns, TableTasks, TableTaskEvents} {
+		_, err := os.Stat(filepath.Join(dir, filepath.FromSlash(ObjectKey(table, tenantId, day))))
+		assert.NoError(t, err)
+	}
+
+	runs := readRows[RunRow](t, filepath.Join(dir, filepath.FromSlash(ObjectKey(TableRuns, tenantId, day))))
+	assert.Empty(t, runs)
+}
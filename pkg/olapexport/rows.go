@@ -0,0 +1,251 @@
+package olapexport
+
+import (
+	"reflect"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/parquet-go/parquet-go"
+
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/sqlcv1"
+)
+
+// JSON columns (metadata, inputs and outputs) are written as JSON encoded strings, which every
+// warehouse can load and parse.
+
+// RunRow is a row of the runs table, with one row per workflow run (a DAG or a standalone task).
+type RunRow struct {
+	TenantID             string     `parquet:"tenant_id"`
+	RunID                string     `parquet:"run_id"`
+	Kind                 string     `parquet:"kind"`
+	WorkflowID           string     `parquet:"workflow_id"`
+	WorkflowVersionID    string     `parquet:"workflow_version_id"`
+	DisplayName          string     `parquet:"display_name"`
+	Status               string     `parquet:"status"`
+	TaskCount            int32      `parquet:"task_count"`
+	RetryCount           int32      `parquet:"retry_count"`
+	ParentTaskExternalID *string    `parquet:"parent_task_id,optional"`
+	IdempotencyKey       *string    `parquet:"idempotency_key,optional"`
+	CreatedAt            time.Time  `parquet:"created_at"`
+	StartedAt            *time.Time `parquet:"started_at,optional"`
+	FinishedAt           *time.Time `parquet:"finished_at,optional"`
+	DurationMs           *int64     `parquet:"duration_ms,optional"`
+	AdditionalMetadata   *string    `parquet:"additional_metadata,optional"`
+}
+
+// TaskRow is a row of the tasks table, with one row per task and the timings of its latest attempt.
+type TaskRow struct {
+	TenantID             string     `parquet:"tenant_id"`
+	TaskID               string     `parquet:"task_id"`
+	RunID                string     `parquet:"run_id"`
+	WorkflowID           string     `parquet:"workflow_id"`
+	WorkflowVersionID    string     `parquet:"workflow_version_id"`
+	StepID               string     `parquet:"step_id"`
+	ActionID             string     `parquet:"action_id"`
+	Queue                string     `parquet:"queue"`
+	DisplayName          string     `parquet:"display_name"`
+	Status               string     `parquet:"status"`
+	RetryCount           int32      `parquet:"retry_count"`
+	WorkerID             *string    `parquet:"worker_id,optional"`
+	Priority             *int32     `parquet:"priority,optional"`
+	IsDurable            bool       `parquet:"is_durable"`
+	ParentTaskExternalID *string    `parquet:"parent_task_id,optional"`
+	CreatedAt            time.Time  `parquet:"created_at"`
+	QueuedAt             *time.Time `parquet:"queued_at,optional"`
+	StartedAt            *time.Time `parquet:"started_at,optional"`
+	FinishedAt           *time.Time `parquet:"finished_at,optional"`
+	DurationMs           *int64     `parquet:"duration_ms,optional"`
+	ErrorMessage         *string    `parquet:"error_message,optional"`
+	AdditionalMetadata   *string    `parquet:"additional_metadata,optional"`
+	Input                *string    `parquet:"input,optional"`
+}
+
+// TaskEventRow is a row of the task events table, with one row per event in the lifecycle of a task.
+type TaskEventRow struct {
+	TenantID     string    `parquet:"tenant_id"`
+	EventID      string    `parquet:"event_id"`
+	TaskID       string    `parquet:"task_id"`
+	WorkflowID   string    `parquet:"workflow_id"`
+	EventType    string    `parquet:"event_type"`
+	Status       string    `parquet:"status"`
+	RetryCount   int32     `parquet:"retry_count"`
+	Timestamp    time.Time `parquet:"timestamp"`
+	WorkerID     *string   `parquet:"worker_id,optional"`
+	ErrorMessage *string   `parquet:"error_message,optional"`
+	Message      *string   `parquet:"message,optional"`
+	Data         *string   `parquet:"data,optional"`
+	Output       *string   `parquet:"output,optional"`
+}
+
+// schemaOf returns the Parquet schema of a row type. parquet-go maps time.Time fields to nanosecond
+// timestamps and can't set a unit on the *time.Time fields used for nullable columns, so timestamp
+// columns are rewritten to microsecond precision, which every warehouse can read.
+func schemaOf[T any](name string) *parquet.Schema {
+	return parquet.NewSchema(name, withMicrosecondTimestamps(parquet.SchemaOf(new(T))))
+}
+
+func withMicrosecondTimestamps(node parquet.Node) parquet.Node {
+	fields := node.Fields()
+	res := make([]parquet.Field, len(fields))
+
+	for i, field := range fields {
+		res[i] = field
+
+		if lt := field.Type().LogicalType(); lt != nil && lt.Timestamp != nil {
+			ts := parquet.TimestampAdjusted(parquet.Microsecond, true)
+
+			if field.Optional() {
+				ts = parquet.Optional(ts)
+			}
+
+			res[i] = &retypedField{Node: ts, field: field}
+		}
+	}
+
+	return &retypedGroup{Node: node, fields: res}
+}
+
+type retypedGroup struct {
+	parquet.Node
+	fields []parquet.Field
+}
+
+func (g *retypedGroup) Fields() []parquet.Field {
+	return g.fields
+}
+
+type retypedField struct {
+	parquet.Node
+	field parquet.Field
+}
+
+func (f *retypedField) Name() string {
+	return f.field.Name()
+}
+
+func (f *retypedField) Value(base reflect.Value) reflect.Value {
+	return f.field.Value(base)
+}
+
+func newRunRow(run *sqlcv1.ListRunsForExportRow) RunRow {
+	return RunRow{
+		TenantID:             run.TenantID.String(),
+		RunID:                run.ExternalID.String(),
+		Kind:                 string(run.Kind),
+		WorkflowID:           run.WorkflowID.String(),
+		WorkflowVersionID:    run.WorkflowVersionID.String(),
+		DisplayName:          run.DisplayName,
+		Status:               string(run.ReadableStatus),
+		TaskCount:            run.TaskCount,
+		RetryCount:           run.RetryCount,
+		ParentTaskExternalID: uuidPtrString(run.ParentTaskExternalID),
+		IdempotencyKey:       textPtr(run.IdempotencyKey),
+		CreatedAt:            run.InsertedAt.Time.UTC(),
+		StartedAt:            timePtr(run.StartedAt),
+		FinishedAt:           timePtr(run.FinishedAt),
+		DurationMs:           durationMs(run.StartedAt, run.FinishedAt),
+		AdditionalMetadata:   jsonPtr(run.AdditionalMetadata),
+	}
+}
+
+func newTaskRow(task *repository.TaskForExport) TaskRow {
+	row := TaskRow{
+		TenantID:             task.TenantID.String(),
+		TaskID:               task.ExternalID.String(),
+		RunID:                task.WorkflowRunID.String(),
+		WorkflowID:           task.WorkflowID.String(),
+		WorkflowVersionID:    task.WorkflowVersionID.String(),
+		StepID:               task.StepID.String(),
+		ActionID:             task.ActionID,
+		Queue:                task.Queue,
+		DisplayName:          task.DisplayName,
+		Status:               string(task.ReadableStatus),
+		RetryCount:           task.LatestRetryCount,
+		WorkerID:             uuidPtrString(task.LatestWorkerID),
+		IsDurable:            task.IsDurable,
+		ParentTaskExternalID: uuidPtrString(task.ParentTaskExternalID),
+		CreatedAt:            task.InsertedAt.Time.UTC(),
+		QueuedAt:             timePtr(task.QueuedAt),
+		StartedAt:            timePtr(task.StartedAt),
+		FinishedAt:           timePtr(task.FinishedAt),
+		DurationMs:           durationMs(task.StartedAt, task.FinishedAt),
+		ErrorMessage:         textPtr(task.ErrorMessage),
+		AdditionalMetadata:   jsonPtr(task.AdditionalMetadata),
+		Input:                jsonPtr(task.InputPayload),
+	}
+
+	if task.Priority.Valid {
+		priority := task.Priority.Int32
+		row.Priority = &priority
+	}
+
+	return row
+}
+
+func newTaskEventRow(event *repository.TaskEventForExport, taskExternalId uuid.UUID) TaskEventRow {
+	return TaskEventRow{
+		TenantID:     event.TenantID.String(),
+		EventID:      event.ExternalID.String(),
+		TaskID:       taskExternalId.String(),
+		WorkflowID:   event.WorkflowID.String(),
+		EventType:    string(event.EventType),
+		Status:       string(event.ReadableStatus),
+		RetryCount:   event.RetryCount,
+		Timestamp:    event.EventTimestamp.Time.UTC(),
+		WorkerID:     uuidPtrString(event.WorkerID),
+		ErrorMessage: textPtr(event.ErrorMessage),
+		Message:      textPtr(event.AdditionalEventMessage),
+		Data:         textPtr(event.AdditionalEventData),
+		Output:       jsonPtr(event.OutputPayload),
+	}
+}
+
+func uuidPtrString(id *uuid.UUID) *string {
+	if id == nil {
+		return nil
+	}
+
+	s := id.String()
+
+	return &s
+}
+
+func textPtr(t pgtype.Text) *string {
+	if !t.Valid {
+		return nil
+	}
+
+	return &t.String
+}
+
+func timePtr(t pgtype.Timestamptz) *time.Time {
+	if !t.Valid {
+		return nil
+	}
+
+	utc := t.Time.UTC()
+
+	return &utc
+}
+
+func jsonPtr(b []byte) *string {
+	if len(b) == 0 {
+		return nil
+	}
+
+	s := string(b)
+
+	return &s
+}
+
+func durationMs(start, finish pgtype.Timestamptz) *int64 {
+	if !start.Valid || !finish.Valid || finish.Time.Before(start.Time) {
+		return nil
+	}
+
+	ms := finish.Time.Sub(start.Time).Milliseconds()
+
+	return &ms
+}
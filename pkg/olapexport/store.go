@@ -0,0 +1,155 @@
+package olapexport
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+)
+
+// Store is where exported Parquet files are written.
+type Store interface {
+	// Put writes the object at key, replacing any object which already exists there. Keys are
+	// slash-separated and relative to the root of the store.
+	Put(ctx context.Context, key string, body io.ReadSeeker, size int64) error
+
+	// String returns the location of the store, which is recorded in the export checkpoints.
+	String() string
+}
+
+// NewStore creates a store from a destination URL. Supported destinations are local directories
+// (file:///var/lib/hatchet/exports, or a plain path) and S3 compatible object stores
+// (s3://bucket/prefix). S3 credentials, region and endpoint are read from the standard AWS
+// environment variables and config files.
+func NewStore(ctx context.Context, destination string) (Store, error) {
+	if destination == "" {
+		return nil, fmt.Errorf("export destination is required")
+	}
+
+	u, err := url.Parse(destination)
+
+	if err != nil || u.Scheme == "" {
+		return NewFileStore(destination)
+	}
+
+	switch u.Scheme {
+	case "file":
+		return NewFileStore(u.Path)
+	case "s3":
+		if u.Host == "" {
+			return nil, fmt.Errorf("s3 destination %q is missing a bucket", destination)
+		}
+
+		cfg, err := config.LoadDefaultConfig(ctx)
+
+		if err != nil {
+			return nil, fmt.Errorf("could not load aws config: %w", err)
+		}
+
+		return NewS3Store(s3.NewFromConfig(cfg), u.Host, strings.Trim(u.Path, "/")), nil
+	default:
+		return nil, fmt.Errorf("unsupported export destination scheme %q, expected file or s3", u.Scheme)
+	}
+}
+
+type fileStore struct {
+	root string
+}
+
+// NewFileStore creates a store which writes to a directory on the local filesystem.
+func NewFileStore(root string) (Store, error) {
+	if root == "" {
+		return nil, fmt.Errorf("export directory is required")
+	}
+
+	abs, err := filepath.Abs(root)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not resolve export directory: %w", err)
+	}
+
+	return &fileStore{root: abs}, nil
+}
+
+func (s *fileStore) Put(ctx context.Context, key string, body io.ReadSeeker, size int64) error {
+	target := filepath.Join(s.root, filepath.FromSlash(path.Clean("/"+key)))
+
+	if err := os.MkdirAll(filepath.Dir(target), 0o750); err != nil {
+		return fmt.Errorf("could not create export directory: %w", err)
+	}
+
+	// write to a temporary file first so readers never see a partially written file
+	tmp, err := os.CreateTemp(filepath.Dir(target), "."+filepath.Base(target)+".tmp-*")
+
+	if err != nil {
+		return fmt.Errorf("could not create export file: %w", err)
+	}
+
+	defer os.Remove(tmp.Name()) // nolint: errcheck
+
+	if _, err := io.Copy(tmp, body); err != nil {
+		_ = tmp.Close()
+		return fmt.Errorf("could not write export file: %w", err)
+	}
+
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("could not write export file: %w", err)
+	}
+
+	if err := os.Rename(tmp.Name(), target); err != nil {
+		return fmt.Errorf("could not move export file into place: %w", err)
+	}
+
+	return nil
+}
+
+func (s *fileStore) String() string {
+	return "file://" + filepath.ToSlash(s.root)
+}
+
+type s3Store struct {
+	client *s3.Client
+	bucket string
+	prefix string
+}
+
+// NewS3Store creates a store which writes to a bucket, with every key placed under prefix.
+func NewS3Store(client *s3.Client, bucket, prefix string) Store {
+	return &s3Store{
+		client: client,
+		bucket: bucket,
+		prefix: prefix,
+	}
+}
+
+func (s *s3Store) Put(ctx context.Context, key string, body io.ReadSeeker, size int64) error {
+	_, err := s.client.PutObject(ctx, &s3.PutObjectInput{
+		Bucket:        aws.String(s.bucket),
+		Key:           aws.String(path.Join(s.prefix, key)),
+		Body:          body,
+		ContentLength: aws.Int64(size),
+		ContentType:   aws.String("application/vnd.apache.parquet"),
+	})
+
+	if err != nil {
+		return fmt.Errorf("could not upload %s to s3: %w", key, err)
+	}
+
+	return nil
+}
+
+func (s *s3Store) String() string {
+	if s.prefix == "" {
+		return "s3://" + s.bucket
+	}
+
+	return "s3://" + s.bucket + "/" + s.prefix
+}
@@ -0,0 +1,82 @@
+//go:build !e2e && !load && !rampup && !integration
+
+package olapexport
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestObjectKey(t *testing.T) {
+	tenantId := uuid.MustParse("707d0855-80ab-4e1f-a156-f1c4546cbf52")
+	day := time.Date(2026, 9, 14, 23, 59, 0, 0, time.UTC)
+
+	assert.Equal(t,
+		"runs/tenant_id=707d0855-80ab-4e1f-a156-f1c4546cbf52/date=2026-09-14/part-00000.parquet",
+		ObjectKey(TableRuns, tenantId, day),
+	)
+
+	// keys are bucketed by the UTC day
+	est := time.FixedZone("EST", -5*60*60)
+
+	assert.Equal(t,
+		"task_events/tenant_id=707d0855-80ab-4e1f-a156-f1c4546cbf52/date=2026-09-15/part-00000.parquet",
+		ObjectKey(TableTaskEvents, tenantId, time.Date(2026, 9, 14, 20, 0, 0, 0, est)),
+	)
+}
+
+func TestNewStore(t *testing.T) {
+	dir := t.TempDir()
+
+	store, err := NewStore(context.Background(), "file://"+dir)
+	require.NoError(t, err)
+	assert.Equal(t, "file://"+filepath.ToSlash(dir), store.String())
+
+	store, err = NewStore(context.Background(), dir)
+	require.NoError(t, err)
+	assert.Equal(t, "file://"+filepath.ToSlash(dir), store.String())
+
+	_, err = NewStore(context.Background(), "")
+	assert.Error(t, err)
+
+	_, err = NewStore(context.Background(), "gs://bucket/prefix")
+	assert.ErrorContains(t, err, "unsupported export destination scheme")
+
+	_, err = NewStore(context.Background(), "s3:///prefix")
+	assert.ErrorContains(t, err, "missing a bucket")
+}
+
+func TestFileStorePut(t *testing.T) {
+	dir := t.TempDir()
+
+	store, err := NewFileStore(dir)
+	require.NoError(t, err)
+
+	key := "runs/tenant_id=abc/date=2026-09-14/part-00000.parquet"
+
+	require.NoError(t, store.Put(context.Background(), key, strings.NewReader("first"), 5))
+	require.NoError(t, store.Put(context.Background(), key, strings.NewReader("second"), 6))
+
+	b, err := os.ReadFile(filepath.Join(dir, filepath.FromSlash(key)))
+	require.NoError(t, err)
+	assert.Equal(t, "second", string(b))
+
+	// no temporary files are left behind
+	entries, err := os.ReadDir(filepath.Join(dir, "runs", "tenant_id=abc", "date=2026-09-14"))
+	require.NoError(t, err)
+	assert.Len(t, entries, 1)
+
+	// keys can't escape the root of the store
+	require.NoError(t, store.Put(context.Background(), "../outside.parquet", strings.NewReader("x"), 1))
+
+	_, err = os.Stat(filepath.Join(dir, "outside.parquet"))
+	assert.NoError(t, err)
+}
@@ -0,0 +1,328 @@
+package approvaloperator
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+	"go.opentelemetry.io/otel/attribute"
+	telemetry_codes "go.opentelemetry.io/otel/codes"
+
+	"github.com/hatchet-dev/hatchet/internal/listutils"
+	"github.com/hatchet-dev/hatchet/internal/services/dispatcher/contracts"
+	tasktypes "github.com/hatchet-dev/hatchet/internal/services/shared/tasktypes/v1"
+	"github.com/hatchet-dev/hatchet/pkg/clock"
+	"github.com/hatchet-dev/hatchet/pkg/operator"
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/sqlcv1"
+	"github.com/hatchet-dev/hatchet/pkg/telemetry"
+)
+
+const (
+	// actionPollInterval is how often the operator queries the database for the tenant's
+	// approval steps to keep its registered actions in sync.
+	actionPollInterval = 5 * time.Second
+
+	// actionPollTimeout bounds a single action-listing poll.
+	actionPollTimeout = 10 * time.Second
+
+	// requestTimeout bounds recording an approval request for an assigned task.
+	requestTimeout = 10 * time.Second
+
+	// defaultSlots is the number of slots of an approval operator's worker. Approval tasks
+	// hold their slot until someone approves or rejects them, but don't do any work while
+	// waiting, so the default is large.
+	defaultSlots = 10000
+)
+
+// ApprovalOperatorConfig is the stored config for an approval operator. Like the DAG operator,
+// the action set is not configured statically: the operator polls the database for the tenant's
+// approval steps and registers their actions.
+type ApprovalOperatorConfig struct {
+	// Slots is the number of approval tasks which can be pending on the operator's worker at
+	// once. Defaults to 10000 when unset.
+	Slots int `json:"slots"`
+}
+
+func SlotConfig(op *sqlcv1.V1Operator) (map[string]int32, error) {
+	var cfg ApprovalOperatorConfig
+
+	if err := json.Unmarshal(op.Config, &cfg); err != nil {
+		return nil, fmt.Errorf("could not unmarshal operator config: %w", err)
+	}
+
+	slots := cfg.Slots
+
+	if slots <= 0 {
+		slots = defaultSlots
+	}
+
+	return map[string]int32{repository.SlotTypeDurable: int32(slots)}, nil // nolint: gosec
+}
+
+// Notifier notifies approvers that an approval was requested.
+type Notifier interface {
+	SendApprovalRequestedAlert(tenantId uuid.UUID, approval *sqlcv1.V1Approval) error
+}
+
+type ApprovalOperator struct {
+	*operator.SharedOperator[ApprovalOperatorConfig]
+
+	repo     repository.Repository
+	notifier Notifier
+
+	// ctx and cancel bound the action poller.
+	ctx    context.Context
+	cancel context.CancelFunc
+
+	// lastActions is the most recently registered action set. Only the polling goroutine
+	// touches it.
+	lastActions []string
+}
+
+type ApprovalOperatorOpt func(*ApprovalOperator)
+
+// WithNotifier sets the notifier used to tell approvers about new approvals.
+func WithNotifier(notifier Notifier) ApprovalOperatorOpt {
+	return func(a *ApprovalOperator) {
+		a.notifier = notifier
+	}
+}
+
+// NewApprovalOperator constructs an approval operator and starts a goroutine that polls the
+// database for the tenant's approval steps, registering their actions so approval tasks are
+// routed to it. An approval task stays assigned to the operator until its approval is resolved
+// through the API or expires, which finishes the task directly.
+func NewApprovalOperator(op *sqlcv1.V1Operator, l *zerolog.Logger, repo repository.Repository, taskEventWriter operator.TaskEventWriter, workerId uuid.UUID, opts ...ApprovalOperatorOpt) (*ApprovalOperator, error) {
+	shared, err := operator.NewSharedOperator(op, l, repo, taskEventWriter, workerId, ApprovalOperatorConfig{})
+
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	a := &ApprovalOperator{
+		SharedOperator: shared,
+		repo:           repo,
+		ctx:            ctx,
+		cancel:         cancel,
+	}
+
+	for _, opt := range opts {
+		opt(a)
+	}
+
+	go a.pollActions(ctx)
+
+	return a, nil
+}
+
+// Cleanup stops the action poller in addition to the shared operator's teardown.
+func (a *ApprovalOperator) Cleanup() {
+	if a.cancel != nil {
+		a.cancel()
+	}
+
+	a.SharedOperator.Cleanup()
+}
+
+// Drain stops the action poller and drains in-flight tasks without pausing the worker.
+func (a *ApprovalOperator) Drain() {
+	if a.cancel != nil {
+		a.cancel()
+	}
+
+	a.SharedOperator.Drain()
+}
+
+func (a *ApprovalOperator) pollActions(ctx context.Context) {
+	a.refreshActions(ctx)
+
+	ticker := time.NewTicker(actionPollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			a.refreshActions(ctx)
+		}
+	}
+}
+
+// refreshActions registers the actions of the tenant's approval steps, skipping the dispatcher
+// write when the set is unchanged.
+func (a *ApprovalOperator) refreshActions(ctx context.Context) {
+	ctx, span := telemetry.NewSpan(ctx, "approvaloperator.refreshActions")
+	defer span.End()
+
+	pollCtx, cancel := context.WithTimeout(ctx, actionPollTimeout)
+	defer cancel()
+
+	actions, err := a.repo.Operators().ListApprovalActions(pollCtx, a.TenantId())
+
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(telemetry_codes.Error, "could not list approval actions")
+		a.Logger().Error().Err(err).Msg("could not list approval actions for operator")
+		return
+	}
+
+	span.SetAttributes(attribute.Int("approvaloperator.action_count", len(actions)))
+
+	if listutils.AreUnorderedEqual(actions, a.lastActions) {
+		return
+	}
+
+	if err := a.UpdateWorkerActions(ctx, actions); err != nil {
+		span.RecordError(err)
+		span.SetStatus(telemetry_codes.Error, "could not update approval operator worker actions")
+		a.Logger().Error().Err(err).Msg("could not update approval operator worker actions")
+		return
+	}
+
+	a.lastActions = actions
+}
+
+func (a *ApprovalOperator) HandleAction(ctx context.Context, action *contracts.AssignedAction) error {
+	ctx, span := telemetry.NewSpan(ctx, "approvaloperator.HandleAction")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("approvaloperator.action_type", action.ActionType.String()),
+		attribute.String("approvaloperator.task_run_external_id", action.TaskRunExternalId),
+	)
+
+	release := a.RecordTask()
+	defer release()
+
+	switch action.ActionType {
+	case contracts.ActionType_START_STEP_RUN:
+		return a.requestApproval(ctx, action)
+	case contracts.ActionType_CANCEL_STEP_RUN:
+		return a.cancelApproval(ctx, action)
+	default:
+		a.Logger().Warn().
+			Str("action_type", action.ActionType.String()).
+			Str("task_run_external_id", action.TaskRunExternalId).
+			Msg("approval operator received unsupported action type; skipping")
+
+		return nil
+	}
+}
+
+// requestApproval records the pending approval for an assigned task and notifies the approvers.
+// The task is left running; it's finished when the approval is resolved or expires.
+func (a *ApprovalOperator) requestApproval(ctx context.Context, action *contracts.AssignedAction) error {
+	// detached from the dispatcher's delivery context, which may be cancelled before the
+	// request is recorded
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), requestTimeout)
+	defer cancel()
+
+	externalId, err := uuid.Parse(action.TaskRunExternalId)
+
+	if err != nil {
+		return a.SendFailed(action, fmt.Sprintf("could not parse task run external id: %s", err.Error()), true)
+	}
+
+	task, err := a.repo.Tasks().GetTaskByExternalId(ctx, a.TenantId(), externalId, false)
+
+	if err != nil {
+		return fmt.Errorf("could not get task: %w", err)
+	}
+
+	stepApproval, err := a.repo.Approvals().GetStepApproval(ctx, a.TenantId(), task.StepID)
+
+	if err != nil {
+		return fmt.Errorf("could not get step approval: %w", err)
+	}
+
+	if stepApproval == nil {
+		return a.SendFailed(action, "task is not an approval task", true)
+	}
+
+	approvalCtx, err := repository.ApprovalContext([]byte(action.ActionPayload), stepApproval.ContextFields)
+
+	if err != nil {
+		return a.SendFailed(action, fmt.Sprintf("could not read approval context: %s", err.Error()), true)
+	}
+
+	approval, isCreated, err := a.repo.Approvals().RequestApproval(ctx, a.TenantId(), repository.RequestApprovalOpts{
+		TaskExternalId: externalId,
+		TaskId:         task.ID,
+		TaskInsertedAt: task.InsertedAt,
+		TaskRetryCount: action.RetryCount,
+		WorkflowRunId:  task.WorkflowRunID,
+		StepId:         task.StepID,
+		TaskName:       action.TaskName,
+		Approvers:      stepApproval.Approvers,
+		OnExpiry:       stepApproval.OnExpiry,
+		ExpiresAt:      clock.Now().UTC().Add(time.Duration(stepApproval.ExpiresInSeconds) * time.Second),
+		Context:        approvalCtx,
+	})
+
+	if err != nil {
+		return fmt.Errorf("could not request approval: %w", err)
+	}
+
+	if err := a.SendStarted(action); err != nil {
+		a.Logger().Error().Err(err).
+			Str("task_run_external_id", action.TaskRunExternalId).
+			Msg("could not report task started")
+	}
+
+	if approval.Status != sqlcv1.V1ApprovalStatusPENDING {
+		// the approval was resolved before the task was reassigned to this operator
+		return a.finish(action, approval)
+	}
+
+	if isCreated && a.notifier != nil {
+		if err := a.notifier.SendApprovalRequestedAlert(a.TenantId(), approval); err != nil {
+			a.Logger().Error().Err(err).
+				Str("task_run_external_id", action.TaskRunExternalId).
+				Msg("could not send approval requested alert")
+		}
+	}
+
+	return nil
+}
+
+// finish reports the result of an approval which was already resolved.
+func (a *ApprovalOperator) finish(action *contracts.AssignedAction, approval *sqlcv1.V1Approval) error {
+	output, ok := tasktypes.ApprovalResult(approval)
+
+	if !ok {
+		return nil
+	}
+
+	if output == nil {
+		return a.SendFailed(action, tasktypes.ApprovalExpiredErrorMsg, true)
+	}
+
+	outputBytes, err := json.Marshal(output)
+
+	if err != nil {
+		return fmt.Errorf("could not marshal approval output: %w", err)
+	}
+
+	return a.SendCompleted(action, outputBytes)
+}
+
+func (a *ApprovalOperator) cancelApproval(ctx context.Context, action *contracts.AssignedAction) error {
+	externalId, err := uuid.Parse(action.TaskRunExternalId)
+
+	if err != nil {
+		return fmt.Errorf("could not parse task run external id %q: %w", action.TaskRunExternalId, err)
+	}
+
+	if err := a.repo.Approvals().CancelApproval(ctx, a.TenantId(), externalId); err != nil {
+		return err
+	}
+
+	return a.SendCancelled(action)
+}
@@ -50,7 +50,8 @@ type dag struct {
 	requestCh    chan<- *v1contracts.DurableTaskRequest
 	responseCh   <-chan *v1contracts.DurableTaskResponse
 	evalBoolExpr func(ctx context.Context, expr string, vars map[string]interface{}) (bool, error)
-	triggerStep  func(ctx context.Context, actionId, workflowName string, childIndex int32, parentTaskRunIds []uuid.UUID, isSkipped, isCancelled, parentReExecuted bool) (*operator.DAGStepTriggerResult, error)
+	evalListExpr func(ctx context.Context, expr string, vars map[string]interface{}) ([]interface{}, error)
+	triggerStep  triggerStepFunc
 
 	tasks []*task
 
@@ -64,6 +65,9 @@ type dag struct {
 	input           string
 	err             error
 
+	// the workflow input parsed from input, exposed to map expressions
+	workflowInput map[string]interface{}
+
 	pendingWaitAcks []*pendingWaitAck
 
 	// pendingEntryCompletions buffers EntryCompleted refs that raced ahead of their WaitForAck
@@ -79,6 +83,17 @@ type dag struct {
 	conditionMatches map[*sqlcv1.V1StepMatchCondition]bool
 }
 
+// triggerStepFunc triggers a run of a step. item is set when triggering one element of a map
+// step, and mapParentReadableIds lists the map steps whose runs are among parentTaskRunIds.
+type triggerStepFunc func(ctx context.Context, actionId, workflowName string, childIndex int32, item *mapItemTrigger, parentTaskRunIds []uuid.UUID, mapParentReadableIds []string, isSkipped, isCancelled, parentReExecuted bool) (*operator.DAGStepTriggerResult, error)
+
+// mapItemTrigger identifies one element of a map step: the child key gives each element a stable
+// identity across replays, and the element is the run's input.
+type mapItemTrigger struct {
+	childKey string
+	input    []byte
+}
+
 type conditionKind int
 
 const (
@@ -141,6 +156,48 @@ type task struct {
 	nodeId                int64
 	branchId              int64
 	workflowRunExternalId *uuid.UUID
+
+	// retainOutput keeps the output after conditions are evaluated, since a map step's expression
+	// reads it
+	retainOutput bool
+
+	// mapExpr is set for map steps, which are fanned out into one run per element of the list it
+	// returns, with at most mapMaxParallelism runs in progress at once
+	mapExpr           string
+	mapMaxParallelism int
+
+	// the elements of a map step, nil until the expression is evaluated
+	mapItems []*mapItem
+
+	// the index of the next element to trigger
+	mapNext int
+
+	// mapStopped is set once an element fails or is cancelled, after which no more are triggered
+	mapStopped bool
+
+	// the parents every element of a map step is triggered with, fixed when the map starts
+	mapParentTaskRunIds  []uuid.UUID
+	mapParentReadableIds []string
+	mapParentReExecuted  bool
+
+	// the ordered outputs of a completed map step, kept for the DAG's output
+	mapResults []interface{}
+}
+
+// mapItem is one element of a map step and the state of its run.
+type mapItem struct {
+	input []byte
+
+	isTriggered  bool
+	isCompleted  bool
+	isFailed     bool
+	isCancelled  bool
+	errorMessage string
+	output       map[string]interface{}
+
+	nodeId                int64
+	branchId              int64
+	workflowRunExternalId *uuid.UUID
 }
 
 func dagDurableTask(
@@ -153,7 +210,8 @@ func dagDurableTask(
 	requestCh chan<- *v1contracts.DurableTaskRequest,
 	responseCh <-chan *v1contracts.DurableTaskResponse,
 	evalBoolExpr func(ctx context.Context, expr string, vars map[string]interface{}) (bool, error),
-	triggerStep func(ctx context.Context, actionId, workflowName string, childIndex int32, parentTaskRunIds []uuid.UUID, isSkipped, isCancelled, parentReExecuted bool) (*operator.DAGStepTriggerResult, error),
+	evalListExpr func(ctx context.Context, expr string, vars map[string]interface{}) ([]interface{}, error),
+	triggerStep triggerStepFunc,
 ) error {
 	ctx, span := telemetry.NewSpan(ctx, "dag.dagDurableTask")
 	defer span.End()
@@ -172,6 +230,7 @@ func dagDurableTask(
 		requestCh:        requestCh,
 		responseCh:       responseCh,
 		evalBoolExpr:     evalBoolExpr,
+		evalListExpr:     evalListExpr,
 		externalId:       externalId,
 		invocationCount:  invocationCount,
 		input:            input,
@@ -179,6 +238,18 @@ func dagDurableTask(
 		conditionMatches: make(map[*sqlcv1.V1StepMatchCondition]bool),
 	}
 
+	d.parseWorkflowInput()
+
+	for _, t := range tasks {
+		if t.mapExpr == "" {
+			continue
+		}
+
+		for _, p := range t.parents {
+			p.retainOutput = true
+		}
+	}
+
 	for !d.isDone() {
 		if err := d.taskEmitter(ctx); err != nil {
 			return err
@@ -229,6 +300,20 @@ func dagDurableTask(
 	return nil
 }
 
+// parseWorkflowInput reads the workflow input out of the action payload, which map expressions
+// read as input. A payload without an input leaves it empty.
+func (d *dag) parseWorkflowInput() {
+	d.workflowInput = make(map[string]interface{})
+
+	var payload struct {
+		Input map[string]interface{} `json:"input"`
+	}
+
+	if err := json.Unmarshal([]byte(d.input), &payload); err == nil && payload.Input != nil {
+		d.workflowInput = payload.Input
+	}
+}
+
 // blocks until the child task returns - this is basically here to just reveal bottlenecks, especially on child spawning, in the traces
 func (d *dag) awaitResponse(ctx context.Context, responseCh <-chan *v1contracts.DurableTaskResponse) (*v1contracts.DurableTaskResponse, error) {
 	_, span := telemetry.NewSpan(ctx, "dag.awaitResponse")
@@ -238,6 +323,12 @@ func (d *dag) awaitResponse(ctx context.Context, responseCh <-chan *v1contracts.
 
 	for _, t := range d.tasks {
 		switch {
+		case t.mapItems != nil:
+			for _, item := range t.mapItems {
+				if item.isTriggered && !item.isCompleted {
+					awaitingChildren++
+				}
+			}
 		case t.isTriggered && !t.isCompleted:
 			awaitingChildren++
 		case t.isWaiting && !t.isWaitSatisfied:
@@ -291,6 +382,20 @@ func (d *dag) emitReadyTasks(ctx context.Context) (bool, error) {
 
 	progressed := false
 
+	for _, t := range d.tasks {
+		if t.mapItems == nil || t.isCompleted {
+			continue
+		}
+
+		triggered, err := d.emitMapItems(ctx, t)
+		if err != nil {
+			d.err = err
+			return progressed, d.err
+		}
+
+		progressed = progressed || triggered
+	}
+
 	stillPending := d.pendingTasks[:0]
 
 	for _, t := range d.pendingTasks {
@@ -373,12 +478,7 @@ func (d *dag) emitReadyTasks(ctx context.Context) (bool, error) {
 			}
 		}
 
-		var parentTaskRunIds []uuid.UUID
-		for _, p := range d.tasks {
-			if p.isCompleted && !p.isFailed && p.workflowRunExternalId != nil {
-				parentTaskRunIds = append(parentTaskRunIds, *p.workflowRunExternalId)
-			}
-		}
+		parentTaskRunIds, mapParentReadableIds := d.completedTaskRunIds()
 
 		parentReExecuted := false
 		for _, p := range t.parents {
@@ -388,7 +488,22 @@ func (d *dag) emitReadyTasks(ctx context.Context) (bool, error) {
 			}
 		}
 
-		result, err := d.triggerStep(ctx, t.actionId, t.workflowName, t.index, parentTaskRunIds, skip, cancelled, parentReExecuted)
+		if t.mapExpr != "" && !skip && !cancelled {
+			t.isTriggered = true
+			t.mapParentTaskRunIds = parentTaskRunIds
+			t.mapParentReadableIds = mapParentReadableIds
+			t.mapParentReExecuted = parentReExecuted
+			progressed = true
+
+			if err := d.startMap(ctx, t); err != nil {
+				d.err = err
+				return progressed, d.err
+			}
+
+			continue
+		}
+
+		result, err := d.triggerStep(ctx, t.actionId, t.workflowName, t.index, nil, parentTaskRunIds, mapParentReadableIds, skip, cancelled, parentReExecuted)
 		if err != nil {
 			d.err = fmt.Errorf("failed to trigger step %q: %w", t.actionId, err)
 			return progressed, d.err
@@ -508,7 +623,19 @@ func (d *dag) tryApplyEntryCompletion(ctx context.Context, entry pendingEntryCom
 			return true
 		}
 
-		if t.nodeId != entry.nodeId || t.branchId != entry.branchId {
+		for _, item := range t.mapItems {
+			if !item.isTriggered || item.isCompleted || item.nodeId != entry.nodeId || item.branchId != entry.branchId {
+				continue
+			}
+
+			if err := d.applyMapItemCompletion(ctx, t, item, entry.isFailure, entry.errorMessage, entry.payload); err != nil {
+				d.err = err
+			}
+
+			return true
+		}
+
+		if t.mapItems != nil || t.nodeId != entry.nodeId || t.branchId != entry.branchId {
 			continue
 		}
 
@@ -566,6 +693,179 @@ func (d *dag) applyCompletion(ctx context.Context, t *task, isFailure bool, erro
 	return d.evaluateConditionsForParent(ctx, t)
 }
 
+// startMap evaluates a map step's expression and triggers its first elements. An expression which
+// can't be evaluated, or which returns anything but a list of objects, fails the map step.
+func (d *dag) startMap(ctx context.Context, t *task) error {
+	parents := make(map[string]interface{}, len(t.parents))
+	for _, p := range t.parents {
+		if p.output != nil {
+			parents[p.readableId] = p.output
+		}
+	}
+
+	elements, err := d.evalListExpr(ctx, t.mapExpr, map[string]interface{}{
+		"input":   d.workflowInput,
+		"parents": parents,
+	})
+	if err != nil {
+		d.failMap(t, fmt.Sprintf("could not evaluate map expression %q: %v", t.mapExpr, err))
+		return nil
+	}
+
+	items := make([]*mapItem, 0, len(elements))
+
+	for i, element := range elements {
+		obj, ok := element.(map[string]interface{})
+		if !ok {
+			d.failMap(t, fmt.Sprintf("map expression %q must return a list of objects, element %d is %T", t.mapExpr, i, element))
+			return nil
+		}
+
+		input, err := json.Marshal(obj)
+		if err != nil {
+			return fmt.Errorf("failed to marshal element %d of map step %q: %w", i, t.readableId, err)
+		}
+
+		items = append(items, &mapItem{input: input})
+	}
+
+	t.mapItems = items
+
+	if _, err := d.emitMapItems(ctx, t); err != nil {
+		return err
+	}
+
+	return d.finishMap(ctx, t)
+}
+
+// emitMapItems triggers the next elements of a map step, up to its max parallelism. It reports
+// whether any element was triggered.
+func (d *dag) emitMapItems(ctx context.Context, t *task) (bool, error) {
+	triggered := false
+
+	for t.mapNext < len(t.mapItems) && !t.mapStopped && d.mapItemsInFlight(t) < t.mapMaxParallelism {
+		i := t.mapNext
+		item := t.mapItems[i]
+		t.mapNext++
+
+		trigger := &mapItemTrigger{
+			childKey: fmt.Sprintf("%s[%d]", t.readableId, i),
+			input:    item.input,
+		}
+
+		result, err := d.triggerStep(ctx, t.actionId, t.workflowName, t.index, trigger, t.mapParentTaskRunIds, t.mapParentReadableIds, false, false, t.mapParentReExecuted)
+		if err != nil {
+			return triggered, fmt.Errorf("failed to trigger element %d of map step %q: %w", i, t.readableId, err)
+		}
+
+		item.isTriggered = true
+		item.nodeId = result.NodeId
+		item.branchId = result.BranchId
+		item.workflowRunExternalId = &result.WorkflowRunExternalId
+		triggered = true
+
+		if result.ReExecuted {
+			t.reExecuted = true
+		}
+
+		if result.IsSatisfied {
+			errorMessage := ""
+			if result.ErrorMessage != nil {
+				errorMessage = *result.ErrorMessage
+			}
+
+			if err := d.applyMapItemCompletion(ctx, t, item, result.IsFailure, errorMessage, result.ResultPayload); err != nil {
+				return triggered, err
+			}
+		}
+	}
+
+	return triggered, nil
+}
+
+func (d *dag) mapItemsInFlight(t *task) int {
+	inFlight := 0
+
+	for _, item := range t.mapItems {
+		if item.isTriggered && !item.isCompleted {
+			inFlight++
+		}
+	}
+
+	return inFlight
+}
+
+func (d *dag) applyMapItemCompletion(ctx context.Context, t *task, item *mapItem, isFailure bool, errorMessage string, payload []byte) error {
+	item.isCompleted = true
+
+	if isFailure {
+		if errorMessage == repository.TaskCancelledErrorMessage {
+			item.isCancelled = true
+		} else {
+			item.isFailed = true
+			item.errorMessage = errorMessage
+		}
+
+		t.mapStopped = true
+	} else {
+		item.output = make(map[string]interface{})
+
+		if len(payload) > 0 {
+			if err := json.Unmarshal(payload, &item.output); err != nil {
+				item.output = make(map[string]interface{})
+			}
+		}
+	}
+
+	return d.finishMap(ctx, t)
+}
+
+// finishMap completes a map step once every element has completed, or once the elements still in
+// flight after a failure have completed. The step fails with the error of the first failed element,
+// and otherwise its output gathers the element outputs in order under "results".
+func (d *dag) finishMap(ctx context.Context, t *task) error {
+	if t.isCompleted {
+		return nil
+	}
+
+	if d.mapItemsInFlight(t) > 0 || (!t.mapStopped && t.mapNext < len(t.mapItems)) {
+		return nil
+	}
+
+	for i, item := range t.mapItems {
+		if item.isFailed {
+			d.failMap(t, fmt.Sprintf("element %d failed: %s", i, item.errorMessage))
+			return nil
+		}
+	}
+
+	t.isCompleted = true
+
+	for _, item := range t.mapItems {
+		if item.isCancelled {
+			t.isCancelled = true
+			return nil
+		}
+	}
+
+	results := make([]interface{}, len(t.mapItems))
+	for i, item := range t.mapItems {
+		results[i] = item.output
+		item.output = nil
+	}
+
+	t.mapResults = results
+	t.output = map[string]interface{}{"results": results}
+
+	return d.evaluateConditionsForParent(ctx, t)
+}
+
+func (d *dag) failMap(t *task, errorMessage string) {
+	t.isCompleted = true
+	t.isFailed = true
+	t.errorMessage = errorMessage
+}
+
 func (d *dag) evaluateConditionsForParent(ctx context.Context, parent *task) error {
 	if parent.output == nil {
 		return nil
@@ -603,11 +903,49 @@ func (d *dag) evaluateConditionsForParent(ctx context.Context, parent *task) err
 	}
 
 	// getting rid of memory here so we don't hold onto the output for the lifetime of the DAG
-	parent.output = nil
+	if !parent.retainOutput {
+		parent.output = nil
+	}
 
 	return nil
 }
 
+// completedTaskRunIds returns the runs of the tasks which completed successfully, which are passed
+// to a triggered step as its parents. The runs of a map step are listed in element order, and the
+// map step is included in the returned readable ids so its runs are gathered into one array.
+func (d *dag) completedTaskRunIds() ([]uuid.UUID, []string) {
+	var runIds []uuid.UUID
+	var mapReadableIds []string
+
+	for _, p := range d.tasks {
+		if !p.isCompleted || p.isFailed {
+			continue
+		}
+
+		if p.mapItems != nil {
+			if p.isCancelled {
+				continue
+			}
+
+			mapReadableIds = append(mapReadableIds, p.readableId)
+
+			for _, item := range p.mapItems {
+				if item.workflowRunExternalId != nil {
+					runIds = append(runIds, *item.workflowRunExternalId)
+				}
+			}
+
+			continue
+		}
+
+		if p.workflowRunExternalId != nil {
+			runIds = append(runIds, *p.workflowRunExternalId)
+		}
+	}
+
+	return runIds, mapReadableIds
+}
+
 func allParentsSkipped(t *task) bool {
 	if len(t.parents) == 0 {
 		return false
@@ -672,14 +1010,9 @@ func (d *dag) evaluateOnFailure(ctx context.Context) (bool, error) {
 		attribute.Bool("dag.on_failure_skipped", skip),
 	)
 
-	var parentTaskRunIds []uuid.UUID
-	for _, p := range d.tasks {
-		if p.isCompleted && !p.isFailed && p.workflowRunExternalId != nil {
-			parentTaskRunIds = append(parentTaskRunIds, *p.workflowRunExternalId)
-		}
-	}
+	parentTaskRunIds, mapParentReadableIds := d.completedTaskRunIds()
 
-	result, err := d.triggerStep(ctx, d.onFailureTask.actionId, d.onFailureTask.workflowName, d.onFailureTask.index, parentTaskRunIds, skip, false, false)
+	result, err := d.triggerStep(ctx, d.onFailureTask.actionId, d.onFailureTask.workflowName, d.onFailureTask.index, nil, parentTaskRunIds, mapParentReadableIds, skip, false, false)
 	if err != nil {
 		d.err = fmt.Errorf("failed to trigger on-failure step %q: %w", d.onFailureTask.actionId, err)
 		return false, d.err
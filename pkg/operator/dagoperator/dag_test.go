@@ -3,6 +3,7 @@ package dagoperator
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"testing"
 	"time"
 
@@ -19,7 +20,7 @@ import (
 
 // triggerStepFn is the step-trigger callback the dag invokes for each task; matches the
 // signature dagDurableTask expects.
-type triggerStepFn = func(ctx context.Context, actionId, workflowName string, childIndex int32, parentTaskRunIds []uuid.UUID, isSkipped, isCancelled, parentReExecuted bool) (*operator.DAGStepTriggerResult, error)
+type triggerStepFn = func(ctx context.Context, actionId, workflowName string, childIndex int32, item *mapItemTrigger, parentTaskRunIds []uuid.UUID, mapParentReadableIds []string, isSkipped, isCancelled, parentReExecuted bool) (*operator.DAGStepTriggerResult, error)
 
 func newTestTask(readableId, actionId string, index int32, parents ...*task) *task {
 	return &task{
@@ -35,7 +36,7 @@ func newTestTask(readableId, actionId string, index int32, parents ...*task) *ta
 func stubTriggerStep(t *testing.T, async map[string]bool) triggerStepFn {
 	var nextId int64 = 1
 
-	return func(ctx context.Context, actionId, workflowName string, childIndex int32, parentTaskRunIds []uuid.UUID, isSkipped, isCancelled, parentReExecuted bool) (*operator.DAGStepTriggerResult, error) {
+	return func(ctx context.Context, actionId, workflowName string, childIndex int32, item *mapItemTrigger, parentTaskRunIds []uuid.UUID, mapParentReadableIds []string, isSkipped, isCancelled, parentReExecuted bool) (*operator.DAGStepTriggerResult, error) {
 		nodeId := nextId
 		nextId++
 
@@ -163,7 +164,7 @@ func startDAGFull(t *testing.T, tasks []*task, onFailureTask *task, triggerStep
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 
 	go func() {
-		errCh <- dagDurableTask(ctx, tasks, onFailureTask, uuid.New(), 1, "{}", requestCh, responseCh, evaluator.EvalBoolExpr, triggerStep)
+		errCh <- dagDurableTask(ctx, tasks, onFailureTask, uuid.New(), 1, "{}", requestCh, responseCh, evaluator.EvalBoolExpr, evalListExpr, triggerStep)
 	}()
 
 	return &dagHarness{
@@ -218,7 +219,7 @@ func asyncTrigger(async map[string]bool) (triggerStepFn, chan asyncTriggered) {
 	triggered := make(chan asyncTriggered, 16)
 	var nextId int64 = 1
 
-	fn := func(ctx context.Context, actionId, workflowName string, childIndex int32, parentTaskRunIds []uuid.UUID, isSkipped, isCancelled, parentReExecuted bool) (*operator.DAGStepTriggerResult, error) {
+	fn := func(ctx context.Context, actionId, workflowName string, childIndex int32, item *mapItemTrigger, parentTaskRunIds []uuid.UUID, mapParentReadableIds []string, isSkipped, isCancelled, parentReExecuted bool) (*operator.DAGStepTriggerResult, error) {
 		nodeId := nextId
 		nextId++
 
@@ -267,6 +268,10 @@ func mapToJson(v interface{}) []byte {
 	return b
 }
 
+func evalListExpr(ctx context.Context, expr string, vars map[string]interface{}) ([]interface{}, error) {
+	return internalcel.NewCELParser().EvaluateStepRunList(expr, vars)
+}
+
 func sendEntryFailed(t *testing.T, responseCh chan *v1contracts.DurableTaskResponse, ref *v1contracts.DurableEventLogEntryRef, errMsg string) {
 	t.Helper()
 
@@ -303,7 +308,7 @@ func TestDag_ParentFailurePropagatesToChild(t *testing.T) {
 	a := newTestTask("a", "action-a", 0)
 	b := newTestTask("b", "action-b", 1, a)
 
-	triggerStep := func(ctx context.Context, actionId, workflowName string, childIndex int32, parentTaskRunIds []uuid.UUID, isSkipped, isCancelled, parentReExecuted bool) (*operator.DAGStepTriggerResult, error) {
+	triggerStep := func(ctx context.Context, actionId, workflowName string, childIndex int32, item *mapItemTrigger, parentTaskRunIds []uuid.UUID, mapParentReadableIds []string, isSkipped, isCancelled, parentReExecuted bool) (*operator.DAGStepTriggerResult, error) {
 		if actionId == "action-a" {
 			return &operator.DAGStepTriggerResult{
 				NodeId:                1,
@@ -345,7 +350,7 @@ func TestDag_ParentReExecutedPropagation(t *testing.T) {
 	received := make(map[string]bool)
 	var nextId int64 = 1
 
-	triggerStep := func(ctx context.Context, actionId, workflowName string, childIndex int32, parentTaskRunIds []uuid.UUID, isSkipped, isCancelled, parentReExecuted bool) (*operator.DAGStepTriggerResult, error) {
+	triggerStep := func(ctx context.Context, actionId, workflowName string, childIndex int32, item *mapItemTrigger, parentTaskRunIds []uuid.UUID, mapParentReadableIds []string, isSkipped, isCancelled, parentReExecuted bool) (*operator.DAGStepTriggerResult, error) {
 		received[actionId] = parentReExecuted
 
 		nodeId := nextId
@@ -391,7 +396,7 @@ func TestDag_SkipConditionOnParentOutput(t *testing.T) {
 			},
 		}
 
-		triggerStep := func(ctx context.Context, actionId, workflowName string, childIndex int32, parentTaskRunIds []uuid.UUID, isSkipped, isCancelled, parentReExecuted bool) (*operator.DAGStepTriggerResult, error) {
+		triggerStep := func(ctx context.Context, actionId, workflowName string, childIndex int32, item *mapItemTrigger, parentTaskRunIds []uuid.UUID, mapParentReadableIds []string, isSkipped, isCancelled, parentReExecuted bool) (*operator.DAGStepTriggerResult, error) {
 			if actionId == "action-a" {
 				payload, _ := json.Marshal(map[string]interface{}{"shouldSkip": shouldSkip})
 				return &operator.DAGStepTriggerResult{
@@ -442,7 +447,7 @@ func TestDag_CancelConditionOnParentOutput(t *testing.T) {
 		},
 	}
 
-	triggerStep := func(ctx context.Context, actionId, workflowName string, childIndex int32, parentTaskRunIds []uuid.UUID, isSkipped, isCancelled, parentReExecuted bool) (*operator.DAGStepTriggerResult, error) {
+	triggerStep := func(ctx context.Context, actionId, workflowName string, childIndex int32, item *mapItemTrigger, parentTaskRunIds []uuid.UUID, mapParentReadableIds []string, isSkipped, isCancelled, parentReExecuted bool) (*operator.DAGStepTriggerResult, error) {
 		if actionId == "action-a" {
 			payload, _ := json.Marshal(map[string]interface{}{"shouldCancel": true})
 			return &operator.DAGStepTriggerResult{
@@ -496,7 +501,7 @@ func TestDag_SleepWaitCondition(t *testing.T) {
 	defer cancel()
 
 	go func() {
-		errCh <- dagDurableTask(ctx, []*task{a, b}, nil, uuid.New(), 1, "{}", requestCh, responseCh, evaluator.EvalBoolExpr, stubTriggerStep(t, nil))
+		errCh <- dagDurableTask(ctx, []*task{a, b}, nil, uuid.New(), 1, "{}", requestCh, responseCh, evaluator.EvalBoolExpr, evalListExpr, stubTriggerStep(t, nil))
 	}()
 
 	var ref *v1contracts.DurableEventLogEntryRef
@@ -549,7 +554,7 @@ func TestDag_SkipOrGroupAcrossParents(t *testing.T) {
 		},
 	}
 
-	triggerStep := func(ctx context.Context, actionId, workflowName string, childIndex int32, parentTaskRunIds []uuid.UUID, isSkipped, isCancelled, parentReExecuted bool) (*operator.DAGStepTriggerResult, error) {
+	triggerStep := func(ctx context.Context, actionId, workflowName string, childIndex int32, item *mapItemTrigger, parentTaskRunIds []uuid.UUID, mapParentReadableIds []string, isSkipped, isCancelled, parentReExecuted bool) (*operator.DAGStepTriggerResult, error) {
 		switch actionId {
 		case "action-a":
 			payload, _ := json.Marshal(map[string]interface{}{"shouldSkip": false})
@@ -807,7 +812,7 @@ func TestDag_CancelBeatsSkipParentOverride(t *testing.T) {
 		},
 	}
 
-	triggerStep := func(ctx context.Context, actionId, workflowName string, childIndex int32, parentTaskRunIds []uuid.UUID, isSkipped, isCancelled, parentReExecuted bool) (*operator.DAGStepTriggerResult, error) {
+	triggerStep := func(ctx context.Context, actionId, workflowName string, childIndex int32, item *mapItemTrigger, parentTaskRunIds []uuid.UUID, mapParentReadableIds []string, isSkipped, isCancelled, parentReExecuted bool) (*operator.DAGStepTriggerResult, error) {
 		if actionId == "action-a" {
 			return &operator.DAGStepTriggerResult{
 				NodeId: 1, BranchId: 1, WorkflowRunExternalId: uuid.New(),
@@ -855,7 +860,7 @@ func TestDag_MultipleSkipGroupsAllRequired(t *testing.T) {
 			},
 		}
 
-		triggerStep := func(ctx context.Context, actionId, workflowName string, childIndex int32, parentTaskRunIds []uuid.UUID, isSkipped, isCancelled, parentReExecuted bool) (*operator.DAGStepTriggerResult, error) {
+		triggerStep := func(ctx context.Context, actionId, workflowName string, childIndex int32, item *mapItemTrigger, parentTaskRunIds []uuid.UUID, mapParentReadableIds []string, isSkipped, isCancelled, parentReExecuted bool) (*operator.DAGStepTriggerResult, error) {
 			switch actionId {
 			case "action-a":
 				return &operator.DAGStepTriggerResult{
@@ -977,7 +982,7 @@ func TestDag_SkippedAndFailedParentChildCancelled(t *testing.T) {
 	b := newTestTask("b", "action-b", 1)
 	c := newTestTask("c", "action-c", 2, a, b)
 
-	triggerStep := func(ctx context.Context, actionId, workflowName string, childIndex int32, parentTaskRunIds []uuid.UUID, isSkipped, isCancelled, parentReExecuted bool) (*operator.DAGStepTriggerResult, error) {
+	triggerStep := func(ctx context.Context, actionId, workflowName string, childIndex int32, item *mapItemTrigger, parentTaskRunIds []uuid.UUID, mapParentReadableIds []string, isSkipped, isCancelled, parentReExecuted bool) (*operator.DAGStepTriggerResult, error) {
 		switch actionId {
 		case "action-a":
 			return &operator.DAGStepTriggerResult{
@@ -1061,7 +1066,7 @@ func TestDag_OnFailureNotTriggeredByCancellation(t *testing.T) {
 	a := newTestTask("a", "action-a", 0)
 	onFailure := newTestTask("on-failure", "action-on-failure", 1)
 
-	triggerStep := func(ctx context.Context, actionId, workflowName string, childIndex int32, parentTaskRunIds []uuid.UUID, isSkipped, isCancelled, parentReExecuted bool) (*operator.DAGStepTriggerResult, error) {
+	triggerStep := func(ctx context.Context, actionId, workflowName string, childIndex int32, item *mapItemTrigger, parentTaskRunIds []uuid.UUID, mapParentReadableIds []string, isSkipped, isCancelled, parentReExecuted bool) (*operator.DAGStepTriggerResult, error) {
 		switch actionId {
 		case "action-a":
 			return &operator.DAGStepTriggerResult{
@@ -1121,7 +1126,7 @@ func TestDag_EntryCompletedRacesAheadOfWaitForAck(t *testing.T) {
 	defer cancel()
 
 	go func() {
-		errCh <- dagDurableTask(ctx, []*task{a, b}, nil, uuid.New(), 1, "{}", requestCh, responseCh, evaluator.EvalBoolExpr, stubTriggerStep(t, nil))
+		errCh <- dagDurableTask(ctx, []*task{a, b}, nil, uuid.New(), 1, "{}", requestCh, responseCh, evaluator.EvalBoolExpr, evalListExpr, stubTriggerStep(t, nil))
 	}()
 
 	// Drive the dispatcher side ourselves (instead of using newFakeDispatcher) so we can choose
@@ -1173,3 +1178,214 @@ func TestDag_EntryCompletedRacesAheadOfWaitForAck(t *testing.T) {
 	require.True(t, b.isCompleted)
 	require.False(t, b.isCancelled)
 }
+
+// mapTrigger triggers a, whose output holds the items to map over, and satisfies every other step
+// immediately. Elements of a map step output their input doubled, unless async holds their key.
+func mapTrigger(t *testing.T, items []interface{}, async map[string]bool, triggered chan asyncTriggered) (triggerStepFn, *[]string) {
+	var nextId int64 = 1
+	var childKeys []string
+
+	return func(ctx context.Context, actionId, workflowName string, childIndex int32, item *mapItemTrigger, parentTaskRunIds []uuid.UUID, mapParentReadableIds []string, isSkipped, isCancelled, parentReExecuted bool) (*operator.DAGStepTriggerResult, error) {
+		nodeId := nextId
+		nextId++
+
+		result := &operator.DAGStepTriggerResult{
+			NodeId:                nodeId,
+			BranchId:              nodeId,
+			WorkflowRunExternalId: uuid.New(),
+			IsSatisfied:           true,
+		}
+
+		switch {
+		case actionId == "action-a":
+			result.ResultPayload = mapToJson(map[string]interface{}{"items": items})
+		case item != nil:
+			childKeys = append(childKeys, item.childKey)
+
+			if async[item.childKey] {
+				result.IsSatisfied = false
+				triggered <- asyncTriggered{
+					actionId: item.childKey,
+					ref:      &v1contracts.DurableEventLogEntryRef{NodeId: nodeId, BranchId: nodeId},
+				}
+				break
+			}
+
+			var in map[string]interface{}
+			require.NoError(t, json.Unmarshal(item.input, &in))
+			result.ResultPayload = mapToJson(map[string]interface{}{"doubled": in["n"].(float64) * 2})
+		default:
+			result.ResultPayload = mapToJson(map[string]interface{}{"ok": true})
+		}
+
+		return result, nil
+	}, &childKeys
+}
+
+func newTestMapTask(readableId, actionId string, index int32, expr string, maxParallelism int, parents ...*task) *task {
+	t := newTestTask(readableId, actionId, index, parents...)
+	t.mapExpr = expr
+	t.mapMaxParallelism = maxParallelism
+
+	return t
+}
+
+func TestDag_MapGathersResultsInOrder(t *testing.T) {
+	a := newTestTask("a", "action-a", 0)
+	m := newTestMapTask("m", "action-m", 1, "parents.a.items", 10, a)
+	c := newTestTask("c", "action-c", 2, m)
+
+	items := []interface{}{
+		map[string]interface{}{"n": 1},
+		map[string]interface{}{"n": 2},
+		map[string]interface{}{"n": 3},
+	}
+
+	triggerStep, childKeys := mapTrigger(t, items, nil, nil)
+
+	var cParentRunIds []uuid.UUID
+	var cMapParents []string
+
+	wrapped := func(ctx context.Context, actionId, workflowName string, childIndex int32, item *mapItemTrigger, parentTaskRunIds []uuid.UUID, mapParentReadableIds []string, isSkipped, isCancelled, parentReExecuted bool) (*operator.DAGStepTriggerResult, error) {
+		if actionId == "action-c" {
+			cParentRunIds = parentTaskRunIds
+			cMapParents = mapParentReadableIds
+		}
+
+		return triggerStep(ctx, actionId, workflowName, childIndex, item, parentTaskRunIds, mapParentReadableIds, isSkipped, isCancelled, parentReExecuted)
+	}
+
+	err, _, cleanup := runDAG(t, []*task{a, m, c}, wrapped)
+	defer cleanup()
+
+	require.NoError(t, err)
+	require.True(t, m.isCompleted)
+	require.True(t, c.isCompleted)
+	require.Equal(t, []string{"m[0]", "m[1]", "m[2]"}, *childKeys)
+	require.Equal(t, []interface{}{
+		map[string]interface{}{"doubled": float64(2)},
+		map[string]interface{}{"doubled": float64(4)},
+		map[string]interface{}{"doubled": float64(6)},
+	}, m.mapResults)
+
+	// a's run and each element's run, in element order
+	require.Len(t, cParentRunIds, 4)
+	require.Equal(t, *a.workflowRunExternalId, cParentRunIds[0])
+	for i, item := range m.mapItems {
+		require.Equal(t, *item.workflowRunExternalId, cParentRunIds[i+1])
+	}
+	require.Equal(t, []string{"m"}, cMapParents)
+}
+
+func TestDag_MapEmptyListCompletesImmediately(t *testing.T) {
+	a := newTestTask("a", "action-a", 0)
+	m := newTestMapTask("m", "action-m", 1, "parents.a.items", 10, a)
+
+	triggerStep, childKeys := mapTrigger(t, []interface{}{}, nil, nil)
+
+	err, _, cleanup := runDAG(t, []*task{a, m}, triggerStep)
+	defer cleanup()
+
+	require.NoError(t, err)
+	require.True(t, m.isCompleted)
+	require.Empty(t, *childKeys)
+	require.Equal(t, []interface{}{}, m.mapResults)
+}
+
+func TestDag_MapBoundedParallelism(t *testing.T) {
+	a := newTestTask("a", "action-a", 0)
+	m := newTestMapTask("m", "action-m", 1, "parents.a.items", 2, a)
+
+	items := make([]interface{}, 5)
+	async := make(map[string]bool, 5)
+	for i := range items {
+		items[i] = map[string]interface{}{"n": i}
+		async[fmt.Sprintf("m[%d]", i)] = true
+	}
+
+	triggered := make(chan asyncTriggered, 16)
+	triggerStep, _ := mapTrigger(t, items, async, triggered)
+
+	h := startDAG(t, []*task{a, m}, triggerStep)
+	defer h.cleanup()
+
+	first := recvTriggered(t, triggered)
+	second := recvTriggered(t, triggered)
+	require.Equal(t, "m[0]", first.actionId)
+	require.Equal(t, "m[1]", second.actionId)
+
+	select {
+	case at := <-triggered:
+		t.Fatalf("%s triggered beyond max parallelism", at.actionId)
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	// completing an element frees a slot for the next one
+	sendEntryCompleted(t, h.responseCh, second.ref, mapToJson(map[string]interface{}{"i": 1}))
+	third := recvTriggered(t, triggered)
+	require.Equal(t, "m[2]", third.actionId)
+
+	sendEntryCompleted(t, h.responseCh, first.ref, mapToJson(map[string]interface{}{"i": 0}))
+	sendEntryCompleted(t, h.responseCh, third.ref, mapToJson(map[string]interface{}{"i": 2}))
+
+	fourth := recvTriggered(t, triggered)
+	fifth := recvTriggered(t, triggered)
+	sendEntryCompleted(t, h.responseCh, fifth.ref, mapToJson(map[string]interface{}{"i": 4}))
+	sendEntryCompleted(t, h.responseCh, fourth.ref, mapToJson(map[string]interface{}{"i": 3}))
+
+	require.NoError(t, h.waitErr(t))
+	require.True(t, m.isCompleted)
+	require.Len(t, m.mapResults, 5)
+	for i, r := range m.mapResults {
+		require.Equal(t, map[string]interface{}{"i": float64(i)}, r)
+	}
+}
+
+func TestDag_MapElementFailureFailsStep(t *testing.T) {
+	a := newTestTask("a", "action-a", 0)
+	m := newTestMapTask("m", "action-m", 1, "parents.a.items", 2, a)
+	c := newTestTask("c", "action-c", 2, m)
+
+	items := []interface{}{
+		map[string]interface{}{"n": 0},
+		map[string]interface{}{"n": 1},
+		map[string]interface{}{"n": 2},
+	}
+
+	triggered := make(chan asyncTriggered, 16)
+	triggerStep, childKeys := mapTrigger(t, items, map[string]bool{"m[0]": true, "m[1]": true, "m[2]": true}, triggered)
+
+	h := startDAG(t, []*task{a, m, c}, triggerStep)
+	defer h.cleanup()
+
+	first := recvTriggered(t, triggered)
+	second := recvTriggered(t, triggered)
+
+	// no further elements are triggered after a failure, but in-flight ones are awaited
+	sendEntryFailed(t, h.responseCh, first.ref, "boom")
+	sendEntryCompleted(t, h.responseCh, second.ref, mapToJson(map[string]interface{}{"ok": true}))
+
+	err := h.waitErr(t)
+	require.Error(t, err)
+	require.True(t, isDagChildFailedErr(err))
+	require.True(t, m.isFailed)
+	require.Contains(t, m.errorMessage, "element 0 failed: boom")
+	require.Equal(t, []string{"m[0]", "m[1]"}, *childKeys)
+	require.True(t, c.isCancelled, "child of a failed map step should be triggered as cancelled")
+}
+
+func TestDag_MapRejectsNonObjectElements(t *testing.T) {
+	a := newTestTask("a", "action-a", 0)
+	m := newTestMapTask("m", "action-m", 1, "parents.a.items", 10, a)
+
+	triggerStep, childKeys := mapTrigger(t, []interface{}{1, 2}, nil, nil)
+
+	err, _, cleanup := runDAG(t, []*task{a, m}, triggerStep)
+	defer cleanup()
+
+	require.Error(t, err)
+	require.True(t, isDagChildFailedErr(err))
+	require.True(t, m.isFailed)
+	require.Contains(t, m.errorMessage, "must return a list of objects")
+	require.Empty(t, *childKeys)
+}
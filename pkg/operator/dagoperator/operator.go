@@ -379,22 +379,31 @@ func (d *DAGOperator) run(action *contracts.AssignedAction) error {
 		additionalMetadata = []byte(meta)
 	}
 
-	triggerStep := func(ctx context.Context, actionId, workflowName string, childIndex int32, parentTaskRunIds []uuid.UUID, isSkipped, isCancelled, parentReExecuted bool) (*operator.DAGStepTriggerResult, error) {
-		return d.TriggerDAGStep(ctx, &operator.DAGStepTriggerRequest{
-			ParentTaskExternalId: externalId,
-			InvocationCount:      action.GetDurableTaskInvocationCount(),
-			WorkflowName:         workflowName,
-			WorkflowVersionId:    workflowVersionId,
-			ActionId:             actionId,
-			ChildIndex:           childIndex,
-			Input:                workflowInput,
-			AdditionalMetadata:   additionalMetadata,
-			DagParentTaskRunIds:  parentTaskRunIds,
-			IsSkipped:            isSkipped,
-			IsCancelled:          isCancelled,
-			DesiredWorkerLabels:  payloadWrapper.DesiredWorkerLabels,
-			ParentReExecuted:     parentReExecuted,
-		})
+	triggerStep := func(ctx context.Context, actionId, workflowName string, childIndex int32, item *mapItemTrigger, parentTaskRunIds []uuid.UUID, mapParentReadableIds []string, isSkipped, isCancelled, parentReExecuted bool) (*operator.DAGStepTriggerResult, error) {
+		req := &operator.DAGStepTriggerRequest{
+			ParentTaskExternalId:    externalId,
+			InvocationCount:         action.GetDurableTaskInvocationCount(),
+			WorkflowName:            workflowName,
+			WorkflowVersionId:       workflowVersionId,
+			ActionId:                actionId,
+			ChildIndex:              childIndex,
+			Input:                   workflowInput,
+			AdditionalMetadata:      additionalMetadata,
+			DagParentTaskRunIds:     parentTaskRunIds,
+			DagMapParentReadableIds: mapParentReadableIds,
+			IsSkipped:               isSkipped,
+			IsCancelled:             isCancelled,
+			DesiredWorkerLabels:     payloadWrapper.DesiredWorkerLabels,
+			ParentReExecuted:        parentReExecuted,
+		}
+
+		// each element of a map step runs with the element as its input, keyed by its position
+		if item != nil {
+			req.ChildKey = &item.childKey
+			req.Input = string(item.input)
+		}
+
+		return d.TriggerDAGStep(ctx, req)
 	}
 
 	dagErr := dagDurableTask(
@@ -407,6 +416,7 @@ func (d *DAGOperator) run(action *contracts.AssignedAction) error {
 		requestCh,
 		responseCh,
 		d.repo.Matches().EvalBoolExpr,
+		d.repo.Matches().EvalListExpr,
 		triggerStep,
 	)
 
@@ -442,6 +452,11 @@ func (d *DAGOperator) run(action *contracts.AssignedAction) error {
 			if b, err := json.Marshal(map[string]interface{}{"cancelled": true}); err == nil {
 				output[t.readableId] = json.RawMessage(b)
 			}
+		case t.mapItems != nil:
+			// a map step has no single run, so its output is the gathered element outputs
+			if b, err := json.Marshal(map[string]interface{}{"results": t.mapResults}); err == nil {
+				output[t.readableId] = json.RawMessage(b)
+			}
 		default:
 			ref := repository.TaskExternalIdNodeIdBranchId{
 				TaskExternalId: externalId,
@@ -512,6 +527,12 @@ func (d *DAGOperator) handleRunCancellation(span trace.Span, action *contracts.A
 		if t.isTriggered && !t.isCompleted && t.workflowRunExternalId != nil {
 			childExternalIds = append(childExternalIds, *t.workflowRunExternalId)
 		}
+
+		for _, item := range t.mapItems {
+			if item.isTriggered && !item.isCompleted && item.workflowRunExternalId != nil {
+				childExternalIds = append(childExternalIds, *item.workflowRunExternalId)
+			}
+		}
 	}
 
 	span.SetAttributes(attribute.Int("dagoperator.cancelled_children_count", len(childExternalIds)))
@@ -650,6 +671,24 @@ func (d *DAGOperator) buildDAG(ctx context.Context, action *contracts.AssignedAc
 		}
 	}
 
+	if len(stepIds) > 0 {
+		stepMaps, err := d.repo.Workflows().ListStepMaps(ctx, d.TenantId(), stepIds)
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(telemetry_codes.Error, "could not list step maps")
+			return nil, nil, fmt.Errorf("could not list step maps for workflow version %s: %w", versionId, err)
+		}
+
+		span.SetAttributes(attribute.Int("dagoperator.step_map_count", len(stepMaps)))
+
+		for _, m := range stepMaps {
+			if t, ok := tasksByStepId[m.StepID]; ok {
+				t.mapExpr = m.Expression
+				t.mapMaxParallelism = int(m.MaxParallelism)
+			}
+		}
+	}
+
 	span.SetAttributes(
 		attribute.Int("dagoperator.task_count", len(tasks)),
 		attribute.Bool("dagoperator.has_on_failure", onFailureTask != nil),
@@ -13,6 +13,7 @@ import (
 	"github.com/hatchet-dev/hatchet/internal/syncx"
 	"github.com/hatchet-dev/hatchet/pkg/encryption"
 	"github.com/hatchet-dev/hatchet/pkg/operator"
+	"github.com/hatchet-dev/hatchet/pkg/operator/approvaloperator"
 	"github.com/hatchet-dev/hatchet/pkg/operator/dagoperator"
 	"github.com/hatchet-dev/hatchet/pkg/operator/httpoperator"
 	"github.com/hatchet-dev/hatchet/pkg/repository"
@@ -50,11 +51,14 @@ type OperatorManager struct {
 
 	dagOperatorDefaultSlots int
 
+	// approvalNotifier tells approvers about approvals requested by approval operators
+	approvalNotifier approvaloperator.Notifier
+
 	// drains tracks in-flight per-operator drain goroutines so Cleanup can await them.
 	drains sync.WaitGroup
 }
 
-func NewOperatorManager(dispatcherId uuid.UUID, l *zerolog.Logger, repo repository.Repository, enc encryption.EncryptionService, infraBlockedCIDRs []string, dagOperatorDefaultSlots int) *OperatorManager {
+func NewOperatorManager(dispatcherId uuid.UUID, l *zerolog.Logger, repo repository.Repository, enc encryption.EncryptionService, infraBlockedCIDRs []string, dagOperatorDefaultSlots int, approvalNotifier approvaloperator.Notifier) *OperatorManager {
 	om := &OperatorManager{
 		dispatcherId:            dispatcherId,
 		repo:                    repo,
@@ -62,6 +66,7 @@ func NewOperatorManager(dispatcherId uuid.UUID, l *zerolog.Logger, repo reposito
 		enc:                     enc,
 		infraBlockedCIDRs:       infraBlockedCIDRs,
 		dagOperatorDefaultSlots: dagOperatorDefaultSlots,
+		approvalNotifier:        approvalNotifier,
 		operatorsCh:             make(chan []operator.Operator),
 		donePollingCh:           make(chan struct{}, 1),
 		doneHeartbeatCh:         make(chan struct{}),
@@ -265,6 +270,15 @@ func (om *OperatorManager) instantiateOperator(ctx context.Context, op *sqlcv1.V
 			return nil
 		}
 
+		return newOperator
+	case sqlcv1.V1OperatorKindAPPROVAL:
+		newOperator, err := approvaloperator.NewApprovalOperator(op, om.l, om.repo, om.taskEventWriter, worker.ID, approvaloperator.WithNotifier(om.approvalNotifier))
+
+		if err != nil {
+			om.l.Error().Err(err).Msgf("could not construct approval operator: %s", err.Error())
+			return nil
+		}
+
 		return newOperator
 	default:
 		return nil
@@ -279,6 +293,8 @@ func (om *OperatorManager) slotConfigForKind(op *sqlcv1.V1Operator) (map[string]
 		return httpoperator.SlotConfig(op)
 	case sqlcv1.V1OperatorKindDAG:
 		return dagoperator.SlotConfig(op, om.dagOperatorDefaultSlots)
+	case sqlcv1.V1OperatorKindAPPROVAL:
+		return approvaloperator.SlotConfig(op)
 	default:
 		return nil, nil
 	}
@@ -54,6 +54,15 @@ type DAGStepTriggerRequest struct {
 	Input               string
 	AdditionalMetadata  []byte
 	DagParentTaskRunIds []uuid.UUID
+
+	// ChildKey identifies one run of a map step in place of ChildIndex, so that replays resolve
+	// each element to the same run.
+	ChildKey *string
+
+	// DagMapParentReadableIds lists the map steps among DagParentTaskRunIds, whose runs are
+	// gathered into a results array.
+	DagMapParentReadableIds []string
+
 	IsSkipped           bool
 	IsCancelled         bool
 	DesiredWorkerLabels []*sqlcv1.GetDesiredLabelsRow
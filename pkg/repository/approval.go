@@ -0,0 +1,433 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"github.com/hatchet-dev/hatchet/pkg/repository/sqlchelpers"
+	"github.com/hatchet-dev/hatchet/pkg/repository/sqlcv1"
+	"github.com/hatchet-dev/hatchet/pkg/telemetry"
+)
+
+var (
+	// ErrApprovalNotFound is returned when there is no approval request for a task.
+	ErrApprovalNotFound = errors.New("approval not found")
+
+	// ErrApprovalNotPending is returned when resolving an approval which has already been
+	// approved, rejected, expired or cancelled.
+	ErrApprovalNotPending = errors.New("approval is no longer pending")
+
+	// ErrApprovalNotApprover is returned when the approver isn't in the approval's approver group.
+	ErrApprovalNotApprover = errors.New("approver is not in the approver group for this approval")
+)
+
+// DefaultApprovalExpiresIn is how long an approval stays pending when the step doesn't set an
+// expiry.
+const DefaultApprovalExpiresIn = 24 * time.Hour
+
+// ApprovalTimeoutGrace is added to the approval expiry to get the timeout of an approval task, so
+// the expiry (and its configured action) always runs before the task times out.
+const ApprovalTimeoutGrace = 5 * time.Minute
+
+type ApprovalRepository interface {
+	GetStepApproval(ctx context.Context, tenantId, stepId uuid.UUID) (*sqlcv1.V1StepApproval, error)
+	ListStepApprovals(ctx context.Context, tenantId uuid.UUID, stepIds []uuid.UUID) ([]*sqlcv1.V1StepApproval, error)
+
+	// RequestApproval records a pending approval for a task. If the task already has a pending
+	// approval, or an approval which was resolved for the same attempt (for example because the
+	// task was reassigned), the existing approval is kept and isCreated is false.
+	RequestApproval(ctx context.Context, tenantId uuid.UUID, opts RequestApprovalOpts) (approval *sqlcv1.V1Approval, isCreated bool, err error)
+
+	GetApproval(ctx context.Context, tenantId, taskExternalId uuid.UUID) (*sqlcv1.V1Approval, error)
+	ListApprovals(ctx context.Context, tenantId uuid.UUID, opts ListApprovalsOpts) ([]*sqlcv1.V1Approval, int64, error)
+
+	// ResolveApproval approves or rejects a pending approval.
+	ResolveApproval(ctx context.Context, tenantId, taskExternalId uuid.UUID, opts ResolveApprovalOpts) (*sqlcv1.V1Approval, error)
+
+	// CancelApproval marks a pending approval as cancelled, and is a no-op if the approval doesn't
+	// exist or has already been resolved.
+	CancelApproval(ctx context.Context, tenantId, taskExternalId uuid.UUID) error
+
+	// ExpireApprovals marks up to limit pending approvals which are past their expiry as expired
+	// and returns them.
+	ExpireApprovals(ctx context.Context, tenantId uuid.UUID, limit int32) ([]*sqlcv1.V1Approval, error)
+}
+
+type approvalRepository struct {
+	*sharedRepository
+}
+
+func newApprovalRepository(shared *sharedRepository) ApprovalRepository {
+	return &approvalRepository{
+		sharedRepository: shared,
+	}
+}
+
+type RequestApprovalOpts struct {
+	TaskExternalId uuid.UUID `validate:"required"`
+	TaskId         int64
+	TaskInsertedAt pgtype.Timestamptz
+	TaskRetryCount int32
+	WorkflowRunId  uuid.UUID `validate:"required"`
+	StepId         uuid.UUID `validate:"required"`
+	TaskName       string
+
+	Approvers []string
+	OnExpiry  sqlcv1.V1ApprovalExpiryAction `validate:"required,oneof=FAIL REJECT APPROVE"`
+	ExpiresAt time.Time                     `validate:"required"`
+
+	// (optional) the context fields shown to approvers, as a JSON object
+	Context []byte
+}
+
+type ListApprovalsOpts struct {
+	Statuses []sqlcv1.V1ApprovalStatus
+
+	Limit  int32 `validate:"omitempty,min=1,max=1000"`
+	Offset int32 `validate:"min=0"`
+}
+
+type ResolveApprovalOpts struct {
+	Approved bool
+
+	// the identity of the approver, which is recorded on the approval
+	Approver string `validate:"required"`
+
+	// (optional) the api token used to resolve the approval
+	ApiTokenId *uuid.UUID
+
+	// (optional) a comment left by the approver
+	Comment *string
+}
+
+func (r *approvalRepository) GetStepApproval(ctx context.Context, tenantId, stepId uuid.UUID) (*sqlcv1.V1StepApproval, error) {
+	stepApproval, err := r.queries.GetStepApproval(ctx, r.pool, sqlcv1.GetStepApprovalParams{
+		Stepid:   stepId,
+		Tenantid: tenantId,
+	})
+
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+
+	return stepApproval, err
+}
+
+func (r *approvalRepository) ListStepApprovals(ctx context.Context, tenantId uuid.UUID, stepIds []uuid.UUID) ([]*sqlcv1.V1StepApproval, error) {
+	return r.queries.ListStepApprovals(ctx, r.pool, sqlcv1.ListStepApprovalsParams{
+		Stepids:  stepIds,
+		Tenantid: tenantId,
+	})
+}
+
+func (r *approvalRepository) RequestApproval(ctx context.Context, tenantId uuid.UUID, opts RequestApprovalOpts) (*sqlcv1.V1Approval, bool, error) {
+	ctx, span := telemetry.NewSpan(ctx, "request-approval")
+	defer span.End()
+
+	if err := r.v.Validate(opts); err != nil {
+		return nil, false, err
+	}
+
+	tx, commit, rollback, err := sqlchelpers.PrepareTx(ctx, r.pool, r.l)
+
+	if err != nil {
+		return nil, false, err
+	}
+
+	defer rollback()
+
+	existing, err := r.queries.GetApprovalForUpdate(ctx, tx, sqlcv1.GetApprovalForUpdateParams{
+		Tenantid:       tenantId,
+		Taskexternalid: opts.TaskExternalId,
+	})
+
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		return nil, false, fmt.Errorf("failed to get approval: %w", err)
+	}
+
+	var approval *sqlcv1.V1Approval
+	isCreated := false
+
+	switch {
+	case existing != nil && existing.Status != sqlcv1.V1ApprovalStatusPENDING &&
+		existing.Status != sqlcv1.V1ApprovalStatusCANCELLED && existing.TaskRetryCount >= opts.TaskRetryCount:
+		// the approval was already resolved for this attempt, so it's returned as-is and the
+		// caller finishes the task from the resolution
+		approval = existing
+	case existing != nil && existing.Status == sqlcv1.V1ApprovalStatusPENDING:
+		approval, err = r.queries.UpdateApprovalRetryCount(ctx, tx, sqlcv1.UpdateApprovalRetryCountParams{
+			Taskretrycount: opts.TaskRetryCount,
+			Tenantid:       tenantId,
+			Taskexternalid: opts.TaskExternalId,
+		})
+
+		if err != nil {
+			return nil, false, fmt.Errorf("failed to update approval: %w", err)
+		}
+	default:
+		approvalCtx := opts.Context
+
+		if len(approvalCtx) == 0 {
+			approvalCtx = []byte("{}")
+		}
+
+		approvers := opts.Approvers
+
+		if approvers == nil {
+			approvers = []string{}
+		}
+
+		approval, err = r.queries.UpsertApproval(ctx, tx, sqlcv1.UpsertApprovalParams{
+			Tenantid:       tenantId,
+			Taskexternalid: opts.TaskExternalId,
+			Taskid:         opts.TaskId,
+			Taskinsertedat: opts.TaskInsertedAt,
+			Taskretrycount: opts.TaskRetryCount,
+			Workflowrunid:  opts.WorkflowRunId,
+			Stepid:         opts.StepId,
+			Taskname:       opts.TaskName,
+			Approvers:      approvers,
+			Context:        approvalCtx,
+			Onexpiry:       opts.OnExpiry,
+			Expiresat:      sqlchelpers.TimestamptzFromTime(opts.ExpiresAt),
+		})
+
+		if err != nil {
+			return nil, false, fmt.Errorf("failed to create approval: %w", err)
+		}
+
+		isCreated = true
+	}
+
+	if err := commit(ctx); err != nil {
+		return nil, false, err
+	}
+
+	return approval, isCreated, nil
+}
+
+func (r *approvalRepository) GetApproval(ctx context.Context, tenantId, taskExternalId uuid.UUID) (*sqlcv1.V1Approval, error) {
+	approval, err := r.queries.GetApproval(ctx, r.pool, sqlcv1.GetApprovalParams{
+		Tenantid:       tenantId,
+		Taskexternalid: taskExternalId,
+	})
+
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrApprovalNotFound
+	}
+
+	return approval, err
+}
+
+func (r *approvalRepository) ListApprovals(ctx context.Context, tenantId uuid.UUID, opts ListApprovalsOpts) ([]*sqlcv1.V1Approval, int64, error) {
+	if err := r.v.Validate(opts); err != nil {
+		return nil, 0, err
+	}
+
+	var statuses []string
+
+	for _, status := range opts.Statuses {
+		statuses = append(statuses, string(status))
+	}
+
+	limit := opts.Limit
+
+	if limit == 0 {
+		limit = 50
+	}
+
+	approvals, err := r.queries.ListApprovals(ctx, r.pool, sqlcv1.ListApprovalsParams{
+		Tenantid:       tenantId,
+		Statuses:       statuses,
+		Approvallimit:  limit,
+		Approvaloffset: opts.Offset,
+	})
+
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list approvals: %w", err)
+	}
+
+	total, err := r.queries.CountApprovals(ctx, r.pool, sqlcv1.CountApprovalsParams{
+		Tenantid: tenantId,
+		Statuses: statuses,
+	})
+
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to count approvals: %w", err)
+	}
+
+	return approvals, total, nil
+}
+
+func (r *approvalRepository) ResolveApproval(ctx context.Context, tenantId, taskExternalId uuid.UUID, opts ResolveApprovalOpts) (*sqlcv1.V1Approval, error) {
+	ctx, span := telemetry.NewSpan(ctx, "resolve-approval")
+	defer span.End()
+
+	if err := r.v.Validate(opts); err != nil {
+		return nil, err
+	}
+
+	tx, commit, rollback, err := sqlchelpers.PrepareTx(ctx, r.pool, r.l)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer rollback()
+
+	existing, err := r.queries.GetApprovalForUpdate(ctx, tx, sqlcv1.GetApprovalForUpdateParams{
+		Tenantid:       tenantId,
+		Taskexternalid: taskExternalId,
+	})
+
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrApprovalNotFound
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to get approval: %w", err)
+	}
+
+	if existing.Status != sqlcv1.V1ApprovalStatusPENDING {
+		return nil, ErrApprovalNotPending
+	}
+
+	if !canResolveApproval(existing.Approvers, opts) {
+		return nil, ErrApprovalNotApprover
+	}
+
+	status := sqlcv1.V1ApprovalStatusREJECTED
+
+	if opts.Approved {
+		status = sqlcv1.V1ApprovalStatusAPPROVED
+	}
+
+	params := sqlcv1.ResolveApprovalParams{
+		Status:             status,
+		Approver:           pgtype.Text{String: opts.Approver, Valid: true},
+		Approverapitokenid: opts.ApiTokenId,
+		Tenantid:           tenantId,
+		Taskexternalid:     taskExternalId,
+	}
+
+	if opts.Comment != nil {
+		params.Comment = pgtype.Text{String: *opts.Comment, Valid: true}
+	}
+
+	approval, err := r.queries.ResolveApproval(ctx, tx, params)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve approval: %w", err)
+	}
+
+	if err := commit(ctx); err != nil {
+		return nil, err
+	}
+
+	return approval, nil
+}
+
+func (r *approvalRepository) CancelApproval(ctx context.Context, tenantId, taskExternalId uuid.UUID) error {
+	_, err := r.queries.ResolveApproval(ctx, r.pool, sqlcv1.ResolveApprovalParams{
+		Status:         sqlcv1.V1ApprovalStatusCANCELLED,
+		Tenantid:       tenantId,
+		Taskexternalid: taskExternalId,
+	})
+
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		return fmt.Errorf("failed to cancel approval: %w", err)
+	}
+
+	return nil
+}
+
+func (r *approvalRepository) ExpireApprovals(ctx context.Context, tenantId uuid.UUID, limit int32) ([]*sqlcv1.V1Approval, error) {
+	return r.queries.ExpireApprovals(ctx, r.pool, sqlcv1.ExpireApprovalsParams{
+		Tenantid:      tenantId,
+		Now:           sqlchelpers.TimestamptzFromTime(r.clock.Now()),
+		Approvallimit: limit,
+	})
+}
+
+// APITokenApprover is the approver recorded for an approval resolved with an api token.
+func APITokenApprover(tokenId uuid.UUID) string {
+	return "api-token:" + tokenId.String()
+}
+
+// canResolveApproval returns whether the approval can be resolved with opts. Api tokens don't
+// identify a person, so they can only resolve approvals without approvers.
+func canResolveApproval(approvers []string, opts ResolveApprovalOpts) bool {
+	if opts.ApiTokenId != nil {
+		return len(approvers) == 0
+	}
+
+	return IsApprover(approvers, opts.Approver)
+}
+
+// IsApprover returns whether the approver is in the approver group. An empty group means any
+// tenant member can resolve the approval.
+func IsApprover(approvers []string, approver string) bool {
+	if len(approvers) == 0 {
+		return true
+	}
+
+	for _, a := range approvers {
+		if strings.EqualFold(strings.TrimSpace(a), strings.TrimSpace(approver)) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ApprovalContext extracts the context fields shown to approvers from a task's action payload.
+// Fields are dot-separated paths into the payload, such as "input.amount" or
+// "parents.review.total", and fields which don't exist in the payload are omitted.
+func ApprovalContext(payload []byte, fields []string) ([]byte, error) {
+	res := make(map[string]interface{}, len(fields))
+
+	if len(fields) == 0 || len(payload) == 0 {
+		return json.Marshal(res)
+	}
+
+	var data map[string]interface{}
+
+	if err := json.Unmarshal(payload, &data); err != nil {
+		return nil, fmt.Errorf("failed to parse task payload: %w", err)
+	}
+
+	for _, field := range fields {
+		var cur interface{} = data
+		found := true
+
+		for _, part := range strings.Split(field, ".") {
+			obj, ok := cur.(map[string]interface{})
+
+			if !ok {
+				found = false
+				break
+			}
+
+			cur, ok = obj[part]
+
+			if !ok {
+				found = false
+				break
+			}
+		}
+
+		if found {
+			res[field] = cur
+		}
+	}
+
+	return json.Marshal(res)
+}
@@ -0,0 +1,83 @@
+//go:build !e2e && !load && !rampup && !integration
+
+package repository
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIsApprover(t *testing.T) {
+	t.Run("anyone can approve without approvers", func(t *testing.T) {
+		assert.True(t, IsApprover(nil, "alice@example.com"))
+		assert.True(t, IsApprover([]string{}, "alice@example.com"))
+	})
+
+	t.Run("matches case-insensitively", func(t *testing.T) {
+		approvers := []string{"Alice@Example.com", " bob@example.com "}
+
+		assert.True(t, IsApprover(approvers, "alice@example.com"))
+		assert.True(t, IsApprover(approvers, "bob@example.com"))
+		assert.False(t, IsApprover(approvers, "mallory@example.com"))
+	})
+}
+
+func TestCanResolveApproval(t *testing.T) {
+	approvers := []string{"alice@example.com"}
+
+	tokenId := uuid.New()
+	tokenOpts := ResolveApprovalOpts{Approver: APITokenApprover(tokenId), ApiTokenId: &tokenId}
+
+	assert.True(t, canResolveApproval(nil, tokenOpts))
+	assert.False(t, canResolveApproval(approvers, tokenOpts), "api tokens can't resolve approvals with approvers")
+
+	// an api token can't claim to be an approver
+	tokenOpts.Approver = "alice@example.com"
+	assert.False(t, canResolveApproval(approvers, tokenOpts))
+
+	assert.True(t, canResolveApproval(approvers, ResolveApprovalOpts{Approver: "alice@example.com"}))
+	assert.False(t, canResolveApproval(approvers, ResolveApprovalOpts{Approver: "mallory@example.com"}))
+}
+
+func TestApprovalContext(t *testing.T) {
+	payload := []byte(`{
+		"input": {"amount": 120.5, "customer": {"id": "c-1", "tier": "gold"}},
+		"parents": {"prepare": {"total": 3}}
+	}`)
+
+	t.Run("extracts nested fields", func(t *testing.T) {
+		res, err := ApprovalContext(payload, []string{"input.amount", "input.customer.tier", "parents.prepare.total"})
+		require.NoError(t, err)
+
+		assert.JSONEq(t, `{"input.amount": 120.5, "input.customer.tier": "gold", "parents.prepare.total": 3}`, string(res))
+	})
+
+	t.Run("omits missing fields", func(t *testing.T) {
+		res, err := ApprovalContext(payload, []string{"input.missing", "input.amount.value", "parents.other.total"})
+		require.NoError(t, err)
+
+		assert.JSONEq(t, `{}`, string(res))
+	})
+
+	t.Run("whole objects", func(t *testing.T) {
+		res, err := ApprovalContext(payload, []string{"input.customer"})
+		require.NoError(t, err)
+
+		assert.JSONEq(t, `{"input.customer": {"id": "c-1", "tier": "gold"}}`, string(res))
+	})
+
+	t.Run("no fields", func(t *testing.T) {
+		res, err := ApprovalContext(payload, nil)
+		require.NoError(t, err)
+
+		assert.JSONEq(t, `{}`, string(res))
+	})
+
+	t.Run("invalid payload", func(t *testing.T) {
+		_, err := ApprovalContext([]byte(`not json`), []string{"input.amount"})
+		assert.Error(t, err)
+	})
+}
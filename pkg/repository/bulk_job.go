@@ -0,0 +1,380 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"github.com/hatchet-dev/hatchet/pkg/repository/sqlchelpers"
+	"github.com/hatchet-dev/hatchet/pkg/repository/sqlcv1"
+	"github.com/hatchet-dev/hatchet/pkg/telemetry"
+)
+
+// ErrBulkJobInvalidTransition is returned when a bulk job is paused or resumed from a status
+// which doesn't allow it, for example resuming a job which has already completed.
+var ErrBulkJobInvalidTransition = errors.New("bulk job cannot transition from its current status")
+
+// the number of items inserted per statement when enumerating a bulk job
+const bulkJobItemInsertChunkSize = 1000
+
+type BulkJobRepository interface {
+	CreateBulkJob(ctx context.Context, tenantId uuid.UUID, opts CreateBulkJobOpts) (*sqlcv1.V1BulkJob, error)
+	GetBulkJob(ctx context.Context, tenantId, bulkJobId uuid.UUID) (*sqlcv1.V1BulkJob, error)
+	ListBulkJobs(ctx context.Context, tenantId uuid.UUID, limit int32) ([]*sqlcv1.V1BulkJob, error)
+	ListFailedBulkJobItems(ctx context.Context, tenantId, bulkJobId uuid.UUID, limit int32) ([]*sqlcv1.V1BulkJobItem, error)
+	PauseBulkJob(ctx context.Context, tenantId, bulkJobId uuid.UUID) (*sqlcv1.V1BulkJob, error)
+	ResumeBulkJob(ctx context.Context, tenantId, bulkJobId uuid.UUID) (*sqlcv1.V1BulkJob, error)
+
+	// GetNextRunningBulkJob returns the oldest running bulk job for the tenant, or nil if there are
+	// no running bulk jobs.
+	GetNextRunningBulkJob(ctx context.Context, tenantId uuid.UUID) (*sqlcv1.V1BulkJob, error)
+
+	// EnumerateBulkJob stores a page of the external ids which matched a bulk job's filter as
+	// pending items, along with the cursor to enumerate the next page from. The job is marked as
+	// enumerated once next is nil.
+	EnumerateBulkJob(ctx context.Context, tenantId, bulkJobId uuid.UUID, externalIds []uuid.UUID, next *WorkflowRunCursor) (*sqlcv1.V1BulkJob, error)
+	ListPendingBulkJobItems(ctx context.Context, tenantId, bulkJobId uuid.UUID, limit int32) ([]uuid.UUID, error)
+	RecordBulkJobItemResults(ctx context.Context, tenantId, bulkJobId uuid.UUID, results []BulkJobItemResult) (*sqlcv1.V1BulkJob, error)
+
+	// FinishBulkJob marks a running bulk job as completed, or as failed if errMsg is set. Items
+	// which succeeded are dropped, while failed items are kept so they can be inspected.
+	FinishBulkJob(ctx context.Context, tenantId, bulkJobId uuid.UUID, errMsg *string) (*sqlcv1.V1BulkJob, error)
+}
+
+type bulkJobRepository struct {
+	*sharedRepository
+}
+
+func newBulkJobRepository(shared *sharedRepository) BulkJobRepository {
+	return &bulkJobRepository{
+		sharedRepository: shared,
+	}
+}
+
+// BulkJobFilter is the set of runs which a bulk job applies to. It is stored on the job and
+// resolved to a list of runs when the job first starts processing.
+type BulkJobFilter struct {
+	Since              time.Time                     `json:"since" validate:"required"`
+	Until              *time.Time                    `json:"until,omitempty"`
+	Statuses           []sqlcv1.V1ReadableStatusOlap `json:"statuses,omitempty"`
+	WorkflowIds        []uuid.UUID                   `json:"workflow_ids,omitempty"`
+	AdditionalMetadata map[string]string             `json:"additional_metadata,omitempty"`
+}
+
+// ListWorkflowRunOpts converts the filter to the options used to list the matching runs.
+func (f *BulkJobFilter) ListWorkflowRunOpts() ListWorkflowRunOpts {
+	opts := ListWorkflowRunOpts{
+		CreatedAfter:   f.Since,
+		FinishedBefore: f.Until,
+		Statuses:       f.Statuses,
+		WorkflowIds:    f.WorkflowIds,
+	}
+
+	if len(f.AdditionalMetadata) > 0 {
+		opts.AdditionalMetadata = make(map[string]interface{}, len(f.AdditionalMetadata))
+
+		for k, v := range f.AdditionalMetadata {
+			opts.AdditionalMetadata[k] = v
+		}
+	}
+
+	return opts
+}
+
+// BulkJobEnumerationCursor returns the cursor to enumerate the next page of a bulk job's runs
+// from, or nil if enumeration hasn't started.
+func BulkJobEnumerationCursor(job *sqlcv1.V1BulkJob) *WorkflowRunCursor {
+	if !job.EnumerationCursorInsertedAt.Valid || !job.EnumerationCursorID.Valid {
+		return nil
+	}
+
+	return &WorkflowRunCursor{
+		InsertedAt: job.EnumerationCursorInsertedAt.Time,
+		Id:         job.EnumerationCursorID.Int64,
+	}
+}
+
+// ParseBulkJobFilter reads the filter stored on a bulk job.
+func ParseBulkJobFilter(job *sqlcv1.V1BulkJob) (*BulkJobFilter, error) {
+	if len(job.Filter) == 0 {
+		return nil, nil
+	}
+
+	var filter BulkJobFilter
+
+	if err := json.Unmarshal(job.Filter, &filter); err != nil {
+		return nil, fmt.Errorf("failed to parse bulk job filter: %w", err)
+	}
+
+	return &filter, nil
+}
+
+type CreateBulkJobOpts struct {
+	Kind sqlcv1.V1BulkJobKind `validate:"required,oneof=CANCEL REPLAY DELETE"`
+
+	// (optional) the filter for the runs to apply the job to, exactly one of Filter or ExternalIds
+	// must be set
+	Filter *BulkJobFilter `validate:"required_without=ExternalIds,excluded_with=ExternalIds"`
+
+	// (optional) the external ids of the runs to apply the job to
+	ExternalIds []uuid.UUID `validate:"required_without=Filter,excluded_with=Filter"`
+}
+
+// BulkJobItemResult is the outcome of applying a bulk job to a single run. An empty Error means
+// the operation succeeded.
+type BulkJobItemResult struct {
+	ExternalId uuid.UUID
+	Error      string
+}
+
+func (r *bulkJobRepository) CreateBulkJob(ctx context.Context, tenantId uuid.UUID, opts CreateBulkJobOpts) (*sqlcv1.V1BulkJob, error) {
+	ctx, span := telemetry.NewSpan(ctx, "create-bulk-job")
+	defer span.End()
+
+	if err := r.v.Validate(opts); err != nil {
+		return nil, err
+	}
+
+	var filter []byte
+
+	if opts.Filter != nil {
+		var err error
+
+		filter, err = json.Marshal(opts.Filter)
+
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal bulk job filter: %w", err)
+		}
+	}
+
+	tx, commit, rollback, err := sqlchelpers.PrepareTx(ctx, r.pool, r.l)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer rollback()
+
+	job, err := r.queries.CreateBulkJob(ctx, tx, sqlcv1.CreateBulkJobParams{
+		Tenantid: tenantId,
+		Kind:     opts.Kind,
+		Filter:   filter,
+		// jobs created with explicit ids don't need to be enumerated
+		Isenumerated: opts.Filter == nil,
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to create bulk job: %w", err)
+	}
+
+	if opts.Filter == nil {
+		job, err = r.insertBulkJobItems(ctx, tx, tenantId, job.ID, opts.ExternalIds, nil)
+
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if err := commit(ctx); err != nil {
+		return nil, err
+	}
+
+	return job, nil
+}
+
+func (r *bulkJobRepository) GetBulkJob(ctx context.Context, tenantId, bulkJobId uuid.UUID) (*sqlcv1.V1BulkJob, error) {
+	return r.queries.GetBulkJob(ctx, r.pool, sqlcv1.GetBulkJobParams{
+		Tenantid: tenantId,
+		ID:       bulkJobId,
+	})
+}
+
+func (r *bulkJobRepository) ListBulkJobs(ctx context.Context, tenantId uuid.UUID, limit int32) ([]*sqlcv1.V1BulkJob, error) {
+	return r.queries.ListBulkJobs(ctx, r.pool, sqlcv1.ListBulkJobsParams{
+		Tenantid: tenantId,
+		Joblimit: limit,
+	})
+}
+
+func (r *bulkJobRepository) ListFailedBulkJobItems(ctx context.Context, tenantId, bulkJobId uuid.UUID, limit int32) ([]*sqlcv1.V1BulkJobItem, error) {
+	return r.queries.ListFailedBulkJobItems(ctx, r.pool, sqlcv1.ListFailedBulkJobItemsParams{
+		Tenantid:  tenantId,
+		Bulkjobid: bulkJobId,
+		Itemlimit: limit,
+	})
+}
+
+func (r *bulkJobRepository) PauseBulkJob(ctx context.Context, tenantId, bulkJobId uuid.UUID) (*sqlcv1.V1BulkJob, error) {
+	return r.transition(ctx, tenantId, bulkJobId, sqlcv1.V1BulkJobStatusRUNNING, sqlcv1.V1BulkJobStatusPAUSED)
+}
+
+func (r *bulkJobRepository) ResumeBulkJob(ctx context.Context, tenantId, bulkJobId uuid.UUID) (*sqlcv1.V1BulkJob, error) {
+	return r.transition(ctx, tenantId, bulkJobId, sqlcv1.V1BulkJobStatusPAUSED, sqlcv1.V1BulkJobStatusRUNNING)
+}
+
+func (r *bulkJobRepository) transition(ctx context.Context, tenantId, bulkJobId uuid.UUID, from, to sqlcv1.V1BulkJobStatus) (*sqlcv1.V1BulkJob, error) {
+	job, err := r.queries.TransitionBulkJobStatus(ctx, r.pool, sqlcv1.TransitionBulkJobStatusParams{
+		Tostatus:   to,
+		Tenantid:   tenantId,
+		ID:         bulkJobId,
+		Fromstatus: from,
+	})
+
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrBulkJobInvalidTransition
+	}
+
+	return job, err
+}
+
+func (r *bulkJobRepository) GetNextRunningBulkJob(ctx context.Context, tenantId uuid.UUID) (*sqlcv1.V1BulkJob, error) {
+	job, err := r.queries.GetNextRunningBulkJob(ctx, r.pool, tenantId)
+
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+
+	return job, err
+}
+
+func (r *bulkJobRepository) EnumerateBulkJob(ctx context.Context, tenantId, bulkJobId uuid.UUID, externalIds []uuid.UUID, next *WorkflowRunCursor) (*sqlcv1.V1BulkJob, error) {
+	ctx, span := telemetry.NewSpan(ctx, "enumerate-bulk-job")
+	defer span.End()
+
+	tx, commit, rollback, err := sqlchelpers.PrepareTx(ctx, r.pool, r.l)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer rollback()
+
+	job, err := r.insertBulkJobItems(ctx, tx, tenantId, bulkJobId, externalIds, next)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if err := commit(ctx); err != nil {
+		return nil, err
+	}
+
+	return job, nil
+}
+
+func (r *bulkJobRepository) insertBulkJobItems(ctx context.Context, tx sqlcv1.DBTX, tenantId, bulkJobId uuid.UUID, externalIds []uuid.UUID, next *WorkflowRunCursor) (*sqlcv1.V1BulkJob, error) {
+	var added int64
+
+	for start := 0; start < len(externalIds); start += bulkJobItemInsertChunkSize {
+		end := min(start+bulkJobItemInsertChunkSize, len(externalIds))
+
+		count, err := r.queries.InsertBulkJobItems(ctx, tx, sqlcv1.InsertBulkJobItemsParams{
+			Tenantid:    tenantId,
+			Bulkjobid:   bulkJobId,
+			Externalids: externalIds[start:end],
+		})
+
+		if err != nil {
+			return nil, fmt.Errorf("failed to insert bulk job items: %w", err)
+		}
+
+		added += count
+	}
+
+	params := sqlcv1.UpdateBulkJobEnumerationParams{
+		Addedcount: added,
+		Tenantid:   tenantId,
+		ID:         bulkJobId,
+	}
+
+	if next != nil {
+		params.Cursorinsertedat = sqlchelpers.TimestamptzFromTime(next.InsertedAt)
+		params.Cursorid = pgtype.Int8{Int64: next.Id, Valid: true}
+	}
+
+	return r.queries.UpdateBulkJobEnumeration(ctx, tx, params)
+}
+
+func (r *bulkJobRepository) ListPendingBulkJobItems(ctx context.Context, tenantId, bulkJobId uuid.UUID, limit int32) ([]uuid.UUID, error) {
+	return r.queries.ListPendingBulkJobItems(ctx, r.pool, sqlcv1.ListPendingBulkJobItemsParams{
+		Tenantid:  tenantId,
+		Bulkjobid: bulkJobId,
+		Itemlimit: limit,
+	})
+}
+
+func (r *bulkJobRepository) RecordBulkJobItemResults(ctx context.Context, tenantId, bulkJobId uuid.UUID, results []BulkJobItemResult) (*sqlcv1.V1BulkJob, error) {
+	externalIds := make([]uuid.UUID, len(results))
+	statuses := make([]string, len(results))
+	errs := make([]string, len(results))
+
+	for i, res := range results {
+		externalIds[i] = res.ExternalId
+		errs[i] = res.Error
+
+		if res.Error == "" {
+			statuses[i] = string(sqlcv1.V1BulkJobItemStatusSUCCEEDED)
+		} else {
+			statuses[i] = string(sqlcv1.V1BulkJobItemStatusFAILED)
+		}
+	}
+
+	return r.queries.RecordBulkJobItemResults(ctx, r.pool, sqlcv1.RecordBulkJobItemResultsParams{
+		Tenantid:    tenantId,
+		Bulkjobid:   bulkJobId,
+		Externalids: externalIds,
+		Statuses:    statuses,
+		Errors:      errs,
+	})
+}
+
+func (r *bulkJobRepository) FinishBulkJob(ctx context.Context, tenantId, bulkJobId uuid.UUID, errMsg *string) (*sqlcv1.V1BulkJob, error) {
+	ctx, span := telemetry.NewSpan(ctx, "finish-bulk-job")
+	defer span.End()
+
+	params := sqlcv1.FinishBulkJobParams{
+		Status:   sqlcv1.V1BulkJobStatusCOMPLETED,
+		Tenantid: tenantId,
+		ID:       bulkJobId,
+	}
+
+	if errMsg != nil {
+		params.Status = sqlcv1.V1BulkJobStatusFAILED
+		params.Error = pgtype.Text{String: *errMsg, Valid: true}
+	}
+
+	tx, commit, rollback, err := sqlchelpers.PrepareTx(ctx, r.pool, r.l)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer rollback()
+
+	job, err := r.queries.FinishBulkJob(ctx, tx, params)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to finish bulk job: %w", err)
+	}
+
+	err = r.queries.DeleteSucceededBulkJobItems(ctx, tx, sqlcv1.DeleteSucceededBulkJobItemsParams{
+		Tenantid:  tenantId,
+		Bulkjobid: bulkJobId,
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to delete succeeded bulk job items: %w", err)
+	}
+
+	if err := commit(ctx); err != nil {
+		return nil, err
+	}
+
+	return job, nil
+}
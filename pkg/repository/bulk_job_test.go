@@ -0,0 +1,79 @@
+//go:build !e2e && !load && !rampup && !integration
+
+package repository
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hatchet-dev/hatchet/pkg/repository/sqlchelpers"
+	"github.com/hatchet-dev/hatchet/pkg/repository/sqlcv1"
+	"github.com/hatchet-dev/hatchet/pkg/validator"
+)
+
+func TestBulkJobFilterRoundTrip(t *testing.T) {
+	until := time.Date(2026, 1, 2, 0, 0, 0, 0, time.UTC)
+
+	filter := &BulkJobFilter{
+		Since:              time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC),
+		Until:              &until,
+		Statuses:           []sqlcv1.V1ReadableStatusOlap{sqlcv1.V1ReadableStatusOlapFAILED},
+		WorkflowIds:        []uuid.UUID{uuid.New()},
+		AdditionalMetadata: map[string]string{"customer": "acme"},
+	}
+
+	raw, err := json.Marshal(filter)
+	require.NoError(t, err)
+
+	parsed, err := ParseBulkJobFilter(&sqlcv1.V1BulkJob{Filter: raw})
+	require.NoError(t, err)
+	assert.Equal(t, filter, parsed)
+
+	opts := parsed.ListWorkflowRunOpts()
+
+	assert.Equal(t, filter.Since, opts.CreatedAfter)
+	assert.Equal(t, &until, opts.FinishedBefore)
+	assert.Equal(t, filter.Statuses, opts.Statuses)
+	assert.Equal(t, filter.WorkflowIds, opts.WorkflowIds)
+	assert.Equal(t, map[string]interface{}{"customer": "acme"}, opts.AdditionalMetadata)
+
+	noFilter, err := ParseBulkJobFilter(&sqlcv1.V1BulkJob{})
+	require.NoError(t, err)
+	assert.Nil(t, noFilter)
+}
+
+func TestCreateBulkJobOptsValidation(t *testing.T) {
+	v := validator.NewDefaultValidator()
+
+	filter := &BulkJobFilter{Since: time.Now()}
+	ids := []uuid.UUID{uuid.New()}
+
+	assert.NoError(t, v.Validate(CreateBulkJobOpts{Kind: sqlcv1.V1BulkJobKindCANCEL, Filter: filter}))
+	assert.NoError(t, v.Validate(CreateBulkJobOpts{Kind: sqlcv1.V1BulkJobKindDELETE, ExternalIds: ids}))
+
+	assert.Error(t, v.Validate(CreateBulkJobOpts{Kind: sqlcv1.V1BulkJobKindREPLAY}), "one of filter or external ids is required")
+	assert.Error(t, v.Validate(CreateBulkJobOpts{Kind: sqlcv1.V1BulkJobKindREPLAY, Filter: filter, ExternalIds: ids}), "filter and external ids are exclusive")
+	assert.Error(t, v.Validate(CreateBulkJobOpts{Kind: "PURGE", ExternalIds: ids}), "unknown kind")
+	assert.Error(t, v.Validate(CreateBulkJobOpts{Kind: sqlcv1.V1BulkJobKindCANCEL, Filter: &BulkJobFilter{}}), "filter requires since")
+}
+
+func TestBulkJobEnumerationCursor(t *testing.T) {
+	assert.Nil(t, BulkJobEnumerationCursor(&sqlcv1.V1BulkJob{}))
+
+	insertedAt := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	cursor := BulkJobEnumerationCursor(&sqlcv1.V1BulkJob{
+		EnumerationCursorInsertedAt: sqlchelpers.TimestamptzFromTime(insertedAt),
+		EnumerationCursorID:         pgtype.Int8{Int64: 42, Valid: true},
+	})
+
+	require.NotNil(t, cursor)
+	assert.Equal(t, insertedAt, cursor.InsertedAt.UTC())
+	assert.Equal(t, int64(42), cursor.Id)
+}
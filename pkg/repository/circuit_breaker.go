@@ -0,0 +1,442 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"github.com/hatchet-dev/hatchet/pkg/repository/sqlchelpers"
+	"github.com/hatchet-dev/hatchet/pkg/repository/sqlcv1"
+	"github.com/hatchet-dev/hatchet/pkg/telemetry"
+)
+
+const (
+	// circuitBreakerExpressionKey is the step expression key of a circuit breaker key expression. A
+	// step has at most one circuit breaker.
+	circuitBreakerExpressionKey = "circuit_breaker"
+
+	defaultCircuitBreakerWindow   = 60 * time.Second
+	defaultCircuitBreakerCooldown = 30 * time.Second
+)
+
+type CircuitBreakerRepository interface {
+	// ListCircuitBreakers lists the state of every circuit breaker in the tenant which has recorded
+	// a failure.
+	ListCircuitBreakers(ctx context.Context, tenantId uuid.UUID) ([]*sqlcv1.V1CircuitBreaker, error)
+}
+
+type circuitBreakerRepository struct {
+	*sharedRepository
+}
+
+func newCircuitBreakerRepository(shared *sharedRepository) *circuitBreakerRepository {
+	return &circuitBreakerRepository{
+		sharedRepository: shared,
+	}
+}
+
+func (r *circuitBreakerRepository) ListCircuitBreakers(ctx context.Context, tenantId uuid.UUID) ([]*sqlcv1.V1CircuitBreaker, error) {
+	return r.queries.ListCircuitBreakersForTenant(ctx, r.pool, tenantId)
+}
+
+func (r *sharedRepository) createStepCircuitBreaker(ctx context.Context, tx sqlcv1.DBTX, tenantId, stepId uuid.UUID, opts *CreateCircuitBreakerOpts) error {
+	window := defaultCircuitBreakerWindow
+	cooldown := defaultCircuitBreakerCooldown
+
+	var err error
+
+	if opts.Window != nil {
+		if window, err = time.ParseDuration(*opts.Window); err != nil {
+			return fmt.Errorf("invalid window: %w", err)
+		}
+	}
+
+	if opts.Cooldown != nil {
+		if cooldown, err = time.ParseDuration(*opts.Cooldown); err != nil {
+			return fmt.Errorf("invalid cooldown: %w", err)
+		}
+	}
+
+	err = r.queries.CreateStepCircuitBreaker(ctx, tx, sqlcv1.CreateStepCircuitBreakerParams{
+		Stepid:           stepId,
+		Tenantid:         tenantId,
+		Failurethreshold: opts.FailureThreshold,
+		Windowseconds:    int32(max(window/time.Second, 1)),   // nolint: gosec
+		Cooldownseconds:  int32(max(cooldown/time.Second, 1)), // nolint: gosec
+	})
+
+	if err != nil {
+		return err
+	}
+
+	if opts.KeyExpr == nil {
+		return nil
+	}
+
+	// the key expression is evaluated when tasks are created, like dynamic rate limit keys
+	return r.queries.CreateStepExpressions(ctx, tx, sqlcv1.CreateStepExpressionsParams{
+		Stepid:      stepId,
+		Keys:        []string{circuitBreakerExpressionKey},
+		Expressions: []string{*opts.KeyExpr},
+		Kinds:       []string{string(sqlcv1.StepExpressionKindCIRCUITBREAKERKEY)},
+	})
+}
+
+// circuitBreakerKey scopes the evaluated key of a task's circuit breaker to its step, so steps
+// which evaluate to the same key don't trip each other's breakers. It must match the keys built by
+// ListTaskCircuitBreakers and CloseCircuitBreakersForTasks.
+func circuitBreakerKey(stepId uuid.UUID, key string) string {
+	return stepId.String() + ":" + key
+}
+
+// circuitBreakerUpdate is the state of a circuit breaker after recording failures.
+type circuitBreakerUpdate struct {
+	state           sqlcv1.V1CircuitBreakerState
+	failureCount    int32
+	windowStartedAt time.Time
+	openedAt        *time.Time
+}
+
+// nextCircuitBreakerState returns the state of a breaker after recording failures at now. existing
+// is nil if the breaker has not recorded a failure before. A closed breaker opens once the failures
+// within the window reach the threshold, and a half-open breaker re-opens on any failure.
+func nextCircuitBreakerState(existing *sqlcv1.V1CircuitBreaker, threshold int32, window time.Duration, failures int32, now time.Time) circuitBreakerUpdate {
+	res := circuitBreakerUpdate{
+		state:           sqlcv1.V1CircuitBreakerStateCLOSED,
+		failureCount:    failures,
+		windowStartedAt: now,
+	}
+
+	if existing != nil {
+		switch existing.State {
+		case sqlcv1.V1CircuitBreakerStateOPEN:
+			// failures of tasks which were running when the breaker opened don't extend the cooldown
+			res.state = sqlcv1.V1CircuitBreakerStateOPEN
+			res.failureCount = existing.FailureCount + failures
+			res.windowStartedAt = existing.WindowStartedAt.Time
+			openedAt := existing.OpenedAt.Time
+			res.openedAt = &openedAt
+
+			return res
+		case sqlcv1.V1CircuitBreakerStateHALFOPEN:
+			res.state = sqlcv1.V1CircuitBreakerStateOPEN
+			res.failureCount = existing.FailureCount + failures
+			res.openedAt = &now
+
+			return res
+		default:
+			if now.Sub(existing.WindowStartedAt.Time) < window {
+				res.failureCount = existing.FailureCount + failures
+				res.windowStartedAt = existing.WindowStartedAt.Time
+			}
+		}
+	}
+
+	if res.failureCount >= threshold {
+		res.state = sqlcv1.V1CircuitBreakerStateOPEN
+		res.openedAt = &now
+	}
+
+	return res
+}
+
+// recordCircuitBreakerFailures counts the failures of the tasks towards their circuit breakers,
+// opening the breakers which reach their threshold.
+func (r *sharedRepository) recordCircuitBreakerFailures(ctx context.Context, tx sqlcv1.DBTX, tenantId uuid.UUID, taskIds []int64, taskInsertedAts []pgtype.Timestamptz) error {
+	taskBreakers, err := r.queries.ListTaskCircuitBreakers(ctx, tx, sqlcv1.ListTaskCircuitBreakersParams{
+		Taskids:         taskIds,
+		Taskinsertedats: taskInsertedAts,
+		Tenantid:        tenantId,
+	})
+
+	if err != nil {
+		return fmt.Errorf("could not list task circuit breakers: %w", err)
+	}
+
+	if len(taskBreakers) == 0 {
+		return nil
+	}
+
+	failuresByKey := make(map[string]int32)
+	configByKey := make(map[string]*sqlcv1.ListTaskCircuitBreakersRow)
+
+	for _, tb := range taskBreakers {
+		failuresByKey[tb.Key]++
+		configByKey[tb.Key] = tb
+	}
+
+	keys := make([]string, 0, len(failuresByKey))
+
+	for key := range failuresByKey {
+		keys = append(keys, key)
+	}
+
+	// sorted to lock the breakers in a consistent order
+	sort.Strings(keys)
+
+	existing, err := r.queries.ListCircuitBreakersForUpdate(ctx, tx, sqlcv1.ListCircuitBreakersForUpdateParams{
+		Tenantid: tenantId,
+		Keys:     keys,
+	})
+
+	if err != nil {
+		return fmt.Errorf("could not lock circuit breakers: %w", err)
+	}
+
+	existingByKey := make(map[string]*sqlcv1.V1CircuitBreaker, len(existing))
+
+	for _, cb := range existing {
+		existingByKey[cb.Key] = cb
+	}
+
+	now := r.clock.Now()
+
+	params := sqlcv1.UpsertCircuitBreakersParams{
+		Tenantid: tenantId,
+		Now:      sqlchelpers.TimestamptzFromTime(now),
+	}
+
+	for _, key := range keys {
+		cfg := configByKey[key]
+
+		update := nextCircuitBreakerState(
+			existingByKey[key],
+			cfg.FailureThreshold,
+			time.Duration(cfg.WindowSeconds)*time.Second,
+			failuresByKey[key],
+			now,
+		)
+
+		var openedAt pgtype.Timestamptz
+
+		if update.openedAt != nil {
+			openedAt = sqlchelpers.TimestamptzFromTime(*update.openedAt)
+
+			if prev, ok := existingByKey[key]; !ok || prev.State != sqlcv1.V1CircuitBreakerStateOPEN {
+				r.l.Warn().Ctx(ctx).Str("tenant_id", tenantId.String()).Msgf("circuit breaker %s opened after %d failures", key, update.failureCount)
+			}
+		}
+
+		params.Keys = append(params.Keys, key)
+		params.States = append(params.States, string(update.state))
+		params.Failurecounts = append(params.Failurecounts, update.failureCount)
+		params.Failurethresholds = append(params.Failurethresholds, cfg.FailureThreshold)
+		params.Windowseconds = append(params.Windowseconds, cfg.WindowSeconds)
+		params.Cooldownseconds = append(params.Cooldownseconds, cfg.CooldownSeconds)
+		params.Windowstartedats = append(params.Windowstartedats, sqlchelpers.TimestamptzFromTime(update.windowStartedAt))
+		params.Openedats = append(params.Openedats, openedAt)
+	}
+
+	return r.queries.UpsertCircuitBreakers(ctx, tx, params)
+}
+
+// closeCircuitBreakers closes the half-open circuit breakers of tasks which succeeded.
+func (r *sharedRepository) closeCircuitBreakers(ctx context.Context, tx sqlcv1.DBTX, tenantId uuid.UUID, tasks []TaskIdInsertedAtRetryCount) error {
+	taskIds := make([]int64, len(tasks))
+	taskInsertedAts := make([]pgtype.Timestamptz, len(tasks))
+
+	for i, task := range tasks {
+		taskIds[i] = task.Id
+		taskInsertedAts[i] = task.InsertedAt
+	}
+
+	return r.queries.CloseCircuitBreakersForTasks(ctx, tx, sqlcv1.CloseCircuitBreakersForTasksParams{
+		Taskids:         taskIds,
+		Taskinsertedats: taskInsertedAts,
+		Tenantid:        tenantId,
+		Now:             sqlchelpers.TimestamptzFromTime(r.clock.Now()),
+	})
+}
+
+// circuitBreakerProbeDue returns true if an open breaker has cooled down, or if the probe of a
+// half-open breaker has not completed within the cooldown, so a new probe may be sent.
+func circuitBreakerProbeDue(cb *sqlcv1.V1CircuitBreaker, now time.Time) bool {
+	cooldown := time.Duration(cb.CooldownSeconds) * time.Second
+
+	switch cb.State {
+	case sqlcv1.V1CircuitBreakerStateOPEN:
+		return !now.Before(cb.OpenedAt.Time.Add(cooldown))
+	case sqlcv1.V1CircuitBreakerStateHALFOPEN:
+		return !now.Before(cb.ProbeStartedAt.Time.Add(cooldown))
+	default:
+		return false
+	}
+}
+
+// GetCircuitBreakerBlockedTasks returns the ids of the tasks which must stay queued because their
+// circuit breaker is open. For each breaker which is due for a probe, the first queue item with
+// the breaker's key is let through as the probe.
+func (d *queueRepository) GetCircuitBreakerBlockedTasks(ctx context.Context, tx *OptimisticTx, queueItems []*sqlcv1.V1QueueItem) (map[int64]struct{}, error) {
+	ctx, span := telemetry.NewSpan(ctx, "get-circuit-breaker-blocked-tasks")
+	defer span.End()
+
+	var queryTx sqlcv1.DBTX
+
+	if tx != nil {
+		queryTx = tx.tx
+	} else {
+		queryTx = d.pool
+	}
+
+	stepBreakers, err := d.getStepCircuitBreakers(ctx, queryTx, queueItems)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(stepBreakers) == 0 {
+		return nil, nil
+	}
+
+	// resolve the key of each queue item whose step has a breaker
+	itemsWithBreakers := make([]*sqlcv1.V1QueueItem, 0)
+	taskIds := make([]int64, 0)
+	taskInsertedAts := make([]pgtype.Timestamptz, 0)
+
+	for _, qi := range queueItems {
+		if _, ok := stepBreakers[qi.StepID]; ok {
+			itemsWithBreakers = append(itemsWithBreakers, qi)
+			taskIds = append(taskIds, qi.TaskID)
+			taskInsertedAts = append(taskInsertedAts, qi.TaskInsertedAt)
+		}
+	}
+
+	expressionEvals, err := d.queries.ListTaskExpressionEvals(ctx, queryTx, sqlcv1.ListTaskExpressionEvalsParams{
+		Taskids:         taskIds,
+		Taskinsertedats: taskInsertedAts,
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("could not list task expression evals: %w", err)
+	}
+
+	taskIdToKey := make(map[int64]string, len(itemsWithBreakers))
+
+	for _, eval := range expressionEvals {
+		if eval.Kind == sqlcv1.StepExpressionKindCIRCUITBREAKERKEY && eval.ValueStr.Valid {
+			taskIdToKey[eval.TaskID] = eval.ValueStr.String
+		}
+	}
+
+	keyToItems := make(map[string][]*sqlcv1.V1QueueItem)
+	keys := make([]string, 0)
+
+	for _, qi := range itemsWithBreakers {
+		keyValue, ok := taskIdToKey[qi.TaskID]
+
+		if !ok {
+			keyValue = qi.ActionID
+		}
+
+		key := circuitBreakerKey(qi.StepID, keyValue)
+
+		if _, ok := keyToItems[key]; !ok {
+			keys = append(keys, key)
+		}
+
+		keyToItems[key] = append(keyToItems[key], qi)
+	}
+
+	breakers, err := d.queries.ListCircuitBreakers(ctx, queryTx, sqlcv1.ListCircuitBreakersParams{
+		Tenantid: d.tenantId,
+		Keys:     keys,
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("could not list circuit breakers: %w", err)
+	}
+
+	now := d.clock.Now()
+	blocked := make(map[int64]struct{})
+
+	for _, cb := range breakers {
+		if cb.State == sqlcv1.V1CircuitBreakerStateCLOSED {
+			continue
+		}
+
+		items := keyToItems[cb.Key]
+		var probeTaskId int64
+
+		switch {
+		case circuitBreakerProbeDue(cb, now):
+			// queue items are sorted by priority, so the first item is the probe
+			acquired, err := d.queries.AcquireCircuitBreakerProbe(ctx, queryTx, sqlcv1.AcquireCircuitBreakerProbeParams{
+				Taskid:   items[0].TaskID,
+				Now:      sqlchelpers.TimestamptzFromTime(now),
+				Tenantid: d.tenantId,
+				Key:      cb.Key,
+			})
+
+			if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+				return nil, fmt.Errorf("could not acquire circuit breaker probe: %w", err)
+			}
+
+			// another scheduler may have acquired the probe first
+			if err == nil {
+				probeTaskId = acquired.ProbeTaskID.Int64
+			}
+		case cb.State == sqlcv1.V1CircuitBreakerStateHALFOPEN && cb.ProbeTaskID.Valid:
+			// the probe may not have been assigned yet
+			probeTaskId = cb.ProbeTaskID.Int64
+		}
+
+		for _, qi := range items {
+			if qi.TaskID != probeTaskId {
+				blocked[qi.TaskID] = struct{}{}
+			}
+		}
+	}
+
+	return blocked, nil
+}
+
+// getStepCircuitBreakers returns the circuit breaker configuration of the steps of the queue items
+// which have one.
+func (d *queueRepository) getStepCircuitBreakers(ctx context.Context, tx sqlcv1.DBTX, queueItems []*sqlcv1.V1QueueItem) (map[uuid.UUID]*sqlcv1.V1StepCircuitBreaker, error) {
+	res := make(map[uuid.UUID]*sqlcv1.V1StepCircuitBreaker)
+	stepIdsToLookup := make([]uuid.UUID, 0)
+	seen := make(map[uuid.UUID]struct{})
+
+	for _, qi := range queueItems {
+		if _, ok := seen[qi.StepID]; ok {
+			continue
+		}
+
+		seen[qi.StepID] = struct{}{}
+
+		if value, found := d.stepIdCircuitBreakerCache.Get(qi.StepID); found {
+			if value != nil {
+				res[qi.StepID] = value
+			}
+		} else {
+			stepIdsToLookup = append(stepIdsToLookup, qi.StepID)
+		}
+	}
+
+	if len(stepIdsToLookup) == 0 {
+		return res, nil
+	}
+
+	rows, err := d.queries.ListStepCircuitBreakers(ctx, tx, stepIdsToLookup)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not list step circuit breakers: %w", err)
+	}
+
+	for _, row := range rows {
+		res[row.StepID] = row
+	}
+
+	// cache nil results so we skip DB lookups for steps without circuit breakers
+	for _, stepId := range stepIdsToLookup {
+		d.stepIdCircuitBreakerCache.Add(stepId, res[stepId])
+	}
+
+	return res, nil
+}
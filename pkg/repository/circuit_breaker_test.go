@@ -0,0 +1,130 @@
+//go:build !e2e && !load && !rampup && !integration
+
+package repository
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/hatchet-dev/hatchet/pkg/repository/sqlchelpers"
+	"github.com/hatchet-dev/hatchet/pkg/repository/sqlcv1"
+)
+
+func TestNextCircuitBreakerState(t *testing.T) {
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	window := time.Minute
+
+	t.Run("first failures below threshold", func(t *testing.T) {
+		res := nextCircuitBreakerState(nil, 5, window, 2, now)
+
+		assert.Equal(t, sqlcv1.V1CircuitBreakerStateCLOSED, res.state)
+		assert.Equal(t, int32(2), res.failureCount)
+		assert.Equal(t, now, res.windowStartedAt)
+		assert.Nil(t, res.openedAt)
+	})
+
+	t.Run("first failures reach threshold", func(t *testing.T) {
+		res := nextCircuitBreakerState(nil, 2, window, 3, now)
+
+		assert.Equal(t, sqlcv1.V1CircuitBreakerStateOPEN, res.state)
+		assert.Equal(t, &now, res.openedAt)
+	})
+
+	t.Run("failures accumulate within the window", func(t *testing.T) {
+		existing := &sqlcv1.V1CircuitBreaker{
+			State:           sqlcv1.V1CircuitBreakerStateCLOSED,
+			FailureCount:    4,
+			WindowStartedAt: sqlchelpers.TimestamptzFromTime(now.Add(-30 * time.Second)),
+		}
+
+		res := nextCircuitBreakerState(existing, 5, window, 1, now)
+
+		assert.Equal(t, sqlcv1.V1CircuitBreakerStateOPEN, res.state)
+		assert.Equal(t, int32(5), res.failureCount)
+		assert.Equal(t, now.Add(-30*time.Second), res.windowStartedAt)
+	})
+
+	t.Run("failures reset after the window", func(t *testing.T) {
+		existing := &sqlcv1.V1CircuitBreaker{
+			State:           sqlcv1.V1CircuitBreakerStateCLOSED,
+			FailureCount:    4,
+			WindowStartedAt: sqlchelpers.TimestamptzFromTime(now.Add(-2 * time.Minute)),
+		}
+
+		res := nextCircuitBreakerState(existing, 5, window, 1, now)
+
+		assert.Equal(t, sqlcv1.V1CircuitBreakerStateCLOSED, res.state)
+		assert.Equal(t, int32(1), res.failureCount)
+		assert.Equal(t, now, res.windowStartedAt)
+	})
+
+	t.Run("open breaker keeps its opened time", func(t *testing.T) {
+		openedAt := now.Add(-10 * time.Second)
+
+		existing := &sqlcv1.V1CircuitBreaker{
+			State:           sqlcv1.V1CircuitBreakerStateOPEN,
+			FailureCount:    5,
+			WindowStartedAt: sqlchelpers.TimestamptzFromTime(now.Add(-20 * time.Second)),
+			OpenedAt:        sqlchelpers.TimestamptzFromTime(openedAt),
+		}
+
+		res := nextCircuitBreakerState(existing, 5, window, 2, now)
+
+		assert.Equal(t, sqlcv1.V1CircuitBreakerStateOPEN, res.state)
+		assert.Equal(t, int32(7), res.failureCount)
+		assert.Equal(t, &openedAt, res.openedAt)
+	})
+
+	t.Run("failed probe re-opens the breaker", func(t *testing.T) {
+		existing := &sqlcv1.V1CircuitBreaker{
+			State:           sqlcv1.V1CircuitBreakerStateHALFOPEN,
+			FailureCount:    5,
+			WindowStartedAt: sqlchelpers.TimestamptzFromTime(now.Add(-time.Hour)),
+			OpenedAt:        sqlchelpers.TimestamptzFromTime(now.Add(-time.Hour)),
+		}
+
+		res := nextCircuitBreakerState(existing, 5, window, 1, now)
+
+		assert.Equal(t, sqlcv1.V1CircuitBreakerStateOPEN, res.state)
+		assert.Equal(t, &now, res.openedAt)
+	})
+}
+
+func TestCircuitBreakerProbeDue(t *testing.T) {
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	open := func(openedAgo time.Duration) *sqlcv1.V1CircuitBreaker {
+		return &sqlcv1.V1CircuitBreaker{
+			State:           sqlcv1.V1CircuitBreakerStateOPEN,
+			CooldownSeconds: 30,
+			OpenedAt:        sqlchelpers.TimestamptzFromTime(now.Add(-openedAgo)),
+		}
+	}
+
+	halfOpen := func(probeAgo time.Duration) *sqlcv1.V1CircuitBreaker {
+		return &sqlcv1.V1CircuitBreaker{
+			State:           sqlcv1.V1CircuitBreakerStateHALFOPEN,
+			CooldownSeconds: 30,
+			ProbeTaskID:     pgtype.Int8{Int64: 1, Valid: true},
+			ProbeStartedAt:  sqlchelpers.TimestamptzFromTime(now.Add(-probeAgo)),
+		}
+	}
+
+	assert.False(t, circuitBreakerProbeDue(&sqlcv1.V1CircuitBreaker{State: sqlcv1.V1CircuitBreakerStateCLOSED}, now))
+	assert.False(t, circuitBreakerProbeDue(open(10*time.Second), now))
+	assert.True(t, circuitBreakerProbeDue(open(30*time.Second), now))
+	assert.False(t, circuitBreakerProbeDue(halfOpen(10*time.Second), now))
+	assert.True(t, circuitBreakerProbeDue(halfOpen(time.Minute), now))
+}
+
+func TestCircuitBreakerKeyIsScopedToStep(t *testing.T) {
+	stepA := uuid.New()
+	stepB := uuid.New()
+
+	assert.Equal(t, stepA.String()+":api.example.com", circuitBreakerKey(stepA, "api.example.com"))
+	assert.NotEqual(t, circuitBreakerKey(stepA, "api.example.com"), circuitBreakerKey(stepB, "api.example.com"))
+}
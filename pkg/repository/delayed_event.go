@@ -0,0 +1,96 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"github.com/hatchet-dev/hatchet/pkg/repository/sqlchelpers"
+	"github.com/hatchet-dev/hatchet/pkg/repository/sqlcv1"
+)
+
+type CreateDelayedEventOpts struct {
+	ExternalId         uuid.UUID `validate:"required"`
+	Key                string    `validate:"required"`
+	Data               []byte
+	AdditionalMetadata []byte
+	Priority           *int32
+	Scope              *string
+	DeliverAt          time.Time `validate:"required"`
+}
+
+type DelayedEventRepository interface {
+	// CreateDelayedEvents stores events which should be delivered at a later time.
+	CreateDelayedEvents(ctx context.Context, tenantId uuid.UUID, opts []CreateDelayedEventOpts) error
+
+	// PollDelayedEvents leases up to limit delayed events which are due for delivery, across all
+	// tenants. Delivered events must be deleted with DeleteDelayedEvents before the lease expires.
+	PollDelayedEvents(ctx context.Context, limit int) ([]*sqlcv1.V1DelayedEvent, error)
+
+	// DeleteDelayedEvents deletes delayed events after they were delivered.
+	DeleteDelayedEvents(ctx context.Context, ids []int64) error
+}
+
+type delayedEventRepository struct {
+	*sharedRepository
+}
+
+func newDelayedEventRepository(shared *sharedRepository) DelayedEventRepository {
+	return &delayedEventRepository{
+		sharedRepository: shared,
+	}
+}
+
+func (r *delayedEventRepository) CreateDelayedEvents(ctx context.Context, tenantId uuid.UUID, opts []CreateDelayedEventOpts) error {
+	if len(opts) == 0 {
+		return nil
+	}
+
+	params := sqlcv1.CreateDelayedEventsParams{
+		Tenantid:            tenantId,
+		Externalids:         make([]uuid.UUID, len(opts)),
+		Keys:                make([]string, len(opts)),
+		Datas:               make([][]byte, len(opts)),
+		Additionalmetadatas: make([][]byte, len(opts)),
+		Priorities:          make([]int32, len(opts)),
+		Scopes:              make([]string, len(opts)),
+		Deliverats:          make([]pgtype.Timestamptz, len(opts)),
+	}
+
+	for i, opt := range opts {
+		if err := r.v.Validate(opt); err != nil {
+			return fmt.Errorf("invalid delayed event: %w", err)
+		}
+
+		params.Externalids[i] = opt.ExternalId
+		params.Keys[i] = opt.Key
+		params.Datas[i] = opt.Data
+		params.Additionalmetadatas[i] = opt.AdditionalMetadata
+		params.Deliverats[i] = sqlchelpers.TimestamptzFromTime(opt.DeliverAt)
+
+		if opt.Priority != nil {
+			params.Priorities[i] = *opt.Priority
+		}
+
+		if opt.Scope != nil {
+			params.Scopes[i] = *opt.Scope
+		}
+	}
+
+	return r.queries.CreateDelayedEvents(ctx, r.pool, params)
+}
+
+func (r *delayedEventRepository) PollDelayedEvents(ctx context.Context, limit int) ([]*sqlcv1.V1DelayedEvent, error) {
+	return r.queries.PollDelayedEvents(ctx, r.pool, int32(limit)) // nolint: gosec
+}
+
+func (r *delayedEventRepository) DeleteDelayedEvents(ctx context.Context, ids []int64) error {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	return r.queries.DeleteDelayedEvents(ctx, r.pool, ids)
+}
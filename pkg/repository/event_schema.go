@@ -0,0 +1,146 @@
+package repository
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/santhosh-tekuri/jsonschema/v6"
+
+	"github.com/hatchet-dev/hatchet/pkg/repository/sqlcv1"
+	"github.com/hatchet-dev/hatchet/pkg/telemetry"
+)
+
+// ErrInvalidEventSchema is returned when registering a document which isn't a valid JSON Schema.
+var ErrInvalidEventSchema = errors.New("invalid event schema")
+
+type RegisterEventSchemaOpts struct {
+	// EventKey is the event key the schema applies to. It may contain `*` wildcards, which match
+	// any sequence of characters, to apply the schema to several event keys.
+	EventKey string `validate:"required,max=255"`
+
+	// Schema is the JSON Schema document event payloads are validated against.
+	Schema []byte `validate:"required"`
+
+	// Mode determines whether events which fail validation are rejected, or only recorded.
+	Mode sqlcv1.V1EventSchemaMode `validate:"oneof=REJECT WARN"`
+}
+
+type EventSchemaRepository interface {
+	// RegisterEventSchema registers a schema as the next version for its event key, which makes it
+	// the active schema for the key.
+	RegisterEventSchema(ctx context.Context, tenantId uuid.UUID, opts RegisterEventSchemaOpts) (*sqlcv1.V1EventSchema, error)
+
+	// ListEventSchemas lists the active version of the schema for each event key in the tenant.
+	ListEventSchemas(ctx context.Context, tenantId uuid.UUID) ([]*sqlcv1.V1EventSchema, error)
+
+	// ListEventSchemaVersions lists every version of the schema for an event key, latest first.
+	ListEventSchemaVersions(ctx context.Context, tenantId uuid.UUID, eventKey string) ([]*sqlcv1.V1EventSchema, error)
+
+	// DeleteEventSchema deletes every version of the schema for an event key. It returns
+	// pgx.ErrNoRows if there is no schema for the key.
+	DeleteEventSchema(ctx context.Context, tenantId uuid.UUID, eventKey string) error
+}
+
+type eventSchemaRepository struct {
+	*sharedRepository
+}
+
+func newEventSchemaRepository(shared *sharedRepository) EventSchemaRepository {
+	return &eventSchemaRepository{
+		sharedRepository: shared,
+	}
+}
+
+// CompileEventSchema compiles a JSON Schema document so that payloads can be validated against it.
+// Documents which don't declare a draft with `$schema` are compiled as draft 2020-12.
+func CompileEventSchema(schema []byte) (*jsonschema.Schema, error) {
+	doc, err := jsonschema.UnmarshalJSON(bytes.NewReader(schema))
+
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidEventSchema, err)
+	}
+
+	const url = "event-schema.json"
+
+	c := jsonschema.NewCompiler()
+	c.UseLoader(noExternalRefsLoader{})
+
+	if err := c.AddResource(url, doc); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidEventSchema, err)
+	}
+
+	compiled, err := c.Compile(url)
+
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidEventSchema, err)
+	}
+
+	return compiled, nil
+}
+
+// noExternalRefsLoader refuses to load any referenced document, so that a schema can't read files
+// from the engine host or make requests to other servers. The standard metaschemas are built into
+// the compiler and don't go through the loader.
+type noExternalRefsLoader struct{}
+
+func (noExternalRefsLoader) Load(url string) (any, error) {
+	return nil, fmt.Errorf("external references are not allowed: %s", url)
+}
+
+func (r *eventSchemaRepository) RegisterEventSchema(ctx context.Context, tenantId uuid.UUID, opts RegisterEventSchemaOpts) (*sqlcv1.V1EventSchema, error) {
+	ctx, span := telemetry.NewSpan(ctx, "register-event-schema")
+	defer span.End()
+
+	if err := r.v.Validate(opts); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidEventSchema, err)
+	}
+
+	if _, err := CompileEventSchema(opts.Schema); err != nil {
+		return nil, err
+	}
+
+	schema, err := r.queries.CreateEventSchema(ctx, r.pool, sqlcv1.CreateEventSchemaParams{
+		Tenantid: tenantId,
+		Eventkey: opts.EventKey,
+		Schema:   opts.Schema,
+		Mode:     opts.Mode,
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to create event schema: %w", err)
+	}
+
+	return schema, nil
+}
+
+func (r *eventSchemaRepository) ListEventSchemas(ctx context.Context, tenantId uuid.UUID) ([]*sqlcv1.V1EventSchema, error) {
+	return r.queries.ListLatestEventSchemas(ctx, r.pool, tenantId)
+}
+
+func (r *eventSchemaRepository) ListEventSchemaVersions(ctx context.Context, tenantId uuid.UUID, eventKey string) ([]*sqlcv1.V1EventSchema, error) {
+	return r.queries.ListEventSchemaVersions(ctx, r.pool, sqlcv1.ListEventSchemaVersionsParams{
+		Tenantid: tenantId,
+		Eventkey: eventKey,
+	})
+}
+
+func (r *eventSchemaRepository) DeleteEventSchema(ctx context.Context, tenantId uuid.UUID, eventKey string) error {
+	deleted, err := r.queries.DeleteEventSchema(ctx, r.pool, sqlcv1.DeleteEventSchemaParams{
+		Tenantid: tenantId,
+		Eventkey: eventKey,
+	})
+
+	if err != nil {
+		return fmt.Errorf("failed to delete event schema: %w", err)
+	}
+
+	if deleted == 0 {
+		return pgx.ErrNoRows
+	}
+
+	return nil
+}
@@ -12,6 +12,7 @@ import (
 
 	"github.com/hatchet-dev/hatchet/pkg/repository/sqlchelpers"
 	"github.com/hatchet-dev/hatchet/pkg/repository/sqlcv1"
+	"github.com/hatchet-dev/hatchet/pkg/validator"
 )
 
 type WasSuccessfullyClaimed bool
@@ -26,6 +27,14 @@ type ClaimIdempotencyKeysOpt struct {
 type IdempotencyRepository interface {
 	EvictExpiredIdempotencyKeys(context context.Context, tenantId uuid.UUID) error
 	ClaimKey(ctx context.Context, tenantId uuid.UUID, key string, expiresAt time.Time, claimedByExternalId uuid.UUID) (bool, error)
+
+	// ClaimKeys claims a batch of keys, and returns the external id which holds each key. A key was
+	// claimed successfully if it's held by the requested external id. If a key is requested more
+	// than once, all of its requests are given the same holder.
+	ClaimKeys(ctx context.Context, tenantId uuid.UUID, opts []ClaimIdempotencyKeysOpt) (map[IdempotencyKey]uuid.UUID, error)
+
+	// ReleaseKeys releases keys which are still held by the external ids they were claimed by.
+	ReleaseKeys(ctx context.Context, tenantId uuid.UUID, opts []ClaimIdempotencyKeysOpt) error
 }
 
 type idempotencyRepository struct {
@@ -45,6 +54,7 @@ func NewIdempotencyRepository(pool *pgxpool.Pool) IdempotencyRepository {
 		ddlPool: pool,
 		l:       &logger,
 		queries: sqlcv1.New(),
+		v:       validator.NewDefaultValidator(),
 	}
 	return newIdempotencyRepository(shared)
 }
@@ -76,6 +86,65 @@ func (r *idempotencyRepository) ClaimKey(ctx context.Context, tenantId uuid.UUID
 	return results[0].WasSuccessfullyClaimed, nil
 }
 
+func (r *idempotencyRepository) ClaimKeys(ctx context.Context, tenantId uuid.UUID, opts []ClaimIdempotencyKeysOpt) (map[IdempotencyKey]uuid.UUID, error) {
+	res := make(map[IdempotencyKey]uuid.UUID, len(opts))
+
+	if len(opts) == 0 {
+		return res, nil
+	}
+
+	params := sqlcv1.ClaimIdempotencyKeysParams{
+		Keys:                 make([]string, len(opts)),
+		Expiresats:           make([]pgtype.Timestamptz, len(opts)),
+		Claimedbyexternalids: make([]uuid.UUID, len(opts)),
+		Tenantid:             tenantId,
+	}
+
+	for i, opt := range opts {
+		if err := r.v.Validate(opt); err != nil {
+			return nil, fmt.Errorf("invalid idempotency key claim: %w", err)
+		}
+
+		params.Keys[i] = opt.Key
+		params.Expiresats[i] = opt.ExpiresAt
+		params.Claimedbyexternalids[i] = opt.ClaimedByExternalId
+	}
+
+	results, err := r.queries.ClaimIdempotencyKeys(ctx, r.pool, params)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, result := range results {
+		if result.ClaimedByExternalID != nil {
+			res[IdempotencyKey(result.Key)] = *result.ClaimedByExternalID
+		}
+	}
+
+	return res, nil
+}
+
+func (r *idempotencyRepository) ReleaseKeys(ctx context.Context, tenantId uuid.UUID, opts []ClaimIdempotencyKeysOpt) error {
+	if len(opts) == 0 {
+		return nil
+	}
+
+	keys := make([]string, len(opts))
+	claimedByExternalIds := make([]uuid.UUID, len(opts))
+
+	for i, opt := range opts {
+		keys[i] = opt.Key
+		claimedByExternalIds[i] = opt.ClaimedByExternalId
+	}
+
+	return r.queries.ReleaseClaimedIdempotencyKeys(ctx, r.pool, sqlcv1.ReleaseClaimedIdempotencyKeysParams{
+		Tenantid:             tenantId,
+		Keys:                 keys,
+		Claimedbyexternalids: claimedByExternalIds,
+	})
+}
+
 type IdempotencyCollision struct {
 	RequestedExternalId uuid.UUID
 	ExistingExternalId  uuid.UUID
@@ -18,6 +18,9 @@ type TaskInput struct {
 	// task run external IDs of parent tasks in a durable DAG orchestration
 	DagParentTaskRunIds []uuid.UUID `json:"dag_parent_task_run_ids,omitempty"`
 
+	// readable ids of the map steps among the DAG parents, see TriggerTaskData
+	DagMapParentReadableIds []string `json:"dag_map_parent_readable_ids,omitempty"`
+
 	// run-level desired worker labels, carried on the DAG orchestrator task so it can
 	// propagate them to the child steps it triggers (child steps route to the same worker pool)
 	DesiredWorkerLabels []*sqlcv1.GetDesiredLabelsRow `json:"desired_worker_labels,omitempty"`
@@ -47,7 +50,7 @@ func (s *sharedRepository) newTaskInputFromExistingBytes(inputBytes []byte) *Tas
 	return i
 }
 
-func (s *sharedRepository) newTaskInput(inputBytes []byte, triggerData *MatchData, filterPayload []byte, dagParentTaskRunIds []uuid.UUID) *TaskInput {
+func (s *sharedRepository) newTaskInput(inputBytes []byte, triggerData *MatchData, filterPayload []byte, dagParentTaskRunIds []uuid.UUID, dagMapParentReadableIds []string) *TaskInput {
 	var input map[string]interface{}
 
 	if len(inputBytes) > 0 {
@@ -67,10 +70,11 @@ func (s *sharedRepository) newTaskInput(inputBytes []byte, triggerData *MatchDat
 	}
 
 	return &TaskInput{
-		Input:               input,
-		TriggerData:         triggerData,
-		FilterPayload:       filterPayloadMap,
-		DagParentTaskRunIds: dagParentTaskRunIds,
+		Input:                   input,
+		TriggerData:             triggerData,
+		FilterPayload:           filterPayloadMap,
+		DagParentTaskRunIds:     dagParentTaskRunIds,
+		DagMapParentReadableIds: dagMapParentReadableIds,
 	}
 }
 
@@ -127,13 +131,14 @@ func (s *sharedRepository) ToV1StepRunData(t *TaskInput) *V1StepRunData {
 	triggers["filter_payload"] = t.FilterPayload
 
 	return &V1StepRunData{
-		Input:               t.Input,
-		TriggeredBy:         "manual",
-		Parents:             parents,
-		Triggers:            triggers,
-		StepRunErrors:       stepRunErrors,
-		DagParentTaskRunIds: t.DagParentTaskRunIds,
-		DesiredWorkerLabels: t.DesiredWorkerLabels,
+		Input:                   t.Input,
+		TriggeredBy:             "manual",
+		Parents:                 parents,
+		Triggers:                triggers,
+		StepRunErrors:           stepRunErrors,
+		DagParentTaskRunIds:     t.DagParentTaskRunIds,
+		DagMapParentReadableIds: t.DagMapParentReadableIds,
+		DesiredWorkerLabels:     t.DesiredWorkerLabels,
 	}
 }
 
@@ -172,6 +177,9 @@ type V1StepRunData struct {
 	// task run external IDs of parent tasks in a durable DAG orchestration
 	DagParentTaskRunIds []uuid.UUID `json:"dag_parent_task_run_ids,omitempty"`
 
+	// readable ids of the map steps among the DAG parents, see TriggerTaskData
+	DagMapParentReadableIds []string `json:"dag_map_parent_readable_ids,omitempty"`
+
 	// run-level desired worker labels, carried on the DAG orchestrator task so it can
 	// propagate them to the child steps it triggers
 	DesiredWorkerLabels []*sqlcv1.GetDesiredLabelsRow `json:"desired_worker_labels,omitempty"`
@@ -2,6 +2,7 @@ package repository
 
 import (
 	"context"
+	"sort"
 	"time"
 
 	"github.com/google/uuid"
@@ -44,6 +45,32 @@ type ListLogsOpts struct {
 
 	// (optional) a list of step ids to filter by
 	StepIds []uuid.UUID
+
+	// (optional) a list of worker ids to filter by
+	WorkerIds []uuid.UUID
+
+	// (optional) a full-text query, which supports quoted phrases, OR and negation with -
+	Query *string
+
+	// (optional) metadata fields the log line must have, keyed by dot-separated paths. Values are
+	// compared as text.
+	Metadata map[string]string
+}
+
+// TailLogsOpts lists the log lines written after a previous one.
+type TailLogsOpts struct {
+	// the filters of the lines, of which the pagination and ordering are ignored
+	*ListLogsOpts
+
+	// the id of the last log line which was listed
+	AfterId int64
+
+	// lines created before this time aren't listed, which bounds the lines which are scanned. It
+	// should be at least MaxLogLineClockSkew before now, since created_at is set by the client.
+	Since time.Time `validate:"required"`
+
+	// the maximum number of lines to list
+	Limit int `validate:"min=1,max=10000"`
 }
 
 type CreateLogLineOpts struct {
@@ -53,7 +80,8 @@ type CreateLogLineOpts struct {
 
 	TaskInsertedAt pgtype.Timestamptz
 
-	// (optional) The time when the log line was created.
+	// (optional) The time when the log line was created, defaults to now. It's clamped to within
+	// MaxLogLineClockSkew of now.
 	CreatedAt *time.Time
 
 	// (required) The message of the log line.
@@ -73,6 +101,9 @@ type CreateLogLineOpts struct {
 
 	// the step id associated with the log line, used for partitioning logs
 	StepId uuid.UUID
+
+	// (optional) the worker which wrote the log line
+	WorkerId *uuid.UUID
 }
 
 type ListLogLineRow struct {
@@ -95,8 +126,22 @@ type GetLogLinePointMetricsOpts struct {
 type LogLineRepository interface {
 	ListLogLines(ctx context.Context, tenantId uuid.UUID, opts *ListLogsOpts) ([]*ListLogLineRow, error)
 
+	// TailLogLines lists the log lines with ids above opts.AfterId, in id order. Ids are assigned when
+	// lines are inserted, so a line can commit after lines with higher ids, and callers should read
+	// again from an earlier id to pick it up.
+	TailLogLines(ctx context.Context, tenantId uuid.UUID, opts *TailLogsOpts) ([]*ListLogLineRow, error)
+
+	// GetLatestLogLineId returns the id of the last log line created after since, which tailing
+	// starts from, or 0 if there is none.
+	GetLatestLogLineId(ctx context.Context, tenantId uuid.UUID, since time.Time) (int64, error)
+
+	// PutLog writes a single log line. Like PutLogs, it clamps the line's created time to within
+	// MaxLogLineClockSkew of now.
 	PutLog(ctx context.Context, tenantId uuid.UUID, opts *CreateLogLineOpts) error
 
+	// PutLogs writes a batch of log lines in a single statement.
+	PutLogs(ctx context.Context, tenantId uuid.UUID, opts []*CreateLogLineOpts) error
+
 	GetLogLinePointMetrics(ctx context.Context, tenantId uuid.UUID, opts *GetLogLinePointMetricsOpts) ([]*sqlcv1.GetLogLinePointMetricsRow, error)
 }
 
@@ -120,12 +165,19 @@ func (r *logLineRepositoryImpl) ListLogLines(ctx context.Context, tenantId uuid.
 		Orderbydirection: "ASC",
 		WorkflowIds:      opts.WorkflowIds,
 		StepIds:          opts.StepIds,
+		WorkerIds:        opts.WorkerIds,
 	}
 
 	if opts.Search != nil {
 		queryParams.Search = sqlchelpers.TextFromStr(*opts.Search)
 	}
 
+	if opts.Query != nil {
+		queryParams.Query = sqlchelpers.TextFromStr(*opts.Query)
+	}
+
+	queryParams.MetadataKeys, queryParams.MetadataValues = logMetadataFilters(opts.Metadata)
+
 	if opts.Limit != nil {
 		queryParams.Limit = pgtype.Int8{
 			Int64: int64(*opts.Limit),
@@ -187,6 +239,111 @@ func (r *logLineRepositoryImpl) ListLogLines(ctx context.Context, tenantId uuid.
 		return nil, err
 	}
 
+	return r.toListLogLineRows(ctx, tenantId, logLines)
+}
+
+func (r *logLineRepositoryImpl) TailLogLines(ctx context.Context, tenantId uuid.UUID, opts *TailLogsOpts) ([]*ListLogLineRow, error) {
+	if err := r.v.Validate(opts); err != nil {
+		return nil, err
+	}
+
+	filters := opts.ListLogsOpts
+
+	if filters == nil {
+		filters = &ListLogsOpts{}
+	}
+
+	queryParams := sqlcv1.ListLogLinesAfterIdParams{
+		Tenantid:    tenantId,
+		Afterid:     opts.AfterId,
+		Since:       sqlchelpers.TimestamptzFromTime(opts.Since),
+		WorkflowIds: filters.WorkflowIds,
+		StepIds:     filters.StepIds,
+		WorkerIds:   filters.WorkerIds,
+		Limit:       int64(opts.Limit),
+	}
+
+	if filters.Search != nil {
+		queryParams.Search = sqlchelpers.TextFromStr(*filters.Search)
+	}
+
+	if filters.Query != nil {
+		queryParams.Query = sqlchelpers.TextFromStr(*filters.Query)
+	}
+
+	if filters.Levels != nil {
+		levels := make([]sqlcv1.V1LogLineLevel, len(filters.Levels))
+		for i, level := range filters.Levels {
+			levels[i] = sqlcv1.V1LogLineLevel(level)
+		}
+
+		queryParams.Levels = levels
+	}
+
+	queryParams.MetadataKeys, queryParams.MetadataValues = logMetadataFilters(filters.Metadata)
+
+	if len(filters.TaskExternalIds) > 0 {
+		internalIds, err := r.resolveTaskExternalIds(ctx, tenantId, filters.TaskExternalIds)
+		if err != nil {
+			return nil, err
+		}
+		queryParams.TaskIds = internalIds
+	}
+
+	logLines, err := r.queries.ListLogLinesAfterId(ctx, r.pool, queryParams)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.toListLogLineRows(ctx, tenantId, logLines)
+	if err != nil {
+		return nil, err
+	}
+
+	// lines whose task was deleted are kept without it, so the caller's cursor moves past them
+	for i, row := range rows {
+		if row == nil {
+			rows[i] = &ListLogLineRow{
+				V1LogLine: logLines[i],
+			}
+		}
+	}
+
+	return rows, nil
+}
+
+func (r *logLineRepositoryImpl) GetLatestLogLineId(ctx context.Context, tenantId uuid.UUID, since time.Time) (int64, error) {
+	return r.queries.GetLatestLogLineId(ctx, r.pool, sqlcv1.GetLatestLogLineIdParams{
+		Tenantid: tenantId,
+		Since:    sqlchelpers.TimestamptzFromTime(since),
+	})
+}
+
+// logMetadataFilters splits metadata filters into keys and values in a stable order, or returns
+// nil slices if there are none.
+func logMetadataFilters(metadata map[string]string) ([]string, []string) {
+	if len(metadata) == 0 {
+		return nil, nil
+	}
+
+	keys := make([]string, 0, len(metadata))
+
+	for key := range metadata {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	values := make([]string, len(keys))
+
+	for i, key := range keys {
+		values[i] = metadata[key]
+	}
+
+	return keys, values
+}
+
+func (r *logLineRepositoryImpl) toListLogLineRows(ctx context.Context, tenantId uuid.UUID, logLines []*sqlcv1.V1LogLine) ([]*ListLogLineRow, error) {
 	// gather unique task ids, inserted ats to look up associated task external ids
 	uniqueTaskIds := make(map[int64]struct{})
 	for _, logLine := range logLines {
@@ -231,36 +388,72 @@ func (r *logLineRepositoryImpl) ListLogLines(ctx context.Context, tenantId uuid.
 	return res, nil
 }
 
+// MaxLogLineClockSkew is how far a client-reported log timestamp may drift from the server time
+// before it is clamped.
+const MaxLogLineClockSkew = 5 * time.Minute
+
+// clampLogLineCreatedAt keeps a client-reported timestamp within MaxLogLineClockSkew of now, so a
+// worker with a bad clock can't write lines far into the past or future. Tailing relies on this to
+// bound the lines it scans by created_at.
+func clampLogLineCreatedAt(createdAt, now time.Time) time.Time {
+	if createdAt.Before(now.Add(-MaxLogLineClockSkew)) {
+		return now.Add(-MaxLogLineClockSkew)
+	}
+
+	if createdAt.After(now.Add(MaxLogLineClockSkew)) {
+		return now.Add(MaxLogLineClockSkew)
+	}
+
+	return createdAt
+}
+
 func (r *logLineRepositoryImpl) PutLog(ctx context.Context, tenantId uuid.UUID, opts *CreateLogLineOpts) error {
-	if err := r.v.Validate(opts); err != nil {
-		return err
-	}
-
-	var level sqlcv1.V1LogLineLevel
-
-	if opts.Level == nil {
-		level = sqlcv1.V1LogLineLevel("INFO")
-	} else {
-		level = sqlcv1.V1LogLineLevel(*opts.Level)
-	}
-
-	_, err := r.queries.InsertLogLine(
-		ctx,
-		r.pool,
-		[]sqlcv1.InsertLogLineParams{
-			{
-				TenantID:       tenantId,
-				TaskID:         opts.TaskId,
-				TaskInsertedAt: opts.TaskInsertedAt,
-				Message:        opts.Message,
-				RetryCount:     int32(opts.RetryCount), // #nosec G115 -- retry count is engine-bounded, never near int32 range
-				Level:          level,
-				Metadata:       opts.Metadata,
-				WorkflowID:     &opts.WorkflowId,
-				StepID:         &opts.StepId,
-			},
-		},
-	)
+	return r.PutLogs(ctx, tenantId, []*CreateLogLineOpts{opts})
+}
+
+func (r *logLineRepositoryImpl) PutLogs(ctx context.Context, tenantId uuid.UUID, opts []*CreateLogLineOpts) error {
+	if len(opts) == 0 {
+		return nil
+	}
+
+	params := make([]sqlcv1.InsertLogLineParams, len(opts))
+	now := time.Now()
+
+	for i, opt := range opts {
+		if err := r.v.Validate(opt); err != nil {
+			return err
+		}
+
+		level := sqlcv1.V1LogLineLevel("INFO")
+
+		if opt.Level != nil {
+			level = sqlcv1.V1LogLineLevel(*opt.Level)
+		}
+
+		// lines are written in batches, so the time they were created at is kept rather than the
+		// time of the insert
+		createdAt := now
+
+		if opt.CreatedAt != nil {
+			createdAt = clampLogLineCreatedAt(*opt.CreatedAt, now)
+		}
+
+		params[i] = sqlcv1.InsertLogLineParams{
+			TenantID:       tenantId,
+			TaskID:         opt.TaskId,
+			TaskInsertedAt: opt.TaskInsertedAt,
+			Message:        opt.Message,
+			RetryCount:     int32(opt.RetryCount), // #nosec G115 -- retry count is engine-bounded, never near int32 range
+			Level:          level,
+			Metadata:       opt.Metadata,
+			WorkflowID:     &opt.WorkflowId,
+			StepID:         &opt.StepId,
+			WorkerID:       opt.WorkerId,
+			CreatedAt:      sqlchelpers.TimestamptzFromTime(createdAt),
+		}
+	}
+
+	_, err := r.queries.InsertLogLine(ctx, r.pool, params)
 
 	return err
 }
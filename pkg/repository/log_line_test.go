@@ -0,0 +1,31 @@
+//go:build !e2e && !load && !rampup && !integration
+
+package repository
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClampLogLineCreatedAt(t *testing.T) {
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name      string
+		createdAt time.Time
+		want      time.Time
+	}{
+		{"within skew", now.Add(-time.Minute), now.Add(-time.Minute)},
+		{"far in the past", now.Add(-24 * time.Hour), now.Add(-MaxLogLineClockSkew)},
+		{"far in the future", now.Add(24 * time.Hour), now.Add(MaxLogLineClockSkew)},
+		{"at the boundary", now.Add(MaxLogLineClockSkew), now.Add(MaxLogLineClockSkew)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, clampLogLineCreatedAt(tt.createdAt, now))
+		})
+	}
+}
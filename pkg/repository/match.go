@@ -3,6 +3,7 @@ package repository
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"slices"
 	"time"
@@ -143,6 +144,9 @@ type EventMatchResults struct {
 
 	// The list of satisfied durable event log entries from matches
 	SatisfiedDurableEventLogEntries []SatisfiedEntry
+
+	// The number of match conditions which passed their expressions
+	MatchedConditions int
 }
 
 type GroupMatchCondition struct {
@@ -179,13 +183,44 @@ type SatisfiedEntry struct {
 	DurableTaskExternalId uuid.UUID
 }
 
+// ErrSignalRunNotFound is returned when a signal targets a run which doesn't exist.
+var ErrSignalRunNotFound = errors.New("run not found")
+
+type SignalRunOpts struct {
+	// The external id of the workflow run or task to signal
+	RunExternalId uuid.UUID `validate:"required"`
+
+	// The name of the signal, which is matched against the event key of the run's waiting conditions
+	Name string `validate:"required"`
+
+	// A unique id for the signal, which is passed to the conditions as the id of the event
+	SignalId uuid.UUID `validate:"required"`
+
+	// The payload of the signal
+	Data []byte
+}
+
+type SignalRunResult struct {
+	*EventMatchResults
+
+	// The number of unsatisfied conditions for the signal which the run was waiting on
+	WaitingConditions int
+}
+
 type MatchRepository interface {
 	RegisterSignalMatchConditions(ctx context.Context, tenantId uuid.UUID, eventMatches []ExternalCreateSignalMatchOpts) error
 
 	ProcessUserEventMatches(ctx context.Context, tenantId uuid.UUID, events []CandidateEventMatch) (*EventMatchResults, error)
 	ProcessInternalEventMatches(ctx context.Context, tenantId uuid.UUID, events []CandidateEventMatch) (*EventMatchResults, error)
 
+	// SignalRun delivers a signal to the user event conditions a single run is waiting on, regardless
+	// of their scope. It returns ErrSignalRunNotFound if the run doesn't exist.
+	SignalRun(ctx context.Context, tenantId uuid.UUID, opts SignalRunOpts) (*SignalRunResult, error)
+
 	EvalBoolExpr(ctx context.Context, expr string, vars map[string]interface{}) (bool, error)
+
+	// EvalListExpr evaluates a CEL expression in the step run environment which returns a list
+	EvalListExpr(ctx context.Context, expr string, vars map[string]interface{}) ([]interface{}, error)
 }
 
 type MatchRepositoryImpl struct {
@@ -202,6 +237,10 @@ func (r *sharedRepository) EvalBoolExpr(ctx context.Context, expr string, vars m
 	return r.boolExprEvaluator.EvalBoolExpr(ctx, expr, vars)
 }
 
+func (r *sharedRepository) EvalListExpr(ctx context.Context, expr string, vars map[string]interface{}) ([]interface{}, error) {
+	return r.celParser.EvaluateStepRunList(expr, vars)
+}
+
 func (r *sharedRepository) registerSignalMatchConditions(ctx context.Context, tx sqlcv1.DBTX, tenantId uuid.UUID, signalMatches []ExternalCreateSignalMatchOpts) error {
 	eventMatches := make([]CreateMatchOpts, 0, len(signalMatches))
 
@@ -379,6 +418,90 @@ func (m *MatchRepositoryImpl) ProcessUserEventMatches(ctx context.Context, tenan
 	return res, nil
 }
 
+func (m *MatchRepositoryImpl) SignalRun(ctx context.Context, tenantId uuid.UUID, opts SignalRunOpts) (*SignalRunResult, error) {
+	if err := m.v.Validate(opts); err != nil {
+		return nil, err
+	}
+
+	tx, commit, rollback, err := sqlchelpers.PrepareTx(ctx, m.pool, m.l)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer rollback()
+
+	lookups, err := m.queries.LookupExternalIds(ctx, tx, sqlcv1.LookupExternalIdsParams{
+		Externalids: []uuid.UUID{opts.RunExternalId},
+		Tenantid:    tenantId,
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to look up run: %w", err)
+	}
+
+	if len(lookups) == 0 {
+		return nil, ErrSignalRunNotFound
+	}
+
+	conditions, err := m.queries.ListMatchConditionsForRunSignal(ctx, tx, sqlcv1.ListMatchConditionsForRunSignalParams{
+		Tenantid:   tenantId,
+		Eventkey:   opts.Name,
+		Taskid:     lookups[0].TaskID,
+		Dagid:      lookups[0].DagID,
+		Insertedat: lookups[0].InsertedAt,
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to list match conditions for run signal: %w", err)
+	}
+
+	res := &SignalRunResult{
+		EventMatchResults: &EventMatchResults{},
+		WaitingConditions: len(conditions),
+	}
+
+	if len(conditions) == 0 {
+		return res, nil
+	}
+
+	res.EventMatchResults, err = m.processMatchConditions(ctx, tx, tenantId, []CandidateEventMatch{{
+		ID:             opts.SignalId,
+		EventTimestamp: m.clock.Now(),
+		Key:            opts.Name,
+		Data:           opts.Data,
+	}}, conditions, sqlcv1.V1EventTypeUSER)
+
+	if err != nil {
+		return nil, err
+	}
+
+	storePayloadOpts := make([]StorePayloadOpts, len(res.CreatedTasks))
+
+	for i, task := range res.CreatedTasks {
+		storePayloadOpts[i] = StorePayloadOpts{
+			Id:         task.ID,
+			InsertedAt: task.InsertedAt,
+			ExternalId: task.ExternalID,
+			Type:       sqlcv1.V1PayloadTypeTASKINPUT,
+			Payload:    task.Payload,
+			TenantId:   task.TenantID,
+		}
+	}
+
+	if len(storePayloadOpts) > 0 {
+		if err := m.payloadStore.Store(ctx, tx, storePayloadOpts...); err != nil {
+			return nil, fmt.Errorf("failed to store payloads for created tasks for run signal: %w", err)
+		}
+	}
+
+	if err := commit(ctx); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
 type DurableTaskNodeIdKey struct {
 	DurableTaskInsertedAt time.Time
 	DurableTaskId         int64
@@ -391,10 +514,6 @@ func (m *sharedRepository) processEventMatches(ctx context.Context, tx sqlcv1.DB
 }
 
 func (m *sharedRepository) processEventMatchesForTarget(ctx context.Context, tx sqlcv1.DBTX, tenantId uuid.UUID, events []CandidateEventMatch, eventType sqlcv1.V1EventType, targetMatchID *int64) (*EventMatchResults, error) {
-	start := time.Now()
-
-	res := &EventMatchResults{}
-
 	eventKeysWithHints := make([]string, 0, len(events))
 	eventKeysWithoutHints := make([]string, 0, len(events))
 	resourceHints := make([]string, 0, len(events))
@@ -475,6 +594,22 @@ func (m *sharedRepository) processEventMatchesForTarget(ctx context.Context, tx
 		})
 	}
 
+	return m.processMatchConditions(ctx, tx, tenantId, events, matchConditions, eventType)
+}
+
+// processMatchConditions satisfies the given match conditions with the events which pass their
+// expressions, and creates, replays or signals the tasks of any matches which are now satisfied.
+func (m *sharedRepository) processMatchConditions(ctx context.Context, tx sqlcv1.DBTX, tenantId uuid.UUID, events []CandidateEventMatch, matchConditions []*sqlcv1.ListMatchConditionsForEventRow, eventType sqlcv1.V1EventType) (*EventMatchResults, error) {
+	start := time.Now()
+
+	res := &EventMatchResults{}
+
+	idsToEvents := make(map[uuid.UUID]CandidateEventMatch, len(events))
+
+	for _, event := range events {
+		idsToEvents[event.ID] = event
+	}
+
 	// pass match conditions through CEL expressions parser
 	matches, err := m.processCELExpressions(ctx, events, matchConditions, eventType)
 
@@ -528,6 +663,8 @@ func (m *sharedRepository) processEventMatchesForTarget(ctx context.Context, tx
 		}
 	}
 
+	res.MatchedConditions = len(conditionIds)
+
 	// update condition rows in the database
 	satisfiedMatchIds, err := m.queries.GetSatisfiedMatchConditions(
 		ctx,
@@ -665,7 +802,7 @@ func (m *sharedRepository) processEventMatchesForTarget(ctx context.Context, tx
 
 					switch matchData.Action() {
 					case sqlcv1.V1MatchConditionActionQUEUE:
-						opt.Input = m.newTaskInput(input, matchData, nil, nil)
+						opt.Input = m.newTaskInput(input, matchData, nil, nil, nil)
 						opt.InitialState = sqlcv1.V1TaskInitialStateQUEUED
 					case sqlcv1.V1MatchConditionActionCANCEL:
 						opt.InitialState = sqlcv1.V1TaskInitialStateCANCELLED
@@ -687,7 +824,7 @@ func (m *sharedRepository) processEventMatchesForTarget(ctx context.Context, tx
 
 					switch matchData.Action() {
 					case sqlcv1.V1MatchConditionActionQUEUE:
-						opt.Input = m.newTaskInput(input, matchData, nil, nil)
+						opt.Input = m.newTaskInput(input, matchData, nil, nil, nil)
 						opt.DesiredWorkerId = m.DesiredWorkerId(opt.Input)
 						opt.InitialState = sqlcv1.V1TaskInitialStateQUEUED
 					case sqlcv1.V1MatchConditionActionCANCEL:
@@ -1503,6 +1640,7 @@ func (m *sharedRepository) durableSleepCondition(ctx context.Context, tx sqlcv1.
 	sleep, err := m.queries.CreateDurableSleep(ctx, tx, sqlcv1.CreateDurableSleepParams{
 		TenantID:       tenantId,
 		SleepDurations: []string{sleepDuration},
+		Now:            sqlchelpers.TimestamptzFromTime(m.clock.Now()),
 	})
 
 	if err != nil {
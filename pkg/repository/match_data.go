@@ -159,3 +159,28 @@ func NewMatchData(mcAggregatedData []byte) (*MatchData, error) {
 
 	return nil, fmt.Errorf("no match condition aggregated data")
 }
+
+// newParentOutputsMatchData returns match data in which the given parents, keyed by step readable
+// id, completed with the given outputs. It returns nil if there are no outputs.
+func newParentOutputsMatchData(outputs map[string][]byte) *MatchData {
+	if len(outputs) == 0 {
+		return nil
+	}
+
+	dataKeys := make(map[string][]interface{}, len(outputs))
+
+	for readableId, output := range outputs {
+		dataKeys[readableId] = []interface{}{
+			&TaskOutputEvent{
+				EventType:      sqlcv1.V1TaskEventTypeCOMPLETED,
+				Output:         output,
+				StepReadableID: readableId,
+			},
+		}
+	}
+
+	return &MatchData{
+		action:   sqlcv1.V1MatchConditionActionQUEUE,
+		dataKeys: dataKeys,
+	}
+}
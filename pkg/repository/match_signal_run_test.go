@@ -0,0 +1,107 @@
+//go:build !e2e && !load && !rampup && !integration
+
+package repository
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hatchet-dev/hatchet/pkg/repository/sqlcv1"
+)
+
+func insertSignalRunTestLookup(t *testing.T, ctx context.Context, repos userEventScopeTestRepositories, tenantID uuid.UUID, task *sqlcv1.FlattenExternalIdsRow) {
+	t.Helper()
+
+	_, err := repos.shared.pool.Exec(ctx, `
+		INSERT INTO v1_lookup_table (tenant_id, external_id, task_id, inserted_at)
+		VALUES ($1, $2, $3, $4)
+	`, tenantID, task.ExternalID, task.ID, task.InsertedAt)
+	require.NoError(t, err)
+}
+
+func TestSignalRunDeliversToScopedWaiter(t *testing.T) {
+	pool, cleanup := setupPostgresWithMigration(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	tenantID := uuid.New()
+	repos := newUserEventScopeTestRepositories(t, pool)
+	key := "approval"
+	scope := "scope-a"
+	task := createUserEventScopeTestTask(t, ctx, repos, tenantID, 401)
+	otherTask := createUserEventScopeTestTask(t, ctx, repos, tenantID, 402)
+	insertSignalRunTestLookup(t, ctx, repos, tenantID, task)
+	insertSignalRunTestLookup(t, ctx, repos, tenantID, otherTask)
+
+	wait := ingestUserEventScopeTestWaiter(t, ctx, repos.durable, tenantID, task, key, &scope, nil, "true")
+	require.False(t, wait.IsSatisfied)
+	otherWait := ingestUserEventScopeTestWaiter(t, ctx, repos.durable, tenantID, otherTask, key, &scope, nil, "true")
+	require.False(t, otherWait.IsSatisfied)
+
+	result, err := repos.matches.SignalRun(ctx, tenantID, SignalRunOpts{
+		RunExternalId: task.ExternalID,
+		Name:          key,
+		SignalId:      uuid.New(),
+		Data:          []byte(`{"approved":true}`),
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, result.WaitingConditions)
+	require.Len(t, result.SatisfiedDurableEventLogEntries, 1)
+	require.Equal(t, task.ExternalID, result.SatisfiedDurableEventLogEntries[0].DurableTaskExternalId)
+	require.JSONEq(t, `{"CREATE":{"payload":[{"approved":true}]}}`, string(result.SatisfiedDurableEventLogEntries[0].Data))
+	requireUserEventScopeTestWaiterState(t, ctx, repos, task, wait.NodeId, wait.BranchId, true)
+	requireUserEventScopeTestWaiterState(t, ctx, repos, otherTask, otherWait.NodeId, otherWait.BranchId, false)
+}
+
+func TestSignalRunUnknownRun(t *testing.T) {
+	pool, cleanup := setupPostgresWithMigration(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	repos := newUserEventScopeTestRepositories(t, pool)
+
+	_, err := repos.matches.SignalRun(ctx, uuid.New(), SignalRunOpts{
+		RunExternalId: uuid.New(),
+		Name:          "approval",
+		SignalId:      uuid.New(),
+	})
+	require.ErrorIs(t, err, ErrSignalRunNotFound)
+}
+
+func TestSignalRunDuplicateSignal(t *testing.T) {
+	pool, cleanup := setupPostgresWithMigration(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	tenantID := uuid.New()
+	repos := newUserEventScopeTestRepositories(t, pool)
+	key := "approval"
+	task := createUserEventScopeTestTask(t, ctx, repos, tenantID, 501)
+	insertSignalRunTestLookup(t, ctx, repos, tenantID, task)
+
+	wait := ingestUserEventScopeTestWaiter(t, ctx, repos.durable, tenantID, task, key, nil, nil, "true")
+	require.False(t, wait.IsSatisfied)
+
+	opts := SignalRunOpts{
+		RunExternalId: task.ExternalID,
+		Name:          key,
+		SignalId:      uuid.New(),
+		Data:          []byte(`{"attempt":1}`),
+	}
+
+	result, err := repos.matches.SignalRun(ctx, tenantID, opts)
+	require.NoError(t, err)
+	require.Equal(t, 1, result.WaitingConditions)
+	require.Len(t, result.SatisfiedDurableEventLogEntries, 1)
+
+	opts.Data = []byte(`{"attempt":2}`)
+
+	result, err = repos.matches.SignalRun(ctx, tenantID, opts)
+	require.NoError(t, err)
+	require.Zero(t, result.WaitingConditions)
+	require.Empty(t, result.SatisfiedDurableEventLogEntries)
+	requireUserEventScopeTestWaiterState(t, ctx, repos, task, wait.NodeId, wait.BranchId, true)
+}
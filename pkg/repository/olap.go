@@ -256,6 +256,7 @@ type OLAPRepository interface {
 
 	ListTasks(ctx context.Context, tenantId uuid.UUID, opts ListTaskRunOpts) ([]*TaskWithPayloads, int, error)
 	ListWorkflowRuns(ctx context.Context, tenantId uuid.UUID, opts ListWorkflowRunOpts) ([]*WorkflowRunData, int, error)
+	SearchWorkflowRuns(ctx context.Context, tenantId uuid.UUID, opts SearchWorkflowRunsOpts) ([]*WorkflowRunData, error)
 	ListTaskRunEvents(ctx context.Context, tenantId uuid.UUID, taskId int64, taskInsertedAt pgtype.Timestamptz, limit, offset *int64) ([]*sqlcv1.ListTaskEventsRow, error)
 	ListTaskRunEventsByWorkflowRunId(ctx context.Context, tenantId uuid.UUID, workflowRunId uuid.UUID) ([]*TaskEventWithPayloads, error)
 	ListWorkflowRunDisplayNames(ctx context.Context, tenantId uuid.UUID, externalIds []uuid.UUID) ([]*sqlcv1.ListWorkflowRunDisplayNamesRow, error)
@@ -290,6 +291,7 @@ type OLAPRepository interface {
 
 	CreateIncomingWebhookValidationFailureLogs(ctx context.Context, tenantId uuid.UUID, opts []CreateIncomingWebhookFailureLogOpts) error
 	StoreCELEvaluationFailures(ctx context.Context, tenantId uuid.UUID, failures []CELEvaluationFailure) error
+	StoreEventSchemaValidationFailures(ctx context.Context, tenantId uuid.UUID, failures []EventSchemaValidationFailure) error
 	PutPayloads(ctx context.Context, tx sqlcv1.DBTX, tenantId uuid.UUID, putPayloadOpts ...StoreOLAPPayloadOpts) error
 	ReadPayload(ctx context.Context, tenantId uuid.UUID, opt ReadOLAPPayloadOpts) ([]byte, error)
 
@@ -301,12 +303,43 @@ type OLAPRepository interface {
 
 	ListWorkflowRunExternalIds(ctx context.Context, tenantId uuid.UUID, opts ListWorkflowRunOpts) ([]uuid.UUID, error)
 
+	// ListWorkflowRunExternalIdsPage lists up to limit external ids of the runs matching opts,
+	// starting after the cursor, or from the start if it is nil. It returns the cursor to read the
+	// next page from, which is nil once the last page has been read.
+	ListWorkflowRunExternalIdsPage(ctx context.Context, tenantId uuid.UUID, opts ListWorkflowRunOpts, after *WorkflowRunCursor, limit int32) ([]uuid.UUID, *WorkflowRunCursor, error)
+
+	// DeleteFinishedRuns removes finished runs from the run history, skipping runs which are still
+	// queued or running. It returns the external ids of the deleted runs.
+	DeleteFinishedRuns(ctx context.Context, tenantId uuid.UUID, externalIds []uuid.UUID) ([]uuid.UUID, error)
+
 	ProcessOLAPPayloadCutovers(ctx context.Context, externalStoreEnabled bool, inlineStoreTTL *time.Duration, externalCutoverBatchSize, externalCutoverNumConcurrentOffloads int32, enableWindowSizeOptimization bool) error
 
 	CountOLAPTempTableSizeForDAGStatusUpdates(ctx context.Context) (int64, error)
 	CountOLAPTempTableSizeForTaskStatusUpdates(ctx context.Context) (int64, error)
 	ListYesterdayRunCountsByStatus(ctx context.Context) (map[sqlcv1.V1ReadableStatusOlap]int64, error)
 
+	// Export queries
+	ListRunsForExport(ctx context.Context, tenantId uuid.UUID, opts ListOLAPExportRowsOpts) ([]*sqlcv1.ListRunsForExportRow, error)
+	ListTasksForExport(ctx context.Context, tenantId uuid.UUID, opts ListOLAPExportRowsOpts) ([]*TaskForExport, error)
+	ListTaskEventsForExport(ctx context.Context, tenantId uuid.UUID, tasks []TaskMetadata, includePayloads bool) ([]*TaskEventForExport, error)
+
+	// AcquireOLAPExportLease leases the export of a day partition, returning false if the partition
+	// has already been exported (and force is false) or another process holds the lease.
+	AcquireOLAPExportLease(ctx context.Context, tenantId uuid.UUID, partitionDate time.Time, processId uuid.UUID, leaseDuration time.Duration, force bool) (bool, error)
+	CompleteOLAPExport(ctx context.Context, tenantId uuid.UUID, partitionDate time.Time, processId uuid.UUID, opts CompleteOLAPExportOpts) error
+	ReleaseOLAPExportLease(ctx context.Context, tenantId uuid.UUID, partitionDate time.Time, processId uuid.UUID) error
+
+	// ListPendingOLAPExportDates returns the days within the retention period, up to but excluding
+	// the day of before, which haven't been exported yet, oldest first.
+	ListPendingOLAPExportDates(ctx context.Context, tenantId uuid.UUID, before time.Time) ([]time.Time, error)
+
+	// StoreTaskSlotUsage records the slot time consumed by released task attempts.
+	StoreTaskSlotUsage(ctx context.Context, tenantId uuid.UUID, opts []StoreTaskSlotUsageOpts) error
+
+	// ListTaskSlotUsage sums the slot-seconds consumed within a time range, grouped by workflow,
+	// action, worker label or additional metadata key.
+	ListTaskSlotUsage(ctx context.Context, tenantId uuid.UUID, opts ListTaskSlotUsageOpts) ([]*sqlcv1.ListTaskSlotUsageRow, error)
+
 	CreateSpans(ctx context.Context, tenantId uuid.UUID, opts *CreateSpansOpts) error
 	ListSpansByTraceId(ctx context.Context, tenantId uuid.UUID, traceId []byte, offset, limit int64) (*ListSpansResult, error)
 	CreateSpanLookupTableEntries(ctx context.Context, tenantId uuid.UUID, opts *CreateSpansOpts) error
@@ -1280,6 +1313,18 @@ func (r *OLAPRepositoryImpl) ListWorkflowRuns(ctx context.Context, tenantId uuid
 		return nil, 0, err
 	}
 
+	res, err := r.populateWorkflowRuns(ctx, tx, commit, tenantId, workflowRunIds, opts.IncludePayloads)
+
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return res, int(count), nil
+}
+
+// populateWorkflowRuns reads the run data of the given DAG and task runs, committing tx once the
+// runs have been read and before their payloads are read.
+func (r *OLAPRepositoryImpl) populateWorkflowRuns(ctx context.Context, tx pgx.Tx, commit func(context.Context) error, tenantId uuid.UUID, workflowRunIds []*sqlcv1.FetchWorkflowRunIdsRow, includePayloads bool) ([]*WorkflowRunData, error) {
 	runIdsWithDAGs := make([]int64, 0)
 	runInsertedAtsWithDAGs := make([]pgtype.Timestamptz, 0)
 	taskIdsInsertedAts := make([]IdInsertedAt, 0, len(workflowRunIds))
@@ -1301,11 +1346,11 @@ func (r *OLAPRepositoryImpl) ListWorkflowRuns(ctx context.Context, tenantId uuid
 		Ids:             runIdsWithDAGs,
 		Insertedats:     runInsertedAtsWithDAGs,
 		Tenantid:        tenantId,
-		Includepayloads: opts.IncludePayloads,
+		Includepayloads: includePayloads,
 	})
 
 	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
-		return nil, 0, err
+		return nil, err
 	}
 
 	dagsToPopulated := make(map[uuid.UUID]*sqlcv1.PopulateDAGMetadataRow)
@@ -1327,10 +1372,10 @@ func (r *OLAPRepositoryImpl) ListWorkflowRuns(ctx context.Context, tenantId uuid
 
 	tasksToPopulated := make(map[uuid.UUID]*sqlcv1.PopulateTaskRunDataRow)
 
-	populatedTasks, err := r.populateTaskRunData(ctx, tx, tenantId, taskIdsInsertedAts, opts.IncludePayloads)
+	populatedTasks, err := r.populateTaskRunData(ctx, tx, tenantId, taskIdsInsertedAts, includePayloads)
 
 	if err != nil {
-		return nil, 0, err
+		return nil, err
 	}
 
 	for _, task := range populatedTasks {
@@ -1350,16 +1395,16 @@ func (r *OLAPRepositoryImpl) ListWorkflowRuns(ctx context.Context, tenantId uuid
 	}
 
 	if err := commit(ctx); err != nil {
-		return nil, 0, err
+		return nil, err
 	}
 
 	externalIdToPayload := make(map[uuid.UUID][]byte)
 
-	if opts.IncludePayloads {
+	if includePayloads {
 		externalIdToPayload, err = r.readPayloads(ctx, r.readPool, tenantId, retrievePayloadOpts...)
 
 		if err != nil {
-			return nil, 0, err
+			return nil, err
 		}
 	}
 
@@ -1381,7 +1426,7 @@ func (r *OLAPRepositoryImpl) ListWorkflowRuns(ctx context.Context, tenantId uuid
 				outputPayload, exists = externalIdToPayload[*dag.OutputEventExternalID]
 
 				if !exists {
-					if opts.IncludePayloads && dag.ReadableStatus == sqlcv1.V1ReadableStatusOlapCOMPLETED {
+					if includePayloads && dag.ReadableStatus == sqlcv1.V1ReadableStatusOlapCOMPLETED {
 						r.l.Error().Ctx(ctx).Msgf("ListWorkflowRuns-1: dag with external_id %s and inserted_at %s has empty payload, falling back to output", dag.ExternalID, dag.InsertedAt.Time)
 					}
 					outputPayload = dag.Output
@@ -1392,7 +1437,7 @@ func (r *OLAPRepositoryImpl) ListWorkflowRuns(ctx context.Context, tenantId uuid
 
 			inputPayload, exists := externalIdToPayload[dag.ExternalID]
 			if !exists {
-				if opts.IncludePayloads && dag.ExternalID != uuid.Nil {
+				if includePayloads && dag.ExternalID != uuid.Nil {
 					r.l.Error().Ctx(ctx).Msgf("ListWorkflowRuns-2: dag with external_id %s and inserted_at %s has empty payload, falling back to input", dag.ExternalID, dag.InsertedAt.Time)
 				}
 				inputPayload = dag.Input
@@ -1434,11 +1479,11 @@ func (r *OLAPRepositoryImpl) ListWorkflowRuns(ctx context.Context, tenantId uuid
 				continue
 			}
 
-			res = append(res, r.taskToWorkflowRunData(ctx, task, externalIdToPayload, opts.IncludePayloads))
+			res = append(res, r.taskToWorkflowRunData(ctx, task, externalIdToPayload, includePayloads))
 		}
 	}
 
-	return res, int(count), nil
+	return res, nil
 }
 
 func (r *OLAPRepositoryImpl) taskToWorkflowRunData(ctx context.Context, task *sqlcv1.PopulateTaskRunDataRow, payloads map[uuid.UUID][]byte, includePayloads bool) *WorkflowRunData {
@@ -1511,6 +1556,73 @@ func (r *OLAPRepositoryImpl) ListWorkflowRunExternalIds(ctx context.Context, ten
 
 	defer rollback()
 
+	externalIds, err := r.queries.ListWorkflowRunExternalIds(ctx, tx, listWorkflowRunExternalIdsParams(tenantId, opts))
+
+	if err != nil {
+		return nil, err
+	}
+
+	if err := commit(ctx); err != nil {
+		return nil, err
+	}
+
+	return externalIds, nil
+}
+
+// WorkflowRunCursor is the position of a run in the (inserted_at, id) order used to page through
+// runs.
+type WorkflowRunCursor struct {
+	InsertedAt time.Time
+	Id         int64
+}
+
+func (r *OLAPRepositoryImpl) ListWorkflowRunExternalIdsPage(ctx context.Context, tenantId uuid.UUID, opts ListWorkflowRunOpts, after *WorkflowRunCursor, limit int32) ([]uuid.UUID, *WorkflowRunCursor, error) {
+	ctx, span := telemetry.NewSpan(ctx, "list-workflow-run-external-ids-page-olap")
+	defer span.End()
+
+	filter := listWorkflowRunExternalIdsParams(tenantId, opts)
+
+	params := sqlcv1.ListWorkflowRunExternalIdsPageParams{
+		Tenantid:             filter.Tenantid,
+		Since:                filter.Since,
+		Until:                filter.Until,
+		Statuses:             filter.Statuses,
+		AdditionalMetaKeys:   filter.AdditionalMetaKeys,
+		AdditionalMetaValues: filter.AdditionalMetaValues,
+		WorkflowIds:          filter.WorkflowIds,
+		Pagelimit:            limit,
+	}
+
+	if after != nil {
+		params.AfterInsertedAt = sqlchelpers.TimestamptzFromTime(after.InsertedAt)
+		params.AfterId = pgtype.Int8{Int64: after.Id, Valid: true}
+	}
+
+	rows, err := r.queries.ListWorkflowRunExternalIdsPage(ctx, r.readPool, params)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	externalIds := make([]uuid.UUID, len(rows))
+
+	for i, row := range rows {
+		externalIds[i] = row.ExternalID
+	}
+
+	if len(rows) < int(limit) {
+		return externalIds, nil, nil
+	}
+
+	last := rows[len(rows)-1]
+
+	return externalIds, &WorkflowRunCursor{
+		InsertedAt: last.InsertedAt.Time,
+		Id:         last.ID,
+	}, nil
+}
+
+func listWorkflowRunExternalIdsParams(tenantId uuid.UUID, opts ListWorkflowRunOpts) sqlcv1.ListWorkflowRunExternalIdsParams {
 	params := sqlcv1.ListWorkflowRunExternalIdsParams{
 		Tenantid: tenantId,
 		Since:    sqlchelpers.TimestamptzFromTime(opts.CreatedAfter),
@@ -1553,17 +1665,17 @@ func (r *OLAPRepositoryImpl) ListWorkflowRunExternalIds(ctx context.Context, ten
 		params.AdditionalMetaValues = append(params.AdditionalMetaValues, value.(string))
 	}
 
-	externalIds, err := r.queries.ListWorkflowRunExternalIds(ctx, tx, params)
-
-	if err != nil {
-		return nil, err
-	}
+	return params
+}
 
-	if err := commit(ctx); err != nil {
-		return nil, err
-	}
+func (r *OLAPRepositoryImpl) DeleteFinishedRuns(ctx context.Context, tenantId uuid.UUID, externalIds []uuid.UUID) ([]uuid.UUID, error) {
+	ctx, span := telemetry.NewSpan(ctx, "delete-finished-runs-olap")
+	defer span.End()
 
-	return externalIds, nil
+	return r.queries.DeleteFinishedRunsOLAP(ctx, r.pool, sqlcv1.DeleteFinishedRunsOLAPParams{
+		Tenantid:    tenantId,
+		Externalids: externalIds,
+	})
 }
 
 func (r *OLAPRepositoryImpl) ListTaskRunEvents(ctx context.Context, tenantId uuid.UUID, taskId int64, taskInsertedAt pgtype.Timestamptz, limit *int64, offset *int64) ([]*sqlcv1.ListTaskEventsRow, error) {
@@ -3103,6 +3215,35 @@ func (r *OLAPRepositoryImpl) StoreCELEvaluationFailures(ctx context.Context, ten
 	})
 }
 
+type EventSchemaValidationFailure struct {
+	EventKey       string `json:"event_key"`
+	SchemaEventKey string `json:"schema_event_key"`
+	SchemaVersion  int32  `json:"schema_version"`
+	Rejected       bool   `json:"rejected"`
+	ErrorMessage   string `json:"error_message"`
+}
+
+func (r *OLAPRepositoryImpl) StoreEventSchemaValidationFailures(ctx context.Context, tenantId uuid.UUID, failures []EventSchemaValidationFailure) error {
+	params := sqlcv1.StoreEventSchemaValidationFailuresParams{
+		Tenantid:        tenantId,
+		Eventkeys:       make([]string, len(failures)),
+		Schemaeventkeys: make([]string, len(failures)),
+		Schemaversions:  make([]int32, len(failures)),
+		Rejected:        make([]bool, len(failures)),
+		Errors:          make([]string, len(failures)),
+	}
+
+	for i, failure := range failures {
+		params.Eventkeys[i] = failure.EventKey
+		params.Schemaeventkeys[i] = failure.SchemaEventKey
+		params.Schemaversions[i] = failure.SchemaVersion
+		params.Rejected[i] = failure.Rejected
+		params.Errors[i] = failure.ErrorMessage
+	}
+
+	return r.queries.StoreEventSchemaValidationFailures(ctx, r.pool, params)
+}
+
 type OffloadPayloadOpts struct {
 	ExternalId          uuid.UUID
 	ExternalLocationKey string
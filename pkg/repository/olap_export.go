@@ -0,0 +1,293 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"github.com/hatchet-dev/hatchet/pkg/repository/sqlchelpers"
+	"github.com/hatchet-dev/hatchet/pkg/repository/sqlcv1"
+	"github.com/hatchet-dev/hatchet/pkg/telemetry"
+)
+
+// ErrOLAPExportLeaseLost is returned when completing an export whose lease was taken over by
+// another process, for example because the export ran past the lease duration.
+var ErrOLAPExportLeaseLost = errors.New("olap export lease is no longer held by this process")
+
+// ListOLAPExportRowsOpts selects a batch of rows from a single day partition. Rows are returned in
+// (inserted_at, id) order, starting after the given cursor.
+type ListOLAPExportRowsOpts struct {
+	// PartitionDate is the UTC day of the partition to read from.
+	PartitionDate time.Time
+
+	// AfterInsertedAt and AfterId are the keyset cursor of the last row of the previous batch. Both
+	// are zero for the first batch.
+	AfterInsertedAt time.Time
+	AfterId         int64
+
+	Limit int32
+
+	IncludePayloads bool
+}
+
+type TaskForExport struct {
+	*sqlcv1.ListTasksForExportRow
+	InputPayload []byte
+}
+
+type TaskEventForExport struct {
+	*sqlcv1.V1TaskEventsOlap
+	OutputPayload []byte
+}
+
+type CompleteOLAPExportOpts struct {
+	Destination    string
+	RunCount       int64
+	TaskCount      int64
+	TaskEventCount int64
+}
+
+// PartitionDay truncates t to the start of its UTC day, which is how OLAP partitions are bounded.
+func PartitionDay(t time.Time) time.Time {
+	t = t.UTC()
+
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+func partitionBounds(day time.Time) (pgtype.Timestamptz, pgtype.Timestamptz) {
+	start := PartitionDay(day)
+
+	return sqlchelpers.TimestamptzFromTime(start), sqlchelpers.TimestamptzFromTime(start.AddDate(0, 0, 1))
+}
+
+func (r *OLAPRepositoryImpl) ListRunsForExport(ctx context.Context, tenantId uuid.UUID, opts ListOLAPExportRowsOpts) ([]*sqlcv1.ListRunsForExportRow, error) {
+	ctx, span := telemetry.NewSpan(ctx, "list-runs-for-export-olap")
+	defer span.End()
+
+	start, end := partitionBounds(opts.PartitionDate)
+
+	return r.queries.ListRunsForExport(ctx, r.readPool, sqlcv1.ListRunsForExportParams{
+		Tenantid:        tenantId,
+		Partitionstart:  start,
+		Partitionend:    end,
+		Afterinsertedat: sqlchelpers.TimestamptzFromTime(opts.AfterInsertedAt),
+		Afterid:         opts.AfterId,
+		Batchsize:       opts.Limit,
+	})
+}
+
+func (r *OLAPRepositoryImpl) ListTasksForExport(ctx context.Context, tenantId uuid.UUID, opts ListOLAPExportRowsOpts) ([]*TaskForExport, error) {
+	ctx, span := telemetry.NewSpan(ctx, "list-tasks-for-export-olap")
+	defer span.End()
+
+	start, end := partitionBounds(opts.PartitionDate)
+
+	rows, err := r.queries.ListTasksForExport(ctx, r.readPool, sqlcv1.ListTasksForExportParams{
+		Tenantid:        tenantId,
+		Partitionstart:  start,
+		Partitionend:    end,
+		Afterinsertedat: sqlchelpers.TimestamptzFromTime(opts.AfterInsertedAt),
+		Afterid:         opts.AfterId,
+		Batchsize:       opts.Limit,
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("could not list tasks for export: %w", err)
+	}
+
+	payloads := make(map[uuid.UUID][]byte)
+
+	if opts.IncludePayloads && len(rows) > 0 {
+		readOpts := make([]ReadOLAPPayloadOpts, len(rows))
+
+		for i, row := range rows {
+			readOpts[i] = ReadOLAPPayloadOpts{
+				ExternalId: row.ExternalID,
+				InsertedAt: row.InsertedAt,
+			}
+		}
+
+		payloads, err = r.readPayloads(ctx, r.readPool, tenantId, readOpts...)
+
+		if err != nil {
+			return nil, fmt.Errorf("could not read task payloads for export: %w", err)
+		}
+	}
+
+	res := make([]*TaskForExport, 0, len(rows))
+
+	for _, row := range rows {
+		task := &TaskForExport{
+			ListTasksForExportRow: row,
+		}
+
+		if opts.IncludePayloads {
+			input, ok := payloads[row.ExternalID]
+
+			if !ok {
+				input = row.Input
+			}
+
+			task.InputPayload = input
+		}
+
+		res = append(res, task)
+	}
+
+	return res, nil
+}
+
+func (r *OLAPRepositoryImpl) ListTaskEventsForExport(ctx context.Context, tenantId uuid.UUID, tasks []TaskMetadata, includePayloads bool) ([]*TaskEventForExport, error) {
+	ctx, span := telemetry.NewSpan(ctx, "list-task-events-for-export-olap")
+	defer span.End()
+
+	if len(tasks) == 0 {
+		return nil, nil
+	}
+
+	taskIds := make([]int64, len(tasks))
+	taskInsertedAts := make([]pgtype.Timestamptz, len(tasks))
+
+	for i, task := range tasks {
+		taskIds[i] = task.TaskID
+		taskInsertedAts[i] = sqlchelpers.TimestamptzFromTime(task.TaskInsertedAt)
+	}
+
+	rows, err := r.queries.ListTaskEventsForExport(ctx, r.readPool, sqlcv1.ListTaskEventsForExportParams{
+		Tenantid:        tenantId,
+		Taskids:         taskIds,
+		Taskinsertedats: taskInsertedAts,
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("could not list task events for export: %w", err)
+	}
+
+	payloads := make(map[uuid.UUID][]byte)
+
+	if includePayloads && len(rows) > 0 {
+		readOpts := make([]ReadOLAPPayloadOpts, len(rows))
+
+		for i, row := range rows {
+			readOpts[i] = ReadOLAPPayloadOpts{
+				ExternalId: row.ExternalID,
+				InsertedAt: row.EventTimestamp,
+			}
+		}
+
+		payloads, err = r.readPayloads(ctx, r.readPool, tenantId, readOpts...)
+
+		if err != nil {
+			return nil, fmt.Errorf("could not read task event payloads for export: %w", err)
+		}
+	}
+
+	res := make([]*TaskEventForExport, 0, len(rows))
+
+	for _, row := range rows {
+		event := &TaskEventForExport{
+			V1TaskEventsOlap: row,
+		}
+
+		if includePayloads {
+			output, ok := payloads[row.ExternalID]
+
+			if !ok {
+				output = row.Output
+			}
+
+			event.OutputPayload = output
+		}
+
+		res = append(res, event)
+	}
+
+	return res, nil
+}
+
+func (r *OLAPRepositoryImpl) AcquireOLAPExportLease(ctx context.Context, tenantId uuid.UUID, partitionDate time.Time, processId uuid.UUID, leaseDuration time.Duration, force bool) (bool, error) {
+	_, err := r.queries.AcquireOLAPExportLease(ctx, r.pool, sqlcv1.AcquireOLAPExportLeaseParams{
+		Tenantid:       tenantId,
+		Partitiondate:  pgtype.Date{Time: PartitionDay(partitionDate), Valid: true},
+		Leaseprocessid: processId,
+		Leaseexpiresat: sqlchelpers.TimestamptzFromTime(time.Now().Add(leaseDuration)),
+		Force:          force,
+	})
+
+	if errors.Is(err, pgx.ErrNoRows) {
+		return false, nil
+	}
+
+	if err != nil {
+		return false, fmt.Errorf("could not acquire olap export lease: %w", err)
+	}
+
+	return true, nil
+}
+
+func (r *OLAPRepositoryImpl) CompleteOLAPExport(ctx context.Context, tenantId uuid.UUID, partitionDate time.Time, processId uuid.UUID, opts CompleteOLAPExportOpts) error {
+	updated, err := r.queries.CompleteOLAPExport(ctx, r.pool, sqlcv1.CompleteOLAPExportParams{
+		Destination:    opts.Destination,
+		Runcount:       opts.RunCount,
+		Taskcount:      opts.TaskCount,
+		Taskeventcount: opts.TaskEventCount,
+		Tenantid:       tenantId,
+		Partitiondate:  pgtype.Date{Time: PartitionDay(partitionDate), Valid: true},
+		Leaseprocessid: processId,
+	})
+
+	if err != nil {
+		return fmt.Errorf("could not complete olap export: %w", err)
+	}
+
+	if updated == 0 {
+		return ErrOLAPExportLeaseLost
+	}
+
+	return nil
+}
+
+func (r *OLAPRepositoryImpl) ReleaseOLAPExportLease(ctx context.Context, tenantId uuid.UUID, partitionDate time.Time, processId uuid.UUID) error {
+	return r.queries.ReleaseOLAPExportLease(ctx, r.pool, sqlcv1.ReleaseOLAPExportLeaseParams{
+		Tenantid:       tenantId,
+		Partitiondate:  pgtype.Date{Time: PartitionDay(partitionDate), Valid: true},
+		Leaseprocessid: processId,
+	})
+}
+
+func (r *OLAPRepositoryImpl) ListPendingOLAPExportDates(ctx context.Context, tenantId uuid.UUID, before time.Time) ([]time.Time, error) {
+	// the oldest partition may be dropped by the retention job at any time, so we start from the
+	// day after it rather than racing the drop and checkpointing an empty export.
+	since := PartitionDay(time.Now().Add(-1*r.olapRetentionPeriod)).AddDate(0, 0, 1)
+	before = PartitionDay(before)
+
+	completed, err := r.queries.ListCompletedOLAPExportDates(ctx, r.pool, sqlcv1.ListCompletedOLAPExportDatesParams{
+		Tenantid: tenantId,
+		Since:    pgtype.Date{Time: since, Valid: true},
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("could not list completed olap exports: %w", err)
+	}
+
+	completedDays := make(map[time.Time]struct{}, len(completed))
+
+	for _, d := range completed {
+		completedDays[PartitionDay(d.Time)] = struct{}{}
+	}
+
+	pending := make([]time.Time, 0)
+
+	for day := since; day.Before(before); day = day.AddDate(0, 0, 1) {
+		if _, ok := completedDays[day]; !ok {
+			pending = append(pending, day)
+		}
+	}
+
+	return pending, nil
+}
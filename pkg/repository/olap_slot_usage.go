@@ -0,0 +1,244 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"github.com/hatchet-dev/hatchet/pkg/repository/sqlchelpers"
+	"github.com/hatchet-dev/hatchet/pkg/repository/sqlcv1"
+	"github.com/hatchet-dev/hatchet/pkg/telemetry"
+)
+
+// TaskSlotUsage is the slot time a task attempt consumed on a worker for a single slot type, from
+// its assignment until its slots were released.
+type TaskSlotUsage struct {
+	TaskId             int64     `json:"task_id"`
+	TaskInsertedAt     time.Time `json:"task_inserted_at"`
+	RetryCount         int32     `json:"retry_count"`
+	WorkerId           uuid.UUID `json:"worker_id"`
+	WorkflowId         uuid.UUID `json:"workflow_id"`
+	ActionId           string    `json:"action_id"`
+	AdditionalMetadata []byte    `json:"additional_metadata,omitempty"`
+	SlotType           string    `json:"slot_type"`
+	Units              int32     `json:"units"`
+	AssignedAt         time.Time `json:"assigned_at"`
+	ReleasedAt         time.Time `json:"released_at"`
+}
+
+// SlotSeconds returns the number of slot units held multiplied by the number of seconds they were
+// held for.
+func (u TaskSlotUsage) SlotSeconds() float64 {
+	return float64(u.Units) * max(u.ReleasedAt.Sub(u.AssignedAt).Seconds(), 0)
+}
+
+// TaskSlotUsageFromReleasedTasks returns the slot usage of the released task attempts which held
+// slots on a worker, one entry per slot type.
+func TaskSlotUsageFromReleasedTasks(tasks []*sqlcv1.ReleaseTasksRow) []TaskSlotUsage {
+	res := make([]TaskSlotUsage, 0)
+
+	for _, task := range tasks {
+		res = append(res, releasedSlotUsage(
+			TaskSlotUsage{
+				TaskId:             task.ID,
+				TaskInsertedAt:     task.InsertedAt.Time,
+				RetryCount:         task.RetryCount,
+				WorkerId:           task.WorkerID,
+				WorkflowId:         task.WorkflowID,
+				ActionId:           task.ActionID,
+				AdditionalMetadata: task.AdditionalMetadata,
+			},
+			task.SlotTypes,
+			task.SlotUnits,
+			task.AssignedAt,
+			task.ReleasedAt,
+		)...)
+	}
+
+	return res
+}
+
+// releasedSlotUsage returns the usage of the slots a task attempt released, one entry per slot
+// type, or nil if it didn't hold any.
+func releasedSlotUsage(attempt TaskSlotUsage, slotTypes []string, slotUnits []int32, assignedAt, releasedAt pgtype.Timestamptz) []TaskSlotUsage {
+	if !assignedAt.Valid || !releasedAt.Valid || len(slotTypes) != len(slotUnits) {
+		return nil
+	}
+
+	res := make([]TaskSlotUsage, 0, len(slotTypes))
+
+	for i, slotType := range slotTypes {
+		usage := attempt
+		usage.SlotType = slotType
+		usage.Units = slotUnits[i]
+		usage.AssignedAt = assignedAt.Time
+		usage.ReleasedAt = releasedAt.Time
+
+		res = append(res, usage)
+	}
+
+	return res
+}
+
+type StoreTaskSlotUsageOpts struct {
+	TaskSlotUsage
+
+	WorkflowName string
+
+	// the labels of the worker at the time the slots were released
+	WorkerLabels map[string]string
+}
+
+// ErrInvalidSlotUsageQuery is returned when slot usage can't be listed as requested.
+var ErrInvalidSlotUsageQuery = errors.New("invalid slot usage query")
+
+const (
+	// DefaultSlotUsageLimit is the number of groups slot usage is listed for when no limit is set.
+	DefaultSlotUsageLimit = 1000
+	MaxSlotUsageLimit     = 10000
+)
+
+// SlotUsageGroupBy is the dimension slot usage is aggregated by.
+type SlotUsageGroupBy string
+
+const (
+	SlotUsageGroupByWorkflow    SlotUsageGroupBy = "workflow"
+	SlotUsageGroupByAction      SlotUsageGroupBy = "action"
+	SlotUsageGroupByWorkerLabel SlotUsageGroupBy = "worker_label"
+	SlotUsageGroupByMetadata    SlotUsageGroupBy = "metadata"
+)
+
+type ListTaskSlotUsageOpts struct {
+	// the slot usage released within [Since, Until) is counted
+	Since time.Time
+	Until time.Time
+
+	GroupBy SlotUsageGroupBy
+
+	// the worker label or additional metadata key to group by, required when grouping by a worker
+	// label or additional metadata
+	Key string
+
+	// (optional) the workflows to count slot usage for
+	WorkflowIds []uuid.UUID
+
+	// (optional) the slot type to count slot usage for
+	SlotType *string
+
+	Limit int32
+}
+
+func (o ListTaskSlotUsageOpts) validate() error {
+	switch o.GroupBy {
+	case SlotUsageGroupByWorkflow, SlotUsageGroupByAction:
+	case SlotUsageGroupByWorkerLabel, SlotUsageGroupByMetadata:
+		if o.Key == "" {
+			return fmt.Errorf("%w: a key is required to group slot usage by %s", ErrInvalidSlotUsageQuery, o.GroupBy)
+		}
+	default:
+		return fmt.Errorf("%w: unknown group by %q", ErrInvalidSlotUsageQuery, o.GroupBy)
+	}
+
+	if !o.Until.After(o.Since) {
+		return fmt.Errorf("%w: until must be after since", ErrInvalidSlotUsageQuery)
+	}
+
+	if o.Limit <= 0 || o.Limit > MaxSlotUsageLimit {
+		return fmt.Errorf("%w: limit must be between 1 and %d", ErrInvalidSlotUsageQuery, MaxSlotUsageLimit)
+	}
+
+	return nil
+}
+
+func (r *OLAPRepositoryImpl) StoreTaskSlotUsage(ctx context.Context, tenantId uuid.UUID, opts []StoreTaskSlotUsageOpts) error {
+	ctx, span := telemetry.NewSpan(ctx, "store-task-slot-usage-olap")
+	defer span.End()
+
+	if len(opts) == 0 {
+		return nil
+	}
+
+	params := sqlcv1.StoreTaskSlotUsageParams{
+		Tenantid: tenantId,
+	}
+
+	for _, opt := range opts {
+		workerLabels := opt.WorkerLabels
+
+		if workerLabels == nil {
+			workerLabels = map[string]string{}
+		}
+
+		workerLabelsBytes, err := json.Marshal(workerLabels)
+
+		if err != nil {
+			return fmt.Errorf("could not marshal worker labels: %w", err)
+		}
+
+		var additionalMetadata []byte
+
+		if len(opt.AdditionalMetadata) > 0 && json.Valid(opt.AdditionalMetadata) {
+			additionalMetadata = opt.AdditionalMetadata
+		}
+
+		params.Taskids = append(params.Taskids, opt.TaskId)
+		params.Taskinsertedats = append(params.Taskinsertedats, sqlchelpers.TimestamptzFromTime(opt.TaskInsertedAt))
+		params.Retrycounts = append(params.Retrycounts, opt.RetryCount)
+		params.Slottypes = append(params.Slottypes, opt.SlotType)
+		params.Units = append(params.Units, opt.Units)
+		params.Workerids = append(params.Workerids, opt.WorkerId)
+		params.Workflowids = append(params.Workflowids, opt.WorkflowId)
+		params.Workflownames = append(params.Workflownames, opt.WorkflowName)
+		params.Actionids = append(params.Actionids, opt.ActionId)
+		params.Workerlabels = append(params.Workerlabels, workerLabelsBytes)
+		params.Additionalmetadatas = append(params.Additionalmetadatas, additionalMetadata)
+		params.Assignedats = append(params.Assignedats, sqlchelpers.TimestamptzFromTime(opt.AssignedAt))
+		params.Releasedats = append(params.Releasedats, sqlchelpers.TimestamptzFromTime(opt.ReleasedAt))
+		params.Slotseconds = append(params.Slotseconds, opt.SlotSeconds())
+	}
+
+	if err := r.queries.StoreTaskSlotUsage(ctx, r.pool, params); err != nil {
+		return fmt.Errorf("could not store task slot usage: %w", err)
+	}
+
+	return nil
+}
+
+func (r *OLAPRepositoryImpl) ListTaskSlotUsage(ctx context.Context, tenantId uuid.UUID, opts ListTaskSlotUsageOpts) ([]*sqlcv1.ListTaskSlotUsageRow, error) {
+	ctx, span := telemetry.NewSpan(ctx, "list-task-slot-usage-olap")
+	defer span.End()
+
+	if err := opts.validate(); err != nil {
+		return nil, err
+	}
+
+	params := sqlcv1.ListTaskSlotUsageParams{
+		Groupby:    string(opts.GroupBy),
+		Key:        opts.Key,
+		Tenantid:   tenantId,
+		Since:      sqlchelpers.TimestamptzFromTime(opts.Since),
+		Until:      sqlchelpers.TimestamptzFromTime(opts.Until),
+		Limitcount: opts.Limit,
+	}
+
+	if len(opts.WorkflowIds) > 0 {
+		params.WorkflowIds = opts.WorkflowIds
+	}
+
+	if opts.SlotType != nil {
+		params.SlotType = pgtype.Text{String: *opts.SlotType, Valid: true}
+	}
+
+	rows, err := r.queries.ListTaskSlotUsage(ctx, r.readPool, params)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not list task slot usage: %w", err)
+	}
+
+	return rows, nil
+}
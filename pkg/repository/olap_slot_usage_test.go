@@ -0,0 +1,89 @@
+//go:build !e2e && !load && !rampup && !integration
+
+package repository
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hatchet-dev/hatchet/pkg/repository/sqlcv1"
+)
+
+func TestTaskSlotUsageFromReleasedTasks(t *testing.T) {
+	assignedAt := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	releasedAt := assignedAt.Add(90 * time.Second)
+	workerId := uuid.New()
+
+	tasks := []*sqlcv1.ReleaseTasksRow{
+		{
+			ID:         1,
+			InsertedAt: pgtype.Timestamptz{Time: assignedAt.Add(-time.Minute), Valid: true},
+			RetryCount: 2,
+			WorkerID:   workerId,
+			ActionID:   "wf:step",
+			SlotTypes:  []string{SlotTypeDefault, SlotTypeDurable},
+			SlotUnits:  []int32{4, 1},
+			AssignedAt: pgtype.Timestamptz{Time: assignedAt, Valid: true},
+			ReleasedAt: pgtype.Timestamptz{Time: releasedAt, Valid: true},
+		},
+		{
+			// a queued task which never held slots
+			ID:         2,
+			ReleasedAt: pgtype.Timestamptz{Time: releasedAt, Valid: true},
+		},
+	}
+
+	usage := TaskSlotUsageFromReleasedTasks(tasks)
+
+	require.Len(t, usage, 2)
+
+	assert.Equal(t, int64(1), usage[0].TaskId)
+	assert.Equal(t, int32(2), usage[0].RetryCount)
+	assert.Equal(t, workerId, usage[0].WorkerId)
+	assert.Equal(t, SlotTypeDefault, usage[0].SlotType)
+	assert.InDelta(t, 360, usage[0].SlotSeconds(), 1e-9)
+
+	assert.Equal(t, SlotTypeDurable, usage[1].SlotType)
+	assert.InDelta(t, 90, usage[1].SlotSeconds(), 1e-9)
+}
+
+func TestTaskSlotUsageSlotSecondsClockSkew(t *testing.T) {
+	now := time.Now()
+
+	u := TaskSlotUsage{Units: 1, AssignedAt: now, ReleasedAt: now.Add(-time.Second)}
+
+	assert.Zero(t, u.SlotSeconds())
+}
+
+func TestListTaskSlotUsageOptsValidate(t *testing.T) {
+	until := time.Now()
+	since := until.Add(-time.Hour)
+
+	valid := []ListTaskSlotUsageOpts{
+		{Since: since, Until: until, GroupBy: SlotUsageGroupByWorkflow, Limit: 1},
+		{Since: since, Until: until, GroupBy: SlotUsageGroupByAction, Limit: MaxSlotUsageLimit},
+		{Since: since, Until: until, GroupBy: SlotUsageGroupByMetadata, Key: "customer_id", Limit: 10},
+	}
+
+	for _, opts := range valid {
+		assert.NoError(t, opts.validate(), opts)
+	}
+
+	invalid := []ListTaskSlotUsageOpts{
+		{Since: since, Until: until, GroupBy: "step", Limit: 10},
+		{Since: since, Until: until, GroupBy: SlotUsageGroupByWorkerLabel, Limit: 10},
+		{Since: until, Until: since, GroupBy: SlotUsageGroupByWorkflow, Limit: 10},
+		{Since: since, Until: until, GroupBy: SlotUsageGroupByWorkflow},
+	}
+
+	for _, opts := range invalid {
+		err := opts.validate()
+		assert.True(t, errors.Is(err, ErrInvalidSlotUsageQuery), opts)
+	}
+}
@@ -39,6 +39,13 @@ type OperatorRepository interface {
 
 	// HasDAGOperator reports whether any DAG operator is registered for the given tenant.
 	HasDAGOperator(ctx context.Context, tenantId uuid.UUID) (bool, error)
+
+	// ListApprovalActions returns the action IDs of the tenant's approval steps. The approval
+	// operator polls this to keep its registered actions in sync with the tenant's workflows.
+	ListApprovalActions(ctx context.Context, tenantId uuid.UUID) ([]string, error)
+
+	// HasApprovalOperator reports whether an approval operator is registered for the given tenant.
+	HasApprovalOperator(ctx context.Context, tenantId uuid.UUID) (bool, error)
 }
 
 type operatorRepository struct {
@@ -164,6 +171,14 @@ func (r *operatorRepository) HasDAGOperator(ctx context.Context, tenantId uuid.U
 	return r.sharedRepository.hasDAGOperator(ctx, tenantId)
 }
 
+func (r *operatorRepository) ListApprovalActions(ctx context.Context, tenantId uuid.UUID) ([]string, error) {
+	return r.queries.ListApprovalActionsForTenant(ctx, r.pool, tenantId)
+}
+
+func (r *operatorRepository) HasApprovalOperator(ctx context.Context, tenantId uuid.UUID) (bool, error) {
+	return r.queries.TenantHasApprovalOperator(ctx, r.pool, tenantId)
+}
+
 func (r *operatorRepository) CreateOperatorWorker(ctx context.Context, dispatcherId uuid.UUID, operator *sqlcv1.V1Operator, slotConfig map[string]int32) (*sqlcv1.Worker, error) {
 	tx, commit, rollback, err := sqlchelpers.PrepareTx(ctx, r.pool, r.l)
 
@@ -0,0 +1,192 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"github.com/hatchet-dev/hatchet/pkg/repository/sqlchelpers"
+	"github.com/hatchet-dev/hatchet/pkg/repository/sqlcv1"
+	"github.com/hatchet-dev/hatchet/pkg/telemetry"
+)
+
+// DefaultMaxPreemptions is the number of times a task may be preempted when its step doesn't set
+// its own limit.
+const DefaultMaxPreemptions = 3
+
+// PreemptedTask is a running task attempt which was released for a higher-priority task. The task
+// has been requeued as a new attempt, which doesn't count against its retries.
+type PreemptedTask struct {
+	*sqlcv1.ReleaseTasksRow
+
+	// Priority is the priority of the preempted task.
+	Priority int32
+
+	// PreemptedForPriority is the priority of the waiting task the slot was freed for.
+	PreemptedForPriority int32
+
+	// NewRetryCount is the retry count of the requeued attempt.
+	NewRetryCount int32
+}
+
+type PreemptTasksResponse struct {
+	Preempted []*PreemptedTask
+}
+
+func (r *TaskRepositoryImpl) PreemptTasks(ctx context.Context, tenantId uuid.UUID, waiting []TaskIdInsertedAtRetryCount) (*PreemptTasksResponse, error) {
+	ctx, span := telemetry.NewSpan(ctx, "preempt-tasks")
+	defer span.End()
+
+	res := &PreemptTasksResponse{}
+
+	if len(waiting) == 0 {
+		return res, nil
+	}
+
+	tx, commit, rollback, err := sqlchelpers.PrepareTx(ctx, r.pool, r.l)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to prepare tx: %w", err)
+	}
+
+	defer rollback()
+
+	waitingIds := make([]int64, len(waiting))
+	waitingInsertedAts := make([]pgtype.Timestamptz, len(waiting))
+	waitingRetryCounts := make([]int32, len(waiting))
+
+	for i, task := range waiting {
+		waitingIds[i] = task.Id
+		waitingInsertedAts[i] = task.InsertedAt
+		waitingRetryCounts[i] = task.RetryCount
+	}
+
+	candidates, err := r.queries.ListPreemptionCandidates(ctx, tx, sqlcv1.ListPreemptionCandidatesParams{
+		Tenantid:        tenantId,
+		Taskids:         waitingIds,
+		Taskinsertedats: waitingInsertedAts,
+		Taskretrycounts: waitingRetryCounts,
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to list preemption candidates: %w", err)
+	}
+
+	// a running task can be a candidate for waiting tasks of several priorities, in which case it's
+	// preempted once, for the highest of them
+	preemptedFor := make(map[int64]int32, len(candidates))
+	victims := make([]TaskIdInsertedAtRetryCount, 0, len(candidates))
+
+	for _, candidate := range candidates {
+		if prev, ok := preemptedFor[candidate.ID]; ok {
+			preemptedFor[candidate.ID] = max(prev, candidate.WaitingPriority)
+			continue
+		}
+
+		preemptedFor[candidate.ID] = candidate.WaitingPriority
+
+		victims = append(victims, TaskIdInsertedAtRetryCount{
+			Id:         candidate.ID,
+			InsertedAt: candidate.InsertedAt,
+			RetryCount: candidate.RetryCount,
+		})
+	}
+
+	if len(victims) == 0 {
+		return res, nil
+	}
+
+	victimIds := make([]int64, len(victims))
+	victimInsertedAts := make([]pgtype.Timestamptz, len(victims))
+	victimRetryCounts := make([]int32, len(victims))
+
+	for i, task := range victims {
+		victimIds[i] = task.Id
+		victimInsertedAts[i] = task.InsertedAt
+		victimRetryCounts[i] = task.RetryCount
+	}
+
+	// the tasks may have finished or been preempted by another scheduler since they were listed, so
+	// only the tasks which are still running the listed attempt are preempted
+	preempted, err := r.queries.PreemptTasks(ctx, tx, sqlcv1.PreemptTasksParams{
+		Taskids:         victimIds,
+		Taskinsertedats: victimInsertedAts,
+		Taskretrycounts: victimRetryCounts,
+		Tenantid:        tenantId,
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to preempt tasks: %w", err)
+	}
+
+	if len(preempted) == 0 {
+		return res, nil
+	}
+
+	tasks := make([]TaskIdInsertedAtRetryCount, len(preempted))
+	taskIds := make([]int64, len(preempted))
+	taskInsertedAts := make([]pgtype.Timestamptz, len(preempted))
+	newRetryCounts := make([]int32, len(preempted))
+	priorities := make([]int32, len(preempted))
+
+	for i, task := range preempted {
+		tasks[i] = TaskIdInsertedAtRetryCount{
+			Id:         task.ID,
+			InsertedAt: task.InsertedAt,
+			RetryCount: task.RetryCount - 1,
+		}
+
+		taskIds[i] = task.ID
+		taskInsertedAts[i] = task.InsertedAt
+		newRetryCounts[i] = task.RetryCount
+		priorities[i] = task.Priority
+	}
+
+	err = r.queries.ResetPreemptedTaskPriorities(ctx, tx, sqlcv1.ResetPreemptedTaskPrioritiesParams{
+		Taskids:         taskIds,
+		Taskinsertedats: taskInsertedAts,
+		Taskretrycounts: newRetryCounts,
+		Priorities:      priorities,
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to reset priorities of preempted tasks: %w", err)
+	}
+
+	err = r.queries.RecordTaskPreemptions(ctx, tx, sqlcv1.RecordTaskPreemptionsParams{
+		Taskids:         taskIds,
+		Taskinsertedats: taskInsertedAts,
+		Tenantid:        tenantId,
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to record task preemptions: %w", err)
+	}
+
+	// NOTE: as when failing tasks, the new attempt must be written before the previous attempt is
+	// released, as the concurrency slot triggers case on it.
+	releasedTasks, err := r.releaseTasks(ctx, tx, tenantId, tasks)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if err := commit(ctx); err != nil {
+		return nil, fmt.Errorf("failed to commit tx: %w", err)
+	}
+
+	res.Preempted = make([]*PreemptedTask, len(releasedTasks))
+
+	for i, released := range releasedTasks {
+		res.Preempted[i] = &PreemptedTask{
+			ReleaseTasksRow:      released,
+			Priority:             priorities[i],
+			PreemptedForPriority: preemptedFor[released.ID],
+			NewRetryCount:        newRetryCounts[i],
+		}
+	}
+
+	return res, nil
+}
@@ -0,0 +1,343 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"github.com/hatchet-dev/hatchet/pkg/repository/sqlcv1"
+	"github.com/hatchet-dev/hatchet/pkg/telemetry"
+)
+
+const (
+	// MaxTaskPriority is the highest priority a task can be triggered with.
+	MaxTaskPriority = 3
+
+	// OverduePriority is the effective priority of a task which has been queued for longer than
+	// its policy's max wait. It orders the task ahead of every priority a task can be triggered with.
+	OverduePriority = MaxTaskPriority + 1
+)
+
+// ErrInvalidPriorityAging is returned when a priority aging policy can't be set as requested.
+var ErrInvalidPriorityAging = errors.New("invalid priority aging policy")
+
+// PriorityAgingPolicy raises the effective priority of a queued task the longer it waits, so that
+// sustained load at a higher priority can't starve it. The time in queue is measured from the
+// task's creation, so a retried task keeps the age it had accrued.
+type PriorityAgingPolicy struct {
+	// Interval is the time in queue after which a task's priority is raised by one level, up to
+	// MaxTaskPriority. Zero disables stepwise aging.
+	Interval time.Duration `json:"interval"`
+
+	// MaxWait is the time in queue after which a task is ordered ahead of every task which has not
+	// exceeded its own max wait. Zero disables it.
+	MaxWait time.Duration `json:"maxWait"`
+}
+
+// EffectivePriority returns the priority a task is ordered by after waiting in the queue.
+func (p *PriorityAgingPolicy) EffectivePriority(priority int32, waited time.Duration) int32 {
+	if p == nil || waited <= 0 {
+		return priority
+	}
+
+	if p.MaxWait > 0 && waited >= p.MaxWait {
+		return OverduePriority
+	}
+
+	if p.Interval > 0 && priority < MaxTaskPriority {
+		levels := waited / p.Interval
+
+		if levels >= MaxTaskPriority {
+			return MaxTaskPriority
+		}
+
+		return min(priority+int32(levels), MaxTaskPriority) // nolint: gosec
+	}
+
+	return priority
+}
+
+func priorityAgingPolicyFromRow(intervalSeconds, maxWaitSeconds pgtype.Int4) *PriorityAgingPolicy {
+	p := &PriorityAgingPolicy{}
+
+	if intervalSeconds.Valid {
+		p.Interval = time.Duration(intervalSeconds.Int32) * time.Second
+	}
+
+	if maxWaitSeconds.Valid {
+		p.MaxWait = time.Duration(maxWaitSeconds.Int32) * time.Second
+	}
+
+	if p.Interval <= 0 && p.MaxWait <= 0 {
+		return nil
+	}
+
+	return p
+}
+
+// PriorityAgingPolicies are the priority aging policies in effect for a tenant's queues.
+type PriorityAgingPolicies struct {
+	// the tenant's policy, which applies to workflows without their own
+	Tenant *PriorityAgingPolicy
+
+	Workflows map[uuid.UUID]*PriorityAgingPolicy
+}
+
+// Policy returns the policy which applies to the workflow's tasks, or nil if they don't age.
+func (p *PriorityAgingPolicies) Policy(workflowId uuid.UUID) *PriorityAgingPolicy {
+	if p == nil {
+		return nil
+	}
+
+	if policy, ok := p.Workflows[workflowId]; ok {
+		return policy
+	}
+
+	return p.Tenant
+}
+
+// Enabled returns whether any of the tenant's tasks age.
+func (p *PriorityAgingPolicies) Enabled() bool {
+	return p != nil && (p.Tenant != nil || len(p.Workflows) > 0)
+}
+
+// PriorityAgingOpts configures a priority aging policy. At least one of Interval or MaxWait must be
+// set.
+type PriorityAgingOpts struct {
+	// (optional) the time in queue after which a task's priority is raised by one level
+	Interval *string `json:"interval,omitempty" validate:"omitnil,duration"`
+
+	// (optional) the time in queue after which a task is ordered ahead of all other tasks
+	MaxWait *string `json:"maxWait,omitempty" validate:"omitnil,duration"`
+}
+
+// params converts the policy to whole seconds. A zero duration leaves the corresponding setting
+// unset.
+func (o *PriorityAgingOpts) params() (intervalSeconds, maxWaitSeconds pgtype.Int4, err error) {
+	toSeconds := func(name string, d *string) (pgtype.Int4, error) {
+		if d == nil || *d == "" {
+			return pgtype.Int4{}, nil
+		}
+
+		parsed, err := time.ParseDuration(*d)
+
+		if err != nil {
+			return pgtype.Int4{}, fmt.Errorf("%w: could not parse %s: %w", ErrInvalidPriorityAging, name, err)
+		}
+
+		if parsed < 0 {
+			return pgtype.Int4{}, fmt.Errorf("%w: %s must not be negative", ErrInvalidPriorityAging, name)
+		}
+
+		if parsed == 0 {
+			return pgtype.Int4{}, nil
+		}
+
+		if parsed < time.Second {
+			return pgtype.Int4{}, fmt.Errorf("%w: %s must be at least 1s", ErrInvalidPriorityAging, name)
+		}
+
+		if parsed > 365*24*time.Hour {
+			return pgtype.Int4{}, fmt.Errorf("%w: %s must be at most 8760h", ErrInvalidPriorityAging, name)
+		}
+
+		return pgtype.Int4{Int32: int32(parsed / time.Second), Valid: true}, nil // nolint: gosec
+	}
+
+	if intervalSeconds, err = toSeconds("interval", o.Interval); err != nil {
+		return
+	}
+
+	maxWaitSeconds, err = toSeconds("max wait", o.MaxWait)
+
+	return
+}
+
+type PriorityAgingRepository interface {
+	// GetTenantPriorityAging returns the tenant's priority aging policy, or nil if it has none.
+	GetTenantPriorityAging(ctx context.Context, tenantId uuid.UUID) (*PriorityAgingPolicy, error)
+
+	// UpdateTenantPriorityAging replaces the tenant's priority aging policy, which applies to
+	// workflows without their own. A policy with neither an interval nor a max wait removes it.
+	UpdateTenantPriorityAging(ctx context.Context, tenantId uuid.UUID, opts PriorityAgingOpts) (*PriorityAgingPolicy, error)
+
+	// ListPriorityAgingPolicies returns the priority aging policies in effect for the tenant.
+	ListPriorityAgingPolicies(ctx context.Context, tenantId uuid.UUID) (*PriorityAgingPolicies, error)
+}
+
+type priorityAgingRepository struct {
+	*sharedRepository
+}
+
+func newPriorityAgingRepository(shared *sharedRepository) PriorityAgingRepository {
+	return &priorityAgingRepository{
+		sharedRepository: shared,
+	}
+}
+
+func (r *priorityAgingRepository) GetTenantPriorityAging(ctx context.Context, tenantId uuid.UUID) (*PriorityAgingPolicy, error) {
+	row, err := r.queries.GetTenantPriorityAging(ctx, r.pool, tenantId)
+
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+
+		return nil, fmt.Errorf("could not get tenant priority aging: %w", err)
+	}
+
+	return priorityAgingPolicyFromRow(row.IntervalSeconds, row.MaxWaitSeconds), nil
+}
+
+func (r *priorityAgingRepository) UpdateTenantPriorityAging(ctx context.Context, tenantId uuid.UUID, opts PriorityAgingOpts) (*PriorityAgingPolicy, error) {
+	ctx, span := telemetry.NewSpan(ctx, "update-tenant-priority-aging")
+	defer span.End()
+
+	intervalSeconds, maxWaitSeconds, err := opts.params()
+
+	if err != nil {
+		return nil, err
+	}
+
+	if !intervalSeconds.Valid && !maxWaitSeconds.Valid {
+		if err := r.queries.DeleteTenantPriorityAging(ctx, r.pool, tenantId); err != nil {
+			return nil, fmt.Errorf("could not delete tenant priority aging: %w", err)
+		}
+
+		r.tenantPriorityAgingCache.Remove(tenantId)
+
+		return nil, nil
+	}
+
+	row, err := r.queries.UpsertTenantPriorityAging(ctx, r.pool, sqlcv1.UpsertTenantPriorityAgingParams{
+		Tenantid:        tenantId,
+		IntervalSeconds: intervalSeconds,
+		MaxWaitSeconds:  maxWaitSeconds,
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("could not upsert tenant priority aging: %w", err)
+	}
+
+	r.tenantPriorityAgingCache.Remove(tenantId)
+
+	return priorityAgingPolicyFromRow(row.IntervalSeconds, row.MaxWaitSeconds), nil
+}
+
+func (r *priorityAgingRepository) ListPriorityAgingPolicies(ctx context.Context, tenantId uuid.UUID) (*PriorityAgingPolicies, error) {
+	return r.listPriorityAgingPolicies(ctx, tenantId)
+}
+
+// listPriorityAgingPolicies reads the tenant's priority aging policies through a short-lived cache,
+// since the queuers look them up on every poll.
+func (s *sharedRepository) listPriorityAgingPolicies(ctx context.Context, tenantId uuid.UUID) (*PriorityAgingPolicies, error) {
+	if policies, ok := s.tenantPriorityAgingCache.Get(tenantId); ok {
+		return policies, nil
+	}
+
+	res := &PriorityAgingPolicies{
+		Workflows: make(map[uuid.UUID]*PriorityAgingPolicy),
+	}
+
+	tenantRow, err := s.queries.GetTenantPriorityAging(ctx, s.pool, tenantId)
+
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		return nil, fmt.Errorf("could not get tenant priority aging: %w", err)
+	}
+
+	if err == nil {
+		res.Tenant = priorityAgingPolicyFromRow(tenantRow.IntervalSeconds, tenantRow.MaxWaitSeconds)
+	}
+
+	workflowRows, err := s.queries.ListWorkflowPriorityAging(ctx, s.pool, tenantId)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not list workflow priority aging: %w", err)
+	}
+
+	for _, row := range workflowRows {
+		if policy := priorityAgingPolicyFromRow(row.IntervalSeconds, row.MaxWaitSeconds); policy != nil {
+			res.Workflows[row.WorkflowID] = policy
+		}
+	}
+
+	s.tenantPriorityAgingCache.Add(tenantId, res)
+
+	return res, nil
+}
+
+// setWorkflowPriorityAging replaces the workflow's priority aging policy with the one of its latest
+// version, removing it if the version has none.
+func (r *workflowRepository) setWorkflowPriorityAging(ctx context.Context, tx sqlcv1.DBTX, tenantId, workflowId uuid.UUID, opts *PriorityAgingOpts) error {
+	var intervalSeconds, maxWaitSeconds pgtype.Int4
+
+	if opts != nil {
+		var err error
+
+		intervalSeconds, maxWaitSeconds, err = opts.params()
+
+		if err != nil {
+			return err
+		}
+	}
+
+	if !intervalSeconds.Valid && !maxWaitSeconds.Valid {
+		return r.queries.DeleteWorkflowPriorityAging(ctx, tx, workflowId)
+	}
+
+	return r.queries.UpsertWorkflowPriorityAging(ctx, tx, sqlcv1.UpsertWorkflowPriorityAgingParams{
+		Workflowid:      workflowId,
+		Tenantid:        tenantId,
+		IntervalSeconds: intervalSeconds,
+		MaxWaitSeconds:  maxWaitSeconds,
+	})
+}
+
+func (d *queueRepository) GetPriorityAgingPolicies(ctx context.Context) (*PriorityAgingPolicies, error) {
+	return d.listPriorityAgingPolicies(ctx, d.tenantId)
+}
+
+// appendAgedQueueItems adds the oldest items of each lower priority to a full page of queue items
+// when the tenant's tasks age. Otherwise, a queue saturated with higher-priority items would never
+// read them, however long they had waited.
+func (d *queueRepository) appendAgedQueueItems(ctx context.Context, qis []*sqlcv1.V1QueueItem, limit int) ([]*sqlcv1.V1QueueItem, error) {
+	policies, err := d.GetPriorityAgingPolicies(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if !policies.Enabled() {
+		return qis, nil
+	}
+
+	aged, err := d.queries.ListAgedQueueItemsForQueue(ctx, d.pool, sqlcv1.ListAgedQueueItemsForQueueParams{
+		Tenantid:         d.tenantId,
+		Queue:            d.queueName,
+		GtId:             d.getMinId(),
+		Limitperpriority: int32(max(limit/2, 1)), // nolint: gosec
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	seen := make(map[int64]struct{}, len(qis))
+
+	for _, qi := range qis {
+		seen[qi.ID] = struct{}{}
+	}
+
+	for _, qi := range aged {
+		if _, ok := seen[qi.ID]; !ok {
+			qis = append(qis, qi)
+		}
+	}
+
+	return qis, nil
+}
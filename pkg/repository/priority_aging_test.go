@@ -0,0 +1,94 @@
+//go:build !e2e && !load && !rampup && !integration
+
+package repository
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPriorityAgingPolicyEffectivePriority(t *testing.T) {
+	policy := &PriorityAgingPolicy{Interval: time.Minute, MaxWait: 10 * time.Minute}
+
+	cases := []struct {
+		name     string
+		priority int32
+		waited   time.Duration
+		want     int32
+	}{
+		{"not yet aged", 1, 59 * time.Second, 1},
+		{"aged one level", 1, time.Minute, 2},
+		{"aged to the highest priority", 1, 5 * time.Minute, MaxTaskPriority},
+		{"already the highest priority", 3, 5 * time.Minute, 3},
+		{"past the max wait", 1, 10 * time.Minute, OverduePriority},
+		{"highest priority past the max wait", 3, 10 * time.Minute, OverduePriority},
+		{"clock skew", 2, -time.Minute, 2},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			assert.Equal(t, c.want, policy.EffectivePriority(c.priority, c.waited))
+		})
+	}
+
+	var none *PriorityAgingPolicy
+
+	assert.Equal(t, int32(1), none.EffectivePriority(1, time.Hour))
+
+	maxWaitOnly := &PriorityAgingPolicy{MaxWait: time.Hour}
+
+	assert.Equal(t, int32(1), maxWaitOnly.EffectivePriority(1, 59*time.Minute))
+	assert.Equal(t, int32(OverduePriority), maxWaitOnly.EffectivePriority(1, time.Hour))
+}
+
+func TestPriorityAgingPoliciesPolicy(t *testing.T) {
+	workflowId := uuid.New()
+	tenantPolicy := &PriorityAgingPolicy{Interval: time.Minute}
+	workflowPolicy := &PriorityAgingPolicy{MaxWait: time.Minute}
+
+	policies := &PriorityAgingPolicies{
+		Tenant:    tenantPolicy,
+		Workflows: map[uuid.UUID]*PriorityAgingPolicy{workflowId: workflowPolicy},
+	}
+
+	assert.True(t, policies.Enabled())
+	assert.Same(t, workflowPolicy, policies.Policy(workflowId))
+	assert.Same(t, tenantPolicy, policies.Policy(uuid.New()))
+
+	var none *PriorityAgingPolicies
+
+	assert.False(t, none.Enabled())
+	assert.Nil(t, none.Policy(workflowId))
+	assert.False(t, (&PriorityAgingPolicies{}).Enabled())
+}
+
+func TestPriorityAgingOptsParams(t *testing.T) {
+	str := func(s string) *string { return &s }
+
+	interval, maxWait, err := (&PriorityAgingOpts{Interval: str("1m30s"), MaxWait: str("1h")}).params()
+
+	require.NoError(t, err)
+	assert.Equal(t, int32(90), interval.Int32)
+	assert.Equal(t, int32(3600), maxWait.Int32)
+
+	interval, maxWait, err = (&PriorityAgingOpts{Interval: str("0s")}).params()
+
+	require.NoError(t, err)
+	assert.False(t, interval.Valid)
+	assert.False(t, maxWait.Valid)
+
+	for _, opts := range []PriorityAgingOpts{
+		{Interval: str("500ms")},
+		{MaxWait: str("-1m")},
+		{MaxWait: str("9000h")},
+		{Interval: str("soon")},
+	} {
+		_, _, err := opts.params()
+		assert.True(t, errors.Is(err, ErrInvalidPriorityAging), opts)
+	}
+}
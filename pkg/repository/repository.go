@@ -20,11 +20,17 @@ type TaskOperationLimits struct {
 
 type Repository interface {
 	APIToken() APITokenRepository
+	Approvals() ApprovalRepository
+	BulkJobs() BulkJobRepository
+	CircuitBreakers() CircuitBreakerRepository
+	DelayedEvents() DelayedEventRepository
 	Dispatcher() DispatcherRepository
 	DurableEvents() DurableEventsRepository
+	EventSchemas() EventSchemaRepository
 	Health() HealthRepository
 	MessageQueue() MessageQueueRepository
 	RateLimit() RateLimitRepository
+	PriorityAging() PriorityAgingRepository
 	Triggers() TriggerRepository
 	Tasks() TaskRepository
 	Scheduler() SchedulerRepository
@@ -36,6 +42,7 @@ type Repository interface {
 	Payloads() PayloadStoreRepository
 	OverwriteExternalPayloadStore(o ExternalStore)
 	Workers() WorkerRepository
+	WorkerCordons() WorkerCordonRepository
 	Workflows() WorkflowRepository
 	Ticker() TickerRepository
 	Filters() FilterRepository
@@ -60,11 +67,17 @@ type Repository interface {
 
 type repositoryImpl struct {
 	apiToken          APITokenRepository
+	approvals         ApprovalRepository
+	bulkJobs          BulkJobRepository
+	circuitBreakers   CircuitBreakerRepository
+	delayedEvents     DelayedEventRepository
 	dispatcher        DispatcherRepository
 	durableEvents     DurableEventsRepository
+	eventSchemas      EventSchemaRepository
 	health            HealthRepository
 	messageQueue      MessageQueueRepository
 	rateLimit         RateLimitRepository
+	priorityAging     PriorityAgingRepository
 	triggers          TriggerRepository
 	tasks             TaskRepository
 	scheduler         SchedulerRepository
@@ -72,6 +85,7 @@ type repositoryImpl struct {
 	olap              OLAPRepository
 	logs              LogLineRepository
 	workers           WorkerRepository
+	workerCordons     WorkerCordonRepository
 	workflows         WorkflowRepository
 	ticker            TickerRepository
 	filters           FilterRepository
@@ -115,11 +129,17 @@ func NewRepository(
 
 	impl := &repositoryImpl{
 		apiToken:          newAPITokenRepository(shared, cacheDuration),
+		approvals:         newApprovalRepository(shared),
+		bulkJobs:          newBulkJobRepository(shared),
+		circuitBreakers:   newCircuitBreakerRepository(shared),
+		delayedEvents:     newDelayedEventRepository(shared),
 		dispatcher:        newDispatcherRepository(shared),
 		durableEvents:     newDurableEventsRepository(shared),
+		eventSchemas:      newEventSchemaRepository(shared),
 		health:            newHealthRepository(shared),
 		messageQueue:      mq,
 		rateLimit:         newRateLimitRepository(shared),
+		priorityAging:     newPriorityAgingRepository(shared),
 		triggers:          newTriggerRepository(shared),
 		tasks:             newTaskRepository(shared, taskRetentionPeriod, maxInternalRetryCount, taskLimits.TimeoutLimit, taskLimits.ReassignLimit, taskLimits.RetryQueueLimit, taskLimits.DurableSleepLimit),
 		scheduler:         newSchedulerRepository(shared),
@@ -127,6 +147,7 @@ func NewRepository(
 		olap:              newOLAPRepository(shared, olapRetentionPeriod, true, true, statusUpdateBatchSizeLimits),
 		logs:              newLogLineRepository(shared),
 		workers:           newWorkerRepository(shared),
+		workerCordons:     newWorkerCordonRepository(shared),
 		workflows:         newWorkflowRepository(shared),
 		ticker:            newTickerRepository(shared),
 		filters:           newFilterRepository(shared),
@@ -169,6 +190,22 @@ func (r *repositoryImpl) APIToken() APITokenRepository {
 	return r.apiToken
 }
 
+func (r *repositoryImpl) Approvals() ApprovalRepository {
+	return r.approvals
+}
+
+func (r *repositoryImpl) BulkJobs() BulkJobRepository {
+	return r.bulkJobs
+}
+
+func (r *repositoryImpl) CircuitBreakers() CircuitBreakerRepository {
+	return r.circuitBreakers
+}
+
+func (r *repositoryImpl) DelayedEvents() DelayedEventRepository {
+	return r.delayedEvents
+}
+
 func (r *repositoryImpl) Dispatcher() DispatcherRepository {
 	return r.dispatcher
 }
@@ -177,6 +214,10 @@ func (r *repositoryImpl) DurableEvents() DurableEventsRepository {
 	return r.durableEvents
 }
 
+func (r *repositoryImpl) EventSchemas() EventSchemaRepository {
+	return r.eventSchemas
+}
+
 func (r *repositoryImpl) Health() HealthRepository {
 	return r.health
 }
@@ -193,6 +234,10 @@ func (r *repositoryImpl) RateLimit() RateLimitRepository {
 	return r.rateLimit
 }
 
+func (r *repositoryImpl) PriorityAging() PriorityAgingRepository {
+	return r.priorityAging
+}
+
 func (r *repositoryImpl) Tasks() TaskRepository {
 	return r.tasks
 }
@@ -233,6 +278,10 @@ func (r *repositoryImpl) Workers() WorkerRepository {
 	return r.workers
 }
 
+func (r *repositoryImpl) WorkerCordons() WorkerCordonRepository {
+	return r.workerCordons
+}
+
 func (r *repositoryImpl) Workflows() WorkflowRepository {
 	return r.workflows
 }
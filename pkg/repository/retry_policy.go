@@ -0,0 +1,142 @@
+package repository
+
+import (
+	"context"
+
+	celgo "github.com/google/cel-go/cel"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"github.com/hatchet-dev/hatchet/internal/cel"
+	"github.com/hatchet-dev/hatchet/pkg/repository/sqlcv1"
+)
+
+// ErrorClassTimeout is the error class of failures caused by a task exceeding its timeout, so that
+// retry policies can match timeouts.
+const ErrorClassTimeout = "TIMEOUT"
+
+// retryOverride is the effect of a matched retry policy on a single failure. Negative values fall
+// back to the step's configuration.
+type retryOverride struct {
+	// the index of the matched policy, whose retries are counted separately from the step's
+	policyIndex       int32
+	neverRetry        bool
+	retries           int32
+	backoffFactor     float64
+	backoffMaxSeconds int32
+}
+
+var defaultRetryOverride = retryOverride{
+	policyIndex:       -1,
+	retries:           -1,
+	backoffFactor:     -1,
+	backoffMaxSeconds: -1,
+}
+
+// listRetryPolicies returns the retry policies of the tasks' steps, keyed by task id and ordered by
+// policy index.
+func (r *sharedRepository) listRetryPolicies(ctx context.Context, tx sqlcv1.DBTX, tenantId uuid.UUID, taskIds []int64, taskInsertedAts []pgtype.Timestamptz) (map[int64][]*sqlcv1.ListTaskRetryPoliciesRow, error) {
+	rows, err := r.queries.ListTaskRetryPolicies(ctx, tx, sqlcv1.ListTaskRetryPoliciesParams{
+		Tenantid:        tenantId,
+		Taskids:         taskIds,
+		Taskinsertedats: taskInsertedAts,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	res := make(map[int64][]*sqlcv1.ListTaskRetryPoliciesRow)
+
+	for _, row := range rows {
+		res[row.TaskID] = append(res[row.TaskID], row)
+	}
+
+	return res, nil
+}
+
+// matchRetryPolicy evaluates the policies in order and returns the override of the first one which
+// matches the failure. Policies with an expression which fails to evaluate are skipped.
+func (r *sharedRepository) matchRetryPolicy(policies []*sqlcv1.ListTaskRetryPoliciesRow, opt FailTaskOpts) retryOverride {
+	for _, policy := range policies {
+		if policy.ErrorClass.Valid && policy.ErrorClass.String != opt.ErrorClass {
+			continue
+		}
+
+		if policy.Expression.Valid {
+			program, err := r.retryPolicyProgram(policy)
+
+			if err != nil {
+				r.l.Warn().Err(err).Msgf("could not compile retry policy %d for step %s", policy.PolicyIndex, policy.StepID)
+				continue
+			}
+
+			matched, err := cel.EvaluateErrorProgram(
+				program,
+				cel.NewInput(
+					cel.WithError(opt.ErrorClass, opt.ErrorMessage),
+					cel.WithRetryCount(opt.RetryCount),
+				),
+			)
+
+			if err != nil {
+				r.l.Warn().Err(err).Msgf("could not evaluate retry policy %d for step %s", policy.PolicyIndex, policy.StepID)
+				continue
+			}
+
+			if !matched {
+				continue
+			}
+		}
+
+		res := defaultRetryOverride
+		res.policyIndex = policy.PolicyIndex
+		res.neverRetry = policy.NeverRetry
+
+		if policy.Retries.Valid {
+			res.retries = policy.Retries.Int32
+		}
+
+		if policy.BackoffFactor.Valid {
+			res.backoffFactor = policy.BackoffFactor.Float64
+
+			// a policy which only sets the factor uses the same default max as task-level backoff
+			res.backoffMaxSeconds = DefaultRetryBackoffMaxSeconds
+		}
+
+		if policy.BackoffMaxSeconds.Valid {
+			res.backoffMaxSeconds = policy.BackoffMaxSeconds.Int32
+		}
+
+		return res
+	}
+
+	return defaultRetryOverride
+}
+
+// retryPolicyKey identifies a retry policy. Steps belong to a single workflow version, so a policy
+// never changes once it's created.
+type retryPolicyKey struct {
+	stepId      uuid.UUID
+	policyIndex int32
+}
+
+// retryPolicyProgram returns the compiled expression of a retry policy, compiling it the first time
+// the policy is seen.
+func (r *sharedRepository) retryPolicyProgram(policy *sqlcv1.ListTaskRetryPoliciesRow) (celgo.Program, error) {
+	key := retryPolicyKey{stepId: policy.StepID, policyIndex: policy.PolicyIndex}
+
+	if program, ok := r.retryPolicyProgramCache.Get(key); ok {
+		return program, nil
+	}
+
+	program, err := r.celParser.ParseErrorExpression(policy.Expression.String)
+
+	if err != nil {
+		return nil, err
+	}
+
+	r.retryPolicyProgramCache.Add(key, program)
+
+	return program, nil
+}
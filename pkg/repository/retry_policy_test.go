@@ -0,0 +1,104 @@
+//go:build !e2e && !load && !rampup && !integration
+
+package repository
+
+import (
+	"testing"
+	"time"
+
+	celgo "github.com/google/cel-go/cel"
+	"github.com/hashicorp/golang-lru/v2/expirable"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/hatchet-dev/hatchet/internal/cel"
+	"github.com/hatchet-dev/hatchet/pkg/repository/sqlchelpers"
+	"github.com/hatchet-dev/hatchet/pkg/repository/sqlcv1"
+)
+
+func TestMatchRetryPolicy(t *testing.T) {
+	logger := zerolog.Nop()
+
+	r := &sharedRepository{
+		l:                       &logger,
+		celParser:               cel.NewCELParser(),
+		retryPolicyProgramCache: expirable.NewLRU(10, func(retryPolicyKey, celgo.Program) {}, time.Minute),
+	}
+
+	policies := []*sqlcv1.ListTaskRetryPoliciesRow{
+		{
+			PolicyIndex: 0,
+			ErrorClass:  sqlchelpers.TextFromStr("ValidationError"),
+			NeverRetry:  true,
+		},
+		{
+			PolicyIndex:   1,
+			Expression:    sqlchelpers.TextFromStr(`error.message.contains("429")`),
+			Retries:       pgtype.Int4{Int32: 10, Valid: true},
+			BackoffFactor: pgtype.Float8{Float64: 2, Valid: true},
+		},
+		{
+			PolicyIndex:       2,
+			ErrorClass:        sqlchelpers.TextFromStr(ErrorClassTimeout),
+			Expression:        sqlchelpers.TextFromStr(`retry_count < 2`),
+			BackoffMaxSeconds: pgtype.Int4{Int32: 30, Valid: true},
+		},
+		{
+			PolicyIndex: 3,
+			Expression:  sqlchelpers.TextFromStr(`error.class`), // not a boolean, skipped
+			NeverRetry:  true,
+		},
+	}
+
+	failure := func(class, message string, retryCount int32) FailTaskOpts {
+		return FailTaskOpts{
+			TaskIdInsertedAtRetryCount: &TaskIdInsertedAtRetryCount{RetryCount: retryCount},
+			ErrorClass:                 class,
+			ErrorMessage:               message,
+		}
+	}
+
+	tests := []struct {
+		name     string
+		failure  FailTaskOpts
+		expected retryOverride
+	}{
+		{
+			name:     "class match",
+			failure:  failure("ValidationError", "429", 0),
+			expected: retryOverride{policyIndex: 0, neverRetry: true, retries: -1, backoffFactor: -1, backoffMaxSeconds: -1},
+		},
+		{
+			name:     "expression match",
+			failure:  failure("", "got 429 from upstream", 0),
+			expected: retryOverride{policyIndex: 1, retries: 10, backoffFactor: 2, backoffMaxSeconds: 86400},
+		},
+		{
+			name:     "class and expression match",
+			failure:  failure(ErrorClassTimeout, "Task exceeded timeout of 1m", 1),
+			expected: retryOverride{policyIndex: 2, retries: -1, backoffFactor: -1, backoffMaxSeconds: 30},
+		},
+		{
+			name:     "expression does not match",
+			failure:  failure(ErrorClassTimeout, "Task exceeded timeout of 1m", 2),
+			expected: defaultRetryOverride,
+		},
+		{
+			name:     "no match",
+			failure:  failure("OtherError", "boom", 0),
+			expected: defaultRetryOverride,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, r.matchRetryPolicy(policies, tt.failure))
+		})
+	}
+
+	assert.Equal(t, defaultRetryOverride, r.matchRetryPolicy(nil, failure("ValidationError", "", 0)))
+
+	// the expressions which compiled are cached, rather than compiled for every failure
+	assert.Equal(t, 2, r.retryPolicyProgramCache.Len())
+}
@@ -0,0 +1,290 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgerrcode"
+	"github.com/jackc/pgx/v5/pgconn"
+
+	"github.com/hatchet-dev/hatchet/internal/cel"
+	"github.com/hatchet-dev/hatchet/pkg/repository/sqlchelpers"
+	"github.com/hatchet-dev/hatchet/pkg/repository/sqlcv1"
+	"github.com/hatchet-dev/hatchet/pkg/telemetry"
+)
+
+// ErrInvalidRunSearch is returned when a run search can't be run as requested, for example because
+// the expression is invalid or the search window is too large.
+var ErrInvalidRunSearch = errors.New("invalid run search")
+
+const (
+	// MaxRunSearchWindow is the largest time window a single run search can cover.
+	MaxRunSearchWindow = 7 * 24 * time.Hour
+
+	DefaultRunSearchLimit = 50
+	MaxRunSearchLimit     = 500
+
+	// runSearchStatementTimeoutMs bounds how long a run search can run in the database.
+	runSearchStatementTimeoutMs = 10_000
+)
+
+type SearchWorkflowRunsOpts struct {
+	// (required) the search expression, see cel.CompileSearchExpr
+	Where string
+
+	// (required) the start of the time window, on the run's creation time
+	Since time.Time
+
+	// (optional) the end of the time window, on the run's creation time, defaults to now. Outputs and
+	// errors of runs created within the window are searched even if they were recorded after it.
+	Until *time.Time
+
+	// (optional) the statuses to filter by
+	Statuses []sqlcv1.V1ReadableStatusOlap
+
+	// (optional) the workflow ids to filter by
+	WorkflowIds []uuid.UUID
+
+	// (optional) the maximum number of runs to return, defaults to 50
+	Limit int64
+
+	IncludePayloads bool
+}
+
+// SearchWorkflowRuns returns the most recent runs in a time window which match a search expression
+// over their inputs, outputs, error messages and additional metadata. Searches are bounded by
+// MaxRunSearchWindow, MaxRunSearchLimit and a statement timeout.
+//
+// Only payloads stored inline in the OLAP database are searched; payloads which have been offloaded
+// to external storage never match.
+func (r *OLAPRepositoryImpl) SearchWorkflowRuns(ctx context.Context, tenantId uuid.UUID, opts SearchWorkflowRunsOpts) ([]*WorkflowRunData, error) {
+	ctx, span := telemetry.NewSpan(ctx, "search-workflow-runs-olap")
+	defer span.End()
+
+	expr, err := cel.CompileSearchExpr(opts.Where)
+
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidRunSearch, err.Error())
+	}
+
+	until := time.Now()
+
+	if opts.Until != nil {
+		until = *opts.Until
+	}
+
+	if opts.Since.IsZero() {
+		return nil, fmt.Errorf("%w: since is required", ErrInvalidRunSearch)
+	}
+
+	if !until.After(opts.Since) {
+		return nil, fmt.Errorf("%w: until must be after since", ErrInvalidRunSearch)
+	}
+
+	if until.Sub(opts.Since) > MaxRunSearchWindow {
+		return nil, fmt.Errorf("%w: the search window can be at most %s", ErrInvalidRunSearch, MaxRunSearchWindow)
+	}
+
+	limit := opts.Limit
+
+	if limit <= 0 {
+		limit = DefaultRunSearchLimit
+	}
+
+	if limit > MaxRunSearchLimit {
+		return nil, fmt.Errorf("%w: limit can be at most %d", ErrInvalidRunSearch, MaxRunSearchLimit)
+	}
+
+	statuses := make([]string, 0, len(opts.Statuses))
+
+	for _, status := range opts.Statuses {
+		statuses = append(statuses, string(status))
+	}
+
+	if len(statuses) == 0 {
+		statuses = []string{
+			string(sqlcv1.V1ReadableStatusOlapQUEUED),
+			string(sqlcv1.V1ReadableStatusOlapRUNNING),
+			string(sqlcv1.V1ReadableStatusOlapCOMPLETED),
+			string(sqlcv1.V1ReadableStatusOlapCANCELLED),
+			string(sqlcv1.V1ReadableStatusOlapFAILED),
+		}
+	}
+
+	params := sqlcv1.SearchWorkflowRunIdsParams{
+		Tenantid: tenantId,
+		Statuses: statuses,
+		Since:    sqlchelpers.TimestamptzFromTime(opts.Since),
+		Until:    sqlchelpers.TimestamptzFromTime(until),
+		Limit:    int32(limit), // nolint: gosec
+	}
+
+	if len(opts.WorkflowIds) > 0 {
+		params.WorkflowIds = opts.WorkflowIds
+	}
+
+	b := &runSearchPredicateBuilder{}
+	params.Predicate = b.build(expr)
+	params.PredicateArgs = b.args
+
+	tx, commit, rollback, err := sqlchelpers.PrepareTxWithStatementTimeout(ctx, r.readPool, r.l, runSearchStatementTimeoutMs)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer rollback()
+
+	workflowRunIds, err := r.queries.SearchWorkflowRunIds(ctx, tx, params)
+
+	if err != nil {
+		return nil, runSearchError(err)
+	}
+
+	return r.populateWorkflowRuns(ctx, tx, commit, tenantId, workflowRunIds, opts.IncludePayloads)
+}
+
+// runSearchError maps the database errors a search expression can cause to ErrInvalidRunSearch.
+func runSearchError(err error) error {
+	var pgErr *pgconn.PgError
+
+	if !errors.As(err, &pgErr) {
+		return err
+	}
+
+	switch pgErr.Code {
+	case pgerrcode.SyntaxError, pgerrcode.InvalidRegularExpression, pgerrcode.InvalidTextRepresentation:
+		return fmt.Errorf("%w: %s", ErrInvalidRunSearch, pgErr.Message)
+	case pgerrcode.QueryCanceled:
+		return fmt.Errorf("%w: the search timed out, narrow the time window or add filters", ErrInvalidRunSearch)
+	}
+
+	return err
+}
+
+// runSearchPredicateBuilder renders a compiled search expression to the predicate of
+// SearchWorkflowRunIds, collecting its parameters. Every condition is scoped to the run's tenant and
+// the search window so the payload partitions outside of it are pruned.
+type runSearchPredicateBuilder struct {
+	args []any
+}
+
+func (b *runSearchPredicateBuilder) arg(v any) string {
+	b.args = append(b.args, v)
+
+	return fmt.Sprintf("$%d", sqlcv1.SearchWorkflowRunIdsFirstPredicateArg+len(b.args)-1)
+}
+
+func (b *runSearchPredicateBuilder) build(expr *cel.SearchExpr) string {
+	switch {
+	case expr.Condition != nil:
+		return "COALESCE(" + b.condition(expr.Condition) + ", FALSE)"
+	case expr.Not != nil:
+		return "NOT (" + b.build(expr.Not) + ")"
+	case len(expr.And) > 0:
+		return b.join(expr.And, " AND ")
+	default:
+		return b.join(expr.Or, " OR ")
+	}
+}
+
+func (b *runSearchPredicateBuilder) join(exprs []*cel.SearchExpr, sep string) string {
+	parts := make([]string, len(exprs))
+
+	for i, e := range exprs {
+		parts[i] = "(" + b.build(e) + ")"
+	}
+
+	return strings.Join(parts, sep)
+}
+
+// runTaskSet selects the (id, inserted_at) of the tasks of the run r: the run itself for
+// standalone tasks, and its tasks for DAGs.
+const runTaskSet = `SELECT r.id, r.inserted_at WHERE r.kind = 'TASK'
+	UNION ALL
+	SELECT dt.task_id, dt.task_inserted_at FROM v1_dag_to_task_olap dt
+	WHERE r.kind = 'DAG' AND dt.dag_id = r.id AND dt.dag_inserted_at = r.inserted_at`
+
+func (b *runSearchPredicateBuilder) condition(c *cel.SearchCondition) string {
+	switch c.Field {
+	case cel.SearchFieldInput:
+		// the input payload of a run is keyed by the run's external id. Standalone task inputs are
+		// nested under an "input" key.
+		payload := func(match string) string {
+			return `r.external_id IN (
+	SELECT p.external_id FROM v1_payloads_olap p
+	WHERE p.tenant_id = $1::uuid
+		AND p.inserted_at >= $4::timestamptz
+		AND p.inserted_at <= $5::timestamptz
+		AND ` + match + `)`
+		}
+
+		if c.IsText {
+			return payload(b.text("p.inline_content::text", c))
+		}
+
+		return fmt.Sprintf("(r.kind = 'TASK' AND %s) OR (r.kind = 'DAG' AND %s)",
+			payload("p.inline_content @@ "+b.arg(c.JSONPath("input"))+"::jsonpath"),
+			payload("p.inline_content @@ "+b.arg(c.JSONPath())+"::jsonpath"),
+		)
+	case cel.SearchFieldOutput:
+		// outputs are stored on the event which completed a task, so a run matches if any of its
+		// tasks completed with a matching output. Only the run is bounded by the search window, since
+		// its outputs can be written after the window ends.
+		var match string
+
+		if c.IsText {
+			match = b.text("p.inline_content::text", c)
+		} else {
+			match = "p.inline_content @@ " + b.arg(c.JSONPath()) + "::jsonpath"
+		}
+
+		return `EXISTS (
+	SELECT 1 FROM v1_task_events_olap e
+	JOIN v1_payloads_olap p ON (p.tenant_id, p.external_id) = (e.tenant_id, e.external_id)
+	WHERE e.tenant_id = $1::uuid
+		AND (e.task_id, e.task_inserted_at) IN (` + runTaskSet + `)
+		AND e.event_type = 'FINISHED'
+		AND e.readable_status = 'COMPLETED'
+		AND ` + match + `)`
+	case cel.SearchFieldError:
+		return `EXISTS (
+	SELECT 1 FROM v1_task_events_olap e
+	WHERE e.tenant_id = $1::uuid
+		AND (e.task_id, e.task_inserted_at) IN (` + runTaskSet + `)
+		AND e.readable_status = 'FAILED'
+		AND ` + b.text("e.error_message", c) + `)`
+	default:
+		if c.IsText {
+			return b.text("r.additional_metadata::text", c)
+		}
+
+		return "r.additional_metadata @@ " + b.arg(c.JSONPath()) + "::jsonpath"
+	}
+}
+
+// text renders a text condition on a column.
+func (b *runSearchPredicateBuilder) text(column string, c *cel.SearchCondition) string {
+	switch c.TextOp {
+	case cel.TextOpEquals:
+		return column + " = " + b.arg(c.Text)
+	case cel.TextOpMatches:
+		return column + " ~ " + b.arg(c.Text)
+	case cel.TextOpStartsWith:
+		return column + " LIKE " + b.arg(escapeLike(c.Text)+"%")
+	case cel.TextOpEndsWith:
+		return column + " LIKE " + b.arg("%"+escapeLike(c.Text))
+	default:
+		return column + " LIKE " + b.arg("%"+escapeLike(c.Text)+"%")
+	}
+}
+
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+func escapeLike(s string) string {
+	return likeEscaper.Replace(s)
+}
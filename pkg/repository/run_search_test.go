@@ -0,0 +1,99 @@
+//go:build !e2e && !load && !rampup && !integration
+
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hatchet-dev/hatchet/internal/cel"
+)
+
+func TestRunSearchPredicateBuilder(t *testing.T) {
+	expr, err := cel.CompileSearchExpr(`input.customer.email == "a@b.com" && !error.contains("50%_off") || additional_metadata.env == "prod"`)
+	require.NoError(t, err)
+
+	b := &runSearchPredicateBuilder{}
+	predicate := b.build(expr)
+
+	assert.Equal(t, []any{
+		`$."input"."customer"."email" == "a@b.com"`,
+		`$."customer"."email" == "a@b.com"`,
+		`%50\%\_off%`,
+		`$."env" == "prod"`,
+	}, b.args)
+
+	assert.Contains(t, predicate, "p.inline_content @@ $7::jsonpath")
+	assert.Contains(t, predicate, "p.inline_content @@ $8::jsonpath")
+	assert.Contains(t, predicate, "NOT (COALESCE(EXISTS (")
+	assert.Contains(t, predicate, "e.error_message LIKE $9")
+	assert.Contains(t, predicate, "r.additional_metadata @@ $10::jsonpath")
+}
+
+func TestRunSearchPredicateBuilderDoesNotBoundEvents(t *testing.T) {
+	for _, where := range []string{`output.status == "ok"`, `error.contains("timeout")`} {
+		expr, err := cel.CompileSearchExpr(where)
+		require.NoError(t, err)
+
+		b := &runSearchPredicateBuilder{}
+		predicate := b.build(expr)
+
+		assert.NotContains(t, predicate, "e.inserted_at", where)
+		assert.NotContains(t, predicate, "p.inserted_at", where)
+	}
+}
+
+func TestRunSearchPredicateBuilderText(t *testing.T) {
+	tests := []struct {
+		expr      string
+		predicate string
+		arg       string
+	}{
+		{`error == "boom"`, "e.error_message = $7", "boom"},
+		{`error.startsWith("boom")`, "e.error_message LIKE $7", "boom%"},
+		{`error.endsWith("boom")`, "e.error_message LIKE $7", "%boom"},
+		{`error.matches("^bo+m$")`, "e.error_message ~ $7", "^bo+m$"},
+		{`output.contains("timeout")`, "p.inline_content::text LIKE $7", "%timeout%"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expr, func(t *testing.T) {
+			expr, err := cel.CompileSearchExpr(tt.expr)
+			require.NoError(t, err)
+
+			b := &runSearchPredicateBuilder{}
+
+			assert.Contains(t, b.build(expr), tt.predicate)
+			assert.Equal(t, []any{tt.arg}, b.args)
+		})
+	}
+}
+
+func TestSearchWorkflowRunsValidation(t *testing.T) {
+	r := &OLAPRepositoryImpl{}
+	now := time.Now()
+	before := now.Add(-time.Hour)
+
+	tests := []struct {
+		name string
+		opts SearchWorkflowRunsOpts
+	}{
+		{"invalid expression", SearchWorkflowRunsOpts{Where: "input.a ==", Since: before}},
+		{"missing since", SearchWorkflowRunsOpts{Where: `input.a == 1`}},
+		{"until before since", SearchWorkflowRunsOpts{Where: `input.a == 1`, Since: now, Until: &before}},
+		{"window too large", SearchWorkflowRunsOpts{Where: `input.a == 1`, Since: now.Add(-8 * 24 * time.Hour)}},
+		{"limit too large", SearchWorkflowRunsOpts{Where: `input.a == 1`, Since: before, Limit: MaxRunSearchLimit + 1}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := r.SearchWorkflowRuns(context.Background(), uuid.New(), tt.opts)
+			assert.ErrorIs(t, err, ErrInvalidRunSearch)
+		})
+	}
+}
@@ -20,6 +20,7 @@ const PARENT_STRATEGY_LOCK_OFFSET = 1000000000000 // 1 trillion
 const (
 	CancelledReasonConcurrencyLimit   = "CONCURRENCY_LIMIT"
 	CancelledReasonSchedulingTimedOut = "SCHEDULING_TIMED_OUT"
+	CancelledReasonPreempted          = "PREEMPTED"
 )
 
 type TaskWithQueue struct {
@@ -42,6 +43,10 @@ type TaskWithCancelledReason struct {
 	// runtime in-transaction, so the downstream MsgIDTaskCancelled handler can no longer resolve the
 	// worker; the scheduler uses it to signal the dispatcher directly for in-progress cancellations.
 	WorkerId uuid.UUID
+
+	// SlotUsage is the slot time the task consumed on its worker, if it was running when it was
+	// cancelled.
+	SlotUsage []TaskSlotUsage
 }
 
 // CancelledSlotInput identifies a concurrency slot to cancel along with the reason it's being
@@ -1001,7 +1006,8 @@ func (c *ConcurrencyRepositoryImpl) UpdateConcurrencySlotsTx(
 			// releaseTasks deleted v1_task_runtime here, so the downstream MsgIDTaskCancelled handler
 			// can no longer resolve the worker for an in-progress cancellation. Capture the worker id
 			// now so the scheduler can signal the dispatcher directly (see notifyAfterConcurrency).
-			WorkerId: released.WorkerID,
+			WorkerId:  released.WorkerID,
+			SlotUsage: TaskSlotUsageFromReleasedTasks([]*sqlcv1.ReleaseTasksRow{released}),
 		})
 	}
 
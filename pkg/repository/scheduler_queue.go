@@ -196,6 +196,17 @@ func (d *queueRepository) ListQueueItems(ctx context.Context, limit int) ([]*sql
 		return nil, nil
 	}
 
+	if len(qis) == limit {
+		withAged, err := d.appendAgedQueueItems(ctx, qis, limit)
+
+		if err != nil {
+			// fall back to strict priority ordering
+			d.l.Error().Err(err).Msgf("could not list aged queue items for queue %s", d.queueName)
+		} else {
+			qis = withAged
+		}
+	}
+
 	listTime := time.Since(checkpoint)
 	checkpoint = time.Now()
 
@@ -626,6 +637,11 @@ func (d *queueRepository) GetTaskRateLimits(ctx context.Context, tx *OptimisticT
 	rateLimitKeyToEvals := make(map[string][]*sqlcv1.V1TaskExpressionEval)
 
 	for _, eval := range expressionEvals {
+		// circuit breaker keys are stored alongside rate limit expressions
+		if eval.Kind == sqlcv1.StepExpressionKindCIRCUITBREAKERKEY {
+			continue
+		}
+
 		k := taskIdAndGlobalKeyToKey[fmt.Sprintf("%d-%s", eval.TaskID, eval.Key)]
 
 		if _, ok := rateLimitKeyToEvals[k]; !ok {
@@ -942,6 +958,42 @@ func (d *queueRepository) GetStepBatchConfigs(ctx context.Context, tx *Optimisti
 	return res, nil
 }
 
+// GetWorkflowRunWorkers returns the workers which are running tasks of each of the given workflow
+// runs.
+func (d *queueRepository) GetWorkflowRunWorkers(ctx context.Context, tx *OptimisticTx, workflowRunIds []uuid.UUID) (map[uuid.UUID][]uuid.UUID, error) {
+	ctx, span := telemetry.NewSpan(ctx, "get-workflow-run-workers")
+	defer span.End()
+
+	res := make(map[uuid.UUID][]uuid.UUID)
+
+	if len(workflowRunIds) == 0 {
+		return res, nil
+	}
+
+	var queryTx sqlcv1.DBTX
+
+	if tx != nil {
+		queryTx = tx.tx
+	} else {
+		queryTx = d.pool
+	}
+
+	rows, err := d.queries.ListWorkflowRunWorkers(ctx, queryTx, sqlcv1.ListWorkflowRunWorkersParams{
+		Tenantid:       d.tenantId,
+		Workflowrunids: listutils.Uniq(workflowRunIds),
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("could not list workflow run workers: %w", err)
+	}
+
+	for _, row := range rows {
+		res[row.WorkflowRunID] = append(res[row.WorkflowRunID], row.WorkerID)
+	}
+
+	return res, nil
+}
+
 // ListWorkflowNamesByIds resolves workflow ids to names through the shared
 // workflowIdNameCache, fetching only uncached ids from the database. Ids which cannot be
 // resolved are absent from the result.
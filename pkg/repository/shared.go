@@ -6,6 +6,7 @@ import (
 	"log"
 	"time"
 
+	celgo "github.com/google/cel-go/cel"
 	lru "github.com/hashicorp/golang-lru/v2"
 	"github.com/hashicorp/golang-lru/v2/expirable"
 	"github.com/jackc/pgx/v5"
@@ -13,6 +14,7 @@ import (
 	"github.com/rs/zerolog"
 
 	"github.com/hatchet-dev/hatchet/internal/cel"
+	"github.com/hatchet-dev/hatchet/pkg/clock"
 	"github.com/hatchet-dev/hatchet/pkg/config/limits"
 	"github.com/hatchet-dev/hatchet/pkg/repository/cache"
 	"github.com/hatchet-dev/hatchet/pkg/repository/sqlcv1"
@@ -48,12 +50,19 @@ type sharedRepository struct {
 	stepIdSlotRequestsCache     *expirable.LRU[uuid.UUID, map[string]int32]
 	stepIdHasBatchConfigCache   *expirable.LRU[uuid.UUID, bool]
 	stepIdMatchConditionsCache  *expirable.LRU[uuid.UUID, []*sqlcv1.V1StepMatchCondition]
+	stepIdCircuitBreakerCache   *expirable.LRU[uuid.UUID, *sqlcv1.V1StepCircuitBreaker]
+	tenantPriorityAgingCache    *expirable.LRU[uuid.UUID, *PriorityAgingPolicies]
+	retryPolicyProgramCache     *expirable.LRU[retryPolicyKey, celgo.Program]
 
 	celParser         *cel.CELParser
 	boolExprEvaluator *cel.BoolExprEvaluator
 	taskLookupCache   *lru.Cache[taskExternalIdTenantIdTuple, *sqlcv1.FlattenExternalIdsRow]
 	payloadStore      PayloadStoreRepository
 	m                 TenantLimitRepository
+
+	// clock is used for sleeps, timeouts and scheduled runs, so time travel in test builds
+	// applies to them
+	clock clock.Clock
 }
 
 func newSharedRepository(
@@ -81,6 +90,10 @@ func newSharedRepository(
 	stepIdSlotRequestsCache := expirable.NewLRU(10000, func(key uuid.UUID, value map[string]int32) {}, 5*time.Minute)
 	stepIdHasBatchConfigCache := expirable.NewLRU(10000, func(key uuid.UUID, value bool) {}, 5*time.Minute)
 	stepIdMatchConditionsCache := expirable.NewLRU(10000, func(key uuid.UUID, value []*sqlcv1.V1StepMatchCondition) {}, 5*time.Minute)
+	stepIdCircuitBreakerCache := expirable.NewLRU(10000, func(key uuid.UUID, value *sqlcv1.V1StepCircuitBreaker) {}, 5*time.Minute)
+	tenantPriorityAgingCache := expirable.NewLRU(10000, func(key uuid.UUID, value *PriorityAgingPolicies) {}, 30*time.Second)
+	// the policies of a step never change, so their expressions only need to be compiled once
+	retryPolicyProgramCache := expirable.NewLRU(10000, func(key retryPolicyKey, value celgo.Program) {}, 5*time.Minute)
 
 	celParser := cel.NewCELParser()
 
@@ -114,10 +127,14 @@ func newSharedRepository(
 		stepIdSlotRequestsCache:     stepIdSlotRequestsCache,
 		stepIdHasBatchConfigCache:   stepIdHasBatchConfigCache,
 		stepIdMatchConditionsCache:  stepIdMatchConditionsCache,
+		stepIdCircuitBreakerCache:   stepIdCircuitBreakerCache,
+		tenantPriorityAgingCache:    tenantPriorityAgingCache,
+		retryPolicyProgramCache:     retryPolicyProgramCache,
 		celParser:                   celParser,
 		boolExprEvaluator:           boolExprEvaluator,
 		taskLookupCache:             lookupCache,
 		payloadStore:                payloadStore,
+		clock:                       clock.Engine(),
 	}
 
 	tenantLimitRepository := newTenantLimitRepository(s, c, shouldEnforceLimits, cacheDuration)
@@ -0,0 +1,185 @@
+-- name: CreateStepApproval :exec
+INSERT INTO v1_step_approval (
+    step_id,
+    tenant_id,
+    approvers,
+    context_fields,
+    expires_in_seconds,
+    on_expiry
+) VALUES (
+    @stepId::uuid,
+    @tenantId::uuid,
+    @approvers::text[],
+    @contextFields::text[],
+    @expiresInSeconds::integer,
+    @onExpiry::v1_approval_expiry_action
+);
+
+-- name: GetStepApproval :one
+SELECT
+    *
+FROM
+    v1_step_approval
+WHERE
+    step_id = @stepId::uuid
+    AND tenant_id = @tenantId::uuid;
+
+-- name: ListStepApprovals :many
+SELECT
+    *
+FROM
+    v1_step_approval
+WHERE
+    step_id = ANY(@stepIds::uuid[])
+    AND tenant_id = @tenantId::uuid;
+
+-- name: GetApproval :one
+SELECT
+    *
+FROM
+    v1_approval
+WHERE
+    tenant_id = @tenantId::uuid
+    AND task_external_id = @taskExternalId::uuid;
+
+-- name: GetApprovalForUpdate :one
+SELECT
+    *
+FROM
+    v1_approval
+WHERE
+    tenant_id = @tenantId::uuid
+    AND task_external_id = @taskExternalId::uuid
+FOR UPDATE;
+
+-- name: UpsertApproval :one
+INSERT INTO v1_approval (
+    tenant_id,
+    task_external_id,
+    task_id,
+    task_inserted_at,
+    task_retry_count,
+    workflow_run_id,
+    step_id,
+    task_name,
+    approvers,
+    context,
+    on_expiry,
+    expires_at
+) VALUES (
+    @tenantId::uuid,
+    @taskExternalId::uuid,
+    @taskId::bigint,
+    @taskInsertedAt::timestamptz,
+    @taskRetryCount::integer,
+    @workflowRunId::uuid,
+    @stepId::uuid,
+    @taskName::text,
+    @approvers::text[],
+    @context::jsonb,
+    @onExpiry::v1_approval_expiry_action,
+    @expiresAt::timestamptz
+)
+ON CONFLICT (tenant_id, task_external_id) DO UPDATE
+SET
+    task_id = EXCLUDED.task_id,
+    task_inserted_at = EXCLUDED.task_inserted_at,
+    task_retry_count = EXCLUDED.task_retry_count,
+    workflow_run_id = EXCLUDED.workflow_run_id,
+    step_id = EXCLUDED.step_id,
+    task_name = EXCLUDED.task_name,
+    approvers = EXCLUDED.approvers,
+    context = EXCLUDED.context,
+    on_expiry = EXCLUDED.on_expiry,
+    expires_at = EXCLUDED.expires_at,
+    status = 'PENDING',
+    approver = NULL,
+    approver_api_token_id = NULL,
+    comment = NULL,
+    created_at = CURRENT_TIMESTAMP,
+    resolved_at = NULL
+RETURNING *;
+
+-- name: UpdateApprovalRetryCount :one
+UPDATE
+    v1_approval
+SET
+    task_retry_count = @taskRetryCount::integer
+WHERE
+    tenant_id = @tenantId::uuid
+    AND task_external_id = @taskExternalId::uuid
+RETURNING *;
+
+-- name: ResolveApproval :one
+UPDATE
+    v1_approval
+SET
+    status = @status::v1_approval_status,
+    approver = sqlc.narg('approver')::text,
+    approver_api_token_id = sqlc.narg('approverApiTokenId')::uuid,
+    comment = sqlc.narg('comment')::text,
+    resolved_at = CURRENT_TIMESTAMP
+WHERE
+    tenant_id = @tenantId::uuid
+    AND task_external_id = @taskExternalId::uuid
+    AND status = 'PENDING'
+RETURNING *;
+
+-- name: ExpireApprovals :many
+WITH expired AS (
+    SELECT
+        tenant_id,
+        task_external_id
+    FROM
+        v1_approval
+    WHERE
+        tenant_id = @tenantId::uuid
+        AND status = 'PENDING'
+        AND expires_at <= @now::timestamptz
+    ORDER BY
+        expires_at ASC
+    LIMIT
+        @approvalLimit::integer
+    FOR UPDATE SKIP LOCKED
+)
+UPDATE
+    v1_approval a
+SET
+    status = 'EXPIRED',
+    resolved_at = CURRENT_TIMESTAMP
+FROM
+    expired
+WHERE
+    a.tenant_id = expired.tenant_id
+    AND a.task_external_id = expired.task_external_id
+RETURNING a.*;
+
+-- name: ListApprovals :many
+SELECT
+    *
+FROM
+    v1_approval
+WHERE
+    tenant_id = @tenantId::uuid
+    AND (
+        sqlc.narg('statuses')::text[] IS NULL
+        OR status = ANY(CAST(sqlc.narg('statuses')::text[] AS v1_approval_status[]))
+    )
+ORDER BY
+    created_at DESC
+LIMIT
+    @approvalLimit::integer
+OFFSET
+    @approvalOffset::integer;
+
+-- name: CountApprovals :one
+SELECT
+    COUNT(*) AS total
+FROM
+    v1_approval
+WHERE
+    tenant_id = @tenantId::uuid
+    AND (
+        sqlc.narg('statuses')::text[] IS NULL
+        OR status = ANY(CAST(sqlc.narg('statuses')::text[] AS v1_approval_status[]))
+    );
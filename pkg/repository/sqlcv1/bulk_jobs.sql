@@ -0,0 +1,177 @@
+-- name: CreateBulkJob :one
+INSERT INTO v1_bulk_job (
+    tenant_id,
+    kind,
+    filter,
+    is_enumerated
+) VALUES (
+    @tenantId::uuid,
+    @kind::v1_bulk_job_kind,
+    sqlc.narg('filter')::jsonb,
+    @isEnumerated::boolean
+)
+RETURNING *;
+
+-- name: GetBulkJob :one
+SELECT
+    *
+FROM
+    v1_bulk_job
+WHERE
+    tenant_id = @tenantId::uuid
+    AND id = @id::uuid;
+
+-- name: ListBulkJobs :many
+SELECT
+    *
+FROM
+    v1_bulk_job
+WHERE
+    tenant_id = @tenantId::uuid
+ORDER BY
+    created_at DESC
+LIMIT
+    @jobLimit::integer;
+
+-- name: GetNextRunningBulkJob :one
+SELECT
+    *
+FROM
+    v1_bulk_job
+WHERE
+    tenant_id = @tenantId::uuid
+    AND status = 'RUNNING'
+ORDER BY
+    created_at ASC
+LIMIT 1;
+
+-- name: InsertBulkJobItems :execrows
+INSERT INTO v1_bulk_job_item (
+    tenant_id,
+    bulk_job_id,
+    external_id
+)
+SELECT
+    @tenantId::uuid,
+    @bulkJobId::uuid,
+    UNNEST(@externalIds::uuid[])
+ON CONFLICT (tenant_id, bulk_job_id, external_id) DO NOTHING;
+
+-- name: UpdateBulkJobEnumeration :one
+-- Adds enumerated items to the job's total and stores the cursor to enumerate the next page from.
+-- The job is enumerated once there is no next page.
+UPDATE
+    v1_bulk_job
+SET
+    total_count = total_count + @addedCount::bigint,
+    enumeration_cursor_inserted_at = sqlc.narg('cursorInsertedAt')::timestamptz,
+    enumeration_cursor_id = sqlc.narg('cursorId')::bigint,
+    is_enumerated = sqlc.narg('cursorId')::bigint IS NULL,
+    updated_at = NOW()
+WHERE
+    tenant_id = @tenantId::uuid
+    AND id = @id::uuid
+RETURNING *;
+
+-- name: ListPendingBulkJobItems :many
+SELECT
+    external_id
+FROM
+    v1_bulk_job_item
+WHERE
+    tenant_id = @tenantId::uuid
+    AND bulk_job_id = @bulkJobId::uuid
+    AND status = 'PENDING'
+ORDER BY
+    external_id
+LIMIT
+    @itemLimit::integer;
+
+-- name: ListFailedBulkJobItems :many
+SELECT
+    *
+FROM
+    v1_bulk_job_item
+WHERE
+    tenant_id = @tenantId::uuid
+    AND bulk_job_id = @bulkJobId::uuid
+    AND status = 'FAILED'
+ORDER BY
+    external_id
+LIMIT
+    @itemLimit::integer;
+
+-- name: RecordBulkJobItemResults :one
+-- Marks pending items as succeeded or failed and adds them to the job's counts. Items which are no
+-- longer pending are skipped, so that a batch which is recorded twice is only counted once.
+WITH input AS (
+    SELECT
+        *
+    FROM
+        (
+            SELECT
+                UNNEST(@externalIds::uuid[]) AS external_id,
+                UNNEST(CAST(@statuses::text[] AS v1_bulk_job_item_status[])) AS status,
+                UNNEST(@errors::text[]) AS error
+        ) AS subquery
+), updated AS (
+    UPDATE
+        v1_bulk_job_item bji
+    SET
+        status = i.status,
+        error = NULLIF(i.error, '')
+    FROM
+        input i
+    WHERE
+        bji.tenant_id = @tenantId::uuid
+        AND bji.bulk_job_id = @bulkJobId::uuid
+        AND bji.external_id = i.external_id
+        AND bji.status = 'PENDING'
+    RETURNING
+        bji.status
+)
+UPDATE
+    v1_bulk_job
+SET
+    processed_count = processed_count + (SELECT COUNT(*) FROM updated),
+    succeeded_count = succeeded_count + (SELECT COUNT(*) FROM updated WHERE updated.status = 'SUCCEEDED'),
+    failed_count = failed_count + (SELECT COUNT(*) FROM updated WHERE updated.status = 'FAILED'),
+    updated_at = NOW()
+WHERE
+    tenant_id = @tenantId::uuid
+    AND id = @bulkJobId::uuid
+RETURNING *;
+
+-- name: TransitionBulkJobStatus :one
+UPDATE
+    v1_bulk_job
+SET
+    status = @toStatus::v1_bulk_job_status,
+    updated_at = NOW()
+WHERE
+    tenant_id = @tenantId::uuid
+    AND id = @id::uuid
+    AND status = @fromStatus::v1_bulk_job_status
+RETURNING *;
+
+-- name: FinishBulkJob :one
+UPDATE
+    v1_bulk_job
+SET
+    status = @status::v1_bulk_job_status,
+    error = sqlc.narg('error')::text,
+    updated_at = NOW(),
+    finished_at = NOW()
+WHERE
+    tenant_id = @tenantId::uuid
+    AND id = @id::uuid
+    AND status = 'RUNNING'
+RETURNING *;
+
+-- name: DeleteSucceededBulkJobItems :exec
+DELETE FROM
+    v1_bulk_job_item
+WHERE
+    tenant_id = @tenantId::uuid
+    AND bulk_job_id = @bulkJobId::uuid
+    AND status = 'SUCCEEDED';
@@ -0,0 +1,194 @@
+-- name: CreateStepCircuitBreaker :exec
+INSERT INTO v1_step_circuit_breaker (
+    step_id,
+    tenant_id,
+    failure_threshold,
+    window_seconds,
+    cooldown_seconds
+) VALUES (
+    @stepId::uuid,
+    @tenantId::uuid,
+    @failureThreshold::int,
+    @windowSeconds::int,
+    @cooldownSeconds::int
+);
+
+-- name: ListStepCircuitBreakers :many
+SELECT
+    *
+FROM
+    v1_step_circuit_breaker
+WHERE
+    step_id = ANY(@stepIds::uuid[]);
+
+-- name: ListTaskCircuitBreakers :many
+-- Returns the circuit breaker key and configuration of each task whose step has a circuit breaker.
+-- The key is the step id followed by the evaluated key expression, or by the action id if the step
+-- has no key expression.
+WITH input AS (
+    SELECT
+        UNNEST(@taskIds::bigint[]) AS task_id,
+        UNNEST(@taskInsertedAts::timestamptz[]) AS task_inserted_at
+)
+SELECT
+    t.id AS task_id,
+    t.step_id,
+    (t.step_id::text || ':' || COALESCE(e.value_str, t.action_id))::text AS key,
+    cb.failure_threshold,
+    cb.window_seconds,
+    cb.cooldown_seconds
+FROM
+    input i
+JOIN
+    v1_task t ON t.id = i.task_id AND t.inserted_at = i.task_inserted_at
+JOIN
+    v1_step_circuit_breaker cb ON cb.step_id = t.step_id
+LEFT JOIN
+    v1_task_expression_eval e ON
+        e.task_id = t.id
+        AND e.task_inserted_at = t.inserted_at
+        AND e.kind = 'CIRCUIT_BREAKER_KEY'
+WHERE
+    t.tenant_id = @tenantId::uuid;
+
+-- name: ListCircuitBreakers :many
+SELECT
+    *
+FROM
+    v1_circuit_breaker
+WHERE
+    tenant_id = @tenantId::uuid
+    AND key = ANY(@keys::text[]);
+
+-- name: ListCircuitBreakersForUpdate :many
+SELECT
+    *
+FROM
+    v1_circuit_breaker
+WHERE
+    tenant_id = @tenantId::uuid
+    AND key = ANY(@keys::text[])
+ORDER BY
+    key
+FOR UPDATE;
+
+-- name: ListCircuitBreakersForTenant :many
+SELECT
+    *
+FROM
+    v1_circuit_breaker
+WHERE
+    tenant_id = @tenantId::uuid
+ORDER BY
+    key;
+
+-- name: UpsertCircuitBreakers :exec
+WITH input AS (
+    SELECT
+        UNNEST(@keys::text[]) AS key,
+        UNNEST(CAST(@states::text[] AS v1_circuit_breaker_state[])) AS state,
+        UNNEST(@failureCounts::int[]) AS failure_count,
+        UNNEST(@failureThresholds::int[]) AS failure_threshold,
+        UNNEST(@windowSeconds::int[]) AS window_seconds,
+        UNNEST(@cooldownSeconds::int[]) AS cooldown_seconds,
+        UNNEST(@windowStartedAts::timestamptz[]) AS window_started_at,
+        UNNEST(@openedAts::timestamptz[]) AS opened_at
+)
+INSERT INTO v1_circuit_breaker (
+    tenant_id,
+    key,
+    state,
+    failure_count,
+    failure_threshold,
+    window_seconds,
+    cooldown_seconds,
+    window_started_at,
+    opened_at,
+    updated_at
+)
+SELECT
+    @tenantId::uuid,
+    i.key,
+    i.state,
+    i.failure_count,
+    i.failure_threshold,
+    i.window_seconds,
+    i.cooldown_seconds,
+    i.window_started_at,
+    i.opened_at,
+    @now::timestamptz
+FROM
+    input i
+ORDER BY
+    i.key
+ON CONFLICT (tenant_id, key) DO UPDATE
+SET
+    state = EXCLUDED.state,
+    failure_count = EXCLUDED.failure_count,
+    failure_threshold = EXCLUDED.failure_threshold,
+    window_seconds = EXCLUDED.window_seconds,
+    cooldown_seconds = EXCLUDED.cooldown_seconds,
+    window_started_at = EXCLUDED.window_started_at,
+    opened_at = EXCLUDED.opened_at,
+    probe_task_id = NULL,
+    probe_started_at = NULL,
+    updated_at = EXCLUDED.updated_at;
+
+-- name: CloseCircuitBreakersForTasks :exec
+-- Closes the half-open circuit breakers of the given tasks after they succeed.
+WITH input AS (
+    SELECT
+        UNNEST(@taskIds::bigint[]) AS task_id,
+        UNNEST(@taskInsertedAts::timestamptz[]) AS task_inserted_at
+), task_keys AS (
+    SELECT DISTINCT
+        t.step_id::text || ':' || COALESCE(e.value_str, t.action_id) AS key
+    FROM
+        input i
+    JOIN
+        v1_task t ON t.id = i.task_id AND t.inserted_at = i.task_inserted_at
+    JOIN
+        v1_step_circuit_breaker scb ON scb.step_id = t.step_id
+    LEFT JOIN
+        v1_task_expression_eval e ON
+            e.task_id = t.id
+            AND e.task_inserted_at = t.inserted_at
+            AND e.kind = 'CIRCUIT_BREAKER_KEY'
+    WHERE
+        t.tenant_id = @tenantId::uuid
+)
+UPDATE
+    v1_circuit_breaker cb
+SET
+    state = 'CLOSED',
+    failure_count = 0,
+    window_started_at = @now::timestamptz,
+    opened_at = NULL,
+    probe_task_id = NULL,
+    probe_started_at = NULL,
+    updated_at = @now::timestamptz
+FROM
+    task_keys tk
+WHERE
+    cb.tenant_id = @tenantId::uuid
+    AND cb.key = tk.key
+    AND cb.state = 'HALF_OPEN';
+
+-- name: AcquireCircuitBreakerProbe :one
+-- Moves an open circuit breaker whose cooldown has elapsed (or a half-open breaker whose probe has
+-- not completed within the cooldown) to half-open, with the given task as its probe.
+UPDATE
+    v1_circuit_breaker
+SET
+    state = 'HALF_OPEN',
+    probe_task_id = @taskId::bigint,
+    probe_started_at = @now::timestamptz,
+    updated_at = @now::timestamptz
+WHERE
+    tenant_id = @tenantId::uuid
+    AND key = @key::text
+    AND (
+        (state = 'OPEN' AND opened_at + make_interval(secs => cooldown_seconds) <= @now::timestamptz)
+        OR (state = 'HALF_OPEN' AND probe_started_at + make_interval(secs => cooldown_seconds) <= @now::timestamptz)
+    )
+RETURNING *;
@@ -0,0 +1,67 @@
+-- name: CreateDelayedEvents :exec
+WITH inputs AS (
+    SELECT
+        UNNEST(@externalIds::UUID[]) AS external_id,
+        UNNEST(@keys::TEXT[]) AS key,
+        UNNEST(@datas::JSONB[]) AS data,
+        UNNEST(@additionalMetadatas::JSONB[]) AS additional_metadata,
+        -- 0 and '' mean the priority and scope are unset
+        UNNEST(@priorities::INTEGER[]) AS priority,
+        UNNEST(@scopes::TEXT[]) AS scope,
+        UNNEST(@deliverAts::TIMESTAMPTZ[]) AS deliver_at
+)
+INSERT INTO v1_delayed_event (
+    tenant_id,
+    external_id,
+    key,
+    data,
+    additional_metadata,
+    priority,
+    scope,
+    deliver_at
+)
+SELECT
+    @tenantId::UUID,
+    external_id,
+    key,
+    data,
+    additional_metadata,
+    NULLIF(priority, 0),
+    NULLIF(scope, ''),
+    deliver_at
+FROM
+    inputs;
+
+-- name: PollDelayedEvents :many
+-- Leases the delayed events which are due for delivery. An event which wasn't delivered and
+-- deleted before its lease expired is leased again by a later poll.
+WITH due AS (
+    SELECT
+        id
+    FROM
+        v1_delayed_event
+    WHERE
+        deliver_at <= NOW()
+        AND (locked_until IS NULL OR locked_until < NOW())
+    ORDER BY
+        deliver_at ASC
+    LIMIT
+        @limit::integer
+    FOR UPDATE SKIP LOCKED
+)
+UPDATE
+    v1_delayed_event e
+SET
+    locked_until = NOW() + INTERVAL '30 seconds'
+FROM
+    due
+WHERE
+    e.id = due.id
+RETURNING
+    e.*;
+
+-- name: DeleteDelayedEvents :exec
+DELETE FROM
+    v1_delayed_event
+WHERE
+    id = ANY(@ids::BIGINT[]);
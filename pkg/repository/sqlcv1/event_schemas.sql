@@ -0,0 +1,51 @@
+-- name: CreateEventSchema :one
+-- Registers a schema as the next version for the event key.
+INSERT INTO v1_event_schema (
+    tenant_id,
+    event_key,
+    version,
+    schema,
+    mode
+)
+SELECT
+    @tenantId::uuid,
+    @eventKey::text,
+    COALESCE(MAX(version), 0) + 1,
+    @schema::jsonb,
+    @mode::v1_event_schema_mode
+FROM
+    v1_event_schema
+WHERE
+    tenant_id = @tenantId::uuid
+    AND event_key = @eventKey::text
+RETURNING *;
+
+-- name: ListLatestEventSchemas :many
+-- Lists the active (latest) version of the schema for each event key in the tenant.
+SELECT DISTINCT ON (event_key)
+    *
+FROM
+    v1_event_schema
+WHERE
+    tenant_id = @tenantId::uuid
+ORDER BY
+    event_key ASC,
+    version DESC;
+
+-- name: ListEventSchemaVersions :many
+SELECT
+    *
+FROM
+    v1_event_schema
+WHERE
+    tenant_id = @tenantId::uuid
+    AND event_key = @eventKey::text
+ORDER BY
+    version DESC;
+
+-- name: DeleteEventSchema :execrows
+DELETE FROM
+    v1_event_schema
+WHERE
+    tenant_id = @tenantId::uuid
+    AND event_key = @eventKey::text;
@@ -95,3 +95,16 @@ WHERE (tenant_id, key) IN (
 	SELECT tenant_id, key
 	FROM keys_to_release
 );
+
+-- name: ReleaseClaimedIdempotencyKeys :exec
+-- Releases keys which are still held by the given claimants, so that a claim can be given up when
+-- the work it guarded failed.
+DELETE FROM v1_idempotency_key
+WHERE
+    tenant_id = @tenantId::UUID
+    AND (key, claimed_by_external_id) IN (
+        SELECT
+            UNNEST(@keys::TEXT[]),
+            UNNEST(@claimedByExternalIds::UUID[])
+    )
+;
@@ -126,6 +126,30 @@ func (q *Queries) CleanUpExpiredIdempotencyKeys(ctx context.Context, db DBTX, te
 	return err
 }
 
+const releaseClaimedIdempotencyKeys = `-- name: ReleaseClaimedIdempotencyKeys :exec
+DELETE FROM v1_idempotency_key
+WHERE
+    tenant_id = $1::UUID
+    AND (key, claimed_by_external_id) IN (
+        SELECT
+            UNNEST($2::TEXT[]),
+            UNNEST($3::UUID[])
+    )
+`
+
+type ReleaseClaimedIdempotencyKeysParams struct {
+	Tenantid             uuid.UUID   `json:"tenantid"`
+	Keys                 []string    `json:"keys"`
+	Claimedbyexternalids []uuid.UUID `json:"claimedbyexternalids"`
+}
+
+// Releases keys which are still held by the given claimants, so that a claim can be given up when
+// the work it guarded failed.
+func (q *Queries) ReleaseClaimedIdempotencyKeys(ctx context.Context, db DBTX, arg ReleaseClaimedIdempotencyKeysParams) error {
+	_, err := db.Exec(ctx, releaseClaimedIdempotencyKeys, arg.Tenantid, arg.Keys, arg.Claimedbyexternalids)
+	return err
+}
+
 const releaseIdempotencyKeys = `-- name: ReleaseIdempotencyKeys :exec
 WITH input AS (
     SELECT
@@ -64,6 +64,9 @@ WHERE
     AND w."lastHeartbeatAt" > NOW() - INTERVAL '5 seconds'
     AND w."isActive" = true
     AND w."isPaused" = false
+    AND NOT EXISTS (
+        SELECT 1 FROM v1_worker_cordon wc WHERE wc.tenant_id = w."tenantId" AND wc.worker_id = w."id"
+    )
 GROUP BY
     w."id",
     w."name",
@@ -8,7 +8,9 @@ INSERT INTO v1_log_line (
     retry_count,
     level,
     workflow_id,
-    step_id
+    step_id,
+    created_at,
+    worker_id
 ) VALUES (
     $1,
     $2,
@@ -18,7 +20,9 @@ INSERT INTO v1_log_line (
     $6,
     $7,
     $8,
-    $9
+    $9,
+    $10,
+    $11
 );
 
 -- name: ListLogLines :many
@@ -36,12 +40,64 @@ WHERE
     AND (sqlc.narg('attempt')::INTEGER IS NULL OR l.retry_count = (sqlc.narg('attempt')::INTEGER - 1))
     AND (sqlc.narg('workflowIds')::UUID[] IS NULL OR l.workflow_id = ANY(sqlc.narg('workflowIds')::UUID[]))
     AND (sqlc.narg('stepIds')::UUID[] IS NULL OR l.step_id = ANY(sqlc.narg('stepIds')::UUID[]))
+    AND (sqlc.narg('workerIds')::UUID[] IS NULL OR l.worker_id = ANY(sqlc.narg('workerIds')::UUID[]))
+    AND (sqlc.narg('query')::TEXT IS NULL OR to_tsvector('simple', l.message) @@ websearch_to_tsquery('simple', sqlc.narg('query')::TEXT))
+    -- metadata keys are dot-separated paths, and every path must have the corresponding value
+    AND (
+        sqlc.narg('metadataKeys')::TEXT[] IS NULL
+        OR NOT EXISTS (
+            SELECT 1
+            FROM unnest(sqlc.narg('metadataKeys')::TEXT[], sqlc.narg('metadataValues')::TEXT[]) AS f(key, value)
+            WHERE l.metadata #>> string_to_array(f.key, '.') IS DISTINCT FROM f.value
+        )
+    )
 ORDER BY
     CASE WHEN @orderByDirection::TEXT = 'DESC' THEN l.created_at END DESC,
     CASE WHEN @orderByDirection::TEXT = 'ASC' THEN l.created_at END ASC
 LIMIT COALESCE(sqlc.narg('limit')::BIGINT, 1000)
 OFFSET COALESCE(sqlc.narg('offset')::BIGINT, 0);
 
+-- name: ListLogLinesAfterId :many
+-- Lists the log lines which were written after the line with the given id, for tailing logs. Lines
+-- are batched by workers, so they're ordered by when they were written rather than created_at,
+-- which is only used to bound the scan.
+SELECT
+    *
+FROM
+    v1_log_line l
+WHERE
+    l.tenant_id = @tenantId::UUID
+    AND l.id > @afterId::BIGINT
+    AND l.created_at > @since::TIMESTAMPTZ
+    AND (sqlc.narg('taskIds')::BIGINT[] IS NULL OR l.task_id = ANY(sqlc.narg('taskIds')::BIGINT[]))
+    AND (sqlc.narg('search')::TEXT IS NULL OR l.message ILIKE CONCAT('%', sqlc.narg('search')::TEXT, '%'))
+    AND (sqlc.narg('levels')::v1_log_line_level[] IS NULL OR l.level = ANY(sqlc.narg('levels')::v1_log_line_level[]))
+    AND (sqlc.narg('workflowIds')::UUID[] IS NULL OR l.workflow_id = ANY(sqlc.narg('workflowIds')::UUID[]))
+    AND (sqlc.narg('stepIds')::UUID[] IS NULL OR l.step_id = ANY(sqlc.narg('stepIds')::UUID[]))
+    AND (sqlc.narg('workerIds')::UUID[] IS NULL OR l.worker_id = ANY(sqlc.narg('workerIds')::UUID[]))
+    AND (sqlc.narg('query')::TEXT IS NULL OR to_tsvector('simple', l.message) @@ websearch_to_tsquery('simple', sqlc.narg('query')::TEXT))
+    -- metadata keys are dot-separated paths, and every path must have the corresponding value
+    AND (
+        sqlc.narg('metadataKeys')::TEXT[] IS NULL
+        OR NOT EXISTS (
+            SELECT 1
+            FROM unnest(sqlc.narg('metadataKeys')::TEXT[], sqlc.narg('metadataValues')::TEXT[]) AS f(key, value)
+            WHERE l.metadata #>> string_to_array(f.key, '.') IS DISTINCT FROM f.value
+        )
+    )
+ORDER BY
+    l.id ASC
+LIMIT @limit::BIGINT;
+
+-- name: GetLatestLogLineId :one
+SELECT
+    COALESCE(MAX(id), 0)::BIGINT AS id
+FROM
+    v1_log_line
+WHERE
+    tenant_id = @tenantId::UUID
+    AND created_at > @since::TIMESTAMPTZ;
+
 -- name: GetLogLinePointMetrics :many
 SELECT
     DATE_BIN(
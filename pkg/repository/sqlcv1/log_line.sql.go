@@ -12,6 +12,28 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+const getLatestLogLineId = `-- name: GetLatestLogLineId :one
+SELECT
+    COALESCE(MAX(id), 0)::BIGINT AS id
+FROM
+    v1_log_line
+WHERE
+    tenant_id = $1::UUID
+    AND created_at > $2::TIMESTAMPTZ
+`
+
+type GetLatestLogLineIdParams struct {
+	Tenantid uuid.UUID          `json:"tenantid"`
+	Since    pgtype.Timestamptz `json:"since"`
+}
+
+func (q *Queries) GetLatestLogLineId(ctx context.Context, db DBTX, arg GetLatestLogLineIdParams) (int64, error) {
+	row := db.QueryRow(ctx, getLatestLogLineId, arg.Tenantid, arg.Since)
+	var id int64
+	err := row.Scan(&id)
+	return id, err
+}
+
 const getLogLinePointMetrics = `-- name: GetLogLinePointMetrics :many
 SELECT
     DATE_BIN(
@@ -102,11 +124,13 @@ type InsertLogLineParams struct {
 	Level          V1LogLineLevel     `json:"level"`
 	WorkflowID     *uuid.UUID         `json:"workflow_id"`
 	StepID         *uuid.UUID         `json:"step_id"`
+	CreatedAt      pgtype.Timestamptz `json:"created_at"`
+	WorkerID       *uuid.UUID         `json:"worker_id"`
 }
 
 const listLogLines = `-- name: ListLogLines :many
 SELECT
-    id, created_at, tenant_id, task_id, task_inserted_at, message, level, metadata, retry_count, workflow_id, step_id
+    id, created_at, tenant_id, task_id, task_inserted_at, message, level, metadata, retry_count, workflow_id, step_id, worker_id
 FROM
     v1_log_line l
 WHERE
@@ -119,11 +143,22 @@ WHERE
     AND ($7::INTEGER IS NULL OR l.retry_count = ($7::INTEGER - 1))
     AND ($8::UUID[] IS NULL OR l.workflow_id = ANY($8::UUID[]))
     AND ($9::UUID[] IS NULL OR l.step_id = ANY($9::UUID[]))
+    AND ($10::UUID[] IS NULL OR l.worker_id = ANY($10::UUID[]))
+    AND ($11::TEXT IS NULL OR to_tsvector('simple', l.message) @@ websearch_to_tsquery('simple', $11::TEXT))
+    -- metadata keys are dot-separated paths, and every path must have the corresponding value
+    AND (
+        $12::TEXT[] IS NULL
+        OR NOT EXISTS (
+            SELECT 1
+            FROM unnest($12::TEXT[], $13::TEXT[]) AS f(key, value)
+            WHERE l.metadata #>> string_to_array(f.key, '.') IS DISTINCT FROM f.value
+        )
+    )
 ORDER BY
-    CASE WHEN $10::TEXT = 'DESC' THEN l.created_at END DESC,
-    CASE WHEN $10::TEXT = 'ASC' THEN l.created_at END ASC
-LIMIT COALESCE($12::BIGINT, 1000)
-OFFSET COALESCE($11::BIGINT, 0)
+    CASE WHEN $14::TEXT = 'DESC' THEN l.created_at END DESC,
+    CASE WHEN $14::TEXT = 'ASC' THEN l.created_at END ASC
+LIMIT COALESCE($16::BIGINT, 1000)
+OFFSET COALESCE($15::BIGINT, 0)
 `
 
 type ListLogLinesParams struct {
@@ -136,6 +171,10 @@ type ListLogLinesParams struct {
 	Attempt          pgtype.Int4        `json:"attempt"`
 	WorkflowIds      []uuid.UUID        `json:"workflowIds"`
 	StepIds          []uuid.UUID        `json:"stepIds"`
+	WorkerIds        []uuid.UUID        `json:"workerIds"`
+	Query            pgtype.Text        `json:"query"`
+	MetadataKeys     []string           `json:"metadataKeys"`
+	MetadataValues   []string           `json:"metadataValues"`
 	Orderbydirection string             `json:"orderbydirection"`
 	Offset           pgtype.Int8        `json:"offset"`
 	Limit            pgtype.Int8        `json:"limit"`
@@ -152,6 +191,10 @@ func (q *Queries) ListLogLines(ctx context.Context, db DBTX, arg ListLogLinesPar
 		arg.Attempt,
 		arg.WorkflowIds,
 		arg.StepIds,
+		arg.WorkerIds,
+		arg.Query,
+		arg.MetadataKeys,
+		arg.MetadataValues,
 		arg.Orderbydirection,
 		arg.Offset,
 		arg.Limit,
@@ -175,6 +218,103 @@ func (q *Queries) ListLogLines(ctx context.Context, db DBTX, arg ListLogLinesPar
 			&i.RetryCount,
 			&i.WorkflowID,
 			&i.StepID,
+			&i.WorkerID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listLogLinesAfterId = `-- name: ListLogLinesAfterId :many
+SELECT
+    id, created_at, tenant_id, task_id, task_inserted_at, message, level, metadata, retry_count, workflow_id, step_id, worker_id
+FROM
+    v1_log_line l
+WHERE
+    l.tenant_id = $1::UUID
+    AND l.id > $2::BIGINT
+    AND l.created_at > $3::TIMESTAMPTZ
+    AND ($4::BIGINT[] IS NULL OR l.task_id = ANY($4::BIGINT[]))
+    AND ($5::TEXT IS NULL OR l.message ILIKE CONCAT('%', $5::TEXT, '%'))
+    AND ($6::v1_log_line_level[] IS NULL OR l.level = ANY($6::v1_log_line_level[]))
+    AND ($7::UUID[] IS NULL OR l.workflow_id = ANY($7::UUID[]))
+    AND ($8::UUID[] IS NULL OR l.step_id = ANY($8::UUID[]))
+    AND ($9::UUID[] IS NULL OR l.worker_id = ANY($9::UUID[]))
+    AND ($10::TEXT IS NULL OR to_tsvector('simple', l.message) @@ websearch_to_tsquery('simple', $10::TEXT))
+    -- metadata keys are dot-separated paths, and every path must have the corresponding value
+    AND (
+        $11::TEXT[] IS NULL
+        OR NOT EXISTS (
+            SELECT 1
+            FROM unnest($11::TEXT[], $12::TEXT[]) AS f(key, value)
+            WHERE l.metadata #>> string_to_array(f.key, '.') IS DISTINCT FROM f.value
+        )
+    )
+ORDER BY
+    l.id ASC
+LIMIT $13::BIGINT
+`
+
+type ListLogLinesAfterIdParams struct {
+	Tenantid       uuid.UUID          `json:"tenantid"`
+	Afterid        int64              `json:"afterid"`
+	Since          pgtype.Timestamptz `json:"since"`
+	TaskIds        []int64            `json:"taskIds"`
+	Search         pgtype.Text        `json:"search"`
+	Levels         []V1LogLineLevel   `json:"levels"`
+	WorkflowIds    []uuid.UUID        `json:"workflowIds"`
+	StepIds        []uuid.UUID        `json:"stepIds"`
+	WorkerIds      []uuid.UUID        `json:"workerIds"`
+	Query          pgtype.Text        `json:"query"`
+	MetadataKeys   []string           `json:"metadataKeys"`
+	MetadataValues []string           `json:"metadataValues"`
+	Limit          int64              `json:"limit"`
+}
+
+// Lists the log lines which were written after the line with the given id, for tailing logs. Lines
+// are batched by workers, so they're ordered by when they were written rather than created_at,
+// which is only used to bound the scan.
+func (q *Queries) ListLogLinesAfterId(ctx context.Context, db DBTX, arg ListLogLinesAfterIdParams) ([]*V1LogLine, error) {
+	rows, err := db.Query(ctx, listLogLinesAfterId,
+		arg.Tenantid,
+		arg.Afterid,
+		arg.Since,
+		arg.TaskIds,
+		arg.Search,
+		arg.Levels,
+		arg.WorkflowIds,
+		arg.StepIds,
+		arg.WorkerIds,
+		arg.Query,
+		arg.MetadataKeys,
+		arg.MetadataValues,
+		arg.Limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*V1LogLine
+	for rows.Next() {
+		var i V1LogLine
+		if err := rows.Scan(
+			&i.ID,
+			&i.CreatedAt,
+			&i.TenantID,
+			&i.TaskID,
+			&i.TaskInsertedAt,
+			&i.Message,
+			&i.Level,
+			&i.Metadata,
+			&i.RetryCount,
+			&i.WorkflowID,
+			&i.StepID,
+			&i.WorkerID,
 		); err != nil {
 			return nil, err
 		}
@@ -157,6 +157,95 @@ func (q *Queries) ListMatchConditionsForEventWithoutHint(ctx context.Context, db
 	return items, nil
 }
 
+const listMatchConditionsForRunSignal = `-- name: ListMatchConditionsForRunSignal :many
+WITH run_tasks AS (
+    SELECT
+        $3::bigint AS id,
+        $5::timestamptz AS inserted_at
+    WHERE
+        $3::bigint IS NOT NULL
+    UNION ALL
+    SELECT
+        dt.task_id AS id,
+        dt.task_inserted_at AS inserted_at
+    FROM
+        v1_dag_to_task dt
+    WHERE
+        dt.dag_id = $4::bigint
+        AND dt.dag_inserted_at = $5::timestamptz
+)
+SELECT
+    mc.v1_match_id,
+    mc.id,
+    mc.registered_at,
+    mc.event_type,
+    mc.event_key,
+    mc.event_resource_hint,
+    mc.readable_data_key,
+    mc.expression
+FROM
+    v1_match_condition mc
+JOIN
+    v1_match m ON m.id = mc.v1_match_id
+WHERE
+    mc.tenant_id = $1::uuid
+    AND mc.event_type = 'USER'
+    AND mc.event_key = $2::text
+    AND mc.is_satisfied = FALSE
+    AND m.tenant_id = $1::uuid
+    AND m.is_satisfied = FALSE
+    AND (
+        (m.signal_task_id, m.signal_task_inserted_at) IN (SELECT id, inserted_at FROM run_tasks)
+        OR (m.trigger_dag_id = $4::bigint AND m.trigger_dag_inserted_at = $5::timestamptz)
+    )
+`
+
+type ListMatchConditionsForRunSignalParams struct {
+	Tenantid   uuid.UUID          `json:"tenantid"`
+	Eventkey   string             `json:"eventkey"`
+	Taskid     pgtype.Int8        `json:"taskid"`
+	Dagid      pgtype.Int8        `json:"dagid"`
+	Insertedat pgtype.Timestamptz `json:"insertedat"`
+}
+
+// ListMatchConditionsForRunSignal lists the unsatisfied user event conditions with the given key
+// which belong to a single run: the matches of its tasks, and for a DAG the matches which trigger
+// its remaining tasks. Resource hints are ignored, since the run is targeted directly.
+func (q *Queries) ListMatchConditionsForRunSignal(ctx context.Context, db DBTX, arg ListMatchConditionsForRunSignalParams) ([]*ListMatchConditionsForEventRow, error) {
+	rows, err := db.Query(ctx, listMatchConditionsForRunSignal,
+		arg.Tenantid,
+		arg.Eventkey,
+		arg.Taskid,
+		arg.Dagid,
+		arg.Insertedat,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*ListMatchConditionsForEventRow
+	for rows.Next() {
+		var i ListMatchConditionsForEventRow
+		if err := rows.Scan(
+			&i.V1MatchID,
+			&i.ID,
+			&i.RegisteredAt,
+			&i.EventType,
+			&i.EventKey,
+			&i.EventResourceHint,
+			&i.ReadableDataKey,
+			&i.Expression,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const createMatchesForDAGTriggers = `-- name: CreateMatchesForDAGTriggers :many
 WITH input AS (
 	SELECT
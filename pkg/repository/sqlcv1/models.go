@@ -461,6 +461,7 @@ const (
 	StepExpressionKindDYNAMICRATELIMITVALUE  StepExpressionKind = "DYNAMIC_RATE_LIMIT_VALUE"
 	StepExpressionKindDYNAMICRATELIMITUNITS  StepExpressionKind = "DYNAMIC_RATE_LIMIT_UNITS"
 	StepExpressionKindDYNAMICRATELIMITWINDOW StepExpressionKind = "DYNAMIC_RATE_LIMIT_WINDOW"
+	StepExpressionKindCIRCUITBREAKERKEY      StepExpressionKind = "CIRCUIT_BREAKER_KEY"
 )
 
 func (e *StepExpressionKind) Scan(src interface{}) error {
@@ -946,6 +947,224 @@ func (ns NullTenantResourceLimitAlertType) Value() (driver.Value, error) {
 	return string(ns.TenantResourceLimitAlertType), nil
 }
 
+type V1ApprovalExpiryAction string
+
+const (
+	V1ApprovalExpiryActionFAIL    V1ApprovalExpiryAction = "FAIL"
+	V1ApprovalExpiryActionREJECT  V1ApprovalExpiryAction = "REJECT"
+	V1ApprovalExpiryActionAPPROVE V1ApprovalExpiryAction = "APPROVE"
+)
+
+func (e *V1ApprovalExpiryAction) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = V1ApprovalExpiryAction(s)
+	case string:
+		*e = V1ApprovalExpiryAction(s)
+	default:
+		return fmt.Errorf("unsupported scan type for V1ApprovalExpiryAction: %T", src)
+	}
+	return nil
+}
+
+type NullV1ApprovalExpiryAction struct {
+	V1ApprovalExpiryAction V1ApprovalExpiryAction `json:"v1_approval_expiry_action"`
+	Valid                  bool                   `json:"valid"` // Valid is true if V1ApprovalExpiryAction is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullV1ApprovalExpiryAction) Scan(value interface{}) error {
+	if value == nil {
+		ns.V1ApprovalExpiryAction, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.V1ApprovalExpiryAction.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullV1ApprovalExpiryAction) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.V1ApprovalExpiryAction), nil
+}
+
+type V1ApprovalStatus string
+
+const (
+	V1ApprovalStatusPENDING   V1ApprovalStatus = "PENDING"
+	V1ApprovalStatusAPPROVED  V1ApprovalStatus = "APPROVED"
+	V1ApprovalStatusREJECTED  V1ApprovalStatus = "REJECTED"
+	V1ApprovalStatusEXPIRED   V1ApprovalStatus = "EXPIRED"
+	V1ApprovalStatusCANCELLED V1ApprovalStatus = "CANCELLED"
+)
+
+func (e *V1ApprovalStatus) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = V1ApprovalStatus(s)
+	case string:
+		*e = V1ApprovalStatus(s)
+	default:
+		return fmt.Errorf("unsupported scan type for V1ApprovalStatus: %T", src)
+	}
+	return nil
+}
+
+type NullV1ApprovalStatus struct {
+	V1ApprovalStatus V1ApprovalStatus `json:"v1_approval_status"`
+	Valid            bool             `json:"valid"` // Valid is true if V1ApprovalStatus is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullV1ApprovalStatus) Scan(value interface{}) error {
+	if value == nil {
+		ns.V1ApprovalStatus, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.V1ApprovalStatus.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullV1ApprovalStatus) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.V1ApprovalStatus), nil
+}
+
+type V1BulkJobItemStatus string
+
+const (
+	V1BulkJobItemStatusPENDING   V1BulkJobItemStatus = "PENDING"
+	V1BulkJobItemStatusSUCCEEDED V1BulkJobItemStatus = "SUCCEEDED"
+	V1BulkJobItemStatusFAILED    V1BulkJobItemStatus = "FAILED"
+)
+
+func (e *V1BulkJobItemStatus) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = V1BulkJobItemStatus(s)
+	case string:
+		*e = V1BulkJobItemStatus(s)
+	default:
+		return fmt.Errorf("unsupported scan type for V1BulkJobItemStatus: %T", src)
+	}
+	return nil
+}
+
+type NullV1BulkJobItemStatus struct {
+	V1BulkJobItemStatus V1BulkJobItemStatus `json:"v1_bulk_job_item_status"`
+	Valid               bool                `json:"valid"` // Valid is true if V1BulkJobItemStatus is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullV1BulkJobItemStatus) Scan(value interface{}) error {
+	if value == nil {
+		ns.V1BulkJobItemStatus, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.V1BulkJobItemStatus.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullV1BulkJobItemStatus) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.V1BulkJobItemStatus), nil
+}
+
+type V1BulkJobKind string
+
+const (
+	V1BulkJobKindCANCEL V1BulkJobKind = "CANCEL"
+	V1BulkJobKindREPLAY V1BulkJobKind = "REPLAY"
+	V1BulkJobKindDELETE V1BulkJobKind = "DELETE"
+)
+
+func (e *V1BulkJobKind) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = V1BulkJobKind(s)
+	case string:
+		*e = V1BulkJobKind(s)
+	default:
+		return fmt.Errorf("unsupported scan type for V1BulkJobKind: %T", src)
+	}
+	return nil
+}
+
+type NullV1BulkJobKind struct {
+	V1BulkJobKind V1BulkJobKind `json:"v1_bulk_job_kind"`
+	Valid         bool          `json:"valid"` // Valid is true if V1BulkJobKind is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullV1BulkJobKind) Scan(value interface{}) error {
+	if value == nil {
+		ns.V1BulkJobKind, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.V1BulkJobKind.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullV1BulkJobKind) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.V1BulkJobKind), nil
+}
+
+type V1BulkJobStatus string
+
+const (
+	V1BulkJobStatusRUNNING   V1BulkJobStatus = "RUNNING"
+	V1BulkJobStatusPAUSED    V1BulkJobStatus = "PAUSED"
+	V1BulkJobStatusCOMPLETED V1BulkJobStatus = "COMPLETED"
+	V1BulkJobStatusFAILED    V1BulkJobStatus = "FAILED"
+)
+
+func (e *V1BulkJobStatus) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = V1BulkJobStatus(s)
+	case string:
+		*e = V1BulkJobStatus(s)
+	default:
+		return fmt.Errorf("unsupported scan type for V1BulkJobStatus: %T", src)
+	}
+	return nil
+}
+
+type NullV1BulkJobStatus struct {
+	V1BulkJobStatus V1BulkJobStatus `json:"v1_bulk_job_status"`
+	Valid           bool            `json:"valid"` // Valid is true if V1BulkJobStatus is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullV1BulkJobStatus) Scan(value interface{}) error {
+	if value == nil {
+		ns.V1BulkJobStatus, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.V1BulkJobStatus.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullV1BulkJobStatus) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.V1BulkJobStatus), nil
+}
+
 type V1CelEvaluationFailureSource string
 
 const (
@@ -989,6 +1208,49 @@ func (ns NullV1CelEvaluationFailureSource) Value() (driver.Value, error) {
 	return string(ns.V1CelEvaluationFailureSource), nil
 }
 
+type V1CircuitBreakerState string
+
+const (
+	V1CircuitBreakerStateCLOSED   V1CircuitBreakerState = "CLOSED"
+	V1CircuitBreakerStateOPEN     V1CircuitBreakerState = "OPEN"
+	V1CircuitBreakerStateHALFOPEN V1CircuitBreakerState = "HALF_OPEN"
+)
+
+func (e *V1CircuitBreakerState) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = V1CircuitBreakerState(s)
+	case string:
+		*e = V1CircuitBreakerState(s)
+	default:
+		return fmt.Errorf("unsupported scan type for V1CircuitBreakerState: %T", src)
+	}
+	return nil
+}
+
+type NullV1CircuitBreakerState struct {
+	V1CircuitBreakerState V1CircuitBreakerState `json:"v1_circuit_breaker_state"`
+	Valid                 bool                  `json:"valid"` // Valid is true if V1CircuitBreakerState is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullV1CircuitBreakerState) Scan(value interface{}) error {
+	if value == nil {
+		ns.V1CircuitBreakerState, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.V1CircuitBreakerState.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullV1CircuitBreakerState) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.V1CircuitBreakerState), nil
+}
+
 type V1ConcurrencyStrategy string
 
 const (
@@ -1076,6 +1338,48 @@ func (ns NullV1DurableEventLogKind) Value() (driver.Value, error) {
 	return string(ns.V1DurableEventLogKind), nil
 }
 
+type V1EventSchemaMode string
+
+const (
+	V1EventSchemaModeREJECT V1EventSchemaMode = "REJECT"
+	V1EventSchemaModeWARN   V1EventSchemaMode = "WARN"
+)
+
+func (e *V1EventSchemaMode) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = V1EventSchemaMode(s)
+	case string:
+		*e = V1EventSchemaMode(s)
+	default:
+		return fmt.Errorf("unsupported scan type for V1EventSchemaMode: %T", src)
+	}
+	return nil
+}
+
+type NullV1EventSchemaMode struct {
+	V1EventSchemaMode V1EventSchemaMode `json:"v1_event_schema_mode"`
+	Valid             bool              `json:"valid"` // Valid is true if V1EventSchemaMode is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullV1EventSchemaMode) Scan(value interface{}) error {
+	if value == nil {
+		ns.V1EventSchemaMode, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.V1EventSchemaMode.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullV1EventSchemaMode) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.V1EventSchemaMode), nil
+}
+
 type V1EventType string
 
 const (
@@ -1147,6 +1451,7 @@ const (
 	V1EventTypeOlapBATCHBUFFERED        V1EventTypeOlap = "BATCH_BUFFERED"
 	V1EventTypeOlapWAITINGFORBATCH      V1EventTypeOlap = "WAITING_FOR_BATCH"
 	V1EventTypeOlapBATCHFLUSHED         V1EventTypeOlap = "BATCH_FLUSHED"
+	V1EventTypeOlapPREEMPTED            V1EventTypeOlap = "PREEMPTED"
 )
 
 func (e *V1EventTypeOlap) Scan(src interface{}) error {
@@ -1494,8 +1799,9 @@ func (ns NullV1MatchKind) Value() (driver.Value, error) {
 type V1OperatorKind string
 
 const (
-	V1OperatorKindHTTPAPI V1OperatorKind = "HTTP_API"
-	V1OperatorKindDAG     V1OperatorKind = "DAG"
+	V1OperatorKindHTTPAPI  V1OperatorKind = "HTTP_API"
+	V1OperatorKindDAG      V1OperatorKind = "DAG"
+	V1OperatorKindAPPROVAL V1OperatorKind = "APPROVAL"
 )
 
 func (e *V1OperatorKind) Scan(src interface{}) error {
@@ -2101,6 +2407,48 @@ func (ns NullV1TaskInitialState) Value() (driver.Value, error) {
 	return string(ns.V1TaskInitialState), nil
 }
 
+type V1WorkerDrainAction string
+
+const (
+	V1WorkerDrainActionCANCEL  V1WorkerDrainAction = "CANCEL"
+	V1WorkerDrainActionREQUEUE V1WorkerDrainAction = "REQUEUE"
+)
+
+func (e *V1WorkerDrainAction) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = V1WorkerDrainAction(s)
+	case string:
+		*e = V1WorkerDrainAction(s)
+	default:
+		return fmt.Errorf("unsupported scan type for V1WorkerDrainAction: %T", src)
+	}
+	return nil
+}
+
+type NullV1WorkerDrainAction struct {
+	V1WorkerDrainAction V1WorkerDrainAction `json:"v1_worker_drain_action"`
+	Valid               bool                `json:"valid"` // Valid is true if V1WorkerDrainAction is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullV1WorkerDrainAction) Scan(value interface{}) error {
+	if value == nil {
+		ns.V1WorkerDrainAction, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.V1WorkerDrainAction.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullV1WorkerDrainAction) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.V1WorkerDrainAction), nil
+}
+
 type VcsProvider string
 
 const (
@@ -2194,6 +2542,13 @@ const (
 	WorkerLabelComparatorGREATERTHANOREQUAL WorkerLabelComparator = "GREATER_THAN_OR_EQUAL"
 	WorkerLabelComparatorLESSTHAN           WorkerLabelComparator = "LESS_THAN"
 	WorkerLabelComparatorLESSTHANOREQUAL    WorkerLabelComparator = "LESS_THAN_OR_EQUAL"
+	WorkerLabelComparatorIN                 WorkerLabelComparator = "IN"
+	WorkerLabelComparatorNOTIN              WorkerLabelComparator = "NOT_IN"
+	WorkerLabelComparatorEXISTS             WorkerLabelComparator = "EXISTS"
+	WorkerLabelComparatorNOTEXISTS          WorkerLabelComparator = "NOT_EXISTS"
+	WorkerLabelComparatorSEMVER             WorkerLabelComparator = "SEMVER"
+	WorkerLabelComparatorNOTCOLOCATED       WorkerLabelComparator = "NOT_COLOCATED"
+	WorkerLabelComparatorBESTFIT            WorkerLabelComparator = "BEST_FIT"
 )
 
 func (e *WorkerLabelComparator) Scan(src interface{}) error {
@@ -2926,6 +3281,7 @@ type StepDesiredWorkerLabel struct {
 	Required   bool                  `json:"required"`
 	Comparator WorkerLabelComparator `json:"comparator"`
 	Weight     int32                 `json:"weight"`
+	StrValues  []string              `json:"strValues"`
 }
 
 type StepExpression struct {
@@ -3222,6 +3578,27 @@ type UserSession struct {
 	ExpiresAt pgtype.Timestamp `json:"expiresAt"`
 }
 
+type V1Approval struct {
+	TenantID           uuid.UUID              `json:"tenant_id"`
+	TaskExternalID     uuid.UUID              `json:"task_external_id"`
+	TaskID             int64                  `json:"task_id"`
+	TaskInsertedAt     pgtype.Timestamptz     `json:"task_inserted_at"`
+	TaskRetryCount     int32                  `json:"task_retry_count"`
+	WorkflowRunID      uuid.UUID              `json:"workflow_run_id"`
+	StepID             uuid.UUID              `json:"step_id"`
+	TaskName           string                 `json:"task_name"`
+	Approvers          []string               `json:"approvers"`
+	Context            []byte                 `json:"context"`
+	OnExpiry           V1ApprovalExpiryAction `json:"on_expiry"`
+	Status             V1ApprovalStatus       `json:"status"`
+	ExpiresAt          pgtype.Timestamptz     `json:"expires_at"`
+	Approver           pgtype.Text            `json:"approver"`
+	ApproverApiTokenID *uuid.UUID             `json:"approver_api_token_id"`
+	Comment            pgtype.Text            `json:"comment"`
+	CreatedAt          pgtype.Timestamptz     `json:"created_at"`
+	ResolvedAt         pgtype.Timestamptz     `json:"resolved_at"`
+}
+
 type V1BatchRuntime struct {
 	TenantID  uuid.UUID          `json:"tenant_id"`
 	StepID    uuid.UUID          `json:"step_id"`
@@ -3253,6 +3630,33 @@ type V1BatchedQueueItem struct {
 	PayloadSize       int32              `json:"payload_size"`
 }
 
+type V1BulkJob struct {
+	ID                          uuid.UUID          `json:"id"`
+	TenantID                    uuid.UUID          `json:"tenant_id"`
+	Kind                        V1BulkJobKind      `json:"kind"`
+	Status                      V1BulkJobStatus    `json:"status"`
+	Filter                      []byte             `json:"filter"`
+	IsEnumerated                bool               `json:"is_enumerated"`
+	TotalCount                  int64              `json:"total_count"`
+	ProcessedCount              int64              `json:"processed_count"`
+	SucceededCount              int64              `json:"succeeded_count"`
+	FailedCount                 int64              `json:"failed_count"`
+	Error                       pgtype.Text        `json:"error"`
+	CreatedAt                   pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt                   pgtype.Timestamptz `json:"updated_at"`
+	FinishedAt                  pgtype.Timestamptz `json:"finished_at"`
+	EnumerationCursorInsertedAt pgtype.Timestamptz `json:"enumeration_cursor_inserted_at"`
+	EnumerationCursorID         pgtype.Int8        `json:"enumeration_cursor_id"`
+}
+
+type V1BulkJobItem struct {
+	TenantID   uuid.UUID           `json:"tenant_id"`
+	BulkJobID  uuid.UUID           `json:"bulk_job_id"`
+	ExternalID uuid.UUID           `json:"external_id"`
+	Status     V1BulkJobItemStatus `json:"status"`
+	Error      pgtype.Text         `json:"error"`
+}
+
 type V1CelEvaluationFailuresOlap struct {
 	ID         int64                        `json:"id"`
 	TenantID   uuid.UUID                    `json:"tenant_id"`
@@ -3262,6 +3666,21 @@ type V1CelEvaluationFailuresOlap struct {
 	UpdatedAt  pgtype.Timestamptz           `json:"updated_at"`
 }
 
+type V1CircuitBreaker struct {
+	TenantID         uuid.UUID             `json:"tenant_id"`
+	Key              string                `json:"key"`
+	State            V1CircuitBreakerState `json:"state"`
+	FailureCount     int32                 `json:"failure_count"`
+	FailureThreshold int32                 `json:"failure_threshold"`
+	WindowSeconds    int32                 `json:"window_seconds"`
+	CooldownSeconds  int32                 `json:"cooldown_seconds"`
+	WindowStartedAt  pgtype.Timestamptz    `json:"window_started_at"`
+	OpenedAt         pgtype.Timestamptz    `json:"opened_at"`
+	ProbeTaskID      pgtype.Int8           `json:"probe_task_id"`
+	ProbeStartedAt   pgtype.Timestamptz    `json:"probe_started_at"`
+	UpdatedAt        pgtype.Timestamptz    `json:"updated_at"`
+}
+
 type V1ConcurrencySlot struct {
 	SortID                pgtype.Int8        `json:"sort_id"`
 	TaskID                int64              `json:"task_id"`
@@ -3334,6 +3753,20 @@ type V1DagsOlap struct {
 	IdempotencyKey       pgtype.Text          `json:"idempotency_key"`
 }
 
+type V1DelayedEvent struct {
+	ID                 int64              `json:"id"`
+	TenantID           uuid.UUID          `json:"tenant_id"`
+	ExternalID         uuid.UUID          `json:"external_id"`
+	Key                string             `json:"key"`
+	Data               []byte             `json:"data"`
+	AdditionalMetadata []byte             `json:"additional_metadata"`
+	Priority           pgtype.Int4        `json:"priority"`
+	Scope              pgtype.Text        `json:"scope"`
+	DeliverAt          pgtype.Timestamptz `json:"deliver_at"`
+	LockedUntil        pgtype.Timestamptz `json:"locked_until"`
+	InsertedAt         pgtype.Timestamptz `json:"inserted_at"`
+}
+
 type V1DurableEventLogBranchPoint struct {
 	TenantID               uuid.UUID          `json:"tenant_id"`
 	ID                     int64              `json:"id"`
@@ -3405,6 +3838,27 @@ type V1EventLookupTableOlap struct {
 	EventSeenAt pgtype.Timestamptz `json:"event_seen_at"`
 }
 
+type V1EventSchema struct {
+	TenantID  uuid.UUID          `json:"tenant_id"`
+	EventKey  string             `json:"event_key"`
+	Version   int32              `json:"version"`
+	Schema    []byte             `json:"schema"`
+	Mode      V1EventSchemaMode  `json:"mode"`
+	CreatedAt pgtype.Timestamptz `json:"created_at"`
+}
+
+type V1EventSchemaValidationFailuresOlap struct {
+	ID             int64              `json:"id"`
+	TenantID       uuid.UUID          `json:"tenant_id"`
+	EventKey       string             `json:"event_key"`
+	SchemaEventKey string             `json:"schema_event_key"`
+	SchemaVersion  int32              `json:"schema_version"`
+	Rejected       bool               `json:"rejected"`
+	Error          string             `json:"error"`
+	InsertedAt     pgtype.Timestamptz `json:"inserted_at"`
+	UpdatedAt      pgtype.Timestamptz `json:"updated_at"`
+}
+
 type V1EventToRunOlap struct {
 	RunID         int64              `json:"run_id"`
 	RunInsertedAt pgtype.Timestamptz `json:"run_inserted_at"`
@@ -3489,6 +3943,7 @@ type V1LogLine struct {
 	RetryCount     int32              `json:"retry_count"`
 	WorkflowID     *uuid.UUID         `json:"workflow_id"`
 	StepID         *uuid.UUID         `json:"step_id"`
+	WorkerID       *uuid.UUID         `json:"worker_id"`
 }
 
 type V1LookupTable struct {
@@ -3555,6 +4010,19 @@ type V1MatchCondition struct {
 	Data              []byte                 `json:"data"`
 }
 
+type V1OlapExportCheckpoint struct {
+	TenantID       uuid.UUID          `json:"tenant_id"`
+	PartitionDate  pgtype.Date        `json:"partition_date"`
+	IsCompleted    bool               `json:"is_completed"`
+	LeaseProcessID uuid.UUID          `json:"lease_process_id"`
+	LeaseExpiresAt pgtype.Timestamptz `json:"lease_expires_at"`
+	Destination    pgtype.Text        `json:"destination"`
+	RunCount       int64              `json:"run_count"`
+	TaskCount      int64              `json:"task_count"`
+	TaskEventCount int64              `json:"task_event_count"`
+	ExportedAt     pgtype.Timestamptz `json:"exported_at"`
+}
+
 type V1OperationIntervalSettings struct {
 	TenantID            uuid.UUID `json:"tenant_id"`
 	OperationID         string    `json:"operation_id"`
@@ -3750,6 +4218,15 @@ type V1StatusesOlap struct {
 	ReadableStatus V1ReadableStatusOlap `json:"readable_status"`
 }
 
+type V1StepApproval struct {
+	StepID           uuid.UUID              `json:"step_id"`
+	TenantID         uuid.UUID              `json:"tenant_id"`
+	Approvers        []string               `json:"approvers"`
+	ContextFields    []string               `json:"context_fields"`
+	ExpiresInSeconds int32                  `json:"expires_in_seconds"`
+	OnExpiry         V1ApprovalExpiryAction `json:"on_expiry"`
+}
+
 type V1StepBatchConfig struct {
 	StepID            uuid.UUID   `json:"step_id"`
 	BatchMaxSize      int32       `json:"batch_max_size"`
@@ -3759,6 +4236,26 @@ type V1StepBatchConfig struct {
 	BroadcastOutput   bool        `json:"broadcast_output"`
 }
 
+type V1StepRetryPolicy struct {
+	StepID            uuid.UUID     `json:"step_id"`
+	TenantID          uuid.UUID     `json:"tenant_id"`
+	PolicyIndex       int32         `json:"policy_index"`
+	ErrorClass        pgtype.Text   `json:"error_class"`
+	Expression        pgtype.Text   `json:"expression"`
+	Retries           pgtype.Int4   `json:"retries"`
+	BackoffFactor     pgtype.Float8 `json:"backoff_factor"`
+	BackoffMaxSeconds pgtype.Int4   `json:"backoff_max_seconds"`
+	NeverRetry        bool          `json:"never_retry"`
+}
+
+type V1StepCircuitBreaker struct {
+	StepID           uuid.UUID `json:"step_id"`
+	TenantID         uuid.UUID `json:"tenant_id"`
+	FailureThreshold int32     `json:"failure_threshold"`
+	WindowSeconds    int32     `json:"window_seconds"`
+	CooldownSeconds  int32     `json:"cooldown_seconds"`
+}
+
 type V1StepConcurrency struct {
 	ID                int64                 `json:"id"`
 	ParentStrategyID  pgtype.Int8           `json:"parent_strategy_id"`
@@ -3773,6 +4270,13 @@ type V1StepConcurrency struct {
 	MaxConcurrency    int32                 `json:"max_concurrency"`
 }
 
+type V1StepMap struct {
+	StepID         uuid.UUID `json:"step_id"`
+	TenantID       uuid.UUID `json:"tenant_id"`
+	Expression     string    `json:"expression"`
+	MaxParallelism int32     `json:"max_parallelism"`
+}
+
 type V1StepMatchCondition struct {
 	ID               int64                    `json:"id"`
 	TenantID         uuid.UUID                `json:"tenant_id"`
@@ -3787,6 +4291,12 @@ type V1StepMatchCondition struct {
 	ParentReadableID pgtype.Text              `json:"parent_readable_id"`
 }
 
+type V1StepPreemption struct {
+	StepID         uuid.UUID `json:"step_id"`
+	TenantID       uuid.UUID `json:"tenant_id"`
+	MaxPreemptions int32     `json:"max_preemptions"`
+}
+
 type V1StepSlotRequest struct {
 	TenantID  uuid.UUID          `json:"tenant_id"`
 	StepID    uuid.UUID          `json:"step_id"`
@@ -3900,6 +4410,22 @@ type V1TaskExpressionEval struct {
 	Kind           StepExpressionKind `json:"kind"`
 }
 
+type V1TaskPreemption struct {
+	TaskID          int64              `json:"task_id"`
+	TaskInsertedAt  pgtype.Timestamptz `json:"task_inserted_at"`
+	TenantID        uuid.UUID          `json:"tenant_id"`
+	PreemptedCount  int32              `json:"preempted_count"`
+	LastPreemptedAt pgtype.Timestamptz `json:"last_preempted_at"`
+}
+
+type V1TaskRetryPolicyCount struct {
+	TaskID         int64              `json:"task_id"`
+	TaskInsertedAt pgtype.Timestamptz `json:"task_inserted_at"`
+	TenantID       uuid.UUID          `json:"tenant_id"`
+	PolicyIndex    int32              `json:"policy_index"`
+	RetryCount     int32              `json:"retry_count"`
+}
+
 type V1TaskRuntime struct {
 	TaskID         int64              `json:"task_id"`
 	TaskInsertedAt pgtype.Timestamptz `json:"task_inserted_at"`
@@ -3926,6 +4452,24 @@ type V1TaskRuntimeSlot struct {
 	UpdatedAt      pgtype.Timestamptz `json:"updated_at"`
 }
 
+type V1TaskSlotUsageOlap struct {
+	TenantID           uuid.UUID          `json:"tenant_id"`
+	TaskID             int64              `json:"task_id"`
+	TaskInsertedAt     pgtype.Timestamptz `json:"task_inserted_at"`
+	RetryCount         int32              `json:"retry_count"`
+	SlotType           string             `json:"slot_type"`
+	Units              int32              `json:"units"`
+	WorkerID           uuid.UUID          `json:"worker_id"`
+	WorkflowID         uuid.UUID          `json:"workflow_id"`
+	WorkflowName       string             `json:"workflow_name"`
+	ActionID           string             `json:"action_id"`
+	WorkerLabels       []byte             `json:"worker_labels"`
+	AdditionalMetadata []byte             `json:"additional_metadata"`
+	AssignedAt         pgtype.Timestamptz `json:"assigned_at"`
+	ReleasedAt         pgtype.Timestamptz `json:"released_at"`
+	SlotSeconds        float64            `json:"slot_seconds"`
+}
+
 type V1TaskStatusUpdatesTmp struct {
 	TenantID       uuid.UUID          `json:"tenant_id"`
 	RequeueAfter   pgtype.Timestamptz `json:"requeue_after"`
@@ -3964,6 +4508,31 @@ type V1TasksOlap struct {
 	IdempotencyKey       pgtype.Text          `json:"idempotency_key"`
 }
 
+type V1TenantPriorityAging struct {
+	TenantID        uuid.UUID          `json:"tenant_id"`
+	IntervalSeconds pgtype.Int4        `json:"interval_seconds"`
+	MaxWaitSeconds  pgtype.Int4        `json:"max_wait_seconds"`
+	UpdatedAt       pgtype.Timestamptz `json:"updated_at"`
+}
+
+type V1WorkerCordon struct {
+	TenantID      uuid.UUID               `json:"tenant_id"`
+	WorkerID      uuid.UUID               `json:"worker_id"`
+	Reason        pgtype.Text             `json:"reason"`
+	CordonedAt    pgtype.Timestamptz      `json:"cordoned_at"`
+	DrainDeadline pgtype.Timestamptz      `json:"drain_deadline"`
+	DrainAction   NullV1WorkerDrainAction `json:"drain_action"`
+	DrainedAt     pgtype.Timestamptz      `json:"drained_at"`
+}
+
+type V1WorkerScalingTarget struct {
+	TenantID           uuid.UUID          `json:"tenant_id"`
+	WorkerID           uuid.UUID          `json:"worker_id"`
+	SlotsPerReplica    pgtype.Int4        `json:"slots_per_replica"`
+	MaxQueueAgeSeconds pgtype.Int4        `json:"max_queue_age_seconds"`
+	CreatedAt          pgtype.Timestamptz `json:"created_at"`
+}
+
 type V1WorkerSlotConfig struct {
 	TenantID  uuid.UUID          `json:"tenant_id"`
 	WorkerID  uuid.UUID          `json:"worker_id"`
@@ -3999,6 +4568,14 @@ type V1WorkflowConcurrencySlot struct {
 	IsFilled                  bool      `json:"is_filled"`
 }
 
+type V1WorkflowPriorityAging struct {
+	WorkflowID      uuid.UUID          `json:"workflow_id"`
+	TenantID        uuid.UUID          `json:"tenant_id"`
+	IntervalSeconds pgtype.Int4        `json:"interval_seconds"`
+	MaxWaitSeconds  pgtype.Int4        `json:"max_wait_seconds"`
+	UpdatedAt       pgtype.Timestamptz `json:"updated_at"`
+}
+
 type WebhookWorker struct {
 	ID         uuid.UUID        `json:"id"`
 	CreatedAt  pgtype.Timestamp `json:"createdAt"`
@@ -4065,6 +4642,7 @@ type WorkerLabel struct {
 	Key       string           `json:"key"`
 	StrValue  pgtype.Text      `json:"strValue"`
 	IntValue  pgtype.Int4      `json:"intValue"`
+	IsDynamic bool             `json:"isDynamic"`
 }
 
 type Workflow struct {
@@ -0,0 +1,85 @@
+package sqlcv1
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// searchWorkflowRunIds is rendered with the search predicate in place of %s. The predicate is built
+// by the repository from a compiled search expression and may only reference the alias r, the fixed
+// parameters below and its own parameters, which start at $7.
+const searchWorkflowRunIds = `-- name: SearchWorkflowRunIds :many
+SELECT r.id, r.inserted_at, r.kind, r.external_id
+FROM v1_runs_olap r
+WHERE
+    r.tenant_id = $1::uuid
+    AND r.readable_status = ANY($2::v1_readable_status_olap[])
+    AND (
+        $3::uuid[] IS NULL
+        OR r.workflow_id = ANY($3::uuid[])
+    )
+    AND r.inserted_at >= $4::timestamptz
+    AND r.inserted_at <= $5::timestamptz
+    AND (%s)
+ORDER BY r.inserted_at DESC, r.id DESC
+LIMIT $6::integer
+`
+
+// SearchWorkflowRunIdsFirstPredicateArg is the index of the first parameter available to the
+// search predicate.
+const SearchWorkflowRunIdsFirstPredicateArg = 7
+
+type SearchWorkflowRunIdsParams struct {
+	Tenantid      uuid.UUID          `json:"tenantid"`
+	Statuses      []string           `json:"statuses"`
+	WorkflowIds   []uuid.UUID        `json:"workflowIds"`
+	Since         pgtype.Timestamptz `json:"since"`
+	Until         pgtype.Timestamptz `json:"until"`
+	Limit         int32              `json:"limit"`
+	Predicate     string             `json:"predicate"`
+	PredicateArgs []any              `json:"predicateArgs"`
+}
+
+func (q *Queries) SearchWorkflowRunIds(ctx context.Context, db DBTX, arg SearchWorkflowRunIdsParams) ([]*FetchWorkflowRunIdsRow, error) {
+	predicate := arg.Predicate
+
+	if predicate == "" {
+		predicate = "TRUE"
+	}
+
+	args := append([]any{
+		arg.Tenantid,
+		arg.Statuses,
+		arg.WorkflowIds,
+		arg.Since,
+		arg.Until,
+		arg.Limit,
+	}, arg.PredicateArgs...)
+
+	rows, err := db.Query(ctx, fmt.Sprintf(searchWorkflowRunIds, predicate), args...)
+
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*FetchWorkflowRunIdsRow
+	for rows.Next() {
+		var i FetchWorkflowRunIdsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.InsertedAt,
+			&i.Kind,
+			&i.ExternalID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
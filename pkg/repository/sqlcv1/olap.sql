@@ -5,7 +5,8 @@ SELECT
     create_v1_range_partition('v1_tasks_olap'::text, @date::date),
     create_v1_range_partition('v1_runs_olap'::text, @date::date),
     create_v1_range_partition('v1_dags_olap'::text, @date::date),
-    create_v1_range_partition('v1_payloads_olap'::text, @date::date)
+    create_v1_range_partition('v1_payloads_olap'::text, @date::date),
+    create_v1_range_partition('v1_task_slot_usage_olap'::text, @date::date)
 ;
 
 -- name: CreateOLAPEventPartitions :exec
@@ -14,7 +15,8 @@ SELECT
     create_v1_range_partition('v1_event_to_run_olap'::text, @date::date),
     create_v1_weekly_range_partition('v1_event_lookup_table_olap'::text, @date::date),
     create_v1_range_partition('v1_incoming_webhook_validation_failures_olap'::text, @date::date),
-    create_v1_range_partition('v1_cel_evaluation_failures_olap'::text, @date::date)
+    create_v1_range_partition('v1_cel_evaluation_failures_olap'::text, @date::date),
+    create_v1_range_partition('v1_event_schema_validation_failures_olap'::text, @date::date)
 ;
 
 -- name: CreateOLAPOtelPartitions :exec
@@ -58,12 +60,16 @@ WITH task_partitions AS (
     SELECT 'v1_incoming_webhook_validation_failures_olap' AS parent_table, p::TEXT AS partition_name FROM get_v1_partitions_before_date('v1_incoming_webhook_validation_failures_olap', @date::date) AS p
 ), cel_evaluation_failures_partitions AS (
     SELECT 'v1_cel_evaluation_failures_olap' AS parent_table, p::TEXT AS partition_name FROM get_v1_partitions_before_date('v1_cel_evaluation_failures_olap', @date::date) AS p
+), event_schema_validation_failures_partitions AS (
+    SELECT 'v1_event_schema_validation_failures_olap' AS parent_table, p::TEXT AS partition_name FROM get_v1_partitions_before_date('v1_event_schema_validation_failures_olap', @date::date) AS p
 ), payloads_partitions AS (
     SELECT 'v1_payloads_olap' AS parent_table, p::TEXT AS partition_name FROM get_v1_partitions_before_date('v1_payloads_olap', @date::date) AS p
 ), otel_trace_partitions AS (
     SELECT 'v1_otel_trace_olap' AS parent_table, p::TEXT AS partition_name FROM get_v1_partitions_before_date('v1_otel_trace_olap', @date::date) AS p
 ), otel_trace_lookup_partitions AS (
     SELECT 'v1_otel_trace_lookup_olap' AS parent_table, p::TEXT AS partition_name FROM get_v1_partitions_before_date('v1_otel_trace_lookup_olap', @date::date) AS p
+), task_slot_usage_partitions AS (
+    SELECT 'v1_task_slot_usage_olap' AS parent_table, p::TEXT AS partition_name FROM get_v1_partitions_before_date('v1_task_slot_usage_olap', @date::date) AS p
 ), candidates AS (
     SELECT
         *
@@ -121,6 +127,13 @@ WITH task_partitions AS (
 
     UNION ALL
 
+    SELECT
+        *
+    FROM
+        event_schema_validation_failures_partitions
+
+    UNION ALL
+
     SELECT
         *
     FROM
@@ -140,6 +153,13 @@ WITH task_partitions AS (
     FROM
         otel_trace_lookup_partitions
 
+    UNION ALL
+
+    SELECT
+        *
+    FROM
+        task_slot_usage_partitions
+
 )
 
 SELECT *
@@ -147,7 +167,7 @@ FROM candidates
 WHERE
     CASE
         WHEN @shouldPartitionEventsTables::BOOLEAN THEN TRUE
-        ELSE parent_table NOT IN ('v1_events_olap', 'v1_event_to_run_olap', 'v1_cel_evaluation_failures_olap', 'v1_incoming_webhook_validation_failures_olap')
+        ELSE parent_table NOT IN ('v1_events_olap', 'v1_event_to_run_olap', 'v1_cel_evaluation_failures_olap', 'v1_incoming_webhook_validation_failures_olap', 'v1_event_schema_validation_failures_olap')
     END
     AND CASE
         WHEN @shouldPartitionOtelTables::BOOLEAN THEN TRUE
@@ -2107,6 +2127,27 @@ SELECT @tenantId::UUID, source, error
 FROM inputs
 ;
 
+-- name: StoreEventSchemaValidationFailures :exec
+WITH inputs AS (
+    SELECT
+        UNNEST(@eventKeys::TEXT[]) AS event_key,
+        UNNEST(@schemaEventKeys::TEXT[]) AS schema_event_key,
+        UNNEST(@schemaVersions::INTEGER[]) AS schema_version,
+        UNNEST(@rejected::BOOLEAN[]) AS rejected,
+        UNNEST(@errors::TEXT[]) AS error
+)
+INSERT INTO v1_event_schema_validation_failures_olap (
+    tenant_id,
+    event_key,
+    schema_event_key,
+    schema_version,
+    rejected,
+    error
+)
+SELECT @tenantId::UUID, event_key, schema_event_key, schema_version, rejected, error
+FROM inputs
+;
+
 -- name: PutPayloads :exec
 WITH inputs AS (
     SELECT
@@ -2205,6 +2246,41 @@ WHERE
     )
 ;
 
+-- name: ListWorkflowRunExternalIdsPage :many
+-- Lists a page of the runs matching the filter in (inserted_at, id) order, starting after the
+-- cursor, so that large sets of runs can be read in bounded batches.
+SELECT external_id, inserted_at, id
+FROM v1_runs_olap
+WHERE
+    tenant_id = @tenantId::UUID
+    AND inserted_at > @since::TIMESTAMPTZ
+    AND (
+        sqlc.narg('until')::TIMESTAMPTZ IS NULL
+        OR inserted_at <= sqlc.narg('until')::TIMESTAMPTZ
+    )
+    AND (
+        sqlc.narg('afterInsertedAt')::TIMESTAMPTZ IS NULL
+        OR (inserted_at, id) > (sqlc.narg('afterInsertedAt')::TIMESTAMPTZ, sqlc.narg('afterId')::BIGINT)
+    )
+    AND readable_status = ANY(CAST(@statuses::TEXT[] AS v1_readable_status_olap[]))
+    AND (
+        sqlc.narg('additionalMetaKeys')::text[] IS NULL
+        OR sqlc.narg('additionalMetaValues')::text[] IS NULL
+        OR EXISTS (
+            SELECT 1 FROM jsonb_each_text(additional_metadata) kv
+            JOIN LATERAL (
+                SELECT unnest(sqlc.narg('additionalMetaKeys')::text[]) AS k,
+                    unnest(sqlc.narg('additionalMetaValues')::text[]) AS v
+            ) AS u ON kv.key = u.k AND kv.value = u.v
+        )
+    )
+    AND (
+        sqlc.narg('workflowIds')::UUID[] IS NULL OR workflow_id = ANY(sqlc.narg('workflowIds')::UUID[])
+    )
+ORDER BY inserted_at, id
+LIMIT @pageLimit::INTEGER
+;
+
 -- name: CountOLAPTempTableSizeForDAGStatusUpdates :one
 SELECT COUNT(*) AS total
 FROM v1_task_status_updates_tmp
@@ -2488,3 +2564,78 @@ SELECT
     UNNEST(@dagIds::bigint[]),
     UNNEST(@dagInsertedAts::timestamptz[])
 ON CONFLICT DO NOTHING;
+
+-- name: DeleteFinishedRunsOLAP :many
+-- Deletes finished runs, along with the tasks of DAG runs, from the OLAP tables. Runs which are not
+-- finished are skipped. Returns the external ids of the deleted runs.
+WITH runs AS (
+    SELECT
+        r.id,
+        r.inserted_at,
+        r.external_id,
+        r.kind
+    FROM
+        v1_lookup_table_olap lt
+    JOIN
+        v1_runs_olap r ON r.inserted_at = lt.inserted_at AND r.id = COALESCE(lt.dag_id, lt.task_id)
+    WHERE
+        lt.tenant_id = @tenantId::uuid
+        AND lt.external_id = ANY(@externalIds::uuid[])
+        AND r.tenant_id = @tenantId::uuid
+        AND r.readable_status = ANY(ARRAY['COMPLETED', 'FAILED', 'CANCELLED']::v1_readable_status_olap[])
+), tasks AS (
+    SELECT
+        dt.task_id AS id,
+        dt.task_inserted_at AS inserted_at
+    FROM
+        runs
+    JOIN
+        v1_dag_to_task_olap dt ON dt.dag_id = runs.id AND dt.dag_inserted_at = runs.inserted_at
+    WHERE
+        runs.kind = 'DAG'
+    UNION ALL
+    SELECT
+        runs.id,
+        runs.inserted_at
+    FROM
+        runs
+    WHERE
+        runs.kind = 'TASK'
+), deleted_tasks AS (
+    DELETE FROM
+        v1_tasks_olap t
+    WHERE
+        (t.inserted_at, t.id) IN (SELECT inserted_at, id FROM tasks)
+    RETURNING
+        t.external_id
+), deleted_dags AS (
+    DELETE FROM
+        v1_dags_olap d
+    WHERE
+        (d.inserted_at, d.id) IN (SELECT inserted_at, id FROM runs WHERE kind = 'DAG')
+    RETURNING
+        d.external_id
+), deleted_dag_to_tasks AS (
+    DELETE FROM
+        v1_dag_to_task_olap dt
+    WHERE
+        (dt.dag_id, dt.dag_inserted_at) IN (SELECT id, inserted_at FROM runs WHERE kind = 'DAG')
+), deleted_external_ids AS (
+    SELECT external_id FROM deleted_tasks
+    UNION ALL
+    SELECT external_id FROM deleted_dags
+), deleted_statuses AS (
+    DELETE FROM
+        v1_statuses_olap s
+    WHERE
+        s.external_id IN (SELECT external_id FROM deleted_external_ids)
+), deleted_lookups AS (
+    DELETE FROM
+        v1_lookup_table_olap lt
+    WHERE
+        lt.external_id IN (SELECT external_id FROM deleted_external_ids)
+)
+SELECT
+    external_id
+FROM
+    runs;
@@ -427,7 +427,8 @@ SELECT
     create_v1_range_partition('v1_event_to_run_olap'::text, $1::date),
     create_v1_weekly_range_partition('v1_event_lookup_table_olap'::text, $1::date),
     create_v1_range_partition('v1_incoming_webhook_validation_failures_olap'::text, $1::date),
-    create_v1_range_partition('v1_cel_evaluation_failures_olap'::text, $1::date)
+    create_v1_range_partition('v1_cel_evaluation_failures_olap'::text, $1::date),
+    create_v1_range_partition('v1_event_schema_validation_failures_olap'::text, $1::date)
 `
 
 func (q *Queries) CreateOLAPEventPartitions(ctx context.Context, db DBTX, date pgtype.Date) error {
@@ -484,7 +485,8 @@ SELECT
     create_v1_range_partition('v1_tasks_olap'::text, $2::date),
     create_v1_range_partition('v1_runs_olap'::text, $2::date),
     create_v1_range_partition('v1_dags_olap'::text, $2::date),
-    create_v1_range_partition('v1_payloads_olap'::text, $2::date)
+    create_v1_range_partition('v1_payloads_olap'::text, $2::date),
+    create_v1_range_partition('v1_task_slot_usage_olap'::text, $2::date)
 `
 
 type CreateOLAPPartitionsParams struct {
@@ -579,6 +581,107 @@ func (q *Queries) CreateV1PayloadOLAPCutoverTemporaryTable(ctx context.Context,
 	return err
 }
 
+const deleteFinishedRunsOLAP = `-- name: DeleteFinishedRunsOLAP :many
+WITH runs AS (
+    SELECT
+        r.id,
+        r.inserted_at,
+        r.external_id,
+        r.kind
+    FROM
+        v1_lookup_table_olap lt
+    JOIN
+        v1_runs_olap r ON r.inserted_at = lt.inserted_at AND r.id = COALESCE(lt.dag_id, lt.task_id)
+    WHERE
+        lt.tenant_id = $1::uuid
+        AND lt.external_id = ANY($2::uuid[])
+        AND r.tenant_id = $1::uuid
+        AND r.readable_status = ANY(ARRAY['COMPLETED', 'FAILED', 'CANCELLED']::v1_readable_status_olap[])
+), tasks AS (
+    SELECT
+        dt.task_id AS id,
+        dt.task_inserted_at AS inserted_at
+    FROM
+        runs
+    JOIN
+        v1_dag_to_task_olap dt ON dt.dag_id = runs.id AND dt.dag_inserted_at = runs.inserted_at
+    WHERE
+        runs.kind = 'DAG'
+    UNION ALL
+    SELECT
+        runs.id,
+        runs.inserted_at
+    FROM
+        runs
+    WHERE
+        runs.kind = 'TASK'
+), deleted_tasks AS (
+    DELETE FROM
+        v1_tasks_olap t
+    WHERE
+        (t.inserted_at, t.id) IN (SELECT inserted_at, id FROM tasks)
+    RETURNING
+        t.external_id
+), deleted_dags AS (
+    DELETE FROM
+        v1_dags_olap d
+    WHERE
+        (d.inserted_at, d.id) IN (SELECT inserted_at, id FROM runs WHERE kind = 'DAG')
+    RETURNING
+        d.external_id
+), deleted_dag_to_tasks AS (
+    DELETE FROM
+        v1_dag_to_task_olap dt
+    WHERE
+        (dt.dag_id, dt.dag_inserted_at) IN (SELECT id, inserted_at FROM runs WHERE kind = 'DAG')
+), deleted_external_ids AS (
+    SELECT external_id FROM deleted_tasks
+    UNION ALL
+    SELECT external_id FROM deleted_dags
+), deleted_statuses AS (
+    DELETE FROM
+        v1_statuses_olap s
+    WHERE
+        s.external_id IN (SELECT external_id FROM deleted_external_ids)
+), deleted_lookups AS (
+    DELETE FROM
+        v1_lookup_table_olap lt
+    WHERE
+        lt.external_id IN (SELECT external_id FROM deleted_external_ids)
+)
+SELECT
+    external_id
+FROM
+    runs
+`
+
+type DeleteFinishedRunsOLAPParams struct {
+	Tenantid    uuid.UUID   `json:"tenantid"`
+	Externalids []uuid.UUID `json:"externalids"`
+}
+
+// Deletes finished runs, along with the tasks of DAG runs, from the OLAP tables. Runs which are not
+// finished are skipped. Returns the external ids of the deleted runs.
+func (q *Queries) DeleteFinishedRunsOLAP(ctx context.Context, db DBTX, arg DeleteFinishedRunsOLAPParams) ([]uuid.UUID, error) {
+	rows, err := db.Query(ctx, deleteFinishedRunsOLAP, arg.Tenantid, arg.Externalids)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []uuid.UUID
+	for rows.Next() {
+		var external_id uuid.UUID
+		if err := rows.Scan(&external_id); err != nil {
+			return nil, err
+		}
+		items = append(items, external_id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const deleteOldOLAPPayloadOffloadedBlockIndexRows = `-- name: DeleteOldOLAPPayloadOffloadedBlockIndexRows :exec
 DELETE FROM v1_payloads_olap_offloaded_block_index
 WHERE payload_inserted_at_date < $1::DATE
@@ -1502,12 +1605,16 @@ WITH task_partitions AS (
     SELECT 'v1_incoming_webhook_validation_failures_olap' AS parent_table, p::TEXT AS partition_name FROM get_v1_partitions_before_date('v1_incoming_webhook_validation_failures_olap', $3::date) AS p
 ), cel_evaluation_failures_partitions AS (
     SELECT 'v1_cel_evaluation_failures_olap' AS parent_table, p::TEXT AS partition_name FROM get_v1_partitions_before_date('v1_cel_evaluation_failures_olap', $3::date) AS p
+), event_schema_validation_failures_partitions AS (
+    SELECT 'v1_event_schema_validation_failures_olap' AS parent_table, p::TEXT AS partition_name FROM get_v1_partitions_before_date('v1_event_schema_validation_failures_olap', $3::date) AS p
 ), payloads_partitions AS (
     SELECT 'v1_payloads_olap' AS parent_table, p::TEXT AS partition_name FROM get_v1_partitions_before_date('v1_payloads_olap', $3::date) AS p
 ), otel_trace_partitions AS (
     SELECT 'v1_otel_trace_olap' AS parent_table, p::TEXT AS partition_name FROM get_v1_partitions_before_date('v1_otel_trace_olap', $3::date) AS p
 ), otel_trace_lookup_partitions AS (
     SELECT 'v1_otel_trace_lookup_olap' AS parent_table, p::TEXT AS partition_name FROM get_v1_partitions_before_date('v1_otel_trace_lookup_olap', $3::date) AS p
+), task_slot_usage_partitions AS (
+    SELECT 'v1_task_slot_usage_olap' AS parent_table, p::TEXT AS partition_name FROM get_v1_partitions_before_date('v1_task_slot_usage_olap', $3::date) AS p
 ), candidates AS (
     SELECT
         parent_table, partition_name
@@ -1565,6 +1672,13 @@ WITH task_partitions AS (
 
     UNION ALL
 
+    SELECT
+        parent_table, partition_name
+    FROM
+        event_schema_validation_failures_partitions
+
+    UNION ALL
+
     SELECT
         parent_table, partition_name
     FROM
@@ -1584,6 +1698,13 @@ WITH task_partitions AS (
     FROM
         otel_trace_lookup_partitions
 
+    UNION ALL
+
+    SELECT
+        *
+    FROM
+        task_slot_usage_partitions
+
 )
 
 SELECT parent_table, partition_name
@@ -1591,7 +1712,7 @@ FROM candidates
 WHERE
     CASE
         WHEN $1::BOOLEAN THEN TRUE
-        ELSE parent_table NOT IN ('v1_events_olap', 'v1_event_to_run_olap', 'v1_cel_evaluation_failures_olap', 'v1_incoming_webhook_validation_failures_olap')
+        ELSE parent_table NOT IN ('v1_events_olap', 'v1_event_to_run_olap', 'v1_cel_evaluation_failures_olap', 'v1_incoming_webhook_validation_failures_olap', 'v1_event_schema_validation_failures_olap')
     END
     AND CASE
         WHEN $2::BOOLEAN THEN TRUE
@@ -2166,6 +2287,91 @@ func (q *Queries) ListWorkflowRunExternalIds(ctx context.Context, db DBTX, arg L
 	return items, nil
 }
 
+const listWorkflowRunExternalIdsPage = `-- name: ListWorkflowRunExternalIdsPage :many
+SELECT external_id, inserted_at, id
+FROM v1_runs_olap
+WHERE
+    tenant_id = $1::UUID
+    AND inserted_at > $2::TIMESTAMPTZ
+    AND (
+        $3::TIMESTAMPTZ IS NULL
+        OR inserted_at <= $3::TIMESTAMPTZ
+    )
+    AND (
+        $4::TIMESTAMPTZ IS NULL
+        OR (inserted_at, id) > ($4::TIMESTAMPTZ, $5::BIGINT)
+    )
+    AND readable_status = ANY(CAST($6::TEXT[] AS v1_readable_status_olap[]))
+    AND (
+        $7::text[] IS NULL
+        OR $8::text[] IS NULL
+        OR EXISTS (
+            SELECT 1 FROM jsonb_each_text(additional_metadata) kv
+            JOIN LATERAL (
+                SELECT unnest($7::text[]) AS k,
+                    unnest($8::text[]) AS v
+            ) AS u ON kv.key = u.k AND kv.value = u.v
+        )
+    )
+    AND (
+        $9::UUID[] IS NULL OR workflow_id = ANY($9::UUID[])
+    )
+ORDER BY inserted_at, id
+LIMIT $10::INTEGER
+`
+
+type ListWorkflowRunExternalIdsPageParams struct {
+	Tenantid             uuid.UUID          `json:"tenantid"`
+	Since                pgtype.Timestamptz `json:"since"`
+	Until                pgtype.Timestamptz `json:"until"`
+	AfterInsertedAt      pgtype.Timestamptz `json:"afterInsertedAt"`
+	AfterId              pgtype.Int8        `json:"afterId"`
+	Statuses             []string           `json:"statuses"`
+	AdditionalMetaKeys   []string           `json:"additionalMetaKeys"`
+	AdditionalMetaValues []string           `json:"additionalMetaValues"`
+	WorkflowIds          []uuid.UUID        `json:"workflowIds"`
+	Pagelimit            int32              `json:"pagelimit"`
+}
+
+type ListWorkflowRunExternalIdsPageRow struct {
+	ExternalID uuid.UUID          `json:"external_id"`
+	InsertedAt pgtype.Timestamptz `json:"inserted_at"`
+	ID         int64              `json:"id"`
+}
+
+// Lists a page of the runs matching the filter in (inserted_at, id) order, starting after the
+// cursor, so that large sets of runs can be read in bounded batches.
+func (q *Queries) ListWorkflowRunExternalIdsPage(ctx context.Context, db DBTX, arg ListWorkflowRunExternalIdsPageParams) ([]*ListWorkflowRunExternalIdsPageRow, error) {
+	rows, err := db.Query(ctx, listWorkflowRunExternalIdsPage,
+		arg.Tenantid,
+		arg.Since,
+		arg.Until,
+		arg.AfterInsertedAt,
+		arg.AfterId,
+		arg.Statuses,
+		arg.AdditionalMetaKeys,
+		arg.AdditionalMetaValues,
+		arg.WorkflowIds,
+		arg.Pagelimit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*ListWorkflowRunExternalIdsPageRow
+	for rows.Next() {
+		var i ListWorkflowRunExternalIdsPageRow
+		if err := rows.Scan(&i.ExternalID, &i.InsertedAt, &i.ID); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listYesterdayRunCountsByStatus = `-- name: ListYesterdayRunCountsByStatus :many
 SELECT readable_status, COUNT(*)
 FROM v1_runs_olap
@@ -3518,6 +3724,48 @@ func (q *Queries) StoreCELEvaluationFailures(ctx context.Context, db DBTX, arg S
 	return err
 }
 
+const storeEventSchemaValidationFailures = `-- name: StoreEventSchemaValidationFailures :exec
+WITH inputs AS (
+    SELECT
+        UNNEST($1::TEXT[]) AS event_key,
+        UNNEST($2::TEXT[]) AS schema_event_key,
+        UNNEST($3::INTEGER[]) AS schema_version,
+        UNNEST($4::BOOLEAN[]) AS rejected,
+        UNNEST($5::TEXT[]) AS error
+)
+INSERT INTO v1_event_schema_validation_failures_olap (
+    tenant_id,
+    event_key,
+    schema_event_key,
+    schema_version,
+    rejected,
+    error
+)
+SELECT $6::UUID, event_key, schema_event_key, schema_version, rejected, error
+FROM inputs
+`
+
+type StoreEventSchemaValidationFailuresParams struct {
+	Eventkeys       []string  `json:"eventkeys"`
+	Schemaeventkeys []string  `json:"schemaeventkeys"`
+	Schemaversions  []int32   `json:"schemaversions"`
+	Rejected        []bool    `json:"rejected"`
+	Errors          []string  `json:"errors"`
+	Tenantid        uuid.UUID `json:"tenantid"`
+}
+
+func (q *Queries) StoreEventSchemaValidationFailures(ctx context.Context, db DBTX, arg StoreEventSchemaValidationFailuresParams) error {
+	_, err := db.Exec(ctx, storeEventSchemaValidationFailures,
+		arg.Eventkeys,
+		arg.Schemaeventkeys,
+		arg.Schemaversions,
+		arg.Rejected,
+		arg.Errors,
+		arg.Tenantid,
+	)
+	return err
+}
+
 const swapV1PayloadOLAPPartitionWithTemp = `-- name: SwapV1PayloadOLAPPartitionWithTemp :exec
 SELECT swap_v1_payloads_olap_partition_with_temp($1::DATE)
 `
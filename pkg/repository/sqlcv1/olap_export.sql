@@ -0,0 +1,176 @@
+-- name: AcquireOLAPExportLease :one
+-- Leases the export of a day partition. Completed partitions are only re-leased when the
+-- export is forced, and partitions leased by another process are skipped until the lease expires.
+INSERT INTO v1_olap_export_checkpoint (
+    tenant_id,
+    partition_date,
+    lease_process_id,
+    lease_expires_at
+)
+VALUES (
+    @tenantId::UUID,
+    @partitionDate::DATE,
+    @leaseProcessId::UUID,
+    @leaseExpiresAt::TIMESTAMPTZ
+)
+ON CONFLICT (tenant_id, partition_date)
+DO UPDATE SET
+    is_completed = FALSE,
+    lease_process_id = EXCLUDED.lease_process_id,
+    lease_expires_at = EXCLUDED.lease_expires_at
+WHERE
+    (NOT v1_olap_export_checkpoint.is_completed OR @force::BOOLEAN)
+    AND (
+        v1_olap_export_checkpoint.lease_expires_at < NOW()
+        OR v1_olap_export_checkpoint.lease_process_id = EXCLUDED.lease_process_id
+    )
+RETURNING *;
+
+-- name: CompleteOLAPExport :execrows
+UPDATE v1_olap_export_checkpoint
+SET
+    is_completed = TRUE,
+    lease_expires_at = NOW(),
+    destination = @destination::TEXT,
+    run_count = @runCount::BIGINT,
+    task_count = @taskCount::BIGINT,
+    task_event_count = @taskEventCount::BIGINT,
+    exported_at = NOW()
+WHERE
+    tenant_id = @tenantId::UUID
+    AND partition_date = @partitionDate::DATE
+    AND lease_process_id = @leaseProcessId::UUID;
+
+-- name: ReleaseOLAPExportLease :exec
+UPDATE v1_olap_export_checkpoint
+SET
+    lease_expires_at = NOW()
+WHERE
+    tenant_id = @tenantId::UUID
+    AND partition_date = @partitionDate::DATE
+    AND lease_process_id = @leaseProcessId::UUID
+    AND NOT is_completed;
+
+-- name: ListCompletedOLAPExportDates :many
+SELECT
+    partition_date
+FROM
+    v1_olap_export_checkpoint
+WHERE
+    tenant_id = @tenantId::UUID
+    AND partition_date >= @since::DATE
+    AND is_completed
+ORDER BY
+    partition_date;
+
+-- name: ListRunsForExport :many
+SELECT
+    r.tenant_id,
+    r.id,
+    r.inserted_at,
+    r.external_id,
+    r.kind,
+    r.readable_status,
+    r.workflow_id,
+    r.workflow_version_id,
+    r.additional_metadata,
+    r.parent_task_external_id,
+    r.idempotency_key,
+    COALESCE(d.display_name, t.display_name, '')::TEXT AS display_name,
+    COALESCE(d.total_tasks, 1)::INTEGER AS task_count,
+    tm.started_at::TIMESTAMPTZ AS started_at,
+    tm.finished_at::TIMESTAMPTZ AS finished_at,
+    COALESCE(tm.retry_count, 0)::INTEGER AS retry_count
+FROM
+    v1_runs_olap r
+LEFT JOIN
+    v1_dags_olap d ON r.kind = 'DAG' AND (d.inserted_at, d.id) = (r.inserted_at, r.id)
+LEFT JOIN
+    v1_tasks_olap t ON r.kind = 'TASK' AND (t.inserted_at, t.id) = (r.inserted_at, r.id)
+LEFT JOIN LATERAL (
+    SELECT
+        MIN(e.event_timestamp) FILTER (WHERE e.readable_status = 'RUNNING') AS started_at,
+        MAX(e.event_timestamp) FILTER (WHERE e.readable_status IN ('COMPLETED', 'FAILED', 'CANCELLED')) AS finished_at,
+        MAX(e.retry_count) AS retry_count
+    FROM
+        v1_task_events_olap e
+    WHERE
+        (e.task_id, e.task_inserted_at) IN (
+            SELECT r.id, r.inserted_at
+            WHERE r.kind = 'TASK'
+            UNION ALL
+            SELECT dt.task_id, dt.task_inserted_at
+            FROM v1_dag_to_task_olap dt
+            WHERE r.kind = 'DAG' AND (dt.dag_id, dt.dag_inserted_at) = (r.id, r.inserted_at)
+        )
+) tm ON TRUE
+WHERE
+    r.tenant_id = @tenantId::UUID
+    AND r.inserted_at >= @partitionStart::TIMESTAMPTZ
+    AND r.inserted_at < @partitionEnd::TIMESTAMPTZ
+    AND (r.inserted_at, r.id) > (@afterInsertedAt::TIMESTAMPTZ, @afterId::BIGINT)
+ORDER BY
+    r.inserted_at, r.id
+LIMIT @batchSize::INTEGER;
+
+-- name: ListTasksForExport :many
+SELECT
+    t.tenant_id,
+    t.id,
+    t.inserted_at,
+    t.external_id,
+    t.display_name,
+    t.queue,
+    t.action_id,
+    t.step_id,
+    t.workflow_id,
+    t.workflow_version_id,
+    t.workflow_run_id,
+    t.readable_status,
+    t.latest_retry_count,
+    t.latest_worker_id,
+    t.priority,
+    t.is_durable,
+    t.additional_metadata,
+    t.parent_task_external_id,
+    t.input,
+    tm.queued_at::TIMESTAMPTZ AS queued_at,
+    tm.started_at::TIMESTAMPTZ AS started_at,
+    tm.finished_at::TIMESTAMPTZ AS finished_at,
+    tm.error_message::TEXT AS error_message
+FROM
+    v1_tasks_olap t
+LEFT JOIN LATERAL (
+    SELECT
+        MIN(e.event_timestamp) FILTER (WHERE e.readable_status = 'QUEUED') AS queued_at,
+        MIN(e.event_timestamp) FILTER (WHERE e.readable_status = 'RUNNING') AS started_at,
+        MAX(e.event_timestamp) FILTER (WHERE e.readable_status IN ('COMPLETED', 'FAILED', 'CANCELLED')) AS finished_at,
+        (ARRAY_AGG(e.error_message ORDER BY e.event_timestamp DESC) FILTER (WHERE e.readable_status = 'FAILED'))[1] AS error_message
+    FROM
+        v1_task_events_olap e
+    WHERE
+        (e.task_id, e.task_inserted_at, e.retry_count) = (t.id, t.inserted_at, t.latest_retry_count)
+) tm ON TRUE
+WHERE
+    t.tenant_id = @tenantId::UUID
+    AND t.inserted_at >= @partitionStart::TIMESTAMPTZ
+    AND t.inserted_at < @partitionEnd::TIMESTAMPTZ
+    AND (t.inserted_at, t.id) > (@afterInsertedAt::TIMESTAMPTZ, @afterId::BIGINT)
+ORDER BY
+    t.inserted_at, t.id
+LIMIT @batchSize::INTEGER;
+
+-- name: ListTaskEventsForExport :many
+SELECT
+    *
+FROM
+    v1_task_events_olap
+WHERE
+    tenant_id = @tenantId::UUID
+    AND (task_id, task_inserted_at) IN (
+        SELECT
+            UNNEST(@taskIds::BIGINT[]),
+            UNNEST(@taskInsertedAts::TIMESTAMPTZ[])
+    )
+ORDER BY
+    task_inserted_at, task_id, id;
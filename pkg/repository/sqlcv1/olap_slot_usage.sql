@@ -0,0 +1,91 @@
+-- name: StoreTaskSlotUsage :exec
+WITH inputs AS (
+    SELECT
+        UNNEST(@taskIds::BIGINT[]) AS task_id,
+        UNNEST(@taskInsertedAts::TIMESTAMPTZ[]) AS task_inserted_at,
+        UNNEST(@retryCounts::INTEGER[]) AS retry_count,
+        UNNEST(@slotTypes::TEXT[]) AS slot_type,
+        UNNEST(@units::INTEGER[]) AS units,
+        UNNEST(@workerIds::UUID[]) AS worker_id,
+        UNNEST(@workflowIds::UUID[]) AS workflow_id,
+        UNNEST(@workflowNames::TEXT[]) AS workflow_name,
+        UNNEST(@actionIds::TEXT[]) AS action_id,
+        UNNEST(@workerLabels::JSONB[]) AS worker_labels,
+        UNNEST(@additionalMetadatas::JSONB[]) AS additional_metadata,
+        UNNEST(@assignedAts::TIMESTAMPTZ[]) AS assigned_at,
+        UNNEST(@releasedAts::TIMESTAMPTZ[]) AS released_at,
+        UNNEST(@slotSeconds::DOUBLE PRECISION[]) AS slot_seconds
+)
+INSERT INTO v1_task_slot_usage_olap (
+    tenant_id,
+    task_id,
+    task_inserted_at,
+    retry_count,
+    slot_type,
+    units,
+    worker_id,
+    workflow_id,
+    workflow_name,
+    action_id,
+    worker_labels,
+    additional_metadata,
+    assigned_at,
+    released_at,
+    slot_seconds
+)
+SELECT
+    @tenantId::UUID,
+    task_id,
+    task_inserted_at,
+    retry_count,
+    slot_type,
+    units,
+    worker_id,
+    workflow_id,
+    workflow_name,
+    action_id,
+    worker_labels,
+    additional_metadata,
+    assigned_at,
+    released_at,
+    slot_seconds
+FROM
+    inputs
+ON CONFLICT DO NOTHING;
+
+-- name: ListTaskSlotUsage :many
+-- Sums the slot-seconds released within a time range, grouped by the workflow name, the action id,
+-- the value of a worker label or the value of an additional metadata key. Usage without a value for
+-- the grouping key is returned under the empty key.
+SELECT
+    COALESCE(
+        CASE @groupBy::TEXT
+            WHEN 'workflow' THEN workflow_name
+            WHEN 'action' THEN action_id
+            WHEN 'worker_label' THEN worker_labels ->> @key::TEXT
+            WHEN 'metadata' THEN additional_metadata ->> @key::TEXT
+        END,
+        ''
+    )::TEXT AS key,
+    slot_type,
+    SUM(slot_seconds)::DOUBLE PRECISION AS slot_seconds,
+    COUNT(*) AS task_attempts
+FROM
+    v1_task_slot_usage_olap
+WHERE
+    tenant_id = @tenantId::UUID
+    AND released_at >= @since::TIMESTAMPTZ
+    AND released_at < @until::TIMESTAMPTZ
+    AND (
+        sqlc.narg('workflowIds')::UUID[] IS NULL
+        OR workflow_id = ANY(sqlc.narg('workflowIds')::UUID[])
+    )
+    AND (
+        sqlc.narg('slotType')::TEXT IS NULL
+        OR slot_type = sqlc.narg('slotType')::TEXT
+    )
+GROUP BY
+    1, slot_type
+ORDER BY
+    slot_seconds DESC, key ASC, slot_type ASC
+LIMIT @limitCount::INTEGER;
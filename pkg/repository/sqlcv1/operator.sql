@@ -128,6 +128,15 @@ SET
 WHERE
     w."id" = @workerId::uuid;
 
+-- name: TenantHasApprovalOperator :one
+SELECT EXISTS(
+    SELECT 1
+    FROM v1_operator
+    WHERE
+        tenant_id = @tenantId::UUID
+        AND kind = 'APPROVAL'
+) AS has_operator;
+
 -- name: TenantHasDAGOperator :one
 SELECT EXISTS(
     SELECT 1
@@ -137,6 +146,18 @@ SELECT EXISTS(
         AND kind = 'DAG'
 ) AS has_operator;
 
+-- name: ListApprovalActionsForTenant :many
+SELECT DISTINCT s."actionId"::text AS action
+FROM "Step" s
+JOIN v1_step_approval sa ON sa.step_id = s."id"
+JOIN "Job" j ON j."id" = s."jobId"
+JOIN "WorkflowVersion" wv ON wv."id" = j."workflowVersionId"
+WHERE
+    s."tenantId" = @tenantId::UUID
+    AND s."deletedAt" IS NULL
+    AND wv."deletedAt" IS NULL
+ORDER BY action;
+
 -- name: ListDAGOrchestrationActionsForTenant :many
 SELECT DISTINCT s."actionId"::text AS action
 FROM "Step" s
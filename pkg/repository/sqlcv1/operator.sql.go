@@ -265,6 +265,39 @@ func (q *Queries) GetOperator(ctx context.Context, db DBTX, id uuid.UUID) (*V1Op
 	return &i, err
 }
 
+const listApprovalActionsForTenant = `-- name: ListApprovalActionsForTenant :many
+SELECT DISTINCT s."actionId"::text AS action
+FROM "Step" s
+JOIN v1_step_approval sa ON sa.step_id = s."id"
+JOIN "Job" j ON j."id" = s."jobId"
+JOIN "WorkflowVersion" wv ON wv."id" = j."workflowVersionId"
+WHERE
+    s."tenantId" = $1::UUID
+    AND s."deletedAt" IS NULL
+    AND wv."deletedAt" IS NULL
+ORDER BY action
+`
+
+func (q *Queries) ListApprovalActionsForTenant(ctx context.Context, db DBTX, tenantid uuid.UUID) ([]string, error) {
+	rows, err := db.Query(ctx, listApprovalActionsForTenant, tenantid)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []string
+	for rows.Next() {
+		var action string
+		if err := rows.Scan(&action); err != nil {
+			return nil, err
+		}
+		items = append(items, action)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listDAGOrchestrationActionsForTenant = `-- name: ListDAGOrchestrationActionsForTenant :many
 SELECT DISTINCT s."actionId"::text AS action
 FROM "Step" s
@@ -353,6 +386,23 @@ func (q *Queries) ListOperators(ctx context.Context, db DBTX, arg ListOperatorsP
 	return items, nil
 }
 
+const tenantHasApprovalOperator = `-- name: TenantHasApprovalOperator :one
+SELECT EXISTS(
+    SELECT 1
+    FROM v1_operator
+    WHERE
+        tenant_id = $1::UUID
+        AND kind = 'APPROVAL'
+) AS has_operator
+`
+
+func (q *Queries) TenantHasApprovalOperator(ctx context.Context, db DBTX, tenantid uuid.UUID) (bool, error) {
+	row := db.QueryRow(ctx, tenantHasApprovalOperator, tenantid)
+	var has_operator bool
+	err := row.Scan(&has_operator)
+	return has_operator, err
+}
+
 const tenantHasDAGOperator = `-- name: TenantHasDAGOperator :one
 SELECT EXISTS(
     SELECT 1
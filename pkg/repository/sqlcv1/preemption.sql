@@ -0,0 +1,241 @@
+-- name: CreateStepPreemption :exec
+INSERT INTO v1_step_preemption (
+    step_id,
+    tenant_id,
+    max_preemptions
+) VALUES (
+    @stepId::uuid,
+    @tenantId::uuid,
+    @maxPreemptions::int
+);
+
+-- name: ListPreemptionCandidates :many
+-- Lists running tasks which can be preempted for the given queued tasks. For each action and
+-- priority among the queued tasks, returns up to one candidate per queued task: a running task of a
+-- preemptible step with a lower priority, on a worker which has registered the action. Tasks which
+-- are batched, orchestrate a DAG, are evicted or have been preempted as often as their step allows
+-- are never candidates. The lowest-priority and most recently created tasks are preferred, as they
+-- have usually done the least work.
+WITH input AS (
+    SELECT
+        *
+    FROM
+        (
+            SELECT
+                unnest(@taskIds::bigint[]) AS task_id,
+                unnest(@taskInsertedAts::timestamptz[]) AS task_inserted_at,
+                unnest(@taskRetryCounts::integer[]) AS task_retry_count
+        ) AS subquery
+), waiting AS (
+    SELECT
+        t.action_id,
+        COALESCE(t.priority, 1) AS priority,
+        COUNT(*) AS count
+    FROM
+        v1_task t
+    JOIN
+        input i ON i.task_id = t.id AND i.task_inserted_at = t.inserted_at AND i.task_retry_count = t.retry_count
+    WHERE
+        t.tenant_id = @tenantId::uuid
+        AND COALESCE(t.priority, 1) > 1
+        -- a freed slot can't be used by a task which is held by a circuit breaker or needs a
+        -- specific worker, so these don't preempt other tasks
+        AND NOT EXISTS (
+            SELECT 1 FROM v1_step_circuit_breaker cb WHERE cb.step_id = t.step_id
+        )
+        AND NOT EXISTS (
+            SELECT 1 FROM "StepDesiredWorkerLabel" dwl WHERE dwl."stepId" = t.step_id AND dwl."required"
+        )
+    GROUP BY
+        t.action_id, COALESCE(t.priority, 1)
+)
+SELECT
+    w.action_id::text AS waiting_action_id,
+    w.priority::integer AS waiting_priority,
+    c.id,
+    c.inserted_at,
+    c.retry_count,
+    c.external_id,
+    c.workflow_run_id,
+    c.priority::integer AS priority,
+    c.worker_id::uuid AS worker_id
+FROM
+    waiting w
+CROSS JOIN LATERAL (
+    SELECT
+        t.id,
+        t.inserted_at,
+        t.retry_count,
+        t.external_id,
+        t.workflow_run_id,
+        COALESCE(t.priority, 1) AS priority,
+        tr.worker_id
+    FROM
+        v1_task_runtime tr
+    JOIN
+        v1_task t ON t.id = tr.task_id AND t.inserted_at = tr.task_inserted_at AND t.retry_count = tr.retry_count
+    JOIN
+        v1_step_preemption sp ON sp.step_id = t.step_id
+    LEFT JOIN
+        v1_task_preemption tp ON tp.task_id = t.id AND tp.task_inserted_at = t.inserted_at
+    WHERE
+        tr.tenant_id = @tenantId::uuid
+        AND tr.worker_id IS NOT NULL
+        AND tr.batch_id IS NULL
+        AND tr.evicted_at IS NULL
+        AND NOT t.is_dag_orchestrator
+        AND COALESCE(t.priority, 1) < w.priority
+        AND COALESCE(tp.preempted_count, 0) < sp.max_preemptions
+        AND tr.worker_id IN (
+            SELECT
+                atw."B"
+            FROM
+                "_ActionToWorker" atw
+            JOIN
+                "Action" a ON a."id" = atw."A"
+            JOIN
+                "Worker" wk ON wk."id" = atw."B"
+            WHERE
+                a."tenantId" = @tenantId::uuid
+                AND a."actionId" = w.action_id
+                AND wk."isActive"
+                AND NOT wk."isPaused"
+                AND NOT EXISTS (
+                    SELECT 1 FROM v1_worker_cordon wc WHERE wc.tenant_id = wk."tenantId" AND wc.worker_id = wk."id"
+                )
+        )
+    ORDER BY
+        COALESCE(t.priority, 1) ASC, t.id DESC
+    LIMIT
+        w.count
+) c;
+
+-- name: PreemptTasks :many
+-- Starts a new attempt of each task which is still running the given attempt, without consuming an
+-- app or internal retry. The v1_task update trigger requeues the new attempt.
+WITH input AS (
+    SELECT
+        *
+    FROM
+        (
+            SELECT
+                unnest(@taskIds::bigint[]) AS task_id,
+                unnest(@taskInsertedAts::timestamptz[]) AS task_inserted_at,
+                unnest(@taskRetryCounts::integer[]) AS task_retry_count
+        ) AS subquery
+), locked_tasks AS (
+    SELECT
+        t.id,
+        t.inserted_at
+    FROM
+        v1_task t
+    JOIN
+        input i ON i.task_id = t.id AND i.task_inserted_at = t.inserted_at AND i.task_retry_count = t.retry_count
+    WHERE
+        t.tenant_id = @tenantId::uuid
+        AND EXISTS (
+            SELECT 1 FROM v1_task_runtime tr
+            WHERE tr.task_id = t.id
+                AND tr.task_inserted_at = t.inserted_at
+                AND tr.retry_count = t.retry_count
+                AND tr.worker_id IS NOT NULL
+                AND tr.evicted_at IS NULL
+        )
+    -- order by the task id to get a stable lock order
+    ORDER BY
+        id
+    FOR UPDATE
+)
+UPDATE
+    v1_task
+SET
+    retry_count = v1_task.retry_count + 1
+FROM
+    locked_tasks
+WHERE
+    v1_task.id = locked_tasks.id
+    AND v1_task.inserted_at = locked_tasks.inserted_at
+RETURNING
+    v1_task.id,
+    v1_task.inserted_at,
+    v1_task.retry_count,
+    COALESCE(v1_task.priority, 1)::integer AS priority;
+
+-- name: ResetPreemptedTaskPriorities :exec
+-- The v1_task update trigger requeues a new attempt ahead of every other task, as it does for
+-- retries. A preempted task must not be ordered ahead of the task it was preempted for, so this
+-- restores the priority the task was triggered with.
+WITH input AS (
+    SELECT
+        *
+    FROM
+        (
+            SELECT
+                unnest(@taskIds::bigint[]) AS task_id,
+                unnest(@taskInsertedAts::timestamptz[]) AS task_inserted_at,
+                unnest(@taskRetryCounts::integer[]) AS task_retry_count,
+                unnest(@priorities::integer[]) AS priority
+        ) AS subquery
+), updated_queue_items AS (
+    UPDATE
+        v1_queue_item qi
+    SET
+        priority = i.priority
+    FROM
+        input i
+    WHERE
+        qi.task_id = i.task_id
+        AND qi.task_inserted_at = i.task_inserted_at
+        AND qi.retry_count = i.task_retry_count
+    RETURNING
+        qi.id
+)
+UPDATE
+    v1_concurrency_slot cs
+SET
+    priority = i.priority
+FROM
+    input i
+WHERE
+    cs.task_id = i.task_id
+    AND cs.task_inserted_at = i.task_inserted_at
+    AND cs.task_retry_count = i.task_retry_count;
+
+-- name: RecordTaskPreemptions :exec
+INSERT INTO v1_task_preemption (
+    task_id,
+    task_inserted_at,
+    tenant_id,
+    preempted_count,
+    last_preempted_at
+)
+SELECT
+    unnest(@taskIds::bigint[]),
+    unnest(@taskInsertedAts::timestamptz[]),
+    @tenantId::uuid,
+    1,
+    CURRENT_TIMESTAMP
+ON CONFLICT (task_id, task_inserted_at) DO UPDATE
+SET
+    preempted_count = v1_task_preemption.preempted_count + 1,
+    last_preempted_at = EXCLUDED.last_preempted_at;
+
+-- name: CleanupV1TaskPreemption :execresult
+WITH locked_tps AS (
+    SELECT tp.task_id, tp.task_inserted_at
+    FROM v1_task_preemption tp
+    WHERE NOT EXISTS (
+        SELECT 1
+        FROM v1_task vt
+        WHERE tp.task_id = vt.id
+            AND tp.task_inserted_at = vt.inserted_at
+    )
+    ORDER BY tp.task_id ASC
+    LIMIT @batchSize::int
+    FOR UPDATE SKIP LOCKED
+)
+DELETE FROM v1_task_preemption
+WHERE (task_id, task_inserted_at) IN (
+    SELECT task_id, task_inserted_at
+    FROM locked_tps
+);
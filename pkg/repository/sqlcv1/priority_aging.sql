@@ -0,0 +1,116 @@
+-- name: UpsertWorkflowPriorityAging :exec
+INSERT INTO v1_workflow_priority_aging (
+    workflow_id,
+    tenant_id,
+    interval_seconds,
+    max_wait_seconds
+) VALUES (
+    @workflowId::uuid,
+    @tenantId::uuid,
+    sqlc.narg('intervalSeconds')::integer,
+    sqlc.narg('maxWaitSeconds')::integer
+)
+ON CONFLICT (workflow_id) DO UPDATE
+SET
+    interval_seconds = EXCLUDED.interval_seconds,
+    max_wait_seconds = EXCLUDED.max_wait_seconds,
+    updated_at = CURRENT_TIMESTAMP;
+
+-- name: DeleteWorkflowPriorityAging :exec
+DELETE FROM
+    v1_workflow_priority_aging
+WHERE
+    workflow_id = @workflowId::uuid;
+
+-- name: ListWorkflowPriorityAging :many
+SELECT
+    pa.*
+FROM
+    v1_workflow_priority_aging pa
+JOIN
+    "Workflow" w ON w."id" = pa.workflow_id
+WHERE
+    pa.tenant_id = @tenantId::uuid
+    AND w."deletedAt" IS NULL;
+
+-- name: GetTenantPriorityAging :one
+SELECT
+    *
+FROM
+    v1_tenant_priority_aging
+WHERE
+    tenant_id = @tenantId::uuid;
+
+-- name: UpsertTenantPriorityAging :one
+INSERT INTO v1_tenant_priority_aging (
+    tenant_id,
+    interval_seconds,
+    max_wait_seconds
+) VALUES (
+    @tenantId::uuid,
+    sqlc.narg('intervalSeconds')::integer,
+    sqlc.narg('maxWaitSeconds')::integer
+)
+ON CONFLICT (tenant_id) DO UPDATE
+SET
+    interval_seconds = EXCLUDED.interval_seconds,
+    max_wait_seconds = EXCLUDED.max_wait_seconds,
+    updated_at = CURRENT_TIMESTAMP
+RETURNING *;
+
+-- name: DeleteTenantPriorityAging :exec
+DELETE FROM
+    v1_tenant_priority_aging
+WHERE
+    tenant_id = @tenantId::uuid;
+
+-- name: ListAgedQueueItemsForQueue :many
+-- Lists the oldest queue items of each priority below the highest, so that items which have aged
+-- past the priority of newer items are considered even when the queue is saturated with them.
+-- Each branch walks v1_queue_item_list_idx for a single priority.
+SELECT
+    qi.*
+FROM
+    v1_queue_item qi
+WHERE
+    qi.id IN (
+        (
+            SELECT
+                id
+            FROM
+                v1_queue_item
+            WHERE
+                tenant_id = @tenantId::uuid
+                AND queue = @queue::text
+                AND (
+                    sqlc.narg('gtId')::bigint IS NULL OR
+                    id >= sqlc.narg('gtId')::bigint
+                )
+                AND priority = 1
+            ORDER BY
+                id ASC
+            LIMIT
+                @limitPerPriority::integer
+        )
+        UNION ALL
+        (
+            SELECT
+                id
+            FROM
+                v1_queue_item
+            WHERE
+                tenant_id = @tenantId::uuid
+                AND queue = @queue::text
+                AND (
+                    sqlc.narg('gtId')::bigint IS NULL OR
+                    id >= sqlc.narg('gtId')::bigint
+                )
+                AND priority = 2
+            ORDER BY
+                id ASC
+            LIMIT
+                @limitPerPriority::integer
+        )
+    )
+ORDER BY
+    qi.id ASC;
@@ -44,7 +44,10 @@ WHERE
     AND w."dispatcherId" IS NOT NULL
     AND w."lastHeartbeatAt" > NOW() - INTERVAL '5 seconds'
     AND w."isActive" = true
-    AND w."isPaused" = false;
+    AND w."isPaused" = false
+    AND NOT EXISTS (
+        SELECT 1 FROM v1_worker_cordon wc WHERE wc.tenant_id = w."tenantId" AND wc.worker_id = w."id"
+    );
 
 -- name: ListWorkerActionSets :many
 WITH worker_actions AS MATERIALIZED (
@@ -95,7 +98,10 @@ WHERE
     AND w."dispatcherId" IS NOT NULL
     AND w."lastHeartbeatAt" > NOW() - INTERVAL '5 seconds'
     AND w."isActive" = true
-    AND w."isPaused" = false;
+    AND w."isPaused" = false
+    AND NOT EXISTS (
+        SELECT 1 FROM v1_worker_cordon wc WHERE wc.tenant_id = w."tenantId" AND wc.worker_id = w."id"
+    );
 
 
 -- name: ListQueues :many
@@ -378,12 +384,30 @@ SELECT
     "required",
     "weight",
     "comparator",
-    "stepId"
+    "stepId",
+    "strValues"
 FROM
     "StepDesiredWorkerLabel"
 WHERE
     "stepId" = ANY(@stepIds::uuid[]);
 
+-- name: ListWorkflowRunWorkers :many
+-- Lists the workers which are running tasks of the given workflow runs. The running tasks of a tenant
+-- are bounded by its worker slots, so they're scanned through the runtime index rather than looked up
+-- by workflow run.
+SELECT DISTINCT
+    t.workflow_run_id,
+    tr.worker_id::uuid AS worker_id
+FROM
+    v1_task_runtime tr
+JOIN
+    v1_task t ON t.id = tr.task_id AND t.inserted_at = tr.task_inserted_at AND t.retry_count = tr.retry_count
+WHERE
+    tr.tenant_id = @tenantId::uuid
+    AND tr.worker_id IS NOT NULL
+    AND tr.evicted_at IS NULL
+    AND t.workflow_run_id = ANY(@workflowRunIds::uuid[]);
+
 -- name: ListStepsWithBatchConfig :many
 SELECT
     s."id" AS step_id
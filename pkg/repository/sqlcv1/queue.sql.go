@@ -279,7 +279,8 @@ SELECT
     "required",
     "weight",
     "comparator",
-    "stepId"
+    "stepId",
+    "strValues"
 FROM
     "StepDesiredWorkerLabel"
 WHERE
@@ -294,6 +295,7 @@ type GetDesiredLabelsRow struct {
 	Weight     int32                 `json:"weight"`
 	Comparator WorkerLabelComparator `json:"comparator"`
 	StepId     uuid.UUID             `json:"stepId"`
+	StrValues  []string              `json:"strValues"`
 }
 
 func (q *Queries) GetDesiredLabels(ctx context.Context, db DBTX, stepids []uuid.UUID) ([]*GetDesiredLabelsRow, error) {
@@ -313,6 +315,7 @@ func (q *Queries) GetDesiredLabels(ctx context.Context, db DBTX, stepids []uuid.
 			&i.Weight,
 			&i.Comparator,
 			&i.StepId,
+			&i.StrValues,
 		); err != nil {
 			return nil, err
 		}
@@ -666,6 +669,9 @@ WHERE
     AND w."lastHeartbeatAt" > NOW() - INTERVAL '5 seconds'
     AND w."isActive" = true
     AND w."isPaused" = false
+    AND NOT EXISTS (
+        SELECT 1 FROM v1_worker_cordon wc WHERE wc.tenant_id = w."tenantId" AND wc.worker_id = w."id"
+    )
 `
 
 type ListActionsForWorkersLegacyFallbackParams struct {
@@ -1068,6 +1074,9 @@ WHERE
     AND w."lastHeartbeatAt" > NOW() - INTERVAL '5 seconds'
     AND w."isActive" = true
     AND w."isPaused" = false
+    AND NOT EXISTS (
+        SELECT 1 FROM v1_worker_cordon wc WHERE wc.tenant_id = w."tenantId" AND wc.worker_id = w."id"
+    )
 `
 
 type ListLiveWorkerActionHashesParams struct {
@@ -1362,6 +1371,54 @@ func (q *Queries) ListWorkerActionSets(ctx context.Context, db DBTX, arg ListWor
 	return items, nil
 }
 
+const listWorkflowRunWorkers = `-- name: ListWorkflowRunWorkers :many
+SELECT DISTINCT
+    t.workflow_run_id,
+    tr.worker_id::uuid AS worker_id
+FROM
+    v1_task_runtime tr
+JOIN
+    v1_task t ON t.id = tr.task_id AND t.inserted_at = tr.task_inserted_at AND t.retry_count = tr.retry_count
+WHERE
+    tr.tenant_id = $1::uuid
+    AND tr.worker_id IS NOT NULL
+    AND tr.evicted_at IS NULL
+    AND t.workflow_run_id = ANY($2::uuid[])
+`
+
+type ListWorkflowRunWorkersParams struct {
+	Tenantid       uuid.UUID   `json:"tenantid"`
+	Workflowrunids []uuid.UUID `json:"workflowrunids"`
+}
+
+type ListWorkflowRunWorkersRow struct {
+	WorkflowRunID uuid.UUID `json:"workflow_run_id"`
+	WorkerID      uuid.UUID `json:"worker_id"`
+}
+
+// Lists the workers which are running tasks of the given workflow runs. The running tasks of a tenant
+// are bounded by its worker slots, so they're scanned through the runtime index rather than looked up
+// by workflow run.
+func (q *Queries) ListWorkflowRunWorkers(ctx context.Context, db DBTX, arg ListWorkflowRunWorkersParams) ([]*ListWorkflowRunWorkersRow, error) {
+	rows, err := db.Query(ctx, listWorkflowRunWorkers, arg.Tenantid, arg.Workflowrunids)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*ListWorkflowRunWorkersRow
+	for rows.Next() {
+		var i ListWorkflowRunWorkersRow
+		if err := rows.Scan(&i.WorkflowRunID, &i.WorkerID); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const moveBatchedQueueItems = `-- name: MoveBatchedQueueItems :many
 WITH moved_items AS (
     DELETE FROM v1_batched_queue_item
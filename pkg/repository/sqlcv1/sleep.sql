@@ -11,7 +11,7 @@ INSERT INTO
     v1_durable_sleep (tenant_id, sleep_until, sleep_duration)
 SELECT
     @tenant_id::uuid,
-    CURRENT_TIMESTAMP + convert_duration_to_interval(sleep_duration),
+    @now::timestamptz + convert_duration_to_interval(sleep_duration),
     sleep_duration
 FROM
     input
@@ -25,7 +25,7 @@ WITH to_delete AS (
         v1_durable_sleep
     WHERE
         tenant_id = @tenant_id::uuid
-        AND sleep_until <= CURRENT_TIMESTAMP
+        AND sleep_until <= @now::timestamptz
     ORDER BY
         id ASC
     LIMIT
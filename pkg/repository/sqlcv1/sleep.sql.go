@@ -25,7 +25,7 @@ INSERT INTO
     v1_durable_sleep (tenant_id, sleep_until, sleep_duration)
 SELECT
     $1::uuid,
-    CURRENT_TIMESTAMP + convert_duration_to_interval(sleep_duration),
+    $3::timestamptz + convert_duration_to_interval(sleep_duration),
     sleep_duration
 FROM
     input
@@ -33,12 +33,13 @@ RETURNING id, tenant_id, sleep_until, sleep_duration
 `
 
 type CreateDurableSleepParams struct {
-	TenantID       uuid.UUID `json:"tenant_id"`
-	SleepDurations []string  `json:"sleep_durations"`
+	TenantID       uuid.UUID          `json:"tenant_id"`
+	SleepDurations []string           `json:"sleep_durations"`
+	Now            pgtype.Timestamptz `json:"now"`
 }
 
 func (q *Queries) CreateDurableSleep(ctx context.Context, db DBTX, arg CreateDurableSleepParams) ([]*V1DurableSleep, error) {
-	rows, err := db.Query(ctx, createDurableSleep, arg.TenantID, arg.SleepDurations)
+	rows, err := db.Query(ctx, createDurableSleep, arg.TenantID, arg.SleepDurations, arg.Now)
 	if err != nil {
 		return nil, err
 	}
@@ -70,11 +71,11 @@ WITH to_delete AS (
         v1_durable_sleep
     WHERE
         tenant_id = $1::uuid
-        AND sleep_until <= CURRENT_TIMESTAMP
+        AND sleep_until <= $2::timestamptz
     ORDER BY
         id ASC
     LIMIT
-        COALESCE($2::integer, 1000)
+        COALESCE($3::integer, 1000)
     FOR UPDATE
 )
 DELETE FROM
@@ -85,12 +86,13 @@ RETURNING id, tenant_id, sleep_until, sleep_duration
 `
 
 type PopDurableSleepParams struct {
-	TenantID uuid.UUID   `json:"tenant_id"`
-	Limit    pgtype.Int4 `json:"limit"`
+	TenantID uuid.UUID          `json:"tenant_id"`
+	Now      pgtype.Timestamptz `json:"now"`
+	Limit    pgtype.Int4        `json:"limit"`
 }
 
 func (q *Queries) PopDurableSleep(ctx context.Context, db DBTX, arg PopDurableSleepParams) ([]*V1DurableSleep, error) {
-	rows, err := db.Query(ctx, popDurableSleep, arg.TenantID, arg.Limit)
+	rows, err := db.Query(ctx, popDurableSleep, arg.TenantID, arg.Now, arg.Limit)
 	if err != nil {
 		return nil, err
 	}
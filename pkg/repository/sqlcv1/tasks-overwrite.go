@@ -810,6 +810,20 @@ WITH input AS (
         v1_task_runtime_slot
     WHERE
         (task_id, task_inserted_at, retry_count) IN (SELECT task_id, task_inserted_at, retry_count FROM input)
+    RETURNING
+        task_id, task_inserted_at, retry_count, slot_type, units, created_at
+), released_slots AS (
+    SELECT
+        task_id,
+        task_inserted_at,
+        retry_count,
+        array_agg(slot_type ORDER BY slot_type)::text[] AS slot_types,
+        array_agg(units ORDER BY slot_type)::integer[] AS slot_units,
+        MIN(created_at) AS assigned_at
+    FROM
+        deleted_slots
+    GROUP BY
+        task_id, task_inserted_at, retry_count
 ), deleted_runtimes AS (
     DELETE FROM
         v1_task_runtime
@@ -830,13 +844,22 @@ SELECT
     t.retry_count = i.retry_count AS is_current_retry,
     t.concurrency_strategy_ids,
 	t.idempotency_key,
-    t.is_dag_orchestrator
+    t.is_dag_orchestrator,
+    t.workflow_id,
+    t.action_id,
+    t.additional_metadata,
+    s.slot_types,
+    s.slot_units,
+    s.assigned_at,
+    NOW()::timestamptz AS released_at
 FROM
     v1_task t
 JOIN
     input i ON i.task_id = t.id AND i.task_inserted_at = t.inserted_at
 LEFT JOIN
     runtimes_to_delete r ON r.task_id = t.id AND r.retry_count = i.retry_count
+LEFT JOIN
+    released_slots s ON s.task_id = t.id AND s.task_inserted_at = t.inserted_at AND s.retry_count = i.retry_count
 `
 
 type ReleaseTasksParams struct {
@@ -860,6 +883,13 @@ type ReleaseTasksRow struct {
 	ConcurrencyStrategyIds []int64            `json:"concurrency_strategy_ids"`
 	IdempotencyKey         pgtype.Text        `json:"idempotency_key"`
 	IsDagOrchestrator      bool               `json:"is_dag_orchestrator"`
+	WorkflowID             uuid.UUID          `json:"workflow_id"`
+	ActionID               string             `json:"action_id"`
+	AdditionalMetadata     []byte             `json:"additional_metadata"`
+	SlotTypes              []string           `json:"slot_types"`
+	SlotUnits              []int32            `json:"slot_units"`
+	AssignedAt             pgtype.Timestamptz `json:"assigned_at"`
+	ReleasedAt             pgtype.Timestamptz `json:"released_at"`
 }
 
 func (q *Queries) ReleaseTasks(ctx context.Context, db DBTX, arg ReleaseTasksParams) ([]*ReleaseTasksRow, error) {
@@ -893,6 +923,13 @@ func (q *Queries) ReleaseTasks(ctx context.Context, db DBTX, arg ReleaseTasksPar
 				&i.ConcurrencyStrategyIds,
 				&i.IdempotencyKey,
 				&i.IsDagOrchestrator,
+				&i.WorkflowID,
+				&i.ActionID,
+				&i.AdditionalMetadata,
+				&i.SlotTypes,
+				&i.SlotUnits,
+				&i.AssignedAt,
+				&i.ReleasedAt,
 			); err != nil {
 				errCh <- err
 				close(rowsCh)
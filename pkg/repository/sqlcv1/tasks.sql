@@ -293,13 +293,26 @@ WITH input AS (
                 unnest(@taskIds::bigint[]) AS task_id,
                 unnest(@taskInsertedAts::timestamptz[]) AS task_inserted_at,
                 unnest(@taskRetryCounts::integer[]) AS task_retry_count,
-                unnest(@isNonRetryables::boolean[]) AS is_non_retryable
+                unnest(@isNonRetryables::boolean[]) AS is_non_retryable,
+                -- retry policy overrides, negative values fall back to the step's configuration
+                unnest(@retries::integer[]) AS retries,
+                unnest(@backoffFactors::double precision[]) AS backoff_factor,
+                unnest(@backoffMaxSeconds::integer[]) AS backoff_max_seconds,
+                -- the index of the matched retry policy, or -1 if no policy matched
+                unnest(@policyIndexes::integer[]) AS policy_index
         ) AS subquery
 ), locked_tasks AS (
     SELECT
         t.id,
         t.inserted_at,
-        t.step_id
+        t.step_id,
+        t.app_retry_count,
+        i.task_retry_count,
+        i.is_non_retryable,
+        i.retries,
+        i.backoff_factor,
+        i.backoff_max_seconds,
+        i.policy_index
     FROM
         v1_task t
     JOIN
@@ -316,30 +329,88 @@ WITH input AS (
     ORDER BY
         id
     FOR UPDATE
+), policy_retry_counts AS (
+    SELECT
+        pc.task_id,
+        pc.task_inserted_at,
+        pc.policy_index,
+        pc.retry_count
+    FROM
+        v1_task_retry_policy_count pc
+    JOIN
+        locked_tasks t ON t.id = pc.task_id AND t.inserted_at = pc.task_inserted_at
 ), tasks_to_steps AS (
     SELECT
         t.id,
-        t.step_id,
-        s."retries"
+        t.inserted_at,
+        t.task_retry_count,
+        t.is_non_retryable,
+        t.policy_index,
+        CASE WHEN t.retries < 0 THEN s."retries" ELSE t.retries END AS "retries",
+        CASE WHEN t.backoff_factor < 0 THEN s."retryBackoffFactor" ELSE t.backoff_factor END AS retry_backoff_factor,
+        CASE WHEN t.backoff_max_seconds < 0 THEN s."retryMaxBackoff" ELSE t.backoff_max_seconds END AS retry_max_backoff,
+        -- a matched policy only counts the retries of the failures which matched it, and the step's
+        -- retries only count the failures which matched no policy
+        CASE WHEN t.policy_index >= 0 THEN
+            COALESCE((
+                SELECT pc.retry_count
+                FROM policy_retry_counts pc
+                WHERE pc.task_id = t.id AND pc.task_inserted_at = t.inserted_at AND pc.policy_index = t.policy_index
+            ), 0)
+        ELSE
+            t.app_retry_count - COALESCE((
+                SELECT SUM(pc.retry_count)
+                FROM policy_retry_counts pc
+                WHERE pc.task_id = t.id AND pc.task_inserted_at = t.inserted_at
+            ), 0)::integer
+        END AS attempts
     FROM
         locked_tasks t
     JOIN
         "Step" s ON s."id" = t.step_id
+), retried_tasks AS (
+    SELECT
+        *
+    FROM
+        tasks_to_steps
+    WHERE
+        is_non_retryable = FALSE
+        AND "retries" > attempts
+), updated_policy_retry_counts AS (
+    INSERT INTO v1_task_retry_policy_count (
+        task_id,
+        task_inserted_at,
+        tenant_id,
+        policy_index,
+        retry_count
+    )
+    SELECT DISTINCT ON (id, inserted_at)
+        id,
+        inserted_at,
+        @tenantId::uuid,
+        policy_index,
+        1
+    FROM
+        retried_tasks
+    WHERE
+        policy_index >= 0
+    ON CONFLICT (task_id, task_inserted_at, policy_index) DO UPDATE
+    SET
+        retry_count = v1_task_retry_policy_count.retry_count + 1
 )
 UPDATE
     v1_task
 SET
-    retry_count = retry_count + 1,
-    app_retry_count = app_retry_count + 1
+    retry_count = v1_task.retry_count + 1,
+    app_retry_count = v1_task.app_retry_count + 1,
+    retry_backoff_factor = retried_tasks.retry_backoff_factor,
+    retry_max_backoff = retried_tasks.retry_max_backoff
 FROM
-    tasks_to_steps
+    retried_tasks
 WHERE
-    (v1_task.id, v1_task.inserted_at, v1_task.retry_count) IN (
-        SELECT task_id, task_inserted_at, task_retry_count
-        FROM input
-        WHERE is_non_retryable = FALSE
-    )
-    AND tasks_to_steps."retries" > v1_task.app_retry_count
+    v1_task.id = retried_tasks.id
+    AND v1_task.inserted_at = retried_tasks.inserted_at
+    AND v1_task.retry_count = retried_tasks.task_retry_count
 RETURNING
     v1_task.id,
     v1_task.inserted_at,
@@ -398,6 +469,25 @@ RETURNING
     v1_task.inserted_at,
     v1_task.retry_count;
 
+-- name: ListTaskRetryPolicies :many
+-- Lists the retry policies of the steps of the given tasks, in evaluation order
+SELECT
+    t.id AS task_id,
+    rp.*
+FROM
+    v1_task t
+JOIN
+    v1_step_retry_policy rp ON rp.step_id = t.step_id AND rp.tenant_id = t.tenant_id
+WHERE
+    t.tenant_id = @tenantId::uuid
+    AND (t.id, t.inserted_at) IN (
+        SELECT
+            unnest(@taskIds::bigint[]),
+            unnest(@taskInsertedAts::timestamptz[])
+    )
+ORDER BY
+    t.id, rp.policy_index;
+
 -- name: ListTasksToTimeout :many
 WITH expired_runtimes AS (
     SELECT
@@ -411,7 +501,7 @@ WITH expired_runtimes AS (
         v1_task_runtime
     WHERE
         tenant_id = @tenantId::uuid
-        AND timeout_at <= NOW()
+        AND timeout_at <= @now::timestamptz
         -- evicted tasks are not eligible for timeout
         AND evicted_at IS NULL
     ORDER BY
@@ -1048,7 +1138,10 @@ WITH task AS (
         t.id,
         t.inserted_at,
         t.retry_count,
-        t.tenant_id
+        t.tenant_id,
+        t.workflow_id,
+        t.action_id,
+        t.additional_metadata
     FROM
         v1_lookup_table lt
     JOIN
@@ -1073,7 +1166,15 @@ WITH task AS (
     DELETE FROM v1_task_runtime_slot
     WHERE
         (task_id, task_inserted_at, retry_count) IN (SELECT task_id, task_inserted_at, retry_count FROM locked_runtime)
-    RETURNING task_id
+    RETURNING worker_id, slot_type, units, created_at
+), released_slots AS (
+    SELECT
+        (array_agg(worker_id))[1]::uuid AS worker_id,
+        array_agg(slot_type ORDER BY slot_type)::text[] AS slot_types,
+        array_agg(units ORDER BY slot_type)::integer[] AS slot_units,
+        MIN(created_at) AS assigned_at
+    FROM
+        deleted_slots
 )
 UPDATE
     v1_task_runtime
@@ -1081,10 +1182,20 @@ SET
     worker_id = NULL
 FROM
     task
+CROSS JOIN
+    released_slots s
 WHERE
     (v1_task_runtime.task_id, v1_task_runtime.task_inserted_at, v1_task_runtime.retry_count) IN (SELECT id, inserted_at, retry_count FROM task)
 RETURNING
-    v1_task_runtime.*;
+    v1_task_runtime.*,
+    task.workflow_id,
+    task.action_id,
+    task.additional_metadata,
+    s.worker_id AS slot_worker_id,
+    s.slot_types,
+    s.slot_units,
+    s.assigned_at,
+    NOW()::timestamptz AS released_at;
 
 -- name: EvictTask :one
 -- Marks a task as evicted in v1_task_runtime and releases worker slots.
@@ -1112,6 +1223,15 @@ WITH locked_runtime AS (
         AND task_id = @taskId::bigint
         AND task_inserted_at = @taskInsertedAt::timestamptz
         AND retry_count = @retryCount::int
+    RETURNING worker_id, slot_type, units, created_at
+), released_slots AS (
+    SELECT
+        (array_agg(worker_id))[1]::uuid AS worker_id,
+        array_agg(slot_type ORDER BY slot_type)::text[] AS slot_types,
+        array_agg(units ORDER BY slot_type)::integer[] AS slot_units,
+        MIN(created_at) AS assigned_at
+    FROM
+        deleted_slots
 ), updated_runtime AS (
     UPDATE v1_task_runtime
     SET
@@ -1122,7 +1242,19 @@ WITH locked_runtime AS (
     RETURNING 1
 )
 SELECT
-    COALESCE((SELECT 1 FROM updated_runtime LIMIT 1), 0)::int AS "evicted";
+    COALESCE((SELECT 1 FROM updated_runtime LIMIT 1), 0)::int AS "evicted",
+    t.workflow_id,
+    t.action_id,
+    t.additional_metadata,
+    s.worker_id AS slot_worker_id,
+    s.slot_types,
+    s.slot_units,
+    s.assigned_at,
+    NOW()::timestamptz AS released_at
+FROM
+    released_slots s
+LEFT JOIN
+    v1_task t ON t.id = @taskId::bigint AND t.inserted_at = @taskInsertedAt::timestamptz;
 
 
 -- name: CleanupWorkflowConcurrencySlotsAfterInsert :exec
@@ -1246,6 +1378,26 @@ WHERE (task_id, task_inserted_at, retry_count) IN (
     FROM locked_trs
 );
 
+-- name: CleanupV1TaskRetryPolicyCount :execresult
+WITH locked_pcs AS (
+    SELECT pc.task_id, pc.task_inserted_at, pc.policy_index
+    FROM v1_task_retry_policy_count pc
+    WHERE NOT EXISTS (
+        SELECT 1
+        FROM v1_task vt
+        WHERE pc.task_id = vt.id
+            AND pc.task_inserted_at = vt.inserted_at
+    )
+    ORDER BY pc.task_id ASC
+    LIMIT @batchSize::int
+    FOR UPDATE SKIP LOCKED
+)
+DELETE FROM v1_task_retry_policy_count
+WHERE (task_id, task_inserted_at, policy_index) IN (
+    SELECT task_id, task_inserted_at, policy_index
+    FROM locked_pcs
+);
+
 -- name: CleanupV1ConcurrencySlot :execresult
 WITH locked_cs AS (
     SELECT cs.task_id, cs.task_inserted_at, cs.task_retry_count
@@ -118,6 +118,31 @@ func (q *Queries) CleanupV1ConcurrencySlot(ctx context.Context, db DBTX, batchsi
 	return db.Exec(ctx, cleanupV1ConcurrencySlot, batchsize)
 }
 
+const cleanupV1TaskRetryPolicyCount = `-- name: CleanupV1TaskRetryPolicyCount :execresult
+WITH locked_pcs AS (
+    SELECT pc.task_id, pc.task_inserted_at, pc.policy_index
+    FROM v1_task_retry_policy_count pc
+    WHERE NOT EXISTS (
+        SELECT 1
+        FROM v1_task vt
+        WHERE pc.task_id = vt.id
+            AND pc.task_inserted_at = vt.inserted_at
+    )
+    ORDER BY pc.task_id ASC
+    LIMIT $1::int
+    FOR UPDATE SKIP LOCKED
+)
+DELETE FROM v1_task_retry_policy_count
+WHERE (task_id, task_inserted_at, policy_index) IN (
+    SELECT task_id, task_inserted_at, policy_index
+    FROM locked_pcs
+)
+`
+
+func (q *Queries) CleanupV1TaskRetryPolicyCount(ctx context.Context, db DBTX, batchsize int32) (pgconn.CommandTag, error) {
+	return db.Exec(ctx, cleanupV1TaskRetryPolicyCount, batchsize)
+}
+
 const cleanupV1TaskRuntime = `-- name: CleanupV1TaskRuntime :execresult
 WITH locked_trs AS (
     SELECT vtr.task_id, vtr.task_inserted_at, vtr.retry_count
@@ -378,6 +403,15 @@ WITH locked_runtime AS (
         AND task_id = $2::bigint
         AND task_inserted_at = $3::timestamptz
         AND retry_count = $4::int
+    RETURNING worker_id, slot_type, units, created_at
+), released_slots AS (
+    SELECT
+        (array_agg(worker_id))[1]::uuid AS worker_id,
+        array_agg(slot_type ORDER BY slot_type)::text[] AS slot_types,
+        array_agg(units ORDER BY slot_type)::integer[] AS slot_units,
+        MIN(created_at) AS assigned_at
+    FROM
+        deleted_slots
 ), updated_runtime AS (
     UPDATE v1_task_runtime
     SET
@@ -388,7 +422,19 @@ WITH locked_runtime AS (
     RETURNING 1
 )
 SELECT
-    COALESCE((SELECT 1 FROM updated_runtime LIMIT 1), 0)::int AS "evicted"
+    COALESCE((SELECT 1 FROM updated_runtime LIMIT 1), 0)::int AS "evicted",
+    t.workflow_id,
+    t.action_id,
+    t.additional_metadata,
+    s.worker_id AS slot_worker_id,
+    s.slot_types,
+    s.slot_units,
+    s.assigned_at,
+    NOW()::timestamptz AS released_at
+FROM
+    released_slots s
+LEFT JOIN
+    v1_task t ON t.id = $2::bigint AND t.inserted_at = $3::timestamptz
 `
 
 type EvictTaskParams struct {
@@ -398,44 +444,79 @@ type EvictTaskParams struct {
 	Retrycount     int32              `json:"retrycount"`
 }
 
+type EvictTaskRow struct {
+	Evicted            int32              `json:"evicted"`
+	WorkflowID         *uuid.UUID         `json:"workflow_id"`
+	ActionID           pgtype.Text        `json:"action_id"`
+	AdditionalMetadata []byte             `json:"additional_metadata"`
+	SlotWorkerID       *uuid.UUID         `json:"slot_worker_id"`
+	SlotTypes          []string           `json:"slot_types"`
+	SlotUnits          []int32            `json:"slot_units"`
+	AssignedAt         pgtype.Timestamptz `json:"assigned_at"`
+	ReleasedAt         pgtype.Timestamptz `json:"released_at"`
+}
+
 // Marks a task as evicted in v1_task_runtime and releases worker slots.
 // Skips rows whose execution timeout has already passed so the timeout
 // mechanism handles them instead of producing a spurious EVICTED status.
-func (q *Queries) EvictTask(ctx context.Context, db DBTX, arg EvictTaskParams) (int32, error) {
+func (q *Queries) EvictTask(ctx context.Context, db DBTX, arg EvictTaskParams) (*EvictTaskRow, error) {
 	row := db.QueryRow(ctx, evictTask,
 		arg.Tenantid,
 		arg.Taskid,
 		arg.Taskinsertedat,
 		arg.Retrycount,
 	)
-	var evicted int32
-	err := row.Scan(&evicted)
-	return evicted, err
+	var i EvictTaskRow
+	err := row.Scan(
+		&i.Evicted,
+		&i.WorkflowID,
+		&i.ActionID,
+		&i.AdditionalMetadata,
+		&i.SlotWorkerID,
+		&i.SlotTypes,
+		&i.SlotUnits,
+		&i.AssignedAt,
+		&i.ReleasedAt,
+	)
+	return &i, err
 }
 
 const failTaskAppFailure = `-- name: FailTaskAppFailure :many
 WITH input AS (
     SELECT
-        task_id, task_inserted_at, task_retry_count, is_non_retryable
+        task_id, task_inserted_at, task_retry_count, is_non_retryable, retries, backoff_factor, backoff_max_seconds, policy_index
     FROM
         (
             SELECT
                 unnest($1::bigint[]) AS task_id,
                 unnest($2::timestamptz[]) AS task_inserted_at,
                 unnest($3::integer[]) AS task_retry_count,
-                unnest($4::boolean[]) AS is_non_retryable
+                unnest($4::boolean[]) AS is_non_retryable,
+                -- retry policy overrides, negative values fall back to the step's configuration
+                unnest($5::integer[]) AS retries,
+                unnest($6::double precision[]) AS backoff_factor,
+                unnest($7::integer[]) AS backoff_max_seconds,
+                -- the index of the matched retry policy, or -1 if no policy matched
+                unnest($8::integer[]) AS policy_index
         ) AS subquery
 ), locked_tasks AS (
     SELECT
         t.id,
         t.inserted_at,
-        t.step_id
+        t.step_id,
+        t.app_retry_count,
+        i.task_retry_count,
+        i.is_non_retryable,
+        i.retries,
+        i.backoff_factor,
+        i.backoff_max_seconds,
+        i.policy_index
     FROM
         v1_task t
     JOIN
         input i ON i.task_id = t.id AND i.task_inserted_at = t.inserted_at AND i.task_retry_count = t.retry_count
     WHERE
-        t.tenant_id = $5::uuid
+        t.tenant_id = $9::uuid
         -- only fail tasks which still have a v1_task_runtime for the current retry count.
         -- a cancellation deletes the v1_task_runtime, so a late failure event should not trigger a retry.
         AND EXISTS (
@@ -446,30 +527,88 @@ WITH input AS (
     ORDER BY
         id
     FOR UPDATE
+), policy_retry_counts AS (
+    SELECT
+        pc.task_id,
+        pc.task_inserted_at,
+        pc.policy_index,
+        pc.retry_count
+    FROM
+        v1_task_retry_policy_count pc
+    JOIN
+        locked_tasks t ON t.id = pc.task_id AND t.inserted_at = pc.task_inserted_at
 ), tasks_to_steps AS (
     SELECT
         t.id,
-        t.step_id,
-        s."retries"
+        t.inserted_at,
+        t.task_retry_count,
+        t.is_non_retryable,
+        t.policy_index,
+        CASE WHEN t.retries < 0 THEN s."retries" ELSE t.retries END AS "retries",
+        CASE WHEN t.backoff_factor < 0 THEN s."retryBackoffFactor" ELSE t.backoff_factor END AS retry_backoff_factor,
+        CASE WHEN t.backoff_max_seconds < 0 THEN s."retryMaxBackoff" ELSE t.backoff_max_seconds END AS retry_max_backoff,
+        -- a matched policy only counts the retries of the failures which matched it, and the step's
+        -- retries only count the failures which matched no policy
+        CASE WHEN t.policy_index >= 0 THEN
+            COALESCE((
+                SELECT pc.retry_count
+                FROM policy_retry_counts pc
+                WHERE pc.task_id = t.id AND pc.task_inserted_at = t.inserted_at AND pc.policy_index = t.policy_index
+            ), 0)
+        ELSE
+            t.app_retry_count - COALESCE((
+                SELECT SUM(pc.retry_count)
+                FROM policy_retry_counts pc
+                WHERE pc.task_id = t.id AND pc.task_inserted_at = t.inserted_at
+            ), 0)::integer
+        END AS attempts
     FROM
         locked_tasks t
     JOIN
         "Step" s ON s."id" = t.step_id
+), retried_tasks AS (
+    SELECT
+        id, inserted_at, task_retry_count, is_non_retryable, policy_index, retries, retry_backoff_factor, retry_max_backoff, attempts
+    FROM
+        tasks_to_steps
+    WHERE
+        is_non_retryable = FALSE
+        AND "retries" > attempts
+), updated_policy_retry_counts AS (
+    INSERT INTO v1_task_retry_policy_count (
+        task_id,
+        task_inserted_at,
+        tenant_id,
+        policy_index,
+        retry_count
+    )
+    SELECT DISTINCT ON (id, inserted_at)
+        id,
+        inserted_at,
+        $9::uuid,
+        policy_index,
+        1
+    FROM
+        retried_tasks
+    WHERE
+        policy_index >= 0
+    ON CONFLICT (task_id, task_inserted_at, policy_index) DO UPDATE
+    SET
+        retry_count = v1_task_retry_policy_count.retry_count + 1
 )
 UPDATE
     v1_task
 SET
-    retry_count = retry_count + 1,
-    app_retry_count = app_retry_count + 1
+    retry_count = v1_task.retry_count + 1,
+    app_retry_count = v1_task.app_retry_count + 1,
+    retry_backoff_factor = retried_tasks.retry_backoff_factor,
+    retry_max_backoff = retried_tasks.retry_max_backoff
 FROM
-    tasks_to_steps
+    retried_tasks
 WHERE
-    (v1_task.id, v1_task.inserted_at, v1_task.retry_count) IN (
-        SELECT task_id, task_inserted_at, task_retry_count
-        FROM input
-        WHERE is_non_retryable = FALSE
-    )
-    AND tasks_to_steps."retries" > v1_task.app_retry_count
+    v1_task.id = retried_tasks.id
+    AND v1_task.inserted_at = retried_tasks.inserted_at
+    AND v1_task.retry_count = retried_tasks.task_retry_count
 RETURNING
     v1_task.id,
     v1_task.inserted_at,
@@ -480,11 +619,15 @@ RETURNING
 `
 
 type FailTaskAppFailureParams struct {
-	Taskids         []int64              `json:"taskids"`
-	Taskinsertedats []pgtype.Timestamptz `json:"taskinsertedats"`
-	Taskretrycounts []int32              `json:"taskretrycounts"`
-	Isnonretryables []bool               `json:"isnonretryables"`
-	Tenantid        uuid.UUID            `json:"tenantid"`
+	Taskids           []int64              `json:"taskids"`
+	Taskinsertedats   []pgtype.Timestamptz `json:"taskinsertedats"`
+	Taskretrycounts   []int32              `json:"taskretrycounts"`
+	Isnonretryables   []bool               `json:"isnonretryables"`
+	Retries           []int32              `json:"retries"`
+	Backofffactors    []float64            `json:"backofffactors"`
+	Backoffmaxseconds []int32              `json:"backoffmaxseconds"`
+	Policyindexes     []int32              `json:"policyindexes"`
+	Tenantid          uuid.UUID            `json:"tenantid"`
 }
 
 type FailTaskAppFailureRow struct {
@@ -503,6 +646,10 @@ func (q *Queries) FailTaskAppFailure(ctx context.Context, db DBTX, arg FailTaskA
 		arg.Taskinsertedats,
 		arg.Taskretrycounts,
 		arg.Isnonretryables,
+		arg.Retries,
+		arg.Backofffactors,
+		arg.Backoffmaxseconds,
+		arg.Policyindexes,
 		arg.Tenantid,
 	)
 	if err != nil {
@@ -1899,6 +2046,76 @@ func (q *Queries) ListTaskParentOutputs(ctx context.Context, db DBTX, arg ListTa
 	return items, nil
 }
 
+const listTaskRetryPolicies = `-- name: ListTaskRetryPolicies :many
+SELECT
+    t.id AS task_id,
+    rp.step_id, rp.tenant_id, rp.policy_index, rp.error_class, rp.expression, rp.retries, rp.backoff_factor, rp.backoff_max_seconds, rp.never_retry
+FROM
+    v1_task t
+JOIN
+    v1_step_retry_policy rp ON rp.step_id = t.step_id AND rp.tenant_id = t.tenant_id
+WHERE
+    t.tenant_id = $1::uuid
+    AND (t.id, t.inserted_at) IN (
+        SELECT
+            unnest($2::bigint[]),
+            unnest($3::timestamptz[])
+    )
+ORDER BY
+    t.id, rp.policy_index
+`
+
+type ListTaskRetryPoliciesParams struct {
+	Tenantid        uuid.UUID            `json:"tenantid"`
+	Taskids         []int64              `json:"taskids"`
+	Taskinsertedats []pgtype.Timestamptz `json:"taskinsertedats"`
+}
+
+type ListTaskRetryPoliciesRow struct {
+	TaskID            int64         `json:"task_id"`
+	StepID            uuid.UUID     `json:"step_id"`
+	TenantID          uuid.UUID     `json:"tenant_id"`
+	PolicyIndex       int32         `json:"policy_index"`
+	ErrorClass        pgtype.Text   `json:"error_class"`
+	Expression        pgtype.Text   `json:"expression"`
+	Retries           pgtype.Int4   `json:"retries"`
+	BackoffFactor     pgtype.Float8 `json:"backoff_factor"`
+	BackoffMaxSeconds pgtype.Int4   `json:"backoff_max_seconds"`
+	NeverRetry        bool          `json:"never_retry"`
+}
+
+// Lists the retry policies of the steps of the given tasks, in evaluation order
+func (q *Queries) ListTaskRetryPolicies(ctx context.Context, db DBTX, arg ListTaskRetryPoliciesParams) ([]*ListTaskRetryPoliciesRow, error) {
+	rows, err := db.Query(ctx, listTaskRetryPolicies, arg.Tenantid, arg.Taskids, arg.Taskinsertedats)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*ListTaskRetryPoliciesRow
+	for rows.Next() {
+		var i ListTaskRetryPoliciesRow
+		if err := rows.Scan(
+			&i.TaskID,
+			&i.StepID,
+			&i.TenantID,
+			&i.PolicyIndex,
+			&i.ErrorClass,
+			&i.Expression,
+			&i.Retries,
+			&i.BackoffFactor,
+			&i.BackoffMaxSeconds,
+			&i.NeverRetry,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listTaskRunningStatuses = `-- name: ListTaskRunningStatuses :many
 WITH inputs AS (
     SELECT
@@ -2443,13 +2660,13 @@ WITH expired_runtimes AS (
         v1_task_runtime
     WHERE
         tenant_id = $1::uuid
-        AND timeout_at <= NOW()
+        AND timeout_at <= $2::timestamptz
         -- evicted tasks are not eligible for timeout
         AND evicted_at IS NULL
     ORDER BY
         task_id, task_inserted_at, retry_count
     LIMIT
-        COALESCE($2::integer, 1000)
+        COALESCE($3::integer, 1000)
     FOR UPDATE SKIP LOCKED
 )
 SELECT
@@ -2473,8 +2690,9 @@ JOIN
 `
 
 type ListTasksToTimeoutParams struct {
-	Tenantid uuid.UUID   `json:"tenantid"`
-	Limit    pgtype.Int4 `json:"limit"`
+	Tenantid uuid.UUID          `json:"tenantid"`
+	Now      pgtype.Timestamptz `json:"now"`
+	Limit    pgtype.Int4        `json:"limit"`
 }
 
 type ListTasksToTimeoutRow struct {
@@ -2494,7 +2712,7 @@ type ListTasksToTimeoutRow struct {
 }
 
 func (q *Queries) ListTasksToTimeout(ctx context.Context, db DBTX, arg ListTasksToTimeoutParams) ([]*ListTasksToTimeoutRow, error) {
-	rows, err := db.Query(ctx, listTasksToTimeout, arg.Tenantid, arg.Limit)
+	rows, err := db.Query(ctx, listTasksToTimeout, arg.Tenantid, arg.Now, arg.Limit)
 	if err != nil {
 		return nil, err
 	}
@@ -2720,7 +2938,10 @@ WITH task AS (
         t.id,
         t.inserted_at,
         t.retry_count,
-        t.tenant_id
+        t.tenant_id,
+        t.workflow_id,
+        t.action_id,
+        t.additional_metadata
     FROM
         v1_lookup_table lt
     JOIN
@@ -2745,7 +2966,15 @@ WITH task AS (
     DELETE FROM v1_task_runtime_slot
     WHERE
         (task_id, task_inserted_at, retry_count) IN (SELECT task_id, task_inserted_at, retry_count FROM locked_runtime)
-    RETURNING task_id
+    RETURNING worker_id, slot_type, units, created_at
+), released_slots AS (
+    SELECT
+        (array_agg(worker_id))[1]::uuid AS worker_id,
+        array_agg(slot_type ORDER BY slot_type)::text[] AS slot_types,
+        array_agg(units ORDER BY slot_type)::integer[] AS slot_units,
+        MIN(created_at) AS assigned_at
+    FROM
+        deleted_slots
 )
 UPDATE
     v1_task_runtime
@@ -2753,10 +2982,20 @@ SET
     worker_id = NULL
 FROM
     task
+CROSS JOIN
+    released_slots s
 WHERE
     (v1_task_runtime.task_id, v1_task_runtime.task_inserted_at, v1_task_runtime.retry_count) IN (SELECT id, inserted_at, retry_count FROM task)
 RETURNING
-    v1_task_runtime.task_id, v1_task_runtime.task_inserted_at, v1_task_runtime.retry_count, v1_task_runtime.worker_id, v1_task_runtime.batch_id, v1_task_runtime.batch_size, v1_task_runtime.batch_index, v1_task_runtime.batch_key, v1_task_runtime.tenant_id, v1_task_runtime.timeout_at, v1_task_runtime.evicted_at
+    v1_task_runtime.task_id, v1_task_runtime.task_inserted_at, v1_task_runtime.retry_count, v1_task_runtime.worker_id, v1_task_runtime.batch_id, v1_task_runtime.batch_size, v1_task_runtime.batch_index, v1_task_runtime.batch_key, v1_task_runtime.tenant_id, v1_task_runtime.timeout_at, v1_task_runtime.evicted_at,
+    task.workflow_id,
+    task.action_id,
+    task.additional_metadata,
+    s.worker_id AS slot_worker_id,
+    s.slot_types,
+    s.slot_units,
+    s.assigned_at,
+    NOW()::timestamptz AS released_at
 `
 
 type ManualSlotReleaseParams struct {
@@ -2764,9 +3003,31 @@ type ManualSlotReleaseParams struct {
 	Tenantid   uuid.UUID `json:"tenantid"`
 }
 
-func (q *Queries) ManualSlotRelease(ctx context.Context, db DBTX, arg ManualSlotReleaseParams) (*V1TaskRuntime, error) {
+type ManualSlotReleaseRow struct {
+	TaskID             int64              `json:"task_id"`
+	TaskInsertedAt     pgtype.Timestamptz `json:"task_inserted_at"`
+	RetryCount         int32              `json:"retry_count"`
+	WorkerID           *uuid.UUID         `json:"worker_id"`
+	BatchID            *uuid.UUID         `json:"batch_id"`
+	BatchSize          pgtype.Int4        `json:"batch_size"`
+	BatchIndex         pgtype.Int4        `json:"batch_index"`
+	BatchKey           pgtype.Text        `json:"batch_key"`
+	TenantID           uuid.UUID          `json:"tenant_id"`
+	TimeoutAt          pgtype.Timestamp   `json:"timeout_at"`
+	EvictedAt          pgtype.Timestamptz `json:"evicted_at"`
+	WorkflowID         uuid.UUID          `json:"workflow_id"`
+	ActionID           string             `json:"action_id"`
+	AdditionalMetadata []byte             `json:"additional_metadata"`
+	SlotWorkerID       *uuid.UUID         `json:"slot_worker_id"`
+	SlotTypes          []string           `json:"slot_types"`
+	SlotUnits          []int32            `json:"slot_units"`
+	AssignedAt         pgtype.Timestamptz `json:"assigned_at"`
+	ReleasedAt         pgtype.Timestamptz `json:"released_at"`
+}
+
+func (q *Queries) ManualSlotRelease(ctx context.Context, db DBTX, arg ManualSlotReleaseParams) (*ManualSlotReleaseRow, error) {
 	row := db.QueryRow(ctx, manualSlotRelease, arg.Externalid, arg.Tenantid)
-	var i V1TaskRuntime
+	var i ManualSlotReleaseRow
 	err := row.Scan(
 		&i.TaskID,
 		&i.TaskInsertedAt,
@@ -2779,6 +3040,14 @@ func (q *Queries) ManualSlotRelease(ctx context.Context, db DBTX, arg ManualSlot
 		&i.TenantID,
 		&i.TimeoutAt,
 		&i.EvictedAt,
+		&i.WorkflowID,
+		&i.ActionID,
+		&i.AdditionalMetadata,
+		&i.SlotWorkerID,
+		&i.SlotTypes,
+		&i.SlotUnits,
+		&i.AssignedAt,
+		&i.ReleasedAt,
 	)
 	return &i, err
 }
@@ -198,7 +198,7 @@ WITH latest_workflow_versions AS (
     JOIN
         latest_workflow_versions AS latestVersions ON latestVersions."workflowId" = workflow."id"
     WHERE
-        "triggerAt" <= NOW() + INTERVAL '5 seconds'
+        "triggerAt" <= @now::timestamptz + INTERVAL '5 seconds'
         AND versions."deletedAt" IS NULL
         AND workflow."deletedAt" IS NULL
         AND tenant."deletedAt" IS NULL
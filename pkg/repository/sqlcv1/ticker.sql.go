@@ -449,7 +449,7 @@ WITH latest_workflow_versions AS (
     JOIN
         latest_workflow_versions AS latestVersions ON latestVersions."workflowId" = workflow."id"
     WHERE
-        "triggerAt" <= NOW() + INTERVAL '5 seconds'
+        "triggerAt" <= $1::timestamptz + INTERVAL '5 seconds'
         AND versions."deletedAt" IS NULL
         AND workflow."deletedAt" IS NULL
         AND tenant."deletedAt" IS NULL
@@ -458,7 +458,7 @@ WITH latest_workflow_versions AS (
             OR NOT EXISTS (
                 SELECT 1 FROM "Ticker" WHERE "id" = scheduledWorkflow."tickerId" AND "isActive" = true AND "lastHeartbeatAt" >= NOW() - INTERVAL '10 seconds'
             )
-            OR "tickerId" = $1::uuid
+            OR "tickerId" = $2::uuid
         )
         AND NOT EXISTS (
             SELECT 1
@@ -487,7 +487,7 @@ active_scheduled_workflows AS (
 UPDATE
     "WorkflowTriggerScheduledRef" as scheduledWorkflows
 SET
-    "tickerId" = $1::uuid
+    "tickerId" = $2::uuid
 FROM
     active_scheduled_workflows
 JOIN "WorkflowVersion" as versions ON versions."id" = active_scheduled_workflows."parentId"
@@ -497,6 +497,11 @@ WHERE
 RETURNING scheduledworkflows.id, scheduledworkflows."parentId", scheduledworkflows."triggerAt", scheduledworkflows."tickerId", scheduledworkflows.input, scheduledworkflows."childIndex", scheduledworkflows."childKey", scheduledworkflows."parentStepRunId", scheduledworkflows."parentWorkflowRunId", scheduledworkflows."additionalMetadata", scheduledworkflows."createdAt", scheduledworkflows."deletedAt", scheduledworkflows."updatedAt", scheduledworkflows.method, scheduledworkflows.priority, versions."id" AS "workflowVersionId", workflow."tenantId"
 `
 
+type PollScheduledWorkflowsParams struct {
+	Now      pgtype.Timestamptz `json:"now"`
+	Tickerid uuid.UUID          `json:"tickerid"`
+}
+
 type PollScheduledWorkflowsRow struct {
 	ID                  uuid.UUID                          `json:"id"`
 	ParentId            uuid.UUID                          `json:"parentId"`
@@ -519,8 +524,8 @@ type PollScheduledWorkflowsRow struct {
 
 // Finds workflows that are either past their execution time or will be in the next 5 seconds and assigns them
 // to a ticker, or finds workflows that were assigned to a ticker that is no longer active
-func (q *Queries) PollScheduledWorkflows(ctx context.Context, db DBTX, tickerid uuid.UUID) ([]*PollScheduledWorkflowsRow, error) {
-	rows, err := db.Query(ctx, pollScheduledWorkflows, tickerid)
+func (q *Queries) PollScheduledWorkflows(ctx context.Context, db DBTX, arg PollScheduledWorkflowsParams) ([]*PollScheduledWorkflowsRow, error) {
+	rows, err := db.Query(ctx, pollScheduledWorkflows, arg.Now, arg.Tickerid)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,157 @@
+-- name: ListWorkersMatchingSelector :many
+-- Lists the workers of a tenant which match a selector: the workers with one of the given ids, if
+-- any are given, which have every given label. Integer labels match their decimal representation.
+SELECT
+    w."id"
+FROM
+    "Worker" w
+WHERE
+    w."tenantId" = @tenantId::uuid
+    AND (
+        cardinality(@workerIds::uuid[]) = 0
+        OR w."id" = ANY(@workerIds::uuid[])
+    )
+    AND NOT EXISTS (
+        SELECT 1
+        FROM unnest(@labelKeys::text[], @labelValues::text[]) AS l(key, value)
+        WHERE NOT EXISTS (
+            SELECT 1
+            FROM "WorkerLabel" wl
+            WHERE
+                wl."workerId" = w."id"
+                AND wl."key" = l.key
+                AND COALESCE(wl."strValue", wl."intValue"::text) = l.value
+        )
+    )
+ORDER BY
+    w."id";
+
+-- name: CordonWorkers :many
+-- Cordons workers. Cordoning a worker which is already cordoned or draining keeps its drain.
+INSERT INTO v1_worker_cordon (
+    tenant_id,
+    worker_id,
+    reason
+)
+SELECT
+    @tenantId::uuid,
+    unnest(@workerIds::uuid[]),
+    sqlc.narg('reason')::text
+ON CONFLICT (tenant_id, worker_id) DO UPDATE
+SET
+    reason = COALESCE(EXCLUDED.reason, v1_worker_cordon.reason)
+RETURNING *;
+
+-- name: DrainWorkers :many
+-- Cordons workers if they aren't already, and starts draining them. Draining a worker which is
+-- already draining replaces its deadline and drain action.
+INSERT INTO v1_worker_cordon (
+    tenant_id,
+    worker_id,
+    reason,
+    drain_deadline,
+    drain_action
+)
+SELECT
+    @tenantId::uuid,
+    unnest(@workerIds::uuid[]),
+    sqlc.narg('reason')::text,
+    @drainDeadline::timestamptz,
+    @drainAction::v1_worker_drain_action
+ON CONFLICT (tenant_id, worker_id) DO UPDATE
+SET
+    reason = COALESCE(EXCLUDED.reason, v1_worker_cordon.reason),
+    drain_deadline = EXCLUDED.drain_deadline,
+    drain_action = EXCLUDED.drain_action,
+    drained_at = NULL
+RETURNING *;
+
+-- name: UncordonWorkers :many
+DELETE FROM
+    v1_worker_cordon
+WHERE
+    tenant_id = @tenantId::uuid
+    AND worker_id = ANY(@workerIds::uuid[])
+RETURNING worker_id;
+
+-- name: ListWorkerCordons :many
+-- Lists the cordoned workers of a tenant, optionally restricted to a set of workers, with the number
+-- of tasks each is still running.
+SELECT
+    sqlc.embed(wc),
+    w."name" AS worker_name,
+    (
+        SELECT COUNT(*)
+        FROM v1_task_runtime tr
+        WHERE
+            tr.tenant_id = wc.tenant_id
+            AND tr.worker_id = wc.worker_id
+            AND tr.evicted_at IS NULL
+    )::int AS running_tasks
+FROM
+    v1_worker_cordon wc
+JOIN
+    "Worker" w ON w."id" = wc.worker_id
+WHERE
+    wc.tenant_id = @tenantId::uuid
+    AND (
+        sqlc.narg('workerIds')::uuid[] IS NULL
+        OR wc.worker_id = ANY(sqlc.narg('workerIds')::uuid[])
+    )
+ORDER BY
+    wc.cordoned_at ASC,
+    wc.worker_id ASC;
+
+-- name: ListWorkersToDrain :many
+-- Lists the draining workers of a tenant which haven't been drained yet, with the number of tasks
+-- each is still running.
+SELECT
+    wc.worker_id,
+    wc.drain_deadline,
+    wc.drain_action,
+    (
+        SELECT COUNT(*)
+        FROM v1_task_runtime tr
+        WHERE
+            tr.tenant_id = wc.tenant_id
+            AND tr.worker_id = wc.worker_id
+            AND tr.evicted_at IS NULL
+    )::int AS running_tasks
+FROM
+    v1_worker_cordon wc
+WHERE
+    wc.tenant_id = @tenantId::uuid
+    AND wc.drain_deadline IS NOT NULL
+    AND wc.drained_at IS NULL;
+
+-- name: ListRunningTasksOnWorker :many
+-- Lists the tasks a worker is running, excluding evicted durable tasks.
+SELECT
+    t.id,
+    t.inserted_at,
+    t.retry_count,
+    t.external_id,
+    t.workflow_run_id,
+    COALESCE(t.is_durable, false)::boolean AS is_durable
+FROM
+    v1_task_runtime tr
+JOIN
+    v1_task t ON t.id = tr.task_id AND t.inserted_at = tr.task_inserted_at AND t.retry_count = tr.retry_count
+WHERE
+    tr.tenant_id = @tenantId::uuid
+    AND tr.worker_id = @workerId::uuid
+    AND tr.evicted_at IS NULL
+ORDER BY
+    t.id ASC
+LIMIT
+    @limit::int;
+
+-- name: MarkWorkersDrained :exec
+UPDATE
+    v1_worker_cordon
+SET
+    drained_at = CURRENT_TIMESTAMP
+WHERE
+    tenant_id = @tenantId::uuid
+    AND worker_id = ANY(@workerIds::uuid[])
+    AND drained_at IS NULL;
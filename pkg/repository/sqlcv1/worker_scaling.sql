@@ -0,0 +1,76 @@
+-- name: UpsertWorkerScalingTarget :exec
+INSERT INTO v1_worker_scaling_target (
+    tenant_id,
+    worker_id,
+    slots_per_replica,
+    max_queue_age_seconds
+)
+VALUES (
+    @tenantId::uuid,
+    @workerId::uuid,
+    sqlc.narg('slotsPerReplica')::int,
+    sqlc.narg('maxQueueAgeSeconds')::int
+)
+ON CONFLICT (tenant_id, worker_id) DO UPDATE
+SET
+    slots_per_replica = EXCLUDED.slots_per_replica,
+    max_queue_age_seconds = EXCLUDED.max_queue_age_seconds;
+
+-- name: ListScalerWorkers :many
+-- ListScalerWorkers lists the active workers of a tenant with their actions, their capacity for a
+-- slot type and the scaling targets they registered with. Operators are excluded.
+SELECT
+    w."id",
+    COALESCE(wsc.max_units, 0)::int AS "maxUnits",
+    COALESCE((
+        SELECT array_agg(a."actionId")
+        FROM "_ActionToWorker" aw
+        JOIN "Action" a ON a."id" = aw."A"
+        WHERE aw."B" = w."id"
+    ), '{}')::text[] AS "actions",
+    st.slots_per_replica,
+    st.max_queue_age_seconds
+FROM
+    "Worker" w
+LEFT JOIN
+    v1_worker_slot_config wsc ON wsc.tenant_id = w."tenantId" AND wsc.worker_id = w."id" AND wsc.slot_type = @slotType::text
+LEFT JOIN
+    v1_worker_scaling_target st ON st.tenant_id = w."tenantId" AND st.worker_id = w."id"
+WHERE
+    w."tenantId" = @tenantId::uuid
+    AND w."dispatcherId" IS NOT NULL
+    AND w."lastHeartbeatAt" > NOW() - INTERVAL '5 seconds'
+    AND w."isActive" = true
+    AND w."isPaused" = false
+    AND NOT EXISTS (
+        SELECT 1 FROM v1_worker_cordon wc WHERE wc.tenant_id = w."tenantId" AND wc.worker_id = w."id"
+    )
+    AND w."operatorId" IS NULL;
+
+-- name: ListScalerQueueStats :many
+-- ListScalerQueueStats aggregates the queued tasks of a tenant by action, step and desired worker
+-- labels, optionally restricted to a set of actions.
+SELECT
+    qi.action_id,
+    qi.step_id,
+    qi.desired_worker_label,
+    COUNT(*)::int AS "queued",
+    (COUNT(*) * COALESCE(MAX(sr.units), 1))::int AS "queuedUnits",
+    MIN(qi.task_inserted_at)::timestamptz AS "oldestTaskInsertedAt",
+    COUNT(*) FILTER (
+        WHERE qi.schedule_timeout_at IS NOT NULL AND qi.schedule_timeout_at < @scheduleTimeoutBefore::timestamptz
+    )::int AS "nearScheduleTimeout"
+FROM
+    v1_queue_item qi
+LEFT JOIN
+    v1_step_slot_request sr ON sr.tenant_id = qi.tenant_id AND sr.step_id = qi.step_id AND sr.slot_type = @slotType::text
+WHERE
+    qi.tenant_id = @tenantId::uuid
+    AND (
+        sqlc.narg('actionIds')::text[] IS NULL
+        OR qi.action_id = ANY(sqlc.narg('actionIds')::text[])
+    )
+GROUP BY
+    qi.action_id,
+    qi.step_id,
+    qi.desired_worker_label;
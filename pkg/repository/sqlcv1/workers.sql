@@ -6,7 +6,8 @@ SELECT
     "strValue",
     "createdAt",
     "updatedAt",
-    "workerId"
+    "workerId",
+    "isDynamic"
 FROM "WorkerLabel" wl
 WHERE wl."workerId" = ANY(@workerIds::uuid[]);
 
@@ -130,12 +131,12 @@ FROM
 WHERE
     workers."tenantId" = @tenantId
     AND NOT EXISTS (
-        -- hide dag operators
+        -- hide dag and approval operators
         SELECT 1
         FROM v1_operator op
         WHERE
             op.id = workers."operatorId"
-            AND op.kind = 'DAG'
+            AND op.kind IN ('DAG', 'APPROVAL')
     )
     AND (
         sqlc.narg('actionId')::text IS NULL OR
@@ -207,12 +208,12 @@ FROM
 WHERE
     workers."tenantId" = @tenantId
     AND NOT EXISTS (
-        -- hide dag operators
+        -- hide dag and approval operators
         SELECT 1
         FROM v1_operator op
         WHERE
             op.id = workers."operatorId"
-            AND op.kind = 'DAG'
+            AND op.kind IN ('DAG', 'APPROVAL')
     )
     AND (
         sqlc.narg('actionId')::text IS NULL OR
@@ -309,7 +310,10 @@ WHERE
     AND w."dispatcherId" IS NOT NULL
     AND w."lastHeartbeatAt" > NOW() - INTERVAL '5 seconds'
     AND w."isActive" = true
-    AND w."isPaused" = false;
+    AND w."isPaused" = false
+    AND NOT EXISTS (
+        SELECT 1 FROM v1_worker_cordon wc WHERE wc.tenant_id = w."tenantId" AND wc.worker_id = w."id"
+    );
 
 -- name: ListSemaphoreSlotsWithStateForWorker :many
 SELECT
@@ -461,7 +465,8 @@ SELECT
     "intValue",
     "strValue",
     "createdAt",
-    "updatedAt"
+    "updatedAt",
+    "isDynamic"
 FROM "WorkerLabel" wl
 WHERE wl."workerId" = ANY(@workerIds::uuid[]);
 
@@ -542,21 +547,32 @@ INSERT INTO "WorkerLabel" (
     "workerId",
     "key",
     "intValue",
-    "strValue"
+    "strValue",
+    "isDynamic"
 ) VALUES (
     CURRENT_TIMESTAMP,
     CURRENT_TIMESTAMP,
     @workerId::uuid,
     @key::text,
     sqlc.narg('intValue')::int,
-    sqlc.narg('strValue')::text
+    sqlc.narg('strValue')::text,
+    @isDynamic::boolean
 ) ON CONFLICT ("workerId", "key") DO UPDATE
 SET
     "updatedAt" = CURRENT_TIMESTAMP,
     "intValue" = sqlc.narg('intValue')::int,
-    "strValue" = sqlc.narg('strValue')::text
+    "strValue" = sqlc.narg('strValue')::text,
+    "isDynamic" = @isDynamic::boolean
 RETURNING *;
 
+-- name: DeleteDynamicWorkerLabels :exec
+DELETE FROM
+    "WorkerLabel"
+WHERE
+    "workerId" = @workerId::uuid
+    AND "isDynamic"
+    AND "key" = ANY(@keys::text[]);
+
 -- name: CleanupOldWorkers :execresult
 WITH old_workers AS (
     SELECT "id"
@@ -567,6 +583,12 @@ WITH old_workers AS (
 ), deleted_worker_slot_configs AS (
     DELETE FROM v1_worker_slot_config
     WHERE worker_id IN (SELECT "id" FROM old_workers)
+), deleted_worker_scaling_targets AS (
+    DELETE FROM v1_worker_scaling_target
+    WHERE worker_id IN (SELECT "id" FROM old_workers)
+), deleted_worker_cordons AS (
+    DELETE FROM v1_worker_cordon
+    WHERE worker_id IN (SELECT "id" FROM old_workers)
 )
 DELETE FROM "Worker"
 WHERE "id" IN (SELECT "id" FROM old_workers);
@@ -23,6 +23,12 @@ WITH old_workers AS (
 ), deleted_worker_slot_configs AS (
     DELETE FROM v1_worker_slot_config
     WHERE worker_id IN (SELECT "id" FROM old_workers)
+), deleted_worker_scaling_targets AS (
+    DELETE FROM v1_worker_scaling_target
+    WHERE worker_id IN (SELECT "id" FROM old_workers)
+), deleted_worker_cordons AS (
+    DELETE FROM v1_worker_cordon
+    WHERE worker_id IN (SELECT "id" FROM old_workers)
 )
 DELETE FROM "Worker"
 WHERE "id" IN (SELECT "id" FROM old_workers)
@@ -45,12 +51,12 @@ FROM
 WHERE
     workers."tenantId" = $1
     AND NOT EXISTS (
-        -- hide dag operators
+        -- hide dag and approval operators
         SELECT 1
         FROM v1_operator op
         WHERE
             op.id = workers."operatorId"
-            AND op.kind = 'DAG'
+            AND op.kind IN ('DAG', 'APPROVAL')
     )
     AND (
         $2::text IS NULL OR
@@ -260,6 +266,25 @@ func (q *Queries) CreateWorkerSlotConfigs(ctx context.Context, db DBTX, arg Crea
 	return err
 }
 
+const deleteDynamicWorkerLabels = `-- name: DeleteDynamicWorkerLabels :exec
+DELETE FROM
+    "WorkerLabel"
+WHERE
+    "workerId" = $1::uuid
+    AND "isDynamic"
+    AND "key" = ANY($2::text[])
+`
+
+type DeleteDynamicWorkerLabelsParams struct {
+	Workerid uuid.UUID `json:"workerid"`
+	Keys     []string  `json:"keys"`
+}
+
+func (q *Queries) DeleteDynamicWorkerLabels(ctx context.Context, db DBTX, arg DeleteDynamicWorkerLabelsParams) error {
+	_, err := db.Exec(ctx, deleteDynamicWorkerLabels, arg.Workerid, arg.Keys)
+	return err
+}
+
 const deleteWorker = `-- name: DeleteWorker :one
 DELETE FROM
   "Worker"
@@ -320,6 +345,9 @@ WHERE
     AND w."lastHeartbeatAt" > NOW() - INTERVAL '5 seconds'
     AND w."isActive" = true
     AND w."isPaused" = false
+    AND NOT EXISTS (
+        SELECT 1 FROM v1_worker_cordon wc WHERE wc.tenant_id = w."tenantId" AND wc.worker_id = w."id"
+    )
 `
 
 type GetActiveWorkerByIdParams struct {
@@ -1057,7 +1085,8 @@ SELECT
     "strValue",
     "createdAt",
     "updatedAt",
-    "workerId"
+    "workerId",
+    "isDynamic"
 FROM "WorkerLabel" wl
 WHERE wl."workerId" = ANY($1::uuid[])
 `
@@ -1070,6 +1099,7 @@ type ListManyWorkerLabelsRow struct {
 	CreatedAt pgtype.Timestamp `json:"createdAt"`
 	UpdatedAt pgtype.Timestamp `json:"updatedAt"`
 	WorkerId  uuid.UUID        `json:"workerId"`
+	IsDynamic bool             `json:"isDynamic"`
 }
 
 func (q *Queries) ListManyWorkerLabels(ctx context.Context, db DBTX, workerids []uuid.UUID) ([]*ListManyWorkerLabelsRow, error) {
@@ -1089,6 +1119,7 @@ func (q *Queries) ListManyWorkerLabels(ctx context.Context, db DBTX, workerids [
 			&i.CreatedAt,
 			&i.UpdatedAt,
 			&i.WorkerId,
+			&i.IsDynamic,
 		); err != nil {
 			return nil, err
 		}
@@ -1303,7 +1334,8 @@ SELECT
     "intValue",
     "strValue",
     "createdAt",
-    "updatedAt"
+    "updatedAt",
+    "isDynamic"
 FROM "WorkerLabel" wl
 WHERE wl."workerId" = ANY($1::uuid[])
 `
@@ -1316,6 +1348,7 @@ type ListWorkerLabelsRow struct {
 	StrValue  pgtype.Text      `json:"strValue"`
 	CreatedAt pgtype.Timestamp `json:"createdAt"`
 	UpdatedAt pgtype.Timestamp `json:"updatedAt"`
+	IsDynamic bool             `json:"isDynamic"`
 }
 
 func (q *Queries) ListWorkerLabels(ctx context.Context, db DBTX, workerids []uuid.UUID) ([]*ListWorkerLabelsRow, error) {
@@ -1335,6 +1368,7 @@ func (q *Queries) ListWorkerLabels(ctx context.Context, db DBTX, workerids []uui
 			&i.StrValue,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.IsDynamic,
 		); err != nil {
 			return nil, err
 		}
@@ -1397,12 +1431,12 @@ FROM
 WHERE
     workers."tenantId" = $1
     AND NOT EXISTS (
-        -- hide dag operators
+        -- hide dag and approval operators
         SELECT 1
         FROM v1_operator op
         WHERE
             op.id = workers."operatorId"
-            AND op.kind = 'DAG'
+            AND op.kind IN ('DAG', 'APPROVAL')
     )
     AND (
         $2::text IS NULL OR
@@ -1798,27 +1832,31 @@ INSERT INTO "WorkerLabel" (
     "workerId",
     "key",
     "intValue",
-    "strValue"
+    "strValue",
+    "isDynamic"
 ) VALUES (
     CURRENT_TIMESTAMP,
     CURRENT_TIMESTAMP,
     $1::uuid,
     $2::text,
     $3::int,
-    $4::text
+    $4::text,
+    $5::boolean
 ) ON CONFLICT ("workerId", "key") DO UPDATE
 SET
     "updatedAt" = CURRENT_TIMESTAMP,
     "intValue" = $3::int,
-    "strValue" = $4::text
-RETURNING id, "createdAt", "updatedAt", "workerId", key, "strValue", "intValue"
+    "strValue" = $4::text,
+    "isDynamic" = $5::boolean
+RETURNING id, "createdAt", "updatedAt", "workerId", key, "strValue", "intValue", "isDynamic"
 `
 
 type UpsertWorkerLabelParams struct {
-	Workerid uuid.UUID   `json:"workerid"`
-	Key      string      `json:"key"`
-	IntValue pgtype.Int4 `json:"intValue"`
-	StrValue pgtype.Text `json:"strValue"`
+	Workerid  uuid.UUID   `json:"workerid"`
+	Key       string      `json:"key"`
+	IntValue  pgtype.Int4 `json:"intValue"`
+	StrValue  pgtype.Text `json:"strValue"`
+	Isdynamic bool        `json:"isdynamic"`
 }
 
 func (q *Queries) UpsertWorkerLabel(ctx context.Context, db DBTX, arg UpsertWorkerLabelParams) (*WorkerLabel, error) {
@@ -1827,6 +1865,7 @@ func (q *Queries) UpsertWorkerLabel(ctx context.Context, db DBTX, arg UpsertWork
 		arg.Key,
 		arg.IntValue,
 		arg.StrValue,
+		arg.Isdynamic,
 	)
 	var i WorkerLabel
 	err := row.Scan(
@@ -1837,6 +1876,7 @@ func (q *Queries) UpsertWorkerLabel(ctx context.Context, db DBTX, arg UpsertWork
 		&i.Key,
 		&i.StrValue,
 		&i.IntValue,
+		&i.IsDynamic,
 	)
 	return &i, err
 }
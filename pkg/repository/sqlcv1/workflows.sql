@@ -343,6 +343,42 @@ INSERT INTO v1_step_batch_config (
     batch_group_max_runs = EXCLUDED.batch_group_max_runs,
     broadcast_output = EXCLUDED.broadcast_output;
 
+-- name: CreateStepRetryPolicy :exec
+INSERT INTO v1_step_retry_policy (
+    step_id,
+    tenant_id,
+    policy_index,
+    error_class,
+    expression,
+    retries,
+    backoff_factor,
+    backoff_max_seconds,
+    never_retry
+) VALUES (
+    @stepId::uuid,
+    @tenantId::uuid,
+    @policyIndex::integer,
+    sqlc.narg('errorClass')::text,
+    sqlc.narg('expression')::text,
+    sqlc.narg('retries')::integer,
+    sqlc.narg('backoffFactor')::double precision,
+    sqlc.narg('backoffMaxSeconds')::integer,
+    @neverRetry::boolean
+);
+
+-- name: CreateStepMap :exec
+INSERT INTO v1_step_map (
+    step_id,
+    tenant_id,
+    expression,
+    max_parallelism
+) VALUES (
+    @stepId::uuid,
+    @tenantId::uuid,
+    @expression::text,
+    @maxParallelism::integer
+);
+
 -- name: CreateStepSlotRequests :exec
 INSERT INTO v1_step_slot_request (
     tenant_id,
@@ -415,7 +451,8 @@ INSERT INTO "StepDesiredWorkerLabel" (
     "strValue",
     "required",
     "weight",
-    "comparator"
+    "comparator",
+    "strValues"
 ) VALUES (
     CURRENT_TIMESTAMP,
     CURRENT_TIMESTAMP,
@@ -425,7 +462,8 @@ INSERT INTO "StepDesiredWorkerLabel" (
     COALESCE(sqlc.narg('strValue')::text, NULL),
     COALESCE(sqlc.narg('required')::boolean, false),
     COALESCE(sqlc.narg('weight')::int, 100),
-    COALESCE(sqlc.narg('comparator')::"WorkerLabelComparator", 'EQUAL')
+    COALESCE(sqlc.narg('comparator')::"WorkerLabelComparator", 'EQUAL'),
+    sqlc.narg('strValues')::text[]
 ) ON CONFLICT ("stepId", "key") DO UPDATE
 SET
     "updatedAt" = CURRENT_TIMESTAMP,
@@ -433,7 +471,8 @@ SET
     "strValue" = COALESCE(sqlc.narg('strValue')::text, null),
     "required" = COALESCE(sqlc.narg('required')::boolean, false),
     "weight" = COALESCE(sqlc.narg('weight')::int, 100),
-    "comparator" = COALESCE(sqlc.narg('comparator')::"WorkerLabelComparator", 'EQUAL')
+    "comparator" = COALESCE(sqlc.narg('comparator')::"WorkerLabelComparator", 'EQUAL'),
+    "strValues" = sqlc.narg('strValues')::text[]
 RETURNING *;
 
 -- name: GetWorkflowVersionForEngine :many
@@ -650,6 +689,15 @@ VALUES (
     sqlc.narg('parentReadableId')::text
 ) RETURNING *;
 
+-- name: ListStepMaps :many
+SELECT
+    *
+FROM
+    v1_step_map
+WHERE
+    step_id = ANY(@stepIds::uuid[])
+    AND tenant_id = @tenantId::uuid;
+
 -- name: ListStepMatchConditions :many
 SELECT
     *
@@ -924,3 +972,14 @@ WHERE
     "id" = ANY(@ids::uuid[])
     AND "deletedAt" IS NULL
 ;
+
+-- name: ListWorkflowVersionsByWorkflowId :many
+SELECT wv.*
+FROM "WorkflowVersion" wv
+JOIN "Workflow" w ON w."id" = wv."workflowId"
+WHERE
+    wv."workflowId" = @workflowId::uuid
+    AND w."tenantId" = @tenantId::uuid
+    AND wv."deletedAt" IS NULL
+ORDER BY
+    wv."order" ASC;
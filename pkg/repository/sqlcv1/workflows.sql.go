@@ -385,6 +385,37 @@ func (q *Queries) CreateStepExpressions(ctx context.Context, db DBTX, arg Create
 	return err
 }
 
+const createStepMap = `-- name: CreateStepMap :exec
+INSERT INTO v1_step_map (
+    step_id,
+    tenant_id,
+    expression,
+    max_parallelism
+) VALUES (
+    $1::uuid,
+    $2::uuid,
+    $3::text,
+    $4::integer
+)
+`
+
+type CreateStepMapParams struct {
+	Stepid         uuid.UUID `json:"stepid"`
+	Tenantid       uuid.UUID `json:"tenantid"`
+	Expression     string    `json:"expression"`
+	Maxparallelism int32     `json:"maxparallelism"`
+}
+
+func (q *Queries) CreateStepMap(ctx context.Context, db DBTX, arg CreateStepMapParams) error {
+	_, err := db.Exec(ctx, createStepMap,
+		arg.Stepid,
+		arg.Tenantid,
+		arg.Expression,
+		arg.Maxparallelism,
+	)
+	return err
+}
+
 const createStepMatchCondition = `-- name: CreateStepMatchCondition :one
 INSERT INTO v1_step_match_condition (
     tenant_id,
@@ -498,6 +529,57 @@ func (q *Queries) CreateStepRateLimit(ctx context.Context, db DBTX, arg CreateSt
 	return &i, err
 }
 
+const createStepRetryPolicy = `-- name: CreateStepRetryPolicy :exec
+INSERT INTO v1_step_retry_policy (
+    step_id,
+    tenant_id,
+    policy_index,
+    error_class,
+    expression,
+    retries,
+    backoff_factor,
+    backoff_max_seconds,
+    never_retry
+) VALUES (
+    $1::uuid,
+    $2::uuid,
+    $3::integer,
+    $4::text,
+    $5::text,
+    $6::integer,
+    $7::double precision,
+    $8::integer,
+    $9::boolean
+)
+`
+
+type CreateStepRetryPolicyParams struct {
+	Stepid            uuid.UUID     `json:"stepid"`
+	Tenantid          uuid.UUID     `json:"tenantid"`
+	Policyindex       int32         `json:"policyindex"`
+	ErrorClass        pgtype.Text   `json:"errorClass"`
+	Expression        pgtype.Text   `json:"expression"`
+	Retries           pgtype.Int4   `json:"retries"`
+	BackoffFactor     pgtype.Float8 `json:"backoffFactor"`
+	BackoffMaxSeconds pgtype.Int4   `json:"backoffMaxSeconds"`
+	Neverretry        bool          `json:"neverretry"`
+}
+
+func (q *Queries) CreateStepRetryPolicy(ctx context.Context, db DBTX, arg CreateStepRetryPolicyParams) error {
+	_, err := db.Exec(ctx, createStepRetryPolicy,
+		arg.Stepid,
+		arg.Tenantid,
+		arg.Policyindex,
+		arg.ErrorClass,
+		arg.Expression,
+		arg.Retries,
+		arg.BackoffFactor,
+		arg.BackoffMaxSeconds,
+		arg.Neverretry,
+	)
+	return err
+}
+
 const createStepSlotRequests = `-- name: CreateStepSlotRequests :exec
 INSERT INTO v1_step_slot_request (
     tenant_id,
@@ -1667,6 +1749,46 @@ func (q *Queries) ListStepExpressions(ctx context.Context, db DBTX, stepids []uu
 	return items, nil
 }
 
+const listStepMaps = `-- name: ListStepMaps :many
+SELECT
+    step_id, tenant_id, expression, max_parallelism
+FROM
+    v1_step_map
+WHERE
+    step_id = ANY($1::uuid[])
+    AND tenant_id = $2::uuid
+`
+
+type ListStepMapsParams struct {
+	Stepids  []uuid.UUID `json:"stepids"`
+	Tenantid uuid.UUID   `json:"tenantid"`
+}
+
+func (q *Queries) ListStepMaps(ctx context.Context, db DBTX, arg ListStepMapsParams) ([]*V1StepMap, error) {
+	rows, err := db.Query(ctx, listStepMaps, arg.Stepids, arg.Tenantid)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*V1StepMap
+	for rows.Next() {
+		var i V1StepMap
+		if err := rows.Scan(
+			&i.StepID,
+			&i.TenantID,
+			&i.Expression,
+			&i.MaxParallelism,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listStepMatchConditions = `-- name: ListStepMatchConditions :many
 SELECT
     id, tenant_id, step_id, readable_data_key, action, or_group_id, expression, kind, sleep_duration, event_key, parent_readable_id
@@ -2075,6 +2197,64 @@ func (q *Queries) ListWorkflowVersionsByIds(ctx context.Context, db DBTX, ids []
 	return items, nil
 }
 
+const listWorkflowVersionsByWorkflowId = `-- name: ListWorkflowVersionsByWorkflowId :many
+SELECT wv.id, wv."createdAt", wv."updatedAt", wv."deletedAt", wv.version, wv."order", wv."workflowId", wv.checksum, wv."scheduleTimeout", wv."onFailureJobId", wv.sticky, wv.kind, wv."defaultPriority", wv."createWorkflowVersionOpts", wv."inputJsonSchema", wv."idempotencyKeyExpression", wv."idempotencyKeyTtlMs", wv."idempotencyMethod", wv."isUsingDagOperator", wv."dagShape"
+FROM "WorkflowVersion" wv
+JOIN "Workflow" w ON w."id" = wv."workflowId"
+WHERE
+    wv."workflowId" = $1::uuid
+    AND w."tenantId" = $2::uuid
+    AND wv."deletedAt" IS NULL
+ORDER BY
+    wv."order" ASC
+`
+
+type ListWorkflowVersionsByWorkflowIdParams struct {
+	Workflowid uuid.UUID `json:"workflowid"`
+	Tenantid   uuid.UUID `json:"tenantid"`
+}
+
+func (q *Queries) ListWorkflowVersionsByWorkflowId(ctx context.Context, db DBTX, arg ListWorkflowVersionsByWorkflowIdParams) ([]*WorkflowVersion, error) {
+	rows, err := db.Query(ctx, listWorkflowVersionsByWorkflowId, arg.Workflowid, arg.Tenantid)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*WorkflowVersion
+	for rows.Next() {
+		var i WorkflowVersion
+		if err := rows.Scan(
+			&i.ID,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.DeletedAt,
+			&i.Version,
+			&i.Order,
+			&i.WorkflowId,
+			&i.Checksum,
+			&i.ScheduleTimeout,
+			&i.OnFailureJobId,
+			&i.Sticky,
+			&i.Kind,
+			&i.DefaultPriority,
+			&i.CreateWorkflowVersionOpts,
+			&i.InputJsonSchema,
+			&i.IdempotencyKeyExpression,
+			&i.IdempotencyKeyTtlMs,
+			&i.IdempotencyMethod,
+			&i.IsUsingDagOperator,
+			&i.DagShape,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listWorkflows = `-- name: ListWorkflows :many
 SELECT
     workflows.id, workflows."createdAt", workflows."updatedAt", workflows."deletedAt", workflows."tenantId", workflows.name, workflows.description, workflows."isPaused", workflows."pausedWorkflowCronRunQueueBehavior", workflows."pausedWorkflowScheduledRunQueueBehavior", workflows."pausedWorkflowQueueTTL"
@@ -2414,7 +2594,8 @@ INSERT INTO "StepDesiredWorkerLabel" (
     "strValue",
     "required",
     "weight",
-    "comparator"
+    "comparator",
+    "strValues"
 ) VALUES (
     CURRENT_TIMESTAMP,
     CURRENT_TIMESTAMP,
@@ -2424,7 +2605,8 @@ INSERT INTO "StepDesiredWorkerLabel" (
     COALESCE($4::text, NULL),
     COALESCE($5::boolean, false),
     COALESCE($6::int, 100),
-    COALESCE($7::"WorkerLabelComparator", 'EQUAL')
+    COALESCE($7::"WorkerLabelComparator", 'EQUAL'),
+    $8::text[]
 ) ON CONFLICT ("stepId", "key") DO UPDATE
 SET
     "updatedAt" = CURRENT_TIMESTAMP,
@@ -2432,8 +2614,9 @@ SET
     "strValue" = COALESCE($4::text, null),
     "required" = COALESCE($5::boolean, false),
     "weight" = COALESCE($6::int, 100),
-    "comparator" = COALESCE($7::"WorkerLabelComparator", 'EQUAL')
-RETURNING id, "createdAt", "updatedAt", "stepId", key, "strValue", "intValue", required, comparator, weight
+    "comparator" = COALESCE($7::"WorkerLabelComparator", 'EQUAL'),
+    "strValues" = $8::text[]
+RETURNING id, "createdAt", "updatedAt", "stepId", key, "strValue", "intValue", required, comparator, weight, "strValues"
 `
 
 type UpsertDesiredWorkerLabelParams struct {
@@ -2444,6 +2627,7 @@ type UpsertDesiredWorkerLabelParams struct {
 	Required   pgtype.Bool               `json:"required"`
 	Weight     pgtype.Int4               `json:"weight"`
 	Comparator NullWorkerLabelComparator `json:"comparator"`
+	StrValues  []string                  `json:"strValues"`
 }
 
 func (q *Queries) UpsertDesiredWorkerLabel(ctx context.Context, db DBTX, arg UpsertDesiredWorkerLabelParams) (*StepDesiredWorkerLabel, error) {
@@ -2455,6 +2639,7 @@ func (q *Queries) UpsertDesiredWorkerLabel(ctx context.Context, db DBTX, arg Ups
 		arg.Required,
 		arg.Weight,
 		arg.Comparator,
+		arg.StrValues,
 	)
 	var i StepDesiredWorkerLabel
 	err := row.Scan(
@@ -2468,6 +2653,7 @@ func (q *Queries) UpsertDesiredWorkerLabel(ctx context.Context, db DBTX, arg Ups
 		&i.Required,
 		&i.Comparator,
 		&i.Weight,
+		&i.StrValues,
 	)
 	return &i, err
 }
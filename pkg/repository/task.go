@@ -182,6 +182,9 @@ type FailTaskOpts struct {
 	// (optional) the error message for the task
 	ErrorMessage string
 
+	// (optional) the error class reported by the worker, matched against the step's retry policies
+	ErrorClass string
+
 	// (optional) A boolean flag to indicate whether the error is non-retryable, meaning it should _not_ be retried. Defaults to false.
 	IsNonRetryable bool
 }
@@ -307,12 +310,20 @@ type TaskRepository interface {
 
 	RefreshTimeoutBy(ctx context.Context, tenantId uuid.UUID, opt RefreshTimeoutBy) (*sqlcv1.V1TaskRuntime, error)
 
-	ReleaseSlot(ctx context.Context, tenantId, externalId uuid.UUID) (*sqlcv1.V1TaskRuntime, error)
+	// ReleaseSlot releases the worker slots of a running task before it completes, and returns the
+	// slot time the task consumed until then.
+	ReleaseSlot(ctx context.Context, tenantId, externalId uuid.UUID) (*sqlcv1.ManualSlotReleaseRow, []TaskSlotUsage, error)
 
-	EvictTask(ctx context.Context, tenantId uuid.UUID, task TaskIdInsertedAtRetryCount) (WasEvicted, error)
+	// EvictTask evicts a durable task from its worker, and returns the slot time the task consumed
+	// until its slots were released.
+	EvictTask(ctx context.Context, tenantId uuid.UUID, task TaskIdInsertedAtRetryCount) (WasEvicted, []TaskSlotUsage, error)
 
 	RestoreEvictedTasks(ctx context.Context, tenantId uuid.UUID, tasks []TaskIdInsertedAtRetryCount) ([]*sqlcv1.RestoreEvictedTasksRow, error)
 
+	// PreemptTasks releases lower-priority running tasks of preemptible steps so that the given
+	// waiting tasks can be assigned, and requeues them as new attempts.
+	PreemptTasks(ctx context.Context, tenantId uuid.UUID, waiting []TaskIdInsertedAtRetryCount) (*PreemptTasksResponse, error)
+
 	ListSignalCompletedEvents(ctx context.Context, tenantId uuid.UUID, tasks []TaskIdInsertedAtSignalKey) ([]*V1TaskEventWithPayload, error)
 
 	CountActiveTaskBatchRuns(ctx context.Context, tenantId, stepId, batchKey string) (int, error)
@@ -793,6 +804,13 @@ func (r *TaskRepositoryImpl) CompleteTasks(ctx context.Context, tenantId uuid.UU
 		return nil, fmt.Errorf("failed to release all tasks")
 	}
 
+	if err := r.closeCircuitBreakers(ctx, tx, tenantId, taskIdRetryCounts); err != nil {
+		err = fmt.Errorf("failed to close circuit breakers: %w", err)
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "failed to close circuit breakers")
+		return nil, err
+	}
+
 	outputs := make([][]byte, len(releasedTasks))
 
 	for i, releasedTask := range releasedTasks {
@@ -884,6 +902,7 @@ func (r *TaskRepositoryImpl) failTasksTx(ctx context.Context, tx sqlcv1.DBTX, te
 	appFailureTaskInsertedAts := make([]pgtype.Timestamptz, 0)
 	appFailureTaskRetryCounts := make([]int32, 0)
 	appFailureIsNonRetryableStatuses := make([]bool, 0)
+	appFailureOpts := make([]FailTaskOpts, 0)
 
 	internalFailureTaskIds := make([]int64, 0)
 	internalFailureInsertedAts := make([]pgtype.Timestamptz, 0)
@@ -898,6 +917,7 @@ func (r *TaskRepositoryImpl) failTasksTx(ctx context.Context, tx sqlcv1.DBTX, te
 			appFailureTaskInsertedAts = append(appFailureTaskInsertedAts, failureOpt.InsertedAt)
 			appFailureTaskRetryCounts = append(appFailureTaskRetryCounts, failureOpt.RetryCount)
 			appFailureIsNonRetryableStatuses = append(appFailureIsNonRetryableStatuses, failureOpt.IsNonRetryable)
+			appFailureOpts = append(appFailureOpts, failureOpt)
 		} else {
 			internalFailureTaskIds = append(internalFailureTaskIds, failureOpt.Id)
 			internalFailureInsertedAts = append(internalFailureInsertedAts, failureOpt.InsertedAt)
@@ -917,18 +937,48 @@ func (r *TaskRepositoryImpl) failTasksTx(ctx context.Context, tx sqlcv1.DBTX, te
 				Value: attribute.IntValue(len(appFailureTaskIds)),
 			},
 		)
+
+		retryPolicies, err := r.listRetryPolicies(ctx, tx, tenantId, appFailureTaskIds, appFailureTaskInsertedAts)
+
+		if err != nil {
+			return nil, fmt.Errorf("could not list retry policies: %w", err)
+		}
+
+		appFailureRetryMaxes := make([]int32, len(appFailureOpts))
+		appFailureBackoffFactors := make([]float64, len(appFailureOpts))
+		appFailureBackoffMaxSeconds := make([]int32, len(appFailureOpts))
+		appFailurePolicyIndexes := make([]int32, len(appFailureOpts))
+
+		for i, failureOpt := range appFailureOpts {
+			override := r.matchRetryPolicy(retryPolicies[failureOpt.Id], failureOpt)
+
+			appFailureIsNonRetryableStatuses[i] = appFailureIsNonRetryableStatuses[i] || override.neverRetry
+			appFailureRetryMaxes[i] = override.retries
+			appFailureBackoffFactors[i] = override.backoffFactor
+			appFailureBackoffMaxSeconds[i] = override.backoffMaxSeconds
+			appFailurePolicyIndexes[i] = override.policyIndex
+		}
+
 		appFailureRetries, err := r.queries.FailTaskAppFailure(ctx, tx, sqlcv1.FailTaskAppFailureParams{
-			Tenantid:        tenantId,
-			Taskids:         appFailureTaskIds,
-			Taskinsertedats: appFailureTaskInsertedAts,
-			Taskretrycounts: appFailureTaskRetryCounts,
-			Isnonretryables: appFailureIsNonRetryableStatuses,
+			Tenantid:          tenantId,
+			Taskids:           appFailureTaskIds,
+			Taskinsertedats:   appFailureTaskInsertedAts,
+			Taskretrycounts:   appFailureTaskRetryCounts,
+			Isnonretryables:   appFailureIsNonRetryableStatuses,
+			Retries:           appFailureRetryMaxes,
+			Backofffactors:    appFailureBackoffFactors,
+			Backoffmaxseconds: appFailureBackoffMaxSeconds,
+			Policyindexes:     appFailurePolicyIndexes,
 		})
 
 		if err != nil {
 			return nil, err
 		}
 
+		if err := r.recordCircuitBreakerFailures(ctx, tx, tenantId, appFailureTaskIds, appFailureTaskInsertedAts); err != nil {
+			return nil, fmt.Errorf("could not record circuit breaker failures: %w", err)
+		}
+
 		for _, task := range appFailureRetries {
 			retriedTasks = append(retriedTasks, RetriedTask{
 				TaskIdInsertedAtRetryCount: &TaskIdInsertedAtRetryCount{
@@ -1424,6 +1474,7 @@ func (r *TaskRepositoryImpl) ProcessTaskTimeouts(ctx context.Context, tenantId u
 	// get task timeouts
 	toTimeout, err := r.queries.ListTasksToTimeout(ctx, tx, sqlcv1.ListTasksToTimeoutParams{
 		Tenantid: tenantId,
+		Now:      sqlchelpers.TimestamptzFromTime(r.clock.Now()),
 		Limit: pgtype.Int4{
 			Int32: int32(limit), // #nosec G115 -- internal engine-configured limit, not attacker-controlled
 			Valid: true,
@@ -1512,6 +1563,7 @@ func (r *TaskRepositoryImpl) ProcessTaskTimeouts(ctx context.Context, tenantId u
 			},
 			IsAppError:     true,
 			ErrorMessage:   errorMsg,
+			ErrorClass:     ErrorClassTimeout,
 			IsNonRetryable: false,
 		})
 	}
@@ -1696,6 +1748,7 @@ func (r *TaskRepositoryImpl) ProcessDurableSleeps(ctx context.Context, tenantId
 
 	emitted, err := r.queries.PopDurableSleep(ctx, tx, sqlcv1.PopDurableSleepParams{
 		TenantID: tenantId,
+		Now:      sqlchelpers.TimestamptzFromTime(r.clock.Now()),
 		Limit:    pgtype.Int4{Int32: int32(limit), Valid: true}, // #nosec G115 -- internal engine-configured limit, not attacker-controlled
 	})
 
@@ -1717,7 +1770,7 @@ func (r *TaskRepositoryImpl) ProcessDurableSleeps(ctx context.Context, tenantId
 
 		events = append(events, CandidateEventMatch{
 			ID:             uuid.New(),
-			EventTimestamp: time.Now(),
+			EventTimestamp: r.clock.Now(),
 			Key:            getDurableSleepEventKey(sleep.ID),
 			Data:           data,
 		})
@@ -1877,11 +1930,11 @@ func (r *TaskRepositoryImpl) RefreshTimeoutBy(ctx context.Context, tenantId uuid
 	return res, nil
 }
 
-func (r *TaskRepositoryImpl) ReleaseSlot(ctx context.Context, tenantId, externalId uuid.UUID) (*sqlcv1.V1TaskRuntime, error) {
+func (r *TaskRepositoryImpl) ReleaseSlot(ctx context.Context, tenantId, externalId uuid.UUID) (*sqlcv1.ManualSlotReleaseRow, []TaskSlotUsage, error) {
 	tx, commit, rollback, err := sqlchelpers.PrepareTx(ctx, r.pool, r.l)
 
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	defer rollback()
@@ -1896,21 +1949,41 @@ func (r *TaskRepositoryImpl) ReleaseSlot(ctx context.Context, tenantId, external
 	)
 
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	if err := commit(ctx); err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	return resp, nil
+	var usage []TaskSlotUsage
+
+	if resp.SlotWorkerID != nil {
+		usage = releasedSlotUsage(
+			TaskSlotUsage{
+				TaskId:             resp.TaskID,
+				TaskInsertedAt:     resp.TaskInsertedAt.Time,
+				RetryCount:         resp.RetryCount,
+				WorkerId:           *resp.SlotWorkerID,
+				WorkflowId:         resp.WorkflowID,
+				ActionId:           resp.ActionID,
+				AdditionalMetadata: resp.AdditionalMetadata,
+			},
+			resp.SlotTypes,
+			resp.SlotUnits,
+			resp.AssignedAt,
+			resp.ReleasedAt,
+		)
+	}
+
+	return resp, usage, nil
 }
 
-func (r *TaskRepositoryImpl) EvictTask(ctx context.Context, tenantId uuid.UUID, task TaskIdInsertedAtRetryCount) (WasEvicted, error) {
+func (r *TaskRepositoryImpl) EvictTask(ctx context.Context, tenantId uuid.UUID, task TaskIdInsertedAtRetryCount) (WasEvicted, []TaskSlotUsage, error) {
 	tx, commit, rollback, err := sqlchelpers.PrepareTx(ctx, r.pool, r.l)
 
 	if err != nil {
-		return false, err
+		return false, nil, err
 	}
 
 	defer rollback()
@@ -1921,7 +1994,7 @@ func (r *TaskRepositoryImpl) EvictTask(ctx context.Context, tenantId uuid.UUID,
 		Tenantid:              tenantId,
 	})
 	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
-		return false, err
+		return false, nil, err
 	}
 
 	evicted, err := r.queries.EvictTask(ctx, tx, sqlcv1.EvictTaskParams{
@@ -1931,14 +2004,35 @@ func (r *TaskRepositoryImpl) EvictTask(ctx context.Context, tenantId uuid.UUID,
 		Retrycount:     task.RetryCount,
 	})
 	if err != nil {
-		return false, err
+		return false, nil, err
 	}
 
 	if err := commit(ctx); err != nil {
-		return false, err
+		return false, nil, err
+	}
+
+	var usage []TaskSlotUsage
+
+	// the slots are released even if the task isn't evicted, e.g. because it has timed out
+	if evicted.SlotWorkerID != nil && evicted.WorkflowID != nil {
+		usage = releasedSlotUsage(
+			TaskSlotUsage{
+				TaskId:             task.Id,
+				TaskInsertedAt:     task.InsertedAt.Time,
+				RetryCount:         task.RetryCount,
+				WorkerId:           *evicted.SlotWorkerID,
+				WorkflowId:         *evicted.WorkflowID,
+				ActionId:           evicted.ActionID.String,
+				AdditionalMetadata: evicted.AdditionalMetadata,
+			},
+			evicted.SlotTypes,
+			evicted.SlotUnits,
+			evicted.AssignedAt,
+			evicted.ReleasedAt,
+		)
 	}
 
-	return WasEvicted(evicted > 0), nil
+	return WasEvicted(evicted.Evicted > 0), usage, nil
 }
 
 func (r *TaskRepositoryImpl) RestoreEvictedTasks(ctx context.Context, tenantId uuid.UUID, tasks []TaskIdInsertedAtRetryCount) ([]*sqlcv1.RestoreEvictedTasksRow, error) {
@@ -4518,6 +4612,34 @@ func (r *TaskRepositoryImpl) Cleanup(ctx context.Context) (bool, error) {
 		return nil
 	}))
 
+	// CleanupV1TaskRetryPolicyCount
+	eg.Go(runCleanup("cleanup-v1-task-retry-policy-count", func(ctx context.Context, tx sqlcv1.DBTX) error {
+		result, err := r.queries.CleanupV1TaskRetryPolicyCount(ctx, tx, batchSize)
+		if err != nil {
+			return fmt.Errorf("error cleaning up v1_task_retry_policy_count: %v", err)
+		}
+		if result.RowsAffected() == batchSize {
+			mu.Lock()
+			shouldContinue = true
+			mu.Unlock()
+		}
+		return nil
+	}))
+
+	// CleanupV1TaskPreemption
+	eg.Go(runCleanup("cleanup-v1-task-preemption", func(ctx context.Context, tx sqlcv1.DBTX) error {
+		result, err := r.queries.CleanupV1TaskPreemption(ctx, tx, batchSize)
+		if err != nil {
+			return fmt.Errorf("error cleaning up v1_task_preemption: %v", err)
+		}
+		if result.RowsAffected() == batchSize {
+			mu.Lock()
+			shouldContinue = true
+			mu.Unlock()
+		}
+		return nil
+	}))
+
 	// CleanupV1ConcurrencySlot
 	eg.Go(runCleanup("cleanup-v1-concurrency-slot", func(ctx context.Context, tx sqlcv1.DBTX) error {
 		result, err := r.queries.CleanupV1ConcurrencySlot(ctx, tx, batchSize)
@@ -147,7 +147,10 @@ func (t *tickerRepository) PollCronSchedules(ctx context.Context, tickerId uuid.
 }
 
 func (t *tickerRepository) PollScheduledWorkflows(ctx context.Context, tickerId uuid.UUID) ([]*sqlcv1.PollScheduledWorkflowsRow, error) {
-	return t.queries.PollScheduledWorkflows(ctx, t.pool, tickerId)
+	return t.queries.PollScheduledWorkflows(ctx, t.pool, sqlcv1.PollScheduledWorkflowsParams{
+		Now:      sqlchelpers.TimestamptzFromTime(t.clock.Now()),
+		Tickerid: tickerId,
+	})
 }
 
 func (t *tickerRepository) PollTenantAlerts(ctx context.Context, tickerId uuid.UUID) ([]*sqlcv1.PollTenantAlertsRow, error) {
@@ -77,9 +77,17 @@ type TriggerTaskData struct {
 	// (optional) task run external IDs of parent tasks for durable DAG orchestration
 	DagParentTaskRunIds []uuid.UUID `json:"dag_parent_task_run_ids,omitempty"`
 
+	// (optional) readable ids of the map steps among the DAG parents, whose task runs are gathered
+	// into a single results array in DagParentTaskRunIds order
+	DagMapParentReadableIds []string `json:"dag_map_parent_readable_ids,omitempty"`
+
 	// (optional) when set, only trigger this specific step by action ID (used by DAG operator)
 	TargetActionId *string `json:"target_action_id,omitempty"`
 
+	// (optional) the outputs of the target step's parents keyed by step readable id, which the step
+	// sees as completed parents instead of running them. Only used with TargetActionId.
+	ParentOutputs map[string][]byte `json:"parent_outputs,omitempty"`
+
 	// (optional) when set, resolve to this exact workflow version instead of latest by name
 	WorkflowVersionId *uuid.UUID `json:"workflow_version_id,omitempty"`
 
@@ -104,11 +112,12 @@ type TriggerTaskData struct {
 	OlapDagInsertedAt *time.Time `json:"olap_dag_inserted_at,omitempty"`
 }
 
-func ProtoToDesiredWorkerLabel(key string, strValue *string, intValue *int32, required *bool, weight *int32, comparator *string) *sqlcv1.GetDesiredLabelsRow {
+func ProtoToDesiredWorkerLabel(key string, strValue *string, intValue *int32, strValues []string, required *bool, weight *int32, comparator *string) *sqlcv1.GetDesiredLabelsRow {
 	row := &sqlcv1.GetDesiredLabelsRow{
 		Key:        key,
 		Comparator: sqlcv1.WorkerLabelComparatorEQUAL,
 		Weight:     100,
+		StrValues:  strValues,
 	}
 
 	if strValue != nil {
@@ -730,7 +739,9 @@ type triggerTuple struct {
 	triggeringEventKey        *string
 	idempotency               *IdempotencyConfig
 	dagParentTaskRunIds       []uuid.UUID
+	dagMapParentReadableIds   []string
 	targetActionId            *string
+	parentOutputs             map[string][]byte
 	isSkipped                 bool
 	isCancelled               bool
 	workflowRunId             *uuid.UUID
@@ -1091,7 +1102,7 @@ func (r *sharedRepository) triggerWorkflowsCore(
 		useOperatorPath := orchestratorStep != nil && tuple.targetActionId == nil
 
 		if useOperatorPath {
-			orchestratorInput := r.newTaskInput(tuple.input, nil, tuple.filterPayload, tuple.dagParentTaskRunIds)
+			orchestratorInput := r.newTaskInput(tuple.input, nil, tuple.filterPayload, tuple.dagParentTaskRunIds, tuple.dagMapParentReadableIds)
 			orchestratorInput.DesiredWorkerLabels = tuple.desiredWorkerLabels
 
 			operatorDagTuples[tuple.externalId] = tuple
@@ -1357,7 +1368,7 @@ func (r *sharedRepository) triggerWorkflowsCore(
 						ExternalId:                taskExternalId,
 						WorkflowRunId:             tuple.effectiveWorkflowRunId(),
 						StepId:                    step.ID,
-						Input:                     r.newTaskInput(tuple.input, nil, tuple.filterPayload, tuple.dagParentTaskRunIds),
+						Input:                     r.newTaskInput(tuple.input, newParentOutputsMatchData(tuple.parentOutputs), tuple.filterPayload, tuple.dagParentTaskRunIds, tuple.dagMapParentReadableIds),
 						AdditionalMetadata:        tuple.additionalMetadata,
 						InitialState:              initialState,
 						DesiredWorkerId:           tuple.desiredWorkerId,
@@ -2743,28 +2754,30 @@ func (r *sharedRepository) prepareTriggerFromWorkflowNames(ctx context.Context,
 			}
 
 			triggerOpts = append(triggerOpts, triggerTuple{
-				workflowVersionId:    pinned.ID,
-				workflowId:           pinned.WorkflowId,
-				workflowName:         opt.WorkflowName,
-				externalId:           opt.ExternalId,
-				input:                opt.Data,
-				additionalMetadata:   opt.AdditionalMetadata,
-				desiredWorkerId:      opt.DesiredWorkerId,
-				parentExternalId:     opt.ParentExternalId,
-				parentTaskId:         opt.ParentTaskId,
-				parentTaskInsertedAt: opt.ParentTaskInsertedAt,
-				childIndex:           opt.ChildIndex,
-				childKey:             opt.ChildKey,
-				priority:             opt.Priority,
-				desiredWorkerLabels:  opt.DesiredWorkerLabels,
-				idempotency:          idempotency,
-				dagParentTaskRunIds:  opt.DagParentTaskRunIds,
-				targetActionId:       opt.TargetActionId,
-				isSkipped:            opt.IsSkipped,
-				isCancelled:          opt.IsCancelled,
-				workflowRunId:        opt.WorkflowRunId,
-				olapDagId:            opt.OlapDagId,
-				olapDagInsertedAt:    opt.OlapDagInsertedAt,
+				workflowVersionId:       pinned.ID,
+				workflowId:              pinned.WorkflowId,
+				workflowName:            opt.WorkflowName,
+				externalId:              opt.ExternalId,
+				input:                   opt.Data,
+				additionalMetadata:      opt.AdditionalMetadata,
+				desiredWorkerId:         opt.DesiredWorkerId,
+				parentExternalId:        opt.ParentExternalId,
+				parentTaskId:            opt.ParentTaskId,
+				parentTaskInsertedAt:    opt.ParentTaskInsertedAt,
+				childIndex:              opt.ChildIndex,
+				childKey:                opt.ChildKey,
+				priority:                opt.Priority,
+				desiredWorkerLabels:     opt.DesiredWorkerLabels,
+				idempotency:             idempotency,
+				dagParentTaskRunIds:     opt.DagParentTaskRunIds,
+				dagMapParentReadableIds: opt.DagMapParentReadableIds,
+				targetActionId:          opt.TargetActionId,
+				parentOutputs:           opt.ParentOutputs,
+				isSkipped:               opt.IsSkipped,
+				isCancelled:             opt.IsCancelled,
+				workflowRunId:           opt.WorkflowRunId,
+				olapDagId:               opt.OlapDagId,
+				olapDagInsertedAt:       opt.OlapDagInsertedAt,
 			})
 
 			continue
@@ -2803,29 +2816,31 @@ func (r *sharedRepository) prepareTriggerFromWorkflowNames(ctx context.Context,
 			}
 
 			triggerOpts = append(triggerOpts, triggerTuple{
-				workflowVersionId:    workflowVersion.WorkflowVersionId,
-				workflowId:           workflowVersion.WorkflowId,
-				workflowName:         workflowVersion.WorkflowName,
-				isPaused:             workflowVersion.WorkflowIsPaused.Bool,
-				externalId:           opt.ExternalId,
-				input:                opt.Data,
-				additionalMetadata:   opt.AdditionalMetadata,
-				desiredWorkerId:      opt.DesiredWorkerId,
-				parentExternalId:     opt.ParentExternalId,
-				parentTaskId:         opt.ParentTaskId,
-				parentTaskInsertedAt: opt.ParentTaskInsertedAt,
-				childIndex:           opt.ChildIndex,
-				childKey:             opt.ChildKey,
-				priority:             opt.Priority,
-				desiredWorkerLabels:  opt.DesiredWorkerLabels,
-				idempotency:          idempotency,
-				dagParentTaskRunIds:  opt.DagParentTaskRunIds,
-				targetActionId:       opt.TargetActionId,
-				isSkipped:            opt.IsSkipped,
-				isCancelled:          opt.IsCancelled,
-				workflowRunId:        opt.WorkflowRunId,
-				olapDagId:            opt.OlapDagId,
-				olapDagInsertedAt:    opt.OlapDagInsertedAt,
+				workflowVersionId:       workflowVersion.WorkflowVersionId,
+				workflowId:              workflowVersion.WorkflowId,
+				workflowName:            workflowVersion.WorkflowName,
+				isPaused:                workflowVersion.WorkflowIsPaused.Bool,
+				externalId:              opt.ExternalId,
+				input:                   opt.Data,
+				additionalMetadata:      opt.AdditionalMetadata,
+				desiredWorkerId:         opt.DesiredWorkerId,
+				parentExternalId:        opt.ParentExternalId,
+				parentTaskId:            opt.ParentTaskId,
+				parentTaskInsertedAt:    opt.ParentTaskInsertedAt,
+				childIndex:              opt.ChildIndex,
+				childKey:                opt.ChildKey,
+				priority:                opt.Priority,
+				desiredWorkerLabels:     opt.DesiredWorkerLabels,
+				idempotency:             idempotency,
+				dagParentTaskRunIds:     opt.DagParentTaskRunIds,
+				dagMapParentReadableIds: opt.DagMapParentReadableIds,
+				targetActionId:          opt.TargetActionId,
+				parentOutputs:           opt.ParentOutputs,
+				isSkipped:               opt.IsSkipped,
+				isCancelled:             opt.IsCancelled,
+				workflowRunId:           opt.WorkflowRunId,
+				olapDagId:               opt.OlapDagId,
+				olapDagInsertedAt:       opt.OlapDagInsertedAt,
 			})
 		}
 	}
@@ -2903,6 +2918,7 @@ func (r *sharedRepository) NewTriggerTaskData(
 				key,
 				label.StrValue,
 				label.IntValue,
+				label.StrValues,
 				label.Required,
 				label.Weight,
 				comparator,
@@ -6,6 +6,7 @@ import (
 	"testing"
 
 	"github.com/google/uuid"
+	"github.com/rs/zerolog"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -93,3 +94,33 @@ func Test_ensureTraceparent_inherits_when_present(t *testing.T) {
 	assert.Nil(t, m["hatchet__traceparent_parent_span_id"],
 		"no separate parent span_id key stored")
 }
+
+func Test_newTaskInput_parentOutputs(t *testing.T) {
+	logger := zerolog.Nop()
+
+	r := &sharedRepository{
+		l: &logger,
+	}
+
+	input := r.newTaskInput(
+		[]byte(`{"user_id":"123"}`),
+		newParentOutputsMatchData(map[string][]byte{
+			"fetch":  []byte(`{"rows":2}`),
+			"enrich": []byte(`{"ok":true}`),
+		}),
+		nil,
+		nil,
+		nil,
+	)
+
+	data := r.ToV1StepRunData(input)
+
+	assert.Equal(t, map[string]interface{}{"user_id": "123"}, data.Input)
+	assert.Equal(t, map[string]map[string]interface{}{
+		"fetch":  {"rows": float64(2)},
+		"enrich": {"ok": true},
+	}, data.Parents)
+	assert.Empty(t, data.StepRunErrors)
+
+	assert.Nil(t, newParentOutputsMatchData(nil))
+}
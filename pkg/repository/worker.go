@@ -45,6 +45,9 @@ type CreateWorkerOpts struct {
 
 	// (optional) Runtime info for the worker
 	RuntimeInfo *RuntimeInfo `validate:"omitempty"`
+
+	// (optional) Autoscaling targets for the worker's pool
+	ScalingTargets *WorkerScalingTargets `validate:"omitempty"`
 }
 
 type UpdateWorkerOpts struct {
@@ -141,10 +144,23 @@ type WorkerRepository interface {
 
 	GetWorkerForEngine(ctx context.Context, tenantId uuid.UUID, workerId uuid.UUID) (*sqlcv1.GetWorkerForEngineRow, error)
 
+	// ListScalerWorkers returns the active workers of a tenant with their default slot usage and
+	// registered scaling targets.
+	ListScalerWorkers(ctx context.Context, tenantId uuid.UUID) ([]*ScalerWorker, error)
+
+	// ListScalerQueueStats returns aggregate stats on the queued tasks of a tenant, optionally
+	// restricted to a set of actions.
+	ListScalerQueueStats(ctx context.Context, tenantId uuid.UUID, opts ListScalerQueueStatsOpts) ([]*ScalerQueueStat, error)
+
 	UpdateWorkerActiveStatus(ctx context.Context, tenantId uuid.UUID, workerId uuid.UUID, isActive bool, timestamp time.Time) (*sqlcv1.Worker, error)
 
 	UpsertWorkerLabels(ctx context.Context, workerId uuid.UUID, opts []UpsertWorkerLabelOpts) ([]*sqlcv1.WorkerLabel, error)
 
+	// ReplaceDynamicWorkerLabels replaces the dynamic labels of a worker, which are reported on its
+	// heartbeats. Labels are only written when their value changed, and dynamic labels which aren't
+	// in opts are removed. It returns true if any label changed.
+	ReplaceDynamicWorkerLabels(ctx context.Context, workerId uuid.UUID, opts []UpsertWorkerLabelOpts) (bool, error)
+
 	CleanupOldWorkers(ctx context.Context, tenantId uuid.UUID, lastHeartbeatBefore time.Time) (bool, error)
 
 	GetDispatcherIdsForWorkers(ctx context.Context, tenantId uuid.UUID, workerIds []uuid.UUID) (map[uuid.UUID]uuid.UUID, map[uuid.UUID]struct{}, error)
@@ -689,6 +705,14 @@ func (w *workerRepository) CreateNewWorker(ctx context.Context, tenantId uuid.UU
 		return nil, fmt.Errorf("could not link actions to worker: %w", err)
 	}
 
+	if opts.ScalingTargets != nil {
+		err = w.upsertWorkerScalingTarget(ctx, tx, tenantId, worker.ID, opts.ScalingTargets)
+
+		if err != nil {
+			return nil, fmt.Errorf("could not upsert worker scaling target: %w", err)
+		}
+	}
+
 	err = tx.Commit(ctx)
 
 	if err != nil {
@@ -891,6 +915,100 @@ func (w *workerRepository) UpsertWorkerLabels(ctx context.Context, workerId uuid
 	return affinities, nil
 }
 
+func (w *workerRepository) ReplaceDynamicWorkerLabels(ctx context.Context, workerId uuid.UUID, opts []UpsertWorkerLabelOpts) (bool, error) {
+	ctx, span := telemetry.NewSpan(ctx, "replace-dynamic-worker-labels")
+	defer span.End()
+
+	tx, commit, rollback, err := sqlchelpers.PrepareTx(ctx, w.pool, w.l)
+
+	if err != nil {
+		return false, fmt.Errorf("could not prepare tx: %w", err)
+	}
+
+	defer rollback()
+
+	current, err := w.queries.ListManyWorkerLabels(ctx, tx, []uuid.UUID{workerId})
+
+	if err != nil {
+		return false, fmt.Errorf("could not list worker labels: %w", err)
+	}
+
+	currentByKey := make(map[string]*sqlcv1.ListManyWorkerLabelsRow, len(current))
+
+	for _, label := range current {
+		currentByKey[label.Key] = label
+	}
+
+	reported := make(map[string]bool, len(opts))
+	changed := false
+
+	for _, opt := range opts {
+		reported[opt.Key] = true
+
+		if existing, ok := currentByKey[opt.Key]; ok && existing.IsDynamic && labelValueEquals(existing, opt) {
+			continue
+		}
+
+		// a dynamic label with the same key as a static label replaces it
+		_, err := w.queries.UpsertWorkerLabel(ctx, tx, sqlcv1.UpsertWorkerLabelParams{
+			Workerid:  workerId,
+			Key:       opt.Key,
+			IntValue:  sqlchelpers.ToInt(opt.IntValue),
+			StrValue:  sqlchelpers.TextFromMaybeStr(opt.StrValue),
+			Isdynamic: true,
+		})
+
+		if err != nil {
+			return false, fmt.Errorf("could not upsert dynamic worker label %s: %w", opt.Key, err)
+		}
+
+		changed = true
+	}
+
+	removed := make([]string, 0)
+
+	for _, label := range current {
+		if label.IsDynamic && !reported[label.Key] {
+			removed = append(removed, label.Key)
+		}
+	}
+
+	if len(removed) > 0 {
+		err = w.queries.DeleteDynamicWorkerLabels(ctx, tx, sqlcv1.DeleteDynamicWorkerLabelsParams{
+			Workerid: workerId,
+			Keys:     removed,
+		})
+
+		if err != nil {
+			return false, fmt.Errorf("could not delete dynamic worker labels: %w", err)
+		}
+
+		changed = true
+	}
+
+	if !changed {
+		return false, nil
+	}
+
+	if err := commit(ctx); err != nil {
+		return false, fmt.Errorf("could not commit tx: %w", err)
+	}
+
+	return true, nil
+}
+
+func labelValueEquals(label *sqlcv1.ListManyWorkerLabelsRow, opt UpsertWorkerLabelOpts) bool {
+	if label.IntValue.Valid != (opt.IntValue != nil) || label.StrValue.Valid != (opt.StrValue != nil) {
+		return false
+	}
+
+	if opt.IntValue != nil && label.IntValue.Int32 != *opt.IntValue {
+		return false
+	}
+
+	return opt.StrValue == nil || label.StrValue.String == *opt.StrValue
+}
+
 func (w *workerRepository) CleanupOldWorkers(ctx context.Context, tenantId uuid.UUID, lastHeartbeatBefore time.Time) (bool, error) {
 	const timeout = 1000 * 60 * 3 // 3 minutes
 	const batchSize int32 = 10000
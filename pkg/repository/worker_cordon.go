@@ -0,0 +1,352 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"github.com/hatchet-dev/hatchet/pkg/repository/sqlchelpers"
+	"github.com/hatchet-dev/hatchet/pkg/repository/sqlcv1"
+	"github.com/hatchet-dev/hatchet/pkg/telemetry"
+)
+
+// ErrEmptyWorkerSelector is returned when a worker selector sets neither worker ids nor labels, as
+// it would otherwise select every worker of the tenant.
+var ErrEmptyWorkerSelector = errors.New("worker selector must set worker ids or labels")
+
+// WorkerSelector selects the workers of a tenant by id and labels. A worker is selected if it has
+// one of the worker ids, when any are set, and every label.
+type WorkerSelector struct {
+	WorkerIds []uuid.UUID
+
+	Labels map[string]string
+}
+
+func (s WorkerSelector) isEmpty() bool {
+	return len(s.WorkerIds) == 0 && len(s.Labels) == 0
+}
+
+type DrainWorkersOpts struct {
+	Reason *string
+
+	// Deadline is the time after which the tasks still running on the workers are moved off them.
+	Deadline time.Time
+
+	// Action determines what happens to the non-durable tasks still running at the deadline.
+	Action sqlcv1.V1WorkerDrainAction `validate:"oneof=CANCEL REQUEUE"`
+}
+
+// RequeuedTask is a task attempt which was released from a draining worker and requeued as a new
+// attempt, which doesn't count against its retries.
+type RequeuedTask struct {
+	*sqlcv1.ReleaseTasksRow
+
+	// NewRetryCount is the retry count of the requeued attempt.
+	NewRetryCount int32
+}
+
+type WorkerCordonRepository interface {
+	// CordonWorkers cordons the workers matching the selector, so no new tasks are assigned to them.
+	// Tasks already running on them are unaffected.
+	CordonWorkers(ctx context.Context, tenantId uuid.UUID, selector WorkerSelector, reason *string) ([]*sqlcv1.V1WorkerCordon, error)
+
+	// DrainWorkers cordons the workers matching the selector and moves the tasks still running on
+	// them off the workers once the deadline passes.
+	DrainWorkers(ctx context.Context, tenantId uuid.UUID, selector WorkerSelector, opts DrainWorkersOpts) ([]*sqlcv1.V1WorkerCordon, error)
+
+	// UncordonWorkers uncordons the workers matching the selector, which stops any drain. It returns
+	// the ids of the workers which were cordoned.
+	UncordonWorkers(ctx context.Context, tenantId uuid.UUID, selector WorkerSelector) ([]uuid.UUID, error)
+
+	// ListWorkerCordons lists the cordoned workers of the tenant, restricted to the given workers
+	// if any are given.
+	ListWorkerCordons(ctx context.Context, tenantId uuid.UUID, workerIds []uuid.UUID) ([]*sqlcv1.ListWorkerCordonsRow, error)
+
+	// ListWorkersToDrain lists the draining workers of the tenant which haven't been drained yet.
+	ListWorkersToDrain(ctx context.Context, tenantId uuid.UUID) ([]*sqlcv1.ListWorkersToDrainRow, error)
+
+	// ListRunningTasksOnWorker lists up to limit tasks running on a worker.
+	ListRunningTasksOnWorker(ctx context.Context, tenantId, workerId uuid.UUID, limit int32) ([]*sqlcv1.ListRunningTasksOnWorkerRow, error)
+
+	// MarkWorkersDrained records that the workers have no tasks left running.
+	MarkWorkersDrained(ctx context.Context, tenantId uuid.UUID, workerIds []uuid.UUID) error
+
+	// RequeueTasks releases the tasks which are still running the given attempts and requeues them
+	// as new attempts, without consuming a retry.
+	RequeueTasks(ctx context.Context, tenantId uuid.UUID, tasks []TaskIdInsertedAtRetryCount) ([]*RequeuedTask, error)
+}
+
+type workerCordonRepository struct {
+	*sharedRepository
+}
+
+func newWorkerCordonRepository(shared *sharedRepository) WorkerCordonRepository {
+	return &workerCordonRepository{
+		sharedRepository: shared,
+	}
+}
+
+func (r *workerCordonRepository) selectWorkers(ctx context.Context, tx sqlcv1.DBTX, tenantId uuid.UUID, selector WorkerSelector) ([]uuid.UUID, error) {
+	if selector.isEmpty() {
+		return nil, ErrEmptyWorkerSelector
+	}
+
+	labelKeys := make([]string, 0, len(selector.Labels))
+
+	for key := range selector.Labels {
+		labelKeys = append(labelKeys, key)
+	}
+
+	sort.Strings(labelKeys)
+
+	labelValues := make([]string, len(labelKeys))
+
+	for i, key := range labelKeys {
+		labelValues[i] = selector.Labels[key]
+	}
+
+	workerIds := selector.WorkerIds
+
+	if workerIds == nil {
+		workerIds = []uuid.UUID{}
+	}
+
+	ids, err := r.queries.ListWorkersMatchingSelector(ctx, tx, sqlcv1.ListWorkersMatchingSelectorParams{
+		Tenantid:    tenantId,
+		Workerids:   workerIds,
+		Labelkeys:   labelKeys,
+		Labelvalues: labelValues,
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to list workers matching selector: %w", err)
+	}
+
+	return ids, nil
+}
+
+func (r *workerCordonRepository) CordonWorkers(ctx context.Context, tenantId uuid.UUID, selector WorkerSelector, reason *string) ([]*sqlcv1.V1WorkerCordon, error) {
+	ctx, span := telemetry.NewSpan(ctx, "cordon-workers")
+	defer span.End()
+
+	workerIds, err := r.selectWorkers(ctx, r.pool, tenantId, selector)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(workerIds) == 0 {
+		return []*sqlcv1.V1WorkerCordon{}, nil
+	}
+
+	params := sqlcv1.CordonWorkersParams{
+		Tenantid:  tenantId,
+		Workerids: workerIds,
+	}
+
+	if reason != nil {
+		params.Reason = sqlchelpers.TextFromStr(*reason)
+	}
+
+	cordons, err := r.queries.CordonWorkers(ctx, r.pool, params)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to cordon workers: %w", err)
+	}
+
+	return cordons, nil
+}
+
+func (r *workerCordonRepository) DrainWorkers(ctx context.Context, tenantId uuid.UUID, selector WorkerSelector, opts DrainWorkersOpts) ([]*sqlcv1.V1WorkerCordon, error) {
+	ctx, span := telemetry.NewSpan(ctx, "drain-workers")
+	defer span.End()
+
+	if err := r.v.Validate(opts); err != nil {
+		return nil, err
+	}
+
+	workerIds, err := r.selectWorkers(ctx, r.pool, tenantId, selector)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(workerIds) == 0 {
+		return []*sqlcv1.V1WorkerCordon{}, nil
+	}
+
+	params := sqlcv1.DrainWorkersParams{
+		Tenantid:      tenantId,
+		Workerids:     workerIds,
+		Draindeadline: sqlchelpers.TimestamptzFromTime(opts.Deadline),
+		Drainaction:   opts.Action,
+	}
+
+	if opts.Reason != nil {
+		params.Reason = sqlchelpers.TextFromStr(*opts.Reason)
+	}
+
+	cordons, err := r.queries.DrainWorkers(ctx, r.pool, params)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to drain workers: %w", err)
+	}
+
+	return cordons, nil
+}
+
+func (r *workerCordonRepository) UncordonWorkers(ctx context.Context, tenantId uuid.UUID, selector WorkerSelector) ([]uuid.UUID, error) {
+	ctx, span := telemetry.NewSpan(ctx, "uncordon-workers")
+	defer span.End()
+
+	workerIds, err := r.selectWorkers(ctx, r.pool, tenantId, selector)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(workerIds) == 0 {
+		return []uuid.UUID{}, nil
+	}
+
+	uncordoned, err := r.queries.UncordonWorkers(ctx, r.pool, sqlcv1.UncordonWorkersParams{
+		Tenantid:  tenantId,
+		Workerids: workerIds,
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to uncordon workers: %w", err)
+	}
+
+	return uncordoned, nil
+}
+
+func (r *workerCordonRepository) ListWorkerCordons(ctx context.Context, tenantId uuid.UUID, workerIds []uuid.UUID) ([]*sqlcv1.ListWorkerCordonsRow, error) {
+	return r.queries.ListWorkerCordons(ctx, r.pool, sqlcv1.ListWorkerCordonsParams{
+		Tenantid:  tenantId,
+		Workerids: workerIds,
+	})
+}
+
+func (r *workerCordonRepository) ListWorkersToDrain(ctx context.Context, tenantId uuid.UUID) ([]*sqlcv1.ListWorkersToDrainRow, error) {
+	return r.queries.ListWorkersToDrain(ctx, r.pool, tenantId)
+}
+
+func (r *workerCordonRepository) ListRunningTasksOnWorker(ctx context.Context, tenantId, workerId uuid.UUID, limit int32) ([]*sqlcv1.ListRunningTasksOnWorkerRow, error) {
+	return r.queries.ListRunningTasksOnWorker(ctx, r.pool, sqlcv1.ListRunningTasksOnWorkerParams{
+		Tenantid: tenantId,
+		Workerid: workerId,
+		Limit:    limit,
+	})
+}
+
+func (r *workerCordonRepository) MarkWorkersDrained(ctx context.Context, tenantId uuid.UUID, workerIds []uuid.UUID) error {
+	return r.queries.MarkWorkersDrained(ctx, r.pool, sqlcv1.MarkWorkersDrainedParams{
+		Tenantid:  tenantId,
+		Workerids: workerIds,
+	})
+}
+
+func (r *workerCordonRepository) RequeueTasks(ctx context.Context, tenantId uuid.UUID, tasks []TaskIdInsertedAtRetryCount) ([]*RequeuedTask, error) {
+	ctx, span := telemetry.NewSpan(ctx, "requeue-tasks")
+	defer span.End()
+
+	if len(tasks) == 0 {
+		return []*RequeuedTask{}, nil
+	}
+
+	tx, commit, rollback, err := sqlchelpers.PrepareTx(ctx, r.pool, r.l)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to prepare tx: %w", err)
+	}
+
+	defer rollback()
+
+	taskIds := make([]int64, len(tasks))
+	taskInsertedAts := make([]pgtype.Timestamptz, len(tasks))
+	taskRetryCounts := make([]int32, len(tasks))
+
+	for i, task := range tasks {
+		taskIds[i] = task.Id
+		taskInsertedAts[i] = task.InsertedAt
+		taskRetryCounts[i] = task.RetryCount
+	}
+
+	// the tasks may have finished since they were listed, so only the tasks which are still running
+	// the listed attempt are requeued. This starts the new attempt the same way preemption does.
+	requeued, err := r.queries.PreemptTasks(ctx, tx, sqlcv1.PreemptTasksParams{
+		Taskids:         taskIds,
+		Taskinsertedats: taskInsertedAts,
+		Taskretrycounts: taskRetryCounts,
+		Tenantid:        tenantId,
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to requeue tasks: %w", err)
+	}
+
+	if len(requeued) == 0 {
+		return []*RequeuedTask{}, nil
+	}
+
+	toRelease := make([]TaskIdInsertedAtRetryCount, len(requeued))
+	requeuedIds := make([]int64, len(requeued))
+	requeuedInsertedAts := make([]pgtype.Timestamptz, len(requeued))
+	newRetryCounts := make([]int32, len(requeued))
+	priorities := make([]int32, len(requeued))
+
+	for i, task := range requeued {
+		toRelease[i] = TaskIdInsertedAtRetryCount{
+			Id:         task.ID,
+			InsertedAt: task.InsertedAt,
+			RetryCount: task.RetryCount - 1,
+		}
+
+		requeuedIds[i] = task.ID
+		requeuedInsertedAts[i] = task.InsertedAt
+		newRetryCounts[i] = task.RetryCount
+		priorities[i] = task.Priority
+	}
+
+	// as for preempted tasks, the new attempt keeps the priority the task was triggered with
+	// instead of being queued ahead of every other task like a retry
+	err = r.queries.ResetPreemptedTaskPriorities(ctx, tx, sqlcv1.ResetPreemptedTaskPrioritiesParams{
+		Taskids:         requeuedIds,
+		Taskinsertedats: requeuedInsertedAts,
+		Taskretrycounts: newRetryCounts,
+		Priorities:      priorities,
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to reset priorities of requeued tasks: %w", err)
+	}
+
+	// NOTE: the new attempt must be written before the previous attempt is released, as the
+	// concurrency slot triggers case on it.
+	released, err := r.releaseTasks(ctx, tx, tenantId, toRelease)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if err := commit(ctx); err != nil {
+		return nil, fmt.Errorf("failed to commit tx: %w", err)
+	}
+
+	res := make([]*RequeuedTask, len(released))
+
+	for i, task := range released {
+		res[i] = &RequeuedTask{
+			ReleaseTasksRow: task,
+			NewRetryCount:   newRetryCounts[i],
+		}
+	}
+
+	return res, nil
+}
@@ -0,0 +1,232 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/hatchet-dev/hatchet/pkg/repository/sqlchelpers"
+	"github.com/hatchet-dev/hatchet/pkg/repository/sqlcv1"
+	"github.com/hatchet-dev/hatchet/pkg/telemetry"
+)
+
+// WorkerScalingTargets are the autoscaling targets a worker declares for its pool when it registers.
+type WorkerScalingTargets struct {
+	// (optional) the number of default slots a single replica of the pool should serve
+	SlotsPerReplica *int32 `validate:"omitempty,gt=0"`
+
+	// (optional) the longest a task should wait in the queue before the pool scales up
+	MaxQueueAge *time.Duration `validate:"omitempty,gt=0"`
+}
+
+// ScalerWorker is an active worker as seen by the autoscaler.
+type ScalerWorker struct {
+	ID uuid.UUID
+
+	Actions []string
+
+	Labels []*sqlcv1.ListManyWorkerLabelsRow
+
+	// the number of default slots of the worker
+	Slots int32
+
+	// the number of default slots in use on the worker
+	UsedSlots int32
+
+	// the scaling targets the worker registered with, if any
+	SlotsPerReplica *int32
+	MaxQueueAge     *time.Duration
+}
+
+type ListScalerQueueStatsOpts struct {
+	// (optional) the actions to list queued tasks for, defaults to all actions
+	Actions []string
+
+	// queued tasks whose schedule timeout is before this time are counted as near their schedule
+	// timeout
+	ScheduleTimeoutBefore time.Time
+}
+
+// ScalerQueueStat aggregates the queued tasks of a step which share the same desired worker labels.
+type ScalerQueueStat struct {
+	ActionID string
+
+	StepID uuid.UUID
+
+	// the desired worker labels of the tasks, from the trigger if it set them and from the step
+	// otherwise
+	DesiredLabels []*sqlcv1.GetDesiredLabelsRow
+
+	// the number of queued tasks
+	Queued int32
+
+	// the number of default slot units the queued tasks need
+	QueuedUnits int32
+
+	// the insertion time of the oldest queued task
+	OldestTaskInsertedAt time.Time
+
+	// the number of queued tasks which are close to their schedule timeout
+	NearScheduleTimeout int32
+}
+
+func (w *workerRepository) ListScalerWorkers(ctx context.Context, tenantId uuid.UUID) ([]*ScalerWorker, error) {
+	ctx, span := telemetry.NewSpan(ctx, "list-scaler-workers")
+	defer span.End()
+
+	rows, err := w.queries.ListScalerWorkers(ctx, w.pool, sqlcv1.ListScalerWorkersParams{
+		Slottype: SlotTypeDefault,
+		Tenantid: tenantId,
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("could not list scaler workers: %w", err)
+	}
+
+	if len(rows) == 0 {
+		return nil, nil
+	}
+
+	workerIds := make([]uuid.UUID, 0, len(rows))
+
+	for _, row := range rows {
+		workerIds = append(workerIds, row.ID)
+	}
+
+	labels, err := w.queries.ListManyWorkerLabels(ctx, w.pool, workerIds)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not list worker labels: %w", err)
+	}
+
+	workerIdsToLabels := make(map[uuid.UUID][]*sqlcv1.ListManyWorkerLabelsRow, len(rows))
+
+	for _, label := range labels {
+		workerIdsToLabels[label.WorkerId] = append(workerIdsToLabels[label.WorkerId], label)
+	}
+
+	available, err := w.ListAvailableSlotsForWorkers(ctx, tenantId, workerIds, SlotTypeDefault)
+
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]*ScalerWorker, 0, len(rows))
+
+	for _, row := range rows {
+		worker := &ScalerWorker{
+			ID:      row.ID,
+			Actions: row.Actions,
+			Labels:  workerIdsToLabels[row.ID],
+			Slots:   row.MaxUnits,
+		}
+
+		if availableSlots, ok := available[row.ID]; ok {
+			worker.UsedSlots = max(row.MaxUnits-availableSlots, 0)
+		}
+
+		if row.SlotsPerReplica.Valid {
+			slotsPerReplica := row.SlotsPerReplica.Int32
+			worker.SlotsPerReplica = &slotsPerReplica
+		}
+
+		if row.MaxQueueAgeSeconds.Valid {
+			maxQueueAge := time.Duration(row.MaxQueueAgeSeconds.Int32) * time.Second
+			worker.MaxQueueAge = &maxQueueAge
+		}
+
+		res = append(res, worker)
+	}
+
+	return res, nil
+}
+
+func (w *workerRepository) ListScalerQueueStats(ctx context.Context, tenantId uuid.UUID, opts ListScalerQueueStatsOpts) ([]*ScalerQueueStat, error) {
+	ctx, span := telemetry.NewSpan(ctx, "list-scaler-queue-stats")
+	defer span.End()
+
+	params := sqlcv1.ListScalerQueueStatsParams{
+		Scheduletimeoutbefore: sqlchelpers.TimestamptzFromTime(opts.ScheduleTimeoutBefore),
+		Slottype:              SlotTypeDefault,
+		Tenantid:              tenantId,
+	}
+
+	if len(opts.Actions) > 0 {
+		params.ActionIds = opts.Actions
+	}
+
+	rows, err := w.queries.ListScalerQueueStats(ctx, w.pool, params)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not list scaler queue stats: %w", err)
+	}
+
+	if len(rows) == 0 {
+		return nil, nil
+	}
+
+	stepIds := make([]uuid.UUID, 0, len(rows))
+
+	for _, row := range rows {
+		stepIds = append(stepIds, row.StepID)
+	}
+
+	stepLabels, err := w.queries.GetDesiredLabels(ctx, w.pool, stepIds)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not get desired labels: %w", err)
+	}
+
+	stepIdsToLabels := make(map[uuid.UUID][]*sqlcv1.GetDesiredLabelsRow)
+
+	for _, label := range stepLabels {
+		stepIdsToLabels[label.StepId] = append(stepIdsToLabels[label.StepId], label)
+	}
+
+	res := make([]*ScalerQueueStat, 0, len(rows))
+
+	for _, row := range rows {
+		stat := &ScalerQueueStat{
+			ActionID:             row.ActionID,
+			StepID:               row.StepID,
+			DesiredLabels:        stepIdsToLabels[row.StepID],
+			Queued:               row.Queued,
+			QueuedUnits:          row.QueuedUnits,
+			OldestTaskInsertedAt: row.OldestTaskInsertedAt.Time,
+			NearScheduleTimeout:  row.NearScheduleTimeout,
+		}
+
+		// labels set on the trigger replace the step's labels, as in the scheduler
+		if len(row.DesiredWorkerLabel) > 0 {
+			var desiredLabels []*sqlcv1.GetDesiredLabelsRow
+
+			if err := json.Unmarshal(row.DesiredWorkerLabel, &desiredLabels); err != nil {
+				w.l.Error().Ctx(ctx).Err(err).Str("step_id", row.StepID.String()).Msg("error unmarshalling desired worker labels for queued tasks")
+			} else {
+				stat.DesiredLabels = desiredLabels
+			}
+		}
+
+		res = append(res, stat)
+	}
+
+	return res, nil
+}
+
+func (w *workerRepository) upsertWorkerScalingTarget(ctx context.Context, tx sqlcv1.DBTX, tenantId, workerId uuid.UUID, targets *WorkerScalingTargets) error {
+	params := sqlcv1.UpsertWorkerScalingTargetParams{
+		Tenantid:        tenantId,
+		Workerid:        workerId,
+		SlotsPerReplica: sqlchelpers.ToInt(targets.SlotsPerReplica),
+	}
+
+	if targets.MaxQueueAge != nil {
+		seconds := int32(targets.MaxQueueAge.Seconds()) // nolint: gosec
+		params.MaxQueueAgeSeconds = sqlchelpers.ToInt(&seconds)
+	}
+
+	return w.queries.UpsertWorkerScalingTarget(ctx, tx, params)
+}
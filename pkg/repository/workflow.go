@@ -8,7 +8,9 @@ import (
 	"slices"
 	"sort"
 	"strings"
+	"time"
 
+	"github.com/Masterminds/semver/v3"
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgtype"
@@ -63,6 +65,9 @@ type CreateWorkflowVersionOpts struct {
 
 	DefaultPriority *int32 `validate:"omitempty,min=1,max=3"`
 
+	// (optional) raises the priority of the workflow's queued tasks the longer they wait
+	PriorityAging *PriorityAgingOpts `json:"priorityAging,omitempty" validate:"omitnil"`
+
 	DefaultFilters []types.DefaultFilter `json:"defaultFilters,omitempty" validate:"omitempty,dive"`
 
 	InputJsonSchema []byte `json:"inputJsonSchema,omitempty"`
@@ -87,6 +92,10 @@ type CreateConcurrencyOpts struct {
 	Expression string `validate:"celworkflowrunstr"`
 }
 
+// DefaultRetryBackoffMaxSeconds is the maximum backoff between retries when a backoff factor is set
+// without a maximum.
+const DefaultRetryBackoffMaxSeconds = 24 * 60 * 60
+
 type CreateStepOpts struct {
 	// (required) the task name
 	ReadableId string `validate:"hatchetName"`
@@ -135,6 +144,94 @@ type CreateStepOpts struct {
 
 	// (optional) batch execution configuration
 	BatchConfig *StepBatchConfig `json:"batchConfig,omitempty"`
+
+	// (optional) retry policies, evaluated in order when the task fails
+	RetryPolicies []CreateRetryPolicyOpts `json:"retryPolicies,omitempty" validate:"omitempty,dive"`
+
+	// (optional) a circuit breaker which holds the step's tasks in the queue after repeated failures
+	CircuitBreaker *CreateCircuitBreakerOpts `json:"circuitBreaker,omitempty" validate:"omitnil"`
+
+	// (optional) lets the scheduler preempt the step's running tasks for higher-priority tasks
+	Preemption *CreatePreemptionOpts `json:"preemption,omitempty" validate:"omitnil"`
+
+	// (optional) fans the step out into one task per element of a list, only supported in DAGs
+	Map *CreateStepMapOpts `json:"map,omitempty" validate:"omitnil"`
+
+	// (optional) makes the step a human approval, which is run by the approval operator instead
+	// of a worker
+	Approval *CreateStepApprovalOpts `json:"approval,omitempty" validate:"omitnil"`
+}
+
+// CreateStepApprovalOpts configures an approval step. When the step runs, a pending approval is
+// recorded and the task completes once an approver approves or rejects it.
+type CreateStepApprovalOpts struct {
+	// (optional) the emails of the approvers, defaults to any tenant member
+	Approvers []string `json:"approvers,omitempty" validate:"omitempty,dive,email"`
+
+	// (optional) dot-separated paths into the task payload which are shown to approvers, such as
+	// "input.amount" or "parents.review.total"
+	ContextFields []string `json:"contextFields,omitempty" validate:"omitempty,dive,required"`
+
+	// (optional) how long the approval stays pending, defaults to 24h
+	ExpiresIn *string `json:"expiresIn,omitempty" validate:"omitnil,duration"`
+
+	// (optional) what happens when the approval expires, defaults to FAIL
+	OnExpiry *string `json:"onExpiry,omitempty" validate:"omitnil,oneof=FAIL REJECT APPROVE"`
+}
+
+// CreateStepMapOpts configures a map step. The expression is evaluated by the DAG operator once the
+// step's parents complete, and one task is triggered per element of the returned list.
+type CreateStepMapOpts struct {
+	// (required) a CEL expression on `input` and `parents` which returns a list
+	Expression string `json:"expression" validate:"required,celsteprunlist"`
+
+	// (optional) the maximum number of tasks in progress at once, defaults to 10
+	MaxParallelism *int32 `json:"maxParallelism,omitempty" validate:"omitnil,min=1,max=1000"`
+}
+
+// CreateCircuitBreakerOpts configures a circuit breaker for a step. Breakers are shared by every
+// step in the tenant which resolves to the same key.
+type CreateCircuitBreakerOpts struct {
+	// (optional) a CEL expression for the breaker key, defaults to the step's action id
+	KeyExpr *string `json:"keyExpr,omitempty" validate:"omitnil,celsteprunstr"`
+
+	// (required) the number of failures within the window which opens the breaker
+	FailureThreshold int32 `json:"failureThreshold" validate:"required,min=1,max=100000"`
+
+	// (optional) the window in which failures are counted, defaults to 60s
+	Window *string `json:"window,omitempty" validate:"omitnil,duration"`
+
+	// (optional) how long the breaker stays open before letting a probe through, defaults to 30s
+	Cooldown *string `json:"cooldown,omitempty" validate:"omitnil,duration"`
+}
+
+// CreatePreemptionOpts opts a step into preemption. A running task of the step may be cancelled
+// and requeued when a higher-priority task for the same action has been waiting for a slot.
+type CreatePreemptionOpts struct {
+	// (optional) the number of times a single task may be preempted, defaults to 3
+	MaxPreemptions *int32 `json:"maxPreemptions,omitempty" validate:"omitnil,min=1,max=100"`
+}
+
+// CreateRetryPolicyOpts overrides the retries and backoff of a step for failures which match the
+// error class and expression. A policy without an error class or expression matches every failure.
+type CreateRetryPolicyOpts struct {
+	// (optional) the error class reported by the worker
+	ErrorClass *string `json:"errorClass,omitempty" validate:"omitnil,min=1"`
+
+	// (optional) a CEL expression on the error, which must evaluate to a boolean
+	Expression *string `json:"expression,omitempty" validate:"omitnil,celerrorexpr"`
+
+	// (optional) the retry max for matching failures
+	Retries *int32 `json:"retries,omitempty" validate:"omitnil,min=0"`
+
+	// (optional) the retry backoff factor for matching failures
+	BackoffFactor *float64 `json:"backoffFactor,omitempty" validate:"omitnil,min=1,max=1000"`
+
+	// (optional) the retry backoff max seconds for matching failures
+	BackoffMaxSeconds *int32 `json:"backoffMaxSeconds,omitempty" validate:"omitnil,min=1,max=86400"`
+
+	// (optional) whether matching failures should never be retried
+	NeverRetry bool `json:"neverRetry,omitempty"`
 }
 
 type StepBatchConfig struct {
@@ -161,11 +258,15 @@ type DesiredWorkerLabelOpts struct {
 	// (required) the label key
 	Key string `validate:"required"`
 
-	// (required if StringValue is nil) the label integer value
-	IntValue *int32 `validate:"omitnil,required_without=StrValue"`
+	// (optional) the label integer value, for the EQUAL, NOT_EQUAL and numeric comparators
+	IntValue *int32 `validate:"omitnil"`
 
-	// (required if StrValue is nil) the label string value
-	StrValue *string `validate:"omitnil,required_without=IntValue"`
+	// (optional) the label string value, for the EQUAL and NOT_EQUAL comparators, or the version
+	// constraint for the SEMVER comparator
+	StrValue *string `validate:"omitnil"`
+
+	// (optional) the set of values for the IN and NOT_IN comparators
+	StrValues []string `validate:"omitempty"`
 
 	// (optional) if the label is required
 	Required *bool `validate:"omitempty"`
@@ -174,7 +275,75 @@ type DesiredWorkerLabelOpts struct {
 	Weight *int32 `validate:"omitempty"`
 
 	// (optional) the label comparator for scheduling (default: EQUAL)
-	Comparator *string `validate:"omitempty,oneof=EQUAL NOT_EQUAL GREATER_THAN LESS_THAN GREATER_THAN_OR_EQUAL LESS_THAN_OR_EQUAL"`
+	Comparator *string `validate:"omitempty,oneof=EQUAL NOT_EQUAL GREATER_THAN LESS_THAN GREATER_THAN_OR_EQUAL LESS_THAN_OR_EQUAL IN NOT_IN EXISTS NOT_EXISTS SEMVER NOT_COLOCATED BEST_FIT"`
+}
+
+// ErrInvalidDesiredWorkerLabel is returned when a desired worker label doesn't have the value its
+// comparator needs.
+var ErrInvalidDesiredWorkerLabel = errors.New("invalid desired worker label")
+
+// validateDesiredWorkerLabel checks that a desired worker label has the value its comparator
+// compares against.
+func validateDesiredWorkerLabel(key string, label DesiredWorkerLabelOpts) error {
+	comparator := string(sqlcv1.WorkerLabelComparatorEQUAL)
+
+	if label.Comparator != nil {
+		comparator = *label.Comparator
+	}
+
+	switch sqlcv1.WorkerLabelComparator(comparator) {
+	case sqlcv1.WorkerLabelComparatorEQUAL, sqlcv1.WorkerLabelComparatorNOTEQUAL:
+		if label.StrValue == nil && label.IntValue == nil {
+			return fmt.Errorf("%w: %s needs a string or integer value for %s", ErrInvalidDesiredWorkerLabel, key, comparator)
+		}
+	case sqlcv1.WorkerLabelComparatorGREATERTHAN, sqlcv1.WorkerLabelComparatorLESSTHAN,
+		sqlcv1.WorkerLabelComparatorGREATERTHANOREQUAL, sqlcv1.WorkerLabelComparatorLESSTHANOREQUAL,
+		sqlcv1.WorkerLabelComparatorBESTFIT:
+		if label.IntValue == nil {
+			return fmt.Errorf("%w: %s needs an integer value for %s", ErrInvalidDesiredWorkerLabel, key, comparator)
+		}
+	case sqlcv1.WorkerLabelComparatorIN, sqlcv1.WorkerLabelComparatorNOTIN:
+		if len(label.StrValues) == 0 {
+			return fmt.Errorf("%w: %s needs a set of values for %s", ErrInvalidDesiredWorkerLabel, key, comparator)
+		}
+	case sqlcv1.WorkerLabelComparatorSEMVER:
+		if label.StrValue == nil {
+			return fmt.Errorf("%w: %s needs a version constraint for SEMVER", ErrInvalidDesiredWorkerLabel, key)
+		}
+
+		if _, err := semver.NewConstraint(*label.StrValue); err != nil {
+			return fmt.Errorf("%w: %s has an invalid version constraint %q: %w", ErrInvalidDesiredWorkerLabel, key, *label.StrValue, err)
+		}
+	}
+
+	return nil
+}
+
+// validateDesiredWorkerLabels checks the desired worker labels of each task of a workflow.
+func validateDesiredWorkerLabels(opts *CreateWorkflowVersionOpts) error {
+	tasks := opts.Tasks
+
+	if opts.OnFailure != nil {
+		tasks = append(slices.Clip(tasks), *opts.OnFailure)
+	}
+
+	for _, task := range tasks {
+		keys := make([]string, 0, len(task.DesiredWorkerLabels))
+
+		for key := range task.DesiredWorkerLabels {
+			keys = append(keys, key)
+		}
+
+		sort.Strings(keys)
+
+		for _, key := range keys {
+			if err := validateDesiredWorkerLabel(key, task.DesiredWorkerLabels[key]); err != nil {
+				return fmt.Errorf("task %s: %w", task.ReadableId, err)
+			}
+		}
+	}
+
+	return nil
 }
 
 type CreateWorkflowStepRateLimitOpts struct {
@@ -237,6 +406,7 @@ type WorkflowRepository interface {
 	GetWorkflowShape(ctx context.Context, workflowVersionId uuid.UUID) ([]*sqlcv1.GetWorkflowShapeRow, error)
 	ListStepsByWorkflowVersionId(ctx context.Context, tenantId uuid.UUID, workflowVersionId uuid.UUID) ([]*sqlcv1.ListStepsByWorkflowVersionIdsRow, error)
 	ListStepMatchConditions(ctx context.Context, tenantId uuid.UUID, stepIds []uuid.UUID) ([]*sqlcv1.V1StepMatchCondition, error)
+	ListStepMaps(ctx context.Context, tenantId uuid.UUID, stepIds []uuid.UUID) ([]*sqlcv1.V1StepMap, error)
 
 	// ListWorkflows returns all workflows for a given tenant.
 	ListWorkflows(tenantId uuid.UUID, opts *ListWorkflowsOpts) (*ListWorkflowsResult, error)
@@ -263,6 +433,9 @@ type WorkflowRepository interface {
 
 	GetLatestWorkflowVersion(ctx context.Context, tenantId uuid.UUID, workflowId uuid.UUID) (*sqlcv1.GetWorkflowVersionForEngineRow, error)
 
+	// ListWorkflowVersions returns every version of a workflow, oldest first.
+	ListWorkflowVersions(ctx context.Context, tenantId uuid.UUID, workflowId uuid.UUID) ([]*sqlcv1.WorkflowVersion, error)
+
 	PauseWorkflow(ctx context.Context, workflowId uuid.UUID, opts PauseWorkflowOpts) (*sqlcv1.Workflow, error)
 
 	UnpauseWorkflow(ctx context.Context, workflowId uuid.UUID) (*sqlcv1.Workflow, error)
@@ -311,10 +484,43 @@ func (r *workflowRepository) ListStepsByWorkflowVersionId(ctx context.Context, t
 	return steps[workflowVersionId], nil
 }
 
+func (r *workflowRepository) ListStepMaps(ctx context.Context, tenantId uuid.UUID, stepIds []uuid.UUID) ([]*sqlcv1.V1StepMap, error) {
+	return r.queries.ListStepMaps(ctx, r.pool, sqlcv1.ListStepMapsParams{
+		Stepids:  stepIds,
+		Tenantid: tenantId,
+	})
+}
+
 func (r *workflowRepository) ListStepMatchConditions(ctx context.Context, tenantId uuid.UUID, stepIds []uuid.UUID) ([]*sqlcv1.V1StepMatchCondition, error) {
 	return r.listStepMatchConditions(ctx, r.pool, tenantId, stepIds)
 }
 
+// DefaultMapMaxParallelism is the number of tasks of a map step in progress at once when the step
+// doesn't set its own limit.
+const DefaultMapMaxParallelism = 10
+
+// ErrMapStepRequiresDagOperator is returned when a workflow with a map step isn't orchestrated by
+// the DAG operator, which is what fans map steps out.
+var ErrMapStepRequiresDagOperator = errors.New("map tasks are only supported in DAG workflows orchestrated by the DAG operator")
+
+func approvalExpiresInOrDefault(opts *CreateStepApprovalOpts) (time.Duration, error) {
+	if opts.ExpiresIn == nil {
+		return DefaultApprovalExpiresIn, nil
+	}
+
+	expiresIn, err := time.ParseDuration(*opts.ExpiresIn)
+
+	if err != nil {
+		return 0, fmt.Errorf("invalid approval expiry %q: %w", *opts.ExpiresIn, err)
+	}
+
+	if expiresIn <= 0 {
+		return 0, fmt.Errorf("approval expiry must be positive")
+	}
+
+	return expiresIn, nil
+}
+
 type JobRunHasCycleError struct {
 	JobName string
 }
@@ -328,6 +534,10 @@ func (r *workflowRepository) PutWorkflowVersion(ctx context.Context, tenantId uu
 		return nil, err
 	}
 
+	if err := validateDesiredWorkerLabels(opts); err != nil {
+		return nil, err
+	}
+
 	if hasCycleV1(opts.Tasks) {
 		return nil, &JobRunHasCycleError{
 			JobName: opts.Name,
@@ -483,6 +693,19 @@ func (r *workflowRepository) createWorkflowVersionTxs(ctx context.Context, tx sq
 	// todo: maybe don't need `len` check here?
 	isUsingDagOperator := dagOperatorEnabled && len(opts.Tasks) > 1
 
+	// the on-failure task is never fanned out, since it isn't gated by the DAG's readiness
+	if opts.OnFailure != nil && opts.OnFailure.Map != nil {
+		return nil, ErrMapStepRequiresDagOperator
+	}
+
+	if !isUsingDagOperator {
+		for _, t := range opts.Tasks {
+			if t.Map != nil {
+				return nil, ErrMapStepRequiresDagOperator
+			}
+		}
+	}
+
 	if isUsingDagOperator {
 		var retentionPeriod *string
 
@@ -606,6 +829,10 @@ func (r *workflowRepository) createWorkflowVersionTxs(ctx context.Context, tx sq
 		return nil, err
 	}
 
+	if err := r.setWorkflowPriorityAging(ctx, tx, tenantId, workflowId, opts.PriorityAging); err != nil {
+		return nil, fmt.Errorf("could not set workflow priority aging: %w", err)
+	}
+
 	// create the onFailure job if exists
 	if opts.OnFailure != nil {
 		jobId, err := r.createJobTx(ctx, tx, tenantId, workflowId, sqlcWorkflowVersion.ID, sqlcv1.JobKindONFAILURE, []CreateStepOpts{*opts.OnFailure})
@@ -869,6 +1096,19 @@ func (r *workflowRepository) createJobTx(ctx context.Context, tx sqlcv1.DBTX, te
 			timeout = sqlchelpers.TextFromStr(*stepOpts.Timeout)
 		}
 
+		var approvalExpiresIn time.Duration
+
+		if stepOpts.Approval != nil {
+			approvalExpiresIn, err = approvalExpiresInOrDefault(stepOpts.Approval)
+
+			if err != nil {
+				return nil, err
+			}
+
+			// the task has to outlive the approval, so the expiry action runs instead of a timeout
+			timeout = sqlchelpers.TextFromStr((approvalExpiresIn + ApprovalTimeoutGrace).String())
+		}
+
 		if stepOpts.Retries != nil {
 			retries = pgtype.Int4{
 				Valid: true,
@@ -960,8 +1200,120 @@ func (r *workflowRepository) createJobTx(ctx context.Context, tx sqlcv1.DBTX, te
 			}
 		}
 
+		for i, policy := range stepOpts.RetryPolicies {
+			policyParams := sqlcv1.CreateStepRetryPolicyParams{
+				Stepid:      stepId,
+				Tenantid:    tenantId,
+				Policyindex: int32(i), // nolint: gosec
+				Neverretry:  policy.NeverRetry,
+			}
+
+			if policy.ErrorClass != nil {
+				policyParams.ErrorClass = sqlchelpers.TextFromStr(*policy.ErrorClass)
+			}
+
+			if policy.Expression != nil {
+				policyParams.Expression = sqlchelpers.TextFromStr(*policy.Expression)
+			}
+
+			if policy.Retries != nil {
+				policyParams.Retries = pgtype.Int4{Int32: *policy.Retries, Valid: true}
+			}
+
+			if policy.BackoffFactor != nil {
+				policyParams.BackoffFactor = pgtype.Float8{Float64: *policy.BackoffFactor, Valid: true}
+			}
+
+			if policy.BackoffMaxSeconds != nil {
+				policyParams.BackoffMaxSeconds = pgtype.Int4{Int32: *policy.BackoffMaxSeconds, Valid: true}
+			}
+
+			if err = r.queries.CreateStepRetryPolicy(ctx, tx, policyParams); err != nil {
+				return nil, err
+			}
+		}
+
+		if stepOpts.CircuitBreaker != nil {
+			if err = r.createStepCircuitBreaker(ctx, tx, tenantId, stepId, stepOpts.CircuitBreaker); err != nil {
+				return nil, fmt.Errorf("could not create step circuit breaker: %w", err)
+			}
+		}
+
+		if stepOpts.Preemption != nil {
+			maxPreemptions := int32(DefaultMaxPreemptions)
+
+			if stepOpts.Preemption.MaxPreemptions != nil {
+				maxPreemptions = *stepOpts.Preemption.MaxPreemptions
+			}
+
+			err = r.queries.CreateStepPreemption(ctx, tx, sqlcv1.CreateStepPreemptionParams{
+				Stepid:         stepId,
+				Tenantid:       tenantId,
+				Maxpreemptions: maxPreemptions,
+			})
+
+			if err != nil {
+				return nil, fmt.Errorf("could not create step preemption: %w", err)
+			}
+		}
+
+		if stepOpts.Map != nil {
+			maxParallelism := int32(DefaultMapMaxParallelism)
+
+			if stepOpts.Map.MaxParallelism != nil {
+				maxParallelism = *stepOpts.Map.MaxParallelism
+			}
+
+			err = r.queries.CreateStepMap(ctx, tx, sqlcv1.CreateStepMapParams{
+				Stepid:         stepId,
+				Tenantid:       tenantId,
+				Expression:     stepOpts.Map.Expression,
+				Maxparallelism: maxParallelism,
+			})
+
+			if err != nil {
+				return nil, fmt.Errorf("could not create step map: %w", err)
+			}
+		}
+
+		if stepOpts.Approval != nil {
+			onExpiry := sqlcv1.V1ApprovalExpiryActionFAIL
+
+			if stepOpts.Approval.OnExpiry != nil {
+				onExpiry = sqlcv1.V1ApprovalExpiryAction(*stepOpts.Approval.OnExpiry)
+			}
+
+			approvers := stepOpts.Approval.Approvers
+
+			if approvers == nil {
+				approvers = []string{}
+			}
+
+			contextFields := stepOpts.Approval.ContextFields
+
+			if contextFields == nil {
+				contextFields = []string{}
+			}
+
+			err = r.queries.CreateStepApproval(ctx, tx, sqlcv1.CreateStepApprovalParams{
+				Stepid:           stepId,
+				Tenantid:         tenantId,
+				Approvers:        approvers,
+				Contextfields:    contextFields,
+				Expiresinseconds: int32(approvalExpiresIn.Seconds()), // nolint: gosec
+				Onexpiry:         onExpiry,
+			})
+
+			if err != nil {
+				return nil, fmt.Errorf("could not create step approval: %w", err)
+			}
+		}
+
 		slotRequests := stepOpts.SlotRequests
-		if len(slotRequests) == 0 {
+		if stepOpts.Approval != nil {
+			// approval steps run on the approval operator, which only has durable slots
+			slotRequests = map[string]int32{SlotTypeDurable: 1}
+		} else if len(slotRequests) == 0 {
 			if stepOpts.IsDurable {
 				slotRequests = map[string]int32{SlotTypeDurable: 1}
 			} else {
@@ -1030,6 +1382,10 @@ func (r *workflowRepository) createJobTx(ctx context.Context, tx sqlcv1.DBTX, te
 					opts.StrValue = sqlchelpers.TextFromStr(*value.StrValue)
 				}
 
+				if len(value.StrValues) > 0 {
+					opts.StrValues = value.StrValues
+				}
+
 				if value.Weight != nil {
 					opts.Weight = sqlchelpers.ToInt(value.Weight)
 				}
@@ -1452,6 +1808,13 @@ func (r *workflowRepository) GetLatestWorkflowVersion(ctx context.Context, tenan
 	return versions[0], nil
 }
 
+func (r *workflowRepository) ListWorkflowVersions(ctx context.Context, tenantId uuid.UUID, workflowId uuid.UUID) ([]*sqlcv1.WorkflowVersion, error) {
+	return r.queries.ListWorkflowVersionsByWorkflowId(ctx, r.pool, sqlcv1.ListWorkflowVersionsByWorkflowIdParams{
+		Workflowid: workflowId,
+		Tenantid:   tenantId,
+	})
+}
+
 type WorkflowPauseScheduledCronRunQueueBehavior string
 
 const (
@@ -0,0 +1,76 @@
+package repository
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateDesiredWorkerLabel(t *testing.T) {
+	str := func(s string) *string { return &s }
+	num := func(i int32) *int32 { return &i }
+
+	valid := []DesiredWorkerLabelOpts{
+		{Key: "model", StrValue: str("llama")},
+		{Key: "memory", IntValue: num(8)},
+		{Key: "model", StrValue: str("llama"), Comparator: str("NOT_EQUAL")},
+		{Key: "memory", IntValue: num(8), Comparator: str("GREATER_THAN_OR_EQUAL")},
+		{Key: "memory", IntValue: num(8), Comparator: str("BEST_FIT")},
+		{Key: "region", StrValues: []string{"us-east-1", "us-west-2"}, Comparator: str("IN")},
+		{Key: "region", StrValues: []string{"eu-west-1"}, Comparator: str("NOT_IN")},
+		{Key: "gpu", Comparator: str("EXISTS")},
+		{Key: "gpu", Comparator: str("NOT_EXISTS")},
+		{Key: "sdk", StrValue: str(">= 1.2, < 2"), Comparator: str("SEMVER")},
+		{Key: "host", Comparator: str("NOT_COLOCATED")},
+	}
+
+	for _, label := range valid {
+		assert.NoError(t, validateDesiredWorkerLabel(label.Key, label), label)
+	}
+
+	invalid := []DesiredWorkerLabelOpts{
+		{Key: "model"},
+		{Key: "model", Comparator: str("NOT_EQUAL")},
+		{Key: "memory", StrValue: str("8"), Comparator: str("LESS_THAN")},
+		{Key: "memory", Comparator: str("BEST_FIT")},
+		{Key: "region", StrValue: str("us-east-1"), Comparator: str("IN")},
+		{Key: "region", Comparator: str("NOT_IN")},
+		{Key: "sdk", Comparator: str("SEMVER")},
+		{Key: "sdk", StrValue: str("not a version"), Comparator: str("SEMVER")},
+	}
+
+	for _, label := range invalid {
+		err := validateDesiredWorkerLabel(label.Key, label)
+
+		assert.True(t, errors.Is(err, ErrInvalidDesiredWorkerLabel), label)
+	}
+}
+
+func TestValidateDesiredWorkerLabels(t *testing.T) {
+	opts := &CreateWorkflowVersionOpts{
+		Name: "workflow",
+		Tasks: []CreateStepOpts{
+			{ReadableId: "first", DesiredWorkerLabels: map[string]DesiredWorkerLabelOpts{
+				"gpu": {Key: "gpu"},
+			}},
+		},
+	}
+
+	assert.ErrorContains(t, validateDesiredWorkerLabels(opts), "task first: invalid desired worker label: gpu")
+
+	exists := "EXISTS"
+	opts.Tasks[0].DesiredWorkerLabels["gpu"] = DesiredWorkerLabelOpts{Key: "gpu", Comparator: &exists}
+
+	assert.NoError(t, validateDesiredWorkerLabels(opts))
+
+	semver := "SEMVER"
+	constraint := "latest"
+
+	opts.OnFailure = &CreateStepOpts{ReadableId: "on-failure", DesiredWorkerLabels: map[string]DesiredWorkerLabelOpts{
+		"region": {Key: "region", Comparator: &exists},
+		"sdk":    {Key: "sdk", StrValue: &constraint, Comparator: &semver},
+	}}
+
+	assert.ErrorContains(t, validateDesiredWorkerLabels(opts), "task on-failure")
+}
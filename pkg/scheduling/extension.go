@@ -3,6 +3,7 @@ package scheduling
 import (
 	"context"
 	"sync"
+	"time"
 
 	"golang.org/x/sync/errgroup"
 
@@ -24,6 +25,22 @@ type SnapshotInput struct {
 
 	// WorkerSlotUtilizationByType breaks WorkerSlotUtilization down by slot type.
 	WorkerSlotUtilizationByType map[uuid.UUID]map[string]*SlotUtilization
+
+	// QueueAging is the priority aging state of each queue whose tasks age, keyed by queue name.
+	QueueAging map[string]*QueueAgingState
+}
+
+// QueueAgingState summarizes how priority aging orders a queue, over the queue items the
+// scheduler has loaded for it.
+type QueueAgingState struct {
+	// AgedItems is the number of items whose effective priority has been raised by aging.
+	AgedItems int
+
+	// OverdueItems is the number of items which have waited longer than their policy's max wait.
+	OverdueItems int
+
+	// OldestWait is the longest time any of the items has been waiting.
+	OldestWait time.Duration
 }
 
 type SlotUtilization struct {
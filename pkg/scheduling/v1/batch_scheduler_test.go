@@ -228,14 +228,26 @@ func (f *fakeQueueRepository) MarkQueueItemsProcessed(context.Context, *v1repo.A
 	return nil, nil, nil
 }
 
+func (f *fakeQueueRepository) GetCircuitBreakerBlockedTasks(context.Context, *v1repo.OptimisticTx, []*sqlcv1.V1QueueItem) (map[int64]struct{}, error) {
+	return nil, nil
+}
+
 func (f *fakeQueueRepository) GetTaskRateLimits(context.Context, *v1repo.OptimisticTx, []*sqlcv1.V1QueueItem) (map[int64]map[string]int32, error) {
 	return nil, nil
 }
 
+func (f *fakeQueueRepository) GetPriorityAgingPolicies(context.Context) (*v1repo.PriorityAgingPolicies, error) {
+	return nil, nil
+}
+
 func (f *fakeQueueRepository) GetStepBatchConfigs(context.Context, *v1repo.OptimisticTx, []uuid.UUID) (map[string]bool, error) {
 	return map[string]bool{}, nil
 }
 
+func (f *fakeQueueRepository) GetWorkflowRunWorkers(context.Context, *v1repo.OptimisticTx, []uuid.UUID) (map[uuid.UUID][]uuid.UUID, error) {
+	return map[uuid.UUID][]uuid.UUID{}, nil
+}
+
 func (f *fakeQueueRepository) RequeueRateLimitedItems(context.Context, uuid.UUID, string) ([]*sqlcv1.RequeueRateLimitedQueueItemsRow, error) {
 	return nil, nil
 }
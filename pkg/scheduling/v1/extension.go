@@ -8,6 +8,7 @@ import "github.com/hatchet-dev/hatchet/pkg/scheduling"
 type PostAssignInput = scheduling.PostAssignInput
 type SnapshotInput = scheduling.SnapshotInput
 type SlotUtilization = scheduling.SlotUtilization
+type QueueAgingState = scheduling.QueueAgingState
 type WorkerCp = scheduling.WorkerCp
 type SlotCp = scheduling.SlotCp
 type SchedulerExtension = scheduling.SchedulerExtension
@@ -17,6 +17,7 @@ type PrometheusExtension struct {
 	tenants                map[uuid.UUID]*sqlcv1.Tenant
 	tenantIdToWorkerLabels map[uuid.UUID]map[WorkerPromLabels]struct{}
 	tenantIdToLabelPairs   map[uuid.UUID]map[LabelPairPromLabels]struct{}
+	tenantIdToAgingQueues  map[uuid.UUID]map[string]struct{}
 
 	promGate *prometheus.Gate
 }
@@ -26,6 +27,7 @@ func NewPrometheusExtension(promGate *prometheus.Gate) *PrometheusExtension {
 		tenants:                make(map[uuid.UUID]*sqlcv1.Tenant),
 		tenantIdToWorkerLabels: make(map[uuid.UUID]map[WorkerPromLabels]struct{}),
 		tenantIdToLabelPairs:   make(map[uuid.UUID]map[LabelPairPromLabels]struct{}),
+		tenantIdToAgingQueues:  make(map[uuid.UUID]map[string]struct{}),
 		promGate:               promGate,
 	}
 }
@@ -218,6 +220,40 @@ func (p *PrometheusExtension) ReportSnapshot(ctx context.Context, tenantId uuid.
 
 	p.tenantIdToWorkerLabels[tenantId] = currentWorkers
 	p.tenantIdToLabelPairs[tenantId] = currentPairs
+
+	p.reportQueueAging(tenantId, input.QueueAging, tenantMetricsEnabled)
+}
+
+// reportQueueAging sets the priority aging gauges of the queues whose tasks age, and removes the
+// gauges of queues which no longer report aging state.
+func (p *PrometheusExtension) reportQueueAging(tenantId uuid.UUID, queueAging map[string]*QueueAgingState, tenantMetricsEnabled bool) {
+	tenantIdStr := tenantId.String()
+
+	for queueName := range p.tenantIdToAgingQueues[tenantId] {
+		if _, stillActive := queueAging[queueName]; !stillActive || !tenantMetricsEnabled {
+			deleteQueueAgingGauges(tenantIdStr, queueName)
+		}
+	}
+
+	currentQueues := make(map[string]struct{}, len(queueAging))
+
+	if tenantMetricsEnabled {
+		for queueName, state := range queueAging {
+			currentQueues[queueName] = struct{}{}
+
+			prometheus.TenantAgedQueueItems.WithLabelValues(tenantIdStr, queueName).Set(float64(state.AgedItems))
+			prometheus.TenantOverdueQueueItems.WithLabelValues(tenantIdStr, queueName).Set(float64(state.OverdueItems))
+			prometheus.TenantQueueOldestWaitSeconds.WithLabelValues(tenantIdStr, queueName).Set(state.OldestWait.Seconds())
+		}
+	}
+
+	p.tenantIdToAgingQueues[tenantId] = currentQueues
+}
+
+func deleteQueueAgingGauges(tenantIdStr, queueName string) {
+	prometheus.TenantAgedQueueItems.DeleteLabelValues(tenantIdStr, queueName)
+	prometheus.TenantOverdueQueueItems.DeleteLabelValues(tenantIdStr, queueName)
+	prometheus.TenantQueueOldestWaitSeconds.DeleteLabelValues(tenantIdStr, queueName)
 }
 
 func (p *PrometheusExtension) PostAssign(tenantId uuid.UUID, input *PostAssignInput) {}
@@ -245,6 +281,14 @@ func (p *PrometheusExtension) deleteGaugesForTenant(tenantId uuid.UUID) {
 		delete(p.tenantIdToLabelPairs, tenantId)
 	}
 
+	if known, ok := p.tenantIdToAgingQueues[tenantId]; ok {
+		for queueName := range known {
+			deleteQueueAgingGauges(tenantIdStr, queueName)
+		}
+
+		delete(p.tenantIdToAgingQueues, tenantId)
+	}
+
 	delete(p.tenants, tenantId)
 }
 
@@ -268,8 +312,13 @@ func (p *PrometheusExtension) Cleanup() error {
 		p.deleteGaugesForTenant(tenantId)
 	}
 
+	for tenantId := range p.tenantIdToAgingQueues {
+		p.deleteGaugesForTenant(tenantId)
+	}
+
 	p.tenants = make(map[uuid.UUID]*sqlcv1.Tenant)
 	p.tenantIdToWorkerLabels = make(map[uuid.UUID]map[WorkerPromLabels]struct{})
 	p.tenantIdToLabelPairs = make(map[uuid.UUID]map[LabelPairPromLabels]struct{})
+	p.tenantIdToAgingQueues = make(map[uuid.UUID]map[string]struct{})
 	return nil
 }
@@ -5,6 +5,7 @@ package v1
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5/pgtype"
@@ -310,3 +311,62 @@ func TestPrometheusExtensionCleanupTenantDeletesLabelPairs(t *testing.T) {
 
 	require.Empty(t, collectLabelPairSeries(t, prometheus.TenantWorkerLabelSlots, otherTenantId.String()))
 }
+
+func collectQueueSeries(t *testing.T, vec *promclient.GaugeVec, tenantId string) map[string]float64 {
+	t.Helper()
+
+	ch := make(chan promclient.Metric, 1024)
+	vec.Collect(ch)
+	close(ch)
+
+	out := make(map[string]float64)
+
+	for m := range ch {
+		var d dto.Metric
+		require.NoError(t, m.Write(&d))
+
+		labels := make(map[string]string, len(d.Label))
+		for _, lp := range d.Label {
+			labels[lp.GetName()] = lp.GetValue()
+		}
+
+		if labels["tenant_id"] != tenantId {
+			continue
+		}
+
+		out[labels["queue"]] = d.GetGauge().GetValue()
+	}
+
+	return out
+}
+
+func TestPrometheusExtensionReportsQueueAging(t *testing.T) {
+	ext := NewPrometheusExtension(nil)
+
+	tenantId := uuid.New()
+	tenantIdStr := tenantId.String()
+
+	input := snapshotInput(map[uuid.UUID]*WorkerCp{}, map[uuid.UUID]map[string]*SlotUtilization{})
+	input.QueueAging = map[string]*QueueAgingState{
+		"queue-a": {AgedItems: 3, OverdueItems: 1, OldestWait: 90 * time.Second},
+		"queue-b": {AgedItems: 1},
+	}
+
+	ext.ReportSnapshot(context.Background(), tenantId, input)
+
+	require.Equal(t, map[string]float64{"queue-a": 3, "queue-b": 1}, collectQueueSeries(t, prometheus.TenantAgedQueueItems, tenantIdStr))
+	require.Equal(t, map[string]float64{"queue-a": 1, "queue-b": 0}, collectQueueSeries(t, prometheus.TenantOverdueQueueItems, tenantIdStr))
+	require.Equal(t, map[string]float64{"queue-a": 90, "queue-b": 0}, collectQueueSeries(t, prometheus.TenantQueueOldestWaitSeconds, tenantIdStr))
+
+	// a queue which no longer reports aging state loses its series
+	delete(input.QueueAging, "queue-b")
+
+	ext.ReportSnapshot(context.Background(), tenantId, input)
+
+	require.Equal(t, map[string]float64{"queue-a": 3}, collectQueueSeries(t, prometheus.TenantAgedQueueItems, tenantIdStr))
+
+	require.NoError(t, ext.CleanupTenant(tenantId))
+
+	require.Empty(t, collectQueueSeries(t, prometheus.TenantAgedQueueItems, tenantIdStr))
+	require.Empty(t, collectQueueSeries(t, prometheus.TenantQueueOldestWaitSeconds, tenantIdStr))
+}
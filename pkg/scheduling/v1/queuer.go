@@ -11,6 +11,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/rs/zerolog"
 
+	"github.com/hatchet-dev/hatchet/pkg/clock"
 	"github.com/hatchet-dev/hatchet/pkg/integrations/metrics/prometheus"
 	v1 "github.com/hatchet-dev/hatchet/pkg/repository"
 	"github.com/hatchet-dev/hatchet/pkg/repository/sqlcv1"
@@ -142,6 +143,8 @@ func newQueuer(conf *sharedConfig, tenantId uuid.UUID, queueName string, s *Sche
 		q.isCleanedUp = true
 		cancel()
 
+		s.setQueueAging(queueName, nil)
+
 		queueRepo.Cleanup()
 	}
 
@@ -307,6 +310,19 @@ func (q *Queuer) loopQueue(ctx context.Context) {
 			continue
 		}
 
+		parseSemverConstraints(labels, taskIdToDesiredLabelsFromTrigger)
+
+		runWorkers, err := q.getWorkflowRunWorkers(ctx, nil, qis, labels, taskIdToDesiredLabelsFromTrigger)
+
+		if err != nil {
+			span.RecordError(err)
+			span.End()
+			q.l.Error().Ctx(ctx).Err(err).Msg("error getting workflow run workers")
+
+			q.unackedToUnassigned(qis)
+			continue
+		}
+
 		desiredLabelsTime := time.Since(checkpoint)
 		checkpoint = time.Now()
 
@@ -338,7 +354,21 @@ func (q *Queuer) loopQueue(ctx context.Context) {
 		getSlotRequestsTime := time.Since(checkpoint)
 		checkpoint = time.Now()
 
-		assignCh := q.s.tryAssign(ctx, qis, labels, stepRequests, rls, taskIdToDesiredLabelsFromTrigger, batchConfigs)
+		circuitBreakerBlocked, err := q.repo.GetCircuitBreakerBlockedTasks(ctx, nil, qis)
+
+		if err != nil {
+			span.RecordError(err)
+			span.End()
+			q.l.Error().Ctx(ctx).Err(err).Msg("error getting circuit breakers")
+
+			q.unackedToUnassigned(qis)
+			continue
+		}
+
+		circuitBreakerTime := time.Since(checkpoint)
+		checkpoint = time.Now()
+
+		assignCh := q.s.tryAssign(ctx, qis, labels, stepRequests, rls, taskIdToDesiredLabelsFromTrigger, batchConfigs, circuitBreakerBlocked, runWorkers)
 		count := 0
 
 		countMu := sync.Mutex{}
@@ -360,7 +390,7 @@ func (q *Queuer) loopQueue(ctx context.Context) {
 
 				countMu.Lock()
 				count += numFlushed
-				processedQiLength += len(ar.assigned) + len(ar.buffered) + len(ar.batched) + len(ar.unassigned) + len(ar.schedulingTimedOut) + len(ar.rateLimited) + len(ar.rateLimitedToMove)
+				processedQiLength += len(ar.assigned) + len(ar.buffered) + len(ar.batched) + len(ar.unassigned) + len(ar.schedulingTimedOut) + len(ar.rateLimited) + len(ar.rateLimitedToMove) + len(ar.circuitBroken)
 				countMu.Unlock()
 
 				if sinceStart := time.Since(startFlush); sinceStart > 100*time.Millisecond {
@@ -474,6 +504,8 @@ func (q *Queuer) loopQueue(ctx context.Context) {
 				"get_slot_requests_time", getSlotRequestsTime,
 			).Dur(
 				"batch_config_time", batchConfigTime,
+			).Dur(
+				"circuit_breaker_time", circuitBreakerTime,
 			).Dur(
 				"assign_time", assignTime,
 			).Dur("elapsed", elapsed).Int("item_count", len(qis)).Msg("queue processing took longer than 100ms")
@@ -506,6 +538,52 @@ func (q *Queuer) loopQueue(ctx context.Context) {
 	}
 }
 
+// getWorkflowRunWorkers returns the workers running tasks of the workflow runs of the queue items
+// with NOT_COLOCATED desired labels. Only these workflow runs are keys of the returned map, so the
+// scheduler only tracks the assignments it needs.
+func (q *Queuer) getWorkflowRunWorkers(
+	ctx context.Context,
+	tx *v1.OptimisticTx,
+	qis []*sqlcv1.V1QueueItem,
+	stepIdsToLabels map[uuid.UUID][]*sqlcv1.GetDesiredLabelsRow,
+	taskIdsToLabelOverrides map[int64][]*sqlcv1.GetDesiredLabelsRow,
+) (map[uuid.UUID][]uuid.UUID, error) {
+	workflowRunIds := make([]uuid.UUID, 0)
+
+	for _, qi := range qis {
+		labels := stepIdsToLabels[qi.StepID]
+
+		if labelOverrides, ok := taskIdsToLabelOverrides[qi.TaskID]; ok {
+			labels = labelOverrides
+		}
+
+		for _, label := range labels {
+			if label.Comparator == sqlcv1.WorkerLabelComparatorNOTCOLOCATED {
+				workflowRunIds = append(workflowRunIds, qi.WorkflowRunID)
+				break
+			}
+		}
+	}
+
+	if len(workflowRunIds) == 0 {
+		return nil, nil
+	}
+
+	runWorkers, err := q.repo.GetWorkflowRunWorkers(ctx, tx, workflowRunIds)
+
+	if err != nil {
+		return nil, err
+	}
+
+	res := make(map[uuid.UUID][]uuid.UUID, len(workflowRunIds))
+
+	for _, workflowRunId := range workflowRunIds {
+		res[workflowRunId] = runWorkers[workflowRunId]
+	}
+
+	return res, nil
+}
+
 func (q *Queuer) refillQueue(ctx context.Context) ([]*sqlcv1.V1QueueItem, error) {
 	q.unackedMu.Lock()
 	defer q.unackedMu.Unlock()
@@ -558,14 +636,66 @@ func (q *Queuer) refillQueue(ctx context.Context) ([]*sqlcv1.V1QueueItem, error)
 		q.unacked[qi.ID] = struct{}{}
 	}
 
-	sort.Slice(newCurr, func(i, j int) bool {
-		if newCurr[i].Priority == newCurr[j].Priority {
-			return newCurr[i].ID < newCurr[j].ID
+	policies, err := q.repo.GetPriorityAgingPolicies(ctx)
+
+	if err != nil {
+		// fall back to strict priority ordering
+		q.l.Error().Ctx(ctx).Err(err).Msg("error getting priority aging policies")
+	}
+
+	q.s.setQueueAging(q.queueName, sortQueueItems(newCurr, policies, clock.Now()))
+
+	return newCurr, nil
+}
+
+// sortQueueItems orders queue items by their effective priority, highest first, and then by id.
+// Without a priority aging policy, an item's effective priority is its priority. It returns the
+// aging state of the items, or nil if none of the tenant's tasks age.
+func sortQueueItems(qis []*sqlcv1.V1QueueItem, policies *v1.PriorityAgingPolicies, now time.Time) *QueueAgingState {
+	if !policies.Enabled() {
+		sort.Slice(qis, func(i, j int) bool {
+			if qis[i].Priority == qis[j].Priority {
+				return qis[i].ID < qis[j].ID
+			}
+			return qis[i].Priority > qis[j].Priority
+		})
+
+		return nil
+	}
+
+	state := &QueueAgingState{}
+	effective := make(map[int64]int32, len(qis))
+
+	for _, qi := range qis {
+		priority := qi.Priority
+
+		if qi.TaskInsertedAt.Valid {
+			waited := now.Sub(qi.TaskInsertedAt.Time)
+			priority = policies.Policy(qi.WorkflowID).EffectivePriority(qi.Priority, waited)
+
+			switch {
+			case priority == v1.OverduePriority && qi.Priority < v1.OverduePriority:
+				state.OverdueItems++
+			case priority > qi.Priority:
+				state.AgedItems++
+			}
+
+			state.OldestWait = max(state.OldestWait, waited)
+		}
+
+		effective[qi.ID] = priority
+	}
+
+	sort.Slice(qis, func(i, j int) bool {
+		pi, pj := effective[qis[i].ID], effective[qis[j].ID]
+
+		if pi == pj {
+			return qis[i].ID < qis[j].ID
 		}
-		return newCurr[i].Priority > newCurr[j].Priority
+		return pi > pj
 	})
 
-	return newCurr, nil
+	return state
 }
 
 func (q *Queuer) ack(r *assignResults) {
@@ -603,6 +733,11 @@ func (q *Queuer) ack(r *assignResults) {
 		q.unassigned[unassignedItem.ID] = unassignedItem
 	}
 
+	for _, circuitBrokenItem := range r.circuitBroken {
+		delete(q.unacked, circuitBrokenItem.ID)
+		q.unassigned[circuitBrokenItem.ID] = circuitBrokenItem
+	}
+
 	for _, schedulingTimedOutItem := range r.schedulingTimedOut {
 		delete(q.unacked, schedulingTimedOutItem.ID)
 		delete(q.unassigned, schedulingTimedOutItem.ID)
@@ -889,12 +1024,25 @@ func (q *Queuer) runOptimisticQueue(
 		return nil, nil, err
 	}
 
+	parseSemverConstraints(labels, taskIdToDesiredLabelsFromTrigger)
+
+	runWorkers, err := q.getWorkflowRunWorkers(ctx, tx, qis, labels, taskIdToDesiredLabelsFromTrigger)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
 	stepRequests, err := q.repo.GetStepSlotRequests(ctx, tx, stepIds)
 	if err != nil {
 		return nil, nil, err
 	}
 
-	assignCh := q.s.tryAssign(ctx, qis, labels, stepRequests, rls, taskIdToDesiredLabelsFromTrigger, batchConfigs)
+	circuitBreakerBlocked, err := q.repo.GetCircuitBreakerBlockedTasks(ctx, tx, qis)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	assignCh := q.s.tryAssign(ctx, qis, labels, stepRequests, rls, taskIdToDesiredLabelsFromTrigger, batchConfigs, circuitBreakerBlocked, runWorkers)
 
 	var allLocalAssigned []*v1.AssignedItem
 	var allQueueResults []*QueueResults
@@ -1107,5 +1255,5 @@ func (q *Queuer) flushToDatabaseOptimistic(
 }
 
 func assignResultsItemCount(r *assignResults) int {
-	return len(r.assigned) + len(r.unassigned) + len(r.schedulingTimedOut) + len(r.rateLimited) + len(r.rateLimitedToMove)
+	return len(r.assigned) + len(r.unassigned) + len(r.schedulingTimedOut) + len(r.rateLimited) + len(r.rateLimitedToMove) + len(r.circuitBroken)
 }
@@ -0,0 +1,83 @@
+//go:build !e2e && !load && !rampup && !integration
+
+package v1
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	repo "github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/sqlcv1"
+)
+
+func TestSortQueueItemsStrictPriority(t *testing.T) {
+	now := time.Now()
+	workflowId := uuid.New()
+
+	qis := []*sqlcv1.V1QueueItem{
+		agingQueueItem(1, 1, workflowId, now.Add(-time.Hour)),
+		agingQueueItem(2, 3, workflowId, now),
+		agingQueueItem(3, 3, workflowId, now),
+	}
+
+	state := sortQueueItems(qis, nil, now)
+
+	assert.Nil(t, state)
+	assert.Equal(t, []int64{2, 3, 1}, queueItemIds(qis))
+}
+
+func TestSortQueueItemsPriorityAging(t *testing.T) {
+	now := time.Now()
+	agingWorkflowId := uuid.New()
+	strictWorkflowId := uuid.New()
+
+	policies := &repo.PriorityAgingPolicies{
+		Workflows: map[uuid.UUID]*repo.PriorityAgingPolicy{
+			agingWorkflowId: {Interval: time.Minute, MaxWait: 10 * time.Minute},
+		},
+	}
+
+	qis := []*sqlcv1.V1QueueItem{
+		// low priority, but past the max wait
+		agingQueueItem(1, 1, agingWorkflowId, now.Add(-11*time.Minute)),
+		// aged from 1 to 3, ordered ahead of the newer priority 3 item
+		agingQueueItem(2, 1, agingWorkflowId, now.Add(-3*time.Minute)),
+		// a workflow without a policy never ages
+		agingQueueItem(3, 1, strictWorkflowId, now.Add(-time.Hour)),
+		agingQueueItem(4, 3, strictWorkflowId, now),
+		// aged from 1 to 2
+		agingQueueItem(5, 1, agingWorkflowId, now.Add(-90*time.Second)),
+	}
+
+	state := sortQueueItems(qis, policies, now)
+
+	require.NotNil(t, state)
+	assert.Equal(t, []int64{1, 2, 4, 5, 3}, queueItemIds(qis))
+	assert.Equal(t, 2, state.AgedItems)
+	assert.Equal(t, 1, state.OverdueItems)
+	assert.Equal(t, time.Hour, state.OldestWait)
+}
+
+func agingQueueItem(id int64, priority int32, workflowId uuid.UUID, insertedAt time.Time) *sqlcv1.V1QueueItem {
+	return &sqlcv1.V1QueueItem{
+		ID:             id,
+		Priority:       priority,
+		WorkflowID:     workflowId,
+		TaskInsertedAt: pgtype.Timestamptz{Time: insertedAt, Valid: true},
+	}
+}
+
+func queueItemIds(qis []*sqlcv1.V1QueueItem) []int64 {
+	ids := make([]int64, 0, len(qis))
+
+	for _, qi := range qis {
+		ids = append(ids, qi.ID)
+	}
+
+	return ids
+}
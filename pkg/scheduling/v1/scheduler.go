@@ -46,6 +46,11 @@ type Scheduler struct {
 	ops     chan func()
 	runDone chan struct{}
 
+	// queueAging is the latest priority aging state reported by each queuer. Queuers report it
+	// off the run loop, so it is guarded by queueAgingMu rather than owned by the loop.
+	queueAging   map[string]*QueueAgingState
+	queueAgingMu sync.Mutex
+
 	// ---- state below is owned by the run loop ----
 
 	actions       map[string]*action
@@ -96,6 +101,7 @@ func newScheduler(cf *sharedConfig, tenantId uuid.UUID, rl *rateLimiter, exts *E
 		workers:         make(map[uuid.UUID]*worker),
 		unackedSlots:    make(map[int]*assignedSlots),
 		warmedSlotTypes: make(map[uuid.UUID]map[string]struct{}),
+		queueAging:      make(map[string]*QueueAgingState),
 	}
 }
 
@@ -744,6 +750,7 @@ func (s *Scheduler) tryAssignBatch(
 	taskIdsToRateLimits map[int64]map[string]int32,
 	stepIdsToBatchConfig map[string]bool,
 	taskIdsToLabelOverrides map[int64][]*sqlcv1.GetDesiredLabelsRow,
+	workflowRunIdsToWorkerIds map[uuid.UUID][]uuid.UUID,
 ) (
 	res []*assignSingleResult, err error,
 ) {
@@ -856,7 +863,7 @@ func (s *Scheduler) tryAssignBatch(
 
 	var attempt func(isRetry bool)
 	attempt = func(isRetry bool) {
-		s.handleAssignBatch(actionId, qis, res, rlAcks, rlNacks, stepIdsToLabels, stepIdsToRequests, taskIdsToLabelOverrides)
+		s.handleAssignBatch(actionId, qis, res, rlAcks, rlNacks, stepIdsToLabels, stepIdsToRequests, taskIdsToLabelOverrides, workflowRunIdsToWorkerIds)
 
 		// If a replenish cycle is in flight, capacity may be milliseconds away:
 		// park the missed items and retry once when the cycle ends, instead of
@@ -931,7 +938,9 @@ func batchHasMisses(res []*assignSingleResult) bool {
 	return false
 }
 
-// handleAssignBatch runs on the run loop.
+// handleAssignBatch runs on the run loop. The workers assigned to workflow runs in
+// workflowRunIdsToWorkerIds are recorded there, so sibling tasks later in the batch aren't
+// colocated with them either.
 func (s *Scheduler) handleAssignBatch(
 	actionId string,
 	qis []*sqlcv1.V1QueueItem,
@@ -941,6 +950,7 @@ func (s *Scheduler) handleAssignBatch(
 	stepIdsToLabels map[uuid.UUID][]*sqlcv1.GetDesiredLabelsRow,
 	stepIdsToRequests map[uuid.UUID]map[string]int32,
 	taskIdsToLabelOverrides map[int64][]*sqlcv1.GetDesiredLabelsRow,
+	workflowRunIdsToWorkerIds map[uuid.UUID][]uuid.UUID,
 ) {
 	action, ok := s.actions[actionId]
 
@@ -1002,16 +1012,24 @@ func (s *Scheduler) handleAssignBatch(
 			}
 		}
 
-		s.assignSingleton(action, qi, r, labels, requests, rlAcks[i], rlNacks[i], now)
+		siblingWorkerIds, trackSiblings := workflowRunIdsToWorkerIds[qi.WorkflowRunID]
+
+		s.assignSingleton(action, qi, r, labels, siblingWorkerIds, requests, rlAcks[i], rlNacks[i], now)
+
+		if trackSiblings && r.succeeded {
+			workflowRunIdsToWorkerIds[qi.WorkflowRunID] = append(siblingWorkerIds, r.workerId)
+		}
 	}
 }
 
-// assignSingleton runs on the run loop.
+// assignSingleton runs on the run loop. The sibling worker ids are the workers running other tasks
+// of the queue item's workflow run.
 func (s *Scheduler) assignSingleton(
 	a *action,
 	qi *sqlcv1.V1QueueItem,
 	r *assignSingleResult,
 	labels []*sqlcv1.GetDesiredLabelsRow,
+	siblingWorkerIds []uuid.UUID,
 	requests map[string]int32,
 	rateLimitAck func(),
 	rateLimitNack func(),
@@ -1023,7 +1041,7 @@ func (s *Scheduler) assignSingleton(
 	topRankCount := len(candidates)
 
 	if qi.Sticky != sqlcv1.V1StickyStrategyNONE || len(labels) > 0 {
-		candidates, topRankCount = s.rankWorkerIds(qi, labels, a.workerIds)
+		candidates, topRankCount = s.rankWorkerIds(qi, labels, siblingWorkerIds, a.workerIds)
 	}
 
 	if len(candidates) == 0 || topRankCount == 0 {
@@ -1123,7 +1141,7 @@ func (s *Scheduler) tryAssignBatchQueueItem(
 		// scheduling skips the regular slot-request lookup path.
 		requests := map[string]int32{v1.SlotTypeDefault: 1}
 
-		s.assignSingleton(action, qi, &res, labels, requests, noop, noop, time.Now())
+		s.assignSingleton(action, qi, &res, labels, nil, requests, noop, noop, time.Now())
 	}); !ok {
 		res.noSlots = true
 	}
@@ -1166,6 +1184,7 @@ func selectSlotsFromPools(poolsByType map[string]*slotPool, requests map[string]
 func (s *Scheduler) rankWorkerIds(
 	qi *sqlcv1.V1QueueItem,
 	labels []*sqlcv1.GetDesiredLabelsRow,
+	siblingWorkerIds []uuid.UUID,
 	workerIds []uuid.UUID,
 ) ([]uuid.UUID, int) {
 	type rankedWorker struct {
@@ -1173,6 +1192,16 @@ func (s *Scheduler) rankWorkerIds(
 		rank int
 	}
 
+	// siblings which are no longer active only match on their id, as their labels are unknown
+	siblings := make([]*worker, 0, len(siblingWorkerIds))
+	for _, workerId := range siblingWorkerIds {
+		sibling := s.workers[workerId]
+		if sibling == nil {
+			sibling = &worker{ListActiveWorkersResult: &v1.ListActiveWorkersResult{ID: workerId}}
+		}
+		siblings = append(siblings, sibling)
+	}
+
 	ranked := make([]rankedWorker, 0, len(workerIds))
 	for _, workerId := range workerIds {
 		rank := 0
@@ -1194,7 +1223,7 @@ func (s *Scheduler) rankWorkerIds(
 				if worker == nil {
 					continue
 				}
-				rank = worker.computeWeight(labels)
+				rank = worker.computeWeight(labels, siblings)
 				if rank < 0 {
 					continue
 				}
@@ -1246,6 +1275,10 @@ type assignResults struct {
 	schedulingTimedOut []*sqlcv1.V1QueueItem
 	rateLimited        []*scheduleRateLimitResult
 	rateLimitedToMove  []*scheduleRateLimitResult
+
+	// circuitBroken are items held back by an open circuit breaker. unlike unassigned items, they
+	// weren't considered for a worker, so they don't count as waiting on a slot.
+	circuitBroken []*sqlcv1.V1QueueItem
 }
 
 func (s *Scheduler) tryAssign(
@@ -1256,6 +1289,8 @@ func (s *Scheduler) tryAssign(
 	taskIdsToRateLimits map[int64]map[string]int32,
 	taskIdsToLabelOverrides map[int64][]*sqlcv1.GetDesiredLabelsRow,
 	stepIdsToBatchConfig map[string]bool,
+	circuitBreakerBlocked map[int64]struct{},
+	workflowRunIdsToWorkerIds map[uuid.UUID][]uuid.UUID,
 ) <-chan *assignResults {
 	ctx, span := telemetry.NewSpan(ctx, "try-assign")
 
@@ -1308,9 +1343,17 @@ func (s *Scheduler) tryAssign(
 
 				batched := make([]*sqlcv1.V1QueueItem, 0)
 				schedulingTimedOut := make([]*sqlcv1.V1QueueItem, 0, len(qis))
+				circuitBroken := make([]*sqlcv1.V1QueueItem, 0)
 				for i := range qis {
 					qi := qis[i]
 
+					// items behind an open circuit breaker stay queued until the breaker lets them through,
+					// and don't time out while they're held
+					if _, ok := circuitBreakerBlocked[qi.TaskID]; ok {
+						circuitBroken = append(circuitBroken, qi)
+						continue
+					}
+
 					if isTimedOut(qi) {
 						schedulingTimedOut = append(schedulingTimedOut, qi)
 						continue
@@ -1321,6 +1364,7 @@ func (s *Scheduler) tryAssign(
 
 				resultsCh <- &assignResults{
 					schedulingTimedOut: schedulingTimedOut,
+					circuitBroken:      circuitBroken,
 				}
 
 				err := queueutils.BatchLinear(50, batched, func(batchQis []*sqlcv1.V1QueueItem) error {
@@ -1334,7 +1378,7 @@ func (s *Scheduler) tryAssign(
 
 					batchStart := time.Now()
 
-					results, err := s.tryAssignBatch(ctx, actionId, batchQis, stepIdsToLabels, stepIdsToRequests, taskIdsToRateLimits, stepIdsToBatchConfig, taskIdsToLabelOverrides)
+					results, err := s.tryAssignBatch(ctx, actionId, batchQis, stepIdsToLabels, stepIdsToRequests, taskIdsToRateLimits, stepIdsToBatchConfig, taskIdsToLabelOverrides, workflowRunIdsToWorkerIds)
 
 					if err != nil {
 						return err
@@ -83,6 +83,8 @@ func BenchmarkScheduler_ConcurrentAssignReplenishSnapshot(b *testing.B) {
 				nil,
 				nil,
 				nil,
+				nil,
+				nil,
 			)
 
 			// ack assignments the way a queuer flush would, so unacked slots
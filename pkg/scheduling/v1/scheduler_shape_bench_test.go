@@ -464,6 +464,7 @@ func BenchmarkScheduler_InventoryShape_TryAssignBatch(b *testing.B) {
 					nil,
 					nil,
 					nil,
+					nil,
 				)
 				if err != nil {
 					b.Fatal(err)
@@ -294,7 +294,7 @@ func assignOne(t *testing.T, s *Scheduler, a *action, qi *sqlcv1.V1QueueItem, la
 
 	r := &assignSingleResult{qi: qi}
 	onLoop(t, s, func() {
-		s.assignSingleton(a, qi, r, labels, requests, rlAck, rlNack, time.Now())
+		s.assignSingleton(a, qi, r, labels, nil, requests, rlAck, rlNack, time.Now())
 	})
 	return r
 }
@@ -547,7 +547,7 @@ func TestScheduler_RankWorkerIds_StickyDoesNotRequirePoolsByWorker(t *testing.T)
 
 	var ranked []uuid.UUID
 	onLoop(t, s, func() {
-		ranked, _ = s.rankWorkerIds(qi, nil, []uuid.UUID{desiredWorkerId})
+		ranked, _ = s.rankWorkerIds(qi, nil, nil, []uuid.UUID{desiredWorkerId})
 	})
 	require.Equal(t, []uuid.UUID{desiredWorkerId}, ranked)
 }
@@ -579,7 +579,7 @@ func TestScheduler_RankWorkerIds_LabelsUseWorkersMap(t *testing.T) {
 	// No poolsByWorker entry — label ranking must still resolve the worker via s.workers.
 	var ranked []uuid.UUID
 	onLoop(t, s, func() {
-		ranked, _ = s.rankWorkerIds(qi, labels, []uuid.UUID{workerId})
+		ranked, _ = s.rankWorkerIds(qi, labels, nil, []uuid.UUID{workerId})
 	})
 	require.Equal(t, []uuid.UUID{workerId}, ranked)
 }
@@ -615,7 +615,7 @@ func TestScheduler_TryAssignBatch_NoActionSlots(t *testing.T) {
 		testQI(tenantId, "missing", 2),
 	}
 
-	res, err := s.tryAssignBatch(context.Background(), "missing", qis, nil, nil, nil, nil, nil)
+	res, err := s.tryAssignBatch(context.Background(), "missing", qis, nil, nil, nil, nil, nil, nil)
 	require.NoError(t, err)
 	require.Len(t, res, 2)
 	for _, r := range res {
@@ -687,7 +687,7 @@ func TestScheduler_TryAssign_NotBlockedByReplenishDBReads(t *testing.T) {
 			defer wg.Done()
 			for probeCtx.Err() == nil {
 				start := time.Now()
-				_, _ = s.tryAssignBatch(context.Background(), "missing", qis, nil, nil, nil, nil, nil)
+				_, _ = s.tryAssignBatch(context.Background(), "missing", qis, nil, nil, nil, nil, nil, nil)
 				d := time.Since(start)
 
 				mu.Lock()
@@ -727,7 +727,7 @@ func TestScheduler_TryAssignBatch_AssignsUntilExhausted(t *testing.T) {
 		testQI(tenantId, "A", 3),
 	}
 
-	res, err := s.tryAssignBatch(context.Background(), "A", qis, map[uuid.UUID][]*sqlcv1.GetDesiredLabelsRow{}, map[uuid.UUID]map[string]int32{}, nil, nil, nil)
+	res, err := s.tryAssignBatch(context.Background(), "A", qis, map[uuid.UUID][]*sqlcv1.GetDesiredLabelsRow{}, map[uuid.UUID]map[string]int32{}, nil, nil, nil, nil)
 	require.NoError(t, err)
 
 	var assigned, noSlots int
@@ -744,6 +744,64 @@ func TestScheduler_TryAssignBatch_AssignsUntilExhausted(t *testing.T) {
 	require.Equal(t, 1, noSlots)
 }
 
+func TestScheduler_TryAssignBatch_NotColocatedSpreadsSiblings(t *testing.T) {
+	tenantId := uuid.New()
+	workerId1 := uuid.New()
+	workerId2 := uuid.New()
+	stepId := uuid.New()
+	workflowRunId := uuid.New()
+
+	s := newTestScheduler(t, tenantId, &mockAssignmentRepo{})
+	s.setWorkers([]*repo.ListActiveWorkersResult{testWorker(workerId1), testWorker(workerId2)})
+
+	w1 := &worker{ListActiveWorkersResult: testWorker(workerId1)}
+	w2 := &worker{ListActiveWorkersResult: testWorker(workerId2)}
+
+	seedActionPools(t, s, "A",
+		newSlot(w1, repo.SlotTypeDefault), newSlot(w1, repo.SlotTypeDefault),
+		newSlot(w2, repo.SlotTypeDefault), newSlot(w2, repo.SlotTypeDefault),
+	)
+
+	qis := make([]*sqlcv1.V1QueueItem, 3)
+
+	for i := range qis {
+		qis[i] = testQI(tenantId, "A", int64(i+1))
+		qis[i].StepID = stepId
+		qis[i].WorkflowRunID = workflowRunId
+	}
+
+	labels := map[uuid.UUID][]*sqlcv1.GetDesiredLabelsRow{
+		stepId: {
+			{
+				Key:        "worker",
+				Required:   true,
+				Comparator: sqlcv1.WorkerLabelComparatorNOTCOLOCATED,
+			},
+		},
+	}
+
+	runWorkers := map[uuid.UUID][]uuid.UUID{workflowRunId: nil}
+
+	res, err := s.tryAssignBatch(context.Background(), "A", qis, labels, map[uuid.UUID]map[string]int32{}, nil, nil, nil, runWorkers)
+	require.NoError(t, err)
+
+	assignedWorkers := make(map[uuid.UUID]bool)
+	noSlots := 0
+
+	for _, r := range res {
+		if r.succeeded {
+			require.False(t, assignedWorkers[r.workerId], "siblings must not be assigned to the same worker")
+			assignedWorkers[r.workerId] = true
+		}
+		if r.noSlots {
+			noSlots++
+		}
+	}
+
+	require.Len(t, assignedWorkers, 2)
+	require.Equal(t, 1, noSlots)
+}
+
 func TestScheduler_TryAssignBatch_RateLimitedSkipsAssignment(t *testing.T) {
 	tenantId := uuid.New()
 	workerId := uuid.New()
@@ -769,7 +827,7 @@ func TestScheduler_TryAssignBatch_RateLimitedSkipsAssignment(t *testing.T) {
 		qi.TaskID: {"k": 1},
 	}
 
-	res, err := s.tryAssignBatch(context.Background(), "A", qis, nil, map[uuid.UUID]map[string]int32{}, rls, nil, nil)
+	res, err := s.tryAssignBatch(context.Background(), "A", qis, nil, map[uuid.UUID]map[string]int32{}, rls, nil, nil, nil)
 	require.NoError(t, err)
 	require.Len(t, res, 1)
 	require.False(t, res[0].succeeded)
@@ -803,6 +861,8 @@ func TestScheduler_TryAssign_GroupsAndFiltersTimedOut(t *testing.T) {
 		nil,
 		nil,
 		nil,
+		nil,
+		nil,
 	)
 
 	var (
@@ -829,6 +889,58 @@ func TestScheduler_TryAssign_GroupsAndFiltersTimedOut(t *testing.T) {
 	require.True(t, assignedIDs[b1.TaskID])
 }
 
+func TestScheduler_TryAssign_HoldsCircuitBrokenItems(t *testing.T) {
+	tenantId := uuid.New()
+
+	s := newTestScheduler(t, tenantId, &mockAssignmentRepo{})
+	w := &worker{ListActiveWorkersResult: testWorker(uuid.New())}
+
+	seedActionPools(t, s, "A", newSlot(w, repo.SlotTypeDefault), newSlot(w, repo.SlotTypeDefault))
+
+	// held items don't time out, even once they're past their schedule timeout
+	blockedQI := testQI(tenantId, "A", 1)
+	blockedQI.ScheduleTimeoutAt = ts(time.Now().UTC().Add(-1 * time.Second))
+	probeQI := testQI(tenantId, "A", 2)
+
+	ch := s.tryAssign(
+		context.Background(),
+		[]*sqlcv1.V1QueueItem{blockedQI, probeQI},
+		map[uuid.UUID][]*sqlcv1.GetDesiredLabelsRow{},
+		map[uuid.UUID]map[string]int32{},
+		nil,
+		nil,
+		nil,
+		map[int64]struct{}{blockedQI.TaskID: {}},
+		nil,
+	)
+
+	assignedIDs := map[int64]bool{}
+	unassignedIDs := map[int64]bool{}
+	circuitBrokenIDs := map[int64]bool{}
+	timedOutIDs := map[int64]bool{}
+
+	for r := range ch {
+		for _, u := range r.unassigned {
+			unassignedIDs[u.TaskID] = true
+		}
+		for _, to := range r.schedulingTimedOut {
+			timedOutIDs[to.TaskID] = true
+		}
+		for _, c := range r.circuitBroken {
+			circuitBrokenIDs[c.TaskID] = true
+		}
+		for _, a := range r.assigned {
+			assignedIDs[a.QueueItem.TaskID] = true
+		}
+	}
+
+	require.True(t, circuitBrokenIDs[blockedQI.TaskID])
+	require.False(t, timedOutIDs[blockedQI.TaskID])
+	require.False(t, unassignedIDs[blockedQI.TaskID])
+	require.False(t, assignedIDs[blockedQI.TaskID])
+	require.True(t, assignedIDs[probeQI.TaskID])
+}
+
 func TestScheduler_GetExtensionInput(t *testing.T) {
 	tenantId := uuid.New()
 	s := newTestScheduler(t, tenantId, &mockAssignmentRepo{})
@@ -1790,7 +1902,7 @@ func TestScheduler_TryAssignBatch_ParkedRetryAfterReplenish(t *testing.T) {
 	resultCh := make(chan batchResult, 1)
 
 	go func() {
-		res, err := s.tryAssignBatch(context.Background(), "A", []*sqlcv1.V1QueueItem{testQI(tenantId, "A", 1)}, nil, nil, nil, nil, nil)
+		res, err := s.tryAssignBatch(context.Background(), "A", []*sqlcv1.V1QueueItem{testQI(tenantId, "A", 1)}, nil, nil, nil, nil, nil, nil)
 		resultCh <- batchResult{res, err}
 	}()
 
@@ -1837,7 +1949,7 @@ func TestScheduler_TryAssignBatch_ParkedRetryTimesOut(t *testing.T) {
 	onLoop(t, s, func() { s.replenishing = true })
 
 	start := time.Now()
-	res, err := s.tryAssignBatch(context.Background(), "A", []*sqlcv1.V1QueueItem{testQI(tenantId, "A", 1)}, nil, nil, nil, nil, nil)
+	res, err := s.tryAssignBatch(context.Background(), "A", []*sqlcv1.V1QueueItem{testQI(tenantId, "A", 1)}, nil, nil, nil, nil, nil, nil)
 	waited := time.Since(start)
 
 	// the cycle never ends: the park must give up after its bounded wait and
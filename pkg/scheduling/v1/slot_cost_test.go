@@ -107,6 +107,8 @@ func TestSlotCost_OverCapacityWaitsThenSchedulingTimesOut(t *testing.T) {
 		nil,
 		nil,
 		nil,
+		nil,
+		nil,
 	)
 
 	assigned := map[int64]bool{}
@@ -150,7 +152,7 @@ func TestSlotCost_ExplicitDefaultCostBlocksProportionally(t *testing.T) {
 	}
 
 	res, err := s.tryAssignBatch(context.Background(), "A", qis,
-		map[uuid.UUID][]*sqlcv1.GetDesiredLabelsRow{}, stepRequests, nil, nil, nil)
+		map[uuid.UUID][]*sqlcv1.GetDesiredLabelsRow{}, stepRequests, nil, nil, nil, nil)
 	require.NoError(t, err)
 
 	assigned, noSlots := 0, 0
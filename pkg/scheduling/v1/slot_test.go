@@ -103,6 +103,7 @@ func TestRankWorkerIds(t *testing.T) {
 		name       string
 		qi         *sqlcv1.V1QueueItem
 		labels     []*sqlcv1.GetDesiredLabelsRow
+		siblings   []uuid.UUID
 		workers    []*v1.ListActiveWorkersResult
 		candidates []uuid.UUID
 		expected   []uuid.UUID
@@ -186,6 +187,32 @@ func TestRankWorkerIds(t *testing.T) {
 			candidates: []uuid.UUID{stableWorkerId1, stableWorkerId2},
 			expected:   []uuid.UUID{stableWorkerId1},
 		},
+		{
+			name: "required anti-affinity drops workers colocated with siblings",
+			qi:   &sqlcv1.V1QueueItem{},
+			labels: []*sqlcv1.GetDesiredLabelsRow{
+				{
+					Key:        "host",
+					Weight:     1,
+					Required:   true,
+					Comparator: sqlcv1.WorkerLabelComparatorNOTCOLOCATED,
+				},
+			},
+			siblings: []uuid.UUID{stableWorkerId1},
+			workers: []*v1.ListActiveWorkersResult{
+				{ID: stableWorkerId1, Labels: []*sqlcv1.ListManyWorkerLabelsRow{
+					{Key: "host", StrValue: pgtype.Text{String: "a", Valid: true}},
+				}},
+				{ID: stableWorkerId2, Labels: []*sqlcv1.ListManyWorkerLabelsRow{
+					{Key: "host", StrValue: pgtype.Text{String: "a", Valid: true}},
+				}},
+				{ID: otherWorkerId, Labels: []*sqlcv1.ListManyWorkerLabelsRow{
+					{Key: "host", StrValue: pgtype.Text{String: "b", Valid: true}},
+				}},
+			},
+			candidates: []uuid.UUID{stableWorkerId1, stableWorkerId2, otherWorkerId},
+			expected:   []uuid.UUID{otherWorkerId},
+		},
 	}
 
 	for _, tt := range tests {
@@ -195,7 +222,7 @@ func TestRankWorkerIds(t *testing.T) {
 
 			var ranked []uuid.UUID
 			onLoop(t, s, func() {
-				ranked, _ = s.rankWorkerIds(tt.qi, tt.labels, tt.candidates)
+				ranked, _ = s.rankWorkerIds(tt.qi, tt.labels, tt.siblings, tt.candidates)
 			})
 
 			assert.Equal(t, tt.expected, ranked)
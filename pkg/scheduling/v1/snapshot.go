@@ -76,6 +76,7 @@ func (s *Scheduler) buildSnapshotInput() *SnapshotInput {
 		Workers:                     make(map[uuid.UUID]*WorkerCp, len(s.workers)),
 		WorkerSlotUtilization:       make(map[uuid.UUID]*SlotUtilization, len(s.workers)),
 		WorkerSlotUtilizationByType: make(map[uuid.UUID]map[string]*SlotUtilization, len(s.workers)),
+		QueueAging:                  s.getQueueAging(),
 	}
 
 	for workerId, worker := range s.workers {
@@ -183,3 +184,34 @@ func (s *Scheduler) buildSnapshotInput() *SnapshotInput {
 
 	return res
 }
+
+// setQueueAging records the priority aging state of a queue for the next snapshot. A nil state
+// removes the queue, e.g. when none of its tasks age.
+func (s *Scheduler) setQueueAging(queueName string, state *QueueAgingState) {
+	s.queueAgingMu.Lock()
+	defer s.queueAgingMu.Unlock()
+
+	if state == nil {
+		delete(s.queueAging, queueName)
+		return
+	}
+
+	if s.queueAging == nil {
+		s.queueAging = make(map[string]*QueueAgingState)
+	}
+
+	s.queueAging[queueName] = state
+}
+
+func (s *Scheduler) getQueueAging() map[string]*QueueAgingState {
+	s.queueAgingMu.Lock()
+	defer s.queueAgingMu.Unlock()
+
+	res := make(map[string]*QueueAgingState, len(s.queueAging))
+
+	for queueName, state := range s.queueAging {
+		res[queueName] = state
+	}
+
+	return res
+}
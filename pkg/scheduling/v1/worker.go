@@ -1,6 +1,13 @@
 package v1
 
 import (
+	"slices"
+	"strconv"
+
+	"github.com/Masterminds/semver/v3"
+	"github.com/google/uuid"
+	lru "github.com/hashicorp/golang-lru/v2"
+
 	v1 "github.com/hatchet-dev/hatchet/pkg/repository"
 	"github.com/hatchet-dev/hatchet/pkg/repository/sqlcv1"
 )
@@ -9,66 +16,201 @@ type worker struct {
 	*v1.ListActiveWorkersResult
 }
 
+// WorkerLabelsSatisfy returns true if a worker with the given labels meets the required desired
+// labels of a task, using the same comparison as the scheduler. NOT_COLOCATED labels are always
+// met, as there are no sibling tasks to compare against.
+func WorkerLabelsSatisfy(labels []*sqlcv1.ListManyWorkerLabelsRow, desired []*sqlcv1.GetDesiredLabelsRow) bool {
+	w := &worker{
+		ListActiveWorkersResult: &v1.ListActiveWorkersResult{
+			Labels: labels,
+		},
+	}
+
+	return w.computeWeight(desired, nil) >= 0
+}
+
 // computeWeight computes the weight of a worker based on the desired labels. If the worker does not
-// meet the required labels, the weight is -1.
-func (w *worker) computeWeight(s []*sqlcv1.GetDesiredLabelsRow) int {
+// meet the required labels, the weight is -1. The siblings are the workers running other tasks of the
+// same workflow run, which NOT_COLOCATED labels are compared against.
+func (w *worker) computeWeight(s []*sqlcv1.GetDesiredLabelsRow, siblings []*worker) int {
 	totalWeight := 0
 
 	for _, desiredLabel := range s {
-		labelFound := false
-
-		for _, workerLabel := range w.Labels {
-			if desiredLabel.Key == workerLabel.Key {
-				labelFound = true
-				conditionMet := false
-				switch desiredLabel.Comparator {
-				case sqlcv1.WorkerLabelComparatorEQUAL:
-					if (desiredLabel.StrValue.Valid && workerLabel.StrValue.Valid && desiredLabel.StrValue.String == workerLabel.StrValue.String) ||
-						(desiredLabel.IntValue.Valid && workerLabel.IntValue.Valid && desiredLabel.IntValue.Int32 == workerLabel.IntValue.Int32) {
-						totalWeight += int(desiredLabel.Weight)
-						conditionMet = true
-					}
-				case sqlcv1.WorkerLabelComparatorNOTEQUAL:
-					if (desiredLabel.StrValue.Valid && workerLabel.StrValue.Valid && desiredLabel.StrValue.String != workerLabel.StrValue.String) ||
-						(desiredLabel.IntValue.Valid && workerLabel.IntValue.Valid && desiredLabel.IntValue.Int32 != workerLabel.IntValue.Int32) {
-						totalWeight += int(desiredLabel.Weight)
-						conditionMet = true
-					}
-				case sqlcv1.WorkerLabelComparatorGREATERTHAN:
-					if desiredLabel.IntValue.Valid && workerLabel.IntValue.Valid && workerLabel.IntValue.Int32 > desiredLabel.IntValue.Int32 {
-						totalWeight += int(desiredLabel.Weight)
-						conditionMet = true
-					}
-				case sqlcv1.WorkerLabelComparatorLESSTHAN:
-					if desiredLabel.IntValue.Valid && workerLabel.IntValue.Valid && workerLabel.IntValue.Int32 < desiredLabel.IntValue.Int32 {
-						totalWeight += int(desiredLabel.Weight)
-						conditionMet = true
-					}
-				case sqlcv1.WorkerLabelComparatorGREATERTHANOREQUAL:
-					if desiredLabel.IntValue.Valid && workerLabel.IntValue.Valid && workerLabel.IntValue.Int32 >= desiredLabel.IntValue.Int32 {
-						totalWeight += int(desiredLabel.Weight)
-						conditionMet = true
-					}
-				case sqlcv1.WorkerLabelComparatorLESSTHANOREQUAL:
-					if desiredLabel.IntValue.Valid && workerLabel.IntValue.Valid && workerLabel.IntValue.Int32 <= desiredLabel.IntValue.Int32 {
-						totalWeight += int(desiredLabel.Weight)
-						conditionMet = true
-					}
-				}
-
-				if !conditionMet && desiredLabel.Required {
-					return -1
-				}
-
-				break // Move to the next desired label
+		switch {
+		case !w.meetsDesiredLabel(desiredLabel, siblings):
+			if desiredLabel.Required {
+				return -1
 			}
+		case desiredLabel.Comparator == sqlcv1.WorkerLabelComparatorBESTFIT:
+			totalWeight += w.bestFitWeight(desiredLabel)
+		default:
+			totalWeight += int(desiredLabel.Weight)
 		}
+	}
+
+	return totalWeight
+}
+
+// bestFitWeight scales the weight of a met BEST_FIT label by how tightly the worker's value fits the
+// desired value, so a worker with exactly the desired value gets the full weight and one with twice
+// the headroom gets about half of it.
+func (w *worker) bestFitWeight(desiredLabel *sqlcv1.GetDesiredLabelsRow) int {
+	workerLabel := w.getLabel(desiredLabel.Key)
+
+	// negative values are treated as 0, and offset by one, so a desired value of 0 still prefers the
+	// smallest value and the worker's value is never 0
+	desired := max(int64(desiredLabel.IntValue.Int32), 0) + 1
+	value := max(int64(workerLabel.IntValue.Int32), 0) + 1
+
+	return int(int64(desiredLabel.Weight) * desired / value)
+}
+
+func (w *worker) meetsDesiredLabel(desiredLabel *sqlcv1.GetDesiredLabelsRow, siblings []*worker) bool {
+	workerLabel := w.getLabel(desiredLabel.Key)
+
+	switch desiredLabel.Comparator {
+	case sqlcv1.WorkerLabelComparatorEXISTS:
+		return workerLabel != nil
+	case sqlcv1.WorkerLabelComparatorNOTEXISTS:
+		return workerLabel == nil
+	case sqlcv1.WorkerLabelComparatorNOTIN:
+		// a worker without the label has none of the values
+		return workerLabel == nil || !slices.Contains(desiredLabel.StrValues, labelValue(workerLabel))
+	case sqlcv1.WorkerLabelComparatorNOTCOLOCATED:
+		return !w.isColocated(desiredLabel.Key, siblings)
+	}
 
-		// If the label is required but not found, return -1 to indicate an invalid match
-		if desiredLabel.Required && !labelFound {
-			return -1
+	if workerLabel == nil {
+		return false
+	}
+
+	switch desiredLabel.Comparator {
+	case sqlcv1.WorkerLabelComparatorEQUAL:
+		return (desiredLabel.StrValue.Valid && workerLabel.StrValue.Valid && desiredLabel.StrValue.String == workerLabel.StrValue.String) ||
+			(desiredLabel.IntValue.Valid && workerLabel.IntValue.Valid && desiredLabel.IntValue.Int32 == workerLabel.IntValue.Int32)
+	case sqlcv1.WorkerLabelComparatorNOTEQUAL:
+		return (desiredLabel.StrValue.Valid && workerLabel.StrValue.Valid && desiredLabel.StrValue.String != workerLabel.StrValue.String) ||
+			(desiredLabel.IntValue.Valid && workerLabel.IntValue.Valid && desiredLabel.IntValue.Int32 != workerLabel.IntValue.Int32)
+	case sqlcv1.WorkerLabelComparatorGREATERTHAN:
+		return desiredLabel.IntValue.Valid && workerLabel.IntValue.Valid && workerLabel.IntValue.Int32 > desiredLabel.IntValue.Int32
+	case sqlcv1.WorkerLabelComparatorLESSTHAN:
+		return desiredLabel.IntValue.Valid && workerLabel.IntValue.Valid && workerLabel.IntValue.Int32 < desiredLabel.IntValue.Int32
+	case sqlcv1.WorkerLabelComparatorGREATERTHANOREQUAL, sqlcv1.WorkerLabelComparatorBESTFIT:
+		return desiredLabel.IntValue.Valid && workerLabel.IntValue.Valid && workerLabel.IntValue.Int32 >= desiredLabel.IntValue.Int32
+	case sqlcv1.WorkerLabelComparatorLESSTHANOREQUAL:
+		return desiredLabel.IntValue.Valid && workerLabel.IntValue.Valid && workerLabel.IntValue.Int32 <= desiredLabel.IntValue.Int32
+	case sqlcv1.WorkerLabelComparatorIN:
+		return slices.Contains(desiredLabel.StrValues, labelValue(workerLabel))
+	case sqlcv1.WorkerLabelComparatorSEMVER:
+		return desiredLabel.StrValue.Valid && semverSatisfies(labelValue(workerLabel), desiredLabel.StrValue.String)
+	}
+
+	return false
+}
+
+// getLabel returns the worker's label with the given key, or nil if the worker doesn't have it.
+func (w *worker) getLabel(key string) *sqlcv1.ListManyWorkerLabelsRow {
+	for _, workerLabel := range w.Labels {
+		if workerLabel.Key == key {
+			return workerLabel
 		}
 	}
 
-	return totalWeight
+	return nil
+}
+
+// isColocated returns true if the worker is one of the siblings, or shares the value of the label
+// with one of them. The label acts as a topology key, like a host or zone, so a key which workers
+// don't have only keeps tasks off the same worker.
+func (w *worker) isColocated(key string, siblings []*worker) bool {
+	workerLabel := w.getLabel(key)
+
+	for _, sibling := range siblings {
+		if sibling.ID == w.ID {
+			return true
+		}
+
+		if workerLabel == nil {
+			continue
+		}
+
+		if siblingLabel := sibling.getLabel(key); siblingLabel != nil && labelValue(siblingLabel) == labelValue(workerLabel) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// labelValue returns the value of a worker label as a string, so that integer labels can be
+// matched against sets and version constraints.
+func labelValue(label *sqlcv1.ListManyWorkerLabelsRow) string {
+	if label.StrValue.Valid {
+		return label.StrValue.String
+	}
+
+	if label.IntValue.Valid {
+		return strconv.Itoa(int(label.IntValue.Int32))
+	}
+
+	return ""
+}
+
+// semverConstraints holds the parsed SEMVER constraints of desired labels by their constraint
+// string, so that a constraint isn't parsed again for every worker it's checked against.
+// Constraints which can't be parsed are stored as nil.
+var semverConstraints, _ = lru.New[string, *semver.Constraints](10000)
+
+// parseSemverConstraints parses the SEMVER constraints of desired labels as they're loaded.
+func parseSemverConstraints(stepIdsToLabels map[uuid.UUID][]*sqlcv1.GetDesiredLabelsRow, taskIdsToLabelOverrides map[int64][]*sqlcv1.GetDesiredLabelsRow) {
+	parse := func(labels []*sqlcv1.GetDesiredLabelsRow) {
+		for _, label := range labels {
+			if label.Comparator == sqlcv1.WorkerLabelComparatorSEMVER && label.StrValue.Valid {
+				semverConstraint(label.StrValue.String)
+			}
+		}
+	}
+
+	for _, labels := range stepIdsToLabels {
+		parse(labels)
+	}
+
+	for _, labels := range taskIdsToLabelOverrides {
+		parse(labels)
+	}
+}
+
+// semverConstraint returns the parsed constraint, or nil if it can't be parsed.
+func semverConstraint(constraint string) *semver.Constraints {
+	if c, ok := semverConstraints.Get(constraint); ok {
+		return c
+	}
+
+	c, err := semver.NewConstraint(constraint)
+
+	if err != nil {
+		c = nil
+	}
+
+	semverConstraints.Add(constraint, c)
+
+	return c
+}
+
+// semverSatisfies returns true if the version satisfies the constraint, e.g. ">= 2.3, < 3". Versions
+// and constraints which can't be parsed never match.
+func semverSatisfies(version, constraint string) bool {
+	c := semverConstraint(constraint)
+
+	if c == nil {
+		return false
+	}
+
+	v, err := semver.NewVersion(version)
+
+	if err != nil {
+		return false
+	}
+
+	return c.Check(v)
 }
@@ -0,0 +1,215 @@
+//go:build !e2e && !load && !rampup && !integration
+
+package v1
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+
+	v1 "github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/sqlcv1"
+)
+
+func TestWorker_ComputeWeight(t *testing.T) {
+	tests := []struct {
+		name     string
+		labels   []*sqlcv1.ListManyWorkerLabelsRow
+		desired  *sqlcv1.GetDesiredLabelsRow
+		expected bool
+	}{
+		{
+			name:     "IN matches a string value in the set",
+			labels:   []*sqlcv1.ListManyWorkerLabelsRow{strLabel("region", "us-east-1")},
+			desired:  &sqlcv1.GetDesiredLabelsRow{Key: "region", Comparator: sqlcv1.WorkerLabelComparatorIN, StrValues: []string{"us-east-1", "us-west-2"}},
+			expected: true,
+		},
+		{
+			name:     "IN matches an integer value by its decimal string",
+			labels:   []*sqlcv1.ListManyWorkerLabelsRow{intLabel("gpus", 4)},
+			desired:  &sqlcv1.GetDesiredLabelsRow{Key: "gpus", Comparator: sqlcv1.WorkerLabelComparatorIN, StrValues: []string{"2", "4"}},
+			expected: true,
+		},
+		{
+			name:     "IN doesn't match a value outside the set",
+			labels:   []*sqlcv1.ListManyWorkerLabelsRow{strLabel("region", "eu-west-1")},
+			desired:  &sqlcv1.GetDesiredLabelsRow{Key: "region", Comparator: sqlcv1.WorkerLabelComparatorIN, StrValues: []string{"us-east-1"}},
+			expected: false,
+		},
+		{
+			name:     "IN doesn't match a worker without the label",
+			desired:  &sqlcv1.GetDesiredLabelsRow{Key: "region", Comparator: sqlcv1.WorkerLabelComparatorIN, StrValues: []string{"us-east-1"}},
+			expected: false,
+		},
+		{
+			name:     "NOT_IN doesn't match a value in the set",
+			labels:   []*sqlcv1.ListManyWorkerLabelsRow{strLabel("region", "us-east-1")},
+			desired:  &sqlcv1.GetDesiredLabelsRow{Key: "region", Comparator: sqlcv1.WorkerLabelComparatorNOTIN, StrValues: []string{"us-east-1"}},
+			expected: false,
+		},
+		{
+			name:     "NOT_IN matches a worker without the label",
+			desired:  &sqlcv1.GetDesiredLabelsRow{Key: "region", Comparator: sqlcv1.WorkerLabelComparatorNOTIN, StrValues: []string{"us-east-1"}},
+			expected: true,
+		},
+		{
+			name:     "EXISTS matches a worker with the label",
+			labels:   []*sqlcv1.ListManyWorkerLabelsRow{strLabel("gpu", "a100")},
+			desired:  &sqlcv1.GetDesiredLabelsRow{Key: "gpu", Comparator: sqlcv1.WorkerLabelComparatorEXISTS},
+			expected: true,
+		},
+		{
+			name:     "EXISTS doesn't match a worker without the label",
+			desired:  &sqlcv1.GetDesiredLabelsRow{Key: "gpu", Comparator: sqlcv1.WorkerLabelComparatorEXISTS},
+			expected: false,
+		},
+		{
+			name:     "NOT_EXISTS matches a worker without the label",
+			labels:   []*sqlcv1.ListManyWorkerLabelsRow{strLabel("region", "us-east-1")},
+			desired:  &sqlcv1.GetDesiredLabelsRow{Key: "spot", Comparator: sqlcv1.WorkerLabelComparatorNOTEXISTS},
+			expected: true,
+		},
+		{
+			name:     "NOT_EXISTS doesn't match a worker with the label",
+			labels:   []*sqlcv1.ListManyWorkerLabelsRow{strLabel("spot", "true")},
+			desired:  &sqlcv1.GetDesiredLabelsRow{Key: "spot", Comparator: sqlcv1.WorkerLabelComparatorNOTEXISTS},
+			expected: false,
+		},
+		{
+			name:     "SEMVER matches a version in the range",
+			labels:   []*sqlcv1.ListManyWorkerLabelsRow{strLabel("model_version", "2.4.1")},
+			desired:  &sqlcv1.GetDesiredLabelsRow{Key: "model_version", Comparator: sqlcv1.WorkerLabelComparatorSEMVER, StrValue: pgtype.Text{String: ">= 2.3, < 3", Valid: true}},
+			expected: true,
+		},
+		{
+			name:     "SEMVER doesn't match a version outside the range",
+			labels:   []*sqlcv1.ListManyWorkerLabelsRow{strLabel("model_version", "2.2")},
+			desired:  &sqlcv1.GetDesiredLabelsRow{Key: "model_version", Comparator: sqlcv1.WorkerLabelComparatorSEMVER, StrValue: pgtype.Text{String: ">= 2.3", Valid: true}},
+			expected: false,
+		},
+		{
+			name:     "SEMVER doesn't match a value which isn't a version",
+			labels:   []*sqlcv1.ListManyWorkerLabelsRow{strLabel("model_version", "latest")},
+			desired:  &sqlcv1.GetDesiredLabelsRow{Key: "model_version", Comparator: sqlcv1.WorkerLabelComparatorSEMVER, StrValue: pgtype.Text{String: ">= 2.3", Valid: true}},
+			expected: false,
+		},
+		{
+			name:     "SEMVER doesn't match an invalid constraint",
+			labels:   []*sqlcv1.ListManyWorkerLabelsRow{strLabel("model_version", "2.4.1")},
+			desired:  &sqlcv1.GetDesiredLabelsRow{Key: "model_version", Comparator: sqlcv1.WorkerLabelComparatorSEMVER, StrValue: pgtype.Text{String: "at least 2", Valid: true}},
+			expected: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.desired.Weight = 10
+
+			w := &worker{ListActiveWorkersResult: &v1.ListActiveWorkersResult{ID: uuid.New(), Labels: tt.labels}}
+
+			if tt.expected {
+				assert.Equal(t, 10, w.computeWeight([]*sqlcv1.GetDesiredLabelsRow{tt.desired}, nil))
+			} else {
+				assert.Equal(t, 0, w.computeWeight([]*sqlcv1.GetDesiredLabelsRow{tt.desired}, nil))
+			}
+
+			tt.desired.Required = true
+
+			assert.Equal(t, tt.expected, w.computeWeight([]*sqlcv1.GetDesiredLabelsRow{tt.desired}, nil) >= 0)
+		})
+	}
+}
+
+func TestWorker_ComputeWeight_NotColocated(t *testing.T) {
+	desired := []*sqlcv1.GetDesiredLabelsRow{
+		{Key: "host", Weight: 10, Comparator: sqlcv1.WorkerLabelComparatorNOTCOLOCATED},
+	}
+
+	newWorker := func(labels ...*sqlcv1.ListManyWorkerLabelsRow) *worker {
+		return &worker{ListActiveWorkersResult: &v1.ListActiveWorkersResult{ID: uuid.New(), Labels: labels}}
+	}
+
+	sibling := newWorker(strLabel("host", "a"))
+	sameHost := newWorker(strLabel("host", "a"))
+	otherHost := newWorker(strLabel("host", "b"))
+	noHost := newWorker()
+
+	siblings := []*worker{sibling}
+
+	assert.Equal(t, 0, sibling.computeWeight(desired, siblings), "a worker is colocated with itself")
+	assert.Equal(t, 0, sameHost.computeWeight(desired, siblings), "workers with the same label value are colocated")
+	assert.Equal(t, 10, otherHost.computeWeight(desired, siblings))
+	assert.Equal(t, 10, noHost.computeWeight(desired, siblings))
+	assert.Equal(t, 10, sibling.computeWeight(desired, nil), "a task without siblings is never colocated")
+}
+
+func TestWorker_ComputeWeight_BestFit(t *testing.T) {
+	desired := []*sqlcv1.GetDesiredLabelsRow{
+		{Key: "gpu_memory_free_gb", Weight: 100, Required: true, Comparator: sqlcv1.WorkerLabelComparatorBESTFIT, IntValue: pgtype.Int4{Int32: 19, Valid: true}},
+	}
+
+	newWorker := func(labels ...*sqlcv1.ListManyWorkerLabelsRow) *worker {
+		return &worker{ListActiveWorkersResult: &v1.ListActiveWorkersResult{ID: uuid.New(), Labels: labels}}
+	}
+
+	exact := newWorker(intLabel("gpu_memory_free_gb", 19))
+	tight := newWorker(intLabel("gpu_memory_free_gb", 39))
+	loose := newWorker(intLabel("gpu_memory_free_gb", 79))
+	tooSmall := newWorker(intLabel("gpu_memory_free_gb", 10))
+	noLabel := newWorker()
+
+	assert.Equal(t, 100, exact.computeWeight(desired, nil))
+	assert.Equal(t, 50, tight.computeWeight(desired, nil))
+	assert.Equal(t, 25, loose.computeWeight(desired, nil))
+	assert.Equal(t, -1, tooSmall.computeWeight(desired, nil), "a worker without enough headroom doesn't fit")
+	assert.Equal(t, -1, noLabel.computeWeight(desired, nil))
+}
+
+func TestWorker_ComputeWeight_BestFitNegative(t *testing.T) {
+	newWorker := func(labels ...*sqlcv1.ListManyWorkerLabelsRow) *worker {
+		return &worker{ListActiveWorkersResult: &v1.ListActiveWorkersResult{ID: uuid.New(), Labels: labels}}
+	}
+
+	desired := []*sqlcv1.GetDesiredLabelsRow{
+		{Key: "slots_free", Weight: 100, Required: true, Comparator: sqlcv1.WorkerLabelComparatorBESTFIT, IntValue: pgtype.Int4{Int32: -5, Valid: true}},
+	}
+
+	// a worker value of -1 used to divide by zero
+	assert.Equal(t, 100, newWorker(intLabel("slots_free", -1)).computeWeight(desired, nil))
+	assert.Equal(t, 100, newWorker(intLabel("slots_free", -3)).computeWeight(desired, nil))
+	assert.Equal(t, 100, newWorker(intLabel("slots_free", 0)).computeWeight(desired, nil))
+	assert.Equal(t, 50, newWorker(intLabel("slots_free", 1)).computeWeight(desired, nil))
+	assert.Equal(t, -1, newWorker(intLabel("slots_free", -6)).computeWeight(desired, nil))
+
+	desired[0].IntValue = pgtype.Int4{Int32: 1, Valid: true}
+
+	assert.Equal(t, -1, newWorker(intLabel("slots_free", -1)).computeWeight(desired, nil))
+	assert.Equal(t, 50, newWorker(intLabel("slots_free", 3)).computeWeight(desired, nil))
+}
+
+func TestParseSemverConstraints(t *testing.T) {
+	stepLabels := map[uuid.UUID][]*sqlcv1.GetDesiredLabelsRow{
+		uuid.New(): {
+			{Key: "model_version", Comparator: sqlcv1.WorkerLabelComparatorSEMVER, StrValue: pgtype.Text{String: ">= 7.1, < 8", Valid: true}},
+			{Key: "model", Comparator: sqlcv1.WorkerLabelComparatorEQUAL, StrValue: pgtype.Text{String: "fancy-model", Valid: true}},
+		},
+	}
+
+	overrides := map[int64][]*sqlcv1.GetDesiredLabelsRow{
+		1: {{Key: "model_version", Comparator: sqlcv1.WorkerLabelComparatorSEMVER, StrValue: pgtype.Text{String: "not a constraint", Valid: true}}},
+	}
+
+	parseSemverConstraints(stepLabels, overrides)
+
+	parsed, ok := semverConstraints.Peek(">= 7.1, < 8")
+	assert.True(t, ok)
+	assert.NotNil(t, parsed)
+
+	invalid, ok := semverConstraints.Peek("not a constraint")
+	assert.True(t, ok, "invalid constraints are stored so they aren't parsed again")
+	assert.Nil(t, invalid)
+
+	assert.False(t, semverConstraints.Contains("fancy-model"))
+}
@@ -0,0 +1,193 @@
+// Package tenantbundle exports the configuration of a tenant to a versioned bundle, and imports a
+// bundle into a tenant, so configuration can be promoted between environments or a tenant can be
+// migrated to another deployment.
+//
+// A bundle holds the workflows of a tenant with their versions, the crons and scheduled runs which
+// were created through the API, filters, incoming webhooks, rate limits, operators, event schemas,
+// alerting settings and resource limits. Run history, workers, members and API tokens aren't
+// included.
+//
+// The secrets of webhooks and operators are encrypted with the keyset of the deployment they're
+// stored in, so they can't be copied as they are. A bundle exported with a passphrase holds them
+// encrypted with a key derived from the passphrase instead, and they're re-encrypted with the keyset
+// of the target deployment when the bundle is imported. A bundle exported without a passphrase holds
+// no secrets.
+package tenantbundle
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+)
+
+// Version is the version of the bundle format written by the exporter. The importer rejects
+// bundles with a later version.
+const Version = 1
+
+// ErrInvalidBundle is returned when importing a bundle which can't be read.
+var ErrInvalidBundle = errors.New("invalid bundle")
+
+type Bundle struct {
+	Version    int       `json:"version"`
+	ExportedAt time.Time `json:"exportedAt"`
+
+	// SourceTenantId is the tenant the bundle was exported from.
+	SourceTenantId uuid.UUID `json:"sourceTenantId"`
+
+	// Secrets describes how the secrets in the bundle are encrypted. It's nil if the bundle was
+	// exported without a passphrase, in which case it holds no secrets.
+	Secrets *SecretsEncryption `json:"secrets,omitempty"`
+
+	Workflows      []Workflow      `json:"workflows"`
+	Crons          []Cron          `json:"crons"`
+	ScheduledRuns  []ScheduledRun  `json:"scheduledRuns"`
+	Filters        []Filter        `json:"filters"`
+	Webhooks       []Webhook       `json:"webhooks"`
+	RateLimits     []RateLimit     `json:"rateLimits"`
+	Operators      []Operator      `json:"operators"`
+	EventSchemas   []EventSchema   `json:"eventSchemas"`
+	Alerting       *Alerting       `json:"alerting,omitempty"`
+	ResourceLimits []ResourceLimit `json:"resourceLimits"`
+}
+
+type Workflow struct {
+	Name string `json:"name"`
+
+	// Versions are the options each version of the workflow was registered with, oldest first.
+	Versions []*repository.CreateWorkflowVersionOpts `json:"versions"`
+}
+
+type Cron struct {
+	Workflow           string          `json:"workflow"`
+	Name               string          `json:"name"`
+	Expression         string          `json:"expression"`
+	Input              json.RawMessage `json:"input,omitempty"`
+	AdditionalMetadata json.RawMessage `json:"additionalMetadata,omitempty"`
+	Priority           int32           `json:"priority"`
+	Enabled            bool            `json:"enabled"`
+}
+
+type ScheduledRun struct {
+	Workflow           string          `json:"workflow"`
+	TriggerAt          time.Time       `json:"triggerAt"`
+	Input              json.RawMessage `json:"input,omitempty"`
+	AdditionalMetadata json.RawMessage `json:"additionalMetadata,omitempty"`
+	Priority           int32           `json:"priority"`
+}
+
+type Filter struct {
+	Workflow   string          `json:"workflow"`
+	Scope      string          `json:"scope"`
+	Expression string          `json:"expression"`
+	Payload    json.RawMessage `json:"payload,omitempty"`
+}
+
+type Webhook struct {
+	Name                         string          `json:"name"`
+	SourceName                   string          `json:"sourceName"`
+	EventKeyExpression           string          `json:"eventKeyExpression"`
+	ScopeExpression              *string         `json:"scopeExpression,omitempty"`
+	StaticPayload                json.RawMessage `json:"staticPayload,omitempty"`
+	ReturnEventAsResponsePayload bool            `json:"returnEventAsResponsePayload"`
+	Auth                         WebhookAuth     `json:"auth"`
+
+	// Secret is the basic auth password, API key or HMAC signing secret of the webhook, encrypted
+	// with the passphrase of the bundle.
+	Secret string `json:"secret,omitempty"`
+}
+
+type WebhookAuth struct {
+	Type                string `json:"type"`
+	Username            string `json:"username,omitempty"`
+	HeaderName          string `json:"headerName,omitempty"`
+	Algorithm           string `json:"algorithm,omitempty"`
+	Encoding            string `json:"encoding,omitempty"`
+	SignatureHeaderName string `json:"signatureHeaderName,omitempty"`
+}
+
+type RateLimit struct {
+	Key      string `json:"key"`
+	Limit    int32  `json:"limit"`
+	Duration string `json:"duration"`
+}
+
+type Operator struct {
+	Name string `json:"name"`
+	Kind string `json:"kind"`
+
+	// Config is the config of the operator, without its signing secret.
+	Config json.RawMessage `json:"config"`
+
+	// Secret is the signing secret of an HTTP operator, encrypted with the passphrase of the
+	// bundle.
+	Secret string `json:"secret,omitempty"`
+}
+
+type EventSchema struct {
+	EventKey string          `json:"eventKey"`
+	Mode     string          `json:"mode"`
+	Schema   json.RawMessage `json:"schema"`
+}
+
+type Alerting struct {
+	MaxFrequency                    string            `json:"maxFrequency"`
+	EnableExpiringTokenAlerts       bool              `json:"enableExpiringTokenAlerts"`
+	EnableWorkflowRunFailureAlerts  bool              `json:"enableWorkflowRunFailureAlerts"`
+	EnableTenantResourceLimitAlerts bool              `json:"enableTenantResourceLimitAlerts"`
+	EmailGroups                     []AlertEmailGroup `json:"emailGroups"`
+}
+
+type AlertEmailGroup struct {
+	Emails []string `json:"emails"`
+}
+
+type ResourceLimit struct {
+	Resource string `json:"resource"`
+	Limit    int32  `json:"limit"`
+	Alarm    int32  `json:"alarm"`
+	Window   string `json:"window,omitempty"`
+}
+
+// Validate checks that a bundle can be imported.
+func (b *Bundle) Validate() error {
+	if b.Version < 1 || b.Version > Version {
+		return fmt.Errorf("%w: unsupported version %d, expected at most %d", ErrInvalidBundle, b.Version, Version)
+	}
+
+	for _, w := range b.Workflows {
+		if w.Name == "" {
+			return fmt.Errorf("%w: workflow without a name", ErrInvalidBundle)
+		}
+
+		if len(w.Versions) == 0 {
+			return fmt.Errorf("%w: workflow %s has no versions", ErrInvalidBundle, w.Name)
+		}
+	}
+
+	return nil
+}
+
+// normalizeJSON re-encodes a JSON document so that documents with the same content compare equal.
+// Empty documents are returned as nil.
+func normalizeJSON(data []byte) (json.RawMessage, error) {
+	if len(data) == 0 {
+		return nil, nil
+	}
+
+	var v interface{}
+
+	if err := json.Unmarshal(data, &v); err != nil {
+		return nil, err
+	}
+
+	if v == nil {
+		return nil, nil
+	}
+
+	return json.Marshal(v)
+}
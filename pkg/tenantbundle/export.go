@@ -0,0 +1,673 @@
+package tenantbundle
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/hatchet-dev/hatchet/pkg/encryption"
+	"github.com/hatchet-dev/hatchet/pkg/operator/httpoperator"
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/sqlcv1"
+	"github.com/hatchet-dev/hatchet/pkg/telemetry"
+)
+
+// pageSize is the number of rows listed at a time.
+const pageSize = 1000
+
+// webhookSecretDataIds are the encryption data ids the secret of a webhook is encrypted under, by
+// auth method.
+var webhookSecretDataIds = map[sqlcv1.V1IncomingWebhookAuthType]string{
+	sqlcv1.V1IncomingWebhookAuthTypeBASIC:  "v1_webhook_basic_auth_password",
+	sqlcv1.V1IncomingWebhookAuthTypeAPIKEY: "v1_webhook_api_key",
+	sqlcv1.V1IncomingWebhookAuthTypeHMAC:   "v1_webhook_hmac_signing_secret",
+}
+
+// signingSecretKey is the key of the encrypted signing secret in the config of an HTTP operator.
+const signingSecretKey = "signingSecret"
+
+type ExportOpts struct {
+	// Passphrase encrypts the secrets of webhooks and operators in the bundle. Secrets are left out
+	// of the bundle if it's empty.
+	Passphrase string
+}
+
+type Exporter struct {
+	repo repository.Repository
+	enc  encryption.EncryptionService
+}
+
+func NewExporter(repo repository.Repository, enc encryption.EncryptionService) *Exporter {
+	return &Exporter{
+		repo: repo,
+		enc:  enc,
+	}
+}
+
+// Export exports the configuration of a tenant. It also returns warnings about configuration which
+// couldn't be exported.
+func (e *Exporter) Export(ctx context.Context, tenantId uuid.UUID, opts ExportOpts) (*Bundle, []string, error) {
+	ctx, span := telemetry.NewSpan(ctx, "export-tenant-bundle")
+	defer span.End()
+
+	b, warnings, err := e.snapshot(ctx, tenantId, true)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if opts.Passphrase == "" {
+		stripSecrets(b)
+		return b, warnings, nil
+	}
+
+	if err := sealSecrets(b, opts.Passphrase); err != nil {
+		return nil, nil, fmt.Errorf("could not encrypt secrets: %w", err)
+	}
+
+	return b, warnings, nil
+}
+
+// snapshot reads the configuration of a tenant with its secrets in plaintext. If allVersions is
+// false, only the latest version of each workflow is read.
+func (e *Exporter) snapshot(ctx context.Context, tenantId uuid.UUID, allVersions bool) (*Bundle, []string, error) {
+	b := &Bundle{
+		Version:        Version,
+		ExportedAt:     time.Now().UTC(),
+		SourceTenantId: tenantId,
+	}
+
+	var warnings []string
+	var err error
+
+	workflowNames := make(map[uuid.UUID]string)
+
+	b.Workflows, warnings, err = e.exportWorkflows(ctx, tenantId, allVersions, workflowNames)
+
+	if err != nil {
+		return nil, nil, fmt.Errorf("could not export workflows: %w", err)
+	}
+
+	if b.Crons, err = e.exportCrons(ctx, tenantId); err != nil {
+		return nil, nil, fmt.Errorf("could not export crons: %w", err)
+	}
+
+	if b.ScheduledRuns, err = e.exportScheduledRuns(ctx, tenantId); err != nil {
+		return nil, nil, fmt.Errorf("could not export scheduled runs: %w", err)
+	}
+
+	if b.Filters, err = e.exportFilters(ctx, tenantId, workflowNames); err != nil {
+		return nil, nil, fmt.Errorf("could not export filters: %w", err)
+	}
+
+	if b.Webhooks, err = e.exportWebhooks(ctx, tenantId); err != nil {
+		return nil, nil, fmt.Errorf("could not export webhooks: %w", err)
+	}
+
+	if b.RateLimits, err = e.exportRateLimits(ctx, tenantId); err != nil {
+		return nil, nil, fmt.Errorf("could not export rate limits: %w", err)
+	}
+
+	if b.Operators, err = e.exportOperators(ctx, tenantId); err != nil {
+		return nil, nil, fmt.Errorf("could not export operators: %w", err)
+	}
+
+	if b.EventSchemas, err = e.exportEventSchemas(ctx, tenantId); err != nil {
+		return nil, nil, fmt.Errorf("could not export event schemas: %w", err)
+	}
+
+	if b.Alerting, err = e.exportAlerting(ctx, tenantId); err != nil {
+		return nil, nil, fmt.Errorf("could not export alerting settings: %w", err)
+	}
+
+	if b.ResourceLimits, err = e.exportResourceLimits(ctx, tenantId); err != nil {
+		return nil, nil, fmt.Errorf("could not export resource limits: %w", err)
+	}
+
+	return b, warnings, nil
+}
+
+func (e *Exporter) exportWorkflows(ctx context.Context, tenantId uuid.UUID, allVersions bool, names map[uuid.UUID]string) ([]Workflow, []string, error) {
+	res := make([]Workflow, 0)
+	warnings := make([]string, 0)
+
+	for offset := 0; ; offset += pageSize {
+		limit, offset := pageSize, offset
+
+		page, err := e.repo.Workflows().ListWorkflows(tenantId, &repository.ListWorkflowsOpts{
+			Limit:  &limit,
+			Offset: &offset,
+		})
+
+		if err != nil {
+			return nil, nil, err
+		}
+
+		for _, workflow := range page.Rows {
+			names[workflow.ID] = workflow.Name
+
+			versions, err := e.repo.Workflows().ListWorkflowVersions(ctx, tenantId, workflow.ID)
+
+			if err != nil {
+				return nil, nil, fmt.Errorf("could not list versions of workflow %s: %w", workflow.Name, err)
+			}
+
+			w := Workflow{
+				Name:     workflow.Name,
+				Versions: make([]*repository.CreateWorkflowVersionOpts, 0, len(versions)),
+			}
+
+			for _, version := range versions {
+				// versions registered before the options were stored can't be exported
+				if len(version.CreateWorkflowVersionOpts) == 0 {
+					warnings = append(warnings, fmt.Sprintf("version %s of workflow %s was registered before its options were stored and was not exported", version.ID, workflow.Name))
+					continue
+				}
+
+				opts, err := decodeWorkflowVersionOpts(version.CreateWorkflowVersionOpts)
+
+				if err != nil {
+					return nil, nil, fmt.Errorf("could not decode version %s of workflow %s: %w", version.ID, workflow.Name, err)
+				}
+
+				w.Versions = append(w.Versions, opts)
+			}
+
+			if len(w.Versions) == 0 {
+				warnings = append(warnings, fmt.Sprintf("workflow %s has no exportable versions, register it again to export it", workflow.Name))
+				continue
+			}
+
+			if !allVersions {
+				w.Versions = w.Versions[len(w.Versions)-1:]
+			}
+
+			res = append(res, w)
+		}
+
+		if len(page.Rows) < pageSize {
+			break
+		}
+	}
+
+	sort.Slice(res, func(i, j int) bool { return res[i].Name < res[j].Name })
+
+	return res, warnings, nil
+}
+
+// decodeWorkflowVersionOpts decodes the stored options of a workflow version. The orchestrator
+// task which is added to DAGs run by the DAG operator is removed, since it's added again when the
+// version is registered.
+func decodeWorkflowVersionOpts(data []byte) (*repository.CreateWorkflowVersionOpts, error) {
+	var opts repository.CreateWorkflowVersionOpts
+
+	if err := json.Unmarshal(data, &opts); err != nil {
+		return nil, err
+	}
+
+	tasks := make([]repository.CreateStepOpts, 0, len(opts.Tasks))
+
+	for _, task := range opts.Tasks {
+		if !task.IsDagOrchestrator {
+			tasks = append(tasks, task)
+		}
+	}
+
+	opts.Tasks = tasks
+
+	return &opts, nil
+}
+
+func (e *Exporter) exportCrons(ctx context.Context, tenantId uuid.UUID) ([]Cron, error) {
+	res := make([]Cron, 0)
+
+	for offset := 0; ; offset += pageSize {
+		limit, offset := pageSize, offset
+
+		rows, _, err := e.repo.WorkflowSchedules().ListCronWorkflows(ctx, tenantId, &repository.ListCronWorkflowsOpts{
+			Limit:  &limit,
+			Offset: &offset,
+		})
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, row := range rows {
+			// crons declared on a workflow are registered with the workflow
+			if row.Method != sqlcv1.WorkflowTriggerCronRefMethodsAPI || !row.Name.Valid {
+				continue
+			}
+
+			input, err := normalizeJSON(row.Input)
+
+			if err != nil {
+				return nil, fmt.Errorf("could not decode input of cron %s: %w", row.Name.String, err)
+			}
+
+			additionalMetadata, err := normalizeJSON(row.AdditionalMetadata)
+
+			if err != nil {
+				return nil, fmt.Errorf("could not decode additional metadata of cron %s: %w", row.Name.String, err)
+			}
+
+			res = append(res, Cron{
+				Workflow:           row.WorkflowName,
+				Name:               row.Name.String,
+				Expression:         row.Cron,
+				Input:              input,
+				AdditionalMetadata: additionalMetadata,
+				Priority:           row.Priority,
+				Enabled:            row.Enabled,
+			})
+		}
+
+		if len(rows) < pageSize {
+			break
+		}
+	}
+
+	sort.Slice(res, func(i, j int) bool {
+		if res[i].Workflow != res[j].Workflow {
+			return res[i].Workflow < res[j].Workflow
+		}
+
+		return res[i].Name < res[j].Name
+	})
+
+	return res, nil
+}
+
+func (e *Exporter) exportScheduledRuns(ctx context.Context, tenantId uuid.UUID) ([]ScheduledRun, error) {
+	res := make([]ScheduledRun, 0)
+	now := time.Now()
+	statuses := []sqlcv1.WorkflowRunStatus{"SCHEDULED"}
+
+	for offset := 0; ; offset += pageSize {
+		limit, offset := pageSize, offset
+
+		rows, _, err := e.repo.WorkflowSchedules().ListScheduledWorkflows(ctx, tenantId, &repository.ListScheduledWorkflowsOpts{
+			Limit:    &limit,
+			Offset:   &offset,
+			Statuses: &statuses,
+		})
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, row := range rows {
+			// only runs which were scheduled through the API and haven't been triggered yet are exported
+			if row.Method != sqlcv1.WorkflowTriggerScheduledRefMethodsAPI || row.WorkflowRunId != nil || !row.TriggerAt.Time.After(now) {
+				continue
+			}
+
+			input, err := normalizeJSON(row.Input)
+
+			if err != nil {
+				return nil, fmt.Errorf("could not decode input of scheduled run %s: %w", row.ID, err)
+			}
+
+			additionalMetadata, err := normalizeJSON(row.AdditionalMetadata)
+
+			if err != nil {
+				return nil, fmt.Errorf("could not decode additional metadata of scheduled run %s: %w", row.ID, err)
+			}
+
+			res = append(res, ScheduledRun{
+				Workflow:           row.Name,
+				TriggerAt:          row.TriggerAt.Time.UTC(),
+				Input:              input,
+				AdditionalMetadata: additionalMetadata,
+				Priority:           row.Priority,
+			})
+		}
+
+		if len(rows) < pageSize {
+			break
+		}
+	}
+
+	sort.SliceStable(res, func(i, j int) bool {
+		if res[i].Workflow != res[j].Workflow {
+			return res[i].Workflow < res[j].Workflow
+		}
+
+		return res[i].TriggerAt.Before(res[j].TriggerAt)
+	})
+
+	return res, nil
+}
+
+func (e *Exporter) exportFilters(ctx context.Context, tenantId uuid.UUID, workflowNames map[uuid.UUID]string) ([]Filter, error) {
+	res := make([]Filter, 0)
+
+	for offset := int64(0); ; offset += pageSize {
+		rows, _, err := e.repo.Filters().ListFilters(ctx, tenantId, repository.ListFiltersOpts{
+			Limit:  pageSize,
+			Offset: offset,
+		})
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, row := range rows {
+			workflow, ok := workflowNames[row.WorkflowID]
+
+			// declarative filters are registered with their workflow
+			if row.IsDeclarative || !ok {
+				continue
+			}
+
+			payload, err := normalizeJSON(row.Payload)
+
+			if err != nil {
+				return nil, fmt.Errorf("could not decode payload of filter %s: %w", row.ID, err)
+			}
+
+			res = append(res, Filter{
+				Workflow:   workflow,
+				Scope:      row.Scope,
+				Expression: row.Expression,
+				Payload:    payload,
+			})
+		}
+
+		if len(rows) < pageSize {
+			break
+		}
+	}
+
+	sort.SliceStable(res, func(i, j int) bool {
+		if res[i].Workflow != res[j].Workflow {
+			return res[i].Workflow < res[j].Workflow
+		}
+
+		if res[i].Scope != res[j].Scope {
+			return res[i].Scope < res[j].Scope
+		}
+
+		return res[i].Expression < res[j].Expression
+	})
+
+	return res, nil
+}
+
+func (e *Exporter) exportWebhooks(ctx context.Context, tenantId uuid.UUID) ([]Webhook, error) {
+	rows, err := e.repo.Webhooks().ListWebhooks(ctx, tenantId, repository.ListWebhooksOpts{})
+
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]Webhook, 0, len(rows))
+
+	for _, row := range rows {
+		staticPayload, err := normalizeJSON(row.StaticPayload)
+
+		if err != nil {
+			return nil, fmt.Errorf("could not decode static payload of webhook %s: %w", row.Name, err)
+		}
+
+		w := Webhook{
+			Name:                         row.Name,
+			SourceName:                   string(row.SourceName),
+			EventKeyExpression:           row.EventKeyExpression,
+			StaticPayload:                staticPayload,
+			ReturnEventAsResponsePayload: row.ReturnEventAsResponsePayload,
+			Auth: WebhookAuth{
+				Type: string(row.AuthMethod),
+			},
+		}
+
+		if row.ScopeExpression.Valid {
+			w.ScopeExpression = &row.ScopeExpression.String
+		}
+
+		var encrypted []byte
+
+		switch row.AuthMethod {
+		case sqlcv1.V1IncomingWebhookAuthTypeBASIC:
+			w.Auth.Username = row.AuthBasicUsername.String
+			encrypted = row.AuthBasicPassword
+		case sqlcv1.V1IncomingWebhookAuthTypeAPIKEY:
+			w.Auth.HeaderName = row.AuthApiKeyHeaderName.String
+			encrypted = row.AuthApiKeyKey
+		case sqlcv1.V1IncomingWebhookAuthTypeHMAC:
+			w.Auth.Algorithm = string(row.AuthHmacAlgorithm.V1IncomingWebhookHmacAlgorithm)
+			w.Auth.Encoding = string(row.AuthHmacEncoding.V1IncomingWebhookHmacEncoding)
+			w.Auth.SignatureHeaderName = row.AuthHmacSignatureHeaderName.String
+			encrypted = row.AuthHmacWebhookSigningSecret
+		default:
+			return nil, fmt.Errorf("webhook %s has unsupported auth method %s", row.Name, row.AuthMethod)
+		}
+
+		secret, err := e.enc.Decrypt(encrypted, webhookSecretDataIds[row.AuthMethod])
+
+		if err != nil {
+			return nil, fmt.Errorf("could not decrypt secret of webhook %s: %w", row.Name, err)
+		}
+
+		w.Secret = string(secret)
+
+		res = append(res, w)
+	}
+
+	sort.Slice(res, func(i, j int) bool { return res[i].Name < res[j].Name })
+
+	return res, nil
+}
+
+func (e *Exporter) exportRateLimits(ctx context.Context, tenantId uuid.UUID) ([]RateLimit, error) {
+	res := make([]RateLimit, 0)
+
+	for offset := 0; ; offset += pageSize {
+		limit, offset := pageSize, offset
+
+		page, err := e.repo.RateLimit().ListRateLimits(ctx, tenantId, &repository.ListRateLimitOpts{
+			Limit:  &limit,
+			Offset: &offset,
+		})
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, row := range page.Rows {
+			res = append(res, RateLimit{
+				Key:      row.Key,
+				Limit:    row.LimitValue,
+				Duration: rateLimitDuration(row.Window),
+			})
+		}
+
+		if len(page.Rows) < pageSize {
+			break
+		}
+	}
+
+	sort.Slice(res, func(i, j int) bool { return res[i].Key < res[j].Key })
+
+	return res, nil
+}
+
+// rateLimitDuration returns the duration a rate limit was upserted with from its window, which is
+// stored as an interval of one unit, for example `1 MINUTE`.
+func rateLimitDuration(window string) string {
+	return strings.TrimPrefix(strings.ToUpper(strings.TrimSpace(window)), "1 ")
+}
+
+func (e *Exporter) exportOperators(ctx context.Context, tenantId uuid.UUID) ([]Operator, error) {
+	res := make([]Operator, 0)
+
+	for offset := int64(0); ; offset += pageSize {
+		rows, _, err := e.repo.Operators().ListOperators(ctx, tenantId, repository.ListOperatorsOpts{
+			Limit:  pageSize,
+			Offset: offset,
+		})
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, row := range rows {
+			o := Operator{
+				Name: row.Name,
+				Kind: string(row.Kind),
+			}
+
+			var config map[string]interface{}
+
+			if err := json.Unmarshal(row.Config, &config); err != nil {
+				return nil, fmt.Errorf("could not decode config of operator %s: %w", row.Name, err)
+			}
+
+			if operatorHasSecret(&o) {
+				encrypted, _ := config[signingSecretKey].(string)
+				delete(config, signingSecretKey)
+
+				if encrypted != "" {
+					if o.Secret, err = e.enc.DecryptString(encrypted, httpoperator.SigningSecretEncryptionDataID); err != nil {
+						return nil, fmt.Errorf("could not decrypt signing secret of operator %s: %w", row.Name, err)
+					}
+				}
+			}
+
+			if o.Config, err = json.Marshal(config); err != nil {
+				return nil, err
+			}
+
+			res = append(res, o)
+		}
+
+		if len(rows) < pageSize {
+			break
+		}
+	}
+
+	sort.Slice(res, func(i, j int) bool {
+		if res[i].Kind != res[j].Kind {
+			return res[i].Kind < res[j].Kind
+		}
+
+		return res[i].Name < res[j].Name
+	})
+
+	return res, nil
+}
+
+// operatorHasSecret returns whether an operator has a signing secret in its config.
+func operatorHasSecret(o *Operator) bool {
+	return o.Kind == string(sqlcv1.V1OperatorKindHTTPAPI)
+}
+
+func (e *Exporter) exportEventSchemas(ctx context.Context, tenantId uuid.UUID) ([]EventSchema, error) {
+	rows, err := e.repo.EventSchemas().ListEventSchemas(ctx, tenantId)
+
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]EventSchema, 0, len(rows))
+
+	for _, row := range rows {
+		schema, err := normalizeJSON(row.Schema)
+
+		if err != nil {
+			return nil, fmt.Errorf("could not decode the schema for %s: %w", row.EventKey, err)
+		}
+
+		res = append(res, EventSchema{
+			EventKey: row.EventKey,
+			Mode:     string(row.Mode),
+			Schema:   schema,
+		})
+	}
+
+	sort.Slice(res, func(i, j int) bool { return res[i].EventKey < res[j].EventKey })
+
+	return res, nil
+}
+
+func (e *Exporter) exportAlerting(ctx context.Context, tenantId uuid.UUID) (*Alerting, error) {
+	res := &Alerting{
+		EmailGroups: make([]AlertEmailGroup, 0),
+	}
+
+	settings, err := e.repo.TenantAlertingSettings().GetTenantAlertingSettings(ctx, tenantId)
+
+	switch {
+	case errors.Is(err, pgx.ErrNoRows):
+		return nil, nil
+	case err != nil:
+		return nil, err
+	}
+
+	res.MaxFrequency = settings.Settings.MaxFrequency
+	res.EnableExpiringTokenAlerts = settings.Settings.EnableExpiringTokenAlerts
+	res.EnableWorkflowRunFailureAlerts = settings.Settings.EnableWorkflowRunFailureAlerts
+	res.EnableTenantResourceLimitAlerts = settings.Settings.EnableTenantResourceLimitAlerts
+
+	groups, err := e.repo.TenantAlertingSettings().ListTenantAlertGroups(ctx, tenantId)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, group := range groups {
+		res.EmailGroups = append(res.EmailGroups, AlertEmailGroup{
+			Emails: splitEmails(group.Emails),
+		})
+	}
+
+	sort.Slice(res.EmailGroups, func(i, j int) bool {
+		return emailGroupKey(res.EmailGroups[i].Emails) < emailGroupKey(res.EmailGroups[j].Emails)
+	})
+
+	return res, nil
+}
+
+func splitEmails(emails string) []string {
+	res := make([]string, 0)
+
+	for _, email := range strings.Split(emails, ",") {
+		if email = strings.TrimSpace(email); email != "" {
+			res = append(res, email)
+		}
+	}
+
+	sort.Strings(res)
+
+	return res
+}
+
+func (e *Exporter) exportResourceLimits(ctx context.Context, tenantId uuid.UUID) ([]ResourceLimit, error) {
+	rows, err := e.repo.TenantLimit().GetLimits(ctx, tenantId)
+
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]ResourceLimit, 0, len(rows))
+
+	for _, row := range rows {
+		res = append(res, ResourceLimit{
+			Resource: string(row.Resource),
+			Limit:    row.LimitValue,
+			Alarm:    row.AlarmValue.Int32,
+			Window:   row.Window.String,
+		})
+	}
+
+	sort.Slice(res, func(i, j int) bool { return res[i].Resource < res[j].Resource })
+
+	return res, nil
+}
@@ -0,0 +1,986 @@
+package tenantbundle
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/hatchet-dev/hatchet/pkg/encryption"
+	"github.com/hatchet-dev/hatchet/pkg/operator/httpoperator"
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/sqlcv1"
+	"github.com/hatchet-dev/hatchet/pkg/telemetry"
+)
+
+// ErrConflicts is returned when importing a bundle with the FAIL conflict strategy into a tenant
+// whose configuration differs from the bundle. Nothing is imported.
+var ErrConflicts = errors.New("the bundle conflicts with the configuration of the tenant")
+
+// ConflictStrategy determines what happens to a resource in the bundle which already exists in the
+// tenant with a different configuration.
+type ConflictStrategy string
+
+const (
+	// ConflictStrategySkip keeps the existing resource.
+	ConflictStrategySkip ConflictStrategy = "SKIP"
+
+	// ConflictStrategyOverwrite replaces the existing resource with the one in the bundle. For
+	// workflows, the version in the bundle is registered as a new version.
+	ConflictStrategyOverwrite ConflictStrategy = "OVERWRITE"
+
+	// ConflictStrategyFail aborts the import before anything is imported.
+	ConflictStrategyFail ConflictStrategy = "FAIL"
+)
+
+// Action is what an import does with a resource in the bundle.
+type Action string
+
+const (
+	ActionCreate    Action = "CREATE"
+	ActionUpdate    Action = "UPDATE"
+	ActionUnchanged Action = "UNCHANGED"
+	ActionSkip      Action = "SKIP"
+	ActionConflict  Action = "CONFLICT"
+)
+
+const (
+	resourceWorkflow        = "workflow"
+	resourceCron            = "cron"
+	resourceScheduledRun    = "scheduled_run"
+	resourceFilter          = "filter"
+	resourceWebhook         = "webhook"
+	resourceRateLimit       = "rate_limit"
+	resourceOperator        = "operator"
+	resourceEventSchema     = "event_schema"
+	resourceAlerting        = "alerting_settings"
+	resourceAlertEmailGroup = "alert_email_group"
+	resourceResourceLimit   = "resource_limit"
+)
+
+type ImportItem struct {
+	Resource string `json:"resource"`
+	Name     string `json:"name"`
+	Action   Action `json:"action"`
+	Reason   string `json:"reason,omitempty"`
+}
+
+type ImportResult struct {
+	DryRun bool `json:"dryRun"`
+
+	// Applied is whether the import was applied to the tenant. It's false for a dry run, or when the
+	// import was aborted because of conflicts.
+	Applied bool `json:"applied"`
+
+	Items []ImportItem `json:"items"`
+}
+
+// Count returns the number of items with an action.
+func (r *ImportResult) Count(action Action) int {
+	count := 0
+
+	for _, item := range r.Items {
+		if item.Action == action {
+			count++
+		}
+	}
+
+	return count
+}
+
+type ImportOpts struct {
+	// Passphrase decrypts the secrets in the bundle. It's required if the bundle holds secrets.
+	Passphrase string
+
+	// ConflictStrategy determines what happens to resources which already exist in the tenant with
+	// a different configuration. Defaults to ConflictStrategySkip.
+	ConflictStrategy ConflictStrategy
+
+	// DryRun plans the import without applying it.
+	DryRun bool
+
+	// ImportResourceLimits imports the resource limits in the bundle. Tenant admins mustn't be able
+	// to raise their own limits, so only hatchet-admin sets it. Otherwise, the resource limits are
+	// skipped.
+	ImportResourceLimits bool
+}
+
+type Importer struct {
+	repo     repository.Repository
+	enc      encryption.EncryptionService
+	exporter *Exporter
+}
+
+func NewImporter(repo repository.Repository, enc encryption.EncryptionService) *Importer {
+	return &Importer{
+		repo:     repo,
+		enc:      enc,
+		exporter: NewExporter(repo, enc),
+	}
+}
+
+// Import imports a bundle into a tenant. Resources which already exist in the tenant with the same
+// configuration are left unchanged, and resources in the tenant which aren't in the bundle are
+// kept. The import isn't transactional: if it fails part of the way, the resources before the
+// failed one are imported, and the import can be run again once the failure is fixed.
+func (i *Importer) Import(ctx context.Context, tenantId uuid.UUID, b *Bundle, opts ImportOpts) (*ImportResult, error) {
+	ctx, span := telemetry.NewSpan(ctx, "import-tenant-bundle")
+	defer span.End()
+
+	strategy := opts.ConflictStrategy
+
+	if strategy == "" {
+		strategy = ConflictStrategySkip
+	}
+
+	if strategy != ConflictStrategySkip && strategy != ConflictStrategyOverwrite && strategy != ConflictStrategyFail {
+		return nil, fmt.Errorf("unsupported conflict strategy %q", strategy)
+	}
+
+	if err := b.Validate(); err != nil {
+		return nil, err
+	}
+
+	withSecrets := b.Secrets != nil
+
+	// the secrets are decrypted on a copy, so the bundle of the caller isn't modified
+	src := *b
+	src.Webhooks = append([]Webhook(nil), b.Webhooks...)
+	src.Operators = append([]Operator(nil), b.Operators...)
+
+	if err := openSecrets(&src, opts.Passphrase); err != nil {
+		return nil, err
+	}
+
+	target, _, err := i.exporter.snapshot(ctx, tenantId, false)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not read the configuration of the tenant: %w", err)
+	}
+
+	steps := plan(&src, target, strategy, withSecrets, opts.ImportResourceLimits, time.Now())
+
+	res := &ImportResult{
+		DryRun: opts.DryRun,
+		Items:  make([]ImportItem, 0, len(steps)),
+	}
+
+	for _, s := range steps {
+		res.Items = append(res.Items, s.ImportItem)
+	}
+
+	if res.Count(ActionConflict) > 0 {
+		return res, ErrConflicts
+	}
+
+	if opts.DryRun {
+		return res, nil
+	}
+
+	a := &applier{
+		repo:        i.repo,
+		enc:         i.enc,
+		tenantId:    tenantId,
+		workflowIds: make(map[string]uuid.UUID),
+	}
+
+	for _, s := range steps {
+		if s.apply == nil {
+			continue
+		}
+
+		if err := s.apply(ctx, a); err != nil {
+			return res, fmt.Errorf("could not import %s %s: %w", strings.ReplaceAll(s.Resource, "_", " "), s.Name, err)
+		}
+	}
+
+	res.Applied = true
+
+	return res, nil
+}
+
+// step is the planned import of a resource. apply is nil if nothing needs to be done.
+type step struct {
+	ImportItem
+	apply func(ctx context.Context, a *applier) error
+}
+
+// plan plans the import of a bundle with plaintext secrets into a tenant whose configuration is
+// target. Resources are imported in dependency order: operators and rate limits before the
+// workflows which use them, and workflows before the resources which refer to them.
+func plan(b, target *Bundle, strategy ConflictStrategy, withSecrets, withResourceLimits bool, now time.Time) []step {
+	steps := make([]step, 0)
+
+	steps = append(steps, planOperators(b, target, strategy, withSecrets)...)
+	steps = append(steps, planRateLimits(b, target, strategy)...)
+	steps = append(steps, planWorkflows(b, target, strategy)...)
+
+	workflows := make(map[string]bool)
+
+	for _, w := range b.Workflows {
+		workflows[w.Name] = true
+	}
+
+	for _, w := range target.Workflows {
+		workflows[w.Name] = true
+	}
+
+	steps = append(steps, planCrons(b, target, strategy, workflows)...)
+	steps = append(steps, planScheduledRuns(b, target, workflows, now)...)
+	steps = append(steps, planFilters(b, target, workflows)...)
+	steps = append(steps, planWebhooks(b, target, strategy, withSecrets)...)
+	steps = append(steps, planEventSchemas(b, target, strategy)...)
+	steps = append(steps, planAlerting(b, target, strategy)...)
+	steps = append(steps, planResourceLimits(b, target, strategy, withResourceLimits)...)
+
+	return steps
+}
+
+// planResource plans the import of a resource which might already exist in the tenant.
+func planResource(resource, name string, exists, same bool, strategy ConflictStrategy, create, update func(ctx context.Context, a *applier) error) step {
+	item := ImportItem{
+		Resource: resource,
+		Name:     name,
+	}
+
+	switch {
+	case !exists:
+		item.Action = ActionCreate
+		return step{ImportItem: item, apply: create}
+	case same:
+		item.Action = ActionUnchanged
+	case strategy == ConflictStrategyOverwrite:
+		item.Action = ActionUpdate
+		return step{ImportItem: item, apply: update}
+	case strategy == ConflictStrategyFail:
+		item.Action = ActionConflict
+		item.Reason = "differs from the existing " + strings.ReplaceAll(resource, "_", " ")
+	default:
+		item.Action = ActionSkip
+		item.Reason = "differs from the existing " + strings.ReplaceAll(resource, "_", " ")
+	}
+
+	return step{ImportItem: item}
+}
+
+func skipResource(resource, name, reason string) step {
+	return step{ImportItem: ImportItem{
+		Resource: resource,
+		Name:     name,
+		Action:   ActionSkip,
+		Reason:   reason,
+	}}
+}
+
+// sameJSON returns whether two values have the same JSON encoding.
+func sameJSON(a, b interface{}) bool {
+	aj, err := json.Marshal(a)
+
+	if err != nil {
+		return false
+	}
+
+	bj, err := json.Marshal(b)
+
+	if err != nil {
+		return false
+	}
+
+	return bytes.Equal(aj, bj)
+}
+
+const missingSecretReason = "the bundle was exported without a passphrase, so it holds no secret to create it with"
+
+func planOperators(b, target *Bundle, strategy ConflictStrategy, withSecrets bool) []step {
+	existing := make(map[string]Operator)
+
+	for _, o := range target.Operators {
+		existing[operatorSecretRef(&o)] = o
+	}
+
+	steps := make([]step, 0, len(b.Operators))
+
+	for _, o := range b.Operators {
+		name := strings.ToLower(o.Kind) + "/" + o.Name
+		current, exists := existing[operatorSecretRef(&o)]
+
+		if !withSecrets && operatorHasSecret(&o) {
+			if !exists {
+				steps = append(steps, skipResource(resourceOperator, name, missingSecretReason))
+				continue
+			}
+
+			o.Secret = current.Secret
+		}
+
+		steps = append(steps, planResource(resourceOperator, name, exists, sameJSON(o, current), strategy,
+			func(ctx context.Context, a *applier) error { return a.createOperator(ctx, &o) },
+			func(ctx context.Context, a *applier) error { return a.updateOperator(ctx, &o) },
+		))
+	}
+
+	return steps
+}
+
+func planRateLimits(b, target *Bundle, strategy ConflictStrategy) []step {
+	existing := make(map[string]RateLimit)
+
+	for _, rl := range target.RateLimits {
+		existing[rl.Key] = rl
+	}
+
+	steps := make([]step, 0, len(b.RateLimits))
+
+	for _, rl := range b.RateLimits {
+		current, exists := existing[rl.Key]
+		upsert := func(ctx context.Context, a *applier) error { return a.upsertRateLimit(ctx, &rl) }
+
+		steps = append(steps, planResource(resourceRateLimit, rl.Key, exists, rl == current, strategy, upsert, upsert))
+	}
+
+	return steps
+}
+
+func planWorkflows(b, target *Bundle, strategy ConflictStrategy) []step {
+	existing := make(map[string]Workflow)
+
+	for _, w := range target.Workflows {
+		existing[w.Name] = w
+	}
+
+	steps := make([]step, 0, len(b.Workflows))
+
+	for _, w := range b.Workflows {
+		current, exists := existing[w.Name]
+		latest := w.Versions[len(w.Versions)-1]
+
+		same := exists && len(current.Versions) > 0 && sameJSON(latest, current.Versions[len(current.Versions)-1])
+
+		steps = append(steps, planResource(resourceWorkflow, w.Name, exists, same, strategy,
+			// a new workflow is registered with every version in the bundle, so its history is kept
+			func(ctx context.Context, a *applier) error { return a.putWorkflowVersions(ctx, w.Versions) },
+			func(ctx context.Context, a *applier) error {
+				return a.putWorkflowVersions(ctx, []*repository.CreateWorkflowVersionOpts{latest})
+			},
+		))
+	}
+
+	return steps
+}
+
+func planCrons(b, target *Bundle, strategy ConflictStrategy, workflows map[string]bool) []step {
+	existing := make(map[string]Cron)
+
+	for _, c := range target.Crons {
+		existing[c.Workflow+"/"+c.Name] = c
+	}
+
+	steps := make([]step, 0, len(b.Crons))
+
+	for _, c := range b.Crons {
+		name := c.Workflow + "/" + c.Name
+
+		if !workflows[c.Workflow] {
+			steps = append(steps, skipResource(resourceCron, name, fmt.Sprintf("workflow %s doesn't exist", c.Workflow)))
+			continue
+		}
+
+		current, exists := existing[name]
+
+		steps = append(steps, planResource(resourceCron, name, exists, sameJSON(c, current), strategy,
+			func(ctx context.Context, a *applier) error { return a.createCron(ctx, &c) },
+			func(ctx context.Context, a *applier) error { return a.replaceCron(ctx, &c) },
+		))
+	}
+
+	return steps
+}
+
+// planScheduledRuns plans the import of scheduled runs. Scheduled runs have no name, so a run is
+// only considered to exist if the tenant has a run with the same workflow, trigger time, input,
+// metadata and priority.
+func planScheduledRuns(b, target *Bundle, workflows map[string]bool, now time.Time) []step {
+	steps := make([]step, 0, len(b.ScheduledRuns))
+
+	for _, r := range b.ScheduledRuns {
+		name := r.Workflow + "@" + r.TriggerAt.UTC().Format(time.RFC3339)
+
+		if !workflows[r.Workflow] {
+			steps = append(steps, skipResource(resourceScheduledRun, name, fmt.Sprintf("workflow %s doesn't exist", r.Workflow)))
+			continue
+		}
+
+		exists := false
+
+		for _, current := range target.ScheduledRuns {
+			if sameJSON(r, current) {
+				exists = true
+				break
+			}
+		}
+
+		if !exists && !r.TriggerAt.After(now) {
+			steps = append(steps, skipResource(resourceScheduledRun, name, "the trigger time has passed"))
+			continue
+		}
+
+		steps = append(steps, planResource(resourceScheduledRun, name, exists, true, ConflictStrategySkip,
+			func(ctx context.Context, a *applier) error { return a.createScheduledRun(ctx, &r) },
+			nil,
+		))
+	}
+
+	return steps
+}
+
+// planFilters plans the import of filters. A filter is identified by all of its fields, so a
+// filter either exists in the tenant or is created.
+func planFilters(b, target *Bundle, workflows map[string]bool) []step {
+	steps := make([]step, 0, len(b.Filters))
+
+	for _, f := range b.Filters {
+		name := f.Workflow + "/" + f.Scope + ": " + f.Expression
+
+		if !workflows[f.Workflow] {
+			steps = append(steps, skipResource(resourceFilter, name, fmt.Sprintf("workflow %s doesn't exist", f.Workflow)))
+			continue
+		}
+
+		exists := false
+
+		for _, current := range target.Filters {
+			if sameJSON(f, current) {
+				exists = true
+				break
+			}
+		}
+
+		steps = append(steps, planResource(resourceFilter, name, exists, true, ConflictStrategySkip,
+			func(ctx context.Context, a *applier) error { return a.createFilter(ctx, &f) },
+			nil,
+		))
+	}
+
+	return steps
+}
+
+func planWebhooks(b, target *Bundle, strategy ConflictStrategy, withSecrets bool) []step {
+	existing := make(map[string]Webhook)
+
+	for _, w := range target.Webhooks {
+		existing[w.Name] = w
+	}
+
+	steps := make([]step, 0, len(b.Webhooks))
+
+	for _, w := range b.Webhooks {
+		current, exists := existing[w.Name]
+
+		if !withSecrets {
+			if !exists {
+				steps = append(steps, skipResource(resourceWebhook, w.Name, missingSecretReason))
+				continue
+			}
+
+			w.Secret = current.Secret
+		}
+
+		// the expressions and payload of a webhook can be updated in place, but its source and
+		// auth can't, so the webhook is recreated if they changed
+		inPlace := w.SourceName == current.SourceName && w.Auth == current.Auth && w.Secret == current.Secret &&
+			(w.ScopeExpression != nil || current.ScopeExpression == nil) &&
+			(w.StaticPayload != nil || current.StaticPayload == nil)
+
+		steps = append(steps, planResource(resourceWebhook, w.Name, exists, sameJSON(w, current), strategy,
+			func(ctx context.Context, a *applier) error { return a.createWebhook(ctx, &w) },
+			func(ctx context.Context, a *applier) error {
+				if inPlace {
+					return a.updateWebhook(ctx, &w)
+				}
+
+				return a.replaceWebhook(ctx, &w)
+			},
+		))
+	}
+
+	return steps
+}
+
+func planEventSchemas(b, target *Bundle, strategy ConflictStrategy) []step {
+	existing := make(map[string]EventSchema)
+
+	for _, s := range target.EventSchemas {
+		existing[s.EventKey] = s
+	}
+
+	steps := make([]step, 0, len(b.EventSchemas))
+
+	for _, s := range b.EventSchemas {
+		current, exists := existing[s.EventKey]
+
+		// registering a schema adds a new version, which becomes the active one
+		register := func(ctx context.Context, a *applier) error { return a.registerEventSchema(ctx, &s) }
+
+		steps = append(steps, planResource(resourceEventSchema, s.EventKey, exists, sameJSON(s, current), strategy, register, register))
+	}
+
+	return steps
+}
+
+func planAlerting(b, target *Bundle, strategy ConflictStrategy) []step {
+	if b.Alerting == nil {
+		return nil
+	}
+
+	steps := make([]step, 0, 1+len(b.Alerting.EmailGroups))
+
+	settings := *b.Alerting
+	settings.EmailGroups = nil
+
+	var current Alerting
+
+	if target.Alerting != nil {
+		current = *target.Alerting
+		current.EmailGroups = nil
+	}
+
+	upsert := func(ctx context.Context, a *applier) error { return a.upsertAlertingSettings(ctx, &settings) }
+
+	steps = append(steps, planResource(resourceAlerting, "settings", target.Alerting != nil, sameJSON(settings, current), strategy, upsert, upsert))
+
+	existing := make(map[string]bool)
+
+	if target.Alerting != nil {
+		for _, g := range target.Alerting.EmailGroups {
+			existing[emailGroupKey(g.Emails)] = true
+		}
+	}
+
+	// email groups have no name, so a group either exists in the tenant or is created
+	for _, g := range b.Alerting.EmailGroups {
+		key := emailGroupKey(g.Emails)
+
+		steps = append(steps, planResource(resourceAlertEmailGroup, key, existing[key], true, ConflictStrategySkip,
+			func(ctx context.Context, a *applier) error { return a.createAlertEmailGroup(ctx, &g) },
+			nil,
+		))
+	}
+
+	return steps
+}
+
+// emailGroupKey identifies an email group by its emails, regardless of their order.
+func emailGroupKey(emails []string) string {
+	sorted := append([]string(nil), emails...)
+	sort.Strings(sorted)
+
+	return strings.Join(sorted, ",")
+}
+
+func planResourceLimits(b, target *Bundle, strategy ConflictStrategy, withResourceLimits bool) []step {
+	if !withResourceLimits {
+		steps := make([]step, 0, len(b.ResourceLimits))
+
+		for _, l := range b.ResourceLimits {
+			steps = append(steps, step{ImportItem: ImportItem{
+				Resource: resourceResourceLimit,
+				Name:     l.Resource,
+				Action:   ActionSkip,
+				Reason:   "resource limits can only be imported with hatchet-admin",
+			}})
+		}
+
+		return steps
+	}
+
+	existing := make(map[string]ResourceLimit)
+
+	for _, l := range target.ResourceLimits {
+		existing[l.Resource] = l
+	}
+
+	steps := make([]step, 0, len(b.ResourceLimits))
+
+	for _, l := range b.ResourceLimits {
+		current, exists := existing[l.Resource]
+		update := func(ctx context.Context, a *applier) error { return a.updateResourceLimit(ctx, &l) }
+
+		steps = append(steps, planResource(resourceResourceLimit, l.Resource, exists, l == current, strategy, update, update))
+	}
+
+	return steps
+}
+
+// applier applies the planned import of a bundle to a tenant.
+type applier struct {
+	repo     repository.Repository
+	enc      encryption.EncryptionService
+	tenantId uuid.UUID
+
+	// workflowIds caches the ids of the workflows in the tenant by name
+	workflowIds map[string]uuid.UUID
+}
+
+func (a *applier) workflowId(ctx context.Context, name string) (uuid.UUID, error) {
+	if id, ok := a.workflowIds[name]; ok {
+		return id, nil
+	}
+
+	workflow, err := a.repo.Workflows().GetWorkflowByName(ctx, a.tenantId, name)
+
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("could not get workflow %s: %w", name, err)
+	}
+
+	a.workflowIds[name] = workflow.ID
+
+	return workflow.ID, nil
+}
+
+func (a *applier) operatorConfig(o *Operator) ([]byte, error) {
+	if !operatorHasSecret(o) {
+		return o.Config, nil
+	}
+
+	var config map[string]interface{}
+
+	if err := json.Unmarshal(o.Config, &config); err != nil {
+		return nil, fmt.Errorf("could not decode config: %w", err)
+	}
+
+	if config == nil {
+		config = make(map[string]interface{})
+	}
+
+	encrypted, err := a.enc.EncryptString(o.Secret, httpoperator.SigningSecretEncryptionDataID)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not encrypt signing secret: %w", err)
+	}
+
+	config[signingSecretKey] = encrypted
+
+	return json.Marshal(config)
+}
+
+func (a *applier) createOperator(ctx context.Context, o *Operator) error {
+	config, err := a.operatorConfig(o)
+
+	if err != nil {
+		return err
+	}
+
+	_, err = a.repo.Operators().CreateOperator(ctx, a.tenantId, repository.CreateOperatorOpts{
+		Name:   o.Name,
+		Kind:   sqlcv1.V1OperatorKind(o.Kind),
+		Config: config,
+	})
+
+	return err
+}
+
+func (a *applier) updateOperator(ctx context.Context, o *Operator) error {
+	config, err := a.operatorConfig(o)
+
+	if err != nil {
+		return err
+	}
+
+	kind := sqlcv1.V1OperatorKind(o.Kind)
+
+	operators, _, err := a.repo.Operators().ListOperators(ctx, a.tenantId, repository.ListOperatorsOpts{
+		Kind:  &kind,
+		Limit: pageSize,
+	})
+
+	if err != nil {
+		return err
+	}
+
+	for _, operator := range operators {
+		if operator.Name != o.Name {
+			continue
+		}
+
+		_, err = a.repo.Operators().UpdateOperator(ctx, a.tenantId, operator.ID, repository.UpdateOperatorOpts{
+			Config: config,
+		})
+
+		return err
+	}
+
+	return fmt.Errorf("operator not found")
+}
+
+func (a *applier) upsertRateLimit(ctx context.Context, rl *RateLimit) error {
+	opts := &repository.UpsertRateLimitOpts{
+		Limit: int(rl.Limit),
+	}
+
+	if rl.Duration != "" {
+		opts.Duration = &rl.Duration
+	}
+
+	_, err := a.repo.RateLimit().UpsertRateLimit(ctx, a.tenantId, rl.Key, opts)
+
+	return err
+}
+
+func (a *applier) putWorkflowVersions(ctx context.Context, versions []*repository.CreateWorkflowVersionOpts) error {
+	for _, opts := range versions {
+		version, err := a.repo.Workflows().PutWorkflowVersion(ctx, a.tenantId, opts)
+
+		if err != nil {
+			return err
+		}
+
+		a.workflowIds[opts.Name] = version.WorkflowVersion.WorkflowId
+	}
+
+	return nil
+}
+
+func decodeObject(data json.RawMessage) (map[string]interface{}, error) {
+	if len(data) == 0 {
+		return nil, nil
+	}
+
+	var res map[string]interface{}
+
+	if err := json.Unmarshal(data, &res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (a *applier) createCron(ctx context.Context, c *Cron) error {
+	workflowId, err := a.workflowId(ctx, c.Workflow)
+
+	if err != nil {
+		return err
+	}
+
+	input, err := decodeObject(c.Input)
+
+	if err != nil {
+		return fmt.Errorf("could not decode input: %w", err)
+	}
+
+	additionalMetadata, err := decodeObject(c.AdditionalMetadata)
+
+	if err != nil {
+		return fmt.Errorf("could not decode additional metadata: %w", err)
+	}
+
+	cron, err := a.repo.WorkflowSchedules().CreateCronWorkflow(ctx, a.tenantId, &repository.CreateCronWorkflowTriggerOpts{
+		Input:              input,
+		AdditionalMetadata: additionalMetadata,
+		Priority:           &c.Priority,
+		WorkflowId:         workflowId,
+		Name:               c.Name,
+		Cron:               c.Expression,
+	})
+
+	if err != nil {
+		return err
+	}
+
+	if c.Enabled {
+		return nil
+	}
+
+	return a.repo.WorkflowSchedules().UpdateCronWorkflow(ctx, a.tenantId, cron.ID_2, &repository.UpdateCronOpts{
+		Enabled: &c.Enabled,
+	})
+}
+
+func (a *applier) replaceCron(ctx context.Context, c *Cron) error {
+	workflowId, err := a.workflowId(ctx, c.Workflow)
+
+	if err != nil {
+		return err
+	}
+
+	crons, _, err := a.repo.WorkflowSchedules().ListCronWorkflows(ctx, a.tenantId, &repository.ListCronWorkflowsOpts{
+		WorkflowId: &workflowId,
+		CronName:   &c.Name,
+	})
+
+	if err != nil {
+		return err
+	}
+
+	for _, cron := range crons {
+		if cron.Method != sqlcv1.WorkflowTriggerCronRefMethodsAPI || cron.Name.String != c.Name {
+			continue
+		}
+
+		if err := a.repo.WorkflowSchedules().DeleteCronWorkflow(ctx, a.tenantId, cron.ID_2); err != nil {
+			return err
+		}
+	}
+
+	return a.createCron(ctx, c)
+}
+
+func (a *applier) createScheduledRun(ctx context.Context, r *ScheduledRun) error {
+	workflowId, err := a.workflowId(ctx, r.Workflow)
+
+	if err != nil {
+		return err
+	}
+
+	_, err = a.repo.WorkflowSchedules().CreateScheduledWorkflow(ctx, a.tenantId, &repository.CreateScheduledWorkflowRunForWorkflowOpts{
+		ScheduledTrigger:   r.TriggerAt,
+		Priority:           &r.Priority,
+		WorkflowId:         workflowId,
+		Input:              r.Input,
+		AdditionalMetadata: r.AdditionalMetadata,
+	})
+
+	return err
+}
+
+func (a *applier) createFilter(ctx context.Context, f *Filter) error {
+	workflowId, err := a.workflowId(ctx, f.Workflow)
+
+	if err != nil {
+		return err
+	}
+
+	_, err = a.repo.Filters().CreateFilter(ctx, a.tenantId, repository.CreateFilterOpts{
+		Workflowid: workflowId,
+		Scope:      f.Scope,
+		Expression: f.Expression,
+		Payload:    f.Payload,
+	})
+
+	return err
+}
+
+func (a *applier) createWebhook(ctx context.Context, w *Webhook) error {
+	authType := sqlcv1.V1IncomingWebhookAuthType(w.Auth.Type)
+	dataId, ok := webhookSecretDataIds[authType]
+
+	if !ok {
+		return fmt.Errorf("unsupported auth type %s", w.Auth.Type)
+	}
+
+	encrypted, err := a.enc.Encrypt([]byte(w.Secret), dataId)
+
+	if err != nil {
+		return fmt.Errorf("could not encrypt secret: %w", err)
+	}
+
+	auth := repository.AuthConfig{
+		Type: authType,
+	}
+
+	switch authType {
+	case sqlcv1.V1IncomingWebhookAuthTypeBASIC:
+		auth.BasicAuth = &repository.BasicAuthCredentials{
+			Username:          w.Auth.Username,
+			EncryptedPassword: encrypted,
+		}
+	case sqlcv1.V1IncomingWebhookAuthTypeAPIKEY:
+		auth.APIKeyAuth = &repository.APIKeyAuthCredentials{
+			HeaderName:   w.Auth.HeaderName,
+			EncryptedKey: encrypted,
+		}
+	case sqlcv1.V1IncomingWebhookAuthTypeHMAC:
+		auth.HMACAuth = &repository.HMACAuthCredentials{
+			Algorithm:                     sqlcv1.V1IncomingWebhookHmacAlgorithm(w.Auth.Algorithm),
+			Encoding:                      sqlcv1.V1IncomingWebhookHmacEncoding(w.Auth.Encoding),
+			SignatureHeaderName:           w.Auth.SignatureHeaderName,
+			EncryptedWebhookSigningSecret: encrypted,
+		}
+	}
+
+	_, err = a.repo.Webhooks().CreateWebhook(ctx, a.tenantId, repository.CreateWebhookOpts{
+		Tenantid:                     a.tenantId,
+		Sourcename:                   sqlcv1.V1IncomingWebhookSourceName(w.SourceName),
+		Name:                         w.Name,
+		Eventkeyexpression:           w.EventKeyExpression,
+		ScopeExpression:              w.ScopeExpression,
+		StaticPayload:                w.StaticPayload,
+		ReturnEventAsResponsePayload: w.ReturnEventAsResponsePayload,
+		AuthConfig:                   auth,
+	})
+
+	return err
+}
+
+func (a *applier) updateWebhook(ctx context.Context, w *Webhook) error {
+	_, err := a.repo.Webhooks().UpdateWebhook(ctx, a.tenantId, w.Name, repository.UpdateWebhookOpts{
+		EventKeyExpression:           &w.EventKeyExpression,
+		ScopeExpression:              w.ScopeExpression,
+		StaticPayload:                w.StaticPayload,
+		ReturnEventAsResponsePayload: &w.ReturnEventAsResponsePayload,
+	})
+
+	return err
+}
+
+func (a *applier) replaceWebhook(ctx context.Context, w *Webhook) error {
+	if _, err := a.repo.Webhooks().DeleteWebhook(ctx, a.tenantId, w.Name); err != nil {
+		return err
+	}
+
+	return a.createWebhook(ctx, w)
+}
+
+func (a *applier) registerEventSchema(ctx context.Context, s *EventSchema) error {
+	_, err := a.repo.EventSchemas().RegisterEventSchema(ctx, a.tenantId, repository.RegisterEventSchemaOpts{
+		EventKey: s.EventKey,
+		Schema:   s.Schema,
+		Mode:     sqlcv1.V1EventSchemaMode(s.Mode),
+	})
+
+	return err
+}
+
+func (a *applier) upsertAlertingSettings(ctx context.Context, settings *Alerting) error {
+	_, err := a.repo.TenantAlertingSettings().UpsertTenantAlertingSettings(ctx, a.tenantId, &repository.UpsertTenantAlertingSettingsOpts{
+		MaxFrequency:                    &settings.MaxFrequency,
+		EnableExpiringTokenAlerts:       &settings.EnableExpiringTokenAlerts,
+		EnableWorkflowRunFailureAlerts:  &settings.EnableWorkflowRunFailureAlerts,
+		EnableTenantResourceLimitAlerts: &settings.EnableTenantResourceLimitAlerts,
+	})
+
+	return err
+}
+
+func (a *applier) createAlertEmailGroup(ctx context.Context, g *AlertEmailGroup) error {
+	_, err := a.repo.TenantAlertingSettings().CreateTenantAlertGroup(ctx, a.tenantId, &repository.CreateTenantAlertGroupOpts{
+		Emails: g.Emails,
+	})
+
+	return err
+}
+
+func (a *applier) updateResourceLimit(ctx context.Context, l *ResourceLimit) error {
+	limit := repository.Limit{
+		Resource: sqlcv1.LimitResource(l.Resource),
+		Limit:    l.Limit,
+		Alarm:    &l.Alarm,
+	}
+
+	if l.Window != "" {
+		window, err := time.ParseDuration(l.Window)
+
+		if err != nil {
+			return fmt.Errorf("invalid window %q: %w", l.Window, err)
+		}
+
+		limit.Window = &window
+	}
+
+	return a.repo.TenantLimit().UpdateLimits(ctx, a.tenantId, []repository.Limit{limit})
+}
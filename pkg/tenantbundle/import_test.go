@@ -0,0 +1,142 @@
+package tenantbundle
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+)
+
+func planItems(b, target *Bundle, strategy ConflictStrategy, withSecrets bool, now time.Time) map[string]ImportItem {
+	res := make(map[string]ImportItem)
+
+	for _, s := range plan(b, target, strategy, withSecrets, true, now) {
+		res[s.Resource+":"+s.Name] = s.ImportItem
+	}
+
+	return res
+}
+
+func TestPlanConflictStrategies(t *testing.T) {
+	now := time.Now()
+
+	b := &Bundle{
+		Version: Version,
+		Workflows: []Workflow{
+			{Name: "new", Versions: []*repository.CreateWorkflowVersionOpts{{Name: "new"}}},
+			{Name: "same", Versions: []*repository.CreateWorkflowVersionOpts{{Name: "same", EventTriggers: []string{"a"}}}},
+			{Name: "changed", Versions: []*repository.CreateWorkflowVersionOpts{{Name: "changed", EventTriggers: []string{"b"}}}},
+		},
+		RateLimits: []RateLimit{
+			{Key: "api", Limit: 10, Duration: "MINUTE"},
+		},
+	}
+
+	target := &Bundle{
+		Workflows: []Workflow{
+			{Name: "same", Versions: []*repository.CreateWorkflowVersionOpts{{Name: "same", EventTriggers: []string{"a"}}}},
+			{Name: "changed", Versions: []*repository.CreateWorkflowVersionOpts{{Name: "changed", EventTriggers: []string{"c"}}}},
+		},
+		RateLimits: []RateLimit{
+			{Key: "api", Limit: 20, Duration: "MINUTE"},
+		},
+	}
+
+	items := planItems(b, target, ConflictStrategySkip, false, now)
+
+	assert.Equal(t, ActionCreate, items["workflow:new"].Action)
+	assert.Equal(t, ActionUnchanged, items["workflow:same"].Action)
+	assert.Equal(t, ActionSkip, items["workflow:changed"].Action)
+	assert.Equal(t, ActionSkip, items["rate_limit:api"].Action)
+
+	items = planItems(b, target, ConflictStrategyOverwrite, false, now)
+
+	assert.Equal(t, ActionUpdate, items["workflow:changed"].Action)
+	assert.Equal(t, ActionUpdate, items["rate_limit:api"].Action)
+	assert.Equal(t, ActionUnchanged, items["workflow:same"].Action)
+
+	items = planItems(b, target, ConflictStrategyFail, false, now)
+
+	assert.Equal(t, ActionConflict, items["workflow:changed"].Action)
+	assert.Equal(t, ActionConflict, items["rate_limit:api"].Action)
+	assert.Equal(t, ActionCreate, items["workflow:new"].Action)
+}
+
+func TestPlanReferencesAndSecrets(t *testing.T) {
+	now := time.Now()
+
+	b := &Bundle{
+		Version: Version,
+		Workflows: []Workflow{
+			{Name: "imported", Versions: []*repository.CreateWorkflowVersionOpts{{Name: "imported"}}},
+		},
+		Crons: []Cron{
+			{Workflow: "imported", Name: "nightly", Expression: "0 0 * * *", Enabled: true},
+			{Workflow: "existing", Name: "hourly", Expression: "0 * * * *", Enabled: true},
+			{Workflow: "missing", Name: "weekly", Expression: "0 0 * * 0", Enabled: true},
+		},
+		ScheduledRuns: []ScheduledRun{
+			{Workflow: "imported", TriggerAt: now.Add(time.Hour)},
+			{Workflow: "imported", TriggerAt: now.Add(-time.Hour)},
+		},
+		Webhooks: []Webhook{
+			{Name: "stripe", Auth: WebhookAuth{Type: "HMAC"}},
+			{Name: "github", Auth: WebhookAuth{Type: "HMAC"}},
+		},
+	}
+
+	target := &Bundle{
+		Workflows: []Workflow{
+			{Name: "existing", Versions: []*repository.CreateWorkflowVersionOpts{{Name: "existing"}}},
+		},
+		Webhooks: []Webhook{
+			{Name: "github", Auth: WebhookAuth{Type: "HMAC"}, Secret: "gh_456"},
+		},
+	}
+
+	items := planItems(b, target, ConflictStrategySkip, false, now)
+
+	assert.Equal(t, ActionCreate, items["cron:imported/nightly"].Action)
+	assert.Equal(t, ActionCreate, items["cron:existing/hourly"].Action)
+	assert.Equal(t, ActionSkip, items["cron:missing/weekly"].Action)
+
+	assert.Equal(t, ActionCreate, items["scheduled_run:imported@"+now.Add(time.Hour).UTC().Format(time.RFC3339)].Action)
+	assert.Equal(t, ActionSkip, items["scheduled_run:imported@"+now.Add(-time.Hour).UTC().Format(time.RFC3339)].Action)
+
+	// without secrets, new webhooks can't be created, but existing ones keep their secret
+	assert.Equal(t, ActionSkip, items["webhook:stripe"].Action)
+	assert.Equal(t, ActionUnchanged, items["webhook:github"].Action)
+}
+
+func TestPlanResourceLimits(t *testing.T) {
+	now := time.Now()
+
+	b := &Bundle{
+		Version: Version,
+		ResourceLimits: []ResourceLimit{
+			{Resource: "TASK_RUN", Limit: 1000000, Alarm: 800000, Window: "24h"},
+		},
+	}
+
+	target := &Bundle{
+		ResourceLimits: []ResourceLimit{
+			{Resource: "TASK_RUN", Limit: 1000, Alarm: 800, Window: "24h"},
+		},
+	}
+
+	items := planItems(b, target, ConflictStrategyOverwrite, false, now)
+
+	assert.Equal(t, ActionUpdate, items["resource_limit:TASK_RUN"].Action)
+
+	// imports through the API can't raise the tenant's own limits
+	steps := plan(b, target, ConflictStrategyOverwrite, false, false, now)
+
+	assert.Len(t, steps, 1)
+
+	for _, s := range steps {
+		assert.Equal(t, ActionSkip, s.Action)
+		assert.Nil(t, s.apply)
+	}
+}
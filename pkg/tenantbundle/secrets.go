@@ -0,0 +1,188 @@
+package tenantbundle
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+
+	"golang.org/x/crypto/argon2"
+)
+
+const secretsAlgorithm = "argon2id+aes-256-gcm"
+
+var (
+	// ErrPassphraseRequired is returned when importing a bundle which holds secrets without a passphrase.
+	ErrPassphraseRequired = errors.New("the bundle holds secrets, a passphrase is required to import it")
+
+	// ErrInvalidPassphrase is returned when the secrets in a bundle can't be decrypted with the passphrase.
+	ErrInvalidPassphrase = errors.New("could not decrypt the secrets in the bundle, the passphrase is wrong")
+)
+
+// SecretsEncryption describes the key the secrets in a bundle are encrypted with, which is derived
+// from the passphrase the bundle was exported with.
+type SecretsEncryption struct {
+	Algorithm string `json:"algorithm"`
+	Salt      []byte `json:"salt"`
+	Time      uint32 `json:"time"`
+	Memory    uint32 `json:"memory"`
+	Threads   uint8  `json:"threads"`
+}
+
+func newSecretsEncryption() (*SecretsEncryption, error) {
+	salt := make([]byte, 16)
+
+	if _, err := rand.Read(salt); err != nil {
+		return nil, fmt.Errorf("could not generate salt: %w", err)
+	}
+
+	// the second recommended option of RFC 9106, which doesn't need much memory
+	return &SecretsEncryption{
+		Algorithm: secretsAlgorithm,
+		Salt:      salt,
+		Time:      3,
+		Memory:    64 * 1024,
+		Threads:   4,
+	}, nil
+}
+
+func (s *SecretsEncryption) aead(passphrase string) (cipher.AEAD, error) {
+	if s.Algorithm != secretsAlgorithm {
+		return nil, fmt.Errorf("%w: unsupported secrets algorithm %q", ErrInvalidBundle, s.Algorithm)
+	}
+
+	if s.Time == 0 || s.Memory == 0 || s.Threads == 0 || len(s.Salt) == 0 {
+		return nil, fmt.Errorf("%w: invalid secrets key parameters", ErrInvalidBundle)
+	}
+
+	key := argon2.IDKey([]byte(passphrase), s.Salt, s.Time, s.Memory, s.Threads, 32)
+
+	block, err := aes.NewCipher(key)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return cipher.NewGCM(block)
+}
+
+// sealSecrets encrypts the secrets of the webhooks and operators in the bundle with a passphrase.
+// Each secret is bound to the resource it belongs to, so secrets can't be swapped between
+// resources by editing the bundle.
+func sealSecrets(b *Bundle, passphrase string) error {
+	enc, err := newSecretsEncryption()
+
+	if err != nil {
+		return err
+	}
+
+	aead, err := enc.aead(passphrase)
+
+	if err != nil {
+		return err
+	}
+
+	seal := func(ref, secret string) (string, error) {
+		nonce := make([]byte, aead.NonceSize())
+
+		if _, err := rand.Read(nonce); err != nil {
+			return "", fmt.Errorf("could not generate nonce: %w", err)
+		}
+
+		return base64.StdEncoding.EncodeToString(aead.Seal(nonce, nonce, []byte(secret), []byte(ref))), nil
+	}
+
+	for i := range b.Webhooks {
+		if b.Webhooks[i].Secret, err = seal(webhookSecretRef(&b.Webhooks[i]), b.Webhooks[i].Secret); err != nil {
+			return err
+		}
+	}
+
+	for i := range b.Operators {
+		if !operatorHasSecret(&b.Operators[i]) {
+			continue
+		}
+
+		if b.Operators[i].Secret, err = seal(operatorSecretRef(&b.Operators[i]), b.Operators[i].Secret); err != nil {
+			return err
+		}
+	}
+
+	b.Secrets = enc
+
+	return nil
+}
+
+// openSecrets decrypts the secrets of the webhooks and operators in the bundle with a passphrase.
+func openSecrets(b *Bundle, passphrase string) error {
+	if b.Secrets == nil {
+		return nil
+	}
+
+	if passphrase == "" {
+		return ErrPassphraseRequired
+	}
+
+	aead, err := b.Secrets.aead(passphrase)
+
+	if err != nil {
+		return err
+	}
+
+	open := func(ref, sealed string) (string, error) {
+		data, err := base64.StdEncoding.DecodeString(sealed)
+
+		if err != nil || len(data) < aead.NonceSize() {
+			return "", fmt.Errorf("%w: malformed secret for %s", ErrInvalidBundle, ref)
+		}
+
+		secret, err := aead.Open(nil, data[:aead.NonceSize()], data[aead.NonceSize():], []byte(ref))
+
+		if err != nil {
+			return "", ErrInvalidPassphrase
+		}
+
+		return string(secret), nil
+	}
+
+	for i := range b.Webhooks {
+		if b.Webhooks[i].Secret, err = open(webhookSecretRef(&b.Webhooks[i]), b.Webhooks[i].Secret); err != nil {
+			return err
+		}
+	}
+
+	for i := range b.Operators {
+		if !operatorHasSecret(&b.Operators[i]) {
+			continue
+		}
+
+		if b.Operators[i].Secret, err = open(operatorSecretRef(&b.Operators[i]), b.Operators[i].Secret); err != nil {
+			return err
+		}
+	}
+
+	b.Secrets = nil
+
+	return nil
+}
+
+// stripSecrets removes the secrets from a bundle which is exported without a passphrase.
+func stripSecrets(b *Bundle) {
+	for i := range b.Webhooks {
+		b.Webhooks[i].Secret = ""
+	}
+
+	for i := range b.Operators {
+		b.Operators[i].Secret = ""
+	}
+}
+
+func webhookSecretRef(w *Webhook) string {
+	return "webhook/" + w.Name
+}
+
+func operatorSecretRef(o *Operator) string {
+	return "operator/" + o.Kind + "/" + o.Name
+}
@@ -0,0 +1,66 @@
+package tenantbundle
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func bundleWithSecrets() *Bundle {
+	return &Bundle{
+		Version: Version,
+		Webhooks: []Webhook{
+			{Name: "stripe", Auth: WebhookAuth{Type: "HMAC"}, Secret: "whsec_123"},
+			{Name: "github", Auth: WebhookAuth{Type: "HMAC"}, Secret: "gh_456"},
+		},
+		Operators: []Operator{
+			{Name: "default", Kind: "DAG", Config: []byte(`{}`)},
+			{Name: "billing", Kind: "HTTP_API", Config: []byte(`{"triggerEndpoint":"https://billing"}`), Secret: "signing"},
+		},
+	}
+}
+
+func TestSealAndOpenSecrets(t *testing.T) {
+	b := bundleWithSecrets()
+
+	require.NoError(t, sealSecrets(b, "correct horse"))
+
+	require.NotNil(t, b.Secrets)
+	assert.NotEqual(t, "whsec_123", b.Webhooks[0].Secret)
+	assert.NotEqual(t, "signing", b.Operators[1].Secret)
+	assert.Empty(t, b.Operators[0].Secret)
+
+	require.NoError(t, openSecrets(b, "correct horse"))
+
+	assert.Nil(t, b.Secrets)
+	assert.Equal(t, bundleWithSecrets(), b)
+}
+
+func TestOpenSecretsWithWrongPassphrase(t *testing.T) {
+	b := bundleWithSecrets()
+
+	require.NoError(t, sealSecrets(b, "correct horse"))
+
+	assert.ErrorIs(t, openSecrets(b, ""), ErrPassphraseRequired)
+	assert.ErrorIs(t, openSecrets(b, "battery staple"), ErrInvalidPassphrase)
+}
+
+func TestOpenSwappedSecrets(t *testing.T) {
+	b := bundleWithSecrets()
+
+	require.NoError(t, sealSecrets(b, "correct horse"))
+
+	// secrets are bound to their webhook, so moving one to another webhook is detected
+	b.Webhooks[0].Secret, b.Webhooks[1].Secret = b.Webhooks[1].Secret, b.Webhooks[0].Secret
+
+	assert.ErrorIs(t, openSecrets(b, "correct horse"), ErrInvalidPassphrase)
+}
+
+func TestOpenSecretsWithoutSecrets(t *testing.T) {
+	b := bundleWithSecrets()
+	stripSecrets(b)
+
+	require.NoError(t, openSecrets(b, ""))
+	assert.Empty(t, b.Webhooks[0].Secret)
+}
@@ -0,0 +1,268 @@
+package fakeengine
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	admincontracts "github.com/hatchet-dev/hatchet/internal/services/admin/contracts"
+	v1contracts "github.com/hatchet-dev/hatchet/internal/services/shared/proto/v1"
+)
+
+type workflowServer struct {
+	admincontracts.UnimplementedWorkflowServiceServer
+
+	e *Engine
+}
+
+type adminServer struct {
+	v1contracts.UnimplementedAdminServiceServer
+
+	e *Engine
+}
+
+func (s *workflowServer) TriggerWorkflow(ctx context.Context, req *v1contracts.TriggerWorkflowRequest) (*admincontracts.TriggerWorkflowResponse, error) {
+	e := s.e
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	r, err := e.triggerFromRequestLocked(req)
+
+	if err != nil {
+		return nil, err
+	}
+
+	e.scheduleLocked()
+
+	return &admincontracts.TriggerWorkflowResponse{
+		WorkflowRunId: r.id,
+	}, nil
+}
+
+func (s *workflowServer) BulkTriggerWorkflow(ctx context.Context, req *admincontracts.BulkTriggerWorkflowRequest) (*admincontracts.BulkTriggerWorkflowResponse, error) {
+	e := s.e
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	ids := make([]string, 0, len(req.Workflows))
+
+	for _, w := range req.Workflows {
+		r, err := e.triggerFromRequestLocked(w)
+
+		if err != nil {
+			return nil, err
+		}
+
+		ids = append(ids, r.id)
+	}
+
+	e.scheduleLocked()
+
+	return &admincontracts.BulkTriggerWorkflowResponse{
+		WorkflowRunIds: ids,
+	}, nil
+}
+
+func (s *workflowServer) PutRateLimit(ctx context.Context, req *admincontracts.PutRateLimitRequest) (*admincontracts.PutRateLimitResponse, error) {
+	// rate limits are accepted but not enforced
+	return &admincontracts.PutRateLimitResponse{}, nil
+}
+
+func (e *Engine) triggerFromRequestLocked(req *v1contracts.TriggerWorkflowRequest) (*run, error) {
+	opts := triggerOpts{
+		input:      []byte(req.Input),
+		priority:   req.Priority,
+		childIndex: req.ChildIndex,
+		childKey:   req.ChildKey,
+	}
+
+	if req.AdditionalMetadata != nil {
+		opts.additionalMetadata = []byte(*req.AdditionalMetadata)
+	}
+
+	if req.ParentTaskRunExternalId != nil {
+		parent, ok := e.tasks[*req.ParentTaskRunExternalId]
+
+		if !ok {
+			return nil, status.Errorf(codes.NotFound, "parent task %s not found", *req.ParentTaskRunExternalId)
+		}
+
+		opts.parentTask = parent
+	}
+
+	r, err := e.triggerRunLocked(req.Name, opts)
+
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	return r, nil
+}
+
+func (s *adminServer) PutWorkflow(ctx context.Context, req *v1contracts.CreateWorkflowVersionRequest) (*v1contracts.CreateWorkflowVersionResponse, error) {
+	e := s.e
+
+	if req.Name == "" || len(req.Tasks) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "workflow name and at least one task are required")
+	}
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	wf := e.putWorkflowLocked(req)
+
+	return &v1contracts.CreateWorkflowVersionResponse{
+		Id:         wf.versionId,
+		WorkflowId: wf.id,
+	}, nil
+}
+
+func (s *adminServer) TriggerWorkflowRun(ctx context.Context, req *v1contracts.TriggerWorkflowRunRequest) (*v1contracts.TriggerWorkflowRunResponse, error) {
+	e := s.e
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	r, err := e.triggerRunLocked(req.WorkflowName, triggerOpts{
+		input:              req.Input,
+		additionalMetadata: req.AdditionalMetadata,
+		priority:           req.Priority,
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	e.scheduleLocked()
+
+	return &v1contracts.TriggerWorkflowRunResponse{
+		ExternalId: r.id,
+	}, nil
+}
+
+// CancelTasks cancels tasks by task or workflow run id. Filters are not supported.
+func (s *adminServer) CancelTasks(ctx context.Context, req *v1contracts.CancelTasksRequest) (*v1contracts.CancelTasksResponse, error) {
+	e := s.e
+
+	if req.Filter != nil {
+		return nil, status.Error(codes.Unimplemented, "the fake engine does not support cancelling tasks by filter")
+	}
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	cancelled := make([]string, 0)
+
+	cancel := func(t *task) {
+		if !t.status.isFinal() {
+			e.cancelTaskLocked(t)
+			cancelled = append(cancelled, t.id)
+		}
+	}
+
+	for _, id := range req.ExternalIds {
+		if t, ok := e.tasks[id]; ok {
+			cancel(t)
+			continue
+		}
+
+		if r, ok := e.runs[id]; ok {
+			for _, t := range r.tasks {
+				cancel(t)
+			}
+		}
+	}
+
+	e.scheduleLocked()
+
+	return &v1contracts.CancelTasksResponse{
+		CancelledTasks: cancelled,
+	}, nil
+}
+
+func (s *adminServer) GetRunDetails(ctx context.Context, req *v1contracts.GetRunDetailsRequest) (*v1contracts.GetRunDetailsResponse, error) {
+	e := s.e
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	r, ok := e.runs[req.ExternalId]
+
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "workflow run %s not found", req.ExternalId)
+	}
+
+	taskRuns := make(map[string]*v1contracts.TaskRunDetail, len(r.tasks))
+
+	for _, t := range r.allTasks() {
+		detail := &v1contracts.TaskRunDetail{
+			ExternalId: t.id,
+			Status:     t.status.toRunStatus(),
+			ReadableId: t.opts.ReadableId,
+		}
+
+		if t.status.isFinal() {
+			detail.Output = t.output
+		}
+
+		if t.errorMessage != "" && t.status != StatusCompleted {
+			errorMessage := t.errorMessage
+			detail.Error = &errorMessage
+		}
+
+		taskRuns[t.id] = detail
+	}
+
+	return &v1contracts.GetRunDetailsResponse{
+		Input:              r.input,
+		Status:             r.status().toRunStatus(),
+		TaskRuns:           taskRuns,
+		Done:               r.finished,
+		AdditionalMetadata: r.additionalMetadata,
+	}, nil
+}
+
+// AdvanceClock advances the engine's ManualClock. Engines running on the wall clock reject it,
+// like a real engine built without time travel.
+func (s *adminServer) AdvanceClock(ctx context.Context, req *v1contracts.AdvanceClockRequest) (*v1contracts.AdvanceClockResponse, error) {
+	manual, ok := s.e.clock.(*ManualClock)
+
+	if !ok {
+		return nil, status.Error(codes.FailedPrecondition, "the fake engine can only advance a ManualClock, use WithClock")
+	}
+
+	d, err := time.ParseDuration(req.AdvanceBy)
+
+	if err != nil || d < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid advance_by duration %q", req.AdvanceBy)
+	}
+
+	manual.Advance(d)
+
+	now := manual.Now()
+
+	return &v1contracts.AdvanceClockResponse{
+		Now:      timestamppb.New(now),
+		OffsetMs: time.Until(now).Milliseconds(),
+	}, nil
+}
+
+func (s Status) toRunStatus() v1contracts.RunStatus {
+	switch s {
+	case StatusRunning:
+		return v1contracts.RunStatus_RUNNING
+	case StatusCompleted, StatusSkipped:
+		return v1contracts.RunStatus_COMPLETED
+	case StatusFailed:
+		return v1contracts.RunStatus_FAILED
+	case StatusCancelled:
+		return v1contracts.RunStatus_CANCELLED
+	default:
+		return v1contracts.RunStatus_QUEUED
+	}
+}
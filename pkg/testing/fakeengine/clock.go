@@ -0,0 +1,28 @@
+package fakeengine
+
+import (
+	"time"
+
+	"github.com/hatchet-dev/hatchet/pkg/clock"
+)
+
+// Clock is the source of time for the fake engine. Sleep conditions, durable sleeps and
+// retry backoffs are all evaluated against it.
+type Clock interface {
+	Now() time.Time
+}
+
+// ManualClock is a Clock which only moves when Advance or Set is called. Passing it to the
+// engine via WithClock makes sleeps and backoffs fully deterministic: an advance which
+// crosses a deadline fires the corresponding condition before Advance returns.
+type ManualClock = clock.Manual
+
+// NewManualClock returns a ManualClock starting at the given time. A zero start time
+// defaults to the current wall-clock time.
+func NewManualClock(start time.Time) *ManualClock {
+	return clock.NewManual(start)
+}
+
+func wallClock() Clock {
+	return clock.Wall()
+}
@@ -0,0 +1,269 @@
+package fakeengine
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	dispatchercontracts "github.com/hatchet-dev/hatchet/internal/services/dispatcher/contracts"
+)
+
+type dispatcherServer struct {
+	dispatchercontracts.UnimplementedDispatcherServer
+
+	e *Engine
+}
+
+type runSubscriber struct {
+	runIds map[string]bool
+	out    *outbox[*dispatchercontracts.WorkflowRunEvent]
+}
+
+func (s *dispatcherServer) GetVersion(ctx context.Context, req *dispatchercontracts.GetVersionRequest) (*dispatchercontracts.GetVersionResponse, error) {
+	return &dispatchercontracts.GetVersionResponse{
+		Version: Version,
+	}, nil
+}
+
+func (s *dispatcherServer) Register(ctx context.Context, req *dispatchercontracts.WorkerRegisterRequest) (*dispatchercontracts.WorkerRegisterResponse, error) {
+	e := s.e
+
+	slots := make(map[string]int32)
+
+	for slotType, units := range req.SlotConfig {
+		slots[slotType] = units
+	}
+
+	if req.Slots != nil {
+		slots[defaultSlotType] = *req.Slots
+	}
+
+	if len(slots) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "slot config is required")
+	}
+
+	actions := make(map[string]bool, len(req.Actions))
+
+	for _, action := range req.Actions {
+		actions[strings.ToLower(action)] = true
+	}
+
+	w := &worker{
+		id:         uuid.NewString(),
+		name:       req.WorkerName,
+		actions:    actions,
+		slots:      slots,
+		used:       make(map[string]int32),
+		labels:     req.Labels,
+		actionsOut: newOutbox[*dispatchercontracts.AssignedAction](),
+	}
+
+	e.mu.Lock()
+	e.workers[w.id] = w
+	e.notifyLocked()
+	e.mu.Unlock()
+
+	return &dispatchercontracts.WorkerRegisterResponse{
+		TenantId:   e.tenantId,
+		WorkerId:   w.id,
+		WorkerName: w.name,
+	}, nil
+}
+
+func (s *dispatcherServer) Listen(req *dispatchercontracts.WorkerListenRequest, stream dispatchercontracts.Dispatcher_ListenServer) error {
+	return s.listen(req.WorkerId, stream.Context(), stream.Send)
+}
+
+func (s *dispatcherServer) ListenV2(req *dispatchercontracts.WorkerListenRequest, stream dispatchercontracts.Dispatcher_ListenV2Server) error {
+	return s.listen(req.WorkerId, stream.Context(), stream.Send)
+}
+
+func (s *dispatcherServer) listen(workerId string, ctx context.Context, send func(*dispatchercontracts.AssignedAction) error) error {
+	e := s.e
+
+	e.mu.Lock()
+	w, ok := e.workers[workerId]
+
+	if !ok {
+		e.mu.Unlock()
+		return status.Errorf(codes.NotFound, "worker %s not found", workerId)
+	}
+
+	w.listeners++
+
+	// the worker may have been registered before any tasks were queued
+	e.scheduleLocked()
+	e.mu.Unlock()
+
+	defer func() {
+		e.mu.Lock()
+		w.listeners--
+		e.notifyLocked()
+		e.mu.Unlock()
+	}()
+
+	return w.actionsOut.drain(ctx, send)
+}
+
+func (s *dispatcherServer) Heartbeat(ctx context.Context, req *dispatchercontracts.HeartbeatRequest) (*dispatchercontracts.HeartbeatResponse, error) {
+	return &dispatchercontracts.HeartbeatResponse{}, nil
+}
+
+func (s *dispatcherServer) Unsubscribe(ctx context.Context, req *dispatchercontracts.WorkerUnsubscribeRequest) (*dispatchercontracts.WorkerUnsubscribeResponse, error) {
+	e := s.e
+
+	e.mu.Lock()
+	delete(e.workers, req.WorkerId)
+	e.notifyLocked()
+	e.mu.Unlock()
+
+	return &dispatchercontracts.WorkerUnsubscribeResponse{
+		TenantId: e.tenantId,
+		WorkerId: req.WorkerId,
+	}, nil
+}
+
+func (s *dispatcherServer) UpsertWorkerLabels(ctx context.Context, req *dispatchercontracts.UpsertWorkerLabelsRequest) (*dispatchercontracts.UpsertWorkerLabelsResponse, error) {
+	e := s.e
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	w, ok := e.workers[req.WorkerId]
+
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "worker %s not found", req.WorkerId)
+	}
+
+	if w.labels == nil {
+		w.labels = make(map[string]*dispatchercontracts.WorkerLabels)
+	}
+
+	for k, v := range req.Labels {
+		w.labels[k] = v
+	}
+
+	return &dispatchercontracts.UpsertWorkerLabelsResponse{
+		TenantId: e.tenantId,
+		WorkerId: w.id,
+	}, nil
+}
+
+func (s *dispatcherServer) SendStepActionEvent(ctx context.Context, req *dispatchercontracts.StepActionEvent) (*dispatchercontracts.ActionEventResponse, error) {
+	e := s.e
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	t, ok := e.tasks[req.TaskRunExternalId]
+
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "task %s not found", req.TaskRunExternalId)
+	}
+
+	// ignore events from an attempt which is no longer current, e.g. a late completion
+	// for a task which was cancelled and replayed
+	if t.status != StatusRunning || (req.RetryCount != nil && *req.RetryCount != t.retryCount) {
+		return &dispatchercontracts.ActionEventResponse{
+			TenantId: e.tenantId,
+			WorkerId: req.WorkerId,
+		}, nil
+	}
+
+	switch req.EventType {
+	case dispatchercontracts.StepActionEventType_STEP_EVENT_TYPE_COMPLETED:
+		e.completeTaskLocked(t, []byte(req.EventPayload))
+	case dispatchercontracts.StepActionEventType_STEP_EVENT_TYPE_FAILED:
+		e.failTaskLocked(t, req.EventPayload, req.GetErrorClass(), req.ShouldNotRetry != nil && *req.ShouldNotRetry)
+	case dispatchercontracts.StepActionEventType_STEP_EVENT_TYPE_CANCELLED:
+		e.finishTaskLocked(t, StatusCancelled, nil, req.EventPayload)
+	}
+
+	e.scheduleLocked()
+
+	return &dispatchercontracts.ActionEventResponse{
+		TenantId: e.tenantId,
+		WorkerId: req.WorkerId,
+	}, nil
+}
+
+func (s *dispatcherServer) ReleaseSlot(ctx context.Context, req *dispatchercontracts.ReleaseSlotRequest) (*dispatchercontracts.ReleaseSlotResponse, error) {
+	e := s.e
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if t, ok := e.tasks[req.TaskRunExternalId]; ok {
+		e.releaseSlotsLocked(t)
+		e.scheduleLocked()
+	}
+
+	return &dispatchercontracts.ReleaseSlotResponse{}, nil
+}
+
+func (s *dispatcherServer) RefreshTimeout(ctx context.Context, req *dispatchercontracts.RefreshTimeoutRequest) (*dispatchercontracts.RefreshTimeoutResponse, error) {
+	d, err := time.ParseDuration(req.IncrementTimeoutBy)
+
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid timeout increment %q", req.IncrementTimeoutBy)
+	}
+
+	return &dispatchercontracts.RefreshTimeoutResponse{
+		TimeoutAt: timestamppb.New(s.e.clock.Now().Add(d)),
+	}, nil
+}
+
+func (s *dispatcherServer) PutOverridesData(ctx context.Context, req *dispatchercontracts.OverridesData) (*dispatchercontracts.OverridesDataResponse, error) {
+	return &dispatchercontracts.OverridesDataResponse{}, nil
+}
+
+func (s *dispatcherServer) SubscribeToWorkflowRuns(stream dispatchercontracts.Dispatcher_SubscribeToWorkflowRunsServer) error {
+	e := s.e
+
+	sub := &runSubscriber{
+		runIds: make(map[string]bool),
+		out:    newOutbox[*dispatchercontracts.WorkflowRunEvent](),
+	}
+
+	e.mu.Lock()
+	e.runSubscribers[sub] = struct{}{}
+	e.mu.Unlock()
+
+	defer func() {
+		e.mu.Lock()
+		delete(e.runSubscribers, sub)
+		e.mu.Unlock()
+	}()
+
+	ctx, cancel := context.WithCancel(stream.Context())
+	defer cancel()
+
+	go func() {
+		defer cancel()
+
+		for {
+			req, err := stream.Recv()
+
+			if err != nil {
+				return
+			}
+
+			e.mu.Lock()
+
+			if r, ok := e.runs[req.WorkflowRunId]; ok && r.finished {
+				sub.out.push(r.result)
+			} else {
+				sub.runIds[req.WorkflowRunId] = true
+			}
+
+			e.mu.Unlock()
+		}
+	}()
+
+	return sub.out.drain(ctx, stream.Send)
+}
@@ -0,0 +1,181 @@
+package fakeengine
+
+import (
+	"context"
+	"encoding/json"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	v1contracts "github.com/hatchet-dev/hatchet/internal/services/shared/proto/v1"
+)
+
+type dispatcherV1Server struct {
+	v1contracts.UnimplementedV1DispatcherServer
+
+	e *Engine
+}
+
+// durableWait is a durable task blocked in SleepFor/WaitForEvent/WaitFor.
+type durableWait struct {
+	task      *task
+	signalKey string
+	match     *match
+
+	resolved bool
+	data     []byte
+}
+
+type durableSubscriber struct {
+	keys map[durableKey]bool
+	out  *outbox[*v1contracts.DurableEvent]
+}
+
+type durableKey struct {
+	taskId    string
+	signalKey string
+}
+
+// DurableTask accepts the durable task stream so SDK listeners can connect, but the fake
+// engine does not implement the durable event log: it reports a version which makes SDKs
+// use RegisterDurableEvent and ListenForDurableEvent instead.
+func (s *dispatcherV1Server) DurableTask(stream v1contracts.V1Dispatcher_DurableTaskServer) error {
+	for {
+		if _, err := stream.Recv(); err != nil {
+			return nil
+		}
+	}
+}
+
+func (s *dispatcherV1Server) RegisterDurableEvent(ctx context.Context, req *v1contracts.RegisterDurableEventRequest) (*v1contracts.RegisterDurableEventResponse, error) {
+	e := s.e
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	t, ok := e.tasks[req.TaskId]
+
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "task %s not found", req.TaskId)
+	}
+
+	conditions, err := newSleepAndEventConditions(
+		e.clock.Now(),
+		req.Conditions.GetSleepConditions(),
+		req.Conditions.GetUserEventConditions(),
+	)
+
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	w := &durableWait{
+		task:      t,
+		signalKey: req.SignalKey,
+		match:     &match{conditions: conditions},
+	}
+
+	e.durableWaits = append(e.durableWaits, w)
+	e.notifyLocked()
+
+	// a zero-duration sleep can be satisfied immediately
+	e.processDeadlinesLocked()
+
+	return &v1contracts.RegisterDurableEventResponse{}, nil
+}
+
+func (s *dispatcherV1Server) ListenForDurableEvent(stream v1contracts.V1Dispatcher_ListenForDurableEventServer) error {
+	e := s.e
+
+	sub := &durableSubscriber{
+		keys: make(map[durableKey]bool),
+		out:  newOutbox[*v1contracts.DurableEvent](),
+	}
+
+	e.mu.Lock()
+	e.durableSubscribers[sub] = struct{}{}
+	e.mu.Unlock()
+
+	defer func() {
+		e.mu.Lock()
+		delete(e.durableSubscribers, sub)
+		e.mu.Unlock()
+	}()
+
+	ctx, cancel := context.WithCancel(stream.Context())
+	defer cancel()
+
+	go func() {
+		defer cancel()
+
+		for {
+			req, err := stream.Recv()
+
+			if err != nil {
+				return
+			}
+
+			key := durableKey{taskId: req.TaskId, signalKey: req.SignalKey}
+
+			e.mu.Lock()
+
+			delivered := false
+
+			for _, w := range e.durableWaits {
+				if w.data != nil && w.task.id == key.taskId && w.signalKey == key.signalKey {
+					sub.out.push(w.durableEvent())
+					delivered = true
+				}
+			}
+
+			if !delivered {
+				sub.keys[key] = true
+			}
+
+			e.mu.Unlock()
+		}
+	}()
+
+	return sub.out.drain(ctx, stream.Send)
+}
+
+// resolveDurableWaitLocked delivers a durable wait's data to listeners once one of its
+// actions is satisfied.
+func (e *Engine) resolveDurableWaitLocked(w *durableWait) {
+	action, ok := w.match.resolve()
+
+	if !ok {
+		return
+	}
+
+	data, err := json.Marshal(map[string]map[string][]json.RawMessage{
+		action.String(): w.match.data(action),
+	})
+
+	if err != nil {
+		e.l.Error().Err(err).Msg("could not marshal durable event data")
+		return
+	}
+
+	w.resolved = true
+	w.data = data
+
+	key := durableKey{taskId: w.task.id, signalKey: w.signalKey}
+
+	for sub := range e.durableSubscribers {
+		if sub.keys[key] {
+			delete(sub.keys, key)
+			sub.out.push(w.durableEvent())
+		}
+	}
+
+	e.notifyLocked()
+}
+
+func (w *durableWait) durableEvent() *v1contracts.DurableEvent {
+	return &v1contracts.DurableEvent{
+		TaskId:    w.task.id,
+		SignalKey: w.signalKey,
+		Data:      w.data,
+	}
+}
@@ -0,0 +1,293 @@
+// Package fakeengine provides an in-process, in-memory implementation of the Hatchet
+// engine's gRPC surface (dispatcher, admin and events) for unit-testing workflows written
+// with the Go SDK. Unlike pkg/testing/harness it needs no database or message queue, so a
+// full DAG runs in milliseconds inside a plain `go test`.
+//
+// The fake engine supports DAG parents, WithWaitFor/WithSkipIf/cancel conditions on
+// parents, sleeps and user events, durable sleeps and event waits, child workflow
+// spawning, retries with backoff and on-failure tasks. Time is read from a pluggable
+// Clock, so tests can use a ManualClock to fast-forward through sleeps and backoffs.
+//
+// Things which are out of scope: concurrency limits, rate limits, worker affinity,
+// sticky assignment, timeouts, cron/scheduled triggers, batch tasks and the REST API.
+package fakeengine
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+	"google.golang.org/grpc"
+
+	"github.com/hatchet-dev/hatchet/internal/cel"
+	admincontracts "github.com/hatchet-dev/hatchet/internal/services/admin/contracts"
+	dispatchercontracts "github.com/hatchet-dev/hatchet/internal/services/dispatcher/contracts"
+	eventcontracts "github.com/hatchet-dev/hatchet/internal/services/ingestor/contracts"
+	v1contracts "github.com/hatchet-dev/hatchet/internal/services/shared/proto/v1"
+	v0Client "github.com/hatchet-dev/hatchet/pkg/client"
+)
+
+// Version is reported to SDKs via GetVersion. It is new enough for slot-config worker
+// registration but predates the durable event log, so durable waits use the
+// RegisterDurableEvent/ListenForDurableEvent protocol which the fake engine implements.
+const Version = "v0.79.0"
+
+// tickInterval is how often the engine re-checks sleeps and backoffs when running on a
+// real clock. With a ManualClock, deadlines are also processed synchronously on advance.
+const tickInterval = 10 * time.Millisecond
+
+type EngineOpt func(*EngineOpts)
+
+type EngineOpts struct {
+	l        *zerolog.Logger
+	clock    Clock
+	tenantId string
+	addr     string
+}
+
+func defaultEngineOpts() *EngineOpts {
+	l := zerolog.Nop()
+
+	return &EngineOpts{
+		l:        &l,
+		clock:    wallClock(),
+		tenantId: uuid.NewString(),
+		addr:     "127.0.0.1:0",
+	}
+}
+
+// WithLogger sets the logger the engine writes diagnostics to. Defaults to a no-op logger.
+func WithLogger(l *zerolog.Logger) EngineOpt {
+	return func(opts *EngineOpts) {
+		opts.l = l
+	}
+}
+
+// WithClock sets the clock the engine uses for sleeps and retry backoffs.
+func WithClock(c Clock) EngineOpt {
+	return func(opts *EngineOpts) {
+		opts.clock = c
+	}
+}
+
+// WithTenantId sets the tenant id embedded in the client token.
+func WithTenantId(tenantId string) EngineOpt {
+	return func(opts *EngineOpts) {
+		opts.tenantId = tenantId
+	}
+}
+
+// WithAddr sets the address the gRPC server listens on. Defaults to a random local port.
+func WithAddr(addr string) EngineOpt {
+	return func(opts *EngineOpts) {
+		opts.addr = addr
+	}
+}
+
+// Engine is an in-memory Hatchet engine. Create one with New and connect SDK clients to
+// it with ClientOpts.
+type Engine struct {
+	l        *zerolog.Logger
+	clock    Clock
+	tenantId string
+	celExpr  *cel.BoolExprEvaluator
+	celErr   *cel.CELParser
+
+	lis    net.Listener
+	srv    *grpc.Server
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+
+	mu      sync.Mutex
+	changed chan struct{}
+
+	workflows map[string]*workflow
+	runs      map[string]*run
+	tasks     map[string]*task
+	taskOrder []*task
+	workers   map[string]*worker
+
+	runSubscribers     map[*runSubscriber]struct{}
+	durableSubscribers map[*durableSubscriber]struct{}
+	durableWaits       []*durableWait
+
+	events []*Event
+	logs   []*LogLine
+}
+
+// New starts a fake engine listening on a local port.
+func New(fs ...EngineOpt) (*Engine, error) {
+	opts := defaultEngineOpts()
+
+	for _, f := range fs {
+		f(opts)
+	}
+
+	celExpr, err := cel.NewBoolExprEvaluator()
+
+	if err != nil {
+		return nil, fmt.Errorf("could not create CEL evaluator: %w", err)
+	}
+
+	lis, err := net.Listen("tcp", opts.addr)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not listen on %s: %w", opts.addr, err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	e := &Engine{
+		l:                  opts.l,
+		clock:              opts.clock,
+		tenantId:           opts.tenantId,
+		celExpr:            celExpr,
+		celErr:             cel.NewCELParser(),
+		lis:                lis,
+		srv:                grpc.NewServer(),
+		cancel:             cancel,
+		changed:            make(chan struct{}),
+		workflows:          make(map[string]*workflow),
+		runs:               make(map[string]*run),
+		tasks:              make(map[string]*task),
+		workers:            make(map[string]*worker),
+		runSubscribers:     make(map[*runSubscriber]struct{}),
+		durableSubscribers: make(map[*durableSubscriber]struct{}),
+	}
+
+	dispatchercontracts.RegisterDispatcherServer(e.srv, &dispatcherServer{e: e})
+	v1contracts.RegisterV1DispatcherServer(e.srv, &dispatcherV1Server{e: e})
+	admincontracts.RegisterWorkflowServiceServer(e.srv, &workflowServer{e: e})
+	v1contracts.RegisterAdminServiceServer(e.srv, &adminServer{e: e})
+	eventcontracts.RegisterEventsServiceServer(e.srv, &eventsServer{e: e})
+
+	if manual, ok := opts.clock.(*ManualClock); ok {
+		manual.OnChange(e.tick)
+	}
+
+	e.wg.Add(2)
+
+	go func() {
+		defer e.wg.Done()
+
+		if err := e.srv.Serve(lis); err != nil && !errors.Is(err, grpc.ErrServerStopped) {
+			e.l.Error().Err(err).Msg("fake engine gRPC server stopped")
+		}
+	}()
+
+	go func() {
+		defer e.wg.Done()
+
+		ticker := time.NewTicker(tickInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				e.tick()
+			}
+		}
+	}()
+
+	return e, nil
+}
+
+// Close stops the gRPC server and all background processing.
+func (e *Engine) Close() {
+	e.cancel()
+	e.srv.Stop()
+	e.wg.Wait()
+}
+
+// Addr returns the host:port the engine is listening on.
+func (e *Engine) Addr() string {
+	return e.lis.Addr().String()
+}
+
+// TenantId returns the tenant id embedded in the client token.
+func (e *Engine) TenantId() string {
+	return e.tenantId
+}
+
+// Token returns an (unsigned) API token which points SDK clients at this engine.
+func (e *Engine) Token() string {
+	header := base64.RawURLEncoding.EncodeToString([]byte(`{"alg":"none","typ":"JWT"}`))
+
+	claims, _ := json.Marshal(map[string]interface{}{
+		"sub":                    e.tenantId,
+		"server_url":             "http://" + e.Addr(),
+		"grpc_broadcast_address": e.Addr(),
+		"iat":                    time.Now().Unix(),
+		"exp":                    time.Now().Add(24 * time.Hour).Unix(),
+	})
+
+	return header + "." + base64.RawURLEncoding.EncodeToString(claims) + ".fake"
+}
+
+// ClientOpts returns the client options needed to connect an SDK client to this engine,
+// e.g. hatchet.NewClient(engine.ClientOpts()...).
+//
+//nolint:staticcheck // SA1019: the v0 client options are how the Go SDK is configured
+func (e *Engine) ClientOpts() []v0Client.ClientOpt {
+	host, portStr, _ := net.SplitHostPort(e.Addr())
+	port, _ := strconv.Atoi(portStr)
+
+	return []v0Client.ClientOpt{
+		v0Client.WithToken(e.Token()),
+		v0Client.WithHostPort(host, port),
+		v0Client.WithTLSConfig(nil),
+		v0Client.WithTenantId(e.tenantId),
+	}
+}
+
+// Now returns the engine's current time.
+func (e *Engine) Now() time.Time {
+	return e.clock.Now()
+}
+
+// tick fires any sleep conditions and retry backoffs which have come due and assigns
+// ready tasks to workers.
+func (e *Engine) tick() {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	e.processDeadlinesLocked()
+	e.scheduleLocked()
+}
+
+// notifyLocked wakes up anyone blocked in waitUntil. Must be called with e.mu held.
+func (e *Engine) notifyLocked() {
+	close(e.changed)
+	e.changed = make(chan struct{})
+}
+
+// waitUntil blocks until cond returns true or the context is done. cond is evaluated
+// with e.mu held.
+func (e *Engine) waitUntil(ctx context.Context, cond func() bool) error {
+	for {
+		e.mu.Lock()
+		ok := cond()
+		changed := e.changed
+		e.mu.Unlock()
+
+		if ok {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-changed:
+		}
+	}
+}
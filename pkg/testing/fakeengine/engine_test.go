@@ -0,0 +1,413 @@
+//go:build !e2e && !load && !rampup && !integration
+
+package fakeengine_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hatchet-dev/hatchet/pkg/client/types"
+	"github.com/hatchet-dev/hatchet/pkg/testing/fakeengine"
+	hatchet "github.com/hatchet-dev/hatchet/sdks/go"
+)
+
+type valueInput struct {
+	Value int `json:"value"`
+}
+
+type valueOutput struct {
+	Value int `json:"value"`
+}
+
+func newTestEngine(t *testing.T, opts ...fakeengine.EngineOpt) (*fakeengine.Engine, *hatchet.Client) {
+	t.Helper()
+
+	engine, err := fakeengine.New(opts...)
+	require.NoError(t, err)
+	t.Cleanup(engine.Close)
+
+	client, err := hatchet.NewClient(engine.ClientOpts()...)
+	require.NoError(t, err)
+
+	return engine, client
+}
+
+func startWorker(t *testing.T, client *hatchet.Client, workflows ...hatchet.WorkflowBase) {
+	t.Helper()
+
+	w, err := client.NewWorker("test-worker", hatchet.WithWorkflows(workflows...), hatchet.WithSlots(10))
+	require.NoError(t, err)
+
+	cleanup, err := w.Start()
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		_ = cleanup()
+	})
+}
+
+func testContext(t *testing.T) context.Context {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	t.Cleanup(cancel)
+
+	return ctx
+}
+
+func TestDAG(t *testing.T) {
+	_, client := newTestEngine(t)
+
+	wf := client.NewWorkflow("dag")
+
+	first := wf.NewTask("first", func(ctx hatchet.Context, input valueInput) (valueOutput, error) {
+		return valueOutput{Value: input.Value + 1}, nil
+	})
+
+	wf.NewTask("second", func(ctx hatchet.Context, input valueInput) (valueOutput, error) {
+		var parent valueOutput
+
+		if err := ctx.ParentOutput(first, &parent); err != nil {
+			return valueOutput{}, err
+		}
+
+		return valueOutput{Value: parent.Value * 10}, nil
+	}, hatchet.WithParents(first))
+
+	startWorker(t, client, wf)
+
+	res, err := wf.Run(testContext(t), valueInput{Value: 1})
+	require.NoError(t, err)
+
+	var out valueOutput
+	require.NoError(t, res.TaskOutput("second").Into(&out))
+	assert.Equal(t, 20, out.Value)
+}
+
+func TestSkipIfParentCondition(t *testing.T) {
+	engine, client := newTestEngine(t)
+
+	wf := client.NewWorkflow("skip-if")
+
+	first := wf.NewTask("first", func(ctx hatchet.Context, input valueInput) (valueOutput, error) {
+		return valueOutput{Value: input.Value}, nil
+	})
+
+	wf.NewTask("big", func(ctx hatchet.Context, input valueInput) (valueOutput, error) {
+		return valueOutput{Value: 1}, nil
+	}, hatchet.WithParents(first), hatchet.WithSkipIf(hatchet.ParentCondition(first, "output.value <= 5")))
+
+	wf.NewTask("small", func(ctx hatchet.Context, input valueInput) (valueOutput, error) {
+		return valueOutput{Value: 2}, nil
+	}, hatchet.WithParents(first), hatchet.WithSkipIf(hatchet.ParentCondition(first, "output.value > 5")))
+
+	startWorker(t, client, wf)
+
+	ref, err := wf.RunNoWait(testContext(t), valueInput{Value: 10})
+	require.NoError(t, err)
+
+	run, err := engine.WaitForRun(testContext(t), ref.RunId)
+	require.NoError(t, err)
+
+	assert.Equal(t, fakeengine.StatusCompleted, run.Status)
+	assert.Equal(t, fakeengine.StatusCompleted, run.Tasks["big"].Status)
+	assert.Equal(t, fakeengine.StatusSkipped, run.Tasks["small"].Status)
+}
+
+func TestWaitForSleepWithManualClock(t *testing.T) {
+	clock := fakeengine.NewManualClock(time.Time{})
+	engine, client := newTestEngine(t, fakeengine.WithClock(clock))
+
+	wf := client.NewWorkflow("sleep-condition")
+
+	wf.NewTask("delayed", func(ctx hatchet.Context, input valueInput) (valueOutput, error) {
+		return valueOutput{Value: input.Value}, nil
+	}, hatchet.WithWaitFor(hatchet.SleepCondition(time.Hour)))
+
+	startWorker(t, client, wf)
+
+	ref, err := wf.RunNoWait(testContext(t), valueInput{Value: 3})
+	require.NoError(t, err)
+
+	require.NoError(t, engine.WaitForPendingSleeps(testContext(t), 1))
+
+	clock.Advance(59 * time.Minute)
+	assert.Equal(t, 1, engine.PendingSleeps())
+
+	clock.Advance(time.Minute)
+
+	run, err := engine.WaitForRun(testContext(t), ref.RunId)
+	require.NoError(t, err)
+	assert.Equal(t, fakeengine.StatusCompleted, run.Status)
+	assert.JSONEq(t, `{"value":3}`, string(run.Tasks["delayed"].Output))
+}
+
+func TestWaitForUserEvent(t *testing.T) {
+	engine, client := newTestEngine(t)
+
+	wf := client.NewWorkflow("event-condition")
+
+	wf.NewTask("approved", func(ctx hatchet.Context, input valueInput) (valueOutput, error) {
+		return valueOutput{Value: input.Value}, nil
+	}, hatchet.WithWaitFor(hatchet.UserEventCondition("approval", "input.approved == true")))
+
+	startWorker(t, client, wf)
+
+	ref, err := wf.RunNoWait(testContext(t), valueInput{Value: 1})
+	require.NoError(t, err)
+
+	require.NoError(t, client.Events().Push(testContext(t), "approval", map[string]any{"approved": false}))
+
+	run, err := engine.GetRun(ref.RunId)
+	require.NoError(t, err)
+	assert.Equal(t, fakeengine.StatusPending, run.Tasks["approved"].Status)
+
+	require.NoError(t, client.Events().Push(testContext(t), "approval", map[string]any{"approved": true}))
+
+	run, err = engine.WaitForRun(testContext(t), ref.RunId)
+	require.NoError(t, err)
+	assert.Equal(t, fakeengine.StatusCompleted, run.Status)
+	assert.Len(t, engine.Events(), 2)
+}
+
+func TestDurableSleepWithManualClock(t *testing.T) {
+	clock := fakeengine.NewManualClock(time.Time{})
+	engine, client := newTestEngine(t, fakeengine.WithClock(clock))
+
+	task := client.NewStandaloneDurableTask("durable-sleep", func(ctx hatchet.DurableContext, input valueInput) (valueOutput, error) {
+		if _, err := ctx.SleepFor(24 * time.Hour); err != nil {
+			return valueOutput{}, err
+		}
+
+		return valueOutput{Value: input.Value}, nil
+	})
+
+	startWorker(t, client, task)
+
+	ref, err := task.RunNoWait(testContext(t), valueInput{Value: 7})
+	require.NoError(t, err)
+
+	require.NoError(t, engine.WaitForPendingSleeps(testContext(t), 1))
+	clock.Advance(24 * time.Hour)
+
+	run, err := engine.WaitForRun(testContext(t), ref.RunId)
+	require.NoError(t, err)
+	assert.Equal(t, fakeengine.StatusCompleted, run.Status)
+}
+
+func TestAdvanceEngineClock(t *testing.T) {
+	engine, client := newTestEngine(t, fakeengine.WithClock(fakeengine.NewManualClock(time.Time{})))
+
+	task := client.NewStandaloneDurableTask("advance-clock", func(ctx hatchet.DurableContext, input valueInput) (valueOutput, error) {
+		if _, err := ctx.SleepFor(72 * time.Hour); err != nil {
+			return valueOutput{}, err
+		}
+
+		return valueOutput{Value: input.Value}, nil
+	})
+
+	startWorker(t, client, task)
+
+	ref, err := task.RunNoWait(testContext(t), valueInput{Value: 1})
+	require.NoError(t, err)
+
+	require.NoError(t, engine.WaitForPendingSleeps(testContext(t), 1))
+
+	offset, err := client.AdvanceEngineClock(testContext(t), 72*time.Hour)
+	require.NoError(t, err)
+	assert.InDelta(t, float64(72*time.Hour), float64(offset), float64(time.Minute))
+
+	run, err := engine.WaitForRun(testContext(t), ref.RunId)
+	require.NoError(t, err)
+	assert.Equal(t, fakeengine.StatusCompleted, run.Status)
+}
+
+func TestAdvanceEngineClockRequiresManualClock(t *testing.T) {
+	_, client := newTestEngine(t)
+
+	_, err := client.AdvanceEngineClock(testContext(t), time.Hour)
+	assert.Error(t, err)
+}
+
+func TestChildWorkflows(t *testing.T) {
+	engine, client := newTestEngine(t)
+
+	child := client.NewStandaloneTask("child", func(ctx hatchet.Context, input valueInput) (valueOutput, error) {
+		return valueOutput{Value: input.Value * 2}, nil
+	})
+
+	parent := client.NewStandaloneTask("parent", func(ctx hatchet.Context, input valueInput) (valueOutput, error) {
+		sum := 0
+
+		for i := 1; i <= input.Value; i++ {
+			res, err := child.Run(ctx, valueInput{Value: i})
+
+			if err != nil {
+				return valueOutput{}, err
+			}
+
+			var out valueOutput
+
+			if err := res.Into(&out); err != nil {
+				return valueOutput{}, err
+			}
+
+			sum += out.Value
+		}
+
+		return valueOutput{Value: sum}, nil
+	})
+
+	startWorker(t, client, child, parent)
+
+	res, err := parent.Run(testContext(t), valueInput{Value: 3})
+	require.NoError(t, err)
+
+	var out valueOutput
+	require.NoError(t, res.Into(&out))
+	assert.Equal(t, 12, out.Value)
+
+	children := engine.ListRuns("child")
+	require.Len(t, children, 3)
+
+	for _, c := range children {
+		assert.NotEmpty(t, c.ParentTaskId)
+	}
+}
+
+func TestRetriesWithBackoff(t *testing.T) {
+	clock := fakeengine.NewManualClock(time.Time{})
+	engine, client := newTestEngine(t, fakeengine.WithClock(clock))
+
+	task := client.NewStandaloneTask("flaky", func(ctx hatchet.Context, input valueInput) (valueOutput, error) {
+		if ctx.RetryCount() < 2 {
+			return valueOutput{}, fmt.Errorf("attempt %d failed", ctx.RetryCount())
+		}
+
+		return valueOutput{Value: ctx.RetryCount()}, nil
+	}, hatchet.WithRetries(3), hatchet.WithRetryBackoff(2, 60))
+
+	startWorker(t, client, task)
+
+	ref, err := task.RunNoWait(testContext(t), valueInput{})
+	require.NoError(t, err)
+
+	var run *fakeengine.RunState
+
+	// backoffs only elapse when the clock is advanced
+	require.Eventually(t, func() bool {
+		clock.Advance(time.Minute)
+
+		run, err = engine.GetRun(ref.RunId)
+		require.NoError(t, err)
+
+		return run.Status == fakeengine.StatusCompleted
+	}, 10*time.Second, 10*time.Millisecond)
+
+	assert.Equal(t, int32(2), run.Tasks["flaky"].RetryCount)
+}
+
+func TestRetryPolicies(t *testing.T) {
+	engine, client := newTestEngine(t)
+
+	validationError := "ValidationError"
+	rateLimited := `error.message.contains("429")`
+	rateLimitRetries := int32(1)
+
+	task := client.NewStandaloneTask("classified", func(ctx hatchet.Context, input valueInput) (valueOutput, error) {
+		if input.Value == 0 {
+			return valueOutput{}, hatchet.NewClassifiedError(validationError, errors.New("value is required"))
+		}
+
+		return valueOutput{}, errors.New("upstream returned 429")
+	}, hatchet.WithRetries(5), hatchet.WithRetryPolicies(
+		&types.RetryPolicy{ErrorClass: &validationError, NeverRetry: true},
+		&types.RetryPolicy{Expression: &rateLimited, Retries: &rateLimitRetries},
+	))
+
+	startWorker(t, client, task)
+
+	invalid, err := task.RunNoWait(testContext(t), valueInput{Value: 0})
+	require.NoError(t, err)
+
+	rateLimitedRun, err := task.RunNoWait(testContext(t), valueInput{Value: 1})
+	require.NoError(t, err)
+
+	run, err := engine.WaitForRun(testContext(t), invalid.RunId)
+	require.NoError(t, err)
+	assert.Equal(t, fakeengine.StatusFailed, run.Status)
+	assert.Equal(t, int32(0), run.Tasks["classified"].RetryCount)
+
+	run, err = engine.WaitForRun(testContext(t), rateLimitedRun.RunId)
+	require.NoError(t, err)
+	assert.Equal(t, fakeengine.StatusFailed, run.Status)
+	assert.Equal(t, int32(1), run.Tasks["classified"].RetryCount)
+}
+
+func TestRetryPolicyRetriesAreCountedPerPolicy(t *testing.T) {
+	engine, client := newTestEngine(t)
+
+	rateLimited := `error.message.contains("429")`
+	rateLimitRetries := int32(2)
+
+	task := client.NewStandaloneTask("per-policy", func(ctx hatchet.Context, input valueInput) (valueOutput, error) {
+		if ctx.RetryCount() < 2 {
+			return valueOutput{}, errors.New("upstream returned 429")
+		}
+
+		return valueOutput{}, errors.New("boom")
+	}, hatchet.WithRetries(2), hatchet.WithRetryPolicies(
+		&types.RetryPolicy{Expression: &rateLimited, Retries: &rateLimitRetries},
+	))
+
+	startWorker(t, client, task)
+
+	ref, err := task.RunNoWait(testContext(t), valueInput{})
+	require.NoError(t, err)
+
+	run, err := engine.WaitForRun(testContext(t), ref.RunId)
+	require.NoError(t, err)
+
+	// the two rate limited retries don't use up the task's own two retries
+	assert.Equal(t, fakeengine.StatusFailed, run.Status)
+	assert.Equal(t, int32(4), run.Tasks["per-policy"].RetryCount)
+}
+
+func TestOnFailure(t *testing.T) {
+	engine, client := newTestEngine(t)
+
+	wf := client.NewWorkflow("on-failure")
+
+	wf.NewTask("fails", func(ctx hatchet.Context, input valueInput) (valueOutput, error) {
+		return valueOutput{}, errors.New("boom")
+	}, hatchet.WithRetries(5))
+
+	errorsSeen := make(chan map[string]string, 1)
+
+	wf.OnFailure(func(ctx hatchet.Context, input valueInput) (valueOutput, error) {
+		errorsSeen <- ctx.StepRunErrors()
+		return valueOutput{}, nil
+	})
+
+	startWorker(t, client, wf)
+
+	ref, err := wf.RunNoWait(testContext(t), valueInput{})
+	require.NoError(t, err)
+
+	run, err := engine.WaitForRun(testContext(t), ref.RunId)
+	require.NoError(t, err)
+
+	assert.Equal(t, fakeengine.StatusFailed, run.Status)
+	assert.Equal(t, int32(5), run.Tasks["fails"].RetryCount)
+
+	select {
+	case stepRunErrors := <-errorsSeen:
+		assert.Len(t, stepRunErrors, 1)
+	case <-testContext(t).Done():
+		t.Fatal("on-failure task did not run")
+	}
+}
@@ -0,0 +1,202 @@
+package fakeengine
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	eventcontracts "github.com/hatchet-dev/hatchet/internal/services/ingestor/contracts"
+)
+
+// Event is a user event pushed to the fake engine.
+type Event struct {
+	Id                 string
+	Key                string
+	Payload            json.RawMessage
+	AdditionalMetadata json.RawMessage
+	Timestamp          time.Time
+
+	priority *int32
+}
+
+// LogLine is a log line written by a task via ctx.Log.
+type LogLine struct {
+	TaskRunId string
+	Message   string
+	Level     string
+	CreatedAt time.Time
+}
+
+type eventsServer struct {
+	eventcontracts.UnimplementedEventsServiceServer
+
+	e *Engine
+}
+
+func (s *eventsServer) Push(ctx context.Context, req *eventcontracts.PushEventRequest) (*eventcontracts.Event, error) {
+	e := s.e
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	ev := e.newEvent(req)
+
+	e.pushEventLocked(ev)
+	e.scheduleLocked()
+
+	return e.toEventPB(ev), nil
+}
+
+func (s *eventsServer) BulkPush(ctx context.Context, req *eventcontracts.BulkPushEventRequest) (*eventcontracts.Events, error) {
+	e := s.e
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	events := make([]*eventcontracts.Event, 0, len(req.Events))
+
+	for _, r := range req.Events {
+		ev := e.newEvent(r)
+
+		e.pushEventLocked(ev)
+
+		events = append(events, e.toEventPB(ev))
+	}
+
+	e.scheduleLocked()
+
+	return &eventcontracts.Events{
+		Events: events,
+	}, nil
+}
+
+func (s *eventsServer) ReplaySingleEvent(ctx context.Context, req *eventcontracts.ReplayEventRequest) (*eventcontracts.Event, error) {
+	e := s.e
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	for _, old := range e.events {
+		if old.Id != req.EventId {
+			continue
+		}
+
+		ev := &Event{
+			Id:                 uuid.NewString(),
+			Key:                old.Key,
+			Payload:            old.Payload,
+			AdditionalMetadata: old.AdditionalMetadata,
+			Timestamp:          e.clock.Now(),
+			priority:           old.priority,
+		}
+
+		e.pushEventLocked(ev)
+		e.scheduleLocked()
+
+		return e.toEventPB(ev), nil
+	}
+
+	return nil, status.Errorf(codes.NotFound, "event %s not found", req.EventId)
+}
+
+func (s *eventsServer) PutLog(ctx context.Context, req *eventcontracts.PutLogRequest) (*eventcontracts.PutLogResponse, error) {
+	e := s.e
+
+	line := s.toLogLine(req)
+
+	e.mu.Lock()
+	e.logs = append(e.logs, line)
+	e.mu.Unlock()
+
+	return &eventcontracts.PutLogResponse{}, nil
+}
+
+func (s *eventsServer) PutLogs(stream eventcontracts.EventsService_PutLogsServer) error {
+	e := s.e
+
+	for {
+		req, err := stream.Recv()
+
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+
+		if err != nil {
+			return err
+		}
+
+		lines := make([]*LogLine, len(req.Logs))
+
+		for i, l := range req.Logs {
+			lines[i] = s.toLogLine(l)
+		}
+
+		e.mu.Lock()
+		e.logs = append(e.logs, lines...)
+		e.mu.Unlock()
+
+		if err := stream.Send(&eventcontracts.PutLogsResponse{Sequence: req.Sequence}); err != nil {
+			return err
+		}
+	}
+}
+
+func (s *eventsServer) toLogLine(req *eventcontracts.PutLogRequest) *LogLine {
+	line := &LogLine{
+		TaskRunId: req.TaskRunExternalId,
+		Message:   req.Message,
+		Level:     req.GetLevel(),
+		CreatedAt: s.e.clock.Now(),
+	}
+
+	if req.CreatedAt != nil {
+		line.CreatedAt = req.CreatedAt.AsTime()
+	}
+
+	return line
+}
+
+func (s *eventsServer) PutStreamEvent(ctx context.Context, req *eventcontracts.PutStreamEventRequest) (*eventcontracts.PutStreamEventResponse, error) {
+	// stream events are accepted but not stored
+	return &eventcontracts.PutStreamEventResponse{}, nil
+}
+
+func (e *Engine) newEvent(req *eventcontracts.PushEventRequest) *Event {
+	ev := &Event{
+		Id:        uuid.NewString(),
+		Key:       req.Key,
+		Payload:   json.RawMessage(req.Payload),
+		Timestamp: e.clock.Now(),
+		priority:  req.Priority,
+	}
+
+	if req.AdditionalMetadata != nil {
+		ev.AdditionalMetadata = json.RawMessage(*req.AdditionalMetadata)
+	}
+
+	return ev
+}
+
+func (e *Engine) toEventPB(ev *Event) *eventcontracts.Event {
+	res := &eventcontracts.Event{
+		TenantId:       e.tenantId,
+		EventId:        ev.Id,
+		Key:            ev.Key,
+		Payload:        string(ev.Payload),
+		EventTimestamp: timestamppb.New(ev.Timestamp),
+	}
+
+	if ev.AdditionalMetadata != nil {
+		additionalMetadata := string(ev.AdditionalMetadata)
+		res.AdditionalMetadata = &additionalMetadata
+	}
+
+	return res
+}
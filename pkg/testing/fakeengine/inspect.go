@@ -0,0 +1,192 @@
+package fakeengine
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// RunState is a snapshot of a workflow run, for assertions in tests.
+type RunState struct {
+	Id                 string
+	WorkflowName       string
+	Status             Status
+	Input              json.RawMessage
+	AdditionalMetadata json.RawMessage
+
+	// ParentTaskId is set for child workflow runs.
+	ParentTaskId string
+
+	// Tasks are keyed by task name. The on-failure task, if it ran, is included under its
+	// own name.
+	Tasks map[string]*TaskState
+}
+
+// TaskState is a snapshot of a task.
+type TaskState struct {
+	Id         string
+	Name       string
+	Status     Status
+	RetryCount int32
+	Output     json.RawMessage
+	Error      string
+	WorkerId   string
+}
+
+// GetRun returns a snapshot of the workflow run with the given id.
+func (e *Engine) GetRun(id string) (*RunState, error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	r, ok := e.runs[id]
+
+	if !ok {
+		return nil, fmt.Errorf("workflow run %s not found", id)
+	}
+
+	return r.snapshot(), nil
+}
+
+// ListRuns returns snapshots of all runs of a workflow, in the order they were triggered.
+// An empty workflow name returns all runs.
+func (e *Engine) ListRuns(workflowName string) []*RunState {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	res := make([]*RunState, 0)
+	seen := make(map[*run]bool)
+
+	for _, t := range e.taskOrder {
+		r := t.run
+
+		if seen[r] {
+			continue
+		}
+
+		seen[r] = true
+
+		if workflowName == "" || r.workflow.req.Name == workflowName {
+			res = append(res, r.snapshot())
+		}
+	}
+
+	return res
+}
+
+// WaitForRun blocks until the workflow run has finished and returns its final state.
+func (e *Engine) WaitForRun(ctx context.Context, id string) (*RunState, error) {
+	var res *RunState
+
+	err := e.waitUntil(ctx, func() bool {
+		r, ok := e.runs[id]
+
+		if !ok || !r.finished {
+			return false
+		}
+
+		res = r.snapshot()
+
+		return true
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("workflow run %s did not finish: %w", id, err)
+	}
+
+	return res, nil
+}
+
+// PendingSleeps returns the number of tasks and durable waits which are blocked on a
+// sleep condition.
+func (e *Engine) PendingSleeps() int {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	return e.pendingSleepsLocked()
+}
+
+// WaitForPendingSleeps blocks until at least n tasks or durable waits are blocked on a
+// sleep condition. This is useful before advancing a ManualClock, since a durable task
+// only registers its sleep once the worker reaches the SleepFor call.
+func (e *Engine) WaitForPendingSleeps(ctx context.Context, n int) error {
+	return e.waitUntil(ctx, func() bool {
+		return e.pendingSleepsLocked() >= n
+	})
+}
+
+func (e *Engine) pendingSleepsLocked() int {
+	count := 0
+
+	for _, t := range e.taskOrder {
+		if t.status == StatusPending && t.match != nil && t.match.hasPendingSleep() {
+			count++
+		}
+	}
+
+	for _, w := range e.durableWaits {
+		if !w.resolved && w.match.hasPendingSleep() {
+			count++
+		}
+	}
+
+	return count
+}
+
+// Events returns all events pushed to the engine, in order.
+func (e *Engine) Events() []Event {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	res := make([]Event, 0, len(e.events))
+
+	for _, ev := range e.events {
+		res = append(res, *ev)
+	}
+
+	return res
+}
+
+// Logs returns the log lines written by a task. An empty task run id returns all logs.
+func (e *Engine) Logs(taskRunId string) []LogLine {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	res := make([]LogLine, 0)
+
+	for _, l := range e.logs {
+		if taskRunId == "" || l.TaskRunId == taskRunId {
+			res = append(res, *l)
+		}
+	}
+
+	return res
+}
+
+func (r *run) snapshot() *RunState {
+	res := &RunState{
+		Id:                 r.id,
+		WorkflowName:       r.workflow.req.Name,
+		Status:             r.status(),
+		Input:              r.input,
+		AdditionalMetadata: r.additionalMetadata,
+		Tasks:              make(map[string]*TaskState, len(r.tasks)),
+	}
+
+	if r.parentTask != nil {
+		res.ParentTaskId = r.parentTask.id
+	}
+
+	for _, t := range r.allTasks() {
+		res.Tasks[t.opts.ReadableId] = &TaskState{
+			Id:         t.id,
+			Name:       t.opts.ReadableId,
+			Status:     t.status,
+			RetryCount: t.retryCount,
+			Output:     t.output,
+			Error:      t.errorMessage,
+			WorkerId:   t.workerId,
+		}
+	}
+
+	return res
+}
@@ -0,0 +1,311 @@
+package fakeengine
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	v1contracts "github.com/hatchet-dev/hatchet/internal/services/shared/proto/v1"
+)
+
+// matchAction mirrors sqlcv1.V1MatchConditionAction, including the internal CREATE_MATCH
+// action which isn't part of the public proto.
+type matchAction int
+
+const (
+	matchActionCreate matchAction = iota
+	matchActionQueue
+	matchActionCancel
+	matchActionSkip
+	matchActionCreateMatch
+)
+
+// resolution order, matching SaveSatisfiedMatchConditions: skips take precedence over
+// cancellations, which take precedence over creates and queues.
+var matchActionPrecedence = []matchAction{
+	matchActionSkip,
+	matchActionCancel,
+	matchActionCreate,
+	matchActionQueue,
+	matchActionCreateMatch,
+}
+
+func (a matchAction) String() string {
+	switch a {
+	case matchActionCreate:
+		return "CREATE"
+	case matchActionQueue:
+		return "QUEUE"
+	case matchActionCancel:
+		return "CANCEL"
+	case matchActionSkip:
+		return "SKIP"
+	case matchActionCreateMatch:
+		return "CREATE_MATCH"
+	default:
+		return "UNKNOWN"
+	}
+}
+
+func matchActionFromPB(a v1contracts.Action) matchAction {
+	switch a {
+	case v1contracts.Action_QUEUE:
+		return matchActionQueue
+	case v1contracts.Action_CANCEL:
+		return matchActionCancel
+	case v1contracts.Action_SKIP:
+		return matchActionSkip
+	default:
+		return matchActionCreate
+	}
+}
+
+type conditionKind int
+
+const (
+	conditionKindParentCompleted conditionKind = iota
+	conditionKindParentFailed
+	conditionKindParentCancelled
+	conditionKindSleep
+	conditionKindUserEvent
+)
+
+type matchCondition struct {
+	kind            conditionKind
+	groupId         string
+	action          matchAction
+	readableDataKey string
+	expression      string
+
+	// set for parent conditions
+	parentReadableId string
+
+	// set for sleep conditions
+	sleepFor   string
+	sleepUntil time.Time
+
+	// set for user event conditions
+	eventKey string
+
+	satisfied bool
+	data      json.RawMessage
+}
+
+// match is a set of conditions attached to a task or a durable wait. Conditions sharing
+// an or-group are ORed, groups are ANDed, and each action resolves independently.
+type match struct {
+	conditions []*matchCondition
+}
+
+// resolve returns the action whose or-groups are all satisfied, if any.
+func (m *match) resolve() (matchAction, bool) {
+	for _, action := range matchActionPrecedence {
+		total := make(map[string]bool)
+		satisfied := make(map[string]bool)
+
+		for _, c := range m.conditions {
+			if c.action != action {
+				continue
+			}
+
+			total[c.groupId] = true
+
+			if c.satisfied {
+				satisfied[c.groupId] = true
+			}
+		}
+
+		if len(total) > 0 && len(total) == len(satisfied) {
+			return action, true
+		}
+	}
+
+	return 0, false
+}
+
+// data aggregates the satisfied condition data for an action, keyed by readable data key,
+// in the same shape as the engine's mc_aggregated_data.
+func (m *match) data(action matchAction) map[string][]json.RawMessage {
+	res := make(map[string][]json.RawMessage)
+
+	for _, c := range m.conditions {
+		if c.action != action || !c.satisfied {
+			continue
+		}
+
+		res[c.readableDataKey] = append(res[c.readableDataKey], c.data)
+	}
+
+	return res
+}
+
+func (m *match) hasPendingSleep() bool {
+	for _, c := range m.conditions {
+		if c.kind == conditionKindSleep && !c.satisfied {
+			return true
+		}
+	}
+
+	return false
+}
+
+type sleepEventData struct {
+	SleepDuration string `json:"sleep_duration"`
+}
+
+// newSleepAndEventConditions converts user-supplied sleep and event conditions into
+// match conditions. Sleep deadlines are computed relative to now.
+func newSleepAndEventConditions(now time.Time, sleeps []*v1contracts.SleepMatchCondition, userEvents []*v1contracts.UserEventMatchCondition) ([]*matchCondition, error) {
+	res := make([]*matchCondition, 0, len(sleeps)+len(userEvents))
+
+	for _, s := range sleeps {
+		d, err := time.ParseDuration(s.SleepFor)
+
+		if err != nil {
+			return nil, fmt.Errorf("invalid sleep duration %q: %w", s.SleepFor, err)
+		}
+
+		res = append(res, &matchCondition{
+			kind:            conditionKindSleep,
+			groupId:         orGroupId(s.Base),
+			action:          matchActionFromPB(s.Base.GetAction()),
+			readableDataKey: s.Base.GetReadableDataKey(),
+			sleepFor:        s.SleepFor,
+			sleepUntil:      now.Add(d),
+		})
+	}
+
+	for _, u := range userEvents {
+		res = append(res, &matchCondition{
+			kind:            conditionKindUserEvent,
+			groupId:         orGroupId(u.Base),
+			action:          matchActionFromPB(u.Base.GetAction()),
+			readableDataKey: u.Base.GetReadableDataKey(),
+			expression:      u.Base.GetExpression(),
+			eventKey:        u.UserEventKey,
+		})
+	}
+
+	return res, nil
+}
+
+func orGroupId(base *v1contracts.BaseMatchCondition) string {
+	if base.GetOrGroupId() != "" {
+		return base.GetOrGroupId()
+	}
+
+	return uuid.NewString()
+}
+
+// newParentConditions mirrors getParentInDAGGroupMatch in pkg/repository/trigger.go:
+//   - if all parents complete, the child task is queued (or its sleep/event match is created)
+//   - if all parents are skipped, the child task is skipped
+//   - if any parent fails or is cancelled, the child is cancelled
+//
+// Parent override conditions replace the corresponding default.
+func newParentConditions(opts *v1contracts.CreateTaskOpts) []*matchCondition {
+	conds := opts.GetConditions()
+
+	completeAction := matchActionQueue
+
+	if len(conds.GetSleepConditions()) > 0 || len(conds.GetUserEventConditions()) > 0 {
+		completeAction = matchActionCreateMatch
+	}
+
+	hasAnySkippingParentOverrides := false
+
+	for _, o := range conds.GetParentOverrideConditions() {
+		if o.Base.GetAction() == v1contracts.Action_SKIP {
+			hasAnySkippingParentOverrides = true
+		}
+	}
+
+	cancelGroupId := uuid.NewString()
+	res := make([]*matchCondition, 0)
+
+	for _, parent := range opts.Parents {
+		overrides := make(map[matchAction][]*v1contracts.ParentOverrideMatchCondition)
+
+		for _, o := range conds.GetParentOverrideConditions() {
+			if o.ParentReadableId == parent {
+				a := matchActionFromPB(o.Base.GetAction())
+				overrides[a] = append(overrides[a], o)
+			}
+		}
+
+		parentCondition := func(kind conditionKind, groupId, expression string, action matchAction) *matchCondition {
+			return &matchCondition{
+				kind:             kind,
+				groupId:          groupId,
+				action:           action,
+				readableDataKey:  parent,
+				expression:       expression,
+				parentReadableId: parent,
+			}
+		}
+
+		if len(overrides[matchActionQueue]) > 0 {
+			for _, o := range overrides[matchActionQueue] {
+				res = append(res, parentCondition(conditionKindParentCompleted, orGroupId(o.Base), o.Base.GetExpression(), completeAction))
+			}
+		} else {
+			res = append(res, parentCondition(conditionKindParentCompleted, uuid.NewString(), "true", completeAction))
+		}
+
+		if len(overrides[matchActionSkip]) > 0 {
+			for _, o := range overrides[matchActionSkip] {
+				res = append(res, parentCondition(conditionKindParentCompleted, orGroupId(o.Base), o.Base.GetExpression(), matchActionSkip))
+			}
+		} else if !hasAnySkippingParentOverrides {
+			res = append(res, parentCondition(conditionKindParentCompleted, uuid.NewString(), "has(output.skipped) && output.skipped", matchActionSkip))
+		}
+
+		if len(overrides[matchActionCancel]) > 0 {
+			for _, o := range overrides[matchActionCancel] {
+				groupId := orGroupId(o.Base)
+
+				res = append(res,
+					parentCondition(conditionKindParentCompleted, groupId, o.Base.GetExpression(), matchActionCancel),
+					parentCondition(conditionKindParentFailed, groupId, "true", matchActionCancel),
+					parentCondition(conditionKindParentCancelled, groupId, "true", matchActionCancel),
+				)
+			}
+		} else {
+			res = append(res,
+				parentCondition(conditionKindParentFailed, cancelGroupId, "true", matchActionCancel),
+				parentCondition(conditionKindParentCancelled, cancelGroupId, "true", matchActionCancel),
+			)
+		}
+	}
+
+	return res
+}
+
+// evalCondition evaluates a condition's CEL expression against an input and output object.
+func (e *Engine) evalCondition(c *matchCondition, input, output []byte) bool {
+	inputMap := map[string]interface{}{}
+	outputMap := map[string]interface{}{}
+
+	if len(input) > 0 {
+		_ = json.Unmarshal(input, &inputMap)
+	}
+
+	if len(output) > 0 {
+		_ = json.Unmarshal(output, &outputMap)
+	}
+
+	ok, err := e.celExpr.EvalBoolExpr(context.Background(), c.expression, map[string]interface{}{
+		"input":  inputMap,
+		"output": outputMap,
+	})
+
+	if err != nil {
+		e.l.Warn().Err(err).Msgf("could not evaluate condition expression %q", c.expression)
+		return false
+	}
+
+	return ok
+}
@@ -0,0 +1,58 @@
+package fakeengine
+
+import (
+	"context"
+	"sync"
+)
+
+// outbox is an unbounded FIFO queue feeding a single gRPC stream. The engine pushes to
+// it while holding its state lock, so push must never block on a slow consumer.
+type outbox[T any] struct {
+	mu     sync.Mutex
+	items  []T
+	notify chan struct{}
+}
+
+func newOutbox[T any]() *outbox[T] {
+	return &outbox[T]{
+		notify: make(chan struct{}, 1),
+	}
+}
+
+func (o *outbox[T]) push(item T) {
+	o.mu.Lock()
+	o.items = append(o.items, item)
+	o.mu.Unlock()
+
+	select {
+	case o.notify <- struct{}{}:
+	default:
+	}
+}
+
+// drain calls send for every queued item until the context is cancelled or send fails.
+func (o *outbox[T]) drain(ctx context.Context, send func(T) error) error {
+	for {
+		o.mu.Lock()
+		items := o.items
+		o.items = nil
+		o.mu.Unlock()
+
+		for i, item := range items {
+			if err := send(item); err != nil {
+				// put back anything we didn't get to, so a reconnecting stream picks it up
+				o.mu.Lock()
+				o.items = append(append([]T{}, items[i:]...), o.items...)
+				o.mu.Unlock()
+
+				return err
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-o.notify:
+		}
+	}
+}
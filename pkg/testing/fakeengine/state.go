@@ -0,0 +1,864 @@
+package fakeengine
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/hatchet-dev/hatchet/internal/cel"
+	dispatchercontracts "github.com/hatchet-dev/hatchet/internal/services/dispatcher/contracts"
+	v1contracts "github.com/hatchet-dev/hatchet/internal/services/shared/proto/v1"
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+)
+
+// Status is the status of a task or workflow run in the fake engine.
+type Status string
+
+const (
+	// StatusPending means the task is waiting on parents or conditions.
+	StatusPending   Status = "PENDING"
+	StatusQueued    Status = "QUEUED"
+	StatusRunning   Status = "RUNNING"
+	StatusCompleted Status = "COMPLETED"
+	StatusSkipped   Status = "SKIPPED"
+	StatusFailed    Status = "FAILED"
+	StatusCancelled Status = "CANCELLED"
+)
+
+func (s Status) isFinal() bool {
+	switch s {
+	case StatusCompleted, StatusSkipped, StatusFailed, StatusCancelled:
+		return true
+	default:
+		return false
+	}
+}
+
+const defaultSlotType = "default"
+
+var skippedOutput = []byte(`{"skipped":true}`)
+
+type workflow struct {
+	id        string
+	versionId string
+	jobId     string
+	req       *v1contracts.CreateWorkflowVersionRequest
+
+	// stable step ids, keyed by readable id
+	stepIds map[string]string
+}
+
+type run struct {
+	id                 string
+	workflow           *workflow
+	input              []byte
+	additionalMetadata []byte
+	priority           int32
+	createdAt          time.Time
+
+	parentTask *task
+	childIndex *int32
+	childKey   *string
+
+	tasks     []*task
+	byName    map[string]*task
+	onFailure *task
+
+	// children spawned from tasks in this run, keyed by parent task id and child index/key
+	children map[string]*run
+
+	finished bool
+	result   *dispatchercontracts.WorkflowRunEvent
+}
+
+type task struct {
+	id     string
+	stepId string
+	run    *run
+	opts   *v1contracts.CreateTaskOpts
+
+	status       Status
+	retryCount   int32
+	retryAt      time.Time
+	output       []byte
+	errorMessage string
+
+	// the retries spent on each retry policy, by policy index
+	policyRetryCounts map[int]int32
+
+	workerId  string
+	slotsHeld map[string]int32
+
+	match    *match
+	triggers map[string]map[string]interface{}
+
+	// only set on on-failure tasks
+	stepRunErrors map[string]string
+}
+
+type worker struct {
+	id      string
+	name    string
+	actions map[string]bool
+	slots   map[string]int32
+	used    map[string]int32
+	labels  map[string]*dispatchercontracts.WorkerLabels
+
+	actionsOut *outbox[*dispatchercontracts.AssignedAction]
+	listeners  int
+}
+
+// allTasks returns the run's tasks followed by its on-failure task, if it has been created.
+func (r *run) allTasks() []*task {
+	if r.onFailure == nil {
+		return r.tasks
+	}
+
+	return append(append([]*task{}, r.tasks...), r.onFailure)
+}
+
+// status derives the run's status from its tasks. The on-failure task does not affect
+// the run's status.
+func (r *run) status() Status {
+	if !r.finished {
+		for _, t := range r.tasks {
+			if t.status == StatusRunning {
+				return StatusRunning
+			}
+		}
+
+		return StatusQueued
+	}
+
+	res := StatusCompleted
+
+	for _, t := range r.tasks {
+		switch t.status {
+		case StatusFailed:
+			return StatusFailed
+		case StatusCancelled:
+			res = StatusCancelled
+		}
+	}
+
+	return res
+}
+
+func (w *worker) hasCapacity(requests map[string]int32) bool {
+	for slotType, units := range requests {
+		if w.slots[slotType]-w.used[slotType] < units {
+			return false
+		}
+	}
+
+	return true
+}
+
+func slotRequests(opts *v1contracts.CreateTaskOpts) map[string]int32 {
+	if len(opts.SlotRequests) > 0 {
+		return opts.SlotRequests
+	}
+
+	return map[string]int32{defaultSlotType: 1}
+}
+
+// putWorkflowLocked registers a new version of a workflow.
+func (e *Engine) putWorkflowLocked(req *v1contracts.CreateWorkflowVersionRequest) *workflow {
+	wf := &workflow{
+		id:        uuid.NewString(),
+		versionId: uuid.NewString(),
+		jobId:     uuid.NewString(),
+		req:       req,
+		stepIds:   make(map[string]string),
+	}
+
+	if existing, ok := e.workflows[req.Name]; ok {
+		wf.id = existing.id
+	}
+
+	for _, t := range req.Tasks {
+		wf.stepIds[t.ReadableId] = uuid.NewString()
+	}
+
+	if req.OnFailureTask != nil {
+		wf.stepIds[req.OnFailureTask.ReadableId] = uuid.NewString()
+	}
+
+	e.workflows[req.Name] = wf
+
+	return wf
+}
+
+type triggerOpts struct {
+	input              []byte
+	additionalMetadata []byte
+	priority           *int32
+	parentTask         *task
+	childIndex         *int32
+	childKey           *string
+}
+
+// triggerRunLocked creates a new workflow run. If the run is a child with an index or key
+// which was already spawned from the same parent task, the existing run is returned.
+func (e *Engine) triggerRunLocked(name string, opts triggerOpts) (*run, error) {
+	wf, ok := e.workflows[name]
+
+	if !ok {
+		return nil, fmt.Errorf("workflow %s not found", name)
+	}
+
+	var childLookupKey string
+
+	if opts.parentTask != nil {
+		switch {
+		case opts.childKey != nil:
+			childLookupKey = opts.parentTask.id + "/key/" + *opts.childKey
+		case opts.childIndex != nil:
+			childLookupKey = fmt.Sprintf("%s/index/%d", opts.parentTask.id, *opts.childIndex)
+		}
+
+		if existing, ok := opts.parentTask.run.children[childLookupKey]; ok && childLookupKey != "" {
+			return existing, nil
+		}
+	}
+
+	input := opts.input
+
+	if len(input) == 0 {
+		input = []byte("{}")
+	}
+
+	r := &run{
+		id:                 uuid.NewString(),
+		workflow:           wf,
+		input:              input,
+		additionalMetadata: opts.additionalMetadata,
+		createdAt:          e.clock.Now(),
+		parentTask:         opts.parentTask,
+		childIndex:         opts.childIndex,
+		childKey:           opts.childKey,
+		byName:             make(map[string]*task),
+		children:           make(map[string]*run),
+	}
+
+	switch {
+	case opts.priority != nil:
+		r.priority = *opts.priority
+	case wf.req.DefaultPriority != nil:
+		r.priority = *wf.req.DefaultPriority
+	default:
+		r.priority = 1
+	}
+
+	if childLookupKey != "" {
+		opts.parentTask.run.children[childLookupKey] = r
+	}
+
+	e.runs[r.id] = r
+
+	for _, taskOpts := range wf.req.Tasks {
+		t := e.newTaskLocked(r, taskOpts)
+
+		conds := taskOpts.GetConditions()
+
+		switch {
+		case len(taskOpts.Parents) > 0:
+			t.match = &match{conditions: newParentConditions(taskOpts)}
+		case len(conds.GetSleepConditions()) > 0 || len(conds.GetUserEventConditions()) > 0:
+			conditions, err := newSleepAndEventConditions(e.clock.Now(), conds.GetSleepConditions(), conds.GetUserEventConditions())
+
+			if err != nil {
+				return nil, err
+			}
+
+			t.match = &match{conditions: conditions}
+		default:
+			t.status = StatusQueued
+		}
+
+		r.tasks = append(r.tasks, t)
+		r.byName[taskOpts.ReadableId] = t
+	}
+
+	e.notifyLocked()
+
+	return r, nil
+}
+
+func (e *Engine) newTaskLocked(r *run, opts *v1contracts.CreateTaskOpts) *task {
+	t := &task{
+		id:     uuid.NewString(),
+		stepId: r.workflow.stepIds[opts.ReadableId],
+		run:    r,
+		opts:   opts,
+		status: StatusPending,
+	}
+
+	e.tasks[t.id] = t
+	e.taskOrder = append(e.taskOrder, t)
+
+	return t
+}
+
+// resolveTaskMatchLocked acts on a task's match once one of its actions is satisfied.
+func (e *Engine) resolveTaskMatchLocked(t *task) {
+	if t.match == nil || t.status != StatusPending {
+		return
+	}
+
+	action, ok := t.match.resolve()
+
+	if !ok {
+		return
+	}
+
+	m := t.match
+	t.match = nil
+
+	switch action {
+	case matchActionSkip:
+		e.finishTaskLocked(t, StatusSkipped, skippedOutput, "")
+	case matchActionCancel:
+		e.finishTaskLocked(t, StatusCancelled, nil, "task was cancelled because a parent task failed or was cancelled")
+	case matchActionCreateMatch:
+		conds := t.opts.GetConditions()
+		conditions, err := newSleepAndEventConditions(e.clock.Now(), conds.GetSleepConditions(), conds.GetUserEventConditions())
+
+		if err != nil {
+			e.finishTaskLocked(t, StatusFailed, nil, err.Error())
+			return
+		}
+
+		t.match = &match{conditions: conditions}
+		e.resolveTaskMatchLocked(t)
+	default:
+		t.triggers = triggersFromMatchData(m.data(action))
+		t.status = StatusQueued
+	}
+
+	e.notifyLocked()
+}
+
+// triggersFromMatchData mirrors V1StepRunData triggers: the first data value for each
+// readable data key which isn't a parent output.
+func triggersFromMatchData(data map[string][]json.RawMessage) map[string]map[string]interface{} {
+	res := make(map[string]map[string]interface{})
+
+	for key, values := range data {
+		for _, v := range values {
+			m := map[string]interface{}{}
+
+			if err := json.Unmarshal(v, &m); err == nil {
+				res[key] = m
+				break
+			}
+		}
+	}
+
+	return res
+}
+
+// signalParentLocked evaluates the parent conditions of every pending task in the run
+// which depends on parent.
+func (e *Engine) signalParentLocked(parent *task) {
+	var kind conditionKind
+	var output []byte
+
+	switch parent.status {
+	case StatusCompleted, StatusSkipped:
+		kind = conditionKindParentCompleted
+		output = parent.output
+	case StatusFailed:
+		kind = conditionKindParentFailed
+	case StatusCancelled:
+		kind = conditionKindParentCancelled
+	default:
+		return
+	}
+
+	for _, t := range parent.run.tasks {
+		if t.status != StatusPending || t.match == nil {
+			continue
+		}
+
+		changed := false
+
+		for _, c := range t.match.conditions {
+			if c.satisfied || c.kind != kind || c.parentReadableId != parent.opts.ReadableId {
+				continue
+			}
+
+			if e.evalCondition(c, nil, output) {
+				c.satisfied = true
+				c.data = output
+				changed = true
+			}
+		}
+
+		if changed {
+			e.resolveTaskMatchLocked(t)
+		}
+	}
+}
+
+// processDeadlinesLocked fires any sleep conditions which have come due.
+func (e *Engine) processDeadlinesLocked() {
+	now := e.clock.Now()
+
+	fire := func(m *match) bool {
+		changed := false
+
+		for _, c := range m.conditions {
+			if c.kind != conditionKindSleep || c.satisfied || now.Before(c.sleepUntil) {
+				continue
+			}
+
+			data, _ := json.Marshal(sleepEventData{SleepDuration: c.sleepFor})
+
+			c.satisfied = true
+			c.data = data
+			changed = true
+		}
+
+		return changed
+	}
+
+	for _, t := range e.taskOrder {
+		if t.status == StatusPending && t.match != nil && fire(t.match) {
+			e.resolveTaskMatchLocked(t)
+		}
+	}
+
+	for _, w := range e.durableWaits {
+		if !w.resolved && fire(w.match) {
+			e.resolveDurableWaitLocked(w)
+		}
+	}
+}
+
+// pushEventLocked records a user event, evaluates it against pending event conditions
+// and triggers any workflows which list the event key as a trigger.
+func (e *Engine) pushEventLocked(ev *Event) {
+	e.events = append(e.events, ev)
+
+	fire := func(m *match) bool {
+		changed := false
+
+		for _, c := range m.conditions {
+			if c.kind != conditionKindUserEvent || c.satisfied || c.eventKey != ev.Key {
+				continue
+			}
+
+			if e.evalCondition(c, ev.Payload, nil) {
+				c.satisfied = true
+				c.data = ev.Payload
+				changed = true
+			}
+		}
+
+		return changed
+	}
+
+	for _, t := range e.taskOrder {
+		if t.status == StatusPending && t.match != nil && fire(t.match) {
+			e.resolveTaskMatchLocked(t)
+		}
+	}
+
+	for _, w := range e.durableWaits {
+		if !w.resolved && fire(w.match) {
+			e.resolveDurableWaitLocked(w)
+		}
+	}
+
+	names := make([]string, 0, len(e.workflows))
+
+	for name := range e.workflows {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	for _, name := range names {
+		for _, trigger := range e.workflows[name].req.EventTriggers {
+			if trigger != ev.Key {
+				continue
+			}
+
+			if _, err := e.triggerRunLocked(name, triggerOpts{
+				input:              ev.Payload,
+				additionalMetadata: ev.AdditionalMetadata,
+				priority:           ev.priority,
+			}); err != nil {
+				e.l.Error().Err(err).Msgf("could not trigger workflow %s from event %s", name, ev.Key)
+			}
+
+			break
+		}
+	}
+
+	e.notifyLocked()
+}
+
+// completeTaskLocked handles a COMPLETED event from a worker.
+func (e *Engine) completeTaskLocked(t *task, output []byte) {
+	e.finishTaskLocked(t, StatusCompleted, output, "")
+}
+
+// failTaskLocked handles a FAILED event from a worker, retrying the task if it has retries
+// left. The first of the task's retry policies which matches the failure overrides its retries
+// and backoff, and only the failures which matched a policy count against its retries.
+func (e *Engine) failTaskLocked(t *task, errorMessage, errorClass string, shouldNotRetry bool) {
+	retries := t.opts.Retries
+	backoffFactor, backoffMaxSeconds := t.opts.BackoffFactor, t.opts.BackoffMaxSeconds
+
+	// the step's retries count the failures which matched no policy
+	attempts := t.retryCount
+
+	for _, count := range t.policyRetryCounts {
+		attempts -= count
+	}
+
+	policyIndex, policy := e.matchRetryPolicy(t, errorMessage, errorClass)
+
+	if policy != nil {
+		attempts = t.policyRetryCounts[policyIndex]
+		shouldNotRetry = shouldNotRetry || policy.NeverRetry
+
+		if policy.Retries != nil {
+			retries = *policy.Retries
+		}
+
+		if policy.BackoffFactor != nil {
+			backoffFactor = policy.BackoffFactor
+			backoffMaxSeconds = policy.BackoffMaxSeconds
+
+			if backoffMaxSeconds == nil {
+				defaultMax := int32(repository.DefaultRetryBackoffMaxSeconds)
+				backoffMaxSeconds = &defaultMax
+			}
+		} else if policy.BackoffMaxSeconds != nil {
+			backoffMaxSeconds = policy.BackoffMaxSeconds
+		}
+	}
+
+	if !shouldNotRetry && attempts < retries {
+		e.releaseSlotsLocked(t)
+
+		if policy != nil {
+			if t.policyRetryCounts == nil {
+				t.policyRetryCounts = make(map[int]int32)
+			}
+
+			t.policyRetryCounts[policyIndex]++
+		}
+
+		t.retryCount++
+		t.status = StatusQueued
+		t.workerId = ""
+		t.errorMessage = errorMessage
+		t.retryAt = time.Time{}
+
+		if backoffFactor != nil && backoffMaxSeconds != nil {
+			// same computation as the task controller's retry path
+			durationMilliseconds := 1000 * math.Min(float64(*backoffMaxSeconds), math.Pow(float64(*backoffFactor), float64(t.retryCount)))
+			t.retryAt = e.clock.Now().Add(time.Duration(int(durationMilliseconds)) * time.Millisecond)
+		}
+
+		e.notifyLocked()
+
+		return
+	}
+
+	e.finishTaskLocked(t, StatusFailed, nil, errorMessage)
+}
+
+// matchRetryPolicy returns the first of the task's retry policies which matches the failure and
+// its index, or nil.
+func (e *Engine) matchRetryPolicy(t *task, errorMessage, errorClass string) (int, *v1contracts.RetryPolicy) {
+	for i, policy := range t.opts.RetryPolicies {
+		if policy.ErrorClass != nil && *policy.ErrorClass != errorClass {
+			continue
+		}
+
+		if policy.Expression != nil {
+			matched, err := e.celErr.EvaluateErrorExpression(*policy.Expression, cel.NewInput(
+				cel.WithError(errorClass, errorMessage),
+				cel.WithRetryCount(t.retryCount),
+			))
+
+			if err != nil {
+				e.l.Warn().Err(err).Msgf("could not evaluate retry policy for task %s", t.id)
+				continue
+			}
+
+			if !matched {
+				continue
+			}
+		}
+
+		return i, policy
+	}
+
+	return -1, nil
+}
+
+// cancelTaskLocked cancels a task which has not yet finished, telling its worker to stop
+// if it is running.
+func (e *Engine) cancelTaskLocked(t *task) {
+	if t.status.isFinal() {
+		return
+	}
+
+	if t.status == StatusRunning {
+		if w, ok := e.workers[t.workerId]; ok {
+			w.actionsOut.push(e.newAssignedActionLocked(t, dispatchercontracts.ActionType_CANCEL_STEP_RUN))
+		}
+	}
+
+	t.match = nil
+
+	e.finishTaskLocked(t, StatusCancelled, nil, "task was cancelled")
+}
+
+func (e *Engine) finishTaskLocked(t *task, status Status, output []byte, errorMessage string) {
+	if t.status.isFinal() {
+		return
+	}
+
+	e.releaseSlotsLocked(t)
+
+	t.status = status
+	t.output = output
+	t.errorMessage = errorMessage
+
+	for _, w := range e.durableWaits {
+		if w.task == t {
+			w.resolved = true
+		}
+	}
+
+	e.signalParentLocked(t)
+
+	r := t.run
+
+	if status == StatusFailed && r.onFailure == nil && r.workflow.req.OnFailureTask != nil && t != r.onFailure {
+		onFailure := e.newTaskLocked(r, r.workflow.req.OnFailureTask)
+		onFailure.stepRunErrors = map[string]string{}
+		onFailure.status = StatusQueued
+
+		r.onFailure = onFailure
+	}
+
+	if r.onFailure != nil && status == StatusFailed && t != r.onFailure {
+		r.onFailure.stepRunErrors[t.opts.ReadableId] = errorMessage
+	}
+
+	e.maybeFinishRunLocked(r)
+	e.notifyLocked()
+}
+
+func (e *Engine) releaseSlotsLocked(t *task) {
+	if t.slotsHeld == nil {
+		return
+	}
+
+	if w, ok := e.workers[t.workerId]; ok {
+		for slotType, units := range t.slotsHeld {
+			w.used[slotType] -= units
+		}
+	}
+
+	t.slotsHeld = nil
+}
+
+func (e *Engine) maybeFinishRunLocked(r *run) {
+	if r.finished {
+		return
+	}
+
+	for _, t := range r.tasks {
+		if !t.status.isFinal() {
+			return
+		}
+	}
+
+	if r.onFailure != nil && !r.onFailure.status.isFinal() {
+		return
+	}
+
+	r.finished = true
+
+	results := make([]*dispatchercontracts.StepRunResult, 0, len(r.tasks)+1)
+
+	for _, t := range r.allTasks() {
+		res := &dispatchercontracts.StepRunResult{
+			TaskRunExternalId: t.id,
+			TaskName:          t.opts.ReadableId,
+			JobRunId:          t.id,
+		}
+
+		switch t.status {
+		case StatusCompleted, StatusSkipped:
+			out := string(t.output)
+			res.Output = &out
+		default:
+			errorMessage := t.errorMessage
+			res.Error = &errorMessage
+		}
+
+		results = append(results, res)
+	}
+
+	r.result = &dispatchercontracts.WorkflowRunEvent{
+		WorkflowRunId:  r.id,
+		EventType:      dispatchercontracts.WorkflowRunEventType_WORKFLOW_RUN_EVENT_TYPE_FINISHED,
+		EventTimestamp: timestamppb.New(e.clock.Now()),
+		Results:        results,
+	}
+
+	for sub := range e.runSubscribers {
+		if sub.runIds[r.id] {
+			delete(sub.runIds, r.id)
+			sub.out.push(r.result)
+		}
+	}
+}
+
+// scheduleLocked assigns queued tasks to workers which can run them, in priority order.
+func (e *Engine) scheduleLocked() {
+	now := e.clock.Now()
+
+	queued := make([]*task, 0)
+
+	for _, t := range e.taskOrder {
+		if t.status == StatusQueued && !now.Before(t.retryAt) {
+			queued = append(queued, t)
+		}
+	}
+
+	if len(queued) == 0 {
+		return
+	}
+
+	sort.SliceStable(queued, func(i, j int) bool {
+		return queued[i].run.priority > queued[j].run.priority
+	})
+
+	workerIds := make([]string, 0, len(e.workers))
+
+	for id := range e.workers {
+		workerIds = append(workerIds, id)
+	}
+
+	sort.Strings(workerIds)
+
+	assigned := false
+
+	for _, t := range queued {
+		requests := slotRequests(t.opts)
+
+		for _, id := range workerIds {
+			w := e.workers[id]
+
+			if w.listeners == 0 || !w.actions[strings.ToLower(t.opts.Action)] || !w.hasCapacity(requests) {
+				continue
+			}
+
+			for slotType, units := range requests {
+				w.used[slotType] += units
+			}
+
+			t.slotsHeld = requests
+			t.workerId = w.id
+			t.status = StatusRunning
+
+			w.actionsOut.push(e.newAssignedActionLocked(t, dispatchercontracts.ActionType_START_STEP_RUN))
+
+			assigned = true
+
+			break
+		}
+	}
+
+	if assigned {
+		e.notifyLocked()
+	}
+}
+
+func (e *Engine) newAssignedActionLocked(t *task, actionType dispatchercontracts.ActionType) *dispatchercontracts.AssignedAction {
+	r := t.run
+
+	input := map[string]interface{}{}
+	_ = json.Unmarshal(r.input, &input)
+
+	parents := make(map[string]map[string]interface{})
+
+	for _, parentName := range t.opts.Parents {
+		parent, ok := r.byName[parentName]
+
+		if !ok || len(parent.output) == 0 {
+			continue
+		}
+
+		out := map[string]interface{}{}
+
+		if err := json.Unmarshal(parent.output, &out); err == nil {
+			parents[parentName] = out
+		}
+	}
+
+	triggers := t.triggers
+
+	if triggers == nil {
+		triggers = make(map[string]map[string]interface{})
+	}
+
+	data := &repository.V1StepRunData{
+		Input:         input,
+		TriggeredBy:   "manual",
+		Parents:       parents,
+		Triggers:      triggers,
+		StepRunErrors: t.stepRunErrors,
+	}
+
+	action := &dispatchercontracts.AssignedAction{
+		TenantId:            e.tenantId,
+		WorkflowRunId:       r.id,
+		JobId:               r.workflow.jobId,
+		JobName:             r.workflow.req.Name,
+		JobRunId:            r.id,
+		TaskId:              t.stepId,
+		TaskRunExternalId:   t.id,
+		ActionId:            t.opts.Action,
+		ActionType:          actionType,
+		ActionPayload:       string(data.Bytes()),
+		TaskName:            t.opts.ReadableId,
+		RetryCount:          t.retryCount,
+		ChildWorkflowIndex:  r.childIndex,
+		ChildWorkflowKey:    r.childKey,
+		Priority:            r.priority,
+		WorkflowId:          &r.workflow.id,
+		WorkflowVersionId:   &r.workflow.versionId,
+		AdditionalMetadata:  nil,
+		ParentWorkflowRunId: nil,
+	}
+
+	if len(r.additionalMetadata) > 0 {
+		meta := string(r.additionalMetadata)
+		action.AdditionalMetadata = &meta
+	}
+
+	if r.parentTask != nil {
+		parentRunId := r.parentTask.run.id
+		action.ParentWorkflowRunId = &parentRunId
+	}
+
+	return action
+}
@@ -220,7 +220,9 @@ func makeContractTaskOpts(t *TaskShared, taskDefaults *create.TaskDefaults) *con
 		taskOpts.WorkerLabels = make(map[string]*contracts.DesiredWorkerLabels)
 
 		for key, value := range t.WorkerLabels {
-			taskOpts.WorkerLabels[key] = &contracts.DesiredWorkerLabels{}
+			taskOpts.WorkerLabels[key] = &contracts.DesiredWorkerLabels{
+				StrValues: value.Values,
+			}
 
 			switch v := value.Value.(type) {
 			case string:
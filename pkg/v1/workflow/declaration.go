@@ -417,6 +417,7 @@ func (w *workflowDeclarationImpl[I, O]) DurableTask(opts create.WorkflowTask[I,
 	for k, v := range opts.WorkerLabels {
 		labels[k] = &types.DesiredWorkerLabel{
 			Value:      fmt.Sprintf("%v-durable", v.Value),
+			Values:     v.Values,
 			Required:   v.Required,
 			Weight:     v.Weight,
 			Comparator: v.Comparator,
@@ -91,6 +91,18 @@ func newValidator() *validator.Validate {
 		return err == nil
 	})
 
+	_ = validate.RegisterValidation("celsteprunlist", func(fl validator.FieldLevel) bool {
+		_, err := celParser.ParseStepRunList(fl.Field().String())
+
+		return err == nil
+	})
+
+	_ = validate.RegisterValidation("celerrorexpr", func(fl validator.FieldLevel) bool {
+		_, err := celParser.ParseErrorExpression(fl.Field().String())
+
+		return err == nil
+	})
+
 	_ = validate.RegisterValidation("future", func(fl validator.FieldLevel) bool {
 		if t, ok := fl.Field().Interface().(time.Time); ok {
 			return t.After(time.Now())
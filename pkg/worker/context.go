@@ -489,41 +489,16 @@ func (h *hatchetContext) Log(message string) {
 		message = string(runes[:10_000])
 	}
 
-	stepRunId := h.a.StepRunId
 	retryCount := h.a.RetryCount
-	createdAt := timestamppb.Now()
+	workerId := h.a.WorkerId
 
-	go func() {
-		const maxRetries = 3
-		baseDelay := 100 * time.Millisecond
+	// lines are batched and streamed to the engine, and flushed before the step run finishes. This
+	// only blocks when the engine is pushing back, and gives up once the step run is cancelled.
+	err := h.c.Event().StreamLog(h, h.a.StepRunId, message, &infoLevel, &retryCount, &workerId, timestamppb.Now())
 
-		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
-		defer cancel()
-
-		var err error
-
-		for attempt := range maxRetries + 1 {
-			if attempt > 0 {
-				delay := baseDelay * time.Duration(1<<(attempt-1))
-
-				select {
-				case <-ctx.Done():
-					h.l.Warn().Err(err).Msg("log delivery timed out, abandoning")
-					return
-				case <-time.After(delay):
-				}
-			}
-
-			err = h.c.Event().PutLogWithTimestamp(ctx, stepRunId, message, &infoLevel, &retryCount, createdAt)
-			if err == nil {
-				return
-			}
-
-			h.l.Warn().Err(err).Msgf("failed to put log (attempt %d/%d)", attempt+1, maxRetries+1)
-		}
-
-		h.l.Err(err).Msg("could not put log after all retries")
-	}()
+	if err != nil {
+		h.l.Warn().Err(err).Msg("could not put log")
+	}
 }
 
 // Deprecated: ReleaseSlot is an internal method used by the new Go SDK.
@@ -26,3 +26,17 @@ func IsNonRetryableError(err error) bool {
 	e := &NonRetryableError{}
 	return errors.As(err, &e)
 }
+
+// errorClass returns the class of a task error for the engine's retry policies, if the error (or
+// an error it wraps) implements ErrorClass() string.
+func errorClass(err error) (string, bool) {
+	var classified interface {
+		ErrorClass() string
+	}
+
+	if errors.As(err, &classified) {
+		return classified.ErrorClass(), true
+	}
+
+	return "", false
+}
@@ -139,6 +139,10 @@ type Worker struct {
 
 	labels map[string]interface{}
 
+	dynamicLabels func() map[string]interface{}
+
+	scalingTargets *client.WorkerScalingTargets
+
 	id *string
 
 	panicHandler func(ctx HatchetContext, recovered any)
@@ -165,6 +169,10 @@ type WorkerOpts struct {
 	actions []string
 
 	labels map[string]interface{}
+
+	dynamicLabels func() map[string]interface{}
+
+	scalingTargets *client.WorkerScalingTargets
 }
 
 func defaultWorkerOpts() *WorkerOpts {
@@ -263,6 +271,22 @@ func WithLabels(labels map[string]interface{}) WorkerOpt {
 	}
 }
 
+// Deprecated: WithDynamicLabels is an internal function used by the new Go SDK.
+// Use the new Go SDK at github.com/hatchet-dev/hatchet/sdks/go instead of calling this directly. Migration guide: https://docs.hatchet.run/home/migration-guide-go
+func WithDynamicLabels(fn func() map[string]interface{}) WorkerOpt {
+	return func(opts *WorkerOpts) {
+		opts.dynamicLabels = fn
+	}
+}
+
+// Deprecated: WithScalingTargets is an internal function used by the new Go SDK.
+// Use the new Go SDK at github.com/hatchet-dev/hatchet/sdks/go instead of calling this directly. Migration guide: https://docs.hatchet.run/home/migration-guide-go
+func WithScalingTargets(targets *client.WorkerScalingTargets) WorkerOpt {
+	return func(opts *WorkerOpts) {
+		opts.scalingTargets = targets
+	}
+}
+
 // Deprecated: WithLogger is an internal function used by the new Go SDK.
 // Use the new Go SDK at github.com/hatchet-dev/hatchet/sdks/go instead of calling this directly. Migration guide: https://docs.hatchet.run/home/migration-guide-go
 func WithLogger(l *zerolog.Logger) WorkerOpt {
@@ -362,6 +386,8 @@ func NewWorker(fs ...WorkerOpt) (*Worker, error) {
 		legacySlots:          opts.legacySlots,
 		initActionNames:      opts.actions,
 		labels:               opts.labels,
+		dynamicLabels:        opts.dynamicLabels,
+		scalingTargets:       opts.scalingTargets,
 		registered_workflows: map[string]bool{},
 	}
 
@@ -590,11 +616,13 @@ func (w *Worker) startBlocking(ctx context.Context) error {
 	_ = NewManagedCompute(&w.actions, w.client, 1)
 
 	listener, id, err := w.client.Dispatcher().GetActionListener(ctx, &client.GetActionListenerRequest{
-		WorkerName:  w.name,
-		Actions:     actionNames,
-		Labels:      w.labels,
-		SlotConfig:  w.slotConfig,
-		LegacySlots: w.legacySlots,
+		WorkerName:     w.name,
+		Actions:        actionNames,
+		Labels:         w.labels,
+		DynamicLabels:  w.dynamicLabels,
+		SlotConfig:     w.slotConfig,
+		LegacySlots:    w.legacySlots,
+		ScalingTargets: w.scalingTargets,
 	})
 
 	w.id = id
@@ -743,6 +771,10 @@ func (w *Worker) startStepRun(ctx context.Context, assignedAction *client.Action
 			default:
 			}
 
+			// the step run's logs are streamed in batches, so they're written before it's reported as
+			// finished
+			w.flushLogs()
+
 			var result any
 
 			if len(runResults) == 2 {
@@ -778,6 +810,18 @@ func (w *Worker) startStepRun(ctx context.Context, assignedAction *client.Action
 	})
 }
 
+// the maximum time a finished step run waits for its logs to be written
+const logFlushTimeout = 5 * time.Second
+
+func (w *Worker) flushLogs() {
+	ctx, cancel := context.WithTimeout(context.Background(), logFlushTimeout)
+	defer cancel()
+
+	if err := w.client.Event().FlushLogs(ctx); err != nil {
+		w.l.Warn().Err(err).Msg("could not flush logs before finishing step run")
+	}
+}
+
 func (w *Worker) startGetGroupKey(ctx context.Context, assignedAction *client.Action) error {
 	// send a message that the step run started
 	_, err := w.client.Dispatcher().SendGroupKeyActionEvent(
@@ -1124,6 +1168,10 @@ func (w *Worker) sendFailureEvent(ctx HatchetContext, taskErr error) error {
 		failureEvent.ShouldNotRetry = &shouldNotRetry
 	}
 
+	if class, ok := errorClass(taskErr); ok {
+		failureEvent.ErrorClass = &class
+	}
+
 	innerCtx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
 	defer cancel()
 
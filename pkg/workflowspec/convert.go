@@ -0,0 +1,386 @@
+package workflowspec
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	contracts "github.com/hatchet-dev/hatchet/internal/services/shared/proto/v1"
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+)
+
+// FromCreateWorkflowVersionOpts rebuilds the request which registered a workflow version from the
+// options stored with it, so that registered workflows can be exported as specs. The synthetic DAG
+// orchestrator task is left out, and or groups get ids derived from the workflow name and the group
+// index, since the original ids aren't stored.
+func FromCreateWorkflowVersionOpts(opts *repository.CreateWorkflowVersionOpts) (*contracts.CreateWorkflowVersionRequest, error) {
+	req := &contracts.CreateWorkflowVersionRequest{
+		Name:            opts.Name,
+		EventTriggers:   opts.EventTriggers,
+		CronTriggers:    opts.CronTriggers,
+		DefaultPriority: opts.DefaultPriority,
+		InputJsonSchema: opts.InputJsonSchema,
+	}
+
+	if opts.Description != nil {
+		req.Description = *opts.Description
+	}
+
+	if len(opts.CronInput) > 0 {
+		cronInput := string(opts.CronInput)
+		req.CronInput = &cronInput
+	}
+
+	for _, step := range opts.Tasks {
+		if step.IsDagOrchestrator {
+			continue
+		}
+
+		task, err := fromCreateStepOpts(opts.Name, &step)
+
+		if err != nil {
+			return nil, err
+		}
+
+		req.Tasks = append(req.Tasks, task)
+	}
+
+	if opts.OnFailure != nil {
+		task, err := fromCreateStepOpts(opts.Name, opts.OnFailure)
+
+		if err != nil {
+			return nil, err
+		}
+
+		req.OnFailureTask = task
+	}
+
+	for _, c := range opts.Concurrency {
+		concurrency, err := fromCreateConcurrencyOpts(c)
+
+		if err != nil {
+			return nil, err
+		}
+
+		req.ConcurrencyArr = append(req.ConcurrencyArr, concurrency)
+	}
+
+	if opts.Sticky != nil {
+		sticky, err := enumValue(contracts.StickyStrategy_value, *opts.Sticky, "sticky strategy")
+
+		if err != nil {
+			return nil, err
+		}
+
+		s := contracts.StickyStrategy(sticky)
+		req.Sticky = &s
+	}
+
+	for _, f := range opts.DefaultFilters {
+		filter := &contracts.DefaultFilter{
+			Expression: f.Expression,
+			Scope:      f.Scope,
+		}
+
+		if len(f.Payload) > 0 {
+			payload, err := json.Marshal(f.Payload)
+
+			if err != nil {
+				return nil, fmt.Errorf("invalid default filter payload: %w", err)
+			}
+
+			filter.Payload = payload
+		}
+
+		req.DefaultFilters = append(req.DefaultFilters, filter)
+	}
+
+	if opts.Idempotency != nil {
+		method, err := enumValue(contracts.IdempotencyMethod_value, string(opts.Idempotency.Method), "idempotency method")
+
+		if err != nil {
+			return nil, err
+		}
+
+		m := contracts.IdempotencyMethod(method)
+
+		req.Idempotency = &contracts.IdempotencyConfig{
+			Expression: opts.Idempotency.Expression,
+			TtlMs:      opts.Idempotency.TTLMs,
+			Method:     &m,
+		}
+	}
+
+	if opts.PriorityAging != nil {
+		req.PriorityAging = &contracts.PriorityAging{
+			Interval: opts.PriorityAging.Interval,
+			MaxWait:  opts.PriorityAging.MaxWait,
+		}
+	}
+
+	return req, nil
+}
+
+func fromCreateStepOpts(workflowName string, step *repository.CreateStepOpts) (*contracts.CreateTaskOpts, error) {
+	task := &contracts.CreateTaskOpts{
+		ReadableId:      step.ReadableId,
+		Action:          step.Action,
+		Parents:         step.Parents,
+		ScheduleTimeout: step.ScheduleTimeout,
+		IsDurable:       step.IsDurable,
+		SlotRequests:    step.SlotRequests,
+	}
+
+	if step.Timeout != nil {
+		task.Timeout = *step.Timeout
+	}
+
+	if step.Retries != nil {
+		task.Retries = int32(*step.Retries) // nolint: gosec
+	}
+
+	if step.RetryBackoffFactor != nil {
+		factor := float32(*step.RetryBackoffFactor)
+		task.BackoffFactor = &factor
+	}
+
+	if step.RetryBackoffMaxSeconds != nil {
+		maxSeconds := int32(*step.RetryBackoffMaxSeconds) // nolint: gosec
+		task.BackoffMaxSeconds = &maxSeconds
+	}
+
+	for _, rl := range step.RateLimits {
+		rateLimit := &contracts.CreateTaskRateLimit{
+			Key:             rl.Key,
+			KeyExpr:         rl.KeyExpr,
+			UnitsExpr:       rl.UnitsExpr,
+			LimitValuesExpr: rl.LimitExpr,
+		}
+
+		if rl.Units != nil {
+			units := int32(*rl.Units) // nolint: gosec
+			rateLimit.Units = &units
+		}
+
+		if rl.Duration != nil {
+			duration, err := enumValue(contracts.RateLimitDuration_value, *rl.Duration, "rate limit duration")
+
+			if err != nil {
+				return nil, err
+			}
+
+			d := contracts.RateLimitDuration(duration)
+			rateLimit.Duration = &d
+		}
+
+		task.RateLimits = append(task.RateLimits, rateLimit)
+	}
+
+	if len(step.DesiredWorkerLabels) > 0 {
+		task.WorkerLabels = make(map[string]*contracts.DesiredWorkerLabels, len(step.DesiredWorkerLabels))
+
+		for key, label := range step.DesiredWorkerLabels {
+			desired := &contracts.DesiredWorkerLabels{
+				StrValue:  label.StrValue,
+				IntValue:  label.IntValue,
+				StrValues: label.StrValues,
+				Required:  label.Required,
+				Weight:    label.Weight,
+			}
+
+			if label.Comparator != nil {
+				comparator, err := enumValue(contracts.WorkerLabelComparator_value, *label.Comparator, "worker label comparator")
+
+				if err != nil {
+					return nil, err
+				}
+
+				c := contracts.WorkerLabelComparator(comparator)
+				desired.Comparator = &c
+			}
+
+			task.WorkerLabels[key] = desired
+		}
+	}
+
+	for _, c := range step.Concurrency {
+		concurrency, err := fromCreateConcurrencyOpts(c)
+
+		if err != nil {
+			return nil, err
+		}
+
+		task.Concurrency = append(task.Concurrency, concurrency)
+	}
+
+	if len(step.TriggerConditions) > 0 {
+		conditions, err := fromCreateStepMatchConditionOpts(workflowName, step.TriggerConditions)
+
+		if err != nil {
+			return nil, fmt.Errorf("task %q: %w", step.ReadableId, err)
+		}
+
+		task.Conditions = conditions
+	}
+
+	if step.BatchConfig != nil {
+		broadcast := step.BatchConfig.BroadcastOutput
+
+		task.Batch = &contracts.TaskBatchConfig{
+			BatchMaxSize:       step.BatchConfig.BatchMaxSize,
+			BatchMaxIntervalMs: step.BatchConfig.BatchMaxInterval,
+			BatchGroupKey:      step.BatchConfig.BatchGroupKey,
+			BatchGroupMaxRuns:  step.BatchConfig.BatchGroupMaxRuns,
+			BroadcastOutput:    &broadcast,
+		}
+	}
+
+	for _, policy := range step.RetryPolicies {
+		retryPolicy := &contracts.RetryPolicy{
+			ErrorClass:        policy.ErrorClass,
+			Expression:        policy.Expression,
+			Retries:           policy.Retries,
+			BackoffMaxSeconds: policy.BackoffMaxSeconds,
+			NeverRetry:        policy.NeverRetry,
+		}
+
+		if policy.BackoffFactor != nil {
+			factor := float32(*policy.BackoffFactor)
+			retryPolicy.BackoffFactor = &factor
+		}
+
+		task.RetryPolicies = append(task.RetryPolicies, retryPolicy)
+	}
+
+	if step.CircuitBreaker != nil {
+		task.CircuitBreaker = &contracts.CircuitBreaker{
+			FailureThreshold: step.CircuitBreaker.FailureThreshold,
+			Window:           step.CircuitBreaker.Window,
+			Cooldown:         step.CircuitBreaker.Cooldown,
+			KeyExpr:          step.CircuitBreaker.KeyExpr,
+		}
+	}
+
+	if step.Preemption != nil {
+		task.Preemption = &contracts.Preemption{
+			MaxPreemptions: step.Preemption.MaxPreemptions,
+		}
+	}
+
+	if step.Map != nil {
+		task.Map = &contracts.TaskMap{
+			Expression:     step.Map.Expression,
+			MaxParallelism: step.Map.MaxParallelism,
+		}
+	}
+
+	if step.Approval != nil {
+		task.Approval = &contracts.TaskApproval{
+			Approvers:     step.Approval.Approvers,
+			ContextFields: step.Approval.ContextFields,
+			ExpiresIn:     step.Approval.ExpiresIn,
+		}
+
+		if step.Approval.OnExpiry != nil {
+			onExpiry, err := enumValue(contracts.ApprovalExpiryAction_value, "APPROVAL_EXPIRY_"+*step.Approval.OnExpiry, "approval expiry action")
+
+			if err != nil {
+				return nil, err
+			}
+
+			action := contracts.ApprovalExpiryAction(onExpiry)
+			task.Approval.OnExpiry = &action
+		}
+	}
+
+	return task, nil
+}
+
+func fromCreateConcurrencyOpts(c repository.CreateConcurrencyOpts) (*contracts.Concurrency, error) {
+	concurrency := &contracts.Concurrency{
+		Expression: c.Expression,
+		MaxRuns:    c.MaxRuns,
+	}
+
+	if c.LimitStrategy != nil {
+		strategy, err := enumValue(contracts.ConcurrencyLimitStrategy_value, *c.LimitStrategy, "concurrency limit strategy")
+
+		if err != nil {
+			return nil, err
+		}
+
+		s := contracts.ConcurrencyLimitStrategy(strategy)
+		concurrency.LimitStrategy = &s
+	}
+
+	return concurrency, nil
+}
+
+func fromCreateStepMatchConditionOpts(workflowName string, opts []repository.CreateStepMatchConditionOpt) (*contracts.TaskConditions, error) {
+	conditions := &contracts.TaskConditions{}
+
+	for _, opt := range opts {
+		action, err := enumValue(contracts.Action_value, opt.Action, "condition action")
+
+		if err != nil {
+			return nil, err
+		}
+
+		base := &contracts.BaseMatchCondition{
+			ReadableDataKey: opt.ReadableDataKey,
+			Action:          contracts.Action(action),
+			OrGroupId:       orGroupId(workflowName, opt.OrGroupIdIndex).String(),
+			Expression:      opt.Expression,
+		}
+
+		switch opt.MatchConditionKind {
+		case "PARENT_OVERRIDE":
+			parentReadableId := opt.ReadableDataKey
+
+			if opt.ParentReadableId != nil {
+				parentReadableId = *opt.ParentReadableId
+			}
+
+			conditions.ParentOverrideConditions = append(conditions.ParentOverrideConditions, &contracts.ParentOverrideMatchCondition{
+				Base:             base,
+				ParentReadableId: parentReadableId,
+			})
+		case "USER_EVENT":
+			condition := &contracts.UserEventMatchCondition{Base: base}
+
+			if opt.EventKey != nil {
+				condition.UserEventKey = *opt.EventKey
+			}
+
+			conditions.UserEventConditions = append(conditions.UserEventConditions, condition)
+		case "SLEEP":
+			condition := &contracts.SleepMatchCondition{Base: base}
+
+			if opt.SleepDuration != nil {
+				condition.SleepFor = *opt.SleepDuration
+			}
+
+			conditions.SleepConditions = append(conditions.SleepConditions, condition)
+		default:
+			return nil, fmt.Errorf("unknown condition kind %q", opt.MatchConditionKind)
+		}
+	}
+
+	return conditions, nil
+}
+
+// orGroupId derives a stable or group id, so that exporting a workflow twice gives the same spec.
+func orGroupId(workflowName string, index int32) uuid.UUID {
+	return uuid.NewSHA1(uuid.NameSpaceOID, fmt.Appendf(nil, "%s/%d", workflowName, index))
+}
+
+func enumValue(values map[string]int32, name, kind string) (int32, error) {
+	v, ok := values[name]
+
+	if !ok {
+		return 0, fmt.Errorf("unknown %s %q", kind, name)
+	}
+
+	return v, nil
+}
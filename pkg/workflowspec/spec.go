@@ -0,0 +1,366 @@
+// Package workflowspec reads and writes declarative workflow definitions.
+//
+// A spec is a YAML or JSON document which maps 1:1 onto CreateWorkflowVersionRequest, using the
+// proto field names:
+//
+//	name: process-order
+//	event_triggers: ["order:created"]
+//	tasks:
+//	  - readable_id: validate
+//	    timeout: 30s
+//	  - readable_id: charge
+//	    parents: [validate]
+//	    retries: 3
+//
+// A task's action defaults to `<workflow name>:<readable id>`, which is what the SDKs register.
+// Fields which are JSON in the request, `input_json_schema` and the `payload` of default filters,
+// are written as YAML or JSON objects rather than base64 strings.
+//
+// A document may also bind the workflow to an HTTP operator under `operator.http`, which is created
+// or updated when the spec is applied. A file may hold several documents separated by `---`.
+package workflowspec
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"gopkg.in/yaml.v3"
+
+	contracts "github.com/hatchet-dev/hatchet/internal/services/shared/proto/v1"
+)
+
+// operatorKey is the document key holding the operator binding, which isn't part of the request.
+const operatorKey = "operator"
+
+// Document is a single workflow spec.
+type Document struct {
+	// Workflow is the request which registers the workflow.
+	Workflow *contracts.CreateWorkflowVersionRequest
+
+	// Operator is the operator which runs the workflow's tasks, if the spec binds one.
+	Operator *Operator
+}
+
+// Operator binds a workflow to an operator. Only HTTP operators can be bound.
+type Operator struct {
+	HTTP *HTTPOperator `json:"http,omitempty" yaml:"http,omitempty"`
+}
+
+// HTTPOperator is an HTTP operator, matched by name. The signing secret is read from an environment
+// variable when the spec is applied, so it's never checked in.
+type HTTPOperator struct {
+	Name                  string `json:"name" yaml:"name"`
+	TriggerEndpoint       string `json:"trigger_endpoint" yaml:"trigger_endpoint"`
+	HealthcheckEndpoint   string `json:"healthcheck_endpoint" yaml:"healthcheck_endpoint"`
+	SigningSecretEnv      string `json:"signing_secret_env" yaml:"signing_secret_env"`
+	RequestTimeoutSeconds int32  `json:"request_timeout_seconds,omitempty" yaml:"request_timeout_seconds,omitempty"`
+}
+
+// Parse reads every workflow spec in data, which is YAML or JSON.
+func Parse(data []byte) ([]*Document, error) {
+	dec := yaml.NewDecoder(bytes.NewReader(data))
+
+	var docs []*Document
+
+	for i := 0; ; i++ {
+		var raw interface{}
+
+		err := dec.Decode(&raw)
+
+		if errors.Is(err, io.EOF) {
+			break
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("document %d: %w", i+1, err)
+		}
+
+		// an empty document, e.g. a trailing `---`
+		if raw == nil {
+			continue
+		}
+
+		doc, err := parseDocument(raw)
+
+		if err != nil {
+			return nil, fmt.Errorf("document %d: %w", i+1, err)
+		}
+
+		docs = append(docs, doc)
+	}
+
+	if len(docs) == 0 {
+		return nil, fmt.Errorf("no workflow specs found")
+	}
+
+	return docs, nil
+}
+
+func parseDocument(raw interface{}) (*Document, error) {
+	obj, ok := raw.(map[string]interface{})
+
+	if !ok {
+		return nil, fmt.Errorf("a workflow spec must be an object")
+	}
+
+	doc := &Document{}
+
+	if op, ok := obj[operatorKey]; ok {
+		delete(obj, operatorKey)
+
+		b, err := json.Marshal(op)
+
+		if err != nil {
+			return nil, fmt.Errorf("invalid operator: %w", err)
+		}
+
+		dec := json.NewDecoder(bytes.NewReader(b))
+		dec.DisallowUnknownFields()
+
+		doc.Operator = &Operator{}
+
+		if err := dec.Decode(doc.Operator); err != nil {
+			return nil, fmt.Errorf("invalid operator: %w", err)
+		}
+	}
+
+	if err := encodeJSONField(obj, "input_json_schema"); err != nil {
+		return nil, err
+	}
+
+	if filters, ok := obj["default_filters"].([]interface{}); ok {
+		for i, f := range filters {
+			if filter, ok := f.(map[string]interface{}); ok {
+				if err := encodeJSONField(filter, "payload"); err != nil {
+					return nil, fmt.Errorf("default filter %d: %w", i, err)
+				}
+			}
+		}
+	}
+
+	if cronInput, ok := obj["cron_input"]; ok {
+		if _, isString := cronInput.(string); !isString {
+			b, err := json.Marshal(cronInput)
+
+			if err != nil {
+				return nil, fmt.Errorf("invalid cron_input: %w", err)
+			}
+
+			obj["cron_input"] = string(b)
+		}
+	}
+
+	b, err := json.Marshal(obj)
+
+	if err != nil {
+		return nil, err
+	}
+
+	doc.Workflow = &contracts.CreateWorkflowVersionRequest{}
+
+	if err := protojson.Unmarshal(b, doc.Workflow); err != nil {
+		return nil, fmt.Errorf("invalid workflow spec: %w", err)
+	}
+
+	for _, task := range doc.Workflow.Tasks {
+		setDefaultAction(doc.Workflow.Name, task)
+	}
+
+	if doc.Workflow.OnFailureTask != nil {
+		setDefaultAction(doc.Workflow.Name, doc.Workflow.OnFailureTask)
+	}
+
+	return doc, nil
+}
+
+func setDefaultAction(workflowName string, task *contracts.CreateTaskOpts) {
+	if task.Action == "" && task.ReadableId != "" {
+		task.Action = defaultAction(workflowName, task.ReadableId)
+	}
+}
+
+// defaultAction matches the action ids the SDKs register for a task.
+func defaultAction(workflowName, readableId string) string {
+	return strings.ToLower(fmt.Sprintf("%s:%s", workflowName, readableId))
+}
+
+// encodeJSONField replaces a JSON field written as an object, or as a string of JSON, with the
+// base64 string protojson expects for bytes.
+func encodeJSONField(obj map[string]interface{}, key string) error {
+	v, ok := obj[key]
+
+	if !ok || v == nil {
+		return nil
+	}
+
+	var b []byte
+
+	if s, isString := v.(string); isString {
+		if !json.Valid([]byte(s)) {
+			return fmt.Errorf("%s is not valid JSON", key)
+		}
+
+		b = []byte(s)
+	} else {
+		var err error
+
+		if b, err = json.Marshal(v); err != nil {
+			return fmt.Errorf("invalid %s: %w", key, err)
+		}
+	}
+
+	obj[key] = base64.StdEncoding.EncodeToString(b)
+
+	return nil
+}
+
+// Format is the encoding of a written spec.
+type Format string
+
+const (
+	FormatYAML Format = "yaml"
+	FormatJSON Format = "json"
+)
+
+// Marshal writes a workflow spec. Default values are left out, and the actions of tasks are left out
+// when they match the default.
+func Marshal(doc *Document, format Format) ([]byte, error) {
+	if doc.Workflow == nil {
+		return nil, fmt.Errorf("a workflow spec requires a workflow")
+	}
+
+	req := proto.Clone(doc.Workflow).(*contracts.CreateWorkflowVersionRequest)
+
+	for _, task := range append(append([]*contracts.CreateTaskOpts{}, req.Tasks...), req.OnFailureTask) {
+		if task != nil && task.Action == defaultAction(req.Name, task.ReadableId) {
+			task.Action = ""
+		}
+	}
+
+	b, err := protojson.MarshalOptions{UseProtoNames: true}.Marshal(req)
+
+	if err != nil {
+		return nil, err
+	}
+
+	// decoding into a node, rather than a map, keeps the fields in proto order
+	var node yaml.Node
+
+	if err := yaml.Unmarshal(b, &node); err != nil {
+		return nil, err
+	}
+
+	root := node.Content[0]
+
+	if err := decodeJSONField(root, "input_json_schema"); err != nil {
+		return nil, err
+	}
+
+	if filters := mappingValue(root, "default_filters"); filters != nil {
+		for _, filter := range filters.Content {
+			if err := decodeJSONField(filter, "payload"); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if doc.Operator != nil {
+		var op yaml.Node
+
+		if err := op.Encode(doc.Operator); err != nil {
+			return nil, err
+		}
+
+		root.Content = append(root.Content, &yaml.Node{Kind: yaml.ScalarNode, Value: operatorKey}, &op)
+	}
+
+	setBlockStyle(root)
+
+	switch format {
+	case FormatJSON:
+		var v interface{}
+
+		if err := root.Decode(&v); err != nil {
+			return nil, err
+		}
+
+		return json.MarshalIndent(v, "", "  ")
+	case FormatYAML, "":
+		var buf bytes.Buffer
+
+		enc := yaml.NewEncoder(&buf)
+		enc.SetIndent(2)
+
+		if err := enc.Encode(root); err != nil {
+			return nil, err
+		}
+
+		if err := enc.Close(); err != nil {
+			return nil, err
+		}
+
+		return buf.Bytes(), nil
+	default:
+		return nil, fmt.Errorf("unknown format %q", format)
+	}
+}
+
+// decodeJSONField replaces a base64 bytes field written by protojson with the JSON it holds.
+func decodeJSONField(mapping *yaml.Node, key string) error {
+	v := mappingValue(mapping, key)
+
+	if v == nil {
+		return nil
+	}
+
+	b, err := base64.StdEncoding.DecodeString(v.Value)
+
+	if err != nil {
+		return fmt.Errorf("invalid %s: %w", key, err)
+	}
+
+	var decoded yaml.Node
+
+	if err := yaml.Unmarshal(b, &decoded); err != nil {
+		return fmt.Errorf("%s is not valid JSON: %w", key, err)
+	}
+
+	if len(decoded.Content) == 0 {
+		return nil
+	}
+
+	*v = *decoded.Content[0]
+
+	return nil
+}
+
+func mappingValue(mapping *yaml.Node, key string) *yaml.Node {
+	if mapping.Kind != yaml.MappingNode {
+		return nil
+	}
+
+	for i := 0; i+1 < len(mapping.Content); i += 2 {
+		if mapping.Content[i].Value == key {
+			return mapping.Content[i+1]
+		}
+	}
+
+	return nil
+}
+
+// setBlockStyle clears the flow style of nodes decoded from JSON, so they're written as block YAML.
+// The encoder still quotes strings which would otherwise read as another type.
+func setBlockStyle(node *yaml.Node) {
+	node.Style = 0
+
+	for _, c := range node.Content {
+		setBlockStyle(c)
+	}
+}
@@ -0,0 +1,280 @@
+package workflowspec
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	contracts "github.com/hatchet-dev/hatchet/internal/services/shared/proto/v1"
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+)
+
+const orderSpec = `
+name: Process-Order
+event_triggers: ["order:created"]
+cron_triggers: ["0 * * * *"]
+cron_input:
+  source: cron
+input_json_schema:
+  type: object
+  properties:
+    id:
+      type: string
+concurrency_arr:
+  - expression: input.customer_id
+    max_runs: 2
+    limit_strategy: GROUP_ROUND_ROBIN
+tasks:
+  - readable_id: validate
+    timeout: 30s
+  - readable_id: charge
+    action: payments:charge
+    parents: [validate]
+    retries: 3
+    rate_limits:
+      - key: stripe
+        units: 1
+    conditions:
+      parent_override_conditions:
+        - parent_readable_id: validate
+          base:
+            readable_data_key: validate
+            action: SKIP
+            expression: output.free == true
+operator:
+  http:
+    name: orders-api
+    trigger_endpoint: https://orders.example.com/hatchet
+    healthcheck_endpoint: https://orders.example.com/hatchet/health
+    signing_secret_env: ORDERS_SIGNING_SECRET
+---
+name: nightly-report
+tasks:
+  - readable_id: build
+`
+
+func TestParse(t *testing.T) {
+	docs, err := Parse([]byte(orderSpec))
+	require.NoError(t, err)
+	require.Len(t, docs, 2)
+
+	wf := docs[0].Workflow
+	assert.Equal(t, "Process-Order", wf.Name)
+	assert.Equal(t, []string{"order:created"}, wf.EventTriggers)
+	assert.Equal(t, `{"source":"cron"}`, wf.GetCronInput())
+	assert.JSONEq(t, `{"type":"object","properties":{"id":{"type":"string"}}}`, string(wf.InputJsonSchema))
+	require.Len(t, wf.ConcurrencyArr, 1)
+	assert.Equal(t, contracts.ConcurrencyLimitStrategy_GROUP_ROUND_ROBIN, wf.ConcurrencyArr[0].GetLimitStrategy())
+
+	require.Len(t, wf.Tasks, 2)
+	assert.Equal(t, "process-order:validate", wf.Tasks[0].Action, "the action defaults to the one the SDKs register")
+	assert.Equal(t, "payments:charge", wf.Tasks[1].Action)
+	assert.Equal(t, int32(3), wf.Tasks[1].Retries)
+	assert.Equal(t, contracts.Action_SKIP, wf.Tasks[1].Conditions.ParentOverrideConditions[0].Base.Action)
+
+	require.NotNil(t, docs[0].Operator)
+	assert.Equal(t, "orders-api", docs[0].Operator.HTTP.Name)
+	assert.Nil(t, docs[1].Operator)
+
+	for _, doc := range docs {
+		assert.NoError(t, Validate(doc))
+	}
+}
+
+func TestParseJSON(t *testing.T) {
+	docs, err := Parse([]byte(`{"name": "wf", "tasks": [{"readable_id": "a"}], "default_filters": [{"expression": "true", "scope": "s", "payload": {"k": 1}}]}`))
+	require.NoError(t, err)
+	require.Len(t, docs, 1)
+	assert.JSONEq(t, `{"k":1}`, string(docs[0].Workflow.DefaultFilters[0].Payload))
+}
+
+func TestParseRejectsUnknownFields(t *testing.T) {
+	_, err := Parse([]byte("name: wf\ntasks:\n  - readable_id: a\n    retires: 3\n"))
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "retires")
+
+	_, err = Parse([]byte("name: wf\ntasks: [{readable_id: a}]\noperator:\n  http:\n    secret: x\n"))
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid operator")
+}
+
+func TestValidate(t *testing.T) {
+	docs, err := Parse([]byte(`
+name: wf
+tasks:
+  - readable_id: a
+    parents: [c]
+  - readable_id: b
+    parents: [missing]
+  - readable_id: c
+    parents: [a]
+  - readable_id: b
+on_failure_task:
+  readable_id: cleanup
+  parents: [a]
+operator:
+  http:
+    name: api
+    trigger_endpoint: http://example.com
+`))
+	require.NoError(t, err)
+
+	err = Validate(docs[0])
+	require.Error(t, err)
+
+	for _, msg := range []string{
+		`task "b" is defined more than once`,
+		`task "b" has an unknown parent "missing"`,
+		`task "a" depends on itself through its parents`,
+		"on_failure_task can't have parents",
+		"operator.http: trigger_endpoint must be an https URL",
+		"operator.http: healthcheck_endpoint is required",
+		"operator.http: signing_secret_env is required",
+	} {
+		assert.Contains(t, err.Error(), msg)
+	}
+}
+
+func TestMarshalRoundTrip(t *testing.T) {
+	docs, err := Parse([]byte(orderSpec))
+	require.NoError(t, err)
+
+	for _, format := range []Format{FormatYAML, FormatJSON} {
+		b, err := Marshal(docs[0], format)
+		require.NoError(t, err)
+
+		assert.NotContains(t, string(b), "process-order:validate", "default actions are left out")
+
+		reparsed, err := Parse(b)
+		require.NoError(t, err, string(b))
+		require.Len(t, reparsed, 1)
+
+		assert.True(t, proto.Equal(docs[0].Workflow, reparsed[0].Workflow), string(b))
+		assert.Equal(t, docs[0].Operator, reparsed[0].Operator)
+	}
+}
+
+func TestMarshalQuotesAmbiguousStrings(t *testing.T) {
+	doc := &Document{Workflow: &contracts.CreateWorkflowVersionRequest{
+		Name:          "wf",
+		Version:       "1.0",
+		EventTriggers: []string{"true", "123"},
+		Tasks:         []*contracts.CreateTaskOpts{{ReadableId: "a", Action: "wf:a"}},
+	}}
+
+	b, err := Marshal(doc, FormatYAML)
+	require.NoError(t, err)
+
+	reparsed, err := Parse(b)
+	require.NoError(t, err, string(b))
+	assert.Equal(t, "1.0", reparsed[0].Workflow.Version)
+	assert.Equal(t, []string{"true", "123"}, reparsed[0].Workflow.EventTriggers)
+}
+
+func TestFromCreateWorkflowVersionOpts(t *testing.T) {
+	docs, err := Parse([]byte(orderSpec))
+	require.NoError(t, err)
+
+	retries := 3
+	units := 1
+	duration := "MINUTE"
+	strategy := "GROUP_ROUND_ROBIN"
+	maxRuns := int32(2)
+	parentReadableId := "validate"
+	timeout := "30s"
+	description := ""
+
+	// the options the engine stores for the first spec, after a round trip through JSON
+	stored, err := json.Marshal(&repository.CreateWorkflowVersionOpts{
+		Name:            "Process-Order",
+		Description:     &description,
+		EventTriggers:   []string{"order:created"},
+		CronTriggers:    []string{"0 * * * *"},
+		CronInput:       []byte(`{"source":"cron"}`),
+		InputJsonSchema: docs[0].Workflow.InputJsonSchema,
+		Concurrency: []repository.CreateConcurrencyOpts{
+			{Expression: "input.customer_id", MaxRuns: &maxRuns, LimitStrategy: &strategy},
+		},
+		Tasks: []repository.CreateStepOpts{
+			{ReadableId: "validate", Action: "process-order:validate", Timeout: &timeout, Parents: []string{}},
+			{
+				ReadableId: "charge",
+				Action:     "payments:charge",
+				Parents:    []string{"validate"},
+				Retries:    &retries,
+				RateLimits: []repository.CreateWorkflowStepRateLimitOpts{{Key: "stripe", Units: &units, Duration: &duration}},
+				TriggerConditions: []repository.CreateStepMatchConditionOpt{{
+					MatchConditionKind: "PARENT_OVERRIDE",
+					ReadableDataKey:    "validate",
+					ParentReadableId:   &parentReadableId,
+					Action:             "SKIP",
+					Expression:         "output.free == true",
+				}},
+			},
+			{ReadableId: "process-order-orchestrator", Action: "process-order:orchestrator", IsDagOrchestrator: true},
+		},
+	})
+	require.NoError(t, err)
+
+	var opts repository.CreateWorkflowVersionOpts
+	require.NoError(t, json.Unmarshal(stored, &opts))
+
+	req, err := FromCreateWorkflowVersionOpts(&opts)
+	require.NoError(t, err)
+
+	require.Len(t, req.Tasks, 2, "the orchestrator task is left out")
+
+	charge := req.Tasks[1]
+	assert.Equal(t, contracts.RateLimitDuration_MINUTE, charge.RateLimits[0].GetDuration())
+	assert.Equal(t, "validate", charge.Conditions.ParentOverrideConditions[0].ParentReadableId)
+	assert.NotEmpty(t, charge.Conditions.ParentOverrideConditions[0].Base.OrGroupId)
+
+	// the or group id isn't stored, so ignore it when comparing with the parsed spec
+	charge.Conditions.ParentOverrideConditions[0].Base.OrGroupId = ""
+	charge.RateLimits[0].Duration = nil
+
+	assert.True(t, proto.Equal(docs[0].Workflow, req), "got %v", req)
+
+	again, err := FromCreateWorkflowVersionOpts(&opts)
+	require.NoError(t, err)
+	assert.Equal(t, orGroupId("Process-Order", 0).String(), again.Tasks[1].Conditions.ParentOverrideConditions[0].Base.OrGroupId)
+}
+
+func TestFromCreateWorkflowVersionOptsApproval(t *testing.T) {
+	docs, err := Parse([]byte(`
+name: refund
+tasks:
+  - readable_id: approve
+    approval:
+      approvers: [finance@example.com]
+      context_fields: [input.amount]
+      expires_in: 2h
+      on_expiry: APPROVAL_EXPIRY_REJECT
+`))
+	require.NoError(t, err)
+	require.NoError(t, Validate(docs[0]))
+
+	expiresIn := "2h"
+	onExpiry := "REJECT"
+
+	req, err := FromCreateWorkflowVersionOpts(&repository.CreateWorkflowVersionOpts{
+		Name: "refund",
+		Tasks: []repository.CreateStepOpts{{
+			ReadableId: "approve",
+			Action:     "refund:approve",
+			Parents:    []string{},
+			Approval: &repository.CreateStepApprovalOpts{
+				Approvers:     []string{"finance@example.com"},
+				ContextFields: []string{"input.amount"},
+				ExpiresIn:     &expiresIn,
+				OnExpiry:      &onExpiry,
+			},
+		}},
+	})
+	require.NoError(t, err)
+
+	assert.True(t, proto.Equal(docs[0].Workflow, req), "got %v", req)
+}
@@ -0,0 +1,169 @@
+package workflowspec
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+
+	contracts "github.com/hatchet-dev/hatchet/internal/services/shared/proto/v1"
+)
+
+// Validate checks a workflow spec for the errors which can be found without the engine: missing
+// fields, duplicate or unknown tasks, cycles and incomplete operator bindings. Every problem found is
+// returned, joined.
+func Validate(doc *Document) error {
+	var errs []error
+
+	wf := doc.Workflow
+
+	if wf.Name == "" {
+		errs = append(errs, errors.New("name is required"))
+	}
+
+	if len(wf.Tasks) == 0 {
+		errs = append(errs, errors.New("at least one task is required"))
+	}
+
+	readableIds := make(map[string]*contracts.CreateTaskOpts, len(wf.Tasks))
+
+	for i, task := range wf.Tasks {
+		if task.ReadableId == "" {
+			errs = append(errs, fmt.Errorf("task %d: readable_id is required", i))
+			continue
+		}
+
+		if _, exists := readableIds[task.ReadableId]; exists {
+			errs = append(errs, fmt.Errorf("task %q is defined more than once", task.ReadableId))
+			continue
+		}
+
+		readableIds[task.ReadableId] = task
+	}
+
+	for _, task := range wf.Tasks {
+		for _, parent := range task.Parents {
+			if parent == task.ReadableId {
+				errs = append(errs, fmt.Errorf("task %q can't be its own parent", task.ReadableId))
+			} else if _, exists := readableIds[parent]; !exists {
+				errs = append(errs, fmt.Errorf("task %q has an unknown parent %q", task.ReadableId, parent))
+			}
+		}
+
+		if task.Conditions != nil {
+			for _, cond := range task.Conditions.ParentOverrideConditions {
+				if _, exists := readableIds[cond.ParentReadableId]; !exists {
+					errs = append(errs, fmt.Errorf("task %q has a condition on an unknown parent %q", task.ReadableId, cond.ParentReadableId))
+				}
+			}
+		}
+	}
+
+	if cycle := findCycle(wf.Tasks, readableIds); cycle != "" {
+		errs = append(errs, fmt.Errorf("task %q depends on itself through its parents", cycle))
+	}
+
+	if onFailure := wf.OnFailureTask; onFailure != nil {
+		if onFailure.ReadableId == "" {
+			errs = append(errs, errors.New("on_failure_task: readable_id is required"))
+		}
+
+		if len(onFailure.Parents) > 0 {
+			errs = append(errs, errors.New("on_failure_task can't have parents"))
+		}
+
+		if onFailure.Map != nil {
+			errs = append(errs, errors.New("on_failure_task can't be a map task"))
+		}
+	}
+
+	if doc.Operator != nil {
+		errs = append(errs, validateOperator(doc.Operator)...)
+	}
+
+	return errors.Join(errs...)
+}
+
+func validateOperator(op *Operator) []error {
+	if op.HTTP == nil {
+		return []error{errors.New("operator: http is required")}
+	}
+
+	var errs []error
+
+	if op.HTTP.Name == "" {
+		errs = append(errs, errors.New("operator.http: name is required"))
+	}
+
+	endpoints := []struct {
+		field string
+		url   string
+	}{
+		{"trigger_endpoint", op.HTTP.TriggerEndpoint},
+		{"healthcheck_endpoint", op.HTTP.HealthcheckEndpoint},
+	}
+
+	for _, endpoint := range endpoints {
+		if endpoint.url == "" {
+			errs = append(errs, fmt.Errorf("operator.http: %s is required", endpoint.field))
+			continue
+		}
+
+		if u, err := url.Parse(endpoint.url); err != nil || u.Scheme != "https" || u.Host == "" {
+			errs = append(errs, fmt.Errorf("operator.http: %s must be an https URL", endpoint.field))
+		}
+	}
+
+	if op.HTTP.SigningSecretEnv == "" {
+		errs = append(errs, errors.New("operator.http: signing_secret_env is required"))
+	}
+
+	if op.HTTP.RequestTimeoutSeconds < 0 {
+		errs = append(errs, errors.New("operator.http: request_timeout_seconds can't be negative"))
+	}
+
+	return errs
+}
+
+// findCycle returns a task which depends on itself through its parents, or an empty string.
+func findCycle(tasks []*contracts.CreateTaskOpts, readableIds map[string]*contracts.CreateTaskOpts) string {
+	const (
+		unvisited = iota
+		visiting
+		visited
+	)
+
+	state := make(map[string]int, len(tasks))
+
+	var visit func(readableId string) bool
+
+	visit = func(readableId string) bool {
+		switch state[readableId] {
+		case visiting:
+			return true
+		case visited:
+			return false
+		}
+
+		state[readableId] = visiting
+
+		if task, ok := readableIds[readableId]; ok {
+			for _, parent := range task.Parents {
+				if parent != readableId && visit(parent) {
+					return true
+				}
+			}
+		}
+
+		state[readableId] = visited
+
+		return false
+	}
+
+	for _, task := range tasks {
+		if state[task.ReadableId] == unvisited && visit(task.ReadableId) {
+			return task.ReadableId
+		}
+	}
+
+	return ""
+}
@@ -0,0 +1,86 @@
+//go:build !e2e && !load && !rampup && !integration
+
+package hatchet
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	v1 "github.com/hatchet-dev/hatchet/internal/services/shared/proto/v1"
+	"github.com/hatchet-dev/hatchet/pkg/client/types"
+)
+
+// These offline tests dump the registration request to check what NewApprovalTask puts in it.
+
+func TestNewApprovalTask_RegistersApprovalWithoutAction(t *testing.T) {
+	c := newTestClient()
+	wf := c.NewWorkflow("refund")
+
+	prepare := wf.NewTask("prepare", sampleTaskFn)
+	wf.NewApprovalTask("approve-refund", &types.TaskApproval{
+		Approvers:     []string{"finance@example.com"},
+		ContextFields: []string{"input.amount"},
+		ExpiresIn:     2 * time.Hour,
+		OnExpiry:      types.ApprovalExpiryReject,
+	}, WithParents(prepare))
+
+	req, regularActions, durableActions, _ := wf.Dump()
+
+	require.Len(t, req.Tasks, 2)
+
+	approvalTask := req.Tasks[1]
+	require.NotNil(t, approvalTask.Approval)
+	assert.Equal(t, []string{"finance@example.com"}, approvalTask.Approval.Approvers)
+	assert.Equal(t, []string{"input.amount"}, approvalTask.Approval.ContextFields)
+	require.NotNil(t, approvalTask.Approval.ExpiresIn)
+	assert.Equal(t, "2h0m0s", *approvalTask.Approval.ExpiresIn)
+	require.NotNil(t, approvalTask.Approval.OnExpiry)
+	assert.Equal(t, v1.ApprovalExpiryAction_APPROVAL_EXPIRY_REJECT, *approvalTask.Approval.OnExpiry)
+	assert.Equal(t, []string{"prepare"}, approvalTask.Parents)
+	assert.Equal(t, map[string]int32{"durable": 1}, approvalTask.SlotRequests)
+
+	// only the regular task is run by the worker
+	require.Len(t, regularActions, 1)
+	assert.Equal(t, req.Tasks[0].Action, regularActions[0].ActionID)
+	assert.Empty(t, durableActions)
+}
+
+func TestNewApprovalTask_DefaultsAreLeftToTheEngine(t *testing.T) {
+	c := newTestClient()
+	wf := c.NewWorkflow("deploy")
+
+	wf.NewApprovalTask("sign-off", &types.TaskApproval{})
+
+	req, _, _, _ := wf.Dump()
+
+	require.Len(t, req.Tasks, 1)
+	require.NotNil(t, req.Tasks[0].Approval)
+	assert.Nil(t, req.Tasks[0].Approval.ExpiresIn)
+	assert.Nil(t, req.Tasks[0].Approval.OnExpiry)
+}
+
+func TestNewApprovalTask_ApprovalTasksDontNeedWorkerSlots(t *testing.T) {
+	c := newTestClient()
+	wf := c.NewWorkflow("sign-off-only")
+
+	wf.NewApprovalTask("sign-off", &types.TaskApproval{})
+
+	dumps := gatherWorkflowDumps([]WorkflowBase{wf})
+
+	assert.Equal(t, map[slotType]int{slotTypeDefault: 100}, resolveWorkerSlotConfig(map[slotType]int{}, dumps))
+}
+
+func TestNewApprovalTask_RejectsInvalidOptions(t *testing.T) {
+	c := newTestClient()
+	wf := c.NewWorkflow("invalid")
+
+	assert.Panics(t, func() {
+		wf.NewApprovalTask("nil-approval", nil)
+	})
+	assert.Panics(t, func() {
+		wf.NewApprovalTask("durable", &types.TaskApproval{}, withDurable())
+	})
+}
@@ -184,6 +184,14 @@ func (c *Client) NewWorker(name string, options ...WorkerOption) (*Worker, error
 		workerOpts = append(workerOpts, worker.WithLabels(config.labels))
 	}
 
+	if config.dynamicLabels != nil {
+		workerOpts = append(workerOpts, worker.WithDynamicLabels(config.dynamicLabels))
+	}
+
+	if config.scalingTargets != nil {
+		workerOpts = append(workerOpts, worker.WithScalingTargets(config.scalingTargets.toClient()))
+	}
+
 	mainWorker, err := worker.NewWorker(workerOpts...)
 	if err != nil {
 		return nil, err
@@ -222,6 +230,9 @@ func (c *Client) NewWorker(name string, options ...WorkerOption) (*Worker, error
 			durableSlotCount = 1000
 		}
 
+		evictionConfig := evictionpkg.DefaultDurableEvictionConfig
+		evictionConfig.Now = config.now
+
 		w.evictionManager = evictionpkg.NewDurableEvictionManager(
 			durableSlotCount,
 			func(key string) {
@@ -233,7 +244,7 @@ func (c *Client) NewWorker(name string, options ...WorkerOption) (*Worker, error
 				}
 				return w.durableTaskListener.SendEvictionRequest(ctx, rec.StepRunID, rec.InvocationCount)
 			},
-			evictionpkg.DefaultDurableEvictionConfig,
+			evictionConfig,
 			config.logger,
 		)
 	}
@@ -397,6 +408,10 @@ func resolveWorkerSlotConfig(
 
 	for _, dump := range dumps {
 		for _, task := range dump.req.Tasks {
+			if task.Approval != nil {
+				// approval tasks hold a slot on the engine's approval operator, not this worker
+				continue
+			}
 			addFromRequests(task.SlotRequests)
 		}
 		if dump.req.OnFailureTask != nil {
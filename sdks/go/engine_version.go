@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+	"time"
 )
 
 // MinEngineVersion defines minimum engine versions for feature support.
@@ -27,6 +28,15 @@ func (c *Client) GetEngineVersion(ctx context.Context) (string, error) {
 	return version, nil
 }
 
+// AdvanceEngineClock moves the engine clock forward by d, firing any sleeps, timeouts and
+// scheduled runs which come due, and returns the engine's total clock offset. It only works
+// against engines built with the timetravel build tag and started with SERVER_ALLOW_TIME_TRAVEL,
+// and is intended for integration tests.
+// Workers can follow the engine clock for eviction TTLs with WithClock.
+func (c *Client) AdvanceEngineClock(ctx context.Context, d time.Duration) (time.Duration, error) {
+	return c.legacyClient.Admin().AdvanceClock(ctx, d)
+}
+
 // SupportsDurableEviction checks whether the engine version supports durable eviction.
 func SupportsDurableEviction(engineVersion string) (bool, error) {
 	return !semverLessThan(engineVersion, MinEngineVersion.DurableEviction), nil
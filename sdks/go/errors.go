@@ -92,3 +92,28 @@ func IsBulkTriggerIdempotencyCollisionError(err error) (*BulkTriggerIdempotencyC
 	}
 	return nil, false
 }
+
+// ClassifiedError is a task error with an error class, which the engine matches against the
+// task's retry policies (see WithRetryPolicies).
+type ClassifiedError struct {
+	Class string
+	Err   error
+}
+
+// NewClassifiedError wraps err with an error class, e.g. "RateLimitError".
+func NewClassifiedError(class string, err error) error {
+	return &ClassifiedError{Class: class, Err: err}
+}
+
+func (e *ClassifiedError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *ClassifiedError) Unwrap() error {
+	return e.Err
+}
+
+// ErrorClass returns the error class reported to the engine when the task fails.
+func (e *ClassifiedError) ErrorClass() string {
+	return e.Class
+}
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"log"
 
+	"github.com/hatchet-dev/hatchet/pkg/client/types"
 	"github.com/hatchet-dev/hatchet/pkg/worker"
 	hatchet "github.com/hatchet-dev/hatchet/sdks/go"
 )
@@ -79,6 +80,36 @@ func NonRetryableError(client *hatchet.Client) *hatchet.StandaloneTask {
 	return retries
 }
 
+type RetryPoliciesInput struct {
+	Url string `json:"url"`
+}
+type RetryPoliciesResult struct{}
+
+// RetryPolicies returns a task whose retries depend on the class of error it fails with
+func RetryPolicies(client *hatchet.Client) *hatchet.StandaloneTask {
+	// > Retry Policies
+	invalidInput := "InvalidInput"
+	rateLimited := `error.message.contains("429")`
+	rateLimitRetries := int32(10)
+	rateLimitBackoff := float32(2)
+
+	retryPolicies := client.NewStandaloneTask("retry-policies-task", func(ctx hatchet.Context, input RetryPoliciesInput) (*RetryPoliciesResult, error) {
+		if input.Url == "" {
+			// never retried, whatever the task's retries
+			return nil, hatchet.NewClassifiedError(invalidInput, errors.New("url is required"))
+		}
+
+		// retried up to 10 times with backoff, since the message matches the expression
+		return nil, errors.New("upstream returned 429")
+	}, hatchet.WithRetries(3), hatchet.WithRetryPolicies(
+		&types.RetryPolicy{ErrorClass: &invalidInput, NeverRetry: true},
+		&types.RetryPolicy{Expression: &rateLimited, Retries: &rateLimitRetries, BackoffFactor: &rateLimitBackoff},
+	))
+	// !!
+
+	return retryPolicies
+}
+
 func main() {
 	client, err := hatchet.NewClient()
 	if err != nil {
@@ -87,7 +118,7 @@ func main() {
 
 	worker, err := client.NewWorker(
 		"retries-worker",
-		hatchet.WithWorkflows(Retries(client), RetriesWithCount(client), WithBackoff(client), NonRetryableError(client)),
+		hatchet.WithWorkflows(Retries(client), RetriesWithCount(client), WithBackoff(client), NonRetryableError(client), RetryPolicies(client)),
 	)
 	if err != nil {
 		log.Fatalf("failed to create worker: %v", err)
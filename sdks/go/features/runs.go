@@ -179,6 +179,34 @@ func (r *RunsClient) BranchDurableTask(ctx context.Context, taskExternalId strin
 	return resp.JSON200, nil
 }
 
+// Signal delivers a signal to the conditions a run is waiting on. The name is matched against the
+// event key of the waiting conditions.
+func (r *RunsClient) Signal(ctx context.Context, runExternalId string, name string, payload map[string]interface{}) (*rest.V1SignalRunResponse, error) {
+	body := rest.V1SignalRunRequest{
+		RunExternalId: uuid.MustParse(runExternalId),
+		Name:          name,
+	}
+
+	if payload != nil {
+		body.Payload = &payload
+	}
+
+	resp, err := r.api.V1WorkflowRunSignalWithResponse(
+		ctx,
+		r.tenantId,
+		body,
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to signal run")
+	}
+
+	if err := validateJSON200Response(resp.StatusCode(), resp.Body, resp.JSON200); err != nil {
+		return nil, err
+	}
+
+	return resp.JSON200, nil
+}
+
 // Restore re-enqueues an evicted task so it can resume execution.
 func (r *RunsClient) Restore(ctx context.Context, taskExternalId string) (*rest.V1RestoreTaskResponse, error) {
 	resp, err := r.api.V1TaskRestoreWithResponse(
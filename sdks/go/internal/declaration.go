@@ -149,6 +149,7 @@ type workflowDeclarationImpl[I any, O any] struct {
 	DefaultPriority *int32
 	DefaultFilters  []types.DefaultFilter
 	Idempotency     *create.IdempotencyConfig
+	PriorityAging   *types.PriorityAging
 }
 
 // NewWorkflowDeclaration creates a new workflow declaration with the specified options and client.
@@ -205,6 +206,7 @@ func NewWorkflowDeclaration[I any, O any](opts create.WorkflowCreateOpts[I], v0
 		DefaultPriority:  opts.DefaultPriority,
 		DefaultFilters:   opts.DefaultFilters,
 		Idempotency:      opts.Idempotency,
+		PriorityAging:    opts.PriorityAging,
 	}
 
 	if opts.Version != "" {
@@ -325,6 +327,11 @@ func (w *workflowDeclarationImpl[I, O]) Task(opts create.WorkflowTask[I, O], fn
 			WorkerLabels:           opts.WorkerLabels,
 			Concurrency:            opts.Concurrency,
 			SlotCost:               opts.SlotCost,
+			RetryPolicies:          opts.RetryPolicies,
+			CircuitBreaker:         opts.CircuitBreaker,
+			Preemption:             opts.Preemption,
+			Map:                    opts.Map,
+			Approval:               opts.Approval,
 		},
 	}
 
@@ -517,6 +524,7 @@ func (w *workflowDeclarationImpl[I, O]) DurableTask(opts create.WorkflowTask[I,
 	for k, v := range opts.WorkerLabels {
 		labels[k] = &types.DesiredWorkerLabel{
 			Value:      fmt.Sprintf("%v-durable", v.Value),
+			Values:     v.Values,
 			Required:   v.Required,
 			Weight:     v.Weight,
 			Comparator: v.Comparator,
@@ -539,6 +547,10 @@ func (w *workflowDeclarationImpl[I, O]) DurableTask(opts create.WorkflowTask[I,
 			RateLimits:             opts.RateLimits,
 			WorkerLabels:           labels,
 			Concurrency:            opts.Concurrency,
+			RetryPolicies:          opts.RetryPolicies,
+			CircuitBreaker:         opts.CircuitBreaker,
+			Preemption:             opts.Preemption,
+			Map:                    opts.Map,
 		},
 	}
 
@@ -798,14 +810,35 @@ func (w *workflowDeclarationImpl[I, O]) Dump() (*contracts.CreateWorkflowVersion
 		}
 	}
 
+	if w.PriorityAging != nil {
+		aging := &contracts.PriorityAging{}
+
+		if w.PriorityAging.Interval > 0 {
+			interval := w.PriorityAging.Interval.String()
+			aging.Interval = &interval
+		}
+
+		if w.PriorityAging.MaxWait > 0 {
+			maxWait := w.PriorityAging.MaxWait.String()
+			aging.MaxWait = &maxWait
+		}
+
+		req.PriorityAging = aging
+	}
+
 	// Create named function objects for regular tasks
-	regularNamedFns := make([]NamedFunction, len(w.tasks))
+	regularNamedFns := make([]NamedFunction, 0, len(w.tasks))
 	for i, task := range w.tasks {
+		if task.Approval != nil {
+			// approval tasks are run by the engine, not the worker
+			continue
+		}
+
 		taskName := task.Name
 		originalFn := w.taskFuncs[taskName]
 		isBatch := task.Batch != nil
 
-		regularNamedFns[i] = NamedFunction{
+		regularNamedFns = append(regularNamedFns, NamedFunction{
 			ActionID: taskOpts[i].Action,
 			Fn: func(ctx worker.HatchetContext) (interface{}, error) {
 				var input I
@@ -837,7 +870,7 @@ func (w *workflowDeclarationImpl[I, O]) Dump() (*contracts.CreateWorkflowVersion
 				// Return the output
 				return results[0].Interface(), nil
 			},
-		}
+		})
 	}
 
 	// Create named function objects for durable tasks
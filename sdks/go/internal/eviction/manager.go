@@ -13,6 +13,9 @@ type DurableEvictionConfig struct {
 	CheckInterval              time.Duration
 	ReserveSlots               int
 	MinWaitForCapacityEviction time.Duration
+
+	// Now is the time source for waiting durations and TTLs. Defaults to time.Now.
+	Now func() time.Time
 }
 
 // DefaultDurableEvictionConfig provides sensible defaults.
@@ -89,7 +92,7 @@ func (m *DurableEvictionManager) Stop() {
 
 // RegisterRun adds a run to the eviction cache.
 func (m *DurableEvictionManager) RegisterRun(key, stepRunID string, invocationCount int, policy *EvictionPolicy) {
-	m.cache.RegisterRun(key, stepRunID, invocationCount, time.Now().UTC(), policy)
+	m.cache.RegisterRun(key, stepRunID, invocationCount, m.now(), policy)
 }
 
 // UnregisterRun removes a run from the eviction cache.
@@ -99,12 +102,20 @@ func (m *DurableEvictionManager) UnregisterRun(key string) {
 
 // MarkWaiting marks a run as waiting.
 func (m *DurableEvictionManager) MarkWaiting(key, waitKind, resourceID string) {
-	m.cache.MarkWaiting(key, time.Now().UTC(), waitKind, resourceID)
+	m.cache.MarkWaiting(key, m.now(), waitKind, resourceID)
 }
 
 // MarkActive marks a run as active (no longer waiting).
 func (m *DurableEvictionManager) MarkActive(key string) {
-	m.cache.MarkActive(key, time.Now().UTC())
+	m.cache.MarkActive(key, m.now())
+}
+
+func (m *DurableEvictionManager) now() time.Time {
+	if m.config.Now != nil {
+		return m.config.Now().UTC()
+	}
+
+	return time.Now().UTC()
 }
 
 func (m *DurableEvictionManager) evictRun(key string) {
@@ -142,7 +153,7 @@ func (m *DurableEvictionManager) tick(ctx context.Context) error {
 	evicted := make(map[string]bool)
 
 	for {
-		now := time.Now().UTC()
+		now := m.now()
 		key := m.cache.SelectEvictionCandidate(
 			now,
 			m.durableSlots,
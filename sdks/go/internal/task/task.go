@@ -60,6 +60,23 @@ type TaskShared struct {
 	// Durable tasks ignore it.
 	SlotCost *int32
 
+	// RetryPolicies override Retries and the backoff for failures which match them, evaluated in
+	// order by the engine
+	RetryPolicies []*types.RetryPolicy
+
+	// CircuitBreaker holds the task in the queue after repeated failures
+	CircuitBreaker *types.CircuitBreaker
+
+	// Preemption lets the engine preempt the running task for higher-priority tasks
+	Preemption *types.Preemption
+
+	// Map fans the task out into one run per element of a list
+	Map *types.TaskMap
+
+	// Approval makes the task wait for a person to approve or reject it. Approval tasks are run
+	// by the engine, so they have no function registered on the worker.
+	Approval *types.TaskApproval
+
 	// Batch configures the task as a batch task. When set, the engine buffers concurrent
 	// runs of this task and dispatches them together; retries is always forced to 0.
 	Batch *types.BatchConfig
@@ -223,6 +240,81 @@ func makeContractTaskOpts(t *TaskShared, taskDefaults *create.TaskDefaults) *con
 		taskOpts.BackoffMaxSeconds = t.RetryMaxBackoffSeconds
 	}
 
+	for _, policy := range t.RetryPolicies {
+		taskOpts.RetryPolicies = append(taskOpts.RetryPolicies, &contracts.RetryPolicy{
+			ErrorClass:        policy.ErrorClass,
+			Expression:        policy.Expression,
+			Retries:           policy.Retries,
+			BackoffFactor:     policy.BackoffFactor,
+			BackoffMaxSeconds: policy.BackoffMaxSeconds,
+			NeverRetry:        policy.NeverRetry,
+		})
+	}
+
+	if t.CircuitBreaker != nil {
+		cb := &contracts.CircuitBreaker{
+			FailureThreshold: t.CircuitBreaker.FailureThreshold,
+			KeyExpr:          t.CircuitBreaker.KeyExpr,
+		}
+
+		if t.CircuitBreaker.Window > 0 {
+			window := t.CircuitBreaker.Window.String()
+			cb.Window = &window
+		}
+
+		if t.CircuitBreaker.Cooldown > 0 {
+			cooldown := t.CircuitBreaker.Cooldown.String()
+			cb.Cooldown = &cooldown
+		}
+
+		taskOpts.CircuitBreaker = cb
+	}
+
+	if t.Preemption != nil {
+		preemption := &contracts.Preemption{}
+
+		if t.Preemption.MaxPreemptions > 0 {
+			preemption.MaxPreemptions = &t.Preemption.MaxPreemptions
+		}
+
+		taskOpts.Preemption = preemption
+	}
+
+	if t.Map != nil {
+		m := &contracts.TaskMap{
+			Expression: t.Map.Expression,
+		}
+
+		if t.Map.MaxParallelism > 0 {
+			m.MaxParallelism = &t.Map.MaxParallelism
+		}
+
+		taskOpts.Map = m
+	}
+
+	if t.Approval != nil {
+		a := &contracts.TaskApproval{
+			Approvers:     t.Approval.Approvers,
+			ContextFields: t.Approval.ContextFields,
+		}
+
+		if t.Approval.ExpiresIn > 0 {
+			expiresIn := t.Approval.ExpiresIn.String()
+			a.ExpiresIn = &expiresIn
+		}
+
+		switch t.Approval.OnExpiry {
+		case types.ApprovalExpiryReject:
+			onExpiry := contracts.ApprovalExpiryAction_APPROVAL_EXPIRY_REJECT
+			a.OnExpiry = &onExpiry
+		case types.ApprovalExpiryApprove:
+			onExpiry := contracts.ApprovalExpiryAction_APPROVAL_EXPIRY_APPROVE
+			a.OnExpiry = &onExpiry
+		}
+
+		taskOpts.Approval = a
+	}
+
 	if t.Batch != nil {
 		batchProto := &contracts.TaskBatchConfig{
 			BatchMaxSize: t.Batch.MaxSize,
@@ -290,6 +382,10 @@ func (t *TaskDeclaration[I]) Dump(workflowName string, taskDefaults *create.Task
 	base.ReadableId = t.Name
 	base.Action = getActionID(workflowName, t.Name)
 	base.IsDurable = false
+	if t.Approval != nil {
+		// approval tasks hold a durable slot on the engine's approval operator
+		base.SlotRequests = map[string]int32{slotTypeDurable: 1}
+	}
 	if base.SlotRequests == nil {
 		units := int32(1)
 		if t.SlotCost != nil {
@@ -1,9 +1,12 @@
 package hatchet
 
 import (
+	"time"
+
 	"github.com/rs/zerolog"
 
 	v1 "github.com/hatchet-dev/hatchet/internal/services/shared/proto/v1"
+	"github.com/hatchet-dev/hatchet/pkg/client"
 	"github.com/hatchet-dev/hatchet/sdks/go/internal"
 )
 
@@ -17,8 +20,11 @@ type workerConfig struct {
 	durableSlots    int
 	durableSlotsSet bool
 	labels          map[string]any
+	dynamicLabels   func() map[string]any
+	scalingTargets  *ScalingTargets
 	logger          *zerolog.Logger
 	panicHandler    func(ctx Context, recovered any)
+	now             func() time.Time
 }
 
 type WorkflowBase interface {
@@ -52,6 +58,49 @@ func WithLabels(labels map[string]any) WorkerOption {
 	}
 }
 
+// WithDynamicLabels reports labels which change while the worker runs, like free GPU memory or
+// the models it has loaded. fn is called on every heartbeat, and the labels it returns replace the
+// ones it returned before. Tasks are routed on them like on static labels, e.g. with the BEST_FIT
+// comparator for gauges or EXISTS to prefer workers which already loaded a model.
+func WithDynamicLabels(fn func() map[string]any) WorkerOption {
+	return func(config *workerConfig) {
+		config.dynamicLabels = fn
+	}
+}
+
+// ScalingTargets are the autoscaling targets a worker declares for its pool. They are used by the
+// engine's KEDA external scaler when computing the number of replicas the pool needs.
+type ScalingTargets struct {
+	// SlotsPerReplica is the number of slots a single replica of the pool should serve. Defaults
+	// to the slots of the pool's workers.
+	SlotsPerReplica int
+
+	// MaxQueueAge is the longest a task should wait in the queue before the pool scales up.
+	MaxQueueAge time.Duration
+}
+
+func (t ScalingTargets) toClient() *client.WorkerScalingTargets {
+	targets := &client.WorkerScalingTargets{}
+
+	if t.SlotsPerReplica > 0 {
+		slotsPerReplica := int32(t.SlotsPerReplica) // #nosec G115 -- slots per replica is developer-configured, not attacker-controlled
+		targets.SlotsPerReplica = &slotsPerReplica
+	}
+
+	if t.MaxQueueAge > 0 {
+		targets.MaxQueueAge = &t.MaxQueueAge
+	}
+
+	return targets
+}
+
+// WithScalingTargets declares autoscaling targets for the worker's pool.
+func WithScalingTargets(targets ScalingTargets) WorkerOption {
+	return func(config *workerConfig) {
+		config.scalingTargets = &targets
+	}
+}
+
 // WithLogger sets a custom logger for the worker.
 func WithLogger(logger *zerolog.Logger) WorkerOption {
 	return func(config *workerConfig) {
@@ -75,3 +124,13 @@ func WithPanicHandler(panicHandler func(ctx Context, recovered any)) WorkerOptio
 		config.panicHandler = panicHandler
 	}
 }
+
+// WithClock sets the time source the worker measures durable eviction TTLs with. It is
+// intended for integration tests which fast-forward the engine clock with
+// Client.AdvanceEngineClock: pass a clock which applies the same offset so eviction TTLs
+// expire along with engine-side sleeps. Defaults to time.Now.
+func WithClock(now func() time.Time) WorkerOption {
+	return func(config *workerConfig) {
+		config.now = now
+	}
+}
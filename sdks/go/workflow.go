@@ -190,6 +190,7 @@ type workflowConfig struct {
 	cronInput       *string
 	defaultFilters  []types.DefaultFilter
 	idempotency     *IdempotencyConfig
+	priorityAging   *types.PriorityAging
 }
 
 // WithWorkflowCron configures the workflow to run on a cron schedule.
@@ -260,6 +261,14 @@ func WithWorkflowDefaultPriority(priority RunPriority) WorkflowOption {
 	}
 }
 
+// WithWorkflowPriorityAging raises the priority of the workflow's queued tasks the longer they
+// wait, so that runs at a higher priority can't starve them. See types.PriorityAging.
+func WithWorkflowPriorityAging(aging *types.PriorityAging) WorkflowOption {
+	return func(config *workflowConfig) {
+		config.priorityAging = aging
+	}
+}
+
 // WithWorkflowStickyStrategy sets the sticky strategy for the workflow.
 func WithWorkflowStickyStrategy(stickyStrategy types.StickyStrategy) WorkflowOption {
 	return func(config *workflowConfig) {
@@ -300,6 +309,7 @@ func newWorkflow(name string, v0Client v0Client.Client, options ...WorkflowOptio
 		TaskDefaults:   config.taskDefaults,
 		StickyStrategy: config.stickyStrategy,
 		DefaultFilters: config.defaultFilters,
+		PriorityAging:  config.priorityAging,
 	}
 
 	if config.defaultPriority != nil {
@@ -330,6 +340,10 @@ type taskConfig struct {
 	retries                int32
 	retryBackoffFactor     float32
 	retryMaxBackoffSeconds int32
+	retryPolicies          []*types.RetryPolicy
+	circuitBreaker         *types.CircuitBreaker
+	preemption             *types.Preemption
+	taskMap                *types.TaskMap
 	executionTimeout       time.Duration
 	scheduleTimeout        time.Duration
 	onCron                 []string
@@ -360,6 +374,42 @@ func WithRetryBackoff(factor float32, maxBackoffSeconds int) TaskOption {
 	}
 }
 
+// WithRetryPolicies overrides the retries and backoff for failures which match a policy, based on
+// the error class (see NewClassifiedError) or a CEL expression on the error. The engine applies the
+// first matching policy; other failures use WithRetries and WithRetryBackoff.
+func WithRetryPolicies(policies ...*types.RetryPolicy) TaskOption {
+	return func(config *taskConfig) {
+		config.retryPolicies = policies
+	}
+}
+
+// WithCircuitBreaker holds the task in the queue after repeated failures, instead of assigning it
+// to a worker while a downstream dependency is down. See types.CircuitBreaker.
+func WithCircuitBreaker(cb *types.CircuitBreaker) TaskOption {
+	return func(config *taskConfig) {
+		config.circuitBreaker = cb
+	}
+}
+
+// WithPreemption lets the engine cancel the running task and requeue it when a higher-priority
+// task for the same action has been waiting for a slot. Only enable it for tasks which are safe to
+// restart. See types.Preemption.
+func WithPreemption(preemption *types.Preemption) TaskOption {
+	return func(config *taskConfig) {
+		config.preemption = preemption
+	}
+}
+
+// WithMap fans the task out into one run per element of the list returned by a CEL expression on
+// `input` and `parents`, e.g. `parents.list_files.files`. Each run receives its element as input, and
+// the task's output gathers their outputs under `results`. Only supported in DAG workflows. See
+// types.TaskMap.
+func WithMap(m *types.TaskMap) TaskOption {
+	return func(config *taskConfig) {
+		config.taskMap = m
+	}
+}
+
 // WithSlotCost sets the number of default worker slots this task consumes. A normal task consumes
 // one. Set it higher for a task that needs more memory or CPU, so a worker runs fewer of them at
 // once. A single worker must have that many free slots to run it. Durable tasks ignore it. Panics
@@ -569,6 +619,10 @@ func (w *Workflow) NewTask(name string, fn any, options ...TaskOption) *Task {
 		Retries:                config.retries,
 		RetryBackoffFactor:     config.retryBackoffFactor,
 		RetryMaxBackoffSeconds: config.retryMaxBackoffSeconds,
+		RetryPolicies:          config.retryPolicies,
+		CircuitBreaker:         config.circuitBreaker,
+		Preemption:             config.preemption,
+		Map:                    config.taskMap,
 		ExecutionTimeout:       config.executionTimeout,
 		ScheduleTimeout:        config.scheduleTimeout,
 		Concurrency:            config.concurrency,
@@ -598,6 +652,53 @@ func (w *Workflow) NewTask(name string, fn any, options ...TaskOption) *Task {
 	return &Task{name: name}
 }
 
+// NewApprovalTask adds a task which waits for a person to approve or reject it. The engine runs
+// approval tasks, so they have no function: when the task runs, the engine records a pending
+// approval and notifies the approvers, and the task completes with `{approved, approver, comment}`
+// once someone resolves it with `hatchet approvals approve|reject` or the REST API. Downstream
+// tasks can branch on the output with WithSkipIf or read it from their parent outputs. Approval
+// tasks cannot be durable. See types.TaskApproval.
+func (w *Workflow) NewApprovalTask(name string, approval *types.TaskApproval, options ...TaskOption) *Task {
+	if name == "" {
+		panic("task name cannot be empty")
+	}
+
+	if approval == nil {
+		panic("approval task '" + name + "' has a nil approval")
+	}
+
+	config := &taskConfig{}
+
+	for _, opt := range options {
+		opt(config)
+	}
+
+	if config.isDurable {
+		panic("approval task '" + name + "' cannot be durable")
+	}
+
+	taskOpts := create.WorkflowTask[any, any]{
+		Name:                   name,
+		Retries:                config.retries,
+		RetryBackoffFactor:     config.retryBackoffFactor,
+		RetryMaxBackoffSeconds: config.retryMaxBackoffSeconds,
+		ScheduleTimeout:        config.scheduleTimeout,
+		Concurrency:            config.concurrency,
+		RateLimits:             config.rateLimits,
+		Parents:                config.parents,
+		WaitFor:                config.waitFor,
+		SkipIf:                 config.skipIf,
+		Approval:               approval,
+	}
+
+	// never called, since approval tasks aren't registered on the worker
+	w.declaration.Task(taskOpts, func(ctx Context, input any) (any, error) {
+		return nil, fmt.Errorf("approval task '%s' is run by the engine", name)
+	})
+
+	return &Task{name: name}
+}
+
 // NewBatchTask transforms a function into a Hatchet batch task that runs as part of a
 // workflow. Batch tasks buffer concurrent runs until Hatchet flushes the batch (size
 // reached or flush interval), then invoke the handler once with all buffered inputs keyed
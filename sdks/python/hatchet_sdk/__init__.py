@@ -145,6 +145,7 @@ from hatchet_sdk.context.worker_context import WorkerContext
 from hatchet_sdk.contracts.workflows_pb2 import CreateWorkflowVersionOpts
 from hatchet_sdk.exceptions import (
     BulkTriggerIdempotencyCollisionError,
+    ClassifiedException,
     DedupeViolationError,
     EvictionNotSupportedError,
     FailedTaskRunExceptionGroup,
@@ -181,6 +182,7 @@ from hatchet_sdk.types.labels import (
 )
 from hatchet_sdk.types.priority import Priority
 from hatchet_sdk.types.rate_limit import RateLimit, RateLimitDuration
+from hatchet_sdk.types.retry_policy import RetryPolicy
 from hatchet_sdk.types.slot_types import SlotType
 from hatchet_sdk.types.sticky import StickyStrategy
 from hatchet_sdk.types.trigger import (
@@ -212,6 +214,7 @@ __all__ = [
     "CELEvaluationResult",
     "CELFailure",
     "CELSuccess",
+    "ClassifiedException",
     "ClientConfig",
     "ClientTLSConfig",
     "ConcurrencyExpression",
@@ -275,6 +278,7 @@ __all__ = [
     "RejectInviteRequest",
     "ReplayEventRequest",
     "RerunStepRunRequest",
+    "RetryPolicy",
     "RunEventListener",
     "RunFilter",
     "RunStatus",
@@ -216,6 +216,7 @@ class AdminClient:
                     required=d.required,
                     weight=d.weight,
                     comparator=d.comparator,  # type: ignore[arg-type]
+                    str_values=d.values,
                 )
                 for key, d in labels_dict.items()
             }
@@ -114,7 +114,7 @@ class RuntimeInfo(_message.Message):
     def __init__(self, sdk_version: _Optional[str] = ..., language: _Optional[_Union[SDKS, str]] = ..., language_version: _Optional[str] = ..., os: _Optional[str] = ..., extra: _Optional[str] = ...) -> None: ...
 
 class WorkerRegisterRequest(_message.Message):
-    __slots__ = ("worker_name", "actions", "services", "slots", "labels", "webhook_id", "runtime_info", "slot_config")
+    __slots__ = ("worker_name", "actions", "services", "slots", "labels", "webhook_id", "runtime_info", "slot_config", "scaling_targets")
     class LabelsEntry(_message.Message):
         __slots__ = ("key", "value")
         KEY_FIELD_NUMBER: _ClassVar[int]
@@ -137,6 +137,7 @@ class WorkerRegisterRequest(_message.Message):
     WEBHOOK_ID_FIELD_NUMBER: _ClassVar[int]
     RUNTIME_INFO_FIELD_NUMBER: _ClassVar[int]
     SLOT_CONFIG_FIELD_NUMBER: _ClassVar[int]
+    SCALING_TARGETS_FIELD_NUMBER: _ClassVar[int]
     worker_name: str
     actions: _containers.RepeatedScalarFieldContainer[str]
     services: _containers.RepeatedScalarFieldContainer[str]
@@ -145,7 +146,16 @@ class WorkerRegisterRequest(_message.Message):
     webhook_id: str
     runtime_info: RuntimeInfo
     slot_config: _containers.ScalarMap[str, int]
-    def __init__(self, worker_name: _Optional[str] = ..., actions: _Optional[_Iterable[str]] = ..., services: _Optional[_Iterable[str]] = ..., slots: _Optional[int] = ..., labels: _Optional[_Mapping[str, WorkerLabels]] = ..., webhook_id: _Optional[str] = ..., runtime_info: _Optional[_Union[RuntimeInfo, _Mapping]] = ..., slot_config: _Optional[_Mapping[str, int]] = ...) -> None: ...
+    scaling_targets: WorkerScalingTargets
+    def __init__(self, worker_name: _Optional[str] = ..., actions: _Optional[_Iterable[str]] = ..., services: _Optional[_Iterable[str]] = ..., slots: _Optional[int] = ..., labels: _Optional[_Mapping[str, WorkerLabels]] = ..., webhook_id: _Optional[str] = ..., runtime_info: _Optional[_Union[RuntimeInfo, _Mapping]] = ..., slot_config: _Optional[_Mapping[str, int]] = ..., scaling_targets: _Optional[_Union[WorkerScalingTargets, _Mapping]] = ...) -> None: ...
+
+class WorkerScalingTargets(_message.Message):
+    __slots__ = ("slots_per_replica", "max_queue_age_seconds")
+    SLOTS_PER_REPLICA_FIELD_NUMBER: _ClassVar[int]
+    MAX_QUEUE_AGE_SECONDS_FIELD_NUMBER: _ClassVar[int]
+    slots_per_replica: int
+    max_queue_age_seconds: int
+    def __init__(self, slots_per_replica: _Optional[int] = ..., max_queue_age_seconds: _Optional[int] = ...) -> None: ...
 
 class WorkerRegisterResponse(_message.Message):
     __slots__ = ("tenant_id", "worker_id", "worker_name")
@@ -289,7 +299,7 @@ class GroupKeyActionEvent(_message.Message):
     def __init__(self, worker_id: _Optional[str] = ..., workflow_run_id: _Optional[str] = ..., get_group_key_run_id: _Optional[str] = ..., action_id: _Optional[str] = ..., event_timestamp: _Optional[_Union[datetime.datetime, _timestamp_pb2.Timestamp, _Mapping]] = ..., event_type: _Optional[_Union[GroupKeyActionEventType, str]] = ..., event_payload: _Optional[str] = ...) -> None: ...
 
 class StepActionEvent(_message.Message):
-    __slots__ = ("worker_id", "job_id", "job_run_id", "task_id", "task_run_external_id", "action_id", "event_timestamp", "event_type", "event_payload", "retry_count", "should_not_retry")
+    __slots__ = ("worker_id", "job_id", "job_run_id", "task_id", "task_run_external_id", "action_id", "event_timestamp", "event_type", "event_payload", "retry_count", "should_not_retry", "error_class")
     WORKER_ID_FIELD_NUMBER: _ClassVar[int]
     JOB_ID_FIELD_NUMBER: _ClassVar[int]
     JOB_RUN_ID_FIELD_NUMBER: _ClassVar[int]
@@ -301,6 +311,7 @@ class StepActionEvent(_message.Message):
     EVENT_PAYLOAD_FIELD_NUMBER: _ClassVar[int]
     RETRY_COUNT_FIELD_NUMBER: _ClassVar[int]
     SHOULD_NOT_RETRY_FIELD_NUMBER: _ClassVar[int]
+    ERROR_CLASS_FIELD_NUMBER: _ClassVar[int]
     worker_id: str
     job_id: str
     job_run_id: str
@@ -312,19 +323,22 @@ class StepActionEvent(_message.Message):
     event_payload: str
     retry_count: int
     should_not_retry: bool
-    def __init__(self, worker_id: _Optional[str] = ..., job_id: _Optional[str] = ..., job_run_id: _Optional[str] = ..., task_id: _Optional[str] = ..., task_run_external_id: _Optional[str] = ..., action_id: _Optional[str] = ..., event_timestamp: _Optional[_Union[datetime.datetime, _timestamp_pb2.Timestamp, _Mapping]] = ..., event_type: _Optional[_Union[StepActionEventType, str]] = ..., event_payload: _Optional[str] = ..., retry_count: _Optional[int] = ..., should_not_retry: bool = ...) -> None: ...
+    error_class: str
+    def __init__(self, worker_id: _Optional[str] = ..., job_id: _Optional[str] = ..., job_run_id: _Optional[str] = ..., task_id: _Optional[str] = ..., task_run_external_id: _Optional[str] = ..., action_id: _Optional[str] = ..., event_timestamp: _Optional[_Union[datetime.datetime, _timestamp_pb2.Timestamp, _Mapping]] = ..., event_type: _Optional[_Union[StepActionEventType, str]] = ..., event_payload: _Optional[str] = ..., retry_count: _Optional[int] = ..., should_not_retry: bool = ..., error_class: _Optional[str] = ...) -> None: ...
 
 class BatchActionEventItem(_message.Message):
-    __slots__ = ("task_run_external_id", "event_payload", "retry_count", "should_not_retry")
+    __slots__ = ("task_run_external_id", "event_payload", "retry_count", "should_not_retry", "error_class")
     TASK_RUN_EXTERNAL_ID_FIELD_NUMBER: _ClassVar[int]
     EVENT_PAYLOAD_FIELD_NUMBER: _ClassVar[int]
     RETRY_COUNT_FIELD_NUMBER: _ClassVar[int]
     SHOULD_NOT_RETRY_FIELD_NUMBER: _ClassVar[int]
+    ERROR_CLASS_FIELD_NUMBER: _ClassVar[int]
     task_run_external_id: str
     event_payload: str
     retry_count: int
     should_not_retry: bool
-    def __init__(self, task_run_external_id: _Optional[str] = ..., event_payload: _Optional[str] = ..., retry_count: _Optional[int] = ..., should_not_retry: bool = ...) -> None: ...
+    error_class: str
+    def __init__(self, task_run_external_id: _Optional[str] = ..., event_payload: _Optional[str] = ..., retry_count: _Optional[int] = ..., should_not_retry: bool = ..., error_class: _Optional[str] = ...) -> None: ...
 
 class BatchActionEvent(_message.Message):
     __slots__ = ("worker_id", "job_id", "action_id", "batch_id", "event_timestamp", "event_type", "items")
@@ -435,12 +449,27 @@ class OverridesDataResponse(_message.Message):
     def __init__(self) -> None: ...
 
 class HeartbeatRequest(_message.Message):
-    __slots__ = ("worker_id", "heartbeat_at")
+    __slots__ = ("worker_id", "heartbeat_at", "dynamic_labels")
     WORKER_ID_FIELD_NUMBER: _ClassVar[int]
     HEARTBEAT_AT_FIELD_NUMBER: _ClassVar[int]
+    DYNAMIC_LABELS_FIELD_NUMBER: _ClassVar[int]
     worker_id: str
     heartbeat_at: _timestamp_pb2.Timestamp
-    def __init__(self, worker_id: _Optional[str] = ..., heartbeat_at: _Optional[_Union[datetime.datetime, _timestamp_pb2.Timestamp, _Mapping]] = ...) -> None: ...
+    dynamic_labels: DynamicWorkerLabels
+    def __init__(self, worker_id: _Optional[str] = ..., heartbeat_at: _Optional[_Union[datetime.datetime, _timestamp_pb2.Timestamp, _Mapping]] = ..., dynamic_labels: _Optional[_Union[DynamicWorkerLabels, _Mapping]] = ...) -> None: ...
+
+class DynamicWorkerLabels(_message.Message):
+    __slots__ = ("labels",)
+    class LabelsEntry(_message.Message):
+        __slots__ = ("key", "value")
+        KEY_FIELD_NUMBER: _ClassVar[int]
+        VALUE_FIELD_NUMBER: _ClassVar[int]
+        key: str
+        value: WorkerLabels
+        def __init__(self, key: _Optional[str] = ..., value: _Optional[_Union[WorkerLabels, _Mapping]] = ...) -> None: ...
+    LABELS_FIELD_NUMBER: _ClassVar[int]
+    labels: _containers.MessageMap[str, WorkerLabels]
+    def __init__(self, labels: _Optional[_Mapping[str, WorkerLabels]] = ...) -> None: ...
 
 class HeartbeatResponse(_message.Message):
     __slots__ = ()
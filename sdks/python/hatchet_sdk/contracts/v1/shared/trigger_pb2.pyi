@@ -2,7 +2,7 @@ from google.protobuf.internal import containers as _containers
 from google.protobuf.internal import enum_type_wrapper as _enum_type_wrapper
 from google.protobuf import descriptor as _descriptor
 from google.protobuf import message as _message
-from collections.abc import Mapping as _Mapping
+from collections.abc import Iterable as _Iterable, Mapping as _Mapping
 from typing import ClassVar as _ClassVar, Optional as _Optional, Union as _Union
 
 DESCRIPTOR: _descriptor.FileDescriptor
@@ -15,26 +15,42 @@ class WorkerLabelComparator(int, metaclass=_enum_type_wrapper.EnumTypeWrapper):
     GREATER_THAN_OR_EQUAL: _ClassVar[WorkerLabelComparator]
     LESS_THAN: _ClassVar[WorkerLabelComparator]
     LESS_THAN_OR_EQUAL: _ClassVar[WorkerLabelComparator]
+    IN: _ClassVar[WorkerLabelComparator]
+    NOT_IN: _ClassVar[WorkerLabelComparator]
+    EXISTS: _ClassVar[WorkerLabelComparator]
+    NOT_EXISTS: _ClassVar[WorkerLabelComparator]
+    SEMVER: _ClassVar[WorkerLabelComparator]
+    NOT_COLOCATED: _ClassVar[WorkerLabelComparator]
+    BEST_FIT: _ClassVar[WorkerLabelComparator]
 EQUAL: WorkerLabelComparator
 NOT_EQUAL: WorkerLabelComparator
 GREATER_THAN: WorkerLabelComparator
 GREATER_THAN_OR_EQUAL: WorkerLabelComparator
 LESS_THAN: WorkerLabelComparator
 LESS_THAN_OR_EQUAL: WorkerLabelComparator
+IN: WorkerLabelComparator
+NOT_IN: WorkerLabelComparator
+EXISTS: WorkerLabelComparator
+NOT_EXISTS: WorkerLabelComparator
+SEMVER: WorkerLabelComparator
+NOT_COLOCATED: WorkerLabelComparator
+BEST_FIT: WorkerLabelComparator
 
 class DesiredWorkerLabels(_message.Message):
-    __slots__ = ("str_value", "int_value", "required", "comparator", "weight")
+    __slots__ = ("str_value", "int_value", "required", "comparator", "weight", "str_values")
     STR_VALUE_FIELD_NUMBER: _ClassVar[int]
     INT_VALUE_FIELD_NUMBER: _ClassVar[int]
     REQUIRED_FIELD_NUMBER: _ClassVar[int]
     COMPARATOR_FIELD_NUMBER: _ClassVar[int]
     WEIGHT_FIELD_NUMBER: _ClassVar[int]
+    STR_VALUES_FIELD_NUMBER: _ClassVar[int]
     str_value: str
     int_value: int
     required: bool
     comparator: WorkerLabelComparator
     weight: int
-    def __init__(self, str_value: _Optional[str] = ..., int_value: _Optional[int] = ..., required: bool = ..., comparator: _Optional[_Union[WorkerLabelComparator, str]] = ..., weight: _Optional[int] = ...) -> None: ...
+    str_values: _containers.RepeatedScalarFieldContainer[str]
+    def __init__(self, str_value: _Optional[str] = ..., int_value: _Optional[int] = ..., required: bool = ..., comparator: _Optional[_Union[WorkerLabelComparator, str]] = ..., weight: _Optional[int] = ..., str_values: _Optional[_Iterable[str]] = ...) -> None: ...
 
 class TriggerWorkflowRequest(_message.Message):
     __slots__ = ("name", "input", "parent_id", "parent_task_run_external_id", "child_index", "child_key", "additional_metadata", "desired_worker_id", "priority", "desired_worker_labels")
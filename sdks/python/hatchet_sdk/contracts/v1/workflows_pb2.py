@@ -27,7 +27,7 @@ from hatchet_sdk.contracts.v1.shared import condition_pb2 as v1_dot_shared_dot_c
 from hatchet_sdk.contracts.v1.shared import trigger_pb2 as v1_dot_shared_dot_trigger__pb2
 
 
-DESCRIPTOR = _descriptor_pool.Default().AddSerializedFile(b'\n\x12v1/workflows.proto\x12\x02v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x19v1/shared/condition.proto\x1a\x17v1/shared/trigger.proto\"[\n\x12\x43\x61ncelTasksRequest\x12\x14\n\x0c\x65xternal_ids\x18\x01 \x03(\t\x12$\n\x06\x66ilter\x18\x02 \x01(\x0b\x32\x0f.v1.TasksFilterH\x00\x88\x01\x01\x42\t\n\x07_filter\"[\n\x12ReplayTasksRequest\x12\x14\n\x0c\x65xternal_ids\x18\x01 \x03(\t\x12$\n\x06\x66ilter\x18\x02 \x01(\x0b\x32\x0f.v1.TasksFilterH\x00\x88\x01\x01\x42\t\n\x07_filter\"\xb7\x01\n\x0bTasksFilter\x12\x10\n\x08statuses\x18\x01 \x03(\t\x12)\n\x05since\x18\x02 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12.\n\x05until\x18\x03 \x01(\x0b\x32\x1a.google.protobuf.TimestampH\x00\x88\x01\x01\x12\x14\n\x0cworkflow_ids\x18\x04 \x03(\t\x12\x1b\n\x13\x61\x64\x64itional_metadata\x18\x05 \x03(\tB\x08\n\x06_until\".\n\x13\x43\x61ncelTasksResponse\x12\x17\n\x0f\x63\x61ncelled_tasks\x18\x01 \x03(\t\"-\n\x13ReplayTasksResponse\x12\x16\n\x0ereplayed_tasks\x18\x01 \x03(\t\"\xae\x02\n\x19TriggerWorkflowRunRequest\x12\x15\n\rworkflow_name\x18\x01 \x01(\t\x12\r\n\x05input\x18\x02 \x01(\x0c\x12\x1b\n\x13\x61\x64\x64itional_metadata\x18\x03 \x01(\x0c\x12\x15\n\x08priority\x18\x04 \x01(\x05H\x00\x88\x01\x01\x12U\n\x15\x64\x65sired_worker_labels\x18\x05 \x03(\x0b\x32\x36.v1.TriggerWorkflowRunRequest.DesiredWorkerLabelsEntry\x1aS\n\x18\x44\x65siredWorkerLabelsEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12&\n\x05value\x18\x02 \x01(\x0b\x32\x17.v1.DesiredWorkerLabels:\x02\x38\x01\x42\x0b\n\t_priority\"1\n\x1aTriggerWorkflowRunResponse\x12\x13\n\x0b\x65xternal_id\x18\x01 \x01(\t\"X\n\x18\x42ranchDurableTaskRequest\x12\x18\n\x10task_external_id\x18\x01 \x01(\t\x12\x0f\n\x07node_id\x18\x02 \x01(\x03\x12\x11\n\tbranch_id\x18\x03 \x01(\x03\"Y\n\x19\x42ranchDurableTaskResponse\x12\x18\n\x10task_external_id\x18\x01 \x01(\t\x12\x0f\n\x07node_id\x18\x02 \x01(\x03\x12\x11\n\tbranch_id\x18\x03 \x01(\x03\"\xed\x04\n\x1c\x43reateWorkflowVersionRequest\x12\x0c\n\x04name\x18\x01 \x01(\t\x12\x13\n\x0b\x64\x65scription\x18\x02 \x01(\t\x12\x0f\n\x07version\x18\x03 \x01(\t\x12\x16\n\x0e\x65vent_triggers\x18\x04 \x03(\t\x12\x15\n\rcron_triggers\x18\x05 \x03(\t\x12!\n\x05tasks\x18\x06 \x03(\x0b\x32\x12.v1.CreateTaskOpts\x12$\n\x0b\x63oncurrency\x18\x07 \x01(\x0b\x32\x0f.v1.Concurrency\x12\x17\n\ncron_input\x18\x08 \x01(\tH\x00\x88\x01\x01\x12\x30\n\x0fon_failure_task\x18\t \x01(\x0b\x32\x12.v1.CreateTaskOptsH\x01\x88\x01\x01\x12\'\n\x06sticky\x18\n \x01(\x0e\x32\x12.v1.StickyStrategyH\x02\x88\x01\x01\x12\x1d\n\x10\x64\x65\x66\x61ult_priority\x18\x0b \x01(\x05H\x03\x88\x01\x01\x12(\n\x0f\x63oncurrency_arr\x18\x0c \x03(\x0b\x32\x0f.v1.Concurrency\x12*\n\x0f\x64\x65\x66\x61ult_filters\x18\r \x03(\x0b\x32\x11.v1.DefaultFilter\x12\x1e\n\x11input_json_schema\x18\x0e \x01(\x0cH\x04\x88\x01\x01\x12/\n\x0bidempotency\x18\x0f \x01(\x0b\x32\x15.v1.IdempotencyConfigH\x05\x88\x01\x01\x42\r\n\x0b_cron_inputB\x12\n\x10_on_failure_taskB\t\n\x07_stickyB\x13\n\x11_default_priorityB\x14\n\x12_input_json_schemaB\x0e\n\x0c_idempotency\"n\n\x11IdempotencyConfig\x12\x12\n\nexpression\x18\x01 \x01(\t\x12\x0e\n\x06ttl_ms\x18\x02 \x01(\x03\x12*\n\x06method\x18\x03 \x01(\x0e\x32\x15.v1.IdempotencyMethodH\x00\x88\x01\x01\x42\t\n\x07_method\"`\n\x19IdempotencyCollisionError\x12 \n\x18\x65xisting_run_external_id\x18\x01 \x01(\t\x12!\n\x19\x63olliding_run_external_id\x18\x02 \x01(\t\"\x87\x01\n$BulkTriggerIdempotencyCollisionError\x12,\n$successful_workflow_run_external_ids\x18\x01 \x03(\t\x12\x31\n\ncollisions\x18\x02 \x03(\x0b\x32\x1d.v1.IdempotencyCollisionError\"T\n\rDefaultFilter\x12\x12\n\nexpression\x18\x01 \x01(\t\x12\r\n\x05scope\x18\x02 \x01(\t\x12\x14\n\x07payload\x18\x03 \x01(\x0cH\x00\x88\x01\x01\x42\n\n\x08_payload\"\x93\x01\n\x0b\x43oncurrency\x12\x12\n\nexpression\x18\x01 \x01(\t\x12\x15\n\x08max_runs\x18\x02 \x01(\x05H\x00\x88\x01\x01\x12\x39\n\x0elimit_strategy\x18\x03 \x01(\x0e\x32\x1c.v1.ConcurrencyLimitStrategyH\x01\x88\x01\x01\x42\x0b\n\t_max_runsB\x11\n\x0f_limit_strategy\"\x89\x02\n\x0fTaskBatchConfig\x12\x16\n\x0e\x62\x61tch_max_size\x18\x01 \x01(\x05\x12\"\n\x15\x62\x61tch_max_interval_ms\x18\x02 \x01(\x05H\x00\x88\x01\x01\x12\x1c\n\x0f\x62\x61tch_group_key\x18\x03 \x01(\tH\x01\x88\x01\x01\x12!\n\x14\x62\x61tch_group_max_runs\x18\x04 \x01(\x05H\x02\x88\x01\x01\x12\x1d\n\x10\x62roadcast_output\x18\x05 \x01(\x08H\x03\x88\x01\x01\x42\x18\n\x16_batch_max_interval_msB\x12\n\x10_batch_group_keyB\x17\n\x15_batch_group_max_runsB\x13\n\x11_broadcast_output\"\xea\x05\n\x0e\x43reateTaskOpts\x12\x13\n\x0breadable_id\x18\x01 \x01(\t\x12\x0e\n\x06\x61\x63tion\x18\x02 \x01(\t\x12\x0f\n\x07timeout\x18\x03 \x01(\t\x12\x0e\n\x06inputs\x18\x04 \x01(\t\x12\x0f\n\x07parents\x18\x05 \x03(\t\x12\x0f\n\x07retries\x18\x06 \x01(\x05\x12,\n\x0brate_limits\x18\x07 \x03(\x0b\x32\x17.v1.CreateTaskRateLimit\x12;\n\rworker_labels\x18\x08 \x03(\x0b\x32$.v1.CreateTaskOpts.WorkerLabelsEntry\x12\x1b\n\x0e\x62\x61\x63koff_factor\x18\t \x01(\x02H\x00\x88\x01\x01\x12 \n\x13\x62\x61\x63koff_max_seconds\x18\n \x01(\x05H\x01\x88\x01\x01\x12$\n\x0b\x63oncurrency\x18\x0b \x03(\x0b\x32\x0f.v1.Concurrency\x12+\n\nconditions\x18\x0c \x01(\x0b\x32\x12.v1.TaskConditionsH\x02\x88\x01\x01\x12\x1d\n\x10schedule_timeout\x18\r \x01(\tH\x03\x88\x01\x01\x12\x12\n\nis_durable\x18\x0e \x01(\x08\x12;\n\rslot_requests\x18\x0f \x03(\x0b\x32$.v1.CreateTaskOpts.SlotRequestsEntry\x12\'\n\x05\x62\x61tch\x18\x10 \x01(\x0b\x32\x13.v1.TaskBatchConfigH\x04\x88\x01\x01\x1aL\n\x11WorkerLabelsEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12&\n\x05value\x18\x02 \x01(\x0b\x32\x17.v1.DesiredWorkerLabels:\x02\x38\x01\x1a\x33\n\x11SlotRequestsEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\x05:\x02\x38\x01\x42\x11\n\x0f_backoff_factorB\x16\n\x14_backoff_max_secondsB\r\n\x0b_conditionsB\x13\n\x11_schedule_timeoutB\x08\n\x06_batch\"\xfd\x01\n\x13\x43reateTaskRateLimit\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\x12\n\x05units\x18\x02 \x01(\x05H\x00\x88\x01\x01\x12\x15\n\x08key_expr\x18\x03 \x01(\tH\x01\x88\x01\x01\x12\x17\n\nunits_expr\x18\x04 \x01(\tH\x02\x88\x01\x01\x12\x1e\n\x11limit_values_expr\x18\x05 \x01(\tH\x03\x88\x01\x01\x12,\n\x08\x64uration\x18\x06 \x01(\x0e\x32\x15.v1.RateLimitDurationH\x04\x88\x01\x01\x42\x08\n\x06_unitsB\x0b\n\t_key_exprB\r\n\x0b_units_exprB\x14\n\x12_limit_values_exprB\x0b\n\t_duration\"@\n\x1d\x43reateWorkflowVersionResponse\x12\n\n\x02id\x18\x01 \x01(\t\x12\x13\n\x0bworkflow_id\x18\x02 \x01(\t\"+\n\x14GetRunDetailsRequest\x12\x13\n\x0b\x65xternal_id\x18\x01 \x01(\t\"\xaa\x01\n\rTaskRunDetail\x12\x13\n\x0b\x65xternal_id\x18\x01 \x01(\t\x12\x1d\n\x06status\x18\x02 \x01(\x0e\x32\r.v1.RunStatus\x12\x12\n\x05\x65rror\x18\x03 \x01(\tH\x00\x88\x01\x01\x12\x13\n\x06output\x18\x04 \x01(\x0cH\x01\x88\x01\x01\x12\x13\n\x0breadable_id\x18\x05 \x01(\t\x12\x12\n\nis_evicted\x18\x06 \x01(\x08\x42\x08\n\x06_errorB\t\n\x07_output\"\x84\x02\n\x15GetRunDetailsResponse\x12\r\n\x05input\x18\x01 \x01(\x0c\x12\x1d\n\x06status\x18\x02 \x01(\x0e\x32\r.v1.RunStatus\x12:\n\ttask_runs\x18\x03 \x03(\x0b\x32\'.v1.GetRunDetailsResponse.TaskRunsEntry\x12\x0c\n\x04\x64one\x18\x04 \x01(\x08\x12\x1b\n\x13\x61\x64\x64itional_metadata\x18\x05 \x01(\x0c\x12\x12\n\nis_evicted\x18\x06 \x01(\x08\x1a\x42\n\rTaskRunsEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12 \n\x05value\x18\x02 \x01(\x0b\x32\x11.v1.TaskRunDetail:\x02\x38\x01*$\n\x0eStickyStrategy\x12\x08\n\x04SOFT\x10\x00\x12\x08\n\x04HARD\x10\x01*]\n\x11RateLimitDuration\x12\n\n\x06SECOND\x10\x00\x12\n\n\x06MINUTE\x10\x01\x12\x08\n\x04HOUR\x10\x02\x12\x07\n\x03\x44\x41Y\x10\x03\x12\x08\n\x04WEEK\x10\x04\x12\t\n\x05MONTH\x10\x05\x12\x08\n\x04YEAR\x10\x06*[\n\tRunStatus\x12\n\n\x06QUEUED\x10\x00\x12\x0b\n\x07RUNNING\x10\x01\x12\r\n\tCOMPLETED\x10\x02\x12\n\n\x06\x46\x41ILED\x10\x03\x12\r\n\tCANCELLED\x10\x04\x12\x0b\n\x07\x45VICTED\x10\x05*(\n\x11IdempotencyMethod\x12\x07\n\x03TTL\x10\x00\x12\n\n\x06STATUS\x10\x01*\x7f\n\x18\x43oncurrencyLimitStrategy\x12\x16\n\x12\x43\x41NCEL_IN_PROGRESS\x10\x00\x12\x0f\n\x0b\x44ROP_NEWEST\x10\x01\x12\x10\n\x0cQUEUE_NEWEST\x10\x02\x12\x15\n\x11GROUP_ROUND_ROBIN\x10\x03\x12\x11\n\rCANCEL_NEWEST\x10\x04\x32\xcf\x03\n\x0c\x41\x64minService\x12R\n\x0bPutWorkflow\x12 .v1.CreateWorkflowVersionRequest\x1a!.v1.CreateWorkflowVersionResponse\x12>\n\x0b\x43\x61ncelTasks\x12\x16.v1.CancelTasksRequest\x1a\x17.v1.CancelTasksResponse\x12>\n\x0bReplayTasks\x12\x16.v1.ReplayTasksRequest\x1a\x17.v1.ReplayTasksResponse\x12S\n\x12TriggerWorkflowRun\x12\x1d.v1.TriggerWorkflowRunRequest\x1a\x1e.v1.TriggerWorkflowRunResponse\x12\x44\n\rGetRunDetails\x12\x18.v1.GetRunDetailsRequest\x1a\x19.v1.GetRunDetailsResponse\x12P\n\x11\x42ranchDurableTask\x12\x1c.v1.BranchDurableTaskRequest\x1a\x1d.v1.BranchDurableTaskResponseBBZ@github.com/hatchet-dev/hatchet/internal/services/shared/proto/v1b\x06proto3')
+DESCRIPTOR = _descriptor_pool.Default().AddSerializedFile(b'\n\x12v1/workflows.proto\x12\x02v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x19v1/shared/condition.proto\x1a\x17v1/shared/trigger.proto\"[\n\x12\x43\x61ncelTasksRequest\x12\x14\n\x0c\x65xternal_ids\x18\x01 \x03(\t\x12$\n\x06\x66ilter\x18\x02 \x01(\x0b\x32\x0f.v1.TasksFilterH\x00\x88\x01\x01\x42\t\n\x07_filter\"[\n\x12ReplayTasksRequest\x12\x14\n\x0c\x65xternal_ids\x18\x01 \x03(\t\x12$\n\x06\x66ilter\x18\x02 \x01(\x0b\x32\x0f.v1.TasksFilterH\x00\x88\x01\x01\x42\t\n\x07_filter\"\xb7\x01\n\x0bTasksFilter\x12\x10\n\x08statuses\x18\x01 \x03(\t\x12)\n\x05since\x18\x02 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12.\n\x05until\x18\x03 \x01(\x0b\x32\x1a.google.protobuf.TimestampH\x00\x88\x01\x01\x12\x14\n\x0cworkflow_ids\x18\x04 \x03(\t\x12\x1b\n\x13\x61\x64\x64itional_metadata\x18\x05 \x03(\tB\x08\n\x06_until\".\n\x13\x43\x61ncelTasksResponse\x12\x17\n\x0f\x63\x61ncelled_tasks\x18\x01 \x03(\t\"-\n\x13ReplayTasksResponse\x12\x16\n\x0ereplayed_tasks\x18\x01 \x03(\t\"\xe2\x03\n\x19TriggerWorkflowRunRequest\x12\x15\n\rworkflow_name\x18\x01 \x01(\t\x12\r\n\x05input\x18\x02 \x01(\x0c\x12\x1b\n\x13\x61\x64\x64itional_metadata\x18\x03 \x01(\x0c\x12\x15\n\x08priority\x18\x04 \x01(\x05H\x00\x88\x01\x01\x12U\n\x15\x64\x65sired_worker_labels\x18\x05 \x03(\x0b\x32\x36.v1.TriggerWorkflowRunRequest.DesiredWorkerLabelsEntry\x12\x1d\n\x10target_action_id\x18\x06 \x01(\tH\x01\x88\x01\x01\x12H\n\x0eparent_outputs\x18\x07 \x03(\x0b\x32\x30.v1.TriggerWorkflowRunRequest.ParentOutputsEntry\x1aS\n\x18\x44\x65siredWorkerLabelsEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12&\n\x05value\x18\x02 \x01(\x0b\x32\x17.v1.DesiredWorkerLabels:\x02\x38\x01\x1a\x34\n\x12ParentOutputsEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\x0c:\x02\x38\x01\x42\x0b\n\t_priorityB\x13\n\x11_target_action_id\"1\n\x1aTriggerWorkflowRunResponse\x12\x13\n\x0b\x65xternal_id\x18\x01 \x01(\t\"X\n\x18\x42ranchDurableTaskRequest\x12\x18\n\x10task_external_id\x18\x01 \x01(\t\x12\x0f\n\x07node_id\x18\x02 \x01(\x03\x12\x11\n\tbranch_id\x18\x03 \x01(\x03\"Y\n\x19\x42ranchDurableTaskResponse\x12\x18\n\x10task_external_id\x18\x01 \x01(\t\x12\x0f\n\x07node_id\x18\x02 \x01(\x03\x12\x11\n\tbranch_id\x18\x03 \x01(\x03\")\n\x13\x41\x64vanceClockRequest\x12\x12\n\nadvance_by\x18\x01 \x01(\t\"R\n\x14\x41\x64vanceClockResponse\x12\'\n\x03now\x18\x01 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12\x11\n\toffset_ms\x18\x02 \x01(\x03\"p\n\x10SignalRunRequest\x12\x17\n\x0frun_external_id\x18\x01 \x01(\t\x12\x0c\n\x04name\x18\x02 \x01(\t\x12\x0f\n\x07payload\x18\x03 \x01(\x0c\x12\x16\n\tsignal_id\x18\x04 \x01(\tH\x00\x88\x01\x01\x42\x0c\n\n_signal_id\"\x8c\x01\n\x11SignalRunResponse\x12\x11\n\tsignal_id\x18\x01 \x01(\t\x12\x1a\n\x12waiting_conditions\x18\x02 \x01(\x05\x12\x1a\n\x12matched_conditions\x18\x03 \x01(\x05\x12\x15\n\rcreated_tasks\x18\x04 \x03(\t\x12\x15\n\rresumed_tasks\x18\x05 \x03(\t\"\xb0\x05\n\x1c\x43reateWorkflowVersionRequest\x12\x0c\n\x04name\x18\x01 \x01(\t\x12\x13\n\x0b\x64\x65scription\x18\x02 \x01(\t\x12\x0f\n\x07version\x18\x03 \x01(\t\x12\x16\n\x0e\x65vent_triggers\x18\x04 \x03(\t\x12\x15\n\rcron_triggers\x18\x05 \x03(\t\x12!\n\x05tasks\x18\x06 \x03(\x0b\x32\x12.v1.CreateTaskOpts\x12$\n\x0b\x63oncurrency\x18\x07 \x01(\x0b\x32\x0f.v1.Concurrency\x12\x17\n\ncron_input\x18\x08 \x01(\tH\x00\x88\x01\x01\x12\x30\n\x0fon_failure_task\x18\t \x01(\x0b\x32\x12.v1.CreateTaskOptsH\x01\x88\x01\x01\x12\'\n\x06sticky\x18\n \x01(\x0e\x32\x12.v1.StickyStrategyH\x02\x88\x01\x01\x12\x1d\n\x10\x64\x65\x66\x61ult_priority\x18\x0b \x01(\x05H\x03\x88\x01\x01\x12(\n\x0f\x63oncurrency_arr\x18\x0c \x03(\x0b\x32\x0f.v1.Concurrency\x12*\n\x0f\x64\x65\x66\x61ult_filters\x18\r \x03(\x0b\x32\x11.v1.DefaultFilter\x12\x1e\n\x11input_json_schema\x18\x0e \x01(\x0cH\x04\x88\x01\x01\x12/\n\x0bidempotency\x18\x0f \x01(\x0b\x32\x15.v1.IdempotencyConfigH\x05\x88\x01\x01\x12.\n\x0epriority_aging\x18\x10 \x01(\x0b\x32\x11.v1.PriorityAgingH\x06\x88\x01\x01\x42\r\n\x0b_cron_inputB\x12\n\x10_on_failure_taskB\t\n\x07_stickyB\x13\n\x11_default_priorityB\x14\n\x12_input_json_schemaB\x0e\n\x0c_idempotencyB\x11\n\x0f_priority_aging\"W\n\rPriorityAging\x12\x15\n\x08interval\x18\x01 \x01(\tH\x00\x88\x01\x01\x12\x15\n\x08max_wait\x18\x02 \x01(\tH\x01\x88\x01\x01\x42\x0b\n\t_intervalB\x0b\n\t_max_wait\"n\n\x11IdempotencyConfig\x12\x12\n\nexpression\x18\x01 \x01(\t\x12\x0e\n\x06ttl_ms\x18\x02 \x01(\x03\x12*\n\x06method\x18\x03 \x01(\x0e\x32\x15.v1.IdempotencyMethodH\x00\x88\x01\x01\x42\t\n\x07_method\"`\n\x19IdempotencyCollisionError\x12 \n\x18\x65xisting_run_external_id\x18\x01 \x01(\t\x12!\n\x19\x63olliding_run_external_id\x18\x02 \x01(\t\"\x87\x01\n$BulkTriggerIdempotencyCollisionError\x12,\n$successful_workflow_run_external_ids\x18\x01 \x03(\t\x12\x31\n\ncollisions\x18\x02 \x03(\x0b\x32\x1d.v1.IdempotencyCollisionError\"T\n\rDefaultFilter\x12\x12\n\nexpression\x18\x01 \x01(\t\x12\r\n\x05scope\x18\x02 \x01(\t\x12\x14\n\x07payload\x18\x03 \x01(\x0cH\x00\x88\x01\x01\x42\n\n\x08_payload\"\x93\x01\n\x0b\x43oncurrency\x12\x12\n\nexpression\x18\x01 \x01(\t\x12\x15\n\x08max_runs\x18\x02 \x01(\x05H\x00\x88\x01\x01\x12\x39\n\x0elimit_strategy\x18\x03 \x01(\x0e\x32\x1c.v1.ConcurrencyLimitStrategyH\x01\x88\x01\x01\x42\x0b\n\t_max_runsB\x11\n\x0f_limit_strategy\"\x89\x02\n\x0fTaskBatchConfig\x12\x16\n\x0e\x62\x61tch_max_size\x18\x01 \x01(\x05\x12\"\n\x15\x62\x61tch_max_interval_ms\x18\x02 \x01(\x05H\x00\x88\x01\x01\x12\x1c\n\x0f\x62\x61tch_group_key\x18\x03 \x01(\tH\x01\x88\x01\x01\x12!\n\x14\x62\x61tch_group_max_runs\x18\x04 \x01(\x05H\x02\x88\x01\x01\x12\x1d\n\x10\x62roadcast_output\x18\x05 \x01(\x08H\x03\x88\x01\x01\x42\x18\n\x16_batch_max_interval_msB\x12\n\x10_batch_group_keyB\x17\n\x15_batch_group_max_runsB\x13\n\x11_broadcast_output\"\xee\x07\n\x0e\x43reateTaskOpts\x12\x13\n\x0breadable_id\x18\x01 \x01(\t\x12\x0e\n\x06\x61\x63tion\x18\x02 \x01(\t\x12\x0f\n\x07timeout\x18\x03 \x01(\t\x12\x0e\n\x06inputs\x18\x04 \x01(\t\x12\x0f\n\x07parents\x18\x05 \x03(\t\x12\x0f\n\x07retries\x18\x06 \x01(\x05\x12,\n\x0brate_limits\x18\x07 \x03(\x0b\x32\x17.v1.CreateTaskRateLimit\x12;\n\rworker_labels\x18\x08 \x03(\x0b\x32$.v1.CreateTaskOpts.WorkerLabelsEntry\x12\x1b\n\x0e\x62\x61\x63koff_factor\x18\t \x01(\x02H\x00\x88\x01\x01\x12 \n\x13\x62\x61\x63koff_max_seconds\x18\n \x01(\x05H\x01\x88\x01\x01\x12$\n\x0b\x63oncurrency\x18\x0b \x03(\x0b\x32\x0f.v1.Concurrency\x12+\n\nconditions\x18\x0c \x01(\x0b\x32\x12.v1.TaskConditionsH\x02\x88\x01\x01\x12\x1d\n\x10schedule_timeout\x18\r \x01(\tH\x03\x88\x01\x01\x12\x12\n\nis_durable\x18\x0e \x01(\x08\x12;\n\rslot_requests\x18\x0f \x03(\x0b\x32$.v1.CreateTaskOpts.SlotRequestsEntry\x12\'\n\x05\x62\x61tch\x18\x10 \x01(\x0b\x32\x13.v1.TaskBatchConfigH\x04\x88\x01\x01\x12\'\n\x0eretry_policies\x18\x11 \x03(\x0b\x32\x0f.v1.RetryPolicy\x12\x30\n\x0f\x63ircuit_breaker\x18\x12 \x01(\x0b\x32\x12.v1.CircuitBreakerH\x05\x88\x01\x01\x12\x1d\n\x03map\x18\x13 \x01(\x0b\x32\x0b.v1.TaskMapH\x06\x88\x01\x01\x12\'\n\x08\x61pproval\x18\x14 \x01(\x0b\x32\x10.v1.TaskApprovalH\x07\x88\x01\x01\x12\'\n\npreemption\x18\x15 \x01(\x0b\x32\x0e.v1.PreemptionH\x08\x88\x01\x01\x1aL\n\x11WorkerLabelsEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12&\n\x05value\x18\x02 \x01(\x0b\x32\x17.v1.DesiredWorkerLabels:\x02\x38\x01\x1a\x33\n\x11SlotRequestsEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\x05:\x02\x38\x01\x42\x11\n\x0f_backoff_factorB\x16\n\x14_backoff_max_secondsB\r\n\x0b_conditionsB\x13\n\x11_schedule_timeoutB\x08\n\x06_batchB\x12\n\x10_circuit_breakerB\x06\n\x04_mapB\x0b\n\t_approvalB\r\n\x0b_preemption\">\n\nPreemption\x12\x1c\n\x0fmax_preemptions\x18\x01 \x01(\x05H\x00\x88\x01\x01\x42\x12\n\x10_max_preemptions\"\xa1\x01\n\x0cTaskApproval\x12\x11\n\tapprovers\x18\x01 \x03(\t\x12\x16\n\x0e\x63ontext_fields\x18\x02 \x03(\t\x12\x17\n\nexpires_in\x18\x03 \x01(\tH\x00\x88\x01\x01\x12\x30\n\ton_expiry\x18\x04 \x01(\x0e\x32\x18.v1.ApprovalExpiryActionH\x01\x88\x01\x01\x42\r\n\x0b_expires_inB\x0c\n\n_on_expiry\"O\n\x07TaskMap\x12\x12\n\nexpression\x18\x01 \x01(\t\x12\x1c\n\x0fmax_parallelism\x18\x02 \x01(\x05H\x00\x88\x01\x01\x42\x12\n\x10_max_parallelism\"\x93\x01\n\x0e\x43ircuitBreaker\x12\x19\n\x11\x66\x61ilure_threshold\x18\x01 \x01(\x05\x12\x13\n\x06window\x18\x02 \x01(\tH\x00\x88\x01\x01\x12\x15\n\x08\x63ooldown\x18\x03 \x01(\tH\x01\x88\x01\x01\x12\x15\n\x08key_expr\x18\x04 \x01(\tH\x02\x88\x01\x01\x42\t\n\x07_windowB\x0b\n\t_cooldownB\x0b\n\t_key_expr\"\x80\x02\n\x0bRetryPolicy\x12\x18\n\x0b\x65rror_class\x18\x01 \x01(\tH\x00\x88\x01\x01\x12\x17\n\nexpression\x18\x02 \x01(\tH\x01\x88\x01\x01\x12\x14\n\x07retries\x18\x03 \x01(\x05H\x02\x88\x01\x01\x12\x1b\n\x0e\x62\x61\x63koff_factor\x18\x04 \x01(\x02H\x03\x88\x01\x01\x12 \n\x13\x62\x61\x63koff_max_seconds\x18\x05 \x01(\x05H\x04\x88\x01\x01\x12\x13\n\x0bnever_retry\x18\x06 \x01(\x08\x42\x0e\n\x0c_error_classB\r\n\x0b_expressionB\n\n\x08_retriesB\x11\n\x0f_backoff_factorB\x16\n\x14_backoff_max_seconds\"\xfd\x01\n\x13\x43reateTaskRateLimit\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\x12\n\x05units\x18\x02 \x01(\x05H\x00\x88\x01\x01\x12\x15\n\x08key_expr\x18\x03 \x01(\tH\x01\x88\x01\x01\x12\x17\n\nunits_expr\x18\x04 \x01(\tH\x02\x88\x01\x01\x12\x1e\n\x11limit_values_expr\x18\x05 \x01(\tH\x03\x88\x01\x01\x12,\n\x08\x64uration\x18\x06 \x01(\x0e\x32\x15.v1.RateLimitDurationH\x04\x88\x01\x01\x42\x08\n\x06_unitsB\x0b\n\t_key_exprB\r\n\x0b_units_exprB\x14\n\x12_limit_values_exprB\x0b\n\t_duration\"@\n\x1d\x43reateWorkflowVersionResponse\x12\n\n\x02id\x18\x01 \x01(\t\x12\x13\n\x0bworkflow_id\x18\x02 \x01(\t\"+\n\x14GetRunDetailsRequest\x12\x13\n\x0b\x65xternal_id\x18\x01 \x01(\t\"\xaa\x01\n\rTaskRunDetail\x12\x13\n\x0b\x65xternal_id\x18\x01 \x01(\t\x12\x1d\n\x06status\x18\x02 \x01(\x0e\x32\r.v1.RunStatus\x12\x12\n\x05\x65rror\x18\x03 \x01(\tH\x00\x88\x01\x01\x12\x13\n\x06output\x18\x04 \x01(\x0cH\x01\x88\x01\x01\x12\x13\n\x0breadable_id\x18\x05 \x01(\t\x12\x12\n\nis_evicted\x18\x06 \x01(\x08\x42\x08\n\x06_errorB\t\n\x07_output\"\x84\x02\n\x15GetRunDetailsResponse\x12\r\n\x05input\x18\x01 \x01(\x0c\x12\x1d\n\x06status\x18\x02 \x01(\x0e\x32\r.v1.RunStatus\x12:\n\ttask_runs\x18\x03 \x03(\x0b\x32\'.v1.GetRunDetailsResponse.TaskRunsEntry\x12\x0c\n\x04\x64one\x18\x04 \x01(\x08\x12\x1b\n\x13\x61\x64\x64itional_metadata\x18\x05 \x01(\x0c\x12\x12\n\nis_evicted\x18\x06 \x01(\x08\x1a\x42\n\rTaskRunsEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12 \n\x05value\x18\x02 \x01(\x0b\x32\x11.v1.TaskRunDetail:\x02\x38\x01*$\n\x0eStickyStrategy\x12\x08\n\x04SOFT\x10\x00\x12\x08\n\x04HARD\x10\x01*]\n\x11RateLimitDuration\x12\n\n\x06SECOND\x10\x00\x12\n\n\x06MINUTE\x10\x01\x12\x08\n\x04HOUR\x10\x02\x12\x07\n\x03\x44\x41Y\x10\x03\x12\x08\n\x04WEEK\x10\x04\x12\t\n\x05MONTH\x10\x05\x12\x08\n\x04YEAR\x10\x06*[\n\tRunStatus\x12\n\n\x06QUEUED\x10\x00\x12\x0b\n\x07RUNNING\x10\x01\x12\r\n\tCOMPLETED\x10\x02\x12\n\n\x06\x46\x41ILED\x10\x03\x12\r\n\tCANCELLED\x10\x04\x12\x0b\n\x07\x45VICTED\x10\x05*(\n\x11IdempotencyMethod\x12\x07\n\x03TTL\x10\x00\x12\n\n\x06STATUS\x10\x01*\x7f\n\x18\x43oncurrencyLimitStrategy\x12\x16\n\x12\x43\x41NCEL_IN_PROGRESS\x10\x00\x12\x0f\n\x0b\x44ROP_NEWEST\x10\x01\x12\x10\n\x0cQUEUE_NEWEST\x10\x02\x12\x15\n\x11GROUP_ROUND_ROBIN\x10\x03\x12\x11\n\rCANCEL_NEWEST\x10\x04*i\n\x14\x41pprovalExpiryAction\x12\x18\n\x14\x41PPROVAL_EXPIRY_FAIL\x10\x00\x12\x1a\n\x16\x41PPROVAL_EXPIRY_REJECT\x10\x01\x12\x1b\n\x17\x41PPROVAL_EXPIRY_APPROVE\x10\x02\x32\xcc\x04\n\x0c\x41\x64minService\x12R\n\x0bPutWorkflow\x12 .v1.CreateWorkflowVersionRequest\x1a!.v1.CreateWorkflowVersionResponse\x12>\n\x0b\x43\x61ncelTasks\x12\x16.v1.CancelTasksRequest\x1a\x17.v1.CancelTasksResponse\x12>\n\x0bReplayTasks\x12\x16.v1.ReplayTasksRequest\x1a\x17.v1.ReplayTasksResponse\x12S\n\x12TriggerWorkflowRun\x12\x1d.v1.TriggerWorkflowRunRequest\x1a\x1e.v1.TriggerWorkflowRunResponse\x12\x44\n\rGetRunDetails\x12\x18.v1.GetRunDetailsRequest\x1a\x19.v1.GetRunDetailsResponse\x12P\n\x11\x42ranchDurableTask\x12\x1c.v1.BranchDurableTaskRequest\x1a\x1d.v1.BranchDurableTaskResponse\x12\x41\n\x0c\x41\x64vanceClock\x12\x17.v1.AdvanceClockRequest\x1a\x18.v1.AdvanceClockResponse\x12\x38\n\tSignalRun\x12\x14.v1.SignalRunRequest\x1a\x15.v1.SignalRunResponseBBZ@github.com/hatchet-dev/hatchet/internal/services/shared/proto/v1b\x06proto3')
 
 _globals = globals()
 _builder.BuildMessageAndEnumDescriptors(DESCRIPTOR, _globals)
@@ -37,22 +37,26 @@ if not _descriptor._USE_C_DESCRIPTORS:
   _globals['DESCRIPTOR']._serialized_options = b'Z@github.com/hatchet-dev/hatchet/internal/services/shared/proto/v1'
   _globals['_TRIGGERWORKFLOWRUNREQUEST_DESIREDWORKERLABELSENTRY']._loaded_options = None
   _globals['_TRIGGERWORKFLOWRUNREQUEST_DESIREDWORKERLABELSENTRY']._serialized_options = b'8\001'
+  _globals['_TRIGGERWORKFLOWRUNREQUEST_PARENTOUTPUTSENTRY']._loaded_options = None
+  _globals['_TRIGGERWORKFLOWRUNREQUEST_PARENTOUTPUTSENTRY']._serialized_options = b'8\001'
   _globals['_CREATETASKOPTS_WORKERLABELSENTRY']._loaded_options = None
   _globals['_CREATETASKOPTS_WORKERLABELSENTRY']._serialized_options = b'8\001'
   _globals['_CREATETASKOPTS_SLOTREQUESTSENTRY']._loaded_options = None
   _globals['_CREATETASKOPTS_SLOTREQUESTSENTRY']._serialized_options = b'8\001'
   _globals['_GETRUNDETAILSRESPONSE_TASKRUNSENTRY']._loaded_options = None
   _globals['_GETRUNDETAILSRESPONSE_TASKRUNSENTRY']._serialized_options = b'8\001'
-  _globals['_STICKYSTRATEGY']._serialized_start=4143
-  _globals['_STICKYSTRATEGY']._serialized_end=4179
-  _globals['_RATELIMITDURATION']._serialized_start=4181
-  _globals['_RATELIMITDURATION']._serialized_end=4274
-  _globals['_RUNSTATUS']._serialized_start=4276
-  _globals['_RUNSTATUS']._serialized_end=4367
-  _globals['_IDEMPOTENCYMETHOD']._serialized_start=4369
-  _globals['_IDEMPOTENCYMETHOD']._serialized_end=4409
-  _globals['_CONCURRENCYLIMITSTRATEGY']._serialized_start=4411
-  _globals['_CONCURRENCYLIMITSTRATEGY']._serialized_end=4538
+  _globals['_STICKYSTRATEGY']._serialized_start=5841
+  _globals['_STICKYSTRATEGY']._serialized_end=5877
+  _globals['_RATELIMITDURATION']._serialized_start=5879
+  _globals['_RATELIMITDURATION']._serialized_end=5972
+  _globals['_RUNSTATUS']._serialized_start=5974
+  _globals['_RUNSTATUS']._serialized_end=6065
+  _globals['_IDEMPOTENCYMETHOD']._serialized_start=6067
+  _globals['_IDEMPOTENCYMETHOD']._serialized_end=6107
+  _globals['_CONCURRENCYLIMITSTRATEGY']._serialized_start=6109
+  _globals['_CONCURRENCYLIMITSTRATEGY']._serialized_end=6236
+  _globals['_APPROVALEXPIRYACTION']._serialized_start=6238
+  _globals['_APPROVALEXPIRYACTION']._serialized_end=6343
   _globals['_CANCELTASKSREQUEST']._serialized_start=111
   _globals['_CANCELTASKSREQUEST']._serialized_end=202
   _globals['_REPLAYTASKSREQUEST']._serialized_start=204
@@ -64,47 +68,69 @@ if not _descriptor._USE_C_DESCRIPTORS:
   _globals['_REPLAYTASKSRESPONSE']._serialized_start=531
   _globals['_REPLAYTASKSRESPONSE']._serialized_end=576
   _globals['_TRIGGERWORKFLOWRUNREQUEST']._serialized_start=579
-  _globals['_TRIGGERWORKFLOWRUNREQUEST']._serialized_end=881
-  _globals['_TRIGGERWORKFLOWRUNREQUEST_DESIREDWORKERLABELSENTRY']._serialized_start=785
-  _globals['_TRIGGERWORKFLOWRUNREQUEST_DESIREDWORKERLABELSENTRY']._serialized_end=868
-  _globals['_TRIGGERWORKFLOWRUNRESPONSE']._serialized_start=883
-  _globals['_TRIGGERWORKFLOWRUNRESPONSE']._serialized_end=932
-  _globals['_BRANCHDURABLETASKREQUEST']._serialized_start=934
-  _globals['_BRANCHDURABLETASKREQUEST']._serialized_end=1022
-  _globals['_BRANCHDURABLETASKRESPONSE']._serialized_start=1024
-  _globals['_BRANCHDURABLETASKRESPONSE']._serialized_end=1113
-  _globals['_CREATEWORKFLOWVERSIONREQUEST']._serialized_start=1116
-  _globals['_CREATEWORKFLOWVERSIONREQUEST']._serialized_end=1737
-  _globals['_IDEMPOTENCYCONFIG']._serialized_start=1739
-  _globals['_IDEMPOTENCYCONFIG']._serialized_end=1849
-  _globals['_IDEMPOTENCYCOLLISIONERROR']._serialized_start=1851
-  _globals['_IDEMPOTENCYCOLLISIONERROR']._serialized_end=1947
-  _globals['_BULKTRIGGERIDEMPOTENCYCOLLISIONERROR']._serialized_start=1950
-  _globals['_BULKTRIGGERIDEMPOTENCYCOLLISIONERROR']._serialized_end=2085
-  _globals['_DEFAULTFILTER']._serialized_start=2087
-  _globals['_DEFAULTFILTER']._serialized_end=2171
-  _globals['_CONCURRENCY']._serialized_start=2174
-  _globals['_CONCURRENCY']._serialized_end=2321
-  _globals['_TASKBATCHCONFIG']._serialized_start=2324
-  _globals['_TASKBATCHCONFIG']._serialized_end=2589
-  _globals['_CREATETASKOPTS']._serialized_start=2592
-  _globals['_CREATETASKOPTS']._serialized_end=3338
-  _globals['_CREATETASKOPTS_WORKERLABELSENTRY']._serialized_start=3120
-  _globals['_CREATETASKOPTS_WORKERLABELSENTRY']._serialized_end=3196
-  _globals['_CREATETASKOPTS_SLOTREQUESTSENTRY']._serialized_start=3198
-  _globals['_CREATETASKOPTS_SLOTREQUESTSENTRY']._serialized_end=3249
-  _globals['_CREATETASKRATELIMIT']._serialized_start=3341
-  _globals['_CREATETASKRATELIMIT']._serialized_end=3594
-  _globals['_CREATEWORKFLOWVERSIONRESPONSE']._serialized_start=3596
-  _globals['_CREATEWORKFLOWVERSIONRESPONSE']._serialized_end=3660
-  _globals['_GETRUNDETAILSREQUEST']._serialized_start=3662
-  _globals['_GETRUNDETAILSREQUEST']._serialized_end=3705
-  _globals['_TASKRUNDETAIL']._serialized_start=3708
-  _globals['_TASKRUNDETAIL']._serialized_end=3878
-  _globals['_GETRUNDETAILSRESPONSE']._serialized_start=3881
-  _globals['_GETRUNDETAILSRESPONSE']._serialized_end=4141
-  _globals['_GETRUNDETAILSRESPONSE_TASKRUNSENTRY']._serialized_start=4075
-  _globals['_GETRUNDETAILSRESPONSE_TASKRUNSENTRY']._serialized_end=4141
-  _globals['_ADMINSERVICE']._serialized_start=4541
-  _globals['_ADMINSERVICE']._serialized_end=5004
+  _globals['_TRIGGERWORKFLOWRUNREQUEST']._serialized_end=1061
+  _globals['_TRIGGERWORKFLOWRUNREQUEST_DESIREDWORKERLABELSENTRY']._serialized_start=890
+  _globals['_TRIGGERWORKFLOWRUNREQUEST_DESIREDWORKERLABELSENTRY']._serialized_end=973
+  _globals['_TRIGGERWORKFLOWRUNREQUEST_PARENTOUTPUTSENTRY']._serialized_start=975
+  _globals['_TRIGGERWORKFLOWRUNREQUEST_PARENTOUTPUTSENTRY']._serialized_end=1027
+  _globals['_TRIGGERWORKFLOWRUNRESPONSE']._serialized_start=1063
+  _globals['_TRIGGERWORKFLOWRUNRESPONSE']._serialized_end=1112
+  _globals['_BRANCHDURABLETASKREQUEST']._serialized_start=1114
+  _globals['_BRANCHDURABLETASKREQUEST']._serialized_end=1202
+  _globals['_BRANCHDURABLETASKRESPONSE']._serialized_start=1204
+  _globals['_BRANCHDURABLETASKRESPONSE']._serialized_end=1293
+  _globals['_ADVANCECLOCKREQUEST']._serialized_start=1295
+  _globals['_ADVANCECLOCKREQUEST']._serialized_end=1336
+  _globals['_ADVANCECLOCKRESPONSE']._serialized_start=1338
+  _globals['_ADVANCECLOCKRESPONSE']._serialized_end=1420
+  _globals['_SIGNALRUNREQUEST']._serialized_start=1422
+  _globals['_SIGNALRUNREQUEST']._serialized_end=1534
+  _globals['_SIGNALRUNRESPONSE']._serialized_start=1537
+  _globals['_SIGNALRUNRESPONSE']._serialized_end=1677
+  _globals['_CREATEWORKFLOWVERSIONREQUEST']._serialized_start=1680
+  _globals['_CREATEWORKFLOWVERSIONREQUEST']._serialized_end=2368
+  _globals['_PRIORITYAGING']._serialized_start=2370
+  _globals['_PRIORITYAGING']._serialized_end=2457
+  _globals['_IDEMPOTENCYCONFIG']._serialized_start=2459
+  _globals['_IDEMPOTENCYCONFIG']._serialized_end=2569
+  _globals['_IDEMPOTENCYCOLLISIONERROR']._serialized_start=2571
+  _globals['_IDEMPOTENCYCOLLISIONERROR']._serialized_end=2667
+  _globals['_BULKTRIGGERIDEMPOTENCYCOLLISIONERROR']._serialized_start=2670
+  _globals['_BULKTRIGGERIDEMPOTENCYCOLLISIONERROR']._serialized_end=2805
+  _globals['_DEFAULTFILTER']._serialized_start=2807
+  _globals['_DEFAULTFILTER']._serialized_end=2891
+  _globals['_CONCURRENCY']._serialized_start=2894
+  _globals['_CONCURRENCY']._serialized_end=3041
+  _globals['_TASKBATCHCONFIG']._serialized_start=3044
+  _globals['_TASKBATCHCONFIG']._serialized_end=3309
+  _globals['_CREATETASKOPTS']._serialized_start=3312
+  _globals['_CREATETASKOPTS']._serialized_end=4318
+  _globals['_CREATETASKOPTS_WORKERLABELSENTRY']._serialized_start=4044
+  _globals['_CREATETASKOPTS_WORKERLABELSENTRY']._serialized_end=4120
+  _globals['_CREATETASKOPTS_SLOTREQUESTSENTRY']._serialized_start=4122
+  _globals['_CREATETASKOPTS_SLOTREQUESTSENTRY']._serialized_end=4173
+  _globals['_PREEMPTION']._serialized_start=4320
+  _globals['_PREEMPTION']._serialized_end=4382
+  _globals['_TASKAPPROVAL']._serialized_start=4385
+  _globals['_TASKAPPROVAL']._serialized_end=4546
+  _globals['_TASKMAP']._serialized_start=4548
+  _globals['_TASKMAP']._serialized_end=4627
+  _globals['_CIRCUITBREAKER']._serialized_start=4630
+  _globals['_CIRCUITBREAKER']._serialized_end=4777
+  _globals['_RETRYPOLICY']._serialized_start=4780
+  _globals['_RETRYPOLICY']._serialized_end=5036
+  _globals['_CREATETASKRATELIMIT']._serialized_start=5039
+  _globals['_CREATETASKRATELIMIT']._serialized_end=5292
+  _globals['_CREATEWORKFLOWVERSIONRESPONSE']._serialized_start=5294
+  _globals['_CREATEWORKFLOWVERSIONRESPONSE']._serialized_end=5358
+  _globals['_GETRUNDETAILSREQUEST']._serialized_start=5360
+  _globals['_GETRUNDETAILSREQUEST']._serialized_end=5403
+  _globals['_TASKRUNDETAIL']._serialized_start=5406
+  _globals['_TASKRUNDETAIL']._serialized_end=5576
+  _globals['_GETRUNDETAILSRESPONSE']._serialized_start=5579
+  _globals['_GETRUNDETAILSRESPONSE']._serialized_end=5839
+  _globals['_GETRUNDETAILSRESPONSE_TASKRUNSENTRY']._serialized_start=5773
+  _globals['_GETRUNDETAILSRESPONSE_TASKRUNSENTRY']._serialized_end=5839
+  _globals['_ADMINSERVICE']._serialized_start=6346
+  _globals['_ADMINSERVICE']._serialized_end=6934
 # @@protoc_insertion_point(module_scope)
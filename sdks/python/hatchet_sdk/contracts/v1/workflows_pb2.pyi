@@ -48,6 +48,12 @@ class ConcurrencyLimitStrategy(int, metaclass=_enum_type_wrapper.EnumTypeWrapper
     QUEUE_NEWEST: _ClassVar[ConcurrencyLimitStrategy]
     GROUP_ROUND_ROBIN: _ClassVar[ConcurrencyLimitStrategy]
     CANCEL_NEWEST: _ClassVar[ConcurrencyLimitStrategy]
+
+class ApprovalExpiryAction(int, metaclass=_enum_type_wrapper.EnumTypeWrapper):
+    __slots__ = ()
+    APPROVAL_EXPIRY_FAIL: _ClassVar[ApprovalExpiryAction]
+    APPROVAL_EXPIRY_REJECT: _ClassVar[ApprovalExpiryAction]
+    APPROVAL_EXPIRY_APPROVE: _ClassVar[ApprovalExpiryAction]
 SOFT: StickyStrategy
 HARD: StickyStrategy
 SECOND: RateLimitDuration
@@ -70,6 +76,9 @@ DROP_NEWEST: ConcurrencyLimitStrategy
 QUEUE_NEWEST: ConcurrencyLimitStrategy
 GROUP_ROUND_ROBIN: ConcurrencyLimitStrategy
 CANCEL_NEWEST: ConcurrencyLimitStrategy
+APPROVAL_EXPIRY_FAIL: ApprovalExpiryAction
+APPROVAL_EXPIRY_REJECT: ApprovalExpiryAction
+APPROVAL_EXPIRY_APPROVE: ApprovalExpiryAction
 
 class CancelTasksRequest(_message.Message):
     __slots__ = ("external_ids", "filter")
@@ -114,7 +123,7 @@ class ReplayTasksResponse(_message.Message):
     def __init__(self, replayed_tasks: _Optional[_Iterable[str]] = ...) -> None: ...
 
 class TriggerWorkflowRunRequest(_message.Message):
-    __slots__ = ("workflow_name", "input", "additional_metadata", "priority", "desired_worker_labels")
+    __slots__ = ("workflow_name", "input", "additional_metadata", "priority", "desired_worker_labels", "target_action_id", "parent_outputs")
     class DesiredWorkerLabelsEntry(_message.Message):
         __slots__ = ("key", "value")
         KEY_FIELD_NUMBER: _ClassVar[int]
@@ -122,17 +131,28 @@ class TriggerWorkflowRunRequest(_message.Message):
         key: str
         value: _trigger_pb2.DesiredWorkerLabels
         def __init__(self, key: _Optional[str] = ..., value: _Optional[_Union[_trigger_pb2.DesiredWorkerLabels, _Mapping]] = ...) -> None: ...
+    class ParentOutputsEntry(_message.Message):
+        __slots__ = ("key", "value")
+        KEY_FIELD_NUMBER: _ClassVar[int]
+        VALUE_FIELD_NUMBER: _ClassVar[int]
+        key: str
+        value: bytes
+        def __init__(self, key: _Optional[str] = ..., value: _Optional[bytes] = ...) -> None: ...
     WORKFLOW_NAME_FIELD_NUMBER: _ClassVar[int]
     INPUT_FIELD_NUMBER: _ClassVar[int]
     ADDITIONAL_METADATA_FIELD_NUMBER: _ClassVar[int]
     PRIORITY_FIELD_NUMBER: _ClassVar[int]
     DESIRED_WORKER_LABELS_FIELD_NUMBER: _ClassVar[int]
+    TARGET_ACTION_ID_FIELD_NUMBER: _ClassVar[int]
+    PARENT_OUTPUTS_FIELD_NUMBER: _ClassVar[int]
     workflow_name: str
     input: bytes
     additional_metadata: bytes
     priority: int
     desired_worker_labels: _containers.MessageMap[str, _trigger_pb2.DesiredWorkerLabels]
-    def __init__(self, workflow_name: _Optional[str] = ..., input: _Optional[bytes] = ..., additional_metadata: _Optional[bytes] = ..., priority: _Optional[int] = ..., desired_worker_labels: _Optional[_Mapping[str, _trigger_pb2.DesiredWorkerLabels]] = ...) -> None: ...
+    target_action_id: str
+    parent_outputs: _containers.ScalarMap[str, bytes]
+    def __init__(self, workflow_name: _Optional[str] = ..., input: _Optional[bytes] = ..., additional_metadata: _Optional[bytes] = ..., priority: _Optional[int] = ..., desired_worker_labels: _Optional[_Mapping[str, _trigger_pb2.DesiredWorkerLabels]] = ..., target_action_id: _Optional[str] = ..., parent_outputs: _Optional[_Mapping[str, bytes]] = ...) -> None: ...
 
 class TriggerWorkflowRunResponse(_message.Message):
     __slots__ = ("external_id",)
@@ -160,8 +180,48 @@ class BranchDurableTaskResponse(_message.Message):
     branch_id: int
     def __init__(self, task_external_id: _Optional[str] = ..., node_id: _Optional[int] = ..., branch_id: _Optional[int] = ...) -> None: ...
 
+class AdvanceClockRequest(_message.Message):
+    __slots__ = ("advance_by",)
+    ADVANCE_BY_FIELD_NUMBER: _ClassVar[int]
+    advance_by: str
+    def __init__(self, advance_by: _Optional[str] = ...) -> None: ...
+
+class AdvanceClockResponse(_message.Message):
+    __slots__ = ("now", "offset_ms")
+    NOW_FIELD_NUMBER: _ClassVar[int]
+    OFFSET_MS_FIELD_NUMBER: _ClassVar[int]
+    now: _timestamp_pb2.Timestamp
+    offset_ms: int
+    def __init__(self, now: _Optional[_Union[datetime.datetime, _timestamp_pb2.Timestamp, _Mapping]] = ..., offset_ms: _Optional[int] = ...) -> None: ...
+
+class SignalRunRequest(_message.Message):
+    __slots__ = ("run_external_id", "name", "payload", "signal_id")
+    RUN_EXTERNAL_ID_FIELD_NUMBER: _ClassVar[int]
+    NAME_FIELD_NUMBER: _ClassVar[int]
+    PAYLOAD_FIELD_NUMBER: _ClassVar[int]
+    SIGNAL_ID_FIELD_NUMBER: _ClassVar[int]
+    run_external_id: str
+    name: str
+    payload: bytes
+    signal_id: str
+    def __init__(self, run_external_id: _Optional[str] = ..., name: _Optional[str] = ..., payload: _Optional[bytes] = ..., signal_id: _Optional[str] = ...) -> None: ...
+
+class SignalRunResponse(_message.Message):
+    __slots__ = ("signal_id", "waiting_conditions", "matched_conditions", "created_tasks", "resumed_tasks")
+    SIGNAL_ID_FIELD_NUMBER: _ClassVar[int]
+    WAITING_CONDITIONS_FIELD_NUMBER: _ClassVar[int]
+    MATCHED_CONDITIONS_FIELD_NUMBER: _ClassVar[int]
+    CREATED_TASKS_FIELD_NUMBER: _ClassVar[int]
+    RESUMED_TASKS_FIELD_NUMBER: _ClassVar[int]
+    signal_id: str
+    waiting_conditions: int
+    matched_conditions: int
+    created_tasks: _containers.RepeatedScalarFieldContainer[str]
+    resumed_tasks: _containers.RepeatedScalarFieldContainer[str]
+    def __init__(self, signal_id: _Optional[str] = ..., waiting_conditions: _Optional[int] = ..., matched_conditions: _Optional[int] = ..., created_tasks: _Optional[_Iterable[str]] = ..., resumed_tasks: _Optional[_Iterable[str]] = ...) -> None: ...
+
 class CreateWorkflowVersionRequest(_message.Message):
-    __slots__ = ("name", "description", "version", "event_triggers", "cron_triggers", "tasks", "concurrency", "cron_input", "on_failure_task", "sticky", "default_priority", "concurrency_arr", "default_filters", "input_json_schema", "idempotency")
+    __slots__ = ("name", "description", "version", "event_triggers", "cron_triggers", "tasks", "concurrency", "cron_input", "on_failure_task", "sticky", "default_priority", "concurrency_arr", "default_filters", "input_json_schema", "idempotency", "priority_aging")
     NAME_FIELD_NUMBER: _ClassVar[int]
     DESCRIPTION_FIELD_NUMBER: _ClassVar[int]
     VERSION_FIELD_NUMBER: _ClassVar[int]
@@ -177,6 +237,7 @@ class CreateWorkflowVersionRequest(_message.Message):
     DEFAULT_FILTERS_FIELD_NUMBER: _ClassVar[int]
     INPUT_JSON_SCHEMA_FIELD_NUMBER: _ClassVar[int]
     IDEMPOTENCY_FIELD_NUMBER: _ClassVar[int]
+    PRIORITY_AGING_FIELD_NUMBER: _ClassVar[int]
     name: str
     description: str
     version: str
@@ -192,7 +253,16 @@ class CreateWorkflowVersionRequest(_message.Message):
     default_filters: _containers.RepeatedCompositeFieldContainer[DefaultFilter]
     input_json_schema: bytes
     idempotency: IdempotencyConfig
-    def __init__(self, name: _Optional[str] = ..., description: _Optional[str] = ..., version: _Optional[str] = ..., event_triggers: _Optional[_Iterable[str]] = ..., cron_triggers: _Optional[_Iterable[str]] = ..., tasks: _Optional[_Iterable[_Union[CreateTaskOpts, _Mapping]]] = ..., concurrency: _Optional[_Union[Concurrency, _Mapping]] = ..., cron_input: _Optional[str] = ..., on_failure_task: _Optional[_Union[CreateTaskOpts, _Mapping]] = ..., sticky: _Optional[_Union[StickyStrategy, str]] = ..., default_priority: _Optional[int] = ..., concurrency_arr: _Optional[_Iterable[_Union[Concurrency, _Mapping]]] = ..., default_filters: _Optional[_Iterable[_Union[DefaultFilter, _Mapping]]] = ..., input_json_schema: _Optional[bytes] = ..., idempotency: _Optional[_Union[IdempotencyConfig, _Mapping]] = ...) -> None: ...
+    priority_aging: PriorityAging
+    def __init__(self, name: _Optional[str] = ..., description: _Optional[str] = ..., version: _Optional[str] = ..., event_triggers: _Optional[_Iterable[str]] = ..., cron_triggers: _Optional[_Iterable[str]] = ..., tasks: _Optional[_Iterable[_Union[CreateTaskOpts, _Mapping]]] = ..., concurrency: _Optional[_Union[Concurrency, _Mapping]] = ..., cron_input: _Optional[str] = ..., on_failure_task: _Optional[_Union[CreateTaskOpts, _Mapping]] = ..., sticky: _Optional[_Union[StickyStrategy, str]] = ..., default_priority: _Optional[int] = ..., concurrency_arr: _Optional[_Iterable[_Union[Concurrency, _Mapping]]] = ..., default_filters: _Optional[_Iterable[_Union[DefaultFilter, _Mapping]]] = ..., input_json_schema: _Optional[bytes] = ..., idempotency: _Optional[_Union[IdempotencyConfig, _Mapping]] = ..., priority_aging: _Optional[_Union[PriorityAging, _Mapping]] = ...) -> None: ...
+
+class PriorityAging(_message.Message):
+    __slots__ = ("interval", "max_wait")
+    INTERVAL_FIELD_NUMBER: _ClassVar[int]
+    MAX_WAIT_FIELD_NUMBER: _ClassVar[int]
+    interval: str
+    max_wait: str
+    def __init__(self, interval: _Optional[str] = ..., max_wait: _Optional[str] = ...) -> None: ...
 
 class IdempotencyConfig(_message.Message):
     __slots__ = ("expression", "ttl_ms", "method")
@@ -255,7 +325,7 @@ class TaskBatchConfig(_message.Message):
     def __init__(self, batch_max_size: _Optional[int] = ..., batch_max_interval_ms: _Optional[int] = ..., batch_group_key: _Optional[str] = ..., batch_group_max_runs: _Optional[int] = ..., broadcast_output: bool = ...) -> None: ...
 
 class CreateTaskOpts(_message.Message):
-    __slots__ = ("readable_id", "action", "timeout", "inputs", "parents", "retries", "rate_limits", "worker_labels", "backoff_factor", "backoff_max_seconds", "concurrency", "conditions", "schedule_timeout", "is_durable", "slot_requests", "batch")
+    __slots__ = ("readable_id", "action", "timeout", "inputs", "parents", "retries", "rate_limits", "worker_labels", "backoff_factor", "backoff_max_seconds", "concurrency", "conditions", "schedule_timeout", "is_durable", "slot_requests", "batch", "retry_policies", "circuit_breaker", "map", "approval", "preemption")
     class WorkerLabelsEntry(_message.Message):
         __slots__ = ("key", "value")
         KEY_FIELD_NUMBER: _ClassVar[int]
@@ -286,6 +356,11 @@ class CreateTaskOpts(_message.Message):
     IS_DURABLE_FIELD_NUMBER: _ClassVar[int]
     SLOT_REQUESTS_FIELD_NUMBER: _ClassVar[int]
     BATCH_FIELD_NUMBER: _ClassVar[int]
+    RETRY_POLICIES_FIELD_NUMBER: _ClassVar[int]
+    CIRCUIT_BREAKER_FIELD_NUMBER: _ClassVar[int]
+    MAP_FIELD_NUMBER: _ClassVar[int]
+    APPROVAL_FIELD_NUMBER: _ClassVar[int]
+    PREEMPTION_FIELD_NUMBER: _ClassVar[int]
     readable_id: str
     action: str
     timeout: str
@@ -302,7 +377,66 @@ class CreateTaskOpts(_message.Message):
     is_durable: bool
     slot_requests: _containers.ScalarMap[str, int]
     batch: TaskBatchConfig
-    def __init__(self, readable_id: _Optional[str] = ..., action: _Optional[str] = ..., timeout: _Optional[str] = ..., inputs: _Optional[str] = ..., parents: _Optional[_Iterable[str]] = ..., retries: _Optional[int] = ..., rate_limits: _Optional[_Iterable[_Union[CreateTaskRateLimit, _Mapping]]] = ..., worker_labels: _Optional[_Mapping[str, _trigger_pb2.DesiredWorkerLabels]] = ..., backoff_factor: _Optional[float] = ..., backoff_max_seconds: _Optional[int] = ..., concurrency: _Optional[_Iterable[_Union[Concurrency, _Mapping]]] = ..., conditions: _Optional[_Union[_condition_pb2.TaskConditions, _Mapping]] = ..., schedule_timeout: _Optional[str] = ..., is_durable: bool = ..., slot_requests: _Optional[_Mapping[str, int]] = ..., batch: _Optional[_Union[TaskBatchConfig, _Mapping]] = ...) -> None: ...
+    retry_policies: _containers.RepeatedCompositeFieldContainer[RetryPolicy]
+    circuit_breaker: CircuitBreaker
+    map: TaskMap
+    approval: TaskApproval
+    preemption: Preemption
+    def __init__(self, readable_id: _Optional[str] = ..., action: _Optional[str] = ..., timeout: _Optional[str] = ..., inputs: _Optional[str] = ..., parents: _Optional[_Iterable[str]] = ..., retries: _Optional[int] = ..., rate_limits: _Optional[_Iterable[_Union[CreateTaskRateLimit, _Mapping]]] = ..., worker_labels: _Optional[_Mapping[str, _trigger_pb2.DesiredWorkerLabels]] = ..., backoff_factor: _Optional[float] = ..., backoff_max_seconds: _Optional[int] = ..., concurrency: _Optional[_Iterable[_Union[Concurrency, _Mapping]]] = ..., conditions: _Optional[_Union[_condition_pb2.TaskConditions, _Mapping]] = ..., schedule_timeout: _Optional[str] = ..., is_durable: bool = ..., slot_requests: _Optional[_Mapping[str, int]] = ..., batch: _Optional[_Union[TaskBatchConfig, _Mapping]] = ..., retry_policies: _Optional[_Iterable[_Union[RetryPolicy, _Mapping]]] = ..., circuit_breaker: _Optional[_Union[CircuitBreaker, _Mapping]] = ..., map: _Optional[_Union[TaskMap, _Mapping]] = ..., approval: _Optional[_Union[TaskApproval, _Mapping]] = ..., preemption: _Optional[_Union[Preemption, _Mapping]] = ...) -> None: ...
+
+class Preemption(_message.Message):
+    __slots__ = ("max_preemptions",)
+    MAX_PREEMPTIONS_FIELD_NUMBER: _ClassVar[int]
+    max_preemptions: int
+    def __init__(self, max_preemptions: _Optional[int] = ...) -> None: ...
+
+class TaskApproval(_message.Message):
+    __slots__ = ("approvers", "context_fields", "expires_in", "on_expiry")
+    APPROVERS_FIELD_NUMBER: _ClassVar[int]
+    CONTEXT_FIELDS_FIELD_NUMBER: _ClassVar[int]
+    EXPIRES_IN_FIELD_NUMBER: _ClassVar[int]
+    ON_EXPIRY_FIELD_NUMBER: _ClassVar[int]
+    approvers: _containers.RepeatedScalarFieldContainer[str]
+    context_fields: _containers.RepeatedScalarFieldContainer[str]
+    expires_in: str
+    on_expiry: ApprovalExpiryAction
+    def __init__(self, approvers: _Optional[_Iterable[str]] = ..., context_fields: _Optional[_Iterable[str]] = ..., expires_in: _Optional[str] = ..., on_expiry: _Optional[_Union[ApprovalExpiryAction, str]] = ...) -> None: ...
+
+class TaskMap(_message.Message):
+    __slots__ = ("expression", "max_parallelism")
+    EXPRESSION_FIELD_NUMBER: _ClassVar[int]
+    MAX_PARALLELISM_FIELD_NUMBER: _ClassVar[int]
+    expression: str
+    max_parallelism: int
+    def __init__(self, expression: _Optional[str] = ..., max_parallelism: _Optional[int] = ...) -> None: ...
+
+class CircuitBreaker(_message.Message):
+    __slots__ = ("failure_threshold", "window", "cooldown", "key_expr")
+    FAILURE_THRESHOLD_FIELD_NUMBER: _ClassVar[int]
+    WINDOW_FIELD_NUMBER: _ClassVar[int]
+    COOLDOWN_FIELD_NUMBER: _ClassVar[int]
+    KEY_EXPR_FIELD_NUMBER: _ClassVar[int]
+    failure_threshold: int
+    window: str
+    cooldown: str
+    key_expr: str
+    def __init__(self, failure_threshold: _Optional[int] = ..., window: _Optional[str] = ..., cooldown: _Optional[str] = ..., key_expr: _Optional[str] = ...) -> None: ...
+
+class RetryPolicy(_message.Message):
+    __slots__ = ("error_class", "expression", "retries", "backoff_factor", "backoff_max_seconds", "never_retry")
+    ERROR_CLASS_FIELD_NUMBER: _ClassVar[int]
+    EXPRESSION_FIELD_NUMBER: _ClassVar[int]
+    RETRIES_FIELD_NUMBER: _ClassVar[int]
+    BACKOFF_FACTOR_FIELD_NUMBER: _ClassVar[int]
+    BACKOFF_MAX_SECONDS_FIELD_NUMBER: _ClassVar[int]
+    NEVER_RETRY_FIELD_NUMBER: _ClassVar[int]
+    error_class: str
+    expression: str
+    retries: int
+    backoff_factor: float
+    backoff_max_seconds: int
+    never_retry: bool
+    def __init__(self, error_class: _Optional[str] = ..., expression: _Optional[str] = ..., retries: _Optional[int] = ..., backoff_factor: _Optional[float] = ..., backoff_max_seconds: _Optional[int] = ..., never_retry: bool = ...) -> None: ...
 
 class CreateTaskRateLimit(_message.Message):
     __slots__ = ("key", "units", "key_expr", "units_expr", "limit_values_expr", "duration")
@@ -65,6 +65,16 @@ class AdminServiceStub(object):
                 request_serializer=v1_dot_workflows__pb2.BranchDurableTaskRequest.SerializeToString,
                 response_deserializer=v1_dot_workflows__pb2.BranchDurableTaskResponse.FromString,
                 _registered_method=True)
+        self.AdvanceClock = channel.unary_unary(
+                '/v1.AdminService/AdvanceClock',
+                request_serializer=v1_dot_workflows__pb2.AdvanceClockRequest.SerializeToString,
+                response_deserializer=v1_dot_workflows__pb2.AdvanceClockResponse.FromString,
+                _registered_method=True)
+        self.SignalRun = channel.unary_unary(
+                '/v1.AdminService/SignalRun',
+                request_serializer=v1_dot_workflows__pb2.SignalRunRequest.SerializeToString,
+                response_deserializer=v1_dot_workflows__pb2.SignalRunResponse.FromString,
+                _registered_method=True)
 
 
 class AdminServiceServicer(object):
@@ -107,6 +117,18 @@ class AdminServiceServicer(object):
         context.set_details('Method not implemented!')
         raise NotImplementedError('Method not implemented!')
 
+    def AdvanceClock(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def SignalRun(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
 
 def add_AdminServiceServicer_to_server(servicer, server):
     rpc_method_handlers = {
@@ -140,6 +162,16 @@ def add_AdminServiceServicer_to_server(servicer, server):
                     request_deserializer=v1_dot_workflows__pb2.BranchDurableTaskRequest.FromString,
                     response_serializer=v1_dot_workflows__pb2.BranchDurableTaskResponse.SerializeToString,
             ),
+            'AdvanceClock': grpc.unary_unary_rpc_method_handler(
+                    servicer.AdvanceClock,
+                    request_deserializer=v1_dot_workflows__pb2.AdvanceClockRequest.FromString,
+                    response_serializer=v1_dot_workflows__pb2.AdvanceClockResponse.SerializeToString,
+            ),
+            'SignalRun': grpc.unary_unary_rpc_method_handler(
+                    servicer.SignalRun,
+                    request_deserializer=v1_dot_workflows__pb2.SignalRunRequest.FromString,
+                    response_serializer=v1_dot_workflows__pb2.SignalRunResponse.SerializeToString,
+            ),
     }
     generic_handler = grpc.method_handlers_generic_handler(
             'v1.AdminService', rpc_method_handlers)
@@ -313,3 +345,57 @@ class AdminService(object):
             timeout,
             metadata,
             _registered_method=True)
+
+    @staticmethod
+    def AdvanceClock(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(
+            request,
+            target,
+            '/v1.AdminService/AdvanceClock',
+            v1_dot_workflows__pb2.AdvanceClockRequest.SerializeToString,
+            v1_dot_workflows__pb2.AdvanceClockResponse.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
+
+    @staticmethod
+    def SignalRun(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(
+            request,
+            target,
+            '/v1.AdminService/SignalRun',
+            v1_dot_workflows__pb2.SignalRunRequest.SerializeToString,
+            v1_dot_workflows__pb2.SignalRunResponse.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
@@ -373,6 +373,7 @@ class LegacyWorkerActionListenerProcess:
                             event.type,
                             event.payload,
                             event.should_not_retry,
+                            event.error_class,
                         )
                     )
 
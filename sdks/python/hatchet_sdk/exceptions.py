@@ -33,6 +33,20 @@ class NonRetryableException(Exception):  # noqa: N818
     pass
 
 
+class ClassifiedException(Exception):  # noqa: N818
+    """Raised from a task to report an error class, which the task's retry policies can match on."""
+
+    def __init__(self, error_class: str, message: str = "") -> None:
+        super().__init__(message or error_class)
+        self.error_class = error_class
+
+
+def error_class_of(e: BaseException) -> str | None:
+    error_class = getattr(e, "error_class", None)
+
+    return error_class if isinstance(error_class, str) and error_class else None
+
+
 class DedupeViolationError(Exception):
     """Raised by the Hatchet library to indicate that a workflow has already been run with this deduplication value."""
 
@@ -49,6 +49,7 @@ from hatchet_sdk.types.idempotency import (
 from hatchet_sdk.types.labels import DesiredWorkerLabel
 from hatchet_sdk.types.priority import Priority, _warn_if_int_priority
 from hatchet_sdk.types.rate_limit import RateLimit
+from hatchet_sdk.types.retry_policy import RetryPolicy
 from hatchet_sdk.types.sticky import StickyStrategy
 from hatchet_sdk.utils.slots import normalize_slot_config, resolve_worker_slot_config
 from hatchet_sdk.utils.timedelta_to_expression import Duration
@@ -416,6 +417,7 @@ class Hatchet:
         ) = None,
         backoff_factor: float | None = None,
         backoff_max_seconds: int | None = None,
+        retry_policies: list[RetryPolicy] | None = None,
         default_filters: list[DefaultFilter] | None = None,
         default_additional_metadata: JSONSerializableMapping | None = None,
         slot_cost: int | None = None,
@@ -452,6 +454,7 @@ class Hatchet:
         ) = None,
         backoff_factor: float | None = None,
         backoff_max_seconds: int | None = None,
+        retry_policies: list[RetryPolicy] | None = None,
         default_filters: list[DefaultFilter] | None = None,
         default_additional_metadata: JSONSerializableMapping | None = None,
         slot_cost: int | None = None,
@@ -487,6 +490,7 @@ class Hatchet:
         ) = None,
         backoff_factor: float | None = None,
         backoff_max_seconds: int | None = None,
+        retry_policies: list[RetryPolicy] | None = None,
         default_filters: list[DefaultFilter] | None = None,
         default_additional_metadata: JSONSerializableMapping | None = None,
         slot_cost: int | None = None,
@@ -540,6 +544,8 @@ class Hatchet:
 
         :param backoff_max_seconds: The maximum number of seconds to allow retries with exponential backoff to continue.
 
+        :param retry_policies: A list of retry policies that override `retries` and the backoff settings for failures matching them. The first matching policy wins.
+
         :param default_filters: A list of filters to create with the task is created. Note that this is a helper to allow you to create filters "declaratively" without needing to make a separate API call once the task is created to create them.
 
         :param default_additional_metadata: A dictionary of additional metadata to attach to each run of this task by default.
@@ -597,6 +603,7 @@ class Hatchet:
                 desired_worker_labels=desired_worker_labels or None,
                 backoff_factor=backoff_factor,
                 backoff_max_seconds=backoff_max_seconds,
+                retry_policies=retry_policies,
                 concurrency=_concurrency,
                 slot_cost=slot_cost,
             )
@@ -901,6 +908,7 @@ class Hatchet:
         ) = None,
         backoff_factor: float | None = None,
         backoff_max_seconds: int | None = None,
+        retry_policies: list[RetryPolicy] | None = None,
         default_filters: list[DefaultFilter] | None = None,
         default_additional_metadata: JSONSerializableMapping | None = None,
         eviction_policy: EvictionPolicy | None = DEFAULT_DURABLE_TASK_EVICTION_POLICY,
@@ -937,6 +945,7 @@ class Hatchet:
         ) = None,
         backoff_factor: float | None = None,
         backoff_max_seconds: int | None = None,
+        retry_policies: list[RetryPolicy] | None = None,
         default_filters: list[DefaultFilter] | None = None,
         default_additional_metadata: JSONSerializableMapping | None = None,
         eviction_policy: EvictionPolicy | None = DEFAULT_DURABLE_TASK_EVICTION_POLICY,
@@ -976,6 +985,7 @@ class Hatchet:
         ) = None,
         backoff_factor: float | None = None,
         backoff_max_seconds: int | None = None,
+        retry_policies: list[RetryPolicy] | None = None,
         default_filters: list[DefaultFilter] | None = None,
         default_additional_metadata: JSONSerializableMapping | None = None,
         eviction_policy: EvictionPolicy | None = DEFAULT_DURABLE_TASK_EVICTION_POLICY,
@@ -1037,6 +1047,8 @@ class Hatchet:
 
         :param backoff_max_seconds: The maximum number of seconds to allow retries with exponential backoff to continue.
 
+        :param retry_policies: A list of retry policies that override `retries` and the backoff settings for failures matching them. The first matching policy wins.
+
         :param default_filters: A list of filters to create with the task is created. Note that this is a helper to allow you to create filters "declaratively" without needing to make a separate API call once the task is created to create them.
 
         :param default_additional_metadata: A dictionary of additional metadata to attach to each run of this task by default.
@@ -1091,6 +1103,7 @@ class Hatchet:
                 desired_worker_labels=desired_worker_labels or None,
                 backoff_factor=backoff_factor,
                 backoff_max_seconds=backoff_max_seconds,
+                retry_policies=retry_policies,
                 concurrency=_concurrency,
                 eviction_policy=eviction_policy,
             )
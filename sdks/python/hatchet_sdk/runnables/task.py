@@ -66,6 +66,7 @@ from hatchet_sdk.serde import HATCHET_PYDANTIC_SENTINEL
 from hatchet_sdk.types.concurrency import ConcurrencyExpression
 from hatchet_sdk.types.labels import DesiredWorkerLabel
 from hatchet_sdk.types.priority import Priority
+from hatchet_sdk.types.retry_policy import RetryPolicy
 from hatchet_sdk.utils.timedelta_to_expression import Duration, timedelta_to_expr
 from hatchet_sdk.utils.typing import (
     AwaitableLike,
@@ -175,6 +176,7 @@ class Task(Generic[TWorkflowInput, R]):
         batch: BatchTaskConfig | None = None,
         slot_requests: dict[str, int] | None = None,
         eviction_policy: EvictionPolicy | None = None,
+        retry_policies: list[RetryPolicy] | None = None,
     ) -> None:
         self._is_durable = is_durable
         self.batch = batch
@@ -209,6 +211,7 @@ class Task(Generic[TWorkflowInput, R]):
         )
         self.backoff_factor = backoff_factor
         self.backoff_max_seconds = backoff_max_seconds
+        self.retry_policies = retry_policies or []
         self.concurrency = concurrency or []
 
         self.wait_for = flatten_conditions(wait_for or [])
@@ -503,6 +506,7 @@ class Task(Generic[TWorkflowInput, R]):
             worker_labels=labels,
             backoff_factor=self.backoff_factor,
             backoff_max_seconds=self.backoff_max_seconds,
+            retry_policies=[p.to_proto() for p in self.retry_policies],
             concurrency=[t.to_proto() for t in concurrency],
             conditions=self._conditions_to_proto(),
             schedule_timeout=timedelta_to_expr(self.schedule_timeout),
@@ -65,6 +65,7 @@ from hatchet_sdk.types.labels import (
     _warn_if_dict_desired_worker_labels,
 )
 from hatchet_sdk.types.priority import Priority, _warn_if_int_priority
+from hatchet_sdk.types.retry_policy import RetryPolicy
 from hatchet_sdk.types.trigger import (
     ScheduleTriggerWorkflowOptions,
     TriggerWorkflowOptions,
@@ -1427,6 +1428,7 @@ class Workflow(BaseWorkflow[TWorkflowInput]):
         skip_if: list[Condition | OrGroup] | None = None,
         cancel_if: list[Condition | OrGroup] | None = None,
         slot_cost: int | None = None,
+        retry_policies: list[RetryPolicy] | None = None,
     ) -> Callable[
         [Callable[Concatenate[TWorkflowInput, Context, P], R | CoroutineLike[R]]],
         Task[TWorkflowInput, R],
@@ -1462,6 +1464,8 @@ class Workflow(BaseWorkflow[TWorkflowInput]):
 
         :param slot_cost: The number of default worker slots this task consumes. A normal task consumes one. Set it higher for a task that needs more memory or CPU, so a worker runs fewer of them at once. A single worker must have that many free slots to run it.
 
+        :param retry_policies: A list of retry policies that override `retries` and the backoff settings for failures matching them. The first matching policy wins.
+
         :returns: A decorator which creates a `Task` object.
 
         :raises ValueError: If `slot_cost` is not positive.
@@ -1517,6 +1521,7 @@ class Workflow(BaseWorkflow[TWorkflowInput]):
                 skip_if=skip_if,
                 cancel_if=cancel_if,
                 slot_requests=slot_requests,
+                retry_policies=retry_policies,
             )
 
             self._default_tasks.append(task)
@@ -1716,6 +1721,7 @@ class Workflow(BaseWorkflow[TWorkflowInput]):
         skip_if: list[Condition | OrGroup] | None = None,
         cancel_if: list[Condition | OrGroup] | None = None,
         eviction_policy: EvictionPolicy | None = DEFAULT_DURABLE_TASK_EVICTION_POLICY,
+        retry_policies: list[RetryPolicy] | None = None,
     ) -> Callable[
         [
             Callable[
@@ -1759,6 +1765,8 @@ class Workflow(BaseWorkflow[TWorkflowInput]):
 
         :param eviction_policy: An optional eviction policy controlling when this durable task can be evicted from a worker slot while waiting.
 
+        :param retry_policies: A list of retry policies that override `retries` and the backoff settings for failures matching them. The first matching policy wins.
+
         :returns: A decorator which creates a `Task` object.
         """
 
@@ -1807,6 +1815,7 @@ class Workflow(BaseWorkflow[TWorkflowInput]):
                 skip_if=skip_if,
                 cancel_if=cancel_if,
                 eviction_policy=eviction_policy,
+                retry_policies=retry_policies,
             )
 
             self._durable_tasks.append(task)
@@ -1825,6 +1834,7 @@ class Workflow(BaseWorkflow[TWorkflowInput]):
         backoff_factor: float | None = None,
         backoff_max_seconds: int | None = None,
         concurrency: int | list[ConcurrencyExpression] | None = None,
+        retry_policies: list[RetryPolicy] | None = None,
     ) -> Callable[
         [Callable[Concatenate[TWorkflowInput, Context, P], R | CoroutineLike[R]]],
         Task[TWorkflowInput, R],
@@ -1848,6 +1858,8 @@ class Workflow(BaseWorkflow[TWorkflowInput]):
 
         :param concurrency: A list of concurrency expressions for the on-failure task. If an integer is provided, it is treated as a constant concurrency limit with a `GROUP_ROUND_ROBIN` strategy, which means that only `N` runs of the task may execute at any given time.
 
+        :param retry_policies: A list of retry policies that override `retries` and the backoff settings for failures matching them. The first matching policy wins.
+
         :returns: A decorator which creates a `Task` object.
         """
         _warn_if_str_duration(schedule_timeout, execution_timeout)
@@ -1875,6 +1887,7 @@ class Workflow(BaseWorkflow[TWorkflowInput]):
                 wait_for=None,
                 skip_if=None,
                 cancel_if=None,
+                retry_policies=retry_policies,
             )
 
             if self._on_failure_task:
@@ -1896,6 +1909,7 @@ class Workflow(BaseWorkflow[TWorkflowInput]):
         backoff_factor: float | None = None,
         backoff_max_seconds: int | None = None,
         concurrency: int | list[ConcurrencyExpression] | None = None,
+        retry_policies: list[RetryPolicy] | None = None,
     ) -> Callable[
         [Callable[Concatenate[TWorkflowInput, Context, P], R | CoroutineLike[R]]],
         Task[TWorkflowInput, R],
@@ -1919,6 +1933,8 @@ class Workflow(BaseWorkflow[TWorkflowInput]):
 
         :param concurrency: A list of concurrency expressions for the on-success task. If an integer is provided, it is treated as a constant concurrency limit with a `GROUP_ROUND_ROBIN` strategy, which means that only `N` runs of the task may execute at any given time.
 
+        :param retry_policies: A list of retry policies that override `retries` and the backoff settings for failures matching them. The first matching policy wins.
+
         :returns: A decorator which creates a Task object.
         """
         _warn_if_str_duration(schedule_timeout, execution_timeout)
@@ -1946,6 +1962,7 @@ class Workflow(BaseWorkflow[TWorkflowInput]):
                 wait_for=None,
                 skip_if=None,
                 cancel_if=None,
+                retry_policies=retry_policies,
             )
 
             if self._on_success_task:
@@ -14,6 +14,13 @@ class WorkerLabelComparator(int, Enum):
     GREATER_THAN_OR_EQUAL = 3
     LESS_THAN = 4
     LESS_THAN_OR_EQUAL = 5
+    IN = 6
+    NOT_IN = 7
+    EXISTS = 8
+    NOT_EXISTS = 9
+    SEMVER = 10
+    NOT_COLOCATED = 11
+    BEST_FIT = 12
 
 
 def _warn_if_int_comparator(
@@ -66,6 +73,11 @@ class WorkerLabel(BaseModel):
 
 
 class DesiredWorkerLabel(WorkerLabel):
+    # `EXISTS`, `NOT_EXISTS` and `NOT_COLOCATED` don't compare against a value
+    value: str | int | None = None  # type: ignore[assignment]
+
+    # the set of values for the `IN` and `NOT_IN` comparators
+    values: list[str] | None = None
     required: bool = False
     weight: int | None = None
     comparator: int | WorkerLabelComparator | None = None
@@ -83,4 +95,5 @@ class DesiredWorkerLabel(WorkerLabel):
             required=self.required,
             weight=self.weight,
             comparator=self.comparator,  # type: ignore[arg-type]
+            str_values=self.values,
         )
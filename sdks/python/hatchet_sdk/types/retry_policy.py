@@ -0,0 +1,37 @@
+from pydantic import BaseModel, Field
+
+from hatchet_sdk.contracts.v1.workflows_pb2 import RetryPolicy as RetryPolicyProto
+
+
+class RetryPolicy(BaseModel):
+    """
+    Overrides a task's retry behavior for failures that match it. The first matching policy wins, and failures
+    that match no policy fall back to the task's `retries` and backoff settings.
+
+    A failure's error class is set by raising a `ClassifiedException`, or any exception with an `error_class` attribute.
+
+    Attributes:
+        error_class (str, optional): The error class to match, e.g. "RateLimitError".
+        expression (str, optional): A CEL expression on `error.class`, `error.message` and `retry_count` to match.
+        retries (int, optional): The number of retries for matching failures. Defaults to the task's retries.
+        backoff_factor (float, optional): The retry backoff factor for matching failures.
+        backoff_max_seconds (int, optional): The maximum backoff time for matching failures.
+        never_retry (bool, default=False): If true, matching failures are never retried.
+    """
+
+    error_class: str | None = None
+    expression: str | None = None
+    retries: int | None = Field(default=None, ge=0)
+    backoff_factor: float | None = None
+    backoff_max_seconds: int | None = None
+    never_retry: bool = False
+
+    def to_proto(self) -> RetryPolicyProto:
+        return RetryPolicyProto(
+            error_class=self.error_class,
+            expression=self.expression,
+            retries=self.retries,
+            backoff_factor=self.backoff_factor,
+            backoff_max_seconds=self.backoff_max_seconds,
+            never_retry=self.never_retry,
+        )
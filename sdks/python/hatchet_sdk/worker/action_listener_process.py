@@ -55,6 +55,7 @@ class ActionEvent:
     type: Any  # TODO type
     payload: str | None
     should_not_retry: bool
+    error_class: str | None = None
 
 
 @dataclass
@@ -439,6 +440,7 @@ class WorkerActionListenerProcess:
                             event.type,
                             event.payload,
                             event.should_not_retry,
+                            event.error_class,
                         )
                     )
 
@@ -39,6 +39,7 @@ from hatchet_sdk.exceptions import (
     IllegalTaskOutputError,
     NonRetryableException,
     TaskRunError,
+    error_class_of,
 )
 from hatchet_sdk.features.runs import RunsClient
 from hatchet_sdk.logger import logger
@@ -297,6 +298,7 @@ class Runner:
                         type=STEP_EVENT_TYPE_FAILED,
                         payload=exc.serialize(include_metadata=True),
                         should_not_retry=should_not_retry,
+                        error_class=error_class_of(e),
                     )
                 )
 
@@ -532,6 +534,7 @@ class Runner:
                                         e, ext_id
                                     ).serialize(include_metadata=True),
                                     should_not_retry=should_not_retry,
+                                    error_class=error_class_of(e),
                                 )
                                 for ext_id in action.batch_items
                             ],
@@ -632,6 +635,7 @@ class Runner:
                                 include_metadata=True
                             ),
                             should_not_retry=should_not_retry,
+                            error_class=error_class_of(e),
                         )
                         for ext_id in action.batch_items
                     ],
@@ -0,0 +1,53 @@
+"""Unit tests for task retry policies and error classes."""
+
+from hatchet_sdk import (
+    ClassifiedException,
+    Context,
+    EmptyModel,
+    Hatchet,
+    NonRetryableException,
+    RetryPolicy,
+)
+from hatchet_sdk.exceptions import error_class_of
+
+
+def dummy(input: EmptyModel, ctx: Context) -> None:
+    return None
+
+
+def test_retry_policies_map_to_proto(hatchet: Hatchet) -> None:
+    wf = hatchet.workflow(name="retry-policies-wf")
+
+    t = wf.task(
+        name="classified",
+        retries=3,
+        retry_policies=[
+            RetryPolicy(error_class="RateLimitError", retries=10, backoff_factor=2.0),
+            RetryPolicy(error_class="InvalidInput", never_retry=True),
+        ],
+    )(dummy)
+
+    policies = t.to_proto("svc").retry_policies
+
+    assert [p.error_class for p in policies] == ["RateLimitError", "InvalidInput"]
+    assert policies[0].retries == 10
+    assert policies[0].backoff_factor == 2.0
+    assert not policies[0].HasField("backoff_max_seconds")
+    assert policies[1].never_retry
+
+
+def test_standalone_task_retry_policies(hatchet: Hatchet) -> None:
+    standalone = hatchet.task(
+        name="standalone-classified",
+        retry_policies=[RetryPolicy(expression="error.message.contains('timeout')")],
+    )(dummy)
+
+    policies = standalone._task.to_proto("svc").retry_policies
+
+    assert [p.expression for p in policies] == ["error.message.contains('timeout')"]
+
+
+def test_error_class_of() -> None:
+    assert error_class_of(ClassifiedException("RateLimitError")) == "RateLimitError"
+    assert error_class_of(NonRetryableException("boom")) is None
+    assert error_class_of(ValueError("boom")) is None
@@ -31,6 +31,7 @@ module Hatchet
   require_relative "hatchet/conditions"
   require_relative "hatchet/condition_converter"
   require_relative "hatchet/rate_limit"
+  require_relative "hatchet/retry_policy"
   require_relative "hatchet/batch"
   require_relative "hatchet/labels"
   require_relative "hatchet/trigger_options"
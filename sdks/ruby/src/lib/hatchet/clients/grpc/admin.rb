@@ -260,6 +260,8 @@ module Hatchet
           equal: :EQUAL, not_equal: :NOT_EQUAL,
           greater_than: :GREATER_THAN, greater_than_or_equal: :GREATER_THAN_OR_EQUAL,
           less_than: :LESS_THAN, less_than_or_equal: :LESS_THAN_OR_EQUAL,
+          in: :IN, not_in: :NOT_IN, exists: :EXISTS, not_exists: :NOT_EXISTS,
+          semver: :SEMVER, not_colocated: :NOT_COLOCATED, best_fit: :BEST_FIT,
         }.freeze
 
         private
@@ -271,9 +273,10 @@ module Hatchet
                     dwl_args = {}
                     if v.value.is_a?(Integer)
                       dwl_args[:int_value] = v.value
-                    else
+                    elsif !v.value.nil?
                       dwl_args[:str_value] = v.value.to_s
                     end
+                    dwl_args[:str_values] = v.values.map(&:to_s) if v.values
                     dwl_args[:required] = v.required
                     dwl_args[:weight] = v.weight if v.weight
                     dwl_args[:comparator] = COMPARATOR_MAP[v.comparator] || :EQUAL
@@ -282,6 +285,7 @@ module Hatchet
                     dwl_args = {}
                     dwl_args[:str_value] = v[:str_value].to_s if v[:str_value]
                     dwl_args[:int_value] = v[:int_value] if v[:int_value]
+                    dwl_args[:str_values] = v[:str_values].map(&:to_s) if v[:str_values]
                     dwl_args[:required] = v[:required] if v.key?(:required)
                     dwl_args[:weight] = v[:weight] if v[:weight]
                     dwl_args[:comparator] = COMPARATOR_MAP[v[:comparator]] || :EQUAL if v[:comparator]
@@ -21,6 +21,8 @@ module V1
       rpc :TriggerWorkflowRun, ::V1::TriggerWorkflowRunRequest, ::V1::TriggerWorkflowRunResponse
       rpc :GetRunDetails, ::V1::GetRunDetailsRequest, ::V1::GetRunDetailsResponse
       rpc :BranchDurableTask, ::V1::BranchDurableTaskRequest, ::V1::BranchDurableTaskResponse
+      rpc :AdvanceClock, ::V1::AdvanceClockRequest, ::V1::AdvanceClockResponse
+      rpc :SignalRun, ::V1::SignalRunRequest, ::V1::SignalRunResponse
     end
 
     Stub = Service.rpc_stub_class
@@ -10,6 +10,17 @@ module Hatchet
     end
   end
 
+  # Raised from a task to report an error class, which the task's retry policies can match on
+  class ClassifiedError < Error
+    # @return [String] The error class reported to the engine
+    attr_reader :error_class
+
+    def initialize(error_class, message = nil)
+      @error_class = error_class
+      super(message || error_class)
+    end
+  end
+
   # Raised when the tenant has exceeded its resource limits (e.g. task run quota)
   class ResourceExhaustedError < Error
     def initialize(message = "Resource exhausted: tenant has reached its task runs limit")
@@ -9,6 +9,13 @@ module Hatchet
     LESS_THAN = :less_than
     GREATER_THAN_OR_EQUAL = :greater_than_or_equal
     LESS_THAN_OR_EQUAL = :less_than_or_equal
+    IN = :in
+    NOT_IN = :not_in
+    EXISTS = :exists
+    NOT_EXISTS = :not_exists
+    SEMVER = :semver
+    NOT_COLOCATED = :not_colocated
+    BEST_FIT = :best_fit
   end
 
   # Defines a desired worker label for task scheduling affinity
@@ -18,10 +25,16 @@ module Hatchet
   #
   # @example Require workers with enough memory
   #   Hatchet::DesiredWorkerLabel.new(value: 256, required: true, comparator: :less_than)
+  #
+  # @example Require workers in one of a set of regions
+  #   Hatchet::DesiredWorkerLabel.new(values: %w[us-east-1 us-west-2], required: true, comparator: :in)
   class DesiredWorkerLabel
-    # @return [String, Integer] The desired label value
+    # @return [String, Integer, nil] The desired label value
     attr_reader :value
 
+    # @return [Array<String>, nil] The set of values for the :in and :not_in comparators
+    attr_reader :values
+
     # @return [Integer] Weight for soft scheduling preferences (higher = stronger preference)
     attr_reader :weight
 
@@ -31,12 +44,14 @@ module Hatchet
     # @return [Symbol] Comparator for numeric values
     attr_reader :comparator
 
-    # @param value [String, Integer] Desired label value
+    # @param value [String, Integer, nil] Desired label value
+    # @param values [Array<String>, nil] Set of values for the :in and :not_in comparators
     # @param weight [Integer] Scheduling weight (default: 1)
     # @param required [Boolean] Hard requirement (default: false)
     # @param comparator [Symbol] Comparison operator (default: :equal)
-    def initialize(value:, weight: 1, required: false, comparator: :equal)
+    def initialize(value: nil, values: nil, weight: 1, required: false, comparator: :equal)
       @value = value
+      @values = values
       @weight = weight
       @required = required
       @comparator = comparator
@@ -46,6 +61,7 @@ module Hatchet
     def to_h
       {
         value: @value,
+        values: @values,
         weight: @weight,
         required: @required,
         comparator: @comparator.to_s.upcase,
@@ -0,0 +1,64 @@
+# frozen_string_literal: true
+
+module Hatchet
+  # Overrides a task's retries and backoff for failures that match it. The first matching
+  # policy wins; failures that match no policy fall back to the task's own settings.
+  #
+  # A failure's error class is set by raising a {Hatchet::ClassifiedError}, or any error
+  # that responds to +error_class+.
+  #
+  # @example Retry rate limit errors more often, and never retry invalid input
+  #   [
+  #     Hatchet::RetryPolicy.new(error_class: "RateLimitError", retries: 10, backoff_factor: 2.0),
+  #     Hatchet::RetryPolicy.new(error_class: "InvalidInput", never_retry: true),
+  #   ]
+  class RetryPolicy
+    # @return [String, nil] Error class to match
+    attr_reader :error_class
+
+    # @return [String, nil] CEL expression on error.class, error.message and retry_count
+    attr_reader :expression
+
+    # @return [Integer, nil] Retries for matching failures
+    attr_reader :retries
+
+    # @return [Float, nil] Backoff factor for matching failures
+    attr_reader :backoff_factor
+
+    # @return [Integer, nil] Maximum backoff seconds for matching failures
+    attr_reader :backoff_max_seconds
+
+    # @return [Boolean] Whether matching failures are never retried
+    attr_reader :never_retry
+
+    # @param error_class [String, nil] Error class to match
+    # @param expression [String, nil] CEL expression to match
+    # @param retries [Integer, nil] Retries for matching failures, defaults to the task retries
+    # @param backoff_factor [Float, nil] Backoff factor for matching failures
+    # @param backoff_max_seconds [Integer, nil] Maximum backoff seconds for matching failures
+    # @param never_retry [Boolean] Never retry matching failures
+    def initialize(error_class: nil, expression: nil, retries: nil, backoff_factor: nil, backoff_max_seconds: nil,
+                   never_retry: false)
+      raise ArgumentError, "retries must not be negative" if retries&.negative?
+
+      @error_class = error_class
+      @expression = expression
+      @retries = retries
+      @backoff_factor = backoff_factor
+      @backoff_max_seconds = backoff_max_seconds
+      @never_retry = never_retry
+    end
+
+    # @return [V1::RetryPolicy]
+    def to_proto
+      args = { never_retry: @never_retry }
+      args[:error_class] = @error_class if @error_class
+      args[:expression] = @expression if @expression
+      args[:retries] = @retries if @retries
+      args[:backoff_factor] = @backoff_factor if @backoff_factor
+      args[:backoff_max_seconds] = @backoff_max_seconds if @backoff_max_seconds
+
+      ::V1::RetryPolicy.new(**args)
+    end
+  end
+end
@@ -24,6 +24,8 @@ module Hatchet
       equal: :EQUAL, not_equal: :NOT_EQUAL,
       greater_than: :GREATER_THAN, greater_than_or_equal: :GREATER_THAN_OR_EQUAL,
       less_than: :LESS_THAN, less_than_or_equal: :LESS_THAN_OR_EQUAL,
+      in: :IN, not_in: :NOT_IN, exists: :EXISTS, not_exists: :NOT_EXISTS,
+      semver: :SEMVER, not_colocated: :NOT_COLOCATED, best_fit: :BEST_FIT,
     }.freeze
 
     # @return [Symbol, String] Task name
@@ -47,6 +49,9 @@ module Hatchet
     # @return [Float, nil] Backoff factor between retries
     attr_reader :backoff_factor
 
+    # @return [Array<RetryPolicy>] Retry policies overriding retries and backoff for matching failures
+    attr_reader :retry_policies
+
     # @return [Array<RateLimit>] Rate limits applied to this task
     attr_reader :rate_limits
 
@@ -90,6 +95,7 @@ module Hatchet
     # @param retries [Integer, nil] Max retries
     # @param backoff_max_seconds [Integer, nil] Max backoff seconds
     # @param backoff_factor [Float, nil] Backoff multiplier
+    # @param retry_policies [Array<RetryPolicy>] Retry policies
     # @param rate_limits [Array<RateLimit>] Rate limits
     # @param concurrency [Array<ConcurrencyExpression>, ConcurrencyExpression, nil]
     # @param desired_worker_labels [Hash, nil]
@@ -108,6 +114,7 @@ module Hatchet
       retries: nil,
       backoff_max_seconds: nil,
       backoff_factor: nil,
+      retry_policies: [],
       rate_limits: [],
       concurrency: nil,
       desired_worker_labels: nil,
@@ -128,6 +135,7 @@ module Hatchet
       @retries = retries
       @backoff_max_seconds = backoff_max_seconds
       @backoff_factor = backoff_factor
+      @retry_policies = retry_policies
       @rate_limits = rate_limits
       @concurrency = concurrency
       @desired_worker_labels = desired_worker_labels
@@ -184,6 +192,9 @@ module Hatchet
       opts[:backoff_factor] = @backoff_factor if @backoff_factor
       opts[:backoff_max_seconds] = @backoff_max_seconds if @backoff_max_seconds
 
+      # Retry policies
+      opts[:retry_policies] = @retry_policies.map(&:to_proto) if @retry_policies && !@retry_policies.empty?
+
       # Task-level concurrency
       if @concurrency
         conc_list = @concurrency.is_a?(Array) ? @concurrency : [@concurrency]
@@ -202,6 +213,7 @@ module Hatchet
       if @batch
         opts[:batch] = @batch.to_proto
         opts[:retries] = 0
+        opts.delete(:retry_policies)
       end
 
       ::V1::CreateTaskOpts.new(**opts)
@@ -374,6 +386,7 @@ module Hatchet
                 dwl_args = {}
                 dwl_args[:str_value] = v[:str_value].to_s if v[:str_value]
                 dwl_args[:int_value] = v[:int_value] if v[:int_value]
+                dwl_args[:str_values] = v[:str_values].map(&:to_s) if v[:str_values]
                 dwl_args[:required] = v[:required] if v.key?(:required)
                 dwl_args[:weight] = v[:weight] if v[:weight]
 
@@ -536,9 +536,17 @@ module Hatchet
           payload: payload,
           retry_count: action.retry_count,
           should_not_retry: !retryable,
+          error_class: error_class_of(error),
         )
       end
 
+      def error_class_of(error)
+        return nil unless error.respond_to?(:error_class)
+
+        error_class = error.error_class
+        error_class.is_a?(String) && !error_class.empty? ? error_class : nil
+      end
+
       # Resolve task dependencies in two passes:
       # 1. Simple deps (2-arg lambdas: input, ctx)
       # 2. Composite deps (3-arg lambdas: input, ctx, resolved_deps)
@@ -64,4 +64,22 @@ RSpec.describe Hatchet::Task do
 
     expect(proto.batch).to be_nil
   end
+
+  it "serializes retry policies onto the registration proto" do
+    task = described_class.new(
+      name: "task_with_retry_policies",
+      retries: 3,
+      retry_policies: [
+        Hatchet::RetryPolicy.new(error_class: "RateLimitError", retries: 10, backoff_factor: 2.0),
+        Hatchet::RetryPolicy.new(error_class: "InvalidInput", never_retry: true),
+      ],
+    ) { |_input, _ctx| nil }
+
+    proto = task.to_proto("test-service", config: config)
+
+    expect(proto.retry_policies.map(&:error_class)).to eq(%w[RateLimitError InvalidInput])
+    expect(proto.retry_policies[0].retries).to eq(10)
+    expect(proto.retry_policies[0].backoff_factor).to eq(2.0)
+    expect(proto.retry_policies[1].never_retry).to be(true)
+  end
 end
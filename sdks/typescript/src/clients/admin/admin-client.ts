@@ -29,7 +29,8 @@ import {
 import { RunListenerClient } from '../listeners/run-listener/child-listener-client';
 
 type DesiredWorkerLabelOpt = {
-  value: string | number;
+  value?: string | number;
+  values?: string[];
   required?: boolean;
   weight?: number;
   comparator?: WorkerLabelComparator;
@@ -47,6 +48,7 @@ function convertDesiredWorkerLabels(
         required: label.required,
         weight: label.weight,
         comparator: label.comparator,
+        strValues: label.values ?? [],
       } satisfies DesiredWorkerLabels,
     ])
   );
@@ -40,7 +40,10 @@ export const DesiredWorkerLabelSchema = z
     z.string(),
     z.number().int(),
     z.object({
-      value: z.union([z.string(), z.number()]),
+      value: z.union([z.string(), z.number()]).optional(),
+
+      // (optional) the set of values for the IN and NOT_IN comparators
+      values: z.array(z.string()).optional(),
       required: z.boolean().optional(),
       weight: z.number().int().optional(),
 
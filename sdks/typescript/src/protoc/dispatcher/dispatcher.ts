@@ -531,6 +531,8 @@ export interface StepActionEvent {
   retryCount?: number | undefined;
   /** a flag indicating if the task should _not_ be retried */
   shouldNotRetry?: boolean | undefined;
+  /** the error class of a failure, matched against the task's retry policies (FAILED only) */
+  errorClass?: string | undefined;
 }
 
 export interface BatchActionEventItem {
@@ -542,6 +544,8 @@ export interface BatchActionEventItem {
   retryCount?: number | undefined;
   /** a flag indicating if the task should _not_ be retried (FAILED only) */
   shouldNotRetry?: boolean | undefined;
+  /** the error class of a failure, matched against the task's retry policies (FAILED only) */
+  errorClass?: string | undefined;
 }
 
 export interface BatchActionEvent {
@@ -2846,6 +2850,7 @@ function createBaseStepActionEvent(): StepActionEvent {
     eventPayload: '',
     retryCount: undefined,
     shouldNotRetry: undefined,
+    errorClass: undefined,
   };
 }
 
@@ -2884,6 +2889,9 @@ export const StepActionEvent: MessageFns<StepActionEvent> = {
     if (message.shouldNotRetry !== undefined) {
       writer.uint32(88).bool(message.shouldNotRetry);
     }
+    if (message.errorClass !== undefined) {
+      writer.uint32(98).string(message.errorClass);
+    }
     return writer;
   },
 
@@ -2982,6 +2990,14 @@ export const StepActionEvent: MessageFns<StepActionEvent> = {
           message.shouldNotRetry = reader.bool();
           continue;
         }
+        case 12: {
+          if (tag !== 98) {
+            break;
+          }
+
+          message.errorClass = reader.string();
+          continue;
+        }
       }
       if ((tag & 7) === 4 || tag === 0) {
         break;
@@ -3048,6 +3064,11 @@ export const StepActionEvent: MessageFns<StepActionEvent> = {
         : isSet(object.should_not_retry)
           ? globalThis.Boolean(object.should_not_retry)
           : undefined,
+      errorClass: isSet(object.errorClass)
+        ? globalThis.String(object.errorClass)
+        : isSet(object.error_class)
+          ? globalThis.String(object.error_class)
+          : undefined,
     };
   },
 
@@ -3086,6 +3107,9 @@ export const StepActionEvent: MessageFns<StepActionEvent> = {
     if (message.shouldNotRetry !== undefined) {
       obj.shouldNotRetry = message.shouldNotRetry;
     }
+    if (message.errorClass !== undefined) {
+      obj.errorClass = message.errorClass;
+    }
     return obj;
   },
 
@@ -3105,6 +3129,7 @@ export const StepActionEvent: MessageFns<StepActionEvent> = {
     message.eventPayload = object.eventPayload ?? '';
     message.retryCount = object.retryCount ?? undefined;
     message.shouldNotRetry = object.shouldNotRetry ?? undefined;
+    message.errorClass = object.errorClass ?? undefined;
     return message;
   },
 };
@@ -3115,6 +3140,7 @@ function createBaseBatchActionEventItem(): BatchActionEventItem {
     eventPayload: '',
     retryCount: undefined,
     shouldNotRetry: undefined,
+    errorClass: undefined,
   };
 }
 
@@ -3132,6 +3158,9 @@ export const BatchActionEventItem: MessageFns<BatchActionEventItem> = {
     if (message.shouldNotRetry !== undefined) {
       writer.uint32(32).bool(message.shouldNotRetry);
     }
+    if (message.errorClass !== undefined) {
+      writer.uint32(42).string(message.errorClass);
+    }
     return writer;
   },
 
@@ -3174,6 +3203,14 @@ export const BatchActionEventItem: MessageFns<BatchActionEventItem> = {
           message.shouldNotRetry = reader.bool();
           continue;
         }
+        case 5: {
+          if (tag !== 42) {
+            break;
+          }
+
+          message.errorClass = reader.string();
+          continue;
+        }
       }
       if ((tag & 7) === 4 || tag === 0) {
         break;
@@ -3205,6 +3242,11 @@ export const BatchActionEventItem: MessageFns<BatchActionEventItem> = {
         : isSet(object.should_not_retry)
           ? globalThis.Boolean(object.should_not_retry)
           : undefined,
+      errorClass: isSet(object.errorClass)
+        ? globalThis.String(object.errorClass)
+        : isSet(object.error_class)
+          ? globalThis.String(object.error_class)
+          : undefined,
     };
   },
 
@@ -3222,6 +3264,9 @@ export const BatchActionEventItem: MessageFns<BatchActionEventItem> = {
     if (message.shouldNotRetry !== undefined) {
       obj.shouldNotRetry = message.shouldNotRetry;
     }
+    if (message.errorClass !== undefined) {
+      obj.errorClass = message.errorClass;
+    }
     return obj;
   },
 
@@ -3234,6 +3279,7 @@ export const BatchActionEventItem: MessageFns<BatchActionEventItem> = {
     message.eventPayload = object.eventPayload ?? '';
     message.retryCount = object.retryCount ?? undefined;
     message.shouldNotRetry = object.shouldNotRetry ?? undefined;
+    message.errorClass = object.errorClass ?? undefined;
     return message;
   },
 };
@@ -16,6 +16,26 @@ export enum WorkerLabelComparator {
   GREATER_THAN_OR_EQUAL = 3,
   LESS_THAN = 4,
   LESS_THAN_OR_EQUAL = 5,
+  /** the label's value is one of str_values */
+  IN = 6,
+  /** the label is missing or its value is not one of str_values */
+  NOT_IN = 7,
+  /** the worker has the label, with any value */
+  EXISTS = 8,
+  /** the worker doesn't have the label */
+  NOT_EXISTS = 9,
+  /** the label's value is a version which satisfies the constraint in str_value, e.g. ">= 2.3, < 3" */
+  SEMVER = 10,
+  /**
+   * the worker isn't running other tasks of the same workflow run, and doesn't share the label's
+   * value with a worker which is
+   */
+  NOT_COLOCATED = 11,
+  /**
+   * the label's value is at least int_value, and workers with the least headroom above it are
+   * preferred
+   */
+  BEST_FIT = 12,
   UNRECOGNIZED = -1,
 }
 
@@ -39,6 +59,27 @@ export function workerLabelComparatorFromJSON(object: any): WorkerLabelComparato
     case 5:
     case 'LESS_THAN_OR_EQUAL':
       return WorkerLabelComparator.LESS_THAN_OR_EQUAL;
+    case 6:
+    case 'IN':
+      return WorkerLabelComparator.IN;
+    case 7:
+    case 'NOT_IN':
+      return WorkerLabelComparator.NOT_IN;
+    case 8:
+    case 'EXISTS':
+      return WorkerLabelComparator.EXISTS;
+    case 9:
+    case 'NOT_EXISTS':
+      return WorkerLabelComparator.NOT_EXISTS;
+    case 10:
+    case 'SEMVER':
+      return WorkerLabelComparator.SEMVER;
+    case 11:
+    case 'NOT_COLOCATED':
+      return WorkerLabelComparator.NOT_COLOCATED;
+    case 12:
+    case 'BEST_FIT':
+      return WorkerLabelComparator.BEST_FIT;
     case -1:
     case 'UNRECOGNIZED':
     default:
@@ -60,6 +101,20 @@ export function workerLabelComparatorToJSON(object: WorkerLabelComparator): stri
       return 'LESS_THAN';
     case WorkerLabelComparator.LESS_THAN_OR_EQUAL:
       return 'LESS_THAN_OR_EQUAL';
+    case WorkerLabelComparator.IN:
+      return 'IN';
+    case WorkerLabelComparator.NOT_IN:
+      return 'NOT_IN';
+    case WorkerLabelComparator.EXISTS:
+      return 'EXISTS';
+    case WorkerLabelComparator.NOT_EXISTS:
+      return 'NOT_EXISTS';
+    case WorkerLabelComparator.SEMVER:
+      return 'SEMVER';
+    case WorkerLabelComparator.NOT_COLOCATED:
+      return 'NOT_COLOCATED';
+    case WorkerLabelComparator.BEST_FIT:
+      return 'BEST_FIT';
     case WorkerLabelComparator.UNRECOGNIZED:
     default:
       return 'UNRECOGNIZED';
@@ -87,6 +142,11 @@ export interface DesiredWorkerLabels {
    * If not set, the default is 100.
    */
   weight?: number | undefined;
+  /**
+   * (optional) The set of values for the IN and NOT_IN comparators. Integer labels are matched by
+   * their decimal string.
+   */
+  strValues: string[];
 }
 
 export interface TriggerWorkflowRequest {
@@ -134,6 +194,7 @@ function createBaseDesiredWorkerLabels(): DesiredWorkerLabels {
     required: undefined,
     comparator: undefined,
     weight: undefined,
+    strValues: [],
   };
 }
 
@@ -154,6 +215,9 @@ export const DesiredWorkerLabels: MessageFns<DesiredWorkerLabels> = {
     if (message.weight !== undefined) {
       writer.uint32(40).int32(message.weight);
     }
+    for (const v of message.strValues) {
+      writer.uint32(50).string(v!);
+    }
     return writer;
   },
 
@@ -204,6 +268,14 @@ export const DesiredWorkerLabels: MessageFns<DesiredWorkerLabels> = {
           message.weight = reader.int32();
           continue;
         }
+        case 6: {
+          if (tag !== 50) {
+            break;
+          }
+
+          message.strValues.push(reader.string());
+          continue;
+        }
       }
       if ((tag & 7) === 4 || tag === 0) {
         break;
@@ -230,6 +302,11 @@ export const DesiredWorkerLabels: MessageFns<DesiredWorkerLabels> = {
         ? workerLabelComparatorFromJSON(object.comparator)
         : undefined,
       weight: isSet(object.weight) ? globalThis.Number(object.weight) : undefined,
+      strValues: globalThis.Array.isArray(object?.strValues)
+        ? object.strValues.map((e: any) => globalThis.String(e))
+        : globalThis.Array.isArray(object?.str_values)
+          ? object.str_values.map((e: any) => globalThis.String(e))
+          : [],
     };
   },
 
@@ -250,6 +327,9 @@ export const DesiredWorkerLabels: MessageFns<DesiredWorkerLabels> = {
     if (message.weight !== undefined) {
       obj.weight = Math.round(message.weight);
     }
+    if (message.strValues?.length) {
+      obj.strValues = message.strValues;
+    }
     return obj;
   },
 
@@ -263,6 +343,7 @@ export const DesiredWorkerLabels: MessageFns<DesiredWorkerLabels> = {
     message.required = object.required ?? undefined;
     message.comparator = object.comparator ?? undefined;
     message.weight = object.weight ?? undefined;
+    message.strValues = object.strValues?.map((e) => e) || [];
     return message;
   },
 };
@@ -437,6 +437,8 @@ export interface CreateTaskOpts {
   slotRequests: { [key: string]: number };
   /** (optional) batch execution configuration */
   batch?: TaskBatchConfig | undefined;
+  /** (optional) retry policies evaluated in order on failure, the first match overrides retries and backoff */
+  retryPolicies: RetryPolicy[];
 }
 
 export interface CreateTaskOpts_WorkerLabelsEntry {
@@ -449,6 +451,26 @@ export interface CreateTaskOpts_SlotRequestsEntry {
   value: number;
 }
 
+/**
+ * RetryPolicy overrides the retry behavior of a task for failures which match it. A policy matches
+ * when the error class (if set) equals the class sent by the worker and the expression (if set)
+ * evaluates to true. A policy with neither set matches every failure.
+ */
+export interface RetryPolicy {
+  /** (optional) the error class to match, e.g. "RateLimitError" */
+  errorClass?: string | undefined;
+  /** (optional) a CEL expression on `error.class`, `error.message` and `retry_count` */
+  expression?: string | undefined;
+  /** (optional) the number of retries for matching failures, defaults to the task retries */
+  retries?: number | undefined;
+  /** (optional) the retry backoff factor for matching failures */
+  backoffFactor?: number | undefined;
+  /** (optional) the maximum backoff time for matching failures */
+  backoffMaxSeconds?: number | undefined;
+  /** (optional) if true, matching failures are never retried */
+  neverRetry: boolean;
+}
+
 export interface CreateTaskRateLimit {
   /** (required) the key for the rate limit */
   key: string;
@@ -2493,6 +2515,7 @@ function createBaseCreateTaskOpts(): CreateTaskOpts {
     isDurable: false,
     slotRequests: {},
     batch: undefined,
+    retryPolicies: [],
   };
 }
 
@@ -2554,6 +2577,9 @@ export const CreateTaskOpts: MessageFns<CreateTaskOpts> = {
     if (message.batch !== undefined) {
       TaskBatchConfig.encode(message.batch, writer.uint32(130).fork()).join();
     }
+    for (const v of message.retryPolicies) {
+      RetryPolicy.encode(v!, writer.uint32(138).fork()).join();
+    }
     return writer;
   },
 
@@ -2698,6 +2724,14 @@ export const CreateTaskOpts: MessageFns<CreateTaskOpts> = {
           message.batch = TaskBatchConfig.decode(reader, reader.uint32());
           continue;
         }
+        case 17: {
+          if (tag !== 138) {
+            break;
+          }
+
+          message.retryPolicies.push(RetryPolicy.decode(reader, reader.uint32()));
+          continue;
+        }
       }
       if ((tag & 7) === 4 || tag === 0) {
         break;
@@ -2785,6 +2819,11 @@ export const CreateTaskOpts: MessageFns<CreateTaskOpts> = {
             )
           : {},
       batch: isSet(object.batch) ? TaskBatchConfig.fromJSON(object.batch) : undefined,
+      retryPolicies: globalThis.Array.isArray(object?.retryPolicies)
+        ? object.retryPolicies.map((e: any) => RetryPolicy.fromJSON(e))
+        : globalThis.Array.isArray(object?.retry_policies)
+          ? object.retry_policies.map((e: any) => RetryPolicy.fromJSON(e))
+          : [],
     };
   },
 
@@ -2853,6 +2892,9 @@ export const CreateTaskOpts: MessageFns<CreateTaskOpts> = {
     if (message.batch !== undefined) {
       obj.batch = TaskBatchConfig.toJSON(message.batch);
     }
+    if (message.retryPolicies?.length) {
+      obj.retryPolicies = message.retryPolicies.map((e) => RetryPolicy.toJSON(e));
+    }
     return obj;
   },
 
@@ -2903,6 +2945,7 @@ export const CreateTaskOpts: MessageFns<CreateTaskOpts> = {
       object.batch !== undefined && object.batch !== null
         ? TaskBatchConfig.fromPartial(object.batch)
         : undefined;
+    message.retryPolicies = object.retryPolicies?.map((e) => RetryPolicy.fromPartial(e)) || [];
     return message;
   },
 };
@@ -3072,6 +3115,169 @@ export const CreateTaskOpts_SlotRequestsEntry: MessageFns<CreateTaskOpts_SlotReq
   },
 };
 
+function createBaseRetryPolicy(): RetryPolicy {
+  return {
+    errorClass: undefined,
+    expression: undefined,
+    retries: undefined,
+    backoffFactor: undefined,
+    backoffMaxSeconds: undefined,
+    neverRetry: false,
+  };
+}
+
+export const RetryPolicy: MessageFns<RetryPolicy> = {
+  encode(message: RetryPolicy, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.errorClass !== undefined) {
+      writer.uint32(10).string(message.errorClass);
+    }
+    if (message.expression !== undefined) {
+      writer.uint32(18).string(message.expression);
+    }
+    if (message.retries !== undefined) {
+      writer.uint32(24).int32(message.retries);
+    }
+    if (message.backoffFactor !== undefined) {
+      writer.uint32(37).float(message.backoffFactor);
+    }
+    if (message.backoffMaxSeconds !== undefined) {
+      writer.uint32(40).int32(message.backoffMaxSeconds);
+    }
+    if (message.neverRetry !== false) {
+      writer.uint32(48).bool(message.neverRetry);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): RetryPolicy {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    const end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseRetryPolicy();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.errorClass = reader.string();
+          continue;
+        }
+        case 2: {
+          if (tag !== 18) {
+            break;
+          }
+
+          message.expression = reader.string();
+          continue;
+        }
+        case 3: {
+          if (tag !== 24) {
+            break;
+          }
+
+          message.retries = reader.int32();
+          continue;
+        }
+        case 4: {
+          if (tag !== 37) {
+            break;
+          }
+
+          message.backoffFactor = reader.float();
+          continue;
+        }
+        case 5: {
+          if (tag !== 40) {
+            break;
+          }
+
+          message.backoffMaxSeconds = reader.int32();
+          continue;
+        }
+        case 6: {
+          if (tag !== 48) {
+            break;
+          }
+
+          message.neverRetry = reader.bool();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): RetryPolicy {
+    return {
+      errorClass: isSet(object.errorClass)
+        ? globalThis.String(object.errorClass)
+        : isSet(object.error_class)
+          ? globalThis.String(object.error_class)
+          : undefined,
+      expression: isSet(object.expression) ? globalThis.String(object.expression) : undefined,
+      retries: isSet(object.retries) ? globalThis.Number(object.retries) : undefined,
+      backoffFactor: isSet(object.backoffFactor)
+        ? globalThis.Number(object.backoffFactor)
+        : isSet(object.backoff_factor)
+          ? globalThis.Number(object.backoff_factor)
+          : undefined,
+      backoffMaxSeconds: isSet(object.backoffMaxSeconds)
+        ? globalThis.Number(object.backoffMaxSeconds)
+        : isSet(object.backoff_max_seconds)
+          ? globalThis.Number(object.backoff_max_seconds)
+          : undefined,
+      neverRetry: isSet(object.neverRetry)
+        ? globalThis.Boolean(object.neverRetry)
+        : isSet(object.never_retry)
+          ? globalThis.Boolean(object.never_retry)
+          : false,
+    };
+  },
+
+  toJSON(message: RetryPolicy): unknown {
+    const obj: any = {};
+    if (message.errorClass !== undefined) {
+      obj.errorClass = message.errorClass;
+    }
+    if (message.expression !== undefined) {
+      obj.expression = message.expression;
+    }
+    if (message.retries !== undefined) {
+      obj.retries = Math.round(message.retries);
+    }
+    if (message.backoffFactor !== undefined) {
+      obj.backoffFactor = message.backoffFactor;
+    }
+    if (message.backoffMaxSeconds !== undefined) {
+      obj.backoffMaxSeconds = Math.round(message.backoffMaxSeconds);
+    }
+    if (message.neverRetry !== false) {
+      obj.neverRetry = message.neverRetry;
+    }
+    return obj;
+  },
+
+  create(base?: DeepPartial<RetryPolicy>): RetryPolicy {
+    return RetryPolicy.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<RetryPolicy>): RetryPolicy {
+    const message = createBaseRetryPolicy();
+    message.errorClass = object.errorClass ?? undefined;
+    message.expression = object.expression ?? undefined;
+    message.retries = object.retries ?? undefined;
+    message.backoffFactor = object.backoffFactor ?? undefined;
+    message.backoffMaxSeconds = object.backoffMaxSeconds ?? undefined;
+    message.neverRetry = object.neverRetry ?? false;
+    return message;
+  },
+};
+
 function createBaseCreateTaskRateLimit(): CreateTaskRateLimit {
   return {
     key: '',
@@ -120,7 +120,8 @@ function extractRunIdFromNiceGrpcMetadata(metadata: NiceGrpcMetadata | undefined
 }
 
 type DesiredWorkerLabelOpt = {
-  value: string | number;
+  value?: string | number;
+  values?: string[];
   required?: boolean;
   weight?: number;
   comparator?: WorkerLabelComparator;
@@ -138,6 +139,7 @@ function convertDesiredWorkerLabels(
         required: label.required,
         weight: label.weight,
         comparator: label.comparator,
+        strValues: label.values ?? [],
       } satisfies DesiredWorkerLabels,
     ])
   );
@@ -17,7 +17,12 @@ import HatchetPromise, { CancellationReason } from '@util/hatchet-promise/hatche
 import { CreateStepRateLimit, StickyStrategy } from '@hatchet/protoc/workflows';
 import { actionMap, Logger, taskRunLog } from '@hatchet/util/logger';
 import { BaseWorkflowDeclaration, WorkflowDefinition, HatchetClient } from '@hatchet/v1';
-import { CreateTaskOpts, IdempotencyMethod, TaskBatchConfig } from '@hatchet/protoc/v1/workflows';
+import {
+  CreateTaskOpts,
+  IdempotencyMethod,
+  RetryPolicy as RetryPolicyPb,
+  TaskBatchConfig,
+} from '@hatchet/protoc/v1/workflows';
 import {
   CreateOnFailureTaskOpts,
   CreateOnSuccessTaskOpts,
@@ -259,6 +264,7 @@ export class InternalWorker {
           concurrency: [],
           isDurable: false,
           slotRequests: { default: 1 },
+          retryPolicies: [],
         };
       }
 
@@ -286,6 +292,7 @@ export class InternalWorker {
             onFailure.backoff?.maxSeconds || workflow.taskDefaults?.backoff?.maxSeconds,
           isDurable: false,
           slotRequests: mapSlotRequestsPb(onFailure, false),
+          retryPolicies: mapRetryPoliciesPb(onFailure.retryPolicies),
         };
       }
 
@@ -410,6 +417,7 @@ export class InternalWorker {
           ),
           backoffFactor: task.backoff?.factor || workflow.taskDefaults?.backoff?.factor,
           backoffMaxSeconds: task.backoff?.maxSeconds || workflow.taskDefaults?.backoff?.maxSeconds,
+          retryPolicies: mapRetryPoliciesPb(task.retryPolicies),
           conditions: taskConditionsToPb(task, this.client.config.namespace),
           isDurable: durableTaskSet.has(task),
           slotRequests: mapSlotRequestsPb(task, durableTaskSet.has(task)),
@@ -657,6 +665,7 @@ export class InternalWorker {
 
       const failure = async (error: any) => {
         const shouldNotRetry = error instanceof NonRetryableError;
+        const errorClass = typeof error?.errorClass === 'string' ? error.errorClass : undefined;
 
         try {
           if (context.cancelled) {
@@ -677,7 +686,8 @@ export class InternalWorker {
               message: error?.message,
               stack: error?.stack,
             },
-            action.retryCount
+            action.retryCount,
+            errorClass
           );
           await this.client.dispatcher.sendStepActionEvent(event);
         } catch (e: any) {
@@ -753,7 +763,8 @@ export class InternalWorker {
     eventType: StepActionEventType,
     shouldNotRetry: boolean,
     payload: any = '',
-    retryCount: number = 0
+    retryCount: number = 0,
+    errorClass?: string
   ): StepActionEvent {
     return {
       workerId: this.name,
@@ -767,6 +778,7 @@ export class InternalWorker {
       eventPayload: JSON.stringify(payload),
       shouldNotRetry,
       retryCount,
+      errorClass,
     };
   }
 
@@ -1184,6 +1196,7 @@ function mapWorkerLabelPb(
           [key]: {
             strValue: undefined,
             intValue: undefined,
+            strValues: [],
           },
         };
       }
@@ -1194,6 +1207,7 @@ function mapWorkerLabelPb(
           [key]: {
             strValue: label,
             intValue: undefined,
+            strValues: [],
           },
         };
       }
@@ -1204,6 +1218,7 @@ function mapWorkerLabelPb(
           [key]: {
             strValue: undefined,
             intValue: label,
+            strValues: [],
           },
         };
       }
@@ -1216,6 +1231,7 @@ function mapWorkerLabelPb(
           required: label.required,
           weight: label.weight,
           comparator: label.comparator,
+          strValues: label.values ?? [],
         },
       };
     },
@@ -1223,6 +1239,19 @@ function mapWorkerLabelPb(
   );
 }
 
+function mapRetryPoliciesPb(
+  policies: CreateWorkflowTaskOpts<any, any>['retryPolicies']
+): RetryPolicyPb[] {
+  return (policies ?? []).map((policy) => ({
+    errorClass: policy.errorClass,
+    expression: policy.expression,
+    retries: policy.retries,
+    backoffFactor: policy.backoff?.factor,
+    backoffMaxSeconds: policy.backoff?.maxSeconds,
+    neverRetry: policy.neverRetry ?? false,
+  }));
+}
+
 function onFailureTaskName(workflow: WorkflowDefinition) {
   return `${workflow.name}:on-failure-task`;
 }
@@ -104,7 +104,8 @@ export type RunOpts = {
   desiredWorkerLabels?: Record<
     string,
     {
-      value: string | number;
+      value?: string | number;
+      values?: string[];
       required?: boolean;
       weight?: number;
       comparator?: WorkerLabelComparator;
@@ -304,6 +305,7 @@ export type TaskDefaults = {
    * (optional) worker labels for task routing and scheduling.
    * Each label can be a simple string/number value or an object with additional configuration:
    * - value: The label value (string or number)
+   * - values: The set of values for the IN and NOT_IN comparators
    * - required: Whether the label is required for worker matching
    * - weight: Priority weight for worker selection
    * - comparator: Custom comparison logic for label matching
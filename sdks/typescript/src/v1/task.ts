@@ -93,6 +93,62 @@ export class NonRetryableError extends Error {
   }
 }
 
+/**
+ * A task error with an error class, which the engine matches against the task's retry policies.
+ * Any thrown error with a string `errorClass` property is reported the same way.
+ */
+export class ClassifiedError extends Error {
+  errorClass: string;
+
+  constructor(errorClass: string, message?: string) {
+    super(message);
+    this.name = 'ClassifiedError';
+    this.errorClass = errorClass;
+
+    Object.setPrototypeOf(this, new.target.prototype);
+  }
+}
+
+/**
+ * Overrides a task's retries and backoff for failures which match it. The engine evaluates a
+ * task's policies in order and applies the first match; failures which match no policy use the
+ * task's own retries and backoff.
+ *
+ * A policy matches when errorClass (if set) equals the class of the failure and expression (if
+ * set) evaluates to true. A policy with neither set matches every failure.
+ */
+export type RetryPolicy = {
+  /**
+   * (optional) the class of errors this policy applies to, see ClassifiedError. Tasks which exceed
+   * their timeout fail with the class "TIMEOUT".
+   */
+  errorClass?: string;
+
+  /**
+   * (optional) a CEL expression which decides whether this policy applies, with access to
+   * `error.class`, `error.message` and `retry_count`, e.g. `error.message.contains("429")`.
+   */
+  expression?: string;
+
+  /**
+   * (optional) the number of retries for matching failures, defaults to the task's retries.
+   */
+  retries?: number;
+
+  /**
+   * (optional) the backoff for matching failures.
+   */
+  backoff?: {
+    factor?: number | undefined;
+    maxSeconds?: number | undefined;
+  };
+
+  /**
+   * (optional) if true, matching failures are never retried.
+   */
+  neverRetry?: boolean;
+};
+
 export type TaskFn<
   I extends InputType = UnknownInputType,
   O extends OutputType = void,
@@ -217,6 +273,12 @@ export type CreateBaseTaskOpts<
     maxSeconds?: number | undefined;
   };
 
+  /**
+   * (optional) retry policies which override retries and backoff for failures which match them,
+   * evaluated in order by the engine.
+   */
+  retryPolicies?: RetryPolicy[];
+
   /**
    * (optional) rate limits for the task.
    */
@@ -233,6 +295,7 @@ export type CreateBaseTaskOpts<
    * (optional) worker labels for task routing and scheduling.
    * Each label can be a simple string/number value or an object with additional configuration:
    * - value: The label value (string or number)
+   * - values: The set of values for the IN and NOT_IN comparators
    * - required: Whether the label is required for worker matching
    * - weight: Priority weight for worker selection
    * - comparator: Custom comparison logic for label matching
@@ -240,7 +303,8 @@ export type CreateBaseTaskOpts<
   desiredWorkerLabels?: Record<
     string,
     {
-      value: string | number;
+      value?: string | number;
+      values?: string[];
       required?: boolean;
       weight?: number;
       comparator?: WorkerLabelComparator;
@@ -55,7 +55,8 @@ CREATE TYPE "StepExpressionKind" AS ENUM (
     'DYNAMIC_RATE_LIMIT_KEY',
     'DYNAMIC_RATE_LIMIT_VALUE',
     'DYNAMIC_RATE_LIMIT_UNITS',
-    'DYNAMIC_RATE_LIMIT_WINDOW'
+    'DYNAMIC_RATE_LIMIT_WINDOW',
+    'CIRCUIT_BREAKER_KEY'
 );
 
 -- CreateEnum
@@ -126,7 +127,14 @@ CREATE TYPE "WorkerLabelComparator" AS ENUM (
     'GREATER_THAN',
     'GREATER_THAN_OR_EQUAL',
     'LESS_THAN',
-    'LESS_THAN_OR_EQUAL'
+    'LESS_THAN_OR_EQUAL',
+    'IN',
+    'NOT_IN',
+    'EXISTS',
+    'NOT_EXISTS',
+    'SEMVER',
+    'NOT_COLOCATED',
+    'BEST_FIT'
 );
 
 -- CreateEnum
@@ -472,6 +480,7 @@ CREATE TABLE "StepDesiredWorkerLabel" (
     "required" BOOLEAN NOT NULL,
     "comparator" "WorkerLabelComparator" NOT NULL,
     "weight" INTEGER NOT NULL,
+    "strValues" TEXT[],
 
     CONSTRAINT "StepDesiredWorkerLabel_pkey" PRIMARY KEY ("id")
 );
@@ -893,6 +902,7 @@ CREATE TABLE "WorkerLabel" (
     "key" TEXT NOT NULL,
     "strValue" TEXT,
     "intValue" INTEGER,
+    "isDynamic" BOOLEAN NOT NULL DEFAULT false,
 
     CONSTRAINT "WorkerLabel_pkey" PRIMARY KEY ("id")
 );
@@ -581,6 +581,158 @@ CREATE TABLE v1_step_batch_config (
     CONSTRAINT v1_step_batch_config_pkey PRIMARY KEY (step_id)
 );
 
+-- Per-step retry policies, evaluated in policy_index order when a task fails. The first
+-- policy matching the failure's error class and expression overrides the step's retries
+-- and backoff for that failure.
+CREATE TABLE v1_step_retry_policy (
+    step_id UUID NOT NULL,
+    tenant_id UUID NOT NULL,
+    policy_index INTEGER NOT NULL,
+    error_class TEXT,
+    expression TEXT,
+    retries INTEGER,
+    backoff_factor DOUBLE PRECISION,
+    backoff_max_seconds INTEGER,
+    never_retry BOOLEAN NOT NULL DEFAULT FALSE,
+    CONSTRAINT v1_step_retry_policy_pkey PRIMARY KEY (step_id, policy_index)
+);
+
+-- v1_task_retry_policy_count counts the retries of a task per matched retry policy, so each policy's
+-- retries only limit the failures which matched it.
+CREATE TABLE v1_task_retry_policy_count (
+    task_id BIGINT NOT NULL,
+    task_inserted_at TIMESTAMPTZ NOT NULL,
+    tenant_id UUID NOT NULL,
+    policy_index INTEGER NOT NULL,
+    retry_count INTEGER NOT NULL DEFAULT 0,
+    CONSTRAINT v1_task_retry_policy_count_pkey PRIMARY KEY (task_id, task_inserted_at, policy_index)
+);
+
+-- v1_step_map stores the map configuration of a DAG step. A map step is fanned out into one task per
+-- element of the list returned by the expression, with at most max_parallelism tasks running at once.
+CREATE TABLE v1_step_map (
+    step_id UUID NOT NULL,
+    tenant_id UUID NOT NULL,
+    expression TEXT NOT NULL,
+    max_parallelism INTEGER NOT NULL,
+    CONSTRAINT v1_step_map_pkey PRIMARY KEY (step_id)
+);
+
+CREATE TYPE v1_approval_expiry_action AS ENUM ('FAIL', 'REJECT', 'APPROVE');
+
+-- v1_step_approval stores the approval configuration of a step. Approval steps are run by the
+-- tenant's approval operator rather than a worker, see v1_approval.
+CREATE TABLE v1_step_approval (
+    step_id UUID NOT NULL,
+    tenant_id UUID NOT NULL,
+    approvers TEXT[] NOT NULL DEFAULT '{}',
+    context_fields TEXT[] NOT NULL DEFAULT '{}',
+    expires_in_seconds INTEGER NOT NULL,
+    on_expiry v1_approval_expiry_action NOT NULL DEFAULT 'FAIL',
+    CONSTRAINT v1_step_approval_pkey PRIMARY KEY (step_id)
+);
+
+CREATE TYPE v1_approval_status AS ENUM ('PENDING', 'APPROVED', 'REJECTED', 'EXPIRED', 'CANCELLED');
+
+-- v1_approval is the approval requested by a running approval task. It is resolved by an approver,
+-- or by the tasks controller once expires_at passes.
+CREATE TABLE v1_approval (
+    tenant_id UUID NOT NULL,
+    task_external_id UUID NOT NULL,
+    task_id BIGINT NOT NULL,
+    task_inserted_at TIMESTAMPTZ NOT NULL,
+    task_retry_count INTEGER NOT NULL,
+    workflow_run_id UUID NOT NULL,
+    step_id UUID NOT NULL,
+    task_name TEXT NOT NULL,
+    approvers TEXT[] NOT NULL DEFAULT '{}',
+    context JSONB NOT NULL DEFAULT '{}',
+    on_expiry v1_approval_expiry_action NOT NULL,
+    status v1_approval_status NOT NULL DEFAULT 'PENDING',
+    expires_at TIMESTAMPTZ NOT NULL,
+    approver TEXT,
+    approver_api_token_id UUID,
+    comment TEXT,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    resolved_at TIMESTAMPTZ,
+    CONSTRAINT v1_approval_pkey PRIMARY KEY (tenant_id, task_external_id)
+);
+
+CREATE INDEX v1_approval_pending_expires_at_idx ON v1_approval (tenant_id, expires_at) WHERE status = 'PENDING';
+
+CREATE INDEX v1_approval_tenant_created_at_idx ON v1_approval (tenant_id, created_at DESC);
+
+CREATE TABLE v1_step_circuit_breaker (
+    step_id UUID NOT NULL,
+    tenant_id UUID NOT NULL,
+    failure_threshold INTEGER NOT NULL,
+    window_seconds INTEGER NOT NULL,
+    cooldown_seconds INTEGER NOT NULL,
+    CONSTRAINT v1_step_circuit_breaker_pkey PRIMARY KEY (step_id)
+);
+
+CREATE TYPE v1_circuit_breaker_state AS ENUM ('CLOSED', 'OPEN', 'HALF_OPEN');
+
+-- v1_circuit_breaker stores the state of each circuit breaker key in a tenant. Keys are prefixed with
+-- the step id, so each step has its own breakers. The thresholds are copied from the step which last
+-- recorded a failure for the key.
+CREATE TABLE v1_circuit_breaker (
+    tenant_id UUID NOT NULL,
+    key TEXT NOT NULL,
+    state v1_circuit_breaker_state NOT NULL DEFAULT 'CLOSED',
+    failure_count INTEGER NOT NULL DEFAULT 0,
+    failure_threshold INTEGER NOT NULL,
+    window_seconds INTEGER NOT NULL,
+    cooldown_seconds INTEGER NOT NULL,
+    window_started_at TIMESTAMPTZ NOT NULL,
+    opened_at TIMESTAMPTZ,
+    probe_task_id BIGINT,
+    probe_started_at TIMESTAMPTZ,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT v1_circuit_breaker_pkey PRIMARY KEY (tenant_id, key)
+);
+
+-- v1_workflow_priority_aging stores the priority aging policy of a workflow, set by its latest
+-- version. The tenant's policy in v1_tenant_priority_aging applies to workflows without one.
+CREATE TABLE v1_workflow_priority_aging (
+    workflow_id UUID NOT NULL,
+    tenant_id UUID NOT NULL,
+    interval_seconds INTEGER,
+    max_wait_seconds INTEGER,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT v1_workflow_priority_aging_pkey PRIMARY KEY (workflow_id)
+);
+
+CREATE INDEX v1_workflow_priority_aging_tenant_idx ON v1_workflow_priority_aging (tenant_id);
+
+CREATE TABLE v1_tenant_priority_aging (
+    tenant_id UUID NOT NULL,
+    interval_seconds INTEGER,
+    max_wait_seconds INTEGER,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT v1_tenant_priority_aging_pkey PRIMARY KEY (tenant_id)
+);
+
+-- v1_step_preemption opts a step's tasks into preemption: a running task of the step can be
+-- cancelled and requeued, without consuming a retry, to free its slot for higher-priority work.
+CREATE TABLE v1_step_preemption (
+    step_id UUID NOT NULL,
+    tenant_id UUID NOT NULL,
+    max_preemptions INTEGER NOT NULL,
+    CONSTRAINT v1_step_preemption_pkey PRIMARY KEY (step_id)
+);
+
+-- v1_task_preemption counts how many times each task has been preempted, so that a task can't be
+-- preempted indefinitely.
+CREATE TABLE v1_task_preemption (
+    task_id BIGINT NOT NULL,
+    task_inserted_at TIMESTAMPTZ NOT NULL,
+    tenant_id UUID NOT NULL,
+    preempted_count INTEGER NOT NULL DEFAULT 0,
+    last_preempted_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT v1_task_preemption_pkey PRIMARY KEY (task_id, task_inserted_at)
+);
+
 alter table v1_task_runtime set (
     autovacuum_vacuum_scale_factor = '0.1',
     autovacuum_analyze_scale_factor='0.05',
@@ -603,6 +755,36 @@ CREATE TABLE v1_worker_slot_config (
 
 CREATE INDEX v1_worker_slot_config_worker_id_idx ON v1_worker_slot_config (worker_id);
 
+-- v1_worker_scaling_target stores the autoscaling targets a worker declared when it registered.
+CREATE TABLE v1_worker_scaling_target (
+    tenant_id UUID NOT NULL,
+    worker_id UUID NOT NULL,
+    slots_per_replica INTEGER,
+    max_queue_age_seconds INTEGER,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (tenant_id, worker_id)
+);
+
+CREATE TYPE v1_worker_drain_action AS ENUM ('CANCEL', 'REQUEUE');
+
+-- v1_worker_cordon holds the cordoned workers of a tenant. A cordoned worker keeps running the tasks
+-- assigned to it, but isn't assigned new ones. A draining worker is also given a deadline, after
+-- which the tasks it's still running are moved off it: durable tasks are evicted and restored on
+-- another worker, and other tasks are cancelled or requeued according to the drain action.
+CREATE TABLE v1_worker_cordon (
+    tenant_id UUID NOT NULL,
+    worker_id UUID NOT NULL,
+    reason TEXT,
+    cordoned_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    drain_deadline TIMESTAMPTZ,
+    drain_action v1_worker_drain_action,
+    -- set once a draining worker is no longer running any tasks
+    drained_at TIMESTAMPTZ,
+    PRIMARY KEY (tenant_id, worker_id)
+);
+
+CREATE INDEX v1_worker_cordon_worker_id_idx ON v1_worker_cordon (worker_id);
+
 -- v1_step_slot_request stores per-step slot requests.
 CREATE TABLE v1_step_slot_request (
     tenant_id UUID NOT NULL,
@@ -1969,12 +2151,16 @@ CREATE TABLE v1_log_line (
     retry_count INTEGER NOT NULL DEFAULT 0,
     workflow_id UUID,
     step_id UUID,
+    worker_id UUID,
 
     PRIMARY KEY (task_id, task_inserted_at, id)
 ) PARTITION BY RANGE(task_inserted_at);
 
 CREATE INDEX v1_log_line_tenant_id_level_idx ON v1_log_line (tenant_id ASC, created_at DESC, level ASC);
 
+-- log searches with a query match against the full-text search vector of the message
+CREATE INDEX v1_log_line_message_tsv_idx ON v1_log_line USING GIN (to_tsvector('simple', message));
+
 CREATE TYPE v1_step_match_condition_kind AS ENUM ('PARENT_OVERRIDE', 'USER_EVENT', 'SLEEP');
 
 CREATE TABLE v1_step_match_condition (
@@ -2521,6 +2707,41 @@ CREATE TABLE v1_event (
 CREATE INDEX v1_event_key_scope_idx ON v1_event (tenant_id, key, scope);
 CREATE UNIQUE INDEX v1_event_external_id_seen_at ON v1_event (external_id, seen_at);
 
+-- v1_delayed_event holds events which were pushed with a delivery time in the future. The ticker
+-- delivers each event once its deliver_at has passed, and deletes it afterwards.
+CREATE TABLE v1_delayed_event (
+    id bigint GENERATED ALWAYS AS IDENTITY,
+    tenant_id UUID NOT NULL,
+    external_id UUID NOT NULL,
+    key TEXT NOT NULL,
+    data JSONB,
+    additional_metadata JSONB,
+    priority INTEGER,
+    scope TEXT,
+    deliver_at TIMESTAMPTZ NOT NULL,
+    -- set while a ticker is delivering the event, so that the event isn't delivered concurrently
+    locked_until TIMESTAMPTZ,
+    inserted_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT v1_delayed_event_pkey PRIMARY KEY (id)
+);
+
+CREATE INDEX v1_delayed_event_deliver_at_idx ON v1_delayed_event (deliver_at);
+
+CREATE TYPE v1_event_schema_mode AS ENUM ('REJECT', 'WARN');
+
+-- v1_event_schema binds an event key, or an event key pattern containing `*` wildcards, to a JSON
+-- Schema which payloads of matching events are validated against at ingest. Registering a schema
+-- for a key which already has one adds a new version, and the latest version is the active one.
+CREATE TABLE v1_event_schema (
+    tenant_id UUID NOT NULL,
+    event_key TEXT NOT NULL,
+    version INTEGER NOT NULL,
+    schema JSONB NOT NULL,
+    mode v1_event_schema_mode NOT NULL DEFAULT 'REJECT',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT v1_event_schema_pkey PRIMARY KEY (tenant_id, event_key, version)
+);
+
 -- v1_durable_event_log represents the log file for the durable event history
 -- of a durable task. This table stores metadata like sequence values for entries.
 --
@@ -2630,7 +2851,7 @@ CREATE TABLE v1_durable_event_log_branch_point (
     CONSTRAINT v1_durable_event_log_branch_point_pkey PRIMARY KEY (durable_task_id, durable_task_inserted_at, parent_branch_id, first_node_id_in_new_branch, next_branch_id)
 ) PARTITION BY RANGE(durable_task_inserted_at);
 
-CREATE TYPE v1_operator_kind AS ENUM ('HTTP_API', 'DAG');
+CREATE TYPE v1_operator_kind AS ENUM ('HTTP_API', 'DAG', 'APPROVAL');
 
 CREATE TABLE v1_operator (
     id UUID NOT NULL DEFAULT gen_random_uuid(),
@@ -2644,6 +2865,49 @@ CREATE TABLE v1_operator (
     CONSTRAINT v1_operator_pkey PRIMARY KEY (id)
 );
 
+CREATE TYPE v1_bulk_job_kind AS ENUM ('CANCEL', 'REPLAY', 'DELETE');
+
+CREATE TYPE v1_bulk_job_status AS ENUM ('RUNNING', 'PAUSED', 'COMPLETED', 'FAILED');
+
+-- v1_bulk_job tracks a bulk cancel, replay or delete which is processed in batches by the tasks
+-- controller. The runs matching the filter are first enumerated into v1_bulk_job_item, so that
+-- the job is resumable and its progress doesn't shift as the runs it acts on change status.
+-- Enumeration is paged, and the enumeration cursor is the last run enumerated so far.
+CREATE TABLE v1_bulk_job (
+    id UUID NOT NULL DEFAULT gen_random_uuid(),
+    tenant_id UUID NOT NULL,
+    kind v1_bulk_job_kind NOT NULL,
+    status v1_bulk_job_status NOT NULL DEFAULT 'RUNNING',
+    filter JSONB,
+    is_enumerated BOOLEAN NOT NULL DEFAULT FALSE,
+    total_count BIGINT NOT NULL DEFAULT 0,
+    processed_count BIGINT NOT NULL DEFAULT 0,
+    succeeded_count BIGINT NOT NULL DEFAULT 0,
+    failed_count BIGINT NOT NULL DEFAULT 0,
+    error TEXT,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    finished_at TIMESTAMPTZ,
+    enumeration_cursor_inserted_at TIMESTAMPTZ,
+    enumeration_cursor_id BIGINT,
+    CONSTRAINT v1_bulk_job_pkey PRIMARY KEY (tenant_id, id)
+);
+
+CREATE INDEX v1_bulk_job_tenant_status_idx ON v1_bulk_job (tenant_id, status, created_at);
+
+CREATE TYPE v1_bulk_job_item_status AS ENUM ('PENDING', 'SUCCEEDED', 'FAILED');
+
+CREATE TABLE v1_bulk_job_item (
+    tenant_id UUID NOT NULL,
+    bulk_job_id UUID NOT NULL,
+    external_id UUID NOT NULL,
+    status v1_bulk_job_item_status NOT NULL DEFAULT 'PENDING',
+    error TEXT,
+    CONSTRAINT v1_bulk_job_item_pkey PRIMARY KEY (tenant_id, bulk_job_id, external_id)
+);
+
+CREATE INDEX v1_bulk_job_item_pending_idx ON v1_bulk_job_item (tenant_id, bulk_job_id) WHERE status = 'PENDING';
+
 CREATE TABLE tenant_entitlement (
     tenant_id UUID NOT NULL,
 
@@ -342,7 +342,8 @@ CREATE TYPE v1_event_type_olap AS ENUM (
     'DURABLE_RESTORING',
     'BATCH_BUFFERED',
     'WAITING_FOR_BATCH',
-    'BATCH_FLUSHED'
+    'BATCH_FLUSHED',
+    'PREEMPTED'
 );
 
 -- this is a hash-partitioned table on the task_id, so that we can process batches of events in parallel
@@ -428,6 +429,30 @@ CREATE TABLE v1_incoming_webhook_validation_failures_olap (
 
 CREATE INDEX v1_incoming_webhook_validation_failures_olap_tenant_id_incoming_webhook_name_idx ON v1_incoming_webhook_validation_failures_olap (tenant_id, incoming_webhook_name);
 
+CREATE TABLE v1_event_schema_validation_failures_olap (
+    id BIGINT NOT NULL GENERATED ALWAYS AS IDENTITY,
+
+    tenant_id UUID NOT NULL,
+
+    event_key TEXT NOT NULL,
+
+    -- the key or pattern and the version of the schema the event failed to validate against
+    schema_event_key TEXT NOT NULL,
+    schema_version INTEGER NOT NULL,
+
+    -- whether the event was rejected, or only recorded because the schema is in warn mode
+    rejected BOOLEAN NOT NULL,
+
+    error TEXT NOT NULL,
+
+    inserted_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    PRIMARY KEY (inserted_at, id)
+) PARTITION BY RANGE(inserted_at);
+
+CREATE INDEX v1_event_schema_validation_failures_olap_tenant_id_event_key_idx ON v1_event_schema_validation_failures_olap (tenant_id, event_key);
+
 -- IMPORTANT: Keep these values in sync with `v1_payload_type` in the core db
 CREATE TYPE v1_payload_location_olap AS ENUM ('INLINE', 'EXTERNAL');
 
@@ -452,6 +477,9 @@ CREATE TABLE v1_payloads_olap (
 
 CREATE INDEX v1_payloads_olap_external_id_idx ON v1_payloads_olap (external_id ASC);
 
+-- Backs run search predicates (@@ jsonpath) over inline payloads.
+CREATE INDEX ix_v1_payloads_olap_inline_content_gin ON v1_payloads_olap USING gin (inline_content jsonb_path_ops);
+
 CREATE TABLE v1_payloads_olap_offloaded_block_index (
     payload_inserted_at_date DATE NOT NULL,
     block_external_id_range uuidrange NOT NULL,
@@ -950,6 +978,51 @@ CREATE TABLE v1_payloads_olap_cutover_job_offset (
     last_external_id UUID NOT NULL DEFAULT '00000000-0000-0000-0000-000000000000'::UUID
 );
 
+-- v1_olap_export_checkpoint records the day partitions which have been exported to Parquet. A row is
+-- leased while an export is in flight and marked completed once every file has been written, so
+-- each partition is exported exactly once unless an export is explicitly forced.
+CREATE TABLE v1_olap_export_checkpoint (
+    tenant_id UUID NOT NULL,
+    partition_date DATE NOT NULL,
+    is_completed BOOLEAN NOT NULL DEFAULT FALSE,
+    lease_process_id UUID NOT NULL,
+    lease_expires_at TIMESTAMPTZ NOT NULL,
+    destination TEXT,
+    run_count BIGINT NOT NULL DEFAULT 0,
+    task_count BIGINT NOT NULL DEFAULT 0,
+    task_event_count BIGINT NOT NULL DEFAULT 0,
+    exported_at TIMESTAMPTZ,
+
+    PRIMARY KEY (tenant_id, partition_date)
+);
+
+-- v1_task_slot_usage_olap records the slot time each task attempt consumed on a worker, from its
+-- assignment until its slots were released. The action and additional metadata are captured when
+-- the slots are released, and the workflow name and worker labels are looked up when the usage is
+-- recorded, so that usage can be aggregated without joins. Worker labels are the worker's labels at
+-- the time the usage is recorded, not at the time the task ran.
+CREATE TABLE v1_task_slot_usage_olap (
+    tenant_id UUID NOT NULL,
+    task_id BIGINT NOT NULL,
+    task_inserted_at TIMESTAMPTZ NOT NULL,
+    retry_count INTEGER NOT NULL,
+    slot_type TEXT NOT NULL,
+    units INTEGER NOT NULL,
+    worker_id UUID NOT NULL,
+    workflow_id UUID NOT NULL,
+    workflow_name TEXT NOT NULL,
+    action_id TEXT NOT NULL,
+    worker_labels JSONB NOT NULL DEFAULT '{}'::JSONB,
+    additional_metadata JSONB,
+    assigned_at TIMESTAMPTZ NOT NULL,
+    released_at TIMESTAMPTZ NOT NULL,
+    slot_seconds DOUBLE PRECISION NOT NULL,
+
+    PRIMARY KEY (released_at, task_id, task_inserted_at, retry_count, slot_type)
+) PARTITION BY RANGE(released_at);
+
+CREATE INDEX v1_task_slot_usage_olap_tenant_released_at_idx ON v1_task_slot_usage_olap (tenant_id, released_at);
+
 CREATE OR REPLACE FUNCTION copy_v1_payloads_olap_partition_structure(
     partition_date date
 ) RETURNS text